@@ -53,11 +53,23 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteWorkflowTask(
+		ctx context.Context,
+		Request *shared.DeleteWorkflowTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeCluster(
 		ctx context.Context,
 		opts ...yarpc.CallOption,
 	) (*admin.DescribeClusterResponse, error)
 
+	DescribeDomainUsage(
+		ctx context.Context,
+		Request *shared.DescribeDomainUsageRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeDomainUsageResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -82,6 +94,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	FailoverWorkflowExecution(
+		ctx context.Context,
+		Request *shared.FailoverWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -124,12 +142,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListWorkflowTasks(
+		ctx context.Context,
+		Request *shared.ListWorkflowTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListWorkflowTasksResponse, error)
+
 	MergeDLQMessages(
 		ctx context.Context,
 		Request *replicator.MergeDLQMessagesRequest,
 		opts ...yarpc.CallOption,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	PromoteDomain(
+		ctx context.Context,
+		Request *admin.PromoteDomainRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateDomainResponse, error)
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
@@ -148,18 +178,36 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ReenqueueWorkflowTask(
+		ctx context.Context,
+		Request *shared.ReenqueueWorkflowTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *shared.RefreshWorkflowTasksRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	RemoveSearchAttribute(
+		ctx context.Context,
+		Request *admin.RemoveSearchAttributeRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	RenameSearchAttribute(
+		ctx context.Context,
+		Request *admin.RenameSearchAttributeRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResendReplicationTasks(
 		ctx context.Context,
 		Request *admin.ResendReplicationTasksRequest,
@@ -184,6 +232,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		Request *shared.ResumeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	SuspendWorkflowExecution(
+		ctx context.Context,
+		Request *shared.SuspendWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	UpdateDynamicConfig(
 		ctx context.Context,
 		Request *admin.UpdateDynamicConfigRequest,
@@ -276,6 +336,34 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteWorkflowTask(
+	ctx context.Context,
+	_Request *shared.DeleteWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_DeleteWorkflowTask_Result
+	args := admin.AdminService_DeleteWorkflowTask_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_DeleteWorkflowTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeCluster(
 	ctx context.Context,
 	opts ...yarpc.CallOption,
@@ -303,6 +391,34 @@ func (c client) DescribeCluster(
 	return
 }
 
+func (c client) DescribeDomainUsage(
+	ctx context.Context,
+	_Request *shared.DescribeDomainUsageRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeDomainUsageResponse, err error) {
+
+	var result admin.AdminService_DescribeDomainUsage_Result
+	args := admin.AdminService_DescribeDomainUsage_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_DescribeDomainUsage_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -415,6 +531,34 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) FailoverWorkflowExecution(
+	ctx context.Context,
+	_Request *shared.FailoverWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_FailoverWorkflowExecution_Result
+	args := admin.AdminService_FailoverWorkflowExecution_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_FailoverWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetCrossClusterTasks(
 	ctx context.Context,
 	_Request *shared.GetCrossClusterTasksRequest,
@@ -611,6 +755,34 @@ func (c client) ListDynamicConfig(
 	return
 }
 
+func (c client) ListWorkflowTasks(
+	ctx context.Context,
+	_Request *shared.ListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListWorkflowTasksResponse, err error) {
+
+	var result admin.AdminService_ListWorkflowTasks_Result
+	args := admin.AdminService_ListWorkflowTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ListWorkflowTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MergeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.MergeDLQMessagesRequest,
@@ -639,6 +811,34 @@ func (c client) MergeDLQMessages(
 	return
 }
 
+func (c client) PromoteDomain(
+	ctx context.Context,
+	_Request *admin.PromoteDomainRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateDomainResponse, err error) {
+
+	var result admin.AdminService_PromoteDomain_Result
+	args := admin.AdminService_PromoteDomain_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_PromoteDomain_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PurgeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.PurgeDLQMessagesRequest,
@@ -723,6 +923,34 @@ func (c client) ReapplyEvents(
 	return
 }
 
+func (c client) ReenqueueWorkflowTask(
+	ctx context.Context,
+	_Request *shared.ReenqueueWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_ReenqueueWorkflowTask_Result
+	args := admin.AdminService_ReenqueueWorkflowTask_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_ReenqueueWorkflowTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RefreshWorkflowTasks(
 	ctx context.Context,
 	_Request *shared.RefreshWorkflowTasksRequest,
@@ -751,6 +979,34 @@ func (c client) RefreshWorkflowTasks(
 	return
 }
 
+func (c client) RemoveSearchAttribute(
+	ctx context.Context,
+	_Request *admin.RemoveSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_RemoveSearchAttribute_Result
+	args := admin.AdminService_RemoveSearchAttribute_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_RemoveSearchAttribute_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveTask(
 	ctx context.Context,
 	_Request *shared.RemoveTaskRequest,
@@ -779,6 +1035,34 @@ func (c client) RemoveTask(
 	return
 }
 
+func (c client) RenameSearchAttribute(
+	ctx context.Context,
+	_Request *admin.RenameSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_RenameSearchAttribute_Result
+	args := admin.AdminService_RenameSearchAttribute_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_RenameSearchAttribute_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResendReplicationTasks(
 	ctx context.Context,
 	_Request *admin.ResendReplicationTasksRequest,
@@ -891,6 +1175,62 @@ func (c client) RestoreDynamicConfig(
 	return
 }
 
+func (c client) ResumeWorkflowExecution(
+	ctx context.Context,
+	_Request *shared.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_ResumeWorkflowExecution_Result
+	args := admin.AdminService_ResumeWorkflowExecution_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_ResumeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) SuspendWorkflowExecution(
+	ctx context.Context,
+	_Request *shared.SuspendWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_SuspendWorkflowExecution_Result
+	args := admin.AdminService_SuspendWorkflowExecution_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_SuspendWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDynamicConfig(
 	ctx context.Context,
 	_Request *admin.UpdateDynamicConfigRequest,
@@ -51,10 +51,20 @@ type Interface interface {
 		Request *shared.CloseShardRequest,
 	) error
 
+	DeleteWorkflowTask(
+		ctx context.Context,
+		Request *shared.DeleteWorkflowTaskRequest,
+	) error
+
 	DescribeCluster(
 		ctx context.Context,
 	) (*admin.DescribeClusterResponse, error)
 
+	DescribeDomainUsage(
+		ctx context.Context,
+		Request *shared.DescribeDomainUsageRequest,
+	) (*shared.DescribeDomainUsageResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -75,6 +85,11 @@ type Interface interface {
 		Request *admin.DescribeWorkflowExecutionRequest,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	FailoverWorkflowExecution(
+		ctx context.Context,
+		Request *shared.FailoverWorkflowExecutionRequest,
+	) error
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -110,11 +125,21 @@ type Interface interface {
 		Request *admin.ListDynamicConfigRequest,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListWorkflowTasks(
+		ctx context.Context,
+		Request *shared.ListWorkflowTasksRequest,
+	) (*shared.ListWorkflowTasksResponse, error)
+
 	MergeDLQMessages(
 		ctx context.Context,
 		Request *replicator.MergeDLQMessagesRequest,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	PromoteDomain(
+		ctx context.Context,
+		Request *admin.PromoteDomainRequest,
+	) (*shared.UpdateDomainResponse, error)
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
@@ -130,16 +155,31 @@ type Interface interface {
 		ReapplyEventsRequest *shared.ReapplyEventsRequest,
 	) error
 
+	ReenqueueWorkflowTask(
+		ctx context.Context,
+		Request *shared.ReenqueueWorkflowTaskRequest,
+	) error
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *shared.RefreshWorkflowTasksRequest,
 	) error
 
+	RemoveSearchAttribute(
+		ctx context.Context,
+		Request *admin.RemoveSearchAttributeRequest,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 	) error
 
+	RenameSearchAttribute(
+		ctx context.Context,
+		Request *admin.RenameSearchAttributeRequest,
+	) error
+
 	ResendReplicationTasks(
 		ctx context.Context,
 		Request *admin.ResendReplicationTasksRequest,
@@ -160,6 +200,16 @@ type Interface interface {
 		Request *admin.RestoreDynamicConfigRequest,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		Request *shared.ResumeWorkflowExecutionRequest,
+	) error
+
+	SuspendWorkflowExecution(
+		ctx context.Context,
+		Request *shared.SuspendWorkflowExecutionRequest,
+	) error
+
 	UpdateDynamicConfig(
 		ctx context.Context,
 		Request *admin.UpdateDynamicConfigRequest,
@@ -201,6 +251,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DeleteWorkflowTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DeleteWorkflowTask),
+					NoWire: deleteworkflowtask_NoWireHandler{impl},
+				},
+				Signature:    "DeleteWorkflowTask(Request *shared.DeleteWorkflowTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeCluster",
 				HandlerSpec: thrift.HandlerSpec{
@@ -213,6 +275,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeDomainUsage",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DescribeDomainUsage),
+					NoWire: describedomainusage_NoWireHandler{impl},
+				},
+				Signature:    "DescribeDomainUsage(Request *shared.DescribeDomainUsageRequest) (*shared.DescribeDomainUsageResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -261,6 +335,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "FailoverWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.FailoverWorkflowExecution),
+					NoWire: failoverworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "FailoverWorkflowExecution(Request *shared.FailoverWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetCrossClusterTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -345,6 +431,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListWorkflowTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListWorkflowTasks),
+					NoWire: listworkflowtasks_NoWireHandler{impl},
+				},
+				Signature:    "ListWorkflowTasks(Request *shared.ListWorkflowTasksRequest) (*shared.ListWorkflowTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "MergeDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -357,6 +455,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PromoteDomain",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PromoteDomain),
+					NoWire: promotedomain_NoWireHandler{impl},
+				},
+				Signature:    "PromoteDomain(Request *admin.PromoteDomainRequest) (*shared.UpdateDomainResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PurgeDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -393,6 +503,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReenqueueWorkflowTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ReenqueueWorkflowTask),
+					NoWire: reenqueueworkflowtask_NoWireHandler{impl},
+				},
+				Signature:    "ReenqueueWorkflowTask(Request *shared.ReenqueueWorkflowTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RefreshWorkflowTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -405,6 +527,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RemoveSearchAttribute",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RemoveSearchAttribute),
+					NoWire: removesearchattribute_NoWireHandler{impl},
+				},
+				Signature:    "RemoveSearchAttribute(Request *admin.RemoveSearchAttributeRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RemoveTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -417,6 +551,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RenameSearchAttribute",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RenameSearchAttribute),
+					NoWire: renamesearchattribute_NoWireHandler{impl},
+				},
+				Signature:    "RenameSearchAttribute(Request *admin.RenameSearchAttributeRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResendReplicationTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -465,6 +611,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResumeWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ResumeWorkflowExecution),
+					NoWire: resumeworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "ResumeWorkflowExecution(Request *shared.ResumeWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "SuspendWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.SuspendWorkflowExecution),
+					NoWire: suspendworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "SuspendWorkflowExecution(Request *shared.SuspendWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UpdateDynamicConfig",
 				HandlerSpec: thrift.HandlerSpec{
@@ -479,7 +649,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 25)
+	procedures := make([]transport.Procedure, 0, 35)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -550,6 +720,36 @@ func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) DeleteWorkflowTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DeleteWorkflowTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'DeleteWorkflowTask': %w", err)
+	}
+
+	appErr := h.impl.DeleteWorkflowTask(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DeleteWorkflowTask_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeCluster(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeCluster_Args
 	if err := args.FromWire(body); err != nil {
@@ -580,6 +780,36 @@ func (h handler) DescribeCluster(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) DescribeDomainUsage(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeDomainUsage_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeDomainUsage': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeDomainUsage(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeDomainUsage_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -700,6 +930,36 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) FailoverWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_FailoverWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'FailoverWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.FailoverWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_FailoverWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_GetCrossClusterTasks_Args
 	if err := args.FromWire(body); err != nil {
@@ -910,17 +1170,17 @@ func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift
 	return response, err
 }
 
-func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_MergeDLQMessages_Args
+func (h handler) ListWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListWorkflowTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'MergeDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ListWorkflowTasks': %w", err)
 	}
 
-	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.ListWorkflowTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_ListWorkflowTasks_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -940,17 +1200,17 @@ func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_PurgeDLQMessages_Args
+func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MergeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'PurgeDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'MergeDLQMessages': %w", err)
 	}
 
-	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_PurgeDLQMessages_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -970,17 +1230,17 @@ func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ReadDLQMessages_Args
+func (h handler) PromoteDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PromoteDomain_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ReadDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'PromoteDomain': %w", err)
 	}
 
-	success, appErr := h.impl.ReadDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.PromoteDomain(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ReadDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_PromoteDomain_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1000,17 +1260,17 @@ func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
-func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ReapplyEvents_Args
+func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ReapplyEvents': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'PurgeDLQMessages': %w", err)
 	}
 
-	appErr := h.impl.ReapplyEvents(ctx, args.ReapplyEventsRequest)
+	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ReapplyEvents_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_PurgeDLQMessages_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1030,17 +1290,17 @@ func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RefreshWorkflowTasks_Args
+func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReadDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RefreshWorkflowTasks': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ReadDLQMessages': %w", err)
 	}
 
-	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+	success, appErr := h.impl.ReadDLQMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_ReadDLQMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1060,17 +1320,17 @@ func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RemoveTask_Args
+func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReapplyEvents_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RemoveTask': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ReapplyEvents': %w", err)
 	}
 
-	appErr := h.impl.RemoveTask(ctx, args.Request)
+	appErr := h.impl.ReapplyEvents(ctx, args.ReapplyEventsRequest)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RemoveTask_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_ReapplyEvents_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1090,17 +1350,17 @@ func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ResendReplicationTasks_Args
+func (h handler) ReenqueueWorkflowTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReenqueueWorkflowTask_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ResendReplicationTasks': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ReenqueueWorkflowTask': %w", err)
 	}
 
-	appErr := h.impl.ResendReplicationTasks(ctx, args.Request)
+	appErr := h.impl.ReenqueueWorkflowTask(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ResendReplicationTasks_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_ReenqueueWorkflowTask_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1120,17 +1380,17 @@ func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ResetQueue_Args
+func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RefreshWorkflowTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ResetQueue': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'RefreshWorkflowTasks': %w", err)
 	}
 
-	appErr := h.impl.ResetQueue(ctx, args.Request)
+	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ResetQueue_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1150,17 +1410,17 @@ func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RespondCrossClusterTasksCompleted_Args
+func (h handler) RemoveSearchAttribute(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveSearchAttribute_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RespondCrossClusterTasksCompleted': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'RemoveSearchAttribute': %w", err)
 	}
 
-	success, appErr := h.impl.RespondCrossClusterTasksCompleted(ctx, args.Request)
+	appErr := h.impl.RemoveSearchAttribute(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RespondCrossClusterTasksCompleted_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_RemoveSearchAttribute_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1180,8 +1440,158 @@ func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wir
 	return response, err
 }
 
-func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RestoreDynamicConfig_Args
+func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RemoveTask': %w", err)
+	}
+
+	appErr := h.impl.RemoveTask(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RemoveTask_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RenameSearchAttribute(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RenameSearchAttribute_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RenameSearchAttribute': %w", err)
+	}
+
+	appErr := h.impl.RenameSearchAttribute(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RenameSearchAttribute_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResendReplicationTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResendReplicationTasks': %w", err)
+	}
+
+	appErr := h.impl.ResendReplicationTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResendReplicationTasks_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResetQueue_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResetQueue': %w", err)
+	}
+
+	appErr := h.impl.ResetQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResetQueue_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RespondCrossClusterTasksCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RespondCrossClusterTasksCompleted': %w", err)
+	}
+
+	success, appErr := h.impl.RespondCrossClusterTasksCompleted(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RespondCrossClusterTasksCompleted_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RestoreDynamicConfig_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
 			"could not decode Thrift request for service 'AdminService' procedure 'RestoreDynamicConfig': %w", err)
@@ -1210,6 +1620,66 @@ func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) ResumeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResumeWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResumeWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.ResumeWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResumeWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SuspendWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_SuspendWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'SuspendWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.SuspendWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_SuspendWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) UpdateDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_UpdateDynamicConfig_Args
 	if err := args.FromWire(body); err != nil {
@@ -1314,6 +1784,43 @@ func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type deleteworkflowtask_NoWireHandler struct{ impl Interface }
+
+func (h deleteworkflowtask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DeleteWorkflowTask_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DeleteWorkflowTask': %w", err)
+	}
+
+	appErr := h.impl.DeleteWorkflowTask(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DeleteWorkflowTask_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type describecluster_NoWireHandler struct{ impl Interface }
 
 func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1351,6 +1858,43 @@ func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 
 }
 
+type describedomainusage_NoWireHandler struct{ impl Interface }
+
+func (h describedomainusage_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeDomainUsage_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeDomainUsage': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeDomainUsage(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeDomainUsage_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type describehistoryhost_NoWireHandler struct{ impl Interface }
 
 func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1499,6 +2043,43 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
+type failoverworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h failoverworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_FailoverWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'FailoverWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.FailoverWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_FailoverWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type getcrossclustertasks_NoWireHandler struct{ impl Interface }
 
 func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1758,6 +2339,43 @@ func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *
 
 }
 
+type listworkflowtasks_NoWireHandler struct{ impl Interface }
+
+func (h listworkflowtasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ListWorkflowTasks_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListWorkflowTasks': %w", err)
+	}
+
+	success, appErr := h.impl.ListWorkflowTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ListWorkflowTasks_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type mergedlqmessages_NoWireHandler struct{ impl Interface }
 
 func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1795,6 +2413,43 @@ func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
+type promotedomain_NoWireHandler struct{ impl Interface }
+
+func (h promotedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_PromoteDomain_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'PromoteDomain': %w", err)
+	}
+
+	success, appErr := h.impl.PromoteDomain(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_PromoteDomain_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type purgedlqmessages_NoWireHandler struct{ impl Interface }
 
 func (h purgedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1906,6 +2561,43 @@ func (h reapplyevents_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
+type reenqueueworkflowtask_NoWireHandler struct{ impl Interface }
+
+func (h reenqueueworkflowtask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ReenqueueWorkflowTask_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ReenqueueWorkflowTask': %w", err)
+	}
+
+	appErr := h.impl.ReenqueueWorkflowTask(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReenqueueWorkflowTask_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type refreshworkflowtasks_NoWireHandler struct{ impl Interface }
 
 func (h refreshworkflowtasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1943,6 +2635,43 @@ func (h refreshworkflowtasks_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
+type removesearchattribute_NoWireHandler struct{ impl Interface }
+
+func (h removesearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_RemoveSearchAttribute_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'RemoveSearchAttribute': %w", err)
+	}
+
+	appErr := h.impl.RemoveSearchAttribute(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RemoveSearchAttribute_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type removetask_NoWireHandler struct{ impl Interface }
 
 func (h removetask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1980,6 +2709,43 @@ func (h removetask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type renamesearchattribute_NoWireHandler struct{ impl Interface }
+
+func (h renamesearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_RenameSearchAttribute_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'RenameSearchAttribute': %w", err)
+	}
+
+	appErr := h.impl.RenameSearchAttribute(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RenameSearchAttribute_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resendreplicationtasks_NoWireHandler struct{ impl Interface }
 
 func (h resendreplicationtasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2128,6 +2894,80 @@ func (h restoredynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
+type resumeworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h resumeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ResumeWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ResumeWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.ResumeWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResumeWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type suspendworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h suspendworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_SuspendWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'SuspendWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.SuspendWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_SuspendWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type updatedynamicconfig_NoWireHandler struct{ impl Interface }
 
 func (h updatedynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
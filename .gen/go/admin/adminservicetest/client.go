@@ -130,6 +130,37 @@ func (mr *_MockClientRecorder) CloseShard(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CloseShard", args...)
 }
 
+// DeleteWorkflowTask responds to a DeleteWorkflowTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DeleteWorkflowTask(gomock.Any(), ...).Return(...)
+//	... := client.DeleteWorkflowTask(...)
+func (m *MockClient) DeleteWorkflowTask(
+	ctx context.Context,
+	_Request *shared.DeleteWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteWorkflowTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteWorkflowTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteWorkflowTask", args...)
+}
+
 // DescribeCluster responds to a DescribeCluster call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -161,6 +192,39 @@ func (mr *_MockClientRecorder) DescribeCluster(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeCluster", args...)
 }
 
+// DescribeDomainUsage responds to a DescribeDomainUsage call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeDomainUsage(gomock.Any(), ...).Return(...)
+//	... := client.DescribeDomainUsage(...)
+func (m *MockClient) DescribeDomainUsage(
+	ctx context.Context,
+	_Request *shared.DescribeDomainUsageRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeDomainUsageResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeDomainUsage", args...)
+	success, _ = ret[i].(*shared.DescribeDomainUsageResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeDomainUsage(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeDomainUsage", args...)
+}
+
 // DescribeHistoryHost responds to a DescribeHistoryHost call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -293,6 +357,37 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// FailoverWorkflowExecution responds to a FailoverWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().FailoverWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.FailoverWorkflowExecution(...)
+func (m *MockClient) FailoverWorkflowExecution(
+	ctx context.Context,
+	_Request *shared.FailoverWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "FailoverWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) FailoverWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "FailoverWorkflowExecution", args...)
+}
+
 // GetCrossClusterTasks responds to a GetCrossClusterTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -524,6 +619,39 @@ func (mr *_MockClientRecorder) ListDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListDynamicConfig", args...)
 }
 
+// ListWorkflowTasks responds to a ListWorkflowTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListWorkflowTasks(gomock.Any(), ...).Return(...)
+//	... := client.ListWorkflowTasks(...)
+func (m *MockClient) ListWorkflowTasks(
+	ctx context.Context,
+	_Request *shared.ListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListWorkflowTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListWorkflowTasks", args...)
+	success, _ = ret[i].(*shared.ListWorkflowTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListWorkflowTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListWorkflowTasks", args...)
+}
+
 // MergeDLQMessages responds to a MergeDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -557,6 +685,39 @@ func (mr *_MockClientRecorder) MergeDLQMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "MergeDLQMessages", args...)
 }
 
+// PromoteDomain responds to a PromoteDomain call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().PromoteDomain(gomock.Any(), ...).Return(...)
+//	... := client.PromoteDomain(...)
+func (m *MockClient) PromoteDomain(
+	ctx context.Context,
+	_Request *admin.PromoteDomainRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateDomainResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PromoteDomain", args...)
+	success, _ = ret[i].(*shared.UpdateDomainResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PromoteDomain(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PromoteDomain", args...)
+}
+
 // PurgeDLQMessages responds to a PurgeDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -652,6 +813,37 @@ func (mr *_MockClientRecorder) ReapplyEvents(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ReapplyEvents", args...)
 }
 
+// ReenqueueWorkflowTask responds to a ReenqueueWorkflowTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReenqueueWorkflowTask(gomock.Any(), ...).Return(...)
+//	... := client.ReenqueueWorkflowTask(...)
+func (m *MockClient) ReenqueueWorkflowTask(
+	ctx context.Context,
+	_Request *shared.ReenqueueWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReenqueueWorkflowTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReenqueueWorkflowTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReenqueueWorkflowTask", args...)
+}
+
 // RefreshWorkflowTasks responds to a RefreshWorkflowTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -683,6 +875,37 @@ func (mr *_MockClientRecorder) RefreshWorkflowTasks(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RefreshWorkflowTasks", args...)
 }
 
+// RemoveSearchAttribute responds to a RemoveSearchAttribute call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RemoveSearchAttribute(gomock.Any(), ...).Return(...)
+//	... := client.RemoveSearchAttribute(...)
+func (m *MockClient) RemoveSearchAttribute(
+	ctx context.Context,
+	_Request *admin.RemoveSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RemoveSearchAttribute", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RemoveSearchAttribute(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveSearchAttribute", args...)
+}
+
 // RemoveTask responds to a RemoveTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -714,6 +937,37 @@ func (mr *_MockClientRecorder) RemoveTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTask", args...)
 }
 
+// RenameSearchAttribute responds to a RenameSearchAttribute call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RenameSearchAttribute(gomock.Any(), ...).Return(...)
+//	... := client.RenameSearchAttribute(...)
+func (m *MockClient) RenameSearchAttribute(
+	ctx context.Context,
+	_Request *admin.RenameSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RenameSearchAttribute", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RenameSearchAttribute(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RenameSearchAttribute", args...)
+}
+
 // ResendReplicationTasks responds to a ResendReplicationTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -840,6 +1094,68 @@ func (mr *_MockClientRecorder) RestoreDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RestoreDynamicConfig", args...)
 }
 
+// ResumeWorkflowExecution responds to a ResumeWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResumeWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ResumeWorkflowExecution(...)
+func (m *MockClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	_Request *shared.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResumeWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResumeWorkflowExecution", args...)
+}
+
+// SuspendWorkflowExecution responds to a SuspendWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().SuspendWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.SuspendWorkflowExecution(...)
+func (m *MockClient) SuspendWorkflowExecution(
+	ctx context.Context,
+	_Request *shared.SuspendWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) SuspendWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "SuspendWorkflowExecution", args...)
+}
+
 // UpdateDynamicConfig responds to a UpdateDynamicConfig call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -47,6 +47,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteWorkflowTask(
+		ctx context.Context,
+		Request *history.DeleteWorkflowTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -71,6 +77,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	FailoverWorkflowExecution(
+		ctx context.Context,
+		Request *history.FailoverWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -101,6 +113,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	ListWorkflowTasks(
+		ctx context.Context,
+		Request *history.ListWorkflowTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListWorkflowTasksResponse, error)
+
 	MergeDLQMessages(
 		ctx context.Context,
 		Request *replicator.MergeDLQMessagesRequest,
@@ -143,6 +161,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RebuildMutableState(
+		ctx context.Context,
+		RebuildRequest *history.RebuildMutableStateRequest,
+		opts ...yarpc.CallOption,
+	) (*history.RebuildMutableStateResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -167,12 +191,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	ReenqueueWorkflowTask(
+		ctx context.Context,
+		Request *history.ReenqueueWorkflowTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *history.RefreshWorkflowTasksRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	ReindexWorkflowExecution(
+		ctx context.Context,
+		ReindexRequest *history.ReindexWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveSignalMutableState(
 		ctx context.Context,
 		RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -251,6 +287,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		ResumeRequest *history.ResumeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -275,6 +317,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.StartWorkflowExecutionResponse, error)
 
+	SuspendWorkflowExecution(
+		ctx context.Context,
+		SuspendRequest *history.SuspendWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	SyncActivity(
 		ctx context.Context,
 		SyncActivityRequest *history.SyncActivityRequest,
@@ -351,6 +399,34 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteWorkflowTask(
+	ctx context.Context,
+	_Request *history.DeleteWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_DeleteWorkflowTask_Result
+	args := history.HistoryService_DeleteWorkflowTask_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_DeleteWorkflowTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -463,6 +539,34 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) FailoverWorkflowExecution(
+	ctx context.Context,
+	_Request *history.FailoverWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_FailoverWorkflowExecution_Result
+	args := history.HistoryService_FailoverWorkflowExecution_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_FailoverWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetCrossClusterTasks(
 	ctx context.Context,
 	_Request *shared.GetCrossClusterTasksRequest,
@@ -603,6 +707,34 @@ func (c client) GetReplicationMessages(
 	return
 }
 
+func (c client) ListWorkflowTasks(
+	ctx context.Context,
+	_Request *history.ListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListWorkflowTasksResponse, err error) {
+
+	var result history.HistoryService_ListWorkflowTasks_Result
+	args := history.HistoryService_ListWorkflowTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_ListWorkflowTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MergeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.MergeDLQMessagesRequest,
@@ -799,6 +931,34 @@ func (c client) ReapplyEvents(
 	return
 }
 
+func (c client) RebuildMutableState(
+	ctx context.Context,
+	_RebuildRequest *history.RebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (success *history.RebuildMutableStateResponse, err error) {
+
+	var result history.HistoryService_RebuildMutableState_Result
+	args := history.HistoryService_RebuildMutableState_Helper.Args(_RebuildRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_RebuildMutableState_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -911,6 +1071,34 @@ func (c client) RecordDecisionTaskStarted(
 	return
 }
 
+func (c client) ReenqueueWorkflowTask(
+	ctx context.Context,
+	_Request *history.ReenqueueWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_ReenqueueWorkflowTask_Result
+	args := history.HistoryService_ReenqueueWorkflowTask_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_ReenqueueWorkflowTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RefreshWorkflowTasks(
 	ctx context.Context,
 	_Request *history.RefreshWorkflowTasksRequest,
@@ -939,6 +1127,34 @@ func (c client) RefreshWorkflowTasks(
 	return
 }
 
+func (c client) ReindexWorkflowExecution(
+	ctx context.Context,
+	_ReindexRequest *history.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_ReindexWorkflowExecution_Result
+	args := history.HistoryService_ReindexWorkflowExecution_Helper.Args(_ReindexRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_ReindexWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveSignalMutableState(
 	ctx context.Context,
 	_RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -1303,6 +1519,34 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) ResumeWorkflowExecution(
+	ctx context.Context,
+	_ResumeRequest *history.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_ResumeWorkflowExecution_Result
+	args := history.HistoryService_ResumeWorkflowExecution_Helper.Args(_ResumeRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_ResumeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -1415,6 +1659,34 @@ func (c client) StartWorkflowExecution(
 	return
 }
 
+func (c client) SuspendWorkflowExecution(
+	ctx context.Context,
+	_SuspendRequest *history.SuspendWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_SuspendWorkflowExecution_Result
+	args := history.HistoryService_SuspendWorkflowExecution_Helper.Args(_SuspendRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_SuspendWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) SyncActivity(
 	ctx context.Context,
 	_SyncActivityRequest *history.SyncActivityRequest,
@@ -99,6 +99,37 @@ func (mr *_MockClientRecorder) CloseShard(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CloseShard", args...)
 }
 
+// DeleteWorkflowTask responds to a DeleteWorkflowTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DeleteWorkflowTask(gomock.Any(), ...).Return(...)
+//	... := client.DeleteWorkflowTask(...)
+func (m *MockClient) DeleteWorkflowTask(
+	ctx context.Context,
+	_Request *history.DeleteWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteWorkflowTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteWorkflowTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteWorkflowTask", args...)
+}
+
 // DescribeHistoryHost responds to a DescribeHistoryHost call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -231,6 +262,37 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// FailoverWorkflowExecution responds to a FailoverWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().FailoverWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.FailoverWorkflowExecution(...)
+func (m *MockClient) FailoverWorkflowExecution(
+	ctx context.Context,
+	_Request *history.FailoverWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "FailoverWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) FailoverWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "FailoverWorkflowExecution", args...)
+}
+
 // GetCrossClusterTasks responds to a GetCrossClusterTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -396,6 +458,39 @@ func (mr *_MockClientRecorder) GetReplicationMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetReplicationMessages", args...)
 }
 
+// ListWorkflowTasks responds to a ListWorkflowTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListWorkflowTasks(gomock.Any(), ...).Return(...)
+//	... := client.ListWorkflowTasks(...)
+func (m *MockClient) ListWorkflowTasks(
+	ctx context.Context,
+	_Request *history.ListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListWorkflowTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListWorkflowTasks", args...)
+	success, _ = ret[i].(*shared.ListWorkflowTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListWorkflowTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListWorkflowTasks", args...)
+}
+
 // MergeDLQMessages responds to a MergeDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -621,6 +716,39 @@ func (mr *_MockClientRecorder) ReapplyEvents(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ReapplyEvents", args...)
 }
 
+// RebuildMutableState responds to a RebuildMutableState call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RebuildMutableState(gomock.Any(), ...).Return(...)
+//	... := client.RebuildMutableState(...)
+func (m *MockClient) RebuildMutableState(
+	ctx context.Context,
+	_RebuildRequest *history.RebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (success *history.RebuildMutableStateResponse, err error) {
+
+	args := []interface{}{ctx, _RebuildRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RebuildMutableState", args...)
+	success, _ = ret[i].(*history.RebuildMutableStateResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RebuildMutableState(
+	ctx interface{},
+	_RebuildRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _RebuildRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RebuildMutableState", args...)
+}
+
 // RecordActivityTaskHeartbeat responds to a RecordActivityTaskHeartbeat call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -751,6 +879,37 @@ func (mr *_MockClientRecorder) RecordDecisionTaskStarted(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RecordDecisionTaskStarted", args...)
 }
 
+// ReenqueueWorkflowTask responds to a ReenqueueWorkflowTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReenqueueWorkflowTask(gomock.Any(), ...).Return(...)
+//	... := client.ReenqueueWorkflowTask(...)
+func (m *MockClient) ReenqueueWorkflowTask(
+	ctx context.Context,
+	_Request *history.ReenqueueWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReenqueueWorkflowTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReenqueueWorkflowTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReenqueueWorkflowTask", args...)
+}
+
 // RefreshWorkflowTasks responds to a RefreshWorkflowTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -782,6 +941,37 @@ func (mr *_MockClientRecorder) RefreshWorkflowTasks(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RefreshWorkflowTasks", args...)
 }
 
+// ReindexWorkflowExecution responds to a ReindexWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReindexWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ReindexWorkflowExecution(...)
+func (m *MockClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	_ReindexRequest *history.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _ReindexRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReindexWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReindexWorkflowExecution(
+	ctx interface{},
+	_ReindexRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ReindexRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReindexWorkflowExecution", args...)
+}
+
 // RemoveSignalMutableState responds to a RemoveSignalMutableState call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1193,6 +1383,37 @@ func (mr *_MockClientRecorder) RespondDecisionTaskFailed(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondDecisionTaskFailed", args...)
 }
 
+// ResumeWorkflowExecution responds to a ResumeWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResumeWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ResumeWorkflowExecution(...)
+func (m *MockClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	_ResumeRequest *history.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _ResumeRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResumeWorkflowExecution(
+	ctx interface{},
+	_ResumeRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ResumeRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResumeWorkflowExecution", args...)
+}
+
 // ScheduleDecisionTask responds to a ScheduleDecisionTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1321,6 +1542,37 @@ func (mr *_MockClientRecorder) StartWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "StartWorkflowExecution", args...)
 }
 
+// SuspendWorkflowExecution responds to a SuspendWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().SuspendWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.SuspendWorkflowExecution(...)
+func (m *MockClient) SuspendWorkflowExecution(
+	ctx context.Context,
+	_SuspendRequest *history.SuspendWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _SuspendRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) SuspendWorkflowExecution(
+	ctx interface{},
+	_SuspendRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _SuspendRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "SuspendWorkflowExecution", args...)
+}
+
 // SyncActivity responds to a SyncActivity call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
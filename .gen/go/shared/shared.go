@@ -12000,6 +12000,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	Header                              *Header                 `json:"header,omitempty"`
 	Memo                                *Memo                   `json:"memo,omitempty"`
 	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
+	RetentionPeriodInDays               *int32                  `json:"retentionPeriodInDays,omitempty"`
 }
 
 // ToWire translates a ContinueAsNewWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
@@ -12019,7 +12020,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 //   }
 func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [15]wire.Field
+		fields [16]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -12145,6 +12146,14 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 150, Value: w}
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -12320,6 +12329,16 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) FromWire(w wire.Value
 					return err
 				}
 
+			}
+		case 160:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -12516,6 +12535,18 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) Encode(sw stream.Writ
 		}
 	}
 
+	if v.RetentionPeriodInDays != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 160, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.RetentionPeriodInDays)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -12657,6 +12688,14 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) Decode(sr stream.Read
 				return err
 			}
 
+		case fh.ID == 160 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.RetentionPeriodInDays = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -12686,7 +12725,7 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [15]string
+	var fields [16]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -12748,6 +12787,10 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("RetentionPeriodInDays: %v", *(v.RetentionPeriodInDays))
+		i++
+	}
 
 	return fmt.Sprintf("ContinueAsNewWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -12817,6 +12860,9 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) Equals(rhs *ContinueA
 	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionPeriodInDays, rhs.RetentionPeriodInDays) {
+		return false
+	}
 
 	return true
 }
@@ -12872,6 +12918,9 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) MarshalLogObject(enc
 	if v.SearchAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
 	}
+	if v.RetentionPeriodInDays != nil {
+		enc.AddInt32("retentionPeriodInDays", *v.RetentionPeriodInDays)
+	}
 	return err
 }
 
@@ -13100,27 +13149,68 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) IsSetSearchAttributes
 	return v != nil && v.SearchAttributes != nil
 }
 
-type CountWorkflowExecutionsRequest struct {
-	Domain *string `json:"domain,omitempty"`
-	Query  *string `json:"query,omitempty"`
+// GetRetentionPeriodInDays returns the value of RetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+
+	return
 }
 
-// ToWire translates a CountWorkflowExecutionsRequest struct into a Thrift-level intermediate
+// IsSetRetentionPeriodInDays returns true if RetentionPeriodInDays is not nil.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) IsSetRetentionPeriodInDays() bool {
+	return v != nil && v.RetentionPeriodInDays != nil
+}
+
+type CountWorkflowExecutionsGroup struct {
+	GroupValues []string `json:"groupValues,omitempty"`
+	Count       *int64   `json:"count,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a CountWorkflowExecutionsGroup struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *CountWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *CountWorkflowExecutionsGroup) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -13128,16 +13218,16 @@ func (v *CountWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.GroupValues != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.GroupValues)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Query != nil {
-		w, err = wire.NewValueString(*(v.Query)), error(nil)
+	if v.Count != nil {
+		w, err = wire.NewValueI64(*(v.Count)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -13148,43 +13238,59 @@ func (v *CountWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a CountWorkflowExecutionsRequest struct from its Thrift-level
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a CountWorkflowExecutionsGroup struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a CountWorkflowExecutionsRequest struct
+// An error is returned if we were unable to build a CountWorkflowExecutionsGroup struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v CountWorkflowExecutionsRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *CountWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v CountWorkflowExecutionsGroup
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *CountWorkflowExecutionsGroup) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+			if field.Value.Type() == wire.TList {
+				v.GroupValues, err = _List_String_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Query = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Count = &x
 				if err != nil {
 					return err
 				}
@@ -13196,20 +13302,38 @@ func (v *CountWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a CountWorkflowExecutionsRequest struct directly into bytes, without going
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a CountWorkflowExecutionsGroup struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a CountWorkflowExecutionsRequest struct could not be encoded.
-func (v *CountWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
+// An error is returned if a CountWorkflowExecutionsGroup struct could not be encoded.
+func (v *CountWorkflowExecutionsGroup) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.GroupValues != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
+		if err := _List_String_Encode(v.GroupValues, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -13217,11 +13341,11 @@ func (v *CountWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Query != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.Count != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Query)); err != nil {
+		if err := sw.WriteInt64(*(v.Count)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -13232,12 +13356,42 @@ func (v *CountWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a CountWorkflowExecutionsRequest struct directly from its Thrift-level
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a CountWorkflowExecutionsGroup struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a CountWorkflowExecutionsRequest struct could not be generated from the wire
+// An error is returned if a CountWorkflowExecutionsGroup struct could not be generated from the wire
 // representation.
-func (v *CountWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
+func (v *CountWorkflowExecutionsGroup) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -13250,18 +13404,16 @@ func (v *CountWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Domain = &x
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.GroupValues, err = _List_String_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Query = &x
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Count = &x
 			if err != nil {
 				return err
 			}
@@ -13288,97 +13440,124 @@ func (v *CountWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a CountWorkflowExecutionsRequest
+// String returns a readable string representation of a CountWorkflowExecutionsGroup
 // struct.
-func (v *CountWorkflowExecutionsRequest) String() string {
+func (v *CountWorkflowExecutionsGroup) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.GroupValues != nil {
+		fields[i] = fmt.Sprintf("GroupValues: %v", v.GroupValues)
 		i++
 	}
-	if v.Query != nil {
-		fields[i] = fmt.Sprintf("Query: %v", *(v.Query))
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
 		i++
 	}
 
-	return fmt.Sprintf("CountWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("CountWorkflowExecutionsGroup{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this CountWorkflowExecutionsRequest match the
-// provided CountWorkflowExecutionsRequest.
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this CountWorkflowExecutionsGroup match the
+// provided CountWorkflowExecutionsGroup.
 //
 // This function performs a deep comparison.
-func (v *CountWorkflowExecutionsRequest) Equals(rhs *CountWorkflowExecutionsRequest) bool {
+func (v *CountWorkflowExecutionsGroup) Equals(rhs *CountWorkflowExecutionsGroup) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !((v.GroupValues == nil && rhs.GroupValues == nil) || (v.GroupValues != nil && rhs.GroupValues != nil && _List_String_Equals(v.GroupValues, rhs.GroupValues))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Query, rhs.Query) {
+	if !_I64_EqualsPtr(v.Count, rhs.Count) {
 		return false
 	}
 
 	return true
 }
 
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of CountWorkflowExecutionsRequest.
-func (v *CountWorkflowExecutionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of CountWorkflowExecutionsGroup.
+func (v *CountWorkflowExecutionsGroup) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
+	if v.GroupValues != nil {
+		err = multierr.Append(err, enc.AddArray("groupValues", (_List_String_Zapper)(v.GroupValues)))
 	}
-	if v.Query != nil {
-		enc.AddString("query", *v.Query)
+	if v.Count != nil {
+		enc.AddInt64("count", *v.Count)
 	}
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetGroupValues returns the value of GroupValues if it is set or its
 // zero value if it is unset.
-func (v *CountWorkflowExecutionsRequest) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+func (v *CountWorkflowExecutionsGroup) GetGroupValues() (o []string) {
+	if v != nil && v.GroupValues != nil {
+		return v.GroupValues
 	}
 
 	return
 }
 
-// IsSetDomain returns true if Domain is not nil.
-func (v *CountWorkflowExecutionsRequest) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// IsSetGroupValues returns true if GroupValues is not nil.
+func (v *CountWorkflowExecutionsGroup) IsSetGroupValues() bool {
+	return v != nil && v.GroupValues != nil
 }
 
-// GetQuery returns the value of Query if it is set or its
+// GetCount returns the value of Count if it is set or its
 // zero value if it is unset.
-func (v *CountWorkflowExecutionsRequest) GetQuery() (o string) {
-	if v != nil && v.Query != nil {
-		return *v.Query
+func (v *CountWorkflowExecutionsGroup) GetCount() (o int64) {
+	if v != nil && v.Count != nil {
+		return *v.Count
 	}
 
 	return
 }
 
-// IsSetQuery returns true if Query is not nil.
-func (v *CountWorkflowExecutionsRequest) IsSetQuery() bool {
-	return v != nil && v.Query != nil
+// IsSetCount returns true if Count is not nil.
+func (v *CountWorkflowExecutionsGroup) IsSetCount() bool {
+	return v != nil && v.Count != nil
 }
 
-type CountWorkflowExecutionsResponse struct {
-	Count *int64 `json:"count,omitempty"`
+type CountWorkflowExecutionsRequest struct {
+	Domain *string `json:"domain,omitempty"`
+	Query  *string `json:"query,omitempty"`
 }
 
-// ToWire translates a CountWorkflowExecutionsResponse struct into a Thrift-level intermediate
+// ToWire translates a CountWorkflowExecutionsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -13393,31 +13572,39 @@ type CountWorkflowExecutionsResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *CountWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+func (v *CountWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Count != nil {
-		w, err = wire.NewValueI64(*(v.Count)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Query != nil {
+		w, err = wire.NewValueString(*(v.Query)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a CountWorkflowExecutionsResponse struct from its Thrift-level
+// FromWire deserializes a CountWorkflowExecutionsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a CountWorkflowExecutionsResponse struct
+// An error is returned if we were unable to build a CountWorkflowExecutionsRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -13425,21 +13612,31 @@ func (v *CountWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v CountWorkflowExecutionsResponse
+//   var v CountWorkflowExecutionsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *CountWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+func (v *CountWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Count = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Query = &x
 				if err != nil {
 					return err
 				}
@@ -13451,20 +13648,32 @@ func (v *CountWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a CountWorkflowExecutionsResponse struct directly into bytes, without going
+// Encode serializes a CountWorkflowExecutionsRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a CountWorkflowExecutionsResponse struct could not be encoded.
-func (v *CountWorkflowExecutionsResponse) Encode(sw stream.Writer) error {
+// An error is returned if a CountWorkflowExecutionsRequest struct could not be encoded.
+func (v *CountWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Count != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.Count)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Query != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Query)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -13475,12 +13684,12 @@ func (v *CountWorkflowExecutionsResponse) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a CountWorkflowExecutionsResponse struct directly from its Thrift-level
+// Decode deserializes a CountWorkflowExecutionsRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a CountWorkflowExecutionsResponse struct could not be generated from the wire
+// An error is returned if a CountWorkflowExecutionsRequest struct could not be generated from the wire
 // representation.
-func (v *CountWorkflowExecutionsResponse) Decode(sr stream.Reader) error {
+func (v *CountWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -13493,10 +13702,398 @@ func (v *CountWorkflowExecutionsResponse) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.Count = &x
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Query = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a CountWorkflowExecutionsRequest
+// struct.
+func (v *CountWorkflowExecutionsRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", *(v.Query))
+		i++
+	}
+
+	return fmt.Sprintf("CountWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this CountWorkflowExecutionsRequest match the
+// provided CountWorkflowExecutionsRequest.
+//
+// This function performs a deep comparison.
+func (v *CountWorkflowExecutionsRequest) Equals(rhs *CountWorkflowExecutionsRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Query, rhs.Query) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CountWorkflowExecutionsRequest.
+func (v *CountWorkflowExecutionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Query != nil {
+		enc.AddString("query", *v.Query)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *CountWorkflowExecutionsRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetQuery returns the value of Query if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsRequest) GetQuery() (o string) {
+	if v != nil && v.Query != nil {
+		return *v.Query
+	}
+
+	return
+}
+
+// IsSetQuery returns true if Query is not nil.
+func (v *CountWorkflowExecutionsRequest) IsSetQuery() bool {
+	return v != nil && v.Query != nil
+}
+
+type CountWorkflowExecutionsResponse struct {
+	Count  *int64                          `json:"count,omitempty"`
+	Groups []*CountWorkflowExecutionsGroup `json:"groups,omitempty"`
+}
+
+type _List_CountWorkflowExecutionsGroup_ValueList []*CountWorkflowExecutionsGroup
+
+func (v _List_CountWorkflowExecutionsGroup_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*CountWorkflowExecutionsGroup', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_CountWorkflowExecutionsGroup_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_CountWorkflowExecutionsGroup_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_CountWorkflowExecutionsGroup_ValueList) Close() {}
+
+// ToWire translates a CountWorkflowExecutionsResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *CountWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Count != nil {
+		w, err = wire.NewValueI64(*(v.Count)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Groups != nil {
+		w, err = wire.NewValueList(_List_CountWorkflowExecutionsGroup_ValueList(v.Groups)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _CountWorkflowExecutionsGroup_Read(w wire.Value) (*CountWorkflowExecutionsGroup, error) {
+	var v CountWorkflowExecutionsGroup
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_CountWorkflowExecutionsGroup_Read(l wire.ValueList) ([]*CountWorkflowExecutionsGroup, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*CountWorkflowExecutionsGroup, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _CountWorkflowExecutionsGroup_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a CountWorkflowExecutionsResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CountWorkflowExecutionsResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v CountWorkflowExecutionsResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *CountWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Groups, err = _List_CountWorkflowExecutionsGroup_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_CountWorkflowExecutionsGroup_Encode(val []*CountWorkflowExecutionsGroup, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*CountWorkflowExecutionsGroup', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a CountWorkflowExecutionsResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a CountWorkflowExecutionsResponse struct could not be encoded.
+func (v *CountWorkflowExecutionsResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Count != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Count)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Groups != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_CountWorkflowExecutionsGroup_Encode(v.Groups, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _CountWorkflowExecutionsGroup_Decode(sr stream.Reader) (*CountWorkflowExecutionsGroup, error) {
+	var v CountWorkflowExecutionsGroup
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_CountWorkflowExecutionsGroup_Decode(sr stream.Reader) ([]*CountWorkflowExecutionsGroup, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*CountWorkflowExecutionsGroup, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _CountWorkflowExecutionsGroup_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a CountWorkflowExecutionsResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a CountWorkflowExecutionsResponse struct could not be generated from the wire
+// representation.
+func (v *CountWorkflowExecutionsResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Count = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.Groups, err = _List_CountWorkflowExecutionsGroup_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -13530,16 +14127,35 @@ func (v *CountWorkflowExecutionsResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	if v.Count != nil {
 		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
 		i++
 	}
+	if v.Groups != nil {
+		fields[i] = fmt.Sprintf("Groups: %v", v.Groups)
+		i++
+	}
 
 	return fmt.Sprintf("CountWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _List_CountWorkflowExecutionsGroup_Equals(lhs, rhs []*CountWorkflowExecutionsGroup) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this CountWorkflowExecutionsResponse match the
 // provided CountWorkflowExecutionsResponse.
 //
@@ -13553,10 +14169,24 @@ func (v *CountWorkflowExecutionsResponse) Equals(rhs *CountWorkflowExecutionsRes
 	if !_I64_EqualsPtr(v.Count, rhs.Count) {
 		return false
 	}
+	if !((v.Groups == nil && rhs.Groups == nil) || (v.Groups != nil && rhs.Groups != nil && _List_CountWorkflowExecutionsGroup_Equals(v.Groups, rhs.Groups))) {
+		return false
+	}
 
 	return true
 }
 
+type _List_CountWorkflowExecutionsGroup_Zapper []*CountWorkflowExecutionsGroup
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_CountWorkflowExecutionsGroup_Zapper.
+func (l _List_CountWorkflowExecutionsGroup_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of CountWorkflowExecutionsResponse.
 func (v *CountWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -13566,6 +14196,9 @@ func (v *CountWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEnc
 	if v.Count != nil {
 		enc.AddInt64("count", *v.Count)
 	}
+	if v.Groups != nil {
+		err = multierr.Append(err, enc.AddArray("groups", (_List_CountWorkflowExecutionsGroup_Zapper)(v.Groups)))
+	}
 	return err
 }
 
@@ -13584,6 +14217,21 @@ func (v *CountWorkflowExecutionsResponse) IsSetCount() bool {
 	return v != nil && v.Count != nil
 }
 
+// GetGroups returns the value of Groups if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsResponse) GetGroups() (o []*CountWorkflowExecutionsGroup) {
+	if v != nil && v.Groups != nil {
+		return v.Groups
+	}
+
+	return
+}
+
+// IsSetGroups returns true if Groups is not nil.
+func (v *CountWorkflowExecutionsResponse) IsSetGroups() bool {
+	return v != nil && v.Groups != nil
+}
+
 type CrossClusterApplyParentClosePolicyRequestAttributes struct {
 	ApplyParentClosePolicyAttributes []*ApplyParentClosePolicyAttributes `json:"applyParentClosePolicyAttributes,omitempty"`
 }
@@ -24755,72 +25403,126 @@ func (v *DecisionType) UnmarshalJSON(text []byte) error {
 	}
 }
 
-type DeprecateDomainRequest struct {
-	Name          *string `json:"name,omitempty"`
-	SecurityToken *string `json:"securityToken,omitempty"`
+type DeleteWorkflowTaskRequest struct {
+	Domain              *string            `json:"domain,omitempty"`
+	WorkflowExecution   *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Type                *int32             `json:"type,omitempty"`
+	TaskID              *int64             `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64             `json:"visibilityTimestamp,omitempty"`
+	ClusterName         *string            `json:"clusterName,omitempty"`
+	Reason              *string            `json:"reason,omitempty"`
+	Identity            *string            `json:"identity,omitempty"`
 }
 
-// ToWire translates a DeprecateDomainRequest struct into a Thrift-level intermediate
+// ToWire translates a DeleteWorkflowTaskRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *DeprecateDomainRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *DeleteWorkflowTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.SecurityToken != nil {
-		w, err = wire.NewValueString(*(v.SecurityToken)), error(nil)
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Type != nil {
+		w, err = wire.NewValueI32(*(v.Type)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskID != nil {
+		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.VisibilityTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.VisibilityTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DeprecateDomainRequest struct from its Thrift-level
+// FromWire deserializes a DeleteWorkflowTaskRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DeprecateDomainRequest struct
+// An error is returned if we were unable to build a DeleteWorkflowTaskRequest struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v DeprecateDomainRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v DeleteWorkflowTaskRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *DeleteWorkflowTaskRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -24829,17 +25531,75 @@ func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Type = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.VisibilityTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.SecurityToken = &x
+				v.ClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -24851,20 +25611,20 @@ func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a DeprecateDomainRequest struct directly into bytes, without going
+// Encode serializes a DeleteWorkflowTaskRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a DeprecateDomainRequest struct could not be encoded.
-func (v *DeprecateDomainRequest) Encode(sw stream.Writer) error {
+// An error is returned if a DeleteWorkflowTaskRequest struct could not be encoded.
+func (v *DeleteWorkflowTaskRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Name != nil {
+	if v.Domain != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Name)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -24872,11 +25632,83 @@ func (v *DeprecateDomainRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.SecurityToken != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.SecurityToken)); err != nil {
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Type != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Type)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.TaskID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.VisibilityTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.VisibilityTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClusterName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ClusterName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Reason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Reason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -24887,12 +25719,12 @@ func (v *DeprecateDomainRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a DeprecateDomainRequest struct directly from its Thrift-level
+// Decode deserializes a DeleteWorkflowTaskRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a DeprecateDomainRequest struct could not be generated from the wire
+// An error is returned if a DeleteWorkflowTaskRequest struct could not be generated from the wire
 // representation.
-func (v *DeprecateDomainRequest) Decode(sr stream.Reader) error {
+func (v *DeleteWorkflowTaskRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -24908,15 +25740,61 @@ func (v *DeprecateDomainRequest) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Name = &x
+			v.Domain = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Type = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TaskID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.VisibilityTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.SecurityToken = &x
+			v.ClusterName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Reason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
 			if err != nil {
 				return err
 			}
@@ -24943,41 +25821,83 @@ func (v *DeprecateDomainRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a DeprecateDomainRequest
+// String returns a readable string representation of a DeleteWorkflowTaskRequest
 // struct.
-func (v *DeprecateDomainRequest) String() string {
+func (v *DeleteWorkflowTaskRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [8]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.SecurityToken != nil {
-		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.VisibilityTimestamp != nil {
+		fields[i] = fmt.Sprintf("VisibilityTimestamp: %v", *(v.VisibilityTimestamp))
+		i++
+	}
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("DeprecateDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DeleteWorkflowTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DeprecateDomainRequest match the
-// provided DeprecateDomainRequest.
+// Equals returns true if all the fields of this DeleteWorkflowTaskRequest match the
+// provided DeleteWorkflowTaskRequest.
 //
 // This function performs a deep comparison.
-func (v *DeprecateDomainRequest) Equals(rhs *DeprecateDomainRequest) bool {
+func (v *DeleteWorkflowTaskRequest) Equals(rhs *DeleteWorkflowTaskRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.VisibilityTimestamp, rhs.VisibilityTimestamp) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
@@ -24985,56 +25905,164 @@ func (v *DeprecateDomainRequest) Equals(rhs *DeprecateDomainRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DeprecateDomainRequest.
-func (v *DeprecateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DeleteWorkflowTaskRequest.
+func (v *DeleteWorkflowTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Name != nil {
-		enc.AddString("name", *v.Name)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
-	if v.SecurityToken != nil {
-		enc.AddString("securityToken", *v.SecurityToken)
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.Type != nil {
+		enc.AddInt32("type", *v.Type)
+	}
+	if v.TaskID != nil {
+		enc.AddInt64("taskID", *v.TaskID)
+	}
+	if v.VisibilityTimestamp != nil {
+		enc.AddInt64("visibilityTimestamp", *v.VisibilityTimestamp)
+	}
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *DeprecateDomainRequest) GetName() (o string) {
-	if v != nil && v.Name != nil {
-		return *v.Name
+func (v *DeleteWorkflowTaskRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetName returns true if Name is not nil.
-func (v *DeprecateDomainRequest) IsSetName() bool {
-	return v != nil && v.Name != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *DeleteWorkflowTaskRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetSecurityToken returns the value of SecurityToken if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *DeprecateDomainRequest) GetSecurityToken() (o string) {
-	if v != nil && v.SecurityToken != nil {
-		return *v.SecurityToken
+func (v *DeleteWorkflowTaskRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetSecurityToken returns true if SecurityToken is not nil.
-func (v *DeprecateDomainRequest) IsSetSecurityToken() bool {
-	return v != nil && v.SecurityToken != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *DeleteWorkflowTaskRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
 }
 
-type DescribeDomainRequest struct {
-	Name *string `json:"name,omitempty"`
-	UUID *string `json:"uuid,omitempty"`
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *DeleteWorkflowTaskRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+
+	return
 }
 
-// ToWire translates a DescribeDomainRequest struct into a Thrift-level intermediate
+// IsSetType returns true if Type is not nil.
+func (v *DeleteWorkflowTaskRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// GetTaskID returns the value of TaskID if it is set or its
+// zero value if it is unset.
+func (v *DeleteWorkflowTaskRequest) GetTaskID() (o int64) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
+	}
+
+	return
+}
+
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *DeleteWorkflowTaskRequest) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
+}
+
+// GetVisibilityTimestamp returns the value of VisibilityTimestamp if it is set or its
+// zero value if it is unset.
+func (v *DeleteWorkflowTaskRequest) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+
+	return
+}
+
+// IsSetVisibilityTimestamp returns true if VisibilityTimestamp is not nil.
+func (v *DeleteWorkflowTaskRequest) IsSetVisibilityTimestamp() bool {
+	return v != nil && v.VisibilityTimestamp != nil
+}
+
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *DeleteWorkflowTaskRequest) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
+}
+
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *DeleteWorkflowTaskRequest) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *DeleteWorkflowTaskRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *DeleteWorkflowTaskRequest) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *DeleteWorkflowTaskRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *DeleteWorkflowTaskRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type DeprecateDomainRequest struct {
+	Name          *string `json:"name,omitempty"`
+	SecurityToken *string `json:"securityToken,omitempty"`
+}
+
+// ToWire translates a DeprecateDomainRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -25049,7 +26077,7 @@ type DescribeDomainRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
+func (v *DeprecateDomainRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -25065,8 +26093,8 @@ func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.UUID != nil {
-		w, err = wire.NewValueString(*(v.UUID)), error(nil)
+	if v.SecurityToken != nil {
+		w, err = wire.NewValueString(*(v.SecurityToken)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -25077,11 +26105,11 @@ func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DescribeDomainRequest struct from its Thrift-level
+// FromWire deserializes a DeprecateDomainRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeDomainRequest struct
+// An error is returned if we were unable to build a DeprecateDomainRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25089,12 +26117,12 @@ func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeDomainRequest
+//   var v DeprecateDomainRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
+func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -25113,7 +26141,7 @@ func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.UUID = &x
+				v.SecurityToken = &x
 				if err != nil {
 					return err
 				}
@@ -25125,11 +26153,11 @@ func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a DescribeDomainRequest struct directly into bytes, without going
+// Encode serializes a DeprecateDomainRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a DescribeDomainRequest struct could not be encoded.
-func (v *DescribeDomainRequest) Encode(sw stream.Writer) error {
+// An error is returned if a DeprecateDomainRequest struct could not be encoded.
+func (v *DeprecateDomainRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -25146,11 +26174,11 @@ func (v *DescribeDomainRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.UUID != nil {
+	if v.SecurityToken != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.UUID)); err != nil {
+		if err := sw.WriteString(*(v.SecurityToken)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -25161,7 +26189,281 @@ func (v *DescribeDomainRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a DescribeDomainRequest struct directly from its Thrift-level
+// Decode deserializes a DeprecateDomainRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DeprecateDomainRequest struct could not be generated from the wire
+// representation.
+func (v *DeprecateDomainRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.SecurityToken = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DeprecateDomainRequest
+// struct.
+func (v *DeprecateDomainRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.SecurityToken != nil {
+		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
+		i++
+	}
+
+	return fmt.Sprintf("DeprecateDomainRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DeprecateDomainRequest match the
+// provided DeprecateDomainRequest.
+//
+// This function performs a deep comparison.
+func (v *DeprecateDomainRequest) Equals(rhs *DeprecateDomainRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DeprecateDomainRequest.
+func (v *DeprecateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.SecurityToken != nil {
+		enc.AddString("securityToken", *v.SecurityToken)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *DeprecateDomainRequest) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *DeprecateDomainRequest) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetSecurityToken returns the value of SecurityToken if it is set or its
+// zero value if it is unset.
+func (v *DeprecateDomainRequest) GetSecurityToken() (o string) {
+	if v != nil && v.SecurityToken != nil {
+		return *v.SecurityToken
+	}
+
+	return
+}
+
+// IsSetSecurityToken returns true if SecurityToken is not nil.
+func (v *DeprecateDomainRequest) IsSetSecurityToken() bool {
+	return v != nil && v.SecurityToken != nil
+}
+
+type DescribeDomainRequest struct {
+	Name *string `json:"name,omitempty"`
+	UUID *string `json:"uuid,omitempty"`
+}
+
+// ToWire translates a DescribeDomainRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.UUID != nil {
+		w, err = wire.NewValueString(*(v.UUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeDomainRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeDomainRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeDomainRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a DescribeDomainRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DescribeDomainRequest struct could not be encoded.
+func (v *DescribeDomainRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.UUID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.UUID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a DescribeDomainRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
 // An error is returned if a DescribeDomainRequest struct could not be generated from the wire
@@ -25865,6 +27167,498 @@ func (v *DescribeDomainResponse) IsSetFailoverInfo() bool {
 	return v != nil && v.FailoverInfo != nil
 }
 
+type DescribeDomainUsageRequest struct {
+	Domain *string `json:"domain,omitempty"`
+}
+
+// ToWire translates a DescribeDomainUsageRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *DescribeDomainUsageRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeDomainUsageRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeDomainUsageRequest struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v DescribeDomainUsageRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *DescribeDomainUsageRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a DescribeDomainUsageRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DescribeDomainUsageRequest struct could not be encoded.
+func (v *DescribeDomainUsageRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a DescribeDomainUsageRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DescribeDomainUsageRequest struct could not be generated from the wire
+// representation.
+func (v *DescribeDomainUsageRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeDomainUsageRequest
+// struct.
+func (v *DescribeDomainUsageRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeDomainUsageRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeDomainUsageRequest match the
+// provided DescribeDomainUsageRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeDomainUsageRequest) Equals(rhs *DescribeDomainUsageRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeDomainUsageRequest.
+func (v *DescribeDomainUsageRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainUsageRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *DescribeDomainUsageRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+type DescribeDomainUsageResponse struct {
+	Usage          *DomainUsage `json:"usage,omitempty"`
+	LastUpdateTime *int64       `json:"lastUpdateTime,omitempty"`
+}
+
+// ToWire translates a DescribeDomainUsageResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *DescribeDomainUsageResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Usage != nil {
+		w, err = v.Usage.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastUpdateTime != nil {
+		w, err = wire.NewValueI64(*(v.LastUpdateTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DomainUsage_Read(w wire.Value) (*DomainUsage, error) {
+	var v DomainUsage
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a DescribeDomainUsageResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeDomainUsageResponse struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v DescribeDomainUsageResponse
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *DescribeDomainUsageResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.Usage, err = _DomainUsage_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastUpdateTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a DescribeDomainUsageResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DescribeDomainUsageResponse struct could not be encoded.
+func (v *DescribeDomainUsageResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Usage != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Usage.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastUpdateTime != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastUpdateTime)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _DomainUsage_Decode(sr stream.Reader) (*DomainUsage, error) {
+	var v DomainUsage
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a DescribeDomainUsageResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DescribeDomainUsageResponse struct could not be generated from the wire
+// representation.
+func (v *DescribeDomainUsageResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TStruct:
+			v.Usage, err = _DomainUsage_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastUpdateTime = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeDomainUsageResponse
+// struct.
+func (v *DescribeDomainUsageResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Usage != nil {
+		fields[i] = fmt.Sprintf("Usage: %v", v.Usage)
+		i++
+	}
+	if v.LastUpdateTime != nil {
+		fields[i] = fmt.Sprintf("LastUpdateTime: %v", *(v.LastUpdateTime))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeDomainUsageResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeDomainUsageResponse match the
+// provided DescribeDomainUsageResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeDomainUsageResponse) Equals(rhs *DescribeDomainUsageResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Usage == nil && rhs.Usage == nil) || (v.Usage != nil && rhs.Usage != nil && v.Usage.Equals(rhs.Usage))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastUpdateTime, rhs.LastUpdateTime) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeDomainUsageResponse.
+func (v *DescribeDomainUsageResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Usage != nil {
+		err = multierr.Append(err, enc.AddObject("usage", v.Usage))
+	}
+	if v.LastUpdateTime != nil {
+		enc.AddInt64("lastUpdateTime", *v.LastUpdateTime)
+	}
+	return err
+}
+
+// GetUsage returns the value of Usage if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainUsageResponse) GetUsage() (o *DomainUsage) {
+	if v != nil && v.Usage != nil {
+		return v.Usage
+	}
+
+	return
+}
+
+// IsSetUsage returns true if Usage is not nil.
+func (v *DescribeDomainUsageResponse) IsSetUsage() bool {
+	return v != nil && v.Usage != nil
+}
+
+// GetLastUpdateTime returns the value of LastUpdateTime if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainUsageResponse) GetLastUpdateTime() (o int64) {
+	if v != nil && v.LastUpdateTime != nil {
+		return *v.LastUpdateTime
+	}
+
+	return
+}
+
+// IsSetLastUpdateTime returns true if LastUpdateTime is not nil.
+func (v *DescribeDomainUsageResponse) IsSetLastUpdateTime() bool {
+	return v != nil && v.LastUpdateTime != nil
+}
+
 type DescribeHistoryHostRequest struct {
 	HostAddress      *string            `json:"hostAddress,omitempty"`
 	ShardIdForHost   *int32             `json:"shardIdForHost,omitempty"`
@@ -26200,11 +27994,12 @@ func (v *DescribeHistoryHostRequest) IsSetExecutionForHost() bool {
 }
 
 type DescribeHistoryHostResponse struct {
-	NumberOfShards        *int32           `json:"numberOfShards,omitempty"`
-	ShardIDs              []int32          `json:"shardIDs,omitempty"`
-	DomainCache           *DomainCacheInfo `json:"domainCache,omitempty"`
-	ShardControllerStatus *string          `json:"shardControllerStatus,omitempty"`
-	Address               *string          `json:"address,omitempty"`
+	NumberOfShards        *int32              `json:"numberOfShards,omitempty"`
+	ShardIDs              []int32             `json:"shardIDs,omitempty"`
+	DomainCache           *DomainCacheInfo    `json:"domainCache,omitempty"`
+	ShardControllerStatus *string             `json:"shardControllerStatus,omitempty"`
+	Address               *string             `json:"address,omitempty"`
+	ShardLoads            []*HistoryShardLoad `json:"shardLoads,omitempty"`
 }
 
 type _List_I32_ValueList []int32
@@ -26233,6 +28028,35 @@ func (_List_I32_ValueList) ValueType() wire.Type {
 
 func (_List_I32_ValueList) Close() {}
 
+type _List_HistoryShardLoad_ValueList []*HistoryShardLoad
+
+func (v _List_HistoryShardLoad_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*HistoryShardLoad', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_HistoryShardLoad_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_HistoryShardLoad_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_HistoryShardLoad_ValueList) Close() {}
+
 // ToWire translates a DescribeHistoryHostResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -26250,7 +28074,7 @@ func (_List_I32_ValueList) Close() {}
 //   }
 func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -26296,6 +28120,14 @@ func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.ShardLoads != nil {
+		w, err = wire.NewValueList(_List_HistoryShardLoad_ValueList(v.ShardLoads)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -26324,6 +28156,30 @@ func _DomainCacheInfo_Read(w wire.Value) (*DomainCacheInfo, error) {
 	return &v, err
 }
 
+func _HistoryShardLoad_Read(w wire.Value) (*HistoryShardLoad, error) {
+	var v HistoryShardLoad
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_HistoryShardLoad_Read(l wire.ValueList) ([]*HistoryShardLoad, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*HistoryShardLoad, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _HistoryShardLoad_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeHistoryHostResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -26391,6 +28247,14 @@ func (v *DescribeHistoryHostResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.ShardLoads, err = _List_HistoryShardLoad_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -26416,6 +28280,27 @@ func _List_I32_Encode(val []int32, sw stream.Writer) error {
 	return sw.WriteListEnd()
 }
 
+func _List_HistoryShardLoad_Encode(val []*HistoryShardLoad, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*HistoryShardLoad', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
 // Encode serializes a DescribeHistoryHostResponse struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -26485,6 +28370,18 @@ func (v *DescribeHistoryHostResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.ShardLoads != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_HistoryShardLoad_Encode(v.ShardLoads, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -26524,6 +28421,42 @@ func _DomainCacheInfo_Decode(sr stream.Reader) (*DomainCacheInfo, error) {
 	return &v, err
 }
 
+func _HistoryShardLoad_Decode(sr stream.Reader) (*HistoryShardLoad, error) {
+	var v HistoryShardLoad
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_HistoryShardLoad_Decode(sr stream.Reader) ([]*HistoryShardLoad, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*HistoryShardLoad, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _HistoryShardLoad_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 // Decode deserializes a DescribeHistoryHostResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -26578,6 +28511,12 @@ func (v *DescribeHistoryHostResponse) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 60 && fh.Type == wire.TList:
+			v.ShardLoads, err = _List_HistoryShardLoad_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -26607,7 +28546,7 @@ func (v *DescribeHistoryHostResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.NumberOfShards != nil {
 		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
@@ -26629,6 +28568,10 @@ func (v *DescribeHistoryHostResponse) String() string {
 		fields[i] = fmt.Sprintf("Address: %v", *(v.Address))
 		i++
 	}
+	if v.ShardLoads != nil {
+		fields[i] = fmt.Sprintf("ShardLoads: %v", v.ShardLoads)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeHistoryHostResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -26648,17 +28591,32 @@ func _List_I32_Equals(lhs, rhs []int32) bool {
 	return true
 }
 
-// Equals returns true if all the fields of this DescribeHistoryHostResponse match the
-// provided DescribeHistoryHostResponse.
-//
-// This function performs a deep comparison.
-func (v *DescribeHistoryHostResponse) Equals(rhs *DescribeHistoryHostResponse) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_I32_EqualsPtr(v.NumberOfShards, rhs.NumberOfShards) {
+func _List_HistoryShardLoad_Equals(lhs, rhs []*HistoryShardLoad) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DescribeHistoryHostResponse match the
+// provided DescribeHistoryHostResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeHistoryHostResponse) Equals(rhs *DescribeHistoryHostResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumberOfShards, rhs.NumberOfShards) {
 		return false
 	}
 	if !((v.ShardIDs == nil && rhs.ShardIDs == nil) || (v.ShardIDs != nil && rhs.ShardIDs != nil && _List_I32_Equals(v.ShardIDs, rhs.ShardIDs))) {
@@ -26673,6 +28631,9 @@ func (v *DescribeHistoryHostResponse) Equals(rhs *DescribeHistoryHostResponse) b
 	if !_String_EqualsPtr(v.Address, rhs.Address) {
 		return false
 	}
+	if !((v.ShardLoads == nil && rhs.ShardLoads == nil) || (v.ShardLoads != nil && rhs.ShardLoads != nil && _List_HistoryShardLoad_Equals(v.ShardLoads, rhs.ShardLoads))) {
+		return false
+	}
 
 	return true
 }
@@ -26688,6 +28649,17 @@ func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error)
 	return err
 }
 
+type _List_HistoryShardLoad_Zapper []*HistoryShardLoad
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_HistoryShardLoad_Zapper.
+func (l _List_HistoryShardLoad_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeHistoryHostResponse.
 func (v *DescribeHistoryHostResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -26709,6 +28681,9 @@ func (v *DescribeHistoryHostResponse) MarshalLogObject(enc zapcore.ObjectEncoder
 	if v.Address != nil {
 		enc.AddString("address", *v.Address)
 	}
+	if v.ShardLoads != nil {
+		err = multierr.Append(err, enc.AddArray("shardLoads", (_List_HistoryShardLoad_Zapper)(v.ShardLoads)))
+	}
 	return err
 }
 
@@ -26787,6 +28762,21 @@ func (v *DescribeHistoryHostResponse) IsSetAddress() bool {
 	return v != nil && v.Address != nil
 }
 
+// GetShardLoads returns the value of ShardLoads if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostResponse) GetShardLoads() (o []*HistoryShardLoad) {
+	if v != nil && v.ShardLoads != nil {
+		return v.ShardLoads
+	}
+
+	return
+}
+
+// IsSetShardLoads returns true if ShardLoads is not nil.
+func (v *DescribeHistoryHostResponse) IsSetShardLoads() bool {
+	return v != nil && v.ShardLoads != nil
+}
+
 type DescribeQueueRequest struct {
 	ShardID     *int32  `json:"shardID,omitempty"`
 	ClusterName *string `json:"clusterName,omitempty"`
@@ -27129,32 +29119,6 @@ type DescribeQueueResponse struct {
 	ProcessingQueueStates []string `json:"processingQueueStates,omitempty"`
 }
 
-type _List_String_ValueList []string
-
-func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_String_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_String_ValueList) ValueType() wire.Type {
-	return wire.TBinary
-}
-
-func (_List_String_ValueList) Close() {}
-
 // ToWire translates a DescribeQueueResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -27190,24 +29154,6 @@ func (v *DescribeQueueResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_String_Read(l wire.ValueList) ([]string, error) {
-	if l.ValueType() != wire.TBinary {
-		return nil, nil
-	}
-
-	o := make([]string, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
 // FromWire deserializes a DescribeQueueResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -27244,24 +29190,6 @@ func (v *DescribeQueueResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_String_Encode(val []string, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TBinary,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for _, v := range val {
-		if err := sw.WriteString(v); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
 // Encode serializes a DescribeQueueResponse struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -27286,36 +29214,6 @@ func (v *DescribeQueueResponse) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _List_String_Decode(sr stream.Reader) ([]string, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TBinary {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]string, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := sr.ReadString()
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
-
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
 // Decode deserializes a DescribeQueueResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -27379,21 +29277,6 @@ func (v *DescribeQueueResponse) String() string {
 	return fmt.Sprintf("DescribeQueueResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_String_Equals(lhs, rhs []string) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
-		}
-	}
-
-	return true
-}
-
 // Equals returns true if all the fields of this DescribeQueueResponse match the
 // provided DescribeQueueResponse.
 //
@@ -27411,17 +29294,6 @@ func (v *DescribeQueueResponse) Equals(rhs *DescribeQueueResponse) bool {
 	return true
 }
 
-type _List_String_Zapper []string
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_String_Zapper.
-func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		enc.AppendString(v)
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeQueueResponse.
 func (v *DescribeQueueResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -32835,6 +34707,408 @@ func (v *DomainStatus) UnmarshalJSON(text []byte) error {
 	}
 }
 
+type DomainUsage struct {
+	HistorySize         *int64 `json:"historySize,omitempty"`
+	HistoryEventCount   *int64 `json:"historyEventCount,omitempty"`
+	OpenWorkflowCount   *int64 `json:"openWorkflowCount,omitempty"`
+	ClosedWorkflowCount *int64 `json:"closedWorkflowCount,omitempty"`
+}
+
+// ToWire translates a DomainUsage struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *DomainUsage) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.HistorySize != nil {
+		w, err = wire.NewValueI64(*(v.HistorySize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.HistoryEventCount != nil {
+		w, err = wire.NewValueI64(*(v.HistoryEventCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.OpenWorkflowCount != nil {
+		w, err = wire.NewValueI64(*(v.OpenWorkflowCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ClosedWorkflowCount != nil {
+		w, err = wire.NewValueI64(*(v.ClosedWorkflowCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DomainUsage struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainUsage struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v DomainUsage
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *DomainUsage) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HistorySize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HistoryEventCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.OpenWorkflowCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ClosedWorkflowCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a DomainUsage struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DomainUsage struct could not be encoded.
+func (v *DomainUsage) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.HistorySize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.HistorySize)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HistoryEventCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.HistoryEventCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OpenWorkflowCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.OpenWorkflowCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClosedWorkflowCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ClosedWorkflowCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a DomainUsage struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DomainUsage struct could not be generated from the wire
+// representation.
+func (v *DomainUsage) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.HistorySize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.HistoryEventCount = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.OpenWorkflowCount = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ClosedWorkflowCount = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainUsage
+// struct.
+func (v *DomainUsage) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.HistorySize != nil {
+		fields[i] = fmt.Sprintf("HistorySize: %v", *(v.HistorySize))
+		i++
+	}
+	if v.HistoryEventCount != nil {
+		fields[i] = fmt.Sprintf("HistoryEventCount: %v", *(v.HistoryEventCount))
+		i++
+	}
+	if v.OpenWorkflowCount != nil {
+		fields[i] = fmt.Sprintf("OpenWorkflowCount: %v", *(v.OpenWorkflowCount))
+		i++
+	}
+	if v.ClosedWorkflowCount != nil {
+		fields[i] = fmt.Sprintf("ClosedWorkflowCount: %v", *(v.ClosedWorkflowCount))
+		i++
+	}
+
+	return fmt.Sprintf("DomainUsage{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DomainUsage match the
+// provided DomainUsage.
+//
+// This function performs a deep comparison.
+func (v *DomainUsage) Equals(rhs *DomainUsage) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HistorySize, rhs.HistorySize) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HistoryEventCount, rhs.HistoryEventCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.OpenWorkflowCount, rhs.OpenWorkflowCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ClosedWorkflowCount, rhs.ClosedWorkflowCount) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainUsage.
+func (v *DomainUsage) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.HistorySize != nil {
+		enc.AddInt64("historySize", *v.HistorySize)
+	}
+	if v.HistoryEventCount != nil {
+		enc.AddInt64("historyEventCount", *v.HistoryEventCount)
+	}
+	if v.OpenWorkflowCount != nil {
+		enc.AddInt64("openWorkflowCount", *v.OpenWorkflowCount)
+	}
+	if v.ClosedWorkflowCount != nil {
+		enc.AddInt64("closedWorkflowCount", *v.ClosedWorkflowCount)
+	}
+	return err
+}
+
+// GetHistorySize returns the value of HistorySize if it is set or its
+// zero value if it is unset.
+func (v *DomainUsage) GetHistorySize() (o int64) {
+	if v != nil && v.HistorySize != nil {
+		return *v.HistorySize
+	}
+
+	return
+}
+
+// IsSetHistorySize returns true if HistorySize is not nil.
+func (v *DomainUsage) IsSetHistorySize() bool {
+	return v != nil && v.HistorySize != nil
+}
+
+// GetHistoryEventCount returns the value of HistoryEventCount if it is set or its
+// zero value if it is unset.
+func (v *DomainUsage) GetHistoryEventCount() (o int64) {
+	if v != nil && v.HistoryEventCount != nil {
+		return *v.HistoryEventCount
+	}
+
+	return
+}
+
+// IsSetHistoryEventCount returns true if HistoryEventCount is not nil.
+func (v *DomainUsage) IsSetHistoryEventCount() bool {
+	return v != nil && v.HistoryEventCount != nil
+}
+
+// GetOpenWorkflowCount returns the value of OpenWorkflowCount if it is set or its
+// zero value if it is unset.
+func (v *DomainUsage) GetOpenWorkflowCount() (o int64) {
+	if v != nil && v.OpenWorkflowCount != nil {
+		return *v.OpenWorkflowCount
+	}
+
+	return
+}
+
+// IsSetOpenWorkflowCount returns true if OpenWorkflowCount is not nil.
+func (v *DomainUsage) IsSetOpenWorkflowCount() bool {
+	return v != nil && v.OpenWorkflowCount != nil
+}
+
+// GetClosedWorkflowCount returns the value of ClosedWorkflowCount if it is set or its
+// zero value if it is unset.
+func (v *DomainUsage) GetClosedWorkflowCount() (o int64) {
+	if v != nil && v.ClosedWorkflowCount != nil {
+		return *v.ClosedWorkflowCount
+	}
+
+	return
+}
+
+// IsSetClosedWorkflowCount returns true if ClosedWorkflowCount is not nil.
+func (v *DomainUsage) IsSetClosedWorkflowCount() bool {
+	return v != nil && v.ClosedWorkflowCount != nil
+}
+
 type EncodingType int32
 
 const (
@@ -33421,6 +35695,8 @@ const (
 	EventTypeSignalExternalWorkflowExecutionFailed           EventType = 39
 	EventTypeExternalWorkflowExecutionSignaled               EventType = 40
 	EventTypeUpsertWorkflowSearchAttributes                  EventType = 41
+	EventTypeWorkflowExecutionSuspended                      EventType = 42
+	EventTypeWorkflowExecutionResumed                        EventType = 43
 )
 
 // EventType_Values returns all recognized values of EventType.
@@ -33468,6 +35744,8 @@ func EventType_Values() []EventType {
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
 		EventTypeUpsertWorkflowSearchAttributes,
+		EventTypeWorkflowExecutionSuspended,
+		EventTypeWorkflowExecutionResumed,
 	}
 }
 
@@ -33604,6 +35882,12 @@ func (v *EventType) UnmarshalText(value []byte) error {
 	case "UpsertWorkflowSearchAttributes":
 		*v = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "WorkflowExecutionSuspended":
+		*v = EventTypeWorkflowExecutionSuspended
+		return nil
+	case "WorkflowExecutionResumed":
+		*v = EventTypeWorkflowExecutionResumed
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -33706,6 +35990,10 @@ func (v EventType) MarshalText() ([]byte, error) {
 		return []byte("ExternalWorkflowExecutionSignaled"), nil
 	case 41:
 		return []byte("UpsertWorkflowSearchAttributes"), nil
+	case 42:
+		return []byte("WorkflowExecutionSuspended"), nil
+	case 43:
+		return []byte("WorkflowExecutionResumed"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -33801,6 +36089,10 @@ func (v EventType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "ExternalWorkflowExecutionSignaled")
 	case 41:
 		enc.AddString("name", "UpsertWorkflowSearchAttributes")
+	case 42:
+		enc.AddString("name", "WorkflowExecutionSuspended")
+	case 43:
+		enc.AddString("name", "WorkflowExecutionResumed")
 	}
 	return nil
 }
@@ -33953,6 +36245,10 @@ func (v EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "WorkflowExecutionSuspended"
+	case 43:
+		return "WorkflowExecutionResumed"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -34055,6 +36351,10 @@ func (v EventType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"ExternalWorkflowExecutionSignaled\""), nil
 	case 41:
 		return ([]byte)("\"UpsertWorkflowSearchAttributes\""), nil
+	case 42:
+		return ([]byte)("\"WorkflowExecutionSuspended\""), nil
+	case 43:
+		return ([]byte)("\"WorkflowExecutionResumed\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -35555,6 +37855,340 @@ func (v *FailoverInfo) IsSetPendingShards() bool {
 	return v != nil && v.PendingShards != nil
 }
 
+type FailoverWorkflowExecutionRequest struct {
+	Domain        *string            `json:"domain,omitempty"`
+	Execution     *WorkflowExecution `json:"execution,omitempty"`
+	TargetCluster *string            `json:"targetCluster,omitempty"`
+}
+
+// ToWire translates a FailoverWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *FailoverWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TargetCluster != nil {
+		w, err = wire.NewValueString(*(v.TargetCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a FailoverWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a FailoverWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v FailoverWorkflowExecutionRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *FailoverWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TargetCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a FailoverWorkflowExecutionRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a FailoverWorkflowExecutionRequest struct could not be encoded.
+func (v *FailoverWorkflowExecutionRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Execution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Execution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TargetCluster != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.TargetCluster)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a FailoverWorkflowExecutionRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a FailoverWorkflowExecutionRequest struct could not be generated from the wire
+// representation.
+func (v *FailoverWorkflowExecutionRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.Execution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.TargetCluster = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a FailoverWorkflowExecutionRequest
+// struct.
+func (v *FailoverWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.TargetCluster != nil {
+		fields[i] = fmt.Sprintf("TargetCluster: %v", *(v.TargetCluster))
+		i++
+	}
+
+	return fmt.Sprintf("FailoverWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this FailoverWorkflowExecutionRequest match the
+// provided FailoverWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *FailoverWorkflowExecutionRequest) Equals(rhs *FailoverWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TargetCluster, rhs.TargetCluster) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of FailoverWorkflowExecutionRequest.
+func (v *FailoverWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.TargetCluster != nil {
+		enc.AddString("targetCluster", *v.TargetCluster)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *FailoverWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *FailoverWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *FailoverWorkflowExecutionRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *FailoverWorkflowExecutionRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetTargetCluster returns the value of TargetCluster if it is set or its
+// zero value if it is unset.
+func (v *FailoverWorkflowExecutionRequest) GetTargetCluster() (o string) {
+	if v != nil && v.TargetCluster != nil {
+		return *v.TargetCluster
+	}
+
+	return
+}
+
+// IsSetTargetCluster returns true if TargetCluster is not nil.
+func (v *FailoverWorkflowExecutionRequest) IsSetTargetCluster() bool {
+	return v != nil && v.TargetCluster != nil
+}
+
 type FeatureFlags struct {
 	WorkflowExecutionAlreadyCompletedErrorEnabled *bool `json:"WorkflowExecutionAlreadyCompletedErrorEnabled,omitempty"`
 }
@@ -40946,6 +43580,8 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	WorkflowExecutionSuspendedEventAttributes                      *WorkflowExecutionSuspendedEventAttributes                      `json:"workflowExecutionSuspendedEventAttributes,omitempty"`
+	WorkflowExecutionResumedEventAttributes                        *WorkflowExecutionResumedEventAttributes                        `json:"workflowExecutionResumedEventAttributes,omitempty"`
 }
 
 // ToWire translates a HistoryEvent struct into a Thrift-level intermediate
@@ -40965,7 +43601,7 @@ type HistoryEvent struct {
 //   }
 func (v *HistoryEvent) ToWire() (wire.Value, error) {
 	var (
-		fields [47]wire.Field
+		fields [49]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -41347,6 +43983,22 @@ func (v *HistoryEvent) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 450, Value: w}
 		i++
 	}
+	if v.WorkflowExecutionSuspendedEventAttributes != nil {
+		w, err = v.WorkflowExecutionSuspendedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 460, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionResumedEventAttributes != nil {
+		w, err = v.WorkflowExecutionResumedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 470, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -41603,6 +44255,18 @@ func _UpsertWorkflowSearchAttributesEventAttributes_Read(w wire.Value) (*UpsertW
 	return &v, err
 }
 
+func _WorkflowExecutionSuspendedEventAttributes_Read(w wire.Value) (*WorkflowExecutionSuspendedEventAttributes, error) {
+	var v WorkflowExecutionSuspendedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionResumedEventAttributes_Read(w wire.Value) (*WorkflowExecutionResumedEventAttributes, error) {
+	var v WorkflowExecutionResumedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a HistoryEvent struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -42010,6 +44674,22 @@ func (v *HistoryEvent) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 460:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionSuspendedEventAttributes, err = _WorkflowExecutionSuspendedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 470:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionResumedEventAttributes, err = _WorkflowExecutionResumedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -42590,6 +45270,30 @@ func (v *HistoryEvent) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.WorkflowExecutionSuspendedEventAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 460, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionSuspendedEventAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecutionResumedEventAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 470, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionResumedEventAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -42845,6 +45549,18 @@ func _UpsertWorkflowSearchAttributesEventAttributes_Decode(sr stream.Reader) (*U
 	return &v, err
 }
 
+func _WorkflowExecutionSuspendedEventAttributes_Decode(sr stream.Reader) (*WorkflowExecutionSuspendedEventAttributes, error) {
+	var v WorkflowExecutionSuspendedEventAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _WorkflowExecutionResumedEventAttributes_Decode(sr stream.Reader) (*WorkflowExecutionResumedEventAttributes, error) {
+	var v WorkflowExecutionResumedEventAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a HistoryEvent struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -43155,6 +45871,18 @@ func (v *HistoryEvent) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 460 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionSuspendedEventAttributes, err = _WorkflowExecutionSuspendedEventAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 470 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionResumedEventAttributes, err = _WorkflowExecutionResumedEventAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -43184,7 +45912,7 @@ func (v *HistoryEvent) String() string {
 		return "<nil>"
 	}
 
-	var fields [47]string
+	var fields [49]string
 	i := 0
 	if v.EventId != nil {
 		fields[i] = fmt.Sprintf("EventId: %v", *(v.EventId))
@@ -43374,6 +46102,14 @@ func (v *HistoryEvent) String() string {
 		fields[i] = fmt.Sprintf("UpsertWorkflowSearchAttributesEventAttributes: %v", v.UpsertWorkflowSearchAttributesEventAttributes)
 		i++
 	}
+	if v.WorkflowExecutionSuspendedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionSuspendedEventAttributes: %v", v.WorkflowExecutionSuspendedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionResumedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionResumedEventAttributes: %v", v.WorkflowExecutionResumedEventAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("HistoryEvent{%v}", strings.Join(fields[:i], ", "))
 }
@@ -43539,6 +46275,12 @@ func (v *HistoryEvent) Equals(rhs *HistoryEvent) bool {
 	if !((v.UpsertWorkflowSearchAttributesEventAttributes == nil && rhs.UpsertWorkflowSearchAttributesEventAttributes == nil) || (v.UpsertWorkflowSearchAttributesEventAttributes != nil && rhs.UpsertWorkflowSearchAttributesEventAttributes != nil && v.UpsertWorkflowSearchAttributesEventAttributes.Equals(rhs.UpsertWorkflowSearchAttributesEventAttributes))) {
 		return false
 	}
+	if !((v.WorkflowExecutionSuspendedEventAttributes == nil && rhs.WorkflowExecutionSuspendedEventAttributes == nil) || (v.WorkflowExecutionSuspendedEventAttributes != nil && rhs.WorkflowExecutionSuspendedEventAttributes != nil && v.WorkflowExecutionSuspendedEventAttributes.Equals(rhs.WorkflowExecutionSuspendedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionResumedEventAttributes == nil && rhs.WorkflowExecutionResumedEventAttributes == nil) || (v.WorkflowExecutionResumedEventAttributes != nil && rhs.WorkflowExecutionResumedEventAttributes != nil && v.WorkflowExecutionResumedEventAttributes.Equals(rhs.WorkflowExecutionResumedEventAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -43690,6 +46432,12 @@ func (v *HistoryEvent) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.UpsertWorkflowSearchAttributesEventAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("upsertWorkflowSearchAttributesEventAttributes", v.UpsertWorkflowSearchAttributesEventAttributes))
 	}
+	if v.WorkflowExecutionSuspendedEventAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionSuspendedEventAttributes", v.WorkflowExecutionSuspendedEventAttributes))
+	}
+	if v.WorkflowExecutionResumedEventAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionResumedEventAttributes", v.WorkflowExecutionResumedEventAttributes))
+	}
 	return err
 }
 
@@ -44398,6 +47146,36 @@ func (v *HistoryEvent) IsSetUpsertWorkflowSearchAttributesEventAttributes() bool
 	return v != nil && v.UpsertWorkflowSearchAttributesEventAttributes != nil
 }
 
+// GetWorkflowExecutionSuspendedEventAttributes returns the value of WorkflowExecutionSuspendedEventAttributes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetWorkflowExecutionSuspendedEventAttributes() (o *WorkflowExecutionSuspendedEventAttributes) {
+	if v != nil && v.WorkflowExecutionSuspendedEventAttributes != nil {
+		return v.WorkflowExecutionSuspendedEventAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionSuspendedEventAttributes returns true if WorkflowExecutionSuspendedEventAttributes is not nil.
+func (v *HistoryEvent) IsSetWorkflowExecutionSuspendedEventAttributes() bool {
+	return v != nil && v.WorkflowExecutionSuspendedEventAttributes != nil
+}
+
+// GetWorkflowExecutionResumedEventAttributes returns the value of WorkflowExecutionResumedEventAttributes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetWorkflowExecutionResumedEventAttributes() (o *WorkflowExecutionResumedEventAttributes) {
+	if v != nil && v.WorkflowExecutionResumedEventAttributes != nil {
+		return v.WorkflowExecutionResumedEventAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionResumedEventAttributes returns true if WorkflowExecutionResumedEventAttributes is not nil.
+func (v *HistoryEvent) IsSetWorkflowExecutionResumedEventAttributes() bool {
+	return v != nil && v.WorkflowExecutionResumedEventAttributes != nil
+}
+
 type HistoryEventFilterType int32
 
 const (
@@ -44597,366 +47375,206 @@ func (v *HistoryEventFilterType) UnmarshalJSON(text []byte) error {
 	}
 }
 
-type IndexedValueType int32
-
-const (
-	IndexedValueTypeString   IndexedValueType = 0
-	IndexedValueTypeKeyword  IndexedValueType = 1
-	IndexedValueTypeInt      IndexedValueType = 2
-	IndexedValueTypeDouble   IndexedValueType = 3
-	IndexedValueTypeBool     IndexedValueType = 4
-	IndexedValueTypeDatetime IndexedValueType = 5
-)
-
-// IndexedValueType_Values returns all recognized values of IndexedValueType.
-func IndexedValueType_Values() []IndexedValueType {
-	return []IndexedValueType{
-		IndexedValueTypeString,
-		IndexedValueTypeKeyword,
-		IndexedValueTypeInt,
-		IndexedValueTypeDouble,
-		IndexedValueTypeBool,
-		IndexedValueTypeDatetime,
-	}
-}
-
-// UnmarshalText tries to decode IndexedValueType from a byte slice
-// containing its name.
-//
-//   var v IndexedValueType
-//   err := v.UnmarshalText([]byte("STRING"))
-func (v *IndexedValueType) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "STRING":
-		*v = IndexedValueTypeString
-		return nil
-	case "KEYWORD":
-		*v = IndexedValueTypeKeyword
-		return nil
-	case "INT":
-		*v = IndexedValueTypeInt
-		return nil
-	case "DOUBLE":
-		*v = IndexedValueTypeDouble
-		return nil
-	case "BOOL":
-		*v = IndexedValueTypeBool
-		return nil
-	case "DATETIME":
-		*v = IndexedValueTypeDatetime
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "IndexedValueType", err)
-		}
-		*v = IndexedValueType(val)
-		return nil
-	}
-}
-
-// MarshalText encodes IndexedValueType to text.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v IndexedValueType) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("STRING"), nil
-	case 1:
-		return []byte("KEYWORD"), nil
-	case 2:
-		return []byte("INT"), nil
-	case 3:
-		return []byte("DOUBLE"), nil
-	case 4:
-		return []byte("BOOL"), nil
-	case 5:
-		return []byte("DATETIME"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of IndexedValueType.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v IndexedValueType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "STRING")
-	case 1:
-		enc.AddString("name", "KEYWORD")
-	case 2:
-		enc.AddString("name", "INT")
-	case 3:
-		enc.AddString("name", "DOUBLE")
-	case 4:
-		enc.AddString("name", "BOOL")
-	case 5:
-		enc.AddString("name", "DATETIME")
-	}
-	return nil
-}
-
-// Ptr returns a pointer to this enum value.
-func (v IndexedValueType) Ptr() *IndexedValueType {
-	return &v
-}
-
-// Encode encodes IndexedValueType directly to bytes.
-//
-//   sWriter := BinaryStreamer.Writer(writer)
-//
-//   var v IndexedValueType
-//   return v.Encode(sWriter)
-func (v IndexedValueType) Encode(sw stream.Writer) error {
-	return sw.WriteInt32(int32(v))
-}
-
-// ToWire translates IndexedValueType into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v IndexedValueType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes IndexedValueType from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return IndexedValueType(0), err
-//   }
-//
-//   var v IndexedValueType
-//   if err := v.FromWire(x); err != nil {
-//     return IndexedValueType(0), err
-//   }
-//   return v, nil
-func (v *IndexedValueType) FromWire(w wire.Value) error {
-	*v = (IndexedValueType)(w.GetI32())
-	return nil
-}
-
-// Decode reads off the encoded IndexedValueType directly off of the wire.
-//
-//   sReader := BinaryStreamer.Reader(reader)
-//
-//   var v IndexedValueType
-//   if err := v.Decode(sReader); err != nil {
-//     return IndexedValueType(0), err
-//   }
-//   return v, nil
-func (v *IndexedValueType) Decode(sr stream.Reader) error {
-	i, err := sr.ReadInt32()
-	if err != nil {
-		return err
-	}
-	*v = (IndexedValueType)(i)
-	return nil
-}
-
-// String returns a readable string representation of IndexedValueType.
-func (v IndexedValueType) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "STRING"
-	case 1:
-		return "KEYWORD"
-	case 2:
-		return "INT"
-	case 3:
-		return "DOUBLE"
-	case 4:
-		return "BOOL"
-	case 5:
-		return "DATETIME"
-	}
-	return fmt.Sprintf("IndexedValueType(%d)", w)
-}
-
-// Equals returns true if this IndexedValueType value matches the provided
-// value.
-func (v IndexedValueType) Equals(rhs IndexedValueType) bool {
-	return v == rhs
-}
-
-// MarshalJSON serializes IndexedValueType into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v IndexedValueType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"STRING\""), nil
-	case 1:
-		return ([]byte)("\"KEYWORD\""), nil
-	case 2:
-		return ([]byte)("\"INT\""), nil
-	case 3:
-		return ([]byte)("\"DOUBLE\""), nil
-	case 4:
-		return ([]byte)("\"BOOL\""), nil
-	case 5:
-		return ([]byte)("\"DATETIME\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// UnmarshalJSON attempts to decode IndexedValueType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *IndexedValueType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "IndexedValueType")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "IndexedValueType")
-		}
-		*v = (IndexedValueType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "IndexedValueType")
-	}
-}
-
-type InternalDataInconsistencyError struct {
-	Message string `json:"message,required"`
+type HistoryLoadEntry struct {
+	DomainID           *string  `json:"domainID,omitempty"`
+	WorkflowID         *string  `json:"workflowID,omitempty"`
+	RequestsPerSecond  *float64 `json:"requestsPerSecond,omitempty"`
+	LockWaitsPerSecond *float64 `json:"lockWaitsPerSecond,omitempty"`
 }
 
-// ToWire translates a InternalDataInconsistencyError struct into a Thrift-level intermediate
+// ToWire translates a HistoryLoadEntry struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *InternalDataInconsistencyError) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *HistoryLoadEntry) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RequestsPerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.RequestsPerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.LockWaitsPerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.LockWaitsPerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a InternalDataInconsistencyError struct from its Thrift-level
+// FromWire deserializes a HistoryLoadEntry struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a InternalDataInconsistencyError struct
+// An error is returned if we were unable to build a HistoryLoadEntry struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v InternalDataInconsistencyError
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *InternalDataInconsistencyError) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v HistoryLoadEntry
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *HistoryLoadEntry) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
 			}
-		}
-	}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
 
-	if !messageIsSet {
-		return errors.New("field Message of InternalDataInconsistencyError is required")
+			}
+		case 30:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.RequestsPerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.LockWaitsPerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
 
 	return nil
 }
 
-// Encode serializes a InternalDataInconsistencyError struct directly into bytes, without going
+// Encode serializes a HistoryLoadEntry struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a InternalDataInconsistencyError struct could not be encoded.
-func (v *InternalDataInconsistencyError) Encode(sw stream.Writer) error {
+// An error is returned if a HistoryLoadEntry struct could not be encoded.
+func (v *HistoryLoadEntry) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
+	if v.DomainID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if err := sw.WriteString(v.Message); err != nil {
-		return err
+
+	if v.WorkflowID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.WorkflowID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+
+	if v.RequestsPerSecond != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.RequestsPerSecond)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LockWaitsPerSecond != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.LockWaitsPerSecond)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a InternalDataInconsistencyError struct directly from its Thrift-level
+// Decode deserializes a HistoryLoadEntry struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a InternalDataInconsistencyError struct could not be generated from the wire
+// An error is returned if a HistoryLoadEntry struct could not be generated from the wire
 // representation.
-func (v *InternalDataInconsistencyError) Decode(sr stream.Reader) error {
-
-	messageIsSet := false
+func (v *HistoryLoadEntry) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -44969,20 +47587,46 @@ func (v *InternalDataInconsistencyError) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.Message, err = sr.ReadString()
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainID = &x
 			if err != nil {
 				return err
 			}
-			messageIsSet = true
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowID = &x
+			if err != nil {
 				return err
 			}
-		}
 
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
+		case fh.ID == 30 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.RequestsPerSecond = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.LockWaitsPerSecond = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
 		}
 
 		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
@@ -44994,45 +47638,68 @@ func (v *InternalDataInconsistencyError) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of InternalDataInconsistencyError is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a InternalDataInconsistencyError
+// String returns a readable string representation of a HistoryLoadEntry
 // struct.
-func (v *InternalDataInconsistencyError) String() string {
+func (v *HistoryLoadEntry) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [4]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.RequestsPerSecond != nil {
+		fields[i] = fmt.Sprintf("RequestsPerSecond: %v", *(v.RequestsPerSecond))
+		i++
+	}
+	if v.LockWaitsPerSecond != nil {
+		fields[i] = fmt.Sprintf("LockWaitsPerSecond: %v", *(v.LockWaitsPerSecond))
+		i++
+	}
 
-	return fmt.Sprintf("InternalDataInconsistencyError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("HistoryLoadEntry{%v}", strings.Join(fields[:i], ", "))
 }
 
-// ErrorName is the name of this type as defined in the Thrift
-// file.
-func (*InternalDataInconsistencyError) ErrorName() string {
-	return "InternalDataInconsistencyError"
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this InternalDataInconsistencyError match the
-// provided InternalDataInconsistencyError.
+// Equals returns true if all the fields of this HistoryLoadEntry match the
+// provided HistoryLoadEntry.
 //
 // This function performs a deep comparison.
-func (v *InternalDataInconsistencyError) Equals(rhs *InternalDataInconsistencyError) bool {
+func (v *HistoryLoadEntry) Equals(rhs *HistoryLoadEntry) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Message == rhs.Message) {
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.RequestsPerSecond, rhs.RequestsPerSecond) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.LockWaitsPerSecond, rhs.LockWaitsPerSecond) {
 		return false
 	}
 
@@ -45040,137 +47707,516 @@ func (v *InternalDataInconsistencyError) Equals(rhs *InternalDataInconsistencyEr
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of InternalDataInconsistencyError.
-func (v *InternalDataInconsistencyError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of HistoryLoadEntry.
+func (v *HistoryLoadEntry) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("message", v.Message)
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.RequestsPerSecond != nil {
+		enc.AddFloat64("requestsPerSecond", *v.RequestsPerSecond)
+	}
+	if v.LockWaitsPerSecond != nil {
+		enc.AddFloat64("lockWaitsPerSecond", *v.LockWaitsPerSecond)
+	}
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetDomainID returns the value of DomainID if it is set or its
 // zero value if it is unset.
-func (v *InternalDataInconsistencyError) GetMessage() (o string) {
-	if v != nil {
-		o = v.Message
+func (v *HistoryLoadEntry) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
 	}
+
 	return
 }
 
-func (v *InternalDataInconsistencyError) Error() string {
-	return v.String()
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *HistoryLoadEntry) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
 }
 
-type InternalServiceError struct {
-	Message string `json:"message,required"`
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *HistoryLoadEntry) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
 }
 
-// ToWire translates a InternalServiceError struct into a Thrift-level intermediate
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *HistoryLoadEntry) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetRequestsPerSecond returns the value of RequestsPerSecond if it is set or its
+// zero value if it is unset.
+func (v *HistoryLoadEntry) GetRequestsPerSecond() (o float64) {
+	if v != nil && v.RequestsPerSecond != nil {
+		return *v.RequestsPerSecond
+	}
+
+	return
+}
+
+// IsSetRequestsPerSecond returns true if RequestsPerSecond is not nil.
+func (v *HistoryLoadEntry) IsSetRequestsPerSecond() bool {
+	return v != nil && v.RequestsPerSecond != nil
+}
+
+// GetLockWaitsPerSecond returns the value of LockWaitsPerSecond if it is set or its
+// zero value if it is unset.
+func (v *HistoryLoadEntry) GetLockWaitsPerSecond() (o float64) {
+	if v != nil && v.LockWaitsPerSecond != nil {
+		return *v.LockWaitsPerSecond
+	}
+
+	return
+}
+
+// IsSetLockWaitsPerSecond returns true if LockWaitsPerSecond is not nil.
+func (v *HistoryLoadEntry) IsSetLockWaitsPerSecond() bool {
+	return v != nil && v.LockWaitsPerSecond != nil
+}
+
+type HistoryShardLoad struct {
+	ShardID                 *int32              `json:"shardID,omitempty"`
+	RequestsPerSecond       *float64            `json:"requestsPerSecond,omitempty"`
+	LockWaitsPerSecond      *float64            `json:"lockWaitsPerSecond,omitempty"`
+	AverageLockWaitInMillis *int64              `json:"averageLockWaitInMillis,omitempty"`
+	TransferTaskLag         *int64              `json:"transferTaskLag,omitempty"`
+	TimerTaskLagInMillis    *int64              `json:"timerTaskLagInMillis,omitempty"`
+	TopDomains              []*HistoryLoadEntry `json:"topDomains,omitempty"`
+	TopWorkflows            []*HistoryLoadEntry `json:"topWorkflows,omitempty"`
+}
+
+type _List_HistoryLoadEntry_ValueList []*HistoryLoadEntry
+
+func (v _List_HistoryLoadEntry_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*HistoryLoadEntry', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_HistoryLoadEntry_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_HistoryLoadEntry_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_HistoryLoadEntry_ValueList) Close() {}
+
+// ToWire translates a HistoryShardLoad struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *InternalServiceError) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *HistoryShardLoad) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.RequestsPerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.RequestsPerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.LockWaitsPerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.LockWaitsPerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.AverageLockWaitInMillis != nil {
+		w, err = wire.NewValueI64(*(v.AverageLockWaitInMillis)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.TransferTaskLag != nil {
+		w, err = wire.NewValueI64(*(v.TransferTaskLag)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.TimerTaskLagInMillis != nil {
+		w, err = wire.NewValueI64(*(v.TimerTaskLagInMillis)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.TopDomains != nil {
+		w, err = wire.NewValueList(_List_HistoryLoadEntry_ValueList(v.TopDomains)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.TopWorkflows != nil {
+		w, err = wire.NewValueList(_List_HistoryLoadEntry_ValueList(v.TopWorkflows)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a InternalServiceError struct from its Thrift-level
+func _HistoryLoadEntry_Read(w wire.Value) (*HistoryLoadEntry, error) {
+	var v HistoryLoadEntry
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_HistoryLoadEntry_Read(l wire.ValueList) ([]*HistoryLoadEntry, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*HistoryLoadEntry, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _HistoryLoadEntry_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a HistoryShardLoad struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a InternalServiceError struct
+// An error is returned if we were unable to build a HistoryShardLoad struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v InternalServiceError
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *InternalServiceError) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v HistoryShardLoad
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *HistoryShardLoad) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.RequestsPerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.LockWaitsPerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AverageLockWaitInMillis = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TransferTaskLag = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TimerTaskLagInMillis = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TList {
+				v.TopDomains, err = _List_HistoryLoadEntry_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TList {
+				v.TopWorkflows, err = _List_HistoryLoadEntry_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of InternalServiceError is required")
+	return nil
+}
+
+func _List_HistoryLoadEntry_Encode(val []*HistoryLoadEntry, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
 	}
 
-	return nil
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*HistoryLoadEntry', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
 }
 
-// Encode serializes a InternalServiceError struct directly into bytes, without going
+// Encode serializes a HistoryShardLoad struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a InternalServiceError struct could not be encoded.
-func (v *InternalServiceError) Encode(sw stream.Writer) error {
+// An error is returned if a HistoryShardLoad struct could not be encoded.
+func (v *HistoryShardLoad) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if err := sw.WriteString(v.Message); err != nil {
-		return err
+
+	if v.RequestsPerSecond != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.RequestsPerSecond)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+
+	if v.LockWaitsPerSecond != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.LockWaitsPerSecond)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AverageLockWaitInMillis != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.AverageLockWaitInMillis)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TransferTaskLag != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.TransferTaskLag)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TimerTaskLagInMillis != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.TimerTaskLagInMillis)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TopDomains != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_HistoryLoadEntry_Encode(v.TopDomains, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TopWorkflows != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_HistoryLoadEntry_Encode(v.TopWorkflows, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a InternalServiceError struct directly from its Thrift-level
+func _HistoryLoadEntry_Decode(sr stream.Reader) (*HistoryLoadEntry, error) {
+	var v HistoryLoadEntry
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_HistoryLoadEntry_Decode(sr stream.Reader) ([]*HistoryLoadEntry, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*HistoryLoadEntry, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _HistoryLoadEntry_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a HistoryShardLoad struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a InternalServiceError struct could not be generated from the wire
+// An error is returned if a HistoryShardLoad struct could not be generated from the wire
 // representation.
-func (v *InternalServiceError) Decode(sr stream.Reader) error {
-
-	messageIsSet := false
+func (v *HistoryShardLoad) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -45183,12 +48229,66 @@ func (v *InternalServiceError) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.Message, err = sr.ReadString()
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
 			if err != nil {
 				return err
 			}
-			messageIsSet = true
+
+		case fh.ID == 20 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.RequestsPerSecond = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.LockWaitsPerSecond = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.AverageLockWaitInMillis = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TransferTaskLag = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TimerTaskLagInMillis = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TList:
+			v.TopDomains, err = _List_HistoryLoadEntry_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TList:
+			v.TopWorkflows, err = _List_HistoryLoadEntry_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -45208,79 +48308,527 @@ func (v *InternalServiceError) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of InternalServiceError is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a InternalServiceError
+// String returns a readable string representation of a HistoryShardLoad
 // struct.
-func (v *InternalServiceError) String() string {
+func (v *HistoryShardLoad) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [8]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.RequestsPerSecond != nil {
+		fields[i] = fmt.Sprintf("RequestsPerSecond: %v", *(v.RequestsPerSecond))
+		i++
+	}
+	if v.LockWaitsPerSecond != nil {
+		fields[i] = fmt.Sprintf("LockWaitsPerSecond: %v", *(v.LockWaitsPerSecond))
+		i++
+	}
+	if v.AverageLockWaitInMillis != nil {
+		fields[i] = fmt.Sprintf("AverageLockWaitInMillis: %v", *(v.AverageLockWaitInMillis))
+		i++
+	}
+	if v.TransferTaskLag != nil {
+		fields[i] = fmt.Sprintf("TransferTaskLag: %v", *(v.TransferTaskLag))
+		i++
+	}
+	if v.TimerTaskLagInMillis != nil {
+		fields[i] = fmt.Sprintf("TimerTaskLagInMillis: %v", *(v.TimerTaskLagInMillis))
+		i++
+	}
+	if v.TopDomains != nil {
+		fields[i] = fmt.Sprintf("TopDomains: %v", v.TopDomains)
+		i++
+	}
+	if v.TopWorkflows != nil {
+		fields[i] = fmt.Sprintf("TopWorkflows: %v", v.TopWorkflows)
+		i++
+	}
 
-	return fmt.Sprintf("InternalServiceError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("HistoryShardLoad{%v}", strings.Join(fields[:i], ", "))
 }
 
-// ErrorName is the name of this type as defined in the Thrift
-// file.
-func (*InternalServiceError) ErrorName() string {
-	return "InternalServiceError"
+func _List_HistoryLoadEntry_Equals(lhs, rhs []*HistoryLoadEntry) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
 }
 
-// Equals returns true if all the fields of this InternalServiceError match the
-// provided InternalServiceError.
+// Equals returns true if all the fields of this HistoryShardLoad match the
+// provided HistoryShardLoad.
 //
 // This function performs a deep comparison.
-func (v *InternalServiceError) Equals(rhs *InternalServiceError) bool {
+func (v *HistoryShardLoad) Equals(rhs *HistoryShardLoad) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Message == rhs.Message) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.RequestsPerSecond, rhs.RequestsPerSecond) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.LockWaitsPerSecond, rhs.LockWaitsPerSecond) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AverageLockWaitInMillis, rhs.AverageLockWaitInMillis) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TransferTaskLag, rhs.TransferTaskLag) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TimerTaskLagInMillis, rhs.TimerTaskLagInMillis) {
+		return false
+	}
+	if !((v.TopDomains == nil && rhs.TopDomains == nil) || (v.TopDomains != nil && rhs.TopDomains != nil && _List_HistoryLoadEntry_Equals(v.TopDomains, rhs.TopDomains))) {
+		return false
+	}
+	if !((v.TopWorkflows == nil && rhs.TopWorkflows == nil) || (v.TopWorkflows != nil && rhs.TopWorkflows != nil && _List_HistoryLoadEntry_Equals(v.TopWorkflows, rhs.TopWorkflows))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_HistoryLoadEntry_Zapper []*HistoryLoadEntry
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_HistoryLoadEntry_Zapper.
+func (l _List_HistoryLoadEntry_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of InternalServiceError.
-func (v *InternalServiceError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of HistoryShardLoad.
+func (v *HistoryShardLoad) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("message", v.Message)
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.RequestsPerSecond != nil {
+		enc.AddFloat64("requestsPerSecond", *v.RequestsPerSecond)
+	}
+	if v.LockWaitsPerSecond != nil {
+		enc.AddFloat64("lockWaitsPerSecond", *v.LockWaitsPerSecond)
+	}
+	if v.AverageLockWaitInMillis != nil {
+		enc.AddInt64("averageLockWaitInMillis", *v.AverageLockWaitInMillis)
+	}
+	if v.TransferTaskLag != nil {
+		enc.AddInt64("transferTaskLag", *v.TransferTaskLag)
+	}
+	if v.TimerTaskLagInMillis != nil {
+		enc.AddInt64("timerTaskLagInMillis", *v.TimerTaskLagInMillis)
+	}
+	if v.TopDomains != nil {
+		err = multierr.Append(err, enc.AddArray("topDomains", (_List_HistoryLoadEntry_Zapper)(v.TopDomains)))
+	}
+	if v.TopWorkflows != nil {
+		err = multierr.Append(err, enc.AddArray("topWorkflows", (_List_HistoryLoadEntry_Zapper)(v.TopWorkflows)))
+	}
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *InternalServiceError) GetMessage() (o string) {
-	if v != nil {
-		o = v.Message
+func (v *HistoryShardLoad) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
+
 	return
 }
 
-func (v *InternalServiceError) Error() string {
-	return v.String()
+// IsSetShardID returns true if ShardID is not nil.
+func (v *HistoryShardLoad) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-type LimitExceededError struct {
+// GetRequestsPerSecond returns the value of RequestsPerSecond if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardLoad) GetRequestsPerSecond() (o float64) {
+	if v != nil && v.RequestsPerSecond != nil {
+		return *v.RequestsPerSecond
+	}
+
+	return
+}
+
+// IsSetRequestsPerSecond returns true if RequestsPerSecond is not nil.
+func (v *HistoryShardLoad) IsSetRequestsPerSecond() bool {
+	return v != nil && v.RequestsPerSecond != nil
+}
+
+// GetLockWaitsPerSecond returns the value of LockWaitsPerSecond if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardLoad) GetLockWaitsPerSecond() (o float64) {
+	if v != nil && v.LockWaitsPerSecond != nil {
+		return *v.LockWaitsPerSecond
+	}
+
+	return
+}
+
+// IsSetLockWaitsPerSecond returns true if LockWaitsPerSecond is not nil.
+func (v *HistoryShardLoad) IsSetLockWaitsPerSecond() bool {
+	return v != nil && v.LockWaitsPerSecond != nil
+}
+
+// GetAverageLockWaitInMillis returns the value of AverageLockWaitInMillis if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardLoad) GetAverageLockWaitInMillis() (o int64) {
+	if v != nil && v.AverageLockWaitInMillis != nil {
+		return *v.AverageLockWaitInMillis
+	}
+
+	return
+}
+
+// IsSetAverageLockWaitInMillis returns true if AverageLockWaitInMillis is not nil.
+func (v *HistoryShardLoad) IsSetAverageLockWaitInMillis() bool {
+	return v != nil && v.AverageLockWaitInMillis != nil
+}
+
+// GetTransferTaskLag returns the value of TransferTaskLag if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardLoad) GetTransferTaskLag() (o int64) {
+	if v != nil && v.TransferTaskLag != nil {
+		return *v.TransferTaskLag
+	}
+
+	return
+}
+
+// IsSetTransferTaskLag returns true if TransferTaskLag is not nil.
+func (v *HistoryShardLoad) IsSetTransferTaskLag() bool {
+	return v != nil && v.TransferTaskLag != nil
+}
+
+// GetTimerTaskLagInMillis returns the value of TimerTaskLagInMillis if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardLoad) GetTimerTaskLagInMillis() (o int64) {
+	if v != nil && v.TimerTaskLagInMillis != nil {
+		return *v.TimerTaskLagInMillis
+	}
+
+	return
+}
+
+// IsSetTimerTaskLagInMillis returns true if TimerTaskLagInMillis is not nil.
+func (v *HistoryShardLoad) IsSetTimerTaskLagInMillis() bool {
+	return v != nil && v.TimerTaskLagInMillis != nil
+}
+
+// GetTopDomains returns the value of TopDomains if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardLoad) GetTopDomains() (o []*HistoryLoadEntry) {
+	if v != nil && v.TopDomains != nil {
+		return v.TopDomains
+	}
+
+	return
+}
+
+// IsSetTopDomains returns true if TopDomains is not nil.
+func (v *HistoryShardLoad) IsSetTopDomains() bool {
+	return v != nil && v.TopDomains != nil
+}
+
+// GetTopWorkflows returns the value of TopWorkflows if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardLoad) GetTopWorkflows() (o []*HistoryLoadEntry) {
+	if v != nil && v.TopWorkflows != nil {
+		return v.TopWorkflows
+	}
+
+	return
+}
+
+// IsSetTopWorkflows returns true if TopWorkflows is not nil.
+func (v *HistoryShardLoad) IsSetTopWorkflows() bool {
+	return v != nil && v.TopWorkflows != nil
+}
+
+type IndexedValueType int32
+
+const (
+	IndexedValueTypeString   IndexedValueType = 0
+	IndexedValueTypeKeyword  IndexedValueType = 1
+	IndexedValueTypeInt      IndexedValueType = 2
+	IndexedValueTypeDouble   IndexedValueType = 3
+	IndexedValueTypeBool     IndexedValueType = 4
+	IndexedValueTypeDatetime IndexedValueType = 5
+)
+
+// IndexedValueType_Values returns all recognized values of IndexedValueType.
+func IndexedValueType_Values() []IndexedValueType {
+	return []IndexedValueType{
+		IndexedValueTypeString,
+		IndexedValueTypeKeyword,
+		IndexedValueTypeInt,
+		IndexedValueTypeDouble,
+		IndexedValueTypeBool,
+		IndexedValueTypeDatetime,
+	}
+}
+
+// UnmarshalText tries to decode IndexedValueType from a byte slice
+// containing its name.
+//
+//   var v IndexedValueType
+//   err := v.UnmarshalText([]byte("STRING"))
+func (v *IndexedValueType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "STRING":
+		*v = IndexedValueTypeString
+		return nil
+	case "KEYWORD":
+		*v = IndexedValueTypeKeyword
+		return nil
+	case "INT":
+		*v = IndexedValueTypeInt
+		return nil
+	case "DOUBLE":
+		*v = IndexedValueTypeDouble
+		return nil
+	case "BOOL":
+		*v = IndexedValueTypeBool
+		return nil
+	case "DATETIME":
+		*v = IndexedValueTypeDatetime
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "IndexedValueType", err)
+		}
+		*v = IndexedValueType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes IndexedValueType to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v IndexedValueType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("STRING"), nil
+	case 1:
+		return []byte("KEYWORD"), nil
+	case 2:
+		return []byte("INT"), nil
+	case 3:
+		return []byte("DOUBLE"), nil
+	case 4:
+		return []byte("BOOL"), nil
+	case 5:
+		return []byte("DATETIME"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of IndexedValueType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v IndexedValueType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "STRING")
+	case 1:
+		enc.AddString("name", "KEYWORD")
+	case 2:
+		enc.AddString("name", "INT")
+	case 3:
+		enc.AddString("name", "DOUBLE")
+	case 4:
+		enc.AddString("name", "BOOL")
+	case 5:
+		enc.AddString("name", "DATETIME")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v IndexedValueType) Ptr() *IndexedValueType {
+	return &v
+}
+
+// Encode encodes IndexedValueType directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v IndexedValueType
+//   return v.Encode(sWriter)
+func (v IndexedValueType) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates IndexedValueType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v IndexedValueType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes IndexedValueType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return IndexedValueType(0), err
+//   }
+//
+//   var v IndexedValueType
+//   if err := v.FromWire(x); err != nil {
+//     return IndexedValueType(0), err
+//   }
+//   return v, nil
+func (v *IndexedValueType) FromWire(w wire.Value) error {
+	*v = (IndexedValueType)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded IndexedValueType directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v IndexedValueType
+//   if err := v.Decode(sReader); err != nil {
+//     return IndexedValueType(0), err
+//   }
+//   return v, nil
+func (v *IndexedValueType) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (IndexedValueType)(i)
+	return nil
+}
+
+// String returns a readable string representation of IndexedValueType.
+func (v IndexedValueType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "STRING"
+	case 1:
+		return "KEYWORD"
+	case 2:
+		return "INT"
+	case 3:
+		return "DOUBLE"
+	case 4:
+		return "BOOL"
+	case 5:
+		return "DATETIME"
+	}
+	return fmt.Sprintf("IndexedValueType(%d)", w)
+}
+
+// Equals returns true if this IndexedValueType value matches the provided
+// value.
+func (v IndexedValueType) Equals(rhs IndexedValueType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes IndexedValueType into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v IndexedValueType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"STRING\""), nil
+	case 1:
+		return ([]byte)("\"KEYWORD\""), nil
+	case 2:
+		return ([]byte)("\"INT\""), nil
+	case 3:
+		return ([]byte)("\"DOUBLE\""), nil
+	case 4:
+		return ([]byte)("\"BOOL\""), nil
+	case 5:
+		return ([]byte)("\"DATETIME\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode IndexedValueType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *IndexedValueType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "IndexedValueType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "IndexedValueType")
+		}
+		*v = (IndexedValueType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "IndexedValueType")
+	}
+}
+
+type InternalDataInconsistencyError struct {
 	Message string `json:"message,required"`
 }
 
-// ToWire translates a LimitExceededError struct into a Thrift-level intermediate
+// ToWire translates a InternalDataInconsistencyError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -45295,7 +48843,7 @@ type LimitExceededError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *LimitExceededError) ToWire() (wire.Value, error) {
+func (v *InternalDataInconsistencyError) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -45313,11 +48861,11 @@ func (v *LimitExceededError) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a LimitExceededError struct from its Thrift-level
+// FromWire deserializes a InternalDataInconsistencyError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a LimitExceededError struct
+// An error is returned if we were unable to build a InternalDataInconsistencyError struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -45325,12 +48873,12 @@ func (v *LimitExceededError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v LimitExceededError
+//   var v InternalDataInconsistencyError
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *LimitExceededError) FromWire(w wire.Value) error {
+func (v *InternalDataInconsistencyError) FromWire(w wire.Value) error {
 	var err error
 
 	messageIsSet := false
@@ -45349,17 +48897,17 @@ func (v *LimitExceededError) FromWire(w wire.Value) error {
 	}
 
 	if !messageIsSet {
-		return errors.New("field Message of LimitExceededError is required")
+		return errors.New("field Message of InternalDataInconsistencyError is required")
 	}
 
 	return nil
 }
 
-// Encode serializes a LimitExceededError struct directly into bytes, without going
+// Encode serializes a InternalDataInconsistencyError struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a LimitExceededError struct could not be encoded.
-func (v *LimitExceededError) Encode(sw stream.Writer) error {
+// An error is returned if a InternalDataInconsistencyError struct could not be encoded.
+func (v *InternalDataInconsistencyError) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -45377,12 +48925,12 @@ func (v *LimitExceededError) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a LimitExceededError struct directly from its Thrift-level
+// Decode deserializes a InternalDataInconsistencyError struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a LimitExceededError struct could not be generated from the wire
+// An error is returned if a InternalDataInconsistencyError struct could not be generated from the wire
 // representation.
-func (v *LimitExceededError) Decode(sr stream.Reader) error {
+func (v *InternalDataInconsistencyError) Decode(sr stream.Reader) error {
 
 	messageIsSet := false
 
@@ -45423,15 +48971,15 @@ func (v *LimitExceededError) Decode(sr stream.Reader) error {
 	}
 
 	if !messageIsSet {
-		return errors.New("field Message of LimitExceededError is required")
+		return errors.New("field Message of InternalDataInconsistencyError is required")
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a LimitExceededError
+// String returns a readable string representation of a InternalDataInconsistencyError
 // struct.
-func (v *LimitExceededError) String() string {
+func (v *InternalDataInconsistencyError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -45441,20 +48989,20 @@ func (v *LimitExceededError) String() string {
 	fields[i] = fmt.Sprintf("Message: %v", v.Message)
 	i++
 
-	return fmt.Sprintf("LimitExceededError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("InternalDataInconsistencyError{%v}", strings.Join(fields[:i], ", "))
 }
 
 // ErrorName is the name of this type as defined in the Thrift
 // file.
-func (*LimitExceededError) ErrorName() string {
-	return "LimitExceededError"
+func (*InternalDataInconsistencyError) ErrorName() string {
+	return "InternalDataInconsistencyError"
 }
 
-// Equals returns true if all the fields of this LimitExceededError match the
-// provided LimitExceededError.
+// Equals returns true if all the fields of this InternalDataInconsistencyError match the
+// provided InternalDataInconsistencyError.
 //
 // This function performs a deep comparison.
-func (v *LimitExceededError) Equals(rhs *LimitExceededError) bool {
+func (v *InternalDataInconsistencyError) Equals(rhs *InternalDataInconsistencyError) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -45468,8 +49016,8 @@ func (v *LimitExceededError) Equals(rhs *LimitExceededError) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of LimitExceededError.
-func (v *LimitExceededError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of InternalDataInconsistencyError.
+func (v *InternalDataInconsistencyError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -45479,25 +49027,22 @@ func (v *LimitExceededError) MarshalLogObject(enc zapcore.ObjectEncoder) (err er
 
 // GetMessage returns the value of Message if it is set or its
 // zero value if it is unset.
-func (v *LimitExceededError) GetMessage() (o string) {
+func (v *InternalDataInconsistencyError) GetMessage() (o string) {
 	if v != nil {
 		o = v.Message
 	}
 	return
 }
 
-func (v *LimitExceededError) Error() string {
+func (v *InternalDataInconsistencyError) Error() string {
 	return v.String()
 }
 
-type ListArchivedWorkflowExecutionsRequest struct {
-	Domain        *string `json:"domain,omitempty"`
-	PageSize      *int32  `json:"pageSize,omitempty"`
-	NextPageToken []byte  `json:"nextPageToken,omitempty"`
-	Query         *string `json:"query,omitempty"`
+type InternalServiceError struct {
+	Message string `json:"message,required"`
 }
 
-// ToWire translates a ListArchivedWorkflowExecutionsRequest struct into a Thrift-level intermediate
+// ToWire translates a InternalServiceError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -45512,55 +49057,29 @@ type ListArchivedWorkflowExecutionsRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ListArchivedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+func (v *InternalServiceError) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.PageSize != nil {
-		w, err = wire.NewValueI32(*(v.PageSize)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.Query != nil {
-		w, err = wire.NewValueString(*(v.Query)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ListArchivedWorkflowExecutionsRequest struct from its Thrift-level
+// FromWire deserializes a InternalServiceError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListArchivedWorkflowExecutionsRequest struct
+// An error is returned if we were unable to build a InternalServiceError struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -45568,17 +49087,474 @@ func (v *ListArchivedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ListArchivedWorkflowExecutionsRequest
+//   var v InternalServiceError
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ListArchivedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+func (v *InternalServiceError) FromWire(w wire.Value) error {
 	var err error
 
+	messageIsSet := false
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of InternalServiceError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a InternalServiceError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a InternalServiceError struct could not be encoded.
+func (v *InternalServiceError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a InternalServiceError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a InternalServiceError struct could not be generated from the wire
+// representation.
+func (v *InternalServiceError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of InternalServiceError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a InternalServiceError
+// struct.
+func (v *InternalServiceError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("InternalServiceError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*InternalServiceError) ErrorName() string {
+	return "InternalServiceError"
+}
+
+// Equals returns true if all the fields of this InternalServiceError match the
+// provided InternalServiceError.
+//
+// This function performs a deep comparison.
+func (v *InternalServiceError) Equals(rhs *InternalServiceError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of InternalServiceError.
+func (v *InternalServiceError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *InternalServiceError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+func (v *InternalServiceError) Error() string {
+	return v.String()
+}
+
+type LimitExceededError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a LimitExceededError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *LimitExceededError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a LimitExceededError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a LimitExceededError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v LimitExceededError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *LimitExceededError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of LimitExceededError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a LimitExceededError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a LimitExceededError struct could not be encoded.
+func (v *LimitExceededError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a LimitExceededError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a LimitExceededError struct could not be generated from the wire
+// representation.
+func (v *LimitExceededError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of LimitExceededError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a LimitExceededError
+// struct.
+func (v *LimitExceededError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("LimitExceededError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*LimitExceededError) ErrorName() string {
+	return "LimitExceededError"
+}
+
+// Equals returns true if all the fields of this LimitExceededError match the
+// provided LimitExceededError.
+//
+// This function performs a deep comparison.
+func (v *LimitExceededError) Equals(rhs *LimitExceededError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of LimitExceededError.
+func (v *LimitExceededError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *LimitExceededError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+func (v *LimitExceededError) Error() string {
+	return v.String()
+}
+
+type ListArchivedWorkflowExecutionsRequest struct {
+	Domain        *string `json:"domain,omitempty"`
+	PageSize      *int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte  `json:"nextPageToken,omitempty"`
+	Query         *string `json:"query,omitempty"`
+}
+
+// ToWire translates a ListArchivedWorkflowExecutionsRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListArchivedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PageSize != nil {
+		w, err = wire.NewValueI32(*(v.PageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Query != nil {
+		w, err = wire.NewValueString(*(v.Query)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ListArchivedWorkflowExecutionsRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListArchivedWorkflowExecutionsRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListArchivedWorkflowExecutionsRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListArchivedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -49968,90 +53944,72 @@ func (v *ListWorkflowExecutionsResponse) IsSetNextPageToken() bool {
 	return v != nil && v.NextPageToken != nil
 }
 
-type MarkerRecordedEventAttributes struct {
-	MarkerName                   *string `json:"markerName,omitempty"`
-	Details                      []byte  `json:"details,omitempty"`
-	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
-	Header                       *Header `json:"header,omitempty"`
+type ListWorkflowTasksRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
 }
 
-// ToWire translates a MarkerRecordedEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a ListWorkflowTasksRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *MarkerRecordedEventAttributes) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ListWorkflowTasksRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.MarkerName != nil {
-		w, err = wire.NewValueString(*(v.MarkerName)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.Header != nil {
-		w, err = v.Header.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MarkerRecordedEventAttributes struct from its Thrift-level
+// FromWire deserializes a ListWorkflowTasksRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MarkerRecordedEventAttributes struct
+// An error is returned if we were unable to build a ListWorkflowTasksRequest struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v MarkerRecordedEventAttributes
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *MarkerRecordedEventAttributes) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ListWorkflowTasksRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ListWorkflowTasksRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -50060,33 +54018,15 @@ func (v *MarkerRecordedEventAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.MarkerName = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
 			if field.Value.Type() == wire.TStruct {
-				v.Header, err = _Header_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -50098,44 +54038,20 @@ func (v *MarkerRecordedEventAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a MarkerRecordedEventAttributes struct directly into bytes, without going
+// Encode serializes a ListWorkflowTasksRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MarkerRecordedEventAttributes struct could not be encoded.
-func (v *MarkerRecordedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a ListWorkflowTasksRequest struct could not be encoded.
+func (v *ListWorkflowTasksRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.MarkerName != nil {
+	if v.Domain != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.MarkerName)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Details != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.Details); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.DecisionTaskCompletedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -50143,11 +54059,11 @@ func (v *MarkerRecordedEventAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Header != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Header.Encode(sw); err != nil {
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -50158,12 +54074,12 @@ func (v *MarkerRecordedEventAttributes) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a MarkerRecordedEventAttributes struct directly from its Thrift-level
+// Decode deserializes a ListWorkflowTasksRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MarkerRecordedEventAttributes struct could not be generated from the wire
+// An error is returned if a ListWorkflowTasksRequest struct could not be generated from the wire
 // representation.
-func (v *MarkerRecordedEventAttributes) Decode(sr stream.Reader) error {
+func (v *ListWorkflowTasksRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -50179,27 +54095,13 @@ func (v *MarkerRecordedEventAttributes) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.MarkerName = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			v.Details, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
+			v.Domain = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TStruct:
-			v.Header, err = _Header_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -50226,55 +54128,41 @@ func (v *MarkerRecordedEventAttributes) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MarkerRecordedEventAttributes
+// String returns a readable string representation of a ListWorkflowTasksRequest
 // struct.
-func (v *MarkerRecordedEventAttributes) String() string {
+func (v *ListWorkflowTasksRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [2]string
 	i := 0
-	if v.MarkerName != nil {
-		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
-		i++
-	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
-		i++
-	}
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.Header != nil {
-		fields[i] = fmt.Sprintf("Header: %v", v.Header)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
 
-	return fmt.Sprintf("MarkerRecordedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ListWorkflowTasksRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MarkerRecordedEventAttributes match the
-// provided MarkerRecordedEventAttributes.
+// Equals returns true if all the fields of this ListWorkflowTasksRequest match the
+// provided ListWorkflowTasksRequest.
 //
 // This function performs a deep comparison.
-func (v *MarkerRecordedEventAttributes) Equals(rhs *MarkerRecordedEventAttributes) bool {
+func (v *ListWorkflowTasksRequest) Equals(rhs *ListWorkflowTasksRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
-		return false
-	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
 
@@ -50282,150 +54170,205 @@ func (v *MarkerRecordedEventAttributes) Equals(rhs *MarkerRecordedEventAttribute
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MarkerRecordedEventAttributes.
-func (v *MarkerRecordedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ListWorkflowTasksRequest.
+func (v *ListWorkflowTasksRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.MarkerName != nil {
-		enc.AddString("markerName", *v.MarkerName)
-	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
-	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
-	if v.Header != nil {
-		err = multierr.Append(err, enc.AddObject("header", v.Header))
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
 	return err
 }
 
-// GetMarkerName returns the value of MarkerName if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *MarkerRecordedEventAttributes) GetMarkerName() (o string) {
-	if v != nil && v.MarkerName != nil {
-		return *v.MarkerName
+func (v *ListWorkflowTasksRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetMarkerName returns true if MarkerName is not nil.
-func (v *MarkerRecordedEventAttributes) IsSetMarkerName() bool {
-	return v != nil && v.MarkerName != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *ListWorkflowTasksRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetDetails returns the value of Details if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *MarkerRecordedEventAttributes) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+func (v *ListWorkflowTasksRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *MarkerRecordedEventAttributes) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ListWorkflowTasksRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
-// zero value if it is unset.
-func (v *MarkerRecordedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
-	}
-
-	return
+type ListWorkflowTasksResponse struct {
+	ShardID   *int32              `json:"shardID,omitempty"`
+	Tasks     []*WorkflowTaskInfo `json:"tasks,omitempty"`
+	Truncated *bool               `json:"truncated,omitempty"`
 }
 
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *MarkerRecordedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
-}
+type _List_WorkflowTaskInfo_ValueList []*WorkflowTaskInfo
 
-// GetHeader returns the value of Header if it is set or its
-// zero value if it is unset.
-func (v *MarkerRecordedEventAttributes) GetHeader() (o *Header) {
-	if v != nil && v.Header != nil {
-		return v.Header
+func (v _List_WorkflowTaskInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*WorkflowTaskInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
-
-	return
+	return nil
 }
 
-// IsSetHeader returns true if Header is not nil.
-func (v *MarkerRecordedEventAttributes) IsSetHeader() bool {
-	return v != nil && v.Header != nil
+func (v _List_WorkflowTaskInfo_ValueList) Size() int {
+	return len(v)
 }
 
-type Memo struct {
-	Fields map[string][]byte `json:"fields,omitempty"`
+func (_List_WorkflowTaskInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-// ToWire translates a Memo struct into a Thrift-level intermediate
+func (_List_WorkflowTaskInfo_ValueList) Close() {}
+
+// ToWire translates a ListWorkflowTasksResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *Memo) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ListWorkflowTasksResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Fields != nil {
-		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Fields)), error(nil)
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Tasks != nil {
+		w, err = wire.NewValueList(_List_WorkflowTaskInfo_ValueList(v.Tasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Truncated != nil {
+		w, err = wire.NewValueBool(*(v.Truncated)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Memo struct from its Thrift-level
+func _WorkflowTaskInfo_Read(w wire.Value) (*WorkflowTaskInfo, error) {
+	var v WorkflowTaskInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_WorkflowTaskInfo_Read(l wire.ValueList) ([]*WorkflowTaskInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*WorkflowTaskInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkflowTaskInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListWorkflowTasksResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Memo struct
+// An error is returned if we were unable to build a ListWorkflowTasksResponse struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v Memo
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *Memo) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ListWorkflowTasksResponse
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ListWorkflowTasksResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TMap {
-				v.Fields, err = _Map_String_Binary_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Tasks, err = _List_WorkflowTaskInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Truncated = &x
 				if err != nil {
 					return err
 				}
@@ -50437,20 +54380,65 @@ func (v *Memo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a Memo struct directly into bytes, without going
+func _List_WorkflowTaskInfo_Encode(val []*WorkflowTaskInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*WorkflowTaskInfo', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a ListWorkflowTasksResponse struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a Memo struct could not be encoded.
-func (v *Memo) Encode(sw stream.Writer) error {
+// An error is returned if a ListWorkflowTasksResponse struct could not be encoded.
+func (v *ListWorkflowTasksResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Fields != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TMap}); err != nil {
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := _Map_String_Binary_Encode(v.Fields, sw); err != nil {
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Tasks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_WorkflowTaskInfo_Encode(v.Tasks, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Truncated != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Truncated)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -50461,12 +54449,48 @@ func (v *Memo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a Memo struct directly from its Thrift-level
+func _WorkflowTaskInfo_Decode(sr stream.Reader) (*WorkflowTaskInfo, error) {
+	var v WorkflowTaskInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_WorkflowTaskInfo_Decode(sr stream.Reader) ([]*WorkflowTaskInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*WorkflowTaskInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _WorkflowTaskInfo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ListWorkflowTasksResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a Memo struct could not be generated from the wire
+// An error is returned if a ListWorkflowTasksResponse struct could not be generated from the wire
 // representation.
-func (v *Memo) Decode(sr stream.Reader) error {
+func (v *ListWorkflowTasksResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -50479,8 +54503,24 @@ func (v *Memo) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TMap:
-			v.Fields, err = _Map_String_Binary_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.Tasks, err = _List_WorkflowTaskInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Truncated = &x
 			if err != nil {
 				return err
 			}
@@ -50507,296 +54547,151 @@ func (v *Memo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a Memo
+// String returns a readable string representation of a ListWorkflowTasksResponse
 // struct.
-func (v *Memo) String() string {
+func (v *ListWorkflowTasksResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [3]string
 	i := 0
-	if v.Fields != nil {
-		fields[i] = fmt.Sprintf("Fields: %v", v.Fields)
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.Tasks != nil {
+		fields[i] = fmt.Sprintf("Tasks: %v", v.Tasks)
+		i++
+	}
+	if v.Truncated != nil {
+		fields[i] = fmt.Sprintf("Truncated: %v", *(v.Truncated))
 		i++
 	}
 
-	return fmt.Sprintf("Memo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ListWorkflowTasksResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Memo match the
-// provided Memo.
+func _List_WorkflowTaskInfo_Equals(lhs, rhs []*WorkflowTaskInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ListWorkflowTasksResponse match the
+// provided ListWorkflowTasksResponse.
 //
 // This function performs a deep comparison.
-func (v *Memo) Equals(rhs *Memo) bool {
+func (v *ListWorkflowTasksResponse) Equals(rhs *ListWorkflowTasksResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Fields == nil && rhs.Fields == nil) || (v.Fields != nil && rhs.Fields != nil && _Map_String_Binary_Equals(v.Fields, rhs.Fields))) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !((v.Tasks == nil && rhs.Tasks == nil) || (v.Tasks != nil && rhs.Tasks != nil && _List_WorkflowTaskInfo_Equals(v.Tasks, rhs.Tasks))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Truncated, rhs.Truncated) {
 		return false
 	}
 
 	return true
 }
 
+type _List_WorkflowTaskInfo_Zapper []*WorkflowTaskInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_WorkflowTaskInfo_Zapper.
+func (l _List_WorkflowTaskInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Memo.
-func (v *Memo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ListWorkflowTasksResponse.
+func (v *ListWorkflowTasksResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Fields != nil {
-		err = multierr.Append(err, enc.AddObject("fields", (_Map_String_Binary_Zapper)(v.Fields)))
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.Tasks != nil {
+		err = multierr.Append(err, enc.AddArray("tasks", (_List_WorkflowTaskInfo_Zapper)(v.Tasks)))
+	}
+	if v.Truncated != nil {
+		enc.AddBool("truncated", *v.Truncated)
 	}
 	return err
 }
 
-// GetFields returns the value of Fields if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *Memo) GetFields() (o map[string][]byte) {
-	if v != nil && v.Fields != nil {
-		return v.Fields
+func (v *ListWorkflowTasksResponse) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// IsSetFields returns true if Fields is not nil.
-func (v *Memo) IsSetFields() bool {
-	return v != nil && v.Fields != nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ListWorkflowTasksResponse) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-type ParentClosePolicy int32
-
-const (
-	ParentClosePolicyAbandon       ParentClosePolicy = 0
-	ParentClosePolicyRequestCancel ParentClosePolicy = 1
-	ParentClosePolicyTerminate     ParentClosePolicy = 2
-)
+// GetTasks returns the value of Tasks if it is set or its
+// zero value if it is unset.
+func (v *ListWorkflowTasksResponse) GetTasks() (o []*WorkflowTaskInfo) {
+	if v != nil && v.Tasks != nil {
+		return v.Tasks
+	}
 
-// ParentClosePolicy_Values returns all recognized values of ParentClosePolicy.
-func ParentClosePolicy_Values() []ParentClosePolicy {
-	return []ParentClosePolicy{
-		ParentClosePolicyAbandon,
-		ParentClosePolicyRequestCancel,
-		ParentClosePolicyTerminate,
-	}
-}
-
-// UnmarshalText tries to decode ParentClosePolicy from a byte slice
-// containing its name.
-//
-//   var v ParentClosePolicy
-//   err := v.UnmarshalText([]byte("ABANDON"))
-func (v *ParentClosePolicy) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "ABANDON":
-		*v = ParentClosePolicyAbandon
-		return nil
-	case "REQUEST_CANCEL":
-		*v = ParentClosePolicyRequestCancel
-		return nil
-	case "TERMINATE":
-		*v = ParentClosePolicyTerminate
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ParentClosePolicy", err)
-		}
-		*v = ParentClosePolicy(val)
-		return nil
-	}
-}
-
-// MarshalText encodes ParentClosePolicy to text.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v ParentClosePolicy) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("ABANDON"), nil
-	case 1:
-		return []byte("REQUEST_CANCEL"), nil
-	case 2:
-		return []byte("TERMINATE"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ParentClosePolicy.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v ParentClosePolicy) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "ABANDON")
-	case 1:
-		enc.AddString("name", "REQUEST_CANCEL")
-	case 2:
-		enc.AddString("name", "TERMINATE")
-	}
-	return nil
-}
-
-// Ptr returns a pointer to this enum value.
-func (v ParentClosePolicy) Ptr() *ParentClosePolicy {
-	return &v
-}
-
-// Encode encodes ParentClosePolicy directly to bytes.
-//
-//   sWriter := BinaryStreamer.Writer(writer)
-//
-//   var v ParentClosePolicy
-//   return v.Encode(sWriter)
-func (v ParentClosePolicy) Encode(sw stream.Writer) error {
-	return sw.WriteInt32(int32(v))
-}
-
-// ToWire translates ParentClosePolicy into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v ParentClosePolicy) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes ParentClosePolicy from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return ParentClosePolicy(0), err
-//   }
-//
-//   var v ParentClosePolicy
-//   if err := v.FromWire(x); err != nil {
-//     return ParentClosePolicy(0), err
-//   }
-//   return v, nil
-func (v *ParentClosePolicy) FromWire(w wire.Value) error {
-	*v = (ParentClosePolicy)(w.GetI32())
-	return nil
+	return
 }
 
-// Decode reads off the encoded ParentClosePolicy directly off of the wire.
-//
-//   sReader := BinaryStreamer.Reader(reader)
-//
-//   var v ParentClosePolicy
-//   if err := v.Decode(sReader); err != nil {
-//     return ParentClosePolicy(0), err
-//   }
-//   return v, nil
-func (v *ParentClosePolicy) Decode(sr stream.Reader) error {
-	i, err := sr.ReadInt32()
-	if err != nil {
-		return err
-	}
-	*v = (ParentClosePolicy)(i)
-	return nil
+// IsSetTasks returns true if Tasks is not nil.
+func (v *ListWorkflowTasksResponse) IsSetTasks() bool {
+	return v != nil && v.Tasks != nil
 }
 
-// String returns a readable string representation of ParentClosePolicy.
-func (v ParentClosePolicy) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "ABANDON"
-	case 1:
-		return "REQUEST_CANCEL"
-	case 2:
-		return "TERMINATE"
+// GetTruncated returns the value of Truncated if it is set or its
+// zero value if it is unset.
+func (v *ListWorkflowTasksResponse) GetTruncated() (o bool) {
+	if v != nil && v.Truncated != nil {
+		return *v.Truncated
 	}
-	return fmt.Sprintf("ParentClosePolicy(%d)", w)
-}
-
-// Equals returns true if this ParentClosePolicy value matches the provided
-// value.
-func (v ParentClosePolicy) Equals(rhs ParentClosePolicy) bool {
-	return v == rhs
-}
 
-// MarshalJSON serializes ParentClosePolicy into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v ParentClosePolicy) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"ABANDON\""), nil
-	case 1:
-		return ([]byte)("\"REQUEST_CANCEL\""), nil
-	case 2:
-		return ([]byte)("\"TERMINATE\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+	return
 }
 
-// UnmarshalJSON attempts to decode ParentClosePolicy from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *ParentClosePolicy) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ParentClosePolicy")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ParentClosePolicy")
-		}
-		*v = (ParentClosePolicy)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ParentClosePolicy")
-	}
+// IsSetTruncated returns true if Truncated is not nil.
+func (v *ListWorkflowTasksResponse) IsSetTruncated() bool {
+	return v != nil && v.Truncated != nil
 }
 
-type PendingActivityInfo struct {
-	ActivityID             *string               `json:"activityID,omitempty"`
-	ActivityType           *ActivityType         `json:"activityType,omitempty"`
-	State                  *PendingActivityState `json:"state,omitempty"`
-	HeartbeatDetails       []byte                `json:"heartbeatDetails,omitempty"`
-	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
-	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
-	Attempt                *int32                `json:"attempt,omitempty"`
-	MaximumAttempts        *int32                `json:"maximumAttempts,omitempty"`
-	ScheduledTimestamp     *int64                `json:"scheduledTimestamp,omitempty"`
-	ExpirationTimestamp    *int64                `json:"expirationTimestamp,omitempty"`
-	LastFailureReason      *string               `json:"lastFailureReason,omitempty"`
-	LastWorkerIdentity     *string               `json:"lastWorkerIdentity,omitempty"`
-	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
+type MarkerRecordedEventAttributes struct {
+	MarkerName                   *string `json:"markerName,omitempty"`
+	Details                      []byte  `json:"details,omitempty"`
+	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+	Header                       *Header `json:"header,omitempty"`
 }
 
-// ToWire translates a PendingActivityInfo struct into a Thrift-level intermediate
+// ToWire translates a MarkerRecordedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -50811,133 +54706,55 @@ type PendingActivityInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PendingActivityInfo) ToWire() (wire.Value, error) {
+func (v *MarkerRecordedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ActivityID != nil {
-		w, err = wire.NewValueString(*(v.ActivityID)), error(nil)
+	if v.MarkerName != nil {
+		w, err = wire.NewValueString(*(v.MarkerName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ActivityType != nil {
-		w, err = v.ActivityType.ToWire()
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.State != nil {
-		w, err = v.State.ToWire()
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.HeartbeatDetails != nil {
-		w, err = wire.NewValueBinary(v.HeartbeatDetails), error(nil)
+	if v.Header != nil {
+		w, err = v.Header.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.LastHeartbeatTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.LastHeartbeatTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.LastStartedTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.LastStartedTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.MaximumAttempts != nil {
-		w, err = wire.NewValueI32(*(v.MaximumAttempts)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.ScheduledTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.ExpirationTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.LastFailureReason != nil {
-		w, err = wire.NewValueString(*(v.LastFailureReason)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
-	if v.LastWorkerIdentity != nil {
-		w, err = wire.NewValueString(*(v.LastWorkerIdentity)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 120, Value: w}
-		i++
-	}
-	if v.LastFailureDetails != nil {
-		w, err = wire.NewValueBinary(v.LastFailureDetails), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 130, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PendingActivityState_Read(w wire.Value) (PendingActivityState, error) {
-	var v PendingActivityState
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a PendingActivityInfo struct from its Thrift-level
+// FromWire deserializes a MarkerRecordedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PendingActivityInfo struct
+// An error is returned if we were unable to build a MarkerRecordedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -50945,12 +54762,12 @@ func _PendingActivityState_Read(w wire.Value) (PendingActivityState, error) {
 //     return nil, err
 //   }
 //
-//   var v PendingActivityInfo
+//   var v MarkerRecordedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PendingActivityInfo) FromWire(w wire.Value) error {
+func (v *MarkerRecordedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -50959,121 +54776,33 @@ func (v *PendingActivityInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ActivityID = &x
+				v.MarkerName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.ActivityType, err = _ActivityType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI32 {
-				var x PendingActivityState
-				x, err = _PendingActivityState_Read(field.Value)
-				v.State = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
 			if field.Value.Type() == wire.TBinary {
-				v.HeartbeatDetails, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastHeartbeatTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastStartedTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumAttempts = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimestamp = &x
+				v.Details, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 100:
+		case 30:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.ExpirationTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.LastFailureReason = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 120:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.LastWorkerIdentity = &x
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 130:
-			if field.Value.Type() == wire.TBinary {
-				v.LastFailureDetails, err = field.Value.GetBinary(), error(nil)
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.Header, err = _Header_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -51085,20 +54814,20 @@ func (v *PendingActivityInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a PendingActivityInfo struct directly into bytes, without going
+// Encode serializes a MarkerRecordedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a PendingActivityInfo struct could not be encoded.
-func (v *PendingActivityInfo) Encode(sw stream.Writer) error {
+// An error is returned if a MarkerRecordedEventAttributes struct could not be encoded.
+func (v *MarkerRecordedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ActivityID != nil {
+	if v.MarkerName != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ActivityID)); err != nil {
+		if err := sw.WriteString(*(v.MarkerName)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -51106,11 +54835,11 @@ func (v *PendingActivityInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ActivityType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+	if v.Details != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.ActivityType.Encode(sw); err != nil {
+		if err := sw.WriteBinary(v.Details); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -51118,11 +54847,11 @@ func (v *PendingActivityInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.State != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := v.State.Encode(sw); err != nil {
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -51130,11 +54859,11 @@ func (v *PendingActivityInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.HeartbeatDetails != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+	if v.Header != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.HeartbeatDetails); err != nil {
+		if err := v.Header.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -51142,137 +54871,23 @@ func (v *PendingActivityInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastHeartbeatTimestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.LastHeartbeatTimestamp)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+	return sw.WriteStructEnd()
+}
 
-	if v.LastStartedTimestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.LastStartedTimestamp)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+// Decode deserializes a MarkerRecordedEventAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a MarkerRecordedEventAttributes struct could not be generated from the wire
+// representation.
+func (v *MarkerRecordedEventAttributes) Decode(sr stream.Reader) error {
 
-	if v.Attempt != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.Attempt)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	if v.MaximumAttempts != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.MaximumAttempts)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ScheduledTimestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.ScheduledTimestamp)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ExpirationTimestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.ExpirationTimestamp)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.LastFailureReason != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.LastFailureReason)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.LastWorkerIdentity != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.LastWorkerIdentity)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.LastFailureDetails != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.LastFailureDetails); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	return sw.WriteStructEnd()
-}
-
-func _PendingActivityState_Decode(sr stream.Reader) (PendingActivityState, error) {
-	var v PendingActivityState
-	err := v.Decode(sr)
-	return v, err
-}
-
-// Decode deserializes a PendingActivityInfo struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a PendingActivityInfo struct could not be generated from the wire
-// representation.
-func (v *PendingActivityInfo) Decode(sr stream.Reader) error {
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
-
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
 	for ok {
@@ -51280,97 +54895,27 @@ func (v *PendingActivityInfo) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.ActivityID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.ActivityType, err = _ActivityType_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TI32:
-			var x PendingActivityState
-			x, err = _PendingActivityState_Decode(sr)
-			v.State = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TBinary:
-			v.HeartbeatDetails, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.LastHeartbeatTimestamp = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 60 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.LastStartedTimestamp = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 70 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.Attempt = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 80 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.MaximumAttempts = &x
+			v.MarkerName = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 90 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ScheduledTimestamp = &x
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			v.Details, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 100 && fh.Type == wire.TI64:
+		case fh.ID == 30 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.ExpirationTimestamp = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 110 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.LastFailureReason = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 120 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.LastWorkerIdentity = &x
+			v.DecisionTaskCompletedEventId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 130 && fh.Type == wire.TBinary:
-			v.LastFailureDetails, err = sr.ReadBinary()
+		case fh.ID == 40 && fh.Type == wire.TStruct:
+			v.Header, err = _Header_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -51397,128 +54942,55 @@ func (v *PendingActivityInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a PendingActivityInfo
+// String returns a readable string representation of a MarkerRecordedEventAttributes
 // struct.
-func (v *PendingActivityInfo) String() string {
+func (v *MarkerRecordedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [13]string
+	var fields [4]string
 	i := 0
-	if v.ActivityID != nil {
-		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
-		i++
-	}
-	if v.ActivityType != nil {
-		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
-		i++
-	}
-	if v.State != nil {
-		fields[i] = fmt.Sprintf("State: %v", *(v.State))
-		i++
-	}
-	if v.HeartbeatDetails != nil {
-		fields[i] = fmt.Sprintf("HeartbeatDetails: %v", v.HeartbeatDetails)
-		i++
-	}
-	if v.LastHeartbeatTimestamp != nil {
-		fields[i] = fmt.Sprintf("LastHeartbeatTimestamp: %v", *(v.LastHeartbeatTimestamp))
-		i++
-	}
-	if v.LastStartedTimestamp != nil {
-		fields[i] = fmt.Sprintf("LastStartedTimestamp: %v", *(v.LastStartedTimestamp))
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
-		i++
-	}
-	if v.MaximumAttempts != nil {
-		fields[i] = fmt.Sprintf("MaximumAttempts: %v", *(v.MaximumAttempts))
-		i++
-	}
-	if v.ScheduledTimestamp != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
-		i++
-	}
-	if v.ExpirationTimestamp != nil {
-		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
+	if v.MarkerName != nil {
+		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
 		i++
 	}
-	if v.LastFailureReason != nil {
-		fields[i] = fmt.Sprintf("LastFailureReason: %v", *(v.LastFailureReason))
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
 		i++
 	}
-	if v.LastWorkerIdentity != nil {
-		fields[i] = fmt.Sprintf("LastWorkerIdentity: %v", *(v.LastWorkerIdentity))
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.LastFailureDetails != nil {
-		fields[i] = fmt.Sprintf("LastFailureDetails: %v", v.LastFailureDetails)
+	if v.Header != nil {
+		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
 
-	return fmt.Sprintf("PendingActivityInfo{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _PendingActivityState_EqualsPtr(lhs, rhs *PendingActivityState) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("MarkerRecordedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PendingActivityInfo match the
-// provided PendingActivityInfo.
+// Equals returns true if all the fields of this MarkerRecordedEventAttributes match the
+// provided MarkerRecordedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *PendingActivityInfo) Equals(rhs *PendingActivityInfo) bool {
+func (v *MarkerRecordedEventAttributes) Equals(rhs *MarkerRecordedEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
-		return false
-	}
-	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
-		return false
-	}
-	if !_PendingActivityState_EqualsPtr(v.State, rhs.State) {
-		return false
-	}
-	if !((v.HeartbeatDetails == nil && rhs.HeartbeatDetails == nil) || (v.HeartbeatDetails != nil && rhs.HeartbeatDetails != nil && bytes.Equal(v.HeartbeatDetails, rhs.HeartbeatDetails))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.LastHeartbeatTimestamp, rhs.LastHeartbeatTimestamp) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.LastStartedTimestamp, rhs.LastStartedTimestamp) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumAttempts, rhs.MaximumAttempts) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
+	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
 		return false
 	}
-	if !_String_EqualsPtr(v.LastFailureReason, rhs.LastFailureReason) {
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.LastWorkerIdentity, rhs.LastWorkerIdentity) {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	if !((v.LastFailureDetails == nil && rhs.LastFailureDetails == nil) || (v.LastFailureDetails != nil && rhs.LastFailureDetails != nil && bytes.Equal(v.LastFailureDetails, rhs.LastFailureDetails))) {
+	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
 
@@ -51526,432 +54998,476 @@ func (v *PendingActivityInfo) Equals(rhs *PendingActivityInfo) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PendingActivityInfo.
-func (v *PendingActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MarkerRecordedEventAttributes.
+func (v *MarkerRecordedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ActivityID != nil {
-		enc.AddString("activityID", *v.ActivityID)
-	}
-	if v.ActivityType != nil {
-		err = multierr.Append(err, enc.AddObject("activityType", v.ActivityType))
-	}
-	if v.State != nil {
-		err = multierr.Append(err, enc.AddObject("state", *v.State))
-	}
-	if v.HeartbeatDetails != nil {
-		enc.AddString("heartbeatDetails", base64.StdEncoding.EncodeToString(v.HeartbeatDetails))
-	}
-	if v.LastHeartbeatTimestamp != nil {
-		enc.AddInt64("lastHeartbeatTimestamp", *v.LastHeartbeatTimestamp)
-	}
-	if v.LastStartedTimestamp != nil {
-		enc.AddInt64("lastStartedTimestamp", *v.LastStartedTimestamp)
-	}
-	if v.Attempt != nil {
-		enc.AddInt32("attempt", *v.Attempt)
-	}
-	if v.MaximumAttempts != nil {
-		enc.AddInt32("maximumAttempts", *v.MaximumAttempts)
-	}
-	if v.ScheduledTimestamp != nil {
-		enc.AddInt64("scheduledTimestamp", *v.ScheduledTimestamp)
-	}
-	if v.ExpirationTimestamp != nil {
-		enc.AddInt64("expirationTimestamp", *v.ExpirationTimestamp)
+	if v.MarkerName != nil {
+		enc.AddString("markerName", *v.MarkerName)
 	}
-	if v.LastFailureReason != nil {
-		enc.AddString("lastFailureReason", *v.LastFailureReason)
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
 	}
-	if v.LastWorkerIdentity != nil {
-		enc.AddString("lastWorkerIdentity", *v.LastWorkerIdentity)
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
-	if v.LastFailureDetails != nil {
-		enc.AddString("lastFailureDetails", base64.StdEncoding.EncodeToString(v.LastFailureDetails))
+	if v.Header != nil {
+		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
 	return err
 }
 
-// GetActivityID returns the value of ActivityID if it is set or its
+// GetMarkerName returns the value of MarkerName if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetActivityID() (o string) {
-	if v != nil && v.ActivityID != nil {
-		return *v.ActivityID
+func (v *MarkerRecordedEventAttributes) GetMarkerName() (o string) {
+	if v != nil && v.MarkerName != nil {
+		return *v.MarkerName
 	}
 
 	return
 }
 
-// IsSetActivityID returns true if ActivityID is not nil.
-func (v *PendingActivityInfo) IsSetActivityID() bool {
-	return v != nil && v.ActivityID != nil
+// IsSetMarkerName returns true if MarkerName is not nil.
+func (v *MarkerRecordedEventAttributes) IsSetMarkerName() bool {
+	return v != nil && v.MarkerName != nil
 }
 
-// GetActivityType returns the value of ActivityType if it is set or its
+// GetDetails returns the value of Details if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetActivityType() (o *ActivityType) {
-	if v != nil && v.ActivityType != nil {
-		return v.ActivityType
+func (v *MarkerRecordedEventAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
 	}
 
 	return
 }
 
-// IsSetActivityType returns true if ActivityType is not nil.
-func (v *PendingActivityInfo) IsSetActivityType() bool {
-	return v != nil && v.ActivityType != nil
+// IsSetDetails returns true if Details is not nil.
+func (v *MarkerRecordedEventAttributes) IsSetDetails() bool {
+	return v != nil && v.Details != nil
 }
 
-// GetState returns the value of State if it is set or its
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetState() (o PendingActivityState) {
-	if v != nil && v.State != nil {
-		return *v.State
+func (v *MarkerRecordedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
 	}
 
 	return
 }
 
-// IsSetState returns true if State is not nil.
-func (v *PendingActivityInfo) IsSetState() bool {
-	return v != nil && v.State != nil
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *MarkerRecordedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
 }
 
-// GetHeartbeatDetails returns the value of HeartbeatDetails if it is set or its
+// GetHeader returns the value of Header if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetHeartbeatDetails() (o []byte) {
-	if v != nil && v.HeartbeatDetails != nil {
-		return v.HeartbeatDetails
+func (v *MarkerRecordedEventAttributes) GetHeader() (o *Header) {
+	if v != nil && v.Header != nil {
+		return v.Header
 	}
 
 	return
 }
 
-// IsSetHeartbeatDetails returns true if HeartbeatDetails is not nil.
-func (v *PendingActivityInfo) IsSetHeartbeatDetails() bool {
-	return v != nil && v.HeartbeatDetails != nil
+// IsSetHeader returns true if Header is not nil.
+func (v *MarkerRecordedEventAttributes) IsSetHeader() bool {
+	return v != nil && v.Header != nil
 }
 
-// GetLastHeartbeatTimestamp returns the value of LastHeartbeatTimestamp if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetLastHeartbeatTimestamp() (o int64) {
-	if v != nil && v.LastHeartbeatTimestamp != nil {
-		return *v.LastHeartbeatTimestamp
-	}
-
-	return
+type Memo struct {
+	Fields map[string][]byte `json:"fields,omitempty"`
 }
 
-// IsSetLastHeartbeatTimestamp returns true if LastHeartbeatTimestamp is not nil.
-func (v *PendingActivityInfo) IsSetLastHeartbeatTimestamp() bool {
-	return v != nil && v.LastHeartbeatTimestamp != nil
-}
+// ToWire translates a Memo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Memo) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// GetLastStartedTimestamp returns the value of LastStartedTimestamp if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetLastStartedTimestamp() (o int64) {
-	if v != nil && v.LastStartedTimestamp != nil {
-		return *v.LastStartedTimestamp
+	if v.Fields != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Fields)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
 
-	return
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// IsSetLastStartedTimestamp returns true if LastStartedTimestamp is not nil.
-func (v *PendingActivityInfo) IsSetLastStartedTimestamp() bool {
-	return v != nil && v.LastStartedTimestamp != nil
-}
+// FromWire deserializes a Memo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Memo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Memo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Memo) FromWire(w wire.Value) error {
+	var err error
 
-// GetAttempt returns the value of Attempt if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetAttempt() (o int32) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.Fields, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
 
-	return
+	return nil
 }
 
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *PendingActivityInfo) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
-}
+// Encode serializes a Memo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Memo struct could not be encoded.
+func (v *Memo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
 
-// GetMaximumAttempts returns the value of MaximumAttempts if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetMaximumAttempts() (o int32) {
-	if v != nil && v.MaximumAttempts != nil {
-		return *v.MaximumAttempts
+	if v.Fields != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Binary_Encode(v.Fields, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	return
+	return sw.WriteStructEnd()
 }
 
-// IsSetMaximumAttempts returns true if MaximumAttempts is not nil.
-func (v *PendingActivityInfo) IsSetMaximumAttempts() bool {
-	return v != nil && v.MaximumAttempts != nil
-}
+// Decode deserializes a Memo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Memo struct could not be generated from the wire
+// representation.
+func (v *Memo) Decode(sr stream.Reader) error {
 
-// GetScheduledTimestamp returns the value of ScheduledTimestamp if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetScheduledTimestamp() (o int64) {
-	if v != nil && v.ScheduledTimestamp != nil {
-		return *v.ScheduledTimestamp
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	return
-}
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
 
-// IsSetScheduledTimestamp returns true if ScheduledTimestamp is not nil.
-func (v *PendingActivityInfo) IsSetScheduledTimestamp() bool {
-	return v != nil && v.ScheduledTimestamp != nil
-}
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TMap:
+			v.Fields, err = _Map_String_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
 
-// GetExpirationTimestamp returns the value of ExpirationTimestamp if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetExpirationTimestamp() (o int64) {
-	if v != nil && v.ExpirationTimestamp != nil {
-		return *v.ExpirationTimestamp
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
 
-	return
-}
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
 
-// IsSetExpirationTimestamp returns true if ExpirationTimestamp is not nil.
-func (v *PendingActivityInfo) IsSetExpirationTimestamp() bool {
-	return v != nil && v.ExpirationTimestamp != nil
+	return nil
 }
 
-// GetLastFailureReason returns the value of LastFailureReason if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetLastFailureReason() (o string) {
-	if v != nil && v.LastFailureReason != nil {
-		return *v.LastFailureReason
+// String returns a readable string representation of a Memo
+// struct.
+func (v *Memo) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
-}
+	var fields [1]string
+	i := 0
+	if v.Fields != nil {
+		fields[i] = fmt.Sprintf("Fields: %v", v.Fields)
+		i++
+	}
 
-// IsSetLastFailureReason returns true if LastFailureReason is not nil.
-func (v *PendingActivityInfo) IsSetLastFailureReason() bool {
-	return v != nil && v.LastFailureReason != nil
+	return fmt.Sprintf("Memo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetLastWorkerIdentity returns the value of LastWorkerIdentity if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetLastWorkerIdentity() (o string) {
-	if v != nil && v.LastWorkerIdentity != nil {
-		return *v.LastWorkerIdentity
+// Equals returns true if all the fields of this Memo match the
+// provided Memo.
+//
+// This function performs a deep comparison.
+func (v *Memo) Equals(rhs *Memo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Fields == nil && rhs.Fields == nil) || (v.Fields != nil && rhs.Fields != nil && _Map_String_Binary_Equals(v.Fields, rhs.Fields))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetLastWorkerIdentity returns true if LastWorkerIdentity is not nil.
-func (v *PendingActivityInfo) IsSetLastWorkerIdentity() bool {
-	return v != nil && v.LastWorkerIdentity != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Memo.
+func (v *Memo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Fields != nil {
+		err = multierr.Append(err, enc.AddObject("fields", (_Map_String_Binary_Zapper)(v.Fields)))
+	}
+	return err
 }
 
-// GetLastFailureDetails returns the value of LastFailureDetails if it is set or its
+// GetFields returns the value of Fields if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetLastFailureDetails() (o []byte) {
-	if v != nil && v.LastFailureDetails != nil {
-		return v.LastFailureDetails
+func (v *Memo) GetFields() (o map[string][]byte) {
+	if v != nil && v.Fields != nil {
+		return v.Fields
 	}
 
 	return
 }
 
-// IsSetLastFailureDetails returns true if LastFailureDetails is not nil.
-func (v *PendingActivityInfo) IsSetLastFailureDetails() bool {
-	return v != nil && v.LastFailureDetails != nil
+// IsSetFields returns true if Fields is not nil.
+func (v *Memo) IsSetFields() bool {
+	return v != nil && v.Fields != nil
 }
 
-type PendingActivityState int32
+type ParentClosePolicy int32
 
 const (
-	PendingActivityStateScheduled       PendingActivityState = 0
-	PendingActivityStateStarted         PendingActivityState = 1
-	PendingActivityStateCancelRequested PendingActivityState = 2
+	ParentClosePolicyAbandon       ParentClosePolicy = 0
+	ParentClosePolicyRequestCancel ParentClosePolicy = 1
+	ParentClosePolicyTerminate     ParentClosePolicy = 2
 )
 
-// PendingActivityState_Values returns all recognized values of PendingActivityState.
-func PendingActivityState_Values() []PendingActivityState {
-	return []PendingActivityState{
-		PendingActivityStateScheduled,
-		PendingActivityStateStarted,
-		PendingActivityStateCancelRequested,
+// ParentClosePolicy_Values returns all recognized values of ParentClosePolicy.
+func ParentClosePolicy_Values() []ParentClosePolicy {
+	return []ParentClosePolicy{
+		ParentClosePolicyAbandon,
+		ParentClosePolicyRequestCancel,
+		ParentClosePolicyTerminate,
 	}
 }
 
-// UnmarshalText tries to decode PendingActivityState from a byte slice
+// UnmarshalText tries to decode ParentClosePolicy from a byte slice
 // containing its name.
 //
-//   var v PendingActivityState
-//   err := v.UnmarshalText([]byte("SCHEDULED"))
-func (v *PendingActivityState) UnmarshalText(value []byte) error {
+//   var v ParentClosePolicy
+//   err := v.UnmarshalText([]byte("ABANDON"))
+func (v *ParentClosePolicy) UnmarshalText(value []byte) error {
 	switch s := string(value); s {
-	case "SCHEDULED":
-		*v = PendingActivityStateScheduled
+	case "ABANDON":
+		*v = ParentClosePolicyAbandon
 		return nil
-	case "STARTED":
-		*v = PendingActivityStateStarted
+	case "REQUEST_CANCEL":
+		*v = ParentClosePolicyRequestCancel
 		return nil
-	case "CANCEL_REQUESTED":
-		*v = PendingActivityStateCancelRequested
+	case "TERMINATE":
+		*v = ParentClosePolicyTerminate
 		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "PendingActivityState", err)
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ParentClosePolicy", err)
 		}
-		*v = PendingActivityState(val)
+		*v = ParentClosePolicy(val)
 		return nil
 	}
 }
 
-// MarshalText encodes PendingActivityState to text.
+// MarshalText encodes ParentClosePolicy to text.
 //
 // If the enum value is recognized, its name is returned.
 // Otherwise, its integer value is returned.
 //
 // This implements the TextMarshaler interface.
-func (v PendingActivityState) MarshalText() ([]byte, error) {
+func (v ParentClosePolicy) MarshalText() ([]byte, error) {
 	switch int32(v) {
 	case 0:
-		return []byte("SCHEDULED"), nil
+		return []byte("ABANDON"), nil
 	case 1:
-		return []byte("STARTED"), nil
+		return []byte("REQUEST_CANCEL"), nil
 	case 2:
-		return []byte("CANCEL_REQUESTED"), nil
+		return []byte("TERMINATE"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PendingActivityState.
+// fast logging of ParentClosePolicy.
 // Enums are logged as objects, where the value is logged with key "value", and
 // if this value's name is known, the name is logged with key "name".
-func (v PendingActivityState) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+func (v ParentClosePolicy) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddInt32("value", int32(v))
 	switch int32(v) {
 	case 0:
-		enc.AddString("name", "SCHEDULED")
+		enc.AddString("name", "ABANDON")
 	case 1:
-		enc.AddString("name", "STARTED")
+		enc.AddString("name", "REQUEST_CANCEL")
 	case 2:
-		enc.AddString("name", "CANCEL_REQUESTED")
+		enc.AddString("name", "TERMINATE")
 	}
 	return nil
 }
 
 // Ptr returns a pointer to this enum value.
-func (v PendingActivityState) Ptr() *PendingActivityState {
+func (v ParentClosePolicy) Ptr() *ParentClosePolicy {
 	return &v
 }
 
-// Encode encodes PendingActivityState directly to bytes.
+// Encode encodes ParentClosePolicy directly to bytes.
 //
 //   sWriter := BinaryStreamer.Writer(writer)
 //
-//   var v PendingActivityState
+//   var v ParentClosePolicy
 //   return v.Encode(sWriter)
-func (v PendingActivityState) Encode(sw stream.Writer) error {
+func (v ParentClosePolicy) Encode(sw stream.Writer) error {
 	return sw.WriteInt32(int32(v))
 }
 
-// ToWire translates PendingActivityState into a Thrift-level intermediate
+// ToWire translates ParentClosePolicy into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // Enums are represented as 32-bit integers over the wire.
-func (v PendingActivityState) ToWire() (wire.Value, error) {
+func (v ParentClosePolicy) ToWire() (wire.Value, error) {
 	return wire.NewValueI32(int32(v)), nil
 }
 
-// FromWire deserializes PendingActivityState from its Thrift-level
+// FromWire deserializes ParentClosePolicy from its Thrift-level
 // representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return PendingActivityState(0), err
+//     return ParentClosePolicy(0), err
 //   }
 //
-//   var v PendingActivityState
+//   var v ParentClosePolicy
 //   if err := v.FromWire(x); err != nil {
-//     return PendingActivityState(0), err
+//     return ParentClosePolicy(0), err
 //   }
 //   return v, nil
-func (v *PendingActivityState) FromWire(w wire.Value) error {
-	*v = (PendingActivityState)(w.GetI32())
+func (v *ParentClosePolicy) FromWire(w wire.Value) error {
+	*v = (ParentClosePolicy)(w.GetI32())
 	return nil
 }
 
-// Decode reads off the encoded PendingActivityState directly off of the wire.
+// Decode reads off the encoded ParentClosePolicy directly off of the wire.
 //
 //   sReader := BinaryStreamer.Reader(reader)
 //
-//   var v PendingActivityState
+//   var v ParentClosePolicy
 //   if err := v.Decode(sReader); err != nil {
-//     return PendingActivityState(0), err
+//     return ParentClosePolicy(0), err
 //   }
 //   return v, nil
-func (v *PendingActivityState) Decode(sr stream.Reader) error {
+func (v *ParentClosePolicy) Decode(sr stream.Reader) error {
 	i, err := sr.ReadInt32()
 	if err != nil {
 		return err
 	}
-	*v = (PendingActivityState)(i)
+	*v = (ParentClosePolicy)(i)
 	return nil
 }
 
-// String returns a readable string representation of PendingActivityState.
-func (v PendingActivityState) String() string {
+// String returns a readable string representation of ParentClosePolicy.
+func (v ParentClosePolicy) String() string {
 	w := int32(v)
 	switch w {
 	case 0:
-		return "SCHEDULED"
+		return "ABANDON"
 	case 1:
-		return "STARTED"
+		return "REQUEST_CANCEL"
 	case 2:
-		return "CANCEL_REQUESTED"
+		return "TERMINATE"
 	}
-	return fmt.Sprintf("PendingActivityState(%d)", w)
+	return fmt.Sprintf("ParentClosePolicy(%d)", w)
 }
 
-// Equals returns true if this PendingActivityState value matches the provided
+// Equals returns true if this ParentClosePolicy value matches the provided
 // value.
-func (v PendingActivityState) Equals(rhs PendingActivityState) bool {
+func (v ParentClosePolicy) Equals(rhs ParentClosePolicy) bool {
 	return v == rhs
 }
 
-// MarshalJSON serializes PendingActivityState into JSON.
+// MarshalJSON serializes ParentClosePolicy into JSON.
 //
 // If the enum value is recognized, its name is returned.
 // Otherwise, its integer value is returned.
 //
 // This implements json.Marshaler.
-func (v PendingActivityState) MarshalJSON() ([]byte, error) {
+func (v ParentClosePolicy) MarshalJSON() ([]byte, error) {
 	switch int32(v) {
 	case 0:
-		return ([]byte)("\"SCHEDULED\""), nil
+		return ([]byte)("\"ABANDON\""), nil
 	case 1:
-		return ([]byte)("\"STARTED\""), nil
+		return ([]byte)("\"REQUEST_CANCEL\""), nil
 	case 2:
-		return ([]byte)("\"CANCEL_REQUESTED\""), nil
+		return ([]byte)("\"TERMINATE\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// UnmarshalJSON attempts to decode PendingActivityState from its JSON
+// UnmarshalJSON attempts to decode ParentClosePolicy from its JSON
 // representation.
 //
 // This implementation supports both, numeric and string inputs. If a
 // string is provided, it must be a known enum name.
 //
 // This implements json.Unmarshaler.
-func (v *PendingActivityState) UnmarshalJSON(text []byte) error {
+func (v *ParentClosePolicy) UnmarshalJSON(text []byte) error {
 	d := json.NewDecoder(bytes.NewReader(text))
 	d.UseNumber()
 	t, err := d.Token()
@@ -51966,29 +55482,37 @@ func (v *PendingActivityState) UnmarshalJSON(text []byte) error {
 			return err
 		}
 		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "PendingActivityState")
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ParentClosePolicy")
 		}
 		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "PendingActivityState")
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ParentClosePolicy")
 		}
-		*v = (PendingActivityState)(x)
+		*v = (ParentClosePolicy)(x)
 		return nil
 	case string:
 		return v.UnmarshalText([]byte(w))
 	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "PendingActivityState")
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ParentClosePolicy")
 	}
 }
 
-type PendingChildExecutionInfo struct {
-	WorkflowID        *string            `json:"workflowID,omitempty"`
-	RunID             *string            `json:"runID,omitempty"`
-	WorkflowTypName   *string            `json:"workflowTypName,omitempty"`
-	InitiatedID       *int64             `json:"initiatedID,omitempty"`
-	ParentClosePolicy *ParentClosePolicy `json:"parentClosePolicy,omitempty"`
+type PendingActivityInfo struct {
+	ActivityID             *string               `json:"activityID,omitempty"`
+	ActivityType           *ActivityType         `json:"activityType,omitempty"`
+	State                  *PendingActivityState `json:"state,omitempty"`
+	HeartbeatDetails       []byte                `json:"heartbeatDetails,omitempty"`
+	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
+	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
+	Attempt                *int32                `json:"attempt,omitempty"`
+	MaximumAttempts        *int32                `json:"maximumAttempts,omitempty"`
+	ScheduledTimestamp     *int64                `json:"scheduledTimestamp,omitempty"`
+	ExpirationTimestamp    *int64                `json:"expirationTimestamp,omitempty"`
+	LastFailureReason      *string               `json:"lastFailureReason,omitempty"`
+	LastWorkerIdentity     *string               `json:"lastWorkerIdentity,omitempty"`
+	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
 }
 
-// ToWire translates a PendingChildExecutionInfo struct into a Thrift-level intermediate
+// ToWire translates a PendingActivityInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -52003,63 +55527,133 @@ type PendingChildExecutionInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PendingChildExecutionInfo) ToWire() (wire.Value, error) {
+func (v *PendingActivityInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [13]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.WorkflowID != nil {
-		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+	if v.ActivityID != nil {
+		w, err = wire.NewValueString(*(v.ActivityID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.RunID != nil {
-		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+	if v.ActivityType != nil {
+		w, err = v.ActivityType.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowTypName != nil {
-		w, err = wire.NewValueString(*(v.WorkflowTypName)), error(nil)
+	if v.State != nil {
+		w, err = v.State.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.InitiatedID != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedID)), error(nil)
+	if v.HeartbeatDetails != nil {
+		w, err = wire.NewValueBinary(v.HeartbeatDetails), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.ParentClosePolicy != nil {
-		w, err = v.ParentClosePolicy.ToWire()
+	if v.LastHeartbeatTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastHeartbeatTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.LastStartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastStartedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.MaximumAttempts != nil {
+		w, err = wire.NewValueI32(*(v.MaximumAttempts)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.LastFailureReason != nil {
+		w, err = wire.NewValueString(*(v.LastFailureReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.LastWorkerIdentity != nil {
+		w, err = wire.NewValueString(*(v.LastWorkerIdentity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.LastFailureDetails != nil {
+		w, err = wire.NewValueBinary(v.LastFailureDetails), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a PendingChildExecutionInfo struct from its Thrift-level
+func _PendingActivityState_Read(w wire.Value) (PendingActivityState, error) {
+	var v PendingActivityState
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a PendingActivityInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PendingChildExecutionInfo struct
+// An error is returned if we were unable to build a PendingActivityInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -52067,12 +55661,12 @@ func (v *PendingChildExecutionInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v PendingChildExecutionInfo
+//   var v PendingActivityInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PendingChildExecutionInfo) FromWire(w wire.Value) error {
+func (v *PendingActivityInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -52081,47 +55675,121 @@ func (v *PendingChildExecutionInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowID = &x
+				v.ActivityID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunID = &x
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityType, err = _ActivityType_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowTypName = &x
+			if field.Value.Type() == wire.TI32 {
+				var x PendingActivityState
+				x, err = _PendingActivityState_Read(field.Value)
+				v.State = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.HeartbeatDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedID = &x
+				v.LastHeartbeatTimestamp = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastStartedTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
 			if field.Value.Type() == wire.TI32 {
-				var x ParentClosePolicy
-				x, err = _ParentClosePolicy_Read(field.Value)
-				v.ParentClosePolicy = &x
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumAttempts = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpirationTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LastFailureReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LastWorkerIdentity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TBinary {
+				v.LastFailureDetails, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -52133,20 +55801,20 @@ func (v *PendingChildExecutionInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a PendingChildExecutionInfo struct directly into bytes, without going
+// Encode serializes a PendingActivityInfo struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a PendingChildExecutionInfo struct could not be encoded.
-func (v *PendingChildExecutionInfo) Encode(sw stream.Writer) error {
+// An error is returned if a PendingActivityInfo struct could not be encoded.
+func (v *PendingActivityInfo) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.WorkflowID != nil {
+	if v.ActivityID != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.WorkflowID)); err != nil {
+		if err := sw.WriteString(*(v.ActivityID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -52154,11 +55822,11 @@ func (v *PendingChildExecutionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RunID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.ActivityType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RunID)); err != nil {
+		if err := v.ActivityType.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -52166,11 +55834,11 @@ func (v *PendingChildExecutionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.WorkflowTypName != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.State != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.WorkflowTypName)); err != nil {
+		if err := v.State.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -52178,11 +55846,11 @@ func (v *PendingChildExecutionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.InitiatedID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+	if v.HeartbeatDetails != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.InitiatedID)); err != nil {
+		if err := sw.WriteBinary(v.HeartbeatDetails); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -52190,11 +55858,107 @@ func (v *PendingChildExecutionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ParentClosePolicy != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
-			return err
+	if v.LastHeartbeatTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
 		}
-		if err := v.ParentClosePolicy.Encode(sw); err != nil {
+		if err := sw.WriteInt64(*(v.LastHeartbeatTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastStartedTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastStartedTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Attempt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Attempt)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MaximumAttempts != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.MaximumAttempts)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduledTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ScheduledTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ExpirationTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ExpirationTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastFailureReason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.LastFailureReason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastWorkerIdentity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.LastWorkerIdentity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastFailureDetails != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.LastFailureDetails); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -52205,12 +55969,18 @@ func (v *PendingChildExecutionInfo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a PendingChildExecutionInfo struct directly from its Thrift-level
+func _PendingActivityState_Decode(sr stream.Reader) (PendingActivityState, error) {
+	var v PendingActivityState
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a PendingActivityInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a PendingChildExecutionInfo struct could not be generated from the wire
+// An error is returned if a PendingActivityInfo struct could not be generated from the wire
 // representation.
-func (v *PendingChildExecutionInfo) Decode(sr stream.Reader) error {
+func (v *PendingActivityInfo) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -52226,39 +55996,97 @@ func (v *PendingChildExecutionInfo) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.WorkflowID = &x
+			v.ActivityID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RunID = &x
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.ActivityType, err = _ActivityType_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.WorkflowTypName = &x
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x PendingActivityState
+			x, err = _PendingActivityState_Decode(sr)
+			v.State = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TI64:
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			v.HeartbeatDetails, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.InitiatedID = &x
+			v.LastHeartbeatTimestamp = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TI32:
-			var x ParentClosePolicy
-			x, err = _ParentClosePolicy_Decode(sr)
-			v.ParentClosePolicy = &x
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastStartedTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Attempt = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MaximumAttempts = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ScheduledTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 100 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ExpirationTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.LastFailureReason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 120 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.LastWorkerIdentity = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 130 && fh.Type == wire.TBinary:
+			v.LastFailureDetails, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
@@ -52285,62 +56113,128 @@ func (v *PendingChildExecutionInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a PendingChildExecutionInfo
+// String returns a readable string representation of a PendingActivityInfo
 // struct.
-func (v *PendingChildExecutionInfo) String() string {
+func (v *PendingActivityInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [13]string
 	i := 0
-	if v.WorkflowID != nil {
-		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+	if v.ActivityID != nil {
+		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
 		i++
 	}
-	if v.RunID != nil {
-		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+	if v.ActivityType != nil {
+		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
 		i++
 	}
-	if v.WorkflowTypName != nil {
-		fields[i] = fmt.Sprintf("WorkflowTypName: %v", *(v.WorkflowTypName))
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
 		i++
 	}
-	if v.InitiatedID != nil {
-		fields[i] = fmt.Sprintf("InitiatedID: %v", *(v.InitiatedID))
+	if v.HeartbeatDetails != nil {
+		fields[i] = fmt.Sprintf("HeartbeatDetails: %v", v.HeartbeatDetails)
 		i++
 	}
-	if v.ParentClosePolicy != nil {
-		fields[i] = fmt.Sprintf("ParentClosePolicy: %v", *(v.ParentClosePolicy))
+	if v.LastHeartbeatTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastHeartbeatTimestamp: %v", *(v.LastHeartbeatTimestamp))
+		i++
+	}
+	if v.LastStartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastStartedTimestamp: %v", *(v.LastStartedTimestamp))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.MaximumAttempts != nil {
+		fields[i] = fmt.Sprintf("MaximumAttempts: %v", *(v.MaximumAttempts))
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
+		i++
+	}
+	if v.LastFailureReason != nil {
+		fields[i] = fmt.Sprintf("LastFailureReason: %v", *(v.LastFailureReason))
+		i++
+	}
+	if v.LastWorkerIdentity != nil {
+		fields[i] = fmt.Sprintf("LastWorkerIdentity: %v", *(v.LastWorkerIdentity))
+		i++
+	}
+	if v.LastFailureDetails != nil {
+		fields[i] = fmt.Sprintf("LastFailureDetails: %v", v.LastFailureDetails)
 		i++
 	}
 
-	return fmt.Sprintf("PendingChildExecutionInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("PendingActivityInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PendingChildExecutionInfo match the
-// provided PendingChildExecutionInfo.
+func _PendingActivityState_EqualsPtr(lhs, rhs *PendingActivityState) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this PendingActivityInfo match the
+// provided PendingActivityInfo.
 //
 // This function performs a deep comparison.
-func (v *PendingChildExecutionInfo) Equals(rhs *PendingChildExecutionInfo) bool {
+func (v *PendingActivityInfo) Equals(rhs *PendingActivityInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.WorkflowTypName, rhs.WorkflowTypName) {
+	if !_PendingActivityState_EqualsPtr(v.State, rhs.State) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.InitiatedID, rhs.InitiatedID) {
+	if !((v.HeartbeatDetails == nil && rhs.HeartbeatDetails == nil) || (v.HeartbeatDetails != nil && rhs.HeartbeatDetails != nil && bytes.Equal(v.HeartbeatDetails, rhs.HeartbeatDetails))) {
 		return false
 	}
-	if !_ParentClosePolicy_EqualsPtr(v.ParentClosePolicy, rhs.ParentClosePolicy) {
+	if !_I64_EqualsPtr(v.LastHeartbeatTimestamp, rhs.LastHeartbeatTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastStartedTimestamp, rhs.LastStartedTimestamp) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumAttempts, rhs.MaximumAttempts) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LastFailureReason, rhs.LastFailureReason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LastWorkerIdentity, rhs.LastWorkerIdentity) {
+		return false
+	}
+	if !((v.LastFailureDetails == nil && rhs.LastFailureDetails == nil) || (v.LastFailureDetails != nil && rhs.LastFailureDetails != nil && bytes.Equal(v.LastFailureDetails, rhs.LastFailureDetails))) {
 		return false
 	}
 
@@ -52348,169 +56242,525 @@ func (v *PendingChildExecutionInfo) Equals(rhs *PendingChildExecutionInfo) bool
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PendingChildExecutionInfo.
-func (v *PendingChildExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PendingActivityInfo.
+func (v *PendingActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.WorkflowID != nil {
-		enc.AddString("workflowID", *v.WorkflowID)
+	if v.ActivityID != nil {
+		enc.AddString("activityID", *v.ActivityID)
 	}
-	if v.RunID != nil {
-		enc.AddString("runID", *v.RunID)
+	if v.ActivityType != nil {
+		err = multierr.Append(err, enc.AddObject("activityType", v.ActivityType))
 	}
-	if v.WorkflowTypName != nil {
-		enc.AddString("workflowTypName", *v.WorkflowTypName)
+	if v.State != nil {
+		err = multierr.Append(err, enc.AddObject("state", *v.State))
 	}
-	if v.InitiatedID != nil {
-		enc.AddInt64("initiatedID", *v.InitiatedID)
+	if v.HeartbeatDetails != nil {
+		enc.AddString("heartbeatDetails", base64.StdEncoding.EncodeToString(v.HeartbeatDetails))
 	}
-	if v.ParentClosePolicy != nil {
-		err = multierr.Append(err, enc.AddObject("parentClosePolicy", *v.ParentClosePolicy))
+	if v.LastHeartbeatTimestamp != nil {
+		enc.AddInt64("lastHeartbeatTimestamp", *v.LastHeartbeatTimestamp)
+	}
+	if v.LastStartedTimestamp != nil {
+		enc.AddInt64("lastStartedTimestamp", *v.LastStartedTimestamp)
+	}
+	if v.Attempt != nil {
+		enc.AddInt32("attempt", *v.Attempt)
+	}
+	if v.MaximumAttempts != nil {
+		enc.AddInt32("maximumAttempts", *v.MaximumAttempts)
+	}
+	if v.ScheduledTimestamp != nil {
+		enc.AddInt64("scheduledTimestamp", *v.ScheduledTimestamp)
+	}
+	if v.ExpirationTimestamp != nil {
+		enc.AddInt64("expirationTimestamp", *v.ExpirationTimestamp)
+	}
+	if v.LastFailureReason != nil {
+		enc.AddString("lastFailureReason", *v.LastFailureReason)
+	}
+	if v.LastWorkerIdentity != nil {
+		enc.AddString("lastWorkerIdentity", *v.LastWorkerIdentity)
+	}
+	if v.LastFailureDetails != nil {
+		enc.AddString("lastFailureDetails", base64.StdEncoding.EncodeToString(v.LastFailureDetails))
 	}
 	return err
 }
 
-// GetWorkflowID returns the value of WorkflowID if it is set or its
+// GetActivityID returns the value of ActivityID if it is set or its
 // zero value if it is unset.
-func (v *PendingChildExecutionInfo) GetWorkflowID() (o string) {
-	if v != nil && v.WorkflowID != nil {
-		return *v.WorkflowID
+func (v *PendingActivityInfo) GetActivityID() (o string) {
+	if v != nil && v.ActivityID != nil {
+		return *v.ActivityID
 	}
 
 	return
 }
 
-// IsSetWorkflowID returns true if WorkflowID is not nil.
-func (v *PendingChildExecutionInfo) IsSetWorkflowID() bool {
-	return v != nil && v.WorkflowID != nil
+// IsSetActivityID returns true if ActivityID is not nil.
+func (v *PendingActivityInfo) IsSetActivityID() bool {
+	return v != nil && v.ActivityID != nil
 }
 
-// GetRunID returns the value of RunID if it is set or its
+// GetActivityType returns the value of ActivityType if it is set or its
 // zero value if it is unset.
-func (v *PendingChildExecutionInfo) GetRunID() (o string) {
-	if v != nil && v.RunID != nil {
-		return *v.RunID
+func (v *PendingActivityInfo) GetActivityType() (o *ActivityType) {
+	if v != nil && v.ActivityType != nil {
+		return v.ActivityType
 	}
 
 	return
 }
 
-// IsSetRunID returns true if RunID is not nil.
-func (v *PendingChildExecutionInfo) IsSetRunID() bool {
-	return v != nil && v.RunID != nil
+// IsSetActivityType returns true if ActivityType is not nil.
+func (v *PendingActivityInfo) IsSetActivityType() bool {
+	return v != nil && v.ActivityType != nil
 }
 
-// GetWorkflowTypName returns the value of WorkflowTypName if it is set or its
+// GetState returns the value of State if it is set or its
 // zero value if it is unset.
-func (v *PendingChildExecutionInfo) GetWorkflowTypName() (o string) {
-	if v != nil && v.WorkflowTypName != nil {
-		return *v.WorkflowTypName
+func (v *PendingActivityInfo) GetState() (o PendingActivityState) {
+	if v != nil && v.State != nil {
+		return *v.State
 	}
 
 	return
 }
 
-// IsSetWorkflowTypName returns true if WorkflowTypName is not nil.
-func (v *PendingChildExecutionInfo) IsSetWorkflowTypName() bool {
-	return v != nil && v.WorkflowTypName != nil
+// IsSetState returns true if State is not nil.
+func (v *PendingActivityInfo) IsSetState() bool {
+	return v != nil && v.State != nil
 }
 
-// GetInitiatedID returns the value of InitiatedID if it is set or its
+// GetHeartbeatDetails returns the value of HeartbeatDetails if it is set or its
 // zero value if it is unset.
-func (v *PendingChildExecutionInfo) GetInitiatedID() (o int64) {
-	if v != nil && v.InitiatedID != nil {
-		return *v.InitiatedID
+func (v *PendingActivityInfo) GetHeartbeatDetails() (o []byte) {
+	if v != nil && v.HeartbeatDetails != nil {
+		return v.HeartbeatDetails
 	}
 
 	return
 }
 
-// IsSetInitiatedID returns true if InitiatedID is not nil.
-func (v *PendingChildExecutionInfo) IsSetInitiatedID() bool {
-	return v != nil && v.InitiatedID != nil
+// IsSetHeartbeatDetails returns true if HeartbeatDetails is not nil.
+func (v *PendingActivityInfo) IsSetHeartbeatDetails() bool {
+	return v != nil && v.HeartbeatDetails != nil
 }
 
-// GetParentClosePolicy returns the value of ParentClosePolicy if it is set or its
+// GetLastHeartbeatTimestamp returns the value of LastHeartbeatTimestamp if it is set or its
 // zero value if it is unset.
-func (v *PendingChildExecutionInfo) GetParentClosePolicy() (o ParentClosePolicy) {
-	if v != nil && v.ParentClosePolicy != nil {
-		return *v.ParentClosePolicy
+func (v *PendingActivityInfo) GetLastHeartbeatTimestamp() (o int64) {
+	if v != nil && v.LastHeartbeatTimestamp != nil {
+		return *v.LastHeartbeatTimestamp
 	}
 
 	return
 }
 
-// IsSetParentClosePolicy returns true if ParentClosePolicy is not nil.
-func (v *PendingChildExecutionInfo) IsSetParentClosePolicy() bool {
-	return v != nil && v.ParentClosePolicy != nil
+// IsSetLastHeartbeatTimestamp returns true if LastHeartbeatTimestamp is not nil.
+func (v *PendingActivityInfo) IsSetLastHeartbeatTimestamp() bool {
+	return v != nil && v.LastHeartbeatTimestamp != nil
 }
 
-type PendingDecisionInfo struct {
-	State                      *PendingDecisionState `json:"state,omitempty"`
-	ScheduledTimestamp         *int64                `json:"scheduledTimestamp,omitempty"`
-	StartedTimestamp           *int64                `json:"startedTimestamp,omitempty"`
-	Attempt                    *int64                `json:"attempt,omitempty"`
-	OriginalScheduledTimestamp *int64                `json:"originalScheduledTimestamp,omitempty"`
+// GetLastStartedTimestamp returns the value of LastStartedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PendingActivityInfo) GetLastStartedTimestamp() (o int64) {
+	if v != nil && v.LastStartedTimestamp != nil {
+		return *v.LastStartedTimestamp
+	}
+
+	return
 }
 
-// ToWire translates a PendingDecisionInfo struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *PendingDecisionInfo) ToWire() (wire.Value, error) {
-	var (
-		fields [5]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+// IsSetLastStartedTimestamp returns true if LastStartedTimestamp is not nil.
+func (v *PendingActivityInfo) IsSetLastStartedTimestamp() bool {
+	return v != nil && v.LastStartedTimestamp != nil
+}
 
-	if v.State != nil {
-		w, err = v.State.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.ScheduledTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *PendingActivityInfo) GetAttempt() (o int32) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
 	}
-	if v.StartedTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *PendingActivityInfo) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetMaximumAttempts returns the value of MaximumAttempts if it is set or its
+// zero value if it is unset.
+func (v *PendingActivityInfo) GetMaximumAttempts() (o int32) {
+	if v != nil && v.MaximumAttempts != nil {
+		return *v.MaximumAttempts
 	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+
+	return
+}
+
+// IsSetMaximumAttempts returns true if MaximumAttempts is not nil.
+func (v *PendingActivityInfo) IsSetMaximumAttempts() bool {
+	return v != nil && v.MaximumAttempts != nil
+}
+
+// GetScheduledTimestamp returns the value of ScheduledTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PendingActivityInfo) GetScheduledTimestamp() (o int64) {
+	if v != nil && v.ScheduledTimestamp != nil {
+		return *v.ScheduledTimestamp
+	}
+
+	return
+}
+
+// IsSetScheduledTimestamp returns true if ScheduledTimestamp is not nil.
+func (v *PendingActivityInfo) IsSetScheduledTimestamp() bool {
+	return v != nil && v.ScheduledTimestamp != nil
+}
+
+// GetExpirationTimestamp returns the value of ExpirationTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PendingActivityInfo) GetExpirationTimestamp() (o int64) {
+	if v != nil && v.ExpirationTimestamp != nil {
+		return *v.ExpirationTimestamp
+	}
+
+	return
+}
+
+// IsSetExpirationTimestamp returns true if ExpirationTimestamp is not nil.
+func (v *PendingActivityInfo) IsSetExpirationTimestamp() bool {
+	return v != nil && v.ExpirationTimestamp != nil
+}
+
+// GetLastFailureReason returns the value of LastFailureReason if it is set or its
+// zero value if it is unset.
+func (v *PendingActivityInfo) GetLastFailureReason() (o string) {
+	if v != nil && v.LastFailureReason != nil {
+		return *v.LastFailureReason
+	}
+
+	return
+}
+
+// IsSetLastFailureReason returns true if LastFailureReason is not nil.
+func (v *PendingActivityInfo) IsSetLastFailureReason() bool {
+	return v != nil && v.LastFailureReason != nil
+}
+
+// GetLastWorkerIdentity returns the value of LastWorkerIdentity if it is set or its
+// zero value if it is unset.
+func (v *PendingActivityInfo) GetLastWorkerIdentity() (o string) {
+	if v != nil && v.LastWorkerIdentity != nil {
+		return *v.LastWorkerIdentity
+	}
+
+	return
+}
+
+// IsSetLastWorkerIdentity returns true if LastWorkerIdentity is not nil.
+func (v *PendingActivityInfo) IsSetLastWorkerIdentity() bool {
+	return v != nil && v.LastWorkerIdentity != nil
+}
+
+// GetLastFailureDetails returns the value of LastFailureDetails if it is set or its
+// zero value if it is unset.
+func (v *PendingActivityInfo) GetLastFailureDetails() (o []byte) {
+	if v != nil && v.LastFailureDetails != nil {
+		return v.LastFailureDetails
+	}
+
+	return
+}
+
+// IsSetLastFailureDetails returns true if LastFailureDetails is not nil.
+func (v *PendingActivityInfo) IsSetLastFailureDetails() bool {
+	return v != nil && v.LastFailureDetails != nil
+}
+
+type PendingActivityState int32
+
+const (
+	PendingActivityStateScheduled       PendingActivityState = 0
+	PendingActivityStateStarted         PendingActivityState = 1
+	PendingActivityStateCancelRequested PendingActivityState = 2
+)
+
+// PendingActivityState_Values returns all recognized values of PendingActivityState.
+func PendingActivityState_Values() []PendingActivityState {
+	return []PendingActivityState{
+		PendingActivityStateScheduled,
+		PendingActivityStateStarted,
+		PendingActivityStateCancelRequested,
+	}
+}
+
+// UnmarshalText tries to decode PendingActivityState from a byte slice
+// containing its name.
+//
+//   var v PendingActivityState
+//   err := v.UnmarshalText([]byte("SCHEDULED"))
+func (v *PendingActivityState) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "SCHEDULED":
+		*v = PendingActivityStateScheduled
+		return nil
+	case "STARTED":
+		*v = PendingActivityStateStarted
+		return nil
+	case "CANCEL_REQUESTED":
+		*v = PendingActivityStateCancelRequested
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "PendingActivityState", err)
+		}
+		*v = PendingActivityState(val)
+		return nil
+	}
+}
+
+// MarshalText encodes PendingActivityState to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v PendingActivityState) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("SCHEDULED"), nil
+	case 1:
+		return []byte("STARTED"), nil
+	case 2:
+		return []byte("CANCEL_REQUESTED"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PendingActivityState.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v PendingActivityState) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "SCHEDULED")
+	case 1:
+		enc.AddString("name", "STARTED")
+	case 2:
+		enc.AddString("name", "CANCEL_REQUESTED")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v PendingActivityState) Ptr() *PendingActivityState {
+	return &v
+}
+
+// Encode encodes PendingActivityState directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v PendingActivityState
+//   return v.Encode(sWriter)
+func (v PendingActivityState) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates PendingActivityState into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v PendingActivityState) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes PendingActivityState from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return PendingActivityState(0), err
+//   }
+//
+//   var v PendingActivityState
+//   if err := v.FromWire(x); err != nil {
+//     return PendingActivityState(0), err
+//   }
+//   return v, nil
+func (v *PendingActivityState) FromWire(w wire.Value) error {
+	*v = (PendingActivityState)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded PendingActivityState directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v PendingActivityState
+//   if err := v.Decode(sReader); err != nil {
+//     return PendingActivityState(0), err
+//   }
+//   return v, nil
+func (v *PendingActivityState) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (PendingActivityState)(i)
+	return nil
+}
+
+// String returns a readable string representation of PendingActivityState.
+func (v PendingActivityState) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "SCHEDULED"
+	case 1:
+		return "STARTED"
+	case 2:
+		return "CANCEL_REQUESTED"
+	}
+	return fmt.Sprintf("PendingActivityState(%d)", w)
+}
+
+// Equals returns true if this PendingActivityState value matches the provided
+// value.
+func (v PendingActivityState) Equals(rhs PendingActivityState) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes PendingActivityState into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v PendingActivityState) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"SCHEDULED\""), nil
+	case 1:
+		return ([]byte)("\"STARTED\""), nil
+	case 2:
+		return ([]byte)("\"CANCEL_REQUESTED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode PendingActivityState from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *PendingActivityState) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "PendingActivityState")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "PendingActivityState")
+		}
+		*v = (PendingActivityState)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "PendingActivityState")
+	}
+}
+
+type PendingChildExecutionInfo struct {
+	WorkflowID        *string            `json:"workflowID,omitempty"`
+	RunID             *string            `json:"runID,omitempty"`
+	WorkflowTypName   *string            `json:"workflowTypName,omitempty"`
+	InitiatedID       *int64             `json:"initiatedID,omitempty"`
+	ParentClosePolicy *ParentClosePolicy `json:"parentClosePolicy,omitempty"`
+}
+
+// ToWire translates a PendingChildExecutionInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PendingChildExecutionInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowTypName != nil {
+		w, err = wire.NewValueString(*(v.WorkflowTypName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.InitiatedID != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.OriginalScheduledTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.OriginalScheduledTimestamp)), error(nil)
+	if v.ParentClosePolicy != nil {
+		w, err = v.ParentClosePolicy.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -52521,17 +56771,11 @@ func (v *PendingDecisionInfo) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PendingDecisionState_Read(w wire.Value) (PendingDecisionState, error) {
-	var v PendingDecisionState
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a PendingDecisionInfo struct from its Thrift-level
+// FromWire deserializes a PendingChildExecutionInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PendingDecisionInfo struct
+// An error is returned if we were unable to build a PendingChildExecutionInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -52539,41 +56783,41 @@ func _PendingDecisionState_Read(w wire.Value) (PendingDecisionState, error) {
 //     return nil, err
 //   }
 //
-//   var v PendingDecisionInfo
+//   var v PendingChildExecutionInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PendingDecisionInfo) FromWire(w wire.Value) error {
+func (v *PendingChildExecutionInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x PendingDecisionState
-				x, err = _PendingDecisionState_Read(field.Value)
-				v.State = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimestamp = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedTimestamp = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowTypName = &x
 				if err != nil {
 					return err
 				}
@@ -52583,17 +56827,17 @@ func (v *PendingDecisionInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.Attempt = &x
+				v.InitiatedID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.OriginalScheduledTimestamp = &x
+			if field.Value.Type() == wire.TI32 {
+				var x ParentClosePolicy
+				x, err = _ParentClosePolicy_Read(field.Value)
+				v.ParentClosePolicy = &x
 				if err != nil {
 					return err
 				}
@@ -52605,20 +56849,20 @@ func (v *PendingDecisionInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a PendingDecisionInfo struct directly into bytes, without going
+// Encode serializes a PendingChildExecutionInfo struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a PendingDecisionInfo struct could not be encoded.
-func (v *PendingDecisionInfo) Encode(sw stream.Writer) error {
+// An error is returned if a PendingChildExecutionInfo struct could not be encoded.
+func (v *PendingChildExecutionInfo) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.State != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+	if v.WorkflowID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.State.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.WorkflowID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -52626,11 +56870,11 @@ func (v *PendingDecisionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ScheduledTimestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+	if v.RunID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.ScheduledTimestamp)); err != nil {
+		if err := sw.WriteString(*(v.RunID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -52638,11 +56882,11 @@ func (v *PendingDecisionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.StartedTimestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+	if v.WorkflowTypName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.StartedTimestamp)); err != nil {
+		if err := sw.WriteString(*(v.WorkflowTypName)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -52650,11 +56894,11 @@ func (v *PendingDecisionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Attempt != nil {
+	if v.InitiatedID != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.Attempt)); err != nil {
+		if err := sw.WriteInt64(*(v.InitiatedID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -52662,11 +56906,11 @@ func (v *PendingDecisionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.OriginalScheduledTimestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+	if v.ParentClosePolicy != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.OriginalScheduledTimestamp)); err != nil {
+		if err := v.ParentClosePolicy.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -52677,18 +56921,12 @@ func (v *PendingDecisionInfo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _PendingDecisionState_Decode(sr stream.Reader) (PendingDecisionState, error) {
-	var v PendingDecisionState
-	err := v.Decode(sr)
-	return v, err
-}
-
-// Decode deserializes a PendingDecisionInfo struct directly from its Thrift-level
+// Decode deserializes a PendingChildExecutionInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a PendingDecisionInfo struct could not be generated from the wire
+// An error is returned if a PendingChildExecutionInfo struct could not be generated from the wire
 // representation.
-func (v *PendingDecisionInfo) Decode(sr stream.Reader) error {
+func (v *PendingChildExecutionInfo) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -52701,26 +56939,26 @@ func (v *PendingDecisionInfo) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x PendingDecisionState
-			x, err = _PendingDecisionState_Decode(sr)
-			v.State = &x
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ScheduledTimestamp = &x
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartedTimestamp = &x
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowTypName = &x
 			if err != nil {
 				return err
 			}
@@ -52728,15 +56966,15 @@ func (v *PendingDecisionInfo) Decode(sr stream.Reader) error {
 		case fh.ID == 40 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.Attempt = &x
+			v.InitiatedID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.OriginalScheduledTimestamp = &x
+		case fh.ID == 50 && fh.Type == wire.TI32:
+			var x ParentClosePolicy
+			x, err = _ParentClosePolicy_Decode(sr)
+			v.ParentClosePolicy = &x
 			if err != nil {
 				return err
 			}
@@ -52763,40 +57001,518 @@ func (v *PendingDecisionInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a PendingDecisionInfo
+// String returns a readable string representation of a PendingChildExecutionInfo
 // struct.
-func (v *PendingDecisionInfo) String() string {
+func (v *PendingChildExecutionInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [5]string
 	i := 0
-	if v.State != nil {
-		fields[i] = fmt.Sprintf("State: %v", *(v.State))
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
 		i++
 	}
-	if v.ScheduledTimestamp != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
 		i++
 	}
-	if v.StartedTimestamp != nil {
-		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+	if v.WorkflowTypName != nil {
+		fields[i] = fmt.Sprintf("WorkflowTypName: %v", *(v.WorkflowTypName))
 		i++
 	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+	if v.InitiatedID != nil {
+		fields[i] = fmt.Sprintf("InitiatedID: %v", *(v.InitiatedID))
 		i++
 	}
-	if v.OriginalScheduledTimestamp != nil {
-		fields[i] = fmt.Sprintf("OriginalScheduledTimestamp: %v", *(v.OriginalScheduledTimestamp))
+	if v.ParentClosePolicy != nil {
+		fields[i] = fmt.Sprintf("ParentClosePolicy: %v", *(v.ParentClosePolicy))
 		i++
 	}
 
-	return fmt.Sprintf("PendingDecisionInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("PendingChildExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _PendingDecisionState_EqualsPtr(lhs, rhs *PendingDecisionState) bool {
+// Equals returns true if all the fields of this PendingChildExecutionInfo match the
+// provided PendingChildExecutionInfo.
+//
+// This function performs a deep comparison.
+func (v *PendingChildExecutionInfo) Equals(rhs *PendingChildExecutionInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowTypName, rhs.WorkflowTypName) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InitiatedID, rhs.InitiatedID) {
+		return false
+	}
+	if !_ParentClosePolicy_EqualsPtr(v.ParentClosePolicy, rhs.ParentClosePolicy) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PendingChildExecutionInfo.
+func (v *PendingChildExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
+	}
+	if v.WorkflowTypName != nil {
+		enc.AddString("workflowTypName", *v.WorkflowTypName)
+	}
+	if v.InitiatedID != nil {
+		enc.AddInt64("initiatedID", *v.InitiatedID)
+	}
+	if v.ParentClosePolicy != nil {
+		err = multierr.Append(err, enc.AddObject("parentClosePolicy", *v.ParentClosePolicy))
+	}
+	return err
+}
+
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *PendingChildExecutionInfo) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
+}
+
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *PendingChildExecutionInfo) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetRunID returns the value of RunID if it is set or its
+// zero value if it is unset.
+func (v *PendingChildExecutionInfo) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
+	}
+
+	return
+}
+
+// IsSetRunID returns true if RunID is not nil.
+func (v *PendingChildExecutionInfo) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
+}
+
+// GetWorkflowTypName returns the value of WorkflowTypName if it is set or its
+// zero value if it is unset.
+func (v *PendingChildExecutionInfo) GetWorkflowTypName() (o string) {
+	if v != nil && v.WorkflowTypName != nil {
+		return *v.WorkflowTypName
+	}
+
+	return
+}
+
+// IsSetWorkflowTypName returns true if WorkflowTypName is not nil.
+func (v *PendingChildExecutionInfo) IsSetWorkflowTypName() bool {
+	return v != nil && v.WorkflowTypName != nil
+}
+
+// GetInitiatedID returns the value of InitiatedID if it is set or its
+// zero value if it is unset.
+func (v *PendingChildExecutionInfo) GetInitiatedID() (o int64) {
+	if v != nil && v.InitiatedID != nil {
+		return *v.InitiatedID
+	}
+
+	return
+}
+
+// IsSetInitiatedID returns true if InitiatedID is not nil.
+func (v *PendingChildExecutionInfo) IsSetInitiatedID() bool {
+	return v != nil && v.InitiatedID != nil
+}
+
+// GetParentClosePolicy returns the value of ParentClosePolicy if it is set or its
+// zero value if it is unset.
+func (v *PendingChildExecutionInfo) GetParentClosePolicy() (o ParentClosePolicy) {
+	if v != nil && v.ParentClosePolicy != nil {
+		return *v.ParentClosePolicy
+	}
+
+	return
+}
+
+// IsSetParentClosePolicy returns true if ParentClosePolicy is not nil.
+func (v *PendingChildExecutionInfo) IsSetParentClosePolicy() bool {
+	return v != nil && v.ParentClosePolicy != nil
+}
+
+type PendingDecisionInfo struct {
+	State                      *PendingDecisionState `json:"state,omitempty"`
+	ScheduledTimestamp         *int64                `json:"scheduledTimestamp,omitempty"`
+	StartedTimestamp           *int64                `json:"startedTimestamp,omitempty"`
+	Attempt                    *int64                `json:"attempt,omitempty"`
+	OriginalScheduledTimestamp *int64                `json:"originalScheduledTimestamp,omitempty"`
+}
+
+// ToWire translates a PendingDecisionInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PendingDecisionInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.State != nil {
+		w, err = v.State.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.OriginalScheduledTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.OriginalScheduledTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PendingDecisionState_Read(w wire.Value) (PendingDecisionState, error) {
+	var v PendingDecisionState
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a PendingDecisionInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PendingDecisionInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PendingDecisionInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PendingDecisionInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x PendingDecisionState
+				x, err = _PendingDecisionState_Read(field.Value)
+				v.State = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.OriginalScheduledTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a PendingDecisionInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a PendingDecisionInfo struct could not be encoded.
+func (v *PendingDecisionInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.State != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.State.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduledTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ScheduledTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartedTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Attempt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Attempt)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OriginalScheduledTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.OriginalScheduledTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _PendingDecisionState_Decode(sr stream.Reader) (PendingDecisionState, error) {
+	var v PendingDecisionState
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a PendingDecisionInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a PendingDecisionInfo struct could not be generated from the wire
+// representation.
+func (v *PendingDecisionInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x PendingDecisionState
+			x, err = _PendingDecisionState_Decode(sr)
+			v.State = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ScheduledTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartedTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Attempt = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.OriginalScheduledTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PendingDecisionInfo
+// struct.
+func (v *PendingDecisionInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.OriginalScheduledTimestamp != nil {
+		fields[i] = fmt.Sprintf("OriginalScheduledTimestamp: %v", *(v.OriginalScheduledTimestamp))
+		i++
+	}
+
+	return fmt.Sprintf("PendingDecisionInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _PendingDecisionState_EqualsPtr(lhs, rhs *PendingDecisionState) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
@@ -53133,67 +57849,405 @@ func (v *PendingDecisionState) UnmarshalJSON(text []byte) error {
 	}
 }
 
-type PollForActivityTaskRequest struct {
-	Domain           *string           `json:"domain,omitempty"`
-	TaskList         *TaskList         `json:"taskList,omitempty"`
-	Identity         *string           `json:"identity,omitempty"`
-	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
+type PendingTimerInfo struct {
+	TimerId         *string `json:"timerId,omitempty"`
+	StartedEventId  *int64  `json:"startedEventId,omitempty"`
+	ExpiryTimestamp *int64  `json:"expiryTimestamp,omitempty"`
 }
 
-// ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
+// ToWire translates a PendingTimerInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *PendingTimerInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.TimerId != nil {
+		w, err = wire.NewValueString(*(v.TimerId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.ExpiryTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpiryTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.TaskListMetadata != nil {
-		w, err = v.TaskListMetadata.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PendingTimerInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PendingTimerInfo struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v PendingTimerInfo
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *PendingTimerInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TimerId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpiryTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a PendingTimerInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a PendingTimerInfo struct could not be encoded.
+func (v *PendingTimerInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.TimerId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.TimerId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartedEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ExpiryTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ExpiryTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a PendingTimerInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a PendingTimerInfo struct could not be generated from the wire
+// representation.
+func (v *PendingTimerInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.TimerId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartedEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ExpiryTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PendingTimerInfo
+// struct.
+func (v *PendingTimerInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.TimerId != nil {
+		fields[i] = fmt.Sprintf("TimerId: %v", *(v.TimerId))
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+	if v.ExpiryTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpiryTimestamp: %v", *(v.ExpiryTimestamp))
+		i++
+	}
+
+	return fmt.Sprintf("PendingTimerInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PendingTimerInfo match the
+// provided PendingTimerInfo.
+//
+// This function performs a deep comparison.
+func (v *PendingTimerInfo) Equals(rhs *PendingTimerInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.TimerId, rhs.TimerId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpiryTimestamp, rhs.ExpiryTimestamp) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PendingTimerInfo.
+func (v *PendingTimerInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TimerId != nil {
+		enc.AddString("timerId", *v.TimerId)
+	}
+	if v.StartedEventId != nil {
+		enc.AddInt64("startedEventId", *v.StartedEventId)
+	}
+	if v.ExpiryTimestamp != nil {
+		enc.AddInt64("expiryTimestamp", *v.ExpiryTimestamp)
+	}
+	return err
+}
+
+// GetTimerId returns the value of TimerId if it is set or its
+// zero value if it is unset.
+func (v *PendingTimerInfo) GetTimerId() (o string) {
+	if v != nil && v.TimerId != nil {
+		return *v.TimerId
+	}
+
+	return
+}
+
+// IsSetTimerId returns true if TimerId is not nil.
+func (v *PendingTimerInfo) IsSetTimerId() bool {
+	return v != nil && v.TimerId != nil
+}
+
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PendingTimerInfo) GetStartedEventId() (o int64) {
+	if v != nil && v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
+}
+
+// IsSetStartedEventId returns true if StartedEventId is not nil.
+func (v *PendingTimerInfo) IsSetStartedEventId() bool {
+	return v != nil && v.StartedEventId != nil
+}
+
+// GetExpiryTimestamp returns the value of ExpiryTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PendingTimerInfo) GetExpiryTimestamp() (o int64) {
+	if v != nil && v.ExpiryTimestamp != nil {
+		return *v.ExpiryTimestamp
+	}
+
+	return
+}
+
+// IsSetExpiryTimestamp returns true if ExpiryTimestamp is not nil.
+func (v *PendingTimerInfo) IsSetExpiryTimestamp() bool {
+	return v != nil && v.ExpiryTimestamp != nil
+}
+
+type PollForActivityTaskRequest struct {
+	Domain           *string           `json:"domain,omitempty"`
+	TaskList         *TaskList         `json:"taskList,omitempty"`
+	Identity         *string           `json:"identity,omitempty"`
+	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
+}
+
+// ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskListMetadata != nil {
+		w, err = v.TaskListMetadata.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
@@ -56577,16 +61631,6 @@ func (v *PollerInfo) String() string {
 	return fmt.Sprintf("PollerInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Double_EqualsPtr(lhs, rhs *float64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this PollerInfo match the
 // provided PollerInfo.
 //
@@ -60123,288 +65167,2269 @@ func (v *RecordMarkerDecisionAttributes) ToWire() (wire.Value, error) {
 		i++
 	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RecordMarkerDecisionAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RecordMarkerDecisionAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RecordMarkerDecisionAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RecordMarkerDecisionAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MarkerName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.Header, err = _Header_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a RecordMarkerDecisionAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a RecordMarkerDecisionAttributes struct could not be encoded.
+func (v *RecordMarkerDecisionAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.MarkerName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.MarkerName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Details != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Details); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Header != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Header.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a RecordMarkerDecisionAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a RecordMarkerDecisionAttributes struct could not be generated from the wire
+// representation.
+func (v *RecordMarkerDecisionAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.MarkerName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			v.Details, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.Header, err = _Header_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RecordMarkerDecisionAttributes
+// struct.
+func (v *RecordMarkerDecisionAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.MarkerName != nil {
+		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.Header != nil {
+		fields[i] = fmt.Sprintf("Header: %v", v.Header)
+		i++
+	}
+
+	return fmt.Sprintf("RecordMarkerDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RecordMarkerDecisionAttributes match the
+// provided RecordMarkerDecisionAttributes.
+//
+// This function performs a deep comparison.
+func (v *RecordMarkerDecisionAttributes) Equals(rhs *RecordMarkerDecisionAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RecordMarkerDecisionAttributes.
+func (v *RecordMarkerDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.MarkerName != nil {
+		enc.AddString("markerName", *v.MarkerName)
+	}
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	}
+	if v.Header != nil {
+		err = multierr.Append(err, enc.AddObject("header", v.Header))
+	}
+	return err
+}
+
+// GetMarkerName returns the value of MarkerName if it is set or its
+// zero value if it is unset.
+func (v *RecordMarkerDecisionAttributes) GetMarkerName() (o string) {
+	if v != nil && v.MarkerName != nil {
+		return *v.MarkerName
+	}
+
+	return
+}
+
+// IsSetMarkerName returns true if MarkerName is not nil.
+func (v *RecordMarkerDecisionAttributes) IsSetMarkerName() bool {
+	return v != nil && v.MarkerName != nil
+}
+
+// GetDetails returns the value of Details if it is set or its
+// zero value if it is unset.
+func (v *RecordMarkerDecisionAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+
+	return
+}
+
+// IsSetDetails returns true if Details is not nil.
+func (v *RecordMarkerDecisionAttributes) IsSetDetails() bool {
+	return v != nil && v.Details != nil
+}
+
+// GetHeader returns the value of Header if it is set or its
+// zero value if it is unset.
+func (v *RecordMarkerDecisionAttributes) GetHeader() (o *Header) {
+	if v != nil && v.Header != nil {
+		return v.Header
+	}
+
+	return
+}
+
+// IsSetHeader returns true if Header is not nil.
+func (v *RecordMarkerDecisionAttributes) IsSetHeader() bool {
+	return v != nil && v.Header != nil
+}
+
+type ReenqueueWorkflowTaskRequest struct {
+	Domain              *string            `json:"domain,omitempty"`
+	WorkflowExecution   *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Type                *int32             `json:"type,omitempty"`
+	TaskID              *int64             `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64             `json:"visibilityTimestamp,omitempty"`
+	ClusterName         *string            `json:"clusterName,omitempty"`
+	Reason              *string            `json:"reason,omitempty"`
+	Identity            *string            `json:"identity,omitempty"`
+}
+
+// ToWire translates a ReenqueueWorkflowTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReenqueueWorkflowTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Type != nil {
+		w, err = wire.NewValueI32(*(v.Type)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskID != nil {
+		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.VisibilityTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.VisibilityTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReenqueueWorkflowTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReenqueueWorkflowTaskRequest struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ReenqueueWorkflowTaskRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReenqueueWorkflowTaskRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Type = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.VisibilityTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ReenqueueWorkflowTaskRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReenqueueWorkflowTaskRequest struct could not be encoded.
+func (v *ReenqueueWorkflowTaskRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Type != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Type)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.TaskID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.VisibilityTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.VisibilityTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClusterName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ClusterName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Reason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Reason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ReenqueueWorkflowTaskRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ReenqueueWorkflowTaskRequest struct could not be generated from the wire
+// representation.
+func (v *ReenqueueWorkflowTaskRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Type = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TaskID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.VisibilityTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ClusterName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Reason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReenqueueWorkflowTaskRequest
+// struct.
+func (v *ReenqueueWorkflowTaskRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.VisibilityTimestamp != nil {
+		fields[i] = fmt.Sprintf("VisibilityTimestamp: %v", *(v.VisibilityTimestamp))
+		i++
+	}
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("ReenqueueWorkflowTaskRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReenqueueWorkflowTaskRequest match the
+// provided ReenqueueWorkflowTaskRequest.
+//
+// This function performs a deep comparison.
+func (v *ReenqueueWorkflowTaskRequest) Equals(rhs *ReenqueueWorkflowTaskRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.VisibilityTimestamp, rhs.VisibilityTimestamp) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReenqueueWorkflowTaskRequest.
+func (v *ReenqueueWorkflowTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.Type != nil {
+		enc.AddInt32("type", *v.Type)
+	}
+	if v.TaskID != nil {
+		enc.AddInt64("taskID", *v.TaskID)
+	}
+	if v.VisibilityTimestamp != nil {
+		enc.AddInt64("visibilityTimestamp", *v.VisibilityTimestamp)
+	}
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ReenqueueWorkflowTaskRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ReenqueueWorkflowTaskRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *ReenqueueWorkflowTaskRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ReenqueueWorkflowTaskRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *ReenqueueWorkflowTaskRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *ReenqueueWorkflowTaskRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// GetTaskID returns the value of TaskID if it is set or its
+// zero value if it is unset.
+func (v *ReenqueueWorkflowTaskRequest) GetTaskID() (o int64) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
+	}
+
+	return
+}
+
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *ReenqueueWorkflowTaskRequest) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
+}
+
+// GetVisibilityTimestamp returns the value of VisibilityTimestamp if it is set or its
+// zero value if it is unset.
+func (v *ReenqueueWorkflowTaskRequest) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+
+	return
+}
+
+// IsSetVisibilityTimestamp returns true if VisibilityTimestamp is not nil.
+func (v *ReenqueueWorkflowTaskRequest) IsSetVisibilityTimestamp() bool {
+	return v != nil && v.VisibilityTimestamp != nil
+}
+
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *ReenqueueWorkflowTaskRequest) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
+}
+
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *ReenqueueWorkflowTaskRequest) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *ReenqueueWorkflowTaskRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *ReenqueueWorkflowTaskRequest) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *ReenqueueWorkflowTaskRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *ReenqueueWorkflowTaskRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type RefreshWorkflowTasksRequest struct {
+	Domain    *string            `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a RefreshWorkflowTasksRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RefreshWorkflowTasksRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RefreshWorkflowTasksRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RefreshWorkflowTasksRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RefreshWorkflowTasksRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RefreshWorkflowTasksRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a RefreshWorkflowTasksRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a RefreshWorkflowTasksRequest struct could not be encoded.
+func (v *RefreshWorkflowTasksRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Execution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Execution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a RefreshWorkflowTasksRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a RefreshWorkflowTasksRequest struct could not be generated from the wire
+// representation.
+func (v *RefreshWorkflowTasksRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.Execution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RefreshWorkflowTasksRequest
+// struct.
+func (v *RefreshWorkflowTasksRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("RefreshWorkflowTasksRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RefreshWorkflowTasksRequest match the
+// provided RefreshWorkflowTasksRequest.
+//
+// This function performs a deep comparison.
+func (v *RefreshWorkflowTasksRequest) Equals(rhs *RefreshWorkflowTasksRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RefreshWorkflowTasksRequest.
+func (v *RefreshWorkflowTasksRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *RefreshWorkflowTasksRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *RefreshWorkflowTasksRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *RefreshWorkflowTasksRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *RefreshWorkflowTasksRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+type RegisterDomainRequest struct {
+	Name                                   *string                            `json:"name,omitempty"`
+	Description                            *string                            `json:"description,omitempty"`
+	OwnerEmail                             *string                            `json:"ownerEmail,omitempty"`
+	WorkflowExecutionRetentionPeriodInDays *int32                             `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
+	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+	ActiveClusterName                      *string                            `json:"activeClusterName,omitempty"`
+	Data                                   map[string]string                  `json:"data,omitempty"`
+	SecurityToken                          *string                            `json:"securityToken,omitempty"`
+	IsGlobalDomain                         *bool                              `json:"isGlobalDomain,omitempty"`
+	HistoryArchivalStatus                  *ArchivalStatus                    `json:"historyArchivalStatus,omitempty"`
+	HistoryArchivalURI                     *string                            `json:"historyArchivalURI,omitempty"`
+	VisibilityArchivalStatus               *ArchivalStatus                    `json:"visibilityArchivalStatus,omitempty"`
+	VisibilityArchivalURI                  *string                            `json:"visibilityArchivalURI,omitempty"`
+}
+
+// Default_RegisterDomainRequest constructs a new RegisterDomainRequest struct,
+// pre-populating any fields with defined default values.
+func Default_RegisterDomainRequest() *RegisterDomainRequest {
+	var v RegisterDomainRequest
+	v.EmitMetric = ptr.Bool(true)
+	return &v
+}
+
+// ToWire translates a RegisterDomainRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [14]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Description != nil {
+		w, err = wire.NewValueString(*(v.Description)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.OwnerEmail != nil {
+		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	vEmitMetric := v.EmitMetric
+	if vEmitMetric == nil {
+		vEmitMetric = ptr.Bool(true)
+	}
+	{
+		w, err = wire.NewValueBool(*(vEmitMetric)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Clusters != nil {
+		w, err = wire.NewValueList(_List_ClusterReplicationConfiguration_ValueList(v.Clusters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ActiveClusterName != nil {
+		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Data != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Data)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.SecurityToken != nil {
+		w, err = wire.NewValueString(*(v.SecurityToken)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.IsGlobalDomain != nil {
+		w, err = wire.NewValueBool(*(v.IsGlobalDomain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.HistoryArchivalStatus != nil {
+		w, err = v.HistoryArchivalStatus.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.HistoryArchivalURI != nil {
+		w, err = wire.NewValueString(*(v.HistoryArchivalURI)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.VisibilityArchivalStatus != nil {
+		w, err = v.VisibilityArchivalStatus.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.VisibilityArchivalURI != nil {
+		w, err = wire.NewValueString(*(v.VisibilityArchivalURI)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RegisterDomainRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RegisterDomainRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RegisterDomainRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Description = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.OwnerEmail = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionRetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.EmitMetric = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.Clusters, err = _List_ClusterReplicationConfiguration_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActiveClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TMap {
+				v.Data, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SecurityToken = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsGlobalDomain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TI32 {
+				var x ArchivalStatus
+				x, err = _ArchivalStatus_Read(field.Value)
+				v.HistoryArchivalStatus = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.HistoryArchivalURI = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 150:
+			if field.Value.Type() == wire.TI32 {
+				var x ArchivalStatus
+				x, err = _ArchivalStatus_Read(field.Value)
+				v.VisibilityArchivalStatus = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 160:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.VisibilityArchivalURI = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if v.EmitMetric == nil {
+		v.EmitMetric = ptr.Bool(true)
+	}
+
+	return nil
+}
+
+// Encode serializes a RegisterDomainRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a RegisterDomainRequest struct could not be encoded.
+func (v *RegisterDomainRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Description != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Description)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OwnerEmail != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.OwnerEmail)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.WorkflowExecutionRetentionPeriodInDays)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	vEmitMetric := v.EmitMetric
+	if vEmitMetric == nil {
+		vEmitMetric = ptr.Bool(true)
+	}
+	{
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(vEmitMetric)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Clusters != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_ClusterReplicationConfiguration_Encode(v.Clusters, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ActiveClusterName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ActiveClusterName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Data != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_String_Encode(v.Data, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SecurityToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.SecurityToken)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.IsGlobalDomain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.IsGlobalDomain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HistoryArchivalStatus != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.HistoryArchivalStatus.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HistoryArchivalURI != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.HistoryArchivalURI)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.VisibilityArchivalStatus != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.VisibilityArchivalStatus.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.VisibilityArchivalURI != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 160, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.VisibilityArchivalURI)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a RegisterDomainRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a RegisterDomainRequest struct could not be generated from the wire
+// representation.
+func (v *RegisterDomainRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Description = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.OwnerEmail = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.WorkflowExecutionRetentionPeriodInDays = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.EmitMetric = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TList:
+			v.Clusters, err = _List_ClusterReplicationConfiguration_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ActiveClusterName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TMap:
+			v.Data, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.SecurityToken = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 120 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.IsGlobalDomain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 130 && fh.Type == wire.TI32:
+			var x ArchivalStatus
+			x, err = _ArchivalStatus_Decode(sr)
+			v.HistoryArchivalStatus = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 140 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.HistoryArchivalURI = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 150 && fh.Type == wire.TI32:
+			var x ArchivalStatus
+			x, err = _ArchivalStatus_Decode(sr)
+			v.VisibilityArchivalStatus = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 160 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.VisibilityArchivalURI = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if v.EmitMetric == nil {
+		v.EmitMetric = ptr.Bool(true)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RegisterDomainRequest
+// struct.
+func (v *RegisterDomainRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [14]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Description != nil {
+		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
+		i++
+	}
+	if v.OwnerEmail != nil {
+		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+		i++
+	}
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
+		i++
+	}
+	if v.EmitMetric != nil {
+		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
+		i++
+	}
+	if v.Clusters != nil {
+		fields[i] = fmt.Sprintf("Clusters: %v", v.Clusters)
+		i++
+	}
+	if v.ActiveClusterName != nil {
+		fields[i] = fmt.Sprintf("ActiveClusterName: %v", *(v.ActiveClusterName))
+		i++
+	}
+	if v.Data != nil {
+		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+		i++
+	}
+	if v.SecurityToken != nil {
+		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
+		i++
+	}
+	if v.IsGlobalDomain != nil {
+		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
+		i++
+	}
+	if v.HistoryArchivalStatus != nil {
+		fields[i] = fmt.Sprintf("HistoryArchivalStatus: %v", *(v.HistoryArchivalStatus))
+		i++
+	}
+	if v.HistoryArchivalURI != nil {
+		fields[i] = fmt.Sprintf("HistoryArchivalURI: %v", *(v.HistoryArchivalURI))
+		i++
+	}
+	if v.VisibilityArchivalStatus != nil {
+		fields[i] = fmt.Sprintf("VisibilityArchivalStatus: %v", *(v.VisibilityArchivalStatus))
+		i++
+	}
+	if v.VisibilityArchivalURI != nil {
+		fields[i] = fmt.Sprintf("VisibilityArchivalURI: %v", *(v.VisibilityArchivalURI))
+		i++
+	}
+
+	return fmt.Sprintf("RegisterDomainRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RegisterDomainRequest match the
+// provided RegisterDomainRequest.
+//
+// This function performs a deep comparison.
+func (v *RegisterDomainRequest) Equals(rhs *RegisterDomainRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Description, rhs.Description) {
+		return false
+	}
+	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
+		return false
+	}
+	if !((v.Clusters == nil && rhs.Clusters == nil) || (v.Clusters != nil && rhs.Clusters != nil && _List_ClusterReplicationConfiguration_Equals(v.Clusters, rhs.Clusters))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActiveClusterName, rhs.ActiveClusterName) {
+		return false
+	}
+	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && _Map_String_String_Equals(v.Data, rhs.Data))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
+		return false
+	}
+	if !_ArchivalStatus_EqualsPtr(v.HistoryArchivalStatus, rhs.HistoryArchivalStatus) {
+		return false
+	}
+	if !_String_EqualsPtr(v.HistoryArchivalURI, rhs.HistoryArchivalURI) {
+		return false
+	}
+	if !_ArchivalStatus_EqualsPtr(v.VisibilityArchivalStatus, rhs.VisibilityArchivalStatus) {
+		return false
+	}
+	if !_String_EqualsPtr(v.VisibilityArchivalURI, rhs.VisibilityArchivalURI) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RegisterDomainRequest.
+func (v *RegisterDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Description != nil {
+		enc.AddString("description", *v.Description)
+	}
+	if v.OwnerEmail != nil {
+		enc.AddString("ownerEmail", *v.OwnerEmail)
+	}
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		enc.AddInt32("workflowExecutionRetentionPeriodInDays", *v.WorkflowExecutionRetentionPeriodInDays)
+	}
+	if v.EmitMetric != nil {
+		enc.AddBool("emitMetric", *v.EmitMetric)
+	}
+	if v.Clusters != nil {
+		err = multierr.Append(err, enc.AddArray("clusters", (_List_ClusterReplicationConfiguration_Zapper)(v.Clusters)))
+	}
+	if v.ActiveClusterName != nil {
+		enc.AddString("activeClusterName", *v.ActiveClusterName)
+	}
+	if v.Data != nil {
+		err = multierr.Append(err, enc.AddObject("data", (_Map_String_String_Zapper)(v.Data)))
+	}
+	if v.SecurityToken != nil {
+		enc.AddString("securityToken", *v.SecurityToken)
+	}
+	if v.IsGlobalDomain != nil {
+		enc.AddBool("isGlobalDomain", *v.IsGlobalDomain)
+	}
+	if v.HistoryArchivalStatus != nil {
+		err = multierr.Append(err, enc.AddObject("historyArchivalStatus", *v.HistoryArchivalStatus))
+	}
+	if v.HistoryArchivalURI != nil {
+		enc.AddString("historyArchivalURI", *v.HistoryArchivalURI)
+	}
+	if v.VisibilityArchivalStatus != nil {
+		err = multierr.Append(err, enc.AddObject("visibilityArchivalStatus", *v.VisibilityArchivalStatus))
+	}
+	if v.VisibilityArchivalURI != nil {
+		enc.AddString("visibilityArchivalURI", *v.VisibilityArchivalURI)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *RegisterDomainRequest) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetDescription returns the value of Description if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetDescription() (o string) {
+	if v != nil && v.Description != nil {
+		return *v.Description
+	}
+
+	return
 }
 
-// FromWire deserializes a RecordMarkerDecisionAttributes struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a RecordMarkerDecisionAttributes struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v RecordMarkerDecisionAttributes
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *RecordMarkerDecisionAttributes) FromWire(w wire.Value) error {
-	var err error
+// IsSetDescription returns true if Description is not nil.
+func (v *RegisterDomainRequest) IsSetDescription() bool {
+	return v != nil && v.Description != nil
+}
 
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.MarkerName = &x
-				if err != nil {
-					return err
-				}
+// GetOwnerEmail returns the value of OwnerEmail if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetOwnerEmail() (o string) {
+	if v != nil && v.OwnerEmail != nil {
+		return *v.OwnerEmail
+	}
 
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
+	return
+}
 
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.Header, err = _Header_Read(field.Value)
-				if err != nil {
-					return err
-				}
+// IsSetOwnerEmail returns true if OwnerEmail is not nil.
+func (v *RegisterDomainRequest) IsSetOwnerEmail() bool {
+	return v != nil && v.OwnerEmail != nil
+}
 
-			}
-		}
+// GetWorkflowExecutionRetentionPeriodInDays returns the value of WorkflowExecutionRetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetWorkflowExecutionRetentionPeriodInDays() (o int32) {
+	if v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil {
+		return *v.WorkflowExecutionRetentionPeriodInDays
 	}
 
-	return nil
+	return
 }
 
-// Encode serializes a RecordMarkerDecisionAttributes struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a RecordMarkerDecisionAttributes struct could not be encoded.
-func (v *RecordMarkerDecisionAttributes) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
+// IsSetWorkflowExecutionRetentionPeriodInDays returns true if WorkflowExecutionRetentionPeriodInDays is not nil.
+func (v *RegisterDomainRequest) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
+	return v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil
+}
 
-	if v.MarkerName != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.MarkerName)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// GetEmitMetric returns the value of EmitMetric if it is set or its
+// default value if it is unset.
+func (v *RegisterDomainRequest) GetEmitMetric() (o bool) {
+	if v != nil && v.EmitMetric != nil {
+		return *v.EmitMetric
 	}
+	o = true
+	return
+}
 
-	if v.Details != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.Details); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+// IsSetEmitMetric returns true if EmitMetric is not nil.
+func (v *RegisterDomainRequest) IsSetEmitMetric() bool {
+	return v != nil && v.EmitMetric != nil
+}
 
-	if v.Header != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.Header.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// GetClusters returns the value of Clusters if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetClusters() (o []*ClusterReplicationConfiguration) {
+	if v != nil && v.Clusters != nil {
+		return v.Clusters
 	}
 
-	return sw.WriteStructEnd()
+	return
 }
 
-// Decode deserializes a RecordMarkerDecisionAttributes struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a RecordMarkerDecisionAttributes struct could not be generated from the wire
-// representation.
-func (v *RecordMarkerDecisionAttributes) Decode(sr stream.Reader) error {
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
+// IsSetClusters returns true if Clusters is not nil.
+func (v *RegisterDomainRequest) IsSetClusters() bool {
+	return v != nil && v.Clusters != nil
+}
 
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
+// GetActiveClusterName returns the value of ActiveClusterName if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetActiveClusterName() (o string) {
+	if v != nil && v.ActiveClusterName != nil {
+		return *v.ActiveClusterName
 	}
 
-	for ok {
-		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.MarkerName = &x
-			if err != nil {
-				return err
-			}
+	return
+}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			v.Details, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
+// IsSetActiveClusterName returns true if ActiveClusterName is not nil.
+func (v *RegisterDomainRequest) IsSetActiveClusterName() bool {
+	return v != nil && v.ActiveClusterName != nil
+}
 
-		case fh.ID == 30 && fh.Type == wire.TStruct:
-			v.Header, err = _Header_Decode(sr)
-			if err != nil {
-				return err
-			}
+// GetData returns the value of Data if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetData() (o map[string]string) {
+	if v != nil && v.Data != nil {
+		return v.Data
+	}
 
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
+	return
+}
 
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
+// IsSetData returns true if Data is not nil.
+func (v *RegisterDomainRequest) IsSetData() bool {
+	return v != nil && v.Data != nil
+}
 
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
-		}
+// GetSecurityToken returns the value of SecurityToken if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetSecurityToken() (o string) {
+	if v != nil && v.SecurityToken != nil {
+		return *v.SecurityToken
 	}
 
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
-	}
+	return
+}
 
-	return nil
+// IsSetSecurityToken returns true if SecurityToken is not nil.
+func (v *RegisterDomainRequest) IsSetSecurityToken() bool {
+	return v != nil && v.SecurityToken != nil
 }
 
-// String returns a readable string representation of a RecordMarkerDecisionAttributes
-// struct.
-func (v *RecordMarkerDecisionAttributes) String() string {
-	if v == nil {
-		return "<nil>"
+// GetIsGlobalDomain returns the value of IsGlobalDomain if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetIsGlobalDomain() (o bool) {
+	if v != nil && v.IsGlobalDomain != nil {
+		return *v.IsGlobalDomain
 	}
 
-	var fields [3]string
-	i := 0
-	if v.MarkerName != nil {
-		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
-		i++
-	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
-		i++
-	}
-	if v.Header != nil {
-		fields[i] = fmt.Sprintf("Header: %v", v.Header)
-		i++
-	}
+	return
+}
 
-	return fmt.Sprintf("RecordMarkerDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+// IsSetIsGlobalDomain returns true if IsGlobalDomain is not nil.
+func (v *RegisterDomainRequest) IsSetIsGlobalDomain() bool {
+	return v != nil && v.IsGlobalDomain != nil
 }
 
-// Equals returns true if all the fields of this RecordMarkerDecisionAttributes match the
-// provided RecordMarkerDecisionAttributes.
-//
-// This function performs a deep comparison.
-func (v *RecordMarkerDecisionAttributes) Equals(rhs *RecordMarkerDecisionAttributes) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
-		return false
-	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
-		return false
-	}
-	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
-		return false
+// GetHistoryArchivalStatus returns the value of HistoryArchivalStatus if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetHistoryArchivalStatus() (o ArchivalStatus) {
+	if v != nil && v.HistoryArchivalStatus != nil {
+		return *v.HistoryArchivalStatus
 	}
 
-	return true
+	return
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordMarkerDecisionAttributes.
-func (v *RecordMarkerDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.MarkerName != nil {
-		enc.AddString("markerName", *v.MarkerName)
-	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
-	}
-	if v.Header != nil {
-		err = multierr.Append(err, enc.AddObject("header", v.Header))
-	}
-	return err
+// IsSetHistoryArchivalStatus returns true if HistoryArchivalStatus is not nil.
+func (v *RegisterDomainRequest) IsSetHistoryArchivalStatus() bool {
+	return v != nil && v.HistoryArchivalStatus != nil
 }
 
-// GetMarkerName returns the value of MarkerName if it is set or its
+// GetHistoryArchivalURI returns the value of HistoryArchivalURI if it is set or its
 // zero value if it is unset.
-func (v *RecordMarkerDecisionAttributes) GetMarkerName() (o string) {
-	if v != nil && v.MarkerName != nil {
-		return *v.MarkerName
+func (v *RegisterDomainRequest) GetHistoryArchivalURI() (o string) {
+	if v != nil && v.HistoryArchivalURI != nil {
+		return *v.HistoryArchivalURI
 	}
 
 	return
 }
 
-// IsSetMarkerName returns true if MarkerName is not nil.
-func (v *RecordMarkerDecisionAttributes) IsSetMarkerName() bool {
-	return v != nil && v.MarkerName != nil
+// IsSetHistoryArchivalURI returns true if HistoryArchivalURI is not nil.
+func (v *RegisterDomainRequest) IsSetHistoryArchivalURI() bool {
+	return v != nil && v.HistoryArchivalURI != nil
 }
 
-// GetDetails returns the value of Details if it is set or its
+// GetVisibilityArchivalStatus returns the value of VisibilityArchivalStatus if it is set or its
 // zero value if it is unset.
-func (v *RecordMarkerDecisionAttributes) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+func (v *RegisterDomainRequest) GetVisibilityArchivalStatus() (o ArchivalStatus) {
+	if v != nil && v.VisibilityArchivalStatus != nil {
+		return *v.VisibilityArchivalStatus
 	}
 
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *RecordMarkerDecisionAttributes) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// IsSetVisibilityArchivalStatus returns true if VisibilityArchivalStatus is not nil.
+func (v *RegisterDomainRequest) IsSetVisibilityArchivalStatus() bool {
+	return v != nil && v.VisibilityArchivalStatus != nil
 }
 
-// GetHeader returns the value of Header if it is set or its
+// GetVisibilityArchivalURI returns the value of VisibilityArchivalURI if it is set or its
 // zero value if it is unset.
-func (v *RecordMarkerDecisionAttributes) GetHeader() (o *Header) {
-	if v != nil && v.Header != nil {
-		return v.Header
+func (v *RegisterDomainRequest) GetVisibilityArchivalURI() (o string) {
+	if v != nil && v.VisibilityArchivalURI != nil {
+		return *v.VisibilityArchivalURI
 	}
 
 	return
 }
 
-// IsSetHeader returns true if Header is not nil.
-func (v *RecordMarkerDecisionAttributes) IsSetHeader() bool {
-	return v != nil && v.Header != nil
+// IsSetVisibilityArchivalURI returns true if VisibilityArchivalURI is not nil.
+func (v *RegisterDomainRequest) IsSetVisibilityArchivalURI() bool {
+	return v != nil && v.VisibilityArchivalURI != nil
 }
 
-type RefreshWorkflowTasksRequest struct {
-	Domain    *string            `json:"domain,omitempty"`
-	Execution *WorkflowExecution `json:"execution,omitempty"`
+type RemoteSyncMatchedError struct {
+	Message string `json:"message,required"`
 }
 
-// ToWire translates a RefreshWorkflowTasksRequest struct into a Thrift-level intermediate
+// ToWire translates a RemoteSyncMatchedError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -60419,39 +67444,29 @@ type RefreshWorkflowTasksRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RefreshWorkflowTasksRequest) ToWire() (wire.Value, error) {
+func (v *RemoteSyncMatchedError) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 10, Value: w}
+	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RefreshWorkflowTasksRequest struct from its Thrift-level
+// FromWire deserializes a RemoteSyncMatchedError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RefreshWorkflowTasksRequest struct
+// An error is returned if we were unable to build a RemoteSyncMatchedError struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -60459,82 +67474,66 @@ func (v *RefreshWorkflowTasksRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RefreshWorkflowTasksRequest
+//   var v RemoteSyncMatchedError
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RefreshWorkflowTasksRequest) FromWire(w wire.Value) error {
+func (v *RemoteSyncMatchedError) FromWire(w wire.Value) error {
 	var err error
 
+	messageIsSet := false
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				v.Message, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				messageIsSet = true
 			}
 		}
 	}
 
+	if !messageIsSet {
+		return errors.New("field Message of RemoteSyncMatchedError is required")
+	}
+
 	return nil
 }
 
-// Encode serializes a RefreshWorkflowTasksRequest struct directly into bytes, without going
+// Encode serializes a RemoteSyncMatchedError struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RefreshWorkflowTasksRequest struct could not be encoded.
-func (v *RefreshWorkflowTasksRequest) Encode(sw stream.Writer) error {
+// An error is returned if a RemoteSyncMatchedError struct could not be encoded.
+func (v *RemoteSyncMatchedError) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+		return err
 	}
-
-	if v.Execution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.Execution.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RefreshWorkflowTasksRequest struct directly from its Thrift-level
+// Decode deserializes a RemoteSyncMatchedError struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RefreshWorkflowTasksRequest struct could not be generated from the wire
+// An error is returned if a RemoteSyncMatchedError struct could not be generated from the wire
 // representation.
-func (v *RefreshWorkflowTasksRequest) Decode(sr stream.Reader) error {
+func (v *RemoteSyncMatchedError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -60548,19 +67547,11 @@ func (v *RefreshWorkflowTasksRequest) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Domain = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.Execution, err = _WorkflowExecution_Decode(sr)
+			v.Message, err = sr.ReadString()
 			if err != nil {
 				return err
 			}
-
+			messageIsSet = true
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -60580,44 +67571,45 @@ func (v *RefreshWorkflowTasksRequest) Decode(sr stream.Reader) error {
 		return err
 	}
 
+	if !messageIsSet {
+		return errors.New("field Message of RemoteSyncMatchedError is required")
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a RefreshWorkflowTasksRequest
+// String returns a readable string representation of a RemoteSyncMatchedError
 // struct.
-func (v *RefreshWorkflowTasksRequest) String() string {
+func (v *RemoteSyncMatchedError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
-	}
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
 
-	return fmt.Sprintf("RefreshWorkflowTasksRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RemoteSyncMatchedError{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RefreshWorkflowTasksRequest match the
-// provided RefreshWorkflowTasksRequest.
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*RemoteSyncMatchedError) ErrorName() string {
+	return "RemoteSyncMatchedError"
+}
+
+// Equals returns true if all the fields of this RemoteSyncMatchedError match the
+// provided RemoteSyncMatchedError.
 //
 // This function performs a deep comparison.
-func (v *RefreshWorkflowTasksRequest) Equals(rhs *RefreshWorkflowTasksRequest) bool {
+func (v *RemoteSyncMatchedError) Equals(rhs *RemoteSyncMatchedError) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+	if !(v.Message == rhs.Message) {
 		return false
 	}
 
@@ -60625,76 +67617,37 @@ func (v *RefreshWorkflowTasksRequest) Equals(rhs *RefreshWorkflowTasksRequest) b
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RefreshWorkflowTasksRequest.
-func (v *RefreshWorkflowTasksRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
-	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
-	}
-	return err
-}
-
-// GetDomain returns the value of Domain if it is set or its
-// zero value if it is unset.
-func (v *RefreshWorkflowTasksRequest) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
-	}
-
-	return
-}
-
-// IsSetDomain returns true if Domain is not nil.
-func (v *RefreshWorkflowTasksRequest) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// fast logging of RemoteSyncMatchedError.
+func (v *RemoteSyncMatchedError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
 }
 
-// GetExecution returns the value of Execution if it is set or its
+// GetMessage returns the value of Message if it is set or its
 // zero value if it is unset.
-func (v *RefreshWorkflowTasksRequest) GetExecution() (o *WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+func (v *RemoteSyncMatchedError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
 	}
-
 	return
 }
 
-// IsSetExecution returns true if Execution is not nil.
-func (v *RefreshWorkflowTasksRequest) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
-}
-
-type RegisterDomainRequest struct {
-	Name                                   *string                            `json:"name,omitempty"`
-	Description                            *string                            `json:"description,omitempty"`
-	OwnerEmail                             *string                            `json:"ownerEmail,omitempty"`
-	WorkflowExecutionRetentionPeriodInDays *int32                             `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
-	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
-	ActiveClusterName                      *string                            `json:"activeClusterName,omitempty"`
-	Data                                   map[string]string                  `json:"data,omitempty"`
-	SecurityToken                          *string                            `json:"securityToken,omitempty"`
-	IsGlobalDomain                         *bool                              `json:"isGlobalDomain,omitempty"`
-	HistoryArchivalStatus                  *ArchivalStatus                    `json:"historyArchivalStatus,omitempty"`
-	HistoryArchivalURI                     *string                            `json:"historyArchivalURI,omitempty"`
-	VisibilityArchivalStatus               *ArchivalStatus                    `json:"visibilityArchivalStatus,omitempty"`
-	VisibilityArchivalURI                  *string                            `json:"visibilityArchivalURI,omitempty"`
+func (v *RemoteSyncMatchedError) Error() string {
+	return v.String()
 }
 
-// Default_RegisterDomainRequest constructs a new RegisterDomainRequest struct,
-// pre-populating any fields with defined default values.
-func Default_RegisterDomainRequest() *RegisterDomainRequest {
-	var v RegisterDomainRequest
-	v.EmitMetric = ptr.Bool(true)
-	return &v
+type RemoveTaskRequest struct {
+	ShardID             *int32  `json:"shardID,omitempty"`
+	Type                *int32  `json:"type,omitempty"`
+	TaskID              *int64  `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64  `json:"visibilityTimestamp,omitempty"`
+	ClusterName         *string `json:"clusterName,omitempty"`
 }
 
-// ToWire translates a RegisterDomainRequest struct into a Thrift-level intermediate
+// ToWire translates a RemoveTaskRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -60709,139 +67662,63 @@ func Default_RegisterDomainRequest() *RegisterDomainRequest {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
+func (v *RemoveTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [14]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Description != nil {
-		w, err = wire.NewValueString(*(v.Description)), error(nil)
+	if v.Type != nil {
+		w, err = wire.NewValueI32(*(v.Type)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.OwnerEmail != nil {
-		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+	if v.TaskID != nil {
+		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
+	if v.VisibilityTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.VisibilityTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	vEmitMetric := v.EmitMetric
-	if vEmitMetric == nil {
-		vEmitMetric = ptr.Bool(true)
-	}
-	{
-		w, err = wire.NewValueBool(*(vEmitMetric)), error(nil)
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Clusters != nil {
-		w, err = wire.NewValueList(_List_ClusterReplicationConfiguration_ValueList(v.Clusters)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.ActiveClusterName != nil {
-		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.Data != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Data)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.SecurityToken != nil {
-		w, err = wire.NewValueString(*(v.SecurityToken)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.IsGlobalDomain != nil {
-		w, err = wire.NewValueBool(*(v.IsGlobalDomain)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 120, Value: w}
-		i++
-	}
-	if v.HistoryArchivalStatus != nil {
-		w, err = v.HistoryArchivalStatus.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 130, Value: w}
-		i++
-	}
-	if v.HistoryArchivalURI != nil {
-		w, err = wire.NewValueString(*(v.HistoryArchivalURI)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 140, Value: w}
-		i++
-	}
-	if v.VisibilityArchivalStatus != nil {
-		w, err = v.VisibilityArchivalStatus.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 150, Value: w}
-		i++
-	}
-	if v.VisibilityArchivalURI != nil {
-		w, err = wire.NewValueString(*(v.VisibilityArchivalURI)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 160, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RegisterDomainRequest struct from its Thrift-level
+// FromWire deserializes a RemoveTaskRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RegisterDomainRequest struct
+// An error is returned if we were unable to build a RemoveTaskRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -60849,147 +67726,61 @@ func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RegisterDomainRequest
+//   var v RemoveTaskRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
+func (v *RemoveTaskRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Description = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.OwnerEmail = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.WorkflowExecutionRetentionPeriodInDays = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.EmitMetric = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TList {
-				v.Clusters, err = _List_ClusterReplicationConfiguration_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActiveClusterName = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TMap {
-				v.Data, err = _Map_String_String_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.SecurityToken = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 120:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.IsGlobalDomain = &x
+				v.ShardID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 130:
+		case 20:
 			if field.Value.Type() == wire.TI32 {
-				var x ArchivalStatus
-				x, err = _ArchivalStatus_Read(field.Value)
-				v.HistoryArchivalStatus = &x
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Type = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 140:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.HistoryArchivalURI = &x
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 150:
-			if field.Value.Type() == wire.TI32 {
-				var x ArchivalStatus
-				x, err = _ArchivalStatus_Read(field.Value)
-				v.VisibilityArchivalStatus = &x
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.VisibilityTimestamp = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 160:
+		case 50:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.VisibilityArchivalURI = &x
+				v.ClusterName = &x
 				if err != nil {
 					return err
 				}
@@ -60998,139 +67789,23 @@ func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 		}
 	}
 
-	if v.EmitMetric == nil {
-		v.EmitMetric = ptr.Bool(true)
-	}
-
 	return nil
 }
 
-// Encode serializes a RegisterDomainRequest struct directly into bytes, without going
+// Encode serializes a RemoveTaskRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RegisterDomainRequest struct could not be encoded.
-func (v *RegisterDomainRequest) Encode(sw stream.Writer) error {
+// An error is returned if a RemoveTaskRequest struct could not be encoded.
+func (v *RemoveTaskRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Name != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Name)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Description != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Description)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.OwnerEmail != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.OwnerEmail)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.WorkflowExecutionRetentionPeriodInDays)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	vEmitMetric := v.EmitMetric
-	if vEmitMetric == nil {
-		vEmitMetric = ptr.Bool(true)
-	}
-	{
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBool}); err != nil {
-			return err
-		}
-		if err := sw.WriteBool(*(vEmitMetric)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Clusters != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TList}); err != nil {
-			return err
-		}
-		if err := _List_ClusterReplicationConfiguration_Encode(v.Clusters, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ActiveClusterName != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.ActiveClusterName)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Data != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TMap}); err != nil {
-			return err
-		}
-		if err := _Map_String_String_Encode(v.Data, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.SecurityToken != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.SecurityToken)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.IsGlobalDomain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBool}); err != nil {
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteBool(*(v.IsGlobalDomain)); err != nil {
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -61138,11 +67813,11 @@ func (v *RegisterDomainRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.HistoryArchivalStatus != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TI32}); err != nil {
+	if v.Type != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := v.HistoryArchivalStatus.Encode(sw); err != nil {
+		if err := sw.WriteInt32(*(v.Type)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -61150,11 +67825,11 @@ func (v *RegisterDomainRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.HistoryArchivalURI != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TBinary}); err != nil {
+	if v.TaskID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.HistoryArchivalURI)); err != nil {
+		if err := sw.WriteInt64(*(v.TaskID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -61162,11 +67837,11 @@ func (v *RegisterDomainRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.VisibilityArchivalStatus != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TI32}); err != nil {
+	if v.VisibilityTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := v.VisibilityArchivalStatus.Encode(sw); err != nil {
+		if err := sw.WriteInt64(*(v.VisibilityTimestamp)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -61174,11 +67849,11 @@ func (v *RegisterDomainRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.VisibilityArchivalURI != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 160, Type: wire.TBinary}); err != nil {
+	if v.ClusterName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.VisibilityArchivalURI)); err != nil {
+		if err := sw.WriteString(*(v.ClusterName)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -61189,12 +67864,12 @@ func (v *RegisterDomainRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RegisterDomainRequest struct directly from its Thrift-level
+// Decode deserializes a RemoveTaskRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RegisterDomainRequest struct could not be generated from the wire
+// An error is returned if a RemoveTaskRequest struct could not be generated from the wire
 // representation.
-func (v *RegisterDomainRequest) Decode(sr stream.Reader) error {
+func (v *RemoveTaskRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -61205,112 +67880,44 @@ func (v *RegisterDomainRequest) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	for ok {
-		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Name = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Description = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.OwnerEmail = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.WorkflowExecutionRetentionPeriodInDays = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.EmitMetric = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 60 && fh.Type == wire.TList:
-			v.Clusters, err = _List_ClusterReplicationConfiguration_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 70 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ActiveClusterName = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 80 && fh.Type == wire.TMap:
-			v.Data, err = _Map_String_String_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 90 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.SecurityToken = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 120 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.IsGlobalDomain = &x
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 130 && fh.Type == wire.TI32:
-			var x ArchivalStatus
-			x, err = _ArchivalStatus_Decode(sr)
-			v.HistoryArchivalStatus = &x
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Type = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 140 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.HistoryArchivalURI = &x
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TaskID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 150 && fh.Type == wire.TI32:
-			var x ArchivalStatus
-			x, err = _ArchivalStatus_Decode(sr)
-			v.VisibilityArchivalStatus = &x
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.VisibilityTimestamp = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 160 && fh.Type == wire.TBinary:
+		case fh.ID == 50 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.VisibilityArchivalURI = &x
+			v.ClusterName = &x
 			if err != nil {
 				return err
 			}
@@ -61334,132 +67941,65 @@ func (v *RegisterDomainRequest) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	if v.EmitMetric == nil {
-		v.EmitMetric = ptr.Bool(true)
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a RegisterDomainRequest
+// String returns a readable string representation of a RemoveTaskRequest
 // struct.
-func (v *RegisterDomainRequest) String() string {
+func (v *RemoveTaskRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [14]string
+	var fields [5]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
-		i++
-	}
-	if v.Description != nil {
-		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
-		i++
-	}
-	if v.OwnerEmail != nil {
-		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
-		i++
-	}
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
-		i++
-	}
-	if v.EmitMetric != nil {
-		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
-		i++
-	}
-	if v.Clusters != nil {
-		fields[i] = fmt.Sprintf("Clusters: %v", v.Clusters)
-		i++
-	}
-	if v.ActiveClusterName != nil {
-		fields[i] = fmt.Sprintf("ActiveClusterName: %v", *(v.ActiveClusterName))
-		i++
-	}
-	if v.Data != nil {
-		fields[i] = fmt.Sprintf("Data: %v", v.Data)
-		i++
-	}
-	if v.SecurityToken != nil {
-		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
-		i++
-	}
-	if v.IsGlobalDomain != nil {
-		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.HistoryArchivalStatus != nil {
-		fields[i] = fmt.Sprintf("HistoryArchivalStatus: %v", *(v.HistoryArchivalStatus))
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
 		i++
 	}
-	if v.HistoryArchivalURI != nil {
-		fields[i] = fmt.Sprintf("HistoryArchivalURI: %v", *(v.HistoryArchivalURI))
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
 		i++
 	}
-	if v.VisibilityArchivalStatus != nil {
-		fields[i] = fmt.Sprintf("VisibilityArchivalStatus: %v", *(v.VisibilityArchivalStatus))
+	if v.VisibilityTimestamp != nil {
+		fields[i] = fmt.Sprintf("VisibilityTimestamp: %v", *(v.VisibilityTimestamp))
 		i++
 	}
-	if v.VisibilityArchivalURI != nil {
-		fields[i] = fmt.Sprintf("VisibilityArchivalURI: %v", *(v.VisibilityArchivalURI))
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
 		i++
 	}
 
-	return fmt.Sprintf("RegisterDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RemoveTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RegisterDomainRequest match the
-// provided RegisterDomainRequest.
+// Equals returns true if all the fields of this RemoveTaskRequest match the
+// provided RemoveTaskRequest.
 //
 // This function performs a deep comparison.
-func (v *RegisterDomainRequest) Equals(rhs *RegisterDomainRequest) bool {
+func (v *RemoveTaskRequest) Equals(rhs *RemoveTaskRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Description, rhs.Description) {
-		return false
-	}
-	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
-		return false
-	}
-	if !((v.Clusters == nil && rhs.Clusters == nil) || (v.Clusters != nil && rhs.Clusters != nil && _List_ClusterReplicationConfiguration_Equals(v.Clusters, rhs.Clusters))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ActiveClusterName, rhs.ActiveClusterName) {
-		return false
-	}
-	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && _Map_String_String_Equals(v.Data, rhs.Data))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !_ArchivalStatus_EqualsPtr(v.HistoryArchivalStatus, rhs.HistoryArchivalStatus) {
+	if !_I32_EqualsPtr(v.Type, rhs.Type) {
 		return false
 	}
-	if !_String_EqualsPtr(v.HistoryArchivalURI, rhs.HistoryArchivalURI) {
+	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
 		return false
 	}
-	if !_ArchivalStatus_EqualsPtr(v.VisibilityArchivalStatus, rhs.VisibilityArchivalStatus) {
+	if !_I64_EqualsPtr(v.VisibilityTimestamp, rhs.VisibilityTimestamp) {
 		return false
 	}
-	if !_String_EqualsPtr(v.VisibilityArchivalURI, rhs.VisibilityArchivalURI) {
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
 		return false
 	}
 
@@ -61467,271 +68007,109 @@ func (v *RegisterDomainRequest) Equals(rhs *RegisterDomainRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RegisterDomainRequest.
-func (v *RegisterDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RemoveTaskRequest.
+func (v *RemoveTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Name != nil {
-		enc.AddString("name", *v.Name)
-	}
-	if v.Description != nil {
-		enc.AddString("description", *v.Description)
-	}
-	if v.OwnerEmail != nil {
-		enc.AddString("ownerEmail", *v.OwnerEmail)
-	}
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		enc.AddInt32("workflowExecutionRetentionPeriodInDays", *v.WorkflowExecutionRetentionPeriodInDays)
-	}
-	if v.EmitMetric != nil {
-		enc.AddBool("emitMetric", *v.EmitMetric)
-	}
-	if v.Clusters != nil {
-		err = multierr.Append(err, enc.AddArray("clusters", (_List_ClusterReplicationConfiguration_Zapper)(v.Clusters)))
-	}
-	if v.ActiveClusterName != nil {
-		enc.AddString("activeClusterName", *v.ActiveClusterName)
-	}
-	if v.Data != nil {
-		err = multierr.Append(err, enc.AddObject("data", (_Map_String_String_Zapper)(v.Data)))
-	}
-	if v.SecurityToken != nil {
-		enc.AddString("securityToken", *v.SecurityToken)
-	}
-	if v.IsGlobalDomain != nil {
-		enc.AddBool("isGlobalDomain", *v.IsGlobalDomain)
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
 	}
-	if v.HistoryArchivalStatus != nil {
-		err = multierr.Append(err, enc.AddObject("historyArchivalStatus", *v.HistoryArchivalStatus))
+	if v.Type != nil {
+		enc.AddInt32("type", *v.Type)
 	}
-	if v.HistoryArchivalURI != nil {
-		enc.AddString("historyArchivalURI", *v.HistoryArchivalURI)
+	if v.TaskID != nil {
+		enc.AddInt64("taskID", *v.TaskID)
 	}
-	if v.VisibilityArchivalStatus != nil {
-		err = multierr.Append(err, enc.AddObject("visibilityArchivalStatus", *v.VisibilityArchivalStatus))
+	if v.VisibilityTimestamp != nil {
+		enc.AddInt64("visibilityTimestamp", *v.VisibilityTimestamp)
 	}
-	if v.VisibilityArchivalURI != nil {
-		enc.AddString("visibilityArchivalURI", *v.VisibilityArchivalURI)
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetName() (o string) {
-	if v != nil && v.Name != nil {
-		return *v.Name
-	}
-
-	return
-}
-
-// IsSetName returns true if Name is not nil.
-func (v *RegisterDomainRequest) IsSetName() bool {
-	return v != nil && v.Name != nil
-}
-
-// GetDescription returns the value of Description if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetDescription() (o string) {
-	if v != nil && v.Description != nil {
-		return *v.Description
-	}
-
-	return
-}
-
-// IsSetDescription returns true if Description is not nil.
-func (v *RegisterDomainRequest) IsSetDescription() bool {
-	return v != nil && v.Description != nil
-}
-
-// GetOwnerEmail returns the value of OwnerEmail if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetOwnerEmail() (o string) {
-	if v != nil && v.OwnerEmail != nil {
-		return *v.OwnerEmail
-	}
-
-	return
-}
-
-// IsSetOwnerEmail returns true if OwnerEmail is not nil.
-func (v *RegisterDomainRequest) IsSetOwnerEmail() bool {
-	return v != nil && v.OwnerEmail != nil
-}
-
-// GetWorkflowExecutionRetentionPeriodInDays returns the value of WorkflowExecutionRetentionPeriodInDays if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetWorkflowExecutionRetentionPeriodInDays() (o int32) {
-	if v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil {
-		return *v.WorkflowExecutionRetentionPeriodInDays
-	}
-
-	return
-}
-
-// IsSetWorkflowExecutionRetentionPeriodInDays returns true if WorkflowExecutionRetentionPeriodInDays is not nil.
-func (v *RegisterDomainRequest) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
-	return v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil
-}
-
-// GetEmitMetric returns the value of EmitMetric if it is set or its
-// default value if it is unset.
-func (v *RegisterDomainRequest) GetEmitMetric() (o bool) {
-	if v != nil && v.EmitMetric != nil {
-		return *v.EmitMetric
-	}
-	o = true
-	return
-}
-
-// IsSetEmitMetric returns true if EmitMetric is not nil.
-func (v *RegisterDomainRequest) IsSetEmitMetric() bool {
-	return v != nil && v.EmitMetric != nil
-}
-
-// GetClusters returns the value of Clusters if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetClusters() (o []*ClusterReplicationConfiguration) {
-	if v != nil && v.Clusters != nil {
-		return v.Clusters
-	}
-
-	return
-}
-
-// IsSetClusters returns true if Clusters is not nil.
-func (v *RegisterDomainRequest) IsSetClusters() bool {
-	return v != nil && v.Clusters != nil
-}
-
-// GetActiveClusterName returns the value of ActiveClusterName if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetActiveClusterName() (o string) {
-	if v != nil && v.ActiveClusterName != nil {
-		return *v.ActiveClusterName
-	}
-
-	return
-}
-
-// IsSetActiveClusterName returns true if ActiveClusterName is not nil.
-func (v *RegisterDomainRequest) IsSetActiveClusterName() bool {
-	return v != nil && v.ActiveClusterName != nil
-}
-
-// GetData returns the value of Data if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetData() (o map[string]string) {
-	if v != nil && v.Data != nil {
-		return v.Data
-	}
-
-	return
-}
-
-// IsSetData returns true if Data is not nil.
-func (v *RegisterDomainRequest) IsSetData() bool {
-	return v != nil && v.Data != nil
-}
-
-// GetSecurityToken returns the value of SecurityToken if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetSecurityToken() (o string) {
-	if v != nil && v.SecurityToken != nil {
-		return *v.SecurityToken
-	}
-
-	return
-}
-
-// IsSetSecurityToken returns true if SecurityToken is not nil.
-func (v *RegisterDomainRequest) IsSetSecurityToken() bool {
-	return v != nil && v.SecurityToken != nil
-}
-
-// GetIsGlobalDomain returns the value of IsGlobalDomain if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetIsGlobalDomain() (o bool) {
-	if v != nil && v.IsGlobalDomain != nil {
-		return *v.IsGlobalDomain
+func (v *RemoveTaskRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// IsSetIsGlobalDomain returns true if IsGlobalDomain is not nil.
-func (v *RegisterDomainRequest) IsSetIsGlobalDomain() bool {
-	return v != nil && v.IsGlobalDomain != nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *RemoveTaskRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// GetHistoryArchivalStatus returns the value of HistoryArchivalStatus if it is set or its
+// GetType returns the value of Type if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetHistoryArchivalStatus() (o ArchivalStatus) {
-	if v != nil && v.HistoryArchivalStatus != nil {
-		return *v.HistoryArchivalStatus
+func (v *RemoveTaskRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
 	}
 
 	return
 }
 
-// IsSetHistoryArchivalStatus returns true if HistoryArchivalStatus is not nil.
-func (v *RegisterDomainRequest) IsSetHistoryArchivalStatus() bool {
-	return v != nil && v.HistoryArchivalStatus != nil
+// IsSetType returns true if Type is not nil.
+func (v *RemoveTaskRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
 }
 
-// GetHistoryArchivalURI returns the value of HistoryArchivalURI if it is set or its
+// GetTaskID returns the value of TaskID if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetHistoryArchivalURI() (o string) {
-	if v != nil && v.HistoryArchivalURI != nil {
-		return *v.HistoryArchivalURI
+func (v *RemoveTaskRequest) GetTaskID() (o int64) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
 	}
 
 	return
 }
 
-// IsSetHistoryArchivalURI returns true if HistoryArchivalURI is not nil.
-func (v *RegisterDomainRequest) IsSetHistoryArchivalURI() bool {
-	return v != nil && v.HistoryArchivalURI != nil
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *RemoveTaskRequest) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
 }
 
-// GetVisibilityArchivalStatus returns the value of VisibilityArchivalStatus if it is set or its
+// GetVisibilityTimestamp returns the value of VisibilityTimestamp if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetVisibilityArchivalStatus() (o ArchivalStatus) {
-	if v != nil && v.VisibilityArchivalStatus != nil {
-		return *v.VisibilityArchivalStatus
+func (v *RemoveTaskRequest) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
 	}
 
 	return
 }
 
-// IsSetVisibilityArchivalStatus returns true if VisibilityArchivalStatus is not nil.
-func (v *RegisterDomainRequest) IsSetVisibilityArchivalStatus() bool {
-	return v != nil && v.VisibilityArchivalStatus != nil
+// IsSetVisibilityTimestamp returns true if VisibilityTimestamp is not nil.
+func (v *RemoveTaskRequest) IsSetVisibilityTimestamp() bool {
+	return v != nil && v.VisibilityTimestamp != nil
 }
 
-// GetVisibilityArchivalURI returns the value of VisibilityArchivalURI if it is set or its
+// GetClusterName returns the value of ClusterName if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetVisibilityArchivalURI() (o string) {
-	if v != nil && v.VisibilityArchivalURI != nil {
-		return *v.VisibilityArchivalURI
+func (v *RemoveTaskRequest) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
 	}
 
 	return
 }
 
-// IsSetVisibilityArchivalURI returns true if VisibilityArchivalURI is not nil.
-func (v *RegisterDomainRequest) IsSetVisibilityArchivalURI() bool {
-	return v != nil && v.VisibilityArchivalURI != nil
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *RemoveTaskRequest) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
 }
 
-type RemoteSyncMatchedError struct {
-	Message string `json:"message,required"`
+type RequestCancelActivityTaskDecisionAttributes struct {
+	ActivityId *string `json:"activityId,omitempty"`
 }
 
-// ToWire translates a RemoteSyncMatchedError struct into a Thrift-level intermediate
+// ToWire translates a RequestCancelActivityTaskDecisionAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -61746,7 +68124,7 @@ type RemoteSyncMatchedError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RemoteSyncMatchedError) ToWire() (wire.Value, error) {
+func (v *RequestCancelActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -61754,21 +68132,23 @@ func (v *RemoteSyncMatchedError) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.ActivityId != nil {
+		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 10, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RemoteSyncMatchedError struct from its Thrift-level
+// FromWire deserializes a RequestCancelActivityTaskDecisionAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RemoteSyncMatchedError struct
+// An error is returned if we were unable to build a RequestCancelActivityTaskDecisionAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -61776,66 +68156,62 @@ func (v *RemoteSyncMatchedError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RemoteSyncMatchedError
+//   var v RequestCancelActivityTaskDecisionAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RemoteSyncMatchedError) FromWire(w wire.Value) error {
+func (v *RequestCancelActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityId = &x
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
 			}
 		}
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of RemoteSyncMatchedError is required")
-	}
-
 	return nil
 }
 
-// Encode serializes a RemoteSyncMatchedError struct directly into bytes, without going
+// Encode serializes a RequestCancelActivityTaskDecisionAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RemoteSyncMatchedError struct could not be encoded.
-func (v *RemoteSyncMatchedError) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelActivityTaskDecisionAttributes struct could not be encoded.
+func (v *RequestCancelActivityTaskDecisionAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.Message); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+	if v.ActivityId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ActivityId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RemoteSyncMatchedError struct directly from its Thrift-level
+// Decode deserializes a RequestCancelActivityTaskDecisionAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RemoteSyncMatchedError struct could not be generated from the wire
+// An error is returned if a RequestCancelActivityTaskDecisionAttributes struct could not be generated from the wire
 // representation.
-func (v *RemoteSyncMatchedError) Decode(sr stream.Reader) error {
-
-	messageIsSet := false
+func (v *RequestCancelActivityTaskDecisionAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -61849,11 +68225,13 @@ func (v *RemoteSyncMatchedError) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
-			v.Message, err = sr.ReadString()
+			var x string
+			x, err = sr.ReadString()
+			v.ActivityId = &x
 			if err != nil {
 				return err
 			}
-			messageIsSet = true
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -61873,45 +68251,37 @@ func (v *RemoteSyncMatchedError) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of RemoteSyncMatchedError is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a RemoteSyncMatchedError
+// String returns a readable string representation of a RequestCancelActivityTaskDecisionAttributes
 // struct.
-func (v *RemoteSyncMatchedError) String() string {
+func (v *RequestCancelActivityTaskDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-
-	return fmt.Sprintf("RemoteSyncMatchedError{%v}", strings.Join(fields[:i], ", "))
-}
+	if v.ActivityId != nil {
+		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+		i++
+	}
 
-// ErrorName is the name of this type as defined in the Thrift
-// file.
-func (*RemoteSyncMatchedError) ErrorName() string {
-	return "RemoteSyncMatchedError"
+	return fmt.Sprintf("RequestCancelActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RemoteSyncMatchedError match the
-// provided RemoteSyncMatchedError.
+// Equals returns true if all the fields of this RequestCancelActivityTaskDecisionAttributes match the
+// provided RequestCancelActivityTaskDecisionAttributes.
 //
 // This function performs a deep comparison.
-func (v *RemoteSyncMatchedError) Equals(rhs *RemoteSyncMatchedError) bool {
+func (v *RequestCancelActivityTaskDecisionAttributes) Equals(rhs *RequestCancelActivityTaskDecisionAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Message == rhs.Message) {
+	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
 		return false
 	}
 
@@ -61919,37 +68289,39 @@ func (v *RemoteSyncMatchedError) Equals(rhs *RemoteSyncMatchedError) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RemoteSyncMatchedError.
-func (v *RemoteSyncMatchedError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelActivityTaskDecisionAttributes.
+func (v *RequestCancelActivityTaskDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("message", v.Message)
+	if v.ActivityId != nil {
+		enc.AddString("activityId", *v.ActivityId)
+	}
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetActivityId returns the value of ActivityId if it is set or its
 // zero value if it is unset.
-func (v *RemoteSyncMatchedError) GetMessage() (o string) {
-	if v != nil {
-		o = v.Message
+func (v *RequestCancelActivityTaskDecisionAttributes) GetActivityId() (o string) {
+	if v != nil && v.ActivityId != nil {
+		return *v.ActivityId
 	}
+
 	return
 }
 
-func (v *RemoteSyncMatchedError) Error() string {
-	return v.String()
+// IsSetActivityId returns true if ActivityId is not nil.
+func (v *RequestCancelActivityTaskDecisionAttributes) IsSetActivityId() bool {
+	return v != nil && v.ActivityId != nil
 }
 
-type RemoveTaskRequest struct {
-	ShardID             *int32  `json:"shardID,omitempty"`
-	Type                *int32  `json:"type,omitempty"`
-	TaskID              *int64  `json:"taskID,omitempty"`
-	VisibilityTimestamp *int64  `json:"visibilityTimestamp,omitempty"`
-	ClusterName         *string `json:"clusterName,omitempty"`
+type RequestCancelActivityTaskFailedEventAttributes struct {
+	ActivityId                   *string `json:"activityId,omitempty"`
+	Cause                        *string `json:"cause,omitempty"`
+	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
 }
 
-// ToWire translates a RemoveTaskRequest struct into a Thrift-level intermediate
+// ToWire translates a RequestCancelActivityTaskFailedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -61964,63 +68336,47 @@ type RemoveTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RemoveTaskRequest) ToWire() (wire.Value, error) {
+func (v *RequestCancelActivityTaskFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ShardID != nil {
-		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+	if v.ActivityId != nil {
+		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Type != nil {
-		w, err = wire.NewValueI32(*(v.Type)), error(nil)
+	if v.Cause != nil {
+		w, err = wire.NewValueString(*(v.Cause)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.TaskID != nil {
-		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.VisibilityTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.VisibilityTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ClusterName != nil {
-		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RemoveTaskRequest struct from its Thrift-level
+// FromWire deserializes a RequestCancelActivityTaskFailedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RemoveTaskRequest struct
+// An error is returned if we were unable to build a RequestCancelActivityTaskFailedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -62028,31 +68384,31 @@ func (v *RemoveTaskRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RemoveTaskRequest
+//   var v RequestCancelActivityTaskFailedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RemoveTaskRequest) FromWire(w wire.Value) error {
+func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ShardID = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Type = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Cause = &x
 				if err != nil {
 					return err
 				}
@@ -62062,27 +68418,7 @@ func (v *RemoveTaskRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.TaskID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.VisibilityTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ClusterName = &x
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
@@ -62094,20 +68430,20 @@ func (v *RemoveTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a RemoveTaskRequest struct directly into bytes, without going
+// Encode serializes a RequestCancelActivityTaskFailedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RemoveTaskRequest struct could not be encoded.
-func (v *RemoveTaskRequest) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelActivityTaskFailedEventAttributes struct could not be encoded.
+func (v *RequestCancelActivityTaskFailedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ShardID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+	if v.ActivityId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+		if err := sw.WriteString(*(v.ActivityId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -62115,11 +68451,11 @@ func (v *RemoveTaskRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Type != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
+	if v.Cause != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.Type)); err != nil {
+		if err := sw.WriteString(*(v.Cause)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -62127,35 +68463,11 @@ func (v *RemoveTaskRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.TaskID != nil {
+	if v.DecisionTaskCompletedEventId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.TaskID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.VisibilityTimestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.VisibilityTimestamp)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ClusterName != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.ClusterName)); err != nil {
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -62166,12 +68478,12 @@ func (v *RemoveTaskRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RemoveTaskRequest struct directly from its Thrift-level
+// Decode deserializes a RequestCancelActivityTaskFailedEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RemoveTaskRequest struct could not be generated from the wire
+// An error is returned if a RequestCancelActivityTaskFailedEventAttributes struct could not be generated from the wire
 // representation.
-func (v *RemoveTaskRequest) Decode(sr stream.Reader) error {
+func (v *RequestCancelActivityTaskFailedEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -62184,18 +68496,18 @@ func (v *RemoveTaskRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.ShardID = &x
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ActivityId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.Type = &x
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Cause = &x
 			if err != nil {
 				return err
 			}
@@ -62203,23 +68515,7 @@ func (v *RemoveTaskRequest) Decode(sr stream.Reader) error {
 		case fh.ID == 30 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.TaskID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.VisibilityTimestamp = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ClusterName = &x
+			v.DecisionTaskCompletedEventId = &x
 			if err != nil {
 				return err
 			}
@@ -62246,62 +68542,48 @@ func (v *RemoveTaskRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a RemoveTaskRequest
+// String returns a readable string representation of a RequestCancelActivityTaskFailedEventAttributes
 // struct.
-func (v *RemoveTaskRequest) String() string {
+func (v *RequestCancelActivityTaskFailedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
-	if v.ShardID != nil {
-		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
-		i++
-	}
-	if v.Type != nil {
-		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
-		i++
-	}
-	if v.TaskID != nil {
-		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+	if v.ActivityId != nil {
+		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
 		i++
 	}
-	if v.VisibilityTimestamp != nil {
-		fields[i] = fmt.Sprintf("VisibilityTimestamp: %v", *(v.VisibilityTimestamp))
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
 		i++
 	}
-	if v.ClusterName != nil {
-		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
 
-	return fmt.Sprintf("RemoveTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelActivityTaskFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RemoveTaskRequest match the
-// provided RemoveTaskRequest.
+// Equals returns true if all the fields of this RequestCancelActivityTaskFailedEventAttributes match the
+// provided RequestCancelActivityTaskFailedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *RemoveTaskRequest) Equals(rhs *RemoveTaskRequest) bool {
+func (v *RequestCancelActivityTaskFailedEventAttributes) Equals(rhs *RequestCancelActivityTaskFailedEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.Type, rhs.Type) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
+	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.VisibilityTimestamp, rhs.VisibilityTimestamp) {
+	if !_String_EqualsPtr(v.Cause, rhs.Cause) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
 
@@ -62309,109 +68591,77 @@ func (v *RemoveTaskRequest) Equals(rhs *RemoveTaskRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RemoveTaskRequest.
-func (v *RemoveTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelActivityTaskFailedEventAttributes.
+func (v *RequestCancelActivityTaskFailedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ShardID != nil {
-		enc.AddInt32("shardID", *v.ShardID)
-	}
-	if v.Type != nil {
-		enc.AddInt32("type", *v.Type)
-	}
-	if v.TaskID != nil {
-		enc.AddInt64("taskID", *v.TaskID)
+	if v.ActivityId != nil {
+		enc.AddString("activityId", *v.ActivityId)
 	}
-	if v.VisibilityTimestamp != nil {
-		enc.AddInt64("visibilityTimestamp", *v.VisibilityTimestamp)
+	if v.Cause != nil {
+		enc.AddString("cause", *v.Cause)
 	}
-	if v.ClusterName != nil {
-		enc.AddString("clusterName", *v.ClusterName)
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
 	return err
 }
 
-// GetShardID returns the value of ShardID if it is set or its
-// zero value if it is unset.
-func (v *RemoveTaskRequest) GetShardID() (o int32) {
-	if v != nil && v.ShardID != nil {
-		return *v.ShardID
-	}
-
-	return
-}
-
-// IsSetShardID returns true if ShardID is not nil.
-func (v *RemoveTaskRequest) IsSetShardID() bool {
-	return v != nil && v.ShardID != nil
-}
-
-// GetType returns the value of Type if it is set or its
-// zero value if it is unset.
-func (v *RemoveTaskRequest) GetType() (o int32) {
-	if v != nil && v.Type != nil {
-		return *v.Type
-	}
-
-	return
-}
-
-// IsSetType returns true if Type is not nil.
-func (v *RemoveTaskRequest) IsSetType() bool {
-	return v != nil && v.Type != nil
-}
-
-// GetTaskID returns the value of TaskID if it is set or its
+// GetActivityId returns the value of ActivityId if it is set or its
 // zero value if it is unset.
-func (v *RemoveTaskRequest) GetTaskID() (o int64) {
-	if v != nil && v.TaskID != nil {
-		return *v.TaskID
+func (v *RequestCancelActivityTaskFailedEventAttributes) GetActivityId() (o string) {
+	if v != nil && v.ActivityId != nil {
+		return *v.ActivityId
 	}
 
 	return
 }
 
-// IsSetTaskID returns true if TaskID is not nil.
-func (v *RemoveTaskRequest) IsSetTaskID() bool {
-	return v != nil && v.TaskID != nil
+// IsSetActivityId returns true if ActivityId is not nil.
+func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetActivityId() bool {
+	return v != nil && v.ActivityId != nil
 }
 
-// GetVisibilityTimestamp returns the value of VisibilityTimestamp if it is set or its
+// GetCause returns the value of Cause if it is set or its
 // zero value if it is unset.
-func (v *RemoveTaskRequest) GetVisibilityTimestamp() (o int64) {
-	if v != nil && v.VisibilityTimestamp != nil {
-		return *v.VisibilityTimestamp
+func (v *RequestCancelActivityTaskFailedEventAttributes) GetCause() (o string) {
+	if v != nil && v.Cause != nil {
+		return *v.Cause
 	}
 
 	return
 }
 
-// IsSetVisibilityTimestamp returns true if VisibilityTimestamp is not nil.
-func (v *RemoveTaskRequest) IsSetVisibilityTimestamp() bool {
-	return v != nil && v.VisibilityTimestamp != nil
+// IsSetCause returns true if Cause is not nil.
+func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetCause() bool {
+	return v != nil && v.Cause != nil
 }
 
-// GetClusterName returns the value of ClusterName if it is set or its
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *RemoveTaskRequest) GetClusterName() (o string) {
-	if v != nil && v.ClusterName != nil {
-		return *v.ClusterName
+func (v *RequestCancelActivityTaskFailedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
 	}
 
 	return
 }
 
-// IsSetClusterName returns true if ClusterName is not nil.
-func (v *RemoveTaskRequest) IsSetClusterName() bool {
-	return v != nil && v.ClusterName != nil
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
 }
 
-type RequestCancelActivityTaskDecisionAttributes struct {
-	ActivityId *string `json:"activityId,omitempty"`
+type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
+	Domain            *string `json:"domain,omitempty"`
+	WorkflowId        *string `json:"workflowId,omitempty"`
+	RunId             *string `json:"runId,omitempty"`
+	Control           []byte  `json:"control,omitempty"`
+	ChildWorkflowOnly *bool   `json:"childWorkflowOnly,omitempty"`
 }
 
-// ToWire translates a RequestCancelActivityTaskDecisionAttributes struct into a Thrift-level intermediate
+// ToWire translates a RequestCancelExternalWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -62426,31 +68676,63 @@ type RequestCancelActivityTaskDecisionAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ActivityId != nil {
-		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ChildWorkflowOnly != nil {
+		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RequestCancelActivityTaskDecisionAttributes struct from its Thrift-level
+// FromWire deserializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelActivityTaskDecisionAttributes struct
+// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionDecisionAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -62458,12 +68740,12 @@ func (v *RequestCancelActivityTaskDecisionAttributes) ToWire() (wire.Value, erro
 //     return nil, err
 //   }
 //
-//   var v RequestCancelActivityTaskDecisionAttributes
+//   var v RequestCancelExternalWorkflowExecutionDecisionAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -62472,7 +68754,45 @@ func (v *RequestCancelActivityTaskDecisionAttributes) FromWire(w wire.Value) err
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ActivityId = &x
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Control, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ChildWorkflowOnly = &x
 				if err != nil {
 					return err
 				}
@@ -62484,20 +68804,68 @@ func (v *RequestCancelActivityTaskDecisionAttributes) FromWire(w wire.Value) err
 	return nil
 }
 
-// Encode serializes a RequestCancelActivityTaskDecisionAttributes struct directly into bytes, without going
+// Encode serializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RequestCancelActivityTaskDecisionAttributes struct could not be encoded.
-func (v *RequestCancelActivityTaskDecisionAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelExternalWorkflowExecutionDecisionAttributes struct could not be encoded.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ActivityId != nil {
+	if v.Domain != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ActivityId)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RunId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RunId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Control != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Control); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ChildWorkflowOnly != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.ChildWorkflowOnly)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -62508,12 +68876,12 @@ func (v *RequestCancelActivityTaskDecisionAttributes) Encode(sw stream.Writer) e
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RequestCancelActivityTaskDecisionAttributes struct directly from its Thrift-level
+// Decode deserializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelActivityTaskDecisionAttributes struct could not be generated from the wire
+// An error is returned if a RequestCancelExternalWorkflowExecutionDecisionAttributes struct could not be generated from the wire
 // representation.
-func (v *RequestCancelActivityTaskDecisionAttributes) Decode(sr stream.Reader) error {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -62529,7 +68897,37 @@ func (v *RequestCancelActivityTaskDecisionAttributes) Decode(sr stream.Reader) e
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.ActivityId = &x
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			v.Control, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.ChildWorkflowOnly = &x
 			if err != nil {
 				return err
 			}
@@ -62556,34 +68954,62 @@ func (v *RequestCancelActivityTaskDecisionAttributes) Decode(sr stream.Reader) e
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelActivityTaskDecisionAttributes
+// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionDecisionAttributes
 // struct.
-func (v *RequestCancelActivityTaskDecisionAttributes) String() string {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [5]string
 	i := 0
-	if v.ActivityId != nil {
-		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+		i++
+	}
+	if v.ChildWorkflowOnly != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelActivityTaskDecisionAttributes match the
-// provided RequestCancelActivityTaskDecisionAttributes.
+// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionDecisionAttributes match the
+// provided RequestCancelExternalWorkflowExecutionDecisionAttributes.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelActivityTaskDecisionAttributes) Equals(rhs *RequestCancelActivityTaskDecisionAttributes) bool {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionDecisionAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
 		return false
 	}
 
@@ -62591,39 +69017,114 @@ func (v *RequestCancelActivityTaskDecisionAttributes) Equals(rhs *RequestCancelA
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelActivityTaskDecisionAttributes.
-func (v *RequestCancelActivityTaskDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelExternalWorkflowExecutionDecisionAttributes.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ActivityId != nil {
-		enc.AddString("activityId", *v.ActivityId)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.Control != nil {
+		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
+	}
+	if v.ChildWorkflowOnly != nil {
+		enc.AddBool("childWorkflowOnly", *v.ChildWorkflowOnly)
 	}
 	return err
 }
 
-// GetActivityId returns the value of ActivityId if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelActivityTaskDecisionAttributes) GetActivityId() (o string) {
-	if v != nil && v.ActivityId != nil {
-		return *v.ActivityId
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetActivityId returns true if ActivityId is not nil.
-func (v *RequestCancelActivityTaskDecisionAttributes) IsSetActivityId() bool {
-	return v != nil && v.ActivityId != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-type RequestCancelActivityTaskFailedEventAttributes struct {
-	ActivityId                   *string `json:"activityId,omitempty"`
-	Cause                        *string `json:"cause,omitempty"`
-	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
 }
 
-// ToWire translates a RequestCancelActivityTaskFailedEventAttributes struct into a Thrift-level intermediate
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetControl returns the value of Control if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetControl() (o []byte) {
+	if v != nil && v.Control != nil {
+		return v.Control
+	}
+
+	return
+}
+
+// IsSetControl returns true if Control is not nil.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetControl() bool {
+	return v != nil && v.Control != nil
+}
+
+// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetChildWorkflowOnly() (o bool) {
+	if v != nil && v.ChildWorkflowOnly != nil {
+		return *v.ChildWorkflowOnly
+	}
+
+	return
+}
+
+// IsSetChildWorkflowOnly returns true if ChildWorkflowOnly is not nil.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetChildWorkflowOnly() bool {
+	return v != nil && v.ChildWorkflowOnly != nil
+}
+
+type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
+	Cause                        *CancelExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
+	DecisionTaskCompletedEventId *int64                                      `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       *string                                     `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
+	InitiatedEventId             *int64                                      `json:"initiatedEventId,omitempty"`
+	Control                      []byte                                      `json:"control,omitempty"`
+}
+
+// ToWire translates a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -62638,47 +69139,77 @@ type RequestCancelActivityTaskFailedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelActivityTaskFailedEventAttributes) ToWire() (wire.Value, error) {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ActivityId != nil {
-		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+	if v.Cause != nil {
+		w, err = v.Cause.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Cause != nil {
-		w, err = wire.NewValueString(*(v.Cause)), error(nil)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.InitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RequestCancelActivityTaskFailedEventAttributes struct from its Thrift-level
+func _CancelExternalWorkflowExecutionFailedCause_Read(w wire.Value) (CancelExternalWorkflowExecutionFailedCause, error) {
+	var v CancelExternalWorkflowExecutionFailedCause
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelActivityTaskFailedEventAttributes struct
+// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -62686,41 +69217,67 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) ToWire() (wire.Value, e
 //     return nil, err
 //   }
 //
-//   var v RequestCancelActivityTaskFailedEventAttributes
+//   var v RequestCancelExternalWorkflowExecutionFailedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value) error {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActivityId = &x
+			if field.Value.Type() == wire.TI32 {
+				var x CancelExternalWorkflowExecutionFailedCause
+				x, err = _CancelExternalWorkflowExecutionFailedCause_Read(field.Value)
+				v.Cause = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Cause = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+				v.InitiatedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.Control, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -62732,20 +69289,20 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value)
 	return nil
 }
 
-// Encode serializes a RequestCancelActivityTaskFailedEventAttributes struct directly into bytes, without going
+// Encode serializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RequestCancelActivityTaskFailedEventAttributes struct could not be encoded.
-func (v *RequestCancelActivityTaskFailedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct could not be encoded.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ActivityId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.Cause != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ActivityId)); err != nil {
+		if err := v.Cause.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -62753,11 +69310,11 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Encode(sw stream.Writer
 		}
 	}
 
-	if v.Cause != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Cause)); err != nil {
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -62765,11 +69322,47 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Encode(sw stream.Writer
 		}
 	}
 
-	if v.DecisionTaskCompletedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InitiatedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.InitiatedEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Control != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Control); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -62780,12 +69373,18 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Encode(sw stream.Writer
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RequestCancelActivityTaskFailedEventAttributes struct directly from its Thrift-level
+func _CancelExternalWorkflowExecutionFailedCause_Decode(sr stream.Reader) (CancelExternalWorkflowExecutionFailedCause, error) {
+	var v CancelExternalWorkflowExecutionFailedCause
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelActivityTaskFailedEventAttributes struct could not be generated from the wire
+// An error is returned if a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct could not be generated from the wire
 // representation.
-func (v *RequestCancelActivityTaskFailedEventAttributes) Decode(sr stream.Reader) error {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -62798,26 +69397,46 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Decode(sr stream.Reader
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ActivityId = &x
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x CancelExternalWorkflowExecutionFailedCause
+			x, err = _CancelExternalWorkflowExecutionFailedCause_Decode(sr)
+			v.Cause = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionTaskCompletedEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Cause = &x
+			v.Domain = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TI64:
+		case fh.ID == 40 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
+			v.InitiatedEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TBinary:
+			v.Control, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
@@ -62844,19 +69463,15 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Decode(sr stream.Reader
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelActivityTaskFailedEventAttributes
+// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionFailedEventAttributes
 // struct.
-func (v *RequestCancelActivityTaskFailedEventAttributes) String() string {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [6]string
 	i := 0
-	if v.ActivityId != nil {
-		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
-		i++
-	}
 	if v.Cause != nil {
 		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
 		i++
@@ -62865,27 +69480,62 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.InitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+		i++
+	}
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+		i++
+	}
 
-	return fmt.Sprintf("RequestCancelActivityTaskFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelActivityTaskFailedEventAttributes match the
-// provided RequestCancelActivityTaskFailedEventAttributes.
+func _CancelExternalWorkflowExecutionFailedCause_EqualsPtr(lhs, rhs *CancelExternalWorkflowExecutionFailedCause) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionFailedEventAttributes match the
+// provided RequestCancelExternalWorkflowExecutionFailedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelActivityTaskFailedEventAttributes) Equals(rhs *RequestCancelActivityTaskFailedEventAttributes) bool {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionFailedEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
+	if !_CancelExternalWorkflowExecutionFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Cause, rhs.Cause) {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+		return false
+	}
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
 		return false
 	}
 
@@ -62893,77 +69543,131 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Equals(rhs *RequestCanc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelActivityTaskFailedEventAttributes.
-func (v *RequestCancelActivityTaskFailedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelExternalWorkflowExecutionFailedEventAttributes.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ActivityId != nil {
-		enc.AddString("activityId", *v.ActivityId)
-	}
 	if v.Cause != nil {
-		enc.AddString("cause", *v.Cause)
+		err = multierr.Append(err, enc.AddObject("cause", *v.Cause))
 	}
 	if v.DecisionTaskCompletedEventId != nil {
 		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.InitiatedEventId != nil {
+		enc.AddInt64("initiatedEventId", *v.InitiatedEventId)
+	}
+	if v.Control != nil {
+		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
+	}
 	return err
 }
 
-// GetActivityId returns the value of ActivityId if it is set or its
+// GetCause returns the value of Cause if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelActivityTaskFailedEventAttributes) GetActivityId() (o string) {
-	if v != nil && v.ActivityId != nil {
-		return *v.ActivityId
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetCause() (o CancelExternalWorkflowExecutionFailedCause) {
+	if v != nil && v.Cause != nil {
+		return *v.Cause
 	}
 
 	return
 }
 
-// IsSetActivityId returns true if ActivityId is not nil.
-func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetActivityId() bool {
-	return v != nil && v.ActivityId != nil
+// IsSetCause returns true if Cause is not nil.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetCause() bool {
+	return v != nil && v.Cause != nil
+}
+
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
+	}
+
+	return
+}
+
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
 }
 
-// GetCause returns the value of Cause if it is set or its
+// GetInitiatedEventId returns the value of InitiatedEventId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelActivityTaskFailedEventAttributes) GetCause() (o string) {
-	if v != nil && v.Cause != nil {
-		return *v.Cause
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() (o int64) {
+	if v != nil && v.InitiatedEventId != nil {
+		return *v.InitiatedEventId
 	}
 
 	return
 }
 
-// IsSetCause returns true if Cause is not nil.
-func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetCause() bool {
-	return v != nil && v.Cause != nil
+// IsSetInitiatedEventId returns true if InitiatedEventId is not nil.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetInitiatedEventId() bool {
+	return v != nil && v.InitiatedEventId != nil
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// GetControl returns the value of Control if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelActivityTaskFailedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetControl() (o []byte) {
+	if v != nil && v.Control != nil {
+		return v.Control
 	}
 
 	return
 }
 
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
+// IsSetControl returns true if Control is not nil.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetControl() bool {
+	return v != nil && v.Control != nil
 }
 
-type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
-	Domain            *string `json:"domain,omitempty"`
-	WorkflowId        *string `json:"workflowId,omitempty"`
-	RunId             *string `json:"runId,omitempty"`
-	Control           []byte  `json:"control,omitempty"`
-	ChildWorkflowOnly *bool   `json:"childWorkflowOnly,omitempty"`
+type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
+	DecisionTaskCompletedEventId *int64             `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       *string            `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Control                      []byte             `json:"control,omitempty"`
+	ChildWorkflowOnly            *bool              `json:"childWorkflowOnly,omitempty"`
 }
 
-// ToWire translates a RequestCancelExternalWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
+// ToWire translates a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -62978,7 +69682,7 @@ type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -62986,24 +69690,24 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wir
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -63030,11 +69734,11 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wir
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct from its Thrift-level
+// FromWire deserializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionDecisionAttributes struct
+// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -63042,21 +69746,21 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wir
 //     return nil, err
 //   }
 //
-//   var v RequestCancelExternalWorkflowExecutionDecisionAttributes
+//   var v RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
@@ -63066,17 +69770,15 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wi
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -63106,20 +69808,20 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wi
 	return nil
 }
 
-// Encode serializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct directly into bytes, without going
+// Encode serializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionDecisionAttributes struct could not be encoded.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct could not be encoded.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -63127,11 +69829,11 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Encode(sw str
 		}
 	}
 
-	if v.WorkflowId != nil {
+	if v.Domain != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -63139,11 +69841,11 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Encode(sw str
 		}
 	}
 
-	if v.RunId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RunId)); err != nil {
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -63178,12 +69880,12 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Encode(sw str
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct directly from its Thrift-level
+// Decode deserializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionDecisionAttributes struct could not be generated from the wire
+// An error is returned if a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct could not be generated from the wire
 // representation.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Decode(sr stream.Reader) error {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -63196,10 +69898,10 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Decode(sr str
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Domain = &x
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionTaskCompletedEventId = &x
 			if err != nil {
 				return err
 			}
@@ -63207,15 +69909,13 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Decode(sr str
 		case fh.ID == 20 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.WorkflowId = &x
+			v.Domain = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RunId = &x
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -63256,25 +69956,25 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Decode(sr str
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionDecisionAttributes
+// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
 // struct.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) String() string {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [5]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
 	if v.Control != nil {
@@ -63286,26 +69986,26 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) String() stri
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionDecisionAttributes match the
-// provided RequestCancelExternalWorkflowExecutionDecisionAttributes.
+// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionInitiatedEventAttributes match the
+// provided RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionDecisionAttributes) bool {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
 	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
@@ -63319,19 +70019,19 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Equals(rhs *R
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelExternalWorkflowExecutionDecisionAttributes.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
 	if v.Control != nil {
 		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
@@ -63342,54 +70042,54 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) MarshalLogObj
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
 	}
 
 	return
 }
 
-// IsSetDomain returns true if Domain is not nil.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
 }
 
-// GetWorkflowId returns the value of WorkflowId if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetRunId returns the value of RunId if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
 }
 
 // GetControl returns the value of Control if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetControl() (o []byte) {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetControl() (o []byte) {
 	if v != nil && v.Control != nil {
 		return v.Control
 	}
@@ -63398,13 +70098,13 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetControl()
 }
 
 // IsSetControl returns true if Control is not nil.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetControl() bool {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetControl() bool {
 	return v != nil && v.Control != nil
 }
 
 // GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetChildWorkflowOnly() (o bool) {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() (o bool) {
 	if v != nil && v.ChildWorkflowOnly != nil {
 		return *v.ChildWorkflowOnly
 	}
@@ -63413,20 +70113,18 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetChildWorkf
 }
 
 // IsSetChildWorkflowOnly returns true if ChildWorkflowOnly is not nil.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetChildWorkflowOnly() bool {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetChildWorkflowOnly() bool {
 	return v != nil && v.ChildWorkflowOnly != nil
 }
 
-type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
-	Cause                        *CancelExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
-	DecisionTaskCompletedEventId *int64                                      `json:"decisionTaskCompletedEventId,omitempty"`
-	Domain                       *string                                     `json:"domain,omitempty"`
-	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
-	InitiatedEventId             *int64                                      `json:"initiatedEventId,omitempty"`
-	Control                      []byte                                      `json:"control,omitempty"`
+type RequestCancelWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
+	RequestId         *string            `json:"requestId,omitempty"`
 }
 
-// ToWire translates a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a RequestCancelWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -63441,36 +70139,20 @@ type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
+func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Cause != nil {
-		w, err = v.Cause.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 	if v.Domain != nil {
 		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
 	if v.WorkflowExecution != nil {
@@ -63478,40 +70160,34 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) ToWire() (
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.InitiatedEventId != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Control != nil {
-		w, err = wire.NewValueBinary(v.Control), error(nil)
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _CancelExternalWorkflowExecutionFailedCause_Read(w wire.Value) (CancelExternalWorkflowExecutionFailedCause, error) {
-	var v CancelExternalWorkflowExecutionFailedCause
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct from its Thrift-level
+// FromWire deserializes a RequestCancelWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct
+// An error is returned if we were unable to build a RequestCancelWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -63519,37 +70195,17 @@ func _CancelExternalWorkflowExecutionFailedCause_Read(w wire.Value) (CancelExter
 //     return nil, err
 //   }
 //
-//   var v RequestCancelExternalWorkflowExecutionFailedEventAttributes
+//   var v RequestCancelWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
+func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x CancelExternalWorkflowExecutionFailedCause
-				x, err = _CancelExternalWorkflowExecutionFailedCause_Read(field.Value)
-				v.Cause = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -63559,7 +70215,7 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w
 				}
 
 			}
-		case 40:
+		case 20:
 			if field.Value.Type() == wire.TStruct {
 				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
@@ -63567,19 +70223,21 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w
 				}
 
 			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedEventId = &x
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 60:
+		case 40:
 			if field.Value.Type() == wire.TBinary {
-				v.Control, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
 				if err != nil {
 					return err
 				}
@@ -63591,41 +70249,17 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w
 	return nil
 }
 
-// Encode serializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct directly into bytes, without going
+// Encode serializes a RequestCancelWorkflowExecutionRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct could not be encoded.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelWorkflowExecutionRequest struct could not be encoded.
+func (v *RequestCancelWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Cause != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := v.Cause.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.DecisionTaskCompletedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
 		if err := sw.WriteString(*(v.Domain)); err != nil {
@@ -63637,7 +70271,7 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw
 	}
 
 	if v.WorkflowExecution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.WorkflowExecution.Encode(sw); err != nil {
@@ -63648,11 +70282,11 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw
 		}
 	}
 
-	if v.InitiatedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.InitiatedEventId)); err != nil {
+		if err := sw.WriteString(*(v.Identity)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -63660,11 +70294,11 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw
 		}
 	}
 
-	if v.Control != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+	if v.RequestId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.Control); err != nil {
+		if err := sw.WriteString(*(v.RequestId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -63675,18 +70309,12 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw
 	return sw.WriteStructEnd()
 }
 
-func _CancelExternalWorkflowExecutionFailedCause_Decode(sr stream.Reader) (CancelExternalWorkflowExecutionFailedCause, error) {
-	var v CancelExternalWorkflowExecutionFailedCause
-	err := v.Decode(sr)
-	return v, err
-}
-
-// Decode deserializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct directly from its Thrift-level
+// Decode deserializes a RequestCancelWorkflowExecutionRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct could not be generated from the wire
+// An error is returned if a RequestCancelWorkflowExecutionRequest struct could not be generated from the wire
 // representation.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Decode(sr stream.Reader) error {
+func (v *RequestCancelWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -63699,23 +70327,7 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Decode(sr
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x CancelExternalWorkflowExecutionFailedCause
-			x, err = _CancelExternalWorkflowExecutionFailedCause_Decode(sr)
-			v.Cause = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
+		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
 			v.Domain = &x
@@ -63723,22 +70335,24 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Decode(sr
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TStruct:
+		case fh.ID == 20 && fh.Type == wire.TStruct:
 			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.InitiatedEventId = &x
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 60 && fh.Type == wire.TBinary:
-			v.Control, err = sr.ReadBinary()
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RequestId = &x
 			if err != nil {
 				return err
 			}
@@ -63765,23 +70379,15 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Decode(sr
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionFailedEventAttributes
+// String returns a readable string representation of a RequestCancelWorkflowExecutionRequest
 // struct.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() string {
+func (v *RequestCancelWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [4]string
 	i := 0
-	if v.Cause != nil {
-		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
-		i++
-	}
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
-		i++
-	}
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
@@ -63790,54 +70396,38 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() s
 		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.InitiatedEventId != nil {
-		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
-	if v.Control != nil {
-		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _CancelExternalWorkflowExecutionFailedCause_EqualsPtr(lhs, rhs *CancelExternalWorkflowExecutionFailedCause) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionFailedEventAttributes match the
-// provided RequestCancelExternalWorkflowExecutionFailedEventAttributes.
+// Equals returns true if all the fields of this RequestCancelWorkflowExecutionRequest match the
+// provided RequestCancelWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionFailedEventAttributes) bool {
+func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_CancelExternalWorkflowExecutionFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
-		return false
-	}
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
 	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
-	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
 
@@ -63845,65 +70435,29 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Equals(rhs
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelExternalWorkflowExecutionFailedEventAttributes.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelWorkflowExecutionRequest.
+func (v *RequestCancelWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Cause != nil {
-		err = multierr.Append(err, enc.AddObject("cause", *v.Cause))
-	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
-	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
 	if v.WorkflowExecution != nil {
 		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
-	if v.InitiatedEventId != nil {
-		enc.AddInt64("initiatedEventId", *v.InitiatedEventId)
-	}
-	if v.Control != nil {
-		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
-	}
-	return err
-}
-
-// GetCause returns the value of Cause if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetCause() (o CancelExternalWorkflowExecutionFailedCause) {
-	if v != nil && v.Cause != nil {
-		return *v.Cause
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
-
-	return
-}
-
-// IsSetCause returns true if Cause is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetCause() bool {
-	return v != nil && v.Cause != nil
-}
-
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
 	}
-
-	return
-}
-
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
+	return err
 }
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDomain() (o string) {
+func (v *RequestCancelWorkflowExecutionRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -63912,13 +70466,13 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDomain(
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetDomain() bool {
+func (v *RequestCancelWorkflowExecutionRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
 // GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
+func (v *RequestCancelWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
 	if v != nil && v.WorkflowExecution != nil {
 		return v.WorkflowExecution
 	}
@@ -63927,49 +70481,50 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetWorkflo
 }
 
 // IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetWorkflowExecution() bool {
+func (v *RequestCancelWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
 	return v != nil && v.WorkflowExecution != nil
 }
 
-// GetInitiatedEventId returns the value of InitiatedEventId if it is set or its
+// GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() (o int64) {
-	if v != nil && v.InitiatedEventId != nil {
-		return *v.InitiatedEventId
+func (v *RequestCancelWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
 	}
 
 	return
 }
 
-// IsSetInitiatedEventId returns true if InitiatedEventId is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetInitiatedEventId() bool {
-	return v != nil && v.InitiatedEventId != nil
+// IsSetIdentity returns true if Identity is not nil.
+func (v *RequestCancelWorkflowExecutionRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
 }
 
-// GetControl returns the value of Control if it is set or its
+// GetRequestId returns the value of RequestId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetControl() (o []byte) {
-	if v != nil && v.Control != nil {
-		return v.Control
+func (v *RequestCancelWorkflowExecutionRequest) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
 	}
 
 	return
 }
 
-// IsSetControl returns true if Control is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetControl() bool {
-	return v != nil && v.Control != nil
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *RequestCancelWorkflowExecutionRequest) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
 }
 
-type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
-	DecisionTaskCompletedEventId *int64             `json:"decisionTaskCompletedEventId,omitempty"`
-	Domain                       *string            `json:"domain,omitempty"`
-	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Control                      []byte             `json:"control,omitempty"`
-	ChildWorkflowOnly            *bool              `json:"childWorkflowOnly,omitempty"`
+type ResetPointInfo struct {
+	BinaryChecksum           *string `json:"binaryChecksum,omitempty"`
+	RunId                    *string `json:"runId,omitempty"`
+	FirstDecisionCompletedId *int64  `json:"firstDecisionCompletedId,omitempty"`
+	CreatedTimeNano          *int64  `json:"createdTimeNano,omitempty"`
+	ExpiringTimeNano         *int64  `json:"expiringTimeNano,omitempty"`
+	Resettable               *bool   `json:"resettable,omitempty"`
 }
 
-// ToWire translates a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a ResetPointInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -63984,63 +70539,71 @@ type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Value, error) {
+func (v *ResetPointInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.BinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.FirstDecisionCompletedId != nil {
+		w, err = wire.NewValueI64(*(v.FirstDecisionCompletedId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Control != nil {
-		w, err = wire.NewValueBinary(v.Control), error(nil)
+	if v.CreatedTimeNano != nil {
+		w, err = wire.NewValueI64(*(v.CreatedTimeNano)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.ChildWorkflowOnly != nil {
-		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
+	if v.ExpiringTimeNano != nil {
+		w, err = wire.NewValueI64(*(v.ExpiringTimeNano)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.Resettable != nil {
+		w, err = wire.NewValueBool(*(v.Resettable)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct from its Thrift-level
+// FromWire deserializes a ResetPointInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct
+// An error is returned if we were unable to build a ResetPointInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -64048,21 +70611,21 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ToWire(
 //     return nil, err
 //   }
 //
-//   var v RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+//   var v ResetPointInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWire(w wire.Value) error {
+func (v *ResetPointInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.BinaryChecksum = &x
 				if err != nil {
 					return err
 				}
@@ -64072,33 +70635,47 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWir
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.RunId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstDecisionCompletedId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TBinary {
-				v.Control, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CreatedTimeNano = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpiringTimeNano = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
 			if field.Value.Type() == wire.TBool {
 				var x bool
 				x, err = field.Value.GetBool(), error(nil)
-				v.ChildWorkflowOnly = &x
+				v.Resettable = &x
 				if err != nil {
 					return err
 				}
@@ -64110,20 +70687,20 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWir
 	return nil
 }
 
-// Encode serializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct directly into bytes, without going
+// Encode serializes a ResetPointInfo struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct could not be encoded.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a ResetPointInfo struct could not be encoded.
+func (v *ResetPointInfo) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.DecisionTaskCompletedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+	if v.BinaryChecksum != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
+		if err := sw.WriteString(*(v.BinaryChecksum)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -64131,11 +70708,11 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(
 		}
 	}
 
-	if v.Domain != nil {
+	if v.RunId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
+		if err := sw.WriteString(*(v.RunId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -64143,11 +70720,11 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(
 		}
 	}
 
-	if v.WorkflowExecution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+	if v.FirstDecisionCompletedId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := v.WorkflowExecution.Encode(sw); err != nil {
+		if err := sw.WriteInt64(*(v.FirstDecisionCompletedId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -64155,11 +70732,11 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(
 		}
 	}
 
-	if v.Control != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+	if v.CreatedTimeNano != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.Control); err != nil {
+		if err := sw.WriteInt64(*(v.CreatedTimeNano)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -64167,11 +70744,23 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(
 		}
 	}
 
-	if v.ChildWorkflowOnly != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBool}); err != nil {
+	if v.ExpiringTimeNano != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteBool(*(v.ChildWorkflowOnly)); err != nil {
+		if err := sw.WriteInt64(*(v.ExpiringTimeNano)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Resettable != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Resettable)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -64182,12 +70771,12 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct directly from its Thrift-level
+// Decode deserializes a ResetPointInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct could not be generated from the wire
+// An error is returned if a ResetPointInfo struct could not be generated from the wire
 // representation.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Decode(sr stream.Reader) error {
+func (v *ResetPointInfo) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -64200,10 +70789,10 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Decode(
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.BinaryChecksum = &x
 			if err != nil {
 				return err
 			}
@@ -64211,27 +70800,39 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Decode(
 		case fh.ID == 20 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Domain = &x
+			v.RunId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TStruct:
-			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.FirstDecisionCompletedId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TBinary:
-			v.Control, err = sr.ReadBinary()
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.CreatedTimeNano = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TBool:
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ExpiringTimeNano = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TBool:
 			var x bool
 			x, err = sr.ReadBool()
-			v.ChildWorkflowOnly = &x
+			v.Resettable = &x
 			if err != nil {
 				return err
 			}
@@ -64258,62 +70859,69 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Decode(
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+// String returns a readable string representation of a ResetPointInfo
 // struct.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String() string {
+func (v *ResetPointInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.BinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
 		i++
 	}
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.FirstDecisionCompletedId != nil {
+		fields[i] = fmt.Sprintf("FirstDecisionCompletedId: %v", *(v.FirstDecisionCompletedId))
 		i++
 	}
-	if v.Control != nil {
-		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+	if v.CreatedTimeNano != nil {
+		fields[i] = fmt.Sprintf("CreatedTimeNano: %v", *(v.CreatedTimeNano))
 		i++
 	}
-	if v.ChildWorkflowOnly != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
+	if v.ExpiringTimeNano != nil {
+		fields[i] = fmt.Sprintf("ExpiringTimeNano: %v", *(v.ExpiringTimeNano))
+		i++
+	}
+	if v.Resettable != nil {
+		fields[i] = fmt.Sprintf("Resettable: %v", *(v.Resettable))
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetPointInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionInitiatedEventAttributes match the
-// provided RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
+// Equals returns true if all the fields of this ResetPointInfo match the
+// provided ResetPointInfo.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) bool {
+func (v *ResetPointInfo) Equals(rhs *ResetPointInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !_I64_EqualsPtr(v.FirstDecisionCompletedId, rhs.FirstDecisionCompletedId) {
 		return false
 	}
-	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+	if !_I64_EqualsPtr(v.CreatedTimeNano, rhs.CreatedTimeNano) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
+	if !_I64_EqualsPtr(v.ExpiringTimeNano, rhs.ExpiringTimeNano) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Resettable, rhs.Resettable) {
 		return false
 	}
 
@@ -64321,112 +70929,156 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Equals(
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResetPointInfo.
+func (v *ResetPointInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	if v.BinaryChecksum != nil {
+		enc.AddString("binaryChecksum", *v.BinaryChecksum)
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
 	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	if v.FirstDecisionCompletedId != nil {
+		enc.AddInt64("firstDecisionCompletedId", *v.FirstDecisionCompletedId)
 	}
-	if v.Control != nil {
-		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
+	if v.CreatedTimeNano != nil {
+		enc.AddInt64("createdTimeNano", *v.CreatedTimeNano)
 	}
-	if v.ChildWorkflowOnly != nil {
-		enc.AddBool("childWorkflowOnly", *v.ChildWorkflowOnly)
+	if v.ExpiringTimeNano != nil {
+		enc.AddInt64("expiringTimeNano", *v.ExpiringTimeNano)
+	}
+	if v.Resettable != nil {
+		enc.AddBool("resettable", *v.Resettable)
 	}
 	return err
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+func (v *ResetPointInfo) GetBinaryChecksum() (o string) {
+	if v != nil && v.BinaryChecksum != nil {
+		return *v.BinaryChecksum
 	}
 
 	return
 }
 
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
+// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
+func (v *ResetPointInfo) IsSetBinaryChecksum() bool {
+	return v != nil && v.BinaryChecksum != nil
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+func (v *ResetPointInfo) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
 	}
 
 	return
 }
 
-// IsSetDomain returns true if Domain is not nil.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// IsSetRunId returns true if RunId is not nil.
+func (v *ResetPointInfo) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
 }
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// GetFirstDecisionCompletedId returns the value of FirstDecisionCompletedId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+func (v *ResetPointInfo) GetFirstDecisionCompletedId() (o int64) {
+	if v != nil && v.FirstDecisionCompletedId != nil {
+		return *v.FirstDecisionCompletedId
 	}
 
 	return
 }
 
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
+// IsSetFirstDecisionCompletedId returns true if FirstDecisionCompletedId is not nil.
+func (v *ResetPointInfo) IsSetFirstDecisionCompletedId() bool {
+	return v != nil && v.FirstDecisionCompletedId != nil
 }
 
-// GetControl returns the value of Control if it is set or its
+// GetCreatedTimeNano returns the value of CreatedTimeNano if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetControl() (o []byte) {
-	if v != nil && v.Control != nil {
-		return v.Control
+func (v *ResetPointInfo) GetCreatedTimeNano() (o int64) {
+	if v != nil && v.CreatedTimeNano != nil {
+		return *v.CreatedTimeNano
 	}
 
 	return
 }
 
-// IsSetControl returns true if Control is not nil.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetControl() bool {
-	return v != nil && v.Control != nil
+// IsSetCreatedTimeNano returns true if CreatedTimeNano is not nil.
+func (v *ResetPointInfo) IsSetCreatedTimeNano() bool {
+	return v != nil && v.CreatedTimeNano != nil
 }
 
-// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// GetExpiringTimeNano returns the value of ExpiringTimeNano if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() (o bool) {
-	if v != nil && v.ChildWorkflowOnly != nil {
-		return *v.ChildWorkflowOnly
+func (v *ResetPointInfo) GetExpiringTimeNano() (o int64) {
+	if v != nil && v.ExpiringTimeNano != nil {
+		return *v.ExpiringTimeNano
 	}
 
 	return
 }
 
-// IsSetChildWorkflowOnly returns true if ChildWorkflowOnly is not nil.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetChildWorkflowOnly() bool {
-	return v != nil && v.ChildWorkflowOnly != nil
+// IsSetExpiringTimeNano returns true if ExpiringTimeNano is not nil.
+func (v *ResetPointInfo) IsSetExpiringTimeNano() bool {
+	return v != nil && v.ExpiringTimeNano != nil
 }
 
-type RequestCancelWorkflowExecutionRequest struct {
-	Domain            *string            `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Identity          *string            `json:"identity,omitempty"`
-	RequestId         *string            `json:"requestId,omitempty"`
+// GetResettable returns the value of Resettable if it is set or its
+// zero value if it is unset.
+func (v *ResetPointInfo) GetResettable() (o bool) {
+	if v != nil && v.Resettable != nil {
+		return *v.Resettable
+	}
+
+	return
 }
 
-// ToWire translates a RequestCancelWorkflowExecutionRequest struct into a Thrift-level intermediate
+// IsSetResettable returns true if Resettable is not nil.
+func (v *ResetPointInfo) IsSetResettable() bool {
+	return v != nil && v.Resettable != nil
+}
+
+type ResetPoints struct {
+	Points []*ResetPointInfo `json:"points,omitempty"`
+}
+
+type _List_ResetPointInfo_ValueList []*ResetPointInfo
+
+func (v _List_ResetPointInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*ResetPointInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ResetPointInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ResetPointInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ResetPointInfo_ValueList) Close() {}
+
+// ToWire translates a ResetPoints struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -64441,55 +71093,55 @@ type RequestCancelWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *ResetPoints) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.Points != nil {
+		w, err = wire.NewValueList(_List_ResetPointInfo_ValueList(v.Points)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ResetPointInfo_Read(w wire.Value) (*ResetPointInfo, error) {
+	var v ResetPointInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ResetPointInfo_Read(l wire.ValueList) ([]*ResetPointInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
 	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+
+	o := make([]*ResetPointInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ResetPointInfo_Read(x)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-// FromWire deserializes a RequestCancelWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a ResetPoints struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a ResetPoints struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -64497,49 +71149,19 @@ func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RequestCancelWorkflowExecutionRequest
+//   var v ResetPoints
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
-//   return &v, nil
-func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
+//   return &v, nil
+func (v *ResetPoints) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Points, err = _List_ResetPointInfo_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -64551,44 +71173,41 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a RequestCancelWorkflowExecutionRequest struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a RequestCancelWorkflowExecutionRequest struct could not be encoded.
-func (v *RequestCancelWorkflowExecutionRequest) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
+func _List_ResetPointInfo_Encode(val []*ResetPointInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
 		return err
 	}
 
-	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
-			return err
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*ResetPointInfo', index [%v]: value is nil", i)
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
+		if err := v.Encode(sw); err != nil {
 			return err
 		}
 	}
+	return sw.WriteListEnd()
+}
 
-	if v.WorkflowExecution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.WorkflowExecution.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// Encode serializes a ResetPoints struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ResetPoints struct could not be encoded.
+func (v *ResetPoints) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
 
-	if v.Identity != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.Points != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Identity)); err != nil {
+		if err := _List_ResetPointInfo_Encode(v.Points, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -64596,27 +71215,51 @@ func (v *RequestCancelWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RequestId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.RequestId)); err != nil {
-			return err
+	return sw.WriteStructEnd()
+}
+
+func _ResetPointInfo_Decode(sr stream.Reader) (*ResetPointInfo, error) {
+	var v ResetPointInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_ResetPointInfo_Decode(sr stream.Reader) ([]*ResetPointInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*ResetPointInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ResetPointInfo_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
+		o = append(o, v)
 	}
 
-	return sw.WriteStructEnd()
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// Decode deserializes a RequestCancelWorkflowExecutionRequest struct directly from its Thrift-level
+// Decode deserializes a ResetPoints struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelWorkflowExecutionRequest struct could not be generated from the wire
+// An error is returned if a ResetPoints struct could not be generated from the wire
 // representation.
-func (v *RequestCancelWorkflowExecutionRequest) Decode(sr stream.Reader) error {
+func (v *ResetPoints) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -64629,32 +71272,8 @@ func (v *RequestCancelWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Domain = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Identity = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RequestId = &x
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.Points, err = _List_ResetPointInfo_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -64681,152 +71300,100 @@ func (v *RequestCancelWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelWorkflowExecutionRequest
+// String returns a readable string representation of a ResetPoints
 // struct.
-func (v *RequestCancelWorkflowExecutionRequest) String() string {
+func (v *ResetPoints) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [1]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
-		i++
+
+	return fmt.Sprintf("ResetPoints{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ResetPointInfo_Equals(lhs, rhs []*ResetPointInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
-		i++
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
 	}
 
-	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return true
 }
 
-// Equals returns true if all the fields of this RequestCancelWorkflowExecutionRequest match the
-// provided RequestCancelWorkflowExecutionRequest.
+// Equals returns true if all the fields of this ResetPoints match the
+// provided ResetPoints.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
+func (v *ResetPoints) Equals(rhs *ResetPoints) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_ResetPointInfo_Equals(v.Points, rhs.Points))) {
 		return false
 	}
 
 	return true
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelWorkflowExecutionRequest.
-func (v *RequestCancelWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
-	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
-	}
-	if v.Identity != nil {
-		enc.AddString("identity", *v.Identity)
-	}
-	if v.RequestId != nil {
-		enc.AddString("requestId", *v.RequestId)
-	}
-	return err
-}
+type _List_ResetPointInfo_Zapper []*ResetPointInfo
 
-// GetDomain returns the value of Domain if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ResetPointInfo_Zapper.
+func (l _List_ResetPointInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
 	}
-
-	return
-}
-
-// IsSetDomain returns true if Domain is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+	return err
 }
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResetPoints.
+func (v *ResetPoints) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-
-	return
-}
-
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
-}
-
-// GetIdentity returns the value of Identity if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+	if v.Points != nil {
+		err = multierr.Append(err, enc.AddArray("points", (_List_ResetPointInfo_Zapper)(v.Points)))
 	}
-
-	return
-}
-
-// IsSetIdentity returns true if Identity is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+	return err
 }
 
-// GetRequestId returns the value of RequestId if it is set or its
+// GetPoints returns the value of Points if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetRequestId() (o string) {
-	if v != nil && v.RequestId != nil {
-		return *v.RequestId
+func (v *ResetPoints) GetPoints() (o []*ResetPointInfo) {
+	if v != nil && v.Points != nil {
+		return v.Points
 	}
 
 	return
 }
 
-// IsSetRequestId returns true if RequestId is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetRequestId() bool {
-	return v != nil && v.RequestId != nil
+// IsSetPoints returns true if Points is not nil.
+func (v *ResetPoints) IsSetPoints() bool {
+	return v != nil && v.Points != nil
 }
 
-type ResetPointInfo struct {
-	BinaryChecksum           *string `json:"binaryChecksum,omitempty"`
-	RunId                    *string `json:"runId,omitempty"`
-	FirstDecisionCompletedId *int64  `json:"firstDecisionCompletedId,omitempty"`
-	CreatedTimeNano          *int64  `json:"createdTimeNano,omitempty"`
-	ExpiringTimeNano         *int64  `json:"expiringTimeNano,omitempty"`
-	Resettable               *bool   `json:"resettable,omitempty"`
+type ResetQueueRequest struct {
+	ShardID     *int32  `json:"shardID,omitempty"`
+	ClusterName *string `json:"clusterName,omitempty"`
+	Type        *int32  `json:"type,omitempty"`
 }
 
-// ToWire translates a ResetPointInfo struct into a Thrift-level intermediate
+// ToWire translates a ResetQueueRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -64841,71 +71408,47 @@ type ResetPointInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetPointInfo) ToWire() (wire.Value, error) {
+func (v *ResetQueueRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.BinaryChecksum != nil {
-		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.FirstDecisionCompletedId != nil {
-		w, err = wire.NewValueI64(*(v.FirstDecisionCompletedId)), error(nil)
+	if v.Type != nil {
+		w, err = wire.NewValueI32(*(v.Type)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.CreatedTimeNano != nil {
-		w, err = wire.NewValueI64(*(v.CreatedTimeNano)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ExpiringTimeNano != nil {
-		w, err = wire.NewValueI64(*(v.ExpiringTimeNano)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.Resettable != nil {
-		w, err = wire.NewValueBool(*(v.Resettable)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetPointInfo struct from its Thrift-level
+// FromWire deserializes a ResetQueueRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetPointInfo struct
+// An error is returned if we were unable to build a ResetQueueRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -64913,21 +71456,21 @@ func (v *ResetPointInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetPointInfo
+//   var v ResetQueueRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetPointInfo) FromWire(w wire.Value) error {
+func (v *ResetQueueRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.BinaryChecksum = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
 				if err != nil {
 					return err
 				}
@@ -64937,47 +71480,17 @@ func (v *ResetPointInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
+				v.ClusterName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FirstDecisionCompletedId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.CreatedTimeNano = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ExpiringTimeNano = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.Resettable = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Type = &x
 				if err != nil {
 					return err
 				}
@@ -64989,20 +71502,20 @@ func (v *ResetPointInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ResetPointInfo struct directly into bytes, without going
+// Encode serializes a ResetQueueRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ResetPointInfo struct could not be encoded.
-func (v *ResetPointInfo) Encode(sw stream.Writer) error {
+// An error is returned if a ResetQueueRequest struct could not be encoded.
+func (v *ResetQueueRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.BinaryChecksum != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.BinaryChecksum)); err != nil {
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -65010,47 +71523,11 @@ func (v *ResetPointInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RunId != nil {
+	if v.ClusterName != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RunId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.FirstDecisionCompletedId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.FirstDecisionCompletedId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.CreatedTimeNano != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.CreatedTimeNano)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ExpiringTimeNano != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.ExpiringTimeNano)); err != nil {
+		if err := sw.WriteString(*(v.ClusterName)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -65058,11 +71535,11 @@ func (v *ResetPointInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Resettable != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBool}); err != nil {
+	if v.Type != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteBool(*(v.Resettable)); err != nil {
+		if err := sw.WriteInt32(*(v.Type)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -65073,12 +71550,12 @@ func (v *ResetPointInfo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ResetPointInfo struct directly from its Thrift-level
+// Decode deserializes a ResetQueueRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ResetPointInfo struct could not be generated from the wire
+// An error is returned if a ResetQueueRequest struct could not be generated from the wire
 // representation.
-func (v *ResetPointInfo) Decode(sr stream.Reader) error {
+func (v *ResetQueueRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -65091,10 +71568,10 @@ func (v *ResetPointInfo) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.BinaryChecksum = &x
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
 			if err != nil {
 				return err
 			}
@@ -65102,39 +71579,15 @@ func (v *ResetPointInfo) Decode(sr stream.Reader) error {
 		case fh.ID == 20 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.RunId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.FirstDecisionCompletedId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.CreatedTimeNano = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ExpiringTimeNano = &x
+			v.ClusterName = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 60 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.Resettable = &x
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Type = &x
 			if err != nil {
 				return err
 			}
@@ -65161,69 +71614,48 @@ func (v *ResetPointInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetPointInfo
+// String returns a readable string representation of a ResetQueueRequest
 // struct.
-func (v *ResetPointInfo) String() string {
+func (v *ResetQueueRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [3]string
 	i := 0
-	if v.BinaryChecksum != nil {
-		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
-		i++
-	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
-		i++
-	}
-	if v.FirstDecisionCompletedId != nil {
-		fields[i] = fmt.Sprintf("FirstDecisionCompletedId: %v", *(v.FirstDecisionCompletedId))
-		i++
-	}
-	if v.CreatedTimeNano != nil {
-		fields[i] = fmt.Sprintf("CreatedTimeNano: %v", *(v.CreatedTimeNano))
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.ExpiringTimeNano != nil {
-		fields[i] = fmt.Sprintf("ExpiringTimeNano: %v", *(v.ExpiringTimeNano))
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
 		i++
 	}
-	if v.Resettable != nil {
-		fields[i] = fmt.Sprintf("Resettable: %v", *(v.Resettable))
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
 		i++
 	}
 
-	return fmt.Sprintf("ResetPointInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetQueueRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetPointInfo match the
-// provided ResetPointInfo.
+// Equals returns true if all the fields of this ResetQueueRequest match the
+// provided ResetQueueRequest.
 //
 // This function performs a deep comparison.
-func (v *ResetPointInfo) Equals(rhs *ResetPointInfo) bool {
+func (v *ResetQueueRequest) Equals(rhs *ResetQueueRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.FirstDecisionCompletedId, rhs.FirstDecisionCompletedId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.CreatedTimeNano, rhs.CreatedTimeNano) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ExpiringTimeNano, rhs.ExpiringTimeNano) {
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.Resettable, rhs.Resettable) {
+	if !_I32_EqualsPtr(v.Type, rhs.Type) {
 		return false
 	}
 
@@ -65231,156 +71663,74 @@ func (v *ResetPointInfo) Equals(rhs *ResetPointInfo) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetPointInfo.
-func (v *ResetPointInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResetQueueRequest.
+func (v *ResetQueueRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.BinaryChecksum != nil {
-		enc.AddString("binaryChecksum", *v.BinaryChecksum)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
-	}
-	if v.FirstDecisionCompletedId != nil {
-		enc.AddInt64("firstDecisionCompletedId", *v.FirstDecisionCompletedId)
-	}
-	if v.CreatedTimeNano != nil {
-		enc.AddInt64("createdTimeNano", *v.CreatedTimeNano)
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
 	}
-	if v.ExpiringTimeNano != nil {
-		enc.AddInt64("expiringTimeNano", *v.ExpiringTimeNano)
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
 	}
-	if v.Resettable != nil {
-		enc.AddBool("resettable", *v.Resettable)
+	if v.Type != nil {
+		enc.AddInt32("type", *v.Type)
 	}
 	return err
 }
 
-// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
-// zero value if it is unset.
-func (v *ResetPointInfo) GetBinaryChecksum() (o string) {
-	if v != nil && v.BinaryChecksum != nil {
-		return *v.BinaryChecksum
-	}
-
-	return
-}
-
-// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
-func (v *ResetPointInfo) IsSetBinaryChecksum() bool {
-	return v != nil && v.BinaryChecksum != nil
-}
-
-// GetRunId returns the value of RunId if it is set or its
-// zero value if it is unset.
-func (v *ResetPointInfo) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
-	}
-
-	return
-}
-
-// IsSetRunId returns true if RunId is not nil.
-func (v *ResetPointInfo) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
-}
-
-// GetFirstDecisionCompletedId returns the value of FirstDecisionCompletedId if it is set or its
-// zero value if it is unset.
-func (v *ResetPointInfo) GetFirstDecisionCompletedId() (o int64) {
-	if v != nil && v.FirstDecisionCompletedId != nil {
-		return *v.FirstDecisionCompletedId
-	}
-
-	return
-}
-
-// IsSetFirstDecisionCompletedId returns true if FirstDecisionCompletedId is not nil.
-func (v *ResetPointInfo) IsSetFirstDecisionCompletedId() bool {
-	return v != nil && v.FirstDecisionCompletedId != nil
-}
-
-// GetCreatedTimeNano returns the value of CreatedTimeNano if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *ResetPointInfo) GetCreatedTimeNano() (o int64) {
-	if v != nil && v.CreatedTimeNano != nil {
-		return *v.CreatedTimeNano
+func (v *ResetQueueRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// IsSetCreatedTimeNano returns true if CreatedTimeNano is not nil.
-func (v *ResetPointInfo) IsSetCreatedTimeNano() bool {
-	return v != nil && v.CreatedTimeNano != nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ResetQueueRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// GetExpiringTimeNano returns the value of ExpiringTimeNano if it is set or its
+// GetClusterName returns the value of ClusterName if it is set or its
 // zero value if it is unset.
-func (v *ResetPointInfo) GetExpiringTimeNano() (o int64) {
-	if v != nil && v.ExpiringTimeNano != nil {
-		return *v.ExpiringTimeNano
+func (v *ResetQueueRequest) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
 	}
 
 	return
 }
 
-// IsSetExpiringTimeNano returns true if ExpiringTimeNano is not nil.
-func (v *ResetPointInfo) IsSetExpiringTimeNano() bool {
-	return v != nil && v.ExpiringTimeNano != nil
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *ResetQueueRequest) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
 }
 
-// GetResettable returns the value of Resettable if it is set or its
+// GetType returns the value of Type if it is set or its
 // zero value if it is unset.
-func (v *ResetPointInfo) GetResettable() (o bool) {
-	if v != nil && v.Resettable != nil {
-		return *v.Resettable
+func (v *ResetQueueRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
 	}
 
 	return
 }
 
-// IsSetResettable returns true if Resettable is not nil.
-func (v *ResetPointInfo) IsSetResettable() bool {
-	return v != nil && v.Resettable != nil
-}
-
-type ResetPoints struct {
-	Points []*ResetPointInfo `json:"points,omitempty"`
-}
-
-type _List_ResetPointInfo_ValueList []*ResetPointInfo
-
-func (v _List_ResetPointInfo_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*ResetPointInfo', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_ResetPointInfo_ValueList) Size() int {
-	return len(v)
+// IsSetType returns true if Type is not nil.
+func (v *ResetQueueRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
 }
 
-func (_List_ResetPointInfo_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+type ResetStickyTaskListRequest struct {
+	Domain    *string            `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
 }
 
-func (_List_ResetPointInfo_ValueList) Close() {}
-
-// ToWire translates a ResetPoints struct into a Thrift-level intermediate
+// ToWire translates a ResetStickyTaskListRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -65395,55 +71745,39 @@ func (_List_ResetPointInfo_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetPoints) ToWire() (wire.Value, error) {
+func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Points != nil {
-		w, err = wire.NewValueList(_List_ResetPointInfo_ValueList(v.Points)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _ResetPointInfo_Read(w wire.Value) (*ResetPointInfo, error) {
-	var v ResetPointInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_ResetPointInfo_Read(l wire.ValueList) ([]*ResetPointInfo, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*ResetPointInfo, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _ResetPointInfo_Read(x)
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
 		if err != nil {
-			return err
+			return w, err
 		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetPoints struct from its Thrift-level
+// FromWire deserializes a ResetStickyTaskListRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetPoints struct
+// An error is returned if we were unable to build a ResetStickyTaskListRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -65451,19 +71785,29 @@ func _List_ResetPointInfo_Read(l wire.ValueList) ([]*ResetPointInfo, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetPoints
+//   var v ResetStickyTaskListRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetPoints) FromWire(w wire.Value) error {
+func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TList {
-				v.Points, err = _List_ResetPointInfo_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -65475,41 +71819,20 @@ func (v *ResetPoints) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_ResetPointInfo_Encode(val []*ResetPointInfo, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*ResetPointInfo', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a ResetPoints struct directly into bytes, without going
+// Encode serializes a ResetStickyTaskListRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ResetPoints struct could not be encoded.
-func (v *ResetPoints) Encode(sw stream.Writer) error {
+// An error is returned if a ResetStickyTaskListRequest struct could not be encoded.
+func (v *ResetStickyTaskListRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Points != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := _List_ResetPointInfo_Encode(v.Points, sw); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -65517,51 +71840,27 @@ func (v *ResetPoints) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
-
-func _ResetPointInfo_Decode(sr stream.Reader) (*ResetPointInfo, error) {
-	var v ResetPointInfo
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_ResetPointInfo_Decode(sr stream.Reader) ([]*ResetPointInfo, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
+	if v.Execution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
 		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]*ResetPointInfo, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _ResetPointInfo_Decode(sr)
-		if err != nil {
-			return nil, err
+		if err := v.Execution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		o = append(o, v)
 	}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
+	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ResetPoints struct directly from its Thrift-level
+// Decode deserializes a ResetStickyTaskListRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ResetPoints struct could not be generated from the wire
+// An error is returned if a ResetStickyTaskListRequest struct could not be generated from the wire
 // representation.
-func (v *ResetPoints) Decode(sr stream.Reader) error {
+func (v *ResetStickyTaskListRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -65574,8 +71873,16 @@ func (v *ResetPoints) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TList:
-			v.Points, err = _List_ResetPointInfo_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.Execution, err = _WorkflowExecution_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -65602,100 +71909,96 @@ func (v *ResetPoints) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetPoints
+// String returns a readable string representation of a ResetStickyTaskListRequest
 // struct.
-func (v *ResetPoints) String() string {
+func (v *ResetStickyTaskListRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	if v.Points != nil {
-		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-
-	return fmt.Sprintf("ResetPoints{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_ResetPointInfo_Equals(lhs, rhs []*ResetPointInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
 	}
 
-	return true
+	return fmt.Sprintf("ResetStickyTaskListRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetPoints match the
-// provided ResetPoints.
+// Equals returns true if all the fields of this ResetStickyTaskListRequest match the
+// provided ResetStickyTaskListRequest.
 //
 // This function performs a deep comparison.
-func (v *ResetPoints) Equals(rhs *ResetPoints) bool {
+func (v *ResetStickyTaskListRequest) Equals(rhs *ResetStickyTaskListRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_ResetPointInfo_Equals(v.Points, rhs.Points))) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_ResetPointInfo_Zapper []*ResetPointInfo
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_ResetPointInfo_Zapper.
-func (l _List_ResetPointInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetPoints.
-func (v *ResetPoints) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResetStickyTaskListRequest.
+func (v *ResetStickyTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Points != nil {
-		err = multierr.Append(err, enc.AddArray("points", (_List_ResetPointInfo_Zapper)(v.Points)))
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
 	}
 	return err
 }
 
-// GetPoints returns the value of Points if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *ResetPoints) GetPoints() (o []*ResetPointInfo) {
-	if v != nil && v.Points != nil {
-		return v.Points
+func (v *ResetStickyTaskListRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetPoints returns true if Points is not nil.
-func (v *ResetPoints) IsSetPoints() bool {
-	return v != nil && v.Points != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *ResetStickyTaskListRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-type ResetQueueRequest struct {
-	ShardID     *int32  `json:"shardID,omitempty"`
-	ClusterName *string `json:"clusterName,omitempty"`
-	Type        *int32  `json:"type,omitempty"`
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *ResetStickyTaskListRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
 }
 
-// ToWire translates a ResetQueueRequest struct into a Thrift-level intermediate
+// IsSetExecution returns true if Execution is not nil.
+func (v *ResetStickyTaskListRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+type ResetStickyTaskListResponse struct {
+}
+
+// ToWire translates a ResetStickyTaskListResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -65710,154 +72013,60 @@ type ResetQueueRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetQueueRequest) ToWire() (wire.Value, error) {
+func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [0]wire.Field
 		i      int = 0
-		w      wire.Value
-		err    error
 	)
 
-	if v.ShardID != nil {
-		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.ClusterName != nil {
-		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.Type != nil {
-		w, err = wire.NewValueI32(*(v.Type)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetQueueRequest struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a ResetQueueRequest struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v ResetQueueRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ResetQueueRequest) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ShardID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ClusterName = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Type = &x
-				if err != nil {
-					return err
-				}
+// FromWire deserializes a ResetStickyTaskListResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResetStickyTaskListResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResetStickyTaskListResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResetStickyTaskListResponse) FromWire(w wire.Value) error {
 
-			}
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
 		}
 	}
 
 	return nil
 }
 
-// Encode serializes a ResetQueueRequest struct directly into bytes, without going
+// Encode serializes a ResetStickyTaskListResponse struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ResetQueueRequest struct could not be encoded.
-func (v *ResetQueueRequest) Encode(sw stream.Writer) error {
+// An error is returned if a ResetStickyTaskListResponse struct could not be encoded.
+func (v *ResetStickyTaskListResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ShardID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ClusterName != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.ClusterName)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Type != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.Type)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ResetQueueRequest struct directly from its Thrift-level
+// Decode deserializes a ResetStickyTaskListResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ResetQueueRequest struct could not be generated from the wire
+// An error is returned if a ResetStickyTaskListResponse struct could not be generated from the wire
 // representation.
-func (v *ResetQueueRequest) Decode(sr stream.Reader) error {
+func (v *ResetStickyTaskListResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -65870,30 +72079,6 @@ func (v *ResetQueueRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.ShardID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ClusterName = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.Type = &x
-			if err != nil {
-				return err
-			}
-
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -65916,200 +72101,291 @@ func (v *ResetQueueRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetQueueRequest
+// String returns a readable string representation of a ResetStickyTaskListResponse
 // struct.
-func (v *ResetQueueRequest) String() string {
+func (v *ResetStickyTaskListResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [0]string
 	i := 0
-	if v.ShardID != nil {
-		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
-		i++
-	}
-	if v.ClusterName != nil {
-		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
-		i++
-	}
-	if v.Type != nil {
-		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
-		i++
-	}
 
-	return fmt.Sprintf("ResetQueueRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetStickyTaskListResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetQueueRequest match the
-// provided ResetQueueRequest.
+// Equals returns true if all the fields of this ResetStickyTaskListResponse match the
+// provided ResetStickyTaskListResponse.
 //
 // This function performs a deep comparison.
-func (v *ResetQueueRequest) Equals(rhs *ResetQueueRequest) bool {
+func (v *ResetStickyTaskListResponse) Equals(rhs *ResetStickyTaskListResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.Type, rhs.Type) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetQueueRequest.
-func (v *ResetQueueRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResetStickyTaskListResponse.
+func (v *ResetStickyTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ShardID != nil {
-		enc.AddInt32("shardID", *v.ShardID)
-	}
-	if v.ClusterName != nil {
-		enc.AddString("clusterName", *v.ClusterName)
-	}
-	if v.Type != nil {
-		enc.AddInt32("type", *v.Type)
-	}
 	return err
 }
 
-// GetShardID returns the value of ShardID if it is set or its
-// zero value if it is unset.
-func (v *ResetQueueRequest) GetShardID() (o int32) {
-	if v != nil && v.ShardID != nil {
-		return *v.ShardID
-	}
-
-	return
+type ResetWorkflowExecutionPreview struct {
+	ResetPointEvent        *HistoryEvent          `json:"resetPointEvent,omitempty"`
+	DiscardedEvents        []*HistoryEvent        `json:"discardedEvents,omitempty"`
+	ReappliedSignals       []*HistoryEvent        `json:"reappliedSignals,omitempty"`
+	PendingActivities      []*PendingActivityInfo `json:"pendingActivities,omitempty"`
+	PendingTimers          []*PendingTimerInfo    `json:"pendingTimers,omitempty"`
+	OrphanedChildWorkflows []*WorkflowExecution   `json:"orphanedChildWorkflows,omitempty"`
 }
 
-// IsSetShardID returns true if ShardID is not nil.
-func (v *ResetQueueRequest) IsSetShardID() bool {
-	return v != nil && v.ShardID != nil
-}
+type _List_PendingTimerInfo_ValueList []*PendingTimerInfo
 
-// GetClusterName returns the value of ClusterName if it is set or its
-// zero value if it is unset.
-func (v *ResetQueueRequest) GetClusterName() (o string) {
-	if v != nil && v.ClusterName != nil {
-		return *v.ClusterName
+func (v _List_PendingTimerInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*PendingTimerInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return
+func (v _List_PendingTimerInfo_ValueList) Size() int {
+	return len(v)
 }
 
-// IsSetClusterName returns true if ClusterName is not nil.
-func (v *ResetQueueRequest) IsSetClusterName() bool {
-	return v != nil && v.ClusterName != nil
+func (_List_PendingTimerInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-// GetType returns the value of Type if it is set or its
-// zero value if it is unset.
-func (v *ResetQueueRequest) GetType() (o int32) {
-	if v != nil && v.Type != nil {
-		return *v.Type
-	}
+func (_List_PendingTimerInfo_ValueList) Close() {}
 
-	return
+type _List_WorkflowExecution_ValueList []*WorkflowExecution
+
+func (v _List_WorkflowExecution_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*WorkflowExecution', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// IsSetType returns true if Type is not nil.
-func (v *ResetQueueRequest) IsSetType() bool {
-	return v != nil && v.Type != nil
+func (v _List_WorkflowExecution_ValueList) Size() int {
+	return len(v)
 }
 
-type ResetStickyTaskListRequest struct {
-	Domain    *string            `json:"domain,omitempty"`
-	Execution *WorkflowExecution `json:"execution,omitempty"`
+func (_List_WorkflowExecution_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-// ToWire translates a ResetStickyTaskListRequest struct into a Thrift-level intermediate
+func (_List_WorkflowExecution_ValueList) Close() {}
+
+// ToWire translates a ResetWorkflowExecutionPreview struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ResetWorkflowExecutionPreview) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.ResetPointEvent != nil {
+		w, err = v.ResetPointEvent.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.DiscardedEvents != nil {
+		w, err = wire.NewValueList(_List_HistoryEvent_ValueList(v.DiscardedEvents)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ReappliedSignals != nil {
+		w, err = wire.NewValueList(_List_HistoryEvent_ValueList(v.ReappliedSignals)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PendingActivities != nil {
+		w, err = wire.NewValueList(_List_PendingActivityInfo_ValueList(v.PendingActivities)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.PendingTimers != nil {
+		w, err = wire.NewValueList(_List_PendingTimerInfo_ValueList(v.PendingTimers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.OrphanedChildWorkflows != nil {
+		w, err = wire.NewValueList(_List_WorkflowExecution_ValueList(v.OrphanedChildWorkflows)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetStickyTaskListRequest struct from its Thrift-level
+func _PendingTimerInfo_Read(w wire.Value) (*PendingTimerInfo, error) {
+	var v PendingTimerInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_PendingTimerInfo_Read(l wire.ValueList) ([]*PendingTimerInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*PendingTimerInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PendingTimerInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_WorkflowExecution_Read(l wire.ValueList) ([]*WorkflowExecution, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*WorkflowExecution, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkflowExecution_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ResetWorkflowExecutionPreview struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetStickyTaskListRequest struct
+// An error is returned if we were unable to build a ResetWorkflowExecutionPreview struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v ResetStickyTaskListRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ResetWorkflowExecutionPreview
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ResetWorkflowExecutionPreview) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+			if field.Value.Type() == wire.TStruct {
+				v.ResetPointEvent, err = _HistoryEvent_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TList {
+				v.DiscardedEvents, err = _List_HistoryEvent_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.ReappliedSignals, err = _List_HistoryEvent_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.PendingActivities, err = _List_PendingActivityInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TList {
+				v.PendingTimers, err = _List_PendingTimerInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.OrphanedChildWorkflows, err = _List_WorkflowExecution_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -66121,20 +72397,62 @@ func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ResetStickyTaskListRequest struct directly into bytes, without going
+func _List_PendingTimerInfo_Encode(val []*PendingTimerInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*PendingTimerInfo', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_WorkflowExecution_Encode(val []*WorkflowExecution, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*WorkflowExecution', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a ResetWorkflowExecutionPreview struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ResetStickyTaskListRequest struct could not be encoded.
-func (v *ResetStickyTaskListRequest) Encode(sw stream.Writer) error {
+// An error is returned if a ResetWorkflowExecutionPreview struct could not be encoded.
+func (v *ResetWorkflowExecutionPreview) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.ResetPointEvent != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
+		if err := v.ResetPointEvent.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66142,11 +72460,59 @@ func (v *ResetStickyTaskListRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Execution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+	if v.DiscardedEvents != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := v.Execution.Encode(sw); err != nil {
+		if err := _List_HistoryEvent_Encode(v.DiscardedEvents, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ReappliedSignals != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_HistoryEvent_Encode(v.ReappliedSignals, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingActivities != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_PendingActivityInfo_Encode(v.PendingActivities, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingTimers != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_PendingTimerInfo_Encode(v.PendingTimers, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OrphanedChildWorkflows != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_WorkflowExecution_Encode(v.OrphanedChildWorkflows, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66157,12 +72523,78 @@ func (v *ResetStickyTaskListRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ResetStickyTaskListRequest struct directly from its Thrift-level
+func _PendingTimerInfo_Decode(sr stream.Reader) (*PendingTimerInfo, error) {
+	var v PendingTimerInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_PendingTimerInfo_Decode(sr stream.Reader) ([]*PendingTimerInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*PendingTimerInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _PendingTimerInfo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _List_WorkflowExecution_Decode(sr stream.Reader) ([]*WorkflowExecution, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*WorkflowExecution, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _WorkflowExecution_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ResetWorkflowExecutionPreview struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ResetStickyTaskListRequest struct could not be generated from the wire
+// An error is returned if a ResetWorkflowExecutionPreview struct could not be generated from the wire
 // representation.
-func (v *ResetStickyTaskListRequest) Decode(sr stream.Reader) error {
+func (v *ResetWorkflowExecutionPreview) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -66175,16 +72607,38 @@ func (v *ResetStickyTaskListRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Domain = &x
+		case fh.ID == 10 && fh.Type == wire.TStruct:
+			v.ResetPointEvent, err = _HistoryEvent_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.Execution, err = _WorkflowExecution_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.DiscardedEvents, err = _List_HistoryEvent_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TList:
+			v.ReappliedSignals, err = _List_HistoryEvent_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TList:
+			v.PendingActivities, err = _List_PendingActivityInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TList:
+			v.PendingTimers, err = _List_PendingTimerInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TList:
+			v.OrphanedChildWorkflows, err = _List_WorkflowExecution_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -66211,232 +72665,242 @@ func (v *ResetStickyTaskListRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetStickyTaskListRequest
+// String returns a readable string representation of a ResetWorkflowExecutionPreview
 // struct.
-func (v *ResetStickyTaskListRequest) String() string {
+func (v *ResetWorkflowExecutionPreview) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [6]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.ResetPointEvent != nil {
+		fields[i] = fmt.Sprintf("ResetPointEvent: %v", v.ResetPointEvent)
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+	if v.DiscardedEvents != nil {
+		fields[i] = fmt.Sprintf("DiscardedEvents: %v", v.DiscardedEvents)
+		i++
+	}
+	if v.ReappliedSignals != nil {
+		fields[i] = fmt.Sprintf("ReappliedSignals: %v", v.ReappliedSignals)
+		i++
+	}
+	if v.PendingActivities != nil {
+		fields[i] = fmt.Sprintf("PendingActivities: %v", v.PendingActivities)
+		i++
+	}
+	if v.PendingTimers != nil {
+		fields[i] = fmt.Sprintf("PendingTimers: %v", v.PendingTimers)
+		i++
+	}
+	if v.OrphanedChildWorkflows != nil {
+		fields[i] = fmt.Sprintf("OrphanedChildWorkflows: %v", v.OrphanedChildWorkflows)
 		i++
 	}
 
-	return fmt.Sprintf("ResetStickyTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetWorkflowExecutionPreview{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetStickyTaskListRequest match the
-// provided ResetStickyTaskListRequest.
+func _List_PendingTimerInfo_Equals(lhs, rhs []*PendingTimerInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_WorkflowExecution_Equals(lhs, rhs []*WorkflowExecution) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ResetWorkflowExecutionPreview match the
+// provided ResetWorkflowExecutionPreview.
 //
 // This function performs a deep comparison.
-func (v *ResetStickyTaskListRequest) Equals(rhs *ResetStickyTaskListRequest) bool {
+func (v *ResetWorkflowExecutionPreview) Equals(rhs *ResetWorkflowExecutionPreview) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !((v.ResetPointEvent == nil && rhs.ResetPointEvent == nil) || (v.ResetPointEvent != nil && rhs.ResetPointEvent != nil && v.ResetPointEvent.Equals(rhs.ResetPointEvent))) {
 		return false
 	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+	if !((v.DiscardedEvents == nil && rhs.DiscardedEvents == nil) || (v.DiscardedEvents != nil && rhs.DiscardedEvents != nil && _List_HistoryEvent_Equals(v.DiscardedEvents, rhs.DiscardedEvents))) {
+		return false
+	}
+	if !((v.ReappliedSignals == nil && rhs.ReappliedSignals == nil) || (v.ReappliedSignals != nil && rhs.ReappliedSignals != nil && _List_HistoryEvent_Equals(v.ReappliedSignals, rhs.ReappliedSignals))) {
+		return false
+	}
+	if !((v.PendingActivities == nil && rhs.PendingActivities == nil) || (v.PendingActivities != nil && rhs.PendingActivities != nil && _List_PendingActivityInfo_Equals(v.PendingActivities, rhs.PendingActivities))) {
+		return false
+	}
+	if !((v.PendingTimers == nil && rhs.PendingTimers == nil) || (v.PendingTimers != nil && rhs.PendingTimers != nil && _List_PendingTimerInfo_Equals(v.PendingTimers, rhs.PendingTimers))) {
+		return false
+	}
+	if !((v.OrphanedChildWorkflows == nil && rhs.OrphanedChildWorkflows == nil) || (v.OrphanedChildWorkflows != nil && rhs.OrphanedChildWorkflows != nil && _List_WorkflowExecution_Equals(v.OrphanedChildWorkflows, rhs.OrphanedChildWorkflows))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_PendingTimerInfo_Zapper []*PendingTimerInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_PendingTimerInfo_Zapper.
+func (l _List_PendingTimerInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _List_WorkflowExecution_Zapper []*WorkflowExecution
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_WorkflowExecution_Zapper.
+func (l _List_WorkflowExecution_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetStickyTaskListRequest.
-func (v *ResetStickyTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResetWorkflowExecutionPreview.
+func (v *ResetWorkflowExecutionPreview) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
+	if v.ResetPointEvent != nil {
+		err = multierr.Append(err, enc.AddObject("resetPointEvent", v.ResetPointEvent))
 	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	if v.DiscardedEvents != nil {
+		err = multierr.Append(err, enc.AddArray("discardedEvents", (_List_HistoryEvent_Zapper)(v.DiscardedEvents)))
+	}
+	if v.ReappliedSignals != nil {
+		err = multierr.Append(err, enc.AddArray("reappliedSignals", (_List_HistoryEvent_Zapper)(v.ReappliedSignals)))
+	}
+	if v.PendingActivities != nil {
+		err = multierr.Append(err, enc.AddArray("pendingActivities", (_List_PendingActivityInfo_Zapper)(v.PendingActivities)))
+	}
+	if v.PendingTimers != nil {
+		err = multierr.Append(err, enc.AddArray("pendingTimers", (_List_PendingTimerInfo_Zapper)(v.PendingTimers)))
+	}
+	if v.OrphanedChildWorkflows != nil {
+		err = multierr.Append(err, enc.AddArray("orphanedChildWorkflows", (_List_WorkflowExecution_Zapper)(v.OrphanedChildWorkflows)))
 	}
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetResetPointEvent returns the value of ResetPointEvent if it is set or its
 // zero value if it is unset.
-func (v *ResetStickyTaskListRequest) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+func (v *ResetWorkflowExecutionPreview) GetResetPointEvent() (o *HistoryEvent) {
+	if v != nil && v.ResetPointEvent != nil {
+		return v.ResetPointEvent
 	}
 
 	return
 }
 
-// IsSetDomain returns true if Domain is not nil.
-func (v *ResetStickyTaskListRequest) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// IsSetResetPointEvent returns true if ResetPointEvent is not nil.
+func (v *ResetWorkflowExecutionPreview) IsSetResetPointEvent() bool {
+	return v != nil && v.ResetPointEvent != nil
 }
 
-// GetExecution returns the value of Execution if it is set or its
+// GetDiscardedEvents returns the value of DiscardedEvents if it is set or its
 // zero value if it is unset.
-func (v *ResetStickyTaskListRequest) GetExecution() (o *WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+func (v *ResetWorkflowExecutionPreview) GetDiscardedEvents() (o []*HistoryEvent) {
+	if v != nil && v.DiscardedEvents != nil {
+		return v.DiscardedEvents
 	}
 
 	return
 }
 
-// IsSetExecution returns true if Execution is not nil.
-func (v *ResetStickyTaskListRequest) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
+// IsSetDiscardedEvents returns true if DiscardedEvents is not nil.
+func (v *ResetWorkflowExecutionPreview) IsSetDiscardedEvents() bool {
+	return v != nil && v.DiscardedEvents != nil
 }
 
-type ResetStickyTaskListResponse struct {
-}
-
-// ToWire translates a ResetStickyTaskListResponse struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
-	var (
-		fields [0]wire.Field
-		i      int = 0
-	)
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a ResetStickyTaskListResponse struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a ResetStickyTaskListResponse struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v ResetStickyTaskListResponse
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ResetStickyTaskListResponse) FromWire(w wire.Value) error {
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		}
+// GetReappliedSignals returns the value of ReappliedSignals if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionPreview) GetReappliedSignals() (o []*HistoryEvent) {
+	if v != nil && v.ReappliedSignals != nil {
+		return v.ReappliedSignals
 	}
 
-	return nil
+	return
 }
 
-// Encode serializes a ResetStickyTaskListResponse struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a ResetStickyTaskListResponse struct could not be encoded.
-func (v *ResetStickyTaskListResponse) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
-
-	return sw.WriteStructEnd()
+// IsSetReappliedSignals returns true if ReappliedSignals is not nil.
+func (v *ResetWorkflowExecutionPreview) IsSetReappliedSignals() bool {
+	return v != nil && v.ReappliedSignals != nil
 }
 
-// Decode deserializes a ResetStickyTaskListResponse struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a ResetStickyTaskListResponse struct could not be generated from the wire
-// representation.
-func (v *ResetStickyTaskListResponse) Decode(sr stream.Reader) error {
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
-
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
-	}
-
-	for ok {
-		switch {
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
-
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
-		}
+// GetPendingActivities returns the value of PendingActivities if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionPreview) GetPendingActivities() (o []*PendingActivityInfo) {
+	if v != nil && v.PendingActivities != nil {
+		return v.PendingActivities
 	}
 
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
-	}
+	return
+}
 
-	return nil
+// IsSetPendingActivities returns true if PendingActivities is not nil.
+func (v *ResetWorkflowExecutionPreview) IsSetPendingActivities() bool {
+	return v != nil && v.PendingActivities != nil
 }
 
-// String returns a readable string representation of a ResetStickyTaskListResponse
-// struct.
-func (v *ResetStickyTaskListResponse) String() string {
-	if v == nil {
-		return "<nil>"
+// GetPendingTimers returns the value of PendingTimers if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionPreview) GetPendingTimers() (o []*PendingTimerInfo) {
+	if v != nil && v.PendingTimers != nil {
+		return v.PendingTimers
 	}
 
-	var fields [0]string
-	i := 0
+	return
+}
 
-	return fmt.Sprintf("ResetStickyTaskListResponse{%v}", strings.Join(fields[:i], ", "))
+// IsSetPendingTimers returns true if PendingTimers is not nil.
+func (v *ResetWorkflowExecutionPreview) IsSetPendingTimers() bool {
+	return v != nil && v.PendingTimers != nil
 }
 
-// Equals returns true if all the fields of this ResetStickyTaskListResponse match the
-// provided ResetStickyTaskListResponse.
-//
-// This function performs a deep comparison.
-func (v *ResetStickyTaskListResponse) Equals(rhs *ResetStickyTaskListResponse) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
+// GetOrphanedChildWorkflows returns the value of OrphanedChildWorkflows if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionPreview) GetOrphanedChildWorkflows() (o []*WorkflowExecution) {
+	if v != nil && v.OrphanedChildWorkflows != nil {
+		return v.OrphanedChildWorkflows
 	}
 
-	return true
+	return
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetStickyTaskListResponse.
-func (v *ResetStickyTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	return err
+// IsSetOrphanedChildWorkflows returns true if OrphanedChildWorkflows is not nil.
+func (v *ResetWorkflowExecutionPreview) IsSetOrphanedChildWorkflows() bool {
+	return v != nil && v.OrphanedChildWorkflows != nil
 }
 
 type ResetWorkflowExecutionRequest struct {
@@ -66446,6 +72910,7 @@ type ResetWorkflowExecutionRequest struct {
 	DecisionFinishEventId *int64             `json:"decisionFinishEventId,omitempty"`
 	RequestId             *string            `json:"requestId,omitempty"`
 	SkipSignalReapply     *bool              `json:"skipSignalReapply,omitempty"`
+	DryRun                *bool              `json:"dryRun,omitempty"`
 }
 
 // ToWire translates a ResetWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -66465,7 +72930,7 @@ type ResetWorkflowExecutionRequest struct {
 //   }
 func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -66519,6 +72984,14 @@ func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.DryRun != nil {
+		w, err = wire.NewValueBool(*(v.DryRun)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -66602,6 +73075,16 @@ func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.DryRun = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -66690,6 +73173,18 @@ func (v *ResetWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.DryRun != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.DryRun)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -66757,6 +73252,14 @@ func (v *ResetWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 70 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.DryRun = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -66786,7 +73289,7 @@ func (v *ResetWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -66812,6 +73315,10 @@ func (v *ResetWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("SkipSignalReapply: %v", *(v.SkipSignalReapply))
 		i++
 	}
+	if v.DryRun != nil {
+		fields[i] = fmt.Sprintf("DryRun: %v", *(v.DryRun))
+		i++
+	}
 
 	return fmt.Sprintf("ResetWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -66844,6 +73351,9 @@ func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionReques
 	if !_Bool_EqualsPtr(v.SkipSignalReapply, rhs.SkipSignalReapply) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.DryRun, rhs.DryRun) {
+		return false
+	}
 
 	return true
 }
@@ -66872,6 +73382,9 @@ func (v *ResetWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncod
 	if v.SkipSignalReapply != nil {
 		enc.AddBool("skipSignalReapply", *v.SkipSignalReapply)
 	}
+	if v.DryRun != nil {
+		enc.AddBool("dryRun", *v.DryRun)
+	}
 	return err
 }
 
@@ -66965,8 +73478,24 @@ func (v *ResetWorkflowExecutionRequest) IsSetSkipSignalReapply() bool {
 	return v != nil && v.SkipSignalReapply != nil
 }
 
+// GetDryRun returns the value of DryRun if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionRequest) GetDryRun() (o bool) {
+	if v != nil && v.DryRun != nil {
+		return *v.DryRun
+	}
+
+	return
+}
+
+// IsSetDryRun returns true if DryRun is not nil.
+func (v *ResetWorkflowExecutionRequest) IsSetDryRun() bool {
+	return v != nil && v.DryRun != nil
+}
+
 type ResetWorkflowExecutionResponse struct {
-	RunId *string `json:"runId,omitempty"`
+	RunId   *string                        `json:"runId,omitempty"`
+	Preview *ResetWorkflowExecutionPreview `json:"preview,omitempty"`
 }
 
 // ToWire translates a ResetWorkflowExecutionResponse struct into a Thrift-level intermediate
@@ -66986,7 +73515,7 @@ type ResetWorkflowExecutionResponse struct {
 //   }
 func (v *ResetWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -67000,10 +73529,24 @@ func (v *ResetWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Preview != nil {
+		w, err = v.Preview.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _ResetWorkflowExecutionPreview_Read(w wire.Value) (*ResetWorkflowExecutionPreview, error) {
+	var v ResetWorkflowExecutionPreview
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a ResetWorkflowExecutionResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -67035,6 +73578,14 @@ func (v *ResetWorkflowExecutionResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Preview, err = _ResetWorkflowExecutionPreview_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -67063,9 +73614,27 @@ func (v *ResetWorkflowExecutionResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Preview != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Preview.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
+func _ResetWorkflowExecutionPreview_Decode(sr stream.Reader) (*ResetWorkflowExecutionPreview, error) {
+	var v ResetWorkflowExecutionPreview
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a ResetWorkflowExecutionResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -67092,6 +73661,12 @@ func (v *ResetWorkflowExecutionResponse) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.Preview, err = _ResetWorkflowExecutionPreview_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -67121,12 +73696,16 @@ func (v *ResetWorkflowExecutionResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	if v.RunId != nil {
 		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
+	if v.Preview != nil {
+		fields[i] = fmt.Sprintf("Preview: %v", v.Preview)
+		i++
+	}
 
 	return fmt.Sprintf("ResetWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -67144,6 +73723,9 @@ func (v *ResetWorkflowExecutionResponse) Equals(rhs *ResetWorkflowExecutionRespo
 	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
+	if !((v.Preview == nil && rhs.Preview == nil) || (v.Preview != nil && rhs.Preview != nil && v.Preview.Equals(rhs.Preview))) {
+		return false
+	}
 
 	return true
 }
@@ -67157,6 +73739,9 @@ func (v *ResetWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.RunId != nil {
 		enc.AddString("runId", *v.RunId)
 	}
+	if v.Preview != nil {
+		err = multierr.Append(err, enc.AddObject("preview", v.Preview))
+	}
 	return err
 }
 
@@ -67175,6 +73760,21 @@ func (v *ResetWorkflowExecutionResponse) IsSetRunId() bool {
 	return v != nil && v.RunId != nil
 }
 
+// GetPreview returns the value of Preview if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionResponse) GetPreview() (o *ResetWorkflowExecutionPreview) {
+	if v != nil && v.Preview != nil {
+		return v.Preview
+	}
+
+	return
+}
+
+// IsSetPreview returns true if Preview is not nil.
+func (v *ResetWorkflowExecutionResponse) IsSetPreview() bool {
+	return v != nil && v.Preview != nil
+}
+
 type RespondActivityTaskCanceledByIDRequest struct {
 	Domain     *string `json:"domain,omitempty"`
 	WorkflowID *string `json:"workflowID,omitempty"`
@@ -73029,6 +79629,404 @@ func (v *RespondQueryTaskCompletedRequest) IsSetWorkerVersionInfo() bool {
 	return v != nil && v.WorkerVersionInfo != nil
 }
 
+type ResumeWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            *string            `json:"reason,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
+}
+
+// ToWire translates a ResumeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ResumeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResumeWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResumeWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ResumeWorkflowExecutionRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ResumeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ResumeWorkflowExecutionRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ResumeWorkflowExecutionRequest struct could not be encoded.
+func (v *ResumeWorkflowExecutionRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Reason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Reason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ResumeWorkflowExecutionRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ResumeWorkflowExecutionRequest struct could not be generated from the wire
+// representation.
+func (v *ResumeWorkflowExecutionRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Reason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResumeWorkflowExecutionRequest
+// struct.
+func (v *ResumeWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("ResumeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResumeWorkflowExecutionRequest match the
+// provided ResumeWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *ResumeWorkflowExecutionRequest) Equals(rhs *ResumeWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResumeWorkflowExecutionRequest.
+func (v *ResumeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ResumeWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ResumeWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *ResumeWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ResumeWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *ResumeWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *ResumeWorkflowExecutionRequest) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *ResumeWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *ResumeWorkflowExecutionRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
 type RetryPolicy struct {
 	InitialIntervalInSeconds    *int32   `json:"initialIntervalInSeconds,omitempty"`
 	BackoffCoefficient          *float64 `json:"backoffCoefficient,omitempty"`
@@ -77381,6 +84379,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 	Header                              *Header                `json:"header,omitempty"`
 	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	RetentionPeriodInDays               *int32                 `json:"retentionPeriodInDays,omitempty"`
 }
 
 // ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -77400,7 +84399,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 //   }
 func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [19]wire.Field
+		fields [20]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -77558,6 +84557,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 180, Value: w}
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 190, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -77761,6 +84768,16 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 190:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -78005,6 +85022,18 @@ func (v *SignalWithStartWorkflowExecutionRequest) Encode(sw stream.Writer) error
 		}
 	}
 
+	if v.RetentionPeriodInDays != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 190, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.RetentionPeriodInDays)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -78166,6 +85195,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) Decode(sr stream.Reader) error
 				return err
 			}
 
+		case fh.ID == 190 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.RetentionPeriodInDays = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -78195,7 +85232,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [19]string
+	var fields [20]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -78273,6 +85310,10 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("DelayStartSeconds: %v", *(v.DelayStartSeconds))
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("RetentionPeriodInDays: %v", *(v.RetentionPeriodInDays))
+		i++
+	}
 
 	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -78354,6 +85395,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 	if !_I32_EqualsPtr(v.DelayStartSeconds, rhs.DelayStartSeconds) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionPeriodInDays, rhs.RetentionPeriodInDays) {
+		return false
+	}
 
 	return true
 }
@@ -78421,6 +85465,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.O
 	if v.DelayStartSeconds != nil {
 		enc.AddInt32("delayStartSeconds", *v.DelayStartSeconds)
 	}
+	if v.RetentionPeriodInDays != nil {
+		enc.AddInt32("retentionPeriodInDays", *v.RetentionPeriodInDays)
+	}
 	return err
 }
 
@@ -78709,6 +85756,21 @@ func (v *SignalWithStartWorkflowExecutionRequest) IsSetDelayStartSeconds() bool
 	return v != nil && v.DelayStartSeconds != nil
 }
 
+// GetRetentionPeriodInDays returns the value of RetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+
+	return
+}
+
+// IsSetRetentionPeriodInDays returns true if RetentionPeriodInDays is not nil.
+func (v *SignalWithStartWorkflowExecutionRequest) IsSetRetentionPeriodInDays() bool {
+	return v != nil && v.RetentionPeriodInDays != nil
+}
+
 type SignalWorkflowExecutionRequest struct {
 	Domain            *string            `json:"domain,omitempty"`
 	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -82740,6 +89802,7 @@ type StartWorkflowExecutionRequest struct {
 	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 	Header                              *Header                `json:"header,omitempty"`
 	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	RetentionPeriodInDays               *int32                 `json:"retentionPeriodInDays,omitempty"`
 }
 
 // ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -82759,7 +89822,7 @@ type StartWorkflowExecutionRequest struct {
 //   }
 func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [16]wire.Field
+		fields [17]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -82893,6 +89956,14 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 160, Value: w}
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -83064,6 +90135,16 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 170:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -83272,6 +90353,18 @@ func (v *StartWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.RetentionPeriodInDays != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 170, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.RetentionPeriodInDays)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -83407,6 +90500,14 @@ func (v *StartWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 170 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.RetentionPeriodInDays = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -83436,7 +90537,7 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [16]string
+	var fields [17]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -83502,6 +90603,10 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("DelayStartSeconds: %v", *(v.DelayStartSeconds))
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("RetentionPeriodInDays: %v", *(v.RetentionPeriodInDays))
+		i++
+	}
 
 	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -83564,6 +90669,9 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 	if !_I32_EqualsPtr(v.DelayStartSeconds, rhs.DelayStartSeconds) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionPeriodInDays, rhs.RetentionPeriodInDays) {
+		return false
+	}
 
 	return true
 }
@@ -83622,6 +90730,9 @@ func (v *StartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncod
 	if v.DelayStartSeconds != nil {
 		enc.AddInt32("delayStartSeconds", *v.DelayStartSeconds)
 	}
+	if v.RetentionPeriodInDays != nil {
+		enc.AddInt32("retentionPeriodInDays", *v.RetentionPeriodInDays)
+	}
 	return err
 }
 
@@ -83865,6 +90976,21 @@ func (v *StartWorkflowExecutionRequest) IsSetDelayStartSeconds() bool {
 	return v != nil && v.DelayStartSeconds != nil
 }
 
+// GetRetentionPeriodInDays returns the value of RetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+
+	return
+}
+
+// IsSetRetentionPeriodInDays returns true if RetentionPeriodInDays is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetRetentionPeriodInDays() bool {
+	return v != nil && v.RetentionPeriodInDays != nil
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId *string `json:"runId,omitempty"`
 }
@@ -84259,41 +91385,361 @@ func (v *StickyExecutionAttributes) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a StickyExecutionAttributes
+// String returns a readable string representation of a StickyExecutionAttributes
+// struct.
+func (v *StickyExecutionAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.WorkerTaskList != nil {
+		fields[i] = fmt.Sprintf("WorkerTaskList: %v", v.WorkerTaskList)
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+		i++
+	}
+
+	return fmt.Sprintf("StickyExecutionAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this StickyExecutionAttributes match the
+// provided StickyExecutionAttributes.
+//
+// This function performs a deep comparison.
+func (v *StickyExecutionAttributes) Equals(rhs *StickyExecutionAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.WorkerTaskList == nil && rhs.WorkerTaskList == nil) || (v.WorkerTaskList != nil && rhs.WorkerTaskList != nil && v.WorkerTaskList.Equals(rhs.WorkerTaskList))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StickyExecutionAttributes.
+func (v *StickyExecutionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.WorkerTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("workerTaskList", v.WorkerTaskList))
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		enc.AddInt32("scheduleToStartTimeoutSeconds", *v.ScheduleToStartTimeoutSeconds)
+	}
+	return err
+}
+
+// GetWorkerTaskList returns the value of WorkerTaskList if it is set or its
+// zero value if it is unset.
+func (v *StickyExecutionAttributes) GetWorkerTaskList() (o *TaskList) {
+	if v != nil && v.WorkerTaskList != nil {
+		return v.WorkerTaskList
+	}
+
+	return
+}
+
+// IsSetWorkerTaskList returns true if WorkerTaskList is not nil.
+func (v *StickyExecutionAttributes) IsSetWorkerTaskList() bool {
+	return v != nil && v.WorkerTaskList != nil
+}
+
+// GetScheduleToStartTimeoutSeconds returns the value of ScheduleToStartTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *StickyExecutionAttributes) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetScheduleToStartTimeoutSeconds returns true if ScheduleToStartTimeoutSeconds is not nil.
+func (v *StickyExecutionAttributes) IsSetScheduleToStartTimeoutSeconds() bool {
+	return v != nil && v.ScheduleToStartTimeoutSeconds != nil
+}
+
+type SupportedClientVersions struct {
+	GoSdk   *string `json:"goSdk,omitempty"`
+	JavaSdk *string `json:"javaSdk,omitempty"`
+	Cli     *string `json:"cli,omitempty"`
+}
+
+// ToWire translates a SupportedClientVersions struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SupportedClientVersions) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.GoSdk != nil {
+		w, err = wire.NewValueString(*(v.GoSdk)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.JavaSdk != nil {
+		w, err = wire.NewValueString(*(v.JavaSdk)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Cli != nil {
+		w, err = wire.NewValueString(*(v.Cli)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a SupportedClientVersions struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SupportedClientVersions struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SupportedClientVersions
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SupportedClientVersions) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.GoSdk = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.JavaSdk = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Cli = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a SupportedClientVersions struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a SupportedClientVersions struct could not be encoded.
+func (v *SupportedClientVersions) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.GoSdk != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.GoSdk)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.JavaSdk != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.JavaSdk)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Cli != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Cli)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a SupportedClientVersions struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a SupportedClientVersions struct could not be generated from the wire
+// representation.
+func (v *SupportedClientVersions) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.GoSdk = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.JavaSdk = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Cli = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SupportedClientVersions
 // struct.
-func (v *StickyExecutionAttributes) String() string {
+func (v *SupportedClientVersions) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
-	if v.WorkerTaskList != nil {
-		fields[i] = fmt.Sprintf("WorkerTaskList: %v", v.WorkerTaskList)
+	if v.GoSdk != nil {
+		fields[i] = fmt.Sprintf("GoSdk: %v", *(v.GoSdk))
 		i++
 	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+	if v.JavaSdk != nil {
+		fields[i] = fmt.Sprintf("JavaSdk: %v", *(v.JavaSdk))
+		i++
+	}
+	if v.Cli != nil {
+		fields[i] = fmt.Sprintf("Cli: %v", *(v.Cli))
 		i++
 	}
 
-	return fmt.Sprintf("StickyExecutionAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("SupportedClientVersions{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this StickyExecutionAttributes match the
-// provided StickyExecutionAttributes.
+// Equals returns true if all the fields of this SupportedClientVersions match the
+// provided SupportedClientVersions.
 //
 // This function performs a deep comparison.
-func (v *StickyExecutionAttributes) Equals(rhs *StickyExecutionAttributes) bool {
+func (v *SupportedClientVersions) Equals(rhs *SupportedClientVersions) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.WorkerTaskList == nil && rhs.WorkerTaskList == nil) || (v.WorkerTaskList != nil && rhs.WorkerTaskList != nil && v.WorkerTaskList.Equals(rhs.WorkerTaskList))) {
+	if !_String_EqualsPtr(v.GoSdk, rhs.GoSdk) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+	if !_String_EqualsPtr(v.JavaSdk, rhs.JavaSdk) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Cli, rhs.Cli) {
 		return false
 	}
 
@@ -84301,116 +91747,152 @@ func (v *StickyExecutionAttributes) Equals(rhs *StickyExecutionAttributes) bool
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of StickyExecutionAttributes.
-func (v *StickyExecutionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of SupportedClientVersions.
+func (v *SupportedClientVersions) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.WorkerTaskList != nil {
-		err = multierr.Append(err, enc.AddObject("workerTaskList", v.WorkerTaskList))
+	if v.GoSdk != nil {
+		enc.AddString("goSdk", *v.GoSdk)
 	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		enc.AddInt32("scheduleToStartTimeoutSeconds", *v.ScheduleToStartTimeoutSeconds)
+	if v.JavaSdk != nil {
+		enc.AddString("javaSdk", *v.JavaSdk)
+	}
+	if v.Cli != nil {
+		enc.AddString("cli", *v.Cli)
 	}
 	return err
 }
 
-// GetWorkerTaskList returns the value of WorkerTaskList if it is set or its
+// GetGoSdk returns the value of GoSdk if it is set or its
 // zero value if it is unset.
-func (v *StickyExecutionAttributes) GetWorkerTaskList() (o *TaskList) {
-	if v != nil && v.WorkerTaskList != nil {
-		return v.WorkerTaskList
+func (v *SupportedClientVersions) GetGoSdk() (o string) {
+	if v != nil && v.GoSdk != nil {
+		return *v.GoSdk
 	}
 
 	return
 }
 
-// IsSetWorkerTaskList returns true if WorkerTaskList is not nil.
-func (v *StickyExecutionAttributes) IsSetWorkerTaskList() bool {
-	return v != nil && v.WorkerTaskList != nil
+// IsSetGoSdk returns true if GoSdk is not nil.
+func (v *SupportedClientVersions) IsSetGoSdk() bool {
+	return v != nil && v.GoSdk != nil
 }
 
-// GetScheduleToStartTimeoutSeconds returns the value of ScheduleToStartTimeoutSeconds if it is set or its
+// GetJavaSdk returns the value of JavaSdk if it is set or its
 // zero value if it is unset.
-func (v *StickyExecutionAttributes) GetScheduleToStartTimeoutSeconds() (o int32) {
-	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
-		return *v.ScheduleToStartTimeoutSeconds
+func (v *SupportedClientVersions) GetJavaSdk() (o string) {
+	if v != nil && v.JavaSdk != nil {
+		return *v.JavaSdk
 	}
 
 	return
 }
 
-// IsSetScheduleToStartTimeoutSeconds returns true if ScheduleToStartTimeoutSeconds is not nil.
-func (v *StickyExecutionAttributes) IsSetScheduleToStartTimeoutSeconds() bool {
-	return v != nil && v.ScheduleToStartTimeoutSeconds != nil
+// IsSetJavaSdk returns true if JavaSdk is not nil.
+func (v *SupportedClientVersions) IsSetJavaSdk() bool {
+	return v != nil && v.JavaSdk != nil
 }
 
-type SupportedClientVersions struct {
-	GoSdk   *string `json:"goSdk,omitempty"`
-	JavaSdk *string `json:"javaSdk,omitempty"`
+// GetCli returns the value of Cli if it is set or its
+// zero value if it is unset.
+func (v *SupportedClientVersions) GetCli() (o string) {
+	if v != nil && v.Cli != nil {
+		return *v.Cli
+	}
+
+	return
 }
 
-// ToWire translates a SupportedClientVersions struct into a Thrift-level intermediate
+// IsSetCli returns true if Cli is not nil.
+func (v *SupportedClientVersions) IsSetCli() bool {
+	return v != nil && v.Cli != nil
+}
+
+type SuspendWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            *string            `json:"reason,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
+}
+
+// ToWire translates a SuspendWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *SupportedClientVersions) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *SuspendWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.GoSdk != nil {
-		w, err = wire.NewValueString(*(v.GoSdk)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.JavaSdk != nil {
-		w, err = wire.NewValueString(*(v.JavaSdk)), error(nil)
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a SupportedClientVersions struct from its Thrift-level
+// FromWire deserializes a SuspendWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a SupportedClientVersions struct
+// An error is returned if we were unable to build a SuspendWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v SupportedClientVersions
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *SupportedClientVersions) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v SuspendWorkflowExecutionRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *SuspendWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -84419,17 +91901,35 @@ func (v *SupportedClientVersions) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.GoSdk = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.JavaSdk = &x
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -84441,20 +91941,20 @@ func (v *SupportedClientVersions) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a SupportedClientVersions struct directly into bytes, without going
+// Encode serializes a SuspendWorkflowExecutionRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a SupportedClientVersions struct could not be encoded.
-func (v *SupportedClientVersions) Encode(sw stream.Writer) error {
+// An error is returned if a SuspendWorkflowExecutionRequest struct could not be encoded.
+func (v *SuspendWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.GoSdk != nil {
+	if v.Domain != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.GoSdk)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -84462,11 +91962,35 @@ func (v *SupportedClientVersions) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.JavaSdk != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.JavaSdk)); err != nil {
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Reason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Reason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -84477,12 +92001,12 @@ func (v *SupportedClientVersions) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a SupportedClientVersions struct directly from its Thrift-level
+// Decode deserializes a SuspendWorkflowExecutionRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a SupportedClientVersions struct could not be generated from the wire
+// An error is returned if a SuspendWorkflowExecutionRequest struct could not be generated from the wire
 // representation.
-func (v *SupportedClientVersions) Decode(sr stream.Reader) error {
+func (v *SuspendWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -84498,15 +92022,29 @@ func (v *SupportedClientVersions) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.GoSdk = &x
+			v.Domain = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.JavaSdk = &x
+			v.Reason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
 			if err != nil {
 				return err
 			}
@@ -84533,41 +92071,55 @@ func (v *SupportedClientVersions) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a SupportedClientVersions
+// String returns a readable string representation of a SuspendWorkflowExecutionRequest
 // struct.
-func (v *SupportedClientVersions) String() string {
+func (v *SuspendWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.GoSdk != nil {
-		fields[i] = fmt.Sprintf("GoSdk: %v", *(v.GoSdk))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.JavaSdk != nil {
-		fields[i] = fmt.Sprintf("JavaSdk: %v", *(v.JavaSdk))
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("SupportedClientVersions{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("SuspendWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this SupportedClientVersions match the
-// provided SupportedClientVersions.
+// Equals returns true if all the fields of this SuspendWorkflowExecutionRequest match the
+// provided SuspendWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *SupportedClientVersions) Equals(rhs *SupportedClientVersions) bool {
+func (v *SuspendWorkflowExecutionRequest) Equals(rhs *SuspendWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.GoSdk, rhs.GoSdk) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_String_EqualsPtr(v.JavaSdk, rhs.JavaSdk) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
@@ -84575,48 +92127,84 @@ func (v *SupportedClientVersions) Equals(rhs *SupportedClientVersions) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of SupportedClientVersions.
-func (v *SupportedClientVersions) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of SuspendWorkflowExecutionRequest.
+func (v *SuspendWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.GoSdk != nil {
-		enc.AddString("goSdk", *v.GoSdk)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
-	if v.JavaSdk != nil {
-		enc.AddString("javaSdk", *v.JavaSdk)
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
 	return err
 }
 
-// GetGoSdk returns the value of GoSdk if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *SupportedClientVersions) GetGoSdk() (o string) {
-	if v != nil && v.GoSdk != nil {
-		return *v.GoSdk
+func (v *SuspendWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetGoSdk returns true if GoSdk is not nil.
-func (v *SupportedClientVersions) IsSetGoSdk() bool {
-	return v != nil && v.GoSdk != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *SuspendWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetJavaSdk returns the value of JavaSdk if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *SupportedClientVersions) GetJavaSdk() (o string) {
-	if v != nil && v.JavaSdk != nil {
-		return *v.JavaSdk
+func (v *SuspendWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetJavaSdk returns true if JavaSdk is not nil.
-func (v *SupportedClientVersions) IsSetJavaSdk() bool {
-	return v != nil && v.JavaSdk != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *SuspendWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *SuspendWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *SuspendWorkflowExecutionRequest) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *SuspendWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *SuspendWorkflowExecutionRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
 }
 
 type TaskIDBlock struct {
@@ -93751,6 +101339,7 @@ type WorkflowExecutionConfiguration struct {
 	TaskList                            *TaskList `json:"taskList,omitempty"`
 	ExecutionStartToCloseTimeoutSeconds *int32    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
 	TaskStartToCloseTimeoutSeconds      *int32    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	RetentionPeriodInDays               *int32    `json:"retentionPeriodInDays,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionConfiguration struct into a Thrift-level intermediate
@@ -93770,7 +101359,7 @@ type WorkflowExecutionConfiguration struct {
 //   }
 func (v *WorkflowExecutionConfiguration) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -93800,6 +101389,14 @@ func (v *WorkflowExecutionConfiguration) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -93853,6 +101450,16 @@ func (v *WorkflowExecutionConfiguration) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -93905,6 +101512,18 @@ func (v *WorkflowExecutionConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.RetentionPeriodInDays != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.RetentionPeriodInDays)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -93948,6 +101567,14 @@ func (v *WorkflowExecutionConfiguration) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 50 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.RetentionPeriodInDays = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -93977,7 +101604,7 @@ func (v *WorkflowExecutionConfiguration) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.TaskList != nil {
 		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
@@ -93991,6 +101618,10 @@ func (v *WorkflowExecutionConfiguration) String() string {
 		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("RetentionPeriodInDays: %v", *(v.RetentionPeriodInDays))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionConfiguration{%v}", strings.Join(fields[:i], ", "))
 }
@@ -94014,6 +101645,9 @@ func (v *WorkflowExecutionConfiguration) Equals(rhs *WorkflowExecutionConfigurat
 	if !_I32_EqualsPtr(v.TaskStartToCloseTimeoutSeconds, rhs.TaskStartToCloseTimeoutSeconds) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionPeriodInDays, rhs.RetentionPeriodInDays) {
+		return false
+	}
 
 	return true
 }
@@ -94033,6 +101667,9 @@ func (v *WorkflowExecutionConfiguration) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.TaskStartToCloseTimeoutSeconds != nil {
 		enc.AddInt32("taskStartToCloseTimeoutSeconds", *v.TaskStartToCloseTimeoutSeconds)
 	}
+	if v.RetentionPeriodInDays != nil {
+		enc.AddInt32("retentionPeriodInDays", *v.RetentionPeriodInDays)
+	}
 	return err
 }
 
@@ -94081,6 +101718,21 @@ func (v *WorkflowExecutionConfiguration) IsSetTaskStartToCloseTimeoutSeconds() b
 	return v != nil && v.TaskStartToCloseTimeoutSeconds != nil
 }
 
+// GetRetentionPeriodInDays returns the value of RetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionConfiguration) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+
+	return
+}
+
+// IsSetRetentionPeriodInDays returns true if RetentionPeriodInDays is not nil.
+func (v *WorkflowExecutionConfiguration) IsSetRetentionPeriodInDays() bool {
+	return v != nil && v.RetentionPeriodInDays != nil
+}
+
 type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	NewExecutionRunId                   *string                 `json:"newExecutionRunId,omitempty"`
 	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
@@ -96793,6 +104445,280 @@ func (v *WorkflowExecutionInfo) IsSetIsCron() bool {
 	return v != nil && v.IsCron != nil
 }
 
+type WorkflowExecutionResumedEventAttributes struct {
+	Reason   *string `json:"reason,omitempty"`
+	Identity *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionResumedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *WorkflowExecutionResumedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionResumedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionResumedEventAttributes struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v WorkflowExecutionResumedEventAttributes
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *WorkflowExecutionResumedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowExecutionResumedEventAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowExecutionResumedEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionResumedEventAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Reason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Reason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowExecutionResumedEventAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowExecutionResumedEventAttributes struct could not be generated from the wire
+// representation.
+func (v *WorkflowExecutionResumedEventAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Reason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionResumedEventAttributes
+// struct.
+func (v *WorkflowExecutionResumedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionResumedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionResumedEventAttributes match the
+// provided WorkflowExecutionResumedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionResumedEventAttributes) Equals(rhs *WorkflowExecutionResumedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionResumedEventAttributes.
+func (v *WorkflowExecutionResumedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionResumedEventAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *WorkflowExecutionResumedEventAttributes) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionResumedEventAttributes) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *WorkflowExecutionResumedEventAttributes) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName *string `json:"signalName,omitempty"`
 	Input      []byte  `json:"input,omitempty"`
@@ -97153,6 +105079,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
 	PrevAutoResetPoints                 *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
 	Header                              *Header                 `json:"header,omitempty"`
+	RetentionPeriodInDays               *int32                  `json:"retentionPeriodInDays,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionStartedEventAttributes struct into a Thrift-level intermediate
@@ -97172,7 +105099,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [25]wire.Field
+		fields [26]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -97378,6 +105305,14 @@ func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 140, Value: w}
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -97631,6 +105566,16 @@ func (v *WorkflowExecutionStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 150:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -97947,6 +105892,18 @@ func (v *WorkflowExecutionStartedEventAttributes) Encode(sw stream.Writer) error
 		}
 	}
 
+	if v.RetentionPeriodInDays != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.RetentionPeriodInDays)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -98146,6 +106103,14 @@ func (v *WorkflowExecutionStartedEventAttributes) Decode(sr stream.Reader) error
 				return err
 			}
 
+		case fh.ID == 150 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.RetentionPeriodInDays = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -98175,7 +106140,7 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [25]string
+	var fields [26]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -98277,6 +106242,10 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("RetentionPeriodInDays: %v", *(v.RetentionPeriodInDays))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -98366,6 +106335,9 @@ func (v *WorkflowExecutionStartedEventAttributes) Equals(rhs *WorkflowExecutionS
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionPeriodInDays, rhs.RetentionPeriodInDays) {
+		return false
+	}
 
 	return true
 }
@@ -98451,6 +106423,9 @@ func (v *WorkflowExecutionStartedEventAttributes) MarshalLogObject(enc zapcore.O
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.RetentionPeriodInDays != nil {
+		enc.AddInt32("retentionPeriodInDays", *v.RetentionPeriodInDays)
+	}
 	return err
 }
 
@@ -98829,6 +106804,295 @@ func (v *WorkflowExecutionStartedEventAttributes) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetRetentionPeriodInDays returns the value of RetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+
+	return
+}
+
+// IsSetRetentionPeriodInDays returns true if RetentionPeriodInDays is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetRetentionPeriodInDays() bool {
+	return v != nil && v.RetentionPeriodInDays != nil
+}
+
+type WorkflowExecutionSuspendedEventAttributes struct {
+	Reason   *string `json:"reason,omitempty"`
+	Identity *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionSuspendedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *WorkflowExecutionSuspendedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionSuspendedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionSuspendedEventAttributes struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v WorkflowExecutionSuspendedEventAttributes
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *WorkflowExecutionSuspendedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowExecutionSuspendedEventAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowExecutionSuspendedEventAttributes struct could not be encoded.
+func (v *WorkflowExecutionSuspendedEventAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Reason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Reason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowExecutionSuspendedEventAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowExecutionSuspendedEventAttributes struct could not be generated from the wire
+// representation.
+func (v *WorkflowExecutionSuspendedEventAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Reason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionSuspendedEventAttributes
+// struct.
+func (v *WorkflowExecutionSuspendedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionSuspendedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionSuspendedEventAttributes match the
+// provided WorkflowExecutionSuspendedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionSuspendedEventAttributes) Equals(rhs *WorkflowExecutionSuspendedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionSuspendedEventAttributes.
+func (v *WorkflowExecutionSuspendedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionSuspendedEventAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *WorkflowExecutionSuspendedEventAttributes) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionSuspendedEventAttributes) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *WorkflowExecutionSuspendedEventAttributes) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   *string `json:"reason,omitempty"`
 	Details  []byte  `json:"details,omitempty"`
@@ -99795,28 +108059,366 @@ func (v *WorkflowQuery) String() string {
 		fields[i] = fmt.Sprintf("QueryType: %v", *(v.QueryType))
 		i++
 	}
-	if v.QueryArgs != nil {
-		fields[i] = fmt.Sprintf("QueryArgs: %v", v.QueryArgs)
+	if v.QueryArgs != nil {
+		fields[i] = fmt.Sprintf("QueryArgs: %v", v.QueryArgs)
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowQuery{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowQuery match the
+// provided WorkflowQuery.
+//
+// This function performs a deep comparison.
+func (v *WorkflowQuery) Equals(rhs *WorkflowQuery) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.QueryType, rhs.QueryType) {
+		return false
+	}
+	if !((v.QueryArgs == nil && rhs.QueryArgs == nil) || (v.QueryArgs != nil && rhs.QueryArgs != nil && bytes.Equal(v.QueryArgs, rhs.QueryArgs))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowQuery.
+func (v *WorkflowQuery) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.QueryType != nil {
+		enc.AddString("queryType", *v.QueryType)
+	}
+	if v.QueryArgs != nil {
+		enc.AddString("queryArgs", base64.StdEncoding.EncodeToString(v.QueryArgs))
+	}
+	return err
+}
+
+// GetQueryType returns the value of QueryType if it is set or its
+// zero value if it is unset.
+func (v *WorkflowQuery) GetQueryType() (o string) {
+	if v != nil && v.QueryType != nil {
+		return *v.QueryType
+	}
+
+	return
+}
+
+// IsSetQueryType returns true if QueryType is not nil.
+func (v *WorkflowQuery) IsSetQueryType() bool {
+	return v != nil && v.QueryType != nil
+}
+
+// GetQueryArgs returns the value of QueryArgs if it is set or its
+// zero value if it is unset.
+func (v *WorkflowQuery) GetQueryArgs() (o []byte) {
+	if v != nil && v.QueryArgs != nil {
+		return v.QueryArgs
+	}
+
+	return
+}
+
+// IsSetQueryArgs returns true if QueryArgs is not nil.
+func (v *WorkflowQuery) IsSetQueryArgs() bool {
+	return v != nil && v.QueryArgs != nil
+}
+
+type WorkflowQueryResult struct {
+	ResultType   *QueryResultType `json:"resultType,omitempty"`
+	Answer       []byte           `json:"answer,omitempty"`
+	ErrorMessage *string          `json:"errorMessage,omitempty"`
+}
+
+// ToWire translates a WorkflowQueryResult struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowQueryResult) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ResultType != nil {
+		w, err = v.ResultType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Answer != nil {
+		w, err = wire.NewValueBinary(v.Answer), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ErrorMessage != nil {
+		w, err = wire.NewValueString(*(v.ErrorMessage)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _QueryResultType_Read(w wire.Value) (QueryResultType, error) {
+	var v QueryResultType
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a WorkflowQueryResult struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowQueryResult struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowQueryResult
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowQueryResult) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x QueryResultType
+				x, err = _QueryResultType_Read(field.Value)
+				v.ResultType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Answer, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ErrorMessage = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowQueryResult struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowQueryResult struct could not be encoded.
+func (v *WorkflowQueryResult) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ResultType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.ResultType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Answer != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Answer); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ErrorMessage != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ErrorMessage)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _QueryResultType_Decode(sr stream.Reader) (QueryResultType, error) {
+	var v QueryResultType
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a WorkflowQueryResult struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowQueryResult struct could not be generated from the wire
+// representation.
+func (v *WorkflowQueryResult) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x QueryResultType
+			x, err = _QueryResultType_Decode(sr)
+			v.ResultType = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			v.Answer, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ErrorMessage = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowQueryResult
+// struct.
+func (v *WorkflowQueryResult) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.ResultType != nil {
+		fields[i] = fmt.Sprintf("ResultType: %v", *(v.ResultType))
+		i++
+	}
+	if v.Answer != nil {
+		fields[i] = fmt.Sprintf("Answer: %v", v.Answer)
+		i++
+	}
+	if v.ErrorMessage != nil {
+		fields[i] = fmt.Sprintf("ErrorMessage: %v", *(v.ErrorMessage))
 		i++
 	}
 
-	return fmt.Sprintf("WorkflowQuery{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowQueryResult{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowQuery match the
-// provided WorkflowQuery.
+func _QueryResultType_EqualsPtr(lhs, rhs *QueryResultType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this WorkflowQueryResult match the
+// provided WorkflowQueryResult.
 //
 // This function performs a deep comparison.
-func (v *WorkflowQuery) Equals(rhs *WorkflowQuery) bool {
+func (v *WorkflowQueryResult) Equals(rhs *WorkflowQueryResult) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.QueryType, rhs.QueryType) {
+	if !_QueryResultType_EqualsPtr(v.ResultType, rhs.ResultType) {
 		return false
 	}
-	if !((v.QueryArgs == nil && rhs.QueryArgs == nil) || (v.QueryArgs != nil && rhs.QueryArgs != nil && bytes.Equal(v.QueryArgs, rhs.QueryArgs))) {
+	if !((v.Answer == nil && rhs.Answer == nil) || (v.Answer != nil && rhs.Answer != nil && bytes.Equal(v.Answer, rhs.Answer))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ErrorMessage, rhs.ErrorMessage) {
 		return false
 	}
 
@@ -99824,158 +108426,248 @@ func (v *WorkflowQuery) Equals(rhs *WorkflowQuery) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowQuery.
-func (v *WorkflowQuery) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowQueryResult.
+func (v *WorkflowQueryResult) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.QueryType != nil {
-		enc.AddString("queryType", *v.QueryType)
+	if v.ResultType != nil {
+		err = multierr.Append(err, enc.AddObject("resultType", *v.ResultType))
 	}
-	if v.QueryArgs != nil {
-		enc.AddString("queryArgs", base64.StdEncoding.EncodeToString(v.QueryArgs))
+	if v.Answer != nil {
+		enc.AddString("answer", base64.StdEncoding.EncodeToString(v.Answer))
+	}
+	if v.ErrorMessage != nil {
+		enc.AddString("errorMessage", *v.ErrorMessage)
 	}
 	return err
 }
 
-// GetQueryType returns the value of QueryType if it is set or its
+// GetResultType returns the value of ResultType if it is set or its
 // zero value if it is unset.
-func (v *WorkflowQuery) GetQueryType() (o string) {
-	if v != nil && v.QueryType != nil {
-		return *v.QueryType
+func (v *WorkflowQueryResult) GetResultType() (o QueryResultType) {
+	if v != nil && v.ResultType != nil {
+		return *v.ResultType
 	}
 
 	return
 }
 
-// IsSetQueryType returns true if QueryType is not nil.
-func (v *WorkflowQuery) IsSetQueryType() bool {
-	return v != nil && v.QueryType != nil
+// IsSetResultType returns true if ResultType is not nil.
+func (v *WorkflowQueryResult) IsSetResultType() bool {
+	return v != nil && v.ResultType != nil
 }
 
-// GetQueryArgs returns the value of QueryArgs if it is set or its
+// GetAnswer returns the value of Answer if it is set or its
 // zero value if it is unset.
-func (v *WorkflowQuery) GetQueryArgs() (o []byte) {
-	if v != nil && v.QueryArgs != nil {
-		return v.QueryArgs
+func (v *WorkflowQueryResult) GetAnswer() (o []byte) {
+	if v != nil && v.Answer != nil {
+		return v.Answer
 	}
 
 	return
 }
 
-// IsSetQueryArgs returns true if QueryArgs is not nil.
-func (v *WorkflowQuery) IsSetQueryArgs() bool {
-	return v != nil && v.QueryArgs != nil
+// IsSetAnswer returns true if Answer is not nil.
+func (v *WorkflowQueryResult) IsSetAnswer() bool {
+	return v != nil && v.Answer != nil
 }
 
-type WorkflowQueryResult struct {
-	ResultType   *QueryResultType `json:"resultType,omitempty"`
-	Answer       []byte           `json:"answer,omitempty"`
-	ErrorMessage *string          `json:"errorMessage,omitempty"`
+// GetErrorMessage returns the value of ErrorMessage if it is set or its
+// zero value if it is unset.
+func (v *WorkflowQueryResult) GetErrorMessage() (o string) {
+	if v != nil && v.ErrorMessage != nil {
+		return *v.ErrorMessage
+	}
+
+	return
 }
 
-// ToWire translates a WorkflowQueryResult struct into a Thrift-level intermediate
+// IsSetErrorMessage returns true if ErrorMessage is not nil.
+func (v *WorkflowQueryResult) IsSetErrorMessage() bool {
+	return v != nil && v.ErrorMessage != nil
+}
+
+type WorkflowTaskInfo struct {
+	Type                *int32  `json:"type,omitempty"`
+	SubType             *int32  `json:"subType,omitempty"`
+	TaskID              *int64  `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64  `json:"visibilityTimestamp,omitempty"`
+	Version             *int64  `json:"version,omitempty"`
+	Attempt             *int32  `json:"attempt,omitempty"`
+	ClusterName         *string `json:"clusterName,omitempty"`
+}
+
+// ToWire translates a WorkflowTaskInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *WorkflowQueryResult) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *WorkflowTaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ResultType != nil {
-		w, err = v.ResultType.ToWire()
+	if v.Type != nil {
+		w, err = wire.NewValueI32(*(v.Type)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Answer != nil {
-		w, err = wire.NewValueBinary(v.Answer), error(nil)
+	if v.SubType != nil {
+		w, err = wire.NewValueI32(*(v.SubType)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ErrorMessage != nil {
-		w, err = wire.NewValueString(*(v.ErrorMessage)), error(nil)
+	if v.TaskID != nil {
+		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.VisibilityTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.VisibilityTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryResultType_Read(w wire.Value) (QueryResultType, error) {
-	var v QueryResultType
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a WorkflowQueryResult struct from its Thrift-level
+// FromWire deserializes a WorkflowTaskInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a WorkflowQueryResult struct
+// An error is returned if we were unable to build a WorkflowTaskInfo struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v WorkflowQueryResult
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *WorkflowQueryResult) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v WorkflowTaskInfo
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *WorkflowTaskInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TI32 {
-				var x QueryResultType
-				x, err = _QueryResultType_Read(field.Value)
-				v.ResultType = &x
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Type = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.Answer, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.SubType = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.VisibilityTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ErrorMessage = &x
+				v.ClusterName = &x
 				if err != nil {
 					return err
 				}
@@ -99987,20 +108679,20 @@ func (v *WorkflowQueryResult) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a WorkflowQueryResult struct directly into bytes, without going
+// Encode serializes a WorkflowTaskInfo struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a WorkflowQueryResult struct could not be encoded.
-func (v *WorkflowQueryResult) Encode(sw stream.Writer) error {
+// An error is returned if a WorkflowTaskInfo struct could not be encoded.
+func (v *WorkflowTaskInfo) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ResultType != nil {
+	if v.Type != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := v.ResultType.Encode(sw); err != nil {
+		if err := sw.WriteInt32(*(v.Type)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -100008,11 +108700,11 @@ func (v *WorkflowQueryResult) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Answer != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.SubType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.Answer); err != nil {
+		if err := sw.WriteInt32(*(v.SubType)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -100020,11 +108712,11 @@ func (v *WorkflowQueryResult) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ErrorMessage != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.TaskID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ErrorMessage)); err != nil {
+		if err := sw.WriteInt64(*(v.TaskID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -100032,21 +108724,63 @@ func (v *WorkflowQueryResult) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
+	if v.VisibilityTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.VisibilityTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-func _QueryResultType_Decode(sr stream.Reader) (QueryResultType, error) {
-	var v QueryResultType
-	err := v.Decode(sr)
-	return v, err
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Attempt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Attempt)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClusterName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ClusterName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a WorkflowQueryResult struct directly from its Thrift-level
+// Decode deserializes a WorkflowTaskInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a WorkflowQueryResult struct could not be generated from the wire
+// An error is returned if a WorkflowTaskInfo struct could not be generated from the wire
 // representation.
-func (v *WorkflowQueryResult) Decode(sr stream.Reader) error {
+func (v *WorkflowTaskInfo) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -100060,23 +108794,57 @@ func (v *WorkflowQueryResult) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x QueryResultType
-			x, err = _QueryResultType_Decode(sr)
-			v.ResultType = &x
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Type = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			v.Answer, err = sr.ReadBinary()
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.SubType = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TBinary:
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TaskID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.VisibilityTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Attempt = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.ErrorMessage = &x
+			v.ClusterName = &x
 			if err != nil {
 				return err
 			}
@@ -100103,58 +108871,76 @@ func (v *WorkflowQueryResult) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a WorkflowQueryResult
+// String returns a readable string representation of a WorkflowTaskInfo
 // struct.
-func (v *WorkflowQueryResult) String() string {
+func (v *WorkflowTaskInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [7]string
 	i := 0
-	if v.ResultType != nil {
-		fields[i] = fmt.Sprintf("ResultType: %v", *(v.ResultType))
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
 		i++
 	}
-	if v.Answer != nil {
-		fields[i] = fmt.Sprintf("Answer: %v", v.Answer)
+	if v.SubType != nil {
+		fields[i] = fmt.Sprintf("SubType: %v", *(v.SubType))
 		i++
 	}
-	if v.ErrorMessage != nil {
-		fields[i] = fmt.Sprintf("ErrorMessage: %v", *(v.ErrorMessage))
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
 		i++
 	}
-
-	return fmt.Sprintf("WorkflowQueryResult{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _QueryResultType_EqualsPtr(lhs, rhs *QueryResultType) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+	if v.VisibilityTimestamp != nil {
+		fields[i] = fmt.Sprintf("VisibilityTimestamp: %v", *(v.VisibilityTimestamp))
+		i++
 	}
-	return lhs == nil && rhs == nil
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowTaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this WorkflowQueryResult match the
-// provided WorkflowQueryResult.
+// Equals returns true if all the fields of this WorkflowTaskInfo match the
+// provided WorkflowTaskInfo.
 //
 // This function performs a deep comparison.
-func (v *WorkflowQueryResult) Equals(rhs *WorkflowQueryResult) bool {
+func (v *WorkflowTaskInfo) Equals(rhs *WorkflowTaskInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_QueryResultType_EqualsPtr(v.ResultType, rhs.ResultType) {
+	if !_I32_EqualsPtr(v.Type, rhs.Type) {
 		return false
 	}
-	if !((v.Answer == nil && rhs.Answer == nil) || (v.Answer != nil && rhs.Answer != nil && bytes.Equal(v.Answer, rhs.Answer))) {
+	if !_I32_EqualsPtr(v.SubType, rhs.SubType) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ErrorMessage, rhs.ErrorMessage) {
+	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.VisibilityTimestamp, rhs.VisibilityTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
 		return false
 	}
 
@@ -100162,66 +108948,138 @@ func (v *WorkflowQueryResult) Equals(rhs *WorkflowQueryResult) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of WorkflowQueryResult.
-func (v *WorkflowQueryResult) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of WorkflowTaskInfo.
+func (v *WorkflowTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ResultType != nil {
-		err = multierr.Append(err, enc.AddObject("resultType", *v.ResultType))
+	if v.Type != nil {
+		enc.AddInt32("type", *v.Type)
 	}
-	if v.Answer != nil {
-		enc.AddString("answer", base64.StdEncoding.EncodeToString(v.Answer))
+	if v.SubType != nil {
+		enc.AddInt32("subType", *v.SubType)
 	}
-	if v.ErrorMessage != nil {
-		enc.AddString("errorMessage", *v.ErrorMessage)
+	if v.TaskID != nil {
+		enc.AddInt64("taskID", *v.TaskID)
+	}
+	if v.VisibilityTimestamp != nil {
+		enc.AddInt64("visibilityTimestamp", *v.VisibilityTimestamp)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.Attempt != nil {
+		enc.AddInt32("attempt", *v.Attempt)
+	}
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
 	}
 	return err
 }
 
-// GetResultType returns the value of ResultType if it is set or its
+// GetType returns the value of Type if it is set or its
 // zero value if it is unset.
-func (v *WorkflowQueryResult) GetResultType() (o QueryResultType) {
-	if v != nil && v.ResultType != nil {
-		return *v.ResultType
+func (v *WorkflowTaskInfo) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
 	}
 
 	return
 }
 
-// IsSetResultType returns true if ResultType is not nil.
-func (v *WorkflowQueryResult) IsSetResultType() bool {
-	return v != nil && v.ResultType != nil
+// IsSetType returns true if Type is not nil.
+func (v *WorkflowTaskInfo) IsSetType() bool {
+	return v != nil && v.Type != nil
 }
 
-// GetAnswer returns the value of Answer if it is set or its
+// GetSubType returns the value of SubType if it is set or its
 // zero value if it is unset.
-func (v *WorkflowQueryResult) GetAnswer() (o []byte) {
-	if v != nil && v.Answer != nil {
-		return v.Answer
+func (v *WorkflowTaskInfo) GetSubType() (o int32) {
+	if v != nil && v.SubType != nil {
+		return *v.SubType
 	}
 
 	return
 }
 
-// IsSetAnswer returns true if Answer is not nil.
-func (v *WorkflowQueryResult) IsSetAnswer() bool {
-	return v != nil && v.Answer != nil
+// IsSetSubType returns true if SubType is not nil.
+func (v *WorkflowTaskInfo) IsSetSubType() bool {
+	return v != nil && v.SubType != nil
 }
 
-// GetErrorMessage returns the value of ErrorMessage if it is set or its
+// GetTaskID returns the value of TaskID if it is set or its
 // zero value if it is unset.
-func (v *WorkflowQueryResult) GetErrorMessage() (o string) {
-	if v != nil && v.ErrorMessage != nil {
-		return *v.ErrorMessage
+func (v *WorkflowTaskInfo) GetTaskID() (o int64) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
 	}
 
 	return
 }
 
-// IsSetErrorMessage returns true if ErrorMessage is not nil.
-func (v *WorkflowQueryResult) IsSetErrorMessage() bool {
-	return v != nil && v.ErrorMessage != nil
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *WorkflowTaskInfo) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
+}
+
+// GetVisibilityTimestamp returns the value of VisibilityTimestamp if it is set or its
+// zero value if it is unset.
+func (v *WorkflowTaskInfo) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+
+	return
+}
+
+// IsSetVisibilityTimestamp returns true if VisibilityTimestamp is not nil.
+func (v *WorkflowTaskInfo) IsSetVisibilityTimestamp() bool {
+	return v != nil && v.VisibilityTimestamp != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *WorkflowTaskInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *WorkflowTaskInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *WorkflowTaskInfo) GetAttempt() (o int32) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *WorkflowTaskInfo) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *WorkflowTaskInfo) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
+}
+
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *WorkflowTaskInfo) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
 }
 
 type WorkflowType struct {
@@ -100649,8 +109507,8 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "shared",
 	Package:  "github.com/uber/cadence/.gen/go/shared",
 	FilePath: "shared.thrift",
-	SHA1:     "bcdbb1c5616721d2119164b10c98472e6f7de1e0",
+	SHA1:     "15016008faadd98e02d994b3c88c4b045f14fb7c",
 	Raw:      rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n}\n\nexception InternalServiceError {\n  1: required string message\n}\n\nexception InternalDataInconsistencyError {\n  1: required string message\n}\n\nexception DomainAlreadyExistsError {\n  1: required string message\n}\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n}\n\nexception WorkflowExecutionAlreadyCompletedError {\n  1: required string message\n}\n\nexception EntityNotExistsError {\n  1: required string message\n  2: optional string currentCluster\n  3: optional string activeCluster\n}\n\nexception ServiceBusyError {\n  1: required string message\n}\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n}\n\nexception QueryFailedError {\n  1: required string message\n}\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n}\n\nexception LimitExceededError {\n  1: required string message\n}\n\nexception AccessDeniedError {\n  1: required string message\n}\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n}\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n}\n\nexception FeatureNotEnabledError {\n  1: required string featureFlag\n}\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n}\n\nexception RemoteSyncMatchedError {\n  10: required string message\n}\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateIfRunning,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n\tABANDON,\n\tREQUEST_CANCEL,\n\tTERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n}\n\nenum DecisionTaskTimedOutCause {\n  TIMEOUT,\n  RESET,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum PendingDecisionState {\n  SCHEDULED,\n  STARTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n  120: optional string taskList\n  130: optional bool isCron\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional bool requestLocalDispatch\n}\n\nstruct ActivityLocalDispatchInfo{\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  50: optional binary taskToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // for reset workflow\n  40: optional string baseRunId\n  50: optional string newRunId\n  60: optional i64 (js.type = \"Long\") forkEventVersion\n  70: optional string reason\n  80: optional DecisionTaskTimedOutCause cause\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n  90: optional string binaryChecksum\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n  50: optional string lastFailureReason\n  60: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n  170: optional i32 delayStartSeconds\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n}\n\nstruct FailoverInfo {\n    10: optional i64 (js.type = \"Long\") failoverVersion\n    20: optional i64 (js.type = \"Long\") failoverStartTimestamp\n    30: optional i64 (js.type = \"Long\") failoverExpireTimestamp\n    40: optional i32 completedShardCount\n    50: optional list<i32> pendingShards\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n 10: optional string activeClusterName\n 20: optional list<ClusterReplicationConfiguration> clusters\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric = true\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n  60: optional FailoverInfo failoverInfo\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n 70: optional i32 failoverTimeoutInSeconds\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n  160: optional i32 delayStartSeconds\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100: optional i64 (js.type = \"Long\") scheduledTimestamp\n  110: optional i64 (js.type = \"Long\") startedTimestamp\n  120: optional map<string, WorkflowQuery> queries\n  130: optional i64 (js.type = 'Long') nextEventId\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n  20: optional map<string,ActivityLocalDispatchInfo> activitiesToDispatchLocally\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n  70: optional bool skipArchival\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  11: optional list<DataBlob> rawHistory\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n  180: optional i32 delayStartSeconds\n}\n\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n  60: optional bool skipSignalReapply\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n}\n\nstruct PendingDecisionInfo {\n  10: optional PendingDecisionState state\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 attempt\n  50: optional i64 (js.type = \"Long\") originalScheduledTimestamp\n}\n\nstruct PendingChildExecutionInfo {\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n  50: optional PendingDecisionInfo pendingDecision\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n}\n\nstruct GetTaskListsByDomainRequest {\n  10: optional string domainName\n}\n\nstruct GetTaskListsByDomainResponse {\n  10: optional map<string,DescribeTaskListResponse> decisionTaskListMap\n  20: optional map<string,DescribeTaskListResponse> activityTaskListMap\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct TaskListPartitionMetadata {\n  10: optional string key\n  20: optional string ownerHostName\n}\n\nstruct ListTaskListPartitionsResponse {\n  10: optional list<TaskListPartitionMetadata> activityTaskListPartitions\n  20: optional list<TaskListPartitionMetadata> decisionTaskListPartitions\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n  40: optional i64 (js.type = \"Long\")   visibilityTimestamp\n  50: optional string                   clusterName\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct ResetQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueResponse {\n  10: optional list<string> processingQueueStates\n}\n\nstruct DescribeShardDistributionRequest {\n  10: optional i32 pageSize\n  20: optional i32 pageID\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32              numberOfShards\n\n  // ShardID to Address (ip:port) map\n  20: optional map<i32, string> shards\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}\n\n// SupportedClientVersions contains the support versions for client library\nstruct SupportedClientVersions{\n  10: optional string goSdk\n  20: optional string javaSdk\n}\n\n// ClusterInfo contains information about cadence cluster\nstruct ClusterInfo{\n  10: optional SupportedClientVersions supportedClientVersions\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct FeatureFlags {\n\t10: optional bool WorkflowExecutionAlreadyCompletedErrorEnabled\n}\n\nenum CrossClusterTaskType {\n  StartChildExecution\n  CancelExecution\n  SignalExecution\n  RecordChildWorkflowExecutionComplete\n  ApplyParentClosePolicy\n}\n\nenum CrossClusterTaskFailedCause {\n  DOMAIN_NOT_ACTIVE\n  DOMAIN_NOT_EXISTS\n  WORKFLOW_ALREADY_RUNNING\n  WORKFLOW_NOT_EXISTS\n  WORKFLOW_ALREADY_COMPLETED\n  UNCATEGORIZED\n}\n\nenum GetTaskFailedCause {\n  SERVICE_BUSY\n  TIMEOUT\n  SHARD_OWNERSHIP_LOST\n  UNCATEGORIZED\n}\n\nstruct CrossClusterTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional CrossClusterTaskType taskType\n  50: optional i16 taskState\n  60: optional i64 (js.type = \"Long\") taskID\n  70: optional i64 (js.type = \"Long\") visibilityTimestamp\n}\n\nstruct CrossClusterStartChildExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string requestID\n  30: optional i64 (js.type = \"Long\") initiatedEventID\n  40: optional StartChildWorkflowExecutionInitiatedEventAttributes initiatedEventAttributes\n  // targetRunID is for scheduling first decision task\n  // targetWorkflowID is available in initiatedEventAttributes\n  50: optional string targetRunID\n}\n\nstruct CrossClusterStartChildExecutionResponseAttributes {\n  10: optional string runID\n}\n\nstruct CrossClusterCancelExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n}\n\nstruct CrossClusterCancelExecutionResponseAttributes {\n}\n\nstruct CrossClusterSignalExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n  70: optional string signalName\n  80: optional binary signalInput\n  90: optional binary control\n}\n\nstruct CrossClusterSignalExecutionResponseAttributes {\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional i64 (js.type = \"Long\") initiatedEventID\n  50: optional HistoryEvent completionEvent\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes {\n}\n\nstruct ApplyParentClosePolicyAttributes {\n  10: optional string childDomainID\n  20: optional string childWorkflowID\n  30: optional string childRunID\n  40: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct CrossClusterApplyParentClosePolicyRequestAttributes {\n  10: optional list<ApplyParentClosePolicyAttributes> applyParentClosePolicyAttributes\n}\n\nstruct CrossClusterApplyParentClosePolicyResponseAttributes {\n}\n\nstruct CrossClusterTaskRequest {\n  10: optional CrossClusterTaskInfo taskInfo\n  20: optional CrossClusterStartChildExecutionRequestAttributes startChildExecutionAttributes\n  30: optional CrossClusterCancelExecutionRequestAttributes cancelExecutionAttributes\n  40: optional CrossClusterSignalExecutionRequestAttributes signalExecutionAttributes\n  50: optional CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes recordChildWorkflowExecutionCompleteAttributes\n  60: optional CrossClusterApplyParentClosePolicyRequestAttributes applyParentClosePolicyAttributes\n}\n\nstruct CrossClusterTaskResponse {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional CrossClusterTaskType taskType\n  30: optional i16 taskState\n  40: optional CrossClusterTaskFailedCause failedCause\n  50: optional CrossClusterStartChildExecutionResponseAttributes startChildExecutionAttributes\n  60: optional CrossClusterCancelExecutionResponseAttributes cancelExecutionAttributes\n  70: optional CrossClusterSignalExecutionResponseAttributes signalExecutionAttributes\n  80: optional CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes recordChildWorkflowExecutionCompleteAttributes\n  90: optional CrossClusterApplyParentClosePolicyResponseAttributes applyParentClosePolicyAttributes\n}\n\nstruct GetCrossClusterTasksRequest {\n  10: optional list<i32> shardIDs\n  20: optional string targetCluster\n}\n\nstruct GetCrossClusterTasksResponse {\n  10: optional map<i32, list<CrossClusterTaskRequest>> tasksByShard\n  20: optional map<i32, GetTaskFailedCause> failedCauseByShard\n}\n\nstruct RespondCrossClusterTasksCompletedRequest {\n  10: optional i32 shardID\n  20: optional string targetCluster\n  30: optional list<CrossClusterTaskResponse> taskResponses\n  40: optional bool fetchNewTasks\n}\n\nstruct RespondCrossClusterTasksCompletedResponse {\n  10: optional list<CrossClusterTaskRequest> tasks\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n}\n\nexception InternalServiceError {\n  1: required string message\n}\n\nexception InternalDataInconsistencyError {\n  1: required string message\n}\n\nexception DomainAlreadyExistsError {\n  1: required string message\n}\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n}\n\nexception WorkflowExecutionAlreadyCompletedError {\n  1: required string message\n}\n\nexception EntityNotExistsError {\n  1: required string message\n  2: optional string currentCluster\n  3: optional string activeCluster\n}\n\nexception ServiceBusyError {\n  1: required string message\n}\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n}\n\nexception QueryFailedError {\n  1: required string message\n}\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n}\n\nexception LimitExceededError {\n  1: required string message\n}\n\nexception AccessDeniedError {\n  1: required string message\n}\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n}\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n}\n\nexception FeatureNotEnabledError {\n  1: required string featureFlag\n}\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n}\n\nexception RemoteSyncMatchedError {\n  10: required string message\n}\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateIfRunning,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n\tABANDON,\n\tREQUEST_CANCEL,\n\tTERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n  WorkflowExecutionSuspended,\n  WorkflowExecutionResumed,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n}\n\nenum DecisionTaskTimedOutCause {\n  TIMEOUT,\n  RESET,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum PendingDecisionState {\n  SCHEDULED,\n  STARTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n  120: optional string taskList\n  130: optional bool isCron\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  50: optional i32 retentionPeriodInDays\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional bool requestLocalDispatch\n}\n\nstruct ActivityLocalDispatchInfo{\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  50: optional binary taskToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n  160: optional i32 retentionPeriodInDays\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n  150: optional i32 retentionPeriodInDays\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // for reset workflow\n  40: optional string baseRunId\n  50: optional string newRunId\n  60: optional i64 (js.type = \"Long\") forkEventVersion\n  70: optional string reason\n  80: optional DecisionTaskTimedOutCause cause\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n  90: optional string binaryChecksum\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n  50: optional string lastFailureReason\n  60: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct WorkflowExecutionSuspendedEventAttributes {\n  10: optional string reason\n  20: optional string identity\n}\n\nstruct WorkflowExecutionResumedEventAttributes {\n  10: optional string reason\n  20: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n  170: optional i32 delayStartSeconds\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n  460: optional WorkflowExecutionSuspendedEventAttributes workflowExecutionSuspendedEventAttributes\n  470: optional WorkflowExecutionResumedEventAttributes workflowExecutionResumedEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n}\n\nstruct FailoverInfo {\n    10: optional i64 (js.type = \"Long\") failoverVersion\n    20: optional i64 (js.type = \"Long\") failoverStartTimestamp\n    30: optional i64 (js.type = \"Long\") failoverExpireTimestamp\n    40: optional i32 completedShardCount\n    50: optional list<i32> pendingShards\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n 10: optional string activeClusterName\n 20: optional list<ClusterReplicationConfiguration> clusters\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric = true\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n  60: optional FailoverInfo failoverInfo\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n 70: optional i32 failoverTimeoutInSeconds\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n  160: optional i32 delayStartSeconds\n  170: optional i32 retentionPeriodInDays\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100: optional i64 (js.type = \"Long\") scheduledTimestamp\n  110: optional i64 (js.type = \"Long\") startedTimestamp\n  120: optional map<string, WorkflowQuery> queries\n  130: optional i64 (js.type = 'Long') nextEventId\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n  20: optional map<string,ActivityLocalDispatchInfo> activitiesToDispatchLocally\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n  70: optional bool skipArchival\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  11: optional list<DataBlob> rawHistory\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n  180: optional i32 delayStartSeconds\n  190: optional i32 retentionPeriodInDays\n}\n\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n  60: optional bool skipSignalReapply\n  70: optional bool dryRun\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n  20: optional ResetWorkflowExecutionPreview preview\n}\n\nstruct ResetWorkflowExecutionPreview {\n  10: optional HistoryEvent resetPointEvent\n  20: optional list<HistoryEvent> discardedEvents\n  30: optional list<HistoryEvent> reappliedSignals\n  40: optional list<PendingActivityInfo> pendingActivities\n  50: optional list<PendingTimerInfo> pendingTimers\n  60: optional list<WorkflowExecution> orphanedChildWorkflows\n}\n\nstruct PendingTimerInfo {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") expiryTimestamp\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n  20: optional list<CountWorkflowExecutionsGroup> groups\n}\n\nstruct CountWorkflowExecutionsGroup {\n  10: optional list<string> groupValues\n  20: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n}\n\nstruct PendingDecisionInfo {\n  10: optional PendingDecisionState state\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 attempt\n  50: optional i64 (js.type = \"Long\") originalScheduledTimestamp\n}\n\nstruct PendingChildExecutionInfo {\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n  50: optional PendingDecisionInfo pendingDecision\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n}\n\nstruct GetTaskListsByDomainRequest {\n  10: optional string domainName\n}\n\nstruct GetTaskListsByDomainResponse {\n  10: optional map<string,DescribeTaskListResponse> decisionTaskListMap\n  20: optional map<string,DescribeTaskListResponse> activityTaskListMap\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct TaskListPartitionMetadata {\n  10: optional string key\n  20: optional string ownerHostName\n}\n\nstruct ListTaskListPartitionsResponse {\n  10: optional list<TaskListPartitionMetadata> activityTaskListPartitions\n  20: optional list<TaskListPartitionMetadata> decisionTaskListPartitions\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n  40: optional i64 (js.type = \"Long\")   visibilityTimestamp\n  50: optional string                   clusterName\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct ResetQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueResponse {\n  10: optional list<string> processingQueueStates\n}\n\nstruct DescribeShardDistributionRequest {\n  10: optional i32 pageSize\n  20: optional i32 pageID\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32              numberOfShards\n\n  // ShardID to Address (ip:port) map\n  20: optional map<i32, string> shards\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n  60: optional list<HistoryShardLoad> shardLoads\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nstruct HistoryShardLoad {\n  10: optional i32                     shardID\n  20: optional double                  requestsPerSecond\n  // number of workflow lock acquisitions per second which had to wait for the lock\n  30: optional double                  lockWaitsPerSecond\n  40: optional i64 (js.type = \"Long\")  averageLockWaitInMillis\n  // difference between the transfer max read level and ack level, in number of task IDs\n  50: optional i64 (js.type = \"Long\")  transferTaskLag\n  60: optional i64 (js.type = \"Long\")  timerTaskLagInMillis\n  70: optional list<HistoryLoadEntry> topDomains\n  80: optional list<HistoryLoadEntry> topWorkflows\n}\n\nstruct HistoryLoadEntry {\n  10: optional string domainID\n  // empty for domain level entries\n  20: optional string workflowID\n  30: optional double requestsPerSecond\n  40: optional double lockWaitsPerSecond\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}\n\n// SupportedClientVersions contains the support versions for client library\nstruct SupportedClientVersions{\n  10: optional string goSdk\n  20: optional string javaSdk\n  30: optional string cli\n}\n\n// ClusterInfo contains information about cadence cluster\nstruct ClusterInfo{\n  10: optional SupportedClientVersions supportedClientVersions\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct FailoverWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string targetCluster\n}\n\nstruct SuspendWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional string identity\n}\n\nstruct ResumeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional string identity\n}\n\nstruct WorkflowTaskInfo {\n  10: optional i32 type\n  20: optional i32 subType\n  30: optional i64 (js.type = \"Long\") taskID\n  40: optional i64 (js.type = \"Long\") visibilityTimestamp\n  50: optional i64 (js.type = \"Long\") version\n  60: optional i32 attempt\n  70: optional string clusterName\n}\n\nstruct ListWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n}\n\nstruct ListWorkflowTasksResponse {\n  10: optional i32 shardID\n  20: optional list<WorkflowTaskInfo> tasks\n  30: optional bool truncated\n}\n\nstruct DeleteWorkflowTaskRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional i32 type\n  40: optional i64 (js.type = \"Long\") taskID\n  50: optional i64 (js.type = \"Long\") visibilityTimestamp\n  60: optional string clusterName\n  70: optional string reason\n  80: optional string identity\n}\n\nstruct ReenqueueWorkflowTaskRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional i32 type\n  40: optional i64 (js.type = \"Long\") taskID\n  50: optional i64 (js.type = \"Long\") visibilityTimestamp\n  60: optional string clusterName\n  70: optional string reason\n  80: optional string identity\n}\n\nstruct DomainUsage {\n  10: optional i64 (js.type = \"Long\") historySize\n  20: optional i64 (js.type = \"Long\") historyEventCount\n  30: optional i64 (js.type = \"Long\") openWorkflowCount\n  40: optional i64 (js.type = \"Long\") closedWorkflowCount\n}\n\nstruct DescribeDomainUsageRequest {\n  10: optional string domain\n}\n\nstruct DescribeDomainUsageResponse {\n  10: optional DomainUsage usage\n  20: optional i64 (js.type = \"Long\") lastUpdateTime\n}\n\nstruct FeatureFlags {\n\t10: optional bool WorkflowExecutionAlreadyCompletedErrorEnabled\n}\n\nenum CrossClusterTaskType {\n  StartChildExecution\n  CancelExecution\n  SignalExecution\n  RecordChildWorkflowExecutionComplete\n  ApplyParentClosePolicy\n}\n\nenum CrossClusterTaskFailedCause {\n  DOMAIN_NOT_ACTIVE\n  DOMAIN_NOT_EXISTS\n  WORKFLOW_ALREADY_RUNNING\n  WORKFLOW_NOT_EXISTS\n  WORKFLOW_ALREADY_COMPLETED\n  UNCATEGORIZED\n}\n\nenum GetTaskFailedCause {\n  SERVICE_BUSY\n  TIMEOUT\n  SHARD_OWNERSHIP_LOST\n  UNCATEGORIZED\n}\n\nstruct CrossClusterTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional CrossClusterTaskType taskType\n  50: optional i16 taskState\n  60: optional i64 (js.type = \"Long\") taskID\n  70: optional i64 (js.type = \"Long\") visibilityTimestamp\n}\n\nstruct CrossClusterStartChildExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string requestID\n  30: optional i64 (js.type = \"Long\") initiatedEventID\n  40: optional StartChildWorkflowExecutionInitiatedEventAttributes initiatedEventAttributes\n  // targetRunID is for scheduling first decision task\n  // targetWorkflowID is available in initiatedEventAttributes\n  50: optional string targetRunID\n}\n\nstruct CrossClusterStartChildExecutionResponseAttributes {\n  10: optional string runID\n}\n\nstruct CrossClusterCancelExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n}\n\nstruct CrossClusterCancelExecutionResponseAttributes {\n}\n\nstruct CrossClusterSignalExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n  70: optional string signalName\n  80: optional binary signalInput\n  90: optional binary control\n}\n\nstruct CrossClusterSignalExecutionResponseAttributes {\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional i64 (js.type = \"Long\") initiatedEventID\n  50: optional HistoryEvent completionEvent\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes {\n}\n\nstruct ApplyParentClosePolicyAttributes {\n  10: optional string childDomainID\n  20: optional string childWorkflowID\n  30: optional string childRunID\n  40: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct CrossClusterApplyParentClosePolicyRequestAttributes {\n  10: optional list<ApplyParentClosePolicyAttributes> applyParentClosePolicyAttributes\n}\n\nstruct CrossClusterApplyParentClosePolicyResponseAttributes {\n}\n\nstruct CrossClusterTaskRequest {\n  10: optional CrossClusterTaskInfo taskInfo\n  20: optional CrossClusterStartChildExecutionRequestAttributes startChildExecutionAttributes\n  30: optional CrossClusterCancelExecutionRequestAttributes cancelExecutionAttributes\n  40: optional CrossClusterSignalExecutionRequestAttributes signalExecutionAttributes\n  50: optional CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes recordChildWorkflowExecutionCompleteAttributes\n  60: optional CrossClusterApplyParentClosePolicyRequestAttributes applyParentClosePolicyAttributes\n}\n\nstruct CrossClusterTaskResponse {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional CrossClusterTaskType taskType\n  30: optional i16 taskState\n  40: optional CrossClusterTaskFailedCause failedCause\n  50: optional CrossClusterStartChildExecutionResponseAttributes startChildExecutionAttributes\n  60: optional CrossClusterCancelExecutionResponseAttributes cancelExecutionAttributes\n  70: optional CrossClusterSignalExecutionResponseAttributes signalExecutionAttributes\n  80: optional CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes recordChildWorkflowExecutionCompleteAttributes\n  90: optional CrossClusterApplyParentClosePolicyResponseAttributes applyParentClosePolicyAttributes\n}\n\nstruct GetCrossClusterTasksRequest {\n  10: optional list<i32> shardIDs\n  20: optional string targetCluster\n}\n\nstruct GetCrossClusterTasksResponse {\n  10: optional map<i32, list<CrossClusterTaskRequest>> tasksByShard\n  20: optional map<i32, GetTaskFailedCause> failedCauseByShard\n}\n\nstruct RespondCrossClusterTasksCompletedRequest {\n  10: optional i32 shardID\n  20: optional string targetCluster\n  30: optional list<CrossClusterTaskResponse> taskResponses\n  40: optional bool fetchNewTasks\n}\n\nstruct RespondCrossClusterTasksCompletedResponse {\n  10: optional list<CrossClusterTaskRequest> tasks\n}\n"
@@ -585,6 +585,7 @@ type SupportedClientVersions struct {
 	GoSdk string `protobuf:"bytes,1,opt,name=go_sdk,json=goSdk,proto3" json:"go_sdk,omitempty"`
 	// Indicates the highest Java SDK version server will accept requests from.
 	JavaSdk              string   `protobuf:"bytes,2,opt,name=java_sdk,json=javaSdk,proto3" json:"java_sdk,omitempty"`
+	Cli                  string   `protobuf:"bytes,3,opt,name=cli,proto3" json:"cli,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -637,6 +638,13 @@ func (m *SupportedClientVersions) GetJavaSdk() string {
 	return ""
 }
 
+func (m *SupportedClientVersions) GetCli() string {
+	if m != nil {
+		return m.Cli
+	}
+	return ""
+}
+
 type RetryPolicy struct {
 	// Interval of the first retry. If backoffCoefficient is 1.0 then it is used for all retries.
 	InitialInterval *types.Duration `protobuf:"bytes,1,opt,name=initial_interval,json=initialInterval,proto3" json:"initial_interval,omitempty"`
@@ -756,59 +764,60 @@ func init() {
 func init() { proto.RegisterFile("uber/cadence/api/v1/common.proto", fileDescriptor_0ff151d4a308b356) }
 
 var fileDescriptor_0ff151d4a308b356 = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xcd, 0x26, 0xed, 0xbe, 0x64, 0xbb, 0x61, 0xca, 0x76, 0xd3, 0x02, 0x25, 0x44, 0x42,
-	0x5b, 0x38, 0xd8, 0x6a, 0xf6, 0xb2, 0x62, 0xb5, 0x42, 0x69, 0x93, 0xb2, 0xde, 0x85, 0x36, 0x72,
-	0xa2, 0xad, 0xe0, 0x80, 0x35, 0xb6, 0x9f, 0xb3, 0x43, 0xec, 0x19, 0x6b, 0x3c, 0x4e, 0x9b, 0x1b,
-	0x9f, 0x84, 0x03, 0x9f, 0x86, 0x23, 0x12, 0x5f, 0x00, 0xf5, 0x93, 0x20, 0xdb, 0x93, 0x26, 0x59,
-	0x82, 0xf6, 0x82, 0xb8, 0xcd, 0xbc, 0xdf, 0x9f, 0xf9, 0x3d, 0xeb, 0xcd, 0x18, 0xda, 0x99, 0x87,
-	0xd2, 0xf2, 0x69, 0x80, 0xdc, 0x47, 0x8b, 0x26, 0xcc, 0x9a, 0x9d, 0x58, 0xbe, 0x88, 0x63, 0xc1,
-	0xcd, 0x44, 0x0a, 0x25, 0xc8, 0x5e, 0xce, 0x30, 0x35, 0xc3, 0xa4, 0x09, 0x33, 0x67, 0x27, 0x87,
-	0x47, 0x13, 0x21, 0x26, 0x11, 0x5a, 0x05, 0xc5, 0xcb, 0x42, 0x2b, 0xc8, 0x24, 0x55, 0x6c, 0x21,
-	0xea, 0xbc, 0x86, 0x0f, 0xaf, 0x84, 0x9c, 0x86, 0x91, 0xb8, 0x1e, 0xdc, 0xa0, 0x9f, 0xe5, 0x10,
-	0xf9, 0x0c, 0xea, 0xd7, 0xba, 0xe8, 0xb2, 0xa0, 0x65, 0xb4, 0x8d, 0xe3, 0xfb, 0x0e, 0x2c, 0x4a,
-	0x76, 0x40, 0x1e, 0x41, 0x4d, 0x66, 0x3c, 0xc7, 0xb6, 0x0a, 0xac, 0x2a, 0x33, 0x6e, 0x07, 0x9d,
-	0x0e, 0x34, 0x16, 0x66, 0xe3, 0x79, 0x82, 0x84, 0xc0, 0x3d, 0x4e, 0x63, 0xd4, 0x06, 0xc5, 0x3a,
-	0xe7, 0xf4, 0x7c, 0xc5, 0x66, 0x4c, 0xcd, 0xff, 0x95, 0xf3, 0x29, 0x6c, 0x0f, 0xe9, 0x3c, 0x12,
-	0x34, 0xc8, 0xe1, 0x80, 0x2a, 0x5a, 0xc0, 0x0d, 0xa7, 0x58, 0x77, 0x9e, 0xc3, 0xf6, 0x39, 0x65,
-	0x51, 0x26, 0x91, 0xec, 0x43, 0x4d, 0x22, 0x4d, 0x05, 0xd7, 0x7a, 0xbd, 0x23, 0x2d, 0xd8, 0x0e,
-	0x50, 0x51, 0x16, 0xa5, 0x45, 0xc2, 0x86, 0xb3, 0xd8, 0x76, 0x7e, 0x35, 0xe0, 0xde, 0xf7, 0x18,
-	0x0b, 0xf2, 0x02, 0x6a, 0x21, 0xc3, 0x28, 0x48, 0x5b, 0x46, 0xbb, 0x72, 0x5c, 0xef, 0x7e, 0x61,
-	0x6e, 0xf8, 0x7e, 0x66, 0x4e, 0x35, 0xcf, 0x0b, 0xde, 0x80, 0x2b, 0x39, 0x77, 0xb4, 0xe8, 0xf0,
-	0x0a, 0xea, 0x2b, 0x65, 0xd2, 0x84, 0xca, 0x14, 0xe7, 0x3a, 0x45, 0xbe, 0x24, 0x5d, 0xa8, 0xce,
-	0x68, 0x94, 0x61, 0x11, 0xa0, 0xde, 0xfd, 0x64, 0xa3, 0xbd, 0x6e, 0xd3, 0x29, 0xa9, 0x5f, 0x6f,
-	0x3d, 0x33, 0x3a, 0xbf, 0x19, 0x50, 0x7b, 0x89, 0x34, 0x40, 0x49, 0xbe, 0x79, 0x27, 0xe2, 0x93,
-	0x8d, 0x1e, 0x25, 0xf9, 0xff, 0x0d, 0xf9, 0xa7, 0x01, 0xcd, 0x11, 0x52, 0xe9, 0xbf, 0xed, 0x29,
-	0x25, 0x99, 0x97, 0x29, 0x4c, 0x89, 0x0b, 0xbb, 0x8c, 0x07, 0x78, 0x83, 0x81, 0xbb, 0x16, 0xfb,
-	0xd9, 0x46, 0xd7, 0x77, 0xe5, 0xa6, 0x5d, 0x6a, 0x57, 0xfb, 0x78, 0xc0, 0x56, 0x6b, 0x87, 0x3f,
-	0x01, 0xf9, 0x27, 0xe9, 0x3f, 0xec, 0x2a, 0x84, 0x9d, 0x3e, 0x55, 0xf4, 0x34, 0x12, 0x1e, 0x39,
-	0x87, 0x07, 0xc8, 0x7d, 0x11, 0x30, 0x3e, 0x71, 0xd5, 0x3c, 0x29, 0x07, 0x74, 0xb7, 0xfb, 0xf9,
-	0x46, 0xaf, 0x81, 0x66, 0xe6, 0x13, 0xed, 0x34, 0x70, 0x65, 0x77, 0x37, 0xc0, 0x5b, 0x2b, 0x03,
-	0x3c, 0x2c, 0x2f, 0x1d, 0xca, 0x37, 0x28, 0x53, 0x26, 0xb8, 0xcd, 0x43, 0x91, 0x13, 0x59, 0x9c,
-	0x44, 0x8b, 0x8b, 0x90, 0xaf, 0xc9, 0x13, 0x78, 0x18, 0x22, 0x55, 0x99, 0x44, 0x77, 0x56, 0x52,
-	0xf5, 0x85, 0xdb, 0xd5, 0x65, 0x6d, 0xd0, 0x79, 0x0d, 0x8f, 0x47, 0x59, 0x92, 0x08, 0xa9, 0x30,
-	0x38, 0x8b, 0x18, 0x72, 0xa5, 0x91, 0x34, 0xbf, 0xab, 0x13, 0xe1, 0xa6, 0xc1, 0x54, 0x3b, 0x57,
-	0x27, 0x62, 0x14, 0x4c, 0xc9, 0x01, 0xec, 0xfc, 0x4c, 0x67, 0xb4, 0x00, 0x4a, 0xcf, 0xed, 0x7c,
-	0x3f, 0x0a, 0xa6, 0x9d, 0x5f, 0x2a, 0x50, 0x77, 0x50, 0xc9, 0xf9, 0x50, 0x44, 0xcc, 0x9f, 0x93,
-	0x3e, 0x34, 0x19, 0x67, 0x8a, 0xd1, 0xc8, 0x65, 0x5c, 0xa1, 0x9c, 0xd1, 0x32, 0x65, 0xbd, 0x7b,
-	0x60, 0x96, 0xcf, 0x8b, 0xb9, 0x78, 0x5e, 0xcc, 0xbe, 0x7e, 0x5e, 0x9c, 0x87, 0x5a, 0x62, 0x6b,
-	0x05, 0xb1, 0x60, 0xcf, 0xa3, 0xfe, 0x54, 0x84, 0xa1, 0xeb, 0x0b, 0x0c, 0x43, 0xe6, 0xe7, 0x31,
-	0x8b, 0xb3, 0x0d, 0x87, 0x68, 0xe8, 0x6c, 0x89, 0xe4, 0xc7, 0xc6, 0xf4, 0x86, 0xc5, 0x59, 0xbc,
-	0x3c, 0xb6, 0xf2, 0xde, 0x63, 0xb5, 0xe4, 0xee, 0xd8, 0x2f, 0x97, 0x2e, 0x54, 0x29, 0x8c, 0x13,
-	0x95, 0xb6, 0xee, 0xb5, 0x8d, 0xe3, 0xea, 0x1d, 0xb5, 0xa7, 0xcb, 0xe4, 0x05, 0x7c, 0xcc, 0x05,
-	0x77, 0x65, 0xde, 0x3a, 0xf5, 0x22, 0x74, 0x51, 0x4a, 0x21, 0xdd, 0xf2, 0x49, 0x49, 0x5b, 0xd5,
-	0x76, 0xe5, 0xf8, 0xbe, 0xd3, 0xe2, 0x82, 0x3b, 0x0b, 0xc6, 0x20, 0x27, 0x38, 0x25, 0x4e, 0x5e,
-	0xc1, 0x1e, 0xde, 0x24, 0xac, 0x0c, 0xb2, 0x8c, 0x5c, 0x7b, 0x5f, 0x64, 0xb2, 0x54, 0x2d, 0x52,
-	0x7f, 0x75, 0x0d, 0x8d, 0xd5, 0x99, 0x22, 0x07, 0xf0, 0x68, 0x70, 0x71, 0x76, 0xd9, 0xb7, 0x2f,
-	0xbe, 0x75, 0xc7, 0x3f, 0x0c, 0x07, 0xae, 0x7d, 0xf1, 0xa6, 0xf7, 0x9d, 0xdd, 0x6f, 0x7e, 0x40,
-	0x0e, 0x61, 0x7f, 0x1d, 0x1a, 0xbf, 0x74, 0xec, 0xf3, 0xb1, 0x73, 0xd5, 0x34, 0xc8, 0x3e, 0x90,
-	0x75, 0xec, 0xd5, 0xe8, 0xf2, 0xa2, 0xb9, 0x45, 0x5a, 0xf0, 0xd1, 0x7a, 0x7d, 0xe8, 0x5c, 0x8e,
-	0x2f, 0x9f, 0x36, 0x2b, 0xa7, 0xde, 0xef, 0xb7, 0x47, 0xc6, 0x1f, 0xb7, 0x47, 0xc6, 0x5f, 0xb7,
-	0x47, 0x06, 0x3c, 0xf6, 0x45, 0xbc, 0x69, 0xe0, 0x4f, 0x77, 0x7a, 0x09, 0x1b, 0xe6, 0x9d, 0x0c,
-	0x8d, 0x1f, 0xad, 0x09, 0x53, 0x6f, 0x33, 0xcf, 0xf4, 0x45, 0x6c, 0xad, 0xfd, 0xa4, 0xcc, 0x09,
-	0xf2, 0xf2, 0xc7, 0xa3, 0xff, 0x57, 0xcf, 0x69, 0xc2, 0x66, 0x27, 0x5e, 0xad, 0xa8, 0x3d, 0xfd,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x8a, 0xf4, 0x9b, 0xd3, 0x06, 0x00, 0x00,
+	// 837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xd1, 0x6e, 0x1b, 0x45,
+	0x14, 0x65, 0xe3, 0xd8, 0x49, 0xaf, 0xdd, 0xd4, 0x4c, 0x68, 0xea, 0x04, 0x08, 0xc6, 0x12, 0x6a,
+	0xe0, 0x61, 0xad, 0xb8, 0x2f, 0x15, 0x55, 0x85, 0x9c, 0xd8, 0xa1, 0x2e, 0x90, 0x58, 0x1b, 0xab,
+	0x11, 0x48, 0xb0, 0x9a, 0xdd, 0xbd, 0xeb, 0x0e, 0xde, 0x9d, 0x59, 0xcd, 0xce, 0x3a, 0xf1, 0x1b,
+	0x5f, 0xc2, 0x03, 0x5f, 0xc3, 0x23, 0x12, 0x3f, 0x80, 0xf2, 0x25, 0x68, 0x76, 0xc7, 0xb1, 0x5d,
+	0x8c, 0xf2, 0x82, 0x78, 0x9b, 0xb9, 0xe7, 0xdc, 0x33, 0xe7, 0x8e, 0xee, 0x9d, 0x81, 0x66, 0xe6,
+	0xa1, 0x6c, 0xfb, 0x34, 0x40, 0xee, 0x63, 0x9b, 0x26, 0xac, 0x3d, 0x3d, 0x6e, 0xfb, 0x22, 0x8e,
+	0x05, 0xb7, 0x13, 0x29, 0x94, 0x20, 0xbb, 0x9a, 0x61, 0x1b, 0x86, 0x4d, 0x13, 0x66, 0x4f, 0x8f,
+	0x0f, 0x0e, 0xc7, 0x42, 0x8c, 0x23, 0x6c, 0xe7, 0x14, 0x2f, 0x0b, 0xdb, 0x41, 0x26, 0xa9, 0x62,
+	0xf3, 0xa4, 0xd6, 0x37, 0xf0, 0xfe, 0x95, 0x90, 0x93, 0x30, 0x12, 0xd7, 0xfd, 0x1b, 0xf4, 0x33,
+	0x0d, 0x91, 0x4f, 0xa0, 0x7a, 0x6d, 0x82, 0x2e, 0x0b, 0x1a, 0x56, 0xd3, 0x3a, 0x7a, 0xe0, 0xc0,
+	0x3c, 0x34, 0x08, 0xc8, 0x63, 0xa8, 0xc8, 0x8c, 0x6b, 0x6c, 0x23, 0xc7, 0xca, 0x32, 0xe3, 0x83,
+	0xa0, 0xd5, 0x82, 0xda, 0x5c, 0x6c, 0x34, 0x4b, 0x90, 0x10, 0xd8, 0xe4, 0x34, 0x46, 0x23, 0x90,
+	0xaf, 0x35, 0xa7, 0xeb, 0x2b, 0x36, 0x65, 0x6a, 0xf6, 0xaf, 0x9c, 0x8f, 0x61, 0x6b, 0x48, 0x67,
+	0x91, 0xa0, 0x81, 0x86, 0x03, 0xaa, 0x68, 0x0e, 0xd7, 0x9c, 0x7c, 0xdd, 0x7a, 0x01, 0x5b, 0x67,
+	0x94, 0x45, 0x99, 0x44, 0xb2, 0x07, 0x15, 0x89, 0x34, 0x15, 0xdc, 0xe4, 0x9b, 0x1d, 0x69, 0xc0,
+	0x56, 0x80, 0x8a, 0xb2, 0x28, 0xcd, 0x1d, 0xd6, 0x9c, 0xf9, 0xb6, 0xf5, 0xab, 0x05, 0x9b, 0xdf,
+	0x61, 0x2c, 0xc8, 0x4b, 0xa8, 0x84, 0x0c, 0xa3, 0x20, 0x6d, 0x58, 0xcd, 0xd2, 0x51, 0xb5, 0xf3,
+	0x99, 0xbd, 0xe6, 0xfe, 0x6c, 0x4d, 0xb5, 0xcf, 0x72, 0x5e, 0x9f, 0x2b, 0x39, 0x73, 0x4c, 0xd2,
+	0xc1, 0x15, 0x54, 0x97, 0xc2, 0xa4, 0x0e, 0xa5, 0x09, 0xce, 0x8c, 0x0b, 0xbd, 0x24, 0x1d, 0x28,
+	0x4f, 0x69, 0x94, 0x61, 0x6e, 0xa0, 0xda, 0xf9, 0x68, 0xad, 0xbc, 0x29, 0xd3, 0x29, 0xa8, 0x5f,
+	0x6e, 0x3c, 0xb7, 0x5a, 0xbf, 0x59, 0x50, 0x79, 0x85, 0x34, 0x40, 0x49, 0xbe, 0x7a, 0xc7, 0xe2,
+	0xd3, 0xb5, 0x1a, 0x05, 0xf9, 0xff, 0x35, 0xf9, 0xa7, 0x05, 0xf5, 0x4b, 0xa4, 0xd2, 0x7f, 0xdb,
+	0x55, 0x4a, 0x32, 0x2f, 0x53, 0x98, 0x12, 0x17, 0x76, 0x18, 0x0f, 0xf0, 0x06, 0x03, 0x77, 0xc5,
+	0xf6, 0xf3, 0xb5, 0xaa, 0xef, 0xa6, 0xdb, 0x83, 0x22, 0x77, 0xb9, 0x8e, 0x87, 0x6c, 0x39, 0x76,
+	0xf0, 0x13, 0x90, 0x7f, 0x92, 0xfe, 0xc3, 0xaa, 0x42, 0xd8, 0xee, 0x51, 0x45, 0x4f, 0x22, 0xe1,
+	0x91, 0x33, 0x78, 0x88, 0xdc, 0x17, 0x01, 0xe3, 0x63, 0x57, 0xcd, 0x92, 0xa2, 0x41, 0x77, 0x3a,
+	0x9f, 0xae, 0xd5, 0xea, 0x1b, 0xa6, 0xee, 0x68, 0xa7, 0x86, 0x4b, 0xbb, 0xbb, 0x06, 0xde, 0x58,
+	0x6a, 0xe0, 0x61, 0x31, 0x74, 0x28, 0xdf, 0xa0, 0x4c, 0x99, 0xe0, 0x03, 0x1e, 0x0a, 0x4d, 0x64,
+	0x71, 0x12, 0xcd, 0x07, 0x41, 0xaf, 0xc9, 0x53, 0x78, 0x14, 0x22, 0x55, 0x99, 0x44, 0x77, 0x5a,
+	0x50, 0xcd, 0xc0, 0xed, 0x98, 0xb0, 0x11, 0x68, 0xfd, 0x08, 0x4f, 0x2e, 0xb3, 0x24, 0x11, 0x52,
+	0x61, 0x70, 0x1a, 0x31, 0xe4, 0xca, 0x20, 0xa9, 0x9e, 0xd5, 0xb1, 0x70, 0xd3, 0x60, 0x62, 0x94,
+	0xcb, 0x63, 0x71, 0x19, 0x4c, 0xc8, 0x3e, 0x6c, 0xff, 0x4c, 0xa7, 0x34, 0x07, 0x0a, 0xcd, 0x2d,
+	0xbd, 0xd7, 0x50, 0x1d, 0x4a, 0x7e, 0xc4, 0x1a, 0xa5, 0xe2, 0x42, 0xfd, 0x88, 0xb5, 0x7e, 0x29,
+	0x41, 0xd5, 0x41, 0x25, 0x67, 0x43, 0x11, 0x31, 0x7f, 0x46, 0x7a, 0x50, 0x67, 0x9c, 0x29, 0x46,
+	0x23, 0x97, 0x71, 0x85, 0x72, 0x4a, 0x0b, 0xdf, 0xd5, 0xce, 0xbe, 0x5d, 0x3c, 0x38, 0xf6, 0xfc,
+	0xc1, 0xb1, 0x7b, 0xe6, 0xc1, 0x71, 0x1e, 0x99, 0x94, 0x81, 0xc9, 0x20, 0x6d, 0xd8, 0xf5, 0xa8,
+	0x3f, 0x11, 0x61, 0xe8, 0xfa, 0x02, 0xc3, 0x90, 0xf9, 0xda, 0x78, 0xee, 0xc6, 0x72, 0x88, 0x81,
+	0x4e, 0x17, 0x88, 0x3e, 0x36, 0xa6, 0x37, 0x2c, 0xce, 0xe2, 0xc5, 0xb1, 0xa5, 0x7b, 0x8f, 0x35,
+	0x29, 0x77, 0xc7, 0x7e, 0xbe, 0x50, 0xa1, 0x4a, 0x61, 0x9c, 0xa8, 0xb4, 0xb1, 0xd9, 0xb4, 0x8e,
+	0xca, 0x77, 0xd4, 0xae, 0x09, 0x93, 0x97, 0xf0, 0x21, 0x17, 0xdc, 0x95, 0xba, 0x74, 0xea, 0x45,
+	0xe8, 0xa2, 0x94, 0x42, 0xba, 0xc5, 0x23, 0x93, 0x36, 0xca, 0xcd, 0xd2, 0xd1, 0x03, 0xa7, 0xc1,
+	0x05, 0x77, 0xe6, 0x8c, 0xbe, 0x26, 0x38, 0x05, 0x4e, 0x5e, 0xc3, 0x2e, 0xde, 0x24, 0xac, 0x30,
+	0xb2, 0xb0, 0x5c, 0xb9, 0xcf, 0x32, 0x59, 0x64, 0xcd, 0x5d, 0x7f, 0x71, 0x0d, 0xb5, 0xe5, 0x2e,
+	0x23, 0xfb, 0xf0, 0xb8, 0x7f, 0x7e, 0x7a, 0xd1, 0x1b, 0x9c, 0x7f, 0xed, 0x8e, 0xbe, 0x1f, 0xf6,
+	0xdd, 0xc1, 0xf9, 0x9b, 0xee, 0xb7, 0x83, 0x5e, 0xfd, 0x3d, 0x72, 0x00, 0x7b, 0xab, 0xd0, 0xe8,
+	0x95, 0x33, 0x38, 0x1b, 0x39, 0x57, 0x75, 0x8b, 0xec, 0x01, 0x59, 0xc5, 0x5e, 0x5f, 0x5e, 0x9c,
+	0xd7, 0x37, 0x48, 0x03, 0x3e, 0x58, 0x8d, 0x0f, 0x9d, 0x8b, 0xd1, 0xc5, 0xb3, 0x7a, 0xe9, 0xc4,
+	0xfb, 0xfd, 0xf6, 0xd0, 0xfa, 0xe3, 0xf6, 0xd0, 0xfa, 0xeb, 0xf6, 0xd0, 0x82, 0x27, 0xbe, 0x88,
+	0xd7, 0x8d, 0xc0, 0xc9, 0x76, 0x37, 0x61, 0x43, 0x5d, 0xc9, 0xd0, 0xfa, 0xa1, 0x3d, 0x66, 0xea,
+	0x6d, 0xe6, 0xd9, 0xbe, 0x88, 0xdb, 0x2b, 0xdf, 0x96, 0x3d, 0x46, 0x5e, 0x7c, 0x45, 0xe6, 0x07,
+	0x7b, 0x41, 0x13, 0x36, 0x3d, 0xf6, 0x2a, 0x79, 0xec, 0xd9, 0xdf, 0x03, 0x00, 0x90, 0xb4, 0x8d,
+	0x2b, 0xe5, 0x06, 0x00, 0x00,
 }
 
 func (m *WorkflowExecution) Marshal() (dAtA []byte, err error) {
@@ -1258,6 +1267,13 @@ func (m *SupportedClientVersions) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Cli) > 0 {
+		i -= len(m.Cli)
+		copy(dAtA[i:], m.Cli)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Cli)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.JavaSdk) > 0 {
 		i -= len(m.JavaSdk)
 		copy(dAtA[i:], m.JavaSdk)
@@ -1585,6 +1601,10 @@ func (m *SupportedClientVersions) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommon(uint64(l))
 	}
+	l = len(m.Cli)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2968,6 +2988,38 @@ func (m *SupportedClientVersions) Unmarshal(dAtA []byte) error {
 			}
 			m.JavaSdk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cli", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cli = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
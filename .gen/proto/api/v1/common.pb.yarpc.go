@@ -28,57 +28,58 @@ package apiv1
 var yarpcFileDescriptorClosure0ff151d4a308b356 = [][]byte{
 	// uber/cadence/api/v1/common.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
-		0x14, 0x9f, 0xe2, 0xda, 0x49, 0x9f, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xfe, 0x79, 0x06, 0x86,
-		0x66, 0x3b, 0x48, 0x88, 0x7b, 0x29, 0x56, 0x14, 0x83, 0x13, 0x3b, 0xab, 0xda, 0x2d, 0x31, 0x64,
-		0x23, 0xc1, 0x76, 0x98, 0x40, 0x4b, 0x4f, 0x2e, 0x67, 0x89, 0x14, 0x28, 0xca, 0x89, 0x6f, 0xfb,
-		0x24, 0x3b, 0xec, 0x2b, 0xed, 0x0b, 0x0d, 0x94, 0xe8, 0xd8, 0xee, 0x3c, 0xf4, 0x32, 0xec, 0x46,
-		0xbe, 0xdf, 0x9f, 0xf7, 0xa3, 0xf0, 0x48, 0x41, 0x3b, 0x9f, 0xa0, 0x74, 0x02, 0x1a, 0x22, 0x0f,
-		0xd0, 0xa1, 0x29, 0x73, 0xe6, 0xa7, 0x4e, 0x20, 0x92, 0x44, 0x70, 0x3b, 0x95, 0x42, 0x09, 0x72,
-		0xa0, 0x19, 0xb6, 0x61, 0xd8, 0x34, 0x65, 0xf6, 0xfc, 0xf4, 0xf8, 0x8b, 0xa9, 0x10, 0xd3, 0x18,
-		0x9d, 0x82, 0x32, 0xc9, 0x23, 0x27, 0xcc, 0x25, 0x55, 0x6c, 0x29, 0xea, 0xbc, 0x85, 0x8f, 0x6f,
-		0x84, 0x9c, 0x45, 0xb1, 0xb8, 0x1d, 0xdc, 0x61, 0x90, 0x6b, 0x88, 0x7c, 0x09, 0xf5, 0x5b, 0x53,
-		0xf4, 0x59, 0xd8, 0xb2, 0xda, 0xd6, 0xc9, 0x43, 0x0f, 0x96, 0x25, 0x37, 0x24, 0x4f, 0xa0, 0x26,
-		0x73, 0xae, 0xb1, 0x9d, 0x02, 0xab, 0xca, 0x9c, 0xbb, 0x61, 0xa7, 0x03, 0x8d, 0xa5, 0xd9, 0x78,
-		0x91, 0x22, 0x21, 0xf0, 0x80, 0xd3, 0x04, 0x8d, 0x41, 0xb1, 0xd6, 0x9c, 0x5e, 0xa0, 0xd8, 0x9c,
-		0xa9, 0xc5, 0xbf, 0x72, 0x3e, 0x87, 0xdd, 0x21, 0x5d, 0xc4, 0x82, 0x86, 0x1a, 0x0e, 0xa9, 0xa2,
-		0x05, 0xdc, 0xf0, 0x8a, 0x75, 0xe7, 0x25, 0xec, 0x5e, 0x50, 0x16, 0xe7, 0x12, 0xc9, 0x21, 0xd4,
-		0x24, 0xd2, 0x4c, 0x70, 0xa3, 0x37, 0x3b, 0xd2, 0x82, 0xdd, 0x10, 0x15, 0x65, 0x71, 0x56, 0x24,
-		0x6c, 0x78, 0xcb, 0x6d, 0xe7, 0x0f, 0x0b, 0x1e, 0xfc, 0x84, 0x89, 0x20, 0xaf, 0xa0, 0x16, 0x31,
-		0x8c, 0xc3, 0xac, 0x65, 0xb5, 0x2b, 0x27, 0xf5, 0xee, 0xd7, 0xf6, 0x96, 0xef, 0x67, 0x6b, 0xaa,
-		0x7d, 0x51, 0xf0, 0x06, 0x5c, 0xc9, 0x85, 0x67, 0x44, 0xc7, 0x37, 0x50, 0x5f, 0x2b, 0x93, 0x26,
-		0x54, 0x66, 0xb8, 0x30, 0x29, 0xf4, 0x92, 0x74, 0xa1, 0x3a, 0xa7, 0x71, 0x8e, 0x45, 0x80, 0x7a,
-		0xf7, 0xb3, 0xad, 0xf6, 0xe6, 0x98, 0x5e, 0x49, 0xfd, 0x6e, 0xe7, 0x85, 0xd5, 0xf9, 0xd3, 0x82,
-		0xda, 0x6b, 0xa4, 0x21, 0x4a, 0xf2, 0xfd, 0x7b, 0x11, 0x9f, 0x6d, 0xf5, 0x28, 0xc9, 0xff, 0x6f,
-		0xc8, 0xbf, 0x2c, 0x68, 0x8e, 0x90, 0xca, 0xe0, 0x5d, 0x4f, 0x29, 0xc9, 0x26, 0xb9, 0xc2, 0x8c,
-		0xf8, 0xb0, 0xcf, 0x78, 0x88, 0x77, 0x18, 0xfa, 0x1b, 0xb1, 0x5f, 0x6c, 0x75, 0x7d, 0x5f, 0x6e,
-		0xbb, 0xa5, 0x76, 0xfd, 0x1c, 0x8f, 0xd8, 0x7a, 0xed, 0xf8, 0x57, 0x20, 0xff, 0x24, 0xfd, 0x87,
-		0xa7, 0x8a, 0x60, 0xaf, 0x4f, 0x15, 0x3d, 0x8b, 0xc5, 0x84, 0x5c, 0xc0, 0x23, 0xe4, 0x81, 0x08,
-		0x19, 0x9f, 0xfa, 0x6a, 0x91, 0x96, 0x03, 0xba, 0xdf, 0xfd, 0x6a, 0xab, 0xd7, 0xc0, 0x30, 0xf5,
-		0x44, 0x7b, 0x0d, 0x5c, 0xdb, 0xdd, 0x0f, 0xf0, 0xce, 0xda, 0x00, 0x0f, 0xcb, 0x4b, 0x87, 0xf2,
-		0x1a, 0x65, 0xc6, 0x04, 0x77, 0x79, 0x24, 0x34, 0x91, 0x25, 0x69, 0xbc, 0xbc, 0x08, 0x7a, 0x4d,
-		0x9e, 0xc1, 0xe3, 0x08, 0xa9, 0xca, 0x25, 0xfa, 0xf3, 0x92, 0x6a, 0x2e, 0xdc, 0xbe, 0x29, 0x1b,
-		0x83, 0xce, 0x5b, 0x78, 0x3a, 0xca, 0xd3, 0x54, 0x48, 0x85, 0xe1, 0x79, 0xcc, 0x90, 0x2b, 0x83,
-		0x64, 0xfa, 0xae, 0x4e, 0x85, 0x9f, 0x85, 0x33, 0xe3, 0x5c, 0x9d, 0x8a, 0x51, 0x38, 0x23, 0x47,
-		0xb0, 0xf7, 0x1b, 0x9d, 0xd3, 0x02, 0x28, 0x3d, 0x77, 0xf5, 0x7e, 0x14, 0xce, 0x3a, 0xbf, 0x57,
-		0xa0, 0xee, 0xa1, 0x92, 0x8b, 0xa1, 0x88, 0x59, 0xb0, 0x20, 0x7d, 0x68, 0x32, 0xce, 0x14, 0xa3,
-		0xb1, 0xcf, 0xb8, 0x42, 0x39, 0xa7, 0x65, 0xca, 0x7a, 0xf7, 0xc8, 0x2e, 0x9f, 0x17, 0x7b, 0xf9,
-		0xbc, 0xd8, 0x7d, 0xf3, 0xbc, 0x78, 0x8f, 0x8d, 0xc4, 0x35, 0x0a, 0xe2, 0xc0, 0xc1, 0x84, 0x06,
-		0x33, 0x11, 0x45, 0x7e, 0x20, 0x30, 0x8a, 0x58, 0xa0, 0x63, 0x16, 0xbd, 0x2d, 0x8f, 0x18, 0xe8,
-		0x7c, 0x85, 0xe8, 0xb6, 0x09, 0xbd, 0x63, 0x49, 0x9e, 0xac, 0xda, 0x56, 0x3e, 0xd8, 0xd6, 0x48,
-		0xee, 0xdb, 0x7e, 0xb3, 0x72, 0xa1, 0x4a, 0x61, 0x92, 0xaa, 0xac, 0xf5, 0xa0, 0x6d, 0x9d, 0x54,
-		0xef, 0xa9, 0x3d, 0x53, 0x26, 0xaf, 0xe0, 0x53, 0x2e, 0xb8, 0x2f, 0xf5, 0xd1, 0xe9, 0x24, 0x46,
-		0x1f, 0xa5, 0x14, 0xd2, 0x2f, 0x9f, 0x94, 0xac, 0x55, 0x6d, 0x57, 0x4e, 0x1e, 0x7a, 0x2d, 0x2e,
-		0xb8, 0xb7, 0x64, 0x0c, 0x34, 0xc1, 0x2b, 0x71, 0xf2, 0x06, 0x0e, 0xf0, 0x2e, 0x65, 0x65, 0x90,
-		0x55, 0xe4, 0xda, 0x87, 0x22, 0x93, 0x95, 0x6a, 0x99, 0xfa, 0xdb, 0x5b, 0x68, 0xac, 0xcf, 0x14,
-		0x39, 0x82, 0x27, 0x83, 0xcb, 0xf3, 0xab, 0xbe, 0x7b, 0xf9, 0x83, 0x3f, 0xfe, 0x79, 0x38, 0xf0,
-		0xdd, 0xcb, 0xeb, 0xde, 0x8f, 0x6e, 0xbf, 0xf9, 0x11, 0x39, 0x86, 0xc3, 0x4d, 0x68, 0xfc, 0xda,
-		0x73, 0x2f, 0xc6, 0xde, 0x4d, 0xd3, 0x22, 0x87, 0x40, 0x36, 0xb1, 0x37, 0xa3, 0xab, 0xcb, 0xe6,
-		0x0e, 0x69, 0xc1, 0x27, 0x9b, 0xf5, 0xa1, 0x77, 0x35, 0xbe, 0x7a, 0xde, 0xac, 0x9c, 0x5d, 0xc3,
-		0xd3, 0x40, 0x24, 0xdb, 0x86, 0xfc, 0x6c, 0xaf, 0x97, 0xb2, 0xa1, 0x4e, 0x3f, 0xb4, 0x7e, 0x71,
-		0xa6, 0x4c, 0xbd, 0xcb, 0x27, 0x76, 0x20, 0x12, 0x67, 0xe3, 0xc7, 0x64, 0x4f, 0x91, 0x97, 0x3f,
-		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x03, 0x00,
-		0x00, 0xff, 0xff, 0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe2, 0xd8, 0x49, 0x8f, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xfe, 0x3c, 0x03, 0x43,
+		0xb3, 0x5d, 0x48, 0x88, 0x7b, 0x53, 0xac, 0x28, 0x06, 0x27, 0x76, 0x56, 0x75, 0x5b, 0x62, 0x28,
+		0x46, 0x82, 0x0d, 0xd8, 0x04, 0x5a, 0x3a, 0x72, 0x39, 0x4b, 0xa4, 0x40, 0x51, 0x4e, 0x7c, 0xb7,
+		0x27, 0xd9, 0xc5, 0x5e, 0x69, 0x2f, 0x34, 0x50, 0xa2, 0x63, 0xbb, 0xf3, 0x90, 0x9b, 0x61, 0x77,
+		0xe4, 0xf9, 0x7e, 0xf8, 0x91, 0x38, 0x24, 0xa1, 0x9d, 0x8f, 0x51, 0x3a, 0x01, 0x0d, 0x91, 0x07,
+		0xe8, 0xd0, 0x94, 0x39, 0xb3, 0x13, 0x27, 0x10, 0x49, 0x22, 0xb8, 0x9d, 0x4a, 0xa1, 0x04, 0xd9,
+		0xd7, 0x0c, 0xdb, 0x30, 0x6c, 0x9a, 0x32, 0x7b, 0x76, 0x72, 0xf4, 0xd9, 0x44, 0x88, 0x49, 0x8c,
+		0x4e, 0x41, 0x19, 0xe7, 0x91, 0x13, 0xe6, 0x92, 0x2a, 0xb6, 0x10, 0x75, 0xbe, 0x87, 0x0f, 0x6f,
+		0x84, 0x9c, 0x46, 0xb1, 0xb8, 0x1d, 0xdc, 0x61, 0x90, 0x6b, 0x88, 0x7c, 0x0e, 0xf5, 0x5b, 0x53,
+		0xf4, 0x59, 0xd8, 0xb2, 0xda, 0xd6, 0xf1, 0x23, 0x0f, 0x16, 0x25, 0x37, 0x24, 0x4f, 0xa1, 0x26,
+		0x73, 0xae, 0xb1, 0xad, 0x02, 0xab, 0xca, 0x9c, 0xbb, 0x61, 0xa7, 0x03, 0x8d, 0x85, 0xd9, 0x68,
+		0x9e, 0x22, 0x21, 0xb0, 0xcd, 0x69, 0x82, 0xc6, 0xa0, 0x18, 0x6b, 0x4e, 0x2f, 0x50, 0x6c, 0xc6,
+		0xd4, 0xfc, 0x5f, 0x39, 0x9f, 0xc2, 0xce, 0x90, 0xce, 0x63, 0x41, 0x43, 0x0d, 0x87, 0x54, 0xd1,
+		0x02, 0x6e, 0x78, 0xc5, 0xb8, 0xf3, 0x0a, 0x76, 0xce, 0x29, 0x8b, 0x73, 0x89, 0xe4, 0x00, 0x6a,
+		0x12, 0x69, 0x26, 0xb8, 0xd1, 0x9b, 0x19, 0x69, 0xc1, 0x4e, 0x88, 0x8a, 0xb2, 0x38, 0x2b, 0x12,
+		0x36, 0xbc, 0xc5, 0xb4, 0xf3, 0x87, 0x05, 0xdb, 0x3f, 0x62, 0x22, 0xc8, 0x6b, 0xa8, 0x45, 0x0c,
+		0xe3, 0x30, 0x6b, 0x59, 0xed, 0xca, 0x71, 0xbd, 0xfb, 0xa5, 0xbd, 0xe1, 0xfc, 0x6c, 0x4d, 0xb5,
+		0xcf, 0x0b, 0xde, 0x80, 0x2b, 0x39, 0xf7, 0x8c, 0xe8, 0xe8, 0x06, 0xea, 0x2b, 0x65, 0xd2, 0x84,
+		0xca, 0x14, 0xe7, 0x26, 0x85, 0x1e, 0x92, 0x2e, 0x54, 0x67, 0x34, 0xce, 0xb1, 0x08, 0x50, 0xef,
+		0x7e, 0xb2, 0xd1, 0xde, 0x6c, 0xd3, 0x2b, 0xa9, 0xdf, 0x6c, 0xbd, 0xb4, 0x3a, 0x7f, 0x5a, 0x50,
+		0x7b, 0x83, 0x34, 0x44, 0x49, 0xbe, 0x7d, 0x2f, 0xe2, 0xf3, 0x8d, 0x1e, 0x25, 0xf9, 0xff, 0x0d,
+		0xf9, 0x97, 0x05, 0xcd, 0x2b, 0xa4, 0x32, 0x78, 0xd7, 0x53, 0x4a, 0xb2, 0x71, 0xae, 0x30, 0x23,
+		0x3e, 0xec, 0x31, 0x1e, 0xe2, 0x1d, 0x86, 0xfe, 0x5a, 0xec, 0x97, 0x1b, 0x5d, 0xdf, 0x97, 0xdb,
+		0x6e, 0xa9, 0x5d, 0xdd, 0xc7, 0x63, 0xb6, 0x5a, 0x3b, 0xfa, 0x15, 0xc8, 0x3f, 0x49, 0xff, 0xe1,
+		0xae, 0x22, 0xd8, 0xed, 0x53, 0x45, 0x4f, 0x63, 0x31, 0x26, 0xe7, 0xf0, 0x18, 0x79, 0x20, 0x42,
+		0xc6, 0x27, 0xbe, 0x9a, 0xa7, 0x65, 0x83, 0xee, 0x75, 0xbf, 0xd8, 0xe8, 0x35, 0x30, 0x4c, 0xdd,
+		0xd1, 0x5e, 0x03, 0x57, 0x66, 0xf7, 0x0d, 0xbc, 0xb5, 0xd2, 0xc0, 0xc3, 0xf2, 0xd2, 0xa1, 0xbc,
+		0x46, 0x99, 0x31, 0xc1, 0x5d, 0x1e, 0x09, 0x4d, 0x64, 0x49, 0x1a, 0x2f, 0x2e, 0x82, 0x1e, 0x93,
+		0xe7, 0xf0, 0x24, 0x42, 0xaa, 0x72, 0x89, 0xfe, 0xac, 0xa4, 0x9a, 0x0b, 0xb7, 0x67, 0xca, 0xc6,
+		0xa0, 0xf3, 0x0b, 0x3c, 0xbb, 0xca, 0xd3, 0x54, 0x48, 0x85, 0xe1, 0x59, 0xcc, 0x90, 0x2b, 0x83,
+		0x64, 0xfa, 0xae, 0x4e, 0x84, 0x9f, 0x85, 0x53, 0xe3, 0x5c, 0x9d, 0x88, 0xab, 0x70, 0x4a, 0x0e,
+		0x61, 0xf7, 0x37, 0x3a, 0xa3, 0x05, 0x50, 0x7a, 0xee, 0xe8, 0xb9, 0x86, 0x9a, 0x50, 0x09, 0x62,
+		0xd6, 0xaa, 0x94, 0x07, 0x1a, 0xc4, 0xac, 0xf3, 0x7b, 0x05, 0xea, 0x1e, 0x2a, 0x39, 0x1f, 0x8a,
+		0x98, 0x05, 0x73, 0xd2, 0x87, 0x26, 0xe3, 0x4c, 0x31, 0x1a, 0xfb, 0x8c, 0x2b, 0x94, 0x33, 0x5a,
+		0xe6, 0xae, 0x77, 0x0f, 0xed, 0xf2, 0xc1, 0xb1, 0x17, 0x0f, 0x8e, 0xdd, 0x37, 0x0f, 0x8e, 0xf7,
+		0xc4, 0x48, 0x5c, 0xa3, 0x20, 0x0e, 0xec, 0x8f, 0x69, 0x30, 0x15, 0x51, 0xe4, 0x07, 0x02, 0xa3,
+		0x88, 0x05, 0x3a, 0x78, 0x91, 0xc6, 0xf2, 0x88, 0x81, 0xce, 0x96, 0x88, 0x5e, 0x36, 0xa1, 0x77,
+		0x2c, 0xc9, 0x93, 0xe5, 0xb2, 0x95, 0x07, 0x97, 0x35, 0x92, 0xfb, 0x65, 0xbf, 0x5a, 0xba, 0x50,
+		0xa5, 0x30, 0x49, 0x55, 0xd6, 0xda, 0x6e, 0x5b, 0xc7, 0xd5, 0x7b, 0x6a, 0xcf, 0x94, 0xc9, 0x6b,
+		0xf8, 0x98, 0x0b, 0xee, 0x4b, 0xbd, 0x75, 0x3a, 0x8e, 0xd1, 0x47, 0x29, 0x85, 0xf4, 0xcb, 0x47,
+		0x26, 0x6b, 0x55, 0xdb, 0x95, 0xe3, 0x47, 0x5e, 0x8b, 0x0b, 0xee, 0x2d, 0x18, 0x03, 0x4d, 0xf0,
+		0x4a, 0x9c, 0xbc, 0x85, 0x7d, 0xbc, 0x4b, 0x59, 0x19, 0x64, 0x19, 0xb9, 0xf6, 0x50, 0x64, 0xb2,
+		0x54, 0x2d, 0x52, 0x7f, 0x7d, 0x0b, 0x8d, 0xd5, 0x2e, 0x23, 0x87, 0xf0, 0x74, 0x70, 0x71, 0x76,
+		0xd9, 0x77, 0x2f, 0xbe, 0xf3, 0x47, 0x3f, 0x0d, 0x07, 0xbe, 0x7b, 0x71, 0xdd, 0xfb, 0xc1, 0xed,
+		0x37, 0x3f, 0x20, 0x47, 0x70, 0xb0, 0x0e, 0x8d, 0xde, 0x78, 0xee, 0xf9, 0xc8, 0xbb, 0x69, 0x5a,
+		0xe4, 0x00, 0xc8, 0x3a, 0xf6, 0xf6, 0xea, 0xf2, 0xa2, 0xb9, 0x45, 0x5a, 0xf0, 0xd1, 0x7a, 0x7d,
+		0xe8, 0x5d, 0x8e, 0x2e, 0x5f, 0x34, 0x2b, 0xa7, 0xd7, 0xf0, 0x2c, 0x10, 0xc9, 0xa6, 0xb6, 0x3f,
+		0xdd, 0xed, 0xa5, 0x6c, 0xa8, 0xd3, 0x0f, 0xad, 0x9f, 0x9d, 0x09, 0x53, 0xef, 0xf2, 0xb1, 0x1d,
+		0x88, 0xc4, 0x59, 0xfb, 0xaa, 0xec, 0x09, 0xf2, 0xf2, 0xfb, 0x31, 0xbf, 0xd6, 0x2b, 0x9a, 0xb2,
+		0xd9, 0xc9, 0xb8, 0x56, 0xd4, 0x5e, 0xfc, 0x3d, 0x00, 0x2f, 0xa5, 0x18, 0x7d, 0xd9, 0x06, 0x00,
+		0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -93,6 +94,6 @@ var yarpcFileDescriptorClosure0ff151d4a308b356 = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 }
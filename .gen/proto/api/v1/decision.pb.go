@@ -871,6 +871,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	Header                       *Header                `protobuf:"bytes,12,opt,name=header,proto3" json:"header,omitempty"`
 	Memo                         *Memo                  `protobuf:"bytes,13,opt,name=memo,proto3" json:"memo,omitempty"`
 	SearchAttributes             *SearchAttributes      `protobuf:"bytes,14,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	RetentionPeriod              *types.Duration        `protobuf:"bytes,15,opt,name=retention_period,json=retentionPeriod,proto3" json:"retention_period,omitempty"`
 	XXX_NoUnkeyedLiteral         struct{}               `json:"-"`
 	XXX_unrecognized             []byte                 `json:"-"`
 	XXX_sizecache                int32                  `json:"-"`
@@ -1011,6 +1012,13 @@ func (m *ContinueAsNewWorkflowExecutionDecisionAttributes) GetSearchAttributes()
 	return nil
 }
 
+func (m *ContinueAsNewWorkflowExecutionDecisionAttributes) GetRetentionPeriod() *types.Duration {
+	if m != nil {
+		return m.RetentionPeriod
+	}
+	return nil
+}
+
 type StartChildWorkflowExecutionDecisionAttributes struct {
 	Domain                       string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowId                   string                `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -1338,105 +1346,107 @@ func init() {
 }
 
 var fileDescriptor_fb529b236ea74dc2 = []byte{
-	// 1564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x4f, 0x1c, 0xc7,
-	0x16, 0x7d, 0xcd, 0xc7, 0x7c, 0x5c, 0xc0, 0xcf, 0x14, 0x36, 0x06, 0x1b, 0x03, 0x9e, 0xa7, 0x87,
-	0x9f, 0x8d, 0x98, 0x01, 0xec, 0x67, 0x59, 0xf6, 0x93, 0xf5, 0x00, 0x1b, 0x19, 0xc9, 0xc6, 0xa8,
-	0x8d, 0x63, 0x29, 0x9b, 0x56, 0x4d, 0x75, 0x01, 0x25, 0x7a, 0xba, 0x27, 0xd5, 0xd5, 0xe0, 0x89,
-	0x14, 0x29, 0xab, 0x24, 0x9b, 0xfc, 0x80, 0x48, 0x59, 0x65, 0x95, 0x6c, 0x92, 0x6d, 0xa2, 0xac,
-	0xb2, 0xcb, 0x32, 0x59, 0x64, 0x1f, 0xf9, 0x17, 0x44, 0xca, 0x1f, 0x88, 0xaa, 0xba, 0xba, 0x67,
-	0x18, 0x7a, 0x7a, 0xba, 0xb1, 0xe3, 0x1d, 0x5d, 0x7d, 0xef, 0xa9, 0x53, 0x75, 0xab, 0xee, 0x39,
-	0xcd, 0x40, 0x25, 0xa8, 0x53, 0x5e, 0x23, 0xd8, 0xa6, 0x2e, 0xa1, 0x35, 0xdc, 0x64, 0xb5, 0xa3,
-	0x95, 0x9a, 0x4d, 0x09, 0xf3, 0x99, 0xe7, 0x56, 0x9b, 0xdc, 0x13, 0x1e, 0x9a, 0x90, 0x31, 0x55,
-	0x1d, 0x53, 0xc5, 0x4d, 0x56, 0x3d, 0x5a, 0xb9, 0x3c, 0xbb, 0xef, 0x79, 0xfb, 0x0e, 0xad, 0xa9,
-	0x90, 0x7a, 0xb0, 0x57, 0xb3, 0x03, 0x8e, 0x45, 0x9c, 0x74, 0x79, 0x3e, 0x09, 0x98, 0x78, 0x8d,
-	0x46, 0x1c, 0x91, 0x38, 0xb5, 0xc0, 0xfe, 0xa1, 0xc3, 0x7c, 0x91, 0x16, 0x73, 0xec, 0xf1, 0xc3,
-	0x3d, 0xc7, 0x3b, 0x0e, 0x63, 0x2a, 0x9f, 0x8d, 0x43, 0xe9, 0xa1, 0x66, 0x8c, 0xbe, 0x30, 0xe0,
-	0xa6, 0x4f, 0x0e, 0xa8, 0x1d, 0x38, 0xd4, 0xc2, 0x44, 0xb0, 0x23, 0x26, 0x5a, 0x96, 0x44, 0xb5,
-	0xa2, 0x55, 0x59, 0x58, 0x08, 0xce, 0xea, 0x81, 0xa0, 0xfe, 0x94, 0x31, 0x6f, 0xfc, 0x67, 0x64,
-	0xf5, 0x7e, 0x35, 0x61, 0x85, 0xd5, 0xe7, 0x1a, 0x66, 0x4d, 0xa3, 0xec, 0x62, 0xff, 0x30, 0x9a,
-	0x67, 0x2d, 0x86, 0x78, 0xfc, 0x0f, 0x73, 0xc1, 0xcf, 0x14, 0x89, 0x3e, 0x84, 0x39, 0x5f, 0x60,
-	0x2e, 0x2c, 0xc1, 0x1a, 0x94, 0x27, 0xf2, 0x19, 0x50, 0x7c, 0x56, 0x92, 0xf9, 0xc8, 0xdc, 0x5d,
-	0x99, 0x9a, 0xc8, 0x62, 0xc6, 0x4f, 0x79, 0x8f, 0xbe, 0x36, 0x40, 0xee, 0x7e, 0xd3, 0xa1, 0x82,
-	0x5a, 0xd1, 0x06, 0x5a, 0xf4, 0x15, 0x25, 0x81, 0x2c, 0x5a, 0x22, 0x99, 0x41, 0x45, 0xe6, 0xff,
-	0x89, 0x64, 0x36, 0x34, 0xd6, 0x4b, 0x0d, 0xf5, 0x28, 0x42, 0x4a, 0xe4, 0xb6, 0x48, 0xb2, 0x87,
-	0xa3, 0x2f, 0x0d, 0x58, 0xdc, 0xc3, 0xcc, 0xc9, 0x4a, 0x73, 0x48, 0xd1, 0xfc, 0x5f, 0x22, 0xcd,
-	0x4d, 0xcc, 0x9c, 0x6c, 0x14, 0xaf, 0xef, 0x65, 0x0b, 0x45, 0xdf, 0x18, 0xb0, 0xcc, 0xe9, 0x07,
-	0x01, 0xf5, 0x85, 0x45, 0xb0, 0x4b, 0xa8, 0x93, 0xe1, 0x9c, 0x0d, 0xa7, 0x6c, 0xa5, 0x19, 0x82,
-	0x6d, 0x28, 0xac, 0xbe, 0x87, 0x6d, 0x91, 0x67, 0x0f, 0x47, 0x1f, 0xc1, 0xbc, 0xa6, 0xd8, 0xfb,
-	0xc8, 0x15, 0x14, 0xb5, 0xd5, 0xe4, 0x2a, 0xab, 0xe4, 0xde, 0x67, 0xee, 0x2a, 0x49, 0x0b, 0x40,
-	0x5f, 0x19, 0xb0, 0xa4, 0xe7, 0xcf, 0x58, 0xcb, 0xa2, 0x22, 0xf3, 0x20, 0x85, 0x4c, 0xb6, 0x6a,
-	0xde, 0x20, 0x59, 0x83, 0xd1, 0xaf, 0x06, 0x3c, 0xe8, 0xaa, 0x27, 0x7d, 0x25, 0x28, 0x77, 0x71,
-	0x66, 0xd6, 0x25, 0xc5, 0xfa, 0x69, 0xff, 0xea, 0x3e, 0xd2, 0xc0, 0xd9, 0x16, 0x71, 0x97, 0x9f,
-	0x31, 0x17, 0x7d, 0x6c, 0xc0, 0x35, 0x4e, 0x89, 0xc7, 0x6d, 0xab, 0x81, 0xf9, 0x61, 0x8f, 0xca,
-	0x97, 0x15, 0xed, 0x5b, 0x3d, 0x68, 0xcb, 0xec, 0xa7, 0x2a, 0x39, 0x91, 0xdc, 0x2c, 0x4f, 0x8d,
-	0x40, 0x3f, 0x18, 0x70, 0x87, 0x78, 0xae, 0x60, 0x6e, 0x40, 0x2d, 0xec, 0x5b, 0x2e, 0x3d, 0xce,
-	0xba, 0x9d, 0xa0, 0x78, 0x3d, 0xea, 0xd1, 0x77, 0x42, 0xc8, 0x35, 0x7f, 0x9b, 0x1e, 0x67, 0xdb,
-	0xc6, 0x65, 0x92, 0x33, 0x07, 0x7d, 0x67, 0xc0, 0x6a, 0xd8, 0xa9, 0xc9, 0x01, 0x73, 0xec, 0xac,
-	0xbc, 0x47, 0x14, 0xef, 0xf5, 0xde, 0xcd, 0x7b, 0x43, 0xa2, 0x65, 0x23, 0xbd, 0xe4, 0xe7, 0x49,
-	0x40, 0x3f, 0x1a, 0x70, 0xc7, 0x67, 0xfb, 0xf2, 0xcc, 0xe6, 0x3d, 0xbc, 0xa3, 0x8a, 0xf5, 0x66,
-	0x32, 0x6b, 0x05, 0x99, 0xef, 0xd4, 0xae, 0xf8, 0x79, 0x93, 0xd0, 0xf7, 0x06, 0xfc, 0x37, 0x68,
-	0xfa, 0x94, 0x8b, 0x36, 0x69, 0x9f, 0x62, 0x4e, 0x0e, 0x3a, 0x88, 0x26, 0x92, 0x1f, 0x4b, 0x39,
-	0x2a, 0x2f, 0x14, 0x62, 0x34, 0xff, 0x73, 0x85, 0xd7, 0x9e, 0x34, 0xf9, 0xa8, 0x04, 0x39, 0x73,
-	0xd6, 0x47, 0x01, 0xda, 0x74, 0x2a, 0x9f, 0x17, 0x60, 0x21, 0x9b, 0x6d, 0x40, 0x73, 0x30, 0x12,
-	0xcb, 0x06, 0xb3, 0x95, 0x11, 0x29, 0x9b, 0x10, 0x0d, 0x6d, 0xd9, 0x68, 0x13, 0xc6, 0xda, 0xba,
-	0xd2, 0x6a, 0x52, 0xed, 0x0d, 0xae, 0x25, 0xae, 0x35, 0x9e, 0xac, 0xd5, 0xa4, 0xe6, 0x28, 0xee,
-	0x78, 0x42, 0x93, 0x50, 0xb0, 0xbd, 0x06, 0x66, 0xae, 0xd2, 0xf3, 0xb2, 0xa9, 0x9f, 0xd0, 0x3d,
-	0x28, 0x2b, 0xb9, 0x92, 0x6e, 0x4b, 0x6b, 0xe8, 0xd5, 0x44, 0x6c, 0xb9, 0x80, 0x27, 0xcc, 0x17,
-	0x66, 0x49, 0xe8, 0xbf, 0xd0, 0x2a, 0x0c, 0x33, 0xb7, 0x19, 0x08, 0xad, 0x6b, 0x33, 0x89, 0x79,
-	0x3b, 0xb8, 0xe5, 0x78, 0xd8, 0x36, 0xc3, 0x50, 0xb4, 0x0b, 0xd3, 0xb1, 0x31, 0x13, 0x9e, 0x45,
-	0x1c, 0xcf, 0xa7, 0x4a, 0x96, 0xbc, 0x40, 0x68, 0x11, 0x9a, 0xae, 0x86, 0xa6, 0xb2, 0x1a, 0x99,
-	0xca, 0xea, 0x43, 0x6d, 0x2a, 0xcd, 0xc9, 0x28, 0x77, 0xd7, 0xdb, 0x90, 0x99, 0xbb, 0x61, 0x62,
-	0x37, 0x6a, 0xdb, 0x5f, 0x49, 0xd4, 0x62, 0x0e, 0xd4, 0xd8, 0x5d, 0x49, 0xd4, 0x6d, 0x98, 0xd4,
-	0x48, 0xdd, 0x44, 0x4b, 0xfd, 0x20, 0x27, 0x42, 0x1b, 0x76, 0x92, 0xe5, 0x26, 0x8c, 0x1f, 0x50,
-	0xcc, 0x45, 0x9d, 0xe2, 0x36, 0xbb, 0x72, 0x3f, 0xa8, 0xf3, 0x71, 0x4e, 0x84, 0xb3, 0x01, 0xa3,
-	0x9c, 0x0a, 0xde, 0xb2, 0x9a, 0x9e, 0xc3, 0x48, 0x4b, 0x77, 0x9c, 0xf9, 0x1e, 0x1d, 0x5c, 0xf0,
-	0xd6, 0x8e, 0x8a, 0x33, 0x47, 0x78, 0xfb, 0x01, 0xdd, 0x82, 0xc2, 0x01, 0xc5, 0x36, 0xe5, 0xfa,
-	0xea, 0x5f, 0x49, 0x4c, 0x7f, 0xac, 0x42, 0x4c, 0x1d, 0x8a, 0x6e, 0xc3, 0x64, 0x24, 0x92, 0x8e,
-	0x47, 0xb0, 0x63, 0xd9, 0xcc, 0x6f, 0x62, 0x41, 0x0e, 0xd4, 0x15, 0x2c, 0x99, 0x17, 0xf4, 0xdb,
-	0x27, 0xf2, 0xe5, 0x43, 0xfd, 0xae, 0xf2, 0xa9, 0x01, 0x33, 0x69, 0xb6, 0x15, 0x4d, 0x43, 0x29,
-	0x74, 0x26, 0xf1, 0x15, 0x28, 0xaa, 0xe7, 0x2d, 0x1b, 0x3d, 0x81, 0x8b, 0x71, 0x0d, 0xf6, 0x18,
-	0x6f, 0x97, 0x60, 0xa0, 0xdf, 0xbe, 0x21, 0x5d, 0x82, 0x4d, 0xc6, 0xa3, 0x0a, 0x54, 0x08, 0x2c,
-	0xe6, 0xb0, 0xac, 0xe8, 0x36, 0x14, 0x38, 0xf5, 0x03, 0x47, 0xe8, 0x2f, 0x84, 0xf4, 0x13, 0xae,
-	0x63, 0x2b, 0x18, 0xae, 0x67, 0x34, 0x9c, 0xe8, 0x0e, 0x14, 0xa5, 0xe1, 0x0c, 0x38, 0x4d, 0x9d,
-	0x61, 0x33, 0x8c, 0x31, 0xa3, 0xe0, 0xca, 0x36, 0x2c, 0xe6, 0xf0, 0x8b, 0x7d, 0xbb, 0x4c, 0xe5,
-	0x1e, 0x5c, 0x4d, 0x35, 0x79, 0x29, 0x15, 0xaa, 0x10, 0xb8, 0x91, 0xd9, 0x93, 0xc9, 0x05, 0xdb,
-	0x54, 0x60, 0xe6, 0xf8, 0x99, 0xb6, 0x34, 0x0a, 0xae, 0xfc, 0x69, 0xc0, 0xdd, 0xb3, 0x7a, 0xa8,
-	0x8e, 0xde, 0x67, 0x9c, 0xe8, 0x7d, 0x2f, 0x00, 0x9d, 0x56, 0x47, 0x7d, 0xb0, 0x16, 0x12, 0x79,
-	0x9d, 0x9a, 0xcd, 0x1c, 0x3f, 0xee, 0x1e, 0x42, 0x53, 0x50, 0x94, 0x5e, 0x83, 0x7b, 0x8e, 0xea,
-	0xb5, 0xa3, 0x66, 0xf4, 0x88, 0xaa, 0x30, 0xd1, 0x65, 0x25, 0x3c, 0xd7, 0x69, 0xa9, 0xb6, 0x5b,
-	0x32, 0xc7, 0x49, 0xa7, 0xcc, 0x3f, 0x73, 0x9d, 0x56, 0xe5, 0x5b, 0x03, 0x66, 0xd3, 0x2d, 0x98,
-	0x2c, 0xad, 0xf6, 0x76, 0x2e, 0x6e, 0xd0, 0xa8, 0xb4, 0xe1, 0xd0, 0x36, 0x6e, 0xd0, 0xce, 0x1d,
-	0x1f, 0xc8, 0xb1, 0xe3, 0x1d, 0xfd, 0x61, 0x30, 0x73, 0x7f, 0xa8, 0xfc, 0x51, 0x84, 0xe5, 0xbc,
-	0xde, 0x4c, 0x4a, 0x5c, 0xbc, 0x1f, 0x4a, 0xe2, 0x8c, 0x14, 0x89, 0x8b, 0x00, 0x43, 0x89, 0x3b,
-	0xee, 0x78, 0x3a, 0x29, 0x65, 0x03, 0x67, 0x94, 0xb2, 0xc1, 0xec, 0x52, 0x86, 0x61, 0xbe, 0xed,
-	0xa9, 0x7a, 0x08, 0xc5, 0x50, 0xbf, 0x2e, 0x35, 0x13, 0x43, 0x3c, 0x4f, 0x50, 0x8c, 0x97, 0x70,
-	0x45, 0x2d, 0xa9, 0x07, 0xfa, 0x70, 0x3f, 0xf4, 0x4b, 0x32, 0x3b, 0x09, 0xf8, 0x19, 0x4c, 0xd6,
-	0x31, 0x39, 0xf4, 0xf6, 0xf6, 0x34, 0x36, 0x73, 0x05, 0xe5, 0x47, 0xd8, 0xe9, 0xaf, 0xc1, 0x17,
-	0x74, 0xa2, 0x82, 0xdd, 0xd2, 0x69, 0xa7, 0x34, 0xa9, 0x78, 0x16, 0x4d, 0xda, 0x82, 0x32, 0x73,
-	0x99, 0x60, 0x58, 0x78, 0x5c, 0x69, 0xec, 0xb9, 0xd5, 0xc5, 0xfe, 0xfe, 0x7f, 0x2b, 0x4a, 0x31,
-	0xdb, 0xd9, 0x9d, 0x9d, 0xb5, 0x9c, 0xa3, 0xb3, 0x22, 0x13, 0x26, 0x1d, 0x2c, 0xbf, 0x01, 0x43,
-	0x99, 0x90, 0xa5, 0xd5, 0x12, 0x00, 0x19, 0x4e, 0xc6, 0x05, 0x99, 0xbb, 0x11, 0xa7, 0x9a, 0x2a,
-	0x13, 0xfd, 0x0b, 0xc6, 0x08, 0x97, 0x67, 0x44, 0xdb, 0x0c, 0x25, 0xd8, 0x65, 0x73, 0x54, 0x0e,
-	0x46, 0x3e, 0xf1, 0x6c, 0x7a, 0xbc, 0x04, 0x43, 0x0d, 0xda, 0xf0, 0xb4, 0x01, 0x9e, 0x4e, 0x4c,
-	0x79, 0x4a, 0x1b, 0x9e, 0xa9, 0xc2, 0x90, 0x09, 0xe3, 0xa7, 0x0c, 0xf5, 0xd4, 0x39, 0x95, 0xfb,
-	0xef, 0x64, 0xe7, 0xdf, 0x65, 0x7d, 0xcd, 0xf3, 0x7e, 0xd7, 0x48, 0xe5, 0xb7, 0x22, 0x2c, 0xe5,
-	0xfa, 0xac, 0xe9, 0xd9, 0x8e, 0xe7, 0x60, 0x24, 0xee, 0x03, 0xcc, 0x56, 0x37, 0xb8, 0x6c, 0x42,
-	0x34, 0x14, 0x7a, 0xe1, 0x93, 0x8d, 0x62, 0xf0, 0x2d, 0x34, 0x8a, 0x77, 0xe0, 0x79, 0xb3, 0x34,
-	0x8a, 0xc2, 0xdf, 0xda, 0x28, 0x8a, 0x67, 0x6e, 0x14, 0xef, 0xc1, 0x44, 0x13, 0x73, 0xea, 0x0a,
-	0x8d, 0xa8, 0xaf, 0x77, 0x78, 0x39, 0x17, 0x7a, 0xac, 0x5e, 0xc6, 0x2b, 0x14, 0x7d, 0xc9, 0xc7,
-	0x9b, 0xdd, 0x43, 0x9d, 0x22, 0x59, 0x3e, 0x29, 0x92, 0x04, 0xa6, 0x3a, 0x8e, 0x81, 0xc5, 0x69,
-	0xd0, 0x9e, 0x16, 0xd4, 0xb4, 0x37, 0x53, 0x0b, 0xbe, 0x65, 0x9b, 0x32, 0x45, 0x4f, 0x7d, 0xf1,
-	0x38, 0x69, 0xf8, 0xed, 0x58, 0xe8, 0x53, 0xf7, 0x7a, 0x34, 0xf5, 0x5e, 0x8f, 0xe5, 0xbf, 0xd7,
-	0xe7, 0xde, 0xe0, 0x5e, 0xff, 0xf3, 0xcd, 0xee, 0xf5, 0x4f, 0x03, 0xb0, 0x92, 0xfb, 0xc3, 0xff,
-	0x5d, 0x5b, 0xad, 0x39, 0x18, 0xd1, 0xff, 0xef, 0x50, 0xee, 0x27, 0xfc, 0xb4, 0x85, 0x70, 0x48,
-	0xb9, 0x9f, 0xf8, 0xba, 0x0e, 0x65, 0xbf, 0xae, 0x1d, 0x47, 0x73, 0x38, 0x93, 0x7f, 0x2b, 0xf4,
-	0xf2, 0x6f, 0x9f, 0x18, 0xb0, 0x9c, 0xf7, 0xff, 0x0f, 0xc9, 0xc5, 0x34, 0xde, 0xa8, 0x98, 0xeb,
-	0xf5, 0x9f, 0x5f, 0xcf, 0x1a, 0xbf, 0xbc, 0x9e, 0x35, 0x7e, 0x7f, 0x3d, 0x6b, 0xc0, 0x25, 0xe2,
-	0x35, 0x92, 0x90, 0xd6, 0x4b, 0x6b, 0x4d, 0xb6, 0x23, 0x7b, 0xc3, 0x8e, 0xf1, 0x7e, 0x6d, 0x9f,
-	0x89, 0x83, 0xa0, 0x5e, 0x25, 0x5e, 0xa3, 0x76, 0xe2, 0xe7, 0x97, 0xea, 0x3e, 0x75, 0xc3, 0xdf,
-	0x7b, 0xf4, 0x2f, 0x31, 0xf7, 0x71, 0x93, 0x1d, 0xad, 0xd4, 0x0b, 0x6a, 0xec, 0xd6, 0x5f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xdf, 0xda, 0xbb, 0xfb, 0x4c, 0x1a, 0x00, 0x00,
+	// 1585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcf, 0x4f, 0x1c, 0x47,
+	0x16, 0xde, 0xe6, 0xc7, 0xfc, 0x78, 0x80, 0x6d, 0x0a, 0x1b, 0x83, 0x8d, 0x01, 0xcf, 0x6a, 0xf1,
+	0xda, 0x88, 0x19, 0xc0, 0x5e, 0xcb, 0xb2, 0x57, 0xd6, 0xf2, 0xc3, 0xc8, 0x48, 0x36, 0x46, 0x6d,
+	0xbc, 0x96, 0xf6, 0x32, 0xaa, 0xa9, 0x2e, 0xa0, 0x44, 0x4f, 0x57, 0x6f, 0x75, 0x35, 0x78, 0x56,
+	0x5a, 0x69, 0x4f, 0x9b, 0x5c, 0xf2, 0x07, 0x44, 0xca, 0x29, 0xa7, 0xe4, 0x92, 0xdc, 0xa2, 0x44,
+	0x39, 0xe5, 0x96, 0x63, 0x72, 0xc8, 0x3d, 0xf2, 0xbf, 0x90, 0x7f, 0x20, 0xea, 0xea, 0xea, 0x9e,
+	0x61, 0xe8, 0xe9, 0xe9, 0xc6, 0x8e, 0x6f, 0x74, 0xcd, 0x7b, 0x5f, 0x7d, 0x55, 0xef, 0xf5, 0xf7,
+	0x3e, 0x66, 0xa0, 0xe2, 0x37, 0xa8, 0xa8, 0x11, 0x6c, 0x51, 0x87, 0xd0, 0x1a, 0x76, 0x59, 0xed,
+	0x78, 0xa5, 0x66, 0x51, 0xc2, 0x3c, 0xc6, 0x9d, 0xaa, 0x2b, 0xb8, 0xe4, 0x68, 0x22, 0x88, 0xa9,
+	0xea, 0x98, 0x2a, 0x76, 0x59, 0xf5, 0x78, 0xe5, 0xda, 0xec, 0x01, 0xe7, 0x07, 0x36, 0xad, 0xa9,
+	0x90, 0x86, 0xbf, 0x5f, 0xb3, 0x7c, 0x81, 0x65, 0x9c, 0x74, 0x6d, 0x3e, 0x09, 0x98, 0xf0, 0x66,
+	0x33, 0x8e, 0x48, 0xdc, 0x5a, 0x62, 0xef, 0xc8, 0x66, 0x9e, 0x4c, 0x8b, 0x39, 0xe1, 0xe2, 0x68,
+	0xdf, 0xe6, 0x27, 0x61, 0x4c, 0xe5, 0xe3, 0x71, 0x28, 0x6d, 0x6a, 0xc6, 0xe8, 0x53, 0x03, 0xee,
+	0x78, 0xe4, 0x90, 0x5a, 0xbe, 0x4d, 0xeb, 0x98, 0x48, 0x76, 0xcc, 0x64, 0xab, 0x1e, 0xa0, 0xd6,
+	0xa3, 0x53, 0xd5, 0xb1, 0x94, 0x82, 0x35, 0x7c, 0x49, 0xbd, 0x29, 0x63, 0xde, 0xf8, 0xeb, 0xc8,
+	0xea, 0xa3, 0x6a, 0xc2, 0x09, 0xab, 0x2f, 0x35, 0xcc, 0x9a, 0x46, 0xd9, 0xc3, 0xde, 0x51, 0xb4,
+	0xcf, 0x5a, 0x0c, 0xf1, 0xf4, 0x4f, 0xe6, 0x82, 0x97, 0x29, 0x12, 0xfd, 0x07, 0xe6, 0x3c, 0x89,
+	0x85, 0xac, 0x4b, 0xd6, 0xa4, 0x22, 0x91, 0xcf, 0x80, 0xe2, 0xb3, 0x92, 0xcc, 0x27, 0xc8, 0xdd,
+	0x0b, 0x52, 0x13, 0x59, 0xcc, 0x78, 0x29, 0x9f, 0xa3, 0x2f, 0x0c, 0x08, 0x6e, 0xdf, 0xb5, 0xa9,
+	0xa4, 0xf5, 0xe8, 0x02, 0xeb, 0xf4, 0x0d, 0x25, 0x7e, 0x50, 0xb4, 0x44, 0x32, 0x83, 0x8a, 0xcc,
+	0x3f, 0x12, 0xc9, 0x6c, 0x68, 0xac, 0xd7, 0x1a, 0xea, 0x49, 0x84, 0x94, 0xc8, 0x6d, 0x91, 0x64,
+	0x0f, 0x47, 0x9f, 0x19, 0xb0, 0xb8, 0x8f, 0x99, 0x9d, 0x95, 0xe6, 0x90, 0xa2, 0xf9, 0xf7, 0x44,
+	0x9a, 0x5b, 0x98, 0xd9, 0xd9, 0x28, 0xde, 0xda, 0xcf, 0x16, 0x8a, 0xbe, 0x34, 0x60, 0x59, 0xd0,
+	0x7f, 0xfb, 0xd4, 0x93, 0x75, 0x82, 0x1d, 0x42, 0xed, 0x0c, 0x7d, 0x36, 0x9c, 0x72, 0x95, 0x66,
+	0x08, 0xb6, 0xa1, 0xb0, 0xfa, 0x36, 0xdb, 0xa2, 0xc8, 0x1e, 0x8e, 0xfe, 0x0b, 0xf3, 0x9a, 0x62,
+	0xef, 0x96, 0x2b, 0x28, 0x6a, 0xab, 0xc9, 0x55, 0x56, 0xc9, 0xbd, 0x7b, 0xee, 0x06, 0x49, 0x0b,
+	0x40, 0x9f, 0x1b, 0xb0, 0xa4, 0xf7, 0xcf, 0x58, 0xcb, 0xa2, 0x22, 0xf3, 0x38, 0x85, 0x4c, 0xb6,
+	0x6a, 0xde, 0x26, 0x59, 0x83, 0xd1, 0xcf, 0x06, 0x3c, 0xee, 0xaa, 0x27, 0x7d, 0x23, 0xa9, 0x70,
+	0x70, 0x66, 0xd6, 0x25, 0xc5, 0xfa, 0x79, 0xff, 0xea, 0x3e, 0xd1, 0xc0, 0xd9, 0x0e, 0xf1, 0x40,
+	0x9c, 0x33, 0x17, 0xfd, 0xcf, 0x80, 0x9b, 0x82, 0x12, 0x2e, 0xac, 0x7a, 0x13, 0x8b, 0xa3, 0x1e,
+	0x95, 0x2f, 0x2b, 0xda, 0x77, 0x7b, 0xd0, 0x0e, 0xb2, 0x9f, 0xab, 0xe4, 0x44, 0x72, 0xb3, 0x22,
+	0x35, 0x02, 0x7d, 0x67, 0xc0, 0x7d, 0xc2, 0x1d, 0xc9, 0x1c, 0x9f, 0xd6, 0xb1, 0x57, 0x77, 0xe8,
+	0x49, 0xd6, 0xeb, 0x04, 0xc5, 0xeb, 0x49, 0x0f, 0xdd, 0x09, 0x21, 0xd7, 0xbc, 0x1d, 0x7a, 0x92,
+	0xed, 0x1a, 0x97, 0x49, 0xce, 0x1c, 0xf4, 0xb5, 0x01, 0xab, 0xa1, 0x52, 0x93, 0x43, 0x66, 0x5b,
+	0x59, 0x79, 0x8f, 0x28, 0xde, 0xeb, 0xbd, 0xc5, 0x7b, 0x23, 0x40, 0xcb, 0x46, 0x7a, 0xc9, 0xcb,
+	0x93, 0x80, 0xbe, 0x37, 0xe0, 0xbe, 0xc7, 0x0e, 0x1c, 0x9c, 0xbf, 0x79, 0x47, 0x15, 0xeb, 0xad,
+	0x64, 0xd6, 0x0a, 0x32, 0x5f, 0xd7, 0xae, 0x78, 0x79, 0x93, 0xd0, 0xb7, 0x06, 0xfc, 0xcd, 0x77,
+	0x3d, 0x2a, 0x64, 0x9b, 0xb4, 0x47, 0xb1, 0x20, 0x87, 0x1d, 0x44, 0x13, 0xc9, 0x8f, 0xa5, 0xb4,
+	0xca, 0x2b, 0x85, 0x18, 0xed, 0xff, 0x52, 0xe1, 0xb5, 0x37, 0x4d, 0x6e, 0x15, 0x3f, 0x67, 0xce,
+	0xfa, 0x28, 0x40, 0x9b, 0x4e, 0xe5, 0x93, 0x02, 0x2c, 0x64, 0xb3, 0x0d, 0x68, 0x0e, 0x46, 0xe2,
+	0xb1, 0xc1, 0x2c, 0x65, 0x44, 0xca, 0x26, 0x44, 0x4b, 0xdb, 0x16, 0xda, 0x82, 0xb1, 0x38, 0x40,
+	0xb6, 0x5c, 0xaa, 0xbd, 0xc1, 0xcd, 0xc4, 0xb3, 0xc6, 0x9b, 0xb5, 0x5c, 0x6a, 0x8e, 0xe2, 0x8e,
+	0x27, 0x34, 0x09, 0x05, 0x8b, 0x37, 0x31, 0x73, 0xd4, 0x3c, 0x2f, 0x9b, 0xfa, 0x09, 0x3d, 0x84,
+	0xb2, 0x1a, 0x57, 0x81, 0xdb, 0xd2, 0x33, 0xf4, 0x46, 0x22, 0x76, 0x70, 0x80, 0x67, 0xcc, 0x93,
+	0x66, 0x49, 0xea, 0xbf, 0xd0, 0x2a, 0x0c, 0x33, 0xc7, 0xf5, 0xa5, 0x9e, 0x6b, 0x33, 0x89, 0x79,
+	0xbb, 0xb8, 0x65, 0x73, 0x6c, 0x99, 0x61, 0x28, 0xda, 0x83, 0xe9, 0xd8, 0x98, 0x49, 0x5e, 0x27,
+	0x36, 0xf7, 0xa8, 0x1a, 0x4b, 0xdc, 0x97, 0x7a, 0x08, 0x4d, 0x57, 0x43, 0x53, 0x59, 0x8d, 0x4c,
+	0x65, 0x75, 0x53, 0x9b, 0x4a, 0x73, 0x32, 0xca, 0xdd, 0xe3, 0x1b, 0x41, 0xe6, 0x5e, 0x98, 0xd8,
+	0x8d, 0xda, 0xf6, 0x57, 0x01, 0x6a, 0x31, 0x07, 0x6a, 0xec, 0xae, 0x02, 0xd4, 0x1d, 0x98, 0xd4,
+	0x48, 0xdd, 0x44, 0x4b, 0xfd, 0x20, 0x27, 0x42, 0x1b, 0x76, 0x9a, 0xe5, 0x16, 0x8c, 0x1f, 0x52,
+	0x2c, 0x64, 0x83, 0xe2, 0x36, 0xbb, 0x72, 0x3f, 0xa8, 0x4b, 0x71, 0x4e, 0x84, 0xb3, 0x01, 0xa3,
+	0x82, 0x4a, 0xd1, 0xaa, 0xbb, 0xdc, 0x66, 0xa4, 0xa5, 0x15, 0x67, 0xbe, 0x87, 0x82, 0x4b, 0xd1,
+	0xda, 0x55, 0x71, 0xe6, 0x88, 0x68, 0x3f, 0xa0, 0xbb, 0x50, 0x38, 0xa4, 0xd8, 0xa2, 0x42, 0xbf,
+	0xfa, 0xd7, 0x13, 0xd3, 0x9f, 0xaa, 0x10, 0x53, 0x87, 0xa2, 0x7b, 0x30, 0x19, 0x0d, 0x49, 0x9b,
+	0x13, 0x6c, 0xd7, 0x2d, 0xe6, 0xb9, 0x58, 0x92, 0x43, 0xf5, 0x0a, 0x96, 0xcc, 0xcb, 0xfa, 0xd3,
+	0x67, 0xc1, 0x87, 0x9b, 0xfa, 0xb3, 0xca, 0x47, 0x06, 0xcc, 0xa4, 0xd9, 0x56, 0x34, 0x0d, 0xa5,
+	0xd0, 0x99, 0xc4, 0xaf, 0x40, 0x51, 0x3d, 0x6f, 0x5b, 0xe8, 0x19, 0x5c, 0x89, 0x6b, 0xb0, 0xcf,
+	0x44, 0xbb, 0x04, 0x03, 0xfd, 0xee, 0x0d, 0xe9, 0x12, 0x6c, 0x31, 0x11, 0x55, 0xa0, 0x42, 0x60,
+	0x31, 0x87, 0x65, 0x45, 0xf7, 0xa0, 0x20, 0xa8, 0xe7, 0xdb, 0x72, 0xca, 0xc8, 0xd0, 0xe1, 0x3a,
+	0xb6, 0x82, 0xe1, 0x56, 0x46, 0xc3, 0x89, 0xee, 0x43, 0x31, 0x30, 0x9c, 0xbe, 0xa0, 0xa9, 0x3b,
+	0x6c, 0x85, 0x31, 0x66, 0x14, 0x5c, 0xd9, 0x81, 0xc5, 0x1c, 0x7e, 0xb1, 0xaf, 0xca, 0x54, 0x1e,
+	0xc2, 0x8d, 0x54, 0x93, 0x97, 0x52, 0xa1, 0x0a, 0x81, 0xdb, 0x99, 0x3d, 0x59, 0x70, 0x60, 0x8b,
+	0x4a, 0xcc, 0x6c, 0x2f, 0xd3, 0x95, 0x46, 0xc1, 0x95, 0xdf, 0x0c, 0x78, 0x70, 0x5e, 0x0f, 0xd5,
+	0xa1, 0x7d, 0xc6, 0x29, 0xed, 0x7b, 0x05, 0xe8, 0xec, 0x74, 0xd4, 0x8d, 0xb5, 0x90, 0xc8, 0xeb,
+	0xcc, 0x6e, 0xe6, 0xf8, 0x49, 0xf7, 0x12, 0x9a, 0x82, 0x22, 0xe1, 0x8e, 0x14, 0xdc, 0x56, 0x5a,
+	0x3b, 0x6a, 0x46, 0x8f, 0xa8, 0x0a, 0x13, 0x5d, 0x56, 0x82, 0x3b, 0x76, 0x4b, 0xc9, 0x6e, 0xc9,
+	0x1c, 0x27, 0x9d, 0x63, 0xfe, 0x85, 0x63, 0xb7, 0x2a, 0x5f, 0x19, 0x30, 0x9b, 0x6e, 0xc1, 0x82,
+	0xd2, 0x6a, 0x6f, 0xe7, 0xe0, 0x26, 0x8d, 0x4a, 0x1b, 0x2e, 0xed, 0xe0, 0x26, 0xed, 0xbc, 0xf1,
+	0x81, 0x1c, 0x37, 0xde, 0xa1, 0x0f, 0x83, 0x99, 0xf5, 0xa1, 0xf2, 0x4d, 0x09, 0x96, 0xf3, 0x7a,
+	0xb3, 0x60, 0xc4, 0xc5, 0xf7, 0xa1, 0x46, 0x9c, 0x91, 0x32, 0xe2, 0x22, 0xc0, 0x70, 0xc4, 0x9d,
+	0x74, 0x3c, 0x9d, 0x1e, 0x65, 0x03, 0xe7, 0x1c, 0x65, 0x83, 0xd9, 0x47, 0x19, 0x86, 0xf9, 0xb6,
+	0xa7, 0xea, 0x31, 0x28, 0x86, 0xfa, 0xa9, 0xd4, 0x4c, 0x0c, 0xf1, 0x32, 0x61, 0x62, 0xbc, 0x86,
+	0xeb, 0xea, 0x48, 0x3d, 0xd0, 0x87, 0xfb, 0xa1, 0x5f, 0x0d, 0xb2, 0x93, 0x80, 0x5f, 0xc0, 0x64,
+	0x03, 0x93, 0x23, 0xbe, 0xbf, 0xaf, 0xb1, 0x99, 0x23, 0xa9, 0x38, 0xc6, 0x76, 0xff, 0x19, 0x7c,
+	0x59, 0x27, 0x2a, 0xd8, 0x6d, 0x9d, 0x76, 0x66, 0x26, 0x15, 0xcf, 0x33, 0x93, 0xb6, 0xa1, 0xcc,
+	0x1c, 0x26, 0x19, 0x96, 0x5c, 0xa8, 0x19, 0x7b, 0x61, 0x75, 0xb1, 0xbf, 0xff, 0xdf, 0x8e, 0x52,
+	0xcc, 0x76, 0x76, 0xa7, 0xb2, 0x96, 0x73, 0x28, 0x2b, 0x32, 0x61, 0xd2, 0xc6, 0xc1, 0xff, 0x80,
+	0xe1, 0x98, 0x08, 0x4a, 0xab, 0x47, 0x00, 0x64, 0xe8, 0x8c, 0xcb, 0x41, 0xee, 0x46, 0x9c, 0x6a,
+	0xaa, 0x4c, 0xf4, 0x67, 0x18, 0x23, 0x22, 0xe8, 0x11, 0x6d, 0x33, 0xd4, 0xc0, 0x2e, 0x9b, 0xa3,
+	0xc1, 0x62, 0xe4, 0x13, 0xcf, 0x37, 0x8f, 0x97, 0x60, 0xa8, 0x49, 0x9b, 0x5c, 0x1b, 0xe0, 0xe9,
+	0xc4, 0x94, 0xe7, 0xb4, 0xc9, 0x4d, 0x15, 0x86, 0x4c, 0x18, 0x3f, 0x63, 0xa8, 0xa7, 0x2e, 0xa8,
+	0xdc, 0xbf, 0x24, 0x3b, 0xff, 0x2e, 0xeb, 0x6b, 0x5e, 0xf2, 0xba, 0x56, 0xd0, 0x26, 0x5c, 0x12,
+	0x54, 0x52, 0x47, 0x5d, 0x95, 0x4b, 0x05, 0xe3, 0xd6, 0xd4, 0xc5, 0x7e, 0x3d, 0x74, 0x31, 0x4e,
+	0xd9, 0x55, 0x19, 0x95, 0x5f, 0x8a, 0xb0, 0x94, 0xeb, 0x9f, 0xa3, 0x9e, 0xa2, 0x3e, 0x07, 0x23,
+	0xb1, 0x9a, 0x30, 0x4b, 0xe9, 0x40, 0xd9, 0x84, 0x68, 0x29, 0x74, 0xd4, 0xa7, 0xe5, 0x66, 0xf0,
+	0x3d, 0xc8, 0xcd, 0x07, 0x70, 0xce, 0x59, 0xe4, 0xa6, 0xf0, 0x87, 0xca, 0x4d, 0xf1, 0xdc, 0x72,
+	0xf3, 0x4f, 0x98, 0x70, 0xb1, 0xa0, 0x8e, 0xd4, 0x88, 0x5a, 0x24, 0xc2, 0x57, 0x7c, 0xa1, 0xc7,
+	0xe9, 0x83, 0x78, 0x85, 0xa2, 0xa5, 0x62, 0xdc, 0xed, 0x5e, 0xea, 0x1c, 0xb5, 0xe5, 0xd3, 0xa3,
+	0x96, 0xc0, 0x54, 0x47, 0x1b, 0xd4, 0x05, 0xf5, 0xdb, 0xdb, 0x82, 0xda, 0xf6, 0x4e, 0x6a, 0xc1,
+	0xb7, 0x2d, 0x93, 0xfa, 0xd1, 0x3e, 0xe6, 0x95, 0x93, 0xa4, 0xe5, 0xf7, 0x63, 0xc4, 0xcf, 0xa8,
+	0xc3, 0x68, 0xaa, 0x3a, 0x8c, 0xe5, 0x57, 0x87, 0x0b, 0xef, 0xa0, 0x0e, 0x17, 0xdf, 0x49, 0x1d,
+	0x2a, 0x3f, 0x0c, 0xc0, 0x4a, 0xee, 0xaf, 0x0f, 0x3e, 0xb4, 0x61, 0x9b, 0x83, 0x11, 0xfd, 0xad,
+	0x89, 0xf2, 0x50, 0xe1, 0x3f, 0xc8, 0x10, 0x2e, 0x29, 0x0f, 0x15, 0xbf, 0xae, 0x43, 0xd9, 0x5f,
+	0xd7, 0x8e, 0xd6, 0x1c, 0xce, 0xe4, 0x02, 0x0b, 0xbd, 0x5c, 0xe0, 0xff, 0x0d, 0x58, 0xce, 0xfb,
+	0x2d, 0x46, 0x72, 0x31, 0x8d, 0x77, 0x2a, 0xe6, 0x7a, 0xe3, 0xc7, 0xb7, 0xb3, 0xc6, 0x4f, 0x6f,
+	0x67, 0x8d, 0x5f, 0xdf, 0xce, 0x1a, 0x70, 0x95, 0xf0, 0x66, 0x12, 0xd2, 0x7a, 0x69, 0xcd, 0x65,
+	0xbb, 0x82, 0x4b, 0xbe, 0x6b, 0xfc, 0xab, 0x76, 0xc0, 0xe4, 0xa1, 0xdf, 0xa8, 0x12, 0xde, 0xac,
+	0x9d, 0xfa, 0x11, 0xa7, 0x7a, 0x40, 0x9d, 0xf0, 0x57, 0x23, 0xfd, 0x7b, 0xce, 0x23, 0xec, 0xb2,
+	0xe3, 0x95, 0x46, 0x41, 0xad, 0xdd, 0xfd, 0x7d, 0x00, 0x17, 0x80, 0xd3, 0xc5, 0x92, 0x1a, 0x00,
+	0x00,
 }
 
 func (m *Decision) Marshal() (dAtA []byte, err error) {
@@ -2283,6 +2293,18 @@ func (m *ContinueAsNewWorkflowExecutionDecisionAttributes) MarshalToSizedBuffer(
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RetentionPeriod != nil {
+		{
+			size, err := m.RetentionPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDecision(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.SearchAttributes != nil {
 		{
 			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
@@ -3189,6 +3211,10 @@ func (m *ContinueAsNewWorkflowExecutionDecisionAttributes) Size() (n int) {
 		l = m.SearchAttributes.Size()
 		n += 1 + l + sovDecision(uint64(l))
 	}
+	if m.RetentionPeriod != nil {
+		l = m.RetentionPeriod.Size()
+		n += 1 + l + sovDecision(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5673,6 +5699,42 @@ func (m *ContinueAsNewWorkflowExecutionDecisionAttributes) Unmarshal(dAtA []byte
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionPeriod == nil {
+				m.RetentionPeriod = &types.Duration{}
+			}
+			if err := m.RetentionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDecision(dAtA[iNdEx:])
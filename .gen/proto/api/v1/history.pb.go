@@ -169,6 +169,8 @@ type HistoryEvent struct {
 	//	*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes
 	//	*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes
 	//	*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes
+	//	*HistoryEvent_WorkflowExecutionSuspendedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionResumedEventAttributes
 	Attributes           isHistoryEvent_Attributes `protobuf_oneof:"attributes"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -340,6 +342,12 @@ type HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes struct {
 type HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes struct {
 	UpsertWorkflowSearchAttributesEventAttributes *UpsertWorkflowSearchAttributesEventAttributes `protobuf:"bytes,46,opt,name=upsert_workflow_search_attributes_event_attributes,json=upsertWorkflowSearchAttributesEventAttributes,proto3,oneof" json:"upsert_workflow_search_attributes_event_attributes,omitempty"`
 }
+type HistoryEvent_WorkflowExecutionSuspendedEventAttributes struct {
+	WorkflowExecutionSuspendedEventAttributes *WorkflowExecutionSuspendedEventAttributes `protobuf:"bytes,47,opt,name=workflow_execution_suspended_event_attributes,json=workflowExecutionSuspendedEventAttributes,proto3,oneof" json:"workflow_execution_suspended_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionResumedEventAttributes struct {
+	WorkflowExecutionResumedEventAttributes *WorkflowExecutionResumedEventAttributes `protobuf:"bytes,48,opt,name=workflow_execution_resumed_event_attributes,json=workflowExecutionResumedEventAttributes,proto3,oneof" json:"workflow_execution_resumed_event_attributes,omitempty"`
+}
 
 func (*HistoryEvent_WorkflowExecutionStartedEventAttributes) isHistoryEvent_Attributes()         {}
 func (*HistoryEvent_WorkflowExecutionCompletedEventAttributes) isHistoryEvent_Attributes()       {}
@@ -389,6 +397,8 @@ func (*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes) isHist
 }
 func (*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes) isHistoryEvent_Attributes() {}
 func (*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) isHistoryEvent_Attributes()    {}
+func (*HistoryEvent_WorkflowExecutionSuspendedEventAttributes) isHistoryEvent_Attributes()        {}
+func (*HistoryEvent_WorkflowExecutionResumedEventAttributes) isHistoryEvent_Attributes()          {}
 
 func (m *HistoryEvent) GetAttributes() isHistoryEvent_Attributes {
 	if m != nil {
@@ -719,6 +729,20 @@ func (m *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() *Upser
 	return nil
 }
 
+func (m *HistoryEvent) GetWorkflowExecutionSuspendedEventAttributes() *WorkflowExecutionSuspendedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionSuspendedEventAttributes); ok {
+		return x.WorkflowExecutionSuspendedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionResumedEventAttributes() *WorkflowExecutionResumedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionResumedEventAttributes); ok {
+		return x.WorkflowExecutionResumedEventAttributes
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*HistoryEvent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -764,6 +788,8 @@ func (*HistoryEvent) XXX_OneofWrappers() []interface{} {
 		(*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes)(nil),
 		(*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes)(nil),
 		(*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionSuspendedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionResumedEventAttributes)(nil),
 	}
 }
 
@@ -792,6 +818,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 	SearchAttributes         *SearchAttributes `protobuf:"bytes,20,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
 	PrevAutoResetPoints      *ResetPoints      `protobuf:"bytes,21,opt,name=prev_auto_reset_points,json=prevAutoResetPoints,proto3" json:"prev_auto_reset_points,omitempty"`
 	Header                   *Header           `protobuf:"bytes,22,opt,name=header,proto3" json:"header,omitempty"`
+	RetentionPeriod          *types.Duration   `protobuf:"bytes,23,opt,name=retention_period,json=retentionPeriod,proto3" json:"retention_period,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{}          `json:"-"`
 	XXX_unrecognized         []byte            `json:"-"`
 	XXX_sizecache            int32             `json:"-"`
@@ -986,6 +1013,13 @@ func (m *WorkflowExecutionStartedEventAttributes) GetHeader() *Header {
 	return nil
 }
 
+func (m *WorkflowExecutionStartedEventAttributes) GetRetentionPeriod() *types.Duration {
+	if m != nil {
+		return m.RetentionPeriod
+	}
+	return nil
+}
+
 type WorkflowExecutionCompletedEventAttributes struct {
 	Result                       *Payload `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 	DecisionTaskCompletedEventId int64    `protobuf:"varint,2,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
@@ -2956,6 +2990,122 @@ func (m *WorkflowExecutionTerminatedEventAttributes) GetIdentity() string {
 	return ""
 }
 
+type WorkflowExecutionSuspendedEventAttributes struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionSuspendedEventAttributes) Reset() {
+	*m = WorkflowExecutionSuspendedEventAttributes{}
+}
+func (m *WorkflowExecutionSuspendedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*WorkflowExecutionSuspendedEventAttributes) ProtoMessage() {}
+func (*WorkflowExecutionSuspendedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{29}
+}
+func (m *WorkflowExecutionSuspendedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionSuspendedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionSuspendedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionSuspendedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionSuspendedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionSuspendedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionSuspendedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionSuspendedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionSuspendedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionSuspendedEventAttributes) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionSuspendedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type WorkflowExecutionResumedEventAttributes struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionResumedEventAttributes) Reset() {
+	*m = WorkflowExecutionResumedEventAttributes{}
+}
+func (m *WorkflowExecutionResumedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionResumedEventAttributes) ProtoMessage()    {}
+func (*WorkflowExecutionResumedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{30}
+}
+func (m *WorkflowExecutionResumedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionResumedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionResumedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionResumedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionResumedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionResumedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionResumedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionResumedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionResumedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionResumedEventAttributes) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionResumedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
 type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 	DecisionTaskCompletedEventId int64              `protobuf:"varint,1,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
 	Domain                       string             `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -2975,7 +3125,7 @@ func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String(
 }
 func (*RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ProtoMessage() {}
 func (*RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{29}
+	return fileDescriptor_8237ca6511ad6c62, []int{31}
 }
 func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3059,7 +3209,7 @@ func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() s
 }
 func (*RequestCancelExternalWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
 func (*RequestCancelExternalWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{30}
+	return fileDescriptor_8237ca6511ad6c62, []int{32}
 }
 func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3297,7 @@ func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) String() strin
 }
 func (*ExternalWorkflowExecutionCancelRequestedEventAttributes) ProtoMessage() {}
 func (*ExternalWorkflowExecutionCancelRequestedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{31}
+	return fileDescriptor_8237ca6511ad6c62, []int{33}
 }
 func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3218,7 +3368,7 @@ func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) String() strin
 }
 func (*SignalExternalWorkflowExecutionInitiatedEventAttributes) ProtoMessage() {}
 func (*SignalExternalWorkflowExecutionInitiatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{32}
+	return fileDescriptor_8237ca6511ad6c62, []int{34}
 }
 func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3316,7 +3466,7 @@ func (m *SignalExternalWorkflowExecutionFailedEventAttributes) String() string {
 }
 func (*SignalExternalWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
 func (*SignalExternalWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{33}
+	return fileDescriptor_8237ca6511ad6c62, []int{35}
 }
 func (m *SignalExternalWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3405,7 +3555,7 @@ func (m *ExternalWorkflowExecutionSignaledEventAttributes) String() string {
 }
 func (*ExternalWorkflowExecutionSignaledEventAttributes) ProtoMessage() {}
 func (*ExternalWorkflowExecutionSignaledEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{34}
+	return fileDescriptor_8237ca6511ad6c62, []int{36}
 }
 func (m *ExternalWorkflowExecutionSignaledEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3478,7 +3628,7 @@ func (m *UpsertWorkflowSearchAttributesEventAttributes) String() string {
 }
 func (*UpsertWorkflowSearchAttributesEventAttributes) ProtoMessage() {}
 func (*UpsertWorkflowSearchAttributesEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{35}
+	return fileDescriptor_8237ca6511ad6c62, []int{37}
 }
 func (m *UpsertWorkflowSearchAttributesEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3552,7 +3702,7 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
 }
 func (*StartChildWorkflowExecutionInitiatedEventAttributes) ProtoMessage() {}
 func (*StartChildWorkflowExecutionInitiatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{36}
+	return fileDescriptor_8237ca6511ad6c62, []int{38}
 }
 func (m *StartChildWorkflowExecutionInitiatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3721,7 +3871,7 @@ func (m *StartChildWorkflowExecutionFailedEventAttributes) String() string {
 }
 func (*StartChildWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
 func (*StartChildWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{37}
+	return fileDescriptor_8237ca6511ad6c62, []int{39}
 }
 func (m *StartChildWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3818,7 +3968,7 @@ func (m *ChildWorkflowExecutionStartedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionStartedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionStartedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{38}
+	return fileDescriptor_8237ca6511ad6c62, []int{40}
 }
 func (m *ChildWorkflowExecutionStartedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3902,7 +4052,7 @@ func (m *ChildWorkflowExecutionCompletedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionCompletedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionCompletedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{39}
+	return fileDescriptor_8237ca6511ad6c62, []int{41}
 }
 func (m *ChildWorkflowExecutionCompletedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3993,7 +4143,7 @@ func (m *ChildWorkflowExecutionFailedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{40}
+	return fileDescriptor_8237ca6511ad6c62, []int{42}
 }
 func (m *ChildWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4084,7 +4234,7 @@ func (m *ChildWorkflowExecutionCanceledEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionCanceledEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionCanceledEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{41}
+	return fileDescriptor_8237ca6511ad6c62, []int{43}
 }
 func (m *ChildWorkflowExecutionCanceledEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4175,7 +4325,7 @@ func (m *ChildWorkflowExecutionTimedOutEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionTimedOutEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionTimedOutEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{42}
+	return fileDescriptor_8237ca6511ad6c62, []int{44}
 }
 func (m *ChildWorkflowExecutionTimedOutEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4265,7 +4415,7 @@ func (m *ChildWorkflowExecutionTerminatedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionTerminatedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionTerminatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{43}
+	return fileDescriptor_8237ca6511ad6c62, []int{45}
 }
 func (m *ChildWorkflowExecutionTerminatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4360,6 +4510,8 @@ func init() {
 	proto.RegisterType((*MarkerRecordedEventAttributes)(nil), "uber.cadence.api.v1.MarkerRecordedEventAttributes")
 	proto.RegisterType((*WorkflowExecutionSignaledEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionSignaledEventAttributes")
 	proto.RegisterType((*WorkflowExecutionTerminatedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionTerminatedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionSuspendedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionSuspendedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionResumedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionResumedEventAttributes")
 	proto.RegisterType((*RequestCancelExternalWorkflowExecutionInitiatedEventAttributes)(nil), "uber.cadence.api.v1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes")
 	proto.RegisterType((*RequestCancelExternalWorkflowExecutionFailedEventAttributes)(nil), "uber.cadence.api.v1.RequestCancelExternalWorkflowExecutionFailedEventAttributes")
 	proto.RegisterType((*ExternalWorkflowExecutionCancelRequestedEventAttributes)(nil), "uber.cadence.api.v1.ExternalWorkflowExecutionCancelRequestedEventAttributes")
@@ -4380,233 +4532,238 @@ func init() {
 func init() { proto.RegisterFile("uber/cadence/api/v1/history.proto", fileDescriptor_8237ca6511ad6c62) }
 
 var fileDescriptor_8237ca6511ad6c62 = []byte{
-	// 3609 bytes of a gzipped FileDescriptorProto
+	// 3694 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-	0xd5, 0xef, 0xdd, 0xb5, 0x77, 0xbd, 0x67, 0x1d, 0xc7, 0xbe, 0x49, 0x1c, 0x3b, 0x7f, 0x9d, 0x49,
-	0x9a, 0xb8, 0x8e, 0xb3, 0x4e, 0x9c, 0x34, 0xf9, 0xd2, 0x7c, 0x6d, 0x3f, 0xc7, 0xb1, 0xd5, 0x95,
-	0xfc, 0x25, 0xd6, 0xc4, 0x49, 0x01, 0x21, 0x2d, 0xe3, 0x9d, 0xeb, 0x78, 0xe4, 0xdd, 0x9d, 0xed,
-	0xcc, 0xac, 0x37, 0x46, 0xe2, 0x89, 0x07, 0x24, 0xd4, 0x0a, 0xaa, 0x0a, 0x89, 0x0a, 0x10, 0x08,
-	0x09, 0xd4, 0x22, 0xa4, 0x22, 0x10, 0x02, 0xc4, 0x0b, 0x20, 0x21, 0x2a, 0x81, 0x4a, 0x9f, 0x78,
-	0xe1, 0x81, 0xaa, 0xe2, 0x81, 0xbe, 0xf1, 0x40, 0x79, 0x43, 0x42, 0x73, 0xe7, 0xce, 0xee, 0xce,
-	0xcc, 0xbd, 0x33, 0x77, 0xd6, 0x4e, 0x0b, 0x6a, 0xde, 0x3c, 0x77, 0xce, 0x3d, 0xf3, 0xbb, 0xe7,
-	0x9e, 0x73, 0xee, 0xb9, 0xe7, 0x9c, 0x35, 0x9c, 0x6a, 0xad, 0x13, 0x6b, 0xae, 0xaa, 0xe9, 0xa4,
-	0x51, 0x25, 0x73, 0x5a, 0xd3, 0x98, 0xdb, 0xbe, 0x34, 0xb7, 0x69, 0xd8, 0x8e, 0x69, 0xed, 0x94,
-	0x9a, 0x96, 0xe9, 0x98, 0xf8, 0x80, 0x4b, 0x52, 0x62, 0x24, 0x25, 0xad, 0x69, 0x94, 0xb6, 0x2f,
-	0x1d, 0x39, 0xf1, 0xc0, 0x34, 0x1f, 0xd4, 0xc8, 0x1c, 0x25, 0x59, 0x6f, 0x6d, 0xcc, 0xe9, 0x2d,
-	0x4b, 0x73, 0x0c, 0xb3, 0xe1, 0x4d, 0x3a, 0x72, 0x32, 0xfc, 0xde, 0x31, 0xea, 0xc4, 0x76, 0xb4,
-	0x7a, 0x93, 0x11, 0x4c, 0xf1, 0x3e, 0x5c, 0x35, 0xeb, 0xf5, 0x0e, 0x0b, 0x85, 0x47, 0xe1, 0x68,
-	0xf6, 0x56, 0xcd, 0xb0, 0x9d, 0x38, 0x9a, 0xb6, 0x69, 0x6d, 0x6d, 0xd4, 0xcc, 0xb6, 0x47, 0xa3,
-	0xdc, 0x82, 0xfc, 0x0b, 0xde, 0x82, 0xf0, 0x75, 0xc8, 0x91, 0x6d, 0xd2, 0x70, 0xec, 0x09, 0x34,
-	0x95, 0x9d, 0x2e, 0xce, 0x9f, 0x2a, 0x71, 0xd6, 0x56, 0x62, 0xd4, 0x4b, 0x2e, 0xa5, 0xca, 0x26,
-	0x28, 0x1f, 0x5c, 0x83, 0xe1, 0xde, 0x17, 0x78, 0x12, 0x86, 0xe8, 0xab, 0x8a, 0xa1, 0x4f, 0xa0,
-	0x29, 0x34, 0x9d, 0x55, 0xf3, 0xf4, 0xb9, 0xac, 0xe3, 0xeb, 0x00, 0xde, 0x2b, 0x77, 0xd1, 0x13,
-	0x99, 0x29, 0x34, 0x5d, 0x9c, 0x3f, 0x52, 0xf2, 0x24, 0x52, 0xf2, 0x25, 0x52, 0x5a, 0xf3, 0x25,
-	0xa2, 0x16, 0x28, 0xb5, 0xfb, 0x8c, 0x27, 0x20, 0xbf, 0x4d, 0x2c, 0xdb, 0x30, 0x1b, 0x13, 0x59,
-	0x8f, 0x29, 0x7b, 0xc4, 0x87, 0x21, 0xef, 0x2e, 0xde, 0xfd, 0xdc, 0x00, 0x7d, 0x93, 0x73, 0x1f,
-	0xcb, 0x3a, 0xfe, 0x26, 0x82, 0xf3, 0xfe, 0x92, 0x2b, 0xe4, 0x21, 0xa9, 0xb6, 0xdc, 0x7d, 0xa8,
-	0xd8, 0x8e, 0x66, 0x39, 0x44, 0xaf, 0x78, 0x48, 0x34, 0xc7, 0xb1, 0x8c, 0xf5, 0x96, 0x43, 0xec,
-	0x89, 0x41, 0x8a, 0xe7, 0x7f, 0xb9, 0x4b, 0x7f, 0x91, 0xf1, 0x59, 0xf2, 0xd9, 0xdc, 0xf5, 0xb8,
-	0xd0, 0x25, 0x2f, 0x74, 0x78, 0xbc, 0xf0, 0x84, 0x7a, 0xae, 0x2d, 0x47, 0x8a, 0xbf, 0x8b, 0xe0,
-	0x02, 0x07, 0x5e, 0xd5, 0xac, 0x37, 0x6b, 0x84, 0x0b, 0x30, 0x47, 0x01, 0x3e, 0x27, 0x07, 0x70,
-	0xd1, 0xe7, 0x13, 0x85, 0xf8, 0x54, 0x5b, 0x96, 0x18, 0xbf, 0x8e, 0x60, 0x86, 0x03, 0x72, 0x43,
-	0x33, 0x6a, 0x3c, 0x84, 0x79, 0x8a, 0xf0, 0x86, 0x1c, 0xc2, 0x65, 0xca, 0x24, 0x0a, 0xef, 0x6c,
-	0x5b, 0x8a, 0x12, 0x7f, 0x87, 0x2f, 0x40, 0x57, 0xb7, 0xf4, 0x8a, 0xd9, 0x72, 0xa2, 0xf0, 0x86,
-	0x28, 0xbc, 0x67, 0xe5, 0xe0, 0xb9, 0x6a, 0xa7, 0xdf, 0x69, 0x39, 0x51, 0x80, 0xd3, 0x6d, 0x49,
-	0x5a, 0xfc, 0x1a, 0x82, 0x69, 0x9d, 0x54, 0x0d, 0x9b, 0x02, 0x73, 0xb5, 0xd4, 0xae, 0x6e, 0x12,
-	0xbd, 0xc5, 0x15, 0x5e, 0x81, 0xa2, 0xbb, 0xce, 0x45, 0x77, 0x8b, 0x31, 0x59, 0xd3, 0xec, 0xad,
-	0xbb, 0x3e, 0x8b, 0x28, 0xb2, 0x33, 0xba, 0x04, 0x1d, 0x7e, 0x05, 0xc1, 0xd9, 0x10, 0x2a, 0x91,
-	0x4d, 0x00, 0xc5, 0x74, 0x2d, 0x19, 0x93, 0xc8, 0x1c, 0x14, 0x3d, 0x91, 0x8a, 0x23, 0xa5, 0x18,
-	0x23, 0x28, 0x4a, 0x4a, 0x29, 0x46, 0xff, 0x03, 0x52, 0x12, 0xaa, 0xfe, 0xab, 0x11, 0x54, 0x31,
-	0x9a, 0x35, 0x4c, 0x51, 0xfd, 0x4f, 0x22, 0x2a, 0xb1, 0x52, 0x9d, 0xd6, 0x93, 0xc9, 0xf0, 0x97,
-	0x11, 0x3c, 0x19, 0xc4, 0x24, 0xb2, 0xc4, 0x7d, 0x14, 0xd0, 0xd5, 0x44, 0x40, 0x22, 0x23, 0x3c,
-	0xa5, 0x27, 0x11, 0xd1, 0x6d, 0xd3, 0xaa, 0x8e, 0xb1, 0x6d, 0x38, 0x3b, 0x89, 0xca, 0x3d, 0x12,
-	0xb3, 0x6d, 0x0b, 0x8c, 0x49, 0x92, 0x72, 0x6b, 0x12, 0x74, 0x54, 0xb9, 0x43, 0xa8, 0x44, 0xca,
-	0xbd, 0x3f, 0x46, 0xb9, 0x03, 0x98, 0x84, 0xca, 0xad, 0x25, 0x52, 0x71, 0xa4, 0x14, 0xa3, 0xdc,
-	0xa3, 0x92, 0x52, 0x8a, 0x53, 0x6e, 0x4d, 0x82, 0x8e, 0x2a, 0x52, 0x10, 0x95, 0x48, 0x91, 0xc6,
-	0x62, 0x14, 0xa9, 0x17, 0x92, 0x50, 0x91, 0xb4, 0x24, 0x22, 0x6a, 0x69, 0x41, 0x30, 0x31, 0x96,
-	0x86, 0x63, 0x2c, 0xad, 0x17, 0x4f, 0x8c, 0xa5, 0x69, 0xc9, 0x64, 0xb8, 0x0d, 0x27, 0x5c, 0x10,
-	0x96, 0x58, 0x7b, 0x0e, 0x50, 0x20, 0x17, 0xb9, 0x40, 0x5c, 0xae, 0x96, 0x50, 0x6d, 0x8e, 0x3a,
-	0xe2, 0xd7, 0xf8, 0x25, 0x38, 0xe6, 0x7d, 0x78, 0xc3, 0xb0, 0x78, 0x9f, 0x3d, 0x48, 0x3f, 0x5b,
-	0x12, 0x7f, 0x76, 0xd9, 0x9d, 0x17, 0xfd, 0xe8, 0xa4, 0x23, 0x7a, 0x89, 0xbf, 0x8f, 0x60, 0x2e,
-	0xa4, 0xa2, 0x5a, 0xa3, 0x4a, 0x6a, 0x15, 0x8b, 0xbc, 0xd4, 0x22, 0x36, 0x77, 0xf5, 0x87, 0x28,
-	0x8c, 0xe7, 0x93, 0x35, 0x95, 0x72, 0x52, 0x7d, 0x46, 0x51, 0x5c, 0x33, 0x9a, 0x34, 0x35, 0xfe,
-	0x09, 0x82, 0x2b, 0x0c, 0x93, 0x0f, 0x51, 0x4e, 0x89, 0xc7, 0x29, 0xda, 0x45, 0x2e, 0x5a, 0xf6,
-	0x35, 0xef, 0xd3, 0x32, 0x1a, 0x5d, 0xb2, 0x52, 0xcd, 0xc0, 0x5f, 0x45, 0x70, 0x8e, 0x27, 0x5e,
-	0x1e, 0xd0, 0xc3, 0x92, 0xda, 0xbd, 0xc8, 0x38, 0x24, 0x68, 0xb7, 0x80, 0x0c, 0x7f, 0x1e, 0x4e,
-	0x7a, 0x4a, 0x26, 0x46, 0x32, 0x41, 0x91, 0x5c, 0x12, 0xeb, 0x99, 0x18, 0x82, 0xa7, 0xc0, 0xa2,
-	0x6f, 0x7f, 0x09, 0xc1, 0x19, 0xb6, 0x79, 0x4c, 0xd1, 0x05, 0x9b, 0x36, 0x49, 0x11, 0x3c, 0xcd,
-	0x45, 0xe0, 0x31, 0xf7, 0xf4, 0x5d, 0xb0, 0x4d, 0x53, 0xd5, 0x04, 0x1a, 0xfc, 0x05, 0x98, 0xaa,
-	0x6b, 0xd6, 0x16, 0xb1, 0x2a, 0x16, 0xa9, 0x9a, 0x96, 0xce, 0x03, 0x71, 0x84, 0x82, 0x98, 0xe7,
-	0x82, 0xf8, 0x7f, 0x3a, 0x59, 0x65, 0x73, 0xa3, 0x08, 0x8e, 0xd7, 0xe3, 0x08, 0xf0, 0xb7, 0x11,
-	0xcc, 0xf2, 0xee, 0x27, 0xc6, 0x83, 0x86, 0xc6, 0x15, 0xc8, 0xd1, 0x34, 0xe1, 0xeb, 0x5d, 0xc6,
-	0x46, 0x26, 0x7c, 0x15, 0xd0, 0xe2, 0xef, 0x21, 0x28, 0xf1, 0x22, 0x6c, 0x62, 0xd5, 0x8d, 0x86,
-	0xc6, 0xf5, 0x0b, 0xc7, 0x62, 0xfc, 0x42, 0x34, 0xc4, 0xee, 0x30, 0xe2, 0xf8, 0x85, 0xb6, 0x34,
-	0x35, 0xfe, 0x29, 0x82, 0x2b, 0xbc, 0xab, 0x54, 0xa2, 0x17, 0x3b, 0x4e, 0xd1, 0xde, 0x92, 0xbc,
-	0x51, 0x25, 0xb9, 0xb2, 0xb9, 0x76, 0xba, 0x29, 0x22, 0x0d, 0x10, 0x1b, 0xe5, 0x89, 0x34, 0x1a,
-	0x20, 0x36, 0xd0, 0xe9, 0xb6, 0x24, 0x2d, 0xfe, 0x2b, 0x82, 0xa5, 0x90, 0xc7, 0x25, 0x0f, 0x1d,
-	0x62, 0x35, 0xb4, 0x5a, 0x85, 0x83, 0xdc, 0x68, 0x18, 0x8e, 0xc1, 0x57, 0x8c, 0x93, 0x14, 0xfa,
-	0xdd, 0x64, 0x17, 0xbc, 0xc4, 0xf8, 0x47, 0xd6, 0x53, 0xf6, 0x99, 0x47, 0x17, 0xf4, 0x9c, 0xb5,
-	0x2b, 0x0e, 0xf8, 0xcf, 0x08, 0x6e, 0xa6, 0x58, 0xa6, 0xc8, 0x63, 0x4d, 0xd1, 0x35, 0xae, 0xee,
-	0x62, 0x8d, 0x22, 0x67, 0x76, 0xc3, 0xea, 0x7f, 0x3a, 0x7e, 0x07, 0xc1, 0xb3, 0x71, 0xcb, 0x49,
-	0xb6, 0x93, 0x53, 0x74, 0x61, 0x2b, 0xdc, 0x85, 0x09, 0xc1, 0x24, 0xda, 0xcb, 0x35, 0xd2, 0xdf,
-	0x54, 0x1a, 0x07, 0x70, 0x53, 0x27, 0x0d, 0xc7, 0x68, 0xb4, 0x88, 0x5e, 0xd1, 0xec, 0x4a, 0x83,
-	0xb4, 0xa3, 0xeb, 0x50, 0x62, 0xe2, 0x00, 0x4e, 0x06, 0x85, 0xb1, 0x5b, 0xb0, 0x6f, 0x93, 0x36,
-	0x27, 0x0e, 0x68, 0xa7, 0x9a, 0x81, 0x7f, 0x83, 0xe0, 0x3a, 0x8d, 0x26, 0x2b, 0xd5, 0x4d, 0xa3,
-	0xa6, 0xa7, 0xb4, 0x9f, 0xd3, 0x14, 0xfa, 0x0b, 0x5c, 0xe8, 0x34, 0x94, 0x5c, 0x74, 0x99, 0xa6,
-	0x31, 0x9a, 0xcb, 0x76, 0xfa, 0x69, 0xf8, 0x17, 0x08, 0xae, 0x26, 0x2c, 0x42, 0x64, 0x1d, 0x67,
-	0xe8, 0x0a, 0x96, 0xd2, 0xae, 0x40, 0x64, 0x12, 0x17, 0xed, 0x94, 0x73, 0xf0, 0x0f, 0x11, 0x5c,
-	0x12, 0xa2, 0x16, 0xc6, 0xf9, 0x4f, 0x52, 0xd8, 0x0b, 0xfc, 0x30, 0x84, 0xfb, 0x75, 0x61, 0xe0,
-	0x3f, 0x5b, 0x4d, 0x41, 0x8f, 0x7f, 0x8c, 0xe0, 0xb2, 0x10, 0x6e, 0xcc, 0x25, 0xf2, 0x6c, 0x8c,
-	0x92, 0xf3, 0x01, 0xc7, 0x5c, 0x27, 0x4b, 0xd5, 0x54, 0x33, 0xf0, 0x9b, 0x08, 0x2e, 0xa6, 0xd6,
-	0x8c, 0x73, 0x14, 0xf1, 0xff, 0xa5, 0x40, 0x2c, 0x52, 0x8a, 0xf3, 0xd5, 0x14, 0xfa, 0xf0, 0x16,
-	0x82, 0x79, 0xb1, 0x80, 0x85, 0x87, 0xf0, 0x34, 0x45, 0x7b, 0x33, 0x8d, 0x7c, 0x85, 0x27, 0xf1,
-	0x85, 0x6a, 0x9a, 0x09, 0xf8, 0x47, 0x71, 0x2a, 0x11, 0x73, 0x69, 0x7e, 0x2a, 0x35, 0x64, 0xf1,
-	0xf5, 0x59, 0x00, 0x59, 0x74, 0x91, 0x76, 0x63, 0x33, 0x31, 0xe4, 0x98, 0x48, 0x72, 0x26, 0x26,
-	0x36, 0x13, 0x60, 0x8e, 0x09, 0x27, 0xe7, 0xaa, 0xe9, 0xa6, 0xd0, 0x43, 0xd3, 0x0b, 0xc5, 0xfb,
-	0x8d, 0x78, 0xce, 0xc7, 0x1c, 0x9a, 0x5e, 0xc4, 0xdd, 0x4f, 0xa8, 0x73, 0xcd, 0xee, 0x6f, 0x2a,
-	0xfe, 0x2d, 0x82, 0x67, 0x24, 0x16, 0x24, 0xb2, 0xd1, 0x59, 0xba, 0x9a, 0x72, 0x3f, 0xab, 0x11,
-	0x19, 0xeb, 0x15, 0xbb, 0x8f, 0x79, 0xf8, 0xe7, 0x08, 0x9e, 0x8e, 0x5b, 0x80, 0xf8, 0xfe, 0x74,
-	0x21, 0xe6, 0x00, 0x12, 0x82, 0x10, 0xdf, 0xa3, 0x2e, 0x92, 0x94, 0x73, 0xa8, 0xc3, 0x69, 0x35,
-	0x6d, 0x62, 0x39, 0x5d, 0xe0, 0x36, 0xd1, 0xac, 0xea, 0x66, 0x0f, 0xcc, 0x28, 0xee, 0x52, 0x8c,
-	0xf5, 0xde, 0xa3, 0xec, 0x7c, 0x04, 0x77, 0x29, 0xb3, 0xee, 0x17, 0x39, 0xd6, 0xdb, 0x4a, 0x33,
-	0xe1, 0xe6, 0x30, 0x40, 0x17, 0x88, 0xf2, 0x5e, 0x11, 0xce, 0xc9, 0x9e, 0x5e, 0xcb, 0xb0, 0xaf,
-	0xb3, 0x46, 0x67, 0xa7, 0x49, 0x68, 0x2d, 0x50, 0x54, 0x59, 0xf4, 0x99, 0xae, 0xed, 0x34, 0x89,
-	0x3a, 0xdc, 0xee, 0x79, 0xc2, 0x9f, 0x85, 0x43, 0x4d, 0xcd, 0x72, 0x25, 0xd2, 0x6b, 0x74, 0x1b,
-	0x26, 0x2b, 0x1f, 0x4e, 0x73, 0xf9, 0xad, 0xd2, 0x19, 0x3d, 0x36, 0xb1, 0x61, 0xaa, 0x07, 0x9a,
-	0xd1, 0x41, 0xfc, 0x0c, 0x14, 0x68, 0x46, 0xa6, 0x66, 0xd8, 0x0e, 0x2d, 0x2c, 0x16, 0xe7, 0x8f,
-	0xf3, 0x53, 0x1e, 0x9a, 0xbd, 0xb5, 0x62, 0xd8, 0x8e, 0x3a, 0xe4, 0xb0, 0xbf, 0xf0, 0x3c, 0x0c,
-	0x1a, 0x8d, 0x66, 0xcb, 0xa1, 0x65, 0xc7, 0xe2, 0xfc, 0x31, 0x01, 0x92, 0x9d, 0x9a, 0xa9, 0xe9,
-	0xaa, 0x47, 0x8a, 0x35, 0x98, 0x0a, 0x85, 0x1c, 0x15, 0xc7, 0xac, 0x54, 0x6b, 0xa6, 0x4d, 0xa8,
-	0xff, 0x36, 0x5b, 0x0e, 0xab, 0x43, 0x4e, 0x46, 0xea, 0xa2, 0xb7, 0x58, 0x25, 0x59, 0x3d, 0x46,
-	0x02, 0xb2, 0x5f, 0x33, 0x17, 0xdd, 0xf9, 0x6b, 0xde, 0x74, 0xfc, 0x22, 0x1c, 0xed, 0xa6, 0xbd,
-	0xa3, 0xdc, 0x73, 0x49, 0xdc, 0x0f, 0x3b, 0x7e, 0x32, 0x3b, 0xc4, 0xf8, 0x06, 0x1c, 0xe9, 0x46,
-	0xd8, 0xdd, 0x55, 0x58, 0xad, 0x46, 0xc5, 0xd0, 0x69, 0xe9, 0xaf, 0xa0, 0x1e, 0xee, 0x50, 0x74,
-	0xe4, 0xac, 0xb6, 0x1a, 0x65, 0x1d, 0x97, 0xa1, 0xc0, 0x5c, 0xa5, 0x69, 0xd1, 0x3a, 0xdc, 0xc8,
-	0xfc, 0x79, 0xbe, 0x6b, 0x67, 0x0c, 0x68, 0x08, 0x5d, 0xf6, 0xa7, 0xa8, 0xdd, 0xd9, 0xb8, 0x0c,
-	0x63, 0x5d, 0x1c, 0xae, 0xbb, 0x6a, 0x59, 0x84, 0x15, 0xcf, 0xf8, 0x7b, 0xb0, 0xec, 0xd1, 0xa8,
-	0xa3, 0x9d, 0x69, 0x6c, 0x04, 0xab, 0x30, 0x5e, 0xd3, 0xdc, 0x3b, 0x9f, 0x17, 0xce, 0xd0, 0xe5,
-	0x10, 0xbb, 0x55, 0x73, 0x58, 0xe1, 0x2b, 0x7e, 0x4f, 0x0f, 0xba, 0x73, 0x17, 0x3b, 0x53, 0x55,
-	0x3a, 0x13, 0x5f, 0x87, 0x49, 0xd3, 0x32, 0x1e, 0x18, 0x9e, 0xa3, 0x0d, 0x49, 0xa9, 0x48, 0xa5,
-	0x34, 0xee, 0x13, 0x84, 0x84, 0x74, 0x04, 0x86, 0x0c, 0x9d, 0x34, 0x1c, 0xc3, 0xd9, 0xa1, 0x15,
-	0xa5, 0x82, 0xda, 0x79, 0xc6, 0x97, 0x61, 0x7c, 0xc3, 0xb0, 0x6c, 0x27, 0xca, 0x73, 0x1f, 0xa5,
-	0x3c, 0x40, 0xdf, 0x86, 0x18, 0x2e, 0xc2, 0xb0, 0x45, 0x1c, 0x6b, 0xa7, 0xd2, 0x34, 0x6b, 0x46,
-	0x75, 0x87, 0x55, 0x61, 0xa6, 0x04, 0x17, 0x54, 0xc7, 0xda, 0x59, 0xa5, 0x74, 0x6a, 0xd1, 0xea,
-	0x3e, 0xe0, 0x09, 0xc8, 0x6b, 0x8e, 0x43, 0xea, 0x4d, 0x87, 0x56, 0x4c, 0x06, 0x55, 0xff, 0x11,
-	0x2f, 0xc2, 0x7e, 0xf2, 0xb0, 0x69, 0x78, 0x8a, 0xe3, 0x15, 0xf5, 0x47, 0x13, 0x8b, 0xfa, 0x23,
-	0xdd, 0x29, 0xb4, 0xb2, 0x7f, 0x1a, 0xf6, 0x55, 0x2d, 0xd7, 0x1a, 0x58, 0x45, 0x87, 0x56, 0x1c,
-	0x0a, 0xea, 0xb0, 0x3b, 0xe8, 0x57, 0x79, 0xf0, 0xa7, 0xe0, 0xa8, 0xb7, 0xfa, 0x60, 0xf5, 0x6b,
-	0x5d, 0xab, 0x6e, 0x99, 0x1b, 0x1b, 0xac, 0x28, 0x10, 0xa3, 0xd4, 0x13, 0x74, 0x76, 0x6f, 0xe1,
-	0xeb, 0xa6, 0x37, 0x15, 0x5f, 0x80, 0x81, 0x3a, 0xa9, 0x9b, 0x2c, 0x9d, 0x3f, 0xc9, 0x4f, 0xf4,
-	0x91, 0xba, 0xa9, 0x52, 0x32, 0xac, 0xc2, 0x58, 0xc4, 0x63, 0xb3, 0x9c, 0xfc, 0x93, 0xfc, 0xb3,
-	0x31, 0xe4, 0x61, 0xd5, 0x51, 0x3b, 0x34, 0x82, 0xef, 0xc1, 0x78, 0xd3, 0x22, 0xdb, 0x15, 0xad,
-	0xe5, 0x98, 0xae, 0xfe, 0x11, 0xa7, 0xd2, 0x34, 0x8d, 0x86, 0xe3, 0x67, 0xd9, 0x45, 0xfb, 0x65,
-	0x13, 0x67, 0x95, 0xd2, 0xa9, 0x07, 0xdc, 0xf9, 0x0b, 0x2d, 0xc7, 0xec, 0x19, 0xc4, 0x97, 0x21,
-	0xb7, 0x49, 0x34, 0x9d, 0x58, 0x2c, 0xfd, 0x7d, 0x94, 0xdf, 0xd4, 0x41, 0x49, 0x54, 0x46, 0xaa,
-	0xbc, 0x89, 0xe0, 0x29, 0xf9, 0x68, 0xff, 0x0a, 0xe4, 0x98, 0xbd, 0x20, 0x09, 0x7b, 0x61, 0xb4,
-	0x78, 0x19, 0xa6, 0xe2, 0xcb, 0xbd, 0x86, 0x4e, 0xbd, 0x7b, 0x56, 0x3d, 0x26, 0xae, 0xd4, 0x96,
-	0x75, 0xe5, 0x0d, 0x04, 0x67, 0x25, 0x83, 0x86, 0xab, 0x90, 0xf7, 0x3d, 0x05, 0x92, 0xf0, 0x14,
-	0x3e, 0xf1, 0x9e, 0x41, 0x35, 0x61, 0x5a, 0x3a, 0x62, 0x5e, 0x84, 0x61, 0xe6, 0xac, 0xbb, 0x07,
-	0xe7, 0x88, 0x40, 0x09, 0x98, 0x6f, 0xa6, 0xe7, 0x66, 0xd1, 0xe9, 0x3e, 0x28, 0x7f, 0x40, 0x70,
-	0x46, 0xa6, 0x69, 0x20, 0x78, 0x02, 0xa2, 0x74, 0x27, 0xe0, 0x6d, 0x18, 0x17, 0x9c, 0x32, 0x99,
-	0x24, 0x83, 0x3c, 0x60, 0x73, 0x4e, 0x98, 0x1e, 0x4f, 0x93, 0x0d, 0x78, 0x1a, 0xe5, 0x15, 0x04,
-	0x4a, 0x72, 0xbf, 0x01, 0x9e, 0x05, 0x1c, 0xae, 0x41, 0x77, 0xba, 0x90, 0x46, 0xed, 0x80, 0x08,
-	0x42, 0xee, 0x36, 0x13, 0x72, 0xb7, 0xc7, 0x01, 0xfc, 0x84, 0xa0, 0xa1, 0x53, 0x34, 0x05, 0xb5,
-	0xc0, 0x46, 0xca, 0xba, 0xf2, 0xf7, 0x90, 0x78, 0x85, 0x16, 0x92, 0x0e, 0xd1, 0x34, 0x8c, 0x06,
-	0xf3, 0x10, 0x1d, 0xf5, 0x1a, 0xb1, 0x7b, 0x56, 0x1c, 0xc2, 0x9e, 0x0d, 0x61, 0x3f, 0x07, 0xfb,
-	0xd7, 0x8d, 0x86, 0x66, 0xed, 0x54, 0xaa, 0x9b, 0xa4, 0xba, 0x65, 0xb7, 0xea, 0x34, 0x44, 0x29,
-	0xa8, 0x23, 0xde, 0xf0, 0x22, 0x1b, 0xc5, 0xe7, 0x61, 0x2c, 0x98, 0x3d, 0x23, 0x0f, 0xbd, 0xf0,
-	0x63, 0x58, 0x1d, 0x25, 0xbd, 0x49, 0x2d, 0xf2, 0xd0, 0x51, 0x5e, 0xce, 0xc2, 0x69, 0x89, 0x56,
-	0x86, 0x47, 0xb6, 0xe2, 0xb0, 0x59, 0x64, 0xfb, 0x30, 0x0b, 0x7c, 0x02, 0x8a, 0xeb, 0x9a, 0x4d,
-	0xfc, 0xa3, 0xd3, 0x13, 0x4b, 0xc1, 0x1d, 0xf2, 0x0e, 0xcc, 0x63, 0x00, 0x0d, 0xd2, 0xf6, 0x5f,
-	0x0f, 0x7a, 0x82, 0x6d, 0x90, 0xb6, 0xf7, 0x76, 0x16, 0xf0, 0x86, 0x69, 0x6d, 0x31, 0xa4, 0x7e,
-	0x3f, 0x5a, 0xce, 0x5b, 0x9a, 0xfb, 0x86, 0x62, 0xbd, 0xcf, 0x1a, 0xd3, 0xc6, 0x5d, 0xe7, 0xa8,
-	0xd9, 0x66, 0x83, 0xc5, 0x46, 0xec, 0x09, 0xdf, 0x82, 0xc1, 0xaa, 0xd6, 0xb2, 0x09, 0x0b, 0x83,
-	0x4a, 0xd2, 0x4d, 0x23, 0x8b, 0xee, 0x2c, 0xd5, 0x9b, 0xac, 0xbc, 0x91, 0x85, 0x53, 0x89, 0x8d,
-	0x1c, 0x8f, 0x6c, 0x33, 0x6e, 0xfa, 0x6b, 0xf0, 0x76, 0x61, 0x56, 0xb2, 0xcf, 0xa4, 0x77, 0x05,
-	0xbd, 0x3e, 0x79, 0x20, 0x8d, 0x4f, 0xee, 0x55, 0xfd, 0xc1, 0x90, 0xea, 0x87, 0xf6, 0x37, 0x17,
-	0xbf, 0xbf, 0x79, 0xa9, 0xfd, 0x1d, 0x12, 0xec, 0x2f, 0xc7, 0xcc, 0x0a, 0x3c, 0x33, 0x53, 0xbe,
-	0x95, 0x83, 0x33, 0x32, 0x3d, 0x2e, 0xf8, 0x24, 0x14, 0x3b, 0x85, 0x62, 0xb6, 0x4d, 0x05, 0x15,
-	0xfc, 0xa1, 0xb2, 0xee, 0x5e, 0xaa, 0xba, 0x95, 0x64, 0xd7, 0x08, 0x32, 0x31, 0x97, 0xaa, 0xce,
-	0x27, 0xe9, 0xa5, 0x4a, 0xeb, 0x79, 0x72, 0x55, 0x53, 0x37, 0xeb, 0x9a, 0xd1, 0x60, 0xbe, 0x83,
-	0x3d, 0x05, 0x0f, 0x83, 0x81, 0x3e, 0xaf, 0x43, 0x39, 0xf9, 0xeb, 0xd0, 0x1a, 0x4c, 0xfa, 0x4a,
-	0x18, 0x3d, 0x43, 0xf2, 0x49, 0x67, 0xc8, 0xb8, 0x3f, 0x37, 0x74, 0x8c, 0x84, 0xb8, 0xb2, 0x23,
-	0x8a, 0x71, 0x1d, 0x4a, 0xc1, 0xd5, 0xbb, 0x05, 0x31, 0xae, 0xe2, 0xc3, 0xae, 0xd0, 0xd7, 0x61,
-	0xb7, 0x0c, 0x63, 0x9b, 0x44, 0xb3, 0x9c, 0x75, 0xa2, 0x75, 0xd1, 0x41, 0x12, 0xab, 0xd1, 0xce,
-	0x9c, 0x2e, 0x9f, 0xe4, 0x10, 0xa5, 0x98, 0x1c, 0xa2, 0x44, 0xee, 0x0a, 0xc3, 0xfd, 0xdc, 0x15,
-	0xba, 0x31, 0xe7, 0x3e, 0xf9, 0x98, 0xf3, 0x6f, 0x08, 0x94, 0xe4, 0x7e, 0xab, 0x8f, 0xec, 0x70,
-	0xef, 0x0d, 0x43, 0x06, 0x82, 0x17, 0x9e, 0xe7, 0x61, 0x98, 0xde, 0x17, 0x7d, 0xbf, 0x35, 0x28,
-	0xe1, 0xb7, 0x8a, 0xee, 0x0c, 0xf6, 0xa0, 0xbc, 0x8b, 0x82, 0xae, 0x60, 0x8f, 0x23, 0x6b, 0xbe,
-	0x88, 0x32, 0x29, 0xdc, 0x7d, 0x36, 0x31, 0xda, 0x18, 0x08, 0x0a, 0x53, 0xf9, 0x23, 0x82, 0x53,
-	0xc9, 0x4d, 0x30, 0xfd, 0x06, 0xe0, 0x1f, 0xc7, 0x8a, 0x7e, 0x99, 0x81, 0xd3, 0x12, 0xad, 0x64,
-	0xee, 0x9a, 0x74, 0xe2, 0x68, 0x46, 0xcd, 0x96, 0xda, 0x24, 0x9f, 0xf8, 0x91, 0xad, 0x29, 0x1c,
-	0x21, 0x0d, 0xf4, 0x13, 0x21, 0xed, 0x5a, 0xc5, 0xbf, 0x86, 0x60, 0x46, 0xbe, 0x03, 0x4c, 0xe6,
-	0xcc, 0xdb, 0x9b, 0x2b, 0xd8, 0x5b, 0x08, 0x52, 0xf6, 0x7a, 0x25, 0x63, 0x3b, 0xe8, 0x87, 0x41,
-	0x9e, 0x87, 0x61, 0x81, 0x8d, 0x0c, 0xe2, 0xac, 0x04, 0xe2, 0xd7, 0x43, 0x7a, 0x28, 0xaa, 0x0a,
-	0xf5, 0xab, 0x87, 0xcb, 0x30, 0x55, 0xd3, 0x9c, 0x9e, 0x9e, 0x87, 0x70, 0x07, 0x40, 0x57, 0xb2,
-	0x1e, 0x1d, 0x6f, 0x2b, 0xbd, 0xb0, 0x89, 0xa3, 0xcf, 0xd9, 0x14, 0xfa, 0x3c, 0x90, 0x68, 0xa3,
-	0xa1, 0x40, 0x4f, 0x79, 0x07, 0xc1, 0xd1, 0x98, 0x2e, 0x4b, 0x3c, 0x09, 0x43, 0x5e, 0x77, 0x59,
-	0x67, 0xdf, 0xf2, 0xf4, 0xb9, 0xac, 0xe3, 0x15, 0x38, 0xd4, 0x39, 0xc8, 0x37, 0x0c, 0x2b, 0xc5,
-	0xa5, 0x15, 0xb3, 0x73, 0x7c, 0xd9, 0xb0, 0x48, 0x9a, 0xe3, 0x57, 0x66, 0xb3, 0x3f, 0x07, 0x93,
-	0xc2, 0xf6, 0xcd, 0xb8, 0xd5, 0x48, 0xc7, 0xec, 0xca, 0xef, 0x10, 0x1c, 0x8b, 0xeb, 0xdc, 0xdb,
-	0x93, 0xaf, 0xec, 0x95, 0x3c, 0x62, 0x1d, 0xf4, 0xcf, 0x10, 0x4c, 0x25, 0x75, 0x00, 0xc6, 0xad,
-	0xe6, 0x91, 0x9a, 0x6d, 0x2c, 0xf2, 0x7f, 0xe5, 0x21, 0x65, 0xa3, 0x09, 0x9e, 0x83, 0x83, 0xb4,
-	0x97, 0x25, 0x9c, 0xf6, 0xf5, 0xd6, 0x34, 0xd6, 0x20, 0xed, 0x50, 0xd2, 0x37, 0x52, 0x79, 0xc9,
-	0xf4, 0x57, 0x79, 0x79, 0x5c, 0x1b, 0x91, 0xaf, 0x8d, 0xc8, 0xe8, 0x4e, 0x5e, 0x42, 0x77, 0xee,
-	0xc0, 0x38, 0xcb, 0x69, 0x33, 0x8c, 0x46, 0xc3, 0x21, 0xd6, 0xb6, 0x56, 0x4b, 0xbe, 0xb7, 0x1c,
-	0x64, 0x13, 0x29, 0xbc, 0x32, 0x9b, 0x16, 0xac, 0xbb, 0x14, 0x76, 0x55, 0x77, 0xe9, 0x09, 0xe1,
-	0x20, 0x4d, 0x08, 0x27, 0x2e, 0xb2, 0x14, 0xfb, 0x2e, 0xb2, 0x74, 0xef, 0x19, 0xc3, 0xd2, 0xf7,
-	0x8c, 0x4e, 0xaa, 0x7f, 0xdf, 0x2e, 0x52, 0xfd, 0x23, 0xbb, 0x4a, 0xf5, 0xbb, 0x3e, 0x78, 0x2e,
-	0x6d, 0xb7, 0x5b, 0xc7, 0x5b, 0xa1, 0x5e, 0x6f, 0x15, 0x77, 0xbf, 0x59, 0x87, 0xc3, 0x9d, 0x0a,
-	0x79, 0xa8, 0x6a, 0xea, 0xd9, 0xf1, 0x4c, 0x6c, 0x0d, 0x3c, 0x58, 0x37, 0x3d, 0x44, 0x78, 0xc3,
-	0xca, 0x0f, 0x10, 0x27, 0xa5, 0x2d, 0x3a, 0x59, 0x64, 0xcc, 0x03, 0x49, 0x98, 0x47, 0x4f, 0xa4,
-	0x93, 0x49, 0x11, 0xe9, 0x28, 0x1f, 0x22, 0x38, 0x1e, 0xdb, 0xad, 0xed, 0x86, 0x7a, 0xac, 0x17,
-	0xbc, 0xa1, 0xd5, 0x7d, 0x51, 0x83, 0x37, 0x74, 0x5b, 0xab, 0x93, 0x7e, 0x3f, 0xbd, 0x67, 0xa7,
-	0x4a, 0x57, 0xe3, 0x07, 0xe4, 0x6f, 0xd6, 0xdf, 0xe0, 0x6d, 0x92, 0xa8, 0x3b, 0xe1, 0x24, 0x14,
-	0x59, 0x7f, 0x48, 0xaf, 0x08, 0xbc, 0x21, 0x2a, 0x82, 0x8e, 0x53, 0xcf, 0xc8, 0x3b, 0xf5, 0x98,
-	0x3c, 0xb5, 0xf2, 0x75, 0x04, 0x33, 0x29, 0x3a, 0x72, 0xba, 0xf9, 0x54, 0x14, 0xc8, 0xa7, 0xf6,
-	0xbb, 0x33, 0x71, 0xd0, 0x7e, 0x9d, 0x81, 0xe7, 0x76, 0xd7, 0x95, 0xbc, 0x67, 0x3a, 0xdf, 0xcd,
-	0xd5, 0x65, 0x02, 0xb9, 0xba, 0x7b, 0x80, 0xa3, 0xdd, 0x2f, 0xcc, 0xbe, 0xcf, 0xca, 0x75, 0xb8,
-	0xaa, 0x63, 0x91, 0x16, 0x56, 0x3c, 0x01, 0xf9, 0xaa, 0xd9, 0x70, 0x2c, 0xb3, 0x46, 0x15, 0x6d,
-	0x58, 0xf5, 0x1f, 0x71, 0x09, 0x0e, 0x84, 0x1a, 0xb9, 0xcc, 0x46, 0xcd, 0x8b, 0xcc, 0x87, 0xd4,
-	0xb1, 0x40, 0x7f, 0xd5, 0x9d, 0x46, 0x6d, 0x47, 0x79, 0x2d, 0x0b, 0x37, 0x76, 0xd1, 0xf5, 0x8c,
-	0xef, 0xf5, 0xfa, 0xbd, 0x11, 0xc1, 0x6f, 0x0a, 0xa4, 0x38, 0x07, 0xd2, 0xce, 0x7b, 0x74, 0x9f,
-	0x14, 0xe6, 0x50, 0xf9, 0xfb, 0x32, 0xb0, 0xdb, 0x7d, 0x99, 0x05, 0x1c, 0xee, 0x35, 0x63, 0x15,
-	0x8a, 0xac, 0x3a, 0x6a, 0x04, 0x94, 0xd0, 0x4b, 0x61, 0xf9, 0xbb, 0x98, 0x0b, 0xec, 0xa2, 0xf2,
-	0x27, 0x04, 0xd7, 0xfa, 0x6c, 0xd9, 0x16, 0x60, 0x40, 0x02, 0x0c, 0x1f, 0xad, 0xe2, 0x2a, 0x5f,
-	0xc9, 0xc2, 0xb5, 0x3e, 0xdb, 0xea, 0xfe, 0x5b, 0x6d, 0x35, 0xe4, 0xb1, 0x07, 0xc4, 0x1e, 0x7b,
-	0x50, 0xde, 0x63, 0x0b, 0x55, 0x47, 0xe4, 0x00, 0xf2, 0x22, 0x07, 0xf0, 0x72, 0x16, 0xae, 0xf4,
-	0xd3, 0x1a, 0x28, 0x67, 0xf9, 0x52, 0x9c, 0x1f, 0x5b, 0x7e, 0xd7, 0xf2, 0x3f, 0x40, 0x70, 0x31,
-	0x6d, 0x9b, 0xe3, 0x7f, 0xb4, 0xc9, 0x8b, 0xcf, 0x2a, 0xe5, 0xf7, 0x08, 0x2e, 0xa4, 0x6a, 0x8d,
-	0xdc, 0x33, 0x17, 0xc0, 0xbd, 0x35, 0x64, 0x76, 0x77, 0x6b, 0xf8, 0xcb, 0x10, 0x5c, 0xee, 0xe3,
-	0x37, 0x1e, 0x3d, 0xdb, 0x81, 0x02, 0xdb, 0x71, 0x12, 0x8a, 0x9d, 0xed, 0x60, 0x3a, 0x5f, 0x50,
-	0xc1, 0x1f, 0xe2, 0xa5, 0x10, 0xb2, 0x7b, 0x90, 0x42, 0xe8, 0xb7, 0x9e, 0x38, 0xb8, 0xb7, 0x29,
-	0x84, 0xdc, 0x23, 0x4d, 0x21, 0xe4, 0xfb, 0x4e, 0x21, 0xdc, 0x07, 0xd6, 0xa1, 0xca, 0x38, 0xb2,
-	0x32, 0x9c, 0xd7, 0x24, 0x70, 0x36, 0xa6, 0xcd, 0x95, 0x72, 0x61, 0xc5, 0xb8, 0xb1, 0x66, 0x78,
-	0xa8, 0xd7, 0x48, 0x0a, 0x41, 0x7f, 0x2e, 0xa3, 0xf2, 0x20, 0xa1, 0xf2, 0x55, 0x98, 0xe8, 0x51,
-	0xa7, 0x8a, 0x45, 0x5a, 0x5d, 0xf8, 0x45, 0x0a, 0x7f, 0x26, 0x56, 0x71, 0xca, 0xba, 0xea, 0x4e,
-	0x61, 0x4b, 0x38, 0xd4, 0xe6, 0x0d, 0x47, 0xca, 0x93, 0xfb, 0xfa, 0x29, 0x4f, 0x46, 0x7a, 0x0d,
-	0x47, 0x38, 0xbd, 0x86, 0xdd, 0x9b, 0xd6, 0xfe, 0xf4, 0xb9, 0x85, 0xd1, 0x5d, 0xe4, 0x16, 0xc6,
-	0x76, 0xd7, 0x46, 0xf8, 0x0c, 0x14, 0x75, 0x52, 0xd3, 0x76, 0x3c, 0xd5, 0x4c, 0xee, 0x89, 0x04,
-	0x4a, 0x4d, 0x55, 0x51, 0x79, 0x35, 0x0b, 0x17, 0xd3, 0xfe, 0x06, 0xeb, 0xe3, 0x77, 0x2f, 0x2b,
-	0x7e, 0x9c, 0xe0, 0x55, 0xba, 0xae, 0xa6, 0xfe, 0x01, 0x51, 0x20, 0x3c, 0xe8, 0x31, 0x94, 0xc1,
-	0xa0, 0xa1, 0xf0, 0x0f, 0xc1, 0x9c, 0xe0, 0x10, 0xdc, 0xa3, 0x5c, 0xa0, 0xf2, 0x76, 0x06, 0x66,
-	0xd3, 0xfc, 0xc0, 0x4c, 0xb8, 0x1f, 0xfc, 0xd3, 0x37, 0xb3, 0xdb, 0xd3, 0x77, 0xaf, 0x76, 0x91,
-	0x2f, 0xdd, 0x01, 0x81, 0x74, 0xbb, 0xd6, 0x39, 0x28, 0x9f, 0x07, 0xf9, 0x30, 0x03, 0x29, 0x7f,
-	0xfa, 0xf6, 0xc9, 0x10, 0x26, 0xaf, 0xac, 0x33, 0xc8, 0x2d, 0xeb, 0x74, 0xfb, 0x11, 0x72, 0xf2,
-	0xfd, 0x08, 0xca, 0x3f, 0x32, 0x70, 0x7e, 0x2f, 0x3c, 0xca, 0x27, 0x54, 0xe8, 0x3d, 0x19, 0xf7,
-	0x5c, 0x8a, 0x8c, 0xbb, 0xf2, 0xcf, 0x0c, 0x5c, 0x48, 0xf5, 0x4b, 0xc4, 0xc7, 0x82, 0x8f, 0x08,
-	0xde, 0x4f, 0x29, 0xe6, 0xd2, 0xe4, 0x99, 0xbf, 0x98, 0x15, 0x09, 0x5e, 0xd4, 0x43, 0xf2, 0x58,
-	0xf0, 0xb1, 0x2d, 0x2c, 0xb9, 0x7e, 0x7a, 0xdf, 0x7f, 0x95, 0x81, 0xb9, 0x94, 0xbf, 0x10, 0x7d,
-	0xbc, 0x0f, 0x81, 0x7d, 0x98, 0x71, 0x60, 0x3f, 0xfd, 0x73, 0xd9, 0xa8, 0x39, 0xc4, 0xa2, 0x9f,
-	0x3a, 0x0e, 0x93, 0x4b, 0xf7, 0x97, 0x6e, 0xaf, 0x55, 0x96, 0xcb, 0x2b, 0x6b, 0x4b, 0x6a, 0x65,
-	0xed, 0xd3, 0xab, 0x4b, 0x95, 0xf2, 0xed, 0xfb, 0x0b, 0x2b, 0xe5, 0x5b, 0xa3, 0x4f, 0xe0, 0x93,
-	0x70, 0x34, 0xfa, 0x7a, 0x61, 0x65, 0xa5, 0x42, 0x47, 0x47, 0x11, 0x3e, 0x05, 0xc7, 0xa3, 0x04,
-	0x8b, 0x2b, 0x77, 0xee, 0x2e, 0x31, 0x92, 0xcc, 0xcd, 0xf5, 0xb7, 0xdf, 0x3f, 0x81, 0xde, 0x7d,
-	0xff, 0x04, 0x7a, 0xef, 0xfd, 0x13, 0x08, 0x0e, 0x57, 0xcd, 0x3a, 0x4f, 0x1e, 0x37, 0x87, 0x16,
-	0x9a, 0xc6, 0xaa, 0x1b, 0xce, 0xae, 0xa2, 0xcf, 0xcc, 0x3d, 0x30, 0x9c, 0xcd, 0xd6, 0x7a, 0xa9,
-	0x6a, 0xd6, 0xe7, 0x02, 0xff, 0xf3, 0xb4, 0xf4, 0x80, 0x34, 0xbc, 0xff, 0xb2, 0xca, 0xfe, 0xfd,
-	0xe9, 0x0d, 0xad, 0x69, 0x6c, 0x5f, 0x5a, 0xcf, 0xd1, 0xb1, 0xcb, 0xff, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0xfe, 0x23, 0x07, 0xbe, 0xe1, 0x55, 0x00, 0x00,
+	0xd5, 0xef, 0xec, 0xda, 0x6b, 0xef, 0x59, 0xc7, 0xb1, 0x6f, 0x12, 0xc7, 0x4e, 0x9c, 0xc4, 0x99,
+	0xa4, 0x89, 0xeb, 0x38, 0xeb, 0xc4, 0x49, 0x93, 0x2f, 0xc9, 0xd7, 0xf6, 0x73, 0x1c, 0x5b, 0x59,
+	0xc9, 0x5f, 0x62, 0x4d, 0x9c, 0xf4, 0xfb, 0x10, 0x68, 0x19, 0xef, 0x5c, 0xc7, 0x23, 0xef, 0xce,
+	0x6c, 0x67, 0xee, 0x7a, 0x63, 0x24, 0x9e, 0x78, 0x40, 0x42, 0xad, 0xa0, 0xaa, 0x90, 0xa8, 0x00,
+	0x81, 0x90, 0xa8, 0x5a, 0x04, 0x2a, 0x02, 0x21, 0x40, 0xbc, 0x00, 0x12, 0xa2, 0x12, 0xa8, 0xf4,
+	0x89, 0x17, 0x1e, 0x40, 0x15, 0x0f, 0xf0, 0xc6, 0x03, 0xe5, 0x0d, 0x09, 0xcd, 0x9d, 0x3b, 0xfb,
+	0x67, 0xe6, 0xde, 0x99, 0x3b, 0x6b, 0xa7, 0x05, 0x35, 0x6f, 0x9e, 0x3b, 0xe7, 0x9c, 0xf9, 0xdd,
+	0x7b, 0xcf, 0xbf, 0x7b, 0xcf, 0x59, 0xc3, 0xc9, 0xc6, 0x3a, 0x76, 0xe6, 0x2a, 0xba, 0x81, 0xad,
+	0x0a, 0x9e, 0xd3, 0xeb, 0xe6, 0xdc, 0xf6, 0xc5, 0xb9, 0x4d, 0xd3, 0x25, 0xb6, 0xb3, 0x53, 0xac,
+	0x3b, 0x36, 0xb1, 0xd1, 0x01, 0x8f, 0xa4, 0xc8, 0x48, 0x8a, 0x7a, 0xdd, 0x2c, 0x6e, 0x5f, 0x3c,
+	0x72, 0xfc, 0xa1, 0x6d, 0x3f, 0xac, 0xe2, 0x39, 0x4a, 0xb2, 0xde, 0xd8, 0x98, 0x33, 0x1a, 0x8e,
+	0x4e, 0x4c, 0xdb, 0xf2, 0x99, 0x8e, 0x9c, 0x08, 0xbf, 0x27, 0x66, 0x0d, 0xbb, 0x44, 0xaf, 0xd5,
+	0x19, 0xc1, 0x14, 0xef, 0xc3, 0x15, 0xbb, 0x56, 0x6b, 0x89, 0x50, 0x79, 0x14, 0x44, 0x77, 0xb7,
+	0xaa, 0xa6, 0x4b, 0xe2, 0x68, 0x9a, 0xb6, 0xb3, 0xb5, 0x51, 0xb5, 0x9b, 0x3e, 0x8d, 0x7a, 0x0b,
+	0x06, 0x6e, 0xfb, 0x13, 0x42, 0xd7, 0x20, 0x87, 0xb7, 0xb1, 0x45, 0xdc, 0x71, 0x65, 0x2a, 0x3b,
+	0x5d, 0x98, 0x3f, 0x59, 0xe4, 0xcc, 0xad, 0xc8, 0xa8, 0x97, 0x3c, 0x4a, 0x8d, 0x31, 0xa8, 0xdf,
+	0xbb, 0x0e, 0x43, 0x9d, 0x2f, 0xd0, 0x04, 0x0c, 0xd2, 0x57, 0x65, 0xd3, 0x18, 0x57, 0xa6, 0x94,
+	0xe9, 0xac, 0x36, 0x40, 0x9f, 0x4b, 0x06, 0xba, 0x06, 0xe0, 0xbf, 0xf2, 0x26, 0x3d, 0x9e, 0x99,
+	0x52, 0xa6, 0x0b, 0xf3, 0x47, 0x8a, 0xfe, 0x8a, 0x14, 0x83, 0x15, 0x29, 0xae, 0x05, 0x2b, 0xa2,
+	0xe5, 0x29, 0xb5, 0xf7, 0x8c, 0xc6, 0x61, 0x60, 0x1b, 0x3b, 0xae, 0x69, 0x5b, 0xe3, 0x59, 0x5f,
+	0x28, 0x7b, 0x44, 0x87, 0x61, 0xc0, 0x9b, 0xbc, 0xf7, 0xb9, 0x3e, 0xfa, 0x26, 0xe7, 0x3d, 0x96,
+	0x0c, 0xf4, 0x35, 0x05, 0xce, 0x05, 0x53, 0x2e, 0xe3, 0x47, 0xb8, 0xd2, 0xf0, 0xf6, 0xa1, 0xec,
+	0x12, 0xdd, 0x21, 0xd8, 0x28, 0xfb, 0x48, 0x74, 0x42, 0x1c, 0x73, 0xbd, 0x41, 0xb0, 0x3b, 0xde,
+	0x4f, 0xf1, 0xfc, 0x37, 0x77, 0xea, 0x2f, 0x32, 0x39, 0x4b, 0x81, 0x98, 0x7b, 0xbe, 0x14, 0x3a,
+	0xe5, 0x85, 0x96, 0x8c, 0xdb, 0x4f, 0x69, 0x67, 0x9b, 0x72, 0xa4, 0xe8, 0x5b, 0x0a, 0x9c, 0xe7,
+	0xc0, 0xab, 0xd8, 0xb5, 0x7a, 0x15, 0x73, 0x01, 0xe6, 0x28, 0xc0, 0xe7, 0xe5, 0x00, 0x2e, 0x06,
+	0x72, 0xa2, 0x10, 0x9f, 0x69, 0xca, 0x12, 0xa3, 0xd7, 0x15, 0x98, 0xe1, 0x80, 0xdc, 0xd0, 0xcd,
+	0x2a, 0x0f, 0xe1, 0x00, 0x45, 0x78, 0x43, 0x0e, 0xe1, 0x32, 0x15, 0x12, 0x85, 0x77, 0xa6, 0x29,
+	0x45, 0x89, 0xbe, 0xc9, 0x5f, 0x40, 0x4f, 0xb7, 0x8c, 0xb2, 0xdd, 0x20, 0x51, 0x78, 0x83, 0x14,
+	0xde, 0x73, 0x72, 0xf0, 0x3c, 0xb5, 0x33, 0xee, 0x36, 0x48, 0x14, 0xe0, 0x74, 0x53, 0x92, 0x16,
+	0xbd, 0xa6, 0xc0, 0xb4, 0x81, 0x2b, 0xa6, 0x4b, 0x81, 0x79, 0x5a, 0xea, 0x56, 0x36, 0xb1, 0xd1,
+	0xe0, 0x2e, 0x5e, 0x9e, 0xa2, 0xbb, 0xc6, 0x45, 0x77, 0x8b, 0x09, 0x59, 0xd3, 0xdd, 0xad, 0x7b,
+	0x81, 0x88, 0x28, 0xb2, 0xd3, 0x86, 0x04, 0x1d, 0x7a, 0x45, 0x81, 0x33, 0x21, 0x54, 0x22, 0x9b,
+	0x00, 0x8a, 0xe9, 0x6a, 0x32, 0x26, 0x91, 0x39, 0xa8, 0x46, 0x22, 0x15, 0x67, 0x95, 0x62, 0x8c,
+	0xa0, 0x20, 0xb9, 0x4a, 0x31, 0xfa, 0x7f, 0xda, 0x90, 0xa0, 0x43, 0xaf, 0x46, 0x50, 0xc5, 0x68,
+	0xd6, 0x10, 0x45, 0xf5, 0x5f, 0x89, 0xa8, 0xc4, 0x4a, 0x75, 0xca, 0x48, 0x26, 0x43, 0x5f, 0x50,
+	0xe0, 0xe9, 0x6e, 0x4c, 0x22, 0x4b, 0xdc, 0x47, 0x01, 0x5d, 0x49, 0x04, 0x24, 0x32, 0xc2, 0x93,
+	0x46, 0x12, 0x11, 0xdd, 0x36, 0xbd, 0x42, 0xcc, 0x6d, 0x93, 0xec, 0x24, 0x2a, 0xf7, 0x70, 0xcc,
+	0xb6, 0x2d, 0x30, 0x21, 0x49, 0xca, 0xad, 0x4b, 0xd0, 0x51, 0xe5, 0x0e, 0xa1, 0x12, 0x29, 0xf7,
+	0xfe, 0x18, 0xe5, 0xee, 0xc2, 0x24, 0x54, 0x6e, 0x3d, 0x91, 0x8a, 0xb3, 0x4a, 0x31, 0xca, 0x3d,
+	0x22, 0xb9, 0x4a, 0x71, 0xca, 0xad, 0x4b, 0xd0, 0x51, 0x45, 0xea, 0x46, 0x25, 0x52, 0xa4, 0xd1,
+	0x18, 0x45, 0xea, 0x84, 0x24, 0x54, 0x24, 0x3d, 0x89, 0x88, 0x5a, 0x5a, 0x37, 0x98, 0x18, 0x4b,
+	0x43, 0x31, 0x96, 0xd6, 0x89, 0x27, 0xc6, 0xd2, 0xf4, 0x64, 0x32, 0xd4, 0x84, 0xe3, 0x1e, 0x08,
+	0x47, 0xac, 0x3d, 0x07, 0x28, 0x90, 0x0b, 0x5c, 0x20, 0x9e, 0x54, 0x47, 0xa8, 0x36, 0x47, 0x89,
+	0xf8, 0x35, 0x7a, 0x09, 0x26, 0xfd, 0x0f, 0x6f, 0x98, 0x0e, 0xef, 0xb3, 0x07, 0xe9, 0x67, 0x8b,
+	0xe2, 0xcf, 0x2e, 0x9b, 0x4e, 0x44, 0xea, 0xed, 0xa7, 0xb4, 0x09, 0x22, 0x7a, 0x89, 0xde, 0x50,
+	0x60, 0x2e, 0xa4, 0xa2, 0xba, 0x55, 0xc1, 0xd5, 0xb2, 0x83, 0x5f, 0x6a, 0x60, 0x97, 0x3b, 0xfb,
+	0x43, 0x14, 0xc6, 0x0b, 0xc9, 0x9a, 0x4a, 0x25, 0x69, 0x81, 0xa0, 0x28, 0xae, 0x19, 0x5d, 0x9a,
+	0x1a, 0xfd, 0x50, 0x81, 0xcb, 0x0c, 0x53, 0x00, 0x51, 0x4e, 0x89, 0xc7, 0x28, 0xda, 0x45, 0x2e,
+	0x5a, 0xf6, 0x35, 0xff, 0xd3, 0x32, 0x1a, 0x5d, 0x74, 0x52, 0x71, 0xa0, 0x2f, 0x29, 0x70, 0x96,
+	0xb7, 0xbc, 0x3c, 0xa0, 0x87, 0x25, 0xb5, 0x7b, 0x91, 0x49, 0x48, 0xd0, 0x6e, 0x01, 0x19, 0xfa,
+	0x0c, 0x9c, 0xf0, 0x95, 0x4c, 0x8c, 0x64, 0x9c, 0x22, 0xb9, 0x28, 0xd6, 0x33, 0x31, 0x84, 0x49,
+	0x12, 0xf3, 0x1e, 0x7d, 0x5e, 0x81, 0xd3, 0x6c, 0xf3, 0x98, 0xa2, 0x0b, 0x36, 0x6d, 0x82, 0x22,
+	0x78, 0x96, 0x8b, 0xc0, 0x17, 0xee, 0xeb, 0xbb, 0x60, 0x9b, 0xa6, 0x2a, 0x09, 0x34, 0xe8, 0xb3,
+	0x30, 0x55, 0xd3, 0x9d, 0x2d, 0xec, 0x94, 0x1d, 0x5c, 0xb1, 0x1d, 0x83, 0x07, 0xe2, 0x08, 0x05,
+	0x31, 0xcf, 0x05, 0xf1, 0xbf, 0x94, 0x59, 0x63, 0xbc, 0x51, 0x04, 0xc7, 0x6a, 0x71, 0x04, 0xe8,
+	0x1b, 0x0a, 0xcc, 0xf2, 0xce, 0x27, 0xe6, 0x43, 0x4b, 0xe7, 0x2e, 0xc8, 0xd1, 0x34, 0xe9, 0xeb,
+	0x3d, 0x26, 0x46, 0x26, 0x7d, 0x15, 0xd0, 0xa2, 0x6f, 0x2b, 0x50, 0xe4, 0x20, 0x24, 0xd8, 0xa9,
+	0x99, 0x96, 0xce, 0xf5, 0x0b, 0x93, 0x31, 0x7e, 0x21, 0x9a, 0x62, 0xb7, 0x04, 0x71, 0xfc, 0x42,
+	0x53, 0x9a, 0x1a, 0xfd, 0x48, 0x81, 0xcb, 0xbc, 0xa3, 0x54, 0xa2, 0x17, 0x3b, 0x46, 0xd1, 0xde,
+	0x92, 0x3c, 0x51, 0x25, 0xb9, 0xb2, 0xb9, 0x66, 0x3a, 0x16, 0x91, 0x06, 0x88, 0x8d, 0xf2, 0x78,
+	0x1a, 0x0d, 0x10, 0x1b, 0xe8, 0x74, 0x53, 0x92, 0x16, 0xfd, 0x59, 0x81, 0xa5, 0x90, 0xc7, 0xc5,
+	0x8f, 0x08, 0x76, 0x2c, 0xbd, 0x5a, 0xe6, 0x20, 0x37, 0x2d, 0x93, 0x98, 0x7c, 0xc5, 0x38, 0x41,
+	0xa1, 0xdf, 0x4b, 0x76, 0xc1, 0x4b, 0x4c, 0x7e, 0x64, 0x3e, 0xa5, 0x40, 0x78, 0x74, 0x42, 0xcf,
+	0x3b, 0xbb, 0x92, 0x80, 0xfe, 0xa0, 0xc0, 0xcd, 0x14, 0xd3, 0x14, 0x79, 0xac, 0x29, 0x3a, 0xc7,
+	0xd5, 0x5d, 0xcc, 0x51, 0xe4, 0xcc, 0x6e, 0x38, 0xbd, 0xb3, 0xa3, 0x77, 0x15, 0x78, 0x2e, 0x6e,
+	0x3a, 0xc9, 0x76, 0x72, 0x92, 0x4e, 0x6c, 0x85, 0x3b, 0x31, 0x21, 0x98, 0x44, 0x7b, 0xb9, 0x8a,
+	0x7b, 0x63, 0xa5, 0x79, 0x00, 0x6f, 0x1e, 0xb6, 0x45, 0x4c, 0xab, 0x81, 0x8d, 0xb2, 0xee, 0x96,
+	0x2d, 0xdc, 0x8c, 0xce, 0x43, 0x8d, 0xc9, 0x03, 0xa2, 0x20, 0x02, 0x71, 0x0b, 0xee, 0x1d, 0xdc,
+	0x8c, 0xc2, 0x2f, 0x36, 0x53, 0x71, 0xa0, 0x5f, 0x2a, 0x70, 0x8d, 0x66, 0x93, 0xe5, 0xca, 0xa6,
+	0x59, 0x35, 0x52, 0xda, 0xcf, 0x29, 0x0a, 0xfd, 0x36, 0x17, 0x3a, 0x4d, 0x25, 0x17, 0x3d, 0xa1,
+	0x69, 0x8c, 0xe6, 0x92, 0x9b, 0x9e, 0x0d, 0xfd, 0x54, 0x81, 0x2b, 0x09, 0x93, 0x10, 0x59, 0xc7,
+	0x69, 0x3a, 0x83, 0xa5, 0xb4, 0x33, 0x10, 0x99, 0xc4, 0x05, 0x37, 0x25, 0x0f, 0xfa, 0xae, 0x02,
+	0x17, 0x85, 0xa8, 0x85, 0x79, 0xfe, 0xd3, 0x14, 0xf6, 0x02, 0x3f, 0x0d, 0xe1, 0x7e, 0x5d, 0x98,
+	0xf8, 0xcf, 0x56, 0x52, 0xd0, 0xa3, 0x1f, 0x28, 0x70, 0x49, 0x08, 0x37, 0xe6, 0x10, 0x79, 0x26,
+	0x46, 0xc9, 0xf9, 0x80, 0x63, 0x8e, 0x93, 0xc5, 0x4a, 0x2a, 0x0e, 0xf4, 0x96, 0x02, 0x17, 0x52,
+	0x6b, 0xc6, 0x59, 0x8a, 0xf8, 0x7f, 0x52, 0x20, 0x16, 0x29, 0xc5, 0xb9, 0x4a, 0x0a, 0x7d, 0x78,
+	0x5b, 0x81, 0x79, 0xf1, 0x02, 0x0b, 0x83, 0xf0, 0x34, 0x45, 0x7b, 0x33, 0xcd, 0xfa, 0x0a, 0x23,
+	0xf1, 0xf9, 0x4a, 0x1a, 0x06, 0xf4, 0xfd, 0x38, 0x95, 0x88, 0x39, 0x34, 0x3f, 0x93, 0x1a, 0xb2,
+	0xf8, 0xf8, 0x7c, 0xbe, 0x92, 0x86, 0x81, 0xe6, 0x66, 0x62, 0xc8, 0x31, 0x99, 0xe4, 0x4c, 0x4c,
+	0x6e, 0x26, 0xc0, 0x1c, 0x93, 0x4e, 0xce, 0x55, 0xd2, 0xb1, 0xd0, 0xa0, 0xe9, 0xa7, 0xe2, 0xbd,
+	0x66, 0x3c, 0xe7, 0x62, 0x82, 0xa6, 0x9f, 0x71, 0xf7, 0x92, 0xea, 0x5c, 0x75, 0x7b, 0x63, 0x45,
+	0xbf, 0x52, 0xe0, 0xba, 0xc4, 0x84, 0x44, 0x36, 0x3a, 0x4b, 0x67, 0x53, 0xea, 0x65, 0x36, 0x22,
+	0x63, 0xbd, 0xec, 0xf6, 0xc0, 0x87, 0x7e, 0xa2, 0xc0, 0xb3, 0x71, 0x13, 0x10, 0x9f, 0x9f, 0xce,
+	0xc7, 0x04, 0x20, 0x21, 0x08, 0xf1, 0x39, 0xea, 0x02, 0x4e, 0xc9, 0x43, 0x1d, 0x4e, 0xa3, 0xee,
+	0x62, 0x87, 0xb4, 0x81, 0xbb, 0x58, 0x77, 0x2a, 0x9b, 0x1d, 0x30, 0xa3, 0xb8, 0x8b, 0x31, 0xd6,
+	0x7b, 0x9f, 0x8a, 0x0b, 0x10, 0xdc, 0xa3, 0xc2, 0xda, 0x5f, 0xe4, 0x58, 0x6f, 0x23, 0x0d, 0x83,
+	0xa8, 0x48, 0xe5, 0x36, 0xdc, 0x3a, 0xb6, 0xb8, 0x07, 0xe6, 0xb9, 0x34, 0x45, 0xaa, 0x7b, 0x81,
+	0x1c, 0x99, 0x22, 0x95, 0x88, 0x58, 0x54, 0xe8, 0x73, 0xb0, 0xdb, 0xa8, 0xf1, 0x20, 0x5e, 0x48,
+	0x53, 0xe8, 0xd3, 0x7c, 0x29, 0x32, 0x85, 0x3e, 0x3e, 0xe9, 0xcd, 0x21, 0x80, 0xf6, 0xc7, 0xd5,
+	0x37, 0x86, 0xe0, 0xac, 0x6c, 0x06, 0xb0, 0x0c, 0xfb, 0x5a, 0xf3, 0x22, 0x3b, 0x75, 0x4c, 0xeb,
+	0xa9, 0xa2, 0xea, 0x6c, 0x20, 0x74, 0x6d, 0xa7, 0x8e, 0xb5, 0xa1, 0x66, 0xc7, 0x13, 0xfa, 0x24,
+	0x1c, 0xaa, 0xeb, 0x8e, 0xb7, 0x0a, 0x9d, 0x8e, 0x6b, 0xc3, 0x66, 0x25, 0xd8, 0x69, 0xae, 0xbc,
+	0x55, 0xca, 0xd1, 0xe1, 0x57, 0x36, 0x6c, 0xed, 0x40, 0x3d, 0x3a, 0x88, 0xae, 0x43, 0x9e, 0xde,
+	0x6a, 0x55, 0x4d, 0x97, 0xd0, 0xe2, 0x6c, 0x61, 0xfe, 0x18, 0xff, 0xda, 0x48, 0x77, 0xb7, 0x56,
+	0x4c, 0x97, 0x68, 0x83, 0x84, 0xfd, 0x85, 0xe6, 0xa1, 0xdf, 0xb4, 0xea, 0x0d, 0x42, 0x4b, 0xb7,
+	0x85, 0xf9, 0x49, 0x01, 0x92, 0x9d, 0xaa, 0xad, 0x1b, 0x9a, 0x4f, 0x8a, 0x74, 0x98, 0x0a, 0xa5,
+	0x6d, 0x65, 0x62, 0x97, 0x2b, 0x55, 0xdb, 0xc5, 0x34, 0x06, 0xda, 0x0d, 0xc2, 0x6a, 0xb9, 0x13,
+	0x91, 0xda, 0xf2, 0x2d, 0x56, 0x8d, 0xd7, 0x26, 0x71, 0xd7, 0xda, 0xaf, 0xd9, 0x8b, 0x1e, 0xff,
+	0x9a, 0xcf, 0x8e, 0x5e, 0x84, 0xa3, 0xed, 0xd2, 0x41, 0x54, 0x7a, 0x2e, 0x49, 0xfa, 0x61, 0x12,
+	0x14, 0x04, 0x42, 0x82, 0x6f, 0xc0, 0x91, 0xf6, 0x29, 0xa5, 0x43, 0x55, 0x1b, 0x96, 0x57, 0xbf,
+	0xf6, 0xca, 0xa7, 0x79, 0xed, 0x70, 0x8b, 0xa2, 0xad, 0x59, 0x0d, 0xab, 0x64, 0xa0, 0x12, 0xe4,
+	0x59, 0xb8, 0xb1, 0x1d, 0x5a, 0xcb, 0x1c, 0x9e, 0x3f, 0xc7, 0x0f, 0x8f, 0x4c, 0x00, 0x3d, 0x86,
+	0x94, 0x02, 0x16, 0xad, 0xcd, 0x8d, 0x4a, 0x30, 0xda, 0xc6, 0xe1, 0xb9, 0xfc, 0x86, 0x83, 0xc7,
+	0xf3, 0x31, 0x7b, 0xb0, 0xec, 0xd3, 0x68, 0x23, 0x2d, 0x36, 0x36, 0x82, 0x34, 0x18, 0xab, 0xea,
+	0xde, 0xb9, 0xd9, 0x4f, 0x09, 0x03, 0xcb, 0xab, 0x92, 0x71, 0x88, 0x91, 0x17, 0xec, 0xe9, 0x41,
+	0x8f, 0x77, 0xb1, 0xc5, 0xaa, 0x51, 0x4e, 0x74, 0x0d, 0x26, 0x6c, 0xc7, 0x7c, 0x68, 0xfa, 0xc1,
+	0x2a, 0xb4, 0x4a, 0x05, 0xba, 0x4a, 0x63, 0x01, 0x41, 0x68, 0x91, 0x8e, 0xc0, 0xa0, 0x69, 0x60,
+	0x8b, 0x98, 0x64, 0x87, 0x56, 0xe5, 0xf2, 0x5a, 0xeb, 0x19, 0x5d, 0x82, 0xb1, 0x0d, 0xd3, 0x71,
+	0x49, 0x54, 0xe6, 0x3e, 0x4a, 0x79, 0x80, 0xbe, 0x0d, 0x09, 0x5c, 0x84, 0x21, 0x07, 0x13, 0x67,
+	0xa7, 0x5c, 0xb7, 0xab, 0x66, 0x65, 0x87, 0x55, 0xb2, 0xa6, 0x04, 0x87, 0x7c, 0xe2, 0xec, 0xac,
+	0x52, 0x3a, 0xad, 0xe0, 0xb4, 0x1f, 0xbc, 0xf6, 0x05, 0x9d, 0x10, 0x5c, 0xab, 0x13, 0x5a, 0x75,
+	0xea, 0xd7, 0x82, 0x47, 0xb4, 0x08, 0xfb, 0xf1, 0xa3, 0xba, 0xe9, 0x2b, 0x8e, 0xdf, 0x18, 0x31,
+	0x92, 0xd8, 0x18, 0x31, 0xdc, 0x66, 0xf1, 0x06, 0xd1, 0x29, 0xd8, 0x57, 0x71, 0x3c, 0x6b, 0x60,
+	0x55, 0x31, 0x5a, 0xb5, 0xc9, 0x6b, 0x43, 0xde, 0x60, 0x50, 0x29, 0x43, 0xff, 0x07, 0x47, 0xfd,
+	0xd9, 0x77, 0x57, 0x10, 0xd7, 0xf5, 0xca, 0x96, 0xbd, 0xb1, 0x31, 0x8e, 0x92, 0x94, 0x7a, 0x9c,
+	0x72, 0x77, 0x16, 0x0f, 0x6f, 0xfa, 0xac, 0xe8, 0x3c, 0xf4, 0xd5, 0x70, 0xcd, 0x66, 0x25, 0x91,
+	0x09, 0xfe, 0x65, 0x29, 0xae, 0xd9, 0x1a, 0x25, 0x43, 0x1a, 0x8c, 0x46, 0xa2, 0x1e, 0xab, 0x6b,
+	0x3c, 0xcd, 0xcf, 0x2f, 0x42, 0x51, 0x4a, 0x1b, 0x71, 0x43, 0x23, 0xe8, 0x3e, 0x8c, 0xd5, 0x1d,
+	0xbc, 0x5d, 0xd6, 0x1b, 0xc4, 0xf6, 0xf4, 0x0f, 0x93, 0x72, 0xdd, 0x36, 0x2d, 0x12, 0x54, 0x2a,
+	0x44, 0xfb, 0xe5, 0x62, 0xb2, 0x4a, 0xe9, 0xb4, 0x03, 0x1e, 0xff, 0x42, 0x83, 0xd8, 0x1d, 0x83,
+	0xe8, 0x12, 0xe4, 0x36, 0xb1, 0x6e, 0x60, 0x87, 0x95, 0x10, 0x8e, 0xf2, 0x1b, 0x63, 0x28, 0x89,
+	0xc6, 0x48, 0xd1, 0x2d, 0x18, 0x71, 0x30, 0xf1, 0x74, 0xce, 0xb6, 0xca, 0x75, 0xec, 0x98, 0xb6,
+	0x31, 0x7e, 0x38, 0x69, 0x75, 0xf7, 0xb7, 0x58, 0x56, 0x29, 0x87, 0xfa, 0x96, 0x02, 0xcf, 0xc8,
+	0x9f, 0xbb, 0x2e, 0x43, 0x8e, 0x59, 0x9d, 0x22, 0x61, 0x75, 0x8c, 0x16, 0x2d, 0xc3, 0x54, 0x7c,
+	0xe1, 0xdd, 0x34, 0x68, 0x8c, 0xc8, 0x6a, 0x93, 0xe2, 0x9a, 0x79, 0xc9, 0x50, 0xdf, 0x54, 0xe0,
+	0x8c, 0x64, 0xfa, 0x76, 0x05, 0x06, 0x02, 0x7f, 0xa3, 0x48, 0xf8, 0x9b, 0x80, 0x78, 0xcf, 0xa0,
+	0xda, 0x30, 0x2d, 0x7d, 0x76, 0x59, 0x84, 0x21, 0xe6, 0xf2, 0xdb, 0xe1, 0x77, 0x58, 0xa0, 0x4a,
+	0xcc, 0xc3, 0xd3, 0xe8, 0x5b, 0x20, 0xed, 0x07, 0xf5, 0xb7, 0x0a, 0x9c, 0x96, 0x69, 0xdf, 0xe8,
+	0x8e, 0xa3, 0x4a, 0xba, 0x38, 0x7a, 0x07, 0xc6, 0x04, 0xb1, 0x2a, 0x93, 0xa4, 0x78, 0x07, 0x5c,
+	0x4e, 0x9c, 0xea, 0xf0, 0x57, 0xd9, 0x2e, 0x7f, 0xa5, 0xbe, 0xa2, 0x80, 0x9a, 0xdc, 0xf9, 0x81,
+	0x66, 0x01, 0x85, 0xbb, 0x01, 0x5a, 0xfd, 0x60, 0x23, 0x6e, 0xd7, 0x12, 0x84, 0x9c, 0x76, 0x26,
+	0xe4, 0xb4, 0x8f, 0x01, 0x04, 0x57, 0xb3, 0xa6, 0x41, 0xd1, 0xe4, 0xb5, 0x3c, 0x1b, 0x29, 0x19,
+	0xea, 0xdf, 0x42, 0xcb, 0x2b, 0xb4, 0x90, 0x74, 0x88, 0xa6, 0x61, 0xa4, 0xfb, 0x46, 0xa8, 0xa5,
+	0x5e, 0xc3, 0x6e, 0xc7, 0x8c, 0x43, 0xd8, 0xb3, 0x21, 0xec, 0x67, 0x61, 0xff, 0xba, 0x69, 0xe9,
+	0xce, 0x4e, 0xb9, 0xb2, 0x89, 0x2b, 0x5b, 0x6e, 0xa3, 0x46, 0x13, 0x9d, 0xbc, 0x36, 0xec, 0x0f,
+	0x2f, 0xb2, 0x51, 0x74, 0x0e, 0x46, 0xbb, 0xef, 0x31, 0xf1, 0x23, 0x3f, 0x89, 0x19, 0xd2, 0x46,
+	0x70, 0xe7, 0xf5, 0x22, 0x7e, 0x44, 0xd4, 0x97, 0xb3, 0x70, 0x4a, 0xa2, 0xa9, 0xe4, 0xb1, 0xcd,
+	0x38, 0x6c, 0x16, 0xd9, 0x1e, 0xcc, 0x02, 0x1d, 0x87, 0xc2, 0xba, 0xee, 0xe2, 0x20, 0x00, 0xfb,
+	0xcb, 0x92, 0xf7, 0x86, 0xfc, 0xb0, 0x3b, 0x09, 0xe0, 0x5d, 0xe1, 0xb2, 0xd7, 0xfd, 0xfe, 0xc2,
+	0x5a, 0xb8, 0xe9, 0xbf, 0x9d, 0x05, 0xb4, 0x61, 0x3b, 0x5b, 0x0c, 0x69, 0xd0, 0x19, 0x98, 0xf3,
+	0xa7, 0xe6, 0xbd, 0xa1, 0x58, 0x1f, 0xf8, 0xe3, 0x68, 0xcc, 0x73, 0x8e, 0xba, 0x6b, 0x5b, 0x2c,
+	0xc3, 0x62, 0x4f, 0xe8, 0x16, 0xf4, 0x57, 0xf4, 0x86, 0x8b, 0x59, 0x32, 0x55, 0x94, 0x6e, 0xdf,
+	0x59, 0xf4, 0xb8, 0x34, 0x9f, 0x59, 0x7d, 0x33, 0x0b, 0x27, 0x13, 0x5b, 0x6a, 0x1e, 0xdb, 0x66,
+	0xdc, 0x0c, 0xe6, 0xe0, 0xef, 0xc2, 0xac, 0x64, 0xc7, 0x4f, 0xe7, 0x0c, 0x3a, 0x7d, 0x72, 0x5f,
+	0x1a, 0x9f, 0xdc, 0xa9, 0xfa, 0xfd, 0x21, 0xd5, 0x0f, 0xed, 0x6f, 0x2e, 0x7e, 0x7f, 0x07, 0xa4,
+	0xf6, 0x77, 0x50, 0xb0, 0xbf, 0x1c, 0x33, 0xcb, 0xf3, 0xcc, 0x4c, 0xfd, 0x7a, 0x0e, 0x4e, 0xcb,
+	0x74, 0x1b, 0xa1, 0x13, 0x50, 0x68, 0x95, 0xec, 0xd9, 0x36, 0xe5, 0x35, 0x08, 0x86, 0x4a, 0x86,
+	0x77, 0x34, 0x6b, 0x11, 0x50, 0x23, 0xc8, 0xc4, 0x1c, 0xcd, 0x5a, 0x9f, 0xa4, 0x47, 0x33, 0xbd,
+	0xe3, 0xc9, 0x53, 0x4d, 0xc3, 0xae, 0xe9, 0xa6, 0xc5, 0x7c, 0x07, 0x7b, 0xea, 0x0e, 0x06, 0x7d,
+	0x3d, 0x1e, 0xaa, 0x72, 0xf2, 0x87, 0xaa, 0x35, 0x98, 0x08, 0x94, 0x30, 0x1a, 0x43, 0x06, 0x92,
+	0x62, 0xc8, 0x58, 0xc0, 0x1b, 0x0a, 0x23, 0x21, 0xa9, 0x2c, 0x44, 0x31, 0xa9, 0x83, 0x29, 0xa4,
+	0xfa, 0x67, 0x29, 0x26, 0x55, 0x1c, 0xec, 0xf2, 0x3d, 0x05, 0xbb, 0x65, 0x18, 0xdd, 0xc4, 0xba,
+	0x43, 0xd6, 0xb1, 0xde, 0x46, 0x07, 0x49, 0xa2, 0x46, 0x5a, 0x3c, 0x6d, 0x39, 0xc9, 0x29, 0x4a,
+	0x21, 0x39, 0x45, 0x89, 0x9c, 0x38, 0x86, 0x7a, 0x39, 0x71, 0xb4, 0x33, 0xd7, 0x7d, 0xd2, 0x99,
+	0xab, 0xfa, 0x17, 0x05, 0xd4, 0xe4, 0xce, 0xb7, 0x0f, 0x2d, 0xb8, 0x77, 0xa6, 0x21, 0x7d, 0xdd,
+	0xc7, 0xa6, 0x17, 0x60, 0x88, 0x9e, 0x3a, 0x03, 0xbf, 0xd5, 0x2f, 0xe1, 0xb7, 0x0a, 0x1e, 0x07,
+	0x7b, 0x50, 0xdf, 0x53, 0xba, 0x5d, 0xc1, 0x1e, 0x67, 0xd6, 0xfc, 0x25, 0xca, 0xa4, 0x70, 0xf7,
+	0xd9, 0xc4, 0x6c, 0xa3, 0xaf, 0x7b, 0x31, 0xd5, 0xdf, 0x29, 0x70, 0x32, 0xb9, 0x1d, 0xa9, 0xd7,
+	0x04, 0xfc, 0xa3, 0x98, 0xd1, 0xcf, 0x32, 0x70, 0x4a, 0xa2, 0xa9, 0xcf, 0x9b, 0x93, 0x81, 0x89,
+	0x6e, 0x56, 0x5d, 0xa9, 0x4d, 0x0a, 0x88, 0x1f, 0xdb, 0x9c, 0xc2, 0x19, 0x52, 0x5f, 0x2f, 0x19,
+	0xd2, 0xae, 0x55, 0xfc, 0xcb, 0x0a, 0xcc, 0xc8, 0xf7, 0xe2, 0xc9, 0xc4, 0xbc, 0xbd, 0x39, 0x82,
+	0xbd, 0xad, 0x40, 0xca, 0xae, 0xbb, 0x64, 0x6c, 0x07, 0x83, 0x34, 0xc8, 0xf7, 0x30, 0xfe, 0x83,
+	0x14, 0xe2, 0xac, 0x04, 0xe2, 0xd7, 0x43, 0x7a, 0x28, 0xaa, 0xcf, 0xf5, 0xaa, 0x87, 0xcb, 0x30,
+	0x55, 0xd5, 0x49, 0x47, 0xf7, 0x49, 0xb8, 0x17, 0xa3, 0xbd, 0xb2, 0x3e, 0x1d, 0x6f, 0x2b, 0xfd,
+	0xb4, 0x89, 0xa3, 0xcf, 0xd9, 0x14, 0xfa, 0xdc, 0x97, 0x68, 0xa3, 0xa1, 0x44, 0x4f, 0x7d, 0x57,
+	0x81, 0xa3, 0x31, 0xfd, 0xae, 0xde, 0xef, 0x81, 0xfc, 0x3e, 0xbf, 0xd6, 0xbe, 0x0d, 0xd0, 0xe7,
+	0x92, 0x81, 0x56, 0xe0, 0x50, 0x2b, 0x90, 0x6f, 0x98, 0x4e, 0x8a, 0x43, 0x2b, 0x62, 0x71, 0xdc,
+	0xeb, 0x67, 0x4d, 0x13, 0x7e, 0x65, 0x36, 0xfb, 0xd3, 0x30, 0x21, 0x6c, 0xa4, 0x8d, 0x9b, 0x8d,
+	0x74, 0xce, 0xae, 0xfe, 0x5a, 0x81, 0xc9, 0xb8, 0x1e, 0xca, 0x3d, 0xf9, 0xca, 0x5e, 0xad, 0x47,
+	0xac, 0x83, 0xfe, 0xb1, 0x02, 0x53, 0x49, 0xbd, 0x98, 0x71, 0xb3, 0x79, 0xac, 0x66, 0x1b, 0x8b,
+	0xfc, 0x9f, 0x03, 0x90, 0xb2, 0xe5, 0x07, 0xcd, 0xc1, 0x41, 0xda, 0x55, 0x14, 0xbe, 0x3c, 0xf6,
+	0xe7, 0x34, 0x6a, 0xe1, 0x66, 0xe8, 0xea, 0x38, 0x52, 0xbf, 0xc9, 0xf4, 0x56, 0xbf, 0x79, 0x52,
+	0x61, 0x91, 0xaf, 0xb0, 0xc8, 0xe8, 0xce, 0x80, 0x84, 0xee, 0xdc, 0x85, 0x31, 0x76, 0x33, 0xce,
+	0x30, 0x9a, 0x16, 0xc1, 0xce, 0xb6, 0x5e, 0x4d, 0x3e, 0xb7, 0x1c, 0x64, 0x8c, 0x14, 0x5e, 0x89,
+	0xb1, 0x75, 0x57, 0x6f, 0xf2, 0xbb, 0xaa, 0xde, 0x74, 0xa4, 0x70, 0x90, 0x26, 0x85, 0x13, 0x97,
+	0x6a, 0x0a, 0x3d, 0x97, 0x6a, 0xda, 0xe7, 0x8c, 0x21, 0xf9, 0x1b, 0xf2, 0xa0, 0x60, 0xb0, 0x6f,
+	0x17, 0x05, 0x83, 0xe1, 0x5d, 0x15, 0x0c, 0x3c, 0x1f, 0x3c, 0x97, 0xb6, 0xef, 0xb0, 0xe5, 0xad,
+	0x94, 0x4e, 0x6f, 0x15, 0x77, 0xbe, 0x59, 0x87, 0xc3, 0xad, 0x5e, 0x85, 0x50, 0xed, 0xd5, 0xb7,
+	0xe3, 0x99, 0xd8, 0x6e, 0x84, 0xee, 0xea, 0xeb, 0x21, 0xcc, 0x1b, 0x56, 0xbf, 0xa3, 0xc0, 0xb4,
+	0x60, 0x26, 0xbc, 0x92, 0x72, 0xb2, 0x79, 0x28, 0x12, 0xe6, 0xd1, 0x91, 0xe9, 0x64, 0x52, 0x64,
+	0x3a, 0xea, 0x07, 0x0a, 0x1c, 0x8b, 0xed, 0x9b, 0xf7, 0x52, 0x3d, 0xd6, 0x95, 0x6f, 0xe9, 0xb5,
+	0x60, 0xa9, 0xc1, 0x1f, 0xba, 0xa3, 0xd7, 0x70, 0xaf, 0x9f, 0xde, 0xb3, 0xa8, 0xd2, 0xd6, 0xf8,
+	0x3e, 0xf9, 0x93, 0xf5, 0x57, 0x79, 0x9b, 0x24, 0xea, 0x13, 0x39, 0x01, 0x05, 0xd6, 0xa9, 0xd3,
+	0xb9, 0x04, 0xfe, 0x10, 0x5d, 0x82, 0x96, 0x53, 0xcf, 0xc8, 0x3b, 0xf5, 0x98, 0x7b, 0x6a, 0xf5,
+	0x2b, 0x0a, 0xcc, 0xa4, 0xe8, 0x8d, 0x6a, 0xdf, 0xa7, 0x2a, 0x5d, 0xf7, 0xa9, 0xbd, 0xee, 0x4c,
+	0x1c, 0xb4, 0x32, 0xa7, 0x0a, 0x26, 0xec, 0x04, 0x11, 0x01, 0x8b, 0x31, 0x51, 0xf5, 0x53, 0x9c,
+	0x7e, 0x0c, 0x7e, 0x27, 0x47, 0x4f, 0xe2, 0x7f, 0x91, 0x81, 0xe7, 0x77, 0xd7, 0xdf, 0xbe, 0x67,
+	0x36, 0xdb, 0xbe, 0x6b, 0xcc, 0x74, 0xdd, 0x35, 0xde, 0x07, 0x14, 0x6d, 0x9f, 0x61, 0xfe, 0xe9,
+	0x8c, 0x64, 0x97, 0xcc, 0x68, 0xa4, 0x1b, 0xc6, 0xbb, 0xbc, 0xa9, 0xd8, 0x16, 0x71, 0xec, 0x2a,
+	0x35, 0x94, 0x21, 0x2d, 0x78, 0x44, 0x45, 0x38, 0x10, 0x6a, 0x09, 0xb4, 0xad, 0xaa, 0x7f, 0xb2,
+	0x18, 0xd4, 0x46, 0xbb, 0x3a, 0xf5, 0xee, 0x5a, 0xd5, 0x1d, 0xf5, 0xb5, 0x2c, 0xdc, 0xd8, 0x45,
+	0xff, 0x3c, 0xba, 0xdf, 0xe9, 0xb7, 0x87, 0x05, 0xbf, 0x4e, 0x91, 0x92, 0xdc, 0x75, 0x6d, 0xbe,
+	0x47, 0xe7, 0x61, 0xe1, 0x1d, 0x30, 0x7f, 0x5f, 0xfa, 0x76, 0xbb, 0x2f, 0xb3, 0x80, 0xc2, 0x5d,
+	0x8b, 0xac, 0xc2, 0x92, 0xd5, 0x46, 0xcc, 0x2e, 0x25, 0xf4, 0xaf, 0xe0, 0x82, 0x5d, 0xcc, 0x75,
+	0xed, 0xa2, 0xfa, 0x7b, 0x05, 0xae, 0xf6, 0xd8, 0xfc, 0x2f, 0xc0, 0xa0, 0x08, 0x30, 0x7c, 0xb8,
+	0x8a, 0xab, 0x7e, 0x31, 0x0b, 0x57, 0x7b, 0x6c, 0xd0, 0xfc, 0x4f, 0xb5, 0xd5, 0x50, 0xc4, 0xe9,
+	0x13, 0x47, 0x9c, 0x7e, 0xf9, 0x88, 0x23, 0x54, 0x1d, 0x91, 0x03, 0x18, 0x10, 0x39, 0x80, 0x97,
+	0xb3, 0x70, 0xb9, 0x97, 0x26, 0x53, 0x39, 0xcb, 0x97, 0x92, 0xfc, 0xc4, 0xf2, 0xdb, 0x96, 0xff,
+	0x57, 0x05, 0x2e, 0xa4, 0x6d, 0x98, 0xfd, 0xb7, 0x36, 0x79, 0x71, 0xac, 0x52, 0x7f, 0xa3, 0xc0,
+	0xf9, 0x54, 0x4d, 0xb6, 0x7b, 0xe6, 0x02, 0xb8, 0xa7, 0x9e, 0xcc, 0xee, 0x4e, 0x3d, 0x7f, 0x1c,
+	0x84, 0x4b, 0x3d, 0xfc, 0x5a, 0xa8, 0x63, 0x3b, 0x94, 0xae, 0xed, 0x38, 0x01, 0x85, 0xd6, 0x76,
+	0x30, 0x9d, 0xcf, 0x6b, 0x10, 0x0c, 0xf1, 0xae, 0x40, 0xb2, 0x7b, 0x70, 0x05, 0xd2, 0x6b, 0x3d,
+	0xb4, 0x7f, 0x6f, 0xaf, 0x40, 0x72, 0x8f, 0xf5, 0x0a, 0x64, 0xa0, 0xe7, 0x2b, 0x90, 0x07, 0xc0,
+	0xfa, 0x74, 0x99, 0x44, 0x56, 0x46, 0xf4, 0x9b, 0x1c, 0xce, 0xc4, 0x34, 0xfb, 0x52, 0x29, 0xac,
+	0x98, 0x38, 0x5a, 0x0f, 0x0f, 0x75, 0x1a, 0x49, 0xbe, 0xdb, 0x9f, 0xcb, 0xa8, 0x3c, 0x48, 0xa8,
+	0x7c, 0x05, 0xc6, 0x3b, 0xd4, 0xa9, 0xec, 0xe0, 0x46, 0x1b, 0x7e, 0x81, 0xc2, 0x9f, 0x89, 0x55,
+	0x9c, 0x92, 0xa1, 0xe1, 0x46, 0x80, 0x57, 0x3b, 0xd4, 0xe4, 0x0d, 0x47, 0xca, 0xab, 0xfb, 0x7a,
+	0x29, 0xaf, 0x46, 0x3a, 0x2e, 0x87, 0x39, 0x1d, 0x97, 0xed, 0x93, 0xe2, 0xfe, 0xf4, 0x77, 0x23,
+	0x23, 0xbb, 0xb8, 0x1b, 0x19, 0xdd, 0x5d, 0x33, 0xe5, 0x75, 0x28, 0x18, 0xb8, 0xaa, 0xef, 0xf8,
+	0xaa, 0x99, 0xdc, 0x19, 0x0a, 0x94, 0x9a, 0xaa, 0xa2, 0xfa, 0x6a, 0x16, 0x2e, 0xa4, 0xfd, 0x35,
+	0xdf, 0x47, 0xef, 0x5e, 0x56, 0x82, 0x3c, 0xc1, 0xaf, 0xd4, 0x5d, 0x49, 0xfd, 0x53, 0xb4, 0xae,
+	0xf4, 0xa0, 0xc3, 0x50, 0xfa, 0xbb, 0x0d, 0x85, 0x1f, 0x04, 0x73, 0x82, 0x20, 0xb8, 0x47, 0x77,
+	0x99, 0xea, 0x3b, 0x19, 0x98, 0x4d, 0xf3, 0x53, 0x45, 0xe1, 0x7e, 0xf0, 0xa3, 0x6f, 0x66, 0xb7,
+	0xd1, 0x77, 0xaf, 0x76, 0x91, 0xbf, 0xba, 0x7d, 0x82, 0xd5, 0x6d, 0x5b, 0x67, 0xbf, 0xfc, 0x3d,
+	0xce, 0x07, 0x19, 0x48, 0xf9, 0x23, 0xca, 0x8f, 0xc7, 0x62, 0xf2, 0xca, 0x52, 0xfd, 0xdc, 0xb2,
+	0x54, 0xbb, 0x9f, 0x22, 0x27, 0xdf, 0x4f, 0xa1, 0xfe, 0x3d, 0x03, 0xe7, 0xf6, 0xc2, 0xa3, 0x7c,
+	0x4c, 0x17, 0xbd, 0xa3, 0x62, 0x90, 0x4b, 0x51, 0x31, 0x50, 0xff, 0x91, 0x81, 0xf3, 0xa9, 0x7e,
+	0xd3, 0xfa, 0x64, 0xe1, 0x23, 0x0b, 0x1f, 0x5c, 0x89, 0xe6, 0xd2, 0xdc, 0x93, 0x7f, 0x2e, 0x2b,
+	0x5a, 0x78, 0x51, 0x0f, 0xcc, 0x93, 0x85, 0x8f, 0x6d, 0xc1, 0xc9, 0xf5, 0xd2, 0xbb, 0xff, 0xf3,
+	0x0c, 0xcc, 0xa5, 0xfc, 0xad, 0xf1, 0x93, 0x7d, 0xe8, 0xda, 0x87, 0x19, 0x02, 0xfb, 0xe9, 0x9f,
+	0xcb, 0x66, 0x95, 0x60, 0x87, 0x7e, 0xea, 0x18, 0x4c, 0x2c, 0x3d, 0x58, 0xba, 0xb3, 0x56, 0x5e,
+	0x2e, 0xad, 0xac, 0x2d, 0x69, 0xe5, 0xb5, 0xff, 0x5f, 0x5d, 0x2a, 0x97, 0xee, 0x3c, 0x58, 0x58,
+	0x29, 0xdd, 0x1a, 0x79, 0x0a, 0x9d, 0x80, 0xa3, 0xd1, 0xd7, 0x0b, 0x2b, 0x2b, 0x65, 0x3a, 0x3a,
+	0xa2, 0xa0, 0x93, 0x70, 0x2c, 0x4a, 0xb0, 0xb8, 0x72, 0xf7, 0xde, 0x12, 0x23, 0xc9, 0xdc, 0x5c,
+	0x7f, 0xe7, 0xfd, 0xe3, 0xca, 0x7b, 0xef, 0x1f, 0x57, 0xfe, 0xf4, 0xfe, 0x71, 0x05, 0x0e, 0x57,
+	0xec, 0x1a, 0x6f, 0x3d, 0x6e, 0x0e, 0x2e, 0xd4, 0xcd, 0x55, 0xc7, 0x26, 0xf6, 0xaa, 0xf2, 0x89,
+	0xb9, 0x87, 0x26, 0xd9, 0x6c, 0xac, 0x17, 0x2b, 0x76, 0x6d, 0xae, 0xeb, 0xbf, 0xe7, 0x16, 0x1f,
+	0x62, 0xcb, 0xff, 0x7f, 0xbd, 0xec, 0x1f, 0xe9, 0xde, 0xd0, 0xeb, 0xe6, 0xf6, 0xc5, 0xf5, 0x1c,
+	0x1d, 0xbb, 0xf4, 0xaf, 0x01, 0x00, 0x1f, 0x08, 0xc6, 0xa9, 0x2b, 0x58, 0x00, 0x00,
 }
 
 func (m *History) Marshal() (dAtA []byte, err error) {
@@ -5657,6 +5814,52 @@ func (m *HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) MarshalToSi
 	}
 	return len(dAtA) - i, nil
 }
+func (m *HistoryEvent_WorkflowExecutionSuspendedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionSuspendedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionSuspendedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionSuspendedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionResumedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionResumedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionResumedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionResumedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
 func (m *WorkflowExecutionStartedEventAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5681,6 +5884,20 @@ func (m *WorkflowExecutionStartedEventAttributes) MarshalToSizedBuffer(dAtA []by
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RetentionPeriod != nil {
+		{
+			size, err := m.RetentionPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.Header != nil {
 		{
 			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
@@ -7540,6 +7757,88 @@ func (m *WorkflowExecutionTerminatedEventAttributes) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowExecutionSuspendedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionSuspendedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionSuspendedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionResumedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionResumedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionResumedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9272,17 +9571,41 @@ func (m *HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) Size() (n i
 	}
 	return n
 }
-func (m *WorkflowExecutionStartedEventAttributes) Size() (n int) {
+func (m *HistoryEvent_WorkflowExecutionSuspendedEventAttributes) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovHistory(uint64(l))
+	if m.WorkflowExecutionSuspendedEventAttributes != nil {
+		l = m.WorkflowExecutionSuspendedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
 	}
-	if m.ParentExecutionInfo != nil {
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionResumedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionResumedEventAttributes != nil {
+		l = m.WorkflowExecutionResumedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *WorkflowExecutionStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ParentExecutionInfo != nil {
 		l = m.ParentExecutionInfo.Size()
 		n += 1 + l + sovHistory(uint64(l))
 	}
@@ -9364,6 +9687,10 @@ func (m *WorkflowExecutionStartedEventAttributes) Size() (n int) {
 		l = m.Header.Size()
 		n += 2 + l + sovHistory(uint64(l))
 	}
+	if m.RetentionPeriod != nil {
+		l = m.RetentionPeriod.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10102,6 +10429,46 @@ func (m *WorkflowExecutionTerminatedEventAttributes) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowExecutionSuspendedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionResumedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12298,6 +12665,76 @@ func (m *HistoryEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.Attributes = &HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{v}
 			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionSuspendedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionSuspendedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionSuspendedEventAttributes{v}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionResumedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionResumedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionResumedEventAttributes{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -13087,6 +13524,42 @@ func (m *WorkflowExecutionStartedEventAttributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionPeriod == nil {
+				m.RetentionPeriod = &types.Duration{}
+			}
+			if err := m.RetentionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -17749,6 +18222,236 @@ func (m *WorkflowExecutionTerminatedEventAttributes) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
+func (m *WorkflowExecutionSuspendedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionSuspendedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionSuspendedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionResumedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionResumedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionResumedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,231 +28,237 @@ package apiv1
 var yarpcFileDescriptorClosure8237ca6511ad6c62 = [][]byte{
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-		0xd5, 0xef, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0xbf, 0xce, 0x24,
-		0x4d, 0x5c, 0xc7, 0x59, 0x27, 0x4e, 0x9a, 0x7c, 0x69, 0xbe, 0xb6, 0x38, 0x8e, 0xad, 0xae, 0x64,
-		0x92, 0x68, 0xe2, 0xa4, 0x80, 0x90, 0x96, 0xf1, 0xcc, 0x75, 0x3c, 0xf2, 0xee, 0xcc, 0x76, 0x66,
-		0xd6, 0x1b, 0x23, 0xf1, 0xc4, 0x03, 0x12, 0x6a, 0x05, 0x55, 0x85, 0x44, 0x05, 0x08, 0x84, 0x04,
-		0x6a, 0x11, 0x52, 0x11, 0x08, 0x01, 0xe2, 0x05, 0x90, 0x10, 0x48, 0xa0, 0xc2, 0x13, 0x2f, 0x3c,
-		0xc0, 0x03, 0x0f, 0xf4, 0x8d, 0x07, 0xca, 0x1b, 0x12, 0x9a, 0x3b, 0x77, 0x76, 0x77, 0x66, 0xee,
-		0x9d, 0xb9, 0xb3, 0xde, 0xb4, 0xa0, 0xe6, 0xcd, 0x73, 0xe7, 0xdc, 0x33, 0xbf, 0x7b, 0xee, 0x39,
-		0xe7, 0x9e, 0x7b, 0xce, 0x59, 0xc3, 0xa9, 0xd6, 0x06, 0xb6, 0x17, 0x34, 0x55, 0xc7, 0xa6, 0x86,
-		0x17, 0xd4, 0xa6, 0xb1, 0xb0, 0x73, 0x69, 0x61, 0xcb, 0x70, 0x5c, 0xcb, 0xde, 0xad, 0x34, 0x6d,
-		0xcb, 0xb5, 0xd0, 0x01, 0x8f, 0xa4, 0x42, 0x49, 0x2a, 0x6a, 0xd3, 0xa8, 0xec, 0x5c, 0x3a, 0x72,
-		0xe2, 0xa1, 0x65, 0x3d, 0xac, 0xe3, 0x05, 0x42, 0xb2, 0xd1, 0xda, 0x5c, 0xd0, 0x5b, 0xb6, 0xea,
-		0x1a, 0x96, 0xe9, 0x4f, 0x3a, 0x72, 0x32, 0xfa, 0xde, 0x35, 0x1a, 0xd8, 0x71, 0xd5, 0x46, 0x93,
-		0x12, 0xcc, 0xb0, 0x3e, 0xac, 0x59, 0x8d, 0x46, 0x87, 0x85, 0xcc, 0xa2, 0x70, 0x55, 0x67, 0xbb,
-		0x6e, 0x38, 0x6e, 0x12, 0x4d, 0xdb, 0xb2, 0xb7, 0x37, 0xeb, 0x56, 0xdb, 0xa7, 0x91, 0x6f, 0x41,
-		0xf1, 0x25, 0x7f, 0x41, 0xe8, 0x3a, 0x14, 0xf0, 0x0e, 0x36, 0x5d, 0x67, 0x4a, 0x9a, 0xc9, 0xcf,
-		0x96, 0x17, 0x4f, 0x55, 0x18, 0x6b, 0xab, 0x50, 0xea, 0x15, 0x8f, 0x52, 0xa1, 0x13, 0xe4, 0xf7,
-		0xae, 0xc1, 0x68, 0xef, 0x0b, 0x34, 0x0d, 0x23, 0xe4, 0x55, 0xcd, 0xd0, 0xa7, 0xa4, 0x19, 0x69,
-		0x36, 0xaf, 0x14, 0xc9, 0x73, 0x55, 0x47, 0xd7, 0x01, 0xfc, 0x57, 0xde, 0xa2, 0xa7, 0x72, 0x33,
-		0xd2, 0x6c, 0x79, 0xf1, 0x48, 0xc5, 0x97, 0x48, 0x25, 0x90, 0x48, 0x65, 0x3d, 0x90, 0x88, 0x52,
-		0x22, 0xd4, 0xde, 0x33, 0x9a, 0x82, 0xe2, 0x0e, 0xb6, 0x1d, 0xc3, 0x32, 0xa7, 0xf2, 0x3e, 0x53,
-		0xfa, 0x88, 0x0e, 0x43, 0xd1, 0x5b, 0xbc, 0xf7, 0xb9, 0x21, 0xf2, 0xa6, 0xe0, 0x3d, 0x56, 0x75,
-		0xf4, 0x75, 0x09, 0xce, 0x07, 0x4b, 0xae, 0xe1, 0x47, 0x58, 0x6b, 0x79, 0xfb, 0x50, 0x73, 0x5c,
-		0xd5, 0x76, 0xb1, 0x5e, 0xf3, 0x91, 0xa8, 0xae, 0x6b, 0x1b, 0x1b, 0x2d, 0x17, 0x3b, 0x53, 0xc3,
-		0x04, 0xcf, 0xff, 0x33, 0x97, 0xfe, 0x32, 0xe5, 0xb3, 0x12, 0xb0, 0xb9, 0xe7, 0x73, 0x21, 0x4b,
-		0x5e, 0xea, 0xf0, 0x78, 0xe9, 0x29, 0xe5, 0x5c, 0x5b, 0x8c, 0x14, 0x7d, 0x5b, 0x82, 0x0b, 0x0c,
-		0x78, 0x9a, 0xd5, 0x68, 0xd6, 0x31, 0x13, 0x60, 0x81, 0x00, 0x7c, 0x41, 0x0c, 0xe0, 0x72, 0xc0,
-		0x27, 0x0e, 0xf1, 0x99, 0xb6, 0x28, 0x31, 0x7a, 0x53, 0x82, 0x39, 0x06, 0xc8, 0x4d, 0xd5, 0xa8,
-		0xb3, 0x10, 0x16, 0x09, 0xc2, 0x1b, 0x62, 0x08, 0x57, 0x09, 0x93, 0x38, 0xbc, 0xb3, 0x6d, 0x21,
-		0x4a, 0xf4, 0x2d, 0xb6, 0x00, 0x3d, 0xdd, 0xd2, 0x6b, 0x56, 0xcb, 0x8d, 0xc3, 0x1b, 0x21, 0xf0,
-		0x9e, 0x17, 0x83, 0xe7, 0xa9, 0x9d, 0x7e, 0xa7, 0xe5, 0xc6, 0x01, 0xce, 0xb6, 0x05, 0x69, 0xd1,
-		0x1b, 0x12, 0xcc, 0xea, 0x58, 0x33, 0x1c, 0x02, 0xcc, 0xd3, 0x52, 0x47, 0xdb, 0xc2, 0x7a, 0x8b,
-		0x29, 0xbc, 0x12, 0x41, 0x77, 0x9d, 0x89, 0xee, 0x16, 0x65, 0xb2, 0xae, 0x3a, 0xdb, 0xf7, 0x02,
-		0x16, 0x71, 0x64, 0x67, 0x74, 0x01, 0x3a, 0xf4, 0x9a, 0x04, 0x67, 0x23, 0xa8, 0x78, 0x36, 0x01,
-		0x04, 0xd3, 0xb5, 0x74, 0x4c, 0x3c, 0x73, 0x90, 0xf5, 0x54, 0x2a, 0x86, 0x94, 0x12, 0x8c, 0xa0,
-		0x2c, 0x28, 0xa5, 0x04, 0xfd, 0x0f, 0x49, 0x89, 0xab, 0xfa, 0xaf, 0xc7, 0x50, 0x25, 0x68, 0xd6,
-		0x28, 0x41, 0xf5, 0x7f, 0xa9, 0xa8, 0xf8, 0x4a, 0x75, 0x5a, 0x4f, 0x27, 0x43, 0x5f, 0x94, 0xe0,
-		0xe9, 0x30, 0x26, 0x9e, 0x25, 0xee, 0x23, 0x80, 0xae, 0xa6, 0x02, 0xe2, 0x19, 0xe1, 0x29, 0x3d,
-		0x8d, 0x88, 0x6c, 0x9b, 0xaa, 0xb9, 0xc6, 0x8e, 0xe1, 0xee, 0xa6, 0x2a, 0xf7, 0x58, 0xc2, 0xb6,
-		0x2d, 0x51, 0x26, 0x69, 0xca, 0xad, 0x0a, 0xd0, 0x11, 0xe5, 0x8e, 0xa0, 0xe2, 0x29, 0xf7, 0xfe,
-		0x04, 0xe5, 0x0e, 0x61, 0xe2, 0x2a, 0xb7, 0x9a, 0x4a, 0xc5, 0x90, 0x52, 0x82, 0x72, 0x8f, 0x0b,
-		0x4a, 0x29, 0x49, 0xb9, 0x55, 0x01, 0x3a, 0xa2, 0x48, 0x61, 0x54, 0x3c, 0x45, 0x9a, 0x48, 0x50,
-		0xa4, 0x5e, 0x48, 0x5c, 0x45, 0x52, 0xd3, 0x88, 0x88, 0xa5, 0x85, 0xc1, 0x24, 0x58, 0x1a, 0x4a,
-		0xb0, 0xb4, 0x5e, 0x3c, 0x09, 0x96, 0xa6, 0xa6, 0x93, 0xa1, 0x36, 0x9c, 0xf0, 0x40, 0xd8, 0x7c,
-		0xed, 0x39, 0x40, 0x80, 0x5c, 0x64, 0x02, 0xf1, 0xb8, 0xda, 0x5c, 0xb5, 0x39, 0xea, 0xf2, 0x5f,
-		0xa3, 0x57, 0xe0, 0x98, 0xff, 0xe1, 0x4d, 0xc3, 0x66, 0x7d, 0xf6, 0x20, 0xf9, 0x6c, 0x85, 0xff,
-		0xd9, 0x55, 0x6f, 0x5e, 0xfc, 0xa3, 0xd3, 0x2e, 0xef, 0x25, 0xfa, 0xae, 0x04, 0x0b, 0x11, 0x15,
-		0x55, 0x4d, 0x0d, 0xd7, 0x6b, 0x36, 0x7e, 0xa5, 0x85, 0x1d, 0xe6, 0xea, 0x0f, 0x11, 0x18, 0x2f,
-		0xa6, 0x6b, 0x2a, 0xe1, 0xa4, 0x04, 0x8c, 0xe2, 0xb8, 0xe6, 0x54, 0x61, 0x6a, 0xf4, 0x23, 0x09,
-		0xae, 0x50, 0x4c, 0x01, 0x44, 0x31, 0x25, 0x9e, 0x24, 0x68, 0x97, 0x99, 0x68, 0xe9, 0xd7, 0xfc,
-		0x4f, 0x8b, 0x68, 0x74, 0xc5, 0xce, 0x34, 0x03, 0x7d, 0x59, 0x82, 0x73, 0x2c, 0xf1, 0xb2, 0x80,
-		0x1e, 0x16, 0xd4, 0xee, 0x65, 0xca, 0x21, 0x45, 0xbb, 0x39, 0x64, 0xe8, 0xb3, 0x70, 0xd2, 0x57,
-		0x32, 0x3e, 0x92, 0x29, 0x82, 0xe4, 0x12, 0x5f, 0xcf, 0xf8, 0x10, 0x7c, 0x05, 0xe6, 0x7d, 0xfb,
-		0x0b, 0x12, 0x9c, 0xa1, 0x9b, 0x47, 0x15, 0x9d, 0xb3, 0x69, 0xd3, 0x04, 0xc1, 0xb3, 0x4c, 0x04,
-		0x3e, 0x73, 0x5f, 0xdf, 0x39, 0xdb, 0x34, 0xa3, 0xa5, 0xd0, 0xa0, 0xcf, 0xc1, 0x4c, 0x43, 0xb5,
-		0xb7, 0xb1, 0x5d, 0xb3, 0xb1, 0x66, 0xd9, 0x3a, 0x0b, 0xc4, 0x11, 0x02, 0x62, 0x91, 0x09, 0xe2,
-		0xe3, 0x64, 0xb2, 0x42, 0xe7, 0xc6, 0x11, 0x1c, 0x6f, 0x24, 0x11, 0xa0, 0x6f, 0x4a, 0x30, 0xcf,
-		0xba, 0x9f, 0x18, 0x0f, 0x4d, 0x95, 0x29, 0x90, 0xa3, 0x59, 0xc2, 0xd7, 0x7b, 0x94, 0x8d, 0x48,
-		0xf8, 0xca, 0xa1, 0x45, 0xdf, 0x91, 0xa0, 0xc2, 0x8a, 0xb0, 0xb1, 0xdd, 0x30, 0x4c, 0x95, 0xe9,
-		0x17, 0x8e, 0x25, 0xf8, 0x85, 0x78, 0x88, 0xdd, 0x61, 0xc4, 0xf0, 0x0b, 0x6d, 0x61, 0x6a, 0xf4,
-		0x63, 0x09, 0xae, 0xb0, 0xae, 0x52, 0xa9, 0x5e, 0xec, 0x38, 0x41, 0x7b, 0x4b, 0xf0, 0x46, 0x95,
-		0xe6, 0xca, 0x16, 0xda, 0xd9, 0xa6, 0xf0, 0x34, 0x80, 0x6f, 0x94, 0x27, 0xb2, 0x68, 0x00, 0xdf,
-		0x40, 0x67, 0xdb, 0x82, 0xb4, 0xe8, 0x6f, 0x12, 0xac, 0x44, 0x3c, 0x2e, 0x7e, 0xe4, 0x62, 0xdb,
-		0x54, 0xeb, 0x35, 0x06, 0x72, 0xc3, 0x34, 0x5c, 0x83, 0xad, 0x18, 0x27, 0x09, 0xf4, 0x7b, 0xe9,
-		0x2e, 0x78, 0x85, 0xf2, 0x8f, 0xad, 0xa7, 0x1a, 0x30, 0x8f, 0x2f, 0xe8, 0x05, 0x7b, 0x4f, 0x1c,
-		0xd0, 0x9f, 0x25, 0xb8, 0x99, 0x61, 0x99, 0x3c, 0x8f, 0x35, 0x43, 0xd6, 0x78, 0x77, 0x0f, 0x6b,
-		0xe4, 0x39, 0xb3, 0x1b, 0x76, 0xff, 0xd3, 0xd1, 0xbb, 0x12, 0x3c, 0x9f, 0xb4, 0x9c, 0x74, 0x3b,
-		0x39, 0x45, 0x16, 0xb6, 0xc6, 0x5c, 0x18, 0x17, 0x4c, 0xaa, 0xbd, 0x5c, 0xc3, 0xfd, 0x4d, 0x25,
-		0x71, 0x00, 0x33, 0x75, 0x62, 0xba, 0x86, 0xd9, 0xc2, 0x7a, 0x4d, 0x75, 0x6a, 0x26, 0x6e, 0xc7,
-		0xd7, 0x21, 0x27, 0xc4, 0x01, 0x8c, 0x0c, 0x0a, 0x65, 0xb7, 0xe4, 0xdc, 0xc6, 0x6d, 0x46, 0x1c,
-		0xd0, 0xce, 0x34, 0x03, 0xfd, 0x4a, 0x82, 0xeb, 0x24, 0x9a, 0xac, 0x69, 0x5b, 0x46, 0x5d, 0xcf,
-		0x68, 0x3f, 0xa7, 0x09, 0xf4, 0x97, 0x98, 0xd0, 0x49, 0x28, 0xb9, 0xec, 0x31, 0xcd, 0x62, 0x34,
-		0x97, 0x9d, 0xec, 0xd3, 0xd0, 0xcf, 0x24, 0xb8, 0x9a, 0xb2, 0x08, 0x9e, 0x75, 0x9c, 0x21, 0x2b,
-		0x58, 0xc9, 0xba, 0x02, 0x9e, 0x49, 0x5c, 0x74, 0x32, 0xce, 0x41, 0xdf, 0x97, 0xe0, 0x12, 0x17,
-		0x35, 0x37, 0xce, 0x7f, 0x9a, 0xc0, 0x5e, 0x62, 0x87, 0x21, 0xcc, 0xaf, 0x73, 0x03, 0xff, 0x79,
-		0x2d, 0x03, 0x3d, 0xfa, 0xa1, 0x04, 0x97, 0xb9, 0x70, 0x13, 0x2e, 0x91, 0x67, 0x13, 0x94, 0x9c,
-		0x0d, 0x38, 0xe1, 0x3a, 0x59, 0xd1, 0x32, 0xcd, 0x40, 0x6f, 0x4b, 0x70, 0x31, 0xb3, 0x66, 0x9c,
-		0x23, 0x88, 0x3f, 0x96, 0x01, 0x31, 0x4f, 0x29, 0xce, 0x6b, 0x19, 0xf4, 0xe1, 0x1d, 0x09, 0x16,
-		0xf9, 0x02, 0xe6, 0x1e, 0xc2, 0xb3, 0x04, 0xed, 0xcd, 0x2c, 0xf2, 0xe5, 0x9e, 0xc4, 0x17, 0xb4,
-		0x2c, 0x13, 0xd0, 0x0f, 0x92, 0x54, 0x22, 0xe1, 0xd2, 0xfc, 0x4c, 0x66, 0xc8, 0xfc, 0xeb, 0x33,
-		0x07, 0x32, 0xef, 0x22, 0xed, 0xc5, 0x66, 0x7c, 0xc8, 0x09, 0x91, 0xe4, 0x5c, 0x42, 0x6c, 0xc6,
-		0xc1, 0x9c, 0x10, 0x4e, 0x2e, 0x68, 0xd9, 0xa6, 0x90, 0x43, 0xd3, 0x0f, 0xc5, 0xfb, 0x8d, 0x78,
-		0xce, 0x27, 0x1c, 0x9a, 0x7e, 0xc4, 0xdd, 0x4f, 0xa8, 0x73, 0xcd, 0xe9, 0x6f, 0x2a, 0xfa, 0xb5,
-		0x04, 0xcf, 0x09, 0x2c, 0x88, 0x67, 0xa3, 0xf3, 0x64, 0x35, 0xd5, 0x7e, 0x56, 0xc3, 0x33, 0xd6,
-		0x2b, 0x4e, 0x1f, 0xf3, 0xd0, 0x4f, 0x25, 0x78, 0x36, 0x69, 0x01, 0xfc, 0xfb, 0xd3, 0x85, 0x84,
-		0x03, 0x88, 0x0b, 0x82, 0x7f, 0x8f, 0xba, 0x88, 0x33, 0xce, 0x21, 0x0e, 0xa7, 0xd5, 0x74, 0xb0,
-		0xed, 0x76, 0x81, 0x3b, 0x58, 0xb5, 0xb5, 0xad, 0x1e, 0x98, 0x71, 0xdc, 0x95, 0x04, 0xeb, 0xbd,
-		0x4f, 0xd8, 0x05, 0x08, 0xee, 0x11, 0x66, 0xdd, 0x2f, 0x32, 0xac, 0xb7, 0x95, 0x65, 0xc2, 0xcd,
-		0x51, 0x80, 0x2e, 0x10, 0xf9, 0xaf, 0x65, 0x38, 0x27, 0x7a, 0x7a, 0xad, 0xc2, 0xbe, 0xce, 0x1a,
-		0xdd, 0xdd, 0x26, 0x26, 0xb5, 0x40, 0x5e, 0x65, 0x31, 0x60, 0xba, 0xbe, 0xdb, 0xc4, 0xca, 0x68,
-		0xbb, 0xe7, 0x09, 0x7d, 0x1a, 0x0e, 0x35, 0x55, 0xdb, 0x93, 0x48, 0xaf, 0xd1, 0x6d, 0x5a, 0xb4,
-		0x7c, 0x38, 0xcb, 0xe4, 0x77, 0x97, 0xcc, 0xe8, 0xb1, 0x89, 0x4d, 0x4b, 0x39, 0xd0, 0x8c, 0x0f,
-		0xa2, 0xe7, 0xa0, 0x44, 0x32, 0x32, 0x75, 0xc3, 0x71, 0x49, 0x61, 0xb1, 0xbc, 0x78, 0x9c, 0x9d,
-		0xf2, 0x50, 0x9d, 0xed, 0x35, 0xc3, 0x71, 0x95, 0x11, 0x97, 0xfe, 0x85, 0x16, 0x61, 0xd8, 0x30,
-		0x9b, 0x2d, 0x97, 0x94, 0x1d, 0xcb, 0x8b, 0xc7, 0x38, 0x48, 0x76, 0xeb, 0x96, 0xaa, 0x2b, 0x3e,
-		0x29, 0x52, 0x61, 0x26, 0x12, 0x72, 0xd4, 0x5c, 0xab, 0xa6, 0xd5, 0x2d, 0x07, 0x13, 0xff, 0x6d,
-		0xb5, 0x5c, 0x5a, 0x87, 0x9c, 0x8e, 0xd5, 0x45, 0x6f, 0xd1, 0x4a, 0xb2, 0x72, 0x0c, 0x87, 0x64,
-		0xbf, 0x6e, 0x2d, 0x7b, 0xf3, 0xd7, 0xfd, 0xe9, 0xe8, 0x65, 0x38, 0xda, 0x4d, 0x7b, 0xc7, 0xb9,
-		0x17, 0xd2, 0xb8, 0x1f, 0x76, 0x83, 0x64, 0x76, 0x84, 0xf1, 0x0d, 0x38, 0xd2, 0x8d, 0xb0, 0xbb,
-		0xab, 0xb0, 0x5b, 0x66, 0xcd, 0xd0, 0x49, 0xe9, 0xaf, 0xa4, 0x1c, 0xee, 0x50, 0x74, 0xe4, 0xac,
-		0xb4, 0xcc, 0xaa, 0x8e, 0xaa, 0x50, 0xa2, 0xae, 0xd2, 0xb2, 0x49, 0x1d, 0x6e, 0x6c, 0xf1, 0x3c,
-		0xdb, 0xb5, 0x53, 0x06, 0x24, 0x84, 0xae, 0x06, 0x53, 0x94, 0xee, 0x6c, 0x54, 0x85, 0x89, 0x2e,
-		0x0e, 0xcf, 0x5d, 0xb5, 0x6c, 0x4c, 0x8b, 0x67, 0xec, 0x3d, 0x58, 0xf5, 0x69, 0x94, 0xf1, 0xce,
-		0x34, 0x3a, 0x82, 0x14, 0x98, 0xac, 0xab, 0xde, 0x9d, 0xcf, 0x0f, 0x67, 0xc8, 0x72, 0xb0, 0xd3,
-		0xaa, 0xbb, 0xb4, 0xf0, 0x95, 0xbc, 0xa7, 0x07, 0xbd, 0xb9, 0xcb, 0x9d, 0xa9, 0x0a, 0x99, 0x89,
-		0xae, 0xc3, 0xb4, 0x65, 0x1b, 0x0f, 0x0d, 0xdf, 0xd1, 0x46, 0xa4, 0x54, 0x26, 0x52, 0x9a, 0x0c,
-		0x08, 0x22, 0x42, 0x3a, 0x02, 0x23, 0x86, 0x8e, 0x4d, 0xd7, 0x70, 0x77, 0x49, 0x45, 0xa9, 0xa4,
-		0x74, 0x9e, 0xd1, 0x65, 0x98, 0xdc, 0x34, 0x6c, 0xc7, 0x8d, 0xf3, 0xdc, 0x47, 0x28, 0x0f, 0x90,
-		0xb7, 0x11, 0x86, 0xcb, 0x30, 0x6a, 0x63, 0xd7, 0xde, 0xad, 0x35, 0xad, 0xba, 0xa1, 0xed, 0xd2,
-		0x2a, 0xcc, 0x0c, 0xe7, 0x82, 0xea, 0xda, 0xbb, 0x77, 0x09, 0x9d, 0x52, 0xb6, 0xbb, 0x0f, 0x68,
-		0x0a, 0x8a, 0xaa, 0xeb, 0xe2, 0x46, 0xd3, 0x25, 0x15, 0x93, 0x61, 0x25, 0x78, 0x44, 0xcb, 0xb0,
-		0x1f, 0x3f, 0x6a, 0x1a, 0xbe, 0xe2, 0xf8, 0x45, 0xfd, 0xf1, 0xd4, 0xa2, 0xfe, 0x58, 0x77, 0x0a,
-		0xa9, 0xec, 0x9f, 0x86, 0x7d, 0x9a, 0xed, 0x59, 0x03, 0xad, 0xe8, 0x90, 0x8a, 0x43, 0x49, 0x19,
-		0xf5, 0x06, 0x83, 0x2a, 0x0f, 0xfa, 0x04, 0x1c, 0xf5, 0x57, 0x1f, 0xae, 0x7e, 0x6d, 0xa8, 0xda,
-		0xb6, 0xb5, 0xb9, 0x49, 0x8b, 0x02, 0x09, 0x4a, 0x3d, 0x45, 0x66, 0xf7, 0x16, 0xbe, 0x6e, 0xfa,
-		0x53, 0xd1, 0x05, 0x18, 0x6a, 0xe0, 0x86, 0x45, 0xd3, 0xf9, 0xd3, 0xec, 0x44, 0x1f, 0x6e, 0x58,
-		0x0a, 0x21, 0x43, 0x0a, 0x4c, 0xc4, 0x3c, 0x36, 0xcd, 0xc9, 0x3f, 0xcd, 0x3e, 0x1b, 0x23, 0x1e,
-		0x56, 0x19, 0x77, 0x22, 0x23, 0xe8, 0x3e, 0x4c, 0x36, 0x6d, 0xbc, 0x53, 0x53, 0x5b, 0xae, 0xe5,
-		0xe9, 0x1f, 0x76, 0x6b, 0x4d, 0xcb, 0x30, 0xdd, 0x20, 0xcb, 0xce, 0xdb, 0x2f, 0x07, 0xbb, 0x77,
-		0x09, 0x9d, 0x72, 0xc0, 0x9b, 0xbf, 0xd4, 0x72, 0xad, 0x9e, 0x41, 0x74, 0x19, 0x0a, 0x5b, 0x58,
-		0xd5, 0xb1, 0x4d, 0xd3, 0xdf, 0x47, 0xd9, 0x4d, 0x1d, 0x84, 0x44, 0xa1, 0xa4, 0xf2, 0xdb, 0x12,
-		0x3c, 0x23, 0x1e, 0xed, 0x5f, 0x81, 0x02, 0xb5, 0x17, 0x49, 0xc0, 0x5e, 0x28, 0x2d, 0x5a, 0x85,
-		0x99, 0xe4, 0x72, 0xaf, 0xa1, 0x13, 0xef, 0x9e, 0x57, 0x8e, 0xf1, 0x2b, 0xb5, 0x55, 0x5d, 0x7e,
-		0x4b, 0x82, 0xb3, 0x82, 0x41, 0xc3, 0x55, 0x28, 0x06, 0x9e, 0x42, 0x12, 0xf0, 0x14, 0x01, 0xf1,
-		0xc0, 0xa0, 0x5a, 0x30, 0x2b, 0x1c, 0x31, 0x2f, 0xc3, 0x28, 0x75, 0xd6, 0xdd, 0x83, 0x73, 0x8c,
-		0xa3, 0x04, 0xd4, 0x37, 0x93, 0x73, 0xb3, 0xec, 0x76, 0x1f, 0xe4, 0xdf, 0x4b, 0x70, 0x46, 0xa4,
-		0x69, 0x20, 0x7c, 0x02, 0x4a, 0xd9, 0x4e, 0xc0, 0xdb, 0x30, 0xc9, 0x39, 0x65, 0x72, 0x69, 0x06,
-		0x79, 0xc0, 0x61, 0x9c, 0x30, 0x3d, 0x9e, 0x26, 0x1f, 0xf2, 0x34, 0xf2, 0x6b, 0x12, 0xc8, 0xe9,
-		0xfd, 0x06, 0x68, 0x1e, 0x50, 0xb4, 0x06, 0xdd, 0xe9, 0x42, 0x1a, 0x77, 0x42, 0x22, 0x88, 0xb8,
-		0xdb, 0x5c, 0xc4, 0xdd, 0x1e, 0x07, 0x08, 0x12, 0x82, 0x86, 0x4e, 0xd0, 0x94, 0x94, 0x12, 0x1d,
-		0xa9, 0xea, 0xf2, 0x3f, 0x22, 0xe2, 0xe5, 0x5a, 0x48, 0x36, 0x44, 0xb3, 0x30, 0x1e, 0xce, 0x43,
-		0x74, 0xd4, 0x6b, 0xcc, 0xe9, 0x59, 0x71, 0x04, 0x7b, 0x3e, 0x82, 0xfd, 0x1c, 0xec, 0xdf, 0x30,
-		0x4c, 0xd5, 0xde, 0xad, 0x69, 0x5b, 0x58, 0xdb, 0x76, 0x5a, 0x0d, 0x12, 0xa2, 0x94, 0x94, 0x31,
-		0x7f, 0x78, 0x99, 0x8e, 0xa2, 0xf3, 0x30, 0x11, 0xce, 0x9e, 0xe1, 0x47, 0x7e, 0xf8, 0x31, 0xaa,
-		0x8c, 0xe3, 0xde, 0xa4, 0x16, 0x7e, 0xe4, 0xca, 0xaf, 0xe6, 0xe1, 0xb4, 0x40, 0x2b, 0xc3, 0x63,
-		0x5b, 0x71, 0xd4, 0x2c, 0xf2, 0x7d, 0x98, 0x05, 0x3a, 0x01, 0xe5, 0x0d, 0xd5, 0xc1, 0xc1, 0xd1,
-		0xe9, 0x8b, 0xa5, 0xe4, 0x0d, 0xf9, 0x07, 0xe6, 0x31, 0x00, 0x13, 0xb7, 0x83, 0xd7, 0xc3, 0xbe,
-		0x60, 0x4d, 0xdc, 0xf6, 0xdf, 0xce, 0x03, 0xda, 0xb4, 0xec, 0x6d, 0x8a, 0x34, 0xe8, 0x47, 0x2b,
-		0xf8, 0x4b, 0xf3, 0xde, 0x10, 0xac, 0x0f, 0x68, 0x63, 0xda, 0xa4, 0xe7, 0x1c, 0x55, 0xc7, 0x32,
-		0x69, 0x6c, 0x44, 0x9f, 0xd0, 0x2d, 0x18, 0xd6, 0xd4, 0x96, 0x83, 0x69, 0x18, 0x54, 0x11, 0x6e,
-		0x1a, 0x59, 0xf6, 0x66, 0x29, 0xfe, 0x64, 0xf9, 0xad, 0x3c, 0x9c, 0x4a, 0x6d, 0xe4, 0x78, 0x6c,
-		0x9b, 0x71, 0x33, 0x58, 0x83, 0xbf, 0x0b, 0xf3, 0x82, 0x7d, 0x26, 0xbd, 0x2b, 0xe8, 0xf5, 0xc9,
-		0x43, 0x59, 0x7c, 0x72, 0xaf, 0xea, 0x0f, 0x47, 0x54, 0x3f, 0xb2, 0xbf, 0x85, 0xe4, 0xfd, 0x2d,
-		0x0a, 0xed, 0xef, 0x08, 0x67, 0x7f, 0x19, 0x66, 0x56, 0x62, 0x99, 0x99, 0xfc, 0x8d, 0x02, 0x9c,
-		0x11, 0xe9, 0x71, 0x41, 0x27, 0xa1, 0xdc, 0x29, 0x14, 0xd3, 0x6d, 0x2a, 0x29, 0x10, 0x0c, 0x55,
-		0x75, 0xef, 0x52, 0xd5, 0xad, 0x24, 0x7b, 0x46, 0x90, 0x4b, 0xb8, 0x54, 0x75, 0x3e, 0x49, 0x2e,
-		0x55, 0x6a, 0xcf, 0x93, 0xa7, 0x9a, 0xba, 0xd5, 0x50, 0x0d, 0x93, 0xfa, 0x0e, 0xfa, 0x14, 0x3e,
-		0x0c, 0x86, 0xfa, 0xbc, 0x0e, 0x15, 0xc4, 0xaf, 0x43, 0xeb, 0x30, 0x1d, 0x28, 0x61, 0xfc, 0x0c,
-		0x29, 0xa6, 0x9d, 0x21, 0x93, 0xc1, 0xdc, 0xc8, 0x31, 0x12, 0xe1, 0x4a, 0x8f, 0x28, 0xca, 0x75,
-		0x24, 0x03, 0x57, 0xff, 0x16, 0x44, 0xb9, 0xf2, 0x0f, 0xbb, 0x52, 0x5f, 0x87, 0xdd, 0x2a, 0x4c,
-		0x6c, 0x61, 0xd5, 0x76, 0x37, 0xb0, 0xda, 0x45, 0x07, 0x69, 0xac, 0xc6, 0x3b, 0x73, 0xba, 0x7c,
-		0xd2, 0x43, 0x94, 0x72, 0x7a, 0x88, 0x12, 0xbb, 0x2b, 0x8c, 0xf6, 0x73, 0x57, 0xe8, 0xc6, 0x9c,
-		0xfb, 0xc4, 0x63, 0xce, 0xbf, 0x4b, 0x20, 0xa7, 0xf7, 0x5b, 0x7d, 0x60, 0x87, 0x7b, 0x6f, 0x18,
-		0x32, 0x14, 0xbe, 0xf0, 0xbc, 0x08, 0xa3, 0xe4, 0xbe, 0x18, 0xf8, 0xad, 0x61, 0x01, 0xbf, 0x55,
-		0xf6, 0x66, 0xd0, 0x07, 0xf9, 0x8f, 0x52, 0xd8, 0x15, 0x0c, 0x38, 0xb2, 0x66, 0x8b, 0x28, 0x97,
-		0xc1, 0xdd, 0xe7, 0x53, 0xa3, 0x8d, 0xa1, 0xb0, 0x30, 0xe5, 0x3f, 0x48, 0x70, 0x2a, 0xbd, 0x09,
-		0xa6, 0xdf, 0x00, 0xfc, 0xc3, 0x58, 0xd1, 0xcf, 0x73, 0x70, 0x5a, 0xa0, 0x95, 0xcc, 0x5b, 0x93,
-		0x8e, 0x5d, 0xd5, 0xa8, 0x3b, 0x42, 0x9b, 0x14, 0x10, 0x3f, 0xb6, 0x35, 0x45, 0x23, 0xa4, 0xa1,
-		0x7e, 0x22, 0xa4, 0x3d, 0xab, 0xf8, 0x57, 0x24, 0x98, 0x13, 0xef, 0x00, 0x13, 0x39, 0xf3, 0x06,
-		0x73, 0x05, 0x7b, 0x47, 0x82, 0x8c, 0xbd, 0x5e, 0xe9, 0xd8, 0x0e, 0x06, 0x61, 0x90, 0xef, 0x61,
-		0x68, 0x60, 0x23, 0x82, 0x38, 0x2f, 0x80, 0xf8, 0xcd, 0x88, 0x1e, 0xf2, 0xaa, 0x42, 0xfd, 0xea,
-		0xe1, 0x2a, 0xcc, 0xd4, 0x55, 0xb7, 0xa7, 0xe7, 0x21, 0xda, 0x01, 0xd0, 0x95, 0xac, 0x4f, 0xc7,
-		0xda, 0x4a, 0x3f, 0x6c, 0x62, 0xe8, 0x73, 0x3e, 0x83, 0x3e, 0x0f, 0xa5, 0xda, 0x68, 0x24, 0xd0,
-		0x93, 0xdf, 0x95, 0xe0, 0x68, 0x42, 0x97, 0x25, 0x9a, 0x86, 0x11, 0xbf, 0xbb, 0xac, 0xb3, 0x6f,
-		0x45, 0xf2, 0x5c, 0xd5, 0xd1, 0x1a, 0x1c, 0xea, 0x1c, 0xe4, 0x9b, 0x86, 0x9d, 0xe1, 0xd2, 0x8a,
-		0xe8, 0x39, 0xbe, 0x6a, 0xd8, 0x38, 0xcb, 0xf1, 0x2b, 0xb2, 0xd9, 0x9f, 0x81, 0x69, 0x6e, 0xfb,
-		0x66, 0xd2, 0x6a, 0x84, 0x63, 0x76, 0xf9, 0x37, 0x12, 0x1c, 0x4b, 0xea, 0xdc, 0x1b, 0xc8, 0x57,
-		0x06, 0x25, 0x8f, 0x44, 0x07, 0xfd, 0x13, 0x09, 0x66, 0xd2, 0x3a, 0x00, 0x93, 0x56, 0xf3, 0x58,
-		0xcd, 0x36, 0x11, 0xf9, 0xbf, 0x8b, 0x90, 0xb1, 0xd1, 0x04, 0x2d, 0xc0, 0x41, 0xd2, 0xcb, 0x12,
-		0x4d, 0xfb, 0xfa, 0x6b, 0x9a, 0x30, 0x71, 0x3b, 0x92, 0xf4, 0x8d, 0x55, 0x5e, 0x72, 0xfd, 0x55,
-		0x5e, 0x9e, 0xd4, 0x46, 0xc4, 0x6b, 0x23, 0x22, 0xba, 0x53, 0x14, 0xd0, 0x9d, 0x3b, 0x30, 0x49,
-		0x73, 0xda, 0x14, 0xa3, 0x61, 0xba, 0xd8, 0xde, 0x51, 0xeb, 0xe9, 0xf7, 0x96, 0x83, 0x74, 0x22,
-		0x81, 0x57, 0xa5, 0xd3, 0xc2, 0x75, 0x97, 0xd2, 0x9e, 0xea, 0x2e, 0x3d, 0x21, 0x1c, 0x64, 0x09,
-		0xe1, 0xf8, 0x45, 0x96, 0x72, 0xdf, 0x45, 0x96, 0xee, 0x3d, 0x63, 0x54, 0xf8, 0x9e, 0xd1, 0x49,
-		0xf5, 0xef, 0xdb, 0x43, 0xaa, 0x7f, 0x6c, 0x4f, 0xa9, 0x7e, 0xcf, 0x07, 0x2f, 0x64, 0xed, 0x76,
-		0xeb, 0x78, 0x2b, 0xa9, 0xd7, 0x5b, 0x25, 0xdd, 0x6f, 0x36, 0xe0, 0x70, 0xa7, 0x42, 0x1e, 0xa9,
-		0x9a, 0xfa, 0x76, 0x3c, 0x97, 0x58, 0x03, 0x0f, 0xd7, 0x4d, 0x0f, 0x61, 0xd6, 0xb0, 0xfc, 0x3d,
-		0x89, 0x91, 0xd2, 0xe6, 0x9d, 0x2c, 0x22, 0xe6, 0x21, 0x09, 0x98, 0x47, 0x4f, 0xa4, 0x93, 0xcb,
-		0x10, 0xe9, 0xc8, 0xef, 0x4b, 0x70, 0x3c, 0xb1, 0x5b, 0xdb, 0x0b, 0xf5, 0x68, 0x2f, 0xb8, 0xa9,
-		0x36, 0x02, 0x51, 0x83, 0x3f, 0x74, 0x5b, 0x6d, 0xe0, 0x7e, 0x3f, 0x3d, 0xb0, 0x53, 0xa5, 0xab,
-		0xf1, 0x43, 0xe2, 0x37, 0xeb, 0xaf, 0xb1, 0x36, 0x89, 0xd7, 0x9d, 0x70, 0x12, 0xca, 0xb4, 0x3f,
-		0xa4, 0x57, 0x04, 0xfe, 0x10, 0x11, 0x41, 0xc7, 0xa9, 0xe7, 0xc4, 0x9d, 0x7a, 0x42, 0x9e, 0x5a,
-		0xfe, 0xaa, 0x04, 0x73, 0x19, 0x3a, 0x72, 0xba, 0xf9, 0x54, 0x29, 0x94, 0x4f, 0xed, 0x77, 0x67,
-		0x92, 0xa0, 0xfd, 0x32, 0x07, 0x2f, 0xec, 0xad, 0x2b, 0x79, 0x60, 0x3a, 0xdf, 0xcd, 0xd5, 0xe5,
-		0x42, 0xb9, 0xba, 0xfb, 0x80, 0xe2, 0xdd, 0x2f, 0xd4, 0xbe, 0xcf, 0x8a, 0x75, 0xb8, 0x2a, 0x13,
-		0xb1, 0x16, 0x56, 0x34, 0x05, 0x45, 0xcd, 0x32, 0x5d, 0xdb, 0xaa, 0x13, 0x45, 0x1b, 0x55, 0x82,
-		0x47, 0x54, 0x81, 0x03, 0x91, 0x46, 0x2e, 0xcb, 0xac, 0xfb, 0x91, 0xf9, 0x88, 0x32, 0x11, 0xea,
-		0xaf, 0xba, 0x63, 0xd6, 0x77, 0xe5, 0x37, 0xf2, 0x70, 0x63, 0x0f, 0x5d, 0xcf, 0xe8, 0x7e, 0xaf,
-		0xdf, 0x1b, 0xe3, 0xfc, 0xa6, 0x40, 0x88, 0x73, 0x28, 0xed, 0x3c, 0xa0, 0xfb, 0x24, 0x37, 0x87,
-		0xca, 0xde, 0x97, 0xa1, 0xbd, 0xee, 0xcb, 0x3c, 0xa0, 0x68, 0xaf, 0x19, 0xad, 0x50, 0xe4, 0x95,
-		0x71, 0x23, 0xa4, 0x84, 0x7e, 0x0a, 0x2b, 0xd8, 0xc5, 0x42, 0x68, 0x17, 0xe5, 0x3f, 0x49, 0x70,
-		0xad, 0xcf, 0x96, 0x6d, 0x0e, 0x06, 0x89, 0x83, 0xe1, 0x83, 0x55, 0x5c, 0xf9, 0x4b, 0x79, 0xb8,
-		0xd6, 0x67, 0x5b, 0xdd, 0xff, 0xaa, 0xad, 0x46, 0x3c, 0xf6, 0x10, 0xdf, 0x63, 0x0f, 0x8b, 0x7b,
-		0x6c, 0xae, 0xea, 0xf0, 0x1c, 0x40, 0x91, 0xe7, 0x00, 0x5e, 0xcd, 0xc3, 0x95, 0x7e, 0x5a, 0x03,
-		0xc5, 0x2c, 0x5f, 0x88, 0xf3, 0x13, 0xcb, 0xef, 0x5a, 0xfe, 0x7b, 0x12, 0x5c, 0xcc, 0xda, 0xe6,
-		0xf8, 0x5f, 0x6d, 0xf2, 0xfc, 0xb3, 0x4a, 0xfe, 0x9d, 0x04, 0x17, 0x32, 0xb5, 0x46, 0x0e, 0xcc,
-		0x05, 0x30, 0x6f, 0x0d, 0xb9, 0xbd, 0xdd, 0x1a, 0xfe, 0x32, 0x02, 0x97, 0xfb, 0xf8, 0x8d, 0x47,
-		0xcf, 0x76, 0x48, 0xa1, 0xed, 0x38, 0x09, 0xe5, 0xce, 0x76, 0x50, 0x9d, 0x2f, 0x29, 0x10, 0x0c,
-		0xb1, 0x52, 0x08, 0xf9, 0x01, 0xa4, 0x10, 0xfa, 0xad, 0x27, 0x0e, 0x0f, 0x36, 0x85, 0x50, 0x78,
-		0xac, 0x29, 0x84, 0x62, 0xdf, 0x29, 0x84, 0x07, 0x40, 0x3b, 0x54, 0x29, 0x47, 0x5a, 0x86, 0xf3,
-		0x9b, 0x04, 0xce, 0x26, 0xb4, 0xb9, 0x12, 0x2e, 0xb4, 0x18, 0x37, 0xd1, 0x8c, 0x0e, 0xf5, 0x1a,
-		0x49, 0x29, 0xec, 0xcf, 0x45, 0x54, 0x1e, 0x04, 0x54, 0x5e, 0x83, 0xa9, 0x1e, 0x75, 0xaa, 0xd9,
-		0xb8, 0xd5, 0x85, 0x5f, 0x26, 0xf0, 0xe7, 0x12, 0x15, 0xa7, 0xaa, 0x2b, 0xde, 0x14, 0xba, 0x84,
-		0x43, 0x6d, 0xd6, 0x70, 0xac, 0x3c, 0xb9, 0xaf, 0x9f, 0xf2, 0x64, 0xac, 0xd7, 0x70, 0x8c, 0xd1,
-		0x6b, 0xd8, 0xbd, 0x69, 0xed, 0xcf, 0x9e, 0x5b, 0x18, 0xdf, 0x43, 0x6e, 0x61, 0x62, 0x6f, 0x6d,
-		0x84, 0xcf, 0x41, 0x59, 0xc7, 0x75, 0x75, 0xd7, 0x57, 0xcd, 0xf4, 0x9e, 0x48, 0x20, 0xd4, 0x44,
-		0x15, 0xe5, 0xd7, 0xf3, 0x70, 0x31, 0xeb, 0x6f, 0xb0, 0x3e, 0x7c, 0xf7, 0xb2, 0x16, 0xc4, 0x09,
-		0x7e, 0xa5, 0xeb, 0x6a, 0xe6, 0x1f, 0x10, 0x85, 0xc2, 0x83, 0x1e, 0x43, 0x19, 0x0e, 0x1b, 0x0a,
-		0xfb, 0x10, 0x2c, 0x70, 0x0e, 0xc1, 0x01, 0xe5, 0x02, 0xe5, 0xdf, 0xe6, 0x60, 0x3e, 0xcb, 0x0f,
-		0xcc, 0xb8, 0xfb, 0xc1, 0x3e, 0x7d, 0x73, 0x7b, 0x3d, 0x7d, 0x07, 0xb5, 0x8b, 0x6c, 0xe9, 0x0e,
-		0x71, 0xa4, 0xdb, 0xb5, 0xce, 0x61, 0xf1, 0x3c, 0xc8, 0xfb, 0x39, 0xc8, 0xf8, 0xd3, 0xb7, 0x8f,
-		0x86, 0x30, 0x59, 0x65, 0x9d, 0x61, 0x66, 0x59, 0xa7, 0xdb, 0x8f, 0x50, 0x10, 0xef, 0x47, 0x90,
-		0xff, 0x99, 0x83, 0xf3, 0x83, 0xf0, 0x28, 0x1f, 0x51, 0xa1, 0xf7, 0x64, 0xdc, 0x0b, 0x19, 0x32,
-		0xee, 0xf2, 0xbf, 0x72, 0x70, 0x21, 0xd3, 0x2f, 0x11, 0x9f, 0x08, 0x3e, 0x26, 0xf8, 0x20, 0xa5,
-		0x58, 0xc8, 0x92, 0x67, 0xfe, 0x7c, 0x9e, 0x27, 0x78, 0x5e, 0x0f, 0xc9, 0x13, 0xc1, 0x27, 0xb6,
-		0xb0, 0x14, 0xfa, 0xe9, 0x7d, 0xff, 0x45, 0x0e, 0x16, 0x32, 0xfe, 0x42, 0xf4, 0xc9, 0x3e, 0x84,
-		0xf6, 0x61, 0xce, 0x85, 0xfd, 0xe4, 0xcf, 0x55, 0xa3, 0xee, 0x62, 0x9b, 0x7c, 0xea, 0x38, 0x4c,
-		0xaf, 0x3c, 0x58, 0xb9, 0xbd, 0x5e, 0x5b, 0xad, 0xae, 0xad, 0xaf, 0x28, 0xb5, 0xf5, 0x4f, 0xde,
-		0x5d, 0xa9, 0x55, 0x6f, 0x3f, 0x58, 0x5a, 0xab, 0xde, 0x1a, 0x7f, 0x0a, 0x9d, 0x84, 0xa3, 0xf1,
-		0xd7, 0x4b, 0x6b, 0x6b, 0x35, 0x32, 0x3a, 0x2e, 0xa1, 0x53, 0x70, 0x3c, 0x4e, 0xb0, 0xbc, 0x76,
-		0xe7, 0xde, 0x0a, 0x25, 0xc9, 0xdd, 0x7c, 0x00, 0x87, 0x35, 0xab, 0xc1, 0x92, 0xc1, 0xcd, 0x91,
-		0xa5, 0xa6, 0x71, 0xd7, 0x0b, 0x61, 0xef, 0x4a, 0x9f, 0x5a, 0x78, 0x68, 0xb8, 0x5b, 0xad, 0x8d,
-		0x8a, 0x66, 0x35, 0x16, 0x42, 0xff, 0xe7, 0xb4, 0xf2, 0x10, 0x9b, 0xfe, 0x7f, 0x56, 0xa5, 0xff,
-		0xf2, 0xf4, 0x86, 0xda, 0x34, 0x76, 0x2e, 0x6d, 0x14, 0xc8, 0xd8, 0xe5, 0xff, 0x04, 0x00, 0x00,
-		0xff, 0xff, 0xee, 0x07, 0x38, 0xbd, 0xd5, 0x55, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4f, 0x6c, 0x1c, 0x49,
+		0xb9, 0xdf, 0x9e, 0xb1, 0xc7, 0x9e, 0x6f, 0x1c, 0xc7, 0xae, 0x24, 0x8e, 0x9d, 0x38, 0x89, 0xd3,
+		0xc9, 0x26, 0x5e, 0xc7, 0x99, 0x49, 0x9c, 0x6c, 0xf2, 0x92, 0xbc, 0xdd, 0x7d, 0x8e, 0x63, 0x2b,
+		0x23, 0xf9, 0x25, 0x56, 0xc7, 0xc9, 0xbe, 0xf7, 0xf4, 0x9e, 0xe6, 0xb5, 0xa7, 0xcb, 0x71, 0xcb,
+		0x33, 0xdd, 0xb3, 0xdd, 0x35, 0x9e, 0xf8, 0x49, 0xef, 0xc4, 0x01, 0x09, 0xed, 0x0a, 0x56, 0x2b,
+		0x24, 0x56, 0x80, 0x40, 0x48, 0xac, 0x76, 0x11, 0x68, 0x11, 0x08, 0x01, 0xe2, 0x02, 0x48, 0x08,
+		0x24, 0xd0, 0xc2, 0x89, 0x0b, 0x07, 0x2e, 0x1c, 0xe0, 0xc6, 0x81, 0xe5, 0x86, 0x84, 0xba, 0xba,
+		0x7a, 0xfe, 0x74, 0x57, 0x75, 0x57, 0x8f, 0x9d, 0x5d, 0xd0, 0xe6, 0xe6, 0xae, 0xfe, 0xbe, 0xaf,
+		0x7f, 0x55, 0xf5, 0xfd, 0xab, 0xfa, 0xbe, 0x31, 0x9c, 0x6e, 0x6e, 0x60, 0xa7, 0x54, 0xd5, 0x0d,
+		0x6c, 0x55, 0x71, 0x49, 0x6f, 0x98, 0xa5, 0x9d, 0xcb, 0xa5, 0x2d, 0xd3, 0x25, 0xb6, 0xb3, 0x5b,
+		0x6c, 0x38, 0x36, 0xb1, 0xd1, 0x21, 0x8f, 0xa4, 0xc8, 0x48, 0x8a, 0x7a, 0xc3, 0x2c, 0xee, 0x5c,
+		0x3e, 0x76, 0xf2, 0xb1, 0x6d, 0x3f, 0xae, 0xe1, 0x12, 0x25, 0xd9, 0x68, 0x6e, 0x96, 0x8c, 0xa6,
+		0xa3, 0x13, 0xd3, 0xb6, 0x7c, 0xa6, 0x63, 0xa7, 0xc2, 0xef, 0x89, 0x59, 0xc7, 0x2e, 0xd1, 0xeb,
+		0x0d, 0x46, 0x30, 0xc3, 0xfb, 0x70, 0xd5, 0xae, 0xd7, 0xdb, 0x22, 0x54, 0x1e, 0x05, 0xd1, 0xdd,
+		0xed, 0x9a, 0xe9, 0x92, 0x38, 0x9a, 0x96, 0xed, 0x6c, 0x6f, 0xd6, 0xec, 0x96, 0x4f, 0xa3, 0xde,
+		0x81, 0xa1, 0xbb, 0xfe, 0x84, 0xd0, 0x0d, 0xc8, 0xe1, 0x1d, 0x6c, 0x11, 0x77, 0x52, 0x99, 0xc9,
+		0xce, 0x16, 0x16, 0x4e, 0x17, 0x39, 0x73, 0x2b, 0x32, 0xea, 0x65, 0x8f, 0x52, 0x63, 0x0c, 0xea,
+		0xb7, 0x6e, 0xc2, 0x48, 0xf7, 0x0b, 0x34, 0x05, 0xc3, 0xf4, 0x55, 0xc5, 0x34, 0x26, 0x95, 0x19,
+		0x65, 0x36, 0xab, 0x0d, 0xd1, 0xe7, 0xb2, 0x81, 0x6e, 0x00, 0xf8, 0xaf, 0xbc, 0x49, 0x4f, 0x66,
+		0x66, 0x94, 0xd9, 0xc2, 0xc2, 0xb1, 0xa2, 0xbf, 0x22, 0xc5, 0x60, 0x45, 0x8a, 0xeb, 0xc1, 0x8a,
+		0x68, 0x79, 0x4a, 0xed, 0x3d, 0xa3, 0x49, 0x18, 0xda, 0xc1, 0x8e, 0x6b, 0xda, 0xd6, 0x64, 0xd6,
+		0x17, 0xca, 0x1e, 0xd1, 0x51, 0x18, 0xf2, 0x26, 0xef, 0x7d, 0x6e, 0x80, 0xbe, 0xc9, 0x79, 0x8f,
+		0x65, 0x03, 0x7d, 0x49, 0x81, 0x0b, 0xc1, 0x94, 0x2b, 0xf8, 0x09, 0xae, 0x36, 0xbd, 0x7d, 0xa8,
+		0xb8, 0x44, 0x77, 0x08, 0x36, 0x2a, 0x3e, 0x12, 0x9d, 0x10, 0xc7, 0xdc, 0x68, 0x12, 0xec, 0x4e,
+		0x0e, 0x52, 0x3c, 0xff, 0xca, 0x9d, 0xfa, 0xab, 0x4c, 0xce, 0x72, 0x20, 0xe6, 0x81, 0x2f, 0x85,
+		0x4e, 0x79, 0xb1, 0x2d, 0xe3, 0xee, 0x73, 0xda, 0xf9, 0x96, 0x1c, 0x29, 0xfa, 0x9a, 0x02, 0x17,
+		0x39, 0xf0, 0xaa, 0x76, 0xbd, 0x51, 0xc3, 0x5c, 0x80, 0x39, 0x0a, 0xf0, 0x65, 0x39, 0x80, 0x4b,
+		0x81, 0x9c, 0x28, 0xc4, 0x17, 0x5a, 0xb2, 0xc4, 0xe8, 0x6d, 0x05, 0xe6, 0x38, 0x20, 0x37, 0x75,
+		0xb3, 0xc6, 0x43, 0x38, 0x44, 0x11, 0xde, 0x92, 0x43, 0xb8, 0x42, 0x85, 0x44, 0xe1, 0x9d, 0x6b,
+		0x49, 0x51, 0xa2, 0xaf, 0xf2, 0x17, 0xd0, 0xd3, 0x2d, 0xa3, 0x62, 0x37, 0x49, 0x14, 0xde, 0x30,
+		0x85, 0xf7, 0x92, 0x1c, 0x3c, 0x4f, 0xed, 0x8c, 0xfb, 0x4d, 0x12, 0x05, 0x38, 0xdb, 0x92, 0xa4,
+		0x45, 0x6f, 0x29, 0x30, 0x6b, 0xe0, 0xaa, 0xe9, 0x52, 0x60, 0x9e, 0x96, 0xba, 0xd5, 0x2d, 0x6c,
+		0x34, 0xb9, 0x8b, 0x97, 0xa7, 0xe8, 0x6e, 0x70, 0xd1, 0xdd, 0x61, 0x42, 0xd6, 0x75, 0x77, 0xfb,
+		0x41, 0x20, 0x22, 0x8a, 0xec, 0xac, 0x21, 0x41, 0x87, 0xde, 0x50, 0xe0, 0x5c, 0x08, 0x95, 0xc8,
+		0x26, 0x80, 0x62, 0xba, 0x9e, 0x8c, 0x49, 0x64, 0x0e, 0xaa, 0x91, 0x48, 0xc5, 0x59, 0xa5, 0x18,
+		0x23, 0x28, 0x48, 0xae, 0x52, 0x8c, 0xfe, 0x9f, 0x35, 0x24, 0xe8, 0xd0, 0x9b, 0x11, 0x54, 0x31,
+		0x9a, 0x35, 0x42, 0x51, 0xfd, 0x4b, 0x22, 0x2a, 0xb1, 0x52, 0x9d, 0x31, 0x92, 0xc9, 0xd0, 0x67,
+		0x14, 0x78, 0xbe, 0x17, 0x93, 0xc8, 0x12, 0x0f, 0x50, 0x40, 0xd7, 0x12, 0x01, 0x89, 0x8c, 0xf0,
+		0xb4, 0x91, 0x44, 0x44, 0xb7, 0x4d, 0xaf, 0x12, 0x73, 0xc7, 0x24, 0xbb, 0x89, 0xca, 0x3d, 0x1a,
+		0xb3, 0x6d, 0x8b, 0x4c, 0x48, 0x92, 0x72, 0xeb, 0x12, 0x74, 0x54, 0xb9, 0x43, 0xa8, 0x44, 0xca,
+		0x7d, 0x30, 0x46, 0xb9, 0x7b, 0x30, 0x09, 0x95, 0x5b, 0x4f, 0xa4, 0xe2, 0xac, 0x52, 0x8c, 0x72,
+		0x8f, 0x49, 0xae, 0x52, 0x9c, 0x72, 0xeb, 0x12, 0x74, 0x54, 0x91, 0x7a, 0x51, 0x89, 0x14, 0x69,
+		0x3c, 0x46, 0x91, 0xba, 0x21, 0x09, 0x15, 0x49, 0x4f, 0x22, 0xa2, 0x96, 0xd6, 0x0b, 0x26, 0xc6,
+		0xd2, 0x50, 0x8c, 0xa5, 0x75, 0xe3, 0x89, 0xb1, 0x34, 0x3d, 0x99, 0x0c, 0xb5, 0xe0, 0xa4, 0x07,
+		0xc2, 0x11, 0x6b, 0xcf, 0x21, 0x0a, 0xe4, 0x12, 0x17, 0x88, 0x27, 0xd5, 0x11, 0xaa, 0xcd, 0x71,
+		0x22, 0x7e, 0x8d, 0x5e, 0x83, 0x69, 0xff, 0xc3, 0x9b, 0xa6, 0xc3, 0xfb, 0xec, 0x61, 0xfa, 0xd9,
+		0xa2, 0xf8, 0xb3, 0x2b, 0xa6, 0x13, 0x91, 0x7a, 0xf7, 0x39, 0x6d, 0x8a, 0x88, 0x5e, 0xa2, 0x77,
+		0x14, 0x28, 0x85, 0x54, 0x54, 0xb7, 0xaa, 0xb8, 0x56, 0x71, 0xf0, 0x6b, 0x4d, 0xec, 0x72, 0x67,
+		0x7f, 0x84, 0xc2, 0x78, 0x25, 0x59, 0x53, 0xa9, 0x24, 0x2d, 0x10, 0x14, 0xc5, 0x35, 0xa7, 0x4b,
+		0x53, 0xa3, 0xef, 0x2a, 0x70, 0x95, 0x61, 0x0a, 0x20, 0xca, 0x29, 0xf1, 0x04, 0x45, 0xbb, 0xc4,
+		0x45, 0xcb, 0xbe, 0xe6, 0x7f, 0x5a, 0x46, 0xa3, 0x8b, 0x4e, 0x2a, 0x0e, 0xf4, 0x39, 0x05, 0xce,
+		0xf3, 0x96, 0x97, 0x07, 0xf4, 0xa8, 0xa4, 0x76, 0x2f, 0x31, 0x09, 0x09, 0xda, 0x2d, 0x20, 0x43,
+		0xff, 0x07, 0xa7, 0x7c, 0x25, 0x13, 0x23, 0x99, 0xa4, 0x48, 0x2e, 0x8b, 0xf5, 0x4c, 0x0c, 0x61,
+		0x9a, 0xc4, 0xbc, 0x47, 0x9f, 0x56, 0xe0, 0x2c, 0xdb, 0x3c, 0xa6, 0xe8, 0x82, 0x4d, 0x9b, 0xa2,
+		0x08, 0x5e, 0xe4, 0x22, 0xf0, 0x85, 0xfb, 0xfa, 0x2e, 0xd8, 0xa6, 0x99, 0x6a, 0x02, 0x0d, 0xfa,
+		0x7f, 0x98, 0xa9, 0xeb, 0xce, 0x36, 0x76, 0x2a, 0x0e, 0xae, 0xda, 0x8e, 0xc1, 0x03, 0x71, 0x8c,
+		0x82, 0x58, 0xe0, 0x82, 0xf8, 0x77, 0xca, 0xac, 0x31, 0xde, 0x28, 0x82, 0x13, 0xf5, 0x38, 0x02,
+		0xf4, 0x15, 0x05, 0xe6, 0x79, 0xe7, 0x13, 0xf3, 0xb1, 0xa5, 0x73, 0x17, 0xe4, 0x78, 0x9a, 0xf4,
+		0xf5, 0x01, 0x13, 0x23, 0x93, 0xbe, 0x0a, 0x68, 0xd1, 0xd7, 0x15, 0x28, 0x72, 0x10, 0x12, 0xec,
+		0xd4, 0x4d, 0x4b, 0xe7, 0xfa, 0x85, 0xe9, 0x18, 0xbf, 0x10, 0x4d, 0xb1, 0xdb, 0x82, 0x38, 0x7e,
+		0xa1, 0x25, 0x4d, 0x8d, 0xbe, 0xa7, 0xc0, 0x55, 0xde, 0x51, 0x2a, 0xd1, 0x8b, 0x9d, 0xa0, 0x68,
+		0xef, 0x48, 0x9e, 0xa8, 0x92, 0x5c, 0x59, 0xa9, 0x95, 0x8e, 0x45, 0xa4, 0x01, 0x62, 0xa3, 0x3c,
+		0x99, 0x46, 0x03, 0xc4, 0x06, 0x3a, 0xdb, 0x92, 0xa4, 0x45, 0x7f, 0x50, 0x60, 0x39, 0xe4, 0x71,
+		0xf1, 0x13, 0x82, 0x1d, 0x4b, 0xaf, 0x55, 0x38, 0xc8, 0x4d, 0xcb, 0x24, 0x26, 0x5f, 0x31, 0x4e,
+		0x51, 0xe8, 0x0f, 0x92, 0x5d, 0xf0, 0x32, 0x93, 0x1f, 0x99, 0x4f, 0x39, 0x10, 0x1e, 0x9d, 0xd0,
+		0xcb, 0xce, 0x9e, 0x24, 0xa0, 0xdf, 0x29, 0x70, 0x3b, 0xc5, 0x34, 0x45, 0x1e, 0x6b, 0x86, 0xce,
+		0x71, 0x6d, 0x0f, 0x73, 0x14, 0x39, 0xb3, 0x5b, 0x4e, 0xff, 0xec, 0xe8, 0x03, 0x05, 0x5e, 0x8a,
+		0x9b, 0x4e, 0xb2, 0x9d, 0x9c, 0xa6, 0x13, 0x5b, 0xe5, 0x4e, 0x4c, 0x08, 0x26, 0xd1, 0x5e, 0xae,
+		0xe3, 0xfe, 0x58, 0x69, 0x1e, 0xc0, 0x9b, 0x87, 0x6d, 0x11, 0xd3, 0x6a, 0x62, 0xa3, 0xa2, 0xbb,
+		0x15, 0x0b, 0xb7, 0xa2, 0xf3, 0x50, 0x63, 0xf2, 0x80, 0x28, 0x88, 0x40, 0xdc, 0xa2, 0x7b, 0x0f,
+		0xb7, 0xa2, 0xf0, 0x8b, 0xad, 0x54, 0x1c, 0xe8, 0xa7, 0x0a, 0xdc, 0xa0, 0xd9, 0x64, 0xa5, 0xba,
+		0x65, 0xd6, 0x8c, 0x94, 0xf6, 0x73, 0x86, 0x42, 0xbf, 0xcb, 0x85, 0x4e, 0x53, 0xc9, 0x25, 0x4f,
+		0x68, 0x1a, 0xa3, 0xb9, 0xe2, 0xa6, 0x67, 0x43, 0x3f, 0x54, 0xe0, 0x5a, 0xc2, 0x24, 0x44, 0xd6,
+		0x71, 0x96, 0xce, 0x60, 0x39, 0xed, 0x0c, 0x44, 0x26, 0x71, 0xc9, 0x4d, 0xc9, 0x83, 0xbe, 0xa9,
+		0xc0, 0x65, 0x21, 0x6a, 0x61, 0x9e, 0xff, 0x3c, 0x85, 0xbd, 0xc8, 0x4f, 0x43, 0xb8, 0x5f, 0x17,
+		0x26, 0xfe, 0xf3, 0xd5, 0x14, 0xf4, 0xe8, 0x3b, 0x0a, 0x5c, 0x11, 0xc2, 0x8d, 0x39, 0x44, 0x9e,
+		0x8b, 0x51, 0x72, 0x3e, 0xe0, 0x98, 0xe3, 0x64, 0xb1, 0x9a, 0x8a, 0x03, 0xbd, 0xa7, 0xc0, 0xa5,
+		0xd4, 0x9a, 0x71, 0x9e, 0x22, 0xfe, 0xb7, 0x14, 0x88, 0x45, 0x4a, 0x71, 0xa1, 0x9a, 0x42, 0x1f,
+		0xde, 0x57, 0x60, 0x41, 0xbc, 0xc0, 0xc2, 0x20, 0x3c, 0x4b, 0xd1, 0xde, 0x4e, 0xb3, 0xbe, 0xc2,
+		0x48, 0x7c, 0xb1, 0x9a, 0x86, 0x01, 0x7d, 0x3b, 0x4e, 0x25, 0x62, 0x0e, 0xcd, 0x2f, 0xa4, 0x86,
+		0x2c, 0x3e, 0x3e, 0x5f, 0xac, 0xa6, 0x61, 0xa0, 0xb9, 0x99, 0x18, 0x72, 0x4c, 0x26, 0x39, 0x17,
+		0x93, 0x9b, 0x09, 0x30, 0xc7, 0xa4, 0x93, 0xa5, 0x6a, 0x3a, 0x16, 0x1a, 0x34, 0xfd, 0x54, 0xbc,
+		0xdf, 0x8c, 0xe7, 0x42, 0x4c, 0xd0, 0xf4, 0x33, 0xee, 0x7e, 0x52, 0x9d, 0xeb, 0x6e, 0x7f, 0xac,
+		0xe8, 0x67, 0x0a, 0xdc, 0x94, 0x98, 0x90, 0xc8, 0x46, 0xe7, 0xe9, 0x6c, 0xca, 0xfd, 0xcc, 0x46,
+		0x64, 0xac, 0x57, 0xdd, 0x3e, 0xf8, 0xd0, 0x0f, 0x14, 0x78, 0x31, 0x6e, 0x02, 0xe2, 0xf3, 0xd3,
+		0xc5, 0x98, 0x00, 0x24, 0x04, 0x21, 0x3e, 0x47, 0x5d, 0xc2, 0x29, 0x79, 0xa8, 0xc3, 0x69, 0x36,
+		0x5c, 0xec, 0x90, 0x0e, 0x70, 0x17, 0xeb, 0x4e, 0x75, 0xab, 0x0b, 0x66, 0x14, 0x77, 0x31, 0xc6,
+		0x7a, 0x1f, 0x52, 0x71, 0x01, 0x82, 0x07, 0x54, 0x58, 0xe7, 0x8b, 0x1c, 0xeb, 0x6d, 0xa6, 0x61,
+		0x10, 0x15, 0xa9, 0xdc, 0xa6, 0xdb, 0xc0, 0x16, 0xf7, 0xc0, 0x5c, 0x4a, 0x53, 0xa4, 0x7a, 0x10,
+		0xc8, 0x91, 0x29, 0x52, 0x89, 0x88, 0x45, 0x85, 0x3e, 0x07, 0xbb, 0xcd, 0x3a, 0x0f, 0xe2, 0xa5,
+		0x34, 0x85, 0x3e, 0xcd, 0x97, 0x22, 0x53, 0xe8, 0xe3, 0x93, 0xde, 0x1e, 0x01, 0xe8, 0x7c, 0x5c,
+		0x7d, 0x67, 0x04, 0xce, 0xcb, 0x66, 0x00, 0x2b, 0x70, 0xa0, 0x3d, 0x2f, 0xb2, 0xdb, 0xc0, 0xb4,
+		0x9e, 0x2a, 0xaa, 0xce, 0x06, 0x42, 0xd7, 0x77, 0x1b, 0x58, 0x1b, 0x69, 0x75, 0x3d, 0xa1, 0xff,
+		0x86, 0x23, 0x0d, 0xdd, 0xf1, 0x56, 0xa1, 0xdb, 0x71, 0x6d, 0xda, 0xac, 0x04, 0x3b, 0xcb, 0x95,
+		0xb7, 0x46, 0x39, 0xba, 0xfc, 0xca, 0xa6, 0xad, 0x1d, 0x6a, 0x44, 0x07, 0xd1, 0x4d, 0xc8, 0xd3,
+		0x5b, 0xad, 0x9a, 0xe9, 0x12, 0x5a, 0x9c, 0x2d, 0x2c, 0x9c, 0xe0, 0x5f, 0x1b, 0xe9, 0xee, 0xf6,
+		0xaa, 0xe9, 0x12, 0x6d, 0x98, 0xb0, 0xbf, 0xd0, 0x02, 0x0c, 0x9a, 0x56, 0xa3, 0x49, 0x68, 0xe9,
+		0xb6, 0xb0, 0x30, 0x2d, 0x40, 0xb2, 0x5b, 0xb3, 0x75, 0x43, 0xf3, 0x49, 0x91, 0x0e, 0x33, 0xa1,
+		0xb4, 0xad, 0x42, 0xec, 0x4a, 0xb5, 0x66, 0xbb, 0x98, 0xc6, 0x40, 0xbb, 0x49, 0x58, 0x2d, 0x77,
+		0x2a, 0x52, 0x5b, 0xbe, 0xc3, 0xaa, 0xf1, 0xda, 0x34, 0xee, 0x59, 0xfb, 0x75, 0x7b, 0xc9, 0xe3,
+		0x5f, 0xf7, 0xd9, 0xd1, 0xab, 0x70, 0xbc, 0x53, 0x3a, 0x88, 0x4a, 0xcf, 0x25, 0x49, 0x3f, 0x4a,
+		0x82, 0x82, 0x40, 0x48, 0xf0, 0x2d, 0x38, 0xd6, 0x39, 0xa5, 0x74, 0xa9, 0x6a, 0xd3, 0xf2, 0xea,
+		0xd7, 0x5e, 0xf9, 0x34, 0xaf, 0x1d, 0x6d, 0x53, 0x74, 0x34, 0xab, 0x69, 0x95, 0x0d, 0x54, 0x86,
+		0x3c, 0x0b, 0x37, 0xb6, 0x43, 0x6b, 0x99, 0xa3, 0x0b, 0x17, 0xf8, 0xe1, 0x91, 0x09, 0xa0, 0xc7,
+		0x90, 0x72, 0xc0, 0xa2, 0x75, 0xb8, 0x51, 0x19, 0xc6, 0x3b, 0x38, 0x3c, 0x97, 0xdf, 0x74, 0xf0,
+		0x64, 0x3e, 0x66, 0x0f, 0x56, 0x7c, 0x1a, 0x6d, 0xac, 0xcd, 0xc6, 0x46, 0x90, 0x06, 0x13, 0x35,
+		0xdd, 0x3b, 0x37, 0xfb, 0x29, 0x61, 0x60, 0x79, 0x35, 0x32, 0x09, 0x31, 0xf2, 0x82, 0x3d, 0x3d,
+		0xec, 0xf1, 0x2e, 0xb5, 0x59, 0x35, 0xca, 0x89, 0x6e, 0xc0, 0x94, 0xed, 0x98, 0x8f, 0x4d, 0x3f,
+		0x58, 0x85, 0x56, 0xa9, 0x40, 0x57, 0x69, 0x22, 0x20, 0x08, 0x2d, 0xd2, 0x31, 0x18, 0x36, 0x0d,
+		0x6c, 0x11, 0x93, 0xec, 0xd2, 0xaa, 0x5c, 0x5e, 0x6b, 0x3f, 0xa3, 0x2b, 0x30, 0xb1, 0x69, 0x3a,
+		0x2e, 0x89, 0xca, 0x3c, 0x40, 0x29, 0x0f, 0xd1, 0xb7, 0x21, 0x81, 0x4b, 0x30, 0xe2, 0x60, 0xe2,
+		0xec, 0x56, 0x1a, 0x76, 0xcd, 0xac, 0xee, 0xb2, 0x4a, 0xd6, 0x8c, 0xe0, 0x90, 0x4f, 0x9c, 0xdd,
+		0x35, 0x4a, 0xa7, 0x15, 0x9c, 0xce, 0x83, 0xd7, 0xbe, 0xa0, 0x13, 0x82, 0xeb, 0x0d, 0x42, 0xab,
+		0x4e, 0x83, 0x5a, 0xf0, 0x88, 0x96, 0xe0, 0x20, 0x7e, 0xd2, 0x30, 0x7d, 0xc5, 0xf1, 0x1b, 0x23,
+		0xc6, 0x12, 0x1b, 0x23, 0x46, 0x3b, 0x2c, 0xde, 0x20, 0x3a, 0x03, 0x07, 0xaa, 0x8e, 0x67, 0x0d,
+		0xac, 0x2a, 0x46, 0xab, 0x36, 0x79, 0x6d, 0xc4, 0x1b, 0x0c, 0x2a, 0x65, 0xe8, 0x3f, 0xe0, 0xb8,
+		0x3f, 0xfb, 0xde, 0x0a, 0xe2, 0x86, 0x5e, 0xdd, 0xb6, 0x37, 0x37, 0x27, 0x51, 0x92, 0x52, 0x4f,
+		0x52, 0xee, 0xee, 0xe2, 0xe1, 0x6d, 0x9f, 0x15, 0x5d, 0x84, 0x81, 0x3a, 0xae, 0xdb, 0xac, 0x24,
+		0x32, 0xc5, 0xbf, 0x2c, 0xc5, 0x75, 0x5b, 0xa3, 0x64, 0x48, 0x83, 0xf1, 0x48, 0xd4, 0x63, 0x75,
+		0x8d, 0xe7, 0xf9, 0xf9, 0x45, 0x28, 0x4a, 0x69, 0x63, 0x6e, 0x68, 0x04, 0x3d, 0x84, 0x89, 0x86,
+		0x83, 0x77, 0x2a, 0x7a, 0x93, 0xd8, 0x9e, 0xfe, 0x61, 0x52, 0x69, 0xd8, 0xa6, 0x45, 0x82, 0x4a,
+		0x85, 0x68, 0xbf, 0x5c, 0x4c, 0xd6, 0x28, 0x9d, 0x76, 0xc8, 0xe3, 0x5f, 0x6c, 0x12, 0xbb, 0x6b,
+		0x10, 0x5d, 0x81, 0xdc, 0x16, 0xd6, 0x0d, 0xec, 0xb0, 0x12, 0xc2, 0x71, 0x7e, 0x63, 0x0c, 0x25,
+		0xd1, 0x18, 0x29, 0xba, 0x03, 0x63, 0x0e, 0x26, 0x9e, 0xce, 0xd9, 0x56, 0xa5, 0x81, 0x1d, 0xd3,
+		0x36, 0x26, 0x8f, 0x26, 0xad, 0xee, 0xc1, 0x36, 0xcb, 0x1a, 0xe5, 0x50, 0xdf, 0x53, 0xe0, 0x05,
+		0xf9, 0x73, 0xd7, 0x55, 0xc8, 0x31, 0xab, 0x53, 0x24, 0xac, 0x8e, 0xd1, 0xa2, 0x15, 0x98, 0x89,
+		0x2f, 0xbc, 0x9b, 0x06, 0x8d, 0x11, 0x59, 0x6d, 0x5a, 0x5c, 0x33, 0x2f, 0x1b, 0xea, 0xbb, 0x0a,
+		0x9c, 0x93, 0x4c, 0xdf, 0xae, 0xc1, 0x50, 0xe0, 0x6f, 0x14, 0x09, 0x7f, 0x13, 0x10, 0xef, 0x1b,
+		0x54, 0x1b, 0x66, 0xa5, 0xcf, 0x2e, 0x4b, 0x30, 0xc2, 0x5c, 0x7e, 0x27, 0xfc, 0x8e, 0x0a, 0x54,
+		0x89, 0x79, 0x78, 0x1a, 0x7d, 0x0b, 0xa4, 0xf3, 0xa0, 0xfe, 0x4a, 0x81, 0xb3, 0x32, 0xed, 0x1b,
+		0xbd, 0x71, 0x54, 0x49, 0x17, 0x47, 0xef, 0xc1, 0x84, 0x20, 0x56, 0x65, 0x92, 0x14, 0xef, 0x90,
+		0xcb, 0x89, 0x53, 0x5d, 0xfe, 0x2a, 0xdb, 0xe3, 0xaf, 0xd4, 0x37, 0x14, 0x50, 0x93, 0x3b, 0x3f,
+		0xd0, 0x3c, 0xa0, 0x70, 0x37, 0x40, 0xbb, 0x1f, 0x6c, 0xcc, 0xed, 0x59, 0x82, 0x90, 0xd3, 0xce,
+		0x84, 0x9c, 0xf6, 0x09, 0x80, 0xe0, 0x6a, 0xd6, 0x34, 0x28, 0x9a, 0xbc, 0x96, 0x67, 0x23, 0x65,
+		0x43, 0xfd, 0x73, 0x68, 0x79, 0x85, 0x16, 0x92, 0x0e, 0xd1, 0x2c, 0x8c, 0xf5, 0xde, 0x08, 0xb5,
+		0xd5, 0x6b, 0xd4, 0xed, 0x9a, 0x71, 0x08, 0x7b, 0x36, 0x84, 0xfd, 0x3c, 0x1c, 0xdc, 0x30, 0x2d,
+		0xdd, 0xd9, 0xad, 0x54, 0xb7, 0x70, 0x75, 0xdb, 0x6d, 0xd6, 0x69, 0xa2, 0x93, 0xd7, 0x46, 0xfd,
+		0xe1, 0x25, 0x36, 0x8a, 0x2e, 0xc0, 0x78, 0xef, 0x3d, 0x26, 0x7e, 0xe2, 0x27, 0x31, 0x23, 0xda,
+		0x18, 0xee, 0xbe, 0x5e, 0xc4, 0x4f, 0x88, 0xfa, 0x7a, 0x16, 0xce, 0x48, 0x34, 0x95, 0x3c, 0xb5,
+		0x19, 0x87, 0xcd, 0x22, 0xdb, 0x87, 0x59, 0xa0, 0x93, 0x50, 0xd8, 0xd0, 0x5d, 0x1c, 0x04, 0x60,
+		0x7f, 0x59, 0xf2, 0xde, 0x90, 0x1f, 0x76, 0xa7, 0x01, 0xbc, 0x2b, 0x5c, 0xf6, 0x7a, 0xd0, 0x5f,
+		0x58, 0x0b, 0xb7, 0xfc, 0xb7, 0xf3, 0x80, 0x36, 0x6d, 0x67, 0x9b, 0x21, 0x0d, 0x3a, 0x03, 0x73,
+		0xfe, 0xd4, 0xbc, 0x37, 0x14, 0xeb, 0x23, 0x7f, 0x1c, 0x4d, 0x78, 0xce, 0x51, 0x77, 0x6d, 0x8b,
+		0x65, 0x58, 0xec, 0x09, 0xdd, 0x81, 0xc1, 0xaa, 0xde, 0x74, 0x31, 0x4b, 0xa6, 0x8a, 0xd2, 0xed,
+		0x3b, 0x4b, 0x1e, 0x97, 0xe6, 0x33, 0xab, 0xef, 0x66, 0xe1, 0x74, 0x62, 0x4b, 0xcd, 0x53, 0xdb,
+		0x8c, 0xdb, 0xc1, 0x1c, 0xfc, 0x5d, 0x98, 0x97, 0xec, 0xf8, 0xe9, 0x9e, 0x41, 0xb7, 0x4f, 0x1e,
+		0x48, 0xe3, 0x93, 0xbb, 0x55, 0x7f, 0x30, 0xa4, 0xfa, 0xa1, 0xfd, 0xcd, 0xc5, 0xef, 0xef, 0x90,
+		0xd4, 0xfe, 0x0e, 0x0b, 0xf6, 0x97, 0x63, 0x66, 0x79, 0x9e, 0x99, 0xa9, 0x5f, 0xce, 0xc1, 0x59,
+		0x99, 0x6e, 0x23, 0x74, 0x0a, 0x0a, 0xed, 0x92, 0x3d, 0xdb, 0xa6, 0xbc, 0x06, 0xc1, 0x50, 0xd9,
+		0xf0, 0x8e, 0x66, 0x6d, 0x02, 0x6a, 0x04, 0x99, 0x98, 0xa3, 0x59, 0xfb, 0x93, 0xf4, 0x68, 0xa6,
+		0x77, 0x3d, 0x79, 0xaa, 0x69, 0xd8, 0x75, 0xdd, 0xb4, 0x98, 0xef, 0x60, 0x4f, 0xbd, 0xc1, 0x60,
+		0xa0, 0xcf, 0x43, 0x55, 0x4e, 0xfe, 0x50, 0xb5, 0x0e, 0x53, 0x81, 0x12, 0x46, 0x63, 0xc8, 0x50,
+		0x52, 0x0c, 0x99, 0x08, 0x78, 0x43, 0x61, 0x24, 0x24, 0x95, 0x85, 0x28, 0x26, 0x75, 0x38, 0x85,
+		0x54, 0xff, 0x2c, 0xc5, 0xa4, 0x8a, 0x83, 0x5d, 0xbe, 0xaf, 0x60, 0xb7, 0x02, 0xe3, 0x5b, 0x58,
+		0x77, 0xc8, 0x06, 0xd6, 0x3b, 0xe8, 0x20, 0x49, 0xd4, 0x58, 0x9b, 0xa7, 0x23, 0x27, 0x39, 0x45,
+		0x29, 0x24, 0xa7, 0x28, 0x91, 0x13, 0xc7, 0x48, 0x3f, 0x27, 0x8e, 0x4e, 0xe6, 0x7a, 0x40, 0x3a,
+		0x73, 0x55, 0xff, 0xa8, 0x80, 0x9a, 0xdc, 0xf9, 0xf6, 0x91, 0x05, 0xf7, 0xee, 0x34, 0x64, 0xa0,
+		0xf7, 0xd8, 0xf4, 0x0a, 0x8c, 0xd0, 0x53, 0x67, 0xe0, 0xb7, 0x06, 0x25, 0xfc, 0x56, 0xc1, 0xe3,
+		0x60, 0x0f, 0xea, 0x6f, 0x94, 0x5e, 0x57, 0xb0, 0xcf, 0x99, 0x35, 0x7f, 0x89, 0x32, 0x29, 0xdc,
+		0x7d, 0x36, 0x31, 0xdb, 0x18, 0xe8, 0x5d, 0x4c, 0xf5, 0xd7, 0x0a, 0x9c, 0x4e, 0x6e, 0x47, 0xea,
+		0x37, 0x01, 0xff, 0x38, 0x66, 0xf4, 0xa3, 0x0c, 0x9c, 0x91, 0x68, 0xea, 0xf3, 0xe6, 0x64, 0x60,
+		0xa2, 0x9b, 0x35, 0x57, 0x6a, 0x93, 0x02, 0xe2, 0xa7, 0x36, 0xa7, 0x70, 0x86, 0x34, 0xd0, 0x4f,
+		0x86, 0xb4, 0x67, 0x15, 0xff, 0xbc, 0x02, 0x73, 0xf2, 0xbd, 0x78, 0x32, 0x31, 0x6f, 0x7f, 0x8e,
+		0x60, 0xef, 0x2b, 0x90, 0xb2, 0xeb, 0x2e, 0x19, 0xdb, 0xe1, 0x20, 0x0d, 0xf2, 0x3d, 0x8c, 0xff,
+		0x20, 0x85, 0x38, 0x2b, 0x81, 0xf8, 0xed, 0x90, 0x1e, 0x8a, 0xea, 0x73, 0xfd, 0xea, 0xe1, 0x0a,
+		0xcc, 0xd4, 0x74, 0xd2, 0xd5, 0x7d, 0x12, 0xee, 0xc5, 0xe8, 0xac, 0xac, 0x4f, 0xc7, 0xdb, 0x4a,
+		0x3f, 0x6d, 0xe2, 0xe8, 0x73, 0x36, 0x85, 0x3e, 0x0f, 0x24, 0xda, 0x68, 0x28, 0xd1, 0x53, 0x3f,
+		0x50, 0xe0, 0x78, 0x4c, 0xbf, 0xab, 0xf7, 0x7b, 0x20, 0xbf, 0xcf, 0xaf, 0xbd, 0x6f, 0x43, 0xf4,
+		0xb9, 0x6c, 0xa0, 0x55, 0x38, 0xd2, 0x0e, 0xe4, 0x9b, 0xa6, 0x93, 0xe2, 0xd0, 0x8a, 0x58, 0x1c,
+		0xf7, 0xfa, 0x59, 0xd3, 0x84, 0x5f, 0x99, 0xcd, 0xfe, 0x5f, 0x98, 0x12, 0x36, 0xd2, 0xc6, 0xcd,
+		0x46, 0x3a, 0x67, 0x57, 0x7f, 0xae, 0xc0, 0x74, 0x5c, 0x0f, 0xe5, 0xbe, 0x7c, 0x65, 0xbf, 0xd6,
+		0x23, 0xd6, 0x41, 0x7f, 0x5f, 0x81, 0x99, 0xa4, 0x5e, 0xcc, 0xb8, 0xd9, 0x3c, 0x55, 0xb3, 0x8d,
+		0x45, 0xfe, 0xb7, 0x21, 0x48, 0xd9, 0xf2, 0x83, 0x4a, 0x70, 0x98, 0x76, 0x15, 0x85, 0x2f, 0x8f,
+		0xfd, 0x39, 0x8d, 0x5b, 0xb8, 0x15, 0xba, 0x3a, 0x8e, 0xd4, 0x6f, 0x32, 0xfd, 0xd5, 0x6f, 0x9e,
+		0x55, 0x58, 0xe4, 0x2b, 0x2c, 0x32, 0xba, 0x33, 0x24, 0xa1, 0x3b, 0xf7, 0x61, 0x82, 0xdd, 0x8c,
+		0x33, 0x8c, 0xa6, 0x45, 0xb0, 0xb3, 0xa3, 0xd7, 0x92, 0xcf, 0x2d, 0x87, 0x19, 0x23, 0x85, 0x57,
+		0x66, 0x6c, 0xbd, 0xd5, 0x9b, 0xfc, 0x9e, 0xaa, 0x37, 0x5d, 0x29, 0x1c, 0xa4, 0x49, 0xe1, 0xc4,
+		0xa5, 0x9a, 0x42, 0xdf, 0xa5, 0x9a, 0xce, 0x39, 0x63, 0x44, 0xfe, 0x86, 0x3c, 0x28, 0x18, 0x1c,
+		0xd8, 0x43, 0xc1, 0x60, 0x74, 0x4f, 0x05, 0x03, 0xcf, 0x07, 0x97, 0xd2, 0xf6, 0x1d, 0xb6, 0xbd,
+		0x95, 0xd2, 0xed, 0xad, 0xe2, 0xce, 0x37, 0x1b, 0x70, 0xb4, 0xdd, 0xab, 0x10, 0xaa, 0xbd, 0xfa,
+		0x76, 0x3c, 0x17, 0xdb, 0x8d, 0xd0, 0x5b, 0x7d, 0x3d, 0x82, 0x79, 0xc3, 0xea, 0x37, 0x14, 0x98,
+		0x15, 0xcc, 0x84, 0x57, 0x52, 0x4e, 0x36, 0x0f, 0x45, 0xc2, 0x3c, 0xba, 0x32, 0x9d, 0x4c, 0x8a,
+		0x4c, 0x47, 0xfd, 0x50, 0x81, 0x13, 0xb1, 0x7d, 0xf3, 0x5e, 0xaa, 0xc7, 0xba, 0xf2, 0x2d, 0xbd,
+		0x1e, 0x2c, 0x35, 0xf8, 0x43, 0xf7, 0xf4, 0x3a, 0xee, 0xf7, 0xd3, 0xfb, 0x16, 0x55, 0x3a, 0x1a,
+		0x3f, 0x20, 0x7f, 0xb2, 0xfe, 0x22, 0x6f, 0x93, 0x44, 0x7d, 0x22, 0xa7, 0xa0, 0xc0, 0x3a, 0x75,
+		0xba, 0x97, 0xc0, 0x1f, 0xa2, 0x4b, 0xd0, 0x76, 0xea, 0x19, 0x79, 0xa7, 0x1e, 0x73, 0x4f, 0xad,
+		0x7e, 0x41, 0x81, 0xb9, 0x14, 0xbd, 0x51, 0x9d, 0xfb, 0x54, 0xa5, 0xe7, 0x3e, 0xb5, 0xdf, 0x9d,
+		0x89, 0x83, 0x56, 0xe1, 0x54, 0xc1, 0x84, 0x9d, 0x20, 0x22, 0x60, 0x31, 0x26, 0xaa, 0xfe, 0x0f,
+		0xa7, 0x1f, 0x83, 0xdf, 0xc9, 0xd1, 0x97, 0xf8, 0x9f, 0x64, 0xe0, 0xe5, 0xbd, 0xf5, 0xb7, 0xef,
+		0x9b, 0xcd, 0x76, 0xee, 0x1a, 0x33, 0x3d, 0x77, 0x8d, 0x0f, 0x01, 0x45, 0xdb, 0x67, 0x98, 0x7f,
+		0x3a, 0x27, 0xd9, 0x25, 0x33, 0x1e, 0xe9, 0x86, 0xf1, 0x2e, 0x6f, 0xaa, 0xb6, 0x45, 0x1c, 0xbb,
+		0x46, 0x0d, 0x65, 0x44, 0x0b, 0x1e, 0x51, 0x11, 0x0e, 0x85, 0x5a, 0x02, 0x6d, 0xab, 0xe6, 0x9f,
+		0x2c, 0x86, 0xb5, 0xf1, 0x9e, 0x4e, 0xbd, 0xfb, 0x56, 0x6d, 0x57, 0x7d, 0x2b, 0x0b, 0xb7, 0xf6,
+		0xd0, 0x3f, 0x8f, 0x1e, 0x76, 0xfb, 0xed, 0x51, 0xc1, 0xaf, 0x53, 0xa4, 0x24, 0xf7, 0x5c, 0x9b,
+		0xef, 0xd3, 0x79, 0x58, 0x78, 0x07, 0xcc, 0xdf, 0x97, 0x81, 0xbd, 0xee, 0xcb, 0x3c, 0xa0, 0x70,
+		0xd7, 0x22, 0xab, 0xb0, 0x64, 0xb5, 0x31, 0xb3, 0x47, 0x09, 0xfd, 0x2b, 0xb8, 0x60, 0x17, 0x73,
+		0x3d, 0xbb, 0xa8, 0xfe, 0x56, 0x81, 0xeb, 0x7d, 0x36, 0xff, 0x0b, 0x30, 0x28, 0x02, 0x0c, 0x1f,
+		0xad, 0xe2, 0xaa, 0x9f, 0xcd, 0xc2, 0xf5, 0x3e, 0x1b, 0x34, 0xff, 0x59, 0x6d, 0x35, 0x14, 0x71,
+		0x06, 0xc4, 0x11, 0x67, 0x50, 0x3e, 0xe2, 0x08, 0x55, 0x47, 0xe4, 0x00, 0x86, 0x44, 0x0e, 0xe0,
+		0xf5, 0x2c, 0x5c, 0xed, 0xa7, 0xc9, 0x54, 0xce, 0xf2, 0xa5, 0x24, 0x3f, 0xb3, 0xfc, 0x8e, 0xe5,
+		0xff, 0x49, 0x81, 0x4b, 0x69, 0x1b, 0x66, 0xff, 0xa1, 0x4d, 0x5e, 0x1c, 0xab, 0xd4, 0x5f, 0x2a,
+		0x70, 0x31, 0x55, 0x93, 0xed, 0xbe, 0xb9, 0x00, 0xee, 0xa9, 0x27, 0xb3, 0xb7, 0x53, 0xcf, 0xef,
+		0x87, 0xe1, 0x4a, 0x1f, 0xbf, 0x16, 0xea, 0xda, 0x0e, 0xa5, 0x67, 0x3b, 0x4e, 0x41, 0xa1, 0xbd,
+		0x1d, 0x4c, 0xe7, 0xf3, 0x1a, 0x04, 0x43, 0xbc, 0x2b, 0x90, 0xec, 0x3e, 0x5c, 0x81, 0xf4, 0x5b,
+		0x0f, 0x1d, 0xdc, 0xdf, 0x2b, 0x90, 0xdc, 0x53, 0xbd, 0x02, 0x19, 0xea, 0xfb, 0x0a, 0xe4, 0x11,
+		0xb0, 0x3e, 0x5d, 0x26, 0x91, 0x95, 0x11, 0xfd, 0x26, 0x87, 0x73, 0x31, 0xcd, 0xbe, 0x54, 0x0a,
+		0x2b, 0x26, 0x8e, 0x37, 0xc2, 0x43, 0xdd, 0x46, 0x92, 0xef, 0xf5, 0xe7, 0x32, 0x2a, 0x0f, 0x12,
+		0x2a, 0x5f, 0x85, 0xc9, 0x2e, 0x75, 0xaa, 0x38, 0xb8, 0xd9, 0x81, 0x5f, 0xa0, 0xf0, 0xe7, 0x62,
+		0x15, 0xa7, 0x6c, 0x68, 0xb8, 0x19, 0xe0, 0xd5, 0x8e, 0xb4, 0x78, 0xc3, 0x91, 0xf2, 0xea, 0x81,
+		0x7e, 0xca, 0xab, 0x91, 0x8e, 0xcb, 0x51, 0x4e, 0xc7, 0x65, 0xe7, 0xa4, 0x78, 0x30, 0xfd, 0xdd,
+		0xc8, 0xd8, 0x1e, 0xee, 0x46, 0xc6, 0xf7, 0xd6, 0x4c, 0x79, 0x13, 0x0a, 0x06, 0xae, 0xe9, 0xbb,
+		0xbe, 0x6a, 0x26, 0x77, 0x86, 0x02, 0xa5, 0xa6, 0xaa, 0xa8, 0xbe, 0x99, 0x85, 0x4b, 0x69, 0x7f,
+		0xcd, 0xf7, 0xf1, 0xbb, 0x97, 0xd5, 0x20, 0x4f, 0xf0, 0x2b, 0x75, 0xd7, 0x52, 0xff, 0x14, 0xad,
+		0x27, 0x3d, 0xe8, 0x32, 0x94, 0xc1, 0x5e, 0x43, 0xe1, 0x07, 0xc1, 0x9c, 0x20, 0x08, 0xee, 0xd3,
+		0x5d, 0xa6, 0xfa, 0x8b, 0x0c, 0xcc, 0xa7, 0xf9, 0xa9, 0xa2, 0x70, 0x3f, 0xf8, 0xd1, 0x37, 0xb3,
+		0xd7, 0xe8, 0xbb, 0x5f, 0xbb, 0xc8, 0x5f, 0xdd, 0x01, 0xc1, 0xea, 0x76, 0xac, 0x73, 0x50, 0xfe,
+		0x1e, 0xe7, 0xc3, 0x0c, 0xa4, 0xfc, 0x11, 0xe5, 0x27, 0x63, 0x31, 0x79, 0x65, 0xa9, 0x41, 0x6e,
+		0x59, 0xaa, 0xd3, 0x4f, 0x91, 0x93, 0xef, 0xa7, 0x50, 0xff, 0x92, 0x81, 0x0b, 0xfb, 0xe1, 0x51,
+		0x3e, 0xa1, 0x8b, 0xde, 0x55, 0x31, 0xc8, 0xa5, 0xa8, 0x18, 0xa8, 0x7f, 0xcd, 0xc0, 0xc5, 0x54,
+		0xbf, 0x69, 0x7d, 0xb6, 0xf0, 0x91, 0x85, 0x0f, 0xae, 0x44, 0x73, 0x69, 0xee, 0xc9, 0x3f, 0x95,
+		0x15, 0x2d, 0xbc, 0xa8, 0x07, 0xe6, 0xd9, 0xc2, 0xc7, 0xb6, 0xe0, 0xe4, 0xfa, 0xe9, 0xdd, 0xff,
+		0x71, 0x06, 0x4a, 0x29, 0x7f, 0x6b, 0xfc, 0x6c, 0x1f, 0x7a, 0xf6, 0x61, 0x8e, 0xc0, 0x41, 0xfa,
+		0xe7, 0x8a, 0x59, 0x23, 0xd8, 0xa1, 0x9f, 0x3a, 0x01, 0x53, 0xcb, 0x8f, 0x96, 0xef, 0xad, 0x57,
+		0x56, 0xca, 0xab, 0xeb, 0xcb, 0x5a, 0x65, 0xfd, 0x3f, 0xd7, 0x96, 0x2b, 0xe5, 0x7b, 0x8f, 0x16,
+		0x57, 0xcb, 0x77, 0xc6, 0x9e, 0x43, 0xa7, 0xe0, 0x78, 0xf4, 0xf5, 0xe2, 0xea, 0x6a, 0x85, 0x8e,
+		0x8e, 0x29, 0xe8, 0x34, 0x9c, 0x88, 0x12, 0x2c, 0xad, 0xde, 0x7f, 0xb0, 0xcc, 0x48, 0x32, 0xb7,
+		0x1f, 0xc1, 0xd1, 0xaa, 0x5d, 0xe7, 0xad, 0xc1, 0xed, 0xe1, 0xc5, 0x86, 0xb9, 0xe6, 0xd8, 0xc4,
+		0x5e, 0x53, 0xfe, 0xab, 0xf4, 0xd8, 0x24, 0x5b, 0xcd, 0x8d, 0x62, 0xd5, 0xae, 0x97, 0x7a, 0xfe,
+		0x63, 0x6e, 0xf1, 0x31, 0xb6, 0xfc, 0xff, 0xd1, 0xcb, 0xfe, 0x79, 0xee, 0x2d, 0xbd, 0x61, 0xee,
+		0x5c, 0xde, 0xc8, 0xd1, 0xb1, 0x2b, 0x7f, 0x1f, 0x00, 0xc8, 0xbb, 0x5d, 0x84, 0x1f, 0x58, 0x00,
+		0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -267,7 +273,7 @@ var yarpcFileDescriptorClosure8237ca6511ad6c62 = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -282,61 +288,62 @@ var yarpcFileDescriptorClosure8237ca6511ad6c62 = [][]byte{
 		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
 		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
 		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
-		0x14, 0x9f, 0xe2, 0xda, 0x49, 0x9f, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xfe, 0x79, 0x06, 0x86,
-		0x66, 0x3b, 0x48, 0x88, 0x7b, 0x29, 0x56, 0x14, 0x83, 0x13, 0x3b, 0xab, 0xda, 0x2d, 0x31, 0x64,
-		0x23, 0xc1, 0x76, 0x98, 0x40, 0x4b, 0x4f, 0x2e, 0x67, 0x89, 0x14, 0x28, 0xca, 0x89, 0x6f, 0xfb,
-		0x24, 0x3b, 0xec, 0x2b, 0xed, 0x0b, 0x0d, 0x94, 0xe8, 0xd8, 0xee, 0x3c, 0xf4, 0x32, 0xec, 0x46,
-		0xbe, 0xdf, 0x9f, 0xf7, 0xa3, 0xf0, 0x48, 0x41, 0x3b, 0x9f, 0xa0, 0x74, 0x02, 0x1a, 0x22, 0x0f,
-		0xd0, 0xa1, 0x29, 0x73, 0xe6, 0xa7, 0x4e, 0x20, 0x92, 0x44, 0x70, 0x3b, 0x95, 0x42, 0x09, 0x72,
-		0xa0, 0x19, 0xb6, 0x61, 0xd8, 0x34, 0x65, 0xf6, 0xfc, 0xf4, 0xf8, 0x8b, 0xa9, 0x10, 0xd3, 0x18,
-		0x9d, 0x82, 0x32, 0xc9, 0x23, 0x27, 0xcc, 0x25, 0x55, 0x6c, 0x29, 0xea, 0xbc, 0x85, 0x8f, 0x6f,
-		0x84, 0x9c, 0x45, 0xb1, 0xb8, 0x1d, 0xdc, 0x61, 0x90, 0x6b, 0x88, 0x7c, 0x09, 0xf5, 0x5b, 0x53,
-		0xf4, 0x59, 0xd8, 0xb2, 0xda, 0xd6, 0xc9, 0x43, 0x0f, 0x96, 0x25, 0x37, 0x24, 0x4f, 0xa0, 0x26,
-		0x73, 0xae, 0xb1, 0x9d, 0x02, 0xab, 0xca, 0x9c, 0xbb, 0x61, 0xa7, 0x03, 0x8d, 0xa5, 0xd9, 0x78,
-		0x91, 0x22, 0x21, 0xf0, 0x80, 0xd3, 0x04, 0x8d, 0x41, 0xb1, 0xd6, 0x9c, 0x5e, 0xa0, 0xd8, 0x9c,
-		0xa9, 0xc5, 0xbf, 0x72, 0x3e, 0x87, 0xdd, 0x21, 0x5d, 0xc4, 0x82, 0x86, 0x1a, 0x0e, 0xa9, 0xa2,
-		0x05, 0xdc, 0xf0, 0x8a, 0x75, 0xe7, 0x25, 0xec, 0x5e, 0x50, 0x16, 0xe7, 0x12, 0xc9, 0x21, 0xd4,
-		0x24, 0xd2, 0x4c, 0x70, 0xa3, 0x37, 0x3b, 0xd2, 0x82, 0xdd, 0x10, 0x15, 0x65, 0x71, 0x56, 0x24,
-		0x6c, 0x78, 0xcb, 0x6d, 0xe7, 0x0f, 0x0b, 0x1e, 0xfc, 0x84, 0x89, 0x20, 0xaf, 0xa0, 0x16, 0x31,
-		0x8c, 0xc3, 0xac, 0x65, 0xb5, 0x2b, 0x27, 0xf5, 0xee, 0xd7, 0xf6, 0x96, 0xef, 0x67, 0x6b, 0xaa,
-		0x7d, 0x51, 0xf0, 0x06, 0x5c, 0xc9, 0x85, 0x67, 0x44, 0xc7, 0x37, 0x50, 0x5f, 0x2b, 0x93, 0x26,
-		0x54, 0x66, 0xb8, 0x30, 0x29, 0xf4, 0x92, 0x74, 0xa1, 0x3a, 0xa7, 0x71, 0x8e, 0x45, 0x80, 0x7a,
-		0xf7, 0xb3, 0xad, 0xf6, 0xe6, 0x98, 0x5e, 0x49, 0xfd, 0x6e, 0xe7, 0x85, 0xd5, 0xf9, 0xd3, 0x82,
-		0xda, 0x6b, 0xa4, 0x21, 0x4a, 0xf2, 0xfd, 0x7b, 0x11, 0x9f, 0x6d, 0xf5, 0x28, 0xc9, 0xff, 0x6f,
-		0xc8, 0xbf, 0x2c, 0x68, 0x8e, 0x90, 0xca, 0xe0, 0x5d, 0x4f, 0x29, 0xc9, 0x26, 0xb9, 0xc2, 0x8c,
-		0xf8, 0xb0, 0xcf, 0x78, 0x88, 0x77, 0x18, 0xfa, 0x1b, 0xb1, 0x5f, 0x6c, 0x75, 0x7d, 0x5f, 0x6e,
-		0xbb, 0xa5, 0x76, 0xfd, 0x1c, 0x8f, 0xd8, 0x7a, 0xed, 0xf8, 0x57, 0x20, 0xff, 0x24, 0xfd, 0x87,
-		0xa7, 0x8a, 0x60, 0xaf, 0x4f, 0x15, 0x3d, 0x8b, 0xc5, 0x84, 0x5c, 0xc0, 0x23, 0xe4, 0x81, 0x08,
-		0x19, 0x9f, 0xfa, 0x6a, 0x91, 0x96, 0x03, 0xba, 0xdf, 0xfd, 0x6a, 0xab, 0xd7, 0xc0, 0x30, 0xf5,
-		0x44, 0x7b, 0x0d, 0x5c, 0xdb, 0xdd, 0x0f, 0xf0, 0xce, 0xda, 0x00, 0x0f, 0xcb, 0x4b, 0x87, 0xf2,
-		0x1a, 0x65, 0xc6, 0x04, 0x77, 0x79, 0x24, 0x34, 0x91, 0x25, 0x69, 0xbc, 0xbc, 0x08, 0x7a, 0x4d,
-		0x9e, 0xc1, 0xe3, 0x08, 0xa9, 0xca, 0x25, 0xfa, 0xf3, 0x92, 0x6a, 0x2e, 0xdc, 0xbe, 0x29, 0x1b,
-		0x83, 0xce, 0x5b, 0x78, 0x3a, 0xca, 0xd3, 0x54, 0x48, 0x85, 0xe1, 0x79, 0xcc, 0x90, 0x2b, 0x83,
-		0x64, 0xfa, 0xae, 0x4e, 0x85, 0x9f, 0x85, 0x33, 0xe3, 0x5c, 0x9d, 0x8a, 0x51, 0x38, 0x23, 0x47,
-		0xb0, 0xf7, 0x1b, 0x9d, 0xd3, 0x02, 0x28, 0x3d, 0x77, 0xf5, 0x7e, 0x14, 0xce, 0x3a, 0xbf, 0x57,
-		0xa0, 0xee, 0xa1, 0x92, 0x8b, 0xa1, 0x88, 0x59, 0xb0, 0x20, 0x7d, 0x68, 0x32, 0xce, 0x14, 0xa3,
-		0xb1, 0xcf, 0xb8, 0x42, 0x39, 0xa7, 0x65, 0xca, 0x7a, 0xf7, 0xc8, 0x2e, 0x9f, 0x17, 0x7b, 0xf9,
-		0xbc, 0xd8, 0x7d, 0xf3, 0xbc, 0x78, 0x8f, 0x8d, 0xc4, 0x35, 0x0a, 0xe2, 0xc0, 0xc1, 0x84, 0x06,
-		0x33, 0x11, 0x45, 0x7e, 0x20, 0x30, 0x8a, 0x58, 0xa0, 0x63, 0x16, 0xbd, 0x2d, 0x8f, 0x18, 0xe8,
-		0x7c, 0x85, 0xe8, 0xb6, 0x09, 0xbd, 0x63, 0x49, 0x9e, 0xac, 0xda, 0x56, 0x3e, 0xd8, 0xd6, 0x48,
-		0xee, 0xdb, 0x7e, 0xb3, 0x72, 0xa1, 0x4a, 0x61, 0x92, 0xaa, 0xac, 0xf5, 0xa0, 0x6d, 0x9d, 0x54,
-		0xef, 0xa9, 0x3d, 0x53, 0x26, 0xaf, 0xe0, 0x53, 0x2e, 0xb8, 0x2f, 0xf5, 0xd1, 0xe9, 0x24, 0x46,
-		0x1f, 0xa5, 0x14, 0xd2, 0x2f, 0x9f, 0x94, 0xac, 0x55, 0x6d, 0x57, 0x4e, 0x1e, 0x7a, 0x2d, 0x2e,
-		0xb8, 0xb7, 0x64, 0x0c, 0x34, 0xc1, 0x2b, 0x71, 0xf2, 0x06, 0x0e, 0xf0, 0x2e, 0x65, 0x65, 0x90,
-		0x55, 0xe4, 0xda, 0x87, 0x22, 0x93, 0x95, 0x6a, 0x99, 0xfa, 0xdb, 0x5b, 0x68, 0xac, 0xcf, 0x14,
-		0x39, 0x82, 0x27, 0x83, 0xcb, 0xf3, 0xab, 0xbe, 0x7b, 0xf9, 0x83, 0x3f, 0xfe, 0x79, 0x38, 0xf0,
-		0xdd, 0xcb, 0xeb, 0xde, 0x8f, 0x6e, 0xbf, 0xf9, 0x11, 0x39, 0x86, 0xc3, 0x4d, 0x68, 0xfc, 0xda,
-		0x73, 0x2f, 0xc6, 0xde, 0x4d, 0xd3, 0x22, 0x87, 0x40, 0x36, 0xb1, 0x37, 0xa3, 0xab, 0xcb, 0xe6,
-		0x0e, 0x69, 0xc1, 0x27, 0x9b, 0xf5, 0xa1, 0x77, 0x35, 0xbe, 0x7a, 0xde, 0xac, 0x9c, 0x5d, 0xc3,
-		0xd3, 0x40, 0x24, 0xdb, 0x86, 0xfc, 0x6c, 0xaf, 0x97, 0xb2, 0xa1, 0x4e, 0x3f, 0xb4, 0x7e, 0x71,
-		0xa6, 0x4c, 0xbd, 0xcb, 0x27, 0x76, 0x20, 0x12, 0x67, 0xe3, 0xc7, 0x64, 0x4f, 0x91, 0x97, 0x3f,
-		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x03, 0x00,
-		0x00, 0xff, 0xff, 0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe2, 0xd8, 0x49, 0x8f, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xfe, 0x3c, 0x03, 0x43,
+		0xb3, 0x5d, 0x48, 0x88, 0x7b, 0x53, 0xac, 0x28, 0x06, 0x27, 0x76, 0x56, 0x75, 0x5b, 0x62, 0x28,
+		0x46, 0x82, 0x0d, 0xd8, 0x04, 0x5a, 0x3a, 0x72, 0x39, 0x4b, 0xa4, 0x40, 0x51, 0x4e, 0x7c, 0xb7,
+		0x27, 0xd9, 0xc5, 0x5e, 0x69, 0x2f, 0x34, 0x50, 0xa2, 0x63, 0xbb, 0xf3, 0x90, 0x9b, 0x61, 0x77,
+		0xe4, 0xf9, 0x7e, 0xf8, 0x91, 0x38, 0x24, 0xa1, 0x9d, 0x8f, 0x51, 0x3a, 0x01, 0x0d, 0x91, 0x07,
+		0xe8, 0xd0, 0x94, 0x39, 0xb3, 0x13, 0x27, 0x10, 0x49, 0x22, 0xb8, 0x9d, 0x4a, 0xa1, 0x04, 0xd9,
+		0xd7, 0x0c, 0xdb, 0x30, 0x6c, 0x9a, 0x32, 0x7b, 0x76, 0x72, 0xf4, 0xd9, 0x44, 0x88, 0x49, 0x8c,
+		0x4e, 0x41, 0x19, 0xe7, 0x91, 0x13, 0xe6, 0x92, 0x2a, 0xb6, 0x10, 0x75, 0xbe, 0x87, 0x0f, 0x6f,
+		0x84, 0x9c, 0x46, 0xb1, 0xb8, 0x1d, 0xdc, 0x61, 0x90, 0x6b, 0x88, 0x7c, 0x0e, 0xf5, 0x5b, 0x53,
+		0xf4, 0x59, 0xd8, 0xb2, 0xda, 0xd6, 0xf1, 0x23, 0x0f, 0x16, 0x25, 0x37, 0x24, 0x4f, 0xa1, 0x26,
+		0x73, 0xae, 0xb1, 0xad, 0x02, 0xab, 0xca, 0x9c, 0xbb, 0x61, 0xa7, 0x03, 0x8d, 0x85, 0xd9, 0x68,
+		0x9e, 0x22, 0x21, 0xb0, 0xcd, 0x69, 0x82, 0xc6, 0xa0, 0x18, 0x6b, 0x4e, 0x2f, 0x50, 0x6c, 0xc6,
+		0xd4, 0xfc, 0x5f, 0x39, 0x9f, 0xc2, 0xce, 0x90, 0xce, 0x63, 0x41, 0x43, 0x0d, 0x87, 0x54, 0xd1,
+		0x02, 0x6e, 0x78, 0xc5, 0xb8, 0xf3, 0x0a, 0x76, 0xce, 0x29, 0x8b, 0x73, 0x89, 0xe4, 0x00, 0x6a,
+		0x12, 0x69, 0x26, 0xb8, 0xd1, 0x9b, 0x19, 0x69, 0xc1, 0x4e, 0x88, 0x8a, 0xb2, 0x38, 0x2b, 0x12,
+		0x36, 0xbc, 0xc5, 0xb4, 0xf3, 0x87, 0x05, 0xdb, 0x3f, 0x62, 0x22, 0xc8, 0x6b, 0xa8, 0x45, 0x0c,
+		0xe3, 0x30, 0x6b, 0x59, 0xed, 0xca, 0x71, 0xbd, 0xfb, 0xa5, 0xbd, 0xe1, 0xfc, 0x6c, 0x4d, 0xb5,
+		0xcf, 0x0b, 0xde, 0x80, 0x2b, 0x39, 0xf7, 0x8c, 0xe8, 0xe8, 0x06, 0xea, 0x2b, 0x65, 0xd2, 0x84,
+		0xca, 0x14, 0xe7, 0x26, 0x85, 0x1e, 0x92, 0x2e, 0x54, 0x67, 0x34, 0xce, 0xb1, 0x08, 0x50, 0xef,
+		0x7e, 0xb2, 0xd1, 0xde, 0x6c, 0xd3, 0x2b, 0xa9, 0xdf, 0x6c, 0xbd, 0xb4, 0x3a, 0x7f, 0x5a, 0x50,
+		0x7b, 0x83, 0x34, 0x44, 0x49, 0xbe, 0x7d, 0x2f, 0xe2, 0xf3, 0x8d, 0x1e, 0x25, 0xf9, 0xff, 0x0d,
+		0xf9, 0x97, 0x05, 0xcd, 0x2b, 0xa4, 0x32, 0x78, 0xd7, 0x53, 0x4a, 0xb2, 0x71, 0xae, 0x30, 0x23,
+		0x3e, 0xec, 0x31, 0x1e, 0xe2, 0x1d, 0x86, 0xfe, 0x5a, 0xec, 0x97, 0x1b, 0x5d, 0xdf, 0x97, 0xdb,
+		0x6e, 0xa9, 0x5d, 0xdd, 0xc7, 0x63, 0xb6, 0x5a, 0x3b, 0xfa, 0x15, 0xc8, 0x3f, 0x49, 0xff, 0xe1,
+		0xae, 0x22, 0xd8, 0xed, 0x53, 0x45, 0x4f, 0x63, 0x31, 0x26, 0xe7, 0xf0, 0x18, 0x79, 0x20, 0x42,
+		0xc6, 0x27, 0xbe, 0x9a, 0xa7, 0x65, 0x83, 0xee, 0x75, 0xbf, 0xd8, 0xe8, 0x35, 0x30, 0x4c, 0xdd,
+		0xd1, 0x5e, 0x03, 0x57, 0x66, 0xf7, 0x0d, 0xbc, 0xb5, 0xd2, 0xc0, 0xc3, 0xf2, 0xd2, 0xa1, 0xbc,
+		0x46, 0x99, 0x31, 0xc1, 0x5d, 0x1e, 0x09, 0x4d, 0x64, 0x49, 0x1a, 0x2f, 0x2e, 0x82, 0x1e, 0x93,
+		0xe7, 0xf0, 0x24, 0x42, 0xaa, 0x72, 0x89, 0xfe, 0xac, 0xa4, 0x9a, 0x0b, 0xb7, 0x67, 0xca, 0xc6,
+		0xa0, 0xf3, 0x0b, 0x3c, 0xbb, 0xca, 0xd3, 0x54, 0x48, 0x85, 0xe1, 0x59, 0xcc, 0x90, 0x2b, 0x83,
+		0x64, 0xfa, 0xae, 0x4e, 0x84, 0x9f, 0x85, 0x53, 0xe3, 0x5c, 0x9d, 0x88, 0xab, 0x70, 0x4a, 0x0e,
+		0x61, 0xf7, 0x37, 0x3a, 0xa3, 0x05, 0x50, 0x7a, 0xee, 0xe8, 0xb9, 0x86, 0x9a, 0x50, 0x09, 0x62,
+		0xd6, 0xaa, 0x94, 0x07, 0x1a, 0xc4, 0xac, 0xf3, 0x7b, 0x05, 0xea, 0x1e, 0x2a, 0x39, 0x1f, 0x8a,
+		0x98, 0x05, 0x73, 0xd2, 0x87, 0x26, 0xe3, 0x4c, 0x31, 0x1a, 0xfb, 0x8c, 0x2b, 0x94, 0x33, 0x5a,
+		0xe6, 0xae, 0x77, 0x0f, 0xed, 0xf2, 0xc1, 0xb1, 0x17, 0x0f, 0x8e, 0xdd, 0x37, 0x0f, 0x8e, 0xf7,
+		0xc4, 0x48, 0x5c, 0xa3, 0x20, 0x0e, 0xec, 0x8f, 0x69, 0x30, 0x15, 0x51, 0xe4, 0x07, 0x02, 0xa3,
+		0x88, 0x05, 0x3a, 0x78, 0x91, 0xc6, 0xf2, 0x88, 0x81, 0xce, 0x96, 0x88, 0x5e, 0x36, 0xa1, 0x77,
+		0x2c, 0xc9, 0x93, 0xe5, 0xb2, 0x95, 0x07, 0x97, 0x35, 0x92, 0xfb, 0x65, 0xbf, 0x5a, 0xba, 0x50,
+		0xa5, 0x30, 0x49, 0x55, 0xd6, 0xda, 0x6e, 0x5b, 0xc7, 0xd5, 0x7b, 0x6a, 0xcf, 0x94, 0xc9, 0x6b,
+		0xf8, 0x98, 0x0b, 0xee, 0x4b, 0xbd, 0x75, 0x3a, 0x8e, 0xd1, 0x47, 0x29, 0x85, 0xf4, 0xcb, 0x47,
+		0x26, 0x6b, 0x55, 0xdb, 0x95, 0xe3, 0x47, 0x5e, 0x8b, 0x0b, 0xee, 0x2d, 0x18, 0x03, 0x4d, 0xf0,
+		0x4a, 0x9c, 0xbc, 0x85, 0x7d, 0xbc, 0x4b, 0x59, 0x19, 0x64, 0x19, 0xb9, 0xf6, 0x50, 0x64, 0xb2,
+		0x54, 0x2d, 0x52, 0x7f, 0x7d, 0x0b, 0x8d, 0xd5, 0x2e, 0x23, 0x87, 0xf0, 0x74, 0x70, 0x71, 0x76,
+		0xd9, 0x77, 0x2f, 0xbe, 0xf3, 0x47, 0x3f, 0x0d, 0x07, 0xbe, 0x7b, 0x71, 0xdd, 0xfb, 0xc1, 0xed,
+		0x37, 0x3f, 0x20, 0x47, 0x70, 0xb0, 0x0e, 0x8d, 0xde, 0x78, 0xee, 0xf9, 0xc8, 0xbb, 0x69, 0x5a,
+		0xe4, 0x00, 0xc8, 0x3a, 0xf6, 0xf6, 0xea, 0xf2, 0xa2, 0xb9, 0x45, 0x5a, 0xf0, 0xd1, 0x7a, 0x7d,
+		0xe8, 0x5d, 0x8e, 0x2e, 0x5f, 0x34, 0x2b, 0xa7, 0xd7, 0xf0, 0x2c, 0x10, 0xc9, 0xa6, 0xb6, 0x3f,
+		0xdd, 0xed, 0xa5, 0x6c, 0xa8, 0xd3, 0x0f, 0xad, 0x9f, 0x9d, 0x09, 0x53, 0xef, 0xf2, 0xb1, 0x1d,
+		0x88, 0xc4, 0x59, 0xfb, 0xaa, 0xec, 0x09, 0xf2, 0xf2, 0xfb, 0x31, 0xbf, 0xd6, 0x2b, 0x9a, 0xb2,
+		0xd9, 0xc9, 0xb8, 0x56, 0xd4, 0x5e, 0xfc, 0x3d, 0x00, 0x2f, 0xa5, 0x18, 0x7d, 0xd9, 0x06, 0x00,
+		0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
@@ -385,8 +392,8 @@ var yarpcFileDescriptorClosure8237ca6511ad6c62 = [][]byte{
 		0xd4, 0x75, 0x36, 0x80, 0xa3, 0xb3, 0x24, 0xbc, 0x0a, 0x93, 0x6b, 0x77, 0xeb, 0xf4, 0x0a, 0xbd,
 		0xcc, 0x44, 0xb9, 0x49, 0xd1, 0xd3, 0xfe, 0xa8, 0xe6, 0x33, 0x23, 0xc8, 0xcc, 0xf9, 0x23, 0x98,
 		0x73, 0x7d, 0xd3, 0xa4, 0x7e, 0x26, 0xca, 0xe0, 0x93, 0x6f, 0xd2, 0x9f, 0x43, 0xd5, 0xfe, 0x5b,
-		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0x58, 0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
+		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x77, 0x00, 0x58,
+		0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -405,153 +412,157 @@ var yarpcFileDescriptorClosure8237ca6511ad6c62 = [][]byte{
 		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
 		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
 		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xca,
-		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xc7, 0xca, 0xb7, 0xa3, 0xfb, 0x26,
-		0x71, 0xd4, 0x1b, 0xf9, 0x3a, 0xb9, 0xb9, 0x69, 0x6e, 0x9a, 0xa6, 0x34, 0x49, 0xc7, 0x4c, 0x64,
-		0x4a, 0x1d, 0x51, 0x71, 0x7c, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x72, 0x94,
-		0xc4, 0xfb, 0x02, 0x5d, 0x77, 0x57, 0xb4, 0x9b, 0xfe, 0x80, 0x02, 0x45, 0x7f, 0x40, 0xd1, 0xa2,
-		0x8b, 0xee, 0xba, 0xed, 0xb2, 0xfb, 0xfe, 0x8b, 0x62, 0x86, 0x43, 0x89, 0xb2, 0x3e, 0xa8, 0xb4,
-		0xc0, 0xed, 0xce, 0x3c, 0x7c, 0x9e, 0x87, 0x67, 0xce, 0x9c, 0xf3, 0x70, 0x68, 0x41, 0xa1, 0x7b,
-		0x4c, 0x82, 0xed, 0x86, 0xd3, 0x24, 0x5e, 0x83, 0x6c, 0x3b, 0x1d, 0x77, 0xfb, 0xc3, 0xce, 0xf6,
-		0x47, 0x3f, 0x78, 0x7f, 0xd2, 0xf2, 0x3f, 0x96, 0x3a, 0x81, 0x4f, 0x7d, 0xb4, 0xc6, 0x30, 0x25,
-		0x81, 0x29, 0x39, 0x1d, 0xb7, 0xf4, 0x61, 0xe7, 0xda, 0xad, 0x53, 0xdf, 0x3f, 0x6d, 0x91, 0x6d,
-		0x0e, 0x39, 0xee, 0x9e, 0x6c, 0x37, 0xbb, 0x81, 0x43, 0x5d, 0xdf, 0x8b, 0x48, 0xd7, 0x6e, 0x5f,
-		0xbc, 0x4f, 0xdd, 0x36, 0x09, 0xa9, 0xd3, 0xee, 0x08, 0xc0, 0xe6, 0xa8, 0x27, 0x37, 0xfc, 0x76,
-		0xbb, 0x27, 0x31, 0x32, 0x37, 0xea, 0x84, 0xef, 0x5b, 0x6e, 0x48, 0x23, 0x4c, 0xe1, 0x2f, 0x73,
-		0xb0, 0x7e, 0x28, 0xd2, 0xd5, 0x3f, 0x91, 0x46, 0x97, 0xa5, 0x60, 0x78, 0x27, 0x3e, 0xaa, 0x03,
-		0x8a, 0xd7, 0x61, 0x93, 0xf8, 0x4e, 0x5e, 0xda, 0x94, 0xb6, 0x72, 0x8f, 0xee, 0x95, 0x46, 0x2c,
-		0xa9, 0x34, 0xa4, 0x83, 0x57, 0x3f, 0x5e, 0x0c, 0xa1, 0x27, 0x30, 0x43, 0xcf, 0x3b, 0x24, 0x9f,
-		0xe1, 0x42, 0x77, 0x26, 0x0a, 0x59, 0xe7, 0x1d, 0x82, 0x39, 0x1c, 0x3d, 0x03, 0x08, 0xa9, 0x13,
-		0x50, 0x9b, 0x95, 0x21, 0x9f, 0xe5, 0xe4, 0x6b, 0xa5, 0xa8, 0x46, 0xa5, 0xb8, 0x46, 0x25, 0x2b,
-		0xae, 0x11, 0x5e, 0xe0, 0x68, 0x76, 0xcd, 0xa8, 0x8d, 0x96, 0x1f, 0x92, 0x88, 0x3a, 0x93, 0x4e,
-		0xe5, 0x68, 0x4e, 0xb5, 0x60, 0x31, 0xa2, 0x86, 0xd4, 0xa1, 0xdd, 0x30, 0x3f, 0xbb, 0x29, 0x6d,
-		0x2d, 0x3f, 0xda, 0x99, 0x6e, 0xf5, 0x2a, 0x63, 0xd6, 0x38, 0x11, 0xe7, 0x1a, 0xfd, 0x0b, 0x74,
-		0x17, 0x96, 0xcf, 0xdc, 0x90, 0xfa, 0xc1, 0xb9, 0xdd, 0x22, 0xde, 0x29, 0x3d, 0xcb, 0xcf, 0x6d,
-		0x4a, 0x5b, 0x59, 0xbc, 0x24, 0xa2, 0x65, 0x1e, 0x44, 0x3f, 0x83, 0xf5, 0x8e, 0x13, 0x10, 0x8f,
-		0xf6, 0xcb, 0x6f, 0xbb, 0xde, 0x89, 0x9f, 0xbf, 0xc4, 0x97, 0xb0, 0x35, 0x32, 0x8b, 0x2a, 0x67,
-		0x0c, 0xec, 0x24, 0x5e, 0xeb, 0x0c, 0x07, 0x91, 0x02, 0xcb, 0x7d, 0x59, 0x5e, 0x99, 0xf9, 0xd4,
-		0xca, 0x2c, 0xf5, 0x18, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x4d, 0xda, 0x7e, 0x7e, 0x81, 0x13, 0xaf,
-		0x8e, 0xcc, 0xe7, 0x80, 0xb4, 0x7d, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0x48, 0x9c, 0xa0, 0x71, 0x66,
-		0x3b, 0x94, 0x06, 0xee, 0x71, 0x97, 0x92, 0x30, 0x0f, 0x9c, 0x7b, 0x77, 0x24, 0xb7, 0xc6, 0xd1,
-		0x4a, 0x0f, 0x8c, 0xe5, 0xf0, 0x42, 0x04, 0x95, 0x61, 0xd5, 0xe9, 0x52, 0xdf, 0x0e, 0x48, 0x48,
-		0xa8, 0xdd, 0xf1, 0x5d, 0x8f, 0x86, 0xf9, 0x1c, 0xd7, 0xdc, 0x1c, 0xa9, 0x89, 0x19, 0xb0, 0xca,
-		0x71, 0x78, 0x85, 0x51, 0x13, 0x01, 0x74, 0x1d, 0x16, 0xd8, 0x78, 0xd8, 0x6c, 0x3e, 0xf2, 0x8b,
-		0x9b, 0xd2, 0xd6, 0x02, 0x9e, 0x67, 0x81, 0xb2, 0x1b, 0x52, 0xb4, 0x01, 0x97, 0xdc, 0xd0, 0x6e,
-		0x04, 0xbe, 0x97, 0x5f, 0xda, 0x94, 0xb6, 0xe6, 0xf1, 0x9c, 0x1b, 0xaa, 0x81, 0xef, 0x15, 0x7e,
-		0x9d, 0x81, 0x5b, 0xc3, 0x9b, 0xef, 0x7b, 0x27, 0xee, 0xa9, 0x18, 0x69, 0xf4, 0x6d, 0x52, 0x38,
-		0x1a, 0xa1, 0x9b, 0x23, 0xd3, 0xb3, 0xc4, 0xd3, 0x12, 0xcf, 0x75, 0x60, 0xb3, 0xbf, 0x51, 0x62,
-		0x06, 0x7c, 0xbb, 0xdf, 0xd1, 0x7e, 0x97, 0x8a, 0x61, 0xba, 0x3a, 0xb4, 0x75, 0x9a, 0x48, 0x00,
-		0xdf, 0xe8, 0x49, 0xd4, 0xf8, 0x5c, 0xf8, 0x6a, 0xdc, 0xe3, 0x7e, 0x97, 0xa2, 0x43, 0xb8, 0xce,
-		0xd3, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0x60, 0xec, 0x11, 0xc2, 0x85, 0xbf, 0x4b, 0xb0, 0x36,
-		0xa2, 0x23, 0x59, 0xa1, 0x9b, 0x7e, 0xdb, 0x71, 0x3d, 0xdb, 0x6d, 0xf2, 0x7a, 0x2c, 0xe0, 0xf9,
-		0x28, 0x60, 0x34, 0xd1, 0x6d, 0xc8, 0x89, 0x9b, 0x9e, 0xd3, 0x8e, 0x8c, 0x62, 0x01, 0x43, 0x14,
-		0x32, 0x9d, 0x36, 0x19, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0, 0xa2, 0xeb, 0xb9, 0xd4,
-		0x75, 0x28, 0x69, 0xb2, 0xbc, 0x66, 0xf8, 0x50, 0xe6, 0x7a, 0x31, 0xa3, 0x59, 0xf8, 0x95, 0x04,
-		0xeb, 0xfa, 0x27, 0x4a, 0x02, 0xcf, 0x69, 0x7d, 0x2f, 0x6e, 0x79, 0x31, 0xa7, 0xcc, 0x70, 0x4e,
-		0xff, 0x9c, 0x85, 0xb5, 0x2a, 0xf1, 0x9a, 0xae, 0x77, 0xaa, 0x34, 0xa8, 0xfb, 0xc1, 0xa5, 0xe7,
-		0x3c, 0xa3, 0xdb, 0x90, 0x73, 0xc4, 0x75, 0xbf, 0xca, 0x10, 0x87, 0x8c, 0x26, 0xda, 0x83, 0xa5,
-		0x1e, 0x20, 0xd5, 0x92, 0x63, 0x69, 0x6e, 0xc9, 0x8b, 0x4e, 0xe2, 0x0a, 0xbd, 0x84, 0x59, 0x66,
-		0x8f, 0x91, 0x2b, 0x2f, 0x3f, 0x7a, 0x30, 0xda, 0x97, 0x06, 0x33, 0x64, 0x4e, 0x48, 0x70, 0xc4,
-		0x43, 0x06, 0xac, 0x9e, 0x11, 0x27, 0xa0, 0xc7, 0xc4, 0xa1, 0x76, 0x93, 0x50, 0xc7, 0x6d, 0x85,
-		0xc2, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xf3, 0x96, 0xef, 0x34, 0xb1, 0xdc, 0xa3, 0x69, 0x11, 0x0b,
-		0xbd, 0x86, 0xb5, 0x96, 0x13, 0x52, 0xbb, 0xaf, 0xc7, 0xad, 0x6d, 0x36, 0xd5, 0xda, 0x56, 0x19,
-		0x6d, 0x3f, 0x66, 0x71, 0x7b, 0xdb, 0x03, 0x1e, 0x8c, 0xa6, 0x82, 0x34, 0x23, 0xa5, 0xb9, 0x54,
-		0xa5, 0x15, 0x46, 0xaa, 0x45, 0x1c, 0xae, 0x93, 0x87, 0x4b, 0x0e, 0xa5, 0xa4, 0xdd, 0xa1, 0xdc,
-		0xb9, 0x67, 0x71, 0x7c, 0x89, 0x1e, 0x80, 0xdc, 0x76, 0x3e, 0xb9, 0xed, 0x6e, 0xdb, 0x16, 0xa1,
-		0x90, 0xbb, 0xf0, 0x2c, 0x5e, 0x11, 0x71, 0x45, 0x84, 0x99, 0x5d, 0x87, 0x8d, 0x33, 0xd2, 0xec,
-		0xb6, 0xe2, 0x4c, 0x16, 0xd2, 0xed, 0xba, 0xc7, 0xe0, 0x79, 0xa8, 0xb0, 0x42, 0x3e, 0x75, 0xdc,
-		0x68, 0x66, 0x23, 0x0d, 0x48, 0xd5, 0x58, 0xee, 0x53, 0xb8, 0xc8, 0x4b, 0x58, 0xe4, 0x45, 0x39,
-		0x71, 0xdc, 0x56, 0x37, 0x20, 0xc2, 0x6b, 0x47, 0x6f, 0xd3, 0x5e, 0x84, 0xc1, 0x39, 0xc6, 0x10,
-		0x17, 0xe8, 0x2b, 0xb8, 0xcc, 0x05, 0x58, 0xaf, 0x93, 0xc0, 0x76, 0x9b, 0xc4, 0xa3, 0x2e, 0x3d,
-		0x17, 0x76, 0x8b, 0xd8, 0xbd, 0x43, 0x7e, 0xcb, 0x10, 0x77, 0x0a, 0xbf, 0xcd, 0xc0, 0x55, 0xd1,
-		0x3e, 0xea, 0x99, 0xdb, 0x6a, 0x7e, 0x2f, 0x83, 0xf7, 0x65, 0x42, 0x96, 0x0d, 0x47, 0xd2, 0x8b,
-		0xe4, 0x8f, 0x89, 0xf3, 0x09, 0x77, 0xa4, 0x8b, 0x63, 0x9a, 0x1d, 0x1a, 0x53, 0xf4, 0x16, 0xc4,
-		0x6b, 0x58, 0x98, 0x6b, 0xc7, 0x6f, 0xb9, 0x8d, 0x73, 0xde, 0xe6, 0xcb, 0x63, 0x12, 0x8d, 0x9c,
-		0x93, 0x1b, 0x6a, 0x95, 0xa3, 0xf1, 0x6a, 0xe7, 0x62, 0xa8, 0xf0, 0xb7, 0x4c, 0x6f, 0xfc, 0x35,
-		0xd2, 0x70, 0xc3, 0xb8, 0x2e, 0xbd, 0xa9, 0x94, 0xd2, 0xa7, 0x32, 0x26, 0x0e, 0x4c, 0xe5, 0x70,
-		0xc7, 0x65, 0x3e, 0xb7, 0xe3, 0x5e, 0xc0, 0xe2, 0xc0, 0xf0, 0xa4, 0x1f, 0xdb, 0x72, 0xe1, 0xe8,
-		0xc1, 0x99, 0x19, 0x1c, 0x1c, 0x0c, 0x1b, 0x7e, 0xe0, 0x9e, 0xba, 0x9e, 0xd3, 0xb2, 0x2f, 0x24,
-		0x99, 0x3e, 0xea, 0xeb, 0x31, 0xb5, 0x96, 0x4c, 0xb6, 0xf0, 0xa7, 0x0c, 0x5c, 0x8d, 0xed, 0xa9,
-		0xec, 0x37, 0x9c, 0x96, 0xe6, 0x86, 0x1d, 0x87, 0x36, 0xce, 0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9,
-		0x7e, 0x0e, 0xb7, 0x06, 0x33, 0xb0, 0xfd, 0x13, 0x9b, 0x9e, 0xb9, 0xa1, 0x9d, 0xac, 0xe2, 0x64,
-		0xc1, 0x6b, 0x03, 0x19, 0x55, 0x4e, 0xac, 0x33, 0x37, 0x14, 0x1e, 0x84, 0x6e, 0x02, 0xf0, 0x53,
-		0x02, 0xf5, 0xdf, 0x13, 0x8f, 0xd7, 0x79, 0x11, 0xf3, 0x63, 0x8d, 0xc5, 0x02, 0x85, 0xd7, 0x90,
-		0x4b, 0x9e, 0xa5, 0x9e, 0xc3, 0x9c, 0x38, 0x8e, 0x49, 0x9b, 0xd9, 0xad, 0xdc, 0xa3, 0x2f, 0x52,
-		0x8e, 0x63, 0xfc, 0xa4, 0x2a, 0x28, 0x85, 0x3f, 0x64, 0x60, 0x79, 0xf0, 0x16, 0xba, 0x0f, 0x2b,
-		0xc7, 0xae, 0xe7, 0x04, 0xe7, 0x76, 0xe3, 0x8c, 0x34, 0xde, 0x87, 0xdd, 0xb6, 0xd8, 0x84, 0xe5,
-		0x28, 0xac, 0x8a, 0x28, 0x5a, 0x87, 0xb9, 0xa0, 0xeb, 0xc5, 0x2f, 0xcb, 0x05, 0x3c, 0x1b, 0x74,
-		0xd9, 0xa9, 0xe2, 0x05, 0x5c, 0x3f, 0x71, 0x83, 0x90, 0xbd, 0x60, 0xa2, 0x66, 0xb7, 0x1b, 0x7e,
-		0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0xe7, 0x90, 0x78, 0x1c, 0xd4, 0x18, 0xc0, 0xe9, 0x8b, 0x8d,
-		0x80, 0x38, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x4e, 0xe0, 0x85, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xbd,
-		0xd3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b, 0x00, 0xfc, 0x8c, 0x4b, 0x9d, 0xe3, 0x56,
-		0xf4, 0x16, 0x9a, 0xc7, 0x89, 0x48, 0xf1, 0x8f, 0x12, 0x5c, 0x1e, 0xf5, 0x8e, 0x45, 0x05, 0xb8,
-		0x55, 0xd5, 0x4d, 0xcd, 0x30, 0x5f, 0xd9, 0x8a, 0x6a, 0x19, 0x6f, 0x0d, 0xeb, 0xc8, 0xae, 0x59,
-		0x8a, 0xa5, 0xdb, 0x86, 0xf9, 0x56, 0x29, 0x1b, 0x9a, 0xfc, 0x7f, 0xe8, 0xff, 0x61, 0x73, 0x0c,
-		0xa6, 0xa6, 0xee, 0xeb, 0x5a, 0xbd, 0xac, 0x6b, 0xb2, 0x34, 0x41, 0xa9, 0x66, 0x29, 0xd8, 0xd2,
-		0x35, 0x39, 0x83, 0x7e, 0x00, 0xf7, 0xc7, 0x60, 0x54, 0xc5, 0x54, 0xf5, 0xb2, 0x8d, 0xf5, 0x9f,
-		0xd6, 0xf5, 0x1a, 0x03, 0x67, 0x8b, 0xbf, 0xe8, 0xe7, 0x3c, 0xe0, 0x40, 0xc9, 0x27, 0x69, 0xba,
-		0x6a, 0xd4, 0x8c, 0x8a, 0x39, 0x29, 0xe7, 0x0b, 0x98, 0x31, 0x39, 0x5f, 0x44, 0xc5, 0x39, 0x17,
-		0x7f, 0x99, 0xe9, 0x7f, 0x02, 0x1b, 0x4d, 0x4c, 0xba, 0xb1, 0xb7, 0xb2, 0x67, 0x1c, 0x56, 0xf0,
-		0x9b, 0xbd, 0x72, 0xe5, 0xd0, 0x36, 0x34, 0x1b, 0xeb, 0xf5, 0x9a, 0x6e, 0x57, 0x2b, 0x65, 0x43,
-		0x3d, 0x4a, 0x64, 0xf2, 0x43, 0xf8, 0x7a, 0x2c, 0x4a, 0x29, 0xb3, 0xa8, 0x56, 0xaf, 0x96, 0x0d,
-		0x95, 0x3d, 0x75, 0x4f, 0x31, 0xca, 0xba, 0x66, 0x57, 0xcc, 0xf2, 0x91, 0x2c, 0xa1, 0x2f, 0x61,
-		0x6b, 0x5a, 0xa6, 0x9c, 0x41, 0x0f, 0xe1, 0xc1, 0x58, 0x34, 0xd6, 0x5f, 0xeb, 0xaa, 0x95, 0x80,
-		0x67, 0xd1, 0x0e, 0x3c, 0x1c, 0x0b, 0xb7, 0x74, 0x7c, 0x60, 0x98, 0xbc, 0xa0, 0x7b, 0x36, 0xae,
-		0x9b, 0xa6, 0x61, 0xbe, 0x92, 0x67, 0x8a, 0xbf, 0x93, 0x60, 0x75, 0xe8, 0xa5, 0x83, 0x6e, 0xc3,
-		0xf5, 0xaa, 0x82, 0x75, 0xd3, 0xb2, 0xd5, 0x72, 0x65, 0x54, 0x01, 0xc6, 0x00, 0x94, 0x5d, 0xc5,
-		0xd4, 0x2a, 0xa6, 0x2c, 0xa1, 0x7b, 0x50, 0x18, 0x05, 0x10, 0xbd, 0x20, 0x5a, 0x43, 0xce, 0xa0,
-		0x3b, 0x70, 0x73, 0x14, 0xae, 0x97, 0xad, 0x9c, 0x2d, 0xfe, 0x2b, 0x03, 0x37, 0x26, 0x7d, 0x69,
-		0xb3, 0x0e, 0xec, 0x2d, 0x5b, 0x7f, 0xa7, 0xab, 0x75, 0x8b, 0xed, 0x79, 0xa4, 0xc7, 0x76, 0xbe,
-		0x5e, 0x4b, 0x64, 0x9e, 0x2c, 0xe9, 0x18, 0xb0, 0x5a, 0x39, 0xa8, 0x96, 0x75, 0x8b, 0x77, 0x53,
-		0x11, 0xee, 0xa5, 0xc1, 0xa3, 0x0d, 0x96, 0x33, 0x03, 0x7b, 0x3b, 0x4e, 0x9a, 0xaf, 0x9b, 0x8d,
-		0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x26, 0xcf, 0xa0, 0xaf, 0xe1, 0xab, 0xf4, 0xc4,
-		0x4d, 0xcb, 0x30, 0xeb, 0xba, 0x66, 0x2b, 0x35, 0xdb, 0xd4, 0x0f, 0xe5, 0xd9, 0x69, 0x96, 0x6b,
-		0x19, 0x07, 0xac, 0x3f, 0xeb, 0x96, 0x3c, 0x57, 0xfc, 0xb3, 0x04, 0x57, 0x54, 0xdf, 0xa3, 0xae,
-		0xd7, 0x25, 0x4a, 0x68, 0x92, 0x8f, 0x46, 0x74, 0x9e, 0xf1, 0x03, 0x74, 0x17, 0xee, 0xc4, 0xfa,
-		0x42, 0xde, 0x36, 0x4c, 0xc3, 0x32, 0x14, 0xab, 0x82, 0x13, 0xf5, 0x9d, 0x08, 0x63, 0x03, 0xa9,
-		0xe9, 0x38, 0xaa, 0xeb, 0x78, 0x18, 0xd6, 0x2d, 0x7c, 0x24, 0x5a, 0x21, 0x72, 0x98, 0xf1, 0x58,
-		0x15, 0xb3, 0xf9, 0x16, 0xf3, 0x2f, 0x67, 0x8b, 0xbf, 0x97, 0x20, 0x27, 0xbe, 0x45, 0xf9, 0xa7,
-		0x4a, 0x1e, 0x2e, 0xb3, 0x05, 0x56, 0xea, 0x96, 0x6d, 0x1d, 0x55, 0xf5, 0xc1, 0x1e, 0x1e, 0xb8,
-		0xc3, 0xed, 0xc1, 0xb6, 0x2a, 0x51, 0x75, 0x22, 0x27, 0x19, 0x04, 0x88, 0xa7, 0x30, 0x0c, 0x07,
-		0xcb, 0x99, 0x89, 0x98, 0x48, 0x27, 0x8b, 0xae, 0xc1, 0x95, 0x01, 0xcc, 0xbe, 0xae, 0x60, 0x6b,
-		0x57, 0x57, 0x2c, 0x79, 0xa6, 0xf8, 0x1b, 0x09, 0xae, 0xc6, 0x4e, 0x68, 0xb1, 0x17, 0xab, 0xdb,
-		0x26, 0xcd, 0x4a, 0x97, 0xaa, 0x4e, 0x37, 0x24, 0xe8, 0x01, 0xdc, 0xed, 0x79, 0x98, 0xa5, 0xd4,
-		0xde, 0xf4, 0xf7, 0xca, 0x56, 0x15, 0x36, 0xdc, 0xfd, 0xd5, 0xa4, 0x42, 0x45, 0x0a, 0xb2, 0x84,
-		0xee, 0xc3, 0x17, 0x93, 0xa1, 0x58, 0xaf, 0xe9, 0x96, 0x9c, 0x29, 0xfe, 0x23, 0x07, 0x1b, 0xc9,
-		0xe4, 0xd8, 0x81, 0x9e, 0x34, 0xa3, 0xd4, 0xee, 0x41, 0x61, 0x50, 0x44, 0xf8, 0xdc, 0xc5, 0xbc,
-		0x76, 0xe0, 0xe1, 0x04, 0x5c, 0xdd, 0xdc, 0x57, 0x4c, 0x8d, 0x5d, 0xc7, 0x20, 0x59, 0x42, 0x2f,
-		0xe1, 0xf9, 0x04, 0xca, 0xae, 0xa2, 0xf5, 0xab, 0xdc, 0x7b, 0xe3, 0x28, 0x96, 0x85, 0x8d, 0xdd,
-		0xba, 0xa5, 0xd7, 0xe4, 0x0c, 0xd2, 0x41, 0x49, 0x11, 0x18, 0xf4, 0xa1, 0x91, 0x32, 0x59, 0xf4,
-		0x0c, 0x9e, 0xa4, 0xe5, 0x11, 0xb5, 0x8c, 0x71, 0xa0, 0xe3, 0x24, 0x75, 0x06, 0x7d, 0x0b, 0xdf,
-		0xa4, 0x50, 0xc5, 0x93, 0x87, 0xb8, 0xb3, 0xe8, 0x39, 0x3c, 0x4d, 0xcd, 0x5e, 0xad, 0x60, 0xcd,
-		0x3e, 0x50, 0xf0, 0x9b, 0x41, 0xf2, 0x1c, 0x32, 0x40, 0x4f, 0x7b, 0xb0, 0x70, 0x37, 0x7b, 0x84,
-		0x2f, 0x24, 0xa4, 0x2e, 0x4d, 0x51, 0x45, 0x16, 0x48, 0x91, 0x99, 0x47, 0xaf, 0x40, 0x9d, 0xae,
-		0x14, 0x93, 0x85, 0x16, 0xd0, 0x3b, 0xb0, 0x3e, 0x6f, 0x57, 0xf5, 0x77, 0x96, 0x8e, 0x4d, 0x25,
-		0x4d, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x5a, 0xb4, 0x41, 0xff, 0x49, 0xd0, 0x73, 0xe8, 0x29, 0x3c,
-		0x9e, 0x40, 0x4f, 0xf6, 0x48, 0xff, 0x54, 0x60, 0x68, 0xf2, 0x22, 0x7a, 0x02, 0x3b, 0x13, 0x88,
-		0x7c, 0x0a, 0xed, 0x9a, 0x65, 0xa8, 0x6f, 0x8e, 0xa2, 0xdb, 0x65, 0xa3, 0x66, 0xc9, 0x4b, 0xe8,
-		0x27, 0xf0, 0xa3, 0x09, 0xb4, 0xde, 0x62, 0xd9, 0x1f, 0x3a, 0x4e, 0x8c, 0x18, 0x83, 0xd5, 0xb1,
-		0x2e, 0x2f, 0x4f, 0xb1, 0x27, 0x35, 0xe3, 0x55, 0x7a, 0xe5, 0x56, 0x90, 0x0a, 0x2f, 0xa7, 0x1a,
-		0x11, 0x75, 0xdf, 0x28, 0x6b, 0xa3, 0x45, 0x64, 0xf4, 0x18, 0xb6, 0x27, 0x88, 0xec, 0x55, 0xb0,
-		0xaa, 0x8b, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x37, 0xf0, 0x68, 0x12, 0x49, 0x31, 0xca, 0x95,
-		0xb7, 0x3a, 0xbe, 0xc8, 0x43, 0xec, 0x35, 0x3a, 0xdd, 0xd2, 0x0d, 0xb3, 0x5a, 0xb7, 0xec, 0x9a,
-		0xf1, 0x9d, 0x2e, 0xaf, 0xb1, 0xd7, 0x68, 0xea, 0x4e, 0xc5, 0xb5, 0x92, 0x2f, 0x0f, 0x9b, 0xf1,
-		0xd0, 0x43, 0x76, 0x0d, 0x53, 0xc1, 0x47, 0xf2, 0x7a, 0x4a, 0xef, 0x0d, 0x1b, 0xdd, 0x40, 0x0b,
-		0x5d, 0x99, 0x66, 0x39, 0xba, 0x82, 0xd5, 0xfd, 0x64, 0xc5, 0x37, 0xd8, 0x5b, 0xe7, 0x0e, 0xff,
-		0xc7, 0xca, 0xd0, 0xb9, 0x2a, 0x69, 0xf1, 0x3b, 0xf0, 0x30, 0xda, 0xb7, 0x11, 0x5d, 0x30, 0xc6,
-		0xed, 0x77, 0xe1, 0xc7, 0xd3, 0x51, 0x7a, 0xf7, 0x95, 0x32, 0xd6, 0x15, 0xed, 0xa8, 0x77, 0x24,
-		0x95, 0x8a, 0x7f, 0x95, 0xa0, 0xa8, 0x3a, 0x5e, 0x83, 0xb4, 0xe2, 0xff, 0xbb, 0x4e, 0xcc, 0xf2,
-		0x39, 0x3c, 0x9d, 0x62, 0xde, 0xc7, 0xe4, 0x7b, 0x08, 0xb5, 0xcf, 0x25, 0xd7, 0xcd, 0x37, 0x66,
-		0xe5, 0xd0, 0x9c, 0x44, 0x10, 0x8b, 0xa8, 0xb9, 0xa7, 0xfc, 0x9f, 0xc6, 0xd3, 0x2d, 0x42, 0xb4,
-		0xdd, 0x7f, 0xb6, 0x88, 0xcf, 0x25, 0x4f, 0xb5, 0x88, 0xdd, 0xb7, 0xb0, 0xd1, 0xf0, 0xdb, 0xa3,
-		0xbe, 0xe2, 0x77, 0xe7, 0x95, 0x8e, 0x5b, 0x65, 0x5f, 0xb0, 0x55, 0xe9, 0xbb, 0xed, 0x53, 0x97,
-		0x9e, 0x75, 0x8f, 0x4b, 0x0d, 0xbf, 0xbd, 0x3d, 0xf0, 0xfb, 0x63, 0xe9, 0x94, 0x78, 0xd1, 0xaf,
-		0x99, 0xe2, 0xa7, 0xc8, 0xe7, 0x4e, 0xc7, 0xfd, 0xb0, 0x73, 0x3c, 0xc7, 0x63, 0x8f, 0xff, 0x1d,
-		0x00, 0x00, 0xff, 0xff, 0x1c, 0xfe, 0xdc, 0x7d, 0x4a, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xc8,
+		0x19, 0x2e, 0x25, 0xdb, 0xb1, 0x5f, 0xf9, 0x83, 0x1e, 0xc7, 0x6b, 0x39, 0xd9, 0x4d, 0x1c, 0x6d,
+		0x93, 0x75, 0xd4, 0x8d, 0xbc, 0xce, 0x6e, 0x36, 0xcd, 0xba, 0x69, 0x4a, 0x93, 0x74, 0xcc, 0x44,
+		0xa6, 0xd4, 0x21, 0x15, 0xc7, 0x8b, 0xa2, 0x04, 0x2d, 0x8d, 0x6d, 0x22, 0x12, 0x29, 0x90, 0x54,
+		0x12, 0xdf, 0x0b, 0xf4, 0x5c, 0xa0, 0xa7, 0xf6, 0xd2, 0x1f, 0x50, 0xa0, 0xe8, 0x0f, 0x28, 0x5a,
+		0xf4, 0xd0, 0x5b, 0xaf, 0x3d, 0xee, 0xbd, 0xff, 0xa2, 0x98, 0xe1, 0x90, 0xa2, 0xac, 0x0f, 0x2a,
+		0x2d, 0xb0, 0xbd, 0x99, 0x2f, 0x9f, 0xe7, 0x9d, 0x77, 0xde, 0x8f, 0x67, 0x86, 0x32, 0x94, 0x7a,
+		0xa7, 0xc4, 0xdf, 0x69, 0xda, 0x2d, 0xe2, 0x36, 0xc9, 0x8e, 0xdd, 0x75, 0x76, 0xde, 0xee, 0xee,
+		0xbc, 0xf3, 0xfc, 0x37, 0x67, 0x6d, 0xef, 0x5d, 0xa5, 0xeb, 0x7b, 0xa1, 0x87, 0xd6, 0x28, 0xa6,
+		0xc2, 0x31, 0x15, 0xbb, 0xeb, 0x54, 0xde, 0xee, 0xde, 0xb8, 0x75, 0xee, 0x79, 0xe7, 0x6d, 0xb2,
+		0xc3, 0x20, 0xa7, 0xbd, 0xb3, 0x9d, 0x56, 0xcf, 0xb7, 0x43, 0xc7, 0x73, 0x23, 0xd2, 0x8d, 0xdb,
+		0x57, 0xdf, 0x87, 0x4e, 0x87, 0x04, 0xa1, 0xdd, 0xe9, 0x72, 0xc0, 0xd6, 0xa8, 0x95, 0x9b, 0x5e,
+		0xa7, 0x93, 0xb8, 0x18, 0x19, 0x5b, 0x68, 0x07, 0x6f, 0xda, 0x4e, 0x10, 0x46, 0x98, 0xd2, 0xdf,
+		0xe6, 0x60, 0xfd, 0x98, 0x87, 0xab, 0xbe, 0x27, 0xcd, 0x1e, 0x0d, 0x41, 0x73, 0xcf, 0x3c, 0xd4,
+		0x00, 0x14, 0xef, 0xc3, 0x22, 0xf1, 0x9b, 0xa2, 0xb0, 0x25, 0x6c, 0x17, 0x1e, 0xde, 0xab, 0x8c,
+		0xd8, 0x52, 0x65, 0xc8, 0x0f, 0x5e, 0x7d, 0x77, 0xd5, 0x84, 0x1e, 0xc1, 0x4c, 0x78, 0xd9, 0x25,
+		0xc5, 0x1c, 0x73, 0x74, 0x67, 0xa2, 0x23, 0xf3, 0xb2, 0x4b, 0x30, 0x83, 0xa3, 0x27, 0x00, 0x41,
+		0x68, 0xfb, 0xa1, 0x45, 0xd3, 0x50, 0xcc, 0x33, 0xf2, 0x8d, 0x4a, 0x94, 0xa3, 0x4a, 0x9c, 0xa3,
+		0x8a, 0x19, 0xe7, 0x08, 0x2f, 0x30, 0x34, 0x7d, 0xa6, 0xd4, 0x66, 0xdb, 0x0b, 0x48, 0x44, 0x9d,
+		0xc9, 0xa6, 0x32, 0x34, 0xa3, 0x9a, 0xb0, 0x18, 0x51, 0x83, 0xd0, 0x0e, 0x7b, 0x41, 0x71, 0x76,
+		0x4b, 0xd8, 0x5e, 0x7e, 0xb8, 0x3b, 0xdd, 0xee, 0x65, 0xca, 0x34, 0x18, 0x11, 0x17, 0x9a, 0xfd,
+		0x07, 0x74, 0x17, 0x96, 0x2f, 0x9c, 0x20, 0xf4, 0xfc, 0x4b, 0xab, 0x4d, 0xdc, 0xf3, 0xf0, 0xa2,
+		0x38, 0xb7, 0x25, 0x6c, 0xe7, 0xf1, 0x12, 0xb7, 0x56, 0x99, 0x11, 0xfd, 0x02, 0xd6, 0xbb, 0xb6,
+		0x4f, 0xdc, 0xb0, 0x9f, 0x7e, 0xcb, 0x71, 0xcf, 0xbc, 0xe2, 0x35, 0xb6, 0x85, 0xed, 0x91, 0x51,
+		0xd4, 0x19, 0x63, 0xa0, 0x92, 0x78, 0xad, 0x3b, 0x6c, 0x44, 0x12, 0x2c, 0xf7, 0xdd, 0xb2, 0xcc,
+		0xcc, 0x67, 0x66, 0x66, 0x29, 0x61, 0xb0, 0xec, 0x3c, 0x80, 0x99, 0x0e, 0xe9, 0x78, 0xc5, 0x05,
+		0x46, 0xdc, 0x1c, 0x19, 0xcf, 0x11, 0xe9, 0x78, 0x98, 0xc1, 0x10, 0x86, 0xd5, 0x80, 0xd8, 0x7e,
+		0xf3, 0xc2, 0xb2, 0xc3, 0xd0, 0x77, 0x4e, 0x7b, 0x21, 0x09, 0x8a, 0xc0, 0xb8, 0x77, 0x47, 0x72,
+		0x0d, 0x86, 0x96, 0x12, 0x30, 0x16, 0x83, 0x2b, 0x16, 0x54, 0x85, 0x55, 0xbb, 0x17, 0x7a, 0x96,
+		0x4f, 0x02, 0x12, 0x5a, 0x5d, 0xcf, 0x71, 0xc3, 0xa0, 0x58, 0x60, 0x3e, 0xb7, 0x46, 0xfa, 0xc4,
+		0x14, 0x58, 0x67, 0x38, 0xbc, 0x42, 0xa9, 0x29, 0x03, 0xba, 0x09, 0x0b, 0x74, 0x3c, 0x2c, 0x3a,
+		0x1f, 0xc5, 0xc5, 0x2d, 0x61, 0x7b, 0x01, 0xcf, 0x53, 0x43, 0xd5, 0x09, 0x42, 0xb4, 0x01, 0xd7,
+		0x9c, 0xc0, 0x6a, 0xfa, 0x9e, 0x5b, 0x5c, 0xda, 0x12, 0xb6, 0xe7, 0xf1, 0x9c, 0x13, 0xc8, 0xbe,
+		0xe7, 0x96, 0xbe, 0xcb, 0xc1, 0xad, 0xe1, 0xe2, 0x7b, 0xee, 0x99, 0x73, 0xce, 0x47, 0x1a, 0x7d,
+		0x93, 0x76, 0x1c, 0x8d, 0xd0, 0x27, 0x23, 0xc3, 0x33, 0xf9, 0x6a, 0xa9, 0x75, 0x6d, 0xd8, 0xea,
+		0x17, 0x8a, 0xcf, 0x80, 0x67, 0xf5, 0x3b, 0xda, 0xeb, 0x85, 0x7c, 0x98, 0x36, 0x87, 0x4a, 0xa7,
+		0xf0, 0x00, 0xf0, 0xc7, 0x89, 0x0b, 0x83, 0xcd, 0x85, 0x27, 0xc7, 0x3d, 0xee, 0xf5, 0x42, 0x74,
+		0x0c, 0x37, 0x59, 0x78, 0x63, 0xbc, 0xe7, 0xb3, 0xbc, 0x6f, 0x50, 0xf6, 0x28, 0xc7, 0x0a, 0x88,
+		0x3e, 0x09, 0x89, 0xcb, 0x62, 0xef, 0x12, 0xdf, 0xf1, 0x5a, 0xc5, 0x99, 0x2c, 0x6f, 0x2b, 0x09,
+		0xa5, 0xce, 0x18, 0xa5, 0x7f, 0x0a, 0xb0, 0x36, 0xa2, 0xaf, 0x69, 0xb9, 0x5a, 0x5e, 0xc7, 0x76,
+		0x5c, 0xcb, 0x69, 0xb1, 0xac, 0x2e, 0xe0, 0xf9, 0xc8, 0xa0, 0xb5, 0xd0, 0x6d, 0x28, 0xf0, 0x97,
+		0xae, 0xdd, 0x89, 0xe4, 0x66, 0x01, 0x43, 0x64, 0xd2, 0xed, 0x0e, 0x19, 0xa3, 0x6f, 0xf9, 0xff,
+		0x55, 0xdf, 0xee, 0xc0, 0xa2, 0xe3, 0x3a, 0xa1, 0x63, 0x87, 0xa4, 0x65, 0x39, 0xd1, 0x76, 0xf3,
+		0xb8, 0x90, 0xd8, 0xb4, 0x56, 0xe9, 0x37, 0x02, 0xac, 0xab, 0xef, 0x43, 0xe2, 0xbb, 0x76, 0xfb,
+		0x7b, 0xd1, 0xdc, 0xab, 0x31, 0xe5, 0x86, 0x63, 0xfa, 0x6e, 0x16, 0xd6, 0xea, 0xc4, 0x6d, 0x39,
+		0xee, 0xb9, 0xd4, 0x0c, 0x9d, 0xb7, 0x4e, 0x78, 0xc9, 0x22, 0xba, 0x0d, 0x05, 0x9b, 0x3f, 0xf7,
+		0xb3, 0x0c, 0xb1, 0x49, 0x6b, 0xa1, 0x03, 0x58, 0x4a, 0x00, 0x99, 0xc2, 0x1e, 0xbb, 0x66, 0xc2,
+		0xbe, 0x68, 0xa7, 0x9e, 0xd0, 0x33, 0x98, 0xa5, 0x22, 0x1b, 0x69, 0xfb, 0xf2, 0xc3, 0xfb, 0xa3,
+		0xd5, 0x6d, 0x30, 0x42, 0xaa, 0xa7, 0x04, 0x47, 0x3c, 0xa4, 0xc1, 0xea, 0x05, 0xb1, 0xfd, 0xf0,
+		0x94, 0xd8, 0xa1, 0xd5, 0x22, 0xa1, 0xed, 0xb4, 0x03, 0xde, 0x6c, 0x1f, 0x8f, 0x91, 0xca, 0xcb,
+		0xb6, 0x67, 0xb7, 0xb0, 0x98, 0xd0, 0x94, 0x88, 0x85, 0x5e, 0xc0, 0x5a, 0xdb, 0x0e, 0x42, 0xab,
+		0xef, 0x8f, 0x09, 0xe4, 0x6c, 0xa6, 0x40, 0xae, 0x52, 0xda, 0x61, 0xcc, 0xa2, 0x76, 0x74, 0x00,
+		0xcc, 0x18, 0xcd, 0x16, 0x69, 0x45, 0x9e, 0xe6, 0x32, 0x3d, 0xad, 0x50, 0x92, 0x11, 0x71, 0x98,
+		0x9f, 0x22, 0x5c, 0xb3, 0xc3, 0x90, 0x74, 0xba, 0x21, 0xd3, 0xff, 0x59, 0x1c, 0x3f, 0xa2, 0xfb,
+		0x20, 0x76, 0xec, 0xf7, 0x4e, 0xa7, 0xd7, 0xb1, 0xb8, 0x29, 0x60, 0x5a, 0x3e, 0x8b, 0x57, 0xb8,
+		0x5d, 0xe2, 0x66, 0x2a, 0xfa, 0x41, 0xf3, 0x82, 0xb4, 0x7a, 0xed, 0x38, 0x92, 0x85, 0x6c, 0xd1,
+		0x4f, 0x18, 0x2c, 0x0e, 0x19, 0x56, 0xc8, 0xfb, 0xae, 0x13, 0xcd, 0x6a, 0xe4, 0x03, 0x32, 0x7d,
+		0x2c, 0xf7, 0x29, 0xcc, 0xc9, 0x33, 0x58, 0x64, 0x49, 0x39, 0xb3, 0x9d, 0x76, 0xcf, 0x27, 0xc5,
+		0xc2, 0x84, 0x32, 0x1d, 0x44, 0x18, 0x5c, 0xa0, 0x0c, 0xfe, 0x80, 0xbe, 0x80, 0xeb, 0xcc, 0x01,
+		0xed, 0x75, 0xe2, 0x5b, 0x4e, 0x8b, 0x0a, 0x46, 0x78, 0xc9, 0x45, 0x1b, 0xd1, 0x77, 0xc7, 0xec,
+		0x95, 0xc6, 0xdf, 0x94, 0x7e, 0x2b, 0x80, 0xc8, 0xdb, 0x87, 0x86, 0xe0, 0xb3, 0xee, 0xde, 0x84,
+		0x79, 0xba, 0x03, 0xbf, 0xdf, 0xda, 0xd7, 0xd8, 0xb3, 0xd6, 0x42, 0xdb, 0x20, 0xc6, 0x25, 0x23,
+		0x6f, 0xe9, 0x21, 0x9c, 0xcc, 0xcd, 0x32, 0xb7, 0xab, 0xd4, 0xac, 0xb5, 0xd0, 0x1e, 0x14, 0xd8,
+		0xf6, 0x2e, 0xa7, 0xbd, 0x9b, 0x40, 0x04, 0xa7, 0x86, 0xd2, 0xef, 0x73, 0xb0, 0xc9, 0xc3, 0x92,
+		0x2f, 0x9c, 0x76, 0xeb, 0x7b, 0xd1, 0x83, 0xcf, 0x53, 0x6e, 0xe9, 0xcc, 0xa6, 0x25, 0x52, 0x7c,
+		0x97, 0xba, 0x7c, 0x31, 0xa1, 0xbc, 0xaa, 0x1e, 0xf9, 0x21, 0xf5, 0x40, 0xaf, 0x80, 0xdf, 0x31,
+		0xf8, 0xc9, 0xd1, 0xf5, 0xda, 0x4e, 0xf3, 0x92, 0x4d, 0xdf, 0xf2, 0x98, 0x40, 0x23, 0x41, 0x67,
+		0xa7, 0x45, 0x9d, 0xa1, 0xf1, 0x6a, 0xf7, 0xaa, 0xa9, 0xf4, 0x8f, 0x5c, 0xa2, 0x4a, 0x0a, 0x69,
+		0x3a, 0x41, 0x9c, 0x97, 0x44, 0x2c, 0x84, 0x6c, 0xb1, 0x88, 0x89, 0x03, 0x62, 0x31, 0x3c, 0x08,
+		0xb9, 0x0f, 0x1d, 0x84, 0xa7, 0xb0, 0x38, 0x30, 0xd3, 0xd9, 0x75, 0x2f, 0x04, 0xa3, 0xe7, 0x79,
+		0x66, 0x70, 0x9e, 0x31, 0x6c, 0x78, 0xbe, 0x73, 0xee, 0xb8, 0x76, 0xdb, 0xba, 0x12, 0x64, 0xb6,
+		0x02, 0xad, 0xc7, 0x54, 0x23, 0x1d, 0x6c, 0xe9, 0x2f, 0x39, 0xd8, 0x8c, 0x55, 0xb3, 0xea, 0x35,
+		0xed, 0xb6, 0xe2, 0x04, 0x5d, 0x3b, 0x6c, 0x5e, 0x4c, 0x27, 0xf2, 0xff, 0xff, 0x74, 0xfd, 0x12,
+		0x6e, 0x0d, 0x46, 0x60, 0x79, 0x67, 0x56, 0x78, 0xe1, 0x04, 0x56, 0x3a, 0x8b, 0x93, 0x1d, 0xde,
+		0x18, 0x88, 0xa8, 0x76, 0x66, 0x5e, 0x38, 0x01, 0x97, 0x46, 0xf4, 0x09, 0x00, 0xbb, 0x02, 0x85,
+		0xde, 0x1b, 0xe2, 0xb2, 0x3c, 0x2f, 0x62, 0x76, 0x67, 0x33, 0xa9, 0xa1, 0xf4, 0x02, 0x0a, 0xe9,
+		0x8b, 0xe2, 0x1e, 0xcc, 0xf1, 0xbb, 0xa6, 0xb0, 0x95, 0xdf, 0x2e, 0x3c, 0xfc, 0x34, 0xe3, 0xae,
+		0xc9, 0xae, 0xe1, 0x9c, 0x52, 0xfa, 0x53, 0x0e, 0x96, 0x07, 0x5f, 0xa1, 0xcf, 0x60, 0xe5, 0xd4,
+		0x71, 0x6d, 0xff, 0xd2, 0x6a, 0x5e, 0x90, 0xe6, 0x9b, 0xa0, 0xd7, 0xe1, 0x45, 0x58, 0x8e, 0xcc,
+		0x32, 0xb7, 0xa2, 0x75, 0x98, 0xf3, 0x7b, 0x6e, 0xac, 0x45, 0x0b, 0x78, 0xd6, 0xef, 0xd1, 0xcb,
+		0xce, 0x53, 0xb8, 0x79, 0xe6, 0xf8, 0x01, 0x3d, 0xf7, 0xa2, 0x66, 0xb7, 0x9a, 0x5e, 0xa7, 0xdb,
+		0x26, 0x03, 0x13, 0x5b, 0x64, 0x90, 0x78, 0x1c, 0xe4, 0x18, 0xc0, 0xe8, 0x8b, 0x4d, 0x9f, 0xd8,
+		0x49, 0x6d, 0xb2, 0x53, 0x59, 0xe0, 0x78, 0xae, 0xe6, 0x4b, 0x4c, 0xd1, 0x1c, 0xf7, 0x7c, 0xda,
+		0x36, 0x5d, 0x8c, 0x09, 0xcc, 0xc1, 0x2d, 0x00, 0x76, 0x81, 0x0f, 0xed, 0xd3, 0x76, 0x74, 0x38,
+		0xce, 0xe3, 0x94, 0xa5, 0xfc, 0x67, 0x01, 0xae, 0x8f, 0x3a, 0xfa, 0x51, 0x09, 0x6e, 0xd5, 0x55,
+		0x5d, 0xd1, 0xf4, 0xe7, 0x96, 0x24, 0x9b, 0xda, 0x2b, 0xcd, 0x3c, 0xb1, 0x0c, 0x53, 0x32, 0x55,
+		0x4b, 0xd3, 0x5f, 0x49, 0x55, 0x4d, 0x11, 0x7f, 0x80, 0x7e, 0x08, 0x5b, 0x63, 0x30, 0x86, 0x7c,
+		0xa8, 0x2a, 0x8d, 0xaa, 0xaa, 0x88, 0xc2, 0x04, 0x4f, 0x86, 0x29, 0x61, 0x53, 0x55, 0xc4, 0x1c,
+		0xfa, 0x11, 0x7c, 0x36, 0x06, 0x23, 0x4b, 0xba, 0xac, 0x56, 0x2d, 0xac, 0xfe, 0xbc, 0xa1, 0x1a,
+		0x14, 0x9c, 0x2f, 0xff, 0xaa, 0x1f, 0xf3, 0x80, 0x02, 0xa5, 0x57, 0x52, 0x54, 0x59, 0x33, 0xb4,
+		0x9a, 0x3e, 0x29, 0xe6, 0x2b, 0x98, 0x31, 0x31, 0x5f, 0x45, 0xc5, 0x31, 0x97, 0x7f, 0x9d, 0xeb,
+		0x7f, 0xdf, 0x6b, 0x2d, 0x4c, 0x7a, 0xb1, 0xb6, 0xd2, 0x35, 0x8e, 0x6b, 0xf8, 0xe5, 0x41, 0xb5,
+		0x76, 0x6c, 0x69, 0x8a, 0x85, 0xd5, 0x86, 0xa1, 0x5a, 0xf5, 0x5a, 0x55, 0x93, 0x4f, 0x52, 0x91,
+		0xfc, 0x18, 0xbe, 0x1a, 0x8b, 0x92, 0xaa, 0xd4, 0xaa, 0x34, 0xea, 0x55, 0x4d, 0xa6, 0xab, 0x1e,
+		0x48, 0x5a, 0x55, 0x55, 0xac, 0x9a, 0x5e, 0x3d, 0x11, 0x05, 0xf4, 0x39, 0x6c, 0x4f, 0xcb, 0x14,
+		0x73, 0xe8, 0x01, 0xdc, 0x1f, 0x8b, 0xc6, 0xea, 0x0b, 0x55, 0x36, 0x53, 0xf0, 0x3c, 0xda, 0x85,
+		0x07, 0x63, 0xe1, 0xa6, 0x8a, 0x8f, 0x34, 0x9d, 0x25, 0xf4, 0xc0, 0xc2, 0x0d, 0x5d, 0xd7, 0xf4,
+		0xe7, 0xe2, 0x4c, 0xf9, 0x0f, 0x02, 0xac, 0x0e, 0x1d, 0x3a, 0xe8, 0x36, 0xdc, 0xac, 0x4b, 0x58,
+		0xd5, 0x4d, 0x4b, 0xae, 0xd6, 0x46, 0x25, 0x60, 0x0c, 0x40, 0xda, 0x97, 0x74, 0xa5, 0xa6, 0x8b,
+		0x02, 0xba, 0x07, 0xa5, 0x51, 0x00, 0xde, 0x0b, 0xbc, 0x35, 0xc4, 0x1c, 0xba, 0x03, 0x9f, 0x8c,
+		0xc2, 0x25, 0xd1, 0x8a, 0xf9, 0xf2, 0xbf, 0x73, 0xf0, 0xf1, 0xa4, 0x9f, 0x11, 0x68, 0x07, 0x26,
+		0xdb, 0x56, 0x5f, 0xab, 0x72, 0xc3, 0xa4, 0x35, 0x8f, 0xfc, 0xd1, 0xca, 0x37, 0x8c, 0x54, 0xe4,
+		0xe9, 0x94, 0x8e, 0x01, 0xcb, 0xb5, 0xa3, 0x7a, 0x55, 0x35, 0x59, 0x37, 0x95, 0xe1, 0x5e, 0x16,
+		0x3c, 0x2a, 0xb0, 0x98, 0x1b, 0xa8, 0xed, 0x38, 0xd7, 0x6c, 0xdf, 0x74, 0x14, 0x50, 0x05, 0xca,
+		0x59, 0xe8, 0x24, 0x0b, 0x8a, 0x38, 0x83, 0xbe, 0x82, 0x2f, 0xb2, 0x03, 0xd7, 0x4d, 0x4d, 0x6f,
+		0xa8, 0x8a, 0x25, 0x19, 0x96, 0xae, 0x1e, 0x8b, 0xb3, 0xd3, 0x6c, 0xd7, 0xd4, 0x8e, 0x68, 0x7f,
+		0x36, 0x4c, 0x71, 0xae, 0xfc, 0x57, 0x01, 0x3e, 0x92, 0x3d, 0x37, 0x74, 0xdc, 0x1e, 0x91, 0x02,
+		0x9d, 0xbc, 0xd3, 0xa2, 0xfb, 0x8c, 0xe7, 0xa3, 0xbb, 0x70, 0x27, 0xf6, 0xcf, 0xdd, 0x5b, 0x9a,
+		0xae, 0x99, 0x9a, 0x64, 0xd6, 0x70, 0x2a, 0xbf, 0x13, 0x61, 0x74, 0x20, 0x15, 0x15, 0x47, 0x79,
+		0x1d, 0x0f, 0xc3, 0xaa, 0x89, 0x4f, 0x78, 0x2b, 0x44, 0x0a, 0x33, 0x1e, 0x2b, 0xe3, 0x9a, 0x9e,
+		0xcc, 0xbf, 0x98, 0x2f, 0xff, 0x51, 0x80, 0x02, 0xff, 0xd0, 0x66, 0x5f, 0x50, 0x45, 0xb8, 0x4e,
+		0x37, 0x58, 0x6b, 0x98, 0x96, 0x79, 0x52, 0x57, 0x07, 0x7b, 0x78, 0xe0, 0x0d, 0x93, 0x07, 0xcb,
+		0xac, 0x45, 0xd9, 0x89, 0x94, 0x64, 0x10, 0xc0, 0x57, 0xa1, 0x18, 0x06, 0x16, 0x73, 0x13, 0x31,
+		0x91, 0x9f, 0x3c, 0xba, 0x01, 0x1f, 0x0d, 0x60, 0x0e, 0x55, 0x09, 0x9b, 0xfb, 0xaa, 0x64, 0x8a,
+		0x33, 0xe5, 0xdf, 0x09, 0xb0, 0x19, 0x2b, 0x21, 0xfd, 0x99, 0x83, 0x86, 0xde, 0xaa, 0xf5, 0x42,
+		0xd9, 0xee, 0x05, 0x04, 0xdd, 0x87, 0xbb, 0x89, 0x86, 0x99, 0x92, 0xf1, 0xb2, 0x5f, 0x2b, 0x4b,
+		0x96, 0x1a, 0x46, 0x7a, 0x37, 0x99, 0x50, 0x1e, 0x82, 0x28, 0xa0, 0xcf, 0xe0, 0xd3, 0xc9, 0x50,
+		0xac, 0x1a, 0xaa, 0x29, 0xe6, 0xca, 0xff, 0x2a, 0xc0, 0x46, 0x3a, 0x38, 0xfa, 0x9d, 0x41, 0x5a,
+		0x51, 0x68, 0xf7, 0xa0, 0x34, 0xe8, 0x84, 0xeb, 0xdc, 0xd5, 0xb8, 0x76, 0xe1, 0xc1, 0x04, 0x5c,
+		0x43, 0x3f, 0x94, 0x74, 0x85, 0x3e, 0xc7, 0x20, 0x51, 0x40, 0xcf, 0x60, 0x6f, 0x02, 0x65, 0x5f,
+		0x52, 0xfa, 0x59, 0x4e, 0x4e, 0x1c, 0xc9, 0x34, 0xb1, 0xb6, 0xdf, 0x30, 0x55, 0x43, 0xcc, 0x21,
+		0x15, 0xa4, 0x0c, 0x07, 0x83, 0x3a, 0x34, 0xd2, 0x4d, 0x1e, 0x3d, 0x81, 0x47, 0x59, 0x71, 0x44,
+		0x2d, 0xa3, 0x1d, 0xa9, 0x38, 0x4d, 0x9d, 0x41, 0xdf, 0xc0, 0xd7, 0x19, 0x54, 0xbe, 0xf2, 0x10,
+		0x77, 0x16, 0xed, 0xc1, 0xe3, 0xcc, 0xe8, 0xe5, 0x1a, 0x56, 0xac, 0x23, 0x09, 0xbf, 0x1c, 0x24,
+		0xcf, 0x21, 0x0d, 0xd4, 0xac, 0x85, 0xb9, 0xba, 0x59, 0x23, 0x74, 0x21, 0xe5, 0xea, 0xda, 0x14,
+		0x59, 0xa4, 0x86, 0x0c, 0x37, 0xf3, 0xe8, 0x39, 0xc8, 0xd3, 0xa5, 0x62, 0xb2, 0xa3, 0x05, 0xf4,
+		0x1a, 0xcc, 0x0f, 0xab, 0xaa, 0xfa, 0xda, 0x54, 0xb1, 0x2e, 0x65, 0x79, 0x06, 0xf4, 0x14, 0x9e,
+		0x64, 0x26, 0x6d, 0x50, 0x7f, 0x52, 0xf4, 0x02, 0x7a, 0x0c, 0x5f, 0x4e, 0xa0, 0xa7, 0x7b, 0xa4,
+		0x7f, 0x2b, 0xd0, 0x14, 0x71, 0x11, 0x3d, 0x82, 0xdd, 0x09, 0x44, 0x36, 0x85, 0x96, 0x61, 0x6a,
+		0xf2, 0xcb, 0x93, 0xe8, 0x75, 0x55, 0x33, 0x4c, 0x71, 0x09, 0xfd, 0x0c, 0x7e, 0x32, 0x81, 0x96,
+		0x6c, 0x96, 0xfe, 0xa1, 0xe2, 0xd4, 0x88, 0x51, 0x58, 0x03, 0xab, 0xe2, 0xf2, 0x14, 0x35, 0x31,
+		0xb4, 0xe7, 0xd9, 0x99, 0x5b, 0x41, 0x32, 0x3c, 0x9b, 0x6a, 0x44, 0xe4, 0x43, 0xad, 0xaa, 0x8c,
+		0x76, 0x22, 0xa2, 0x2f, 0x61, 0x67, 0x82, 0x93, 0x83, 0x1a, 0x96, 0x55, 0x7e, 0x62, 0x25, 0x22,
+		0xb1, 0x8a, 0xbe, 0x86, 0x87, 0x93, 0x48, 0x92, 0x56, 0xad, 0xbd, 0x52, 0xf1, 0x55, 0x1e, 0xa2,
+		0xc7, 0xe8, 0x74, 0x5b, 0xd7, 0xf4, 0x7a, 0xc3, 0xb4, 0x0c, 0xed, 0x5b, 0x55, 0x5c, 0xa3, 0xc7,
+		0x68, 0x66, 0xa5, 0xe2, 0x5c, 0x89, 0xd7, 0x87, 0xc5, 0x78, 0x68, 0x91, 0x7d, 0x4d, 0x97, 0xf0,
+		0x89, 0xb8, 0x9e, 0xd1, 0x7b, 0xc3, 0x42, 0x37, 0xd0, 0x42, 0x1f, 0x4d, 0xb3, 0x1d, 0x55, 0xc2,
+		0xf2, 0x61, 0x3a, 0xe3, 0x1b, 0xf4, 0xd4, 0xb9, 0xc3, 0x7e, 0x58, 0x19, 0xba, 0x57, 0xa5, 0x25,
+		0x7e, 0x17, 0x1e, 0x44, 0x75, 0x1b, 0xd1, 0x05, 0x63, 0xd4, 0x7e, 0x1f, 0x7e, 0x3a, 0x1d, 0x25,
+		0x79, 0x2f, 0x55, 0xb1, 0x2a, 0x29, 0x27, 0xc9, 0x95, 0x54, 0x28, 0xff, 0x5d, 0x80, 0xb2, 0x6c,
+		0xbb, 0x4d, 0xd2, 0x8e, 0x7f, 0x0e, 0x9e, 0x18, 0xe5, 0x1e, 0x3c, 0x9e, 0x62, 0xde, 0xc7, 0xc4,
+		0x7b, 0x0c, 0xc6, 0x87, 0x92, 0x1b, 0xfa, 0x4b, 0xbd, 0x76, 0xac, 0x4f, 0x22, 0xf0, 0x4d, 0x18,
+		0xce, 0xb9, 0x6b, 0x4f, 0xbd, 0x09, 0xde, 0x76, 0xff, 0xdd, 0x26, 0x3e, 0x94, 0x3c, 0xd5, 0x26,
+		0xf6, 0x5f, 0xc1, 0x46, 0xd3, 0xeb, 0x8c, 0xfa, 0x8a, 0xdf, 0x9f, 0x97, 0xba, 0x4e, 0x9d, 0x7e,
+		0xc1, 0xd6, 0x85, 0x6f, 0x77, 0xce, 0x9d, 0xf0, 0xa2, 0x77, 0x5a, 0x69, 0x7a, 0x9d, 0x9d, 0x81,
+		0x7f, 0xae, 0x56, 0xce, 0x89, 0x1b, 0xfd, 0xab, 0x96, 0xff, 0x9f, 0x75, 0xcf, 0xee, 0x3a, 0x6f,
+		0x77, 0x4f, 0xe7, 0x98, 0xed, 0xcb, 0xff, 0x0c, 0x00, 0x51, 0xc5, 0x93, 0x38, 0x27, 0x1e, 0x00,
+		0x00,
 	},
 }
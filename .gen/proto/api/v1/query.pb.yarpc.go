@@ -60,62 +60,63 @@ var yarpcFileDescriptorClosure91769cfce21084c6 = [][]byte{
 		0x47, 0x5c, 0xdb, 0xfa, 0x20, 0xd7, 0xae, 0x7a, 0xf0, 0x32, 0x64, 0x93, 0xaa, 0x17, 0xbd, 0x7a,
 		0xa2, 0x25, 0x91, 0x93, 0xdf, 0x8f, 0x23, 0x7d, 0x52, 0x47, 0x51, 0xfa, 0x25, 0x1b, 0x28, 0x21,
 		0x9b, 0xa8, 0xf7, 0x0e, 0x4e, 0x19, 0xd1, 0x58, 0x2d, 0xae, 0xac, 0xbc, 0xbd, 0xcb, 0x20, 0x89,
-		0xa6, 0xed, 0xc1, 0x56, 0x81, 0xbd, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x96, 0x7d, 0xf9, 0x10,
-		0xf7, 0x03, 0x00, 0x00,
+		0xa6, 0xed, 0xc1, 0x56, 0x81, 0xbd, 0xfd, 0x3d, 0x00, 0x96, 0x7d, 0xf9, 0x10, 0xf7, 0x03, 0x00,
+		0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
-		0x14, 0x9f, 0xe2, 0xda, 0x49, 0x9f, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xfe, 0x79, 0x06, 0x86,
-		0x66, 0x3b, 0x48, 0x88, 0x7b, 0x29, 0x56, 0x14, 0x83, 0x13, 0x3b, 0xab, 0xda, 0x2d, 0x31, 0x64,
-		0x23, 0xc1, 0x76, 0x98, 0x40, 0x4b, 0x4f, 0x2e, 0x67, 0x89, 0x14, 0x28, 0xca, 0x89, 0x6f, 0xfb,
-		0x24, 0x3b, 0xec, 0x2b, 0xed, 0x0b, 0x0d, 0x94, 0xe8, 0xd8, 0xee, 0x3c, 0xf4, 0x32, 0xec, 0x46,
-		0xbe, 0xdf, 0x9f, 0xf7, 0xa3, 0xf0, 0x48, 0x41, 0x3b, 0x9f, 0xa0, 0x74, 0x02, 0x1a, 0x22, 0x0f,
-		0xd0, 0xa1, 0x29, 0x73, 0xe6, 0xa7, 0x4e, 0x20, 0x92, 0x44, 0x70, 0x3b, 0x95, 0x42, 0x09, 0x72,
-		0xa0, 0x19, 0xb6, 0x61, 0xd8, 0x34, 0x65, 0xf6, 0xfc, 0xf4, 0xf8, 0x8b, 0xa9, 0x10, 0xd3, 0x18,
-		0x9d, 0x82, 0x32, 0xc9, 0x23, 0x27, 0xcc, 0x25, 0x55, 0x6c, 0x29, 0xea, 0xbc, 0x85, 0x8f, 0x6f,
-		0x84, 0x9c, 0x45, 0xb1, 0xb8, 0x1d, 0xdc, 0x61, 0x90, 0x6b, 0x88, 0x7c, 0x09, 0xf5, 0x5b, 0x53,
-		0xf4, 0x59, 0xd8, 0xb2, 0xda, 0xd6, 0xc9, 0x43, 0x0f, 0x96, 0x25, 0x37, 0x24, 0x4f, 0xa0, 0x26,
-		0x73, 0xae, 0xb1, 0x9d, 0x02, 0xab, 0xca, 0x9c, 0xbb, 0x61, 0xa7, 0x03, 0x8d, 0xa5, 0xd9, 0x78,
-		0x91, 0x22, 0x21, 0xf0, 0x80, 0xd3, 0x04, 0x8d, 0x41, 0xb1, 0xd6, 0x9c, 0x5e, 0xa0, 0xd8, 0x9c,
-		0xa9, 0xc5, 0xbf, 0x72, 0x3e, 0x87, 0xdd, 0x21, 0x5d, 0xc4, 0x82, 0x86, 0x1a, 0x0e, 0xa9, 0xa2,
-		0x05, 0xdc, 0xf0, 0x8a, 0x75, 0xe7, 0x25, 0xec, 0x5e, 0x50, 0x16, 0xe7, 0x12, 0xc9, 0x21, 0xd4,
-		0x24, 0xd2, 0x4c, 0x70, 0xa3, 0x37, 0x3b, 0xd2, 0x82, 0xdd, 0x10, 0x15, 0x65, 0x71, 0x56, 0x24,
-		0x6c, 0x78, 0xcb, 0x6d, 0xe7, 0x0f, 0x0b, 0x1e, 0xfc, 0x84, 0x89, 0x20, 0xaf, 0xa0, 0x16, 0x31,
-		0x8c, 0xc3, 0xac, 0x65, 0xb5, 0x2b, 0x27, 0xf5, 0xee, 0xd7, 0xf6, 0x96, 0xef, 0x67, 0x6b, 0xaa,
-		0x7d, 0x51, 0xf0, 0x06, 0x5c, 0xc9, 0x85, 0x67, 0x44, 0xc7, 0x37, 0x50, 0x5f, 0x2b, 0x93, 0x26,
-		0x54, 0x66, 0xb8, 0x30, 0x29, 0xf4, 0x92, 0x74, 0xa1, 0x3a, 0xa7, 0x71, 0x8e, 0x45, 0x80, 0x7a,
-		0xf7, 0xb3, 0xad, 0xf6, 0xe6, 0x98, 0x5e, 0x49, 0xfd, 0x6e, 0xe7, 0x85, 0xd5, 0xf9, 0xd3, 0x82,
-		0xda, 0x6b, 0xa4, 0x21, 0x4a, 0xf2, 0xfd, 0x7b, 0x11, 0x9f, 0x6d, 0xf5, 0x28, 0xc9, 0xff, 0x6f,
-		0xc8, 0xbf, 0x2c, 0x68, 0x8e, 0x90, 0xca, 0xe0, 0x5d, 0x4f, 0x29, 0xc9, 0x26, 0xb9, 0xc2, 0x8c,
-		0xf8, 0xb0, 0xcf, 0x78, 0x88, 0x77, 0x18, 0xfa, 0x1b, 0xb1, 0x5f, 0x6c, 0x75, 0x7d, 0x5f, 0x6e,
-		0xbb, 0xa5, 0x76, 0xfd, 0x1c, 0x8f, 0xd8, 0x7a, 0xed, 0xf8, 0x57, 0x20, 0xff, 0x24, 0xfd, 0x87,
-		0xa7, 0x8a, 0x60, 0xaf, 0x4f, 0x15, 0x3d, 0x8b, 0xc5, 0x84, 0x5c, 0xc0, 0x23, 0xe4, 0x81, 0x08,
-		0x19, 0x9f, 0xfa, 0x6a, 0x91, 0x96, 0x03, 0xba, 0xdf, 0xfd, 0x6a, 0xab, 0xd7, 0xc0, 0x30, 0xf5,
-		0x44, 0x7b, 0x0d, 0x5c, 0xdb, 0xdd, 0x0f, 0xf0, 0xce, 0xda, 0x00, 0x0f, 0xcb, 0x4b, 0x87, 0xf2,
-		0x1a, 0x65, 0xc6, 0x04, 0x77, 0x79, 0x24, 0x34, 0x91, 0x25, 0x69, 0xbc, 0xbc, 0x08, 0x7a, 0x4d,
-		0x9e, 0xc1, 0xe3, 0x08, 0xa9, 0xca, 0x25, 0xfa, 0xf3, 0x92, 0x6a, 0x2e, 0xdc, 0xbe, 0x29, 0x1b,
-		0x83, 0xce, 0x5b, 0x78, 0x3a, 0xca, 0xd3, 0x54, 0x48, 0x85, 0xe1, 0x79, 0xcc, 0x90, 0x2b, 0x83,
-		0x64, 0xfa, 0xae, 0x4e, 0x85, 0x9f, 0x85, 0x33, 0xe3, 0x5c, 0x9d, 0x8a, 0x51, 0x38, 0x23, 0x47,
-		0xb0, 0xf7, 0x1b, 0x9d, 0xd3, 0x02, 0x28, 0x3d, 0x77, 0xf5, 0x7e, 0x14, 0xce, 0x3a, 0xbf, 0x57,
-		0xa0, 0xee, 0xa1, 0x92, 0x8b, 0xa1, 0x88, 0x59, 0xb0, 0x20, 0x7d, 0x68, 0x32, 0xce, 0x14, 0xa3,
-		0xb1, 0xcf, 0xb8, 0x42, 0x39, 0xa7, 0x65, 0xca, 0x7a, 0xf7, 0xc8, 0x2e, 0x9f, 0x17, 0x7b, 0xf9,
-		0xbc, 0xd8, 0x7d, 0xf3, 0xbc, 0x78, 0x8f, 0x8d, 0xc4, 0x35, 0x0a, 0xe2, 0xc0, 0xc1, 0x84, 0x06,
-		0x33, 0x11, 0x45, 0x7e, 0x20, 0x30, 0x8a, 0x58, 0xa0, 0x63, 0x16, 0xbd, 0x2d, 0x8f, 0x18, 0xe8,
-		0x7c, 0x85, 0xe8, 0xb6, 0x09, 0xbd, 0x63, 0x49, 0x9e, 0xac, 0xda, 0x56, 0x3e, 0xd8, 0xd6, 0x48,
-		0xee, 0xdb, 0x7e, 0xb3, 0x72, 0xa1, 0x4a, 0x61, 0x92, 0xaa, 0xac, 0xf5, 0xa0, 0x6d, 0x9d, 0x54,
-		0xef, 0xa9, 0x3d, 0x53, 0x26, 0xaf, 0xe0, 0x53, 0x2e, 0xb8, 0x2f, 0xf5, 0xd1, 0xe9, 0x24, 0x46,
-		0x1f, 0xa5, 0x14, 0xd2, 0x2f, 0x9f, 0x94, 0xac, 0x55, 0x6d, 0x57, 0x4e, 0x1e, 0x7a, 0x2d, 0x2e,
-		0xb8, 0xb7, 0x64, 0x0c, 0x34, 0xc1, 0x2b, 0x71, 0xf2, 0x06, 0x0e, 0xf0, 0x2e, 0x65, 0x65, 0x90,
-		0x55, 0xe4, 0xda, 0x87, 0x22, 0x93, 0x95, 0x6a, 0x99, 0xfa, 0xdb, 0x5b, 0x68, 0xac, 0xcf, 0x14,
-		0x39, 0x82, 0x27, 0x83, 0xcb, 0xf3, 0xab, 0xbe, 0x7b, 0xf9, 0x83, 0x3f, 0xfe, 0x79, 0x38, 0xf0,
-		0xdd, 0xcb, 0xeb, 0xde, 0x8f, 0x6e, 0xbf, 0xf9, 0x11, 0x39, 0x86, 0xc3, 0x4d, 0x68, 0xfc, 0xda,
-		0x73, 0x2f, 0xc6, 0xde, 0x4d, 0xd3, 0x22, 0x87, 0x40, 0x36, 0xb1, 0x37, 0xa3, 0xab, 0xcb, 0xe6,
-		0x0e, 0x69, 0xc1, 0x27, 0x9b, 0xf5, 0xa1, 0x77, 0x35, 0xbe, 0x7a, 0xde, 0xac, 0x9c, 0x5d, 0xc3,
-		0xd3, 0x40, 0x24, 0xdb, 0x86, 0xfc, 0x6c, 0xaf, 0x97, 0xb2, 0xa1, 0x4e, 0x3f, 0xb4, 0x7e, 0x71,
-		0xa6, 0x4c, 0xbd, 0xcb, 0x27, 0x76, 0x20, 0x12, 0x67, 0xe3, 0xc7, 0x64, 0x4f, 0x91, 0x97, 0x3f,
-		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x03, 0x00,
-		0x00, 0xff, 0xff, 0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe2, 0xd8, 0x49, 0x8f, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xfe, 0x3c, 0x03, 0x43,
+		0xb3, 0x5d, 0x48, 0x88, 0x7b, 0x53, 0xac, 0x28, 0x06, 0x27, 0x76, 0x56, 0x75, 0x5b, 0x62, 0x28,
+		0x46, 0x82, 0x0d, 0xd8, 0x04, 0x5a, 0x3a, 0x72, 0x39, 0x4b, 0xa4, 0x40, 0x51, 0x4e, 0x7c, 0xb7,
+		0x27, 0xd9, 0xc5, 0x5e, 0x69, 0x2f, 0x34, 0x50, 0xa2, 0x63, 0xbb, 0xf3, 0x90, 0x9b, 0x61, 0x77,
+		0xe4, 0xf9, 0x7e, 0xf8, 0x91, 0x38, 0x24, 0xa1, 0x9d, 0x8f, 0x51, 0x3a, 0x01, 0x0d, 0x91, 0x07,
+		0xe8, 0xd0, 0x94, 0x39, 0xb3, 0x13, 0x27, 0x10, 0x49, 0x22, 0xb8, 0x9d, 0x4a, 0xa1, 0x04, 0xd9,
+		0xd7, 0x0c, 0xdb, 0x30, 0x6c, 0x9a, 0x32, 0x7b, 0x76, 0x72, 0xf4, 0xd9, 0x44, 0x88, 0x49, 0x8c,
+		0x4e, 0x41, 0x19, 0xe7, 0x91, 0x13, 0xe6, 0x92, 0x2a, 0xb6, 0x10, 0x75, 0xbe, 0x87, 0x0f, 0x6f,
+		0x84, 0x9c, 0x46, 0xb1, 0xb8, 0x1d, 0xdc, 0x61, 0x90, 0x6b, 0x88, 0x7c, 0x0e, 0xf5, 0x5b, 0x53,
+		0xf4, 0x59, 0xd8, 0xb2, 0xda, 0xd6, 0xf1, 0x23, 0x0f, 0x16, 0x25, 0x37, 0x24, 0x4f, 0xa1, 0x26,
+		0x73, 0xae, 0xb1, 0xad, 0x02, 0xab, 0xca, 0x9c, 0xbb, 0x61, 0xa7, 0x03, 0x8d, 0x85, 0xd9, 0x68,
+		0x9e, 0x22, 0x21, 0xb0, 0xcd, 0x69, 0x82, 0xc6, 0xa0, 0x18, 0x6b, 0x4e, 0x2f, 0x50, 0x6c, 0xc6,
+		0xd4, 0xfc, 0x5f, 0x39, 0x9f, 0xc2, 0xce, 0x90, 0xce, 0x63, 0x41, 0x43, 0x0d, 0x87, 0x54, 0xd1,
+		0x02, 0x6e, 0x78, 0xc5, 0xb8, 0xf3, 0x0a, 0x76, 0xce, 0x29, 0x8b, 0x73, 0x89, 0xe4, 0x00, 0x6a,
+		0x12, 0x69, 0x26, 0xb8, 0xd1, 0x9b, 0x19, 0x69, 0xc1, 0x4e, 0x88, 0x8a, 0xb2, 0x38, 0x2b, 0x12,
+		0x36, 0xbc, 0xc5, 0xb4, 0xf3, 0x87, 0x05, 0xdb, 0x3f, 0x62, 0x22, 0xc8, 0x6b, 0xa8, 0x45, 0x0c,
+		0xe3, 0x30, 0x6b, 0x59, 0xed, 0xca, 0x71, 0xbd, 0xfb, 0xa5, 0xbd, 0xe1, 0xfc, 0x6c, 0x4d, 0xb5,
+		0xcf, 0x0b, 0xde, 0x80, 0x2b, 0x39, 0xf7, 0x8c, 0xe8, 0xe8, 0x06, 0xea, 0x2b, 0x65, 0xd2, 0x84,
+		0xca, 0x14, 0xe7, 0x26, 0x85, 0x1e, 0x92, 0x2e, 0x54, 0x67, 0x34, 0xce, 0xb1, 0x08, 0x50, 0xef,
+		0x7e, 0xb2, 0xd1, 0xde, 0x6c, 0xd3, 0x2b, 0xa9, 0xdf, 0x6c, 0xbd, 0xb4, 0x3a, 0x7f, 0x5a, 0x50,
+		0x7b, 0x83, 0x34, 0x44, 0x49, 0xbe, 0x7d, 0x2f, 0xe2, 0xf3, 0x8d, 0x1e, 0x25, 0xf9, 0xff, 0x0d,
+		0xf9, 0x97, 0x05, 0xcd, 0x2b, 0xa4, 0x32, 0x78, 0xd7, 0x53, 0x4a, 0xb2, 0x71, 0xae, 0x30, 0x23,
+		0x3e, 0xec, 0x31, 0x1e, 0xe2, 0x1d, 0x86, 0xfe, 0x5a, 0xec, 0x97, 0x1b, 0x5d, 0xdf, 0x97, 0xdb,
+		0x6e, 0xa9, 0x5d, 0xdd, 0xc7, 0x63, 0xb6, 0x5a, 0x3b, 0xfa, 0x15, 0xc8, 0x3f, 0x49, 0xff, 0xe1,
+		0xae, 0x22, 0xd8, 0xed, 0x53, 0x45, 0x4f, 0x63, 0x31, 0x26, 0xe7, 0xf0, 0x18, 0x79, 0x20, 0x42,
+		0xc6, 0x27, 0xbe, 0x9a, 0xa7, 0x65, 0x83, 0xee, 0x75, 0xbf, 0xd8, 0xe8, 0x35, 0x30, 0x4c, 0xdd,
+		0xd1, 0x5e, 0x03, 0x57, 0x66, 0xf7, 0x0d, 0xbc, 0xb5, 0xd2, 0xc0, 0xc3, 0xf2, 0xd2, 0xa1, 0xbc,
+		0x46, 0x99, 0x31, 0xc1, 0x5d, 0x1e, 0x09, 0x4d, 0x64, 0x49, 0x1a, 0x2f, 0x2e, 0x82, 0x1e, 0x93,
+		0xe7, 0xf0, 0x24, 0x42, 0xaa, 0x72, 0x89, 0xfe, 0xac, 0xa4, 0x9a, 0x0b, 0xb7, 0x67, 0xca, 0xc6,
+		0xa0, 0xf3, 0x0b, 0x3c, 0xbb, 0xca, 0xd3, 0x54, 0x48, 0x85, 0xe1, 0x59, 0xcc, 0x90, 0x2b, 0x83,
+		0x64, 0xfa, 0xae, 0x4e, 0x84, 0x9f, 0x85, 0x53, 0xe3, 0x5c, 0x9d, 0x88, 0xab, 0x70, 0x4a, 0x0e,
+		0x61, 0xf7, 0x37, 0x3a, 0xa3, 0x05, 0x50, 0x7a, 0xee, 0xe8, 0xb9, 0x86, 0x9a, 0x50, 0x09, 0x62,
+		0xd6, 0xaa, 0x94, 0x07, 0x1a, 0xc4, 0xac, 0xf3, 0x7b, 0x05, 0xea, 0x1e, 0x2a, 0x39, 0x1f, 0x8a,
+		0x98, 0x05, 0x73, 0xd2, 0x87, 0x26, 0xe3, 0x4c, 0x31, 0x1a, 0xfb, 0x8c, 0x2b, 0x94, 0x33, 0x5a,
+		0xe6, 0xae, 0x77, 0x0f, 0xed, 0xf2, 0xc1, 0xb1, 0x17, 0x0f, 0x8e, 0xdd, 0x37, 0x0f, 0x8e, 0xf7,
+		0xc4, 0x48, 0x5c, 0xa3, 0x20, 0x0e, 0xec, 0x8f, 0x69, 0x30, 0x15, 0x51, 0xe4, 0x07, 0x02, 0xa3,
+		0x88, 0x05, 0x3a, 0x78, 0x91, 0xc6, 0xf2, 0x88, 0x81, 0xce, 0x96, 0x88, 0x5e, 0x36, 0xa1, 0x77,
+		0x2c, 0xc9, 0x93, 0xe5, 0xb2, 0x95, 0x07, 0x97, 0x35, 0x92, 0xfb, 0x65, 0xbf, 0x5a, 0xba, 0x50,
+		0xa5, 0x30, 0x49, 0x55, 0xd6, 0xda, 0x6e, 0x5b, 0xc7, 0xd5, 0x7b, 0x6a, 0xcf, 0x94, 0xc9, 0x6b,
+		0xf8, 0x98, 0x0b, 0xee, 0x4b, 0xbd, 0x75, 0x3a, 0x8e, 0xd1, 0x47, 0x29, 0x85, 0xf4, 0xcb, 0x47,
+		0x26, 0x6b, 0x55, 0xdb, 0x95, 0xe3, 0x47, 0x5e, 0x8b, 0x0b, 0xee, 0x2d, 0x18, 0x03, 0x4d, 0xf0,
+		0x4a, 0x9c, 0xbc, 0x85, 0x7d, 0xbc, 0x4b, 0x59, 0x19, 0x64, 0x19, 0xb9, 0xf6, 0x50, 0x64, 0xb2,
+		0x54, 0x2d, 0x52, 0x7f, 0x7d, 0x0b, 0x8d, 0xd5, 0x2e, 0x23, 0x87, 0xf0, 0x74, 0x70, 0x71, 0x76,
+		0xd9, 0x77, 0x2f, 0xbe, 0xf3, 0x47, 0x3f, 0x0d, 0x07, 0xbe, 0x7b, 0x71, 0xdd, 0xfb, 0xc1, 0xed,
+		0x37, 0x3f, 0x20, 0x47, 0x70, 0xb0, 0x0e, 0x8d, 0xde, 0x78, 0xee, 0xf9, 0xc8, 0xbb, 0x69, 0x5a,
+		0xe4, 0x00, 0xc8, 0x3a, 0xf6, 0xf6, 0xea, 0xf2, 0xa2, 0xb9, 0x45, 0x5a, 0xf0, 0xd1, 0x7a, 0x7d,
+		0xe8, 0x5d, 0x8e, 0x2e, 0x5f, 0x34, 0x2b, 0xa7, 0xd7, 0xf0, 0x2c, 0x10, 0xc9, 0xa6, 0xb6, 0x3f,
+		0xdd, 0xed, 0xa5, 0x6c, 0xa8, 0xd3, 0x0f, 0xad, 0x9f, 0x9d, 0x09, 0x53, 0xef, 0xf2, 0xb1, 0x1d,
+		0x88, 0xc4, 0x59, 0xfb, 0xaa, 0xec, 0x09, 0xf2, 0xf2, 0xfb, 0x31, 0xbf, 0xd6, 0x2b, 0x9a, 0xb2,
+		0xd9, 0xc9, 0xb8, 0x56, 0xd4, 0x5e, 0xfc, 0x3d, 0x00, 0x2f, 0xa5, 0x18, 0x7d, 0xd9, 0x06, 0x00,
+		0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -130,153 +131,158 @@ var yarpcFileDescriptorClosure91769cfce21084c6 = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xca,
-		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xc7, 0xca, 0xb7, 0xa3, 0xfb, 0x26,
-		0x71, 0xd4, 0x1b, 0xf9, 0x3a, 0xb9, 0xb9, 0x69, 0x6e, 0x9a, 0xa6, 0x34, 0x49, 0xc7, 0x4c, 0x64,
-		0x4a, 0x1d, 0x51, 0x71, 0x7c, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x72, 0x94,
-		0xc4, 0xfb, 0x02, 0x5d, 0x77, 0x57, 0xb4, 0x9b, 0xfe, 0x80, 0x02, 0x45, 0x7f, 0x40, 0xd1, 0xa2,
-		0x8b, 0xee, 0xba, 0xed, 0xb2, 0xfb, 0xfe, 0x8b, 0x62, 0x86, 0x43, 0x89, 0xb2, 0x3e, 0xa8, 0xb4,
-		0xc0, 0xed, 0xce, 0x3c, 0x7c, 0x9e, 0x87, 0x67, 0xce, 0x9c, 0xf3, 0x70, 0x68, 0x41, 0xa1, 0x7b,
-		0x4c, 0x82, 0xed, 0x86, 0xd3, 0x24, 0x5e, 0x83, 0x6c, 0x3b, 0x1d, 0x77, 0xfb, 0xc3, 0xce, 0xf6,
-		0x47, 0x3f, 0x78, 0x7f, 0xd2, 0xf2, 0x3f, 0x96, 0x3a, 0x81, 0x4f, 0x7d, 0xb4, 0xc6, 0x30, 0x25,
-		0x81, 0x29, 0x39, 0x1d, 0xb7, 0xf4, 0x61, 0xe7, 0xda, 0xad, 0x53, 0xdf, 0x3f, 0x6d, 0x91, 0x6d,
-		0x0e, 0x39, 0xee, 0x9e, 0x6c, 0x37, 0xbb, 0x81, 0x43, 0x5d, 0xdf, 0x8b, 0x48, 0xd7, 0x6e, 0x5f,
-		0xbc, 0x4f, 0xdd, 0x36, 0x09, 0xa9, 0xd3, 0xee, 0x08, 0xc0, 0xe6, 0xa8, 0x27, 0x37, 0xfc, 0x76,
-		0xbb, 0x27, 0x31, 0x32, 0x37, 0xea, 0x84, 0xef, 0x5b, 0x6e, 0x48, 0x23, 0x4c, 0xe1, 0x2f, 0x73,
-		0xb0, 0x7e, 0x28, 0xd2, 0xd5, 0x3f, 0x91, 0x46, 0x97, 0xa5, 0x60, 0x78, 0x27, 0x3e, 0xaa, 0x03,
-		0x8a, 0xd7, 0x61, 0x93, 0xf8, 0x4e, 0x5e, 0xda, 0x94, 0xb6, 0x72, 0x8f, 0xee, 0x95, 0x46, 0x2c,
-		0xa9, 0x34, 0xa4, 0x83, 0x57, 0x3f, 0x5e, 0x0c, 0xa1, 0x27, 0x30, 0x43, 0xcf, 0x3b, 0x24, 0x9f,
-		0xe1, 0x42, 0x77, 0x26, 0x0a, 0x59, 0xe7, 0x1d, 0x82, 0x39, 0x1c, 0x3d, 0x03, 0x08, 0xa9, 0x13,
-		0x50, 0x9b, 0x95, 0x21, 0x9f, 0xe5, 0xe4, 0x6b, 0xa5, 0xa8, 0x46, 0xa5, 0xb8, 0x46, 0x25, 0x2b,
-		0xae, 0x11, 0x5e, 0xe0, 0x68, 0x76, 0xcd, 0xa8, 0x8d, 0x96, 0x1f, 0x92, 0x88, 0x3a, 0x93, 0x4e,
-		0xe5, 0x68, 0x4e, 0xb5, 0x60, 0x31, 0xa2, 0x86, 0xd4, 0xa1, 0xdd, 0x30, 0x3f, 0xbb, 0x29, 0x6d,
-		0x2d, 0x3f, 0xda, 0x99, 0x6e, 0xf5, 0x2a, 0x63, 0xd6, 0x38, 0x11, 0xe7, 0x1a, 0xfd, 0x0b, 0x74,
-		0x17, 0x96, 0xcf, 0xdc, 0x90, 0xfa, 0xc1, 0xb9, 0xdd, 0x22, 0xde, 0x29, 0x3d, 0xcb, 0xcf, 0x6d,
-		0x4a, 0x5b, 0x59, 0xbc, 0x24, 0xa2, 0x65, 0x1e, 0x44, 0x3f, 0x83, 0xf5, 0x8e, 0x13, 0x10, 0x8f,
-		0xf6, 0xcb, 0x6f, 0xbb, 0xde, 0x89, 0x9f, 0xbf, 0xc4, 0x97, 0xb0, 0x35, 0x32, 0x8b, 0x2a, 0x67,
-		0x0c, 0xec, 0x24, 0x5e, 0xeb, 0x0c, 0x07, 0x91, 0x02, 0xcb, 0x7d, 0x59, 0x5e, 0x99, 0xf9, 0xd4,
-		0xca, 0x2c, 0xf5, 0x18, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x4d, 0xda, 0x7e, 0x7e, 0x81, 0x13, 0xaf,
-		0x8e, 0xcc, 0xe7, 0x80, 0xb4, 0x7d, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0x48, 0x9c, 0xa0, 0x71, 0x66,
-		0x3b, 0x94, 0x06, 0xee, 0x71, 0x97, 0x92, 0x30, 0x0f, 0x9c, 0x7b, 0x77, 0x24, 0xb7, 0xc6, 0xd1,
-		0x4a, 0x0f, 0x8c, 0xe5, 0xf0, 0x42, 0x04, 0x95, 0x61, 0xd5, 0xe9, 0x52, 0xdf, 0x0e, 0x48, 0x48,
-		0xa8, 0xdd, 0xf1, 0x5d, 0x8f, 0x86, 0xf9, 0x1c, 0xd7, 0xdc, 0x1c, 0xa9, 0x89, 0x19, 0xb0, 0xca,
-		0x71, 0x78, 0x85, 0x51, 0x13, 0x01, 0x74, 0x1d, 0x16, 0xd8, 0x78, 0xd8, 0x6c, 0x3e, 0xf2, 0x8b,
-		0x9b, 0xd2, 0xd6, 0x02, 0x9e, 0x67, 0x81, 0xb2, 0x1b, 0x52, 0xb4, 0x01, 0x97, 0xdc, 0xd0, 0x6e,
-		0x04, 0xbe, 0x97, 0x5f, 0xda, 0x94, 0xb6, 0xe6, 0xf1, 0x9c, 0x1b, 0xaa, 0x81, 0xef, 0x15, 0x7e,
-		0x9d, 0x81, 0x5b, 0xc3, 0x9b, 0xef, 0x7b, 0x27, 0xee, 0xa9, 0x18, 0x69, 0xf4, 0x6d, 0x52, 0x38,
-		0x1a, 0xa1, 0x9b, 0x23, 0xd3, 0xb3, 0xc4, 0xd3, 0x12, 0xcf, 0x75, 0x60, 0xb3, 0xbf, 0x51, 0x62,
-		0x06, 0x7c, 0xbb, 0xdf, 0xd1, 0x7e, 0x97, 0x8a, 0x61, 0xba, 0x3a, 0xb4, 0x75, 0x9a, 0x48, 0x00,
-		0xdf, 0xe8, 0x49, 0xd4, 0xf8, 0x5c, 0xf8, 0x6a, 0xdc, 0xe3, 0x7e, 0x97, 0xa2, 0x43, 0xb8, 0xce,
-		0xd3, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0x60, 0xec, 0x11, 0xc2, 0x85, 0xbf, 0x4b, 0xb0, 0x36,
-		0xa2, 0x23, 0x59, 0xa1, 0x9b, 0x7e, 0xdb, 0x71, 0x3d, 0xdb, 0x6d, 0xf2, 0x7a, 0x2c, 0xe0, 0xf9,
-		0x28, 0x60, 0x34, 0xd1, 0x6d, 0xc8, 0x89, 0x9b, 0x9e, 0xd3, 0x8e, 0x8c, 0x62, 0x01, 0x43, 0x14,
-		0x32, 0x9d, 0x36, 0x19, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0, 0xa2, 0xeb, 0xb9, 0xd4,
-		0x75, 0x28, 0x69, 0xb2, 0xbc, 0x66, 0xf8, 0x50, 0xe6, 0x7a, 0x31, 0xa3, 0x59, 0xf8, 0x95, 0x04,
-		0xeb, 0xfa, 0x27, 0x4a, 0x02, 0xcf, 0x69, 0x7d, 0x2f, 0x6e, 0x79, 0x31, 0xa7, 0xcc, 0x70, 0x4e,
-		0xff, 0x9c, 0x85, 0xb5, 0x2a, 0xf1, 0x9a, 0xae, 0x77, 0xaa, 0x34, 0xa8, 0xfb, 0xc1, 0xa5, 0xe7,
-		0x3c, 0xa3, 0xdb, 0x90, 0x73, 0xc4, 0x75, 0xbf, 0xca, 0x10, 0x87, 0x8c, 0x26, 0xda, 0x83, 0xa5,
-		0x1e, 0x20, 0xd5, 0x92, 0x63, 0x69, 0x6e, 0xc9, 0x8b, 0x4e, 0xe2, 0x0a, 0xbd, 0x84, 0x59, 0x66,
-		0x8f, 0x91, 0x2b, 0x2f, 0x3f, 0x7a, 0x30, 0xda, 0x97, 0x06, 0x33, 0x64, 0x4e, 0x48, 0x70, 0xc4,
-		0x43, 0x06, 0xac, 0x9e, 0x11, 0x27, 0xa0, 0xc7, 0xc4, 0xa1, 0x76, 0x93, 0x50, 0xc7, 0x6d, 0x85,
-		0xc2, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xf3, 0x96, 0xef, 0x34, 0xb1, 0xdc, 0xa3, 0x69, 0x11, 0x0b,
-		0xbd, 0x86, 0xb5, 0x96, 0x13, 0x52, 0xbb, 0xaf, 0xc7, 0xad, 0x6d, 0x36, 0xd5, 0xda, 0x56, 0x19,
-		0x6d, 0x3f, 0x66, 0x71, 0x7b, 0xdb, 0x03, 0x1e, 0x8c, 0xa6, 0x82, 0x34, 0x23, 0xa5, 0xb9, 0x54,
-		0xa5, 0x15, 0x46, 0xaa, 0x45, 0x1c, 0xae, 0x93, 0x87, 0x4b, 0x0e, 0xa5, 0xa4, 0xdd, 0xa1, 0xdc,
-		0xb9, 0x67, 0x71, 0x7c, 0x89, 0x1e, 0x80, 0xdc, 0x76, 0x3e, 0xb9, 0xed, 0x6e, 0xdb, 0x16, 0xa1,
-		0x90, 0xbb, 0xf0, 0x2c, 0x5e, 0x11, 0x71, 0x45, 0x84, 0x99, 0x5d, 0x87, 0x8d, 0x33, 0xd2, 0xec,
-		0xb6, 0xe2, 0x4c, 0x16, 0xd2, 0xed, 0xba, 0xc7, 0xe0, 0x79, 0xa8, 0xb0, 0x42, 0x3e, 0x75, 0xdc,
-		0x68, 0x66, 0x23, 0x0d, 0x48, 0xd5, 0x58, 0xee, 0x53, 0xb8, 0xc8, 0x4b, 0x58, 0xe4, 0x45, 0x39,
-		0x71, 0xdc, 0x56, 0x37, 0x20, 0xc2, 0x6b, 0x47, 0x6f, 0xd3, 0x5e, 0x84, 0xc1, 0x39, 0xc6, 0x10,
-		0x17, 0xe8, 0x2b, 0xb8, 0xcc, 0x05, 0x58, 0xaf, 0x93, 0xc0, 0x76, 0x9b, 0xc4, 0xa3, 0x2e, 0x3d,
-		0x17, 0x76, 0x8b, 0xd8, 0xbd, 0x43, 0x7e, 0xcb, 0x10, 0x77, 0x0a, 0xbf, 0xcd, 0xc0, 0x55, 0xd1,
-		0x3e, 0xea, 0x99, 0xdb, 0x6a, 0x7e, 0x2f, 0x83, 0xf7, 0x65, 0x42, 0x96, 0x0d, 0x47, 0xd2, 0x8b,
-		0xe4, 0x8f, 0x89, 0xf3, 0x09, 0x77, 0xa4, 0x8b, 0x63, 0x9a, 0x1d, 0x1a, 0x53, 0xf4, 0x16, 0xc4,
-		0x6b, 0x58, 0x98, 0x6b, 0xc7, 0x6f, 0xb9, 0x8d, 0x73, 0xde, 0xe6, 0xcb, 0x63, 0x12, 0x8d, 0x9c,
-		0x93, 0x1b, 0x6a, 0x95, 0xa3, 0xf1, 0x6a, 0xe7, 0x62, 0xa8, 0xf0, 0xb7, 0x4c, 0x6f, 0xfc, 0x35,
-		0xd2, 0x70, 0xc3, 0xb8, 0x2e, 0xbd, 0xa9, 0x94, 0xd2, 0xa7, 0x32, 0x26, 0x0e, 0x4c, 0xe5, 0x70,
-		0xc7, 0x65, 0x3e, 0xb7, 0xe3, 0x5e, 0xc0, 0xe2, 0xc0, 0xf0, 0xa4, 0x1f, 0xdb, 0x72, 0xe1, 0xe8,
-		0xc1, 0x99, 0x19, 0x1c, 0x1c, 0x0c, 0x1b, 0x7e, 0xe0, 0x9e, 0xba, 0x9e, 0xd3, 0xb2, 0x2f, 0x24,
-		0x99, 0x3e, 0xea, 0xeb, 0x31, 0xb5, 0x96, 0x4c, 0xb6, 0xf0, 0xa7, 0x0c, 0x5c, 0x8d, 0xed, 0xa9,
-		0xec, 0x37, 0x9c, 0x96, 0xe6, 0x86, 0x1d, 0x87, 0x36, 0xce, 0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9,
-		0x7e, 0x0e, 0xb7, 0x06, 0x33, 0xb0, 0xfd, 0x13, 0x9b, 0x9e, 0xb9, 0xa1, 0x9d, 0xac, 0xe2, 0x64,
-		0xc1, 0x6b, 0x03, 0x19, 0x55, 0x4e, 0xac, 0x33, 0x37, 0x14, 0x1e, 0x84, 0x6e, 0x02, 0xf0, 0x53,
-		0x02, 0xf5, 0xdf, 0x13, 0x8f, 0xd7, 0x79, 0x11, 0xf3, 0x63, 0x8d, 0xc5, 0x02, 0x85, 0xd7, 0x90,
-		0x4b, 0x9e, 0xa5, 0x9e, 0xc3, 0x9c, 0x38, 0x8e, 0x49, 0x9b, 0xd9, 0xad, 0xdc, 0xa3, 0x2f, 0x52,
-		0x8e, 0x63, 0xfc, 0xa4, 0x2a, 0x28, 0x85, 0x3f, 0x64, 0x60, 0x79, 0xf0, 0x16, 0xba, 0x0f, 0x2b,
-		0xc7, 0xae, 0xe7, 0x04, 0xe7, 0x76, 0xe3, 0x8c, 0x34, 0xde, 0x87, 0xdd, 0xb6, 0xd8, 0x84, 0xe5,
-		0x28, 0xac, 0x8a, 0x28, 0x5a, 0x87, 0xb9, 0xa0, 0xeb, 0xc5, 0x2f, 0xcb, 0x05, 0x3c, 0x1b, 0x74,
-		0xd9, 0xa9, 0xe2, 0x05, 0x5c, 0x3f, 0x71, 0x83, 0x90, 0xbd, 0x60, 0xa2, 0x66, 0xb7, 0x1b, 0x7e,
-		0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0xe7, 0x90, 0x78, 0x1c, 0xd4, 0x18, 0xc0, 0xe9, 0x8b, 0x8d,
-		0x80, 0x38, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x4e, 0xe0, 0x85, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xbd,
-		0xd3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b, 0x00, 0xfc, 0x8c, 0x4b, 0x9d, 0xe3, 0x56,
-		0xf4, 0x16, 0x9a, 0xc7, 0x89, 0x48, 0xf1, 0x8f, 0x12, 0x5c, 0x1e, 0xf5, 0x8e, 0x45, 0x05, 0xb8,
-		0x55, 0xd5, 0x4d, 0xcd, 0x30, 0x5f, 0xd9, 0x8a, 0x6a, 0x19, 0x6f, 0x0d, 0xeb, 0xc8, 0xae, 0x59,
-		0x8a, 0xa5, 0xdb, 0x86, 0xf9, 0x56, 0x29, 0x1b, 0x9a, 0xfc, 0x7f, 0xe8, 0xff, 0x61, 0x73, 0x0c,
-		0xa6, 0xa6, 0xee, 0xeb, 0x5a, 0xbd, 0xac, 0x6b, 0xb2, 0x34, 0x41, 0xa9, 0x66, 0x29, 0xd8, 0xd2,
-		0x35, 0x39, 0x83, 0x7e, 0x00, 0xf7, 0xc7, 0x60, 0x54, 0xc5, 0x54, 0xf5, 0xb2, 0x8d, 0xf5, 0x9f,
-		0xd6, 0xf5, 0x1a, 0x03, 0x67, 0x8b, 0xbf, 0xe8, 0xe7, 0x3c, 0xe0, 0x40, 0xc9, 0x27, 0x69, 0xba,
-		0x6a, 0xd4, 0x8c, 0x8a, 0x39, 0x29, 0xe7, 0x0b, 0x98, 0x31, 0x39, 0x5f, 0x44, 0xc5, 0x39, 0x17,
-		0x7f, 0x99, 0xe9, 0x7f, 0x02, 0x1b, 0x4d, 0x4c, 0xba, 0xb1, 0xb7, 0xb2, 0x67, 0x1c, 0x56, 0xf0,
-		0x9b, 0xbd, 0x72, 0xe5, 0xd0, 0x36, 0x34, 0x1b, 0xeb, 0xf5, 0x9a, 0x6e, 0x57, 0x2b, 0x65, 0x43,
-		0x3d, 0x4a, 0x64, 0xf2, 0x43, 0xf8, 0x7a, 0x2c, 0x4a, 0x29, 0xb3, 0xa8, 0x56, 0xaf, 0x96, 0x0d,
-		0x95, 0x3d, 0x75, 0x4f, 0x31, 0xca, 0xba, 0x66, 0x57, 0xcc, 0xf2, 0x91, 0x2c, 0xa1, 0x2f, 0x61,
-		0x6b, 0x5a, 0xa6, 0x9c, 0x41, 0x0f, 0xe1, 0xc1, 0x58, 0x34, 0xd6, 0x5f, 0xeb, 0xaa, 0x95, 0x80,
-		0x67, 0xd1, 0x0e, 0x3c, 0x1c, 0x0b, 0xb7, 0x74, 0x7c, 0x60, 0x98, 0xbc, 0xa0, 0x7b, 0x36, 0xae,
-		0x9b, 0xa6, 0x61, 0xbe, 0x92, 0x67, 0x8a, 0xbf, 0x93, 0x60, 0x75, 0xe8, 0xa5, 0x83, 0x6e, 0xc3,
-		0xf5, 0xaa, 0x82, 0x75, 0xd3, 0xb2, 0xd5, 0x72, 0x65, 0x54, 0x01, 0xc6, 0x00, 0x94, 0x5d, 0xc5,
-		0xd4, 0x2a, 0xa6, 0x2c, 0xa1, 0x7b, 0x50, 0x18, 0x05, 0x10, 0xbd, 0x20, 0x5a, 0x43, 0xce, 0xa0,
-		0x3b, 0x70, 0x73, 0x14, 0xae, 0x97, 0xad, 0x9c, 0x2d, 0xfe, 0x2b, 0x03, 0x37, 0x26, 0x7d, 0x69,
-		0xb3, 0x0e, 0xec, 0x2d, 0x5b, 0x7f, 0xa7, 0xab, 0x75, 0x8b, 0xed, 0x79, 0xa4, 0xc7, 0x76, 0xbe,
-		0x5e, 0x4b, 0x64, 0x9e, 0x2c, 0xe9, 0x18, 0xb0, 0x5a, 0x39, 0xa8, 0x96, 0x75, 0x8b, 0x77, 0x53,
-		0x11, 0xee, 0xa5, 0xc1, 0xa3, 0x0d, 0x96, 0x33, 0x03, 0x7b, 0x3b, 0x4e, 0x9a, 0xaf, 0x9b, 0x8d,
-		0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x26, 0xcf, 0xa0, 0xaf, 0xe1, 0xab, 0xf4, 0xc4,
-		0x4d, 0xcb, 0x30, 0xeb, 0xba, 0x66, 0x2b, 0x35, 0xdb, 0xd4, 0x0f, 0xe5, 0xd9, 0x69, 0x96, 0x6b,
-		0x19, 0x07, 0xac, 0x3f, 0xeb, 0x96, 0x3c, 0x57, 0xfc, 0xb3, 0x04, 0x57, 0x54, 0xdf, 0xa3, 0xae,
-		0xd7, 0x25, 0x4a, 0x68, 0x92, 0x8f, 0x46, 0x74, 0x9e, 0xf1, 0x03, 0x74, 0x17, 0xee, 0xc4, 0xfa,
-		0x42, 0xde, 0x36, 0x4c, 0xc3, 0x32, 0x14, 0xab, 0x82, 0x13, 0xf5, 0x9d, 0x08, 0x63, 0x03, 0xa9,
-		0xe9, 0x38, 0xaa, 0xeb, 0x78, 0x18, 0xd6, 0x2d, 0x7c, 0x24, 0x5a, 0x21, 0x72, 0x98, 0xf1, 0x58,
-		0x15, 0xb3, 0xf9, 0x16, 0xf3, 0x2f, 0x67, 0x8b, 0xbf, 0x97, 0x20, 0x27, 0xbe, 0x45, 0xf9, 0xa7,
-		0x4a, 0x1e, 0x2e, 0xb3, 0x05, 0x56, 0xea, 0x96, 0x6d, 0x1d, 0x55, 0xf5, 0xc1, 0x1e, 0x1e, 0xb8,
-		0xc3, 0xed, 0xc1, 0xb6, 0x2a, 0x51, 0x75, 0x22, 0x27, 0x19, 0x04, 0x88, 0xa7, 0x30, 0x0c, 0x07,
-		0xcb, 0x99, 0x89, 0x98, 0x48, 0x27, 0x8b, 0xae, 0xc1, 0x95, 0x01, 0xcc, 0xbe, 0xae, 0x60, 0x6b,
-		0x57, 0x57, 0x2c, 0x79, 0xa6, 0xf8, 0x1b, 0x09, 0xae, 0xc6, 0x4e, 0x68, 0xb1, 0x17, 0xab, 0xdb,
-		0x26, 0xcd, 0x4a, 0x97, 0xaa, 0x4e, 0x37, 0x24, 0xe8, 0x01, 0xdc, 0xed, 0x79, 0x98, 0xa5, 0xd4,
-		0xde, 0xf4, 0xf7, 0xca, 0x56, 0x15, 0x36, 0xdc, 0xfd, 0xd5, 0xa4, 0x42, 0x45, 0x0a, 0xb2, 0x84,
-		0xee, 0xc3, 0x17, 0x93, 0xa1, 0x58, 0xaf, 0xe9, 0x96, 0x9c, 0x29, 0xfe, 0x23, 0x07, 0x1b, 0xc9,
-		0xe4, 0xd8, 0x81, 0x9e, 0x34, 0xa3, 0xd4, 0xee, 0x41, 0x61, 0x50, 0x44, 0xf8, 0xdc, 0xc5, 0xbc,
-		0x76, 0xe0, 0xe1, 0x04, 0x5c, 0xdd, 0xdc, 0x57, 0x4c, 0x8d, 0x5d, 0xc7, 0x20, 0x59, 0x42, 0x2f,
-		0xe1, 0xf9, 0x04, 0xca, 0xae, 0xa2, 0xf5, 0xab, 0xdc, 0x7b, 0xe3, 0x28, 0x96, 0x85, 0x8d, 0xdd,
-		0xba, 0xa5, 0xd7, 0xe4, 0x0c, 0xd2, 0x41, 0x49, 0x11, 0x18, 0xf4, 0xa1, 0x91, 0x32, 0x59, 0xf4,
-		0x0c, 0x9e, 0xa4, 0xe5, 0x11, 0xb5, 0x8c, 0x71, 0xa0, 0xe3, 0x24, 0x75, 0x06, 0x7d, 0x0b, 0xdf,
-		0xa4, 0x50, 0xc5, 0x93, 0x87, 0xb8, 0xb3, 0xe8, 0x39, 0x3c, 0x4d, 0xcd, 0x5e, 0xad, 0x60, 0xcd,
-		0x3e, 0x50, 0xf0, 0x9b, 0x41, 0xf2, 0x1c, 0x32, 0x40, 0x4f, 0x7b, 0xb0, 0x70, 0x37, 0x7b, 0x84,
-		0x2f, 0x24, 0xa4, 0x2e, 0x4d, 0x51, 0x45, 0x16, 0x48, 0x91, 0x99, 0x47, 0xaf, 0x40, 0x9d, 0xae,
-		0x14, 0x93, 0x85, 0x16, 0xd0, 0x3b, 0xb0, 0x3e, 0x6f, 0x57, 0xf5, 0x77, 0x96, 0x8e, 0x4d, 0x25,
-		0x4d, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x5a, 0xb4, 0x41, 0xff, 0x49, 0xd0, 0x73, 0xe8, 0x29, 0x3c,
-		0x9e, 0x40, 0x4f, 0xf6, 0x48, 0xff, 0x54, 0x60, 0x68, 0xf2, 0x22, 0x7a, 0x02, 0x3b, 0x13, 0x88,
-		0x7c, 0x0a, 0xed, 0x9a, 0x65, 0xa8, 0x6f, 0x8e, 0xa2, 0xdb, 0x65, 0xa3, 0x66, 0xc9, 0x4b, 0xe8,
-		0x27, 0xf0, 0xa3, 0x09, 0xb4, 0xde, 0x62, 0xd9, 0x1f, 0x3a, 0x4e, 0x8c, 0x18, 0x83, 0xd5, 0xb1,
-		0x2e, 0x2f, 0x4f, 0xb1, 0x27, 0x35, 0xe3, 0x55, 0x7a, 0xe5, 0x56, 0x90, 0x0a, 0x2f, 0xa7, 0x1a,
-		0x11, 0x75, 0xdf, 0x28, 0x6b, 0xa3, 0x45, 0x64, 0xf4, 0x18, 0xb6, 0x27, 0x88, 0xec, 0x55, 0xb0,
-		0xaa, 0x8b, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x37, 0xf0, 0x68, 0x12, 0x49, 0x31, 0xca, 0x95,
-		0xb7, 0x3a, 0xbe, 0xc8, 0x43, 0xec, 0x35, 0x3a, 0xdd, 0xd2, 0x0d, 0xb3, 0x5a, 0xb7, 0xec, 0x9a,
-		0xf1, 0x9d, 0x2e, 0xaf, 0xb1, 0xd7, 0x68, 0xea, 0x4e, 0xc5, 0xb5, 0x92, 0x2f, 0x0f, 0x9b, 0xf1,
-		0xd0, 0x43, 0x76, 0x0d, 0x53, 0xc1, 0x47, 0xf2, 0x7a, 0x4a, 0xef, 0x0d, 0x1b, 0xdd, 0x40, 0x0b,
-		0x5d, 0x99, 0x66, 0x39, 0xba, 0x82, 0xd5, 0xfd, 0x64, 0xc5, 0x37, 0xd8, 0x5b, 0xe7, 0x0e, 0xff,
-		0xc7, 0xca, 0xd0, 0xb9, 0x2a, 0x69, 0xf1, 0x3b, 0xf0, 0x30, 0xda, 0xb7, 0x11, 0x5d, 0x30, 0xc6,
-		0xed, 0x77, 0xe1, 0xc7, 0xd3, 0x51, 0x7a, 0xf7, 0x95, 0x32, 0xd6, 0x15, 0xed, 0xa8, 0x77, 0x24,
-		0x95, 0x8a, 0x7f, 0x95, 0xa0, 0xa8, 0x3a, 0x5e, 0x83, 0xb4, 0xe2, 0xff, 0xbb, 0x4e, 0xcc, 0xf2,
-		0x39, 0x3c, 0x9d, 0x62, 0xde, 0xc7, 0xe4, 0x7b, 0x08, 0xb5, 0xcf, 0x25, 0xd7, 0xcd, 0x37, 0x66,
-		0xe5, 0xd0, 0x9c, 0x44, 0x10, 0x8b, 0xa8, 0xb9, 0xa7, 0xfc, 0x9f, 0xc6, 0xd3, 0x2d, 0x42, 0xb4,
-		0xdd, 0x7f, 0xb6, 0x88, 0xcf, 0x25, 0x4f, 0xb5, 0x88, 0xdd, 0xb7, 0xb0, 0xd1, 0xf0, 0xdb, 0xa3,
-		0xbe, 0xe2, 0x77, 0xe7, 0x95, 0x8e, 0x5b, 0x65, 0x5f, 0xb0, 0x55, 0xe9, 0xbb, 0xed, 0x53, 0x97,
-		0x9e, 0x75, 0x8f, 0x4b, 0x0d, 0xbf, 0xbd, 0x3d, 0xf0, 0xfb, 0x63, 0xe9, 0x94, 0x78, 0xd1, 0xaf,
-		0x99, 0xe2, 0xa7, 0xc8, 0xe7, 0x4e, 0xc7, 0xfd, 0xb0, 0x73, 0x3c, 0xc7, 0x63, 0x8f, 0xff, 0x1d,
-		0x00, 0x00, 0xff, 0xff, 0x1c, 0xfe, 0xdc, 0x7d, 0x4a, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xc8,
+		0x19, 0x2e, 0x25, 0xdb, 0xb1, 0x5f, 0xf9, 0x83, 0x1e, 0xc7, 0x6b, 0x39, 0xd9, 0x4d, 0x1c, 0x6d,
+		0x93, 0x75, 0xd4, 0x8d, 0xbc, 0xce, 0x6e, 0x36, 0xcd, 0xba, 0x69, 0x4a, 0x93, 0x74, 0xcc, 0x44,
+		0xa6, 0xd4, 0x21, 0x15, 0xc7, 0x8b, 0xa2, 0x04, 0x2d, 0x8d, 0x6d, 0x22, 0x12, 0x29, 0x90, 0x54,
+		0x12, 0xdf, 0x0b, 0xf4, 0x5c, 0xa0, 0xa7, 0xf6, 0xd2, 0x1f, 0x50, 0xa0, 0xe8, 0x0f, 0x28, 0x5a,
+		0xf4, 0xd0, 0x5b, 0xaf, 0x3d, 0xee, 0xbd, 0xff, 0xa2, 0x98, 0xe1, 0x90, 0xa2, 0xac, 0x0f, 0x2a,
+		0x2d, 0xb0, 0xbd, 0x99, 0x2f, 0x9f, 0xe7, 0x9d, 0x77, 0xde, 0x8f, 0x67, 0x86, 0x32, 0x94, 0x7a,
+		0xa7, 0xc4, 0xdf, 0x69, 0xda, 0x2d, 0xe2, 0x36, 0xc9, 0x8e, 0xdd, 0x75, 0x76, 0xde, 0xee, 0xee,
+		0xbc, 0xf3, 0xfc, 0x37, 0x67, 0x6d, 0xef, 0x5d, 0xa5, 0xeb, 0x7b, 0xa1, 0x87, 0xd6, 0x28, 0xa6,
+		0xc2, 0x31, 0x15, 0xbb, 0xeb, 0x54, 0xde, 0xee, 0xde, 0xb8, 0x75, 0xee, 0x79, 0xe7, 0x6d, 0xb2,
+		0xc3, 0x20, 0xa7, 0xbd, 0xb3, 0x9d, 0x56, 0xcf, 0xb7, 0x43, 0xc7, 0x73, 0x23, 0xd2, 0x8d, 0xdb,
+		0x57, 0xdf, 0x87, 0x4e, 0x87, 0x04, 0xa1, 0xdd, 0xe9, 0x72, 0xc0, 0xd6, 0xa8, 0x95, 0x9b, 0x5e,
+		0xa7, 0x93, 0xb8, 0x18, 0x19, 0x5b, 0x68, 0x07, 0x6f, 0xda, 0x4e, 0x10, 0x46, 0x98, 0xd2, 0xdf,
+		0xe6, 0x60, 0xfd, 0x98, 0x87, 0xab, 0xbe, 0x27, 0xcd, 0x1e, 0x0d, 0x41, 0x73, 0xcf, 0x3c, 0xd4,
+		0x00, 0x14, 0xef, 0xc3, 0x22, 0xf1, 0x9b, 0xa2, 0xb0, 0x25, 0x6c, 0x17, 0x1e, 0xde, 0xab, 0x8c,
+		0xd8, 0x52, 0x65, 0xc8, 0x0f, 0x5e, 0x7d, 0x77, 0xd5, 0x84, 0x1e, 0xc1, 0x4c, 0x78, 0xd9, 0x25,
+		0xc5, 0x1c, 0x73, 0x74, 0x67, 0xa2, 0x23, 0xf3, 0xb2, 0x4b, 0x30, 0x83, 0xa3, 0x27, 0x00, 0x41,
+		0x68, 0xfb, 0xa1, 0x45, 0xd3, 0x50, 0xcc, 0x33, 0xf2, 0x8d, 0x4a, 0x94, 0xa3, 0x4a, 0x9c, 0xa3,
+		0x8a, 0x19, 0xe7, 0x08, 0x2f, 0x30, 0x34, 0x7d, 0xa6, 0xd4, 0x66, 0xdb, 0x0b, 0x48, 0x44, 0x9d,
+		0xc9, 0xa6, 0x32, 0x34, 0xa3, 0x9a, 0xb0, 0x18, 0x51, 0x83, 0xd0, 0x0e, 0x7b, 0x41, 0x71, 0x76,
+		0x4b, 0xd8, 0x5e, 0x7e, 0xb8, 0x3b, 0xdd, 0xee, 0x65, 0xca, 0x34, 0x18, 0x11, 0x17, 0x9a, 0xfd,
+		0x07, 0x74, 0x17, 0x96, 0x2f, 0x9c, 0x20, 0xf4, 0xfc, 0x4b, 0xab, 0x4d, 0xdc, 0xf3, 0xf0, 0xa2,
+		0x38, 0xb7, 0x25, 0x6c, 0xe7, 0xf1, 0x12, 0xb7, 0x56, 0x99, 0x11, 0xfd, 0x02, 0xd6, 0xbb, 0xb6,
+		0x4f, 0xdc, 0xb0, 0x9f, 0x7e, 0xcb, 0x71, 0xcf, 0xbc, 0xe2, 0x35, 0xb6, 0x85, 0xed, 0x91, 0x51,
+		0xd4, 0x19, 0x63, 0xa0, 0x92, 0x78, 0xad, 0x3b, 0x6c, 0x44, 0x12, 0x2c, 0xf7, 0xdd, 0xb2, 0xcc,
+		0xcc, 0x67, 0x66, 0x66, 0x29, 0x61, 0xb0, 0xec, 0x3c, 0x80, 0x99, 0x0e, 0xe9, 0x78, 0xc5, 0x05,
+		0x46, 0xdc, 0x1c, 0x19, 0xcf, 0x11, 0xe9, 0x78, 0x98, 0xc1, 0x10, 0x86, 0xd5, 0x80, 0xd8, 0x7e,
+		0xf3, 0xc2, 0xb2, 0xc3, 0xd0, 0x77, 0x4e, 0x7b, 0x21, 0x09, 0x8a, 0xc0, 0xb8, 0x77, 0x47, 0x72,
+		0x0d, 0x86, 0x96, 0x12, 0x30, 0x16, 0x83, 0x2b, 0x16, 0x54, 0x85, 0x55, 0xbb, 0x17, 0x7a, 0x96,
+		0x4f, 0x02, 0x12, 0x5a, 0x5d, 0xcf, 0x71, 0xc3, 0xa0, 0x58, 0x60, 0x3e, 0xb7, 0x46, 0xfa, 0xc4,
+		0x14, 0x58, 0x67, 0x38, 0xbc, 0x42, 0xa9, 0x29, 0x03, 0xba, 0x09, 0x0b, 0x74, 0x3c, 0x2c, 0x3a,
+		0x1f, 0xc5, 0xc5, 0x2d, 0x61, 0x7b, 0x01, 0xcf, 0x53, 0x43, 0xd5, 0x09, 0x42, 0xb4, 0x01, 0xd7,
+		0x9c, 0xc0, 0x6a, 0xfa, 0x9e, 0x5b, 0x5c, 0xda, 0x12, 0xb6, 0xe7, 0xf1, 0x9c, 0x13, 0xc8, 0xbe,
+		0xe7, 0x96, 0xbe, 0xcb, 0xc1, 0xad, 0xe1, 0xe2, 0x7b, 0xee, 0x99, 0x73, 0xce, 0x47, 0x1a, 0x7d,
+		0x93, 0x76, 0x1c, 0x8d, 0xd0, 0x27, 0x23, 0xc3, 0x33, 0xf9, 0x6a, 0xa9, 0x75, 0x6d, 0xd8, 0xea,
+		0x17, 0x8a, 0xcf, 0x80, 0x67, 0xf5, 0x3b, 0xda, 0xeb, 0x85, 0x7c, 0x98, 0x36, 0x87, 0x4a, 0xa7,
+		0xf0, 0x00, 0xf0, 0xc7, 0x89, 0x0b, 0x83, 0xcd, 0x85, 0x27, 0xc7, 0x3d, 0xee, 0xf5, 0x42, 0x74,
+		0x0c, 0x37, 0x59, 0x78, 0x63, 0xbc, 0xe7, 0xb3, 0xbc, 0x6f, 0x50, 0xf6, 0x28, 0xc7, 0x0a, 0x88,
+		0x3e, 0x09, 0x89, 0xcb, 0x62, 0xef, 0x12, 0xdf, 0xf1, 0x5a, 0xc5, 0x99, 0x2c, 0x6f, 0x2b, 0x09,
+		0xa5, 0xce, 0x18, 0xa5, 0x7f, 0x0a, 0xb0, 0x36, 0xa2, 0xaf, 0x69, 0xb9, 0x5a, 0x5e, 0xc7, 0x76,
+		0x5c, 0xcb, 0x69, 0xb1, 0xac, 0x2e, 0xe0, 0xf9, 0xc8, 0xa0, 0xb5, 0xd0, 0x6d, 0x28, 0xf0, 0x97,
+		0xae, 0xdd, 0x89, 0xe4, 0x66, 0x01, 0x43, 0x64, 0xd2, 0xed, 0x0e, 0x19, 0xa3, 0x6f, 0xf9, 0xff,
+		0x55, 0xdf, 0xee, 0xc0, 0xa2, 0xe3, 0x3a, 0xa1, 0x63, 0x87, 0xa4, 0x65, 0x39, 0xd1, 0x76, 0xf3,
+		0xb8, 0x90, 0xd8, 0xb4, 0x56, 0xe9, 0x37, 0x02, 0xac, 0xab, 0xef, 0x43, 0xe2, 0xbb, 0x76, 0xfb,
+		0x7b, 0xd1, 0xdc, 0xab, 0x31, 0xe5, 0x86, 0x63, 0xfa, 0x6e, 0x16, 0xd6, 0xea, 0xc4, 0x6d, 0x39,
+		0xee, 0xb9, 0xd4, 0x0c, 0x9d, 0xb7, 0x4e, 0x78, 0xc9, 0x22, 0xba, 0x0d, 0x05, 0x9b, 0x3f, 0xf7,
+		0xb3, 0x0c, 0xb1, 0x49, 0x6b, 0xa1, 0x03, 0x58, 0x4a, 0x00, 0x99, 0xc2, 0x1e, 0xbb, 0x66, 0xc2,
+		0xbe, 0x68, 0xa7, 0x9e, 0xd0, 0x33, 0x98, 0xa5, 0x22, 0x1b, 0x69, 0xfb, 0xf2, 0xc3, 0xfb, 0xa3,
+		0xd5, 0x6d, 0x30, 0x42, 0xaa, 0xa7, 0x04, 0x47, 0x3c, 0xa4, 0xc1, 0xea, 0x05, 0xb1, 0xfd, 0xf0,
+		0x94, 0xd8, 0xa1, 0xd5, 0x22, 0xa1, 0xed, 0xb4, 0x03, 0xde, 0x6c, 0x1f, 0x8f, 0x91, 0xca, 0xcb,
+		0xb6, 0x67, 0xb7, 0xb0, 0x98, 0xd0, 0x94, 0x88, 0x85, 0x5e, 0xc0, 0x5a, 0xdb, 0x0e, 0x42, 0xab,
+		0xef, 0x8f, 0x09, 0xe4, 0x6c, 0xa6, 0x40, 0xae, 0x52, 0xda, 0x61, 0xcc, 0xa2, 0x76, 0x74, 0x00,
+		0xcc, 0x18, 0xcd, 0x16, 0x69, 0x45, 0x9e, 0xe6, 0x32, 0x3d, 0xad, 0x50, 0x92, 0x11, 0x71, 0x98,
+		0x9f, 0x22, 0x5c, 0xb3, 0xc3, 0x90, 0x74, 0xba, 0x21, 0xd3, 0xff, 0x59, 0x1c, 0x3f, 0xa2, 0xfb,
+		0x20, 0x76, 0xec, 0xf7, 0x4e, 0xa7, 0xd7, 0xb1, 0xb8, 0x29, 0x60, 0x5a, 0x3e, 0x8b, 0x57, 0xb8,
+		0x5d, 0xe2, 0x66, 0x2a, 0xfa, 0x41, 0xf3, 0x82, 0xb4, 0x7a, 0xed, 0x38, 0x92, 0x85, 0x6c, 0xd1,
+		0x4f, 0x18, 0x2c, 0x0e, 0x19, 0x56, 0xc8, 0xfb, 0xae, 0x13, 0xcd, 0x6a, 0xe4, 0x03, 0x32, 0x7d,
+		0x2c, 0xf7, 0x29, 0xcc, 0xc9, 0x33, 0x58, 0x64, 0x49, 0x39, 0xb3, 0x9d, 0x76, 0xcf, 0x27, 0xc5,
+		0xc2, 0x84, 0x32, 0x1d, 0x44, 0x18, 0x5c, 0xa0, 0x0c, 0xfe, 0x80, 0xbe, 0x80, 0xeb, 0xcc, 0x01,
+		0xed, 0x75, 0xe2, 0x5b, 0x4e, 0x8b, 0x0a, 0x46, 0x78, 0xc9, 0x45, 0x1b, 0xd1, 0x77, 0xc7, 0xec,
+		0x95, 0xc6, 0xdf, 0x94, 0x7e, 0x2b, 0x80, 0xc8, 0xdb, 0x87, 0x86, 0xe0, 0xb3, 0xee, 0xde, 0x84,
+		0x79, 0xba, 0x03, 0xbf, 0xdf, 0xda, 0xd7, 0xd8, 0xb3, 0xd6, 0x42, 0xdb, 0x20, 0xc6, 0x25, 0x23,
+		0x6f, 0xe9, 0x21, 0x9c, 0xcc, 0xcd, 0x32, 0xb7, 0xab, 0xd4, 0xac, 0xb5, 0xd0, 0x1e, 0x14, 0xd8,
+		0xf6, 0x2e, 0xa7, 0xbd, 0x9b, 0x40, 0x04, 0xa7, 0x86, 0xd2, 0xef, 0x73, 0xb0, 0xc9, 0xc3, 0x92,
+		0x2f, 0x9c, 0x76, 0xeb, 0x7b, 0xd1, 0x83, 0xcf, 0x53, 0x6e, 0xe9, 0xcc, 0xa6, 0x25, 0x52, 0x7c,
+		0x97, 0xba, 0x7c, 0x31, 0xa1, 0xbc, 0xaa, 0x1e, 0xf9, 0x21, 0xf5, 0x40, 0xaf, 0x80, 0xdf, 0x31,
+		0xf8, 0xc9, 0xd1, 0xf5, 0xda, 0x4e, 0xf3, 0x92, 0x4d, 0xdf, 0xf2, 0x98, 0x40, 0x23, 0x41, 0x67,
+		0xa7, 0x45, 0x9d, 0xa1, 0xf1, 0x6a, 0xf7, 0xaa, 0xa9, 0xf4, 0x8f, 0x5c, 0xa2, 0x4a, 0x0a, 0x69,
+		0x3a, 0x41, 0x9c, 0x97, 0x44, 0x2c, 0x84, 0x6c, 0xb1, 0x88, 0x89, 0x03, 0x62, 0x31, 0x3c, 0x08,
+		0xb9, 0x0f, 0x1d, 0x84, 0xa7, 0xb0, 0x38, 0x30, 0xd3, 0xd9, 0x75, 0x2f, 0x04, 0xa3, 0xe7, 0x79,
+		0x66, 0x70, 0x9e, 0x31, 0x6c, 0x78, 0xbe, 0x73, 0xee, 0xb8, 0x76, 0xdb, 0xba, 0x12, 0x64, 0xb6,
+		0x02, 0xad, 0xc7, 0x54, 0x23, 0x1d, 0x6c, 0xe9, 0x2f, 0x39, 0xd8, 0x8c, 0x55, 0xb3, 0xea, 0x35,
+		0xed, 0xb6, 0xe2, 0x04, 0x5d, 0x3b, 0x6c, 0x5e, 0x4c, 0x27, 0xf2, 0xff, 0xff, 0x74, 0xfd, 0x12,
+		0x6e, 0x0d, 0x46, 0x60, 0x79, 0x67, 0x56, 0x78, 0xe1, 0x04, 0x56, 0x3a, 0x8b, 0x93, 0x1d, 0xde,
+		0x18, 0x88, 0xa8, 0x76, 0x66, 0x5e, 0x38, 0x01, 0x97, 0x46, 0xf4, 0x09, 0x00, 0xbb, 0x02, 0x85,
+		0xde, 0x1b, 0xe2, 0xb2, 0x3c, 0x2f, 0x62, 0x76, 0x67, 0x33, 0xa9, 0xa1, 0xf4, 0x02, 0x0a, 0xe9,
+		0x8b, 0xe2, 0x1e, 0xcc, 0xf1, 0xbb, 0xa6, 0xb0, 0x95, 0xdf, 0x2e, 0x3c, 0xfc, 0x34, 0xe3, 0xae,
+		0xc9, 0xae, 0xe1, 0x9c, 0x52, 0xfa, 0x53, 0x0e, 0x96, 0x07, 0x5f, 0xa1, 0xcf, 0x60, 0xe5, 0xd4,
+		0x71, 0x6d, 0xff, 0xd2, 0x6a, 0x5e, 0x90, 0xe6, 0x9b, 0xa0, 0xd7, 0xe1, 0x45, 0x58, 0x8e, 0xcc,
+		0x32, 0xb7, 0xa2, 0x75, 0x98, 0xf3, 0x7b, 0x6e, 0xac, 0x45, 0x0b, 0x78, 0xd6, 0xef, 0xd1, 0xcb,
+		0xce, 0x53, 0xb8, 0x79, 0xe6, 0xf8, 0x01, 0x3d, 0xf7, 0xa2, 0x66, 0xb7, 0x9a, 0x5e, 0xa7, 0xdb,
+		0x26, 0x03, 0x13, 0x5b, 0x64, 0x90, 0x78, 0x1c, 0xe4, 0x18, 0xc0, 0xe8, 0x8b, 0x4d, 0x9f, 0xd8,
+		0x49, 0x6d, 0xb2, 0x53, 0x59, 0xe0, 0x78, 0xae, 0xe6, 0x4b, 0x4c, 0xd1, 0x1c, 0xf7, 0x7c, 0xda,
+		0x36, 0x5d, 0x8c, 0x09, 0xcc, 0xc1, 0x2d, 0x00, 0x76, 0x81, 0x0f, 0xed, 0xd3, 0x76, 0x74, 0x38,
+		0xce, 0xe3, 0x94, 0xa5, 0xfc, 0x67, 0x01, 0xae, 0x8f, 0x3a, 0xfa, 0x51, 0x09, 0x6e, 0xd5, 0x55,
+		0x5d, 0xd1, 0xf4, 0xe7, 0x96, 0x24, 0x9b, 0xda, 0x2b, 0xcd, 0x3c, 0xb1, 0x0c, 0x53, 0x32, 0x55,
+		0x4b, 0xd3, 0x5f, 0x49, 0x55, 0x4d, 0x11, 0x7f, 0x80, 0x7e, 0x08, 0x5b, 0x63, 0x30, 0x86, 0x7c,
+		0xa8, 0x2a, 0x8d, 0xaa, 0xaa, 0x88, 0xc2, 0x04, 0x4f, 0x86, 0x29, 0x61, 0x53, 0x55, 0xc4, 0x1c,
+		0xfa, 0x11, 0x7c, 0x36, 0x06, 0x23, 0x4b, 0xba, 0xac, 0x56, 0x2d, 0xac, 0xfe, 0xbc, 0xa1, 0x1a,
+		0x14, 0x9c, 0x2f, 0xff, 0xaa, 0x1f, 0xf3, 0x80, 0x02, 0xa5, 0x57, 0x52, 0x54, 0x59, 0x33, 0xb4,
+		0x9a, 0x3e, 0x29, 0xe6, 0x2b, 0x98, 0x31, 0x31, 0x5f, 0x45, 0xc5, 0x31, 0x97, 0x7f, 0x9d, 0xeb,
+		0x7f, 0xdf, 0x6b, 0x2d, 0x4c, 0x7a, 0xb1, 0xb6, 0xd2, 0x35, 0x8e, 0x6b, 0xf8, 0xe5, 0x41, 0xb5,
+		0x76, 0x6c, 0x69, 0x8a, 0x85, 0xd5, 0x86, 0xa1, 0x5a, 0xf5, 0x5a, 0x55, 0x93, 0x4f, 0x52, 0x91,
+		0xfc, 0x18, 0xbe, 0x1a, 0x8b, 0x92, 0xaa, 0xd4, 0xaa, 0x34, 0xea, 0x55, 0x4d, 0xa6, 0xab, 0x1e,
+		0x48, 0x5a, 0x55, 0x55, 0xac, 0x9a, 0x5e, 0x3d, 0x11, 0x05, 0xf4, 0x39, 0x6c, 0x4f, 0xcb, 0x14,
+		0x73, 0xe8, 0x01, 0xdc, 0x1f, 0x8b, 0xc6, 0xea, 0x0b, 0x55, 0x36, 0x53, 0xf0, 0x3c, 0xda, 0x85,
+		0x07, 0x63, 0xe1, 0xa6, 0x8a, 0x8f, 0x34, 0x9d, 0x25, 0xf4, 0xc0, 0xc2, 0x0d, 0x5d, 0xd7, 0xf4,
+		0xe7, 0xe2, 0x4c, 0xf9, 0x0f, 0x02, 0xac, 0x0e, 0x1d, 0x3a, 0xe8, 0x36, 0xdc, 0xac, 0x4b, 0x58,
+		0xd5, 0x4d, 0x4b, 0xae, 0xd6, 0x46, 0x25, 0x60, 0x0c, 0x40, 0xda, 0x97, 0x74, 0xa5, 0xa6, 0x8b,
+		0x02, 0xba, 0x07, 0xa5, 0x51, 0x00, 0xde, 0x0b, 0xbc, 0x35, 0xc4, 0x1c, 0xba, 0x03, 0x9f, 0x8c,
+		0xc2, 0x25, 0xd1, 0x8a, 0xf9, 0xf2, 0xbf, 0x73, 0xf0, 0xf1, 0xa4, 0x9f, 0x11, 0x68, 0x07, 0x26,
+		0xdb, 0x56, 0x5f, 0xab, 0x72, 0xc3, 0xa4, 0x35, 0x8f, 0xfc, 0xd1, 0xca, 0x37, 0x8c, 0x54, 0xe4,
+		0xe9, 0x94, 0x8e, 0x01, 0xcb, 0xb5, 0xa3, 0x7a, 0x55, 0x35, 0x59, 0x37, 0x95, 0xe1, 0x5e, 0x16,
+		0x3c, 0x2a, 0xb0, 0x98, 0x1b, 0xa8, 0xed, 0x38, 0xd7, 0x6c, 0xdf, 0x74, 0x14, 0x50, 0x05, 0xca,
+		0x59, 0xe8, 0x24, 0x0b, 0x8a, 0x38, 0x83, 0xbe, 0x82, 0x2f, 0xb2, 0x03, 0xd7, 0x4d, 0x4d, 0x6f,
+		0xa8, 0x8a, 0x25, 0x19, 0x96, 0xae, 0x1e, 0x8b, 0xb3, 0xd3, 0x6c, 0xd7, 0xd4, 0x8e, 0x68, 0x7f,
+		0x36, 0x4c, 0x71, 0xae, 0xfc, 0x57, 0x01, 0x3e, 0x92, 0x3d, 0x37, 0x74, 0xdc, 0x1e, 0x91, 0x02,
+		0x9d, 0xbc, 0xd3, 0xa2, 0xfb, 0x8c, 0xe7, 0xa3, 0xbb, 0x70, 0x27, 0xf6, 0xcf, 0xdd, 0x5b, 0x9a,
+		0xae, 0x99, 0x9a, 0x64, 0xd6, 0x70, 0x2a, 0xbf, 0x13, 0x61, 0x74, 0x20, 0x15, 0x15, 0x47, 0x79,
+		0x1d, 0x0f, 0xc3, 0xaa, 0x89, 0x4f, 0x78, 0x2b, 0x44, 0x0a, 0x33, 0x1e, 0x2b, 0xe3, 0x9a, 0x9e,
+		0xcc, 0xbf, 0x98, 0x2f, 0xff, 0x51, 0x80, 0x02, 0xff, 0xd0, 0x66, 0x5f, 0x50, 0x45, 0xb8, 0x4e,
+		0x37, 0x58, 0x6b, 0x98, 0x96, 0x79, 0x52, 0x57, 0x07, 0x7b, 0x78, 0xe0, 0x0d, 0x93, 0x07, 0xcb,
+		0xac, 0x45, 0xd9, 0x89, 0x94, 0x64, 0x10, 0xc0, 0x57, 0xa1, 0x18, 0x06, 0x16, 0x73, 0x13, 0x31,
+		0x91, 0x9f, 0x3c, 0xba, 0x01, 0x1f, 0x0d, 0x60, 0x0e, 0x55, 0x09, 0x9b, 0xfb, 0xaa, 0x64, 0x8a,
+		0x33, 0xe5, 0xdf, 0x09, 0xb0, 0x19, 0x2b, 0x21, 0xfd, 0x99, 0x83, 0x86, 0xde, 0xaa, 0xf5, 0x42,
+		0xd9, 0xee, 0x05, 0x04, 0xdd, 0x87, 0xbb, 0x89, 0x86, 0x99, 0x92, 0xf1, 0xb2, 0x5f, 0x2b, 0x4b,
+		0x96, 0x1a, 0x46, 0x7a, 0x37, 0x99, 0x50, 0x1e, 0x82, 0x28, 0xa0, 0xcf, 0xe0, 0xd3, 0xc9, 0x50,
+		0xac, 0x1a, 0xaa, 0x29, 0xe6, 0xca, 0xff, 0x2a, 0xc0, 0x46, 0x3a, 0x38, 0xfa, 0x9d, 0x41, 0x5a,
+		0x51, 0x68, 0xf7, 0xa0, 0x34, 0xe8, 0x84, 0xeb, 0xdc, 0xd5, 0xb8, 0x76, 0xe1, 0xc1, 0x04, 0x5c,
+		0x43, 0x3f, 0x94, 0x74, 0x85, 0x3e, 0xc7, 0x20, 0x51, 0x40, 0xcf, 0x60, 0x6f, 0x02, 0x65, 0x5f,
+		0x52, 0xfa, 0x59, 0x4e, 0x4e, 0x1c, 0xc9, 0x34, 0xb1, 0xb6, 0xdf, 0x30, 0x55, 0x43, 0xcc, 0x21,
+		0x15, 0xa4, 0x0c, 0x07, 0x83, 0x3a, 0x34, 0xd2, 0x4d, 0x1e, 0x3d, 0x81, 0x47, 0x59, 0x71, 0x44,
+		0x2d, 0xa3, 0x1d, 0xa9, 0x38, 0x4d, 0x9d, 0x41, 0xdf, 0xc0, 0xd7, 0x19, 0x54, 0xbe, 0xf2, 0x10,
+		0x77, 0x16, 0xed, 0xc1, 0xe3, 0xcc, 0xe8, 0xe5, 0x1a, 0x56, 0xac, 0x23, 0x09, 0xbf, 0x1c, 0x24,
+		0xcf, 0x21, 0x0d, 0xd4, 0xac, 0x85, 0xb9, 0xba, 0x59, 0x23, 0x74, 0x21, 0xe5, 0xea, 0xda, 0x14,
+		0x59, 0xa4, 0x86, 0x0c, 0x37, 0xf3, 0xe8, 0x39, 0xc8, 0xd3, 0xa5, 0x62, 0xb2, 0xa3, 0x05, 0xf4,
+		0x1a, 0xcc, 0x0f, 0xab, 0xaa, 0xfa, 0xda, 0x54, 0xb1, 0x2e, 0x65, 0x79, 0x06, 0xf4, 0x14, 0x9e,
+		0x64, 0x26, 0x6d, 0x50, 0x7f, 0x52, 0xf4, 0x02, 0x7a, 0x0c, 0x5f, 0x4e, 0xa0, 0xa7, 0x7b, 0xa4,
+		0x7f, 0x2b, 0xd0, 0x14, 0x71, 0x11, 0x3d, 0x82, 0xdd, 0x09, 0x44, 0x36, 0x85, 0x96, 0x61, 0x6a,
+		0xf2, 0xcb, 0x93, 0xe8, 0x75, 0x55, 0x33, 0x4c, 0x71, 0x09, 0xfd, 0x0c, 0x7e, 0x32, 0x81, 0x96,
+		0x6c, 0x96, 0xfe, 0xa1, 0xe2, 0xd4, 0x88, 0x51, 0x58, 0x03, 0xab, 0xe2, 0xf2, 0x14, 0x35, 0x31,
+		0xb4, 0xe7, 0xd9, 0x99, 0x5b, 0x41, 0x32, 0x3c, 0x9b, 0x6a, 0x44, 0xe4, 0x43, 0xad, 0xaa, 0x8c,
+		0x76, 0x22, 0xa2, 0x2f, 0x61, 0x67, 0x82, 0x93, 0x83, 0x1a, 0x96, 0x55, 0x7e, 0x62, 0x25, 0x22,
+		0xb1, 0x8a, 0xbe, 0x86, 0x87, 0x93, 0x48, 0x92, 0x56, 0xad, 0xbd, 0x52, 0xf1, 0x55, 0x1e, 0xa2,
+		0xc7, 0xe8, 0x74, 0x5b, 0xd7, 0xf4, 0x7a, 0xc3, 0xb4, 0x0c, 0xed, 0x5b, 0x55, 0x5c, 0xa3, 0xc7,
+		0x68, 0x66, 0xa5, 0xe2, 0x5c, 0x89, 0xd7, 0x87, 0xc5, 0x78, 0x68, 0x91, 0x7d, 0x4d, 0x97, 0xf0,
+		0x89, 0xb8, 0x9e, 0xd1, 0x7b, 0xc3, 0x42, 0x37, 0xd0, 0x42, 0x1f, 0x4d, 0xb3, 0x1d, 0x55, 0xc2,
+		0xf2, 0x61, 0x3a, 0xe3, 0x1b, 0xf4, 0xd4, 0xb9, 0xc3, 0x7e, 0x58, 0x19, 0xba, 0x57, 0xa5, 0x25,
+		0x7e, 0x17, 0x1e, 0x44, 0x75, 0x1b, 0xd1, 0x05, 0x63, 0xd4, 0x7e, 0x1f, 0x7e, 0x3a, 0x1d, 0x25,
+		0x79, 0x2f, 0x55, 0xb1, 0x2a, 0x29, 0x27, 0xc9, 0x95, 0x54, 0x28, 0xff, 0x5d, 0x80, 0xb2, 0x6c,
+		0xbb, 0x4d, 0xd2, 0x8e, 0x7f, 0x0e, 0x9e, 0x18, 0xe5, 0x1e, 0x3c, 0x9e, 0x62, 0xde, 0xc7, 0xc4,
+		0x7b, 0x0c, 0xc6, 0x87, 0x92, 0x1b, 0xfa, 0x4b, 0xbd, 0x76, 0xac, 0x4f, 0x22, 0xf0, 0x4d, 0x18,
+		0xce, 0xb9, 0x6b, 0x4f, 0xbd, 0x09, 0xde, 0x76, 0xff, 0xdd, 0x26, 0x3e, 0x94, 0x3c, 0xd5, 0x26,
+		0xf6, 0x5f, 0xc1, 0x46, 0xd3, 0xeb, 0x8c, 0xfa, 0x8a, 0xdf, 0x9f, 0x97, 0xba, 0x4e, 0x9d, 0x7e,
+		0xc1, 0xd6, 0x85, 0x6f, 0x77, 0xce, 0x9d, 0xf0, 0xa2, 0x77, 0x5a, 0x69, 0x7a, 0x9d, 0x9d, 0x81,
+		0x7f, 0xae, 0x56, 0xce, 0x89, 0x1b, 0xfd, 0xab, 0x96, 0xff, 0x9f, 0x75, 0xcf, 0xee, 0x3a, 0x6f,
+		0x77, 0x4f, 0xe7, 0x98, 0xed, 0xcb, 0xff, 0x0c, 0x00, 0x51, 0xc5, 0x93, 0x38, 0x27, 0x1e, 0x00,
+		0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -291,7 +297,7 @@ var yarpcFileDescriptorClosure91769cfce21084c6 = [][]byte{
 		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
 		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
 		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
@@ -340,8 +346,8 @@ var yarpcFileDescriptorClosure91769cfce21084c6 = [][]byte{
 		0xd4, 0x75, 0x36, 0x80, 0xa3, 0xb3, 0x24, 0xbc, 0x0a, 0x93, 0x6b, 0x77, 0xeb, 0xf4, 0x0a, 0xbd,
 		0xcc, 0x44, 0xb9, 0x49, 0xd1, 0xd3, 0xfe, 0xa8, 0xe6, 0x33, 0x23, 0xc8, 0xcc, 0xf9, 0x23, 0x98,
 		0x73, 0x7d, 0xd3, 0xa4, 0x7e, 0x26, 0xca, 0xe0, 0x93, 0x6f, 0xd2, 0x9f, 0x43, 0xd5, 0xfe, 0x5b,
-		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0x58, 0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
+		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x77, 0x00, 0x58,
+		0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -360,7 +366,6 @@ var yarpcFileDescriptorClosure91769cfce21084c6 = [][]byte{
 		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
 		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
 		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
 	},
 }
@@ -848,10 +848,11 @@ func (m *CountWorkflowExecutionsRequest) GetQuery() string {
 }
 
 type CountWorkflowExecutionsResponse struct {
-	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Count                int64                           `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Groups               []*CountWorkflowExecutionsGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
 func (m *CountWorkflowExecutionsResponse) Reset()         { *m = CountWorkflowExecutionsResponse{} }
@@ -894,6 +895,68 @@ func (m *CountWorkflowExecutionsResponse) GetCount() int64 {
 	return 0
 }
 
+func (m *CountWorkflowExecutionsResponse) GetGroups() []*CountWorkflowExecutionsGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type CountWorkflowExecutionsGroup struct {
+	GroupValues          []string `protobuf:"bytes,1,rep,name=group_values,json=groupValues,proto3" json:"group_values,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountWorkflowExecutionsGroup) Reset()         { *m = CountWorkflowExecutionsGroup{} }
+func (m *CountWorkflowExecutionsGroup) String() string { return proto.CompactTextString(m) }
+func (*CountWorkflowExecutionsGroup) ProtoMessage()    {}
+func (*CountWorkflowExecutionsGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7341dc69cef4364, []int{12}
+}
+func (m *CountWorkflowExecutionsGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountWorkflowExecutionsGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountWorkflowExecutionsGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CountWorkflowExecutionsGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountWorkflowExecutionsGroup.Merge(m, src)
+}
+func (m *CountWorkflowExecutionsGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountWorkflowExecutionsGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountWorkflowExecutionsGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountWorkflowExecutionsGroup proto.InternalMessageInfo
+
+func (m *CountWorkflowExecutionsGroup) GetGroupValues() []string {
+	if m != nil {
+		return m.GroupValues
+	}
+	return nil
+}
+
+func (m *CountWorkflowExecutionsGroup) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 type GetSearchAttributesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -904,7 +967,7 @@ func (m *GetSearchAttributesRequest) Reset()         { *m = GetSearchAttributesR
 func (m *GetSearchAttributesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSearchAttributesRequest) ProtoMessage()    {}
 func (*GetSearchAttributesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7341dc69cef4364, []int{12}
+	return fileDescriptor_a7341dc69cef4364, []int{13}
 }
 func (m *GetSearchAttributesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,7 +1007,7 @@ func (m *GetSearchAttributesResponse) Reset()         { *m = GetSearchAttributes
 func (m *GetSearchAttributesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSearchAttributesResponse) ProtoMessage()    {}
 func (*GetSearchAttributesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7341dc69cef4364, []int{13}
+	return fileDescriptor_a7341dc69cef4364, []int{14}
 }
 func (m *GetSearchAttributesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -993,6 +1056,7 @@ func init() {
 	proto.RegisterType((*ScanWorkflowExecutionsResponse)(nil), "uber.cadence.api.v1.ScanWorkflowExecutionsResponse")
 	proto.RegisterType((*CountWorkflowExecutionsRequest)(nil), "uber.cadence.api.v1.CountWorkflowExecutionsRequest")
 	proto.RegisterType((*CountWorkflowExecutionsResponse)(nil), "uber.cadence.api.v1.CountWorkflowExecutionsResponse")
+	proto.RegisterType((*CountWorkflowExecutionsGroup)(nil), "uber.cadence.api.v1.CountWorkflowExecutionsGroup")
 	proto.RegisterType((*GetSearchAttributesRequest)(nil), "uber.cadence.api.v1.GetSearchAttributesRequest")
 	proto.RegisterType((*GetSearchAttributesResponse)(nil), "uber.cadence.api.v1.GetSearchAttributesResponse")
 	proto.RegisterMapType((map[string]IndexedValueType)(nil), "uber.cadence.api.v1.GetSearchAttributesResponse.KeysEntry")
@@ -1003,59 +1067,62 @@ func init() {
 }
 
 var fileDescriptor_a7341dc69cef4364 = []byte{
-	// 827 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x19, 0xbb, 0x4e, 0xea, 0x97, 0x86, 0x94, 0x69, 0xd5, 0x5a, 0xdb, 0x62, 0xdc, 0x25,
-	0x2d, 0x16, 0xaa, 0x76, 0x89, 0x8b, 0x68, 0x49, 0x4e, 0x4e, 0x55, 0x68, 0x0a, 0x2a, 0xd6, 0x3a,
-	0x2a, 0x82, 0x03, 0xd6, 0x7a, 0xfd, 0xe2, 0x8c, 0x6c, 0xef, 0x6e, 0x77, 0x66, 0x5d, 0x6f, 0x6f,
-	0x48, 0xb9, 0x72, 0x01, 0x0e, 0x10, 0xf1, 0x07, 0x71, 0x41, 0xca, 0x99, 0x13, 0xca, 0x5f, 0x82,
-	0xf6, 0x87, 0x57, 0x0e, 0xcc, 0xae, 0xe3, 0x1c, 0x30, 0x87, 0xde, 0x3c, 0xcf, 0xef, 0x7d, 0xf6,
-	0x3b, 0x6f, 0xdf, 0xbe, 0x79, 0x03, 0xf7, 0xfd, 0x2e, 0x7a, 0xba, 0x65, 0xf6, 0xd0, 0xb6, 0x50,
-	0x37, 0x5d, 0xa6, 0x8f, 0xb7, 0x74, 0x8e, 0xde, 0x98, 0x59, 0xd8, 0x19, 0x33, 0xce, 0xba, 0x6c,
-	0xc8, 0x44, 0xa0, 0xb9, 0x9e, 0x23, 0x1c, 0x7a, 0x2d, 0xf4, 0xd6, 0x12, 0x6f, 0xcd, 0x74, 0x99,
-	0x36, 0xde, 0x52, 0x36, 0x65, 0x88, 0x7f, 0x86, 0x2a, 0xaa, 0xcc, 0xeb, 0x95, 0xe3, 0x0d, 0x0e,
-	0x86, 0xce, 0xab, 0xd8, 0x47, 0xfd, 0x91, 0xc0, 0xbb, 0x5f, 0x32, 0x2e, 0xbe, 0x4e, 0xcc, 0x4f,
-	0x26, 0x68, 0xf9, 0x82, 0x39, 0x36, 0x37, 0xf0, 0xa5, 0x8f, 0x5c, 0xd0, 0x1b, 0xb0, 0xd2, 0x73,
-	0x46, 0x26, 0xb3, 0x2b, 0xa4, 0x46, 0xea, 0x65, 0x23, 0x59, 0xd1, 0x5b, 0x50, 0x76, 0xcd, 0x3e,
-	0x76, 0x38, 0x7b, 0x8d, 0x95, 0x42, 0x8d, 0xd4, 0x4b, 0xc6, 0xe5, 0xd0, 0xd0, 0x66, 0xaf, 0x91,
-	0xde, 0x83, 0x0d, 0x1b, 0x27, 0xa2, 0x13, 0x79, 0x08, 0x67, 0x80, 0x76, 0xa5, 0x58, 0x23, 0xf5,
-	0x2b, 0xc6, 0x7a, 0x68, 0x6e, 0x99, 0x7d, 0xdc, 0x0f, 0x8d, 0xf4, 0x3a, 0x94, 0x5e, 0xfa, 0xe8,
-	0x05, 0x95, 0x4b, 0x11, 0x3b, 0x5e, 0xa8, 0x3f, 0x13, 0xa8, 0x66, 0x89, 0xe2, 0xae, 0x63, 0x73,
-	0xa4, 0xcf, 0x00, 0x30, 0xb5, 0x56, 0x48, 0xad, 0x58, 0x5f, 0x6b, 0x7c, 0xa8, 0x49, 0x72, 0xa5,
-	0xfd, 0x0b, 0xb2, 0x67, 0x1f, 0x38, 0xc6, 0x4c, 0xb4, 0x4c, 0x6c, 0x41, 0x22, 0x56, 0x3d, 0x2a,
-	0xc2, 0x9d, 0x50, 0xd6, 0x57, 0x2e, 0xda, 0x4b, 0xca, 0x57, 0x0b, 0xde, 0xe1, 0xc2, 0xf4, 0x44,
-	0x47, 0xb0, 0x11, 0x76, 0x0e, 0xd8, 0x50, 0xa0, 0x17, 0xe5, 0x6e, 0xad, 0xb1, 0x29, 0xdd, 0x7d,
-	0x3b, 0xf4, 0xde, 0x67, 0x23, 0xfc, 0x2c, 0xf2, 0x35, 0x36, 0xf8, 0x59, 0x03, 0xfd, 0x06, 0xae,
-	0xa6, 0xa9, 0x98, 0x02, 0x4b, 0x11, 0xf0, 0xfe, 0xf9, 0xd2, 0x19, 0x73, 0x9e, 0xbe, 0x65, 0x6c,
-	0xe0, 0x59, 0x13, 0x7d, 0x06, 0x6b, 0x22, 0x70, 0x53, 0x99, 0x2b, 0x11, 0xf5, 0x83, 0x5c, 0xea,
-	0x7e, 0xe0, 0x62, 0x0a, 0x04, 0x91, 0xae, 0x76, 0xcb, 0xb0, 0x1a, 0x63, 0xb8, 0xfa, 0x0b, 0x01,
-	0x35, 0xef, 0x35, 0x2c, 0xb1, 0x42, 0x4e, 0x8a, 0xf0, 0x7e, 0x28, 0xed, 0xf1, 0xd0, 0xe1, 0xd8,
-	0x7b, 0x53, 0x23, 0xff, 0x69, 0x8d, 0xd0, 0xa7, 0xb0, 0xce, 0x85, 0x29, 0x7c, 0x3e, 0xa5, 0xad,
-	0x46, 0xb4, 0x3b, 0x59, 0x9b, 0x16, 0x3e, 0x4f, 0x39, 0x57, 0xf8, 0xcc, 0x7a, 0xb6, 0xda, 0x8e,
-	0x09, 0x6c, 0xe6, 0xbf, 0xd2, 0x25, 0xd6, 0xdb, 0x31, 0x81, 0xbb, 0xa1, 0xb8, 0xa6, 0x67, 0x1d,
-	0xb2, 0xf1, 0xd2, 0x2a, 0x4e, 0xde, 0xc5, 0x7f, 0x23, 0x70, 0x6f, 0x9e, 0xb8, 0x25, 0xe6, 0x2e,
-	0x3c, 0xf9, 0xda, 0x96, 0x69, 0xff, 0xef, 0x4e, 0xbe, 0x2c, 0x51, 0x4b, 0xcc, 0xd5, 0x73, 0xa8,
-	0x3e, 0x76, 0x7c, 0xfb, 0x02, 0x53, 0x42, 0xba, 0xcd, 0xc2, 0xec, 0x36, 0x1f, 0xc2, 0x7b, 0x99,
-	0xbc, 0x64, 0x9b, 0xd7, 0xa1, 0x64, 0x85, 0x2e, 0x11, 0xaf, 0x68, 0xc4, 0x0b, 0xf5, 0x36, 0x28,
-	0x9f, 0xa3, 0x68, 0xa3, 0xe9, 0x59, 0x87, 0x4d, 0x21, 0x3c, 0xd6, 0xf5, 0x05, 0x4e, 0x45, 0xa8,
-	0x7f, 0x10, 0xb8, 0x25, 0xfd, 0x3b, 0x61, 0x3e, 0x87, 0x4b, 0x03, 0x0c, 0xa6, 0x49, 0xdb, 0x96,
-	0x26, 0x2d, 0x27, 0x5e, 0xfb, 0x02, 0x03, 0xfe, 0xc4, 0x16, 0x5e, 0x60, 0x44, 0x1c, 0xe5, 0x3b,
-	0x28, 0xa7, 0x26, 0x7a, 0x15, 0x8a, 0x03, 0x0c, 0x92, 0xed, 0x87, 0x3f, 0xe9, 0x0e, 0x94, 0xc6,
-	0xe6, 0xd0, 0x8f, 0x6b, 0xe4, 0xed, 0xc6, 0x5d, 0xe9, 0xf3, 0xf6, 0xec, 0x1e, 0x4e, 0xb0, 0xf7,
-	0x22, 0x74, 0x0c, 0x3b, 0x9b, 0x11, 0xc7, 0x6c, 0x17, 0x1e, 0x91, 0xc6, 0x9f, 0xab, 0xb0, 0xfe,
-	0x22, 0x9d, 0xea, 0x9a, 0xad, 0x3d, 0xfa, 0x3d, 0x81, 0x1b, 0xf2, 0xc9, 0x88, 0x36, 0xa4, 0xf8,
-	0xdc, 0xd9, 0x4e, 0x79, 0xb0, 0x50, 0x4c, 0x92, 0xc5, 0x1f, 0x08, 0x28, 0xd9, 0xe7, 0x2f, 0xfd,
-	0x24, 0x93, 0x99, 0x3b, 0x37, 0x29, 0x0f, 0x17, 0x8e, 0x4b, 0xf4, 0xfc, 0x44, 0xe0, 0x76, 0x5e,
-	0x87, 0xa6, 0x8f, 0x32, 0xc9, 0x73, 0xce, 0x69, 0xe5, 0xd3, 0x0b, 0x44, 0x26, 0xaa, 0x7e, 0x4d,
-	0x66, 0xd8, 0xec, 0xee, 0x47, 0xb7, 0x33, 0xe9, 0x73, 0xfb, 0xb9, 0xb2, 0x73, 0xa1, 0xd8, 0x44,
-	0x5b, 0x58, 0x45, 0xf2, 0x2e, 0x93, 0x51, 0x45, 0xb9, 0x7d, 0x32, 0xa3, 0x8a, 0xe6, 0xb4, 0xb1,
-	0x23, 0x02, 0x37, 0x33, 0x7a, 0x00, 0x95, 0x03, 0xf3, 0x3b, 0x90, 0xf2, 0xf1, 0x62, 0x41, 0x89,
-	0x8c, 0x09, 0x5c, 0x93, 0x7c, 0xf1, 0x54, 0x3f, 0x7f, 0x6f, 0x88, 0x9f, 0xfe, 0xd1, 0xa2, 0xcd,
-	0x64, 0xb7, 0xfb, 0xfb, 0x69, 0x95, 0x9c, 0x9c, 0x56, 0xc9, 0x5f, 0xa7, 0x55, 0x02, 0x37, 0x2d,
-	0x67, 0x24, 0x43, 0xec, 0x5e, 0x6e, 0xba, 0xac, 0x15, 0x5e, 0xd5, 0x5a, 0xe4, 0x5b, 0xbd, 0xcf,
-	0xc4, 0xa1, 0xdf, 0xd5, 0x2c, 0x67, 0xa4, 0x9f, 0xb9, 0xdb, 0x69, 0x7d, 0xb4, 0xf5, 0xe8, 0x42,
-	0x97, 0x5c, 0xf3, 0x76, 0x4c, 0x97, 0x8d, 0xb7, 0xba, 0x2b, 0x91, 0xed, 0xc1, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x3f, 0xeb, 0x89, 0xd3, 0x73, 0x0e, 0x00, 0x00,
+	// 874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x41, 0x73, 0xdb, 0x44,
+	0x14, 0x66, 0xed, 0x3a, 0xa9, 0x5f, 0x12, 0x52, 0xb6, 0x9d, 0xd6, 0xa3, 0x06, 0x93, 0x88, 0xb4,
+	0x64, 0x98, 0x8e, 0x44, 0x1c, 0x06, 0x4a, 0x72, 0x4a, 0x3a, 0xa5, 0x4d, 0x61, 0x8a, 0x47, 0xc9,
+	0xb4, 0x03, 0x07, 0x3c, 0xb2, 0xfc, 0xe2, 0xec, 0xd8, 0xd6, 0xaa, 0xda, 0x95, 0x6b, 0xf5, 0x06,
+	0xd3, 0x2b, 0x17, 0xe0, 0x00, 0x1d, 0x7e, 0x10, 0x17, 0x66, 0x7a, 0xe6, 0xc4, 0xe4, 0x97, 0x30,
+	0x5a, 0xc9, 0xc2, 0x01, 0x49, 0xa9, 0x73, 0xc0, 0x3d, 0xf4, 0x96, 0xf7, 0xf2, 0xde, 0xa7, 0xef,
+	0x7b, 0xfb, 0xfc, 0xf6, 0x2d, 0xdc, 0x0a, 0xda, 0xe8, 0x9b, 0x8e, 0xdd, 0x41, 0xd7, 0x41, 0xd3,
+	0xf6, 0x98, 0x39, 0xdc, 0x34, 0x05, 0xfa, 0x43, 0xe6, 0x60, 0x6b, 0xc8, 0x04, 0x6b, 0xb3, 0x3e,
+	0x93, 0xa1, 0xe1, 0xf9, 0x5c, 0x72, 0x7a, 0x39, 0x8a, 0x36, 0x92, 0x68, 0xc3, 0xf6, 0x98, 0x31,
+	0xdc, 0xd4, 0xd6, 0xb3, 0x20, 0xfe, 0x9d, 0xaa, 0xe9, 0x59, 0x51, 0x4f, 0xb9, 0xdf, 0x3b, 0xea,
+	0xf3, 0xa7, 0x71, 0x8c, 0xfe, 0x23, 0x81, 0x77, 0xbf, 0x64, 0x42, 0x3e, 0x4e, 0xdc, 0x77, 0x47,
+	0xe8, 0x04, 0x92, 0x71, 0x57, 0x58, 0xf8, 0x24, 0x40, 0x21, 0xe9, 0x55, 0x98, 0xeb, 0xf0, 0x81,
+	0xcd, 0xdc, 0x1a, 0x59, 0x25, 0x1b, 0x55, 0x2b, 0xb1, 0xe8, 0x75, 0xa8, 0x7a, 0x76, 0x17, 0x5b,
+	0x82, 0x3d, 0xc3, 0x5a, 0x69, 0x95, 0x6c, 0x54, 0xac, 0x8b, 0x91, 0xe3, 0x80, 0x3d, 0x43, 0x7a,
+	0x13, 0x96, 0x5d, 0x1c, 0xc9, 0x96, 0x8a, 0x90, 0xbc, 0x87, 0x6e, 0xad, 0xbc, 0x4a, 0x36, 0x16,
+	0xad, 0xa5, 0xc8, 0xdd, 0xb4, 0xbb, 0x78, 0x18, 0x39, 0xe9, 0x15, 0xa8, 0x3c, 0x09, 0xd0, 0x0f,
+	0x6b, 0x17, 0x14, 0x76, 0x6c, 0xe8, 0x3f, 0x13, 0xa8, 0xe7, 0x91, 0x12, 0x1e, 0x77, 0x05, 0xd2,
+	0x07, 0x00, 0x98, 0x7a, 0x6b, 0x64, 0xb5, 0xbc, 0xb1, 0xd0, 0xf8, 0xd0, 0xc8, 0xa8, 0x95, 0xf1,
+	0x1f, 0x90, 0x7d, 0xf7, 0x88, 0x5b, 0x13, 0xd9, 0x59, 0x64, 0x4b, 0x19, 0x64, 0xf5, 0xe7, 0x65,
+	0x58, 0x8b, 0x68, 0x7d, 0xe5, 0xa1, 0x3b, 0xa3, 0x7a, 0x35, 0xe1, 0x1d, 0x21, 0x6d, 0x5f, 0xb6,
+	0x24, 0x1b, 0x60, 0xeb, 0x88, 0xf5, 0x25, 0xfa, 0xaa, 0x76, 0x0b, 0x8d, 0xf5, 0x4c, 0xf5, 0x07,
+	0x51, 0xf4, 0x21, 0x1b, 0xe0, 0xe7, 0x2a, 0xd6, 0x5a, 0x16, 0xa7, 0x1d, 0xf4, 0x6b, 0xb8, 0x94,
+	0x96, 0x62, 0x0c, 0x58, 0x51, 0x80, 0xb7, 0x5e, 0xad, 0x9c, 0x31, 0xce, 0xfd, 0xb7, 0xac, 0x65,
+	0x3c, 0xed, 0xa2, 0x0f, 0x60, 0x41, 0x86, 0x5e, 0x4a, 0x73, 0x4e, 0xa1, 0x7e, 0x50, 0x88, 0x7a,
+	0x18, 0x7a, 0x98, 0x02, 0x82, 0x4c, 0xad, 0xbd, 0x2a, 0xcc, 0xc7, 0x30, 0x42, 0xff, 0x85, 0x80,
+	0x5e, 0x74, 0x0c, 0x33, 0xec, 0x90, 0x97, 0x65, 0x78, 0x3f, 0xa2, 0x76, 0xa7, 0xcf, 0x05, 0x76,
+	0xde, 0xf4, 0xc8, 0xff, 0xda, 0x23, 0xf4, 0x3e, 0x2c, 0x09, 0x69, 0xcb, 0x40, 0x8c, 0xd1, 0xe6,
+	0x15, 0xda, 0x5a, 0x9e, 0x68, 0x19, 0x88, 0x14, 0x67, 0x51, 0x4c, 0xd8, 0x93, 0xdd, 0xf6, 0x82,
+	0xc0, 0x7a, 0xf1, 0x91, 0xce, 0xb0, 0xdf, 0x5e, 0x10, 0xb8, 0x11, 0x91, 0xdb, 0xf5, 0x9d, 0x63,
+	0x36, 0xc4, 0xce, 0x6b, 0x35, 0xc5, 0x7f, 0x23, 0x70, 0xf3, 0x2c, 0x72, 0x33, 0xac, 0x5d, 0x74,
+	0xf3, 0x1d, 0x38, 0xb6, 0xfb, 0xda, 0xdd, 0x7c, 0x79, 0xa4, 0x66, 0x58, 0xab, 0x87, 0x50, 0xbf,
+	0xc3, 0x03, 0xf7, 0x1c, 0x5b, 0x42, 0x2a, 0xb3, 0x34, 0x29, 0xf3, 0x7b, 0x02, 0xef, 0xe5, 0x02,
+	0x26, 0x3a, 0xaf, 0x40, 0xc5, 0x89, 0x42, 0x14, 0x60, 0xd9, 0x8a, 0x0d, 0xba, 0x0f, 0x73, 0x5d,
+	0x9f, 0x07, 0x9e, 0xa8, 0x95, 0x94, 0xf2, 0xcd, 0x4c, 0xe5, 0x39, 0xd8, 0xf7, 0xa2, 0x4c, 0x2b,
+	0x01, 0xd0, 0x1f, 0xc3, 0x4a, 0x51, 0x1c, 0x5d, 0x83, 0x45, 0x15, 0xd9, 0x1a, 0xda, 0xfd, 0x00,
+	0xe3, 0x52, 0x57, 0xad, 0x05, 0xe5, 0x7b, 0xa4, 0x5c, 0xff, 0x70, 0x2c, 0x4d, 0x70, 0xd4, 0x57,
+	0x40, 0xbb, 0x87, 0xf2, 0x00, 0x6d, 0xdf, 0x39, 0xde, 0x95, 0xd2, 0x67, 0xed, 0x40, 0xe2, 0xb8,
+	0x52, 0xfa, 0x1f, 0x04, 0xae, 0x67, 0xfe, 0x3b, 0xd1, 0xfd, 0x10, 0x2e, 0xf4, 0x30, 0x1c, 0x9f,
+	0xec, 0x76, 0xa6, 0xbe, 0x82, 0x7c, 0xe3, 0x0b, 0x0c, 0xc5, 0x5d, 0x57, 0xfa, 0xa1, 0xa5, 0x70,
+	0xb4, 0x6f, 0xa1, 0x9a, 0xba, 0xe8, 0x25, 0x28, 0xf7, 0x30, 0x4c, 0xce, 0x28, 0xfa, 0x93, 0xee,
+	0x40, 0x45, 0xe9, 0x53, 0x12, 0xde, 0x6e, 0xdc, 0xc8, 0xfc, 0xde, 0xbe, 0xdb, 0xc1, 0x11, 0x76,
+	0x94, 0xea, 0x68, 0xfc, 0x5a, 0x71, 0xce, 0x76, 0xe9, 0x36, 0x69, 0xfc, 0x39, 0x0f, 0x4b, 0x8f,
+	0xd2, 0xd5, 0x73, 0xb7, 0xb9, 0x4f, 0xbf, 0x23, 0x70, 0x35, 0x7b, 0x7d, 0xa3, 0x8d, 0x4c, 0xf8,
+	0xc2, 0x05, 0x54, 0xdb, 0x9a, 0x2a, 0x27, 0xa9, 0xe2, 0x0f, 0x04, 0xb4, 0xfc, 0x25, 0x81, 0x7e,
+	0x92, 0x8b, 0x59, 0xb8, 0xdc, 0x69, 0x9f, 0x4e, 0x9d, 0x97, 0xf0, 0xf9, 0x89, 0xc0, 0x4a, 0xd1,
+	0x35, 0x42, 0x6f, 0xe7, 0x22, 0x9f, 0xb1, 0x4c, 0x68, 0x9f, 0x9d, 0x23, 0x33, 0x61, 0xf5, 0x6b,
+	0xb2, 0x68, 0xe7, 0x8f, 0x68, 0xba, 0x9d, 0x8b, 0x7e, 0xe6, 0xa5, 0xa3, 0xed, 0x9c, 0x2b, 0x37,
+	0xe1, 0x16, 0x75, 0x51, 0xf6, 0x28, 0xcc, 0xe9, 0xa2, 0xc2, 0x61, 0xae, 0x6d, 0x4d, 0x95, 0x93,
+	0x70, 0x78, 0x4e, 0xe0, 0x5a, 0xce, 0x8c, 0xa0, 0x5b, 0xd3, 0x4c, 0x9e, 0x31, 0x8b, 0x8f, 0xa7,
+	0x4b, 0x4a, 0x68, 0x8c, 0xe0, 0x72, 0xc6, 0x2f, 0x9e, 0x9a, 0xaf, 0x3e, 0x1b, 0xe2, 0xaf, 0x7f,
+	0x34, 0xed, 0x30, 0xd9, 0x6b, 0xff, 0x7e, 0x52, 0x27, 0x2f, 0x4f, 0xea, 0xe4, 0xaf, 0x93, 0x3a,
+	0x81, 0x6b, 0x0e, 0x1f, 0x64, 0x41, 0xec, 0x5d, 0xdc, 0xf5, 0x58, 0xd3, 0xe7, 0x92, 0x37, 0xc9,
+	0x37, 0x66, 0x97, 0xc9, 0xe3, 0xa0, 0x6d, 0x38, 0x7c, 0x60, 0x9e, 0x7a, 0x80, 0x1a, 0x5d, 0x74,
+	0x4d, 0xf5, 0xea, 0x4c, 0xde, 0xa2, 0x3b, 0xb6, 0xc7, 0x86, 0x9b, 0xed, 0x39, 0xe5, 0xdb, 0xfa,
+	0x7b, 0x00, 0x13, 0xc7, 0x6d, 0xbe, 0x18, 0x0f, 0x00, 0x00,
 }
 
 func (m *ListWorkflowExecutionsRequest) Marshal() (dAtA []byte, err error) {
@@ -1761,6 +1828,20 @@ func (m *CountWorkflowExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceVisibility(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Count != 0 {
 		i = encodeVarintServiceVisibility(dAtA, i, uint64(m.Count))
 		i--
@@ -1769,6 +1850,47 @@ func (m *CountWorkflowExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *CountWorkflowExecutionsGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountWorkflowExecutionsGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountWorkflowExecutionsGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintServiceVisibility(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GroupValues) > 0 {
+		for iNdEx := len(m.GroupValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupValues[iNdEx])
+			copy(dAtA[i:], m.GroupValues[iNdEx])
+			i = encodeVarintServiceVisibility(dAtA, i, uint64(len(m.GroupValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2191,6 +2313,33 @@ func (m *CountWorkflowExecutionsResponse) Size() (n int) {
 	if m.Count != 0 {
 		n += 1 + sovServiceVisibility(uint64(m.Count))
 	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovServiceVisibility(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CountWorkflowExecutionsGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GroupValues) > 0 {
+		for _, s := range m.GroupValues {
+			l = len(s)
+			n += 1 + l + sovServiceVisibility(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovServiceVisibility(uint64(m.Count))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4016,6 +4165,142 @@ func (m *CountWorkflowExecutionsResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceVisibility
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceVisibility
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceVisibility
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &CountWorkflowExecutionsGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceVisibility(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceVisibility
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountWorkflowExecutionsGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceVisibility
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountWorkflowExecutionsGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountWorkflowExecutionsGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceVisibility
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceVisibility
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceVisibility
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupValues = append(m.GroupValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceVisibility
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceVisibility(dAtA[iNdEx:])
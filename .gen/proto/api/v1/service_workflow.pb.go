@@ -63,6 +63,7 @@ type StartWorkflowExecutionRequest struct {
 	SearchAttributes             *SearchAttributes     `protobuf:"bytes,14,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
 	Header                       *Header               `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
 	DelayStart                   *types.Duration       `protobuf:"bytes,16,opt,name=delay_start,json=delayStart,proto3" json:"delay_start,omitempty"`
+	RetentionPeriod              *types.Duration       `protobuf:"bytes,17,opt,name=retention_period,json=retentionPeriod,proto3" json:"retention_period,omitempty"`
 	XXX_NoUnkeyedLiteral         struct{}              `json:"-"`
 	XXX_unrecognized             []byte                `json:"-"`
 	XXX_sizecache                int32                 `json:"-"`
@@ -213,6 +214,13 @@ func (m *StartWorkflowExecutionRequest) GetDelayStart() *types.Duration {
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetRetentionPeriod() *types.Duration {
+	if m != nil {
+		return m.RetentionPeriod
+	}
+	return nil
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -523,6 +531,7 @@ type ResetWorkflowExecutionRequest struct {
 	DecisionFinishEventId int64              `protobuf:"varint,4,opt,name=decision_finish_event_id,json=decisionFinishEventId,proto3" json:"decision_finish_event_id,omitempty"`
 	RequestId             string             `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	SkipSignalReapply     bool               `protobuf:"varint,6,opt,name=skip_signal_reapply,json=skipSignalReapply,proto3" json:"skip_signal_reapply,omitempty"`
+	DryRun                bool               `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}           `json:"-"`
 	XXX_unrecognized      []byte             `json:"-"`
 	XXX_sizecache         int32              `json:"-"`
@@ -603,11 +612,19 @@ func (m *ResetWorkflowExecutionRequest) GetSkipSignalReapply() bool {
 	return false
 }
 
+func (m *ResetWorkflowExecutionRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type ResetWorkflowExecutionResponse struct {
-	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RunId                string                         `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Preview              *ResetWorkflowExecutionPreview `protobuf:"bytes,2,opt,name=preview,proto3" json:"preview,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *ResetWorkflowExecutionResponse) Reset()         { *m = ResetWorkflowExecutionResponse{} }
@@ -650,6 +667,100 @@ func (m *ResetWorkflowExecutionResponse) GetRunId() string {
 	return ""
 }
 
+func (m *ResetWorkflowExecutionResponse) GetPreview() *ResetWorkflowExecutionPreview {
+	if m != nil {
+		return m.Preview
+	}
+	return nil
+}
+
+type ResetWorkflowExecutionPreview struct {
+	ResetPointEvent        *HistoryEvent          `protobuf:"bytes,1,opt,name=reset_point_event,json=resetPointEvent,proto3" json:"reset_point_event,omitempty"`
+	DiscardedEvents        []*HistoryEvent        `protobuf:"bytes,2,rep,name=discarded_events,json=discardedEvents,proto3" json:"discarded_events,omitempty"`
+	ReappliedSignals       []*HistoryEvent        `protobuf:"bytes,3,rep,name=reapplied_signals,json=reappliedSignals,proto3" json:"reapplied_signals,omitempty"`
+	PendingActivities      []*PendingActivityInfo `protobuf:"bytes,4,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
+	PendingTimers          []*PendingTimerInfo    `protobuf:"bytes,5,rep,name=pending_timers,json=pendingTimers,proto3" json:"pending_timers,omitempty"`
+	OrphanedChildWorkflows []*WorkflowExecution   `protobuf:"bytes,6,rep,name=orphaned_child_workflows,json=orphanedChildWorkflows,proto3" json:"orphaned_child_workflows,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
+	XXX_unrecognized       []byte                 `json:"-"`
+	XXX_sizecache          int32                  `json:"-"`
+}
+
+func (m *ResetWorkflowExecutionPreview) Reset()         { *m = ResetWorkflowExecutionPreview{} }
+func (m *ResetWorkflowExecutionPreview) String() string { return proto.CompactTextString(m) }
+func (*ResetWorkflowExecutionPreview) ProtoMessage()    {}
+func (*ResetWorkflowExecutionPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{8}
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetWorkflowExecutionPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetWorkflowExecutionPreview.Merge(m, src)
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetWorkflowExecutionPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetWorkflowExecutionPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetWorkflowExecutionPreview proto.InternalMessageInfo
+
+func (m *ResetWorkflowExecutionPreview) GetResetPointEvent() *HistoryEvent {
+	if m != nil {
+		return m.ResetPointEvent
+	}
+	return nil
+}
+
+func (m *ResetWorkflowExecutionPreview) GetDiscardedEvents() []*HistoryEvent {
+	if m != nil {
+		return m.DiscardedEvents
+	}
+	return nil
+}
+
+func (m *ResetWorkflowExecutionPreview) GetReappliedSignals() []*HistoryEvent {
+	if m != nil {
+		return m.ReappliedSignals
+	}
+	return nil
+}
+
+func (m *ResetWorkflowExecutionPreview) GetPendingActivities() []*PendingActivityInfo {
+	if m != nil {
+		return m.PendingActivities
+	}
+	return nil
+}
+
+func (m *ResetWorkflowExecutionPreview) GetPendingTimers() []*PendingTimerInfo {
+	if m != nil {
+		return m.PendingTimers
+	}
+	return nil
+}
+
+func (m *ResetWorkflowExecutionPreview) GetOrphanedChildWorkflows() []*WorkflowExecution {
+	if m != nil {
+		return m.OrphanedChildWorkflows
+	}
+	return nil
+}
+
 type RequestCancelWorkflowExecutionRequest struct {
 	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -664,7 +775,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Reset()         { *m = RequestCa
 func (m *RequestCancelWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{8}
+	return fileDescriptor_674d14d2fee4e473, []int{9}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +844,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{9}
+	return fileDescriptor_674d14d2fee4e473, []int{10}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -777,7 +888,7 @@ func (m *TerminateWorkflowExecutionRequest) Reset()         { *m = TerminateWork
 func (m *TerminateWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*TerminateWorkflowExecutionRequest) ProtoMessage()    {}
 func (*TerminateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{10}
+	return fileDescriptor_674d14d2fee4e473, []int{11}
 }
 func (m *TerminateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +962,7 @@ func (m *TerminateWorkflowExecutionResponse) Reset()         { *m = TerminateWor
 func (m *TerminateWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*TerminateWorkflowExecutionResponse) ProtoMessage()    {}
 func (*TerminateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{11}
+	return fileDescriptor_674d14d2fee4e473, []int{12}
 }
 func (m *TerminateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -892,7 +1003,7 @@ func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkfl
 func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{12}
+	return fileDescriptor_674d14d2fee4e473, []int{13}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -950,7 +1061,7 @@ func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkf
 func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{13}
+	return fileDescriptor_674d14d2fee4e473, []int{14}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1140,7 @@ func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
 func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowRequest) ProtoMessage()    {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{14}
+	return fileDescriptor_674d14d2fee4e473, []int{15}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,7 +1216,7 @@ func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
 func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowResponse) ProtoMessage()    {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{15}
+	return fileDescriptor_674d14d2fee4e473, []int{16}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1162,7 +1273,7 @@ func (m *DescribeTaskListRequest) Reset()         { *m = DescribeTaskListRequest
 func (m *DescribeTaskListRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeTaskListRequest) ProtoMessage()    {}
 func (*DescribeTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{16}
+	return fileDescriptor_674d14d2fee4e473, []int{17}
 }
 func (m *DescribeTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1231,7 +1342,7 @@ func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListRespon
 func (m *DescribeTaskListResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeTaskListResponse) ProtoMessage()    {}
 func (*DescribeTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{17}
+	return fileDescriptor_674d14d2fee4e473, []int{18}
 }
 func (m *DescribeTaskListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1285,7 +1396,7 @@ func (m *GetTaskListsByDomainRequest) Reset()         { *m = GetTaskListsByDomai
 func (m *GetTaskListsByDomainRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainRequest) ProtoMessage()    {}
 func (*GetTaskListsByDomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{18}
+	return fileDescriptor_674d14d2fee4e473, []int{19}
 }
 func (m *GetTaskListsByDomainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1444,7 @@ func (m *GetTaskListsByDomainResponse) Reset()         { *m = GetTaskListsByDoma
 func (m *GetTaskListsByDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainResponse) ProtoMessage()    {}
 func (*GetTaskListsByDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{19}
+	return fileDescriptor_674d14d2fee4e473, []int{20}
 }
 func (m *GetTaskListsByDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1499,7 @@ func (m *ListTaskListPartitionsRequest) Reset()         { *m = ListTaskListParti
 func (m *ListTaskListPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsRequest) ProtoMessage()    {}
 func (*ListTaskListPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{20}
+	return fileDescriptor_674d14d2fee4e473, []int{21}
 }
 func (m *ListTaskListPartitionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1443,7 +1554,7 @@ func (m *ListTaskListPartitionsResponse) Reset()         { *m = ListTaskListPart
 func (m *ListTaskListPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsResponse) ProtoMessage()    {}
 func (*ListTaskListPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{21}
+	return fileDescriptor_674d14d2fee4e473, []int{22}
 }
 func (m *ListTaskListPartitionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1496,7 +1607,7 @@ func (m *GetClusterInfoRequest) Reset()         { *m = GetClusterInfoRequest{} }
 func (m *GetClusterInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetClusterInfoRequest) ProtoMessage()    {}
 func (*GetClusterInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{22}
+	return fileDescriptor_674d14d2fee4e473, []int{23}
 }
 func (m *GetClusterInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1536,7 +1647,7 @@ func (m *GetClusterInfoResponse) Reset()         { *m = GetClusterInfoResponse{}
 func (m *GetClusterInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetClusterInfoResponse) ProtoMessage()    {}
 func (*GetClusterInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{23}
+	return fileDescriptor_674d14d2fee4e473, []int{24}
 }
 func (m *GetClusterInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1589,7 +1700,7 @@ func (m *GetWorkflowExecutionHistoryRequest) Reset()         { *m = GetWorkflowE
 func (m *GetWorkflowExecutionHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowExecutionHistoryRequest) ProtoMessage()    {}
 func (*GetWorkflowExecutionHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{24}
+	return fileDescriptor_674d14d2fee4e473, []int{25}
 }
 func (m *GetWorkflowExecutionHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1681,7 +1792,7 @@ func (m *GetWorkflowExecutionHistoryResponse) Reset()         { *m = GetWorkflow
 func (m *GetWorkflowExecutionHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowExecutionHistoryResponse) ProtoMessage()    {}
 func (*GetWorkflowExecutionHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{25}
+	return fileDescriptor_674d14d2fee4e473, []int{26}
 }
 func (m *GetWorkflowExecutionHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1749,7 +1860,7 @@ func (m *FeatureFlags) Reset()         { *m = FeatureFlags{} }
 func (m *FeatureFlags) String() string { return proto.CompactTextString(m) }
 func (*FeatureFlags) ProtoMessage()    {}
 func (*FeatureFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{26}
+	return fileDescriptor_674d14d2fee4e473, []int{27}
 }
 func (m *FeatureFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1794,6 +1905,7 @@ func init() {
 	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.SignalWithStartWorkflowExecutionResponse")
 	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.ResetWorkflowExecutionRequest")
 	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.ResetWorkflowExecutionResponse")
+	proto.RegisterType((*ResetWorkflowExecutionPreview)(nil), "uber.cadence.api.v1.ResetWorkflowExecutionPreview")
 	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionRequest")
 	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionResponse")
 	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionRequest")
@@ -1822,141 +1934,153 @@ func init() {
 }
 
 var fileDescriptor_674d14d2fee4e473 = []byte{
-	// 2141 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4f, 0x73, 0xdc, 0x48,
-	0x15, 0x2f, 0x79, 0xfc, 0x67, 0xfc, 0xc6, 0x76, 0x9c, 0x4e, 0x6c, 0x2b, 0x93, 0xd8, 0x71, 0x94,
-	0xdd, 0x60, 0xb2, 0x9b, 0x31, 0x71, 0xd8, 0x24, 0x64, 0x81, 0x2d, 0x67, 0x12, 0x67, 0x4d, 0x6d,
-	0xb6, 0x8c, 0x6c, 0x48, 0xc1, 0x45, 0xd5, 0x96, 0x9e, 0xc7, 0x8d, 0x35, 0x92, 0xdc, 0x6a, 0xd9,
-	0x3b, 0xcb, 0x81, 0x82, 0xda, 0xe2, 0x00, 0x05, 0x05, 0x47, 0x4e, 0x1c, 0xe0, 0xcc, 0xc7, 0xa0,
-	0x28, 0x2e, 0xc0, 0x37, 0x80, 0x7c, 0x01, 0xbe, 0xc1, 0x16, 0xd5, 0xad, 0xd6, 0x8c, 0x67, 0x2c,
-	0x69, 0x6c, 0x53, 0x5b, 0x9b, 0xda, 0xdb, 0xe8, 0xf5, 0x7b, 0xbf, 0xf7, 0xb7, 0xbb, 0xdf, 0xeb,
-	0x81, 0xbb, 0xc9, 0x2e, 0xf2, 0x55, 0x97, 0x7a, 0x18, 0xb8, 0xb8, 0x4a, 0x23, 0xb6, 0x7a, 0x74,
-	0x7f, 0x35, 0x46, 0x7e, 0xc4, 0x5c, 0x74, 0x8e, 0x43, 0x7e, 0xb0, 0xe7, 0x87, 0xc7, 0x8d, 0x88,
-	0x87, 0x22, 0x24, 0x57, 0x24, 0x6f, 0x43, 0xf3, 0x36, 0x68, 0xc4, 0x1a, 0x47, 0xf7, 0xeb, 0x4b,
-	0xad, 0x30, 0x6c, 0xf9, 0xb8, 0xaa, 0x58, 0x76, 0x93, 0xbd, 0x55, 0x2f, 0xe1, 0x54, 0xb0, 0x30,
-	0x48, 0x85, 0xea, 0xcb, 0x79, 0x0a, 0xdc, 0xb0, 0xdd, 0xee, 0x72, 0xdc, 0xca, 0xe3, 0xd8, 0x67,
-	0xb1, 0x08, 0x79, 0x47, 0xb3, 0xdc, 0xcc, 0x63, 0x39, 0x4c, 0xb0, 0xcb, 0x60, 0xe5, 0x31, 0x08,
-	0x1a, 0x1f, 0xf8, 0x2c, 0x16, 0x65, 0x3c, 0xfd, 0x2e, 0x5a, 0x7f, 0x9f, 0x80, 0xc5, 0x6d, 0x41,
-	0xb9, 0x78, 0xa5, 0xe9, 0xcf, 0x3f, 0x41, 0x37, 0x91, 0xee, 0xd8, 0x78, 0x98, 0x60, 0x2c, 0xc8,
-	0x3c, 0x8c, 0x7b, 0x61, 0x9b, 0xb2, 0xc0, 0x34, 0x96, 0x8d, 0x95, 0x49, 0x5b, 0x7f, 0x91, 0x9b,
-	0x50, 0xcb, 0xb0, 0x1c, 0xe6, 0x99, 0x23, 0x6a, 0x11, 0x32, 0xd2, 0xa6, 0x47, 0x36, 0x60, 0xba,
-	0xcb, 0x20, 0x3a, 0x11, 0x9a, 0x95, 0x65, 0x63, 0xa5, 0xb6, 0x76, 0xab, 0x91, 0x13, 0xd5, 0x46,
-	0xa6, 0x7e, 0xa7, 0x13, 0xa1, 0x3d, 0x75, 0x7c, 0xe2, 0x8b, 0x3c, 0x81, 0x49, 0xe9, 0x98, 0x23,
-	0x3d, 0x33, 0x47, 0x15, 0xc6, 0x62, 0x2e, 0xc6, 0x0e, 0x8d, 0x0f, 0x3e, 0x62, 0xb1, 0xb0, 0xab,
-	0x42, 0xff, 0x22, 0x6b, 0x30, 0xc6, 0x82, 0x28, 0x11, 0xe6, 0x98, 0x92, 0xbb, 0x91, 0x2b, 0xb7,
-	0x45, 0x3b, 0x7e, 0x48, 0x3d, 0x3b, 0x65, 0x25, 0x14, 0x96, 0x31, 0x0b, 0x82, 0x13, 0xcb, 0xd8,
-	0x38, 0x22, 0x74, 0x5c, 0x3f, 0x8c, 0xd1, 0x11, 0xac, 0x8d, 0x61, 0x22, 0xcc, 0x71, 0x05, 0x77,
-	0xad, 0x91, 0xd6, 0x42, 0x23, 0xab, 0x85, 0xc6, 0x33, 0x5d, 0x0b, 0xf6, 0x8d, 0x2e, 0x84, 0x8a,
-	0xee, 0x4e, 0xd8, 0x94, 0xf2, 0x3b, 0xa9, 0x38, 0x79, 0x05, 0xd7, 0x95, 0x4b, 0x05, 0xe8, 0x13,
-	0xc3, 0xd0, 0x17, 0xa4, 0x74, 0x1e, 0x70, 0x1d, 0xaa, 0xcc, 0xc3, 0x40, 0x30, 0xd1, 0x31, 0xab,
-	0x2a, 0x23, 0xdd, 0x6f, 0xb2, 0x08, 0xc0, 0xd3, 0x9c, 0xca, 0x7c, 0x4d, 0xaa, 0xd5, 0x49, 0x4d,
-	0xd9, 0xf4, 0x88, 0x0b, 0xe6, 0x89, 0x7c, 0x3a, 0x1c, 0x93, 0x18, 0x9d, 0x28, 0xf4, 0x99, 0xdb,
-	0x31, 0x61, 0xd9, 0x58, 0x99, 0x59, 0xbb, 0x5b, 0x9a, 0xb9, 0x4d, 0xcf, 0x96, 0x22, 0x5b, 0x4a,
-	0xc2, 0x9e, 0x3b, 0xce, 0x23, 0x93, 0x26, 0x4c, 0x71, 0x14, 0xbc, 0x93, 0x01, 0xd7, 0x94, 0xa7,
-	0xcb, 0xb9, 0xc0, 0xb6, 0x64, 0xd4, 0x70, 0x35, 0xde, 0xfb, 0x20, 0xb7, 0x61, 0xda, 0xe5, 0x32,
-	0x37, 0xee, 0x3e, 0x7a, 0x89, 0x8f, 0xe6, 0x94, 0xf2, 0x65, 0x4a, 0x12, 0xb7, 0x35, 0x8d, 0xdc,
-	0x83, 0xd1, 0x36, 0xb6, 0x43, 0x73, 0x5a, 0xc7, 0x32, 0x4f, 0xc3, 0x4b, 0x6c, 0x87, 0xb6, 0x62,
-	0x23, 0x36, 0x5c, 0x8e, 0x91, 0x72, 0x77, 0xdf, 0xa1, 0x42, 0x70, 0xb6, 0x9b, 0x08, 0x8c, 0xcd,
-	0x19, 0x25, 0xfb, 0x76, 0xae, 0xec, 0xb6, 0xe2, 0x5e, 0xef, 0x32, 0xdb, 0xb3, 0xf1, 0x00, 0x85,
-	0x3c, 0x80, 0xf1, 0x7d, 0xa4, 0x1e, 0x72, 0xf3, 0x92, 0x02, 0xba, 0x9e, 0x0b, 0xf4, 0xa1, 0x62,
-	0xb1, 0x35, 0x2b, 0x79, 0x02, 0x35, 0x0f, 0x7d, 0xda, 0x49, 0x6b, 0xc3, 0x9c, 0x1d, 0x56, 0x0a,
-	0xa0, 0xb8, 0x55, 0x2d, 0x58, 0x8f, 0x60, 0xa9, 0x68, 0x2f, 0xc7, 0x51, 0x18, 0xc4, 0x48, 0xe6,
-	0x60, 0x9c, 0x27, 0x81, 0xcc, 0x7f, 0xba, 0x99, 0xc7, 0x78, 0x12, 0x6c, 0x7a, 0xd6, 0x5f, 0x47,
-	0x60, 0x69, 0x9b, 0xb5, 0x02, 0xea, 0x9f, 0xfb, 0x18, 0xf8, 0x01, 0x90, 0x6e, 0xd9, 0x74, 0x6b,
-	0x5e, 0x9d, 0x06, 0xb5, 0xb5, 0x3b, 0xa5, 0x05, 0xd3, 0x53, 0x71, 0xf9, 0x78, 0x90, 0xd4, 0x57,
-	0xc8, 0x95, 0xd2, 0x42, 0x1e, 0x1d, 0x2c, 0xe4, 0x9b, 0x50, 0x8b, 0x95, 0x2f, 0x4e, 0x40, 0xdb,
-	0xa8, 0x76, 0xfe, 0xa4, 0x0d, 0x29, 0xe9, 0x63, 0xda, 0x46, 0xf2, 0x01, 0x4c, 0x69, 0x86, 0xf4,
-	0x6c, 0x18, 0x3f, 0xc3, 0xd9, 0xa0, 0x21, 0x37, 0xd5, 0x09, 0x61, 0xc2, 0x84, 0x1b, 0x06, 0x82,
-	0x87, 0xbe, 0xda, 0xaa, 0x53, 0x76, 0xf6, 0x69, 0xdd, 0x82, 0x9b, 0x85, 0x71, 0x4c, 0x53, 0x60,
-	0x7d, 0x6e, 0xc0, 0xd7, 0x34, 0x0f, 0x13, 0xfb, 0xe5, 0x67, 0xef, 0x2b, 0x98, 0x4e, 0x8f, 0x08,
-	0xed, 0x9d, 0x8a, 0x7d, 0x6d, 0x6d, 0x2d, 0xbf, 0x22, 0xcb, 0xa0, 0xec, 0x29, 0x05, 0x94, 0x01,
-	0x0f, 0xc4, 0x68, 0x64, 0x68, 0x8c, 0x2a, 0xff, 0x47, 0x8c, 0x46, 0xfb, 0x63, 0xb4, 0x0e, 0x2b,
-	0xc3, 0xfd, 0x2f, 0xaf, 0xd7, 0xbf, 0x8c, 0xc0, 0xa2, 0x8d, 0x31, 0x8a, 0x37, 0xa5, 0x5c, 0xe7,
-	0x61, 0x9c, 0x23, 0x8d, 0xc3, 0x40, 0x17, 0xab, 0xfe, 0x22, 0x8f, 0xc0, 0xf4, 0xd0, 0x65, 0xb1,
-	0xbc, 0x4a, 0xf6, 0x58, 0xc0, 0xe2, 0x7d, 0x07, 0x8f, 0x30, 0xe8, 0x16, 0x6e, 0xc5, 0x9e, 0xcb,
-	0xd6, 0x37, 0xd4, 0xf2, 0x73, 0xb9, 0xba, 0xe9, 0x0d, 0xd4, 0xf8, 0xd8, 0x60, 0x8d, 0x37, 0xe0,
-	0x4a, 0x7c, 0xc0, 0x22, 0x47, 0xe7, 0x88, 0x23, 0x8d, 0x22, 0xbf, 0xa3, 0x2a, 0xb9, 0x6a, 0x5f,
-	0x96, 0x4b, 0x69, 0x88, 0xed, 0x74, 0x41, 0x9e, 0x0c, 0x45, 0xf1, 0x2a, 0x8f, 0xf4, 0xbf, 0x0c,
-	0x78, 0x5b, 0xc7, 0xb4, 0x49, 0x03, 0x17, 0xbf, 0x02, 0x07, 0x84, 0xb5, 0x02, 0x77, 0x86, 0xb9,
-	0xd4, 0xdb, 0xab, 0xb7, 0x76, 0x90, 0xb7, 0x59, 0x40, 0x05, 0xbe, 0xe9, 0xb5, 0xf6, 0x10, 0x26,
-	0x3c, 0x14, 0x94, 0xf9, 0xb1, 0xee, 0x92, 0xca, 0x77, 0x6b, 0xc6, 0xdc, 0x17, 0xc9, 0xb1, 0xfe,
-	0x48, 0x5a, 0x6f, 0x81, 0x55, 0xe6, 0xbf, 0x0e, 0xd3, 0xef, 0x0d, 0x58, 0x7e, 0x86, 0xb1, 0xcb,
-	0xd9, 0xee, 0x9b, 0x12, 0x25, 0xeb, 0xf3, 0x0a, 0xdc, 0x2a, 0xb1, 0x49, 0x57, 0xbd, 0x0f, 0x0b,
-	0xbd, 0x5e, 0xcf, 0x0d, 0x83, 0x3d, 0xd6, 0xd2, 0x17, 0xab, 0x3e, 0x6a, 0x1f, 0x9c, 0xcd, 0x82,
-	0xe6, 0x49, 0x51, 0x7b, 0x1e, 0x73, 0xe9, 0x64, 0x17, 0x16, 0x4e, 0xbb, 0xea, 0xb0, 0x60, 0x2f,
-	0xd4, 0xfe, 0xde, 0x3d, 0x9b, 0xb6, 0xcd, 0x60, 0x2f, 0xec, 0x75, 0x58, 0x7d, 0x64, 0xf2, 0x0a,
-	0x48, 0x84, 0x81, 0xc7, 0x82, 0x96, 0x43, 0x5d, 0xc1, 0x8e, 0x98, 0x60, 0x18, 0x9b, 0x95, 0xe5,
-	0xca, 0x4a, 0x6d, 0x6d, 0x25, 0xbf, 0x20, 0x52, 0xf6, 0xf5, 0x94, 0xbb, 0xa3, 0xc0, 0x2f, 0x47,
-	0x7d, 0x44, 0x86, 0x31, 0xf9, 0x11, 0xcc, 0x66, 0xc0, 0xee, 0x3e, 0xf3, 0x3d, 0x8e, 0x81, 0x39,
-	0xaa, 0x60, 0x1b, 0x65, 0xb0, 0x4d, 0xc9, 0xdb, 0x6f, 0xf9, 0xa5, 0xe8, 0xc4, 0x12, 0xc7, 0x80,
-	0x6c, 0xf7, 0xa0, 0xb3, 0xd3, 0x50, 0x37, 0xec, 0xa5, 0x16, 0x3f, 0xd3, 0xbc, 0x7d, 0xa0, 0x19,
-	0xd1, 0xfa, 0xac, 0x02, 0x57, 0xbf, 0x2f, 0x27, 0xa6, 0x2c, 0x7c, 0x5f, 0xd2, 0x76, 0x7d, 0x0c,
-	0x63, 0x6a, 0x70, 0xd3, 0x57, 0xa8, 0x55, 0x8a, 0xa4, 0x0c, 0xb6, 0x53, 0x01, 0xe2, 0xc0, 0xbc,
-	0xfa, 0xe1, 0x70, 0xfc, 0x09, 0xba, 0x42, 0xd6, 0xa7, 0xc7, 0x94, 0x51, 0xa3, 0xaa, 0x1f, 0xff,
-	0x7a, 0x2e, 0x54, 0x0a, 0xa1, 0x24, 0x9a, 0x99, 0x80, 0x7d, 0xf5, 0x30, 0x87, 0x2a, 0xeb, 0x31,
-	0x55, 0xe0, 0x86, 0x41, 0xcc, 0x62, 0x81, 0x81, 0xdb, 0x71, 0x7c, 0x3c, 0x42, 0x5f, 0x85, 0xbf,
-	0xa8, 0xe3, 0x57, 0x1a, 0x9a, 0x3d, 0x91, 0x8f, 0xa4, 0x84, 0x3d, 0x77, 0x98, 0x47, 0xb6, 0xfe,
-	0x64, 0xc0, 0xdc, 0x40, 0x1a, 0xf4, 0xde, 0xfb, 0x00, 0xa6, 0x32, 0xf7, 0xe2, 0xc4, 0xcf, 0x7a,
-	0x9b, 0x21, 0x2d, 0x86, 0xf6, 0x43, 0x0a, 0x90, 0x4d, 0x98, 0x39, 0x19, 0x1f, 0xf4, 0x74, 0xb2,
-	0xac, 0x61, 0x71, 0x41, 0xcf, 0x9e, 0x3e, 0x3c, 0xf9, 0x69, 0xfd, 0xd7, 0x80, 0x85, 0xec, 0xb4,
-	0xe8, 0x8e, 0x91, 0x43, 0xea, 0xa5, 0x6f, 0x2e, 0x1d, 0x39, 0xdf, 0x5c, 0xfa, 0x02, 0x66, 0xba,
-	0xb2, 0xbd, 0xe1, 0x78, 0xa6, 0x60, 0x38, 0xce, 0x00, 0xd2, 0xe1, 0x58, 0x9c, 0xf8, 0x92, 0x0d,
-	0x06, 0x0b, 0x5c, 0x3f, 0xf1, 0xd0, 0xe9, 0x01, 0xc6, 0x82, 0x8a, 0x24, 0xbd, 0x05, 0xaa, 0xf6,
-	0x9c, 0x5e, 0xcf, 0x40, 0xb6, 0xd5, 0xa2, 0xf5, 0x67, 0x03, 0xcc, 0xd3, 0x1e, 0xeb, 0xd4, 0x7c,
-	0x0b, 0x26, 0xa2, 0xd0, 0xf7, 0x91, 0xc7, 0xa6, 0xa1, 0xb6, 0xf8, 0xcd, 0xfc, 0xac, 0x28, 0x1e,
-	0xb5, 0xfd, 0x32, 0x7e, 0xf2, 0x12, 0x66, 0x4f, 0x19, 0x92, 0x06, 0xe7, 0x76, 0xa9, 0x6f, 0xa9,
-	0x59, 0xf6, 0x8c, 0xe8, 0x37, 0xf3, 0x3d, 0xb8, 0xfe, 0x02, 0x45, 0xc6, 0x14, 0x3f, 0xed, 0x3c,
-	0x53, 0xc1, 0x1f, 0x92, 0x1b, 0xeb, 0xb7, 0xa3, 0x70, 0x23, 0x5f, 0x4e, 0x7b, 0xf8, 0x33, 0x98,
-	0xef, 0x36, 0x66, 0x3d, 0x7b, 0xdb, 0x34, 0xd2, 0x0e, 0x7f, 0x2f, 0xd7, 0xd8, 0x32, 0xc8, 0x46,
-	0x76, 0xf2, 0x64, 0x1c, 0x2f, 0x69, 0xf4, 0x3c, 0x10, 0xbc, 0x63, 0x5f, 0xf1, 0x4e, 0xaf, 0x48,
-	0x03, 0xf4, 0xf9, 0xdc, 0x19, 0x30, 0x60, 0xe4, 0xa2, 0x06, 0x64, 0x27, 0xf8, 0x69, 0x03, 0xe8,
-	0xe9, 0x95, 0x7a, 0x22, 0xf3, 0x9f, 0x6f, 0x31, 0x99, 0x85, 0xca, 0x01, 0x76, 0x74, 0x4c, 0xe5,
-	0x4f, 0xd2, 0x84, 0xb1, 0x23, 0xea, 0x27, 0xa8, 0x73, 0x79, 0x2f, 0xd7, 0xba, 0xa2, 0x7a, 0xb2,
-	0x53, 0xd9, 0x27, 0x23, 0x8f, 0x0d, 0xa9, 0xb6, 0xc8, 0xce, 0x2f, 0x50, 0xad, 0x15, 0xc3, 0xa2,
-	0xda, 0x33, 0x9a, 0x65, 0x8b, 0x72, 0xa1, 0xce, 0xc0, 0xf8, 0x0b, 0xdc, 0xe5, 0xd6, 0x2f, 0x47,
-	0x60, 0xa9, 0x48, 0xab, 0xae, 0xc3, 0x43, 0x58, 0xcc, 0x29, 0x83, 0xa8, 0xcb, 0xa8, 0xcb, 0xb1,
-	0x51, 0xaa, 0xb2, 0x8b, 0xfb, 0x12, 0x05, 0xf5, 0xa8, 0xa0, 0x76, 0x7d, 0x30, 0xe3, 0x3d, 0xd5,
-	0x52, 0x65, 0x4e, 0xe9, 0x9f, 0x50, 0x39, 0x72, 0x31, 0x95, 0x83, 0x55, 0xde, 0x53, 0x69, 0x2d,
-	0xc0, 0xdc, 0x0b, 0x14, 0x4d, 0x3f, 0x89, 0x85, 0x3e, 0x2f, 0xd2, 0xa8, 0x5b, 0xbf, 0x30, 0x60,
-	0x7e, 0x70, 0x45, 0x47, 0x66, 0x1f, 0xae, 0xc5, 0x49, 0x14, 0x85, 0x5c, 0xa0, 0xe7, 0xb8, 0x3e,
-	0x93, 0x53, 0xd3, 0x11, 0xf2, 0x58, 0x47, 0x45, 0x26, 0xe2, 0xdd, 0xfc, 0x39, 0x38, 0x93, 0x6a,
-	0x2a, 0xa1, 0x1f, 0x6a, 0x19, 0x7b, 0x21, 0xce, 0x5f, 0xb0, 0x7e, 0x5d, 0x01, 0xeb, 0x45, 0xce,
-	0x6c, 0xf4, 0x61, 0xfa, 0x24, 0xfb, 0x25, 0xf5, 0x0d, 0xd7, 0x61, 0x32, 0xa2, 0x2d, 0x74, 0x62,
-	0xf6, 0x69, 0x7a, 0x3b, 0x8c, 0xd9, 0x55, 0x49, 0xd8, 0x66, 0x9f, 0x22, 0xb9, 0x03, 0x97, 0x02,
-	0xfc, 0x44, 0x66, 0xad, 0x85, 0x8e, 0x08, 0x0f, 0x30, 0xd0, 0x53, 0xf6, 0xb4, 0x24, 0x6f, 0xd1,
-	0x16, 0xee, 0x48, 0x22, 0x79, 0x07, 0xc8, 0x31, 0x65, 0xc2, 0xd9, 0x0b, 0xb9, 0x13, 0xe0, 0x71,
-	0x3a, 0x7c, 0xaa, 0xcb, 0xbd, 0x6a, 0x5f, 0x92, 0x2b, 0x1b, 0x21, 0xff, 0x18, 0x8f, 0xd5, 0xd4,
-	0x49, 0x1c, 0xb8, 0xa6, 0x5f, 0xa1, 0xf5, 0x90, 0xba, 0xc7, 0x7c, 0x81, 0x3c, 0xbd, 0x9f, 0xc6,
-	0xd5, 0xfd, 0xf4, 0x56, 0xae, 0x3f, 0x4a, 0x7c, 0x43, 0x31, 0xab, 0x2b, 0x6a, 0x5e, 0xc3, 0x0c,
-	0xd0, 0xc9, 0x6d, 0x98, 0x56, 0x53, 0x2b, 0xe5, 0xee, 0x3e, 0x3b, 0xa2, 0xe9, 0xeb, 0x49, 0xd5,
-	0x9e, 0x92, 0xc4, 0x75, 0x4d, 0xb3, 0xfe, 0x63, 0xc0, 0xed, 0xd2, 0x6c, 0xe8, 0xfa, 0x78, 0x08,
-	0x13, 0x5a, 0x4d, 0x69, 0xe7, 0x90, 0x89, 0x65, 0xcc, 0xe4, 0xbb, 0x50, 0xe3, 0xf4, 0xd8, 0xc9,
-	0x64, 0xd3, 0x62, 0xcf, 0xdf, 0xd2, 0xcf, 0xa8, 0xa0, 0x4f, 0xfd, 0x70, 0xd7, 0x06, 0x4e, 0x8f,
-	0x35, 0x50, 0x5e, 0xe8, 0x2b, 0x79, 0xa1, 0xaf, 0x43, 0x35, 0xf5, 0x13, 0x3d, 0x7d, 0x13, 0x77,
-	0xbf, 0xad, 0x0e, 0x4c, 0x6d, 0x20, 0x15, 0x09, 0xc7, 0x0d, 0x9f, 0xb6, 0x62, 0xc2, 0x60, 0x2d,
-	0x67, 0x30, 0xa0, 0x3e, 0x47, 0xea, 0xc9, 0xee, 0xac, 0x1d, 0xf9, 0x28, 0xb7, 0x01, 0x72, 0x1e,
-	0x72, 0x07, 0x03, 0xba, 0xeb, 0x63, 0x3a, 0xa8, 0x57, 0xed, 0x7b, 0xa7, 0x4a, 0x67, 0x3d, 0x95,
-	0x6b, 0x66, 0x62, 0xcf, 0xa5, 0xd4, 0xf3, 0x54, 0x68, 0xed, 0x1f, 0xd3, 0x50, 0xcb, 0x62, 0xbb,
-	0xbe, 0xb5, 0x49, 0x7e, 0x6e, 0xc0, 0x7c, 0xfe, 0x23, 0x0c, 0xb9, 0xc0, 0x33, 0x53, 0xfd, 0xc1,
-	0xb9, 0x64, 0x74, 0x2a, 0x3f, 0x33, 0x60, 0xa1, 0xe0, 0xd9, 0x8c, 0x14, 0x00, 0x96, 0x3e, 0x56,
-	0xd6, 0xbf, 0x79, 0x3e, 0x21, 0x6d, 0xc6, 0x1f, 0x0d, 0x58, 0x1e, 0xf6, 0x32, 0x45, 0xbe, 0x5d,
-	0x06, 0x3d, 0xec, 0x41, 0xaf, 0xfe, 0x9d, 0x0b, 0x4a, 0x6b, 0x0b, 0x65, 0xb2, 0xf2, 0xdf, 0x71,
-	0x0a, 0x92, 0x55, 0xfa, 0x48, 0x56, 0x90, 0xac, 0x21, 0x0f, 0x45, 0x7f, 0x30, 0x60, 0xa9, 0xfc,
-	0xf9, 0x84, 0x3c, 0x29, 0xc0, 0x3d, 0xc3, 0x33, 0x52, 0xfd, 0xfd, 0x0b, 0xc9, 0x6a, 0xdb, 0x7e,
-	0x63, 0x40, 0xbd, 0xf8, 0xbd, 0x82, 0x3c, 0xcc, 0xbf, 0xd2, 0x86, 0x3d, 0xf0, 0xd4, 0x1f, 0x9d,
-	0x5b, 0x4e, 0xdb, 0xf3, 0x2b, 0x03, 0xae, 0x15, 0x3e, 0x42, 0x90, 0xf7, 0x4a, 0xbb, 0x99, 0x42,
-	0x6b, 0x1e, 0x9e, 0x57, 0x4c, 0x1b, 0xb3, 0x07, 0xd3, 0x7d, 0x83, 0x18, 0x29, 0x99, 0x1f, 0x07,
-	0x66, 0xe6, 0xfa, 0xdd, 0xb3, 0xb0, 0x6a, 0x3d, 0x21, 0xcc, 0x0e, 0x76, 0x64, 0xe4, 0xdd, 0x33,
-	0x36, 0x6e, 0xa9, 0xb6, 0xf3, 0xb5, 0x79, 0xe4, 0xa7, 0x70, 0x35, 0xaf, 0x2f, 0x26, 0xdf, 0x38,
-	0x47, 0x0b, 0x9d, 0x2a, 0xbe, 0x7f, 0xee, 0xa6, 0x5b, 0x6d, 0xc9, 0xfc, 0x1e, 0xaf, 0x60, 0x4b,
-	0x96, 0xb6, 0xa1, 0x05, 0x5b, 0x72, 0x48, 0x13, 0xc9, 0x60, 0xa6, 0xbf, 0x89, 0x22, 0x77, 0x8b,
-	0x1c, 0x39, 0xdd, 0x83, 0xd5, 0xdf, 0x39, 0x13, 0xaf, 0x56, 0xf5, 0x3b, 0x43, 0x0d, 0x64, 0x45,
-	0xb7, 0x33, 0x79, 0x54, 0x04, 0x36, 0xa4, 0xbb, 0xaa, 0x3f, 0x3e, 0xbf, 0x60, 0x6a, 0xd2, 0xd3,
-	0xdd, 0xbf, 0xbd, 0x5e, 0x32, 0xfe, 0xf9, 0x7a, 0xc9, 0xf8, 0xf7, 0xeb, 0x25, 0x03, 0x16, 0xdc,
-	0xb0, 0x9d, 0x07, 0xf5, 0xb4, 0xba, 0x1e, 0xb1, 0x2d, 0x1e, 0x8a, 0x70, 0xcb, 0xf8, 0xf1, 0x6a,
-	0x8b, 0x89, 0xfd, 0x64, 0xb7, 0xe1, 0x86, 0xed, 0xd5, 0xbe, 0x3f, 0xc9, 0x1b, 0x2d, 0x0c, 0xd2,
-	0x7f, 0xf6, 0xf5, 0xff, 0xe5, 0xef, 0xd3, 0x88, 0x1d, 0xdd, 0xdf, 0x1d, 0x57, 0xb4, 0x07, 0xff,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x4c, 0xc8, 0xf1, 0x3e, 0x20, 0x00, 0x00,
+	// 2336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4f, 0x73, 0x1c, 0x47,
+	0x15, 0xaf, 0xd9, 0xd5, 0xdf, 0xa7, 0x3f, 0x96, 0xda, 0x96, 0x34, 0x5e, 0x5b, 0xb2, 0x34, 0x4e,
+	0x8c, 0x70, 0xe2, 0x15, 0x96, 0x89, 0x6d, 0x1c, 0x20, 0x25, 0xaf, 0x2c, 0x47, 0x94, 0xed, 0x12,
+	0x23, 0x81, 0x0b, 0x2e, 0x43, 0x6b, 0xa6, 0xb5, 0xdb, 0x68, 0x76, 0x66, 0xd4, 0xdd, 0xb3, 0x9b,
+	0x0d, 0x07, 0x0a, 0x2a, 0x70, 0x80, 0x82, 0x0a, 0x07, 0x0e, 0x9c, 0x38, 0xc0, 0x67, 0x01, 0x6e,
+	0xc0, 0x37, 0x00, 0x7f, 0x01, 0xbe, 0x41, 0x8a, 0xea, 0x9e, 0x9e, 0xfd, 0xa7, 0xd9, 0x59, 0xad,
+	0x53, 0xa9, 0xb8, 0x72, 0xd3, 0xbc, 0x7e, 0xef, 0xd7, 0xef, 0x5f, 0xbf, 0x7e, 0xfd, 0xb4, 0x70,
+	0x3b, 0x3e, 0x26, 0x6c, 0xcb, 0xc5, 0x1e, 0x09, 0x5c, 0xb2, 0x85, 0x23, 0xba, 0xd5, 0xb8, 0xbb,
+	0xc5, 0x09, 0x6b, 0x50, 0x97, 0x38, 0xcd, 0x90, 0x9d, 0x9e, 0xf8, 0x61, 0xb3, 0x1c, 0xb1, 0x50,
+	0x84, 0xe8, 0xb2, 0xe4, 0x2d, 0x6b, 0xde, 0x32, 0x8e, 0x68, 0xb9, 0x71, 0xb7, 0xb4, 0x56, 0x0d,
+	0xc3, 0xaa, 0x4f, 0xb6, 0x14, 0xcb, 0x71, 0x7c, 0xb2, 0xe5, 0xc5, 0x0c, 0x0b, 0x1a, 0x06, 0x89,
+	0x50, 0x69, 0x3d, 0x6b, 0x03, 0x37, 0xac, 0xd7, 0xdb, 0x1c, 0x1b, 0x59, 0x1c, 0x35, 0xca, 0x45,
+	0xc8, 0x5a, 0x9a, 0xe5, 0x46, 0x16, 0xcb, 0x59, 0x4c, 0xda, 0x0c, 0x56, 0x16, 0x83, 0xc0, 0xfc,
+	0xd4, 0xa7, 0x5c, 0xe4, 0xf1, 0xf4, 0x9a, 0x68, 0x7d, 0x3a, 0x05, 0xab, 0x87, 0x02, 0x33, 0xf1,
+	0x52, 0xd3, 0x9f, 0x7c, 0x44, 0xdc, 0x58, 0x9a, 0x63, 0x93, 0xb3, 0x98, 0x70, 0x81, 0x96, 0x61,
+	0xc2, 0x0b, 0xeb, 0x98, 0x06, 0xa6, 0xb1, 0x6e, 0x6c, 0x4e, 0xdb, 0xfa, 0x0b, 0xdd, 0x80, 0x99,
+	0x14, 0xcb, 0xa1, 0x9e, 0x59, 0x50, 0x8b, 0x90, 0x92, 0xf6, 0x3d, 0xb4, 0x07, 0x73, 0x6d, 0x06,
+	0xd1, 0x8a, 0x88, 0x59, 0x5c, 0x37, 0x36, 0x67, 0xb6, 0x37, 0xca, 0x19, 0x5e, 0x2d, 0xa7, 0xdb,
+	0x1f, 0xb5, 0x22, 0x62, 0xcf, 0x36, 0xbb, 0xbe, 0xd0, 0x23, 0x98, 0x96, 0x86, 0x39, 0xd2, 0x32,
+	0x73, 0x4c, 0x61, 0xac, 0x66, 0x62, 0x1c, 0x61, 0x7e, 0xfa, 0x8c, 0x72, 0x61, 0x4f, 0x09, 0xfd,
+	0x17, 0xda, 0x86, 0x71, 0x1a, 0x44, 0xb1, 0x30, 0xc7, 0x95, 0xdc, 0xf5, 0x4c, 0xb9, 0x03, 0xdc,
+	0xf2, 0x43, 0xec, 0xd9, 0x09, 0x2b, 0xc2, 0xb0, 0x4e, 0x52, 0x27, 0x38, 0x5c, 0xfa, 0xc6, 0x11,
+	0xa1, 0xe3, 0xfa, 0x21, 0x27, 0x8e, 0xa0, 0x75, 0x12, 0xc6, 0xc2, 0x9c, 0x50, 0x70, 0x57, 0xcb,
+	0x49, 0x2e, 0x94, 0xd3, 0x5c, 0x28, 0xef, 0xea, 0x5c, 0xb0, 0xaf, 0xb7, 0x21, 0x94, 0x77, 0x8f,
+	0xc2, 0x8a, 0x94, 0x3f, 0x4a, 0xc4, 0xd1, 0x4b, 0xb8, 0xa6, 0x4c, 0x1a, 0x80, 0x3e, 0x39, 0x0c,
+	0x7d, 0x45, 0x4a, 0x67, 0x01, 0x97, 0x60, 0x8a, 0x7a, 0x24, 0x10, 0x54, 0xb4, 0xcc, 0x29, 0x15,
+	0x91, 0xf6, 0x37, 0x5a, 0x05, 0x60, 0x49, 0x4c, 0x65, 0xbc, 0xa6, 0xd5, 0xea, 0xb4, 0xa6, 0xec,
+	0x7b, 0xc8, 0x05, 0xb3, 0x2b, 0x9e, 0x0e, 0x23, 0x31, 0x27, 0x4e, 0x14, 0xfa, 0xd4, 0x6d, 0x99,
+	0xb0, 0x6e, 0x6c, 0xce, 0x6f, 0xdf, 0xce, 0x8d, 0xdc, 0xbe, 0x67, 0x4b, 0x91, 0x03, 0x25, 0x61,
+	0x2f, 0x35, 0xb3, 0xc8, 0xa8, 0x02, 0xb3, 0x8c, 0x08, 0xd6, 0x4a, 0x81, 0x67, 0x94, 0xa5, 0xeb,
+	0x99, 0xc0, 0xb6, 0x64, 0xd4, 0x70, 0x33, 0xac, 0xf3, 0x81, 0x6e, 0xc2, 0x9c, 0xcb, 0x64, 0x6c,
+	0xdc, 0x1a, 0xf1, 0x62, 0x9f, 0x98, 0xb3, 0xca, 0x96, 0x59, 0x49, 0x3c, 0xd4, 0x34, 0x74, 0x07,
+	0xc6, 0xea, 0xa4, 0x1e, 0x9a, 0x73, 0xda, 0x97, 0x59, 0x3b, 0x3c, 0x27, 0xf5, 0xd0, 0x56, 0x6c,
+	0xc8, 0x86, 0x45, 0x4e, 0x30, 0x73, 0x6b, 0x0e, 0x16, 0x82, 0xd1, 0xe3, 0x58, 0x10, 0x6e, 0xce,
+	0x2b, 0xd9, 0xb7, 0x33, 0x65, 0x0f, 0x15, 0xf7, 0x4e, 0x9b, 0xd9, 0x5e, 0xe0, 0x7d, 0x14, 0x74,
+	0x0f, 0x26, 0x6a, 0x04, 0x7b, 0x84, 0x99, 0x97, 0x14, 0xd0, 0xb5, 0x4c, 0xa0, 0x0f, 0x15, 0x8b,
+	0xad, 0x59, 0xd1, 0x23, 0x98, 0xf1, 0x88, 0x8f, 0x5b, 0x49, 0x6e, 0x98, 0x0b, 0xc3, 0x52, 0x01,
+	0x14, 0xb7, 0xca, 0x05, 0xb4, 0x0b, 0x0b, 0x8c, 0x08, 0x19, 0xee, 0x30, 0x70, 0x22, 0xc2, 0x68,
+	0xe8, 0x99, 0x8b, 0xc3, 0x00, 0x2e, 0xb5, 0x45, 0x0e, 0x94, 0x84, 0xf5, 0x00, 0xd6, 0x06, 0x55,
+	0x04, 0x1e, 0x85, 0x01, 0x27, 0x68, 0x09, 0x26, 0x58, 0x1c, 0xc8, 0x2c, 0x4a, 0x4a, 0xc2, 0x38,
+	0x8b, 0x83, 0x7d, 0xcf, 0xfa, 0x5b, 0x01, 0xd6, 0x0e, 0x69, 0x35, 0xc0, 0xfe, 0xc8, 0xc5, 0xe4,
+	0x07, 0x80, 0xda, 0xc9, 0xd7, 0x3e, 0x39, 0xaa, 0xa6, 0xcc, 0x6c, 0xdf, 0xca, 0x4d, 0xbb, 0xce,
+	0x16, 0x8b, 0xcd, 0x7e, 0x52, 0xcf, 0x71, 0x28, 0xe6, 0x1e, 0x87, 0xb1, 0xfe, 0xe3, 0x70, 0x03,
+	0x66, 0xb8, 0xb2, 0xc5, 0x09, 0x70, 0x9d, 0xa8, 0xfa, 0x31, 0x6d, 0x43, 0x42, 0x7a, 0x81, 0xeb,
+	0x04, 0x7d, 0x00, 0xb3, 0x9a, 0x21, 0xa9, 0x30, 0x13, 0x17, 0xa8, 0x30, 0x1a, 0x72, 0x5f, 0xd5,
+	0x19, 0x13, 0x26, 0xdd, 0x30, 0x10, 0x2c, 0xf4, 0xd5, 0x81, 0x9f, 0xb5, 0xd3, 0x4f, 0x6b, 0x03,
+	0x6e, 0x0c, 0xf4, 0x63, 0x12, 0x02, 0xeb, 0x33, 0x03, 0xbe, 0xa6, 0x79, 0xa8, 0xa8, 0xe5, 0x57,
+	0xf0, 0x97, 0x30, 0x97, 0x14, 0x1a, 0x6d, 0x9d, 0xf2, 0xfd, 0xcc, 0xf6, 0x76, 0x76, 0x5e, 0xe7,
+	0x41, 0xd9, 0xb3, 0x0a, 0x28, 0x05, 0xee, 0xf3, 0x51, 0x61, 0xa8, 0x8f, 0x8a, 0x9f, 0xc3, 0x47,
+	0x63, 0xbd, 0x3e, 0xda, 0x81, 0xcd, 0xe1, 0xf6, 0xe7, 0xe7, 0xeb, 0xdf, 0x0b, 0xb0, 0x6a, 0x13,
+	0x4e, 0xc4, 0x9b, 0x92, 0xae, 0xcb, 0x30, 0xc1, 0x08, 0xe6, 0x61, 0xa0, 0x93, 0x55, 0x7f, 0xa1,
+	0x07, 0x60, 0x7a, 0xc4, 0xa5, 0x5c, 0x1e, 0xeb, 0x13, 0x1a, 0x50, 0x5e, 0x73, 0x48, 0x83, 0x04,
+	0xed, 0xc4, 0x2d, 0xda, 0x4b, 0xe9, 0xfa, 0x9e, 0x5a, 0x7e, 0x22, 0x57, 0xf7, 0xbd, 0xbe, 0x1c,
+	0x1f, 0xef, 0xcf, 0xf1, 0x32, 0x5c, 0xe6, 0xa7, 0x34, 0x72, 0x74, 0x8c, 0x18, 0xc1, 0x51, 0xe4,
+	0xb7, 0x54, 0x26, 0x4f, 0xd9, 0x8b, 0x72, 0x29, 0x71, 0xb1, 0x9d, 0x2c, 0xa0, 0x15, 0x98, 0xf4,
+	0x58, 0xcb, 0x61, 0x71, 0xa0, 0x32, 0x76, 0xca, 0x9e, 0xf0, 0x58, 0xcb, 0x8e, 0x03, 0xeb, 0x57,
+	0x06, 0xac, 0x0d, 0xf2, 0x64, 0x6e, 0x0c, 0xd0, 0x33, 0x98, 0x8c, 0x18, 0x69, 0x50, 0xd2, 0x34,
+	0x0b, 0x39, 0x59, 0x99, 0x0d, 0x7e, 0x90, 0x48, 0xda, 0x29, 0x84, 0xf5, 0xc7, 0x31, 0x58, 0xcd,
+	0x65, 0x45, 0xcf, 0x61, 0x91, 0x49, 0x06, 0x27, 0x0a, 0x69, 0x20, 0x12, 0x37, 0x9a, 0x46, 0x4e,
+	0x63, 0xf2, 0x61, 0xd2, 0x97, 0x29, 0x8f, 0xca, 0x5a, 0xc9, 0x89, 0x38, 0x90, 0xa2, 0x8a, 0x80,
+	0x9e, 0xc1, 0x82, 0x47, 0xb9, 0x8b, 0x99, 0x47, 0xbc, 0x04, 0x8c, 0x9b, 0x85, 0xf5, 0xe2, 0x05,
+	0xd1, 0xda, 0xa2, 0xea, 0x9b, 0xa3, 0x17, 0x52, 0x39, 0xe9, 0x6a, 0x4a, 0x3c, 0x1d, 0x14, 0x6e,
+	0x16, 0x2f, 0x0a, 0xb7, 0xd0, 0x96, 0x4d, 0xa2, 0xc6, 0xd1, 0x4b, 0x40, 0x11, 0x09, 0x3c, 0x1a,
+	0x54, 0x1d, 0xec, 0x0a, 0xda, 0xa0, 0x82, 0x12, 0x6e, 0x8e, 0x29, 0xc0, 0xcd, 0xec, 0x43, 0x98,
+	0xb0, 0xef, 0x24, 0xdc, 0xad, 0xfd, 0xe0, 0x24, 0xb4, 0x17, 0xa3, 0x1e, 0x22, 0x25, 0x1c, 0x3d,
+	0x83, 0xf9, 0x14, 0x58, 0xf6, 0x2c, 0x8c, 0x9b, 0xe3, 0xeb, 0xc5, 0x81, 0x57, 0xa5, 0x06, 0x95,
+	0x3d, 0x0a, 0x53, 0x88, 0x73, 0x51, 0x17, 0x85, 0xa3, 0x9f, 0x80, 0x19, 0xb2, 0xa8, 0x86, 0x03,
+	0xe2, 0x39, 0x6e, 0x8d, 0xfa, 0x5e, 0xbb, 0x0f, 0xe7, 0xe6, 0xc4, 0x7a, 0x71, 0x84, 0x33, 0xb5,
+	0x9c, 0xe2, 0x54, 0x24, 0x4c, 0xba, 0xce, 0xad, 0x7f, 0x1b, 0xf0, 0xb6, 0x3e, 0xd3, 0x15, 0x1c,
+	0xb8, 0xe4, 0x2b, 0x70, 0x41, 0x59, 0x9b, 0x70, 0x6b, 0x98, 0x49, 0x9d, 0xbb, 0x62, 0xe3, 0x88,
+	0xb0, 0x3a, 0x0d, 0xb0, 0x20, 0x6f, 0x7a, 0xad, 0xbb, 0x0f, 0x93, 0x1e, 0x11, 0x98, 0xfa, 0xdc,
+	0x1c, 0xbb, 0xc0, 0x6d, 0x91, 0x32, 0xf7, 0x78, 0x72, 0xbc, 0xd7, 0x93, 0xd6, 0x5b, 0x60, 0xe5,
+	0xd9, 0xaf, 0xdd, 0xf4, 0x07, 0x03, 0xd6, 0x77, 0x09, 0x77, 0x19, 0x3d, 0x7e, 0x53, 0xbc, 0x64,
+	0x7d, 0x56, 0x84, 0x8d, 0x1c, 0x9d, 0x74, 0x6d, 0xf5, 0x61, 0xa5, 0xf3, 0x62, 0x71, 0xc3, 0xe0,
+	0x84, 0x56, 0x75, 0x77, 0xa7, 0x4b, 0xdb, 0xbd, 0x8b, 0x69, 0x50, 0xe9, 0x16, 0xb5, 0x97, 0x49,
+	0x26, 0x1d, 0x1d, 0xc3, 0xca, 0x79, 0x53, 0x1d, 0x1a, 0x9c, 0x84, 0xda, 0xde, 0xdb, 0x17, 0xdb,
+	0x4d, 0x95, 0x82, 0xa5, 0x66, 0x16, 0x79, 0x40, 0xe5, 0x2a, 0x7e, 0xfe, 0xca, 0xf5, 0x23, 0x58,
+	0x48, 0x81, 0x55, 0xa9, 0x61, 0x24, 0xd0, 0x05, 0xb1, 0x9c, 0x07, 0xab, 0xea, 0x49, 0xaf, 0xe6,
+	0x97, 0xa2, 0xae, 0x25, 0x46, 0x02, 0x74, 0xd8, 0x81, 0x4e, 0x6f, 0x63, 0xfd, 0xec, 0xcc, 0xd5,
+	0x78, 0x57, 0xf3, 0xf6, 0x80, 0xa6, 0x44, 0xeb, 0x93, 0x22, 0x5c, 0xf9, 0xbe, 0x7c, 0xf7, 0xa7,
+	0xee, 0xfb, 0x92, 0x8e, 0xeb, 0x43, 0x18, 0x57, 0xe3, 0x07, 0xdd, 0xc2, 0x59, 0xb9, 0x48, 0x4a,
+	0x61, 0x3b, 0x11, 0x40, 0x0e, 0x2c, 0xab, 0x3f, 0x1c, 0x46, 0x7e, 0x4a, 0x5c, 0x21, 0xf3, 0xd3,
+	0xa3, 0x4a, 0xa9, 0x31, 0xf5, 0xaa, 0xfc, 0x7a, 0x26, 0x54, 0x02, 0xa1, 0x24, 0x2a, 0xa9, 0x80,
+	0x7d, 0xe5, 0x2c, 0x83, 0x2a, 0xf3, 0x31, 0xd9, 0xc0, 0x0d, 0x03, 0x4e, 0xb9, 0x20, 0x81, 0xdb,
+	0x72, 0x7c, 0xd2, 0x20, 0xbe, 0x39, 0x9e, 0xf3, 0x6e, 0x55, 0x3b, 0x54, 0x3a, 0x22, 0xcf, 0xa4,
+	0x84, 0xbd, 0x74, 0x96, 0x45, 0xb6, 0xfe, 0x62, 0xc0, 0x52, 0x5f, 0x18, 0xf4, 0xd9, 0xfb, 0x00,
+	0x66, 0x53, 0xf3, 0x78, 0xec, 0xa7, 0xbd, 0xc4, 0x90, 0x16, 0x57, 0xdb, 0x21, 0x05, 0xd0, 0x3e,
+	0xcc, 0x77, 0xfb, 0x87, 0x78, 0x66, 0x21, 0xc7, 0xc5, 0x5d, 0x7e, 0x21, 0x9e, 0x3d, 0x77, 0xd6,
+	0xfd, 0x69, 0xfd, 0xcf, 0x80, 0x95, 0xb4, 0x5a, 0xb4, 0x87, 0x21, 0x43, 0xf2, 0xa5, 0x67, 0xba,
+	0x52, 0x18, 0x6d, 0xba, 0xf2, 0x14, 0xe6, 0xdb, 0xb2, 0x9d, 0x11, 0xcf, 0xfc, 0xf6, 0x46, 0x2e,
+	0x40, 0x32, 0xe2, 0x11, 0x5d, 0x5f, 0xb2, 0xc1, 0xa5, 0x81, 0xeb, 0xc7, 0x1e, 0x71, 0x3a, 0x80,
+	0x5c, 0x60, 0x11, 0x27, 0xb7, 0xc0, 0x94, 0xbd, 0xa4, 0xd7, 0x53, 0x90, 0x43, 0xb5, 0x68, 0xfd,
+	0xd5, 0x00, 0xf3, 0xbc, 0xc5, 0x3a, 0x34, 0xdf, 0x82, 0xc9, 0x28, 0xf4, 0x7d, 0xd9, 0x9e, 0x18,
+	0xea, 0x88, 0xdf, 0xc8, 0x8e, 0x8a, 0xe2, 0x51, 0xc7, 0x2f, 0xe5, 0x47, 0xcf, 0x61, 0xe1, 0x9c,
+	0x22, 0x89, 0x73, 0x6e, 0xe6, 0xda, 0x96, 0xa8, 0x65, 0xcf, 0x8b, 0x5e, 0x35, 0xdf, 0x83, 0x6b,
+	0x4f, 0x89, 0x48, 0x99, 0xf8, 0xe3, 0xd6, 0xae, 0x72, 0xfe, 0x90, 0xd8, 0x58, 0xbf, 0x1f, 0x83,
+	0xeb, 0xd9, 0x72, 0xda, 0xc2, 0x9f, 0xc3, 0x72, 0xfb, 0x61, 0xd0, 0xd1, 0xb7, 0x8e, 0x23, 0x6d,
+	0xf0, 0xf7, 0x32, 0x95, 0xcd, 0x83, 0x2c, 0xa7, 0x95, 0x27, 0xe5, 0x78, 0x8e, 0xa3, 0x27, 0x81,
+	0x60, 0x2d, 0xfb, 0xb2, 0x77, 0x7e, 0x45, 0x2a, 0xa0, 0xeb, 0x73, 0xab, 0x4f, 0x81, 0xc2, 0xeb,
+	0x2a, 0x90, 0x56, 0xf0, 0xf3, 0x0a, 0xe0, 0xf3, 0x2b, 0xa5, 0x58, 0xc6, 0x3f, 0x5b, 0x63, 0xb4,
+	0x00, 0xc5, 0x53, 0xd2, 0xd2, 0x3e, 0x95, 0x7f, 0xa2, 0x0a, 0x8c, 0x37, 0xb0, 0x1f, 0x13, 0x1d,
+	0xcb, 0x3b, 0x99, 0xda, 0x0d, 0xca, 0x27, 0x3b, 0x91, 0x7d, 0x54, 0x78, 0x68, 0xc8, 0x6d, 0x07,
+	0xe9, 0xf9, 0x05, 0x6e, 0x6b, 0x71, 0x58, 0x55, 0x67, 0x46, 0xb3, 0x1c, 0x60, 0x26, 0x54, 0x0d,
+	0xe4, 0x5f, 0xe0, 0x29, 0xb7, 0x7e, 0x5d, 0x80, 0xb5, 0x41, 0xbb, 0xea, 0x3c, 0x3c, 0x83, 0xd5,
+	0x8c, 0x34, 0x88, 0xda, 0x8c, 0xa6, 0x91, 0x73, 0xc5, 0x9e, 0xc3, 0x7d, 0x4e, 0x04, 0xf6, 0xb0,
+	0xc0, 0x76, 0xa9, 0x3f, 0xe2, 0x9d, 0xad, 0xe5, 0x96, 0x19, 0xa9, 0xdf, 0xb5, 0x65, 0xe1, 0xf5,
+	0xb6, 0xec, 0xcf, 0xf2, 0xce, 0x96, 0xd6, 0x0a, 0x2c, 0x3d, 0x25, 0xa2, 0xe2, 0xc7, 0x5c, 0xe8,
+	0x7a, 0x91, 0x78, 0xdd, 0xfa, 0xa5, 0x01, 0xcb, 0xfd, 0x2b, 0xda, 0x33, 0x35, 0xb8, 0xca, 0xe3,
+	0x28, 0x0a, 0x99, 0x90, 0x8f, 0x1b, 0x9f, 0xca, 0x57, 0x7b, 0x83, 0x30, 0xae, 0xbd, 0x22, 0x03,
+	0xf1, 0x6e, 0xf6, 0x1c, 0x26, 0x95, 0xaa, 0x28, 0xa1, 0x1f, 0x6a, 0x19, 0x7b, 0x85, 0x67, 0x2f,
+	0x58, 0xbf, 0x2d, 0x82, 0xf5, 0x34, 0xe3, 0xe5, 0xab, 0xdf, 0x88, 0x5f, 0x52, 0xdf, 0x70, 0x0d,
+	0xa6, 0x23, 0x5c, 0x25, 0x0e, 0xa7, 0x1f, 0x27, 0xb7, 0xc3, 0xb8, 0x3d, 0x25, 0x09, 0x87, 0xf4,
+	0x63, 0x82, 0x6e, 0xc1, 0xa5, 0x80, 0x7c, 0x24, 0xa3, 0x56, 0x25, 0x8e, 0x08, 0x4f, 0x49, 0xa0,
+	0xa7, 0x3c, 0x73, 0x92, 0x7c, 0x80, 0xab, 0xe4, 0x48, 0x12, 0xd1, 0x3b, 0x80, 0x9a, 0x98, 0x0a,
+	0xe7, 0x24, 0x64, 0x4e, 0x40, 0x9a, 0xfa, 0xd5, 0x3e, 0xae, 0x2e, 0x86, 0x4b, 0x72, 0x65, 0x2f,
+	0x64, 0x2f, 0x48, 0x33, 0x79, 0x92, 0x3b, 0x70, 0x55, 0xff, 0x2f, 0x25, 0xe1, 0x73, 0x4e, 0xa8,
+	0x2f, 0x08, 0x4b, 0xee, 0xa7, 0x09, 0x75, 0x3f, 0xbd, 0x95, 0x69, 0x8f, 0x12, 0xdf, 0x53, 0xcc,
+	0xea, 0x8a, 0x5a, 0xae, 0x75, 0xbd, 0xae, 0x3b, 0x74, 0x39, 0x7e, 0x56, 0x53, 0x13, 0x39, 0xed,
+	0xa5, 0x0d, 0xec, 0xeb, 0x59, 0xc8, 0xac, 0x24, 0xee, 0x68, 0x9a, 0xf5, 0x5f, 0x03, 0x6e, 0xe6,
+	0x46, 0x43, 0xe7, 0xc7, 0x7d, 0x98, 0xd4, 0xdb, 0xe4, 0x76, 0x0e, 0xa9, 0x58, 0xca, 0x8c, 0xbe,
+	0x0b, 0x33, 0x0c, 0x37, 0x9d, 0x54, 0x36, 0x49, 0xf6, 0xec, 0x23, 0xbd, 0x8b, 0x05, 0x7e, 0xec,
+	0x87, 0xc7, 0x36, 0x30, 0xdc, 0xd4, 0x40, 0x59, 0xae, 0x2f, 0x66, 0xb9, 0xbe, 0x04, 0x53, 0x89,
+	0x9d, 0xc4, 0xd3, 0x37, 0x71, 0xfb, 0xdb, 0x6a, 0xc1, 0xec, 0x1e, 0xc1, 0x22, 0x66, 0x64, 0xcf,
+	0xc7, 0x55, 0x8e, 0x28, 0x6c, 0x67, 0x3c, 0x0c, 0xb0, 0xcf, 0x08, 0xf6, 0x64, 0x77, 0x56, 0x8f,
+	0x7c, 0x22, 0x8f, 0x01, 0x61, 0x2c, 0x64, 0x0e, 0x09, 0xf0, 0xb1, 0x4f, 0x92, 0x71, 0xd0, 0x94,
+	0x7d, 0xe7, 0x5c, 0xea, 0xec, 0x24, 0x72, 0x95, 0x54, 0xec, 0x89, 0x94, 0x7a, 0x92, 0x08, 0x6d,
+	0xff, 0x73, 0x0e, 0x66, 0x52, 0xdf, 0xee, 0x1c, 0xec, 0xa3, 0x5f, 0x18, 0xb0, 0x9c, 0x3d, 0x04,
+	0x44, 0xaf, 0x31, 0xe6, 0x2c, 0xdd, 0x1b, 0x49, 0x46, 0x87, 0xf2, 0x13, 0x03, 0x56, 0x06, 0x8c,
+	0x6d, 0xd1, 0x00, 0xc0, 0xdc, 0x61, 0x79, 0xe9, 0x9b, 0xa3, 0x09, 0x69, 0x35, 0xfe, 0x6c, 0xc0,
+	0xfa, 0xb0, 0xc9, 0x28, 0xfa, 0x76, 0x1e, 0xf4, 0xb0, 0x81, 0x72, 0xe9, 0x3b, 0xaf, 0x29, 0xad,
+	0x35, 0x94, 0xc1, 0xca, 0x9e, 0xd2, 0xa1, 0x51, 0xa6, 0x7f, 0xf9, 0xc1, 0x1a, 0x32, 0x8e, 0xfc,
+	0x93, 0x9a, 0x58, 0xe6, 0x8d, 0x4f, 0xd0, 0xa3, 0x01, 0xb8, 0x17, 0x18, 0x23, 0x95, 0xde, 0x7f,
+	0x2d, 0x59, 0xad, 0xdb, 0xef, 0x0c, 0x28, 0x0d, 0x9e, 0x57, 0xa0, 0xfb, 0xd9, 0x57, 0xda, 0xb0,
+	0x01, 0x4f, 0xe9, 0xc1, 0xc8, 0x72, 0x5a, 0x9f, 0xdf, 0x18, 0x70, 0x75, 0xe0, 0x10, 0x02, 0xbd,
+	0x97, 0xdb, 0xcd, 0x0c, 0xd4, 0xe6, 0xfe, 0xa8, 0x62, 0x5a, 0x99, 0x13, 0x98, 0xeb, 0x79, 0x88,
+	0xa1, 0x9c, 0xf7, 0x63, 0xdf, 0x9b, 0xb9, 0x74, 0xfb, 0x22, 0xac, 0x7a, 0x9f, 0x10, 0x16, 0xfa,
+	0x3b, 0x32, 0xf4, 0xee, 0x05, 0x1b, 0xb7, 0x64, 0xb7, 0xd1, 0xda, 0x3c, 0xf4, 0x33, 0xb8, 0x92,
+	0xd5, 0x17, 0xa3, 0x6f, 0x8c, 0xd0, 0x42, 0x27, 0x1b, 0xdf, 0x1d, 0xb9, 0xe9, 0x56, 0x47, 0x32,
+	0xbb, 0xc7, 0x1b, 0x70, 0x24, 0x73, 0xdb, 0xd0, 0x01, 0x47, 0x72, 0x48, 0x13, 0x49, 0x61, 0xbe,
+	0xb7, 0x89, 0x42, 0xb7, 0x07, 0x19, 0x72, 0xbe, 0x07, 0x2b, 0xbd, 0x73, 0x21, 0x5e, 0xbd, 0xd5,
+	0xa7, 0x86, 0x7a, 0x90, 0x0d, 0xba, 0x9d, 0xd1, 0x83, 0x41, 0x60, 0x43, 0xba, 0xab, 0xd2, 0xc3,
+	0xd1, 0x05, 0x13, 0x95, 0x1e, 0x1f, 0xff, 0xe3, 0xd5, 0x9a, 0xf1, 0xaf, 0x57, 0x6b, 0xc6, 0x7f,
+	0x5e, 0xad, 0x19, 0xb0, 0xe2, 0x86, 0xf5, 0x2c, 0xa8, 0xc7, 0x53, 0x3b, 0x11, 0x3d, 0x60, 0xa1,
+	0x08, 0x0f, 0x8c, 0x1f, 0x6f, 0x55, 0xa9, 0xa8, 0xc5, 0xc7, 0x65, 0x37, 0xac, 0x6f, 0xf5, 0xfc,
+	0xd4, 0xa3, 0x5c, 0x25, 0x41, 0xf2, 0xfb, 0x14, 0xfd, 0xab, 0x8f, 0xf7, 0x71, 0x44, 0x1b, 0x77,
+	0x8f, 0x27, 0x14, 0xed, 0xde, 0xff, 0x07, 0x00, 0xe9, 0xf6, 0xce, 0xde, 0x04, 0x23, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -1983,6 +2107,20 @@ func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RetentionPeriod != nil {
+		{
+			size, err := m.RetentionPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.DelayStart != nil {
 		{
 			size, err := m.DelayStart.MarshalToSizedBuffer(dAtA[:i])
@@ -2418,6 +2556,16 @@ func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.SkipSignalReapply {
 		i--
 		if m.SkipSignalReapply {
@@ -2493,6 +2641,18 @@ func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Preview != nil {
+		{
+			size, err := m.Preview.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.RunId) > 0 {
 		i -= len(m.RunId)
 		copy(dAtA[i:], m.RunId)
@@ -2503,6 +2663,115 @@ func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ResetWorkflowExecutionPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OrphanedChildWorkflows) > 0 {
+		for iNdEx := len(m.OrphanedChildWorkflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrphanedChildWorkflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingTimers) > 0 {
+		for iNdEx := len(m.PendingTimers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTimers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingActivities) > 0 {
+		for iNdEx := len(m.PendingActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ReappliedSignals) > 0 {
+		for iNdEx := len(m.ReappliedSignals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReappliedSignals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DiscardedEvents) > 0 {
+		for iNdEx := len(m.DiscardedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DiscardedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ResetPointEvent != nil {
+		{
+			size, err := m.ResetPointEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3609,6 +3878,10 @@ func (m *StartWorkflowExecutionRequest) Size() (n int) {
 		l = m.DelayStart.Size()
 		n += 2 + l + sovServiceWorkflow(uint64(l))
 	}
+	if m.RetentionPeriod != nil {
+		l = m.RetentionPeriod.Size()
+		n += 2 + l + sovServiceWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3755,6 +4028,9 @@ func (m *ResetWorkflowExecutionRequest) Size() (n int) {
 	if m.SkipSignalReapply {
 		n += 2
 	}
+	if m.DryRun {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3771,33 +4047,83 @@ func (m *ResetWorkflowExecutionResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovServiceWorkflow(uint64(l))
 	}
+	if m.Preview != nil {
+		l = m.Preview.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
+func (m *ResetWorkflowExecutionPreview) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
+	if m.ResetPointEvent != nil {
+		l = m.ResetPointEvent.Size()
 		n += 1 + l + sovServiceWorkflow(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovServiceWorkflow(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if len(m.DiscardedEvents) > 0 {
+		for _, e := range m.DiscardedEvents {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
 	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovServiceWorkflow(uint64(l))
+	if len(m.ReappliedSignals) > 0 {
+		for _, e := range m.ReappliedSignals {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	if len(m.PendingActivities) > 0 {
+		for _, e := range m.PendingActivities {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	if len(m.PendingTimers) > 0 {
+		for _, e := range m.PendingTimers {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	if len(m.OrphanedChildWorkflows) > 0 {
+		for _, e := range m.OrphanedChildWorkflows {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -4795,6 +5121,42 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionPeriod == nil {
+				m.RetentionPeriod = &types.Duration{}
+			}
+			if err := m.RetentionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -5708,6 +6070,26 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SkipSignalReapply = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -5791,6 +6173,299 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preview", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Preview == nil {
+				m.Preview = &ResetWorkflowExecutionPreview{}
+			}
+			if err := m.Preview.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetWorkflowExecutionPreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionPreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionPreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetPointEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResetPointEvent == nil {
+				m.ResetPointEvent = &HistoryEvent{}
+			}
+			if err := m.ResetPointEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscardedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiscardedEvents = append(m.DiscardedEvents, &HistoryEvent{})
+			if err := m.DiscardedEvents[len(m.DiscardedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReappliedSignals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReappliedSignals = append(m.ReappliedSignals, &HistoryEvent{})
+			if err := m.ReappliedSignals[len(m.ReappliedSignals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingActivities = append(m.PendingActivities, &PendingActivityInfo{})
+			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTimers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTimers = append(m.PendingTimers, &PendingTimerInfo{})
+			if err := m.PendingTimers[len(m.PendingTimers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedChildWorkflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrphanedChildWorkflows = append(m.OrphanedChildWorkflows, &WorkflowExecution{})
+			if err := m.OrphanedChildWorkflows[len(m.OrphanedChildWorkflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
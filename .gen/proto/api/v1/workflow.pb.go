@@ -624,6 +624,7 @@ type WorkflowExecutionConfiguration struct {
 	TaskList                     *TaskList       `protobuf:"bytes,1,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
 	ExecutionStartToCloseTimeout *types.Duration `protobuf:"bytes,2,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
 	TaskStartToCloseTimeout      *types.Duration `protobuf:"bytes,3,opt,name=task_start_to_close_timeout,json=taskStartToCloseTimeout,proto3" json:"task_start_to_close_timeout,omitempty"`
+	RetentionPeriod              *types.Duration `protobuf:"bytes,4,opt,name=retention_period,json=retentionPeriod,proto3" json:"retention_period,omitempty"`
 	XXX_NoUnkeyedLiteral         struct{}        `json:"-"`
 	XXX_unrecognized             []byte          `json:"-"`
 	XXX_sizecache                int32           `json:"-"`
@@ -683,6 +684,13 @@ func (m *WorkflowExecutionConfiguration) GetTaskStartToCloseTimeout() *types.Dur
 	return nil
 }
 
+func (m *WorkflowExecutionConfiguration) GetRetentionPeriod() *types.Duration {
+	if m != nil {
+		return m.RetentionPeriod
+	}
+	return nil
+}
+
 type ParentExecutionInfo struct {
 	DomainId             string             `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	DomainName           string             `protobuf:"bytes,2,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
@@ -944,6 +952,69 @@ func (m *PendingActivityInfo) GetLastWorkerIdentity() string {
 	return ""
 }
 
+type PendingTimerInfo struct {
+	TimerId              string           `protobuf:"bytes,1,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
+	StartedEventId       int64            `protobuf:"varint,2,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	ExpiryTime           *types.Timestamp `protobuf:"bytes,3,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PendingTimerInfo) Reset()         { *m = PendingTimerInfo{} }
+func (m *PendingTimerInfo) String() string { return proto.CompactTextString(m) }
+func (*PendingTimerInfo) ProtoMessage()    {}
+func (*PendingTimerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2775eefb5053680f, []int{5}
+}
+func (m *PendingTimerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTimerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTimerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTimerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTimerInfo.Merge(m, src)
+}
+func (m *PendingTimerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTimerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTimerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTimerInfo proto.InternalMessageInfo
+
+func (m *PendingTimerInfo) GetTimerId() string {
+	if m != nil {
+		return m.TimerId
+	}
+	return ""
+}
+
+func (m *PendingTimerInfo) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *PendingTimerInfo) GetExpiryTime() *types.Timestamp {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
 type PendingChildExecutionInfo struct {
 	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,1,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
 	WorkflowTypeName     string             `protobuf:"bytes,2,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
@@ -958,7 +1029,7 @@ func (m *PendingChildExecutionInfo) Reset()         { *m = PendingChildExecution
 func (m *PendingChildExecutionInfo) String() string { return proto.CompactTextString(m) }
 func (*PendingChildExecutionInfo) ProtoMessage()    {}
 func (*PendingChildExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{5}
+	return fileDescriptor_2775eefb5053680f, []int{6}
 }
 func (m *PendingChildExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1101,7 @@ func (m *PendingDecisionInfo) Reset()         { *m = PendingDecisionInfo{} }
 func (m *PendingDecisionInfo) String() string { return proto.CompactTextString(m) }
 func (*PendingDecisionInfo) ProtoMessage()    {}
 func (*PendingDecisionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{6}
+	return fileDescriptor_2775eefb5053680f, []int{7}
 }
 func (m *PendingDecisionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1109,7 +1180,7 @@ func (m *ActivityLocalDispatchInfo) Reset()         { *m = ActivityLocalDispatch
 func (m *ActivityLocalDispatchInfo) String() string { return proto.CompactTextString(m) }
 func (*ActivityLocalDispatchInfo) ProtoMessage()    {}
 func (*ActivityLocalDispatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{7}
+	return fileDescriptor_2775eefb5053680f, []int{8}
 }
 func (m *ActivityLocalDispatchInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1184,7 +1255,7 @@ func (m *ResetPoints) Reset()         { *m = ResetPoints{} }
 func (m *ResetPoints) String() string { return proto.CompactTextString(m) }
 func (*ResetPoints) ProtoMessage()    {}
 func (*ResetPoints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{8}
+	return fileDescriptor_2775eefb5053680f, []int{9}
 }
 func (m *ResetPoints) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1236,7 +1307,7 @@ func (m *ResetPointInfo) Reset()         { *m = ResetPointInfo{} }
 func (m *ResetPointInfo) String() string { return proto.CompactTextString(m) }
 func (*ResetPointInfo) ProtoMessage()    {}
 func (*ResetPointInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{9}
+	return fileDescriptor_2775eefb5053680f, []int{10}
 }
 func (m *ResetPointInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1325,6 +1396,7 @@ func init() {
 	proto.RegisterType((*ParentExecutionInfo)(nil), "uber.cadence.api.v1.ParentExecutionInfo")
 	proto.RegisterType((*ExternalExecutionInfo)(nil), "uber.cadence.api.v1.ExternalExecutionInfo")
 	proto.RegisterType((*PendingActivityInfo)(nil), "uber.cadence.api.v1.PendingActivityInfo")
+	proto.RegisterType((*PendingTimerInfo)(nil), "uber.cadence.api.v1.PendingTimerInfo")
 	proto.RegisterType((*PendingChildExecutionInfo)(nil), "uber.cadence.api.v1.PendingChildExecutionInfo")
 	proto.RegisterType((*PendingDecisionInfo)(nil), "uber.cadence.api.v1.PendingDecisionInfo")
 	proto.RegisterType((*ActivityLocalDispatchInfo)(nil), "uber.cadence.api.v1.ActivityLocalDispatchInfo")
@@ -1337,151 +1409,156 @@ func init() {
 }
 
 var fileDescriptor_2775eefb5053680f = []byte{
-	// 2302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x2e, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x83, 0x1e, 0xc7, 0x6b, 0x25, 0xd9, 0x38, 0x8e, 0xb6,
-	0x49, 0x1c, 0x75, 0x23, 0xaf, 0x93, 0xcd, 0xa6, 0xd9, 0x34, 0x4d, 0x69, 0x92, 0x8e, 0x99, 0xc8,
-	0x94, 0x3a, 0xa2, 0xe2, 0x78, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x92, 0x4a,
-	0xe2, 0x7b, 0x81, 0x9e, 0x7b, 0x2b, 0xda, 0x4b, 0xff, 0x80, 0x02, 0x45, 0xff, 0x80, 0xa2, 0x45,
-	0x0f, 0x45, 0x2f, 0x05, 0x7a, 0xea, 0xb1, 0x28, 0x72, 0xeb, 0x7f, 0x51, 0xcc, 0x70, 0x28, 0x51,
-	0x12, 0x25, 0x2a, 0x2d, 0xb0, 0xbd, 0x99, 0x8f, 0xdf, 0xf7, 0xf1, 0xcd, 0x9b, 0xf7, 0x3e, 0x0e,
-	0x2d, 0x28, 0x74, 0x4f, 0x88, 0xb7, 0xd3, 0xb0, 0x9a, 0xc4, 0x69, 0x90, 0x1d, 0xab, 0x63, 0xef,
-	0xbc, 0xdd, 0xdd, 0x79, 0xe7, 0x7a, 0x6f, 0x4e, 0x5b, 0xee, 0xbb, 0x52, 0xc7, 0x73, 0x03, 0x17,
-	0xad, 0x51, 0x4c, 0x89, 0x63, 0x4a, 0x56, 0xc7, 0x2e, 0xbd, 0xdd, 0xbd, 0xba, 0x79, 0xe6, 0xba,
-	0x67, 0x2d, 0xb2, 0xc3, 0x20, 0x27, 0xdd, 0xd3, 0x9d, 0x66, 0xd7, 0xb3, 0x02, 0xdb, 0x75, 0x42,
-	0xd2, 0xd5, 0x1b, 0xc3, 0xf7, 0x03, 0xbb, 0x4d, 0xfc, 0xc0, 0x6a, 0x77, 0x38, 0x60, 0x2b, 0xe9,
-	0xc9, 0x0d, 0xb7, 0xdd, 0xee, 0x49, 0x24, 0xe6, 0x16, 0x58, 0xfe, 0x9b, 0x96, 0xed, 0x07, 0x21,
-	0xa6, 0xf0, 0xa7, 0x39, 0x58, 0x3f, 0xe2, 0xe9, 0xaa, 0xef, 0x49, 0xa3, 0x4b, 0x53, 0xd0, 0x9c,
-	0x53, 0x17, 0xd5, 0x01, 0x45, 0xeb, 0x30, 0x49, 0x74, 0x27, 0x2f, 0x6c, 0x09, 0xdb, 0xb9, 0xfb,
-	0xb7, 0x4b, 0x09, 0x4b, 0x2a, 0x8d, 0xe8, 0xe0, 0xd5, 0x77, 0xc3, 0x21, 0xf4, 0x10, 0x66, 0x82,
-	0x8b, 0x0e, 0xc9, 0x67, 0x98, 0xd0, 0xcd, 0x89, 0x42, 0xc6, 0x45, 0x87, 0x60, 0x06, 0x47, 0x8f,
-	0x01, 0xfc, 0xc0, 0xf2, 0x02, 0x93, 0x96, 0x21, 0x9f, 0x65, 0xe4, 0xab, 0xa5, 0xb0, 0x46, 0xa5,
-	0xa8, 0x46, 0x25, 0x23, 0xaa, 0x11, 0x5e, 0x60, 0x68, 0x7a, 0x4d, 0xa9, 0x8d, 0x96, 0xeb, 0x93,
-	0x90, 0x3a, 0x93, 0x4e, 0x65, 0x68, 0x46, 0x35, 0x60, 0x31, 0xa4, 0xfa, 0x81, 0x15, 0x74, 0xfd,
-	0xfc, 0xec, 0x96, 0xb0, 0xbd, 0x7c, 0x7f, 0x77, 0xba, 0xd5, 0xcb, 0x94, 0x59, 0x63, 0x44, 0x9c,
-	0x6b, 0xf4, 0x2f, 0xd0, 0x2d, 0x58, 0x3e, 0xb7, 0xfd, 0xc0, 0xf5, 0x2e, 0xcc, 0x16, 0x71, 0xce,
-	0x82, 0xf3, 0xfc, 0xdc, 0x96, 0xb0, 0x9d, 0xc5, 0x4b, 0x3c, 0x5a, 0x66, 0x41, 0xf4, 0x13, 0x58,
-	0xef, 0x58, 0x1e, 0x71, 0x82, 0x7e, 0xf9, 0x4d, 0xdb, 0x39, 0x75, 0xf3, 0x97, 0xd8, 0x12, 0xb6,
-	0x13, 0xb3, 0xa8, 0x32, 0xc6, 0xc0, 0x4e, 0xe2, 0xb5, 0xce, 0x68, 0x10, 0x49, 0xb0, 0xdc, 0x97,
-	0x65, 0x95, 0x99, 0x4f, 0xad, 0xcc, 0x52, 0x8f, 0xc1, 0xaa, 0x73, 0x0f, 0x66, 0xda, 0xa4, 0xed,
-	0xe6, 0x17, 0x18, 0xf1, 0x4a, 0x62, 0x3e, 0x87, 0xa4, 0xed, 0x62, 0x06, 0x43, 0x18, 0x56, 0x7d,
-	0x62, 0x79, 0x8d, 0x73, 0xd3, 0x0a, 0x02, 0xcf, 0x3e, 0xe9, 0x06, 0xc4, 0xcf, 0x03, 0xe3, 0xde,
-	0x4a, 0xe4, 0xd6, 0x18, 0x5a, 0xea, 0x81, 0xb1, 0xe8, 0x0f, 0x45, 0x50, 0x19, 0x56, 0xad, 0x6e,
-	0xe0, 0x9a, 0x1e, 0xf1, 0x49, 0x60, 0x76, 0x5c, 0xdb, 0x09, 0xfc, 0x7c, 0x8e, 0x69, 0x6e, 0x25,
-	0x6a, 0x62, 0x0a, 0xac, 0x32, 0x1c, 0x5e, 0xa1, 0xd4, 0x58, 0x00, 0x5d, 0x83, 0x05, 0x3a, 0x1e,
-	0x26, 0x9d, 0x8f, 0xfc, 0xe2, 0x96, 0xb0, 0xbd, 0x80, 0xe7, 0x69, 0xa0, 0x6c, 0xfb, 0x01, 0xda,
-	0x80, 0x4b, 0xb6, 0x6f, 0x36, 0x3c, 0xd7, 0xc9, 0x2f, 0x6d, 0x09, 0xdb, 0xf3, 0x78, 0xce, 0xf6,
-	0x65, 0xcf, 0x75, 0x0a, 0xbf, 0xcc, 0xc0, 0xe6, 0xe8, 0xe6, 0xbb, 0xce, 0xa9, 0x7d, 0xc6, 0x47,
-	0x1a, 0x7d, 0x1d, 0x17, 0x0e, 0x47, 0xe8, 0x7a, 0x62, 0x7a, 0x06, 0x7f, 0x5a, 0xec, 0xb9, 0x16,
-	0x6c, 0xf5, 0x37, 0x8a, 0xcf, 0x80, 0x6b, 0xf6, 0x3b, 0xda, 0xed, 0x06, 0x7c, 0x98, 0xae, 0x8c,
-	0x6c, 0x9d, 0xc2, 0x13, 0xc0, 0x9f, 0xf6, 0x24, 0x6a, 0x6c, 0x2e, 0x5c, 0x39, 0xea, 0x71, 0xb7,
-	0x1b, 0xa0, 0x23, 0xb8, 0xc6, 0xd2, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0xa0, 0xec, 0x04, 0xe1,
-	0xc2, 0xdf, 0x04, 0x58, 0x4b, 0xe8, 0x48, 0x5a, 0xe8, 0xa6, 0xdb, 0xb6, 0x6c, 0xc7, 0xb4, 0x9b,
-	0xac, 0x1e, 0x0b, 0x78, 0x3e, 0x0c, 0x68, 0x4d, 0x74, 0x03, 0x72, 0xfc, 0xa6, 0x63, 0xb5, 0x43,
-	0xa3, 0x58, 0xc0, 0x10, 0x86, 0x74, 0xab, 0x4d, 0xc6, 0x38, 0x53, 0xf6, 0x7f, 0x75, 0xa6, 0x9b,
-	0xb0, 0x68, 0x3b, 0x76, 0x60, 0x5b, 0x01, 0x69, 0xd2, 0xbc, 0x66, 0xd8, 0x50, 0xe6, 0x7a, 0x31,
-	0xad, 0x59, 0xf8, 0x85, 0x00, 0xeb, 0xea, 0xfb, 0x80, 0x78, 0x8e, 0xd5, 0xfa, 0x56, 0xdc, 0x72,
-	0x38, 0xa7, 0xcc, 0x68, 0x4e, 0xff, 0x9c, 0x85, 0xb5, 0x2a, 0x71, 0x9a, 0xb6, 0x73, 0x26, 0x35,
-	0x02, 0xfb, 0xad, 0x1d, 0x5c, 0xb0, 0x8c, 0x6e, 0x40, 0xce, 0xe2, 0xd7, 0xfd, 0x2a, 0x43, 0x14,
-	0xd2, 0x9a, 0x68, 0x1f, 0x96, 0x7a, 0x80, 0x54, 0x4b, 0x8e, 0xa4, 0x99, 0x25, 0x2f, 0x5a, 0xb1,
-	0x2b, 0xf4, 0x0c, 0x66, 0xa9, 0x3d, 0x86, 0xae, 0xbc, 0x7c, 0xff, 0x6e, 0xb2, 0x2f, 0x0d, 0x66,
-	0x48, 0x9d, 0x90, 0xe0, 0x90, 0x87, 0x34, 0x58, 0x3d, 0x27, 0x96, 0x17, 0x9c, 0x10, 0x2b, 0x30,
-	0x9b, 0x24, 0xb0, 0xec, 0x96, 0xcf, 0x7d, 0xfa, 0xd3, 0x31, 0x26, 0x77, 0xd1, 0x72, 0xad, 0x26,
-	0x16, 0x7b, 0x34, 0x25, 0x64, 0xa1, 0x17, 0xb0, 0xd6, 0xb2, 0xfc, 0xc0, 0xec, 0xeb, 0x31, 0x6b,
-	0x9b, 0x4d, 0xb5, 0xb6, 0x55, 0x4a, 0x3b, 0x88, 0x58, 0xcc, 0xde, 0xf6, 0x81, 0x05, 0xc3, 0xa9,
-	0x20, 0xcd, 0x50, 0x69, 0x2e, 0x55, 0x69, 0x85, 0x92, 0x6a, 0x21, 0x87, 0xe9, 0xe4, 0xe1, 0x92,
-	0x15, 0x04, 0xa4, 0xdd, 0x09, 0x98, 0x73, 0xcf, 0xe2, 0xe8, 0x12, 0xdd, 0x05, 0xb1, 0x6d, 0xbd,
-	0xb7, 0xdb, 0xdd, 0xb6, 0xc9, 0x43, 0x3e, 0x73, 0xe1, 0x59, 0xbc, 0xc2, 0xe3, 0x12, 0x0f, 0x53,
-	0xbb, 0xf6, 0x1b, 0xe7, 0xa4, 0xd9, 0x6d, 0x45, 0x99, 0x2c, 0xa4, 0xdb, 0x75, 0x8f, 0xc1, 0xf2,
-	0x90, 0x61, 0x85, 0xbc, 0xef, 0xd8, 0xe1, 0xcc, 0x86, 0x1a, 0x90, 0xaa, 0xb1, 0xdc, 0xa7, 0x30,
-	0x91, 0x67, 0xb0, 0xc8, 0x8a, 0x72, 0x6a, 0xd9, 0xad, 0xae, 0x47, 0xb8, 0xd7, 0x26, 0x6f, 0xd3,
-	0x7e, 0x88, 0xc1, 0x39, 0xca, 0xe0, 0x17, 0xe8, 0x0b, 0xb8, 0xcc, 0x04, 0x68, 0xaf, 0x13, 0xcf,
-	0xb4, 0x9b, 0xc4, 0x09, 0xec, 0xe0, 0x82, 0xdb, 0x2d, 0xa2, 0xf7, 0x8e, 0xd8, 0x2d, 0x8d, 0xdf,
-	0x29, 0xfc, 0x3a, 0x03, 0x57, 0x78, 0xfb, 0xc8, 0xe7, 0x76, 0xab, 0xf9, 0xad, 0x0c, 0xde, 0xe7,
-	0x31, 0x59, 0x3a, 0x1c, 0x71, 0x2f, 0x12, 0xdf, 0xc5, 0xce, 0x27, 0xcc, 0x91, 0x86, 0xc7, 0x34,
-	0x3b, 0x32, 0xa6, 0xe8, 0x15, 0xf0, 0xd7, 0x30, 0x37, 0xd7, 0x8e, 0xdb, 0xb2, 0x1b, 0x17, 0xac,
-	0xcd, 0x97, 0xc7, 0x24, 0x1a, 0x3a, 0x27, 0x33, 0xd4, 0x2a, 0x43, 0xe3, 0xd5, 0xce, 0x70, 0xa8,
-	0xf0, 0x97, 0x4c, 0x6f, 0xfc, 0x15, 0xd2, 0xb0, 0xfd, 0xa8, 0x2e, 0xbd, 0xa9, 0x14, 0xd2, 0xa7,
-	0x32, 0x22, 0x0e, 0x4c, 0xe5, 0x68, 0xc7, 0x65, 0x3e, 0xb6, 0xe3, 0x9e, 0xc2, 0xe2, 0xc0, 0xf0,
-	0xa4, 0x1f, 0xdb, 0x72, 0x7e, 0xf2, 0xe0, 0xcc, 0x0c, 0x0e, 0x0e, 0x86, 0x0d, 0xd7, 0xb3, 0xcf,
-	0x6c, 0xc7, 0x6a, 0x99, 0x43, 0x49, 0xa6, 0x8f, 0xfa, 0x7a, 0x44, 0xad, 0xc5, 0x93, 0x2d, 0xfc,
-	0x21, 0x03, 0x57, 0x22, 0x7b, 0x2a, 0xbb, 0x0d, 0xab, 0xa5, 0xd8, 0x7e, 0xc7, 0x0a, 0x1a, 0xe7,
-	0xd3, 0xb9, 0xe9, 0xff, 0xbf, 0x5c, 0x3f, 0x85, 0xcd, 0xc1, 0x0c, 0x4c, 0xf7, 0xd4, 0x0c, 0xce,
-	0x6d, 0xdf, 0x8c, 0x57, 0x71, 0xb2, 0xe0, 0xd5, 0x81, 0x8c, 0x2a, 0xa7, 0xc6, 0xb9, 0xed, 0x73,
-	0x0f, 0x42, 0xd7, 0x01, 0xd8, 0x29, 0x21, 0x70, 0xdf, 0x10, 0x87, 0xd5, 0x79, 0x11, 0xb3, 0x63,
-	0x8d, 0x41, 0x03, 0x85, 0x17, 0x90, 0x8b, 0x9f, 0xa5, 0x9e, 0xc0, 0x1c, 0x3f, 0x8e, 0x09, 0x5b,
-	0xd9, 0xed, 0xdc, 0xfd, 0xcf, 0x52, 0x8e, 0x63, 0xec, 0xa4, 0xca, 0x29, 0x85, 0xdf, 0x65, 0x60,
-	0x79, 0xf0, 0x16, 0xba, 0x03, 0x2b, 0x27, 0xb6, 0x63, 0x79, 0x17, 0x66, 0xe3, 0x9c, 0x34, 0xde,
-	0xf8, 0xdd, 0x36, 0xdf, 0x84, 0xe5, 0x30, 0x2c, 0xf3, 0x28, 0x5a, 0x87, 0x39, 0xaf, 0xeb, 0x44,
-	0x2f, 0xcb, 0x05, 0x3c, 0xeb, 0x75, 0xe9, 0xa9, 0xe2, 0x29, 0x5c, 0x3b, 0xb5, 0x3d, 0x9f, 0xbe,
-	0x60, 0xc2, 0x66, 0x37, 0x1b, 0x6e, 0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0x67, 0x90, 0x68, 0x1c,
-	0xe4, 0x08, 0xc0, 0xe8, 0x8b, 0x0d, 0x8f, 0x58, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x8e, 0xe3, 0xb9,
-	0x6d, 0x2e, 0x31, 0x23, 0xb5, 0x9d, 0xb3, 0x69, 0xdb, 0x74, 0x31, 0x22, 0x30, 0x81, 0x4d, 0x00,
-	0x76, 0xc6, 0x0d, 0xac, 0x93, 0x56, 0xf8, 0x16, 0x9a, 0xc7, 0xb1, 0x48, 0xf1, 0xf7, 0x02, 0x5c,
-	0x4e, 0x7a, 0xc7, 0xa2, 0x02, 0x6c, 0x56, 0x55, 0x5d, 0xd1, 0xf4, 0xe7, 0xa6, 0x24, 0x1b, 0xda,
-	0x2b, 0xcd, 0x38, 0x36, 0x6b, 0x86, 0x64, 0xa8, 0xa6, 0xa6, 0xbf, 0x92, 0xca, 0x9a, 0x22, 0x7e,
-	0x07, 0x7d, 0x17, 0xb6, 0xc6, 0x60, 0x6a, 0xf2, 0x81, 0xaa, 0xd4, 0xcb, 0xaa, 0x22, 0x0a, 0x13,
-	0x94, 0x6a, 0x86, 0x84, 0x0d, 0x55, 0x11, 0x33, 0xe8, 0x7b, 0x70, 0x67, 0x0c, 0x46, 0x96, 0x74,
-	0x59, 0x2d, 0x9b, 0x58, 0xfd, 0x71, 0x5d, 0xad, 0x51, 0x70, 0xb6, 0xf8, 0xb3, 0x7e, 0xce, 0x03,
-	0x0e, 0x14, 0x7f, 0x92, 0xa2, 0xca, 0x5a, 0x4d, 0xab, 0xe8, 0x93, 0x72, 0x1e, 0xc2, 0x8c, 0xc9,
-	0x79, 0x18, 0x15, 0xe5, 0x5c, 0xfc, 0x79, 0xa6, 0xff, 0x09, 0xac, 0x35, 0x31, 0xe9, 0x46, 0xde,
-	0x4a, 0x9f, 0x71, 0x54, 0xc1, 0x2f, 0xf7, 0xcb, 0x95, 0x23, 0x53, 0x53, 0x4c, 0xac, 0xd6, 0x6b,
-	0xaa, 0x59, 0xad, 0x94, 0x35, 0xf9, 0x38, 0x96, 0xc9, 0xf7, 0xe1, 0xcb, 0xb1, 0x28, 0xa9, 0x4c,
-	0xa3, 0x4a, 0xbd, 0x5a, 0xd6, 0x64, 0xfa, 0xd4, 0x7d, 0x49, 0x2b, 0xab, 0x8a, 0x59, 0xd1, 0xcb,
-	0xc7, 0xa2, 0x80, 0x3e, 0x87, 0xed, 0x69, 0x99, 0x62, 0x06, 0xdd, 0x83, 0xbb, 0x63, 0xd1, 0x58,
-	0x7d, 0xa1, 0xca, 0x46, 0x0c, 0x9e, 0x45, 0xbb, 0x70, 0x6f, 0x2c, 0xdc, 0x50, 0xf1, 0xa1, 0xa6,
-	0xb3, 0x82, 0xee, 0x9b, 0xb8, 0xae, 0xeb, 0x9a, 0xfe, 0x5c, 0x9c, 0x29, 0xfe, 0x46, 0x80, 0xd5,
-	0x91, 0x97, 0x0e, 0xba, 0x01, 0xd7, 0xaa, 0x12, 0x56, 0x75, 0xc3, 0x94, 0xcb, 0x95, 0xa4, 0x02,
-	0x8c, 0x01, 0x48, 0x7b, 0x92, 0xae, 0x54, 0x74, 0x51, 0x40, 0xb7, 0xa1, 0x90, 0x04, 0xe0, 0xbd,
-	0xc0, 0x5b, 0x43, 0xcc, 0xa0, 0x9b, 0x70, 0x3d, 0x09, 0xd7, 0xcb, 0x56, 0xcc, 0x16, 0xff, 0x9d,
-	0x81, 0x4f, 0x27, 0x7d, 0x69, 0xd3, 0x0e, 0xec, 0x2d, 0x5b, 0x7d, 0xad, 0xca, 0x75, 0x83, 0xee,
-	0x79, 0xa8, 0x47, 0x77, 0xbe, 0x5e, 0x8b, 0x65, 0x1e, 0x2f, 0xe9, 0x18, 0xb0, 0x5c, 0x39, 0xac,
-	0x96, 0x55, 0x83, 0x75, 0x53, 0x11, 0x6e, 0xa7, 0xc1, 0xc3, 0x0d, 0x16, 0x33, 0x03, 0x7b, 0x3b,
-	0x4e, 0x9a, 0xad, 0x9b, 0x8e, 0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x22, 0xce, 0xa0,
-	0x2f, 0xe1, 0x8b, 0xf4, 0xc4, 0x75, 0x43, 0xd3, 0xeb, 0xaa, 0x62, 0x4a, 0x35, 0x53, 0x57, 0x8f,
-	0xc4, 0xd9, 0x69, 0x96, 0x6b, 0x68, 0x87, 0xb4, 0x3f, 0xeb, 0x86, 0x38, 0x57, 0xfc, 0xa3, 0x00,
-	0x9f, 0xc8, 0xae, 0x13, 0xd8, 0x4e, 0x97, 0x48, 0xbe, 0x4e, 0xde, 0x69, 0xe1, 0x79, 0xc6, 0xf5,
-	0xd0, 0x2d, 0xb8, 0x19, 0xe9, 0x73, 0x79, 0x53, 0xd3, 0x35, 0x43, 0x93, 0x8c, 0x0a, 0x8e, 0xd5,
-	0x77, 0x22, 0x8c, 0x0e, 0xa4, 0xa2, 0xe2, 0xb0, 0xae, 0xe3, 0x61, 0x58, 0x35, 0xf0, 0x31, 0x6f,
-	0x85, 0xd0, 0x61, 0xc6, 0x63, 0x65, 0x4c, 0xe7, 0x9b, 0xcf, 0xbf, 0x98, 0x2d, 0xfe, 0x56, 0x80,
-	0x1c, 0xff, 0x16, 0x65, 0x9f, 0x2a, 0x79, 0xb8, 0x4c, 0x17, 0x58, 0xa9, 0x1b, 0xa6, 0x71, 0x5c,
-	0x55, 0x07, 0x7b, 0x78, 0xe0, 0x0e, 0xb3, 0x07, 0xd3, 0xa8, 0x84, 0xd5, 0x09, 0x9d, 0x64, 0x10,
-	0xc0, 0x9f, 0x42, 0x31, 0x0c, 0x2c, 0x66, 0x26, 0x62, 0x42, 0x9d, 0x2c, 0xba, 0x0a, 0x9f, 0x0c,
-	0x60, 0x0e, 0x54, 0x09, 0x1b, 0x7b, 0xaa, 0x64, 0x88, 0x33, 0xc5, 0x5f, 0x09, 0x70, 0x25, 0x72,
-	0x42, 0x83, 0xbe, 0x58, 0xed, 0x36, 0x69, 0x56, 0xba, 0x81, 0x6c, 0x75, 0x7d, 0x82, 0xee, 0xc2,
-	0xad, 0x9e, 0x87, 0x19, 0x52, 0xed, 0x65, 0x7f, 0xaf, 0x4c, 0x59, 0xa2, 0xc3, 0xdd, 0x5f, 0x4d,
-	0x2a, 0x94, 0xa7, 0x20, 0x0a, 0xe8, 0x0e, 0x7c, 0x36, 0x19, 0x8a, 0xd5, 0x9a, 0x6a, 0x88, 0x99,
-	0xe2, 0x3f, 0x72, 0xb0, 0x11, 0x4f, 0x8e, 0x1e, 0xe8, 0x49, 0x33, 0x4c, 0xed, 0x36, 0x14, 0x06,
-	0x45, 0xb8, 0xcf, 0x0d, 0xe7, 0xb5, 0x0b, 0xf7, 0x26, 0xe0, 0xea, 0xfa, 0x81, 0xa4, 0x2b, 0xf4,
-	0x3a, 0x02, 0x89, 0x02, 0x7a, 0x06, 0x4f, 0x26, 0x50, 0xf6, 0x24, 0xa5, 0x5f, 0xe5, 0xde, 0x1b,
-	0x47, 0x32, 0x0c, 0xac, 0xed, 0xd5, 0x0d, 0xb5, 0x26, 0x66, 0x90, 0x0a, 0x52, 0x8a, 0xc0, 0xa0,
-	0x0f, 0x25, 0xca, 0x64, 0xd1, 0x63, 0x78, 0x98, 0x96, 0x47, 0xd8, 0x32, 0xda, 0xa1, 0x8a, 0xe3,
-	0xd4, 0x19, 0xf4, 0x35, 0x7c, 0x95, 0x42, 0xe5, 0x4f, 0x1e, 0xe1, 0xce, 0xa2, 0x27, 0xf0, 0x28,
-	0x35, 0x7b, 0xb9, 0x82, 0x15, 0xf3, 0x50, 0xc2, 0x2f, 0x07, 0xc9, 0x73, 0x48, 0x03, 0x35, 0xed,
-	0xc1, 0xdc, 0xdd, 0xcc, 0x04, 0x5f, 0x88, 0x49, 0x5d, 0x9a, 0xa2, 0x8a, 0x34, 0x90, 0x22, 0x33,
-	0x8f, 0x9e, 0x83, 0x3c, 0x5d, 0x29, 0x26, 0x0b, 0x2d, 0xa0, 0xd7, 0x60, 0x7c, 0xdc, 0xae, 0xaa,
-	0xaf, 0x0d, 0x15, 0xeb, 0x52, 0x9a, 0x32, 0xa0, 0xa7, 0xf0, 0x38, 0xb5, 0x68, 0x83, 0xfe, 0x13,
-	0xa3, 0xe7, 0xd0, 0x23, 0x78, 0x30, 0x81, 0x1e, 0xef, 0x91, 0xfe, 0xa9, 0x40, 0x53, 0xc4, 0x45,
-	0xf4, 0x10, 0x76, 0x27, 0x10, 0xd9, 0x14, 0x9a, 0x35, 0x43, 0x93, 0x5f, 0x1e, 0x87, 0xb7, 0xcb,
-	0x5a, 0xcd, 0x10, 0x97, 0xd0, 0x8f, 0xe0, 0x07, 0x13, 0x68, 0xbd, 0xc5, 0xd2, 0x3f, 0x54, 0x1c,
-	0x1b, 0x31, 0x0a, 0xab, 0x63, 0x55, 0x5c, 0x9e, 0x62, 0x4f, 0x6a, 0xda, 0xf3, 0xf4, 0xca, 0xad,
-	0x20, 0x19, 0x9e, 0x4d, 0x35, 0x22, 0xf2, 0x81, 0x56, 0x56, 0x92, 0x45, 0x44, 0xf4, 0x00, 0x76,
-	0x26, 0x88, 0xec, 0x57, 0xb0, 0xac, 0xf2, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x57, 0x70, 0x7f,
-	0x12, 0x49, 0xd2, 0xca, 0x95, 0x57, 0x2a, 0x1e, 0xe6, 0x21, 0xfa, 0x1a, 0x9d, 0x6e, 0xe9, 0x9a,
-	0x5e, 0xad, 0x1b, 0x66, 0x4d, 0xfb, 0x46, 0x15, 0xd7, 0xe8, 0x6b, 0x34, 0x75, 0xa7, 0xa2, 0x5a,
-	0x89, 0x97, 0x47, 0xcd, 0x78, 0xe4, 0x21, 0x7b, 0x9a, 0x2e, 0xe1, 0x63, 0x71, 0x3d, 0xa5, 0xf7,
-	0x46, 0x8d, 0x6e, 0xa0, 0x85, 0x3e, 0x99, 0x66, 0x39, 0xaa, 0x84, 0xe5, 0x83, 0x78, 0xc5, 0x37,
-	0xe8, 0x5b, 0xe7, 0x26, 0xfb, 0xc7, 0xca, 0xc8, 0xb9, 0x2a, 0x6e, 0xf1, 0xbb, 0x70, 0x2f, 0xdc,
-	0xb7, 0x84, 0x2e, 0x18, 0xe3, 0xf6, 0x7b, 0xf0, 0xc3, 0xe9, 0x28, 0xbd, 0xfb, 0x52, 0x19, 0xab,
-	0x92, 0x72, 0xdc, 0x3b, 0x92, 0x0a, 0xc5, 0x3f, 0x0b, 0x50, 0x94, 0x2d, 0xa7, 0x41, 0x5a, 0xd1,
-	0xff, 0x5d, 0x27, 0x66, 0xf9, 0x04, 0x1e, 0x4d, 0x31, 0xef, 0x63, 0xf2, 0x3d, 0x82, 0xda, 0xc7,
-	0x92, 0xeb, 0xfa, 0x4b, 0xbd, 0x72, 0xa4, 0x4f, 0x22, 0xf0, 0x45, 0xd4, 0xec, 0x33, 0xf6, 0x4f,
-	0xe3, 0xe9, 0x16, 0xc1, 0xdb, 0xee, 0xbf, 0x5b, 0xc4, 0xc7, 0x92, 0xa7, 0x5a, 0xc4, 0xde, 0xc9,
-	0x5f, 0x3f, 0x6c, 0x0a, 0x7f, 0xff, 0xb0, 0x29, 0xfc, 0xeb, 0xc3, 0xa6, 0x00, 0x1b, 0x0d, 0xb7,
-	0x9d, 0xf4, 0x45, 0xbf, 0x37, 0x2f, 0x75, 0xec, 0x2a, 0xfd, 0x9a, 0xad, 0x0a, 0xdf, 0xec, 0x9c,
-	0xd9, 0xc1, 0x79, 0xf7, 0xa4, 0xd4, 0x70, 0xdb, 0x3b, 0x03, 0xbf, 0x45, 0x96, 0xce, 0x88, 0x13,
-	0xfe, 0xb2, 0xc9, 0x7f, 0x96, 0x7c, 0x62, 0x75, 0xec, 0xb7, 0xbb, 0x27, 0x73, 0x2c, 0xf6, 0xe0,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xa4, 0xf1, 0x62, 0x56, 0x1d, 0x00, 0x00,
+	// 2370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xd8,
+	0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0xfa, 0x3a, 0x1e, 0xcb, 0xc9, 0xc4, 0x71, 0x34,
+	0x6f, 0x12, 0x47, 0x9d, 0xc8, 0xe3, 0x64, 0x32, 0x69, 0xc6, 0x4d, 0x53, 0x9a, 0xa4, 0x63, 0x26,
+	0x32, 0xa5, 0x5e, 0x52, 0x71, 0x3c, 0x28, 0x4a, 0xd0, 0xd2, 0xb5, 0x45, 0x44, 0x22, 0x05, 0x92,
+	0x4a, 0xe2, 0x7d, 0x81, 0xae, 0x0b, 0x74, 0xd5, 0x6e, 0xfa, 0x03, 0x0a, 0x14, 0xfd, 0x01, 0x45,
+	0x8b, 0x2e, 0x8a, 0x6e, 0x0a, 0x74, 0xd5, 0xe5, 0xa0, 0xc8, 0xae, 0xff, 0xa2, 0xb8, 0x97, 0x1f,
+	0xa2, 0x3e, 0xa9, 0xb4, 0xc0, 0x74, 0x67, 0x1e, 0x3e, 0xcf, 0xb9, 0xe7, 0x9e, 0x8f, 0xe7, 0x5e,
+	0xca, 0x50, 0xe8, 0x9e, 0x11, 0x77, 0xb7, 0x6e, 0x36, 0x88, 0x5d, 0x27, 0xbb, 0x66, 0xc7, 0xda,
+	0x7d, 0xbb, 0xb7, 0xfb, 0xce, 0x71, 0xdf, 0x9c, 0xb7, 0x9c, 0x77, 0xa5, 0x8e, 0xeb, 0xf8, 0x0e,
+	0x5a, 0xa3, 0x98, 0x52, 0x88, 0x29, 0x99, 0x1d, 0xab, 0xf4, 0x76, 0xef, 0xda, 0xd6, 0x85, 0xe3,
+	0x5c, 0xb4, 0xc8, 0x2e, 0x83, 0x9c, 0x75, 0xcf, 0x77, 0x1b, 0x5d, 0xd7, 0xf4, 0x2d, 0xc7, 0x0e,
+	0x48, 0xd7, 0x6e, 0x0e, 0xbe, 0xf7, 0xad, 0x36, 0xf1, 0x7c, 0xb3, 0xdd, 0x09, 0x01, 0xdb, 0xa3,
+	0x56, 0xae, 0x3b, 0xed, 0x76, 0xec, 0x62, 0x64, 0x6c, 0xbe, 0xe9, 0xbd, 0x69, 0x59, 0x9e, 0x1f,
+	0x60, 0x0a, 0x7f, 0x9a, 0x83, 0xf5, 0x93, 0x30, 0x5c, 0xf9, 0x3d, 0xa9, 0x77, 0x69, 0x08, 0x8a,
+	0x7d, 0xee, 0xa0, 0x1a, 0xa0, 0x68, 0x1f, 0x06, 0x89, 0xde, 0xe4, 0xb9, 0x6d, 0x6e, 0x27, 0xf7,
+	0xe0, 0x4e, 0x69, 0xc4, 0x96, 0x4a, 0x43, 0x7e, 0xf0, 0xea, 0xbb, 0x41, 0x13, 0x7a, 0x04, 0x33,
+	0xfe, 0x65, 0x87, 0xe4, 0x33, 0xcc, 0xd1, 0xad, 0x89, 0x8e, 0xf4, 0xcb, 0x0e, 0xc1, 0x0c, 0x8e,
+	0x9e, 0x00, 0x78, 0xbe, 0xe9, 0xfa, 0x06, 0x4d, 0x43, 0x3e, 0xcb, 0xc8, 0xd7, 0x4a, 0x41, 0x8e,
+	0x4a, 0x51, 0x8e, 0x4a, 0x7a, 0x94, 0x23, 0xbc, 0xc0, 0xd0, 0xf4, 0x99, 0x52, 0xeb, 0x2d, 0xc7,
+	0x23, 0x01, 0x75, 0x26, 0x9d, 0xca, 0xd0, 0x8c, 0xaa, 0xc3, 0x62, 0x40, 0xf5, 0x7c, 0xd3, 0xef,
+	0x7a, 0xf9, 0xd9, 0x6d, 0x6e, 0x67, 0xf9, 0xc1, 0xde, 0x74, 0xbb, 0x17, 0x29, 0x53, 0x63, 0x44,
+	0x9c, 0xab, 0xf7, 0x1e, 0xd0, 0x6d, 0x58, 0x6e, 0x5a, 0x9e, 0xef, 0xb8, 0x97, 0x46, 0x8b, 0xd8,
+	0x17, 0x7e, 0x33, 0x3f, 0xb7, 0xcd, 0xed, 0x64, 0xf1, 0x52, 0x68, 0x2d, 0x33, 0x23, 0xfa, 0x09,
+	0xac, 0x77, 0x4c, 0x97, 0xd8, 0x7e, 0x2f, 0xfd, 0x86, 0x65, 0x9f, 0x3b, 0xf9, 0x2b, 0x6c, 0x0b,
+	0x3b, 0x23, 0xa3, 0xa8, 0x32, 0x46, 0x5f, 0x25, 0xf1, 0x5a, 0x67, 0xd8, 0x88, 0x04, 0x58, 0xee,
+	0xb9, 0x65, 0x99, 0x99, 0x4f, 0xcd, 0xcc, 0x52, 0xcc, 0x60, 0xd9, 0xb9, 0x0f, 0x33, 0x6d, 0xd2,
+	0x76, 0xf2, 0x0b, 0x8c, 0xb8, 0x39, 0x32, 0x9e, 0x63, 0xd2, 0x76, 0x30, 0x83, 0x21, 0x0c, 0xab,
+	0x1e, 0x31, 0xdd, 0x7a, 0xd3, 0x30, 0x7d, 0xdf, 0xb5, 0xce, 0xba, 0x3e, 0xf1, 0xf2, 0xc0, 0xb8,
+	0xb7, 0x47, 0x72, 0x35, 0x86, 0x16, 0x62, 0x30, 0xe6, 0xbd, 0x01, 0x0b, 0x2a, 0xc3, 0xaa, 0xd9,
+	0xf5, 0x1d, 0xc3, 0x25, 0x1e, 0xf1, 0x8d, 0x8e, 0x63, 0xd9, 0xbe, 0x97, 0xcf, 0x31, 0x9f, 0xdb,
+	0x23, 0x7d, 0x62, 0x0a, 0xac, 0x32, 0x1c, 0x5e, 0xa1, 0xd4, 0x84, 0x01, 0x5d, 0x87, 0x05, 0x3a,
+	0x1e, 0x06, 0x9d, 0x8f, 0xfc, 0xe2, 0x36, 0xb7, 0xb3, 0x80, 0xe7, 0xa9, 0xa1, 0x6c, 0x79, 0x3e,
+	0xda, 0x80, 0x2b, 0x96, 0x67, 0xd4, 0x5d, 0xc7, 0xce, 0x2f, 0x6d, 0x73, 0x3b, 0xf3, 0x78, 0xce,
+	0xf2, 0x44, 0xd7, 0xb1, 0x0b, 0xdf, 0x66, 0x60, 0x6b, 0xb8, 0xf8, 0x8e, 0x7d, 0x6e, 0x5d, 0x84,
+	0x23, 0x8d, 0xbe, 0x4e, 0x3a, 0x0e, 0x46, 0xe8, 0xc6, 0xc8, 0xf0, 0xf4, 0x70, 0xb5, 0xc4, 0xba,
+	0x26, 0x6c, 0xf7, 0x0a, 0x15, 0xce, 0x80, 0x63, 0xf4, 0x3a, 0xda, 0xe9, 0xfa, 0xe1, 0x30, 0x6d,
+	0x0e, 0x95, 0x4e, 0x0a, 0x03, 0xc0, 0x9f, 0xc6, 0x2e, 0x34, 0x36, 0x17, 0x8e, 0x18, 0xf5, 0xb8,
+	0xd3, 0xf5, 0xd1, 0x09, 0x5c, 0x67, 0xe1, 0x8d, 0xf1, 0x9e, 0x4d, 0xf3, 0xbe, 0x41, 0xd9, 0xa3,
+	0x1c, 0x4b, 0xc0, 0xbb, 0xc4, 0x27, 0x36, 0x8b, 0xbd, 0x43, 0x5c, 0xcb, 0x69, 0xe4, 0x67, 0xd2,
+	0xbc, 0xad, 0xc4, 0x94, 0x2a, 0x63, 0x14, 0xfe, 0xc6, 0xc1, 0xda, 0x88, 0xbe, 0xa6, 0xe5, 0x6a,
+	0x38, 0x6d, 0xd3, 0xb2, 0x0d, 0xab, 0xc1, 0xb2, 0xba, 0x80, 0xe7, 0x03, 0x83, 0xd2, 0x40, 0x37,
+	0x21, 0x17, 0xbe, 0xb4, 0xcd, 0x76, 0x20, 0x37, 0x0b, 0x18, 0x02, 0x93, 0x6a, 0xb6, 0xc9, 0x18,
+	0x7d, 0xcb, 0xfe, 0xb7, 0xfa, 0x76, 0x0b, 0x16, 0x2d, 0xdb, 0xf2, 0x2d, 0xd3, 0x27, 0x0d, 0xc3,
+	0x0a, 0xb6, 0x9b, 0xc5, 0xb9, 0xd8, 0xa6, 0x34, 0x0a, 0xbf, 0xe0, 0x60, 0x5d, 0x7e, 0xef, 0x13,
+	0xd7, 0x36, 0x5b, 0xdf, 0x89, 0xe6, 0x0e, 0xc6, 0x94, 0x19, 0x8e, 0xe9, 0xdb, 0x59, 0x58, 0xab,
+	0x12, 0xbb, 0x61, 0xd9, 0x17, 0x42, 0xdd, 0xb7, 0xde, 0x5a, 0xfe, 0x25, 0x8b, 0xe8, 0x26, 0xe4,
+	0xcc, 0xf0, 0xb9, 0x97, 0x65, 0x88, 0x4c, 0x4a, 0x03, 0x1d, 0xc2, 0x52, 0x0c, 0x48, 0x15, 0xf6,
+	0xc8, 0x35, 0x13, 0xf6, 0x45, 0x33, 0xf1, 0x84, 0x9e, 0xc1, 0x2c, 0x15, 0xd9, 0x40, 0xdb, 0x97,
+	0x1f, 0xdc, 0x1b, 0xad, 0x6e, 0xfd, 0x11, 0x52, 0x3d, 0x25, 0x38, 0xe0, 0x21, 0x05, 0x56, 0x9b,
+	0xc4, 0x74, 0xfd, 0x33, 0x62, 0xfa, 0x46, 0x83, 0xf8, 0xa6, 0xd5, 0xf2, 0xc2, 0x66, 0xfb, 0x74,
+	0x8c, 0x54, 0x5e, 0xb6, 0x1c, 0xb3, 0x81, 0xf9, 0x98, 0x26, 0x05, 0x2c, 0xf4, 0x02, 0xd6, 0x5a,
+	0xa6, 0xe7, 0x1b, 0x3d, 0x7f, 0x4c, 0x20, 0x67, 0x53, 0x05, 0x72, 0x95, 0xd2, 0x8e, 0x22, 0x16,
+	0xb5, 0xa3, 0x43, 0x60, 0xc6, 0x60, 0xb6, 0x48, 0x23, 0xf0, 0x34, 0x97, 0xea, 0x69, 0x85, 0x92,
+	0xb4, 0x80, 0xc3, 0xfc, 0xe4, 0xe1, 0x8a, 0xe9, 0xfb, 0xa4, 0xdd, 0xf1, 0x99, 0xfe, 0xcf, 0xe2,
+	0xe8, 0x11, 0xdd, 0x03, 0xbe, 0x6d, 0xbe, 0xb7, 0xda, 0xdd, 0xb6, 0x11, 0x9a, 0x3c, 0xa6, 0xe5,
+	0xb3, 0x78, 0x25, 0xb4, 0x0b, 0xa1, 0x99, 0x8a, 0xbe, 0x57, 0x6f, 0x92, 0x46, 0xb7, 0x15, 0x45,
+	0xb2, 0x90, 0x2e, 0xfa, 0x31, 0x83, 0xc5, 0x21, 0xc2, 0x0a, 0x79, 0xdf, 0xb1, 0x82, 0x59, 0x0d,
+	0x7c, 0x40, 0xaa, 0x8f, 0xe5, 0x1e, 0x85, 0x39, 0x79, 0x06, 0x8b, 0x2c, 0x29, 0xe7, 0xa6, 0xd5,
+	0xea, 0xba, 0x24, 0x9f, 0x9b, 0x50, 0xa6, 0xc3, 0x00, 0x83, 0x73, 0x94, 0x11, 0x3e, 0xa0, 0x2f,
+	0xe0, 0x2a, 0x73, 0x40, 0x7b, 0x9d, 0xb8, 0x86, 0xd5, 0xa0, 0x82, 0xe1, 0x5f, 0x86, 0xa2, 0x8d,
+	0xe8, 0xbb, 0x13, 0xf6, 0x4a, 0x09, 0xdf, 0x14, 0x7e, 0xc9, 0x01, 0x1f, 0xb6, 0x0f, 0x0d, 0xc1,
+	0x65, 0xdd, 0xbd, 0x09, 0xf3, 0x74, 0x07, 0x6e, 0xaf, 0xb5, 0xaf, 0xb0, 0x67, 0xa5, 0x81, 0x76,
+	0x80, 0x8f, 0x4a, 0x46, 0xde, 0xd2, 0x43, 0x38, 0x9e, 0x9b, 0xe5, 0xd0, 0x2e, 0x53, 0xb3, 0xd2,
+	0x40, 0xfb, 0x90, 0x63, 0xdb, 0xbb, 0x9c, 0xf6, 0x6e, 0x02, 0x01, 0x9c, 0x1a, 0x0a, 0xbf, 0xce,
+	0xc0, 0x66, 0x18, 0x96, 0xd8, 0xb4, 0x5a, 0x8d, 0xef, 0x44, 0x0f, 0x3e, 0x4f, 0xb8, 0xa5, 0x33,
+	0x9b, 0x94, 0x48, 0xfe, 0x5d, 0xe2, 0xf2, 0xc5, 0x84, 0x72, 0x50, 0x3d, 0xb2, 0x43, 0xea, 0x81,
+	0x5e, 0x41, 0x78, 0xc7, 0x08, 0x4f, 0x8e, 0x8e, 0xd3, 0xb2, 0xea, 0x97, 0x6c, 0xfa, 0x96, 0xc7,
+	0x04, 0x1a, 0x08, 0x3a, 0x3b, 0x2d, 0xaa, 0x0c, 0x8d, 0x57, 0x3b, 0x83, 0xa6, 0xc2, 0x5f, 0x32,
+	0xb1, 0x2a, 0x49, 0xa4, 0x6e, 0x79, 0x51, 0x5e, 0x62, 0xb1, 0xe0, 0xd2, 0xc5, 0x22, 0x22, 0xf6,
+	0x89, 0xc5, 0xf0, 0x20, 0x64, 0x3e, 0x76, 0x10, 0x9e, 0xc2, 0x62, 0xdf, 0x4c, 0xa7, 0xd7, 0x3d,
+	0xe7, 0x8d, 0x9e, 0xe7, 0x99, 0xfe, 0x79, 0xc6, 0xb0, 0xe1, 0xb8, 0xd6, 0x85, 0x65, 0x9b, 0x2d,
+	0x63, 0x20, 0xc8, 0x74, 0x05, 0x5a, 0x8f, 0xa8, 0x5a, 0x32, 0xd8, 0xc2, 0x1f, 0x32, 0xb0, 0x19,
+	0xa9, 0x66, 0xd9, 0xa9, 0x9b, 0x2d, 0xc9, 0xf2, 0x3a, 0xa6, 0x5f, 0x6f, 0x4e, 0x27, 0xf2, 0xff,
+	0xfb, 0x74, 0xfd, 0x14, 0xb6, 0xfa, 0x23, 0x30, 0x9c, 0x73, 0xc3, 0x6f, 0x5a, 0x9e, 0x91, 0xcc,
+	0xe2, 0x64, 0x87, 0xd7, 0xfa, 0x22, 0xaa, 0x9c, 0xeb, 0x4d, 0xcb, 0x0b, 0xa5, 0x11, 0xdd, 0x00,
+	0x60, 0x57, 0x20, 0xdf, 0x79, 0x43, 0x6c, 0x96, 0xe7, 0x45, 0xcc, 0xee, 0x6c, 0x3a, 0x35, 0x14,
+	0x5e, 0x40, 0x2e, 0x79, 0x51, 0xdc, 0x87, 0xb9, 0xf0, 0xae, 0xc9, 0x6d, 0x67, 0x77, 0x72, 0x0f,
+	0x3e, 0x4b, 0xb9, 0x6b, 0xb2, 0x6b, 0x78, 0x48, 0x29, 0xfc, 0x2e, 0x03, 0xcb, 0xfd, 0xaf, 0xd0,
+	0x5d, 0x58, 0x39, 0xb3, 0x6c, 0xd3, 0xbd, 0x34, 0xea, 0x4d, 0x52, 0x7f, 0xe3, 0x75, 0xdb, 0x61,
+	0x11, 0x96, 0x03, 0xb3, 0x18, 0x5a, 0xd1, 0x3a, 0xcc, 0xb9, 0x5d, 0x3b, 0xd2, 0xa2, 0x05, 0x3c,
+	0xeb, 0x76, 0xe9, 0x65, 0xe7, 0x29, 0x5c, 0x3f, 0xb7, 0x5c, 0x8f, 0x9e, 0x7b, 0x41, 0xb3, 0x1b,
+	0x75, 0xa7, 0xdd, 0x69, 0x91, 0xbe, 0x89, 0xcd, 0x33, 0x48, 0x34, 0x0e, 0x62, 0x04, 0x60, 0xf4,
+	0xc5, 0xba, 0x4b, 0xcc, 0xb8, 0x36, 0xe9, 0xa9, 0xcc, 0x85, 0xf8, 0x50, 0xcd, 0x97, 0x98, 0xa2,
+	0x59, 0xf6, 0xc5, 0xb4, 0x6d, 0xba, 0x18, 0x11, 0x98, 0x83, 0x2d, 0x00, 0x76, 0x81, 0xf7, 0xcd,
+	0xb3, 0x56, 0x70, 0x38, 0xce, 0xe3, 0x84, 0xa5, 0xf8, 0x7b, 0x0e, 0xae, 0x8e, 0x3a, 0xfa, 0x51,
+	0x01, 0xb6, 0xaa, 0xb2, 0x2a, 0x29, 0xea, 0x73, 0x43, 0x10, 0x75, 0xe5, 0x95, 0xa2, 0x9f, 0x1a,
+	0x9a, 0x2e, 0xe8, 0xb2, 0xa1, 0xa8, 0xaf, 0x84, 0xb2, 0x22, 0xf1, 0xff, 0x87, 0xfe, 0x1f, 0xb6,
+	0xc7, 0x60, 0x34, 0xf1, 0x48, 0x96, 0x6a, 0x65, 0x59, 0xe2, 0xb9, 0x09, 0x9e, 0x34, 0x5d, 0xc0,
+	0xba, 0x2c, 0xf1, 0x19, 0xf4, 0x3d, 0xb8, 0x3b, 0x06, 0x23, 0x0a, 0xaa, 0x28, 0x97, 0x0d, 0x2c,
+	0xff, 0xb8, 0x26, 0x6b, 0x14, 0x9c, 0x2d, 0xfe, 0xac, 0x17, 0x73, 0x9f, 0x02, 0x25, 0x57, 0x92,
+	0x64, 0x51, 0xd1, 0x94, 0x8a, 0x3a, 0x29, 0xe6, 0x01, 0xcc, 0x98, 0x98, 0x07, 0x51, 0x51, 0xcc,
+	0xc5, 0x9f, 0x67, 0x7a, 0xdf, 0xf7, 0x4a, 0x03, 0x93, 0x6e, 0xa4, 0xad, 0x74, 0x8d, 0x93, 0x0a,
+	0x7e, 0x79, 0x58, 0xae, 0x9c, 0x18, 0x8a, 0x64, 0x60, 0xb9, 0xa6, 0xc9, 0x46, 0xb5, 0x52, 0x56,
+	0xc4, 0xd3, 0x44, 0x24, 0xdf, 0x87, 0x2f, 0xc7, 0xa2, 0x84, 0x32, 0xb5, 0x4a, 0xb5, 0x6a, 0x59,
+	0x11, 0xe9, 0xaa, 0x87, 0x82, 0x52, 0x96, 0x25, 0xa3, 0xa2, 0x96, 0x4f, 0x79, 0x0e, 0x7d, 0x0e,
+	0x3b, 0xd3, 0x32, 0xf9, 0x0c, 0xba, 0x0f, 0xf7, 0xc6, 0xa2, 0xb1, 0xfc, 0x42, 0x16, 0xf5, 0x04,
+	0x3c, 0x8b, 0xf6, 0xe0, 0xfe, 0x58, 0xb8, 0x2e, 0xe3, 0x63, 0x45, 0x65, 0x09, 0x3d, 0x34, 0x70,
+	0x4d, 0x55, 0x15, 0xf5, 0x39, 0x3f, 0x53, 0xfc, 0x0d, 0x07, 0xab, 0x43, 0x87, 0x0e, 0xba, 0x09,
+	0xd7, 0xab, 0x02, 0x96, 0x55, 0xdd, 0x10, 0xcb, 0x95, 0x51, 0x09, 0x18, 0x03, 0x10, 0x0e, 0x04,
+	0x55, 0xaa, 0xa8, 0x3c, 0x87, 0xee, 0x40, 0x61, 0x14, 0x20, 0xec, 0x85, 0xb0, 0x35, 0xf8, 0x0c,
+	0xba, 0x05, 0x37, 0x46, 0xe1, 0xe2, 0x68, 0xf9, 0x6c, 0xf1, 0x5f, 0x19, 0xf8, 0x74, 0xd2, 0xcf,
+	0x08, 0xb4, 0x03, 0xe3, 0x6d, 0xcb, 0xaf, 0x65, 0xb1, 0xa6, 0xd3, 0x9a, 0x07, 0xfe, 0x68, 0xe5,
+	0x6b, 0x5a, 0x22, 0xf2, 0x64, 0x4a, 0xc7, 0x80, 0xc5, 0xca, 0x71, 0xb5, 0x2c, 0xeb, 0xac, 0x9b,
+	0x8a, 0x70, 0x27, 0x0d, 0x1e, 0x14, 0x98, 0xcf, 0xf4, 0xd5, 0x76, 0x9c, 0x6b, 0xb6, 0x6f, 0x3a,
+	0x0a, 0xa8, 0x04, 0xc5, 0x34, 0x74, 0x9c, 0x05, 0x89, 0x9f, 0x41, 0x5f, 0xc2, 0x17, 0xe9, 0x81,
+	0xab, 0xba, 0xa2, 0xd6, 0x64, 0xc9, 0x10, 0x34, 0x43, 0x95, 0x4f, 0xf8, 0xd9, 0x69, 0xb6, 0xab,
+	0x2b, 0xc7, 0xb4, 0x3f, 0x6b, 0x3a, 0x3f, 0x57, 0xfc, 0x23, 0x07, 0x9f, 0x88, 0x8e, 0xed, 0x5b,
+	0x76, 0x97, 0x08, 0x9e, 0x4a, 0xde, 0x29, 0xc1, 0x7d, 0xc6, 0x71, 0xd1, 0x6d, 0xb8, 0x15, 0xf9,
+	0x0f, 0xdd, 0x1b, 0x8a, 0xaa, 0xe8, 0x8a, 0xa0, 0x57, 0x70, 0x22, 0xbf, 0x13, 0x61, 0x74, 0x20,
+	0x25, 0x19, 0x07, 0x79, 0x1d, 0x0f, 0xc3, 0xb2, 0x8e, 0x4f, 0xc3, 0x56, 0x08, 0x14, 0x66, 0x3c,
+	0x56, 0xc4, 0x15, 0x35, 0x9e, 0x7f, 0x3e, 0x5b, 0xfc, 0x2d, 0x07, 0xb9, 0xf0, 0x43, 0x9b, 0x7d,
+	0x41, 0xe5, 0xe1, 0x2a, 0xdd, 0x60, 0xa5, 0xa6, 0x1b, 0xfa, 0x69, 0x55, 0xee, 0xef, 0xe1, 0xbe,
+	0x37, 0x4c, 0x1e, 0x0c, 0xbd, 0x12, 0x64, 0x27, 0x50, 0x92, 0x7e, 0x40, 0xb8, 0x0a, 0xc5, 0x30,
+	0x30, 0x9f, 0x99, 0x88, 0x09, 0xfc, 0x64, 0xd1, 0x35, 0xf8, 0xa4, 0x0f, 0x73, 0x24, 0x0b, 0x58,
+	0x3f, 0x90, 0x05, 0x9d, 0x9f, 0x29, 0xfe, 0x8a, 0x83, 0xcd, 0x48, 0x09, 0xe9, 0xcf, 0x1c, 0x34,
+	0xf4, 0x46, 0xa5, 0xeb, 0x8b, 0x66, 0xd7, 0x23, 0xe8, 0x1e, 0xdc, 0x8e, 0x35, 0x4c, 0x17, 0xb4,
+	0x97, 0xbd, 0x5a, 0x19, 0xa2, 0x50, 0xd3, 0x92, 0xbb, 0x49, 0x85, 0x86, 0x21, 0xf0, 0x1c, 0xba,
+	0x0b, 0x9f, 0x4d, 0x86, 0x62, 0x59, 0x93, 0x75, 0x3e, 0x53, 0xfc, 0x47, 0x0e, 0x36, 0x92, 0xc1,
+	0xd1, 0xef, 0x0c, 0xd2, 0x08, 0x42, 0xbb, 0x03, 0x85, 0x7e, 0x27, 0xa1, 0xce, 0x0d, 0xc6, 0xb5,
+	0x07, 0xf7, 0x27, 0xe0, 0x6a, 0xea, 0x91, 0xa0, 0x4a, 0xf4, 0x39, 0x02, 0xf1, 0x1c, 0x7a, 0x06,
+	0xfb, 0x13, 0x28, 0x07, 0x82, 0xd4, 0xcb, 0x72, 0x7c, 0xe2, 0x08, 0xba, 0x8e, 0x95, 0x83, 0x9a,
+	0x2e, 0x6b, 0x7c, 0x06, 0xc9, 0x20, 0xa4, 0x38, 0xe8, 0xd7, 0xa1, 0x91, 0x6e, 0xb2, 0xe8, 0x09,
+	0x3c, 0x4a, 0x8b, 0x23, 0x68, 0x19, 0xe5, 0x58, 0xc6, 0x49, 0xea, 0x0c, 0xfa, 0x1a, 0xbe, 0x4a,
+	0xa1, 0x86, 0x2b, 0x0f, 0x71, 0x67, 0xd1, 0x3e, 0x3c, 0x4e, 0x8d, 0x5e, 0xac, 0x60, 0xc9, 0x38,
+	0x16, 0xf0, 0xcb, 0x7e, 0xf2, 0x1c, 0x52, 0x40, 0x4e, 0x5b, 0x38, 0x54, 0x37, 0x63, 0x84, 0x2e,
+	0x24, 0x5c, 0x5d, 0x99, 0x22, 0x8b, 0xd4, 0x90, 0xe2, 0x66, 0x1e, 0x3d, 0x07, 0x71, 0xba, 0x54,
+	0x4c, 0x76, 0xb4, 0x80, 0x5e, 0x83, 0xfe, 0x71, 0x55, 0x95, 0x5f, 0xeb, 0x32, 0x56, 0x85, 0x34,
+	0xcf, 0x80, 0x9e, 0xc2, 0x93, 0xd4, 0xa4, 0xf5, 0xeb, 0x4f, 0x82, 0x9e, 0x43, 0x8f, 0xe1, 0xe1,
+	0x04, 0x7a, 0xb2, 0x47, 0x7a, 0xb7, 0x02, 0x45, 0xe2, 0x17, 0xd1, 0x23, 0xd8, 0x9b, 0x40, 0x64,
+	0x53, 0x68, 0x68, 0xba, 0x22, 0xbe, 0x3c, 0x0d, 0x5e, 0x97, 0x15, 0x4d, 0xe7, 0x97, 0xd0, 0x8f,
+	0xe0, 0x07, 0x13, 0x68, 0xf1, 0x66, 0xe9, 0x1f, 0x32, 0x4e, 0x8c, 0x18, 0x85, 0xd5, 0xb0, 0xcc,
+	0x2f, 0x4f, 0x51, 0x13, 0x4d, 0x79, 0x9e, 0x9e, 0xb9, 0x15, 0x24, 0xc2, 0xb3, 0xa9, 0x46, 0x44,
+	0x3c, 0x52, 0xca, 0xd2, 0x68, 0x27, 0x3c, 0x7a, 0x08, 0xbb, 0x13, 0x9c, 0x1c, 0x56, 0xb0, 0x28,
+	0x87, 0x27, 0x56, 0x2c, 0x12, 0xab, 0xe8, 0x2b, 0x78, 0x30, 0x89, 0x24, 0x28, 0xe5, 0xca, 0x2b,
+	0x19, 0x0f, 0xf2, 0x10, 0x3d, 0x46, 0xa7, 0xdb, 0xba, 0xa2, 0x56, 0x6b, 0xba, 0xa1, 0x29, 0xdf,
+	0xc8, 0xfc, 0x1a, 0x3d, 0x46, 0x53, 0x2b, 0x15, 0xe5, 0x8a, 0xbf, 0x3a, 0x2c, 0xc6, 0x43, 0x8b,
+	0x1c, 0x28, 0xaa, 0x80, 0x4f, 0xf9, 0xf5, 0x94, 0xde, 0x1b, 0x16, 0xba, 0xbe, 0x16, 0xfa, 0x64,
+	0x9a, 0xed, 0xc8, 0x02, 0x16, 0x8f, 0x92, 0x19, 0xdf, 0xa0, 0xa7, 0xce, 0x2d, 0xf6, 0xc3, 0xca,
+	0xd0, 0xbd, 0x2a, 0x29, 0xf1, 0x7b, 0x70, 0x3f, 0xa8, 0xdb, 0x88, 0x2e, 0x18, 0xa3, 0xf6, 0x07,
+	0xf0, 0xc3, 0xe9, 0x28, 0xf1, 0x7b, 0xa1, 0x8c, 0x65, 0x41, 0x3a, 0x8d, 0xaf, 0xa4, 0x5c, 0xf1,
+	0xcf, 0x1c, 0x14, 0x45, 0xd3, 0xae, 0x93, 0x56, 0xf4, 0x73, 0xf0, 0xc4, 0x28, 0xf7, 0xe1, 0xf1,
+	0x14, 0xf3, 0x3e, 0x26, 0xde, 0x13, 0xd0, 0x3e, 0x96, 0x5c, 0x53, 0x5f, 0xaa, 0x95, 0x13, 0x75,
+	0x12, 0x21, 0xdc, 0x84, 0x66, 0x5d, 0xd8, 0xe6, 0xd4, 0x9b, 0x08, 0xdb, 0xee, 0x3f, 0xdb, 0xc4,
+	0xc7, 0x92, 0xa7, 0xda, 0xc4, 0xc1, 0xd9, 0x5f, 0x3f, 0x6c, 0x71, 0x7f, 0xff, 0xb0, 0xc5, 0xfd,
+	0xf3, 0xc3, 0x16, 0x07, 0x1b, 0x75, 0xa7, 0x3d, 0xea, 0x8b, 0xfe, 0x60, 0x5e, 0xe8, 0x58, 0x55,
+	0xfa, 0x35, 0x5b, 0xe5, 0xbe, 0xd9, 0xbd, 0xb0, 0xfc, 0x66, 0xf7, 0xac, 0x54, 0x77, 0xda, 0xbb,
+	0x7d, 0xff, 0x68, 0x2d, 0x5d, 0x10, 0x3b, 0xf8, 0xb7, 0x6d, 0xf8, 0x3f, 0xd7, 0x7d, 0xb3, 0x63,
+	0xbd, 0xdd, 0x3b, 0x9b, 0x63, 0xb6, 0x87, 0xff, 0x1e, 0x00, 0xfc, 0xc4, 0xc2, 0x3c, 0x33, 0x1e,
+	0x00, 0x00,
 }
 
 func (m *WorkflowExecutionInfo) Marshal() (dAtA []byte, err error) {
@@ -1670,6 +1747,18 @@ func (m *WorkflowExecutionConfiguration) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RetentionPeriod != nil {
+		{
+			size, err := m.RetentionPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TaskStartToCloseTimeout != nil {
 		{
 			size, err := m.TaskStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
@@ -1951,6 +2040,57 @@ func (m *PendingActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingTimerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTimerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiryTime != nil {
+		{
+			size, err := m.ExpiryTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintWorkflow(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TimerId) > 0 {
+		i -= len(m.TimerId)
+		copy(dAtA[i:], m.TimerId)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.TimerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PendingChildExecutionInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2368,6 +2508,10 @@ func (m *WorkflowExecutionConfiguration) Size() (n int) {
 		l = m.TaskStartToCloseTimeout.Size()
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	if m.RetentionPeriod != nil {
+		l = m.RetentionPeriod.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2477,6 +2621,29 @@ func (m *PendingActivityInfo) Size() (n int) {
 	return n
 }
 
+func (m *PendingTimerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TimerId)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovWorkflow(uint64(m.StartedEventId))
+	}
+	if m.ExpiryTime != nil {
+		l = m.ExpiryTime.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *PendingChildExecutionInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3225,6 +3392,42 @@ func (m *WorkflowExecutionConfiguration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionPeriod == nil {
+				m.RetentionPeriod = &types.Duration{}
+			}
+			if err := m.RetentionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -3947,6 +4150,144 @@ func (m *PendingActivityInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingTimerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTimerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTimerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = &types.Timestamp{}
+			}
+			if err := m.ExpiryTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PendingChildExecutionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
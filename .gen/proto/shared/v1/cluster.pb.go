@@ -26,12 +26,14 @@
 package sharedv1
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +275,183 @@ func (m *DomainCacheInfo) GetNumOfItemsInCacheByName() int64 {
 	return 0
 }
 
+type HistoryShardLoad struct {
+	ShardId           int32   `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	RequestsPerSecond float64 `protobuf:"fixed64,2,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	// Number of workflow lock acquisitions per second which had to wait for the lock.
+	LockWaitsPerSecond float64         `protobuf:"fixed64,3,opt,name=lock_waits_per_second,json=lockWaitsPerSecond,proto3" json:"lock_waits_per_second,omitempty"`
+	AverageLockWait    *types.Duration `protobuf:"bytes,4,opt,name=average_lock_wait,json=averageLockWait,proto3" json:"average_lock_wait,omitempty"`
+	// Difference between the transfer max read level and ack level, in number of task IDs.
+	TransferTaskLag      int64               `protobuf:"varint,5,opt,name=transfer_task_lag,json=transferTaskLag,proto3" json:"transfer_task_lag,omitempty"`
+	TimerTaskLag         *types.Duration     `protobuf:"bytes,6,opt,name=timer_task_lag,json=timerTaskLag,proto3" json:"timer_task_lag,omitempty"`
+	TopDomains           []*HistoryLoadEntry `protobuf:"bytes,7,rep,name=top_domains,json=topDomains,proto3" json:"top_domains,omitempty"`
+	TopWorkflows         []*HistoryLoadEntry `protobuf:"bytes,8,rep,name=top_workflows,json=topWorkflows,proto3" json:"top_workflows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *HistoryShardLoad) Reset()         { *m = HistoryShardLoad{} }
+func (m *HistoryShardLoad) String() string { return proto.CompactTextString(m) }
+func (*HistoryShardLoad) ProtoMessage()    {}
+func (*HistoryShardLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31e4c5c03631024e, []int{4}
+}
+func (m *HistoryShardLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryShardLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryShardLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryShardLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryShardLoad.Merge(m, src)
+}
+func (m *HistoryShardLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryShardLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryShardLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryShardLoad proto.InternalMessageInfo
+
+func (m *HistoryShardLoad) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *HistoryShardLoad) GetRequestsPerSecond() float64 {
+	if m != nil {
+		return m.RequestsPerSecond
+	}
+	return 0
+}
+
+func (m *HistoryShardLoad) GetLockWaitsPerSecond() float64 {
+	if m != nil {
+		return m.LockWaitsPerSecond
+	}
+	return 0
+}
+
+func (m *HistoryShardLoad) GetAverageLockWait() *types.Duration {
+	if m != nil {
+		return m.AverageLockWait
+	}
+	return nil
+}
+
+func (m *HistoryShardLoad) GetTransferTaskLag() int64 {
+	if m != nil {
+		return m.TransferTaskLag
+	}
+	return 0
+}
+
+func (m *HistoryShardLoad) GetTimerTaskLag() *types.Duration {
+	if m != nil {
+		return m.TimerTaskLag
+	}
+	return nil
+}
+
+func (m *HistoryShardLoad) GetTopDomains() []*HistoryLoadEntry {
+	if m != nil {
+		return m.TopDomains
+	}
+	return nil
+}
+
+func (m *HistoryShardLoad) GetTopWorkflows() []*HistoryLoadEntry {
+	if m != nil {
+		return m.TopWorkflows
+	}
+	return nil
+}
+
+type HistoryLoadEntry struct {
+	DomainId string `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	// Empty for domain level entries.
+	WorkflowId           string   `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RequestsPerSecond    float64  `protobuf:"fixed64,3,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	LockWaitsPerSecond   float64  `protobuf:"fixed64,4,opt,name=lock_waits_per_second,json=lockWaitsPerSecond,proto3" json:"lock_waits_per_second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoryLoadEntry) Reset()         { *m = HistoryLoadEntry{} }
+func (m *HistoryLoadEntry) String() string { return proto.CompactTextString(m) }
+func (*HistoryLoadEntry) ProtoMessage()    {}
+func (*HistoryLoadEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31e4c5c03631024e, []int{5}
+}
+func (m *HistoryLoadEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryLoadEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryLoadEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryLoadEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryLoadEntry.Merge(m, src)
+}
+func (m *HistoryLoadEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryLoadEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryLoadEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryLoadEntry proto.InternalMessageInfo
+
+func (m *HistoryLoadEntry) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *HistoryLoadEntry) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *HistoryLoadEntry) GetRequestsPerSecond() float64 {
+	if m != nil {
+		return m.RequestsPerSecond
+	}
+	return 0
+}
+
+func (m *HistoryLoadEntry) GetLockWaitsPerSecond() float64 {
+	if m != nil {
+		return m.LockWaitsPerSecond
+	}
+	return 0
+}
+
 type PersistenceSetting struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -285,7 +464,7 @@ func (m *PersistenceSetting) Reset()         { *m = PersistenceSetting{} }
 func (m *PersistenceSetting) String() string { return proto.CompactTextString(m) }
 func (*PersistenceSetting) ProtoMessage()    {}
 func (*PersistenceSetting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31e4c5c03631024e, []int{4}
+	return fileDescriptor_31e4c5c03631024e, []int{6}
 }
 func (m *PersistenceSetting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +519,7 @@ func (m *PersistenceFeature) Reset()         { *m = PersistenceFeature{} }
 func (m *PersistenceFeature) String() string { return proto.CompactTextString(m) }
 func (*PersistenceFeature) ProtoMessage()    {}
 func (*PersistenceFeature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31e4c5c03631024e, []int{5}
+	return fileDescriptor_31e4c5c03631024e, []int{7}
 }
 func (m *PersistenceFeature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +575,7 @@ func (m *PersistenceInfo) Reset()         { *m = PersistenceInfo{} }
 func (m *PersistenceInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistenceInfo) ProtoMessage()    {}
 func (*PersistenceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31e4c5c03631024e, []int{6}
+	return fileDescriptor_31e4c5c03631024e, []int{8}
 }
 func (m *PersistenceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,6 +630,8 @@ func init() {
 	proto.RegisterType((*RingInfo)(nil), "uber.cadence.shared.v1.RingInfo")
 	proto.RegisterType((*MembershipInfo)(nil), "uber.cadence.shared.v1.MembershipInfo")
 	proto.RegisterType((*DomainCacheInfo)(nil), "uber.cadence.shared.v1.DomainCacheInfo")
+	proto.RegisterType((*HistoryShardLoad)(nil), "uber.cadence.shared.v1.HistoryShardLoad")
+	proto.RegisterType((*HistoryLoadEntry)(nil), "uber.cadence.shared.v1.HistoryLoadEntry")
 	proto.RegisterType((*PersistenceSetting)(nil), "uber.cadence.shared.v1.PersistenceSetting")
 	proto.RegisterType((*PersistenceFeature)(nil), "uber.cadence.shared.v1.PersistenceFeature")
 	proto.RegisterType((*PersistenceInfo)(nil), "uber.cadence.shared.v1.PersistenceInfo")
@@ -461,39 +642,56 @@ func init() {
 }
 
 var fileDescriptor_31e4c5c03631024e = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdd, 0x8a, 0x13, 0x4d,
-	0x10, 0x65, 0x76, 0xbe, 0x7c, 0x49, 0x2a, 0x8b, 0xbb, 0x36, 0xfe, 0x04, 0xc5, 0x10, 0x07, 0x91,
-	0xa0, 0x30, 0x43, 0x56, 0xf4, 0x62, 0x15, 0x91, 0x8d, 0x2c, 0xe6, 0xc2, 0x1f, 0xda, 0x3b, 0x6f,
-	0x86, 0x9e, 0x9e, 0xca, 0x4c, 0xb3, 0x99, 0xee, 0xa5, 0xa7, 0x27, 0x90, 0x0b, 0x1f, 0xc1, 0x17,
-	0xd2, 0x17, 0xf0, 0xd2, 0x47, 0x90, 0x3c, 0x89, 0x4c, 0xf7, 0x4c, 0x74, 0x31, 0x8b, 0x7b, 0x57,
-	0xd5, 0x75, 0xce, 0xe9, 0x53, 0x55, 0x14, 0x3c, 0xa8, 0x12, 0xd4, 0x11, 0x67, 0x29, 0x4a, 0x8e,
-	0x51, 0x99, 0x33, 0x8d, 0x69, 0xb4, 0x9a, 0x46, 0x7c, 0x59, 0x95, 0x06, 0x75, 0x78, 0xae, 0x95,
-	0x51, 0xe4, 0x56, 0x8d, 0x0a, 0x1b, 0x54, 0xe8, 0x50, 0xe1, 0x6a, 0x1a, 0x3c, 0x84, 0xde, 0x1b,
-	0x55, 0x9a, 0xb9, 0x5c, 0x28, 0x72, 0x07, 0x7a, 0x22, 0x45, 0x69, 0x84, 0x59, 0x0f, 0xbd, 0xb1,
-	0x37, 0xe9, 0xd3, 0x6d, 0x1e, 0x7c, 0x86, 0x1e, 0x15, 0x32, 0xb3, 0x38, 0x02, 0xff, 0x69, 0xb5,
-	0xc4, 0x06, 0x63, 0x63, 0x72, 0x1f, 0xf6, 0x0b, 0x2c, 0x12, 0xd4, 0x31, 0x57, 0x95, 0x34, 0xc3,
-	0xbd, 0xb1, 0x37, 0xe9, 0xd0, 0x81, 0x7b, 0x9b, 0xd5, 0x4f, 0xe4, 0x18, 0xba, 0x2e, 0x2d, 0x87,
-	0xfe, 0xd8, 0x9f, 0x0c, 0x8e, 0xc6, 0xe1, 0x6e, 0x53, 0x61, 0xeb, 0x88, 0xb6, 0x84, 0xe0, 0xab,
-	0x07, 0xd7, 0xde, 0xba, 0x38, 0x17, 0xe7, 0xd6, 0xc5, 0x0c, 0xf6, 0x79, 0xa5, 0x35, 0x4a, 0x13,
-	0xe7, 0xaa, 0x34, 0xd6, 0xcd, 0x55, 0x34, 0x07, 0x0d, 0xab, 0x7e, 0x20, 0x8f, 0xe1, 0xba, 0x46,
-	0xc6, 0x73, 0x96, 0x2c, 0x31, 0x6e, 0xdd, 0xed, 0x8d, 0xfd, 0x49, 0x9f, 0x1e, 0x6e, 0x0b, 0xcd,
-	0xc7, 0xe4, 0x19, 0x74, 0xb4, 0x90, 0xd9, 0x3f, 0xed, 0xb7, 0x83, 0xa2, 0x0e, 0x1e, 0x7c, 0xf1,
-	0xe0, 0xe0, 0xb5, 0x2a, 0x98, 0x90, 0x33, 0xc6, 0x73, 0xb4, 0xee, 0x8f, 0xe1, 0xae, 0xac, 0x8a,
-	0x58, 0x2d, 0x62, 0x61, 0xb0, 0x28, 0x63, 0x21, 0x63, 0x5e, 0x17, 0xe3, 0x64, 0x1d, 0x8b, 0xd4,
-	0x36, 0xe3, 0xd3, 0x9b, 0xb2, 0x2a, 0xde, 0x2f, 0xe6, 0x35, 0x60, 0xee, 0xb8, 0x27, 0xeb, 0x79,
-	0x4a, 0x5e, 0xc2, 0xbd, 0x4b, 0xb9, 0x92, 0x15, 0x68, 0x87, 0xef, 0xd3, 0xdb, 0x3b, 0xd8, 0xef,
-	0x58, 0x81, 0xc1, 0x0b, 0x20, 0x1f, 0x50, 0x97, 0xa2, 0x34, 0xb5, 0xf1, 0x8f, 0x68, 0x8c, 0x90,
-	0x19, 0x39, 0x04, 0xff, 0x0c, 0xdb, 0xc5, 0xd7, 0x21, 0xb9, 0x01, 0x9d, 0x15, 0x5b, 0x56, 0x4e,
-	0xaf, 0x4f, 0x5d, 0x12, 0xbc, 0xba, 0xc0, 0x3e, 0x45, 0x66, 0x2a, 0x8d, 0x3b, 0xd8, 0x43, 0xe8,
-	0xa2, 0xac, 0xc7, 0x97, 0x5a, 0x7e, 0x8f, 0xb6, 0x69, 0xf0, 0xcd, 0x83, 0x83, 0x3f, 0x24, 0xec,
-	0x3c, 0x86, 0xd0, 0x4d, 0x18, 0x3f, 0x43, 0x99, 0x36, 0x1a, 0x6d, 0x4a, 0x4e, 0xa1, 0x57, 0x3a,
-	0x8b, 0x6e, 0x33, 0x83, 0xa3, 0x47, 0x97, 0x0d, 0xfe, 0xef, 0xae, 0xe8, 0x96, 0x5b, 0xeb, 0x2c,
-	0x9c, 0xd9, 0x76, 0x81, 0x57, 0xd1, 0x69, 0xfa, 0xa3, 0x5b, 0xee, 0xc9, 0xec, 0xfb, 0x66, 0xe4,
-	0xfd, 0xd8, 0x8c, 0xbc, 0x9f, 0x9b, 0x91, 0xf7, 0xe9, 0x69, 0x26, 0x4c, 0x5e, 0x25, 0x21, 0x57,
-	0x45, 0x74, 0xe1, 0x10, 0xc3, 0x0c, 0x65, 0x64, 0x6f, 0xef, 0xf7, 0x4d, 0x3e, 0x77, 0xd1, 0x6a,
-	0x9a, 0xfc, 0x6f, 0x2b, 0x4f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x93, 0x7f, 0x12, 0xb0, 0xbd,
-	0x03, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xd6, 0x76, 0x93, 0xda, 0x3e, 0x0e, 0x4d, 0x32, 0x50, 0xd8, 0xb6, 0x22, 0x98, 0x15, 0x42,
+	0x56, 0x91, 0xd6, 0x4a, 0x11, 0x5c, 0x14, 0x04, 0xa8, 0x69, 0xab, 0x5a, 0x4a, 0xa1, 0x9a, 0x20,
+	0x55, 0xe2, 0x66, 0x34, 0xde, 0x3d, 0x5e, 0x8f, 0xec, 0x9d, 0x31, 0x33, 0xb3, 0x8e, 0x7c, 0xc1,
+	0x23, 0xf0, 0x34, 0xdc, 0xc1, 0x0b, 0x70, 0xc9, 0x13, 0x20, 0x94, 0x27, 0x41, 0x3b, 0xb3, 0x63,
+	0x12, 0x1a, 0xab, 0xed, 0xdd, 0x9c, 0x39, 0xdf, 0x77, 0xce, 0x77, 0x7e, 0x76, 0x07, 0x3e, 0xa9,
+	0x27, 0xa8, 0x47, 0x39, 0x2f, 0x50, 0xe6, 0x38, 0x32, 0x33, 0xae, 0xb1, 0x18, 0xad, 0x8e, 0x47,
+	0xf9, 0xa2, 0x36, 0x16, 0x75, 0xb6, 0xd4, 0xca, 0x2a, 0xf2, 0x7e, 0x83, 0xca, 0x5a, 0x54, 0xe6,
+	0x51, 0xd9, 0xea, 0xf8, 0xee, 0x51, 0xa9, 0x54, 0xb9, 0xc0, 0x91, 0x43, 0x4d, 0xea, 0xe9, 0xa8,
+	0xa8, 0x35, 0xb7, 0x42, 0x49, 0xcf, 0x4b, 0x3f, 0x85, 0xee, 0x33, 0x65, 0xec, 0x58, 0x4e, 0x15,
+	0xb9, 0x0b, 0x5d, 0x51, 0xa0, 0xb4, 0xc2, 0xae, 0x93, 0x68, 0x10, 0x0d, 0x7b, 0x74, 0x63, 0xa7,
+	0xbf, 0x40, 0x97, 0x0a, 0x59, 0x3a, 0x1c, 0x81, 0x1d, 0xad, 0x16, 0xd8, 0x62, 0xdc, 0x99, 0x7c,
+	0x0c, 0x7b, 0x15, 0x56, 0x13, 0xd4, 0x2c, 0x57, 0xb5, 0xb4, 0xc9, 0x8d, 0x41, 0x34, 0xdc, 0xa5,
+	0x7d, 0x7f, 0x77, 0xd2, 0x5c, 0x91, 0x87, 0xd0, 0xf1, 0xa6, 0x49, 0xe2, 0x41, 0x3c, 0xec, 0x3f,
+	0x18, 0x64, 0xd7, 0x8b, 0xce, 0x82, 0x22, 0x1a, 0x08, 0xe9, 0xef, 0x11, 0xdc, 0x7a, 0xee, 0xcf,
+	0x33, 0xb1, 0x74, 0x2a, 0x4e, 0x60, 0x2f, 0xaf, 0xb5, 0x46, 0x69, 0xd9, 0x4c, 0x19, 0xeb, 0xd4,
+	0xbc, 0x49, 0xcc, 0x7e, 0xcb, 0x6a, 0x2e, 0xc8, 0x67, 0x70, 0xa8, 0x91, 0xe7, 0x33, 0x3e, 0x59,
+	0x20, 0x0b, 0xea, 0x6e, 0x0c, 0xe2, 0x61, 0x8f, 0x1e, 0x6c, 0x1c, 0x6d, 0x62, 0xf2, 0x25, 0xec,
+	0x6a, 0x21, 0xcb, 0xd7, 0xca, 0x0f, 0x8d, 0xa2, 0x1e, 0x9e, 0xfe, 0x1a, 0xc1, 0xfe, 0x63, 0x55,
+	0x71, 0x21, 0x4f, 0x78, 0x3e, 0x43, 0xa7, 0xfe, 0x21, 0xdc, 0x93, 0x75, 0xc5, 0xd4, 0x94, 0x09,
+	0x8b, 0x95, 0x61, 0x42, 0xb2, 0xbc, 0x71, 0xb2, 0xc9, 0x9a, 0x89, 0xc2, 0x15, 0x13, 0xd3, 0xdb,
+	0xb2, 0xae, 0x7e, 0x98, 0x8e, 0x1b, 0xc0, 0xd8, 0x73, 0x1f, 0xad, 0xc7, 0x05, 0xf9, 0x06, 0x3e,
+	0xdc, 0xca, 0x95, 0xbc, 0x42, 0xd7, 0xfc, 0x98, 0x7e, 0x70, 0x0d, 0xfb, 0x7b, 0x5e, 0x61, 0xfa,
+	0x77, 0x0c, 0x07, 0xcf, 0x84, 0xb1, 0x4a, 0xaf, 0xcf, 0x66, 0x5c, 0x17, 0xa7, 0x8a, 0x17, 0xe4,
+	0x0e, 0x74, 0x9b, 0x0a, 0x8a, 0x90, 0x7d, 0x97, 0x76, 0x9c, 0x3d, 0x2e, 0x48, 0x06, 0xef, 0x6a,
+	0xfc, 0xb9, 0x46, 0x63, 0x0d, 0x5b, 0xa2, 0x66, 0x06, 0x73, 0x25, 0x0b, 0x97, 0x25, 0xa2, 0x87,
+	0xc1, 0xf5, 0x02, 0xf5, 0x99, 0x73, 0x90, 0x63, 0xb8, 0xbd, 0x50, 0xf9, 0x9c, 0x9d, 0x73, 0x71,
+	0x95, 0x11, 0x3b, 0x06, 0x69, 0x9c, 0x2f, 0xb9, 0xb8, 0x4c, 0x79, 0x02, 0x87, 0x7c, 0x85, 0x9a,
+	0x97, 0xc8, 0x36, 0xd4, 0x64, 0xc7, 0x4d, 0xf4, 0x4e, 0xe6, 0x57, 0x38, 0x0b, 0x2b, 0x9c, 0x3d,
+	0x6e, 0x57, 0x98, 0xee, 0xb7, 0x9c, 0xd3, 0x36, 0x20, 0xb9, 0x0f, 0x87, 0x56, 0x73, 0x69, 0xa6,
+	0xa8, 0x99, 0xe5, 0x66, 0xce, 0x16, 0xbc, 0x4c, 0x76, 0x5d, 0x37, 0xf6, 0x83, 0xe3, 0x47, 0x6e,
+	0xe6, 0xa7, 0xbc, 0x24, 0xdf, 0xc2, 0x2d, 0x2b, 0xaa, 0xcb, 0xc0, 0x9b, 0xaf, 0xcb, 0xb7, 0xe7,
+	0x08, 0x21, 0xc0, 0x18, 0xfa, 0x56, 0x2d, 0x59, 0xe1, 0x26, 0x6b, 0x92, 0x8e, 0x5b, 0x8a, 0xe1,
+	0xd6, 0xfd, 0xf3, 0x0d, 0x6f, 0x7a, 0xfd, 0x44, 0x5a, 0xbd, 0xa6, 0x60, 0xd5, 0xd2, 0x6f, 0x85,
+	0x21, 0xcf, 0xe1, 0x9d, 0x26, 0xd4, 0xb9, 0xd2, 0xf3, 0xe9, 0x42, 0x9d, 0x9b, 0xa4, 0xfb, 0x96,
+	0xc1, 0xf6, 0xac, 0x5a, 0xbe, 0x0c, 0xec, 0xf4, 0xb7, 0x08, 0x0e, 0xfe, 0x0f, 0x21, 0xf7, 0xa0,
+	0xe7, 0xa5, 0x86, 0x09, 0xf7, 0x68, 0xd7, 0x5f, 0x8c, 0x0b, 0xf2, 0x11, 0xf4, 0x43, 0x72, 0x26,
+	0xfc, 0x68, 0x7b, 0x14, 0xc2, 0xd5, 0xf6, 0x1d, 0x88, 0xdf, 0x7a, 0x07, 0x76, 0xb6, 0xed, 0x40,
+	0xfa, 0x35, 0x90, 0x17, 0xa8, 0x8d, 0x30, 0xb6, 0xa9, 0xf6, 0x0c, 0xad, 0x15, 0xb2, 0x24, 0x07,
+	0x10, 0xcf, 0x31, 0xfc, 0x8f, 0x9a, 0x23, 0x79, 0x0f, 0x76, 0x57, 0x7c, 0x51, 0x63, 0xab, 0xd2,
+	0x1b, 0xe9, 0x77, 0x57, 0xd8, 0x4f, 0x91, 0xdb, 0x5a, 0xe3, 0x35, 0xec, 0x04, 0x3a, 0x28, 0x9b,
+	0xaf, 0xda, 0x57, 0xd9, 0xa5, 0xc1, 0x4c, 0xff, 0x88, 0x60, 0xff, 0x52, 0x08, 0xf7, 0x99, 0x26,
+	0xd0, 0x99, 0xf0, 0x7c, 0x8e, 0x32, 0xb4, 0x2c, 0x98, 0xe4, 0x29, 0x74, 0x8d, 0x97, 0xe8, 0x7f,
+	0x18, 0xfd, 0x07, 0xf7, 0xb7, 0x4d, 0xeb, 0xd5, 0xaa, 0xe8, 0x86, 0xdb, 0xc4, 0x99, 0x7a, 0xb1,
+	0xe1, 0xbf, 0xf2, 0x26, 0x71, 0xda, 0xfa, 0xe8, 0x86, 0xfb, 0xe8, 0xe4, 0xcf, 0x8b, 0xa3, 0xe8,
+	0xaf, 0x8b, 0xa3, 0xe8, 0x9f, 0x8b, 0xa3, 0xe8, 0xa7, 0x2f, 0x4a, 0x61, 0x67, 0xf5, 0x24, 0xcb,
+	0x55, 0x35, 0xba, 0xf2, 0x7e, 0x64, 0x25, 0x4a, 0xff, 0x18, 0xfc, 0xf7, 0x94, 0x7c, 0xe5, 0x4f,
+	0xab, 0xe3, 0xc9, 0x4d, 0xe7, 0xf9, 0xfc, 0xdf, 0x01, 0x00, 0x55, 0x09, 0x0e, 0x84, 0x74, 0x06,
+	0x00, 0x00,
 }
 
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
@@ -682,6 +880,160 @@ func (m *DomainCacheInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoryShardLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryShardLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryShardLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TopWorkflows) > 0 {
+		for iNdEx := len(m.TopWorkflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopWorkflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCluster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TopDomains) > 0 {
+		for iNdEx := len(m.TopDomains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopDomains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCluster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TimerTaskLag != nil {
+		{
+			size, err := m.TimerTaskLag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCluster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TransferTaskLag != 0 {
+		i = encodeVarintCluster(dAtA, i, uint64(m.TransferTaskLag))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AverageLockWait != nil {
+		{
+			size, err := m.AverageLockWait.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCluster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LockWaitsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LockWaitsPerSecond))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.RequestsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RequestsPerSecond))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintCluster(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryLoadEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryLoadEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryLoadEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LockWaitsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LockWaitsPerSecond))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.RequestsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RequestsPerSecond))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PersistenceSetting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -927,38 +1279,69 @@ func (m *DomainCacheInfo) Size() (n int) {
 	return n
 }
 
-func (m *PersistenceSetting) Size() (n int) {
+func (m *HistoryShardLoad) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
+	if m.ShardId != 0 {
+		n += 1 + sovCluster(uint64(m.ShardId))
+	}
+	if m.RequestsPerSecond != 0 {
+		n += 9
+	}
+	if m.LockWaitsPerSecond != 0 {
+		n += 9
+	}
+	if m.AverageLockWait != nil {
+		l = m.AverageLockWait.Size()
 		n += 1 + l + sovCluster(uint64(l))
 	}
-	l = len(m.Value)
-	if l > 0 {
+	if m.TransferTaskLag != 0 {
+		n += 1 + sovCluster(uint64(m.TransferTaskLag))
+	}
+	if m.TimerTaskLag != nil {
+		l = m.TimerTaskLag.Size()
 		n += 1 + l + sovCluster(uint64(l))
 	}
+	if len(m.TopDomains) > 0 {
+		for _, e := range m.TopDomains {
+			l = e.Size()
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if len(m.TopWorkflows) > 0 {
+		for _, e := range m.TopWorkflows {
+			l = e.Size()
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *PersistenceFeature) Size() (n int) {
+func (m *HistoryLoadEntry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovCluster(uint64(l))
 	}
-	if m.Enabled {
-		n += 2
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.RequestsPerSecond != 0 {
+		n += 9
+	}
+	if m.LockWaitsPerSecond != 0 {
+		n += 9
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -966,27 +1349,66 @@ func (m *PersistenceFeature) Size() (n int) {
 	return n
 }
 
-func (m *PersistenceInfo) Size() (n int) {
+func (m *PersistenceSetting) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Backend)
+	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovCluster(uint64(l))
 	}
-	if len(m.Settings) > 0 {
-		for _, e := range m.Settings {
-			l = e.Size()
-			n += 1 + l + sovCluster(uint64(l))
-		}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
 	}
-	if len(m.Features) > 0 {
-		for _, e := range m.Features {
-			l = e.Size()
-			n += 1 + l + sovCluster(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PersistenceFeature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PersistenceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Backend)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if len(m.Settings) > 0 {
+		for _, e := range m.Settings {
+			l = e.Size()
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if len(m.Features) > 0 {
+		for _, e := range m.Features {
+			l = e.Size()
+			n += 1 + l + sovCluster(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1461,6 +1883,394 @@ func (m *DomainCacheInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HistoryShardLoad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryShardLoad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryShardLoad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequestsPerSecond = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockWaitsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LockWaitsPerSecond = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageLockWait", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AverageLockWait == nil {
+				m.AverageLockWait = &types.Duration{}
+			}
+			if err := m.AverageLockWait.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTaskLag", wireType)
+			}
+			m.TransferTaskLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferTaskLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerTaskLag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimerTaskLag == nil {
+				m.TimerTaskLag = &types.Duration{}
+			}
+			if err := m.TimerTaskLag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopDomains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopDomains = append(m.TopDomains, &HistoryLoadEntry{})
+			if err := m.TopDomains[len(m.TopDomains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopWorkflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopWorkflows = append(m.TopWorkflows, &HistoryLoadEntry{})
+			if err := m.TopWorkflows[len(m.TopWorkflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryLoadEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryLoadEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryLoadEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequestsPerSecond = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockWaitsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LockWaitsPerSecond = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PersistenceSetting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
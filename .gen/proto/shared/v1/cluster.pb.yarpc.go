@@ -28,35 +28,67 @@ package sharedv1
 var yarpcFileDescriptorClosure31e4c5c03631024e = [][]byte{
 	// uber/cadence/shared/v1/cluster.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdf, 0x8b, 0xd3, 0x40,
-		0x10, 0x26, 0x17, 0x6b, 0xdb, 0xe9, 0xe1, 0x9d, 0x8b, 0x3f, 0x82, 0x22, 0xc4, 0x20, 0x52, 0x14,
-		0x12, 0x7a, 0xa2, 0xc2, 0x29, 0x22, 0x57, 0x39, 0xec, 0x83, 0x3f, 0x58, 0xdf, 0x7c, 0x09, 0x9b,
-		0xcd, 0x34, 0x59, 0xae, 0xd9, 0x3d, 0x36, 0x9b, 0x42, 0x1f, 0xfc, 0x13, 0xfc, 0x87, 0xf4, 0x9f,
-		0x93, 0xec, 0x26, 0xd5, 0xc3, 0x1e, 0x77, 0x6f, 0x33, 0x3b, 0xdf, 0xf7, 0xed, 0x37, 0x33, 0x0c,
-		0x3c, 0x69, 0x32, 0xd4, 0x09, 0x67, 0x39, 0x4a, 0x8e, 0x49, 0x5d, 0x32, 0x8d, 0x79, 0xb2, 0x9e,
-		0x25, 0x7c, 0xd5, 0xd4, 0x06, 0x75, 0x7c, 0xae, 0x95, 0x51, 0xe4, 0x5e, 0x8b, 0x8a, 0x3b, 0x54,
-		0xec, 0x50, 0xf1, 0x7a, 0x16, 0x3d, 0x85, 0xd1, 0x47, 0x55, 0x9b, 0x85, 0x5c, 0x2a, 0xf2, 0x00,
-		0x46, 0x22, 0x47, 0x69, 0x84, 0xd9, 0x04, 0x5e, 0xe8, 0x4d, 0xc7, 0x74, 0x9b, 0x47, 0x3f, 0x60,
-		0x44, 0x85, 0x2c, 0x2c, 0x8e, 0xc0, 0x0d, 0xad, 0x56, 0xd8, 0x61, 0x6c, 0x4c, 0x1e, 0xc3, 0x7e,
-		0x85, 0x55, 0x86, 0x3a, 0xe5, 0xaa, 0x91, 0x26, 0xd8, 0x0b, 0xbd, 0xe9, 0x80, 0x4e, 0xdc, 0xdb,
-		0xbc, 0x7d, 0x22, 0xc7, 0x30, 0x74, 0x69, 0x1d, 0xf8, 0xa1, 0x3f, 0x9d, 0x1c, 0x85, 0xf1, 0x6e,
-		0x53, 0x71, 0xef, 0x88, 0xf6, 0x84, 0xe8, 0x97, 0x07, 0xb7, 0x3e, 0xb9, 0xb8, 0x14, 0xe7, 0xd6,
-		0xc5, 0x1c, 0xf6, 0x79, 0xa3, 0x35, 0x4a, 0x93, 0x96, 0xaa, 0x36, 0xd6, 0xcd, 0x75, 0x34, 0x27,
-		0x1d, 0xab, 0x7d, 0x20, 0xcf, 0xe1, 0xb6, 0x46, 0xc6, 0x4b, 0x96, 0xad, 0x30, 0xed, 0xdd, 0xed,
-		0x85, 0xfe, 0x74, 0x4c, 0x0f, 0xb7, 0x85, 0xee, 0x63, 0xf2, 0x0a, 0x06, 0x5a, 0xc8, 0xe2, 0x4a,
-		0xfb, 0xfd, 0xa0, 0xa8, 0x83, 0x47, 0x3f, 0x3d, 0x38, 0xf8, 0xa0, 0x2a, 0x26, 0xe4, 0x9c, 0xf1,
-		0x12, 0xad, 0xfb, 0x63, 0x78, 0x28, 0x9b, 0x2a, 0x55, 0xcb, 0x54, 0x18, 0xac, 0xea, 0x54, 0xc8,
-		0x94, 0xb7, 0xc5, 0x34, 0xdb, 0xa4, 0x22, 0xb7, 0xcd, 0xf8, 0xf4, 0xae, 0x6c, 0xaa, 0x2f, 0xcb,
-		0x45, 0x0b, 0x58, 0x38, 0xee, 0xc9, 0x66, 0x91, 0x93, 0x77, 0xf0, 0xe8, 0x52, 0xae, 0x64, 0x15,
-		0xda, 0xe1, 0xfb, 0xf4, 0xfe, 0x0e, 0xf6, 0x67, 0x56, 0x61, 0xf4, 0x16, 0xc8, 0x57, 0xd4, 0xb5,
-		0xa8, 0x4d, 0x6b, 0xfc, 0x1b, 0x1a, 0x23, 0x64, 0x41, 0x0e, 0xc1, 0x3f, 0xc3, 0x7e, 0xf1, 0x6d,
-		0x48, 0xee, 0xc0, 0x60, 0xcd, 0x56, 0x8d, 0xd3, 0x1b, 0x53, 0x97, 0x44, 0xef, 0x2f, 0xb0, 0x4f,
-		0x91, 0x99, 0x46, 0xe3, 0x0e, 0x76, 0x00, 0x43, 0x94, 0xed, 0xf8, 0x72, 0xcb, 0x1f, 0xd1, 0x3e,
-		0x8d, 0x7e, 0x7b, 0x70, 0xf0, 0x8f, 0x84, 0x9d, 0x47, 0x00, 0xc3, 0x8c, 0xf1, 0x33, 0x94, 0x79,
-		0xa7, 0xd1, 0xa7, 0xe4, 0x14, 0x46, 0xb5, 0xb3, 0xe8, 0x36, 0x33, 0x39, 0x7a, 0x76, 0xd9, 0xe0,
-		0xff, 0xef, 0x8a, 0x6e, 0xb9, 0xad, 0xce, 0xd2, 0x99, 0xed, 0x17, 0x78, 0x1d, 0x9d, 0xae, 0x3f,
-		0xba, 0xe5, 0x9e, 0xbc, 0xfe, 0xfe, 0xb2, 0x10, 0xa6, 0x6c, 0xb2, 0x98, 0xab, 0x2a, 0xb9, 0x70,
-		0x7c, 0x71, 0x81, 0x32, 0xb1, 0xf7, 0xf6, 0xf7, 0x0e, 0xdf, 0xb8, 0x68, 0x3d, 0xcb, 0x6e, 0xda,
-		0xca, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x90, 0xef, 0x39, 0x7d, 0xb1, 0x03, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x8e, 0x1b, 0x35,
+		0x14, 0x56, 0x3a, 0x9b, 0x26, 0x39, 0x59, 0xba, 0xbb, 0x86, 0xc2, 0xb4, 0x15, 0x10, 0x46, 0x08,
+		0x45, 0x45, 0x9a, 0x28, 0x45, 0x80, 0x54, 0x10, 0xa0, 0x6e, 0x5b, 0x35, 0xd2, 0x16, 0x2a, 0x2f,
+		0x52, 0x25, 0x6e, 0x2c, 0x67, 0xe6, 0x64, 0x62, 0x65, 0xc6, 0x0e, 0xb6, 0x27, 0xab, 0x5c, 0xf0,
+		0x08, 0x3c, 0x0d, 0x77, 0xf0, 0x2e, 0x3c, 0x0b, 0x1a, 0x7b, 0x1c, 0x76, 0x61, 0xa3, 0x76, 0xef,
+		0x7c, 0x7c, 0xbe, 0xef, 0x9c, 0xef, 0xfc, 0xcc, 0x18, 0x3e, 0xad, 0xe7, 0xa8, 0x27, 0x19, 0xcf,
+		0x51, 0x66, 0x38, 0x31, 0x4b, 0xae, 0x31, 0x9f, 0x6c, 0xa6, 0x93, 0xac, 0xac, 0x8d, 0x45, 0x9d,
+		0xae, 0xb5, 0xb2, 0x8a, 0xbc, 0xdf, 0xa0, 0xd2, 0x16, 0x95, 0x7a, 0x54, 0xba, 0x99, 0xde, 0xff,
+		0xa8, 0x50, 0xaa, 0x28, 0x71, 0xe2, 0x50, 0xf3, 0x7a, 0x31, 0xc9, 0x6b, 0xcd, 0xad, 0x50, 0xd2,
+		0xf3, 0x92, 0xcf, 0xa0, 0xff, 0x42, 0x19, 0x3b, 0x93, 0x0b, 0x45, 0xee, 0x43, 0x5f, 0xe4, 0x28,
+		0xad, 0xb0, 0xdb, 0xb8, 0x33, 0xea, 0x8c, 0x07, 0x74, 0x67, 0x27, 0xbf, 0x41, 0x9f, 0x0a, 0x59,
+		0x38, 0x1c, 0x81, 0x03, 0xad, 0x4a, 0x6c, 0x31, 0xee, 0x4c, 0x3e, 0x81, 0xc3, 0x0a, 0xab, 0x39,
+		0x6a, 0x96, 0xa9, 0x5a, 0xda, 0xf8, 0xd6, 0xa8, 0x33, 0xee, 0xd2, 0xa1, 0xbf, 0x3b, 0x6d, 0xae,
+		0xc8, 0x63, 0xe8, 0x79, 0xd3, 0xc4, 0xd1, 0x28, 0x1a, 0x0f, 0x1f, 0x8d, 0xd2, 0xeb, 0x45, 0xa7,
+		0x41, 0x11, 0x0d, 0x84, 0xe4, 0xcf, 0x0e, 0xdc, 0x79, 0xe9, 0xcf, 0x4b, 0xb1, 0x76, 0x2a, 0x4e,
+		0xe1, 0x30, 0xab, 0xb5, 0x46, 0x69, 0xd9, 0x52, 0x19, 0xeb, 0xd4, 0xbc, 0x4d, 0xcc, 0x61, 0xcb,
+		0x6a, 0x2e, 0xc8, 0xe7, 0x70, 0xa2, 0x91, 0x67, 0x4b, 0x3e, 0x2f, 0x91, 0x05, 0x75, 0xb7, 0x46,
+		0xd1, 0x78, 0x40, 0x8f, 0x77, 0x8e, 0x36, 0x31, 0xf9, 0x0a, 0xba, 0x5a, 0xc8, 0xe2, 0x8d, 0xf2,
+		0x43, 0xa3, 0xa8, 0x87, 0x27, 0xbf, 0x77, 0xe0, 0xe8, 0xa9, 0xaa, 0xb8, 0x90, 0xa7, 0x3c, 0x5b,
+		0xa2, 0x53, 0xff, 0x18, 0x1e, 0xc8, 0xba, 0x62, 0x6a, 0xc1, 0x84, 0xc5, 0xca, 0x30, 0x21, 0x59,
+		0xd6, 0x38, 0xd9, 0x7c, 0xcb, 0x44, 0xee, 0x8a, 0x89, 0xe8, 0x5d, 0x59, 0x57, 0x3f, 0x2d, 0x66,
+		0x0d, 0x60, 0xe6, 0xb9, 0x4f, 0xb6, 0xb3, 0x9c, 0x7c, 0x07, 0x1f, 0xee, 0xe5, 0x4a, 0x5e, 0xa1,
+		0x6b, 0x7e, 0x44, 0x3f, 0xb8, 0x86, 0xfd, 0x23, 0xaf, 0x30, 0xf9, 0x3b, 0x82, 0xe3, 0x17, 0xc2,
+		0x58, 0xa5, 0xb7, 0xe7, 0x4b, 0xae, 0xf3, 0x33, 0xc5, 0x73, 0x72, 0x0f, 0xfa, 0x4d, 0x05, 0x79,
+		0xc8, 0xde, 0xa5, 0x3d, 0x67, 0xcf, 0x72, 0x92, 0xc2, 0xbb, 0x1a, 0x7f, 0xad, 0xd1, 0x58, 0xc3,
+		0xd6, 0xa8, 0x99, 0xc1, 0x4c, 0xc9, 0xdc, 0x65, 0xe9, 0xd0, 0x93, 0xe0, 0x7a, 0x85, 0xfa, 0xdc,
+		0x39, 0xc8, 0x14, 0xee, 0x96, 0x2a, 0x5b, 0xb1, 0x0b, 0x2e, 0xae, 0x32, 0x22, 0xc7, 0x20, 0x8d,
+		0xf3, 0x35, 0x17, 0x97, 0x29, 0xcf, 0xe0, 0x84, 0x6f, 0x50, 0xf3, 0x02, 0xd9, 0x8e, 0x1a, 0x1f,
+		0xb8, 0x89, 0xde, 0x4b, 0xfd, 0x0a, 0xa7, 0x61, 0x85, 0xd3, 0xa7, 0xed, 0x0a, 0xd3, 0xa3, 0x96,
+		0x73, 0xd6, 0x06, 0x24, 0x0f, 0xe1, 0xc4, 0x6a, 0x2e, 0xcd, 0x02, 0x35, 0xb3, 0xdc, 0xac, 0x58,
+		0xc9, 0x8b, 0xb8, 0xeb, 0xba, 0x71, 0x14, 0x1c, 0x3f, 0x73, 0xb3, 0x3a, 0xe3, 0x05, 0xf9, 0x1e,
+		0xee, 0x58, 0x51, 0x5d, 0x06, 0xde, 0x7e, 0x53, 0xbe, 0x43, 0x47, 0x08, 0x01, 0x66, 0x30, 0xb4,
+		0x6a, 0xcd, 0x72, 0x37, 0x59, 0x13, 0xf7, 0xdc, 0x52, 0x8c, 0xf7, 0xee, 0x9f, 0x6f, 0x78, 0xd3,
+		0xeb, 0x67, 0xd2, 0xea, 0x2d, 0x05, 0xab, 0xd6, 0x7e, 0x2b, 0x0c, 0x79, 0x09, 0xef, 0x34, 0xa1,
+		0x2e, 0x94, 0x5e, 0x2d, 0x4a, 0x75, 0x61, 0xe2, 0xfe, 0x0d, 0x83, 0x1d, 0x5a, 0xb5, 0x7e, 0x1d,
+		0xd8, 0xc9, 0x1f, 0x1d, 0x38, 0xfe, 0x2f, 0x84, 0x3c, 0x80, 0x81, 0x97, 0x1a, 0x26, 0x3c, 0xa0,
+		0x7d, 0x7f, 0x31, 0xcb, 0xc9, 0xc7, 0x30, 0x0c, 0xc9, 0x99, 0xf0, 0xa3, 0x1d, 0x50, 0x08, 0x57,
+		0xfb, 0x77, 0x20, 0xba, 0xf1, 0x0e, 0x1c, 0xec, 0xdb, 0x81, 0xe4, 0x5b, 0x20, 0xaf, 0x50, 0x1b,
+		0x61, 0x6c, 0x53, 0xed, 0x39, 0x5a, 0x2b, 0x64, 0x41, 0x8e, 0x21, 0x5a, 0x61, 0xf8, 0x1f, 0x35,
+		0x47, 0xf2, 0x1e, 0x74, 0x37, 0xbc, 0xac, 0xb1, 0x55, 0xe9, 0x8d, 0xe4, 0x87, 0x2b, 0xec, 0xe7,
+		0xc8, 0x6d, 0xad, 0xf1, 0x1a, 0x76, 0x0c, 0x3d, 0x94, 0xcd, 0x57, 0xed, 0xab, 0xec, 0xd3, 0x60,
+		0x26, 0x7f, 0x75, 0xe0, 0xe8, 0x52, 0x08, 0xf7, 0x99, 0xc6, 0xd0, 0x9b, 0xf3, 0x6c, 0x85, 0x32,
+		0xb4, 0x2c, 0x98, 0xe4, 0x39, 0xf4, 0x8d, 0x97, 0xe8, 0x7f, 0x18, 0xc3, 0x47, 0x0f, 0xf7, 0x4d,
+		0xeb, 0xff, 0x55, 0xd1, 0x1d, 0xb7, 0x89, 0xb3, 0xf0, 0x62, 0xc3, 0x7f, 0xe5, 0x6d, 0xe2, 0xb4,
+		0xf5, 0xd1, 0x1d, 0xf7, 0xc9, 0xd7, 0xbf, 0x7c, 0x59, 0x08, 0xbb, 0xac, 0xe7, 0x69, 0xa6, 0xaa,
+		0xc9, 0x95, 0x37, 0x23, 0x2d, 0x50, 0xfa, 0x07, 0xe0, 0xdf, 0xe7, 0xe3, 0x1b, 0x7f, 0xda, 0x4c,
+		0xe7, 0xb7, 0x9d, 0xe7, 0x8b, 0x7f, 0x06, 0x00, 0x9c, 0xf8, 0x3a, 0x79, 0x68, 0x06, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 }
@@ -1322,6 +1322,101 @@ func (m *CrossClusterTaskRequests) GetTaskRequests() []*CrossClusterTaskRequest
 	return nil
 }
 
+type WorkflowTaskInfo struct {
+	TaskType             TaskType         `protobuf:"varint,1,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.shared.v1.TaskType" json:"task_type,omitempty"`
+	SubType              int32            `protobuf:"varint,2,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
+	TaskId               int64            `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VisibilityTime       *types.Timestamp `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
+	Version              int64            `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Attempt              int32            `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	ClusterName          string           `protobuf:"bytes,7,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *WorkflowTaskInfo) Reset()         { *m = WorkflowTaskInfo{} }
+func (m *WorkflowTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTaskInfo) ProtoMessage()    {}
+func (*WorkflowTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8958fa454fc8f819, []int{15}
+}
+func (m *WorkflowTaskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTaskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTaskInfo.Merge(m, src)
+}
+func (m *WorkflowTaskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTaskInfo proto.InternalMessageInfo
+
+func (m *WorkflowTaskInfo) GetTaskType() TaskType {
+	if m != nil {
+		return m.TaskType
+	}
+	return TaskType_TASK_TYPE_INVALID
+}
+
+func (m *WorkflowTaskInfo) GetSubType() int32 {
+	if m != nil {
+		return m.SubType
+	}
+	return 0
+}
+
+func (m *WorkflowTaskInfo) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *WorkflowTaskInfo) GetVisibilityTime() *types.Timestamp {
+	if m != nil {
+		return m.VisibilityTime
+	}
+	return nil
+}
+
+func (m *WorkflowTaskInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *WorkflowTaskInfo) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *WorkflowTaskInfo) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("uber.cadence.shared.v1.TaskType", TaskType_name, TaskType_value)
 	proto.RegisterEnum("uber.cadence.shared.v1.CrossClusterTaskType", CrossClusterTaskType_name, CrossClusterTaskType_value)
@@ -1342,6 +1437,7 @@ func init() {
 	proto.RegisterType((*CrossClusterTaskRequest)(nil), "uber.cadence.shared.v1.CrossClusterTaskRequest")
 	proto.RegisterType((*CrossClusterTaskResponse)(nil), "uber.cadence.shared.v1.CrossClusterTaskResponse")
 	proto.RegisterType((*CrossClusterTaskRequests)(nil), "uber.cadence.shared.v1.CrossClusterTaskRequests")
+	proto.RegisterType((*WorkflowTaskInfo)(nil), "uber.cadence.shared.v1.WorkflowTaskInfo")
 }
 
 func init() {
@@ -1349,109 +1445,116 @@ func init() {
 }
 
 var fileDescriptor_8958fa454fc8f819 = []byte{
-	// 1627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x73, 0xe3, 0x48,
-	0x15, 0x8f, 0xec, 0xfc, 0x7d, 0xce, 0xce, 0x28, 0x1d, 0x66, 0xe3, 0xc9, 0x90, 0x19, 0x8f, 0x97,
-	0x9a, 0x71, 0x85, 0xac, 0x4c, 0x12, 0x06, 0xb6, 0x80, 0x02, 0x14, 0x59, 0x89, 0x45, 0x34, 0xb6,
-	0xab, 0x25, 0x27, 0x9b, 0xb9, 0xa8, 0x14, 0xab, 0x93, 0xa8, 0x62, 0x4b, 0x5e, 0x49, 0xce, 0xe0,
-	0x3b, 0xbb, 0xc5, 0x91, 0xfd, 0x0a, 0x5c, 0x28, 0xb8, 0x73, 0xe0, 0xc6, 0x0d, 0x2e, 0x54, 0x01,
-	0x07, 0x2e, 0x7b, 0xa1, 0xe6, 0x13, 0xf0, 0x11, 0x28, 0xb5, 0xe4, 0x58, 0x96, 0x65, 0x5b, 0xc9,
-	0xcc, 0x81, 0x03, 0x37, 0xfb, 0xf5, 0xef, 0xbd, 0xfe, 0xbd, 0xf7, 0xba, 0x7f, 0xdd, 0x2d, 0x28,
-	0xf6, 0xce, 0x89, 0x53, 0x6e, 0xe9, 0x06, 0xb1, 0x5a, 0xa4, 0xec, 0x5e, 0xe9, 0x0e, 0x31, 0xca,
-	0x37, 0xbb, 0xe5, 0x2f, 0x7a, 0xa4, 0x47, 0xb8, 0xae, 0x63, 0x7b, 0x36, 0xfa, 0xd8, 0xc7, 0x70,
-	0x21, 0x86, 0x0b, 0x30, 0xdc, 0xcd, 0xee, 0xe6, 0xb3, 0x4b, 0xdb, 0xbe, 0x6c, 0x93, 0x32, 0x45,
-	0x9d, 0xf7, 0x2e, 0xca, 0x9e, 0xd9, 0x21, 0xae, 0xa7, 0x77, 0xba, 0x81, 0xe3, 0x66, 0x61, 0x24,
-	0xb8, 0xde, 0x35, 0xfd, 0xc8, 0x2d, 0xbb, 0xd3, 0xb1, 0xad, 0x10, 0xf1, 0x3c, 0x09, 0x71, 0x65,
-	0xba, 0x9e, 0xed, 0xf4, 0x43, 0x48, 0x31, 0x09, 0xf2, 0xd6, 0x76, 0xae, 0x2f, 0xda, 0xf6, 0xdb,
-	0x00, 0x53, 0xfc, 0x26, 0x03, 0xdf, 0x12, 0x1c, 0xdb, 0x75, 0x85, 0x76, 0xcf, 0xf5, 0x88, 0xa3,
-	0xea, 0xee, 0xb5, 0x64, 0x5d, 0xd8, 0xe8, 0x09, 0xac, 0x18, 0x76, 0x47, 0x37, 0x2d, 0xcd, 0x34,
-	0xf2, 0x4c, 0x81, 0x29, 0xad, 0xe0, 0xe5, 0xc0, 0x20, 0x19, 0xa8, 0x09, 0x68, 0x10, 0x47, 0x23,
-	0xbf, 0x24, 0xad, 0x9e, 0x67, 0xda, 0x56, 0x3e, 0x53, 0x60, 0x4a, 0xb9, 0xbd, 0x17, 0xdc, 0x48,
-	0xd2, 0x7a, 0xd7, 0xe4, 0x6e, 0x76, 0xb9, 0xd3, 0x10, 0x2e, 0x0e, 0xd0, 0x78, 0xed, 0x6d, 0xdc,
-	0x84, 0x24, 0x58, 0xf1, 0x74, 0xf7, 0x5a, 0xf3, 0xfa, 0x5d, 0x92, 0xcf, 0x16, 0x98, 0xd2, 0x83,
-	0xbd, 0x1d, 0x2e, 0xb9, 0x84, 0x5c, 0x9c, 0xb4, 0xda, 0xef, 0x12, 0xbc, 0xec, 0x85, 0xbf, 0xd0,
-	0x16, 0x00, 0x0d, 0xe5, 0x7a, 0xba, 0x47, 0xf2, 0xf3, 0x05, 0xa6, 0xb4, 0x80, 0x69, 0x70, 0xc5,
-	0x37, 0xa0, 0x0d, 0x58, 0xa2, 0xc3, 0xa6, 0x91, 0x5f, 0x28, 0x30, 0xa5, 0x2c, 0x5e, 0xf4, 0xff,
-	0x4a, 0x06, 0x92, 0x61, 0xfd, 0xc6, 0x74, 0xcd, 0x73, 0xb3, 0x6d, 0x7a, 0x7d, 0x75, 0xd0, 0x95,
-	0xfc, 0x22, 0x4d, 0x6d, 0x93, 0x0b, 0xfa, 0xc6, 0x0d, 0xfa, 0xc6, 0xdd, 0x22, 0x70, 0x92, 0x5b,
-	0xf1, 0x5f, 0x19, 0xf8, 0x5e, 0x94, 0xa8, 0xe2, 0xe9, 0x8e, 0x27, 0x5c, 0x99, 0x6d, 0x63, 0x58,
-	0x07, 0xf2, 0x45, 0x8f, 0xb8, 0x1e, 0xef, 0x79, 0x8e, 0x79, 0xde, 0xf3, 0x88, 0x8b, 0x4a, 0xc0,
-	0x7a, 0xba, 0x73, 0x49, 0x3c, 0x2d, 0xde, 0x80, 0x07, 0x81, 0xbd, 0x32, 0x68, 0xc3, 0x16, 0x80,
-	0x13, 0xb8, 0xfb, 0x98, 0x0c, 0xc5, 0xac, 0x84, 0x16, 0xc9, 0x40, 0x3b, 0x80, 0x4c, 0xcb, 0xf4,
-	0x4c, 0xdd, 0x23, 0x86, 0x46, 0x6e, 0x88, 0x45, 0x61, 0x59, 0x9a, 0x2f, 0x7b, 0x3b, 0x22, 0xfa,
-	0x03, 0x92, 0x81, 0xbe, 0x62, 0x60, 0x33, 0x0e, 0xd7, 0x6f, 0x59, 0xd1, 0x12, 0xe6, 0xf6, 0xaa,
-	0x89, 0xcd, 0x1d, 0xa6, 0x35, 0xd6, 0x66, 0x69, 0x64, 0x9a, 0x61, 0x96, 0x38, 0x6f, 0x4e, 0x18,
-	0x41, 0x45, 0xf8, 0x28, 0xcc, 0xdf, 0xe9, 0x59, 0x83, 0x0e, 0xad, 0xe0, 0x5c, 0x60, 0xc4, 0x3d,
-	0x4b, 0x32, 0x8a, 0xbf, 0x80, 0xdd, 0x99, 0x75, 0x75, 0xbb, 0xb6, 0xe5, 0x92, 0x48, 0xe0, 0x47,
-	0xb0, 0x18, 0x46, 0x0c, 0xca, 0xb9, 0xe0, 0xd0, 0x58, 0x7f, 0xca, 0xc0, 0x4e, 0x34, 0x98, 0xa0,
-	0x5b, 0x2d, 0xd2, 0xfe, 0x20, 0x0d, 0x3a, 0x87, 0xc7, 0x21, 0xf2, 0xbd, 0xb7, 0xcb, 0x46, 0x10,
-	0x68, 0x6c, 0x20, 0xb6, 0x08, 0xb2, 0xe9, 0x16, 0xc1, 0xfc, 0x84, 0x45, 0xc0, 0xc1, 0x7a, 0xcb,
-	0x2f, 0xe3, 0x90, 0xaf, 0x6d, 0xb5, 0xfb, 0xb4, 0x03, 0xcb, 0x78, 0xad, 0x15, 0x6d, 0x71, 0xdd,
-	0x6a, 0xf7, 0x8b, 0x65, 0xf8, 0x74, 0x6a, 0xe9, 0xe2, 0x3d, 0x28, 0xfe, 0x31, 0x3b, 0x5a, 0x6c,
-	0xc5, 0xbc, 0xb4, 0xf4, 0xff, 0x17, 0x3b, 0x4d, 0xb1, 0xd1, 0x33, 0xc8, 0xb9, 0xb4, 0x5c, 0x9a,
-	0xa5, 0x77, 0x08, 0xd5, 0xa4, 0x15, 0x0c, 0x81, 0xa9, 0xa6, 0x77, 0x08, 0xfa, 0x19, 0xac, 0x86,
-	0x00, 0xd3, 0xea, 0xf6, 0xbc, 0xfc, 0x12, 0x4d, 0xfa, 0xdb, 0x89, 0x49, 0x37, 0xf4, 0x7e, 0xdb,
-	0xd6, 0x0d, 0x1c, 0x86, 0x94, 0x7c, 0x07, 0x94, 0x87, 0xa5, 0x96, 0x6d, 0x79, 0x8e, 0xdd, 0xce,
-	0x2f, 0x17, 0x98, 0xd2, 0x2a, 0x1e, 0xfc, 0x8d, 0x37, 0x7a, 0xac, 0x6d, 0x63, 0x8d, 0xfe, 0x5b,
-	0x06, 0xf8, 0xa8, 0x07, 0x26, 0x2d, 0xdb, 0x31, 0x92, 0x45, 0x42, 0xb0, 0x3b, 0xdd, 0x36, 0xf1,
-	0xc8, 0xff, 0x7a, 0xf7, 0xef, 0x26, 0xa8, 0x32, 0xb0, 0xad, 0x20, 0x31, 0xd3, 0xb6, 0x02, 0x78,
-	0xa8, 0xa2, 0xcf, 0x13, 0x89, 0x54, 0x83, 0xc3, 0x9b, 0xba, 0xe3, 0x87, 0x43, 0x57, 0x6a, 0x28,
-	0x56, 0xe0, 0xe0, 0xee, 0xe5, 0x1c, 0xeb, 0xca, 0x7f, 0x18, 0x28, 0xf0, 0xdd, 0x6e, 0xbb, 0xdf,
-	0xd0, 0x1d, 0x62, 0x79, 0x42, 0xdb, 0x76, 0x49, 0xc3, 0x6e, 0x9b, 0xad, 0x7e, 0xa4, 0xe8, 0x2f,
-	0xe0, 0x61, 0xb0, 0x2e, 0xe3, 0x35, 0xff, 0x88, 0x9a, 0x6f, 0x4b, 0xbe, 0x0d, 0x6b, 0xb1, 0xf5,
-	0x7b, 0x7b, 0x0a, 0x3d, 0x1c, 0x59, 0xbd, 0x92, 0x81, 0x0a, 0xb0, 0x1a, 0x60, 0x43, 0x05, 0x0e,
-	0xb6, 0x0e, 0x50, 0x1b, 0x95, 0x74, 0x74, 0x02, 0xeb, 0x5d, 0x4a, 0x4a, 0x6b, 0xf9, 0xac, 0xb4,
-	0x2e, 0xa5, 0x45, 0x2b, 0xf6, 0x60, 0x42, 0xeb, 0xc6, 0x92, 0xc0, 0x6b, 0xdd, 0xb8, 0xa9, 0xf8,
-	0x67, 0x06, 0xf6, 0xa3, 0x95, 0x4b, 0x4e, 0x7f, 0x7c, 0xe9, 0xfd, 0x9a, 0x81, 0xef, 0xe8, 0x3e,
-	0x56, 0x4b, 0xa0, 0x15, 0x3d, 0x19, 0x99, 0x42, 0xb6, 0x94, 0xdb, 0xfb, 0x6c, 0xd2, 0x45, 0x65,
-	0x56, 0xb9, 0x71, 0x41, 0x9f, 0x81, 0x28, 0xfe, 0x00, 0xbe, 0x9f, 0x26, 0x83, 0xb1, 0x6e, 0x7f,
-	0xbd, 0x04, 0x1b, 0xf1, 0x7b, 0x52, 0x98, 0xe8, 0xed, 0x5d, 0xcb, 0xb4, 0x2e, 0x6c, 0xda, 0xde,
-	0x5c, 0xfa, 0xbb, 0x96, 0x7f, 0x41, 0x0c, 0xee, 0x5a, 0xf4, 0xaa, 0xf8, 0x1b, 0x06, 0xb6, 0xdc,
-	0xf1, 0x13, 0x78, 0x48, 0x24, 0xdc, 0x7f, 0xd5, 0x34, 0xf1, 0xd3, 0x5c, 0x91, 0xaa, 0x73, 0x78,
-	0xfa, 0x84, 0xe8, 0x57, 0x0c, 0x3c, 0x6e, 0x8d, 0x1e, 0x46, 0x11, 0x3a, 0x59, 0x4a, 0xa7, 0x92,
-	0x86, 0xce, 0xac, 0xcb, 0x40, 0x75, 0x0e, 0x4f, 0x9e, 0x88, 0xd2, 0x70, 0x47, 0xa5, 0x92, 0x8f,
-	0x5f, 0xa9, 0x52, 0xd1, 0x98, 0x75, 0x4c, 0xfa, 0x34, 0x26, 0x4e, 0x84, 0xfe, 0xc1, 0xc0, 0x2b,
-	0xe7, 0x3e, 0xfa, 0x4b, 0xcf, 0xa2, 0xdc, 0xde, 0x59, 0x1a, 0x8a, 0xf7, 0x12, 0xf8, 0xea, 0x1c,
-	0xbe, 0x1f, 0x33, 0xf4, 0x3b, 0x06, 0x5e, 0xea, 0xe9, 0xb6, 0x72, 0x78, 0x7b, 0x3f, 0x4e, 0x93,
-	0x45, 0x4a, 0x75, 0xa8, 0xce, 0xe1, 0xb4, 0xb3, 0x1f, 0xac, 0x02, 0x0c, 0xd5, 0xa2, 0xf8, 0xfb,
-	0x65, 0xc8, 0x8f, 0xef, 0xc9, 0x60, 0xeb, 0x46, 0x9f, 0x25, 0xcc, 0xc8, 0xb3, 0x64, 0xe4, 0x65,
-	0x94, 0xf9, 0x80, 0x2f, 0xa3, 0x6c, 0xfc, 0x65, 0x74, 0x02, 0xab, 0x17, 0xba, 0xd9, 0x26, 0x86,
-	0xd6, 0xd2, 0x7b, 0x2e, 0x09, 0xf5, 0x77, 0x3f, 0xed, 0x64, 0x87, 0xd4, 0x57, 0xf0, 0x5d, 0x71,
-	0xee, 0x62, 0xf8, 0x07, 0x7d, 0x3d, 0x53, 0x24, 0x82, 0xb5, 0x26, 0xdd, 0x5b, 0x24, 0xe2, 0xf2,
-	0x37, 0x5b, 0x25, 0xbe, 0x9c, 0xaa, 0x12, 0xc1, 0xaa, 0x11, 0xef, 0xa5, 0x12, 0x09, 0x5c, 0xa6,
-	0xc8, 0xc4, 0x97, 0x53, 0x65, 0x62, 0x29, 0x3d, 0x8f, 0x99, 0xd7, 0xb2, 0xe9, 0x3a, 0xf1, 0xcf,
-	0x7b, 0xeb, 0xc4, 0x32, 0xe5, 0xf8, 0xe6, 0xc3, 0xe9, 0x44, 0x02, 0xf1, 0x7b, 0x0a, 0xc5, 0x1f,
-	0x18, 0x28, 0xe9, 0x29, 0x4f, 0xcc, 0xfc, 0x0a, 0xcd, 0x43, 0x7e, 0x1f, 0xa5, 0x48, 0x60, 0x9e,
-	0x7a, 0xfe, 0x98, 0x56, 0x74, 0x93, 0xa4, 0x82, 0xe6, 0xe7, 0x22, 0xd5, 0x7f, 0x25, 0xbb, 0xd7,
-	0x5a, 0xf8, 0xf8, 0x18, 0x5c, 0x43, 0xca, 0x69, 0x37, 0x6a, 0x18, 0x08, 0xaf, 0x7a, 0x91, 0xa8,
-	0xdb, 0x5f, 0x31, 0xb0, 0x3c, 0xd0, 0x0c, 0xf4, 0x08, 0xd6, 0x54, 0x5e, 0x39, 0xd6, 0xd4, 0xb3,
-	0x86, 0xa8, 0x49, 0xb5, 0x13, 0x5e, 0x96, 0x2a, 0xec, 0x1c, 0xfa, 0x18, 0xd0, 0xd0, 0xac, 0x62,
-	0xbe, 0xa6, 0x1c, 0x8a, 0x98, 0x65, 0xd0, 0x3a, 0x3c, 0x8c, 0xd8, 0xa5, 0xd7, 0x22, 0x66, 0x33,
-	0xe8, 0x31, 0x3c, 0x1a, 0x1a, 0xb1, 0xd8, 0x90, 0x25, 0x81, 0x57, 0xa5, 0x7a, 0x8d, 0xcd, 0xa2,
-	0x27, 0xb0, 0x31, 0x1c, 0x12, 0x70, 0x5d, 0x51, 0x34, 0x41, 0x6e, 0x2a, 0xaa, 0x88, 0xd9, 0xf9,
-	0xed, 0xbf, 0x24, 0x7c, 0x97, 0xa2, 0xa4, 0x3e, 0x81, 0x67, 0x23, 0x58, 0x2d, 0x89, 0xe2, 0x2e,
-	0x7c, 0x3a, 0x09, 0xa4, 0xa8, 0x3c, 0x56, 0x35, 0xa1, 0x2a, 0xc9, 0x15, 0x4d, 0xfc, 0x5c, 0x14,
-	0x9a, 0x94, 0x0d, 0x83, 0x76, 0xa0, 0x34, 0xc9, 0x45, 0xe0, 0x6b, 0x82, 0x28, 0x47, 0xd0, 0x99,
-	0x69, 0x68, 0x45, 0x3a, 0xaa, 0xf1, 0x51, 0x74, 0x16, 0x55, 0xe0, 0xe7, 0x93, 0xd0, 0x58, 0x14,
-	0xea, 0xb8, 0x12, 0xf2, 0x39, 0xad, 0xe3, 0xe3, 0x63, 0xb9, 0x7e, 0x3a, 0x74, 0xd6, 0x84, 0xfa,
-	0xeb, 0x86, 0x2c, 0xaa, 0x22, 0x3b, 0x8f, 0x5e, 0xc1, 0xee, 0xa4, 0x28, 0x7c, 0xa3, 0x21, 0x9f,
-	0x69, 0x0d, 0x1e, 0x8b, 0x35, 0x55, 0x13, 0xe4, 0xba, 0x22, 0x6a, 0x8d, 0xba, 0x2c, 0x09, 0x67,
-	0xec, 0xc2, 0xf6, 0x6f, 0xb3, 0xf0, 0x64, 0x8a, 0x4a, 0xa3, 0xef, 0xc2, 0xcb, 0x84, 0xb0, 0x87,
-	0xbc, 0x24, 0x8b, 0x15, 0x4d, 0xe0, 0x9b, 0x4a, 0xb4, 0xb0, 0xc9, 0x1c, 0x46, 0xc0, 0x95, 0xfa,
-	0x6b, 0x5e, 0xaa, 0x69, 0xb5, 0xba, 0xaa, 0xf1, 0x82, 0x2a, 0x9d, 0x88, 0x2c, 0x73, 0x47, 0x37,
-	0xf1, 0x73, 0x49, 0x51, 0x15, 0x36, 0x83, 0x7e, 0x02, 0x9f, 0xcd, 0x72, 0xf3, 0x4b, 0x76, 0xe8,
-	0x97, 0x8c, 0x97, 0xb1, 0xc8, 0x57, 0xce, 0x34, 0xdc, 0xac, 0xd5, 0xa4, 0xda, 0x11, 0x9b, 0x45,
-	0x3f, 0x84, 0xfd, 0xd4, 0xde, 0x91, 0x69, 0xe7, 0xd1, 0x4f, 0xe1, 0x47, 0x77, 0x9e, 0x76, 0xd0,
-	0xa7, 0x0a, 0xbb, 0x30, 0x61, 0xf5, 0x8d, 0xf8, 0x37, 0x6b, 0x02, 0xaf, 0x8a, 0x47, 0x75, 0x2c,
-	0xbd, 0x11, 0x2b, 0xec, 0xe2, 0xf6, 0x37, 0x0c, 0xa0, 0x23, 0xe2, 0xc5, 0x7b, 0xf3, 0x1c, 0xb6,
-	0x8e, 0x44, 0x75, 0x6a, 0x47, 0x5e, 0x40, 0x31, 0x19, 0xa2, 0x88, 0xf8, 0x44, 0x12, 0x44, 0xed,
-	0xa0, 0xa9, 0x9c, 0xb1, 0xcc, 0xe4, 0x50, 0xfe, 0x4e, 0xad, 0x37, 0x55, 0x36, 0x83, 0x38, 0xd8,
-	0x9e, 0x10, 0xaa, 0xca, 0xe3, 0x8a, 0x56, 0x3f, 0xad, 0x89, 0x58, 0xa9, 0x4a, 0x0d, 0x4d, 0xae,
-	0x2b, 0x2a, 0x9b, 0x45, 0x2f, 0xe1, 0x93, 0x64, 0xfc, 0x68, 0x76, 0xf3, 0x07, 0xc2, 0x5f, 0xdf,
-	0x3d, 0x65, 0xfe, 0xfe, 0xee, 0x29, 0xf3, 0xef, 0x77, 0x4f, 0x99, 0x37, 0xaf, 0x2e, 0x4d, 0xef,
-	0xaa, 0x77, 0xce, 0xb5, 0xec, 0x4e, 0x79, 0xe4, 0x0b, 0x35, 0x77, 0x49, 0xac, 0xe0, 0x93, 0xf8,
-	0xf0, 0x73, 0xfa, 0x8f, 0x83, 0x5f, 0x37, 0xbb, 0xe7, 0x8b, 0x74, 0x64, 0xff, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xdf, 0xb1, 0x0e, 0xed, 0x78, 0x17, 0x00, 0x00,
+	// 1730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x93, 0xdb, 0x48,
+	0x15, 0x1f, 0xd9, 0xf3, 0xf9, 0x66, 0x36, 0xa3, 0xe9, 0x90, 0x8d, 0x93, 0x90, 0xc4, 0xf1, 0x52,
+	0x89, 0x6b, 0xc8, 0xda, 0xcc, 0x84, 0xc0, 0x16, 0xdf, 0x8a, 0xac, 0x8c, 0x45, 0x1c, 0xdb, 0xd5,
+	0x92, 0x27, 0x3b, 0xb9, 0xa8, 0x64, 0xbb, 0x67, 0xa2, 0x8a, 0x2d, 0x69, 0xa5, 0xd6, 0x04, 0xdf,
+	0xd9, 0x2d, 0x8e, 0xec, 0x9f, 0x00, 0x17, 0x0a, 0xee, 0x1c, 0xb8, 0x71, 0x83, 0x0b, 0x55, 0xc0,
+	0x81, 0xcb, 0x5e, 0xa8, 0xfc, 0x05, 0xfc, 0x09, 0x94, 0x5a, 0xf2, 0xe8, 0xc3, 0xb2, 0xad, 0xcc,
+	0xe6, 0xc0, 0x81, 0x9b, 0xd5, 0xfd, 0x7b, 0xaf, 0x7f, 0xef, 0xbd, 0xee, 0x5f, 0x7f, 0x18, 0x2a,
+	0x5e, 0x9f, 0x38, 0xf5, 0x81, 0x3e, 0x24, 0xe6, 0x80, 0xd4, 0xdd, 0x57, 0xba, 0x43, 0x86, 0xf5,
+	0xf3, 0x83, 0xfa, 0x67, 0x1e, 0xf1, 0x48, 0xcd, 0x76, 0x2c, 0x6a, 0xa1, 0x0f, 0x7d, 0x4c, 0x2d,
+	0xc4, 0xd4, 0x02, 0x4c, 0xed, 0xfc, 0xe0, 0xe6, 0xdd, 0x33, 0xcb, 0x3a, 0x1b, 0x91, 0x3a, 0x43,
+	0xf5, 0xbd, 0xd3, 0x3a, 0x35, 0xc6, 0xc4, 0xa5, 0xfa, 0xd8, 0x0e, 0x0c, 0x6f, 0x96, 0x13, 0xce,
+	0x75, 0xdb, 0xf0, 0x3d, 0x0f, 0xac, 0xf1, 0xd8, 0x32, 0x43, 0xc4, 0xbd, 0x2c, 0xc4, 0x2b, 0xc3,
+	0xa5, 0x96, 0x33, 0x09, 0x21, 0x95, 0x2c, 0xc8, 0x1b, 0xcb, 0x79, 0x7d, 0x3a, 0xb2, 0xde, 0x04,
+	0x98, 0xca, 0x57, 0x05, 0xf8, 0x86, 0xe8, 0x58, 0xae, 0x2b, 0x8e, 0x3c, 0x97, 0x12, 0x47, 0xd5,
+	0xdd, 0xd7, 0xb2, 0x79, 0x6a, 0xa1, 0x5b, 0xb0, 0x35, 0xb4, 0xc6, 0xba, 0x61, 0x6a, 0xc6, 0xb0,
+	0xc4, 0x95, 0xb9, 0xea, 0x16, 0xde, 0x0c, 0x1a, 0xe4, 0x21, 0xea, 0x01, 0x9a, 0xfa, 0xd1, 0xc8,
+	0x2f, 0xc8, 0xc0, 0xa3, 0x86, 0x65, 0x96, 0x0a, 0x65, 0xae, 0xba, 0x7d, 0x78, 0xbf, 0x96, 0x08,
+	0x5a, 0xb7, 0x8d, 0xda, 0xf9, 0x41, 0xed, 0x45, 0x08, 0x97, 0xa6, 0x68, 0xbc, 0xf7, 0x26, 0xdd,
+	0x84, 0x64, 0xd8, 0xa2, 0xba, 0xfb, 0x5a, 0xa3, 0x13, 0x9b, 0x94, 0x8a, 0x65, 0xae, 0x7a, 0xe5,
+	0xf0, 0x61, 0x2d, 0x3b, 0x85, 0xb5, 0x34, 0x69, 0x75, 0x62, 0x13, 0xbc, 0x49, 0xc3, 0x5f, 0xe8,
+	0x36, 0x00, 0x73, 0xe5, 0x52, 0x9d, 0x92, 0xd2, 0x6a, 0x99, 0xab, 0xae, 0x61, 0xe6, 0x5c, 0xf1,
+	0x1b, 0xd0, 0x75, 0xd8, 0x60, 0xdd, 0xc6, 0xb0, 0xb4, 0x56, 0xe6, 0xaa, 0x45, 0xbc, 0xee, 0x7f,
+	0xca, 0x43, 0xd4, 0x82, 0xab, 0xe7, 0x86, 0x6b, 0xf4, 0x8d, 0x91, 0x41, 0x27, 0xea, 0xb4, 0x2a,
+	0xa5, 0x75, 0x16, 0xda, 0xcd, 0x5a, 0x50, 0xb7, 0xda, 0xb4, 0x6e, 0xb5, 0x0b, 0x04, 0xce, 0x32,
+	0xab, 0xfc, 0xab, 0x00, 0xdf, 0x89, 0x13, 0x55, 0xa8, 0xee, 0x50, 0xf1, 0x95, 0x31, 0x1a, 0x46,
+	0x79, 0x20, 0x9f, 0x79, 0xc4, 0xa5, 0x02, 0xa5, 0x8e, 0xd1, 0xf7, 0x28, 0x71, 0x51, 0x15, 0x78,
+	0xaa, 0x3b, 0x67, 0x84, 0x6a, 0xe9, 0x02, 0x5c, 0x09, 0xda, 0x1b, 0xd3, 0x32, 0xdc, 0x06, 0x70,
+	0x02, 0x73, 0x1f, 0x53, 0x60, 0x98, 0xad, 0xb0, 0x45, 0x1e, 0xa2, 0x87, 0x80, 0x0c, 0xd3, 0xa0,
+	0x86, 0x4e, 0xc9, 0x50, 0x23, 0xe7, 0xc4, 0x64, 0xb0, 0x22, 0x8b, 0x97, 0xbf, 0xe8, 0x91, 0xfc,
+	0x0e, 0x79, 0x88, 0xbe, 0xe0, 0xe0, 0x66, 0x1a, 0xae, 0x5f, 0xb0, 0x62, 0x29, 0xdc, 0x3e, 0x6c,
+	0x66, 0x16, 0x37, 0x0a, 0x6b, 0xa6, 0xcc, 0x72, 0x62, 0x98, 0x28, 0x4a, 0x5c, 0x32, 0xe6, 0xf4,
+	0xa0, 0x0a, 0x7c, 0x10, 0xc6, 0xef, 0x78, 0xe6, 0xb4, 0x42, 0x5b, 0x78, 0x3b, 0x68, 0xc4, 0x9e,
+	0x29, 0x0f, 0x2b, 0x3f, 0x87, 0x83, 0xa5, 0x79, 0x75, 0x6d, 0xcb, 0x74, 0x49, 0xcc, 0xf1, 0x35,
+	0x58, 0x77, 0xbc, 0x58, 0x3a, 0xd7, 0x1c, 0xe6, 0xeb, 0x4f, 0x05, 0x78, 0x18, 0x77, 0x26, 0xea,
+	0xe6, 0x80, 0x8c, 0xde, 0x4b, 0x81, 0xfa, 0x70, 0x23, 0x44, 0x7e, 0xed, 0xe5, 0x72, 0x3d, 0x70,
+	0x34, 0xd3, 0x91, 0x9a, 0x04, 0xc5, 0x7c, 0x93, 0x60, 0x75, 0xce, 0x24, 0xa8, 0xc1, 0xd5, 0x81,
+	0x9f, 0xc6, 0x88, 0xaf, 0x65, 0x8e, 0x26, 0xac, 0x02, 0x9b, 0x78, 0x6f, 0x10, 0x2f, 0x71, 0xc7,
+	0x1c, 0x4d, 0x2a, 0x75, 0xf8, 0x78, 0x61, 0xea, 0xd2, 0x35, 0xa8, 0xfc, 0xb1, 0x98, 0x4c, 0xb6,
+	0x62, 0x9c, 0x99, 0xfa, 0xff, 0x93, 0x9d, 0x27, 0xd9, 0xe8, 0x2e, 0x6c, 0xbb, 0x2c, 0x5d, 0x9a,
+	0xa9, 0x8f, 0x09, 0xd3, 0xa4, 0x2d, 0x0c, 0x41, 0x53, 0x5b, 0x1f, 0x13, 0xf4, 0x53, 0xd8, 0x09,
+	0x01, 0x86, 0x69, 0x7b, 0xb4, 0xb4, 0xc1, 0x82, 0xfe, 0x66, 0x66, 0xd0, 0x5d, 0x7d, 0x32, 0xb2,
+	0xf4, 0x21, 0x0e, 0x5d, 0xca, 0xbe, 0x01, 0x2a, 0xc1, 0xc6, 0xc0, 0x32, 0xa9, 0x63, 0x8d, 0x4a,
+	0x9b, 0x65, 0xae, 0xba, 0x83, 0xa7, 0x9f, 0xe9, 0x42, 0xcf, 0x94, 0x6d, 0xa6, 0xd0, 0x7f, 0x2b,
+	0x80, 0x10, 0xb7, 0xc0, 0x64, 0x60, 0x39, 0xc3, 0x6c, 0x91, 0x10, 0xad, 0xb1, 0x3d, 0x22, 0x94,
+	0xfc, 0xaf, 0x57, 0xff, 0xdd, 0x04, 0xb5, 0x05, 0xfc, 0x20, 0x08, 0xcc, 0xb0, 0xcc, 0x00, 0x1e,
+	0xaa, 0xe8, 0xbd, 0x4c, 0x22, 0xcd, 0x60, 0xf3, 0x66, 0xe6, 0x78, 0x37, 0x32, 0x65, 0x0d, 0x95,
+	0x06, 0x3c, 0x79, 0xf7, 0x74, 0xce, 0x54, 0xe5, 0x3f, 0x1c, 0x94, 0x05, 0xdb, 0x1e, 0x4d, 0xba,
+	0xba, 0x43, 0x4c, 0x2a, 0x8e, 0x2c, 0x97, 0x74, 0xad, 0x91, 0x31, 0x98, 0xc4, 0x92, 0x7e, 0x1f,
+	0x76, 0x83, 0x79, 0x99, 0xce, 0xf9, 0x07, 0xac, 0xf9, 0x22, 0xe5, 0xfb, 0xb0, 0x97, 0x9a, 0xbf,
+	0x17, 0xbb, 0xd0, 0x6e, 0x62, 0xf6, 0xca, 0x43, 0x54, 0x86, 0x9d, 0x00, 0x1b, 0x2a, 0x70, 0xb0,
+	0x74, 0x80, 0xb5, 0x31, 0x49, 0x47, 0xc7, 0x70, 0xd5, 0x66, 0xa4, 0xb4, 0x81, 0xcf, 0x4a, 0xb3,
+	0x19, 0x2d, 0x96, 0xb1, 0x2b, 0x73, 0x4a, 0x37, 0x13, 0x04, 0xde, 0xb3, 0xd3, 0x4d, 0x95, 0x3f,
+	0x73, 0xf0, 0x28, 0x9e, 0xb9, 0xec, 0xf0, 0x67, 0xa7, 0xde, 0xaf, 0x38, 0xf8, 0x96, 0xee, 0x63,
+	0xb5, 0x0c, 0x5a, 0xf1, 0x9d, 0x91, 0x2b, 0x17, 0xab, 0xdb, 0x87, 0x9f, 0xcc, 0x3b, 0xa8, 0x2c,
+	0x4b, 0x37, 0x2e, 0xeb, 0x4b, 0x10, 0x95, 0xef, 0xc1, 0x77, 0xf3, 0x44, 0x30, 0x53, 0xed, 0x2f,
+	0x37, 0xe0, 0x7a, 0xfa, 0x9c, 0x14, 0x06, 0x7a, 0x71, 0xd6, 0x32, 0xcc, 0x53, 0x8b, 0x95, 0x77,
+	0x3b, 0xff, 0x59, 0xcb, 0x3f, 0x20, 0x06, 0x67, 0x2d, 0xff, 0x17, 0xfa, 0x35, 0x07, 0xb7, 0xdd,
+	0xd9, 0x1d, 0x38, 0x22, 0x52, 0x2a, 0x64, 0x1d, 0x1e, 0xb2, 0xfd, 0xe7, 0x39, 0x22, 0x35, 0x57,
+	0xf0, 0xe2, 0x01, 0xd1, 0x2f, 0x39, 0xb8, 0x31, 0x48, 0x6e, 0x46, 0x31, 0x3a, 0x45, 0x46, 0xa7,
+	0x91, 0x87, 0xce, 0xb2, 0xc3, 0x40, 0x73, 0x05, 0xcf, 0x1f, 0x88, 0xd1, 0x70, 0x93, 0x52, 0x29,
+	0xa4, 0x8f, 0x54, 0xb9, 0x68, 0x2c, 0xdb, 0x26, 0x7d, 0x1a, 0x73, 0x07, 0x42, 0xff, 0xe0, 0xe0,
+	0xb1, 0x73, 0x19, 0xfd, 0x65, 0x7b, 0xd1, 0xf6, 0xe1, 0x49, 0x1e, 0x8a, 0x97, 0x12, 0xf8, 0xe6,
+	0x0a, 0xbe, 0x1c, 0x33, 0xf4, 0x3b, 0x0e, 0x1e, 0xe8, 0xf9, 0x96, 0x72, 0x78, 0x7a, 0x7f, 0x96,
+	0x27, 0x8a, 0x9c, 0xea, 0xd0, 0x5c, 0xc1, 0x79, 0x47, 0x7f, 0xb2, 0x03, 0x10, 0xa9, 0x45, 0xe5,
+	0xf7, 0x9b, 0x50, 0x9a, 0x5d, 0x93, 0xc1, 0xd2, 0x8d, 0x5f, 0x4b, 0xb8, 0xc4, 0xb5, 0x24, 0x71,
+	0x33, 0x2a, 0xbc, 0xc7, 0x9b, 0x51, 0x31, 0x7d, 0x33, 0x3a, 0x86, 0x9d, 0x53, 0xdd, 0x18, 0x91,
+	0xa1, 0x36, 0xd0, 0x3d, 0x97, 0x84, 0xfa, 0xfb, 0x28, 0xef, 0x60, 0x4f, 0x99, 0xad, 0xe8, 0x9b,
+	0xe2, 0xed, 0xd3, 0xe8, 0x03, 0x7d, 0xb9, 0x54, 0x24, 0x82, 0xb9, 0x26, 0x5f, 0x5a, 0x24, 0xd2,
+	0xf2, 0xb7, 0x5c, 0x25, 0x3e, 0x5f, 0xa8, 0x12, 0xc1, 0xac, 0x91, 0x2e, 0xa5, 0x12, 0x19, 0x5c,
+	0x16, 0xc8, 0xc4, 0xe7, 0x0b, 0x65, 0x62, 0x23, 0x3f, 0x8f, 0xa5, 0xc7, 0xb2, 0xc5, 0x3a, 0xf1,
+	0xcf, 0x4b, 0xeb, 0xc4, 0x26, 0xe3, 0xf8, 0xf2, 0xfd, 0xe9, 0x44, 0x06, 0xf1, 0x4b, 0x0a, 0xc5,
+	0x1f, 0x38, 0xa8, 0xea, 0x39, 0x77, 0xcc, 0xd2, 0x16, 0x8b, 0xa3, 0xf5, 0x75, 0x94, 0x22, 0x83,
+	0x79, 0xee, 0xf1, 0x53, 0x5a, 0x61, 0x67, 0x49, 0x05, 0x8b, 0xcf, 0x45, 0xaa, 0x7f, 0x4b, 0x76,
+	0x5f, 0x6b, 0xe1, 0xe5, 0x63, 0x7a, 0x0c, 0xa9, 0xe7, 0x5d, 0xa8, 0xa1, 0x23, 0xbc, 0x43, 0xa3,
+	0x0f, 0xb7, 0xf2, 0x9b, 0x02, 0xf0, 0xd3, 0xdc, 0x5e, 0x3c, 0x05, 0xfd, 0x38, 0x2e, 0x3e, 0x1c,
+	0xd3, 0x83, 0xf2, 0xbc, 0x61, 0x32, 0x04, 0xe7, 0x06, 0x6c, 0xba, 0x5e, 0x3f, 0x92, 0xae, 0x35,
+	0xbc, 0xe1, 0x7a, 0x7d, 0xd6, 0x15, 0xd3, 0xbb, 0x62, 0x42, 0xef, 0x44, 0xd8, 0x8d, 0xde, 0x53,
+	0x34, 0xff, 0x75, 0xac, 0xb4, 0xba, 0xf4, 0x09, 0xe6, 0x4a, 0xf2, 0x09, 0xc6, 0xbf, 0xcd, 0x9c,
+	0x13, 0xc7, 0xf5, 0x2f, 0x00, 0xc1, 0x23, 0xcf, 0xf4, 0xd3, 0xef, 0xd1, 0x29, 0x25, 0x63, 0x9b,
+	0xb2, 0x55, 0xbe, 0x86, 0xa7, 0x9f, 0xe8, 0x1e, 0xec, 0x0c, 0x82, 0x24, 0x05, 0x97, 0xac, 0x8d,
+	0xe0, 0xed, 0x21, 0x6c, 0xf3, 0x6f, 0x59, 0xfb, 0x5f, 0x70, 0xb0, 0x39, 0x0d, 0x13, 0x5d, 0x83,
+	0x3d, 0x55, 0x50, 0x9e, 0x69, 0xea, 0x49, 0x57, 0xd2, 0xe4, 0xf6, 0xb1, 0xd0, 0x92, 0x1b, 0xfc,
+	0x0a, 0xfa, 0x10, 0x50, 0xd4, 0xac, 0x62, 0xa1, 0xad, 0x3c, 0x95, 0x30, 0xcf, 0xa1, 0xab, 0xb0,
+	0x1b, 0x6b, 0x97, 0x9f, 0x4b, 0x98, 0x2f, 0xa0, 0x1b, 0x70, 0x2d, 0x6a, 0xc4, 0x52, 0xb7, 0x25,
+	0x8b, 0x82, 0x2a, 0x77, 0xda, 0x7c, 0x11, 0xdd, 0x82, 0xeb, 0x51, 0x97, 0x88, 0x3b, 0x8a, 0xa2,
+	0x89, 0xad, 0x9e, 0xa2, 0x4a, 0x98, 0x5f, 0xdd, 0xff, 0x4b, 0xc6, 0xdb, 0x1d, 0x23, 0xf5, 0x11,
+	0xdc, 0x4d, 0x60, 0xb5, 0x2c, 0x8a, 0x07, 0xf0, 0xf1, 0x3c, 0x90, 0xa2, 0x0a, 0x58, 0xd5, 0xc4,
+	0xa6, 0xdc, 0x6a, 0x68, 0xd2, 0xa7, 0x92, 0xd8, 0x63, 0x6c, 0x38, 0xf4, 0x10, 0xaa, 0xf3, 0x4c,
+	0x44, 0xa1, 0x2d, 0x4a, 0xad, 0x18, 0xba, 0xb0, 0x08, 0xad, 0xc8, 0x47, 0x6d, 0x21, 0x8e, 0x2e,
+	0xa2, 0x06, 0xfc, 0x6c, 0x1e, 0x1a, 0x4b, 0x62, 0x07, 0x37, 0x42, 0x3e, 0x2f, 0x3a, 0xf8, 0xd9,
+	0xb3, 0x56, 0xe7, 0x45, 0x64, 0xac, 0x89, 0x9d, 0xe7, 0xdd, 0x96, 0xa4, 0x4a, 0xfc, 0x2a, 0x7a,
+	0x0c, 0x07, 0xf3, 0xbc, 0x08, 0xdd, 0x6e, 0xeb, 0x44, 0xeb, 0x0a, 0x58, 0x6a, 0xab, 0x9a, 0xd8,
+	0xea, 0x28, 0x92, 0xd6, 0xed, 0xb4, 0x64, 0xf1, 0x84, 0x5f, 0xdb, 0xff, 0x6d, 0x11, 0x6e, 0x2d,
+	0xd8, 0xc9, 0xd0, 0xb7, 0xe1, 0x41, 0x86, 0xdb, 0xa7, 0x82, 0xdc, 0x92, 0x1a, 0x9a, 0x28, 0xf4,
+	0x94, 0x78, 0x62, 0xb3, 0x39, 0x24, 0xc0, 0x8d, 0xce, 0x73, 0x41, 0x6e, 0x6b, 0xed, 0x8e, 0xaa,
+	0x09, 0xa2, 0x2a, 0x1f, 0x4b, 0x3c, 0xf7, 0x8e, 0x66, 0xd2, 0xa7, 0xb2, 0xa2, 0x2a, 0x7c, 0x01,
+	0xfd, 0x08, 0x3e, 0x59, 0x66, 0xe6, 0xa7, 0xec, 0xa9, 0x9f, 0x32, 0xa1, 0x85, 0x25, 0xa1, 0x71,
+	0xa2, 0xe1, 0x5e, 0xbb, 0x2d, 0xb7, 0x8f, 0xf8, 0x22, 0xfa, 0x3e, 0x3c, 0xca, 0x6d, 0x1d, 0x1b,
+	0x76, 0x15, 0xfd, 0x04, 0x7e, 0xf0, 0xce, 0xc3, 0x4e, 0xeb, 0xd4, 0xe0, 0xd7, 0xe6, 0xcc, 0xbe,
+	0x84, 0x7d, 0xaf, 0x2d, 0x0a, 0xaa, 0x74, 0xd4, 0xc1, 0xf2, 0x4b, 0xa9, 0xc1, 0xaf, 0xef, 0x7f,
+	0xc5, 0x01, 0x3a, 0x22, 0x34, 0x5d, 0x9b, 0x7b, 0x70, 0xfb, 0x48, 0x52, 0x17, 0x56, 0xe4, 0x3e,
+	0x54, 0xb2, 0x21, 0x8a, 0x84, 0x8f, 0x65, 0x51, 0xd2, 0x9e, 0xf4, 0x94, 0x13, 0x9e, 0x9b, 0xef,
+	0xca, 0x5f, 0xa9, 0x9d, 0x9e, 0xca, 0x17, 0x50, 0x0d, 0xf6, 0xe7, 0xb8, 0x6a, 0x0a, 0xb8, 0xa1,
+	0x75, 0x5e, 0xb4, 0x25, 0xac, 0x34, 0xe5, 0xae, 0xd6, 0xea, 0x28, 0x2a, 0x5f, 0x44, 0x0f, 0xe0,
+	0xa3, 0x6c, 0x7c, 0x32, 0xba, 0xd5, 0x27, 0xe2, 0x5f, 0xdf, 0xde, 0xe1, 0xfe, 0xfe, 0xf6, 0x0e,
+	0xf7, 0xef, 0xb7, 0x77, 0xb8, 0x97, 0x8f, 0xcf, 0x0c, 0xfa, 0xca, 0xeb, 0xd7, 0x06, 0xd6, 0xb8,
+	0x9e, 0x78, 0xc5, 0xaf, 0x9d, 0x11, 0x33, 0xf8, 0xdb, 0x20, 0xfa, 0xcb, 0xe1, 0x87, 0xc1, 0xaf,
+	0xf3, 0x83, 0xfe, 0x3a, 0xeb, 0x79, 0xf4, 0xdf, 0x01, 0x00, 0xe3, 0x97, 0xee, 0xb6, 0x9c, 0x18,
+	0x00, 0x00,
 }
 
 func (m *CrossClusterTaskInfo) Marshal() (dAtA []byte, err error) {
@@ -2408,6 +2511,77 @@ func (m *CrossClusterTaskRequests) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Version != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VisibilityTime != nil {
+		{
+			size, err := m.VisibilityTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SubType != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.SubType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQueue(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQueue(v)
 	base := offset
@@ -2875,6 +3049,41 @@ func (m *CrossClusterTaskRequests) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskType != 0 {
+		n += 1 + sovQueue(uint64(m.TaskType))
+	}
+	if m.SubType != 0 {
+		n += 1 + sovQueue(uint64(m.SubType))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovQueue(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = m.VisibilityTime.Size()
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQueue(uint64(m.Version))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovQueue(uint64(m.Attempt))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovQueue(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5138,6 +5347,220 @@ func (m *CrossClusterTaskRequests) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+			}
+			m.SubType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = &types.Timestamp{}
+			}
+			if err := m.VisibilityTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQueue(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -880,6 +880,8 @@ type ReplicationTaskInfo struct {
 	FirstEventId         int64                 `protobuf:"varint,6,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
 	NextEventId          int64                 `protobuf:"varint,7,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
 	ScheduledId          int64                 `protobuf:"varint,8,opt,name=scheduled_id,json=scheduledId,proto3" json:"scheduled_id,omitempty"`
+	DeadReason           string                `protobuf:"bytes,9,opt,name=dead_reason,json=deadReason,proto3" json:"dead_reason,omitempty"`
+	RedriveAttempts      int32                 `protobuf:"varint,10,opt,name=redrive_attempts,json=redriveAttempts,proto3" json:"redrive_attempts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -974,6 +976,20 @@ func (m *ReplicationTaskInfo) GetScheduledId() int64 {
 	return 0
 }
 
+func (m *ReplicationTaskInfo) GetDeadReason() string {
+	if m != nil {
+		return m.DeadReason
+	}
+	return ""
+}
+
+func (m *ReplicationTaskInfo) GetRedriveAttempts() int32 {
+	if m != nil {
+		return m.RedriveAttempts
+	}
+	return 0
+}
+
 type ReplicationToken struct {
 	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	// lastRetrievedMessageId is where the next fetch should begin with.
@@ -1109,102 +1125,104 @@ func init() {
 }
 
 var fileDescriptor_00df2ec6c2eaefe5 = []byte{
-	// 1506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0x1a, 0x49,
-	0x12, 0x76, 0x83, 0xf8, 0x51, 0x0a, 0x01, 0x2a, 0x69, 0x2d, 0x2c, 0x59, 0x5e, 0xcc, 0xca, 0x96,
-	0x2c, 0x6f, 0x80, 0x2d, 0x87, 0x37, 0xf6, 0x27, 0x36, 0x1c, 0x6d, 0x81, 0x42, 0xbd, 0xd6, 0x9f,
-	0x8b, 0xb6, 0x1c, 0xda, 0xc3, 0x74, 0xb4, 0xe8, 0x92, 0xe8, 0x10, 0x74, 0x13, 0x5d, 0x05, 0x32,
-	0xc7, 0x99, 0xfb, 0x1c, 0x67, 0x2e, 0x73, 0x9c, 0xe7, 0x98, 0xeb, 0xc4, 0x1c, 0xfd, 0x08, 0x13,
-	0x7e, 0x92, 0x89, 0xae, 0xaa, 0x06, 0x1a, 0x1a, 0x2c, 0x87, 0x0f, 0x73, 0x53, 0x67, 0x7e, 0x5f,
-	0x66, 0x56, 0x66, 0x56, 0x66, 0x09, 0xd8, 0xee, 0x5e, 0x10, 0xaf, 0xd2, 0x30, 0x2d, 0xe2, 0x34,
-	0x48, 0x85, 0x36, 0x4d, 0x8f, 0x58, 0x95, 0xde, 0xf3, 0x8a, 0x47, 0x3a, 0x2d, 0xbb, 0x61, 0x32,
-	0xdb, 0x75, 0xca, 0x1d, 0xcf, 0x65, 0x2e, 0xba, 0xeb, 0x23, 0xcb, 0x12, 0x59, 0x16, 0xc8, 0x72,
-	0xef, 0xf9, 0xda, 0x5f, 0xaf, 0x5c, 0xf7, 0xaa, 0x45, 0x2a, 0x1c, 0x75, 0xd1, 0xbd, 0xac, 0x30,
-	0xbb, 0x4d, 0x28, 0x33, 0xdb, 0x1d, 0x41, 0x5c, 0x2b, 0x86, 0x5c, 0x98, 0x1d, 0xdb, 0xb7, 0xdf,
-	0x70, 0xdb, 0xed, 0xc0, 0x74, 0x34, 0xc2, 0x72, 0xdb, 0xa6, 0x1d, 0x20, 0x36, 0xa7, 0x84, 0xd9,
-	0xb4, 0x29, 0x73, 0xbd, 0xbe, 0x40, 0x95, 0x7e, 0x8c, 0xc1, 0x32, 0x1e, 0x06, 0x7e, 0x44, 0x28,
-	0x35, 0xaf, 0x08, 0x45, 0x3a, 0x2c, 0x8d, 0x9c, 0xc7, 0x60, 0x26, 0xbd, 0xa6, 0x05, 0xa5, 0x18,
-	0xdf, 0x5e, 0xd8, 0xdd, 0x2a, 0x47, 0x1f, 0xab, 0x3c, 0x62, 0x47, 0x37, 0xe9, 0x35, 0xce, 0x7b,
-	0x61, 0x01, 0x45, 0xff, 0x82, 0x7b, 0x2d, 0x93, 0x32, 0xc3, 0x23, 0xcc, 0xb3, 0x49, 0x8f, 0x58,
-	0x46, 0x5b, 0x38, 0x34, 0x6c, 0xab, 0x10, 0x2b, 0x2a, 0xdb, 0x71, 0x7c, 0xd7, 0x07, 0xe0, 0x40,
-	0x2f, 0xe3, 0xd1, 0x2c, 0x74, 0x0f, 0xd2, 0x4d, 0x93, 0x1a, 0x6d, 0xd7, 0x23, 0x85, 0x78, 0x51,
-	0xd9, 0x4e, 0xe3, 0x54, 0xd3, 0xa4, 0x47, 0xae, 0x47, 0x50, 0x1d, 0x96, 0x68, 0xdf, 0x69, 0x18,
-	0x7e, 0x24, 0x96, 0x41, 0x99, 0xc9, 0xba, 0xb4, 0x30, 0x57, 0x54, 0x66, 0xc5, 0x5a, 0xef, 0x3b,
-	0x8d, 0xba, 0x8f, 0xaf, 0x73, 0x38, 0xce, 0xd1, 0xb0, 0xa0, 0xf4, 0x43, 0x12, 0x72, 0x63, 0x07,
-	0x42, 0x07, 0x30, 0xef, 0x27, 0xc2, 0x60, 0xfd, 0x0e, 0x29, 0x28, 0x45, 0x65, 0x3b, 0xbb, 0xfb,
-	0xf4, 0x96, 0xc9, 0xd0, 0xfb, 0x1d, 0x82, 0xd3, 0x4c, 0xfe, 0x85, 0x36, 0x21, 0x4b, 0xdd, 0xae,
-	0xd7, 0x20, 0x3c, 0xb3, 0xc3, 0xd3, 0x67, 0x84, 0xd4, 0x67, 0x68, 0x16, 0x7a, 0x05, 0x8b, 0x0d,
-	0x8f, 0xc8, 0x0a, 0xd8, 0x6d, 0x71, 0xf0, 0x85, 0xdd, 0xb5, 0xb2, 0xe8, 0x9f, 0x72, 0xd0, 0x3f,
-	0x65, 0x3d, 0xe8, 0x1f, 0x9c, 0x09, 0x08, 0xbe, 0x08, 0x59, 0x70, 0x57, 0xf4, 0x84, 0x70, 0x63,
-	0x32, 0xe6, 0xd9, 0x17, 0x5d, 0x46, 0x82, 0xf4, 0xfc, 0x7d, 0x5a, 0xf4, 0x55, 0xce, 0xf2, 0xc3,
-	0x50, 0x07, 0x9c, 0x83, 0x3b, 0x78, 0xc5, 0x8a, 0x90, 0xa3, 0x6f, 0x15, 0x78, 0x38, 0x51, 0x80,
-	0x09, 0x8f, 0x09, 0xee, 0xf1, 0xe5, 0x2d, 0x0b, 0x32, 0xe1, 0x7a, 0x83, 0xce, 0x02, 0xa0, 0x1b,
-	0xe0, 0x00, 0xc3, 0x6c, 0x30, 0xbb, 0x67, 0xb3, 0xfe, 0x84, 0xfb, 0x24, 0x77, 0xbf, 0x3b, 0xcb,
-	0xbd, 0x2a, 0xb9, 0x13, 0xbe, 0xd7, 0xe8, 0x54, 0x2d, 0x72, 0x60, 0x4d, 0xde, 0x28, 0xe1, 0xb2,
-	0xb7, 0x3b, 0xea, 0x35, 0xc5, 0xbd, 0x56, 0xa6, 0x79, 0x3d, 0x10, 0x4c, 0xdf, 0xe4, 0xd9, 0x6e,
-	0xc8, 0xe5, 0x6a, 0x33, 0x5a, 0x85, 0x3a, 0xb0, 0x76, 0x69, 0xda, 0x2d, 0xb7, 0x47, 0x3c, 0xa3,
-	0x6d, 0x7a, 0xd7, 0xc4, 0x1b, 0xf5, 0x97, 0xe6, 0xfe, 0x9e, 0x4d, 0xf3, 0xb7, 0x2f, 0x99, 0x47,
-	0x9c, 0x18, 0x72, 0x58, 0xb8, 0x9c, 0xa2, 0x7b, 0x9d, 0x01, 0x18, 0x7a, 0x28, 0xfd, 0x12, 0x83,
-	0x95, 0xa8, 0xee, 0x40, 0x18, 0xf2, 0xb2, 0xd7, 0xdc, 0x0e, 0xf1, 0x78, 0x0f, 0xca, 0x3b, 0xb2,
-	0x35, 0xbb, 0xcb, 0x4e, 0x02, 0x38, 0xce, 0x59, 0x61, 0x01, 0xca, 0x42, 0x4c, 0x5e, 0x8d, 0x79,
-	0x1c, 0xb3, 0x2d, 0xf4, 0x02, 0x92, 0x02, 0x22, 0x6f, 0xc2, 0x7a, 0xd8, 0xb2, 0xd9, 0xb1, 0x87,
-	0x66, 0xb1, 0x84, 0xa2, 0x47, 0x90, 0x6d, 0xb8, 0xce, 0xa5, 0x7d, 0x65, 0xf4, 0x88, 0x47, 0xfd,
-	0xb0, 0xe6, 0xf8, 0x5d, 0x5b, 0x14, 0xd2, 0x33, 0x21, 0x44, 0x4f, 0x20, 0x3f, 0x48, 0x6c, 0x00,
-	0x4c, 0x70, 0x60, 0x2e, 0x90, 0x07, 0xd0, 0x7f, 0xc3, 0xbd, 0x8e, 0x47, 0x7a, 0xb6, 0xdb, 0xa5,
-	0xc6, 0x04, 0x27, 0xc9, 0x39, 0xab, 0x01, 0x60, 0x3f, 0xcc, 0x2d, 0xfd, 0xa4, 0xc0, 0xc6, 0xcc,
-	0x5e, 0xf7, 0xe3, 0x95, 0xb3, 0xa1, 0xd1, 0xea, 0x52, 0x46, 0x3c, 0x9e, 0xc6, 0x79, 0xbc, 0x28,
-	0xa4, 0x7b, 0x42, 0xe8, 0x0f, 0x44, 0x71, 0xdf, 0x64, 0x86, 0x12, 0x38, 0xc5, 0xbf, 0x35, 0x0b,
-	0xfd, 0x13, 0xe6, 0x07, 0x1b, 0xe5, 0x16, 0x33, 0x63, 0x08, 0x2e, 0x7d, 0x4c, 0xc0, 0xda, 0xf4,
-	0xab, 0x80, 0xd6, 0x61, 0x5e, 0xd6, 0xd8, 0xb6, 0x64, 0x54, 0x69, 0x21, 0xd0, 0x2c, 0xf4, 0x0e,
-	0xd0, 0x8d, 0xeb, 0x5d, 0x5f, 0xb6, 0xdc, 0x1b, 0x83, 0x7c, 0x20, 0x8d, 0x2e, 0x6f, 0x81, 0x18,
-	0x77, 0xff, 0x38, 0xb2, 0x50, 0xef, 0x25, 0xbc, 0x16, 0xa0, 0xf1, 0xd2, 0xcd, 0xb8, 0x08, 0x15,
-	0x20, 0x15, 0xa4, 0x36, 0xce, 0x53, 0x1b, 0x7c, 0xa2, 0x87, 0x90, 0xa1, 0x8d, 0x26, 0xb1, 0xba,
-	0x2d, 0xc2, 0xb3, 0x20, 0xca, 0xba, 0x30, 0x90, 0x69, 0x16, 0x52, 0x21, 0x3b, 0x84, 0xf0, 0x11,
-	0x9a, 0xf8, 0x6c, 0x3a, 0x16, 0x07, 0x0c, 0x3e, 0x43, 0x37, 0x00, 0x28, 0x33, 0x3d, 0x26, 0x7c,
-	0x88, 0xea, 0xce, 0x4b, 0x89, 0x66, 0xa1, 0xff, 0x42, 0x26, 0x50, 0x73, 0xfb, 0xa9, 0xcf, 0xda,
-	0x5f, 0x90, 0x78, 0x6e, 0xfd, 0x7f, 0xb0, 0xcc, 0x37, 0x62, 0x93, 0x98, 0x1e, 0xbb, 0x20, 0x26,
-	0x13, 0x56, 0xd2, 0x9f, 0xb5, 0xb2, 0xe4, 0xd3, 0x0e, 0x02, 0x16, 0xb7, 0xf5, 0x0f, 0x48, 0x59,
-	0x84, 0x99, 0x76, 0x8b, 0x16, 0xe6, 0x39, 0xff, 0x7e, 0x64, 0xd6, 0x4f, 0xcd, 0x7e, 0xcb, 0x35,
-	0x2d, 0x1c, 0x80, 0xfd, 0x0c, 0x9b, 0x8c, 0x91, 0x76, 0x87, 0x15, 0x40, 0x34, 0x92, 0xfc, 0x44,
-	0xaf, 0x20, 0xc3, 0xa3, 0xf3, 0x9b, 0xbc, 0xeb, 0x91, 0xc2, 0xc2, 0x0c, 0xb3, 0xfb, 0x02, 0x83,
-	0x17, 0x7c, 0x86, 0xfc, 0x40, 0xcf, 0x60, 0x85, 0x1b, 0xf0, 0xcb, 0x4a, 0x3c, 0xc3, 0xb6, 0x88,
-	0xc3, 0x6c, 0xd6, 0x2f, 0x64, 0x78, 0xef, 0x20, 0x5f, 0xf7, 0x9e, 0xab, 0x34, 0xa9, 0x41, 0x27,
-	0x90, 0x93, 0xf5, 0x35, 0xe4, 0x08, 0x2c, 0x2c, 0x46, 0xb5, 0xd0, 0x70, 0x8a, 0xc8, 0x9b, 0x25,
-	0x67, 0x29, 0xce, 0xf6, 0x42, 0xdf, 0xa5, 0xef, 0xe2, 0xb0, 0x3a, 0x65, 0xce, 0xa2, 0x55, 0x48,
-	0x05, 0xfb, 0x57, 0xe1, 0x85, 0x4d, 0x32, 0xb1, 0x79, 0x43, 0x8d, 0x1e, 0xbb, 0x55, 0xa3, 0xc7,
-	0xbf, 0xb6, 0xd1, 0xbf, 0x81, 0xbf, 0x8c, 0x9d, 0xdc, 0xb0, 0x19, 0x69, 0xfb, 0xbb, 0xda, 0x7f,
-	0x76, 0xed, 0xdc, 0xee, 0xfc, 0x1a, 0x23, 0x6d, 0xbc, 0xdc, 0x9b, 0x90, 0x51, 0xf4, 0x12, 0x92,
-	0xa4, 0x47, 0x1c, 0x16, 0xac, 0xe2, 0x8d, 0xe8, 0xe1, 0x69, 0x32, 0xf3, 0x75, 0xcb, 0xbd, 0xc0,
-	0x12, 0x8c, 0xf6, 0x20, 0xeb, 0x90, 0x1b, 0xc3, 0xeb, 0x3a, 0x86, 0xa4, 0x27, 0x6f, 0x43, 0xcf,
-	0x38, 0xe4, 0x06, 0x77, 0x9d, 0x1a, 0xa7, 0x94, 0x7e, 0x56, 0xa0, 0x30, 0x6d, 0xf9, 0xcc, 0x9e,
-	0x2a, 0x51, 0x63, 0x39, 0x16, 0x3d, 0x96, 0xbf, 0xf6, 0xb9, 0x54, 0xfa, 0x5e, 0x81, 0xe5, 0x70,
-	0x94, 0xba, 0x7b, 0x4d, 0x1c, 0x3f, 0xc0, 0x60, 0xd4, 0x8a, 0x47, 0x70, 0x02, 0xa7, 0xe5, 0xac,
-	0xa5, 0xe8, 0x1c, 0x72, 0x63, 0x0b, 0x59, 0xce, 0xbc, 0x2f, 0xde, 0xc2, 0x38, 0x1b, 0xde, 0xc1,
-	0xa5, 0x5f, 0xc3, 0x8f, 0x73, 0xfe, 0x2a, 0x74, 0x2e, 0xdd, 0x3f, 0x65, 0x0c, 0xaf, 0x8f, 0xbe,
-	0x7d, 0xe3, 0x7c, 0x4c, 0x0c, 0x9f, 0xb3, 0x23, 0xf7, 0x68, 0x2e, 0x74, 0x8f, 0x46, 0x86, 0x77,
-	0x22, 0x3c, 0xbc, 0x37, 0x21, 0x7b, 0x69, 0x7b, 0x94, 0x89, 0xa6, 0x1a, 0x8e, 0xd6, 0x0c, 0x97,
-	0xf2, 0xb6, 0xd1, 0x2c, 0x54, 0x82, 0x45, 0x87, 0x7c, 0x18, 0x01, 0xa5, 0xc4, 0x8c, 0xf7, 0x85,
-	0x01, 0x66, 0x7c, 0x0d, 0xa4, 0x27, 0xd6, 0x80, 0xdf, 0x7e, 0xf9, 0xd1, 0x44, 0xf2, 0xaa, 0x8e,
-	0x2e, 0x50, 0x25, 0xbc, 0x40, 0xbf, 0xe2, 0xff, 0x94, 0x80, 0xda, 0xf1, 0xdc, 0x06, 0xa1, 0x34,
-	0x4c, 0x8d, 0x0f, 0xa9, 0xa7, 0x81, 0x7e, 0x40, 0x2d, 0xbd, 0x81, 0xdc, 0xd8, 0xcb, 0x20, 0xbc,
-	0xc9, 0x95, 0x2f, 0xd8, 0xe4, 0x3b, 0x1f, 0x27, 0x7b, 0x87, 0x97, 0xea, 0x21, 0x6c, 0xe0, 0xda,
-	0xe9, 0xa1, 0xb6, 0xa7, 0xea, 0xda, 0xc9, 0xb1, 0xa1, 0xab, 0xf5, 0x37, 0x86, 0x7e, 0x7e, 0x5a,
-	0x33, 0xb4, 0xe3, 0x33, 0xf5, 0x50, 0xab, 0xe6, 0xef, 0xa0, 0x22, 0xdc, 0x8f, 0x86, 0x54, 0x4f,
-	0x8e, 0x54, 0xed, 0x38, 0xaf, 0x4c, 0x37, 0x72, 0xa0, 0xd5, 0xf5, 0x13, 0x7c, 0x9e, 0x8f, 0xa1,
-	0xa7, 0xb0, 0x15, 0x0d, 0xa9, 0x9f, 0x1f, 0xef, 0x19, 0xf5, 0x03, 0x15, 0x57, 0x8d, 0xba, 0xae,
-	0xea, 0xef, 0xea, 0xf9, 0x38, 0xda, 0x82, 0xbf, 0xcd, 0x00, 0xab, 0x7b, 0xba, 0x76, 0xa6, 0xe9,
-	0xe7, 0xf9, 0x39, 0xb4, 0x03, 0x8f, 0x67, 0x3a, 0x36, 0x8e, 0x6a, 0xba, 0x5a, 0x55, 0x75, 0x35,
-	0x9f, 0x40, 0x9b, 0x50, 0x9c, 0x8d, 0x3d, 0xdb, 0xcd, 0x27, 0xd1, 0x13, 0x78, 0x14, 0x8d, 0xda,
-	0x57, 0xb5, 0xc3, 0x93, 0xb3, 0x1a, 0x36, 0x8e, 0x54, 0xfc, 0xa6, 0x86, 0xf3, 0xa9, 0x1d, 0x1b,
-	0x72, 0x63, 0x2f, 0x56, 0x74, 0x1f, 0x0a, 0x22, 0x29, 0xc6, 0xc9, 0x69, 0x0d, 0x0b, 0x13, 0xc3,
-	0x44, 0xae, 0xc3, 0xea, 0x84, 0x76, 0x0f, 0xd7, 0x54, 0xbd, 0x96, 0x57, 0x22, 0x95, 0xef, 0x4e,
-	0xab, 0xbe, 0x32, 0xb6, 0x73, 0x0c, 0xa9, 0xea, 0xe1, 0x5b, 0x5e, 0xb0, 0x15, 0xc8, 0x57, 0x0f,
-	0xdf, 0x8e, 0xd7, 0xa8, 0x00, 0x2b, 0x03, 0xe9, 0x48, 0xfc, 0x79, 0x05, 0x2d, 0x43, 0x6e, 0xa0,
-	0x91, 0x05, 0x8b, 0xbd, 0xde, 0xfb, 0xed, 0xd3, 0x03, 0xe5, 0xe3, 0xa7, 0x07, 0xca, 0xef, 0x9f,
-	0x1e, 0x28, 0xff, 0x7f, 0x79, 0x65, 0xb3, 0x66, 0xf7, 0xa2, 0xdc, 0x70, 0xdb, 0x95, 0xd0, 0xaf,
-	0x04, 0xe5, 0x2b, 0xe2, 0x88, 0x5f, 0x25, 0x86, 0x3f, 0x18, 0xfc, 0x47, 0xfc, 0xd5, 0x7b, 0x7e,
-	0x91, 0xe4, 0x9a, 0x17, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x16, 0xd1, 0xe8, 0x01, 0x11,
-	0x00, 0x00,
+	// 1540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0xdb, 0xca,
+	0x11, 0x0f, 0x25, 0xeb, 0x6b, 0x2c, 0x4b, 0xf2, 0xda, 0x8d, 0x19, 0x3b, 0x4e, 0x14, 0xd5, 0x89,
+	0x1d, 0xa7, 0x90, 0x12, 0x07, 0x29, 0xfa, 0x81, 0x22, 0x60, 0x2c, 0x19, 0x66, 0xe3, 0xaf, 0xac,
+	0x18, 0x07, 0xee, 0xa1, 0x04, 0x2d, 0xae, 0x2d, 0xc2, 0x12, 0x29, 0x70, 0x57, 0x72, 0x74, 0x6c,
+	0xef, 0xbd, 0x14, 0x68, 0x2f, 0x3d, 0xf6, 0xef, 0xe8, 0xbd, 0xc7, 0xfc, 0x09, 0x0f, 0xf9, 0x4b,
+	0x1e, 0xb8, 0xbb, 0x94, 0x44, 0x7d, 0xc5, 0xef, 0xe5, 0xf0, 0x6e, 0xe6, 0xcc, 0xef, 0x37, 0x33,
+	0x3b, 0x33, 0x3b, 0xb3, 0x16, 0xec, 0x74, 0x2f, 0x89, 0x5f, 0x69, 0x58, 0x36, 0x71, 0x1b, 0xa4,
+	0x42, 0x9b, 0x96, 0x4f, 0xec, 0x4a, 0xef, 0x55, 0xc5, 0x27, 0x9d, 0x96, 0xd3, 0xb0, 0x98, 0xe3,
+	0xb9, 0xe5, 0x8e, 0xef, 0x31, 0x0f, 0xdd, 0x0f, 0x90, 0x65, 0x89, 0x2c, 0x0b, 0x64, 0xb9, 0xf7,
+	0x6a, 0xfd, 0xf1, 0xb5, 0xe7, 0x5d, 0xb7, 0x48, 0x85, 0xa3, 0x2e, 0xbb, 0x57, 0x15, 0xe6, 0xb4,
+	0x09, 0x65, 0x56, 0xbb, 0x23, 0x88, 0xeb, 0xc5, 0x88, 0x0b, 0xab, 0xe3, 0x04, 0xf6, 0x1b, 0x5e,
+	0xbb, 0xed, 0xb9, 0xf3, 0x10, 0xb6, 0xd7, 0xb6, 0x9c, 0x10, 0xb1, 0x35, 0x23, 0xcc, 0xa6, 0x43,
+	0x99, 0xe7, 0xf7, 0x05, 0xaa, 0xf4, 0xef, 0x18, 0xac, 0xe0, 0x61, 0xe0, 0xc7, 0x84, 0x52, 0xeb,
+	0x9a, 0x50, 0x64, 0xc0, 0xf2, 0xc8, 0x79, 0x4c, 0x66, 0xd1, 0x1b, 0xaa, 0x2a, 0xc5, 0xf8, 0xce,
+	0xe2, 0xde, 0x76, 0x79, 0xfa, 0xb1, 0xca, 0x23, 0x76, 0x0c, 0x8b, 0xde, 0xe0, 0x82, 0x1f, 0x15,
+	0x50, 0xf4, 0x7b, 0x78, 0xd0, 0xb2, 0x28, 0x33, 0x7d, 0xc2, 0x7c, 0x87, 0xf4, 0x88, 0x6d, 0xb6,
+	0x85, 0x43, 0xd3, 0xb1, 0xd5, 0x58, 0x51, 0xd9, 0x89, 0xe3, 0xfb, 0x01, 0x00, 0x87, 0x7a, 0x19,
+	0x8f, 0x6e, 0xa3, 0x07, 0x90, 0x6e, 0x5a, 0xd4, 0x6c, 0x7b, 0x3e, 0x51, 0xe3, 0x45, 0x65, 0x27,
+	0x8d, 0x53, 0x4d, 0x8b, 0x1e, 0x7b, 0x3e, 0x41, 0x75, 0x58, 0xa6, 0x7d, 0xb7, 0x61, 0x06, 0x91,
+	0xd8, 0x26, 0x65, 0x16, 0xeb, 0x52, 0x75, 0xa1, 0xa8, 0xcc, 0x8b, 0xb5, 0xde, 0x77, 0x1b, 0xf5,
+	0x00, 0x5f, 0xe7, 0x70, 0x9c, 0xa7, 0x51, 0x41, 0xe9, 0x5f, 0x49, 0xc8, 0x8f, 0x1d, 0x08, 0x1d,
+	0x42, 0x26, 0x48, 0x84, 0xc9, 0xfa, 0x1d, 0xa2, 0x2a, 0x45, 0x65, 0x27, 0xb7, 0xf7, 0xe2, 0x8e,
+	0xc9, 0x30, 0xfa, 0x1d, 0x82, 0xd3, 0x4c, 0xfe, 0x85, 0xb6, 0x20, 0x47, 0xbd, 0xae, 0xdf, 0x20,
+	0x3c, 0xb3, 0xc3, 0xd3, 0x67, 0x85, 0x34, 0x60, 0xe8, 0x36, 0x7a, 0x0b, 0x4b, 0x0d, 0x9f, 0xc8,
+	0x0a, 0x38, 0x6d, 0x71, 0xf0, 0xc5, 0xbd, 0xf5, 0xb2, 0xe8, 0x9f, 0x72, 0xd8, 0x3f, 0x65, 0x23,
+	0xec, 0x1f, 0x9c, 0x0d, 0x09, 0x81, 0x08, 0xd9, 0x70, 0x5f, 0xf4, 0x84, 0x70, 0x63, 0x31, 0xe6,
+	0x3b, 0x97, 0x5d, 0x46, 0xc2, 0xf4, 0xfc, 0x66, 0x56, 0xf4, 0x55, 0xce, 0x0a, 0xc2, 0xd0, 0x06,
+	0x9c, 0xc3, 0x7b, 0x78, 0xd5, 0x9e, 0x22, 0x47, 0x7f, 0x53, 0xe0, 0xc9, 0x44, 0x01, 0x26, 0x3c,
+	0x26, 0xb8, 0xc7, 0x37, 0x77, 0x2c, 0xc8, 0x84, 0xeb, 0x4d, 0x3a, 0x0f, 0x80, 0x6e, 0x81, 0x03,
+	0x4c, 0xab, 0xc1, 0x9c, 0x9e, 0xc3, 0xfa, 0x13, 0xee, 0x93, 0xdc, 0xfd, 0xde, 0x3c, 0xf7, 0x9a,
+	0xe4, 0x4e, 0xf8, 0x5e, 0xa7, 0x33, 0xb5, 0xc8, 0x85, 0x75, 0x79, 0xa3, 0x84, 0xcb, 0xde, 0xde,
+	0xa8, 0xd7, 0x14, 0xf7, 0x5a, 0x99, 0xe5, 0xf5, 0x50, 0x30, 0x03, 0x93, 0xe7, 0x7b, 0x11, 0x97,
+	0x6b, 0xcd, 0xe9, 0x2a, 0xd4, 0x81, 0xf5, 0x2b, 0xcb, 0x69, 0x79, 0x3d, 0xe2, 0x9b, 0x6d, 0xcb,
+	0xbf, 0x21, 0xfe, 0xa8, 0xbf, 0x34, 0xf7, 0xf7, 0x72, 0x96, 0xbf, 0x03, 0xc9, 0x3c, 0xe6, 0xc4,
+	0x88, 0x43, 0xf5, 0x6a, 0x86, 0xee, 0x5d, 0x16, 0x60, 0xe8, 0xa1, 0xf4, 0xbf, 0x18, 0xac, 0x4e,
+	0xeb, 0x0e, 0x84, 0xa1, 0x20, 0x7b, 0xcd, 0xeb, 0x10, 0x9f, 0xf7, 0xa0, 0xbc, 0x23, 0xdb, 0xf3,
+	0xbb, 0xec, 0x34, 0x84, 0xe3, 0xbc, 0x1d, 0x15, 0xa0, 0x1c, 0xc4, 0xe4, 0xd5, 0xc8, 0xe0, 0x98,
+	0x63, 0xa3, 0xd7, 0x90, 0x14, 0x10, 0x79, 0x13, 0x36, 0xa2, 0x96, 0xad, 0x8e, 0x33, 0x34, 0x8b,
+	0x25, 0x14, 0x3d, 0x85, 0x5c, 0xc3, 0x73, 0xaf, 0x9c, 0x6b, 0xb3, 0x47, 0x7c, 0x1a, 0x84, 0xb5,
+	0xc0, 0xef, 0xda, 0x92, 0x90, 0x9e, 0x0b, 0x21, 0x7a, 0x0e, 0x85, 0x41, 0x62, 0x43, 0x60, 0x82,
+	0x03, 0xf3, 0xa1, 0x3c, 0x84, 0xfe, 0x01, 0x1e, 0x74, 0x7c, 0xd2, 0x73, 0xbc, 0x2e, 0x35, 0x27,
+	0x38, 0x49, 0xce, 0x59, 0x0b, 0x01, 0x07, 0x51, 0x6e, 0xe9, 0x3f, 0x0a, 0x6c, 0xce, 0xed, 0xf5,
+	0x20, 0x5e, 0x39, 0x1b, 0x1a, 0xad, 0x2e, 0x65, 0xc4, 0xe7, 0x69, 0xcc, 0xe0, 0x25, 0x21, 0xdd,
+	0x17, 0xc2, 0x60, 0x20, 0x8a, 0xfb, 0x26, 0x33, 0x94, 0xc0, 0x29, 0xfe, 0xad, 0xdb, 0xe8, 0x77,
+	0x90, 0x19, 0x6c, 0x94, 0x3b, 0xcc, 0x8c, 0x21, 0xb8, 0xf4, 0x25, 0x01, 0xeb, 0xb3, 0xaf, 0x02,
+	0xda, 0x80, 0x8c, 0xac, 0xb1, 0x63, 0xcb, 0xa8, 0xd2, 0x42, 0xa0, 0xdb, 0xe8, 0x23, 0xa0, 0x5b,
+	0xcf, 0xbf, 0xb9, 0x6a, 0x79, 0xb7, 0x26, 0xf9, 0x4c, 0x1a, 0x5d, 0xde, 0x02, 0x31, 0xee, 0xfe,
+	0xd9, 0xd4, 0x42, 0x7d, 0x92, 0xf0, 0x5a, 0x88, 0xc6, 0xcb, 0xb7, 0xe3, 0x22, 0xa4, 0x42, 0x2a,
+	0x4c, 0x6d, 0x9c, 0xa7, 0x36, 0xfc, 0x44, 0x4f, 0x20, 0x4b, 0x1b, 0x4d, 0x62, 0x77, 0x5b, 0x84,
+	0x67, 0x41, 0x94, 0x75, 0x71, 0x20, 0xd3, 0x6d, 0xa4, 0x41, 0x6e, 0x08, 0xe1, 0x23, 0x34, 0xf1,
+	0xcd, 0x74, 0x2c, 0x0d, 0x18, 0x81, 0x0c, 0x6d, 0x02, 0x50, 0x66, 0xf9, 0x4c, 0xf8, 0x10, 0xd5,
+	0xcd, 0x48, 0x89, 0x6e, 0xa3, 0x3f, 0x41, 0x36, 0x54, 0x73, 0xfb, 0xa9, 0x6f, 0xda, 0x5f, 0x94,
+	0x78, 0x6e, 0xfd, 0xcf, 0xb0, 0xc2, 0x37, 0x62, 0x93, 0x58, 0x3e, 0xbb, 0x24, 0x16, 0x13, 0x56,
+	0xd2, 0xdf, 0xb4, 0xb2, 0x1c, 0xd0, 0x0e, 0x43, 0x16, 0xb7, 0xf5, 0x5b, 0x48, 0xd9, 0x84, 0x59,
+	0x4e, 0x8b, 0xaa, 0x19, 0xce, 0x7f, 0x38, 0x35, 0xeb, 0x67, 0x56, 0xbf, 0xe5, 0x59, 0x36, 0x0e,
+	0xc1, 0x41, 0x86, 0x2d, 0xc6, 0x48, 0xbb, 0xc3, 0x54, 0x10, 0x8d, 0x24, 0x3f, 0xd1, 0x5b, 0xc8,
+	0xf2, 0xe8, 0x82, 0x26, 0xef, 0xfa, 0x44, 0x5d, 0x9c, 0x63, 0xf6, 0x40, 0x60, 0xf0, 0x62, 0xc0,
+	0x90, 0x1f, 0xe8, 0x25, 0xac, 0x72, 0x03, 0x41, 0x59, 0x89, 0x6f, 0x3a, 0x36, 0x71, 0x99, 0xc3,
+	0xfa, 0x6a, 0x96, 0xf7, 0x0e, 0x0a, 0x74, 0x9f, 0xb8, 0x4a, 0x97, 0x1a, 0x74, 0x0a, 0x79, 0x59,
+	0x5f, 0x53, 0x8e, 0x40, 0x75, 0x69, 0x5a, 0x0b, 0x0d, 0xa7, 0x88, 0xbc, 0x59, 0x72, 0x96, 0xe2,
+	0x5c, 0x2f, 0xf2, 0x5d, 0xfa, 0x7b, 0x1c, 0xd6, 0x66, 0xcc, 0x59, 0xb4, 0x06, 0xa9, 0x70, 0xff,
+	0x2a, 0xbc, 0xb0, 0x49, 0x26, 0x36, 0x6f, 0xa4, 0xd1, 0x63, 0x77, 0x6a, 0xf4, 0xf8, 0xf7, 0x36,
+	0xfa, 0x5f, 0xe1, 0x57, 0x63, 0x27, 0x37, 0x1d, 0x46, 0xda, 0xc1, 0xae, 0x0e, 0x9e, 0x5d, 0xbb,
+	0x77, 0x3b, 0xbf, 0xce, 0x48, 0x1b, 0xaf, 0xf4, 0x26, 0x64, 0x14, 0xbd, 0x81, 0x24, 0xe9, 0x11,
+	0x97, 0x85, 0xab, 0x78, 0x73, 0xfa, 0xf0, 0xb4, 0x98, 0xf5, 0xae, 0xe5, 0x5d, 0x62, 0x09, 0x46,
+	0xfb, 0x90, 0x73, 0xc9, 0xad, 0xe9, 0x77, 0x5d, 0x53, 0xd2, 0x93, 0x77, 0xa1, 0x67, 0x5d, 0x72,
+	0x8b, 0xbb, 0x6e, 0x8d, 0x53, 0x4a, 0xff, 0x55, 0x40, 0x9d, 0xb5, 0x7c, 0xe6, 0x4f, 0x95, 0x69,
+	0x63, 0x39, 0x36, 0x7d, 0x2c, 0x7f, 0xef, 0x73, 0xa9, 0xf4, 0x0f, 0x05, 0x56, 0xa2, 0x51, 0x1a,
+	0xde, 0x0d, 0x71, 0x83, 0x00, 0xc3, 0x51, 0x2b, 0x1e, 0xc1, 0x09, 0x9c, 0x96, 0xb3, 0x96, 0xa2,
+	0x0b, 0xc8, 0x8f, 0x2d, 0x64, 0x35, 0xf6, 0xf3, 0xb6, 0x30, 0xce, 0x45, 0x77, 0x70, 0xe9, 0x9f,
+	0xf1, 0xc8, 0xe3, 0x9c, 0xbf, 0x0a, 0xdd, 0x2b, 0xef, 0x17, 0x19, 0xc3, 0x1b, 0xa3, 0x6f, 0xdf,
+	0x38, 0x1f, 0x13, 0xc3, 0xe7, 0xec, 0xc8, 0x3d, 0x5a, 0x88, 0xdc, 0xa3, 0x91, 0xe1, 0x9d, 0x88,
+	0x0e, 0xef, 0x2d, 0xc8, 0x5d, 0x39, 0x3e, 0x65, 0xa2, 0xa9, 0x86, 0xa3, 0x35, 0xcb, 0xa5, 0xbc,
+	0x6d, 0x74, 0x1b, 0x95, 0x60, 0xc9, 0x25, 0x9f, 0x47, 0x40, 0x29, 0x31, 0xe3, 0x03, 0x61, 0x88,
+	0x19, 0x5f, 0x03, 0xe9, 0xc9, 0x35, 0xf0, 0x18, 0x16, 0x6d, 0x62, 0xd9, 0xa6, 0x4f, 0x2c, 0xea,
+	0xb9, 0x7c, 0x3a, 0x66, 0x30, 0x04, 0x22, 0xcc, 0x25, 0x41, 0x97, 0xf9, 0xc4, 0xf6, 0x9d, 0x1e,
+	0x31, 0xe5, 0xec, 0xa3, 0x72, 0x16, 0xe6, 0xa5, 0x5c, 0x93, 0xe2, 0xa0, 0x95, 0x0b, 0xa3, 0x45,
+	0xe1, 0x1d, 0x32, 0xba, 0x8c, 0x95, 0xe8, 0x32, 0xfe, 0x8e, 0xff, 0x79, 0x42, 0x6a, 0xc7, 0xf7,
+	0x1a, 0x84, 0xd2, 0x28, 0x35, 0x3e, 0xa4, 0x9e, 0x85, 0xfa, 0x01, 0xb5, 0xf4, 0x1e, 0xf2, 0x63,
+	0xaf, 0x8c, 0xe8, 0xab, 0x40, 0xf9, 0x09, 0xaf, 0x82, 0xdd, 0x2f, 0xb1, 0x89, 0x3e, 0xe4, 0x65,
+	0x7f, 0x02, 0x9b, 0xb8, 0x76, 0x76, 0xa4, 0xef, 0x6b, 0x86, 0x7e, 0x7a, 0x62, 0x1a, 0x5a, 0xfd,
+	0xbd, 0x69, 0x5c, 0x9c, 0xd5, 0x4c, 0xfd, 0xe4, 0x5c, 0x3b, 0xd2, 0xab, 0x85, 0x7b, 0xa8, 0x08,
+	0x0f, 0xa7, 0x43, 0xaa, 0xa7, 0xc7, 0x9a, 0x7e, 0x52, 0x50, 0x66, 0x1b, 0x39, 0xd4, 0xeb, 0xc6,
+	0x29, 0xbe, 0x28, 0xc4, 0xd0, 0x0b, 0xd8, 0x9e, 0x0e, 0xa9, 0x5f, 0x9c, 0xec, 0x9b, 0xf5, 0x43,
+	0x0d, 0x57, 0xcd, 0xba, 0xa1, 0x19, 0x1f, 0xeb, 0x85, 0x38, 0xda, 0x86, 0x5f, 0xcf, 0x01, 0x6b,
+	0xfb, 0x86, 0x7e, 0xae, 0x1b, 0x17, 0x85, 0x05, 0xb4, 0x0b, 0xcf, 0xe6, 0x3a, 0x36, 0x8f, 0x6b,
+	0x86, 0x56, 0xd5, 0x0c, 0xad, 0x90, 0x40, 0x5b, 0x50, 0x9c, 0x8f, 0x3d, 0xdf, 0x2b, 0x24, 0xd1,
+	0x73, 0x78, 0x3a, 0x1d, 0x75, 0xa0, 0xe9, 0x47, 0xa7, 0xe7, 0x35, 0x6c, 0x1e, 0x6b, 0xf8, 0x7d,
+	0x0d, 0x17, 0x52, 0xbb, 0x0e, 0xe4, 0xc7, 0x5e, 0xbf, 0xe8, 0x21, 0xa8, 0x22, 0x29, 0xe6, 0xe9,
+	0x59, 0x0d, 0x0b, 0x13, 0xc3, 0x44, 0x6e, 0xc0, 0xda, 0x84, 0x76, 0x1f, 0xd7, 0x34, 0xa3, 0x56,
+	0x50, 0xa6, 0x2a, 0x3f, 0x9e, 0x55, 0x03, 0x65, 0x6c, 0xf7, 0x04, 0x52, 0xd5, 0xa3, 0x0f, 0xbc,
+	0x60, 0xab, 0x50, 0xa8, 0x1e, 0x7d, 0x18, 0xaf, 0x91, 0x0a, 0xab, 0x03, 0xe9, 0x48, 0xfc, 0x05,
+	0x05, 0xad, 0x40, 0x7e, 0xa0, 0x91, 0x05, 0x8b, 0xbd, 0xdb, 0xff, 0xff, 0xd7, 0x47, 0xca, 0x97,
+	0xaf, 0x8f, 0x94, 0x1f, 0xbe, 0x3e, 0x52, 0xfe, 0xf2, 0xe6, 0xda, 0x61, 0xcd, 0xee, 0x65, 0xb9,
+	0xe1, 0xb5, 0x2b, 0x91, 0x5f, 0x1c, 0xca, 0xd7, 0xc4, 0x15, 0xbf, 0x70, 0x0c, 0x7f, 0x7c, 0xf8,
+	0xa3, 0xf8, 0xab, 0xf7, 0xea, 0x32, 0xc9, 0x35, 0xaf, 0x7f, 0x1c, 0x00, 0x25, 0x99, 0xba, 0x86,
+	0x4d, 0x11, 0x00, 0x00,
 }
 
 func (m *ReplicationMessages) Marshal() (dAtA []byte, err error) {
@@ -1922,6 +1940,18 @@ func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RedriveAttempts != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.RedriveAttempts))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.DeadReason) > 0 {
+		i -= len(m.DeadReason)
+		copy(dAtA[i:], m.DeadReason)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.DeadReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.ScheduledId != 0 {
 		i = encodeVarintReplication(dAtA, i, uint64(m.ScheduledId))
 		i--
@@ -2409,6 +2439,13 @@ func (m *ReplicationTaskInfo) Size() (n int) {
 	if m.ScheduledId != 0 {
 		n += 1 + sovReplication(uint64(m.ScheduledId))
 	}
+	l = len(m.DeadReason)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.RedriveAttempts != 0 {
+		n += 1 + sovReplication(uint64(m.RedriveAttempts))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4450,6 +4487,57 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedriveAttempts", wireType)
+			}
+			m.RedriveAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedriveAttempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplication(dAtA[iNdEx:])
@@ -53,6 +53,7 @@ const (
 	WorkflowState_WORKFLOW_STATE_ZOMBIE    WorkflowState = 4
 	WorkflowState_WORKFLOW_STATE_VOID      WorkflowState = 5
 	WorkflowState_WORKFLOW_STATE_CORRUPTED WorkflowState = 6
+	WorkflowState_WORKFLOW_STATE_SUSPENDED WorkflowState = 7
 )
 
 var WorkflowState_name = map[int32]string{
@@ -63,6 +64,7 @@ var WorkflowState_name = map[int32]string{
 	4: "WORKFLOW_STATE_ZOMBIE",
 	5: "WORKFLOW_STATE_VOID",
 	6: "WORKFLOW_STATE_CORRUPTED",
+	7: "WORKFLOW_STATE_SUSPENDED",
 }
 
 var WorkflowState_value = map[string]int32{
@@ -73,6 +75,7 @@ var WorkflowState_value = map[string]int32{
 	"WORKFLOW_STATE_ZOMBIE":    4,
 	"WORKFLOW_STATE_VOID":      5,
 	"WORKFLOW_STATE_CORRUPTED": 6,
+	"WORKFLOW_STATE_SUSPENDED": 7,
 }
 
 func (x WorkflowState) String() string {
@@ -92,20 +95,21 @@ func init() {
 }
 
 var fileDescriptor_7ca73ea33aecbb95 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
+	// 249 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4d, 0x4a, 0x2d,
 	0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0xd1,
 	0x2f, 0x33, 0xd4, 0x2f, 0xcf, 0x2f, 0xca, 0x4e, 0xcb, 0xc9, 0x2f, 0xd7, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x03, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0xd4, 0xba,
-	0xcc, 0xc8, 0xc5, 0x1b, 0x0e, 0x55, 0x1a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x24, 0xc5, 0x25, 0x16,
+	0xc9, 0x17, 0x12, 0x03, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0xd4, 0xfa,
+	0xc8, 0xc8, 0xc5, 0x1b, 0x0e, 0x55, 0x1a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x24, 0xc5, 0x25, 0x16,
 	0xee, 0x1f, 0xe4, 0xed, 0xe6, 0xe3, 0x1f, 0x1e, 0x1f, 0x1c, 0xe2, 0x18, 0xe2, 0x1a, 0xef, 0xe9,
 	0x17, 0xe6, 0xe8, 0xe3, 0xe9, 0x22, 0xc0, 0x80, 0x45, 0xce, 0x39, 0xc8, 0xd5, 0x31, 0xc4, 0xd5,
 	0x45, 0x80, 0x11, 0x8b, 0x5c, 0x50, 0xa8, 0x9f, 0x9f, 0xa7, 0x9f, 0xbb, 0x00, 0x93, 0x90, 0x0c,
 	0x97, 0x04, 0xba, 0x3e, 0x7f, 0xdf, 0x00, 0x1f, 0x57, 0x90, 0x4e, 0x66, 0x21, 0x49, 0x2e, 0x51,
 	0x34, 0xd9, 0x28, 0x7f, 0x5f, 0x27, 0x4f, 0x57, 0x01, 0x16, 0x21, 0x71, 0x2e, 0x61, 0x34, 0xa9,
-	0x30, 0x7f, 0x4f, 0x17, 0x01, 0x56, 0xac, 0x26, 0x06, 0x05, 0x85, 0x06, 0x80, 0x4c, 0x64, 0x73,
-	0x72, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0xa3, 0x4c,
-	0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x51, 0x42, 0x4b, 0x2f, 0x3d,
-	0x35, 0x4f, 0x1f, 0x1c, 0x3e, 0x88, 0x80, 0xb3, 0x86, 0xb0, 0xca, 0x0c, 0x93, 0xd8, 0xc0, 0x32,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x33, 0x81, 0xb3, 0x62, 0x01, 0x00, 0x00,
+	0x30, 0x7f, 0x4f, 0x17, 0x01, 0x56, 0xac, 0x26, 0x06, 0x05, 0x85, 0x06, 0x80, 0x4c, 0x64, 0xc3,
+	0x22, 0x1b, 0x1c, 0x1a, 0x1c, 0xe0, 0xea, 0xe7, 0xe2, 0xea, 0x22, 0xc0, 0xee, 0xe4, 0x7c, 0xe2,
+	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x46, 0x99, 0xa6, 0x67, 0x96,
+	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa3, 0x84, 0xa5, 0x5e, 0x7a, 0x6a, 0x9e, 0x3e,
+	0x38, 0xf4, 0x10, 0xc1, 0x6a, 0x0d, 0x61, 0x95, 0x19, 0x26, 0xb1, 0x81, 0x65, 0x8c, 0x01, 0x03,
+	0x00, 0x61, 0x30, 0x92, 0xd5, 0x80, 0x01, 0x00, 0x00,
 }
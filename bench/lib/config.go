@@ -85,6 +85,7 @@ type (
 		Timer            *TimerTestConfig          `yaml:"timer"`
 		ConcurrentExec   *ConcurrentExecTestConfig `yaml:"concurrentExec"`
 		Cancellation     *CancellationTestConfig   `yaml:"cancellation"`
+		Scenario         *ScenarioTestConfig       `yaml:"scenario"`
 	}
 
 	// BasicTestConfig contains the configuration for running the Basic test scenario
@@ -218,6 +219,87 @@ type (
 		// default: 3s
 		ContextTimeoutInSeconds int `yaml:"contextTimeoutInSeconds"`
 	}
+
+	// ScenarioTestConfig contains the config for running a declarative load scenario
+	// The scenario starts workflows of the given shape following the arrival rate curve,
+	// waits for them to complete and verifies the results against the SLOs
+	ScenarioTestConfig struct {
+		// Name identifies the scenario in the report, so that reports can be compared across runs
+		Name string `yaml:"name"`
+
+		// Workflow describes the shape of the workflows started by the scenario
+		Workflow ScenarioWorkflowConfig `yaml:"workflow"`
+
+		// Arrival is the arrival rate curve, phases are run one after another
+		// the duration of the scenario is the sum of the phase durations
+		Arrival []ScenarioArrivalPhase `yaml:"arrival"`
+
+		// RoutineCount is the number of launcher activities starting workflows in parallel,
+		// each of them starts 1/RoutineCount of the arrivals, default: 1
+		RoutineCount int `yaml:"routineCount"`
+
+		// ExecutionStartToCloseTimeoutInSeconds is the timeout of the started workflows, default 5m
+		ExecutionStartToCloseTimeoutInSeconds int `yaml:"executionStartToCloseTimeoutInSeconds"`
+
+		// WaitTimeBufferInSeconds is the time to wait for the started workflows after the last arrival, default 60s
+		WaitTimeBufferInSeconds int `yaml:"waitTimeBufferInSeconds"`
+
+		// ContextTimeoutInSeconds specifies the context timeout for start and signal workflow calls, default: 3s
+		ContextTimeoutInSeconds int `yaml:"contextTimeoutInSeconds"`
+
+		// SLO is the pass/fail criteria of the scenario
+		SLO ScenarioSLOConfig `yaml:"slo"`
+	}
+
+	// ScenarioWorkflowConfig describes the shape of a scenario workflow as a sequence of steps
+	ScenarioWorkflowConfig struct {
+		Steps []ScenarioStepConfig `yaml:"steps"`
+	}
+
+	// ScenarioStepConfig is a step of a scenario workflow, everything configured in a step
+	// is run in parallel, and the workflow moves to the next step when all of them complete
+	ScenarioStepConfig struct {
+		// ActivityCount is the number of echo activities to execute
+		ActivityCount int `yaml:"activityCount"`
+
+		// ChildWorkflowCount is the number of child workflows to execute, each of them executes one echo activity
+		ChildWorkflowCount int `yaml:"childWorkflowCount"`
+
+		// TimerInSeconds is the duration of a timer, 0 means no timer
+		TimerInSeconds int `yaml:"timerInSeconds"`
+
+		// SignalCount is the number of signals to wait for, the signals are sent by the launcher
+		// right after the workflow is started
+		SignalCount int `yaml:"signalCount"`
+
+		// PayloadSizeBytes is the size of the payload of activities, child workflows and signals
+		PayloadSizeBytes int `yaml:"payloadSizeBytes"`
+
+		// Repeat is the number of times the step is run, default: 1
+		Repeat int `yaml:"repeat"`
+	}
+
+	// ScenarioArrivalPhase is a phase of the arrival rate curve, the rate of starting workflows
+	// changes linearly from StartRPS to EndRPS during the phase, or stays constant if EndRPS is not set
+	ScenarioArrivalPhase struct {
+		DurationInSeconds int     `yaml:"durationInSeconds"`
+		StartRPS          float64 `yaml:"startRPS"`
+		EndRPS            float64 `yaml:"endRPS"`
+	}
+
+	// ScenarioSLOConfig contains the pass/fail criteria of a scenario, criteria with zero value are not checked
+	// The scenario always fails if any workflow is still open after the wait time buffer
+	ScenarioSLOConfig struct {
+		// MaxStartLatencyP99InMilliseconds is the max p99 latency of the StartWorkflowExecution calls
+		MaxStartLatencyP99InMilliseconds int64 `yaml:"maxStartLatencyP99InMilliseconds"`
+
+		// MaxBacklog is the max backlog of decision and activity tasks, summed across the bench task lists,
+		// sampled while workflows are being started
+		MaxBacklog int64 `yaml:"maxBacklog"`
+
+		// MaxFailureRate is the max ratio of failed or timed out workflows, and workflows failed to start
+		MaxFailureRate float64 `yaml:"maxFailureRate"`
+	}
 )
 
 func (c *Config) Validate() error {
@@ -232,3 +314,34 @@ func (c *Config) Validate() error {
 	}
 	return nil
 }
+
+// Validate validates the scenario test config
+func (c *ScenarioTestConfig) Validate() error {
+	if len(c.Arrival) == 0 {
+		return errors.New("missing arrival phases for scenario")
+	}
+	for _, phase := range c.Arrival {
+		if phase.DurationInSeconds <= 0 {
+			return errors.New("arrival phase duration must be positive")
+		}
+		if phase.StartRPS < 0 || phase.EndRPS < 0 {
+			return errors.New("arrival phase rate can not be negative")
+		}
+	}
+	if len(c.Workflow.Steps) == 0 {
+		return errors.New("missing workflow steps for scenario")
+	}
+	for _, step := range c.Workflow.Steps {
+		if step.ActivityCount < 0 || step.ChildWorkflowCount < 0 || step.TimerInSeconds < 0 ||
+			step.SignalCount < 0 || step.PayloadSizeBytes < 0 || step.Repeat < 0 {
+			return errors.New("workflow step values can not be negative")
+		}
+	}
+	if c.RoutineCount < 0 {
+		return errors.New("routine count can not be negative")
+	}
+	if c.SLO.MaxFailureRate < 0 || c.SLO.MaxFailureRate > 1 {
+		return errors.New("max failure rate must be between 0 and 1")
+	}
+	return nil
+}
@@ -48,6 +48,37 @@ func (s *ConfigTestSuite) TestValidate() {
 	}
 }
 
+func (s *ConfigTestSuite) TestValidateScenario() {
+	testCases := []func(*ScenarioTestConfig){
+		func(c *ScenarioTestConfig) { c.Arrival = nil },
+		func(c *ScenarioTestConfig) { c.Arrival[0].DurationInSeconds = 0 },
+		func(c *ScenarioTestConfig) { c.Arrival[0].EndRPS = -1 },
+		func(c *ScenarioTestConfig) { c.Workflow.Steps = nil },
+		func(c *ScenarioTestConfig) { c.Workflow.Steps[0].SignalCount = -1 },
+		func(c *ScenarioTestConfig) { c.RoutineCount = -1 },
+		func(c *ScenarioTestConfig) { c.SLO.MaxFailureRate = 2 },
+	}
+
+	config := s.buildScenarioConfig()
+	s.NoError(config.Validate())
+	for _, tc := range testCases {
+		config := s.buildScenarioConfig()
+		tc(&config)
+		s.Error(config.Validate())
+	}
+}
+
+func (s *ConfigTestSuite) buildScenarioConfig() ScenarioTestConfig {
+	return ScenarioTestConfig{
+		Name: "scenario",
+		Workflow: ScenarioWorkflowConfig{
+			Steps: []ScenarioStepConfig{{ActivityCount: 1, SignalCount: 1}},
+		},
+		Arrival: []ScenarioArrivalPhase{{DurationInSeconds: 10, StartRPS: 1}},
+		SLO:     ScenarioSLOConfig{MaxFailureRate: 0.01},
+	}
+}
+
 func (s *ConfigTestSuite) buildConfig() Config {
 	return Config{
 		Bench: Bench{
@@ -39,6 +39,7 @@ import (
 	"github.com/uber/cadence/bench/load/cancellation"
 	"github.com/uber/cadence/bench/load/common"
 	"github.com/uber/cadence/bench/load/concurrentexec"
+	"github.com/uber/cadence/bench/load/scenario"
 	"github.com/uber/cadence/bench/load/signal"
 	"github.com/uber/cadence/bench/load/timer"
 )
@@ -272,6 +273,8 @@ func launcherWorkflow(
 			childFuture = workflow.ExecuteChildWorkflow(childCtx, concurrentexec.LauncherWorkflowName, *testConfig.ConcurrentExec)
 		case cancellation.TestName:
 			childFuture = workflow.ExecuteChildWorkflow(childCtx, cancellation.LauncherWorkflowName, *testConfig.Cancellation)
+		case scenario.TestName:
+			childFuture = workflow.ExecuteChildWorkflow(childCtx, scenario.LauncherWorkflowName, *testConfig.Scenario)
 		default:
 			workflow.GetLogger(ctx).Error("Unknown test name", zap.String("test-name", testConfig.Name))
 		}
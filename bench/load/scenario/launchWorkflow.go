@@ -0,0 +1,383 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/bench/lib"
+	"github.com/uber/cadence/bench/load/common"
+)
+
+const (
+	// TestName is the test name for scenario test
+	TestName = "scenario"
+
+	// LauncherWorkflowName is the workflow name for launching scenario test
+	LauncherWorkflowName = "scenario-load-test-workflow"
+
+	launcherActivityName = "scenario-load-test-launch-activity"
+	backlogActivityName  = "scenario-load-test-backlog-activity"
+	countActivityName    = "scenario-load-test-count-activity"
+
+	defaultScenarioWorkflowTimeout = 5 * time.Minute
+	defaultWaitTimeBuffer          = time.Minute
+
+	launchInterval        = 10 * time.Millisecond
+	backlogSampleInterval = 5 * time.Second
+	closeCheckInterval    = 10 * time.Second
+
+	// launcherRunIDSearchAttribute tags the scenario workflows with the run ID of the launcher that started them
+	launcherRunIDSearchAttribute = "CustomKeywordField"
+)
+
+type (
+	launcherActivityParams struct {
+		RoutineID int                    // the ID of the launchActivity
+		StartTime time.Time              // the time the first arrival phase starts
+		Config    lib.ScenarioTestConfig // config of this load test
+	}
+
+	launcherActivityResult struct {
+		Started      int
+		StartFailed  int
+		StartLatency latencyHistogram
+	}
+
+	countActivityParams struct {
+		LauncherRunID  string // the run ID of the launcher workflow
+		StartTimeNanos int64  // the time the launcher workflow started
+	}
+
+	countActivityResult struct {
+		Completed int64
+		Failed    int64
+		TimedOut  int64
+		Open      int64
+	}
+)
+
+// RegisterLauncher registers workflows and activities for scenario load launching
+func RegisterLauncher(w worker.Worker) {
+	w.RegisterWorkflowWithOptions(launcherWorkflow, workflow.RegisterOptions{Name: LauncherWorkflowName})
+	w.RegisterActivityWithOptions(launcherActivity, activity.RegisterOptions{Name: launcherActivityName})
+	w.RegisterActivityWithOptions(backlogActivity, activity.RegisterOptions{Name: backlogActivityName})
+	w.RegisterActivityWithOptions(countActivity, activity.RegisterOptions{Name: countActivityName})
+}
+
+func launcherWorkflow(ctx workflow.Context, config lib.ScenarioTestConfig) (*Report, error) {
+	logger := workflow.GetLogger(ctx).With(zap.String("Test", TestName), zap.String("Scenario", config.Name))
+	if err := config.Validate(); err != nil {
+		return nil, cadence.NewCustomError(common.ErrReasonValidationFailed, fmt.Sprintf("invalid scenario: %v", err))
+	}
+	if config.RoutineCount == 0 {
+		config.RoutineCount = 1
+	}
+	if config.ExecutionStartToCloseTimeoutInSeconds == 0 {
+		config.ExecutionStartToCloseTimeoutInSeconds = int(defaultScenarioWorkflowTimeout / time.Second)
+	}
+	if config.WaitTimeBufferInSeconds == 0 {
+		config.WaitTimeBufferInSeconds = int(defaultWaitTimeBuffer / time.Second)
+	}
+	if config.ContextTimeoutInSeconds == 0 {
+		config.ContextTimeoutInSeconds = int(common.DefaultContextTimeout / time.Second)
+	}
+
+	duration := arrivalDuration(config.Arrival)
+	startTime := workflow.Now(ctx)
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    duration + time.Minute,
+		HeartbeatTimeout:       20 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 1,
+			MaximumInterval:    time.Second,
+			ExpirationInterval: duration + time.Minute,
+		},
+	}
+	launchCtx := workflow.WithActivityOptions(ctx, ao)
+
+	backlogFuture := workflow.ExecuteActivity(launchCtx, backlogActivityName, startTime.Add(duration))
+	futures := make([]workflow.Future, 0, config.RoutineCount)
+	for i := 0; i < config.RoutineCount; i++ {
+		params := launcherActivityParams{
+			RoutineID: i,
+			StartTime: startTime,
+			Config:    config,
+		}
+		futures = append(futures, workflow.ExecuteActivity(launchCtx, launcherActivityName, params))
+	}
+
+	report := &Report{
+		Name:          config.Name,
+		StartTime:     startTime,
+		Config:        config,
+		ExpectedCount: int(expectedArrivals(config.Arrival, duration)),
+	}
+	startLatency := newLatencyHistogram()
+	for _, future := range futures {
+		var result launcherActivityResult
+		if err := future.Get(ctx, &result); err != nil {
+			return nil, err
+		}
+		report.StartedCount += result.Started
+		report.StartFailedCount += result.StartFailed
+		startLatency.merge(result.StartLatency)
+	}
+	report.StartLatency = startLatency.summary()
+	if err := backlogFuture.Get(ctx, &report.MaxBacklog); err != nil {
+		logger.Error("Failed to sample task list backlog", zap.Error(err))
+	}
+
+	// wait for the started workflows to close, or until they should have
+	logger.Info(fmt.Sprintf("%v scenario workflows are launched, now waiting for them to close ...", report.StartedCount))
+	countCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    5,
+		},
+	})
+	deadline := workflow.Now(ctx).Add(time.Duration(config.ExecutionStartToCloseTimeoutInSeconds+config.WaitTimeBufferInSeconds) * time.Second)
+	countParams := countActivityParams{
+		LauncherRunID:  workflow.GetInfo(ctx).WorkflowExecution.RunID,
+		StartTimeNanos: startTime.UnixNano(),
+	}
+	var counts countActivityResult
+	for {
+		if err := workflow.ExecuteActivity(countCtx, countActivityName, countParams).Get(ctx, &counts); err != nil {
+			return nil, err
+		}
+		if counts.Open == 0 || !workflow.Now(ctx).Before(deadline) {
+			break
+		}
+		if err := workflow.Sleep(ctx, closeCheckInterval); err != nil {
+			return nil, fmt.Errorf("launcher workflow sleep failed: %v", err)
+		}
+	}
+
+	report.CompletedCount = counts.Completed
+	report.FailedCount = counts.Failed
+	report.TimedOutCount = counts.TimedOut
+	report.OpenCount = counts.Open
+	report.EndTime = workflow.Now(ctx)
+	report.evaluate(config.SLO)
+	if !report.Passed {
+		return nil, cadence.NewCustomError(common.ErrReasonValidationFailed, report.String())
+	}
+	return report, nil
+}
+
+// launcherActivity starts its share of scenario workflows following the arrival rate curve
+func launcherActivity(ctx context.Context, params launcherActivityParams) (launcherActivityResult, error) {
+	info := activity.GetInfo(ctx)
+	logger := activity.GetLogger(ctx).With(zap.String("Test", TestName), zap.Int("RoutineID", params.RoutineID))
+
+	progress := launcherActivityResult{StartLatency: newLatencyHistogram()}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			logger.Error("failed to resume from last checkpoint...start from beginning...")
+			progress = launcherActivityResult{StartLatency: newLatencyHistogram()}
+		}
+	}
+
+	cc := ctx.Value(lib.CtxKeyCadenceClient).(lib.CadenceClient)
+	rc := ctx.Value(lib.CtxKeyRuntimeContext).(*lib.RuntimeContext)
+	config := params.Config
+	duration := arrivalDuration(config.Arrival)
+	contextTimeout := time.Duration(config.ContextTimeoutInSeconds) * time.Second
+	workflowOptions := client.StartWorkflowOptions{
+		ExecutionStartToCloseTimeout:    time.Duration(config.ExecutionStartToCloseTimeoutInSeconds) * time.Second,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+		SearchAttributes: map[string]interface{}{
+			launcherRunIDSearchAttribute: info.WorkflowExecution.RunID,
+		},
+	}
+	signals := signalPayloads(config.Workflow.Steps)
+
+	for {
+		elapsed := time.Since(params.StartTime)
+		if elapsed > duration {
+			elapsed = duration
+		}
+		// split the arrivals evenly between the launcher activities
+		arrivals := int(expectedArrivals(config.Arrival, elapsed))
+		target := (arrivals + config.RoutineCount - 1 - params.RoutineID) / config.RoutineCount
+
+		for launched := progress.Started + progress.StartFailed; launched < target; launched++ {
+			input := WorkflowParams{
+				Steps:          config.Workflow.Steps,
+				TaskListNumber: rand.Intn(rc.Bench.NumTaskLists),
+			}
+			workflowOptions.ID = fmt.Sprintf("%v-%d-%d", info.WorkflowExecution.ID, params.RoutineID, launched)
+			workflowOptions.TaskList = common.GetTaskListName(input.TaskListNumber)
+
+			startCtx, cancel := context.WithTimeout(ctx, contextTimeout)
+			startTime := time.Now()
+			_, err := cc.StartWorkflow(startCtx, workflowOptions, scenarioWorkflowName, input)
+			latency := time.Since(startTime)
+			cancel()
+			if err != nil && !cadence.IsWorkflowExecutionAlreadyStartedError(err) {
+				logger.Error("Failed to start workflow execution", zap.Error(err))
+				progress.StartFailed++
+				continue
+			}
+			progress.Started++
+			progress.StartLatency.record(latency)
+
+			for _, payload := range signals {
+				err := common.RetryOp(func() error {
+					signalCtx, cancel := context.WithTimeout(ctx, contextTimeout)
+					defer cancel()
+					return cc.SignalWorkflow(signalCtx, workflowOptions.ID, "", scenarioSignalName, payload)
+				}, common.IsNonRetryableError)
+				if err != nil {
+					logger.Error("Failed to signal workflow execution", zap.String("WorkflowID", workflowOptions.ID), zap.Error(err))
+				}
+			}
+		}
+
+		activity.RecordHeartbeat(ctx, progress)
+		if elapsed >= duration {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return progress, ctx.Err()
+		case <-time.After(launchInterval):
+		}
+	}
+
+	logger.Info("finish running launcher activity", zap.Int("StartedCount", progress.Started), zap.Int("StartFailedCount", progress.StartFailed))
+	return progress, nil
+}
+
+// backlogActivity samples the backlog of all bench task lists until the given time, and returns the max
+func backlogActivity(ctx context.Context, endTime time.Time) (int64, error) {
+	logger := activity.GetLogger(ctx).With(zap.String("Test", TestName))
+	cc := ctx.Value(lib.CtxKeyCadenceClient).(lib.CadenceClient)
+	rc := ctx.Value(lib.CtxKeyRuntimeContext).(*lib.RuntimeContext)
+
+	var maxBacklog int64
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &maxBacklog); err != nil {
+			logger.Error("failed to resume from last checkpoint...start from beginning...")
+		}
+	}
+
+	taskListTypes := []shared.TaskListType{shared.TaskListTypeDecision, shared.TaskListTypeActivity}
+	for time.Now().Before(endTime) {
+		var backlog int64
+		for i := 0; i < rc.Bench.NumTaskLists; i++ {
+			for _, taskListType := range taskListTypes {
+				describeCtx, cancel := context.WithTimeout(ctx, common.DefaultContextTimeout)
+				resp, err := cc.DescribeTaskList(describeCtx, common.GetTaskListName(i), taskListType)
+				cancel()
+				if err != nil {
+					logger.Warn("Failed to describe task list", zap.Error(err))
+					continue
+				}
+				backlog += resp.GetTaskListStatus().GetBacklogCountHint()
+			}
+		}
+		if backlog > maxBacklog {
+			maxBacklog = backlog
+		}
+
+		activity.RecordHeartbeat(ctx, maxBacklog)
+		select {
+		case <-ctx.Done():
+			return maxBacklog, ctx.Err()
+		case <-time.After(backlogSampleInterval):
+		}
+	}
+	return maxBacklog, nil
+}
+
+// countActivity counts the scenario workflows started by the given launcher run by their status
+func countActivity(ctx context.Context, params countActivityParams) (countActivityResult, error) {
+	cc := ctx.Value(lib.CtxKeyCadenceClient).(lib.CadenceClient)
+	domain := activity.GetInfo(ctx).WorkflowDomain
+
+	count := func(filter string) (int64, error) {
+		query := fmt.Sprintf(
+			"WorkflowType = '%v' and %v = '%v' and StartTime > %v and %v",
+			scenarioWorkflowName,
+			launcherRunIDSearchAttribute,
+			params.LauncherRunID,
+			params.StartTimeNanos,
+			filter,
+		)
+		resp, err := cc.CountWorkflow(ctx, &shared.CountWorkflowExecutionsRequest{
+			Domain: &domain,
+			Query:  &query,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return resp.GetCount(), nil
+	}
+
+	var result countActivityResult
+	var err error
+	if result.Open, err = count("CloseTime = missing"); err != nil {
+		return result, err
+	}
+	if result.Completed, err = count("CloseStatus = 0"); err != nil {
+		return result, err
+	}
+	if result.Failed, err = count("CloseStatus = 1"); err != nil {
+		return result, err
+	}
+	if result.TimedOut, err = count("CloseStatus = 5"); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// signalPayloads returns the payloads of all signals a scenario workflow waits for
+func signalPayloads(steps []lib.ScenarioStepConfig) [][]byte {
+	var payloads [][]byte
+	for _, step := range steps {
+		repeat := step.Repeat
+		if repeat == 0 {
+			repeat = 1
+		}
+		for i := 0; i < step.SignalCount*repeat; i++ {
+			payloads = append(payloads, make([]byte, step.PayloadSizeBytes))
+		}
+	}
+	return payloads
+}
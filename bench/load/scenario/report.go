@@ -0,0 +1,218 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scenario
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/uber/cadence/bench/lib"
+)
+
+const (
+	// latencyBucketGrowth is the ratio between the upper bounds of two adjacent latency buckets,
+	// which bounds the error of reported percentiles to 10%
+	latencyBucketGrowth = 1.1
+	latencyBucketCount  = 200
+)
+
+type (
+	// Report is the machine readable result of a scenario run
+	Report struct {
+		Name      string                 `json:"name"`
+		Passed    bool                   `json:"passed"`
+		StartTime time.Time              `json:"startTime"`
+		EndTime   time.Time              `json:"endTime"`
+		Config    lib.ScenarioTestConfig `json:"config"`
+
+		ExpectedCount    int `json:"expectedCount"`
+		StartedCount     int `json:"startedCount"`
+		StartFailedCount int `json:"startFailedCount"`
+
+		CompletedCount int64 `json:"completedCount"`
+		FailedCount    int64 `json:"failedCount"`
+		TimedOutCount  int64 `json:"timedOutCount"`
+		OpenCount      int64 `json:"openCount"`
+
+		StartLatency LatencySummary `json:"startLatency"`
+		MaxBacklog   int64          `json:"maxBacklog"`
+
+		SLOs []SLOResult `json:"slos"`
+	}
+
+	// LatencySummary contains the percentiles of a latency distribution in milliseconds
+	LatencySummary struct {
+		P50 int64 `json:"p50"`
+		P95 int64 `json:"p95"`
+		P99 int64 `json:"p99"`
+		Max int64 `json:"max"`
+	}
+
+	// SLOResult is the result of checking one SLO of the scenario
+	SLOResult struct {
+		Name      string  `json:"name"`
+		Threshold float64 `json:"threshold"`
+		Actual    float64 `json:"actual"`
+		Passed    bool    `json:"passed"`
+	}
+
+	// latencyHistogram is a mergeable histogram with exponential buckets,
+	// small enough to be passed around as activity heartbeat details and results
+	latencyHistogram struct {
+		Counts            []int64
+		Count             int64
+		MaxInMilliseconds int64
+	}
+)
+
+// String returns the report as JSON
+func (r *Report) String() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
+
+// evaluate checks the report against the SLOs and sets the pass/fail result
+func (r *Report) evaluate(slo lib.ScenarioSLOConfig) {
+	r.SLOs = append(r.SLOs, newSLOResult("openWorkflows", 0, float64(r.OpenCount)))
+	if slo.MaxStartLatencyP99InMilliseconds > 0 {
+		r.SLOs = append(r.SLOs, newSLOResult("startLatencyP99", float64(slo.MaxStartLatencyP99InMilliseconds), float64(r.StartLatency.P99)))
+	}
+	if slo.MaxBacklog > 0 {
+		r.SLOs = append(r.SLOs, newSLOResult("maxBacklog", float64(slo.MaxBacklog), float64(r.MaxBacklog)))
+	}
+	if slo.MaxFailureRate > 0 {
+		var failureRate float64
+		if attempts := r.StartedCount + r.StartFailedCount; attempts > 0 {
+			failures := r.FailedCount + r.TimedOutCount + int64(r.StartFailedCount)
+			failureRate = float64(failures) / float64(attempts)
+		}
+		r.SLOs = append(r.SLOs, newSLOResult("failureRate", slo.MaxFailureRate, failureRate))
+	}
+
+	r.Passed = true
+	for _, result := range r.SLOs {
+		r.Passed = r.Passed && result.Passed
+	}
+}
+
+func newSLOResult(name string, threshold float64, actual float64) SLOResult {
+	return SLOResult{
+		Name:      name,
+		Threshold: threshold,
+		Actual:    actual,
+		Passed:    actual <= threshold,
+	}
+}
+
+// arrivalDuration returns the total duration of the arrival rate curve
+func arrivalDuration(phases []lib.ScenarioArrivalPhase) time.Duration {
+	var duration time.Duration
+	for _, phase := range phases {
+		duration += time.Duration(phase.DurationInSeconds) * time.Second
+	}
+	return duration
+}
+
+// expectedArrivals returns the number of workflows that should have been started
+// after the given time has elapsed, which is the integral of the arrival rate curve
+func expectedArrivals(phases []lib.ScenarioArrivalPhase, elapsed time.Duration) float64 {
+	var arrivals float64
+	remaining := elapsed.Seconds()
+	for _, phase := range phases {
+		if remaining <= 0 {
+			break
+		}
+		duration := float64(phase.DurationInSeconds)
+		t := math.Min(remaining, duration)
+		endRPS := phase.EndRPS
+		if endRPS == 0 {
+			endRPS = phase.StartRPS
+		}
+		arrivals += phase.StartRPS*t + (endRPS-phase.StartRPS)*t*t/(2*duration)
+		remaining -= duration
+	}
+	return arrivals
+}
+
+func newLatencyHistogram() latencyHistogram {
+	return latencyHistogram{
+		Counts: make([]int64, latencyBucketCount),
+	}
+}
+
+func (h *latencyHistogram) record(latency time.Duration) {
+	ms := latency.Milliseconds()
+	index := 0
+	if ms > 1 {
+		index = int(math.Ceil(math.Log(float64(ms)) / math.Log(latencyBucketGrowth)))
+	}
+	if index >= latencyBucketCount {
+		index = latencyBucketCount - 1
+	}
+	h.Counts[index]++
+	h.Count++
+	if ms > h.MaxInMilliseconds {
+		h.MaxInMilliseconds = ms
+	}
+}
+
+func (h *latencyHistogram) merge(other latencyHistogram) {
+	for i, count := range other.Counts {
+		h.Counts[i] += count
+	}
+	h.Count += other.Count
+	if other.MaxInMilliseconds > h.MaxInMilliseconds {
+		h.MaxInMilliseconds = other.MaxInMilliseconds
+	}
+}
+
+// percentile returns the upper bound of the bucket containing the given percentile, in milliseconds
+func (h *latencyHistogram) percentile(q float64) int64 {
+	if h.Count == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(q * float64(h.Count)))
+	var seen int64
+	for i, count := range h.Counts {
+		seen += count
+		if seen >= rank {
+			upperBound := int64(math.Floor(math.Pow(latencyBucketGrowth, float64(i))))
+			if upperBound > h.MaxInMilliseconds {
+				return h.MaxInMilliseconds
+			}
+			return upperBound
+		}
+	}
+	return h.MaxInMilliseconds
+}
+
+func (h *latencyHistogram) summary() LatencySummary {
+	return LatencySummary{
+		P50: h.percentile(0.5),
+		P95: h.percentile(0.95),
+		P99: h.percentile(0.99),
+		Max: h.MaxInMilliseconds,
+	}
+}
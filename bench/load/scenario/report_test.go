@@ -0,0 +1,117 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scenario
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/bench/lib"
+)
+
+type ReportTestSuite struct {
+	suite.Suite
+}
+
+func TestReportTestSuite(t *testing.T) {
+	suite.Run(t, new(ReportTestSuite))
+}
+
+func (s *ReportTestSuite) TestExpectedArrivals() {
+	phases := []lib.ScenarioArrivalPhase{
+		{DurationInSeconds: 10, StartRPS: 0, EndRPS: 10},
+		{DurationInSeconds: 20, StartRPS: 10},
+	}
+
+	s.Equal(30*time.Second, arrivalDuration(phases))
+	s.InDelta(0, expectedArrivals(phases, 0), 1e-9)
+	s.InDelta(12.5, expectedArrivals(phases, 5*time.Second), 1e-9)
+	s.InDelta(50, expectedArrivals(phases, 10*time.Second), 1e-9)
+	s.InDelta(150, expectedArrivals(phases, 20*time.Second), 1e-9)
+	s.InDelta(250, expectedArrivals(phases, 30*time.Second), 1e-9)
+	s.InDelta(250, expectedArrivals(phases, time.Minute), 1e-9)
+}
+
+func (s *ReportTestSuite) TestLatencyHistogram() {
+	h := newLatencyHistogram()
+	s.Equal(LatencySummary{}, h.summary())
+
+	for i := 1; i <= 100; i++ {
+		h.record(time.Duration(i) * time.Millisecond)
+	}
+	other := newLatencyHistogram()
+	other.record(2 * time.Second)
+	h.merge(other)
+
+	summary := h.summary()
+	s.Equal(int64(101), h.Count)
+	s.Equal(int64(2000), summary.Max)
+	// percentiles are bucket upper bounds, within 10% of the actual value
+	s.InDelta(51, summary.P50, 51*0.1)
+	s.InDelta(96, summary.P95, 96*0.1)
+	s.InDelta(100, summary.P99, 100*0.1)
+}
+
+func (s *ReportTestSuite) TestEvaluate() {
+	slo := lib.ScenarioSLOConfig{
+		MaxStartLatencyP99InMilliseconds: 100,
+		MaxBacklog:                       10,
+		MaxFailureRate:                   0.1,
+	}
+
+	report := &Report{
+		StartedCount:     95,
+		StartFailedCount: 5,
+		FailedCount:      3,
+		StartLatency:     LatencySummary{P99: 80},
+		MaxBacklog:       10,
+	}
+	report.evaluate(slo)
+	s.True(report.Passed)
+	s.Len(report.SLOs, 4)
+	s.InDelta(0.08, report.SLOs[3].Actual, 1e-9)
+
+	report = &Report{
+		StartedCount:  100,
+		TimedOutCount: 11,
+	}
+	report.evaluate(slo)
+	s.False(report.Passed)
+	s.False(report.SLOs[3].Passed)
+
+	report = &Report{OpenCount: 1}
+	report.evaluate(lib.ScenarioSLOConfig{})
+	s.False(report.Passed)
+	s.Len(report.SLOs, 1)
+}
+
+func (s *ReportTestSuite) TestSignalPayloads() {
+	payloads := signalPayloads([]lib.ScenarioStepConfig{
+		{SignalCount: 2, PayloadSizeBytes: 8, Repeat: 3},
+		{ActivityCount: 1},
+		{SignalCount: 1},
+	})
+	s.Len(payloads, 7)
+	s.Len(payloads[0], 8)
+	s.Len(payloads[6], 0)
+}
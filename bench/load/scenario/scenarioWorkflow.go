@@ -0,0 +1,133 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scenario
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/bench/lib"
+	"github.com/uber/cadence/bench/load/common"
+)
+
+const (
+	scenarioWorkflowName      = "scenarioWorkflow"
+	scenarioChildWorkflowName = "scenarioChildWorkflow"
+	scenarioSignalName        = "scenarioSignal"
+
+	childWorkflowTimeout = 5 * time.Minute
+)
+
+type (
+	// WorkflowParams inputs to scenario workflow
+	WorkflowParams struct {
+		Steps          []lib.ScenarioStepConfig
+		TaskListNumber int
+	}
+)
+
+// RegisterWorker registers workflows for scenario load
+func RegisterWorker(w worker.Worker) {
+	w.RegisterWorkflowWithOptions(scenarioWorkflow, workflow.RegisterOptions{Name: scenarioWorkflowName})
+	w.RegisterWorkflowWithOptions(scenarioChildWorkflow, workflow.RegisterOptions{Name: scenarioChildWorkflowName})
+}
+
+func scenarioWorkflow(ctx workflow.Context, params WorkflowParams) error {
+	ao := workflow.ActivityOptions{
+		TaskList:               common.GetTaskListName(params.TaskListNumber),
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	signalCh := workflow.GetSignalChannel(ctx, scenarioSignalName)
+	for i, step := range params.Steps {
+		repeat := step.Repeat
+		if repeat == 0 {
+			repeat = 1
+		}
+		for j := 0; j < repeat; j++ {
+			stepID := fmt.Sprintf("%d-%d", i, j)
+			if err := executeStep(ctx, stepID, step, params.TaskListNumber, signalCh); err != nil {
+				return fmt.Errorf("scenario step %v failed: %v", stepID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// executeStep runs the activities, child workflows and timer of the step in parallel,
+// and waits for them as well as the signals of the step
+func executeStep(
+	ctx workflow.Context,
+	stepID string,
+	step lib.ScenarioStepConfig,
+	taskListNumber int,
+	signalCh workflow.Channel,
+) error {
+	payload := make([]byte, step.PayloadSizeBytes)
+	futures := make([]workflow.Future, 0, step.ActivityCount+step.ChildWorkflowCount+1)
+	for i := 0; i < step.ActivityCount; i++ {
+		futures = append(futures, workflow.ExecuteActivity(ctx, common.EchoActivityName, common.EchoActivityParams{Payload: payload}))
+	}
+
+	workflowID := workflow.GetInfo(ctx).WorkflowExecution.ID
+	for i := 0; i < step.ChildWorkflowCount; i++ {
+		cwo := workflow.ChildWorkflowOptions{
+			WorkflowID:                   fmt.Sprintf("%v-%v-%d", workflowID, stepID, i),
+			TaskList:                     common.GetTaskListName(taskListNumber),
+			ExecutionStartToCloseTimeout: childWorkflowTimeout,
+			TaskStartToCloseTimeout:      time.Minute,
+			WorkflowIDReusePolicy:        client.WorkflowIDReusePolicyAllowDuplicate,
+		}
+		childCtx := workflow.WithChildOptions(ctx, cwo)
+		futures = append(futures, workflow.ExecuteChildWorkflow(childCtx, scenarioChildWorkflowName, payload))
+	}
+
+	if step.TimerInSeconds > 0 {
+		futures = append(futures, workflow.NewTimer(ctx, time.Duration(step.TimerInSeconds)*time.Second))
+	}
+
+	for i := 0; i < step.SignalCount; i++ {
+		signalCh.Receive(ctx, nil)
+	}
+
+	for _, future := range futures {
+		if err := future.Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scenarioChildWorkflow(ctx workflow.Context, payload []byte) error {
+	ao := workflow.ActivityOptions{
+		TaskList:               workflow.GetInfo(ctx).TaskListName,
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+	return workflow.ExecuteActivity(ctx, common.EchoActivityName, common.EchoActivityParams{Payload: payload}).Get(ctx, nil)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/bench/load/common"
 	"github.com/uber/cadence/bench/load/concurrentexec"
 	"github.com/uber/cadence/bench/load/cron"
+	"github.com/uber/cadence/bench/load/scenario"
 	"github.com/uber/cadence/bench/load/signal"
 	"github.com/uber/cadence/bench/load/timer"
 )
@@ -141,6 +142,7 @@ func registerWorkers(w worker.Worker) {
 	timer.RegisterWorker(w)
 	concurrentexec.RegisterWorker(w)
 	cancellation.RegisterWorker(w)
+	scenario.RegisterWorker(w)
 }
 
 func registerLaunchers(w worker.Worker) {
@@ -150,4 +152,5 @@ func registerLaunchers(w worker.Worker) {
 	timer.RegisterLauncher(w)
 	concurrentexec.RegisterLauncher(w)
 	cancellation.RegisterLauncher(w)
+	scenario.RegisterLauncher(w)
 }
@@ -44,8 +44,10 @@ type (
 		archivalClient cadenceClient
 		systemClient   cadenceClient
 		batcherClient  cadenceClient
-		runtime        *RuntimeContext
-		canaryConfig   *Canary
+		// standbyClient is only set for the replication canary domain
+		standbyClient *cadenceClient
+		runtime       *RuntimeContext
+		canaryConfig  *Canary
 	}
 
 	activityContext struct {
@@ -67,12 +69,18 @@ func newCanary(domain string, rc *RuntimeContext, canaryConfig *Canary) Runnable
 	archivalClient := newCadenceClient(archivalDomain, rc)
 	systemClient := newCadenceClient(common.SystemLocalDomainName, rc)
 	batcherClient := newCadenceClient(common.BatcherLocalDomainName, rc)
+	var standbyClient *cadenceClient
+	if domain == canaryConfig.Replication.Domain && rc.standbyService != nil {
+		client := newCadenceClientForService(domain, rc, rc.standbyService)
+		standbyClient = &client
+	}
 	return &canaryImpl{
 		canaryClient:   canaryClient,
 		canaryDomain:   domain,
 		archivalClient: archivalClient,
 		systemClient:   systemClient,
 		batcherClient:  batcherClient,
+		standbyClient:  standbyClient,
 		runtime:        rc,
 		canaryConfig:   canaryConfig,
 	}
@@ -86,8 +94,11 @@ func (c *canaryImpl) Run(mode string) error {
 	var err error
 	log := c.runtime.logger
 
-	if err = c.createDomain(); err != nil {
-		log.Error("createDomain failed", zap.Error(err))
+	// the replication canary domain is a global domain managed by the operator
+	if !c.isReplicationDomain() {
+		if err = c.createDomain(); err != nil {
+			log.Error("createDomain failed", zap.Error(err))
+		}
 	}
 
 	if err = c.createArchivalDomain(); err != nil {
@@ -96,7 +107,12 @@ func (c *canaryImpl) Run(mode string) error {
 
 	if mode == ModeAll || mode == ModeCronCanary {
 		// start the initial cron workflow
-		c.startCronWorkflow()
+		if c.isReplicationDomain() {
+			c.startCronWorkflow(replicationCronWorkflowID, wfTypeReplication)
+			c.startFailoverDrillWorkflow()
+		} else {
+			c.startCronWorkflow(cronWorkflowID, wfTypeSanity)
+		}
 	}
 
 	if mode == ModeAll || mode == ModeWorker {
@@ -130,9 +146,12 @@ func (c *canaryImpl) startWorker() error {
 	return canaryWorker.Run()
 }
 
-func (c *canaryImpl) startCronWorkflow() {
-	c.runtime.logger.Info("starting canary cron workflow...")
-	wfID := "cadence.canary.cron"
+func (c *canaryImpl) isReplicationDomain() bool {
+	return c.canaryDomain == c.canaryConfig.Replication.Domain
+}
+
+func (c *canaryImpl) startCronWorkflow(wfID string, jobName string) {
+	c.runtime.logger.Info("starting canary cron workflow...", zap.String("wfID", wfID))
 	opts := newWorkflowOptions(wfID, c.canaryConfig.Cron.CronExecutionTimeout)
 	opts.CronSchedule = c.canaryConfig.Cron.CronSchedule
 
@@ -141,7 +160,30 @@ func (c *canaryImpl) startCronWorkflow() {
 	span := opentracing.StartSpan("start-cron-workflow-span")
 	defer span.Finish()
 	ctx = opentracing.ContextWithSpan(ctx, span)
-	_, err := c.canaryClient.StartWorkflow(ctx, opts, cronWorkflow, wfTypeSanity)
+	_, err := c.canaryClient.StartWorkflow(ctx, opts, cronWorkflow, jobName)
+	c.logStartCronWorkflowError(err)
+}
+
+// startFailoverDrillWorkflow starts the cron workflow which periodically fails over the replication
+// canary domain and back, it is a no-op unless failover drills are enabled
+func (c *canaryImpl) startFailoverDrillWorkflow() {
+	interval := c.canaryConfig.Replication.FailoverInterval
+	if interval <= 0 {
+		return
+	}
+	c.runtime.logger.Info("starting canary failover drill workflow...", zap.Duration("interval", interval))
+	opts := newWorkflowOptions(failoverDrillCronWorkflowID, failoverDrillTimeout)
+	opts.CronSchedule = fmt.Sprintf("@every %v", interval)
+
+	ctx := context.Background()
+	span := opentracing.StartSpan("start-failover-drill-workflow-span")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	_, err := c.canaryClient.StartWorkflow(ctx, opts, wfTypeFailoverDrill, int64(0))
+	c.logStartCronWorkflowError(err)
+}
+
+func (c *canaryImpl) logStartCronWorkflowError(err error) {
 	if err != nil {
 		// TODO: improvement: compare the cron schedule to decide whether or not terminating the current one
 		// https://github.com/uber/cadence/issues/4469
@@ -161,6 +203,9 @@ func (c *canaryImpl) newActivityContext() context.Context {
 	ctx = context.WithValue(ctx, ctxKeyActivitySystemClient, &activityContext{cadence: c.systemClient})
 	ctx = context.WithValue(ctx, ctxKeyActivityBatcherClient, &activityContext{cadence: c.batcherClient})
 	ctx = context.WithValue(ctx, ctxKeyConfig, c.canaryConfig)
+	if c.standbyClient != nil {
+		ctx = context.WithValue(ctx, ctxKeyActivityStandbyRuntime, &activityContext{cadence: *c.standbyClient})
+	}
 	return overrideWorkerOptions(ctx)
 }
 
@@ -181,6 +226,7 @@ func (c *canaryImpl) createArchivalDomain() error {
 }
 
 // Override worker options to create large number of pollers to improve the chances of activities getting sync matched
+//
 //nolint:unused
 func overrideWorkerOptions(ctx context.Context) context.Context {
 	optionsOverride := make(map[string]map[string]string)
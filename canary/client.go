@@ -74,9 +74,14 @@ func (client *cadenceClient) createDomain(name string, desc string, owner string
 
 // newCadenceClient builds a cadenceClient from the runtimeContext
 func newCadenceClient(domain string, runtime *RuntimeContext) cadenceClient {
+	return newCadenceClientForService(domain, runtime, runtime.service)
+}
+
+// newCadenceClientForService builds a cadenceClient talking to the given frontend service
+func newCadenceClientForService(domain string, runtime *RuntimeContext, service workflowserviceclient.Interface) cadenceClient {
 	tracer := opentracing.GlobalTracer()
 	cclient := client.NewClient(
-		runtime.service,
+		service,
 		domain,
 		&client.Options{
 			MetricsScope: runtime.metrics,
@@ -84,7 +89,7 @@ func newCadenceClient(domain string, runtime *RuntimeContext) cadenceClient {
 		},
 	)
 	domainClient := client.NewDomainClient(
-		runtime.service,
+		service,
 		&client.Options{
 			MetricsScope: runtime.metrics,
 			Tracer:       tracer,
@@ -93,7 +98,7 @@ func newCadenceClient(domain string, runtime *RuntimeContext) cadenceClient {
 	return cadenceClient{
 		Client:       cclient,
 		DomainClient: domainClient,
-		Service:      runtime.service,
+		Service:      service,
 	}
 }
 
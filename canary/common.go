@@ -73,6 +73,12 @@ func getActivityArchivalContext(ctx context.Context) *activityContext {
 	return getContextValue(ctx, ctxKeyActivityArchivalRuntime).(*activityContext)
 }
 
+// getActivityStandbyContext retrieves and returns the activity context talking to
+// the standby cluster of the replication canary domain
+func getActivityStandbyContext(ctx context.Context) *activityContext {
+	return getContextValue(ctx, ctxKeyActivityStandbyRuntime).(*activityContext)
+}
+
 // checkWFVersionCompatibility takes a workflow.Context param and
 // validates that the workflow task currently being handled
 // is compatible with this version of the canary - this method
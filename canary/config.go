@@ -63,9 +63,10 @@ type (
 
 	// Canary contains the configuration for canary tests
 	Canary struct {
-		Domains  []string `yaml:"domains"`
-		Excludes []string `yaml:"excludes"`
-		Cron     Cron     `yaml:"cron"`
+		Domains     []string    `yaml:"domains"`
+		Excludes    []string    `yaml:"excludes"`
+		Cron        Cron        `yaml:"cron"`
+		Replication Replication `yaml:"replication"`
 	}
 
 	// Cron contains configuration for the cron workflow for canary
@@ -75,6 +76,16 @@ type (
 		StartJobTimeout      time.Duration `yaml:"startJobTimeout"`      // default to 9 minutes
 	}
 
+	// Replication contains configuration for the cross cluster canary, which runs on a global domain
+	// and reads back from a standby cluster of the domain. It is disabled if Domain is empty.
+	Replication struct {
+		Domain             string        `yaml:"domain"`             // an existing global domain, must not be a sanity canary domain
+		Standby            Cadence       `yaml:"standby"`            // frontend of a standby cluster of the domain
+		ReplicationTimeout time.Duration `yaml:"replicationTimeout"` // default to 2 minutes
+		FailoverInterval   time.Duration `yaml:"failoverInterval"`   // interval of graceful failover drills, default to 0 (disabled)
+		FailoverTimeout    time.Duration `yaml:"failoverTimeout"`    // timeout of graceful failovers, default to 1 minute
+	}
+
 	// Cadence contains the configuration for cadence service
 	Cadence struct {
 		ServiceName string `yaml:"service"`
@@ -87,9 +98,18 @@ type (
 
 // Validate validates canary configration
 func (c *Config) Validate() error {
-	if len(c.Canary.Domains) == 0 {
+	replication := c.Canary.Replication
+	if len(c.Canary.Domains) == 0 && replication.Domain == "" {
 		return errors.New("missing value for domains property")
 	}
+	if replication.Domain != "" {
+		if isStringInList(replication.Domain, c.Canary.Domains) {
+			return errors.New("replication domain must not be one of the domains")
+		}
+		if replication.Standby.GRPCHostNameAndPort == "" && replication.Standby.ThriftHostNameAndPort == "" {
+			return errors.New("missing standby address for the replication canary")
+		}
+	}
 	return nil
 }
 
@@ -99,6 +119,9 @@ type RuntimeContext struct {
 	logger  *zap.Logger
 	metrics tally.Scope
 	service workflowserviceclient.Interface
+	// standbyService is the frontend of the standby cluster used
+	// by the replication canary, nil if it is disabled
+	standbyService workflowserviceclient.Interface
 }
 
 // NewRuntimeContext builds a runtime context from the config
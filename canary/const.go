@@ -40,8 +40,14 @@ const (
 	ctxKeyActivitySystemClient    = "system-client"
 	ctxKeyActivityBatcherClient   = "batcher-client"
 	ctxKeyConfig                  = "runtime-config"
+	ctxKeyActivityStandbyRuntime  = "runtime-standby"
 	archivalDomain                = "canary-archival-domain"
 	archivalTaskListName          = "canary-archival-task-queue"
+	cronWorkflowID                = "cadence.canary.cron"
+	replicationCronWorkflowID     = "cadence.canary.replication.cron"
+	failoverDrillCronWorkflowID   = "cadence.canary.failover.cron"
+	failoverDrillTimeout          = 15 * time.Minute
+	replicationPollInterval       = time.Second
 )
 
 // canary running modes
@@ -85,6 +91,9 @@ const (
 	wfTypeBatch                = "workflow.batch"
 	wfTypeBatchParent          = "workflow.batch.parent"
 	wfTypeBatchChild           = "workflow.batch.child"
+	wfTypeReplication          = "workflow.replication"
+	wfTypeReplicationTarget    = "workflow.replication.target"
+	wfTypeFailoverDrill        = "workflow.failover.drill"
 
 	activityTypeEcho               = "activity.echo"
 	activityTypeCron               = "activity.cron"
@@ -107,4 +116,7 @@ const (
 	activityTypeLargeResult        = "activity.largeResult"
 	activityTypeVerifyBatch        = "activity.batch.verify"
 	activityTypeStartBatch         = "activity.batch.start.batch"
+	activityTypeReplicationLag     = "activity.replication.lag"
+	activityTypeVerifyReplication  = "activity.replication.verify"
+	activityTypeFailoverDrill      = "activity.failover.drill"
 )
@@ -47,6 +47,10 @@ const (
 	getWorkflowHistoryFailureCount    = "get-workflow-history.failures"
 	errTimeoutCount                   = "errors.timeout"
 	errIncompatibleVersion            = "errors.incompatibleversion"
+	replicationTimeoutCount           = "replication.timeout"
+	replicationVerifyFailureCount     = "replication.verify.failures"
+	failoverCount                     = "failover"
+	failoverFailureCount              = "failover.failures"
 )
 
 // latency metrics go here
@@ -59,6 +63,8 @@ const (
 	listArchivedWorkflowsLatency = "latency.list-archived-workflows"
 	getWorkflowHistoryLatency    = "latency.get-workflow-history"
 	timerDriftLatency            = "latency.timer-drift"
+	replicationLatency           = "latency.replication"
+	failoverLatency              = "latency.failover"
 )
 
 // workflowMetricsProfile is the state that's needed to
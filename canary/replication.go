@@ -0,0 +1,383 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	replicationProbeSignal = "replication-probe"
+	// failoverDrillActivityTimeout leaves room for two failovers and their replication
+	failoverDrillActivityTimeout = failoverDrillTimeout - time.Minute
+)
+
+func init() {
+	registerWorkflow(replicationWorkflow, wfTypeReplication)
+	registerWorkflow(replicationTargetWorkflow, wfTypeReplicationTarget)
+	registerWorkflow(failoverDrillWorkflow, wfTypeFailoverDrill)
+	registerActivity(replicationLagActivity, activityTypeReplicationLag)
+	registerActivity(verifyReplicationActivity, activityTypeVerifyReplication)
+	registerActivity(failoverDrillActivity, activityTypeFailoverDrill)
+}
+
+// replicationWorkflow verifies cross cluster replication of a global domain. It first measures
+// the time it takes for an event written in the active cluster to show up in the standby cluster,
+// then runs a child workflow to completion and verifies that the standby cluster ends up with
+// exactly the same history as the active one
+func replicationWorkflow(ctx workflow.Context, inputScheduledTimeNanos int64) error {
+	scheduledTimeNanos := getScheduledTimeFromInputIfNonZero(ctx, inputScheduledTimeNanos)
+	domain := workflow.GetInfo(ctx).Domain
+	profile, err := beginWorkflow(ctx, wfTypeReplication, scheduledTimeNanos)
+	if err != nil {
+		return err
+	}
+
+	aCtx := workflow.WithActivityOptions(ctx, newActivityOptions())
+	now := workflow.Now(ctx).UnixNano()
+	if err := workflow.ExecuteActivity(aCtx, activityTypeReplicationLag, now).Get(aCtx, nil); err != nil {
+		workflow.GetLogger(ctx).Info("replicationLagActivity failed", zap.Error(err))
+		return profile.end(err)
+	}
+	// the probe signal has been recorded before the activity completed, drain it
+	probes := workflow.GetSignalChannel(ctx, replicationProbeSignal)
+	for probes.ReceiveAsync(nil) {
+	}
+
+	myID := workflow.GetInfo(ctx).WorkflowExecution.ID
+	cCtx := workflow.WithChildOptions(ctx, newChildWorkflowOptions(domain, concat(myID, wfTypeReplicationTarget)))
+	future := workflow.ExecuteChildWorkflow(cCtx, wfTypeReplicationTarget, workflow.Now(ctx).UnixNano())
+	var execution workflow.Execution
+	if err := future.GetChildWorkflowExecution().Get(ctx, &execution); err != nil {
+		return profile.end(err)
+	}
+	if err := future.Get(ctx, nil); err != nil {
+		workflow.GetLogger(ctx).Info("replicationTargetWorkflow failed", zap.Error(err))
+		return profile.end(err)
+	}
+
+	now = workflow.Now(ctx).UnixNano()
+	if err := workflow.ExecuteActivity(aCtx, activityTypeVerifyReplication, now, execution).Get(aCtx, nil); err != nil {
+		workflow.GetLogger(ctx).Info("verifyReplicationActivity failed", zap.Error(err))
+		return profile.end(err)
+	}
+	return profile.end(nil)
+}
+
+// replicationTargetWorkflow is the workflow whose history gets compared across clusters,
+// it produces a mix of activity, timer and marker events
+func replicationTargetWorkflow(ctx workflow.Context, scheduledTimeNanos int64) error {
+	profile, err := beginWorkflow(ctx, wfTypeReplicationTarget, scheduledTimeNanos)
+	if err != nil {
+		return err
+	}
+
+	aCtx := workflow.WithActivityOptions(ctx, newActivityOptions())
+	input := newEchoInput()
+	if err := workflow.ExecuteActivity(aCtx, activityTypeEcho, workflow.Now(ctx).UnixNano(), input).Get(aCtx, nil); err != nil {
+		return profile.end(err)
+	}
+	if err := workflow.Sleep(ctx, time.Second); err != nil {
+		return profile.end(err)
+	}
+	var marker string
+	encoded := workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
+		return uuid.New()
+	})
+	if err := encoded.Get(&marker); err != nil {
+		return profile.end(err)
+	}
+	return profile.end(nil)
+}
+
+// replicationLagActivity signals its own workflow in the active cluster and waits for the
+// signal to show up in the standby cluster, the elapsed time is reported as replication latency
+func replicationLagActivity(ctx context.Context, scheduledTimeNanos int64) (err error) {
+	scope := activity.GetMetricsScope(ctx)
+	scope, sw := recordActivityStart(scope, activityTypeReplicationLag, scheduledTimeNanos)
+	defer func() { recordActivityEnd(scope, sw, err) }()
+
+	client := getActivityContext(ctx).cadence
+	standby := getActivityStandbyContext(ctx).cadence
+	config := getContextValue(ctx, ctxKeyConfig).(*Canary)
+	execution := activity.GetInfo(ctx).WorkflowExecution
+
+	probe := uuid.New()
+	start := time.Now()
+	if err = client.SignalWorkflow(ctx, execution.ID, execution.RunID, replicationProbeSignal, probe); err != nil {
+		return err
+	}
+
+	deadline := start.Add(config.Replication.ReplicationTimeout)
+	for {
+		events, err := getHistory(ctx, &standby, execution.ID, execution.RunID)
+		if err == nil && hasProbeSignal(events, probe) {
+			scope.Timer(replicationLatency).Record(time.Since(start))
+			return nil
+		}
+		if time.Now().After(deadline) {
+			scope.Counter(replicationTimeoutCount).Inc(1)
+			return fmt.Errorf("probe signal not replicated to standby cluster after %v", config.Replication.ReplicationTimeout)
+		}
+		activity.RecordHeartbeat(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(replicationPollInterval):
+		}
+	}
+}
+
+// verifyReplicationActivity waits for the history of the given closed execution to be fully
+// replicated, and verifies it is identical in the active and standby clusters
+func verifyReplicationActivity(ctx context.Context, scheduledTimeNanos int64, execution workflow.Execution) (err error) {
+	scope := activity.GetMetricsScope(ctx)
+	scope, sw := recordActivityStart(scope, activityTypeVerifyReplication, scheduledTimeNanos)
+	defer func() { recordActivityEnd(scope, sw, err) }()
+
+	client := getActivityContext(ctx).cadence
+	standby := getActivityStandbyContext(ctx).cadence
+	config := getContextValue(ctx, ctxKeyConfig).(*Canary)
+
+	active, err := getHistory(ctx, &client, execution.ID, execution.RunID)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(config.Replication.ReplicationTimeout)
+	for {
+		replicated, err := getHistory(ctx, &standby, execution.ID, execution.RunID)
+		if err == nil && len(replicated) >= len(active) {
+			if err := compareReplicatedHistory(active, replicated); err != nil {
+				scope.Counter(replicationVerifyFailureCount).Inc(1)
+				activity.GetLogger(ctx).Error("replicated history mismatch",
+					zap.String("wfID", execution.ID), zap.String("runID", execution.RunID), zap.Error(err))
+				return err
+			}
+			return nil
+		}
+		if time.Now().After(deadline) {
+			scope.Counter(replicationTimeoutCount).Inc(1)
+			return fmt.Errorf("history not replicated to standby cluster after %v", config.Replication.ReplicationTimeout)
+		}
+		activity.RecordHeartbeat(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(replicationPollInterval):
+		}
+	}
+}
+
+// failoverDrillWorkflow gracefully fails over the replication canary domain to
+// another cluster and back, it is started by its own cron schedule
+func failoverDrillWorkflow(ctx workflow.Context, inputScheduledTimeNanos int64) error {
+	scheduledTimeNanos := getScheduledTimeFromInputIfNonZero(ctx, inputScheduledTimeNanos)
+	profile, err := beginWorkflow(ctx, wfTypeFailoverDrill, scheduledTimeNanos)
+	if err != nil {
+		return err
+	}
+
+	opts := newActivityOptions()
+	opts.StartToCloseTimeout = failoverDrillActivityTimeout
+	opts.ScheduleToCloseTimeout = scheduleToStartTimeout + failoverDrillActivityTimeout
+	aCtx := workflow.WithActivityOptions(ctx, opts)
+	now := workflow.Now(ctx).UnixNano()
+	if err := workflow.ExecuteActivity(aCtx, activityTypeFailoverDrill, now).Get(aCtx, nil); err != nil {
+		workflow.GetLogger(ctx).Info("failoverDrillActivity failed", zap.Error(err))
+		return profile.end(err)
+	}
+	return profile.end(nil)
+}
+
+// failoverDrillActivity gracefully fails over the domain to the next cluster and then back to
+// the original active cluster, waiting for both clusters to agree after each failover
+func failoverDrillActivity(ctx context.Context, scheduledTimeNanos int64) (err error) {
+	scope := activity.GetMetricsScope(ctx)
+	scope, sw := recordActivityStart(scope, activityTypeFailoverDrill, scheduledTimeNanos)
+	defer func() { recordActivityEnd(scope, sw, err) }()
+
+	client := getActivityContext(ctx).cadence
+	standby := getActivityStandbyContext(ctx).cadence
+	config := getContextValue(ctx, ctxKeyConfig).(*Canary)
+	domain := activity.GetInfo(ctx).WorkflowDomain
+
+	resp, err := client.Describe(ctx, domain)
+	if err != nil {
+		return err
+	}
+	source, target, err := getFailoverTarget(resp)
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range []string{target, source} {
+		scope.Counter(failoverCount).Inc(1)
+		start := time.Now()
+		if err = failoverDomain(ctx, &client, &standby, domain, cluster, config); err != nil {
+			scope.Counter(failoverFailureCount).Inc(1)
+			activity.GetLogger(ctx).Error("failover drill failed",
+				zap.String("domain", domain), zap.String("cluster", cluster), zap.Error(err))
+			return err
+		}
+		scope.Timer(failoverLatency).Record(time.Since(start))
+	}
+	return nil
+}
+
+// failoverDomain gracefully fails over the domain to the given cluster, and waits until
+// both the active and the standby cluster report the same active cluster and failover version
+func failoverDomain(
+	ctx context.Context,
+	client *cadenceClient,
+	standby *cadenceClient,
+	domain string,
+	cluster string,
+	config *Canary,
+) error {
+	timeout := config.Replication.FailoverTimeout
+	err := client.Update(ctx, &shared.UpdateDomainRequest{
+		Name: stringPtr(domain),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: stringPtr(cluster),
+		},
+		FailoverTimeoutInSeconds: int32Ptr(int32(timeout.Seconds())),
+	})
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout + config.Replication.ReplicationTimeout)
+	for {
+		done, err := isFailoverComplete(ctx, []*cadenceClient{client, standby}, domain, cluster)
+		if err == nil && done {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("domain %v not failed over to %v after %v", domain, cluster, timeout+config.Replication.ReplicationTimeout)
+		}
+		activity.RecordHeartbeat(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(replicationPollInterval):
+		}
+	}
+}
+
+func isFailoverComplete(ctx context.Context, clients []*cadenceClient, domain string, cluster string) (bool, error) {
+	var failoverVersion *int64
+	for _, client := range clients {
+		resp, err := client.Describe(ctx, domain)
+		if err != nil {
+			return false, err
+		}
+		if resp.GetReplicationConfiguration().GetActiveClusterName() != cluster {
+			return false, nil
+		}
+		if failoverVersion != nil && *failoverVersion != resp.GetFailoverVersion() {
+			return false, nil
+		}
+		version := resp.GetFailoverVersion()
+		failoverVersion = &version
+	}
+	return true, nil
+}
+
+// getFailoverTarget returns the current active cluster of the domain, and the cluster
+// following it in name order, which is the one a failover drill moves the domain to
+func getFailoverTarget(resp *shared.DescribeDomainResponse) (string, string, error) {
+	if !resp.GetIsGlobalDomain() {
+		return "", "", fmt.Errorf("domain %v is not a global domain", resp.GetDomainInfo().GetName())
+	}
+	replicationConfig := resp.GetReplicationConfiguration()
+	var clusters []string
+	for _, c := range replicationConfig.GetClusters() {
+		clusters = append(clusters, c.GetClusterName())
+	}
+	if len(clusters) < 2 {
+		return "", "", fmt.Errorf("domain %v is replicated to less than 2 clusters", resp.GetDomainInfo().GetName())
+	}
+	sort.Strings(clusters)
+	source := replicationConfig.GetActiveClusterName()
+	for i, c := range clusters {
+		if c == source {
+			return source, clusters[(i+1)%len(clusters)], nil
+		}
+	}
+	return "", "", fmt.Errorf("active cluster %v is not one of the domain clusters", source)
+}
+
+func getHistory(ctx context.Context, client *cadenceClient, workflowID string, runID string) ([]*shared.HistoryEvent, error) {
+	var events []*shared.HistoryEvent
+	iter := client.GetWorkflowHistory(ctx, workflowID, runID, false, shared.HistoryEventFilterTypeAllEvent)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func hasProbeSignal(events []*shared.HistoryEvent, probe string) bool {
+	for _, event := range events {
+		attr := event.GetWorkflowExecutionSignaledEventAttributes()
+		if attr != nil && attr.GetSignalName() == replicationProbeSignal && strings.Contains(string(attr.GetInput()), probe) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareReplicatedHistory returns an error describing the first difference
+// between the history in the active cluster and the replicated one
+func compareReplicatedHistory(active []*shared.HistoryEvent, replicated []*shared.HistoryEvent) error {
+	if len(active) == 0 {
+		return errors.New("active history is empty")
+	}
+	for i, event := range active {
+		if i >= len(replicated) {
+			return fmt.Errorf("replicated history is missing events from event %v", event.GetEventId())
+		}
+		if !event.Equals(replicated[i]) {
+			return fmt.Errorf("replicated event %v does not match, expected %v, got %v",
+				event.GetEventId(), event.GetEventType(), replicated[i].GetEventType())
+		}
+	}
+	if len(replicated) > len(active) {
+		return fmt.Errorf("replicated history has %v unexpected events after event %v",
+			len(replicated)-len(active), active[len(active)-1].GetEventId())
+	}
+	return nil
+}
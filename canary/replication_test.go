@@ -0,0 +1,89 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/cadence/.gen/go/shared"
+)
+
+func TestCompareReplicatedHistory(t *testing.T) {
+	newEvent := func(id int64, eventType shared.EventType) *shared.HistoryEvent {
+		return &shared.HistoryEvent{EventId: &id, EventType: &eventType}
+	}
+	active := []*shared.HistoryEvent{
+		newEvent(1, shared.EventTypeWorkflowExecutionStarted),
+		newEvent(2, shared.EventTypeDecisionTaskScheduled),
+		newEvent(3, shared.EventTypeDecisionTaskStarted),
+	}
+
+	require.NoError(t, compareReplicatedHistory(active, []*shared.HistoryEvent{
+		newEvent(1, shared.EventTypeWorkflowExecutionStarted),
+		newEvent(2, shared.EventTypeDecisionTaskScheduled),
+		newEvent(3, shared.EventTypeDecisionTaskStarted),
+	}))
+	require.Error(t, compareReplicatedHistory(nil, nil))
+	require.EqualError(t, compareReplicatedHistory(active, active[:2]),
+		"replicated history is missing events from event 3")
+	require.EqualError(t, compareReplicatedHistory(active, append(active, newEvent(4, shared.EventTypeDecisionTaskCompleted))),
+		"replicated history has 1 unexpected events after event 3")
+	require.EqualError(t, compareReplicatedHistory(active, []*shared.HistoryEvent{
+		newEvent(1, shared.EventTypeWorkflowExecutionStarted),
+		newEvent(2, shared.EventTypeDecisionTaskScheduled),
+		newEvent(3, shared.EventTypeDecisionTaskTimedOut),
+	}), "replicated event 3 does not match, expected DecisionTaskStarted, got DecisionTaskTimedOut")
+}
+
+func TestGetFailoverTarget(t *testing.T) {
+	newResponse := func(isGlobal bool, active string, clusters ...string) *shared.DescribeDomainResponse {
+		var replicationClusters []*shared.ClusterReplicationConfiguration
+		for _, c := range clusters {
+			replicationClusters = append(replicationClusters, &shared.ClusterReplicationConfiguration{ClusterName: stringPtr(c)})
+		}
+		return &shared.DescribeDomainResponse{
+			DomainInfo:     &shared.DomainInfo{Name: stringPtr("canary-global-domain")},
+			IsGlobalDomain: &isGlobal,
+			ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+				ActiveClusterName: stringPtr(active),
+				Clusters:          replicationClusters,
+			},
+		}
+	}
+
+	source, target, err := getFailoverTarget(newResponse(true, "cluster1", "cluster2", "cluster1", "cluster0"))
+	require.NoError(t, err)
+	require.Equal(t, "cluster1", source)
+	require.Equal(t, "cluster2", target)
+
+	source, target, err = getFailoverTarget(newResponse(true, "cluster2", "cluster2", "cluster1", "cluster0"))
+	require.NoError(t, err)
+	require.Equal(t, "cluster2", source)
+	require.Equal(t, "cluster0", target)
+
+	_, _, err = getFailoverTarget(newResponse(false, "cluster0", "cluster0"))
+	require.Error(t, err)
+	_, _, err = getFailoverTarget(newResponse(true, "cluster0", "cluster0"))
+	require.Error(t, err)
+	_, _, err = getFailoverTarget(newResponse(true, "cluster3", "cluster0", "cluster1"))
+	require.Error(t, err)
+}
@@ -51,55 +51,18 @@ func NewCanaryRunner(cfg *Config) (Runnable, error) {
 
 	metricsScope := cfg.Metrics.NewScope(loggerimpl.NewLogger(logger), "cadence-canary")
 
-	if cfg.Cadence.ServiceName == "" {
-		cfg.Cadence.ServiceName = CadenceServiceName
+	service, err := newServiceClient(&cfg.Cadence)
+	if err != nil {
+		return nil, err
 	}
+	runtimeContext := NewRuntimeContext(logger, metricsScope, service)
 
-	var dispatcher *yarpc.Dispatcher
-	var runtimeContext *RuntimeContext
-	if cfg.Cadence.GRPCHostNameAndPort != "" {
-		dispatcher = yarpc.NewDispatcher(yarpc.Config{
-			Name: CanaryServiceName,
-			Outbounds: yarpc.Outbounds{
-				cfg.Cadence.ServiceName: {Unary: grpc.NewTransport().NewSingleOutbound(cfg.Cadence.GRPCHostNameAndPort)},
-			},
-		})
-		clientConfig := dispatcher.ClientConfig(cfg.Cadence.ServiceName)
-		runtimeContext = NewRuntimeContext(
-			logger,
-			metricsScope,
-			compatibility.NewThrift2ProtoAdapter(
-				apiv1.NewDomainAPIYARPCClient(clientConfig),
-				apiv1.NewWorkflowAPIYARPCClient(clientConfig),
-				apiv1.NewWorkerAPIYARPCClient(clientConfig),
-				apiv1.NewVisibilityAPIYARPCClient(clientConfig),
-			),
-		)
-	} else if cfg.Cadence.ThriftHostNameAndPort != "" {
-		tch, err := tchannel.NewChannelTransport(
-			tchannel.ServiceName(CanaryServiceName),
-		)
+	if cfg.Canary.Replication.Domain != "" {
+		standbyService, err := newServiceClient(&cfg.Canary.Replication.Standby)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create transport channel: %v", err)
+			return nil, fmt.Errorf("failed to create standby client: %v", err)
 		}
-		dispatcher = yarpc.NewDispatcher(yarpc.Config{
-			Name: CanaryServiceName,
-			Outbounds: yarpc.Outbounds{
-				cfg.Cadence.ServiceName: {Unary: tch.NewSingleOutbound(cfg.Cadence.ThriftHostNameAndPort)},
-			},
-		})
-		runtimeContext = NewRuntimeContext(
-			logger,
-			metricsScope,
-			workflowserviceclient.New(dispatcher.ClientConfig(cfg.Cadence.ServiceName)),
-		)
-	} else {
-		return nil, fmt.Errorf("must specify either gRPC address(address) or Thrift address (host) in the config")
-	}
-
-	if err := dispatcher.Start(); err != nil {
-		dispatcher.Stop()
-		return nil, fmt.Errorf("failed to create outbound transport channel: %v", err)
+		runtimeContext.standbyService = standbyService
 	}
 
 	return &canaryRunner{
@@ -125,8 +88,20 @@ func (r *canaryRunner) Run(mode string) error {
 		r.config.Cron.StartJobTimeout = 9 * time.Minute
 	}
 
+	if r.config.Replication.ReplicationTimeout == 0 {
+		r.config.Replication.ReplicationTimeout = 2 * time.Minute
+	}
+	if r.config.Replication.FailoverTimeout == 0 {
+		r.config.Replication.FailoverTimeout = time.Minute
+	}
+
+	domains := append([]string{}, r.config.Domains...)
+	if r.config.Replication.Domain != "" {
+		domains = append(domains, r.config.Replication.Domain)
+	}
+
 	var wg sync.WaitGroup
-	for _, d := range r.config.Domains {
+	for _, d := range domains {
 		canary := newCanary(d, r.RuntimeContext, r.config)
 		r.logger.Info("starting canary", zap.String("domain", d))
 		r.execute(canary, mode, &wg)
@@ -135,6 +110,53 @@ func (r *canaryRunner) Run(mode string) error {
 	return nil
 }
 
+// newServiceClient builds a frontend client from the given config, and starts its dispatcher
+func newServiceClient(cfg *Cadence) (workflowserviceclient.Interface, error) {
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = CadenceServiceName
+	}
+
+	var dispatcher *yarpc.Dispatcher
+	var service workflowserviceclient.Interface
+	if cfg.GRPCHostNameAndPort != "" {
+		dispatcher = yarpc.NewDispatcher(yarpc.Config{
+			Name: CanaryServiceName,
+			Outbounds: yarpc.Outbounds{
+				cfg.ServiceName: {Unary: grpc.NewTransport().NewSingleOutbound(cfg.GRPCHostNameAndPort)},
+			},
+		})
+		clientConfig := dispatcher.ClientConfig(cfg.ServiceName)
+		service = compatibility.NewThrift2ProtoAdapter(
+			apiv1.NewDomainAPIYARPCClient(clientConfig),
+			apiv1.NewWorkflowAPIYARPCClient(clientConfig),
+			apiv1.NewWorkerAPIYARPCClient(clientConfig),
+			apiv1.NewVisibilityAPIYARPCClient(clientConfig),
+		)
+	} else if cfg.ThriftHostNameAndPort != "" {
+		tch, err := tchannel.NewChannelTransport(
+			tchannel.ServiceName(CanaryServiceName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create transport channel: %v", err)
+		}
+		dispatcher = yarpc.NewDispatcher(yarpc.Config{
+			Name: CanaryServiceName,
+			Outbounds: yarpc.Outbounds{
+				cfg.ServiceName: {Unary: tch.NewSingleOutbound(cfg.ThriftHostNameAndPort)},
+			},
+		})
+		service = workflowserviceclient.New(dispatcher.ClientConfig(cfg.ServiceName))
+	} else {
+		return nil, fmt.Errorf("must specify either gRPC address(address) or Thrift address (host) in the config")
+	}
+
+	if err := dispatcher.Start(); err != nil {
+		dispatcher.Stop()
+		return nil, fmt.Errorf("failed to create outbound transport channel: %v", err)
+	}
+	return service, nil
+}
+
 func (r *canaryRunner) execute(task Runnable, mode string, wg *sync.WaitGroup) {
 	wg.Add(1)
 	go func() {
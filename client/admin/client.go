@@ -187,6 +187,15 @@ func (c *clientImpl) GetReplicationMessages(
 	return c.client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...yarpc.CallOption,
+) (ReplicationMessagesStream, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	// the stream is long-lived, its lifetime is bound to the caller context instead of a call timeout
+	return c.client.StreamReplicationMessages(ctx, opts...)
+}
+
 func (c *clientImpl) GetDomainReplicationMessages(
 	ctx context.Context,
 	request *types.GetDomainReplicationMessagesRequest,
@@ -270,6 +279,90 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) FailoverWorkflowExecution(
+	ctx context.Context,
+	request *types.FailoverWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.FailoverWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *types.SuspendWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SuspendWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *types.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.ListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListWorkflowTasksResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListWorkflowTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteWorkflowTask(
+	ctx context.Context,
+	request *types.DeleteWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteWorkflowTask(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReenqueueWorkflowTask(
+	ctx context.Context,
+	request *types.ReenqueueWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ReenqueueWorkflowTask(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeDomainUsage(
+	ctx context.Context,
+	request *types.DescribeDomainUsageRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeDomainUsageResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeDomainUsage(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResendReplicationTasks(
 	ctx context.Context,
 	request *types.ResendReplicationTasksRequest,
@@ -348,6 +441,41 @@ func (c *clientImpl) ListDynamicConfig(
 	return c.client.ListDynamicConfig(ctx, request, opts...)
 }
 
+func (c *clientImpl) PromoteDomain(
+	ctx context.Context,
+	request *types.PromoteDomainRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateDomainResponse, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PromoteDomain(ctx, request, opts...)
+}
+
+func (c *clientImpl) RemoveSearchAttribute(
+	ctx context.Context,
+	request *types.RemoveSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RemoveSearchAttribute(ctx, request, opts...)
+}
+
+func (c *clientImpl) RenameSearchAttribute(
+	ctx context.Context,
+	request *types.RenameSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RenameSearchAttribute(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
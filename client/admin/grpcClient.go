@@ -22,6 +22,7 @@ package admin
 
 import (
 	"context"
+	"io"
 
 	"go.uber.org/yarpc"
 
@@ -88,6 +89,14 @@ func (g grpcClient) GetReplicationMessages(ctx context.Context, request *types.G
 	return proto.ToAdminGetReplicationMessagesResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) StreamReplicationMessages(ctx context.Context, opts ...yarpc.CallOption) (ReplicationMessagesStream, error) {
+	stream, err := g.c.StreamReplicationMessages(ctx, opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return grpcReplicationMessagesStream{stream}, nil
+}
+
 func (g grpcClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *types.GetWorkflowExecutionRawHistoryV2Request, opts ...yarpc.CallOption) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 	response, err := g.c.GetWorkflowExecutionRawHistoryV2(ctx, proto.FromAdminGetWorkflowExecutionRawHistoryV2Request(request), opts...)
 	return proto.ToAdminGetWorkflowExecutionRawHistoryV2Response(response), proto.ToError(err)
@@ -118,6 +127,41 @@ func (g grpcClient) RefreshWorkflowTasks(ctx context.Context, request *types.Ref
 	return proto.ToError(err)
 }
 
+func (g grpcClient) FailoverWorkflowExecution(ctx context.Context, request *types.FailoverWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.FailoverWorkflowExecution(ctx, proto.FromAdminFailoverWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) SuspendWorkflowExecution(ctx context.Context, request *types.SuspendWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.SuspendWorkflowExecution(ctx, proto.FromAdminSuspendWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ResumeWorkflowExecution(ctx context.Context, request *types.ResumeWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ResumeWorkflowExecution(ctx, proto.FromAdminResumeWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ListWorkflowTasks(ctx context.Context, request *types.ListWorkflowTasksRequest, opts ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error) {
+	response, err := g.c.ListWorkflowTasks(ctx, proto.FromAdminListWorkflowTasksRequest(request), opts...)
+	return proto.ToAdminListWorkflowTasksResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) DeleteWorkflowTask(ctx context.Context, request *types.DeleteWorkflowTaskRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.DeleteWorkflowTask(ctx, proto.FromAdminDeleteWorkflowTaskRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ReenqueueWorkflowTask(ctx context.Context, request *types.ReenqueueWorkflowTaskRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ReenqueueWorkflowTask(ctx, proto.FromAdminReenqueueWorkflowTaskRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) DescribeDomainUsage(ctx context.Context, request *types.DescribeDomainUsageRequest, opts ...yarpc.CallOption) (*types.DescribeDomainUsageResponse, error) {
+	response, err := g.c.DescribeDomainUsage(ctx, proto.FromAdminDescribeDomainUsageRequest(request), opts...)
+	return proto.ToAdminDescribeDomainUsageResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) RemoveTask(ctx context.Context, request *types.RemoveTaskRequest, opts ...yarpc.CallOption) error {
 	_, err := g.c.RemoveTask(ctx, proto.FromAdminRemoveTaskRequest(request), opts...)
 	return proto.ToError(err)
@@ -162,3 +206,41 @@ func (g grpcClient) ListDynamicConfig(ctx context.Context, request *types.ListDy
 	response, err := g.c.ListDynamicConfig(ctx, proto.FromListDynamicConfigRequest(request), opts...)
 	return proto.ToListDynamicConfigResponse(response), proto.ToError(err)
 }
+
+func (g grpcClient) PromoteDomain(ctx context.Context, request *types.PromoteDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
+	response, err := g.c.PromoteDomain(ctx, proto.FromAdminPromoteDomainRequest(request), opts...)
+	return proto.ToAdminPromoteDomainResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) RemoveSearchAttribute(ctx context.Context, request *types.RemoveSearchAttributeRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RemoveSearchAttribute(ctx, proto.FromAdminRemoveSearchAttributeRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) RenameSearchAttribute(ctx context.Context, request *types.RenameSearchAttributeRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RenameSearchAttribute(ctx, proto.FromAdminRenameSearchAttributeRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+type grpcReplicationMessagesStream struct {
+	s adminv1.AdminAPIServiceStreamReplicationMessagesYARPCClient
+}
+
+func (g grpcReplicationMessagesStream) Send(request *types.StreamReplicationMessagesRequest) error {
+	return proto.ToError(g.s.Send(proto.FromAdminStreamReplicationMessagesRequest(request)))
+}
+
+func (g grpcReplicationMessagesStream) Recv() (*types.StreamReplicationMessagesResponse, error) {
+	response, err := g.s.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, proto.ToError(err)
+	}
+	return proto.ToAdminStreamReplicationMessagesResponse(response), nil
+}
+
+func (g grpcReplicationMessagesStream) CloseSend() error {
+	return proto.ToError(g.s.CloseSend())
+}
@@ -42,12 +42,20 @@ type Client interface {
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error)
 	GetDomainReplicationMessages(context.Context, *types.GetDomainReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDomainReplicationMessagesResponse, error)
 	GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetReplicationMessagesResponse, error)
+	StreamReplicationMessages(context.Context, ...yarpc.CallOption) (ReplicationMessagesStream, error)
 	GetWorkflowExecutionRawHistoryV2(context.Context, *types.GetWorkflowExecutionRawHistoryV2Request, ...yarpc.CallOption) (*types.GetWorkflowExecutionRawHistoryV2Response, error)
 	MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest, ...yarpc.CallOption) (*types.MergeDLQMessagesResponse, error)
 	PurgeDLQMessages(context.Context, *types.PurgeDLQMessagesRequest, ...yarpc.CallOption) error
 	ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest, ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error)
 	ReapplyEvents(context.Context, *types.ReapplyEventsRequest, ...yarpc.CallOption) error
 	RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest, ...yarpc.CallOption) error
+	FailoverWorkflowExecution(context.Context, *types.FailoverWorkflowExecutionRequest, ...yarpc.CallOption) error
+	SuspendWorkflowExecution(context.Context, *types.SuspendWorkflowExecutionRequest, ...yarpc.CallOption) error
+	ResumeWorkflowExecution(context.Context, *types.ResumeWorkflowExecutionRequest, ...yarpc.CallOption) error
+	ListWorkflowTasks(context.Context, *types.ListWorkflowTasksRequest, ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error)
+	DeleteWorkflowTask(context.Context, *types.DeleteWorkflowTaskRequest, ...yarpc.CallOption) error
+	ReenqueueWorkflowTask(context.Context, *types.ReenqueueWorkflowTaskRequest, ...yarpc.CallOption) error
+	DescribeDomainUsage(context.Context, *types.DescribeDomainUsageRequest, ...yarpc.CallOption) (*types.DescribeDomainUsageResponse, error)
 	RemoveTask(context.Context, *types.RemoveTaskRequest, ...yarpc.CallOption) error
 	ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest, ...yarpc.CallOption) error
 	ResetQueue(context.Context, *types.ResetQueueRequest, ...yarpc.CallOption) error
@@ -57,4 +65,16 @@ type Client interface {
 	UpdateDynamicConfig(context.Context, *types.UpdateDynamicConfigRequest, ...yarpc.CallOption) error
 	RestoreDynamicConfig(context.Context, *types.RestoreDynamicConfigRequest, ...yarpc.CallOption) error
 	ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest, ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error)
+	PromoteDomain(context.Context, *types.PromoteDomainRequest, ...yarpc.CallOption) (*types.UpdateDomainResponse, error)
+	RemoveSearchAttribute(context.Context, *types.RemoveSearchAttributeRequest, ...yarpc.CallOption) error
+	RenameSearchAttribute(context.Context, *types.RenameSearchAttributeRequest, ...yarpc.CallOption) error
+}
+
+// ReplicationMessagesStream is the client side of a replication messages stream.
+// Requests carry the latest replication tokens of each shard, responses carry the replication tasks
+// pushed by the remote cluster.
+type ReplicationMessagesStream interface {
+	Send(*types.StreamReplicationMessagesRequest) error
+	Recv() (*types.StreamReplicationMessagesResponse, error)
+	CloseSend() error
 }
@@ -257,6 +257,26 @@ func (mr *MockClientMockRecorder) GetReplicationMessages(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// StreamReplicationMessages mocks base method
+func (m *MockClient) StreamReplicationMessages(arg0 context.Context, arg1 ...yarpc.CallOption) (ReplicationMessagesStream, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamReplicationMessages", varargs...)
+	ret0, _ := ret[0].(ReplicationMessagesStream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamReplicationMessages indicates an expected call of StreamReplicationMessages
+func (mr *MockClientMockRecorder) StreamReplicationMessages(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamReplicationMessages", reflect.TypeOf((*MockClient)(nil).StreamReplicationMessages), varargs...)
+}
+
 // GetWorkflowExecutionRawHistoryV2 mocks base method
 func (m *MockClient) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *types.GetWorkflowExecutionRawHistoryV2Request, arg2 ...yarpc.CallOption) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
@@ -374,6 +394,141 @@ func (mr *MockClientMockRecorder) RefreshWorkflowTasks(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// FailoverWorkflowExecution mocks base method
+func (m *MockClient) FailoverWorkflowExecution(arg0 context.Context, arg1 *types.FailoverWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FailoverWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailoverWorkflowExecution indicates an expected call of FailoverWorkflowExecution
+func (mr *MockClientMockRecorder) FailoverWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverWorkflowExecution", reflect.TypeOf((*MockClient)(nil).FailoverWorkflowExecution), varargs...)
+}
+
+// SuspendWorkflowExecution mocks base method
+func (m *MockClient) SuspendWorkflowExecution(arg0 context.Context, arg1 *types.SuspendWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendWorkflowExecution indicates an expected call of SuspendWorkflowExecution
+func (mr *MockClientMockRecorder) SuspendWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendWorkflowExecution", reflect.TypeOf((*MockClient)(nil).SuspendWorkflowExecution), varargs...)
+}
+
+// ResumeWorkflowExecution mocks base method
+func (m *MockClient) ResumeWorkflowExecution(arg0 context.Context, arg1 *types.ResumeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution
+func (mr *MockClientMockRecorder) ResumeWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockClient)(nil).ResumeWorkflowExecution), varargs...)
+}
+
+// ListWorkflowTasks mocks base method
+func (m *MockClient) ListWorkflowTasks(arg0 context.Context, arg1 *types.ListWorkflowTasksRequest, arg2 ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkflowTasks", varargs...)
+	ret0, _ := ret[0].(*types.ListWorkflowTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowTasks indicates an expected call of ListWorkflowTasks
+func (mr *MockClientMockRecorder) ListWorkflowTasks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowTasks", reflect.TypeOf((*MockClient)(nil).ListWorkflowTasks), varargs...)
+}
+
+// DeleteWorkflowTask mocks base method
+func (m *MockClient) DeleteWorkflowTask(arg0 context.Context, arg1 *types.DeleteWorkflowTaskRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkflowTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowTask indicates an expected call of DeleteWorkflowTask
+func (mr *MockClientMockRecorder) DeleteWorkflowTask(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowTask", reflect.TypeOf((*MockClient)(nil).DeleteWorkflowTask), varargs...)
+}
+
+// ReenqueueWorkflowTask mocks base method
+func (m *MockClient) ReenqueueWorkflowTask(arg0 context.Context, arg1 *types.ReenqueueWorkflowTaskRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReenqueueWorkflowTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReenqueueWorkflowTask indicates an expected call of ReenqueueWorkflowTask
+func (mr *MockClientMockRecorder) ReenqueueWorkflowTask(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueWorkflowTask", reflect.TypeOf((*MockClient)(nil).ReenqueueWorkflowTask), varargs...)
+}
+
+// DescribeDomainUsage mocks base method
+func (m *MockClient) DescribeDomainUsage(arg0 context.Context, arg1 *types.DescribeDomainUsageRequest, arg2 ...yarpc.CallOption) (*types.DescribeDomainUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDomainUsage", varargs...)
+	ret0, _ := ret[0].(*types.DescribeDomainUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDomainUsage indicates an expected call of DescribeDomainUsage
+func (mr *MockClientMockRecorder) DescribeDomainUsage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDomainUsage", reflect.TypeOf((*MockClient)(nil).DescribeDomainUsage), varargs...)
+}
+
 // RemoveTask mocks base method
 func (m *MockClient) RemoveTask(arg0 context.Context, arg1 *types.RemoveTaskRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -548,3 +703,127 @@ func (mr *MockClientMockRecorder) ListDynamicConfig(arg0, arg1 interface{}, arg2
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockClient)(nil).ListDynamicConfig), varargs...)
 }
+
+// PromoteDomain mocks base method
+func (m *MockClient) PromoteDomain(arg0 context.Context, arg1 *types.PromoteDomainRequest, arg2 ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PromoteDomain", varargs...)
+	ret0, _ := ret[0].(*types.UpdateDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteDomain indicates an expected call of PromoteDomain
+func (mr *MockClientMockRecorder) PromoteDomain(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteDomain", reflect.TypeOf((*MockClient)(nil).PromoteDomain), varargs...)
+}
+
+// RemoveSearchAttribute mocks base method
+func (m *MockClient) RemoveSearchAttribute(arg0 context.Context, arg1 *types.RemoveSearchAttributeRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveSearchAttribute", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSearchAttribute indicates an expected call of RemoveSearchAttribute
+func (mr *MockClientMockRecorder) RemoveSearchAttribute(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttribute", reflect.TypeOf((*MockClient)(nil).RemoveSearchAttribute), varargs...)
+}
+
+// RenameSearchAttribute mocks base method
+func (m *MockClient) RenameSearchAttribute(arg0 context.Context, arg1 *types.RenameSearchAttributeRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameSearchAttribute", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameSearchAttribute indicates an expected call of RenameSearchAttribute
+func (mr *MockClientMockRecorder) RenameSearchAttribute(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameSearchAttribute", reflect.TypeOf((*MockClient)(nil).RenameSearchAttribute), varargs...)
+}
+
+// MockReplicationMessagesStream is a mock of ReplicationMessagesStream interface
+type MockReplicationMessagesStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockReplicationMessagesStreamMockRecorder
+}
+
+// MockReplicationMessagesStreamMockRecorder is the mock recorder for MockReplicationMessagesStream
+type MockReplicationMessagesStreamMockRecorder struct {
+	mock *MockReplicationMessagesStream
+}
+
+// NewMockReplicationMessagesStream creates a new mock instance
+func NewMockReplicationMessagesStream(ctrl *gomock.Controller) *MockReplicationMessagesStream {
+	mock := &MockReplicationMessagesStream{ctrl: ctrl}
+	mock.recorder = &MockReplicationMessagesStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockReplicationMessagesStream) EXPECT() *MockReplicationMessagesStreamMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockReplicationMessagesStream) Send(arg0 *types.StreamReplicationMessagesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockReplicationMessagesStreamMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockReplicationMessagesStream)(nil).Send), arg0)
+}
+
+// Recv mocks base method
+func (m *MockReplicationMessagesStream) Recv() (*types.StreamReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*types.StreamReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockReplicationMessagesStreamMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockReplicationMessagesStream)(nil).Recv))
+}
+
+// CloseSend mocks base method
+func (m *MockReplicationMessagesStream) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockReplicationMessagesStreamMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockReplicationMessagesStream)(nil).CloseSend))
+}
@@ -240,6 +240,22 @@ func (c *metricClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *metricClient) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...yarpc.CallOption,
+) (ReplicationMessagesStream, error) {
+	c.metricsClient.IncCounter(metrics.FrontendClientStreamReplicationTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientStreamReplicationTasksScope, metrics.CadenceClientLatency)
+	stream, err := c.client.StreamReplicationMessages(ctx, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientStreamReplicationTasksScope, metrics.CadenceClientFailures)
+	}
+	return stream, err
+}
+
 func (c *metricClient) GetDomainReplicationMessages(
 	ctx context.Context,
 	request *types.GetDomainReplicationMessagesRequest,
@@ -359,6 +375,125 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return err
 }
 
+func (c *metricClient) FailoverWorkflowExecution(
+	ctx context.Context,
+	request *types.FailoverWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientFailoverWorkflowExecutionScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientFailoverWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.FailoverWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientFailoverWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *types.SuspendWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientSuspendWorkflowExecutionScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientSuspendWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.SuspendWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientSuspendWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *types.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResumeWorkflowExecutionScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResumeWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResumeWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.ListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListWorkflowTasksResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListWorkflowTasksScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListWorkflowTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListWorkflowTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListWorkflowTasksScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteWorkflowTask(
+	ctx context.Context,
+	request *types.DeleteWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowTaskScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteWorkflowTaskScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteWorkflowTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ReenqueueWorkflowTask(
+	ctx context.Context,
+	request *types.ReenqueueWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReenqueueWorkflowTaskScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReenqueueWorkflowTaskScope, metrics.CadenceClientLatency)
+	err := c.client.ReenqueueWorkflowTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReenqueueWorkflowTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) DescribeDomainUsage(
+	ctx context.Context,
+	request *types.DescribeDomainUsageRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeDomainUsageResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeDomainUsageScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeDomainUsageScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeDomainUsage(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeDomainUsageScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *types.ResendReplicationTasksRequest,
@@ -478,3 +613,54 @@ func (c *metricClient) ListDynamicConfig(
 	}
 	return resp, err
 }
+
+func (c *metricClient) PromoteDomain(
+	ctx context.Context,
+	request *types.PromoteDomainRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateDomainResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientPromoteDomainScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPromoteDomainScope, metrics.CadenceClientLatency)
+	resp, err := c.client.PromoteDomain(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPromoteDomainScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RemoveSearchAttribute(
+	ctx context.Context,
+	request *types.RemoveSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRemoveSearchAttributeScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRemoveSearchAttributeScope, metrics.CadenceClientLatency)
+	err := c.client.RemoveSearchAttribute(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRemoveSearchAttributeScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) RenameSearchAttribute(
+	ctx context.Context,
+	request *types.RenameSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRenameSearchAttributeScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRenameSearchAttributeScope, metrics.CadenceClientLatency)
+	err := c.client.RenameSearchAttribute(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRenameSearchAttributeScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
@@ -205,6 +205,21 @@ func (c *retryableClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...yarpc.CallOption,
+) (ReplicationMessagesStream, error) {
+	// only opening the stream is retried, errors on an established stream are surfaced to the caller
+	var stream ReplicationMessagesStream
+	op := func() error {
+		var err error
+		stream, err = c.client.StreamReplicationMessages(ctx, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return stream, err
+}
+
 func (c *retryableClient) GetDomainReplicationMessages(
 	ctx context.Context,
 	request *types.GetDomainReplicationMessagesRequest,
@@ -303,6 +318,102 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *retryableClient) FailoverWorkflowExecution(
+	ctx context.Context,
+	request *types.FailoverWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.FailoverWorkflowExecution(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *types.SuspendWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.SuspendWorkflowExecution(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *types.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.ListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListWorkflowTasksResponse, error) {
+
+	var resp *types.ListWorkflowTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListWorkflowTasks(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteWorkflowTask(
+	ctx context.Context,
+	request *types.DeleteWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteWorkflowTask(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) ReenqueueWorkflowTask(
+	ctx context.Context,
+	request *types.ReenqueueWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ReenqueueWorkflowTask(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) DescribeDomainUsage(
+	ctx context.Context,
+	request *types.DescribeDomainUsageRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeDomainUsageResponse, error) {
+
+	var resp *types.DescribeDomainUsageResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeDomainUsage(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *retryableClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *types.ResendReplicationTasksRequest,
@@ -397,3 +508,44 @@ func (c *retryableClient) ListDynamicConfig(
 	err := c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *retryableClient) PromoteDomain(
+	ctx context.Context,
+	request *types.PromoteDomainRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateDomainResponse, error) {
+	var resp *types.UpdateDomainResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PromoteDomain(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) RemoveSearchAttribute(
+	ctx context.Context,
+	request *types.RemoveSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RemoveSearchAttribute(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) RenameSearchAttribute(
+	ctx context.Context,
+	request *types.RenameSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RenameSearchAttribute(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
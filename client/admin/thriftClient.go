@@ -89,6 +89,10 @@ func (t thriftClient) GetReplicationMessages(ctx context.Context, request *types
 	return thrift.ToGetReplicationMessagesResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) StreamReplicationMessages(ctx context.Context, opts ...yarpc.CallOption) (ReplicationMessagesStream, error) {
+	return nil, &types.BadRequestError{Message: "StreamReplicationMessages is not supported over TChannel transport"}
+}
+
 func (t thriftClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *types.GetWorkflowExecutionRawHistoryV2Request, opts ...yarpc.CallOption) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 	response, err := t.c.GetWorkflowExecutionRawHistoryV2(ctx, thrift.FromGetWorkflowExecutionRawHistoryV2Request(request), opts...)
 	return thrift.ToGetWorkflowExecutionRawHistoryV2Response(response), thrift.ToError(err)
@@ -119,6 +123,41 @@ func (t thriftClient) RefreshWorkflowTasks(ctx context.Context, request *types.R
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) FailoverWorkflowExecution(ctx context.Context, request *types.FailoverWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.FailoverWorkflowExecution(ctx, thrift.FromFailoverWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) SuspendWorkflowExecution(ctx context.Context, request *types.SuspendWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.SuspendWorkflowExecution(ctx, thrift.FromSuspendWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ResumeWorkflowExecution(ctx context.Context, request *types.ResumeWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ResumeWorkflowExecution(ctx, thrift.FromResumeWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ListWorkflowTasks(ctx context.Context, request *types.ListWorkflowTasksRequest, opts ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error) {
+	response, err := t.c.ListWorkflowTasks(ctx, thrift.FromListWorkflowTasksRequest(request), opts...)
+	return thrift.ToListWorkflowTasksResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DeleteWorkflowTask(ctx context.Context, request *types.DeleteWorkflowTaskRequest, opts ...yarpc.CallOption) error {
+	err := t.c.DeleteWorkflowTask(ctx, thrift.FromDeleteWorkflowTaskRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ReenqueueWorkflowTask(ctx context.Context, request *types.ReenqueueWorkflowTaskRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ReenqueueWorkflowTask(ctx, thrift.FromReenqueueWorkflowTaskRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) DescribeDomainUsage(ctx context.Context, request *types.DescribeDomainUsageRequest, opts ...yarpc.CallOption) (*types.DescribeDomainUsageResponse, error) {
+	response, err := t.c.DescribeDomainUsage(ctx, thrift.FromDescribeDomainUsageRequest(request), opts...)
+	return thrift.ToDescribeDomainUsageResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) RemoveTask(ctx context.Context, request *types.RemoveTaskRequest, opts ...yarpc.CallOption) error {
 	err := t.c.RemoveTask(ctx, thrift.FromRemoveTaskRequest(request), opts...)
 	return thrift.ToError(err)
@@ -163,3 +202,18 @@ func (t thriftClient) ListDynamicConfig(ctx context.Context, request *types.List
 	response, err := t.c.ListDynamicConfig(ctx, thrift.FromListDynamicConfigRequest(request), opts...)
 	return thrift.ToListDynamicConfigResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) PromoteDomain(ctx context.Context, request *types.PromoteDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
+	response, err := t.c.PromoteDomain(ctx, thrift.FromPromoteDomainRequest(request), opts...)
+	return thrift.ToUpdateDomainResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) RemoveSearchAttribute(ctx context.Context, request *types.RemoveSearchAttributeRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RemoveSearchAttribute(ctx, thrift.FromRemoveSearchAttributeRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) RenameSearchAttribute(ctx context.Context, request *types.RenameSearchAttributeRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RenameSearchAttribute(ctx, thrift.FromRenameSearchAttributeRequest(request), opts...)
+	return thrift.ToError(err)
+}
@@ -825,7 +825,8 @@ func (c *clientImpl) GetReplicationMessages(
 
 		if _, ok := requestsByPeer[peer]; !ok {
 			requestsByPeer[peer] = &types.GetReplicationMessagesRequest{
-				ClusterName: request.ClusterName,
+				ClusterName:     request.ClusterName,
+				WaitForNewTasks: request.WaitForNewTasks,
 			}
 		}
 
@@ -987,6 +988,164 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return err
 }
 
+func (c *clientImpl) FailoverWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryFailoverWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.FailoverWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *types.HistorySuspendWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetSuspendRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.SuspendWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetResumeRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.ResumeWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.ReindexWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) RebuildMutableState(
+	ctx context.Context,
+	request *types.HistoryRebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.RebuildMutableStateResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *types.RebuildMutableStateResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = c.client.RebuildMutableState(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.HistoryListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListWorkflowTasksResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *types.ListWorkflowTasksResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = c.client.ListWorkflowTasks(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DeleteWorkflowTask(
+	ctx context.Context,
+	request *types.HistoryDeleteWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.DeleteWorkflowTask(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) ReenqueueWorkflowTask(
+	ctx context.Context,
+	request *types.HistoryReenqueueWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.ReenqueueWorkflowTask(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
 func (c *clientImpl) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
@@ -143,6 +143,46 @@ func (g grpcClient) RefreshWorkflowTasks(ctx context.Context, request *types.His
 	return proto.ToError(err)
 }
 
+func (g grpcClient) FailoverWorkflowExecution(ctx context.Context, request *types.HistoryFailoverWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.FailoverWorkflowExecution(ctx, proto.FromHistoryFailoverWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) SuspendWorkflowExecution(ctx context.Context, request *types.HistorySuspendWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.SuspendWorkflowExecution(ctx, proto.FromHistorySuspendWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ResumeWorkflowExecution(ctx context.Context, request *types.HistoryResumeWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ResumeWorkflowExecution(ctx, proto.FromHistoryResumeWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ReindexWorkflowExecution(ctx context.Context, request *types.HistoryReindexWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ReindexWorkflowExecution(ctx, proto.FromHistoryReindexWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) RebuildMutableState(ctx context.Context, request *types.HistoryRebuildMutableStateRequest, opts ...yarpc.CallOption) (*types.RebuildMutableStateResponse, error) {
+	response, err := g.c.RebuildMutableState(ctx, proto.FromHistoryRebuildMutableStateRequest(request), opts...)
+	return proto.ToHistoryRebuildMutableStateResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) ListWorkflowTasks(ctx context.Context, request *types.HistoryListWorkflowTasksRequest, opts ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error) {
+	response, err := g.c.ListWorkflowTasks(ctx, proto.FromHistoryListWorkflowTasksRequest(request), opts...)
+	return proto.ToHistoryListWorkflowTasksResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) DeleteWorkflowTask(ctx context.Context, request *types.HistoryDeleteWorkflowTaskRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.DeleteWorkflowTask(ctx, proto.FromHistoryDeleteWorkflowTaskRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ReenqueueWorkflowTask(ctx context.Context, request *types.HistoryReenqueueWorkflowTaskRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ReenqueueWorkflowTask(ctx, proto.FromHistoryReenqueueWorkflowTaskRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest, opts ...yarpc.CallOption) error {
 	_, err := g.c.RemoveSignalMutableState(ctx, proto.FromHistoryRemoveSignalMutableStateRequest(request), opts...)
 	return proto.ToError(err)
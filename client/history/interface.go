@@ -53,6 +53,14 @@ type Client interface {
 	RecordChildExecutionCompleted(context.Context, *types.RecordChildExecutionCompletedRequest, ...yarpc.CallOption) error
 	RecordDecisionTaskStarted(context.Context, *types.RecordDecisionTaskStartedRequest, ...yarpc.CallOption) (*types.RecordDecisionTaskStartedResponse, error)
 	RefreshWorkflowTasks(context.Context, *types.HistoryRefreshWorkflowTasksRequest, ...yarpc.CallOption) error
+	FailoverWorkflowExecution(context.Context, *types.HistoryFailoverWorkflowExecutionRequest, ...yarpc.CallOption) error
+	SuspendWorkflowExecution(context.Context, *types.HistorySuspendWorkflowExecutionRequest, ...yarpc.CallOption) error
+	ResumeWorkflowExecution(context.Context, *types.HistoryResumeWorkflowExecutionRequest, ...yarpc.CallOption) error
+	ReindexWorkflowExecution(context.Context, *types.HistoryReindexWorkflowExecutionRequest, ...yarpc.CallOption) error
+	RebuildMutableState(context.Context, *types.HistoryRebuildMutableStateRequest, ...yarpc.CallOption) (*types.RebuildMutableStateResponse, error)
+	ListWorkflowTasks(context.Context, *types.HistoryListWorkflowTasksRequest, ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error)
+	DeleteWorkflowTask(context.Context, *types.HistoryDeleteWorkflowTaskRequest, ...yarpc.CallOption) error
+	ReenqueueWorkflowTask(context.Context, *types.HistoryReenqueueWorkflowTaskRequest, ...yarpc.CallOption) error
 	RemoveSignalMutableState(context.Context, *types.RemoveSignalMutableStateRequest, ...yarpc.CallOption) error
 	RemoveTask(context.Context, *types.RemoveTaskRequest, ...yarpc.CallOption) error
 	ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request, ...yarpc.CallOption) error
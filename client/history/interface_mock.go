@@ -473,6 +473,160 @@ func (mr *MockClientMockRecorder) RefreshWorkflowTasks(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// FailoverWorkflowExecution mocks base method
+func (m *MockClient) FailoverWorkflowExecution(arg0 context.Context, arg1 *types.HistoryFailoverWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FailoverWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailoverWorkflowExecution indicates an expected call of FailoverWorkflowExecution
+func (mr *MockClientMockRecorder) FailoverWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverWorkflowExecution", reflect.TypeOf((*MockClient)(nil).FailoverWorkflowExecution), varargs...)
+}
+
+// SuspendWorkflowExecution mocks base method
+func (m *MockClient) SuspendWorkflowExecution(arg0 context.Context, arg1 *types.HistorySuspendWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendWorkflowExecution indicates an expected call of SuspendWorkflowExecution
+func (mr *MockClientMockRecorder) SuspendWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendWorkflowExecution", reflect.TypeOf((*MockClient)(nil).SuspendWorkflowExecution), varargs...)
+}
+
+// ResumeWorkflowExecution mocks base method
+func (m *MockClient) ResumeWorkflowExecution(arg0 context.Context, arg1 *types.HistoryResumeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution
+func (mr *MockClientMockRecorder) ResumeWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockClient)(nil).ResumeWorkflowExecution), varargs...)
+}
+
+// ReindexWorkflowExecution mocks base method
+func (m *MockClient) ReindexWorkflowExecution(arg0 context.Context, arg1 *types.HistoryReindexWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReindexWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReindexWorkflowExecution indicates an expected call of ReindexWorkflowExecution
+func (mr *MockClientMockRecorder) ReindexWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexWorkflowExecution", reflect.TypeOf((*MockClient)(nil).ReindexWorkflowExecution), varargs...)
+}
+
+// RebuildMutableState mocks base method
+func (m *MockClient) RebuildMutableState(arg0 context.Context, arg1 *types.HistoryRebuildMutableStateRequest, arg2 ...yarpc.CallOption) (*types.RebuildMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebuildMutableState", varargs...)
+	ret0, _ := ret[0].(*types.RebuildMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildMutableState indicates an expected call of RebuildMutableState
+func (mr *MockClientMockRecorder) RebuildMutableState(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockClient)(nil).RebuildMutableState), varargs...)
+}
+
+// ListWorkflowTasks mocks base method
+func (m *MockClient) ListWorkflowTasks(arg0 context.Context, arg1 *types.HistoryListWorkflowTasksRequest, arg2 ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkflowTasks", varargs...)
+	ret0, _ := ret[0].(*types.ListWorkflowTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowTasks indicates an expected call of ListWorkflowTasks
+func (mr *MockClientMockRecorder) ListWorkflowTasks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowTasks", reflect.TypeOf((*MockClient)(nil).ListWorkflowTasks), varargs...)
+}
+
+// DeleteWorkflowTask mocks base method
+func (m *MockClient) DeleteWorkflowTask(arg0 context.Context, arg1 *types.HistoryDeleteWorkflowTaskRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkflowTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowTask indicates an expected call of DeleteWorkflowTask
+func (mr *MockClientMockRecorder) DeleteWorkflowTask(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowTask", reflect.TypeOf((*MockClient)(nil).DeleteWorkflowTask), varargs...)
+}
+
+// ReenqueueWorkflowTask mocks base method
+func (m *MockClient) ReenqueueWorkflowTask(arg0 context.Context, arg1 *types.HistoryReenqueueWorkflowTaskRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReenqueueWorkflowTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReenqueueWorkflowTask indicates an expected call of ReenqueueWorkflowTask
+func (mr *MockClientMockRecorder) ReenqueueWorkflowTask(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueWorkflowTask", reflect.TypeOf((*MockClient)(nil).ReenqueueWorkflowTask), varargs...)
+}
+
 // RemoveSignalMutableState mocks base method
 func (m *MockClient) RemoveSignalMutableState(arg0 context.Context, arg1 *types.RemoveSignalMutableStateRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
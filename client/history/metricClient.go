@@ -723,6 +723,142 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return err
 }
 
+func (c *metricClient) FailoverWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryFailoverWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientFailoverWorkflowExecutionScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientFailoverWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.FailoverWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientFailoverWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *types.HistorySuspendWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientSuspendWorkflowExecutionScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientSuspendWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.SuspendWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientSuspendWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientReindexWorkflowExecutionScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReindexWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ReindexWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReindexWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) RebuildMutableState(
+	ctx context.Context,
+	request *types.HistoryRebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.RebuildMutableStateResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientRebuildMutableStateScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRebuildMutableStateScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RebuildMutableState(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRebuildMutableStateScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.HistoryListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListWorkflowTasksResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientListWorkflowTasksScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientListWorkflowTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListWorkflowTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientListWorkflowTasksScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteWorkflowTask(
+	ctx context.Context,
+	request *types.HistoryDeleteWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowTaskScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDeleteWorkflowTaskScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteWorkflowTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ReenqueueWorkflowTask(
+	ctx context.Context,
+	request *types.HistoryReenqueueWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientReenqueueWorkflowTaskScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReenqueueWorkflowTaskScope, metrics.CadenceClientLatency)
+	err := c.client.ReenqueueWorkflowTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReenqueueWorkflowTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
@@ -624,6 +624,118 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *retryableClient) FailoverWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryFailoverWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.FailoverWorkflowExecution(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *types.HistorySuspendWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.SuspendWorkflowExecution(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ReindexWorkflowExecution(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) RebuildMutableState(
+	ctx context.Context,
+	request *types.HistoryRebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.RebuildMutableStateResponse, error) {
+
+	var resp *types.RebuildMutableStateResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RebuildMutableState(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.HistoryListWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListWorkflowTasksResponse, error) {
+
+	var resp *types.ListWorkflowTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListWorkflowTasks(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteWorkflowTask(
+	ctx context.Context,
+	request *types.HistoryDeleteWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteWorkflowTask(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) ReenqueueWorkflowTask(
+	ctx context.Context,
+	request *types.HistoryReenqueueWorkflowTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ReenqueueWorkflowTask(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
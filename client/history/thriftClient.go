@@ -144,6 +144,46 @@ func (t thriftClient) RefreshWorkflowTasks(ctx context.Context, request *types.H
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) FailoverWorkflowExecution(ctx context.Context, request *types.HistoryFailoverWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.FailoverWorkflowExecution(ctx, thrift.FromHistoryFailoverWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) SuspendWorkflowExecution(ctx context.Context, request *types.HistorySuspendWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.SuspendWorkflowExecution(ctx, thrift.FromHistorySuspendWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ResumeWorkflowExecution(ctx context.Context, request *types.HistoryResumeWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ResumeWorkflowExecution(ctx, thrift.FromHistoryResumeWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ReindexWorkflowExecution(ctx context.Context, request *types.HistoryReindexWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ReindexWorkflowExecution(ctx, thrift.FromHistoryReindexWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) RebuildMutableState(ctx context.Context, request *types.HistoryRebuildMutableStateRequest, opts ...yarpc.CallOption) (*types.RebuildMutableStateResponse, error) {
+	response, err := t.c.RebuildMutableState(ctx, thrift.FromHistoryRebuildMutableStateRequest(request), opts...)
+	return thrift.ToRebuildMutableStateResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) ListWorkflowTasks(ctx context.Context, request *types.HistoryListWorkflowTasksRequest, opts ...yarpc.CallOption) (*types.ListWorkflowTasksResponse, error) {
+	response, err := t.c.ListWorkflowTasks(ctx, thrift.FromHistoryListWorkflowTasksRequest(request), opts...)
+	return thrift.ToListWorkflowTasksResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DeleteWorkflowTask(ctx context.Context, request *types.HistoryDeleteWorkflowTaskRequest, opts ...yarpc.CallOption) error {
+	err := t.c.DeleteWorkflowTask(ctx, thrift.FromHistoryDeleteWorkflowTaskRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ReenqueueWorkflowTask(ctx context.Context, request *types.HistoryReenqueueWorkflowTaskRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ReenqueueWorkflowTask(ctx, thrift.FromHistoryReenqueueWorkflowTaskRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest, opts ...yarpc.CallOption) error {
 	err := t.c.RemoveSignalMutableState(ctx, thrift.FromRemoveSignalMutableStateRequest(request), opts...)
 	return thrift.ToError(err)
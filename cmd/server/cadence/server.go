@@ -183,8 +183,9 @@ func (s *server) startService() common.Daemon {
 		common.GetDefaultAdvancedVisibilityWritingMode(params.PersistenceConfig.IsAdvancedVisibilityConfigExist()),
 	)()
 	isAdvancedVisEnabled := advancedVisMode != common.AdvancedVisibilityWritingModeOff
-	if isAdvancedVisEnabled {
-		params.MessagingClient = kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, isAdvancedVisEnabled)
+	_, isLifecycleEventsConfigured := s.cfg.Kafka.Applications[common.WorkflowLifecycleEventsAppName]
+	if isAdvancedVisEnabled || isLifecycleEventsConfigured {
+		params.MessagingClient = kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, true)
 	} else {
 		params.MessagingClient = nil
 	}
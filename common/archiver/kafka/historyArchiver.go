@@ -0,0 +1,243 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Kafka History Archiver will publish workflow histories to a kafka topic
+
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	errEncodeHistory  = "failed to encode history chunk"
+	errPublishHistory = "failed to publish history chunk to kafka"
+)
+
+type (
+	historyArchiver struct {
+		container *archiver.HistoryBootstrapContainer
+		producers *producerCache
+		chunkSize int
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	publishProgress struct {
+		ChunkIdx      int
+		IteratorState []byte
+		publishedSize int64
+	}
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver based on kafka
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.KafkaArchiver,
+) (archiver.HistoryArchiver, error) {
+	producers, err := newProducerCache(&config.Kafka, container.MetricsClient, container.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryArchiver(container, config, producers, nil), nil
+}
+
+func newHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.KafkaArchiver,
+	producers *producerCache,
+	historyIterator archiver.HistoryIterator,
+) *historyArchiver {
+	chunkSize := config.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	return &historyArchiver{
+		container:       container,
+		producers:       producers,
+		chunkSize:       chunkSize,
+		historyIterator: historyIterator,
+	}
+}
+
+// Archive publishes the history of a closed workflow as one or more history messages,
+// each message carries the batches of one history blob returned by the history iterator.
+func (h *historyArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	scope := h.container.MetricsClient.Scope(metrics.HistoryArchiverScope, metrics.DomainTag(request.DomainName))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if persistence.IsTransientError(err) || isRetryableError(err) {
+				scope.IncCounter(metrics.HistoryArchiverArchiveTransientErrorCount)
+			} else {
+				scope.IncCounter(metrics.HistoryArchiverArchiveNonRetryableErrorCount)
+				if featureCatalog.NonRetriableError != nil {
+					err = featureCatalog.NonRetriableError()
+				}
+			}
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.container.Logger, request, URI.String())
+
+	if err := h.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateHistoryArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	producer, err := h.producers.getProducer(URI.Hostname())
+	if err != nil {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errPublishHistory), tag.Error(err))
+		return err
+	}
+
+	var progress publishProgress
+	historyIterator := h.historyIterator
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator = h.loadHistoryIterator(ctx, request, featureCatalog, &progress)
+	}
+	for historyIterator.HasNext() {
+		historyBlob, err := getNextHistoryBlob(ctx, historyIterator)
+		if err != nil {
+			if common.IsEntityNotExistsError(err) {
+				// workflow history no longer exists, may due to duplicated archival signal
+				// this may happen even in the middle of iterating history as two archival signals
+				// can be processed concurrently.
+				logger.Info(archiver.ArchiveSkippedInfoMsg)
+				scope.IncCounter(metrics.HistoryArchiverDuplicateArchivalsCount)
+				return nil
+			}
+
+			logger := logger.WithTags(tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
+			if persistence.IsTransientError(err) {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+			}
+			return err
+		}
+
+		if archiver.IsHistoryMutated(request, historyBlob.Body, *historyBlob.Header.IsLast, logger) {
+			if !featureCatalog.ArchiveIncompleteHistory() {
+				return archiver.ErrHistoryMutated
+			}
+		}
+
+		encodedMessage, err := encode(newHistoryMessage(request, historyBlob, progress.ChunkIdx))
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return err
+		}
+
+		if err := producer.Publish(ctx, encodedMessage); err != nil {
+			logger := logger.WithTags(tag.ArchivalArchiveFailReason(errPublishHistory), tag.Error(err))
+			if isRetryableError(err) {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+			}
+			return err
+		}
+
+		messageSize := int64(len(encodedMessage.Value))
+		scope.RecordTimer(metrics.HistoryArchiverBlobSize, time.Duration(messageSize))
+		progress.publishedSize += messageSize
+		progress.ChunkIdx++
+		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, &progress)
+	}
+
+	scope.RecordTimer(metrics.HistoryArchiverTotalUploadSize, time.Duration(progress.publishedSize))
+	scope.IncCounter(metrics.HistoryArchiverArchiveSuccessCount)
+	return nil
+}
+
+func (h *historyArchiver) loadHistoryIterator(
+	ctx context.Context,
+	request *archiver.ArchiveHistoryRequest,
+	featureCatalog *archiver.ArchiveFeatureCatalog,
+	progress *publishProgress,
+) archiver.HistoryIterator {
+	if featureCatalog.ProgressManager != nil && featureCatalog.ProgressManager.HasProgress(ctx) {
+		err := featureCatalog.ProgressManager.LoadProgress(ctx, progress)
+		if err == nil {
+			historyIterator, err := archiver.NewHistoryIteratorFromState(ctx, request, h.container.HistoryV2Manager, h.chunkSize, progress.IteratorState)
+			if err == nil {
+				return historyIterator
+			}
+		}
+		// start over from the first chunk, consumers deduplicate chunks by chunk index
+		*progress = publishProgress{}
+	}
+	return archiver.NewHistoryIterator(ctx, request, h.container.HistoryV2Manager, h.chunkSize)
+}
+
+func saveHistoryIteratorState(
+	ctx context.Context,
+	featureCatalog *archiver.ArchiveFeatureCatalog,
+	historyIterator archiver.HistoryIterator,
+	progress *publishProgress,
+) {
+	// Saving history state is a best effort operation. Ignore errors and continue
+	if featureCatalog.ProgressManager != nil {
+		state, err := historyIterator.GetState()
+		if err != nil {
+			return
+		}
+		progress.IteratorState = state
+		_ = featureCatalog.ProgressManager.RecordProgress(ctx, progress)
+	}
+}
+
+// Get is not supported, archived history is consumed from the kafka topic
+func (h *historyArchiver) Get(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+) (*archiver.GetHistoryResponse, error) {
+	return nil, &types.BadRequestError{Message: errReadNotSupported.Error()}
+}
+
+func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
+	if err := softValidateURI(URI); err != nil {
+		return err
+	}
+	return h.producers.validateApplication(URI.Hostname())
+}
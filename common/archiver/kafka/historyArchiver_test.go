@@ -0,0 +1,339 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID             = "test-domain-id"
+	testDomainName           = "test-domain-name"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 3
+	testCloseFailoverVersion = 100
+	testApplication          = "closed-workflows"
+	testArchivalURI          = "kafka://" + testApplication
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+)
+
+type historyArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller      *gomock.Controller
+	historyIterator *archiver.MockHistoryIterator
+	producer        *mocks.KafkaProducer
+	container       *archiver.HistoryBootstrapContainer
+	testArchivalURI archiver.URI
+}
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	var err error
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.historyIterator = archiver.NewMockHistoryIterator(s.controller)
+	s.producer = &mocks.KafkaProducer{}
+	s.container = &archiver.HistoryBootstrapContainer{
+		Logger:        loggerimpl.NewLogger(zap.NewNop()),
+		MetricsClient: metrics.NewClient(tally.NewTestScope("test", nil), metrics.HistoryArchiverScope),
+	}
+	s.testArchivalURI, err = archiver.NewURI(testArchivalURI)
+	s.NoError(err)
+}
+
+func (s *historyArchiverSuite) TearDownTest() {
+	s.controller.Finish()
+	s.producer.AssertExpectations(s.T())
+}
+
+func (s *historyArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr bool
+	}{
+		{URI: "wrongscheme://" + testApplication, expectedErr: true},
+		{URI: "kafka://", expectedErr: true},
+		{URI: "kafka://unknown-application", expectedErr: true},
+		{URI: testArchivalURI, expectedErr: false},
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, historyArchiver.ValidateURI(URI) != nil, tc.URI)
+	}
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_EmptyKafkaConfig() {
+	_, err := NewHistoryArchiver(s.container, &config.KafkaArchiver{})
+	s.Equal(errEmptyKafkaConfig, err)
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_InvalidKafkaConfig() {
+	testCases := map[string]config.KafkaConfig{
+		"missing cluster": {
+			Clusters: map[string]config.ClusterConfig{
+				"test-cluster": {Brokers: []string{"127.0.0.1:9092"}},
+			},
+			Topics: map[string]config.TopicConfig{
+				"test-topic": {Cluster: "unknown-cluster"},
+			},
+		},
+		"missing brokers": {
+			Clusters: map[string]config.ClusterConfig{
+				"test-cluster": {},
+			},
+			Topics: map[string]config.TopicConfig{
+				"test-topic": {Cluster: "test-cluster"},
+			},
+		},
+		"missing application topic": {
+			Clusters: map[string]config.ClusterConfig{
+				"test-cluster": {Brokers: []string{"127.0.0.1:9092"}},
+			},
+			Topics: map[string]config.TopicConfig{
+				"test-topic": {Cluster: "test-cluster"},
+			},
+			Applications: map[string]config.TopicList{
+				testApplication: {Topic: "unknown-topic"},
+			},
+		},
+	}
+
+	for name, kafkaConfig := range testCases {
+		_, err := NewHistoryArchiver(s.container, &config.KafkaArchiver{Kafka: kafkaConfig})
+		s.Error(err, name)
+	}
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_Success() {
+	_, err := NewHistoryArchiver(s.container, &config.KafkaArchiver{
+		Kafka: config.KafkaConfig{
+			Clusters: map[string]config.ClusterConfig{
+				"test-cluster": {Brokers: []string{"127.0.0.1:9092"}},
+			},
+			Topics: map[string]config.TopicConfig{
+				"test-topic": {Cluster: "test-cluster"},
+			},
+			Applications: map[string]config.TopicList{
+				testApplication: {Topic: "test-topic"},
+			},
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidURI() {
+	URI, err := archiver.NewURI("kafka://unknown-application")
+	s.NoError(err)
+
+	err = s.newTestHistoryArchiver(s.historyIterator).Archive(context.Background(), URI, s.newArchiveRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	request := s.newArchiveRequest()
+	request.WorkflowID = ""
+
+	err := s.newTestHistoryArchiver(s.historyIterator).Archive(context.Background(), s.testArchivalURI, request)
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_HistoryMutated() {
+	gomock.InOrder(
+		s.historyIterator.EXPECT().HasNext().Return(true),
+		s.historyIterator.EXPECT().Next().Return(s.newHistoryBlob(testNextEventID-1, testCloseFailoverVersion+1, true), nil),
+	)
+
+	err := s.newTestHistoryArchiver(s.historyIterator).Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.Equal(archiver.ErrHistoryMutated, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Skip_WorkflowNotExist() {
+	gomock.InOrder(
+		s.historyIterator.EXPECT().HasNext().Return(true),
+		s.historyIterator.EXPECT().Next().Return(nil, &types.EntityNotExistsError{Message: "workflow not exist"}),
+	)
+
+	err := s.newTestHistoryArchiver(s.historyIterator).Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.NoError(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_PublishTransientError() {
+	gomock.InOrder(
+		s.historyIterator.EXPECT().HasNext().Return(true),
+		s.historyIterator.EXPECT().Next().Return(s.newHistoryBlob(testNextEventID-1, testCloseFailoverVersion, true), nil),
+	)
+	publishErr := &sarama.ProducerError{Err: sarama.ErrNotLeaderForPartition}
+	s.producer.On("Publish", mock.Anything, mock.Anything).Return(publishErr).Once()
+
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := s.newTestHistoryArchiver(s.historyIterator).Archive(
+		context.Background(),
+		s.testArchivalURI,
+		s.newArchiveRequest(),
+		archiver.GetNonRetriableErrorOption(nonRetryableErr),
+	)
+	s.Equal(publishErr, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_MessageTooLarge() {
+	gomock.InOrder(
+		s.historyIterator.EXPECT().HasNext().Return(true),
+		s.historyIterator.EXPECT().Next().Return(s.newHistoryBlob(testNextEventID-1, testCloseFailoverVersion, true), nil),
+	)
+	s.producer.On("Publish", mock.Anything, mock.Anything).Return(messaging.ErrMessageSizeLimit).Once()
+
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := s.newTestHistoryArchiver(s.historyIterator).Archive(
+		context.Background(),
+		s.testArchivalURI,
+		s.newArchiveRequest(),
+		archiver.GetNonRetriableErrorOption(nonRetryableErr),
+	)
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Success_Chunked() {
+	gomock.InOrder(
+		s.historyIterator.EXPECT().HasNext().Return(true),
+		s.historyIterator.EXPECT().Next().Return(s.newHistoryBlob(testNextEventID-2, testCloseFailoverVersion, false), nil),
+		s.historyIterator.EXPECT().HasNext().Return(true),
+		s.historyIterator.EXPECT().Next().Return(s.newHistoryBlob(testNextEventID-1, testCloseFailoverVersion, true), nil),
+		s.historyIterator.EXPECT().HasNext().Return(false),
+	)
+	var published []*Message
+	s.producer.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		encodedMessage := args.Get(1).(*messaging.EncodedMessage)
+		s.Equal(testDomainID+"/"+testWorkflowID+"/"+testRunID, string(encodedMessage.Key))
+		message := &Message{}
+		s.NoError(json.Unmarshal(encodedMessage.Value, message))
+		published = append(published, message)
+	}).Twice()
+
+	err := s.newTestHistoryArchiver(s.historyIterator).Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.NoError(err)
+
+	s.Len(published, 2)
+	for idx, message := range published {
+		s.Equal(SchemaVersion, message.SchemaVersion)
+		s.Equal(MessageTypeHistory, message.Type)
+		s.Equal(testDomainName, message.DomainName)
+		s.Nil(message.Visibility)
+		s.Equal(idx, message.History.ChunkIndex)
+		s.Equal(int64(testCloseFailoverVersion), message.History.CloseFailoverVersion)
+		s.Equal(idx == len(published)-1, message.History.IsLast)
+		s.Len(message.History.Batches, 1)
+	}
+	s.Equal(int64(testNextEventID-1), published[1].History.Batches[0].Events[0].EventID)
+}
+
+func (s *historyArchiverSuite) TestGet_NotSupported() {
+	_, err := s.newTestHistoryArchiver(nil).Get(context.Background(), s.testArchivalURI, &archiver.GetHistoryRequest{})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
+	return newHistoryArchiver(s.container, &config.KafkaArchiver{}, newTestProducerCache(s.producer), historyIterator)
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (s *historyArchiverSuite) newHistoryBlob(eventID, version int64, isLast bool) *archiver.HistoryBlob {
+	return &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast:       common.BoolPtr(isLast),
+			FirstEventID: common.Int64Ptr(eventID),
+			LastEventID:  common.Int64Ptr(eventID),
+			EventCount:   common.Int64Ptr(1),
+		},
+		Body: []*types.History{
+			{
+				Events: []*types.HistoryEvent{
+					{
+						EventID: eventID,
+						Version: version,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTestProducerCache(producer messaging.Producer) *producerCache {
+	return &producerCache{
+		config: &config.KafkaConfig{
+			Clusters: map[string]config.ClusterConfig{
+				"test-cluster": {Brokers: []string{"127.0.0.1:9092"}},
+			},
+			Topics: map[string]config.TopicConfig{
+				"test-topic": {Cluster: "test-cluster"},
+			},
+			Applications: map[string]config.TopicList{
+				testApplication: {Topic: "test-topic"},
+			},
+		},
+		newProducer: func(application string) (messaging.Producer, error) {
+			return producer, nil
+		},
+		producers: make(map[string]messaging.Producer),
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/types"
+)
+
+// SchemaVersion is the version of the message schema published by the kafka archiver.
+// It will be bumped whenever a backward incompatible change is made to Message.
+const SchemaVersion = 1
+
+// MessageType is the type of a message published by the kafka archiver
+type MessageType string
+
+const (
+	// MessageTypeHistory is the type of a message carrying a chunk of workflow history
+	MessageTypeHistory MessageType = "history"
+	// MessageTypeVisibility is the type of a message carrying a workflow visibility record
+	MessageTypeVisibility MessageType = "visibility"
+)
+
+type (
+	// Message is the JSON encoded value of every message published by the kafka archiver.
+	// Messages are keyed by "<domainID>/<workflowID>/<runID>", so all messages of a workflow run
+	// land in the same partition and history chunks are consumed in order.
+	//
+	// Archival is at least once: an archival may be retried or repeated after a conflict resolution,
+	// so consumers should deduplicate history chunks by (DomainID, WorkflowID, RunID,
+	// History.CloseFailoverVersion, History.ChunkIndex) and visibility records by (DomainID, WorkflowID, RunID).
+	// When the same run is archived with different CloseFailoverVersion, the highest version wins.
+	Message struct {
+		SchemaVersion int         `json:"schemaVersion"`
+		Type          MessageType `json:"type"`
+		DomainID      string      `json:"domainId"`
+		DomainName    string      `json:"domainName"`
+		WorkflowID    string      `json:"workflowId"`
+		RunID         string      `json:"runId"`
+		// History is set when Type is MessageTypeHistory
+		History *HistoryChunk `json:"history,omitempty"`
+		// Visibility is set when Type is MessageTypeVisibility
+		Visibility *VisibilityRecord `json:"visibility,omitempty"`
+	}
+
+	// HistoryChunk is a chunk of workflow history. Large histories are split into multiple chunks
+	// numbered from 0, the last chunk has IsLast set.
+	HistoryChunk struct {
+		CloseFailoverVersion int64            `json:"closeFailoverVersion"`
+		ChunkIndex           int              `json:"chunkIndex"`
+		IsLast               bool             `json:"isLast"`
+		FirstEventID         int64            `json:"firstEventId"`
+		LastEventID          int64            `json:"lastEventId"`
+		EventCount           int64            `json:"eventCount"`
+		Batches              []*types.History `json:"batches"`
+	}
+
+	// VisibilityRecord is the visibility record of a closed workflow
+	VisibilityRecord struct {
+		WorkflowTypeName   string                             `json:"workflowTypeName"`
+		StartTimestamp     int64                              `json:"startTimestamp"`
+		ExecutionTimestamp int64                              `json:"executionTimestamp"`
+		CloseTimestamp     int64                              `json:"closeTimestamp"`
+		CloseStatus        types.WorkflowExecutionCloseStatus `json:"closeStatus"`
+		HistoryLength      int64                              `json:"historyLength"`
+		Memo               *types.Memo                        `json:"memo,omitempty"`
+		SearchAttributes   map[string]string                  `json:"searchAttributes,omitempty"`
+		HistoryArchivalURI string                             `json:"historyArchivalUri,omitempty"`
+	}
+)
+
+func newHistoryMessage(request *archiver.ArchiveHistoryRequest, blob *archiver.HistoryBlob, chunkIndex int) *Message {
+	header := blob.Header
+	return &Message{
+		SchemaVersion: SchemaVersion,
+		Type:          MessageTypeHistory,
+		DomainID:      request.DomainID,
+		DomainName:    request.DomainName,
+		WorkflowID:    request.WorkflowID,
+		RunID:         request.RunID,
+		History: &HistoryChunk{
+			CloseFailoverVersion: request.CloseFailoverVersion,
+			ChunkIndex:           chunkIndex,
+			IsLast:               common.BoolDefault(header.IsLast),
+			FirstEventID:         common.Int64Default(header.FirstEventID),
+			LastEventID:          common.Int64Default(header.LastEventID),
+			EventCount:           common.Int64Default(header.EventCount),
+			Batches:              blob.Body,
+		},
+	}
+}
+
+func newVisibilityMessage(request *archiver.ArchiveVisibilityRequest) *Message {
+	return &Message{
+		SchemaVersion: SchemaVersion,
+		Type:          MessageTypeVisibility,
+		DomainID:      request.DomainID,
+		DomainName:    request.DomainName,
+		WorkflowID:    request.WorkflowID,
+		RunID:         request.RunID,
+		Visibility: &VisibilityRecord{
+			WorkflowTypeName:   request.WorkflowTypeName,
+			StartTimestamp:     request.StartTimestamp,
+			ExecutionTimestamp: request.ExecutionTimestamp,
+			CloseTimestamp:     request.CloseTimestamp,
+			CloseStatus:        request.CloseStatus,
+			HistoryLength:      request.HistoryLength,
+			Memo:               request.Memo,
+			SearchAttributes:   request.SearchAttributes,
+			HistoryArchivalURI: request.HistoryArchivalURI,
+		},
+	}
+}
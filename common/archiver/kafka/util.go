@@ -0,0 +1,212 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/messaging"
+	messagingkafka "github.com/uber/cadence/common/messaging/kafka"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// URIScheme is the scheme for the kafka implementation
+	URIScheme = "kafka"
+
+	defaultChunkSize = 256 * 1024 // 256KB
+)
+
+var (
+	errNoApplicationSpecified = errors.New("no kafka application specified")
+	errEmptyKafkaConfig       = errors.New("empty kafka cluster or topic config")
+	errReadNotSupported       = errors.New("kafka archiver does not support reading archived data")
+)
+
+type (
+	// producerCache lazily creates and caches one producer per kafka application
+	producerCache struct {
+		sync.Mutex
+
+		config      *config.KafkaConfig
+		newProducer func(application string) (messaging.Producer, error)
+		producers   map[string]messaging.Producer
+	}
+)
+
+func newProducerCache(
+	config *config.KafkaConfig,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) (*producerCache, error) {
+	if err := validateConfig(config); err != nil {
+		return nil, err
+	}
+
+	// the config is validated above, so that creating the client doesn't panic
+	client := messagingkafka.NewKafkaClient(config, metricsClient, logger, nil, false)
+	return &producerCache{
+		config:      config,
+		newProducer: client.NewProducer,
+		producers:   make(map[string]messaging.Producer),
+	}, nil
+}
+
+// validateConfig checks the kafka config like config.KafkaConfig.Validate, but returns an error instead of panicking
+func validateConfig(config *config.KafkaConfig) error {
+	if len(config.Clusters) == 0 || len(config.Topics) == 0 {
+		return errEmptyKafkaConfig
+	}
+	for topic, topicConfig := range config.Topics {
+		if cluster, ok := config.Clusters[topicConfig.Cluster]; !ok || len(cluster.Brokers) == 0 {
+			return fmt.Errorf("missing kafka brokers config for cluster %v of topic %v", topicConfig.Cluster, topic)
+		}
+	}
+	for application := range config.Applications {
+		if err := validateApplication(config, application); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *producerCache) getProducer(application string) (messaging.Producer, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if producer, ok := c.producers[application]; ok {
+		return producer, nil
+	}
+	producer, err := c.newProducer(application)
+	if err != nil {
+		return nil, err
+	}
+	c.producers[application] = producer
+	return producer, nil
+}
+
+func (c *producerCache) validateApplication(application string) error {
+	return validateApplication(c.config, application)
+}
+
+// validateApplication checks the application is configured with a topic which belongs to a known kafka cluster
+func validateApplication(config *config.KafkaConfig, application string) error {
+	topics, ok := config.Applications[application]
+	if !ok || topics.Topic == "" {
+		return fmt.Errorf("kafka application %v is not configured", application)
+	}
+	topic, ok := config.Topics[topics.Topic]
+	if !ok {
+		return fmt.Errorf("missing topic config for topic %v", topics.Topic)
+	}
+	if cluster, ok := config.Clusters[topic.Cluster]; !ok || len(cluster.Brokers) == 0 {
+		return fmt.Errorf("missing kafka brokers config for cluster %v", topic.Cluster)
+	}
+	return nil
+}
+
+func softValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	if len(URI.Hostname()) == 0 {
+		return errNoApplicationSpecified
+	}
+	return nil
+}
+
+func encode(message *Message) (*messaging.EncodedMessage, error) {
+	value, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	return &messaging.EncodedMessage{
+		Key:   []byte(messageKey(message)),
+		Value: value,
+	}, nil
+}
+
+func messageKey(message *Message) string {
+	return fmt.Sprintf("%s/%s/%s", message.DomainID, message.WorkflowID, message.RunID)
+}
+
+// isRetryableError returns true if the error is returned by kafka and publishing may succeed on retry
+func isRetryableError(err error) bool {
+	if err == nil || err == messaging.ErrMessageSizeLimit {
+		return false
+	}
+	var producerErr *sarama.ProducerError
+	if errors.As(err, &producerErr) {
+		return producerErr.Err != sarama.ErrMessageSizeTooLarge
+	}
+	var kafkaErr sarama.KError
+	if errors.As(err, &kafkaErr) {
+		return kafkaErr != sarama.ErrMessageSizeTooLarge
+	}
+	var netErr net.Error
+	return errors.Is(err, sarama.ErrOutOfBrokers) ||
+		errors.Is(err, sarama.ErrNotConnected) ||
+		errors.As(err, &netErr)
+}
+
+func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIterator) (*archiver.HistoryBlob, error) {
+	historyBlob, err := historyIterator.Next()
+	op := func() error {
+		historyBlob, err = historyIterator.Next()
+		return err
+	}
+	throttleRetry := backoff.NewThrottleRetry(
+		backoff.WithRetryPolicy(common.CreatePersistenceRetryPolicy()),
+		backoff.WithRetryableError(persistence.IsTransientError),
+	)
+	for err != nil {
+		if contextExpired(ctx) {
+			return nil, archiver.ErrContextTimeout
+		}
+		if !persistence.IsTransientError(err) {
+			return nil, err
+		}
+		err = throttleRetry.Do(ctx, op)
+	}
+	return historyBlob, nil
+}
+
+func contextExpired(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	errEncodeVisibilityRecord  = "failed to encode visibility record"
+	errPublishVisibilityRecord = "failed to publish visibility record to kafka"
+)
+
+type (
+	visibilityArchiver struct {
+		container *archiver.VisibilityBootstrapContainer
+		producers *producerCache
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on kafka
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.KafkaArchiver,
+) (archiver.VisibilityArchiver, error) {
+	producers, err := newProducerCache(&config.Kafka, container.MetricsClient, container.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return newVisibilityArchiver(container, producers), nil
+}
+
+func newVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	producers *producerCache,
+) *visibilityArchiver {
+	return &visibilityArchiver{
+		container: container,
+		producers: producers,
+	}
+}
+
+// Archive publishes the visibility record of a closed workflow as a visibility message
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveVisibilityRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	scope := v.container.MetricsClient.Scope(metrics.VisibilityArchiverScope, metrics.DomainTag(request.DomainName))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+	archiveFailReason := ""
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if isRetryableError(err) {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveTransientErrorCount)
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+			} else {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveNonRetryableErrorCount)
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+				if featureCatalog.NonRetriableError != nil {
+					err = featureCatalog.NonRetriableError()
+				}
+			}
+		}
+	}()
+
+	if err := v.ValidateURI(URI); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidURI
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidArchiveRequest
+		return err
+	}
+
+	encodedMessage, err := encode(newVisibilityMessage(request))
+	if err != nil {
+		archiveFailReason = errEncodeVisibilityRecord
+		return err
+	}
+
+	producer, err := v.producers.getProducer(URI.Hostname())
+	if err != nil {
+		archiveFailReason = errPublishVisibilityRecord
+		return err
+	}
+	if err := producer.Publish(ctx, encodedMessage); err != nil {
+		archiveFailReason = errPublishVisibilityRecord
+		return err
+	}
+	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
+	return nil
+}
+
+// Query is not supported, archived visibility records are consumed from the kafka topic
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	return nil, &types.BadRequestError{Message: errReadNotSupported.Error()}
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if err := softValidateURI(URI); err != nil {
+		return err
+	}
+	return v.producers.validateApplication(URI.Hostname())
+}
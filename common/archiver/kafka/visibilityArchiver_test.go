@@ -0,0 +1,158 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/types"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	producer        *mocks.KafkaProducer
+	container       *archiver.VisibilityBootstrapContainer
+	testArchivalURI archiver.URI
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	var err error
+	s.Assertions = require.New(s.T())
+	s.producer = &mocks.KafkaProducer{}
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger:        loggerimpl.NewLogger(zap.NewNop()),
+		MetricsClient: metrics.NewClient(tally.NewTestScope("test", nil), metrics.VisibilityArchiverScope),
+	}
+	s.testArchivalURI, err = archiver.NewURI(testArchivalURI)
+	s.NoError(err)
+}
+
+func (s *visibilityArchiverSuite) TearDownTest() {
+	s.producer.AssertExpectations(s.T())
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	URI, err := archiver.NewURI("wrongscheme://" + testApplication)
+	s.NoError(err)
+
+	err = s.newTestVisibilityArchiver().Archive(context.Background(), URI, s.newArchiveRequest())
+	s.Equal(archiver.ErrURISchemeMismatch, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	request := s.newArchiveRequest()
+	request.CloseTimestamp = 0
+
+	err := s.newTestVisibilityArchiver().Archive(context.Background(), s.testArchivalURI, request)
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_PublishError() {
+	publishErr := &sarama.ProducerError{Err: sarama.ErrRequestTimedOut}
+	s.producer.On("Publish", mock.Anything, mock.Anything).Return(publishErr).Once()
+
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := s.newTestVisibilityArchiver().Archive(
+		context.Background(),
+		s.testArchivalURI,
+		s.newArchiveRequest(),
+		archiver.GetNonRetriableErrorOption(nonRetryableErr),
+	)
+	s.Equal(publishErr, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success() {
+	request := s.newArchiveRequest()
+	var published *Message
+	s.producer.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		published = &Message{}
+		s.NoError(json.Unmarshal(args.Get(1).(*messaging.EncodedMessage).Value, published))
+	}).Once()
+
+	err := s.newTestVisibilityArchiver().Archive(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+
+	s.Equal(&Message{
+		SchemaVersion: SchemaVersion,
+		Type:          MessageTypeVisibility,
+		DomainID:      testDomainID,
+		DomainName:    testDomainName,
+		WorkflowID:    testWorkflowID,
+		RunID:         testRunID,
+		Visibility: &VisibilityRecord{
+			WorkflowTypeName:   request.WorkflowTypeName,
+			StartTimestamp:     request.StartTimestamp,
+			ExecutionTimestamp: request.ExecutionTimestamp,
+			CloseTimestamp:     request.CloseTimestamp,
+			CloseStatus:        types.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:      request.HistoryLength,
+			SearchAttributes:   request.SearchAttributes,
+			HistoryArchivalURI: testArchivalURI,
+		},
+	}, published)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_NotSupported() {
+	_, err := s.newTestVisibilityArchiver().Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
+	return newVisibilityArchiver(s.container, newTestProducerCache(s.producer))
+}
+
+func (s *visibilityArchiverSuite) newArchiveRequest() *archiver.ArchiveVisibilityRequest {
+	return &archiver.ArchiveVisibilityRequest{
+		DomainID:           testDomainID,
+		DomainName:         testDomainName,
+		WorkflowID:         testWorkflowID,
+		RunID:              testRunID,
+		WorkflowTypeName:   "test-workflow-type",
+		StartTimestamp:     1580896574804475000,
+		ExecutionTimestamp: 1580896575804475000,
+		CloseTimestamp:     1580896575946478000,
+		CloseStatus:        types.WorkflowExecutionCloseStatusFailed,
+		HistoryLength:      36,
+		SearchAttributes:   map[string]string{"CustomKeywordField": `"keyword"`},
+		HistoryArchivalURI: testArchivalURI,
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/filestore"
+	"github.com/uber/cadence/common/archiver/kafka"
 	"github.com/uber/cadence/common/archiver/s3store"
 	"github.com/uber/cadence/common/config"
 )
@@ -151,6 +152,12 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 			return nil, ErrArchiverConfigNotFound
 		}
 		historyArchiver, err = s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
+
+	case kafka.URIScheme:
+		if p.historyArchiverConfigs.Kafka == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		historyArchiver, err = kafka.NewHistoryArchiver(container, p.historyArchiverConfigs.Kafka)
 	default:
 		return nil, ErrUnknownScheme
 	}
@@ -201,6 +208,11 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 			return nil, ErrArchiverConfigNotFound
 		}
 		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Gstorage)
+	case kafka.URIScheme:
+		if p.visibilityArchiverConfigs.Kafka == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		visibilityArchiver, err = kafka.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Kafka)
 
 	default:
 		return nil, ErrUnknownScheme
@@ -0,0 +1,114 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestGetPeerIdentity(t *testing.T) {
+	spiffeID, err := url.Parse("spiffe://cluster.local/ns/cadence/sa/worker")
+	assert.NoError(t, err)
+	otherURI, err := url.Parse("https://example.com/worker")
+	assert.NoError(t, err)
+
+	withPeer := func(authInfo credentials.AuthInfo) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{
+			Addr:     &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 7833},
+			AuthInfo: authInfo,
+		})
+	}
+	withCert := func(cert *x509.Certificate) context.Context {
+		return withPeer(credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{cert}},
+		}})
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{
+			name:     "no peer",
+			ctx:      context.Background(),
+			expected: "",
+		},
+		{
+			name:     "no TLS",
+			ctx:      withPeer(nil),
+			expected: "",
+		},
+		{
+			name:     "no verified client certificate",
+			ctx:      withPeer(credentials.TLSInfo{}),
+			expected: "",
+		},
+		{
+			name: "SPIFFE ID",
+			ctx: withCert(&x509.Certificate{
+				Subject:  pkix.Name{CommonName: "worker"},
+				URIs:     []*url.URL{otherURI, spiffeID},
+				DNSNames: []string{"worker.cadence"},
+			}),
+			expected: "spiffe://cluster.local/ns/cadence/sa/worker",
+		},
+		{
+			name: "common name",
+			ctx: withCert(&x509.Certificate{
+				Subject:  pkix.Name{CommonName: "worker"},
+				URIs:     []*url.URL{otherURI},
+				DNSNames: []string{"worker.cadence"},
+			}),
+			expected: "worker",
+		},
+		{
+			name: "DNS name",
+			ctx: withCert(&x509.Certificate{
+				DNSNames:       []string{"worker.cadence", "worker"},
+				EmailAddresses: []string{"worker@cadence"},
+			}),
+			expected: "worker.cadence",
+		},
+		{
+			name: "email address",
+			ctx: withCert(&x509.Certificate{
+				EmailAddresses: []string{"worker@cadence"},
+			}),
+			expected: "worker@cadence",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetPeerIdentity(tt.ctx))
+		})
+	}
+}
@@ -815,12 +815,39 @@ func (entry *DomainCacheEntry) GetFailoverEndTime() *int64 {
 	return entry.failoverEndTime
 }
 
+// IsActiveActive return whether the domain is an active-active global domain, i.e. each workflow
+// is active in its own cluster, derived from the workflow's failover version
+func (entry *DomainCacheEntry) IsActiveActive() bool {
+	return entry.isGlobalDomain && entry.info != nil && common.IsActiveActiveDomainData(entry.info.Data)
+}
+
+// GetWorkflowActiveCluster return the cluster in which a workflow with the given failover version is active
+func (entry *DomainCacheEntry) GetWorkflowActiveCluster(failoverVersion int64) string {
+	if !entry.IsActiveActive() {
+		return entry.replicationConfig.ActiveClusterName
+	}
+	return entry.clusterMetadata.ClusterNameForFailoverVersion(failoverVersion)
+}
+
+// IsWorkflowActive return whether a workflow with the given failover version is active in the current cluster
+func (entry *DomainCacheEntry) IsWorkflowActive(failoverVersion int64) bool {
+	if !entry.IsActiveActive() {
+		return entry.IsDomainActive()
+	}
+	return entry.clusterMetadata.ClusterNameForFailoverVersion(failoverVersion) == entry.clusterMetadata.GetCurrentClusterName()
+}
+
 // IsDomainActive return whether the domain is active, i.e. non global domain or global domain which active cluster is the current cluster
 func (entry *DomainCacheEntry) IsDomainActive() bool {
 	if !entry.isGlobalDomain {
 		// domain is not a global domain, meaning domain is always "active" within each cluster
 		return true
 	}
+	if entry.IsActiveActive() {
+		// active-active domain is active in every cluster, whether a workflow is active
+		// is decided by the cluster of the workflow's failover version, see GetWorkflowNotActiveErr
+		return true
+	}
 	return entry.clusterMetadata.GetCurrentClusterName() == entry.replicationConfig.ActiveClusterName && !entry.IsDomainPendingActive()
 }
 
@@ -830,6 +857,10 @@ func (entry *DomainCacheEntry) IsDomainPendingActive() bool {
 		// domain is not a global domain, meaning domain can never be in pending active state
 		return false
 	}
+	if entry.IsActiveActive() {
+		// active-active domain cannot be gracefully failed over
+		return false
+	}
 	return entry.failoverEndTime != nil
 }
 
@@ -862,6 +893,21 @@ func (entry *DomainCacheEntry) GetDomainNotActiveErr() error {
 	)
 }
 
+// GetWorkflowNotActiveErr return err if a workflow with the given failover version is not active, nil otherwise
+func (entry *DomainCacheEntry) GetWorkflowNotActiveErr(failoverVersion int64) error {
+	if !entry.IsActiveActive() {
+		return entry.GetDomainNotActiveErr()
+	}
+	if entry.IsWorkflowActive(failoverVersion) {
+		return nil
+	}
+	return errors.NewDomainNotActiveError(
+		entry.info.Name,
+		entry.clusterMetadata.GetCurrentClusterName(),
+		entry.GetWorkflowActiveCluster(failoverVersion),
+	)
+}
+
 // Len return length
 func (t DomainCacheEntries) Len() int {
 	return len(t)
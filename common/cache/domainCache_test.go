@@ -794,3 +794,55 @@ func Test_DomainCacheEntry_GetDomainNotActiveErr(t *testing.T) {
 	_, ok := err.(*types.DomainNotActiveError)
 	require.True(t, ok)
 }
+
+func Test_DomainCacheEntry_ActiveActive(t *testing.T) {
+	clusterMetadata := cluster.NewMetadata(
+		loggerimpl.NewNopLogger(),
+		dynamicconfig.GetBoolPropertyFn(true),
+		cluster.TestFailoverVersionIncrement,
+		cluster.TestCurrentClusterName,
+		cluster.TestCurrentClusterName,
+		cluster.TestAllClusterInfo,
+	)
+	domainEntry := NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			Name: "test-domain",
+			Data: map[string]string{common.DomainDataKeyForActiveActive: "true"},
+		},
+		nil,
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		cluster.TestAlternativeClusterInitialFailoverVersion,
+		clusterMetadata,
+	)
+	currentVersion := cluster.TestCurrentClusterInitialFailoverVersion + cluster.TestFailoverVersionIncrement
+	alternativeVersion := cluster.TestAlternativeClusterInitialFailoverVersion + cluster.TestFailoverVersionIncrement
+
+	require.True(t, domainEntry.IsActiveActive())
+	require.True(t, domainEntry.IsDomainActive())
+	require.False(t, domainEntry.IsDomainPendingActive())
+	require.Nil(t, domainEntry.GetDomainNotActiveErr())
+
+	require.True(t, domainEntry.IsWorkflowActive(currentVersion))
+	require.Equal(t, cluster.TestCurrentClusterName, domainEntry.GetWorkflowActiveCluster(currentVersion))
+	require.Nil(t, domainEntry.GetWorkflowNotActiveErr(currentVersion))
+
+	require.False(t, domainEntry.IsWorkflowActive(alternativeVersion))
+	require.Equal(t, cluster.TestAlternativeClusterName, domainEntry.GetWorkflowActiveCluster(alternativeVersion))
+	err := domainEntry.GetWorkflowNotActiveErr(alternativeVersion)
+	require.NotNil(t, err)
+	domainNotActiveErr, ok := err.(*types.DomainNotActiveError)
+	require.True(t, ok)
+	require.Equal(t, cluster.TestAlternativeClusterName, domainNotActiveErr.ActiveCluster)
+
+	// workflows of a domain which is not active-active follow the domain's active cluster
+	domainEntry.info.Data = nil
+	require.False(t, domainEntry.IsActiveActive())
+	require.False(t, domainEntry.IsWorkflowActive(currentVersion))
+	require.Equal(t, cluster.TestAlternativeClusterName, domainEntry.GetWorkflowActiveCluster(currentVersion))
+}
@@ -72,6 +72,9 @@ const (
 	GoWorkerWorkflowAlreadyCompletedVersion = "1.7.0"
 	// Java Client version that supports WorkflowExecutionAlreadyCompleted Error
 	JavaWorkflowAlreadyCompletedVersion = "1.4.0"
+	// CLIResetDryRunVersion indicates the minimum cli version a server advertises in GetClusterInfo when it supports
+	// dry run reset, servers without support do not advertise a cli version
+	CLIResetDryRunVersion = "1.7.0"
 
 	stickyQuery                   = "sticky-query"
 	consistentQuery               = "consistent-query"
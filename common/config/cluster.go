@@ -27,6 +27,7 @@ import (
 
 	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
+	"google.golang.org/grpc/encoding/gzip"
 
 	"go.uber.org/multierr"
 )
@@ -68,6 +69,10 @@ type (
 		// Allowed values: tchannel|grpc
 		// Default: tchannel
 		RPCTransport string `yaml:"rpcTransport"`
+		// RPCCompression specifies compression to use for replication traffic, only supported with grpc transport.
+		// Allowed values: gzip
+		// Default: no compression
+		RPCCompression string `yaml:"rpcCompression"`
 		// AuthorizationProvider contains the information to authorize the cluster
 		AuthorizationProvider AuthorizationProvider `yaml:"authorizationProvider"`
 		// TLS configures client TLS/SSL authentication for connections to this cluster
@@ -139,6 +144,10 @@ func (m *ClusterGroupMetadata) Validate() error {
 			errs = multierr.Append(errs, fmt.Errorf("cluster %v: rpc transport must %v or %v",
 				clusterName, tchannel.TransportName, grpc.TransportName))
 		}
+		if info.RPCCompression != "" && (info.RPCCompression != gzip.Name || info.RPCTransport != grpc.TransportName) {
+			errs = multierr.Append(errs, fmt.Errorf("cluster %v: rpc compression must be empty or %v with %v transport",
+				clusterName, gzip.Name, grpc.TransportName))
+		}
 	}
 	if len(versionToClusterName) != len(m.ClusterGroup) {
 		errs = multierr.Append(errs, errors.New("initial versions of the cluster group have duplicates"))
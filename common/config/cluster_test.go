@@ -144,6 +144,26 @@ func TestClusterGroupMetadataValidate(t *testing.T) {
 			}),
 			err: "cluster active: rpc transport must tchannel or grpc",
 		},
+		{
+			msg: "invalid rpc compression",
+			config: modify(validClusterGroupMetadata(), func(m *ClusterGroupMetadata) {
+				active := m.ClusterGroup["active"]
+				active.RPCTransport = "grpc"
+				active.RPCCompression = "invalid"
+				m.ClusterGroup["active"] = active
+			}),
+			err: "cluster active: rpc compression must be empty or gzip with grpc transport",
+		},
+		{
+			msg: "rpc compression with tchannel",
+			config: modify(validClusterGroupMetadata(), func(m *ClusterGroupMetadata) {
+				active := m.ClusterGroup["active"]
+				active.RPCTransport = "tchannel"
+				active.RPCCompression = "gzip"
+				m.ClusterGroup["active"] = active
+			}),
+			err: "cluster active: rpc compression must be empty or gzip with grpc transport",
+		},
 		{
 			msg: "initial version duplicated",
 			config: modify(validClusterGroupMetadata(), func(m *ClusterGroupMetadata) {
@@ -139,8 +139,11 @@ type (
 		LogLevel string `yaml:"logLevel"`
 		// GRPCMaxMsgSize allows overriding default (4MB) message size for gRPC
 		GRPCMaxMsgSize int `yaml:"grpcMaxMsgSize"`
-		// TLS allows configuring optional TLS/SSL authentication on the server (only on gRPC port)
+		// TLS allows configuring optional TLS/SSL authentication on the server (only on gRPC port, unless EnableTChannelTLS is set)
 		TLS TLS `yaml:"tls"`
+		// EnableTChannelTLS uses the TLS config above for the TChannel port as well,
+		// both for inbound connections and for connections made to other hosts, including ringpop
+		EnableTChannelTLS bool `yaml:"enableTChannelTLS"`
 	}
 
 	// Blobstore contains the config for blobstore
@@ -188,6 +191,8 @@ type (
 		NumHistoryShards int `yaml:"numHistoryShards" validate:"nonzero"`
 		// DataStores contains the configuration for all datastores
 		DataStores map[string]DataStore `yaml:"datastores"`
+		// PayloadEncryption contains the config for encrypting workflow payloads before they are persisted
+		PayloadEncryption PayloadEncryption `yaml:"payloadEncryption"`
 		// TODO: move dynamic config out of static config
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
@@ -196,6 +201,19 @@ type (
 		ErrorInjectionRate dynamicconfig.FloatPropertyFn `yaml:"-" json:"-"`
 	}
 
+	// PayloadEncryption contains the config of the key provider used to encrypt workflow payloads,
+	// the key used for a domain is configured with dynamic config
+	PayloadEncryption struct {
+		// Keyring is the config for reading keys from a local keyring file
+		Keyring *Keyring `yaml:"keyring"`
+	}
+
+	// Keyring is the config for a local keyring file
+	Keyring struct {
+		// Path is the path of the keyring file
+		Path string `yaml:"path"`
+	}
+
 	// DataStore is the configuration for a single datastore
 	DataStore struct {
 		// Cassandra contains the config for a cassandra datastore
@@ -435,6 +453,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		Kafka     *KafkaArchiver     `yaml:"kafka"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -452,6 +471,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Kafka     *KafkaArchiver     `yaml:"kafka"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -472,6 +492,15 @@ type (
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
 	}
 
+	// KafkaArchiver contains the config for kafka archiver
+	KafkaArchiver struct {
+		// Kafka is the config for connecting to kafka, the host of a kafka archival URI
+		// is the name of an application in this config
+		Kafka KafkaConfig `yaml:"kafka"`
+		// ChunkSize is the target size in bytes of a single history message, default to 256KB
+		ChunkSize int `yaml:"chunkSize"`
+	}
+
 	// PublicClient is config for connecting to cadence frontend
 	PublicClient struct {
 		// HostPort is the host port to connect on. Host can be DNS name
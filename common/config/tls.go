@@ -23,7 +23,12 @@ package config
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"os"
+	"sync"
+	"time"
 )
 
 type (
@@ -55,9 +60,30 @@ type (
 		RequireClientAuth bool `yaml:"requireClientAuth"`
 
 		ServerName string `yaml:"serverName"`
+
+		// ReloadInterval is how often the cert, key and CA files are checked for changes.
+		// Changed files are reloaded on the next handshake, so certificates can be rotated without a restart.
+		// Default to 0, which loads the files only once.
+		ReloadInterval time.Duration `yaml:"reloadInterval"`
+	}
+
+	// tlsReloader keeps the certificate and CA pool loaded from the files of a TLS config,
+	// and reloads them when the files are modified
+	tlsReloader struct {
+		config  TLS
+		caFiles []string
+		now     func() time.Time
+
+		sync.RWMutex
+		nextCheck time.Time
+		modTimes  map[string]time.Time
+		cert      *tls.Certificate
+		caPool    *x509.CertPool
 	}
 )
 
+var errNoCertificate = errors.New("no certificate configured")
+
 // ToTLSConfig converts Cadence TLS config to crypto/tls.Config
 func (config TLS) ToTLSConfig() (*tls.Config, error) {
 	if !config.Enabled {
@@ -75,38 +101,189 @@ func (config TLS) ToTLSConfig() (*tls.Config, error) {
 		tlsConfig.ServerName = config.ServerName
 	}
 
+	reloader, err := newTLSReloader(config)
+	if err != nil {
+		return nil, err
+	}
+
 	// Load CA certs
+	tlsConfig.RootCAs = reloader.caPool
+
+	// Enable mutual TLS
+	if config.RequireClientAuth {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		tlsConfig.ClientCAs = tlsConfig.RootCAs
+	}
+
+	// Load client cert
+	if reloader.cert != nil {
+		tlsConfig.Certificates = []tls.Certificate{*reloader.cert}
+	}
+
+	if config.ReloadInterval > 0 {
+		reloader.apply(tlsConfig)
+	}
+	return tlsConfig, nil
+}
+
+func newTLSReloader(config TLS) (*tlsReloader, error) {
 	caFiles := config.CaFiles
 	if config.CaFile != "" {
 		caFiles = append(caFiles, config.CaFile)
 	}
+	r := &tlsReloader{
+		config:  config,
+		caFiles: caFiles,
+		now:     time.Now,
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	r.nextCheck = r.now().Add(config.ReloadInterval)
+	return r, nil
+}
+
+// apply makes the TLS config pick up reloaded certificates and CAs on every handshake
+func (r *tlsReloader) apply(tlsConfig *tls.Config) {
+	base := tlsConfig.Clone()
+
+	// server side, a fresh config is built for every client
+	tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		cert, caPool := r.get()
+		serverConfig := base.Clone()
+		if cert != nil {
+			serverConfig.Certificates = []tls.Certificate{*cert}
+		}
+		if caPool != nil {
+			serverConfig.RootCAs = caPool
+			if serverConfig.ClientAuth == tls.RequireAndVerifyClientCert {
+				serverConfig.ClientCAs = caPool
+			}
+		}
+		return serverConfig, nil
+	}
 
-	if len(caFiles) > 0 {
-		caCertPool := x509.NewCertPool()
-		for _, caFile := range caFiles {
+	// client side
+	if r.cert != nil {
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.get()
+			return cert, nil
+		}
+	}
+	if r.config.EnableHostVerification && len(r.caFiles) > 0 {
+		// the server certificate has to be verified against the current CA pool,
+		// which crypto/tls only supports by doing the verification ourselves
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			_, caPool := r.get()
+			return verifyServerCertificate(state, caPool, r.config.ServerName)
+		}
+	}
+}
+
+// get returns the current certificate and CA pool, reloading them first if the files have changed
+func (r *tlsReloader) get() (*tls.Certificate, *x509.CertPool) {
+	now := r.now()
+	r.RLock()
+	due := !now.Before(r.nextCheck)
+	cert, caPool := r.cert, r.caPool
+	r.RUnlock()
+	if !due {
+		return cert, caPool
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if now.Before(r.nextCheck) {
+		return r.cert, r.caPool
+	}
+	r.nextCheck = now.Add(r.config.ReloadInterval)
+	if r.isModified() {
+		// keep serving the previous certificate if the new files are invalid,
+		// e.g. the cert file has been replaced but not the key file yet
+		_ = r.load()
+	}
+	return r.cert, r.caPool
+}
+
+// load loads the certificate and CA pool from the files, and records their modification times.
+// It must be called with the lock held.
+func (r *tlsReloader) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, file := range r.files() {
+		// files are stat'ed before being read so that a change in between is picked up by the next check,
+		// a missing file is reported when reading it below
+		if info, err := os.Stat(file); err == nil {
+			modTimes[file] = info.ModTime()
+		}
+	}
+
+	var caPool *x509.CertPool
+	if len(r.caFiles) > 0 {
+		caPool = x509.NewCertPool()
+		for _, caFile := range r.caFiles {
 			caCert, err := ioutil.ReadFile(caFile)
 			if err != nil {
-				return nil, err
+				return err
+			}
+			if !caPool.AppendCertsFromPEM(caCert) && r.config.ReloadInterval > 0 {
+				return fmt.Errorf("no certificate found in CA file %v", caFile)
 			}
-			caCertPool.AppendCertsFromPEM(caCert)
 		}
-		tlsConfig.RootCAs = caCertPool
 	}
 
-	// Enable mutual TLS
-	if config.RequireClientAuth {
-		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
-		tlsConfig.ClientCAs = tlsConfig.RootCAs
+	var cert *tls.Certificate
+	if r.config.CertFile != "" && r.config.KeyFile != "" {
+		keyPair, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+		if err != nil {
+			return err
+		}
+		cert = &keyPair
 	}
 
-	// Load client cert
-	if config.CertFile != "" && config.KeyFile != "" {
-		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+	r.modTimes = modTimes
+	r.cert = cert
+	r.caPool = caPool
+	return nil
+}
+
+// isModified returns true if any file has a different modification time than when it was last loaded
+func (r *tlsReloader) isModified() bool {
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
 		if err != nil {
-			return nil, err
+			continue
 		}
-		tlsConfig.Certificates = []tls.Certificate{cert}
+		if !info.ModTime().Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *tlsReloader) files() []string {
+	files := append([]string{}, r.caFiles...)
+	if r.config.CertFile != "" && r.config.KeyFile != "" {
+		files = append(files, r.config.CertFile, r.config.KeyFile)
 	}
+	return files
+}
 
-	return tlsConfig, nil
+func verifyServerCertificate(state tls.ConnectionState, caPool *x509.CertPool, serverName string) error {
+	if len(state.PeerCertificates) == 0 {
+		return errNoCertificate
+	}
+	if serverName == "" {
+		serverName = state.ServerName
+	}
+	opts := x509.VerifyOptions{
+		Roots:         caPool,
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range state.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(opts)
+	return err
 }
@@ -0,0 +1,289 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	tlsSuite struct {
+		suite.Suite
+		dir string
+	}
+
+	testCA struct {
+		cert *x509.Certificate
+		key  *ecdsa.PrivateKey
+		pem  []byte
+	}
+)
+
+func TestTLSSuite(t *testing.T) {
+	suite.Run(t, new(tlsSuite))
+}
+
+func (s *tlsSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "tls_test")
+	s.Require().NoError(err)
+	s.dir = dir
+}
+
+func (s *tlsSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *tlsSuite) TestToTLSConfig_Disabled() {
+	tlsConfig, err := TLS{}.ToTLSConfig()
+	s.NoError(err)
+	s.Nil(tlsConfig)
+}
+
+func (s *tlsSuite) TestToTLSConfig_Static() {
+	ca := s.newCA("ca")
+	s.writeCert(ca, "host1", "cert.pem", "key.pem")
+	s.writeFile("ca.pem", ca.pem)
+
+	tlsConfig, err := TLS{
+		Enabled:           true,
+		CertFile:          s.path("cert.pem"),
+		KeyFile:           s.path("key.pem"),
+		CaFile:            s.path("ca.pem"),
+		RequireClientAuth: true,
+	}.ToTLSConfig()
+	s.NoError(err)
+	s.Len(tlsConfig.Certificates, 1)
+	s.NotNil(tlsConfig.RootCAs)
+	s.Equal(tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+	s.Nil(tlsConfig.GetConfigForClient)
+	s.Nil(tlsConfig.GetClientCertificate)
+}
+
+func (s *tlsSuite) TestReloader() {
+	ca := s.newCA("ca")
+	s.writeCert(ca, "host1", "cert.pem", "key.pem")
+	s.writeFile("ca.pem", ca.pem)
+
+	now := time.Now()
+	r, err := newTLSReloader(TLS{
+		Enabled:        true,
+		CertFile:       s.path("cert.pem"),
+		KeyFile:        s.path("key.pem"),
+		CaFile:         s.path("ca.pem"),
+		ReloadInterval: time.Minute,
+	})
+	s.NoError(err)
+	r.now = func() time.Time { return now }
+	s.Equal("host1", s.commonName(r))
+
+	// rotated files are not picked up before the reload interval
+	s.writeCert(ca, "host2", "cert.pem", "key.pem")
+	s.touch(time.Hour, "cert.pem", "key.pem")
+	now = now.Add(30 * time.Second)
+	s.Equal("host1", s.commonName(r))
+
+	now = now.Add(time.Minute)
+	s.Equal("host2", s.commonName(r))
+
+	// invalid files are ignored and the previous certificate is kept
+	s.writeFile("cert.pem", []byte("invalid"))
+	s.touch(2*time.Hour, "cert.pem")
+	now = now.Add(time.Minute)
+	s.Equal("host2", s.commonName(r))
+}
+
+func (s *tlsSuite) TestMutualTLS_CARotation() {
+	oldCA := s.newCA("old-ca")
+	newCA := s.newCA("new-ca")
+	s.writeCert(oldCA, "server", "server.pem", "server-key.pem")
+	s.writeCert(oldCA, "client", "client.pem", "client-key.pem")
+	s.writeFile("ca.pem", oldCA.pem)
+
+	serverConfig, err := TLS{
+		Enabled:           true,
+		CertFile:          s.path("server.pem"),
+		KeyFile:           s.path("server-key.pem"),
+		CaFile:            s.path("ca.pem"),
+		RequireClientAuth: true,
+		ReloadInterval:    time.Nanosecond,
+	}.ToTLSConfig()
+	s.NoError(err)
+	clientConfig, err := TLS{
+		Enabled:                true,
+		CertFile:               s.path("client.pem"),
+		KeyFile:                s.path("client-key.pem"),
+		CaFile:                 s.path("ca.pem"),
+		EnableHostVerification: true,
+		ServerName:             "server",
+		ReloadInterval:         time.Nanosecond,
+	}.ToTLSConfig()
+	s.NoError(err)
+
+	peer, err := s.handshake(serverConfig, clientConfig)
+	s.NoError(err)
+	s.Equal("client", peer.Subject.CommonName)
+
+	// the client gets a certificate from the new CA before the server trusts it
+	s.writeCert(newCA, "client", "client.pem", "client-key.pem")
+	s.touch(time.Hour, "client.pem", "client-key.pem")
+	_, err = s.handshake(serverConfig, clientConfig)
+	s.Error(err)
+
+	// both trust the new CA, and the server rotates its certificate
+	s.writeFile("ca.pem", append(append([]byte{}, oldCA.pem...), newCA.pem...))
+	s.writeCert(newCA, "server", "server.pem", "server-key.pem")
+	s.touch(2*time.Hour, "ca.pem", "server.pem", "server-key.pem")
+	peer, err = s.handshake(serverConfig, clientConfig)
+	s.NoError(err)
+	s.Equal("client", peer.Subject.CommonName)
+	s.Equal("new-ca", peer.Issuer.CommonName)
+}
+
+// handshake runs a TLS handshake and returns the verified client certificate seen by the server
+func (s *tlsSuite) handshake(serverConfig *tls.Config, clientConfig *tls.Config) (*x509.Certificate, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	defer listener.Close()
+
+	type result struct {
+		peer *x509.Certificate
+		err  error
+	}
+	resultC := make(chan result, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			resultC <- result{err: err}
+			return
+		}
+		defer conn.Close()
+		server := tls.Server(conn, serverConfig)
+		if err := server.Handshake(); err != nil {
+			resultC <- result{err: err}
+			return
+		}
+		chains := server.ConnectionState().VerifiedChains
+		if len(chains) == 0 {
+			resultC <- result{err: errNoCertificate}
+			return
+		}
+		resultC <- result{peer: chains[0][0]}
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	s.Require().NoError(err)
+	defer conn.Close()
+	client := tls.Client(conn, clientConfig)
+	clientErr := client.Handshake()
+	if clientErr == nil {
+		// TLS 1.3 reports client certificate failures on the first read
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		client.Read(make([]byte, 1))
+	}
+	r := <-resultC
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.peer, clientErr
+}
+
+func (s *tlsSuite) commonName(r *tlsReloader) string {
+	cert, _ := r.get()
+	s.Require().NotNil(cert)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	s.Require().NoError(err)
+	return leaf.Subject.CommonName
+}
+
+func (s *tlsSuite) newCA(name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	s.Require().NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	s.Require().NoError(err)
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func (s *tlsSuite) writeCert(ca *testCA, name string, certFile string, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	s.Require().NoError(err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	s.Require().NoError(err)
+	s.writeFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	s.writeFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func (s *tlsSuite) writeFile(name string, data []byte) {
+	s.Require().NoError(ioutil.WriteFile(s.path(name), data, 0600))
+}
+
+// touch moves the modification time of the files forward, so changes are detected
+// even if the files are rewritten within the resolution of the file system clock
+func (s *tlsSuite) touch(offset time.Duration, names ...string) {
+	for _, name := range names {
+		t := time.Now().Add(offset)
+		s.Require().NoError(os.Chtimes(s.path(name), t, t))
+	}
+}
+
+func (s *tlsSuite) path(name string) string {
+	return filepath.Join(s.dir, name)
+}
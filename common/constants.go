@@ -66,6 +66,11 @@ const (
 	EncodingTypeUnknown  EncodingType = "unknow"
 	EncodingTypeEmpty    EncodingType = ""
 	EncodingTypeProto    EncodingType = "proto3"
+
+	// EncodingTypeJSONEncrypted is json encoded data encrypted by an encryption.Encryptor
+	EncodingTypeJSONEncrypted EncodingType = "json-enc"
+	// EncodingTypeThriftRWEncrypted is thriftrw encoded data encrypted by an encryption.Encryptor
+	EncodingTypeThriftRWEncrypted EncodingType = "thriftrw-enc"
 )
 
 type (
@@ -83,9 +88,17 @@ const (
 	ReadDLQMessagesPageSize = 1000
 )
 
+const (
+	// ReplicationDeadDLQSuffix is appended to the source cluster name of the replication DLQ
+	// to address tasks which are given up on by the DLQ redrive processor
+	ReplicationDeadDLQSuffix = ":dead"
+)
+
 const (
 	// VisibilityAppName is used to find kafka topics and ES indexName for visibility
 	VisibilityAppName = "visibility"
+	// WorkflowLifecycleEventsAppName is used to find the kafka topic for workflow lifecycle events
+	WorkflowLifecycleEventsAppName = "workflow-lifecycle-events"
 )
 
 // This was flagged by salus as potentially hardcoded credentials. This is a false positive by the scanner and should be
@@ -158,6 +171,9 @@ const (
 	DomainDataKeyForManagedFailover = "IsManagedByCadence"
 	// DomainDataKeyForPreferredCluster is the key of DomainData for domain rebalance
 	DomainDataKeyForPreferredCluster = "PreferredCluster"
+	// DomainDataKeyForActiveActive is the key of DomainData that makes a global domain active-active,
+	// i.e. each workflow is active in its own cluster instead of the domain's active cluster
+	DomainDataKeyForActiveActive = "IsActiveActive"
 	// DomainDataKeyForReadGroups stores which groups have read permission of the domain API
 	DomainDataKeyForReadGroups = "READ_GROUPS"
 	// DomainDataKeyForWriteGroups stores which groups have write permission of the domain API
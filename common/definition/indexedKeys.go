@@ -103,3 +103,12 @@ func IsSystemIndexedKey(key string) bool {
 	_, ok := systemIndexedKeys[key]
 	return ok
 }
+
+// ResolveIndexedKeyAlias returns the key a renamed search attribute now maps to,
+// or the key itself if it is not an alias
+func ResolveIndexedKeyAlias(aliases map[string]interface{}, key string) string {
+	if newKey, ok := aliases[key].(string); ok && newKey != "" {
+		return newKey
+	}
+	return key
+}
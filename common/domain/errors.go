@@ -34,6 +34,9 @@ var (
 	errGracefulFailoverInActiveCluster     = &types.BadRequestError{Message: "Cannot start the graceful failover from an active cluster to an active cluster."}
 	errOngoingGracefulFailover             = &types.BadRequestError{Message: "Cannot start concurrent graceful failover."}
 	errInvalidGracefulFailover             = &types.BadRequestError{Message: "Cannot start graceful failover without updating active cluster or in local domain."}
+	errGlobalDomainNotEnabled              = &types.BadRequestError{Message: "Cannot promote domain when global domain is not enabled."}
+	errDomainAlreadyGlobal                 = &types.BadRequestError{Message: "Domain is already a global domain."}
+	errActiveActiveDomainFailover          = &types.BadRequestError{Message: "Cannot fail over an active-active domain, fail over its workflows instead."}
 
 	errInvalidRetentionPeriod = &types.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig  = &types.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
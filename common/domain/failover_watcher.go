@@ -201,6 +201,7 @@ func CleanPendingActiveState(
 			Info:                        getResponse.Info,
 			Config:                      getResponse.Config,
 			ReplicationConfig:           getResponse.ReplicationConfig,
+			IsGlobalDomain:              isGlobalDomain,
 			ConfigVersion:               getResponse.ConfigVersion,
 			FailoverVersion:             localFailoverVersion,
 			FailoverNotificationVersion: getResponse.FailoverNotificationVersion,
@@ -163,6 +163,7 @@ func (s *failoverWatcherSuite) TestCleanPendingActiveState() {
 		Info:                        info,
 		Config:                      domainConfig,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              true,
 		ConfigVersion:               1,
 		FailoverVersion:             2,
 		FailoverNotificationVersion: 2,
@@ -226,6 +227,7 @@ func (s *failoverWatcherSuite) TestHandleFailoverTimeout() {
 		Info:                        info,
 		Config:                      domainConfig,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              true,
 		ConfigVersion:               1,
 		FailoverVersion:             1,
 		FailoverNotificationVersion: 1,
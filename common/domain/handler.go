@@ -61,6 +61,10 @@ type (
 			ctx context.Context,
 			listRequest *types.ListDomainsRequest,
 		) (*types.ListDomainsResponse, error)
+		PromoteDomain(
+			ctx context.Context,
+			promoteRequest *types.PromoteDomainRequest,
+		) (*types.UpdateDomainResponse, error)
 		RegisterDomain(
 			ctx context.Context,
 			registerRequest *types.RegisterDomainRequest,
@@ -458,6 +462,11 @@ func (d *handlerImpl) UpdateDomain(
 		return nil, err
 	}
 
+	// workflows of an active-active domain are failed over individually
+	if activeClusterChanged && isGlobalDomain && common.IsActiveActiveDomainData(info.Data) {
+		return nil, errActiveActiveDomainFailover
+	}
+
 	// Handle graceful failover request
 	if updateRequest.FailoverTimeoutInSeconds != nil {
 		// must update active cluster on a global domain
@@ -536,6 +545,7 @@ func (d *handlerImpl) UpdateDomain(
 			Info:                        info,
 			Config:                      config,
 			ReplicationConfig:           replicationConfig,
+			IsGlobalDomain:              isGlobalDomain,
 			ConfigVersion:               configVersion,
 			FailoverVersion:             failoverVersion,
 			FailoverNotificationVersion: failoverNotificationVersion,
@@ -610,6 +620,7 @@ func (d *handlerImpl) DeprecateDomain(
 		Info:                        getResponse.Info,
 		Config:                      getResponse.Config,
 		ReplicationConfig:           getResponse.ReplicationConfig,
+		IsGlobalDomain:              isGlobalDomain,
 		ConfigVersion:               getResponse.ConfigVersion,
 		FailoverVersion:             getResponse.FailoverVersion,
 		FailoverNotificationVersion: getResponse.FailoverNotificationVersion,
@@ -646,6 +657,103 @@ func (d *handlerImpl) DeprecateDomain(
 	return nil
 }
 
+// PromoteDomain converts a local domain into a global domain in place
+func (d *handlerImpl) PromoteDomain(
+	ctx context.Context,
+	promoteRequest *types.PromoteDomainRequest,
+) (*types.UpdateDomainResponse, error) {
+
+	if !d.clusterMetadata.IsGlobalDomainEnabled() {
+		return nil, errGlobalDomainNotEnabled
+	}
+	if !d.clusterMetadata.IsPrimaryCluster() {
+		return nil, errNotPrimaryCluster
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 domain table
+	metadata, err := d.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: promoteRequest.GetDomain()})
+	if err != nil {
+		return nil, err
+	}
+	if getResponse.IsGlobalDomain {
+		return nil, errDomainAlreadyGlobal
+	}
+
+	info := getResponse.Info
+	config := getResponse.Config
+	activeClusterName := getResponse.ReplicationConfig.ActiveClusterName
+	if activeClusterName == "" {
+		activeClusterName = d.clusterMetadata.GetCurrentClusterName()
+	}
+	clusters := []*persistence.ClusterReplicationConfig{}
+	for _, clusterConfig := range promoteRequest.GetClusters() {
+		clusters = append(clusters, &persistence.ClusterReplicationConfig{ClusterName: clusterConfig.GetClusterName()})
+	}
+	replicationConfig := &persistence.DomainReplicationConfig{
+		ActiveClusterName: activeClusterName,
+		Clusters:          cluster.GetOrUseDefaultClusters(activeClusterName, clusters),
+	}
+	if err := d.domainAttrValidator.validateDomainReplicationConfigForGlobalDomain(
+		replicationConfig,
+	); err != nil {
+		return nil, err
+	}
+
+	// the failover notification version is left untouched so that the promotion
+	// is not mistaken for a failover by the domain cache callbacks
+	configVersion := getResponse.ConfigVersion + 1
+	failoverVersion := d.clusterMetadata.GetNextFailoverVersion(activeClusterName, 0)
+	updateReq := &persistence.UpdateDomainRequest{
+		Info:                        info,
+		Config:                      config,
+		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              true,
+		ConfigVersion:               configVersion,
+		FailoverVersion:             failoverVersion,
+		FailoverNotificationVersion: getResponse.FailoverNotificationVersion,
+		PreviousFailoverVersion:     common.InitialPreviousFailoverVersion,
+		LastUpdatedTime:             d.timeSource.Now().UnixNano(),
+		NotificationVersion:         notificationVersion,
+	}
+	if err := d.domainManager.UpdateDomain(ctx, updateReq); err != nil {
+		return nil, err
+	}
+
+	// the promotion is replicated as an update, remote clusters without the domain create it
+	// and remote clusters with a different domain of the same name reject it as a collision
+	if err := d.domainReplicator.HandleTransmissionTask(
+		ctx,
+		types.DomainOperationUpdate,
+		info,
+		config,
+		replicationConfig,
+		configVersion,
+		failoverVersion,
+		common.InitialPreviousFailoverVersion,
+		true,
+	); err != nil {
+		return nil, err
+	}
+
+	response := &types.UpdateDomainResponse{
+		IsGlobalDomain:  true,
+		FailoverVersion: failoverVersion,
+	}
+	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = d.createResponse(info, config, replicationConfig)
+
+	d.logger.Info("Promote domain succeeded",
+		tag.WorkflowDomainName(info.Name),
+		tag.WorkflowDomainID(info.ID),
+	)
+	return response, nil
+}
+
 func (d *handlerImpl) createResponse(
 	info *persistence.DomainInfo,
 	config *persistence.DomainConfig,
@@ -484,6 +484,16 @@ func (s *domainHandlerGlobalDomainDisabledSuite) TestDeprecateGetDomain() {
 	assertDomainEqual(s.Suite, getResp, expectedResp)
 }
 
+func (s *domainHandlerGlobalDomainDisabledSuite) TestPromoteDomain() {
+	domainName := s.getRandomDomainName()
+	s.setupLocalDomain(domainName)
+
+	_, err := s.handler.PromoteDomain(context.Background(), &types.PromoteDomainRequest{
+		Domain: domainName,
+	})
+	s.Equal(errGlobalDomainNotEnabled, err)
+}
+
 func (s *domainHandlerGlobalDomainDisabledSuite) getRandomDomainName() string {
 	return "domain" + uuid.New()
 }
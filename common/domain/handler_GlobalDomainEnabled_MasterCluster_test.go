@@ -873,6 +873,43 @@ func (s *domainHandlerGlobalDomainEnabledPrimaryClusterSuite) TestUpdateGetDomai
 	)
 }
 
+func (s *domainHandlerGlobalDomainEnabledPrimaryClusterSuite) TestUpdateDomain_ActiveActive_Failover() {
+	domainName := s.getRandomDomainName()
+	prevActiveClusterName := ""
+	nextActiveClusterName := s.ClusterMetadata.GetCurrentClusterName()
+	clusters := []*types.ClusterReplicationConfiguration{}
+	for clusterName, info := range s.ClusterMetadata.GetAllClusterInfo() {
+		if !info.Enabled {
+			continue
+		}
+		if clusterName != nextActiveClusterName {
+			prevActiveClusterName = clusterName
+		}
+		clusters = append(clusters, &types.ClusterReplicationConfiguration{
+			ClusterName: clusterName,
+		})
+	}
+	s.True(len(prevActiveClusterName) > 0)
+
+	s.mockProducer.On("Publish", mock.Anything, mock.Anything).Return(nil).Once()
+
+	err := s.handler.RegisterDomain(context.Background(), &types.RegisterDomainRequest{
+		Name:                                   domainName,
+		WorkflowExecutionRetentionPeriodInDays: 1,
+		Clusters:                               clusters,
+		ActiveClusterName:                      prevActiveClusterName,
+		Data:                                   map[string]string{common.DomainDataKeyForActiveActive: "true"},
+		IsGlobalDomain:                         true,
+	})
+	s.Nil(err)
+
+	_, err = s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name:              domainName,
+		ActiveClusterName: common.StringPtr(nextActiveClusterName),
+	})
+	s.Equal(errActiveActiveDomainFailover, err)
+}
+
 func (s *domainHandlerGlobalDomainEnabledPrimaryClusterSuite) TestUpdateDomain_CoolDown() {
 	domainConfig := Config{
 		MinRetentionDays:  dc.GetIntPropertyFn(s.minRetentionDays),
@@ -968,6 +1005,56 @@ func (s *domainHandlerGlobalDomainEnabledPrimaryClusterSuite) TestDeprecateGetDo
 	assertDomainEqual(s.Suite, getResp, expectedResp)
 }
 
+func (s *domainHandlerGlobalDomainEnabledPrimaryClusterSuite) TestPromoteGetDomain_LocalDomain() {
+	domainName := s.getRandomDomainName()
+	domain := s.setupLocalDomain(domainName)
+
+	clusters := []*types.ClusterReplicationConfiguration{}
+	for clusterName, info := range s.ClusterMetadata.GetAllClusterInfo() {
+		if !info.Enabled {
+			continue
+		}
+		clusters = append(clusters, &types.ClusterReplicationConfiguration{
+			ClusterName: clusterName,
+		})
+	}
+	s.True(len(clusters) > 1)
+
+	s.mockProducer.On("Publish", mock.Anything, mock.MatchedBy(func(task *types.ReplicationTask) bool {
+		return task.DomainTaskAttributes.GetDomainOperation() == types.DomainOperationUpdate
+	})).Return(nil).Once()
+
+	resp, err := s.handler.PromoteDomain(context.Background(), &types.PromoteDomainRequest{
+		Domain:   domainName,
+		Clusters: clusters,
+	})
+	s.Nil(err)
+	s.True(resp.GetIsGlobalDomain())
+	s.Equal(s.ClusterMetadata.GetNextFailoverVersion(s.ClusterMetadata.GetCurrentClusterName(), 0), resp.GetFailoverVersion())
+	s.Equal(s.ClusterMetadata.GetCurrentClusterName(), resp.ReplicationConfiguration.GetActiveClusterName())
+	s.ElementsMatch(clusters, resp.ReplicationConfiguration.GetClusters())
+
+	getResp, err := s.handler.DescribeDomain(context.Background(), &types.DescribeDomainRequest{
+		Name: common.StringPtr(domainName),
+	})
+	s.Nil(err)
+	s.True(getResp.GetIsGlobalDomain())
+	s.Equal(resp.GetFailoverVersion(), getResp.GetFailoverVersion())
+	s.Equal(domain.DomainInfo, getResp.DomainInfo)
+	s.Equal(domain.Configuration, getResp.Configuration)
+	s.ElementsMatch(clusters, getResp.ReplicationConfiguration.GetClusters())
+}
+
+func (s *domainHandlerGlobalDomainEnabledPrimaryClusterSuite) TestPromoteDomain_GlobalDomain() {
+	domainName := s.getRandomDomainName()
+	s.setupGlobalDomain(domainName)
+
+	_, err := s.handler.PromoteDomain(context.Background(), &types.PromoteDomainRequest{
+		Domain: domainName,
+	})
+	s.Equal(errDomainAlreadyGlobal, err)
+}
+
 func (s *domainHandlerGlobalDomainEnabledPrimaryClusterSuite) getRandomDomainName() string {
 	return "domain" + uuid.New()
 }
@@ -102,6 +102,21 @@ func (mr *MockHandlerMockRecorder) ListDomains(ctx, listRequest interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomains", reflect.TypeOf((*MockHandler)(nil).ListDomains), ctx, listRequest)
 }
 
+// PromoteDomain mocks base method
+func (m *MockHandler) PromoteDomain(ctx context.Context, promoteRequest *types.PromoteDomainRequest) (*types.UpdateDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteDomain", ctx, promoteRequest)
+	ret0, _ := ret[0].(*types.UpdateDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteDomain indicates an expected call of PromoteDomain
+func (mr *MockHandlerMockRecorder) PromoteDomain(ctx, promoteRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteDomain", reflect.TypeOf((*MockHandler)(nil).PromoteDomain), ctx, promoteRequest)
+}
+
 // RegisterDomain mocks base method
 func (m *MockHandler) RegisterDomain(ctx context.Context, registerRequest *types.RegisterDomainRequest) error {
 	m.ctrl.T.Helper()
@@ -215,12 +215,16 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainUpdateReplicationTask(ct
 		}
 		return err
 	}
+	if resp.Info.ID != task.GetID() {
+		return ErrNameUUIDCollision
+	}
 
 	recordUpdated := false
 	request := &persistence.UpdateDomainRequest{
 		Info:                        resp.Info,
 		Config:                      resp.Config,
 		ReplicationConfig:           resp.ReplicationConfig,
+		IsGlobalDomain:              resp.IsGlobalDomain,
 		ConfigVersion:               resp.ConfigVersion,
 		FailoverVersion:             resp.FailoverVersion,
 		FailoverNotificationVersion: resp.FailoverNotificationVersion,
@@ -253,6 +257,11 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainUpdateReplicationTask(ct
 		request.ReplicationConfig.Clusters = h.convertClusterReplicationConfigFromThrift(task.ReplicationConfig.Clusters)
 		request.ConfigVersion = task.GetConfigVersion()
 	}
+	if !resp.IsGlobalDomain {
+		// the domain was promoted to global, local domain will not be replicated
+		recordUpdated = true
+		request.IsGlobalDomain = true
+	}
 	if resp.FailoverVersion < task.GetFailoverVersion() {
 		recordUpdated = true
 		request.ReplicationConfig.ActiveClusterName = task.ReplicationConfig.GetActiveClusterName()
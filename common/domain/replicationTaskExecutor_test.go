@@ -863,3 +863,116 @@ func (s *domainReplicationTaskExecutorSuite) TestExecute_UpdateDomainTask_NoUpda
 	s.Equal(int64(0), resp.FailoverNotificationVersion)
 	s.Equal(notificationVersion, resp.NotificationVersion)
 }
+
+func (s *domainReplicationTaskExecutorSuite) TestExecute_UpdateDomainTask_PromotedDomain() {
+	operation := types.DomainOperationUpdate
+	id := uuid.New()
+	name := "some random domain test name " + id
+	status := types.DomainStatusRegistered
+	clusterActive := "some random active cluster name"
+	clusterStandby := "some random standby cluster name"
+	configVersion := int64(1)
+	failoverVersion := int64(59)
+	clusters := []*types.ClusterReplicationConfiguration{
+		{
+			ClusterName: clusterActive,
+		},
+		{
+			ClusterName: clusterStandby,
+		},
+	}
+
+	_, err := s.DomainManager.CreateDomain(context.Background(), &persistence.CreateDomainRequest{
+		Info: &persistence.DomainInfo{
+			ID:     id,
+			Name:   name,
+			Status: persistence.DomainStatusRegistered,
+		},
+		Config: &persistence.DomainConfig{Retention: 1},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: clusterActive,
+			Clusters:          []*persistence.ClusterReplicationConfig{{ClusterName: clusterActive}},
+		},
+		IsGlobalDomain:  false,
+		ConfigVersion:   0,
+		FailoverVersion: common.EmptyVersion,
+	})
+	s.Nil(err)
+
+	updateTask := &types.DomainTaskAttributes{
+		DomainOperation: &operation,
+		ID:              id,
+		Info: &types.DomainInfo{
+			Name:   name,
+			Status: &status,
+		},
+		Config: &types.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: 1,
+		},
+		ReplicationConfig: &types.DomainReplicationConfiguration{
+			ActiveClusterName: clusterActive,
+			Clusters:          clusters,
+		},
+		ConfigVersion:           configVersion,
+		FailoverVersion:         failoverVersion,
+		PreviousFailoverVersion: common.InitialPreviousFailoverVersion,
+	}
+	err = s.domainReplicator.Execute(updateTask)
+	s.Nil(err)
+
+	resp, err := s.DomainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: name})
+	s.Nil(err)
+	s.True(resp.IsGlobalDomain)
+	s.Equal(id, resp.Info.ID)
+	s.Equal(clusterActive, resp.ReplicationConfig.ActiveClusterName)
+	s.Equal(s.domainReplicator.convertClusterReplicationConfigFromThrift(clusters), resp.ReplicationConfig.Clusters)
+	s.Equal(configVersion, resp.ConfigVersion)
+	s.Equal(failoverVersion, resp.FailoverVersion)
+}
+
+func (s *domainReplicationTaskExecutorSuite) TestExecute_UpdateDomainTask_NameUUIDCollision() {
+	operation := types.DomainOperationUpdate
+	name := "some random domain test name " + uuid.New()
+	status := types.DomainStatusRegistered
+	clusterActive := "some random active cluster name"
+
+	_, err := s.DomainManager.CreateDomain(context.Background(), &persistence.CreateDomainRequest{
+		Info: &persistence.DomainInfo{
+			ID:     uuid.New(),
+			Name:   name,
+			Status: persistence.DomainStatusRegistered,
+		},
+		Config: &persistence.DomainConfig{Retention: 1},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: clusterActive,
+			Clusters:          []*persistence.ClusterReplicationConfig{{ClusterName: clusterActive}},
+		},
+		IsGlobalDomain:  false,
+		FailoverVersion: common.EmptyVersion,
+	})
+	s.Nil(err)
+
+	updateTask := &types.DomainTaskAttributes{
+		DomainOperation: &operation,
+		ID:              uuid.New(),
+		Info: &types.DomainInfo{
+			Name:   name,
+			Status: &status,
+		},
+		Config: &types.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: 1,
+		},
+		ReplicationConfig: &types.DomainReplicationConfiguration{
+			ActiveClusterName: clusterActive,
+			Clusters:          []*types.ClusterReplicationConfiguration{{ClusterName: clusterActive}},
+		},
+		ConfigVersion:   1,
+		FailoverVersion: 59,
+	}
+	err = s.domainReplicator.Execute(updateTask)
+	s.Equal(ErrNameUUIDCollision, err)
+
+	resp, err := s.DomainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: name})
+	s.Nil(err)
+	s.False(resp.IsGlobalDomain)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domainusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	cclient "go.uber.org/cadence/client"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// WorkflowID is the ID of the workflow which aggregates the usage of all domains
+	WorkflowID = "cadence-domain-usage-aggregator"
+	// QueryType returns the latest Snapshot of the aggregator workflow
+	QueryType = "usage"
+
+	queryTimeout = 10 * time.Second
+)
+
+type (
+	// Snapshot is the per-domain usage of the cluster at a point in time, returned by QueryType
+	Snapshot struct {
+		UsagesByDomainID map[string]*types.DomainUsage
+		// LastUpdateTime is the unix nano timestamp of the aggregation, zero if no aggregation is done yet
+		LastUpdateTime int64
+	}
+
+	// Client returns the domain usage aggregated by the aggregator workflow
+	Client interface {
+		DescribeDomainUsage(ctx context.Context, domainID string) (*types.DescribeDomainUsageResponse, error)
+	}
+
+	clientImpl struct {
+		sdkClient       cclient.Client
+		timeSource      clock.TimeSource
+		refreshInterval dynamicconfig.DurationPropertyFn
+		refreshGroup    singleflight.Group
+
+		sync.RWMutex
+		snapshot    *Snapshot
+		err         error
+		refreshedAt time.Time
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// NewClient creates a Client which queries the aggregator workflow and caches
+// the result, including failures, for refreshInterval
+func NewClient(
+	svcClient workflowserviceclient.Interface,
+	timeSource clock.TimeSource,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+) Client {
+	return &clientImpl{
+		sdkClient:       cclient.NewClient(svcClient, common.SystemLocalDomainName, &cclient.Options{}),
+		timeSource:      timeSource,
+		refreshInterval: refreshInterval,
+	}
+}
+
+// DescribeDomainUsage returns the latest aggregated usage of a domain,
+// a domain without any workflow gets an empty usage
+func (c *clientImpl) DescribeDomainUsage(ctx context.Context, domainID string) (*types.DescribeDomainUsageResponse, error) {
+	snapshot, err := c.getSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.LastUpdateTime == 0 {
+		return nil, &types.EntityNotExistsError{Message: "Domain usage is not aggregated yet."}
+	}
+	usage, ok := snapshot.UsagesByDomainID[domainID]
+	if !ok {
+		usage = &types.DomainUsage{}
+	}
+	return &types.DescribeDomainUsageResponse{
+		Usage:          usage,
+		LastUpdateTime: common.Int64Ptr(snapshot.LastUpdateTime),
+	}, nil
+}
+
+func (c *clientImpl) getSnapshot(ctx context.Context) (*Snapshot, error) {
+	c.RLock()
+	snapshot, err, refreshedAt := c.snapshot, c.err, c.refreshedAt
+	c.RUnlock()
+	if !refreshedAt.IsZero() && c.timeSource.Now().Sub(refreshedAt) < c.refreshInterval() {
+		return snapshot, err
+	}
+
+	// concurrent callers share a single query, which doesn't depend on the context of any of them,
+	// so that a cancelled caller doesn't fail the others or get its cancellation cached
+	result := c.refreshGroup.DoChan("", func() (interface{}, error) {
+		return c.refresh()
+	})
+	select {
+	case r := <-result:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		return r.Val.(*Snapshot), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *clientImpl) refresh() (*Snapshot, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	snapshot, err := c.query(ctx)
+	c.Lock()
+	defer c.Unlock()
+	c.snapshot, c.err, c.refreshedAt = snapshot, err, c.timeSource.Now()
+	return snapshot, err
+}
+
+func (c *clientImpl) query(ctx context.Context) (*Snapshot, error) {
+	value, err := c.sdkClient.QueryWorkflow(ctx, WorkflowID, "", QueryType)
+	if err != nil {
+		return nil, err
+	}
+	var snapshot Snapshot
+	if err := value.Get(&snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domainusage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/cadence/mocks"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestClient_CachesResult(t *testing.T) {
+	sdkClient := &mocks.Client{}
+	defer sdkClient.AssertExpectations(t)
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(0, 0))
+	client := &clientImpl{
+		sdkClient:       sdkClient,
+		timeSource:      timeSource,
+		refreshInterval: dynamicconfig.GetDurationPropertyFn(time.Minute),
+	}
+	ctx := context.Background()
+
+	// failures are cached for the refresh interval as well
+	sdkClient.On("QueryWorkflow", mock.Anything, WorkflowID, "", QueryType).Return(nil, errors.New("query failed")).Once()
+	_, err := client.DescribeDomainUsage(ctx, "domain-id")
+	require.Error(t, err)
+	_, err = client.DescribeDomainUsage(ctx, "domain-id")
+	require.Error(t, err)
+
+	value := &mocks.Value{}
+	value.On("Get", mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(0).(*Snapshot) = Snapshot{
+			UsagesByDomainID: map[string]*types.DomainUsage{"domain-id": {OpenWorkflowCount: 3}},
+			LastUpdateTime:   100,
+		}
+	}).Return(nil).Once()
+	sdkClient.On("QueryWorkflow", mock.Anything, WorkflowID, "", QueryType).Return(value, nil).Once()
+	timeSource.Update(time.Unix(0, 0).Add(time.Minute))
+	for i := 0; i < 2; i++ {
+		resp, err := client.DescribeDomainUsage(ctx, "domain-id")
+		require.NoError(t, err)
+		require.Equal(t, int64(3), resp.Usage.GetOpenWorkflowCount())
+		resp, err = client.DescribeDomainUsage(ctx, "other-domain-id")
+		require.NoError(t, err)
+		require.Equal(t, &types.DomainUsage{}, resp.Usage)
+	}
+}
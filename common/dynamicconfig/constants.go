@@ -166,6 +166,18 @@ const (
 	// Default value: 240
 	// Allowed filters: DomainName
 	MaxDecisionStartToCloseSeconds
+	// MinWorkflowRetentionDays is the minimum retention a workflow may request to override its domain retention
+	// KeyName: system.minWorkflowRetentionDays
+	// Value type: Int
+	// Default value: 1
+	// Allowed filters: DomainName
+	MinWorkflowRetentionDays
+	// MaxWorkflowRetentionDays is the maximum retention a workflow may request to override its domain retention
+	// KeyName: system.maxWorkflowRetentionDays
+	// Value type: Int
+	// Default value: 30
+	// Allowed filters: DomainName
+	MaxWorkflowRetentionDays
 	// DisallowQuery is the key to disallow query for a domain
 	// KeyName: system.disallowQuery
 	// Value type: Bool
@@ -349,6 +361,12 @@ const (
 	// Default value: 10000
 	// Allowed filters: N/A
 	FrontendESIndexMaxResultWindow
+	// FrontendESCountMaxGroups is the max number of groups a GROUP BY CountWorkflowExecutions query can return
+	// KeyName: frontend.esCountMaxGroups
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: N/A
+	FrontendESCountMaxGroups
 	// FrontendHistoryMaxPageSize is default max size for GetWorkflowExecutionHistory in one page
 	// KeyName: frontend.historyMaxPageSize
 	// Value type: Int
@@ -373,6 +391,24 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	FrontendGlobalDomainRPS
+	// FrontendMaxOpenWorkflowsPerDomain is the max number of open workflows of a domain, new workflows are rejected beyond it
+	// KeyName: frontend.maxOpenWorkflowsPerDomain
+	// Value type: Int
+	// Default value: 0 (no limit)
+	// Allowed filters: DomainName
+	FrontendMaxOpenWorkflowsPerDomain
+	// FrontendMaxHistorySizePerDomain is the max total history size in bytes of a domain, new workflows are rejected beyond it
+	// KeyName: frontend.maxHistorySizePerDomain
+	// Value type: Int
+	// Default value: 0 (no limit)
+	// Allowed filters: DomainName
+	FrontendMaxHistorySizePerDomain
+	// FrontendDomainUsageRefreshInterval is how long the aggregated domain usage is cached by frontend
+	// KeyName: frontend.domainUsageRefreshInterval
+	// Value type: Duration
+	// Default value: 1m (1*time.Minute)
+	// Allowed filters: N/A
+	FrontendDomainUsageRefreshInterval
 	// FrontendDecisionResultCountLimit is max number of decisions per RespondDecisionTaskCompleted request
 	// KeyName: frontend.decisionResultCountLimit
 	// Value type: Int
@@ -421,6 +457,18 @@ const (
 	// Default value: the default attributes of this release version, see definition.GetDefaultIndexedKeys()
 	// Allowed filters: N/A
 	ValidSearchAttributes
+	// SearchAttributeAliases maps the old name of a renamed search attribute to its current name, so that existing queries and workflows using the old name keep working
+	// KeyName: frontend.searchAttributeAliases
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: N/A
+	SearchAttributeAliases
+	// RemovedSearchAttributes contains the search attributes removed from the whitelist, so that the indexer drops them from documents without reporting corrupted data
+	// KeyName: frontend.removedSearchAttributes
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: N/A
+	RemovedSearchAttributes
 	// SendRawWorkflowHistory is whether to enable raw history retrieving
 	// KeyName: frontend.sendRawWorkflowHistory
 	// Value type: Bool
@@ -1368,6 +1416,18 @@ const (
 	// Default value: 5m (5*time.Minute)
 	// Allowed filters: N/A
 	ShardSyncMinInterval
+	// ShardLoadReportWindow is the time window over which per-shard request rate and lock contention are measured
+	// KeyName: history.shardLoadReportWindow
+	// Value type: Duration
+	// Default value: 1m (1*time.Minute)
+	// Allowed filters: N/A
+	ShardLoadReportWindow
+	// ShardLoadReportTopN is the number of top domains and workflows reported for each shard in DescribeHistoryHost
+	// KeyName: history.shardLoadReportTopN
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: N/A
+	ShardLoadReportTopN
 	// DefaultEventEncoding is the encoding type for history events
 	// KeyName: history.defaultEventEncoding
 	// Value type: String
@@ -1582,6 +1642,24 @@ const (
 	// Default value: 60s (60 * time.Second)
 	// Allowed filters: N/A
 	ReplicationTaskFetcherServiceBusyWait
+	// ReplicationTaskFetcherStreamingEnabled is whether fetcher receives replication tasks pushed over a stream instead of polling
+	// KeyName: history.ReplicationTaskFetcherStreamingEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	ReplicationTaskFetcherStreamingEnabled
+	// ReplicationTaskFetcherStreamRetryInterval is how long fetcher falls back to polling before re-opening a failed stream
+	// KeyName: history.ReplicationTaskFetcherStreamRetryInterval
+	// Value type: Duration
+	// Default value: 1m (1 * time.Minute)
+	// Allowed filters: N/A
+	ReplicationTaskFetcherStreamRetryInterval
+	// ReplicationTaskLongPollExpirationInterval is the max time a streamed replication task request waits for new tasks
+	// KeyName: history.ReplicationTaskLongPollExpirationInterval
+	// Value type: Duration
+	// Default value: 10s (10 * time.Second)
+	// Allowed filters: N/A
+	ReplicationTaskLongPollExpirationInterval
 	// ReplicationTaskProcessorErrorRetryWait is the initial retry wait when we see errors in applying replication tasks
 	// KeyName: history.ReplicationTaskProcessorErrorRetryWait
 	// Value type: Duration
@@ -1660,6 +1738,69 @@ const (
 	// Default value: 5
 	// Allowed filters: N/A
 	ReplicationTaskProcessorShardQPS
+	// ReplicationDLQRedriveEnabled is whether replication tasks in DLQ are retried automatically
+	// KeyName: history.ReplicationDLQRedriveEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	ReplicationDLQRedriveEnabled
+	// ReplicationDLQRedriveMaxAttempts is the max redrive attempts before a DLQ replication task is marked as dead
+	// KeyName: history.ReplicationDLQRedriveMaxAttempts
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName
+	ReplicationDLQRedriveMaxAttempts
+	// ReplicationDLQRedriveInitialInterval is the initial backoff between redrive attempts of a DLQ replication task
+	// KeyName: history.ReplicationDLQRedriveInitialInterval
+	// Value type: Duration
+	// Default value: 1m (1* time.Minute)
+	// Allowed filters: DomainName
+	ReplicationDLQRedriveInitialInterval
+	// ReplicationDLQRedriveMaxInterval is the max backoff between redrive attempts of a DLQ replication task
+	// KeyName: history.ReplicationDLQRedriveMaxInterval
+	// Value type: Duration
+	// Default value: 1h (1* time.Hour)
+	// Allowed filters: DomainName
+	ReplicationDLQRedriveMaxInterval
+	// ReplicationDLQRedriveScanInterval is how frequently the replication DLQ of a shard is scanned for redrive and stats
+	// KeyName: history.ReplicationDLQRedriveScanInterval
+	// Value type: Duration
+	// Default value: 1m (1* time.Minute)
+	// Allowed filters: N/A
+	ReplicationDLQRedriveScanInterval
+	// ReplicationDLQRedriveBatchSize is the page size used when scanning the replication DLQ
+	// KeyName: history.ReplicationDLQRedriveBatchSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	ReplicationDLQRedriveBatchSize
+	// ActiveActiveClusterSearchAttribute is the search attribute whose value picks the cluster a new workflow
+	// of an active-active domain is active in, workflows without it are active in the cluster that started them
+	// KeyName: history.activeActiveClusterSearchAttribute
+	// Value type: String
+	// Default value: "" (disabled)
+	// Allowed filters: DomainName
+	ActiveActiveClusterSearchAttribute
+	// EnableWorkflowLifecycleEvents is whether workflow started, closed, signaled and reset events of a domain
+	// are published to the workflow lifecycle events kafka topic
+	// KeyName: history.enableWorkflowLifecycleEvents
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableWorkflowLifecycleEvents
+	// WorkflowLifecycleEventsIncludeSearchAttributes is whether search attribute updates are also published
+	// to the workflow lifecycle events kafka topic
+	// KeyName: history.workflowLifecycleEventsIncludeSearchAttributes
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	WorkflowLifecycleEventsIncludeSearchAttributes
+	// PayloadEncryptionKeyID is the ID of the keyring key used to encrypt the payloads of a domain before they are persisted
+	// KeyName: history.payloadEncryptionKeyID
+	// Value type: String
+	// Default value: "" (payloads are not encrypted)
+	// Allowed filters: DomainName
+	PayloadEncryptionKeyID
 	// ReplicationTaskGenerationQPS is the wait time between each replication task generation qps
 	// KeyName: history.ReplicationTaskGenerationQPS
 	// Value type: Float64
@@ -1838,6 +1979,18 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	ConcreteExecutionsScannerInvariantCollectionHistory
+	// ConcreteExecutionsScannerInvariantCollectionVisibility is indicates if visibility invariant checks should be run
+	// KeyName: worker.executionsScannerInvariantCollectionVisibility
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	ConcreteExecutionsScannerInvariantCollectionVisibility
+	// ConcreteExecutionsScannerInvariantCollectionReplay is indicates if history replay invariant checks should be run
+	// KeyName: worker.executionsScannerInvariantCollectionReplay
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	ConcreteExecutionsScannerInvariantCollectionReplay
 	// CurrentExecutionsScannerEnabled is indicates if current executions scanner should be started as part of worker.Scanner
 	// KeyName: worker.currentExecutionsScannerEnabled
 	// Value type: Bool
@@ -1910,6 +2063,25 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	EnableFailoverManager
+	// EnableShardMigration indicates if the shard migration worker is enabled
+	// KeyName: system.enableShardMigration
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableShardMigration
+	// EnableReindexer indicates if the reindexer worker, which rebuilds visibility records of a domain, is enabled
+	// KeyName: system.enableReindexer
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableReindexer
+	// EnableDomainUsageAggregator indicates if the worker which aggregates per-domain usage counters of all shards is enabled,
+	// frontend only checks the domain usage quota when it is
+	// KeyName: system.enableDomainUsageAggregator
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableDomainUsageAggregator
 	// EnableWorkflowShadower indicates if workflow shadower is enabled
 	// KeyName: system.enableWorkflowShadower
 	// Value type: Bool
@@ -2082,6 +2254,12 @@ const (
 	// Default value: "" => means no limitation
 	ESAnalyzerLimitToDomains
 
+	// DomainUsageAggregationInterval is the interval between two aggregations of the per-domain usage counters
+	// KeyName: worker.domainUsageAggregationInterval
+	// Value type: Duration
+	// Default value: 10m (10*time.Minute)
+	DomainUsageAggregationInterval
+
 	// LastKeyForTest must be the last one in this const group for testing purpose
 	LastKeyForTest
 )
@@ -2122,11 +2300,16 @@ var Keys = map[Key]string{
 	MaxRetentionDays:                    "system.maxRetentionDays",
 	MinRetentionDays:                    "system.minRetentionDays",
 	MaxDecisionStartToCloseSeconds:      "system.maxDecisionStartToCloseSeconds",
+	MinWorkflowRetentionDays:            "system.minWorkflowRetentionDays",
+	MaxWorkflowRetentionDays:            "system.maxWorkflowRetentionDays",
 	DisallowQuery:                       "system.disallowQuery",
 	EnableBatcher:                       "worker.enableBatcher",
 	EnableParentClosePolicyWorker:       "system.enableParentClosePolicyWorker",
 	EnableESAnalyzer:                    "system.enableESAnalyzer",
 	EnableFailoverManager:               "system.enableFailoverManager",
+	EnableShardMigration:                "system.enableShardMigration",
+	EnableReindexer:                     "system.enableReindexer",
+	EnableDomainUsageAggregator:         "system.enableDomainUsageAggregator",
 	EnableWorkflowShadower:              "system.enableWorkflowShadower",
 	EnableStickyQuery:                   "system.enableStickyQuery",
 	EnableDebugMode:                     "system.enableDebugMode",
@@ -2168,17 +2351,23 @@ var Keys = map[Key]string{
 	FrontendMaxBadBinaries:                      "frontend.maxBadBinaries",
 	FrontendFailoverCoolDown:                    "frontend.failoverCoolDown",
 	FrontendESIndexMaxResultWindow:              "frontend.esIndexMaxResultWindow",
+	FrontendESCountMaxGroups:                    "frontend.esCountMaxGroups",
 	FrontendHistoryMaxPageSize:                  "frontend.historyMaxPageSize",
 	FrontendRPS:                                 "frontend.rps",
 	FrontendMaxDomainRPSPerInstance:             "frontend.domainrps",
 	FrontendDecisionResultCountLimit:            "frontend.decisionResultCountLimit",
 	FrontendGlobalDomainRPS:                     "frontend.globalDomainrps",
+	FrontendMaxOpenWorkflowsPerDomain:           "frontend.maxOpenWorkflowsPerDomain",
+	FrontendMaxHistorySizePerDomain:             "frontend.maxHistorySizePerDomain",
+	FrontendDomainUsageRefreshInterval:          "frontend.domainUsageRefreshInterval",
 	FrontendHistoryMgrNumConns:                  "frontend.historyMgrNumConns",
 	FrontendShutdownDrainDuration:               "frontend.shutdownDrainDuration",
 	DisableListVisibilityByFilter:               "frontend.disableListVisibilityByFilter",
 	FrontendThrottledLogRPS:                     "frontend.throttledLogRPS",
 	EnableClientVersionCheck:                    "frontend.enableClientVersionCheck",
 	ValidSearchAttributes:                       "frontend.validSearchAttributes",
+	SearchAttributeAliases:                      "frontend.searchAttributeAliases",
+	RemovedSearchAttributes:                     "frontend.removedSearchAttributes",
 	SendRawWorkflowHistory:                      "frontend.sendRawWorkflowHistory",
 	SearchAttributesNumberOfKeysLimit:           "frontend.searchAttributesNumberOfKeysLimit",
 	SearchAttributesSizeOfValueLimit:            "frontend.searchAttributesSizeOfValueLimit",
@@ -2344,6 +2533,8 @@ var Keys = map[Key]string{
 	MaximumSignalsPerExecution:                         "history.maximumSignalsPerExecution",
 	ShardUpdateMinInterval:                             "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                               "history.shardSyncMinInterval",
+	ShardLoadReportWindow:                              "history.shardLoadReportWindow",
+	ShardLoadReportTopN:                                "history.shardLoadReportTopN",
 	DefaultEventEncoding:                               "history.defaultEventEncoding",
 	EnableAdminProtection:                              "history.enableAdminProtection",
 	AdminOperationToken:                                "history.adminOperationToken",
@@ -2365,6 +2556,9 @@ var Keys = map[Key]string{
 	ReplicationTaskFetcherTimerJitterCoefficient:       "history.ReplicationTaskFetcherTimerJitterCoefficient",
 	ReplicationTaskFetcherErrorRetryWait:               "history.ReplicationTaskFetcherErrorRetryWait",
 	ReplicationTaskFetcherServiceBusyWait:              "history.ReplicationTaskFetcherServiceBusyWait",
+	ReplicationTaskFetcherStreamingEnabled:             "history.ReplicationTaskFetcherStreamingEnabled",
+	ReplicationTaskFetcherStreamRetryInterval:          "history.ReplicationTaskFetcherStreamRetryInterval",
+	ReplicationTaskLongPollExpirationInterval:          "history.ReplicationTaskLongPollExpirationInterval",
 	ReplicationTaskProcessorErrorRetryWait:             "history.ReplicationTaskProcessorErrorRetryWait",
 	ReplicationTaskProcessorErrorRetryMaxAttempts:      "history.ReplicationTaskProcessorErrorRetryMaxAttempts",
 	ReplicationTaskProcessorErrorSecondRetryWait:       "history.ReplicationTaskProcessorErrorSecondRetryWait",
@@ -2378,6 +2572,16 @@ var Keys = map[Key]string{
 	ReplicationTaskProcessorStartWaitJitterCoefficient: "history.ReplicationTaskProcessorStartWaitJitterCoefficient",
 	ReplicationTaskProcessorHostQPS:                    "history.ReplicationTaskProcessorHostQPS",
 	ReplicationTaskProcessorShardQPS:                   "history.ReplicationTaskProcessorShardQPS",
+	ReplicationDLQRedriveEnabled:                       "history.ReplicationDLQRedriveEnabled",
+	ReplicationDLQRedriveMaxAttempts:                   "history.ReplicationDLQRedriveMaxAttempts",
+	ReplicationDLQRedriveInitialInterval:               "history.ReplicationDLQRedriveInitialInterval",
+	ReplicationDLQRedriveMaxInterval:                   "history.ReplicationDLQRedriveMaxInterval",
+	ReplicationDLQRedriveScanInterval:                  "history.ReplicationDLQRedriveScanInterval",
+	ReplicationDLQRedriveBatchSize:                     "history.ReplicationDLQRedriveBatchSize",
+	ActiveActiveClusterSearchAttribute:                 "history.activeActiveClusterSearchAttribute",
+	EnableWorkflowLifecycleEvents:                      "history.enableWorkflowLifecycleEvents",
+	WorkflowLifecycleEventsIncludeSearchAttributes:     "history.workflowLifecycleEventsIncludeSearchAttributes",
+	PayloadEncryptionKeyID:                             "history.payloadEncryptionKeyID",
 	EnableReplicationTaskGeneration:                    "history.enableReplicationTaskGeneration",
 	ReplicationTaskGenerationQPS:                       "history.ReplicationTaskGenerationQPS",
 	EnableConsistentQuery:                              "history.EnableConsistentQuery",
@@ -2422,6 +2626,8 @@ var Keys = map[Key]string{
 	ConcreteExecutionsScannerPersistencePageSize:             "worker.executionsScannerPersistencePageSize",
 	ConcreteExecutionsScannerInvariantCollectionHistory:      "worker.executionsScannerInvariantCollectionHistory",
 	ConcreteExecutionsScannerInvariantCollectionMutableState: "worker.executionsScannerInvariantCollectionMutableState",
+	ConcreteExecutionsScannerInvariantCollectionVisibility:   "worker.executionsScannerInvariantCollectionVisibility",
+	ConcreteExecutionsScannerInvariantCollectionReplay:       "worker.executionsScannerInvariantCollectionReplay",
 	CurrentExecutionsScannerEnabled:                          "worker.currentExecutionsScannerEnabled",
 	CurrentExecutionsScannerBlobstoreFlushThreshold:          "worker.currentExecutionsBlobstoreFlushThreshold",
 	CurrentExecutionsScannerActivityBatchSize:                "worker.currentExecutionsActivityBatchSize",
@@ -2463,6 +2669,8 @@ var Keys = map[Key]string{
 	ESAnalyzerMinNumWorkflowsForAvg: "worker.ESAnalyzerMinNumWorkflowsForAvg",
 	ESAnalyzerLimitToTypes:          "worker.ESAnalyzerLimitToTypes",
 	ESAnalyzerLimitToDomains:        "worker.ESAnalyzerLimitToDomains",
+
+	DomainUsageAggregationInterval: "worker.domainUsageAggregationInterval",
 }
 
 var KeyNames map[string]Key
@@ -35,26 +35,41 @@ import (
 
 // VisibilityQueryValidator for sql query validation
 type VisibilityQueryValidator struct {
-	validSearchAttributes dynamicconfig.MapPropertyFn
+	validSearchAttributes  dynamicconfig.MapPropertyFn
+	searchAttributeAliases dynamicconfig.MapPropertyFn
 }
 
 // NewQueryValidator create VisibilityQueryValidator
-func NewQueryValidator(validSearchAttributes dynamicconfig.MapPropertyFn) *VisibilityQueryValidator {
+func NewQueryValidator(
+	validSearchAttributes dynamicconfig.MapPropertyFn,
+	searchAttributeAliases dynamicconfig.MapPropertyFn,
+) *VisibilityQueryValidator {
 	return &VisibilityQueryValidator{
-		validSearchAttributes: validSearchAttributes,
+		validSearchAttributes:  validSearchAttributes,
+		searchAttributeAliases: searchAttributeAliases,
 	}
 }
 
 // ValidateQuery validates that search attributes in the query are legal.
 // Adds attr prefix for customized fields and returns modified query.
 func (qv *VisibilityQueryValidator) ValidateQuery(whereClause string) (string, error) {
+	return qv.validateQuery(whereClause, false)
+}
+
+// ValidateCountQuery is the same as ValidateQuery, but also accepts a GROUP BY clause
+// on search attributes, which is only meaningful when counting workflow executions.
+func (qv *VisibilityQueryValidator) ValidateCountQuery(whereClause string) (string, error) {
+	return qv.validateQuery(whereClause, true)
+}
+
+func (qv *VisibilityQueryValidator) validateQuery(whereClause string, allowGroupBy bool) (string, error) {
 	if len(whereClause) != 0 {
 		// Build a placeholder query that allows us to easily parse the contents of the where clause.
 		// IMPORTANT: This query is never executed, it is just used to parse and validate whereClause
 		var placeholderQuery string
 		whereClause := strings.TrimSpace(whereClause)
 		// #nosec
-		if common.IsJustOrderByClause(whereClause) { // just order by
+		if common.IsJustOrderByClause(whereClause) || common.IsJustGroupByClause(whereClause) { // just order by or group by
 			placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", whereClause)
 		} else {
 			placeholderQuery = fmt.Sprintf("SELECT * FROM dummy WHERE %s", whereClause)
@@ -78,6 +93,17 @@ func (qv *VisibilityQueryValidator) ValidateQuery(whereClause string) (string, e
 			}
 			sel.Where.Expr.Format(buf)
 		}
+		// validate group by
+		if len(sel.GroupBy) != 0 {
+			if !allowGroupBy {
+				return "", &types.BadRequestError{Message: "GROUP BY is only supported for counting workflow executions."}
+			}
+			err = qv.validateGroupByExpr(sel.GroupBy)
+			if err != nil {
+				return "", &types.BadRequestError{Message: err.Error()}
+			}
+			sel.GroupBy.Format(buf)
+		}
 		// validate order by
 		err = qv.validateOrderByExpr(sel.OrderBy)
 		if err != nil {
@@ -135,7 +161,7 @@ func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr)
 	if !ok {
 		return errors.New("invalid comparison expression")
 	}
-	colNameStr := colName.Name.String()
+	colNameStr := qv.resolveAlias(colName.Name.String())
 	if qv.isValidSearchAttributes(colNameStr) {
 		if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
 			comparisonExpr.Left = &sqlparser.ColName{
@@ -155,7 +181,7 @@ func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr) error
 	if !ok {
 		return errors.New("invalid range expression")
 	}
-	colNameStr := colName.Name.String()
+	colNameStr := qv.resolveAlias(colName.Name.String())
 	if qv.isValidSearchAttributes(colNameStr) {
 		if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
 			rangeCond.Left = &sqlparser.ColName{
@@ -175,7 +201,7 @@ func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderB
 		if !ok {
 			return errors.New("invalid order by expression")
 		}
-		colNameStr := colName.Name.String()
+		colNameStr := qv.resolveAlias(colName.Name.String())
 		if qv.isValidSearchAttributes(colNameStr) {
 			if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
 				orderByExpr.Expr = &sqlparser.ColName{
@@ -191,9 +217,39 @@ func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderB
 	return nil
 }
 
+func (qv *VisibilityQueryValidator) validateGroupByExpr(groupBy sqlparser.GroupBy) error {
+	for i, groupByExpr := range groupBy {
+		colName, ok := groupByExpr.(*sqlparser.ColName)
+		if !ok {
+			return errors.New("invalid group by expression")
+		}
+		colNameStr := qv.resolveAlias(colName.Name.String())
+		if qv.isValidSearchAttributes(colNameStr) {
+			if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
+				groupBy[i] = &sqlparser.ColName{
+					Metadata:  colName.Metadata,
+					Name:      sqlparser.NewColIdent(definition.Attr + "." + colNameStr),
+					Qualifier: colName.Qualifier,
+				}
+			}
+		} else {
+			return errors.New("invalid group by attribute")
+		}
+	}
+	return nil
+}
+
 // isValidSearchAttributes return true if key is registered
 func (qv *VisibilityQueryValidator) isValidSearchAttributes(key string) bool {
 	validAttr := qv.validSearchAttributes()
 	_, isValidKey := validAttr[key]
 	return isValidKey
 }
+
+// resolveAlias returns the current name of key if it is the old name of a renamed search attribute
+func (qv *VisibilityQueryValidator) resolveAlias(key string) string {
+	if qv.searchAttributeAliases == nil {
+		return key
+	}
+	return definition.ResolveIndexedKeyAlias(qv.searchAttributeAliases(), key)
+}
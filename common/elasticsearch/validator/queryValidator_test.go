@@ -111,6 +111,11 @@ func TestValidateQuery(t *testing.T) {
 			query: "order by 123",
 			err:   "BadRequestError{Message: invalid order by expression}",
 		},
+		{
+			msg:   "group by not allowed",
+			query: "WorkflowID = 'wid' group by WorkflowType",
+			err:   "BadRequestError{Message: GROUP BY is only supported for counting workflow executions.}",
+		},
 		{
 			msg:   "security SQL injection - with another statement",
 			query: "WorkflowID = 'wid'; SELECT * FROM important_table;",
@@ -131,7 +136,113 @@ func TestValidateQuery(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.msg, func(t *testing.T) {
 			validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
-			qv := NewQueryValidator(validSearchAttr)
+			qv := NewQueryValidator(validSearchAttr, dynamicconfig.GetMapPropertyFn(map[string]interface{}{}))
+			validated, err := qv.ValidateQuery(tt.query)
+			if err != nil {
+				assert.Equal(t, tt.err, err.Error())
+			} else {
+				assert.Equal(t, tt.validated, validated)
+			}
+		})
+	}
+}
+
+func TestValidateCountQuery(t *testing.T) {
+	tests := []struct {
+		msg       string
+		query     string
+		validated string
+		err       string
+	}{
+		{
+			msg:       "empty query",
+			query:     "",
+			validated: "",
+		},
+		{
+			msg:       "simple query",
+			query:     "WorkflowID = 'wid'",
+			validated: "WorkflowID = 'wid'",
+		},
+		{
+			msg:       "only group by",
+			query:     "group by WorkflowType, CloseStatus",
+			validated: " group by WorkflowType, CloseStatus",
+		},
+		{
+			msg:       "only group by search attribute",
+			query:     "GROUP BY CustomKeywordField",
+			validated: " group by `Attr.CustomKeywordField`",
+		},
+		{
+			msg:       "condition + group by",
+			query:     "CloseTime = missing group by WorkflowType",
+			validated: "CloseTime = missing group by WorkflowType",
+		},
+		{
+			msg:   "invalid group by attribute",
+			query: "group by InvalidField",
+			err:   "BadRequestError{Message: invalid group by attribute}",
+		},
+		{
+			msg:   "invalid group by expression",
+			query: "group by 123",
+			err:   "BadRequestError{Message: invalid group by expression}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+			qv := NewQueryValidator(validSearchAttr, dynamicconfig.GetMapPropertyFn(map[string]interface{}{}))
+			validated, err := qv.ValidateCountQuery(tt.query)
+			if err != nil {
+				assert.Equal(t, tt.err, err.Error())
+			} else {
+				assert.Equal(t, tt.validated, validated)
+			}
+		})
+	}
+}
+
+func TestValidateQuery_Alias(t *testing.T) {
+	tests := []struct {
+		msg       string
+		query     string
+		validated string
+		err       string
+	}{
+		{
+			msg:       "alias in comparison",
+			query:     "OldStringField = 'custom'",
+			validated: "`Attr.CustomStringField` = 'custom'",
+		},
+		{
+			msg:       "alias in range and order by",
+			query:     "OldIntField between 1 and 10 order by OldIntField desc",
+			validated: "`Attr.CustomIntField` between 1 and 10 order by `Attr.CustomIntField` desc",
+		},
+		{
+			msg:       "current name still valid",
+			query:     "CustomStringField = 'custom'",
+			validated: "`Attr.CustomStringField` = 'custom'",
+		},
+		{
+			msg:   "alias to unknown attribute",
+			query: "DanglingField = 'custom'",
+			err:   "BadRequestError{Message: invalid search attribute}",
+		},
+	}
+
+	aliases := map[string]interface{}{
+		"OldStringField": "CustomStringField",
+		"OldIntField":    "CustomIntField",
+		"DanglingField":  "RemovedField",
+	}
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+			qv := NewQueryValidator(validSearchAttr, dynamicconfig.GetMapPropertyFn(aliases))
 			validated, err := qv.ValidateQuery(tt.query)
 			if err != nil {
 				assert.Equal(t, tt.err, err.Error())
@@ -36,6 +36,7 @@ type SearchAttributesValidator struct {
 	logger log.Logger
 
 	validSearchAttributes             dynamicconfig.MapPropertyFn
+	searchAttributeAliases            dynamicconfig.MapPropertyFn
 	searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
 	searchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	searchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
@@ -45,6 +46,7 @@ type SearchAttributesValidator struct {
 func NewSearchAttributesValidator(
 	logger log.Logger,
 	validSearchAttributes dynamicconfig.MapPropertyFn,
+	searchAttributeAliases dynamicconfig.MapPropertyFn,
 	searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter,
 	searchAttributesSizeOfValueLimit dynamicconfig.IntPropertyFnWithDomainFilter,
 	searchAttributesTotalSizeLimit dynamicconfig.IntPropertyFnWithDomainFilter,
@@ -52,6 +54,7 @@ func NewSearchAttributesValidator(
 	return &SearchAttributesValidator{
 		logger:                            logger,
 		validSearchAttributes:             validSearchAttributes,
+		searchAttributeAliases:            searchAttributeAliases,
 		searchAttributesNumberOfKeysLimit: searchAttributesNumberOfKeysLimit,
 		searchAttributesSizeOfValueLimit:  searchAttributesSizeOfValueLimit,
 		searchAttributesTotalSizeLimit:    searchAttributesTotalSizeLimit,
@@ -75,21 +78,27 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *types.Searc
 
 	totalSize := 0
 	validAttr := sv.validSearchAttributes()
+	var aliases map[string]interface{}
+	if sv.searchAttributeAliases != nil {
+		aliases = sv.searchAttributeAliases()
+	}
 	for key, val := range fields {
+		// old names of renamed attributes are validated against the attribute they were renamed to
+		attrKey := definition.ResolveIndexedKeyAlias(aliases, key)
 		// verify: key is whitelisted
-		if !sv.isValidSearchAttributesKey(validAttr, key) {
+		if !sv.isValidSearchAttributesKey(validAttr, attrKey) {
 			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
 				Error("invalid search attribute key")
 			return &types.BadRequestError{Message: fmt.Sprintf("%s is not a valid search attribute key", key)}
 		}
 		// verify: value has the correct type
-		if !sv.isValidSearchAttributesValue(validAttr, key, val) {
+		if !sv.isValidSearchAttributesValue(validAttr, attrKey, val) {
 			sv.logger.WithTags(tag.ESKey(key), tag.ESValue(val), tag.WorkflowDomainName(domain)).
 				Error("invalid search attribute value")
 			return &types.BadRequestError{Message: fmt.Sprintf("%s is not a valid search attribute value for key %s", val, key)}
 		}
 		// verify: key is not system reserved
-		if definition.IsSystemIndexedKey(key) {
+		if definition.IsSystemIndexedKey(attrKey) {
 			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
 				Error("illegal update of system reserved attribute")
 			return &types.BadRequestError{Message: fmt.Sprintf("%s is read-only Cadence reservered attribute", key)}
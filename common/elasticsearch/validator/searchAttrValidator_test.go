@@ -47,6 +47,7 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 
 	validator := NewSearchAttributesValidator(log.NewNoop(),
 		dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		dynamicconfig.GetMapPropertyFn(map[string]interface{}{"OldIntField": "CustomIntField"}),
 		dynamicconfig.GetIntPropertyFilteredByDomain(numOfKeysLimit),
 		dynamicconfig.GetIntPropertyFilteredByDomain(sizeOfValueLimit),
 		dynamicconfig.GetIntPropertyFilteredByDomain(sizeOfTotalLimit))
@@ -75,6 +76,20 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	err = validator.ValidateSearchAttributes(attr, domain)
 	s.Equal("BadRequestError{Message: number of keys 3 exceed limit}", err.Error())
 
+	fields = map[string][]byte{
+		"OldIntField": []byte(`1`),
+	}
+	attr.IndexedFields = fields
+	err = validator.ValidateSearchAttributes(attr, domain)
+	s.Nil(err)
+
+	fields = map[string][]byte{
+		"OldIntField": []byte(`"not an int"`),
+	}
+	attr.IndexedFields = fields
+	err = validator.ValidateSearchAttributes(attr, domain)
+	s.Equal(`BadRequestError{Message: "not an int" is not a valid search attribute value for key OldIntField}`, err.Error())
+
 	fields = map[string][]byte{
 		"InvalidKey": []byte(`"1"`),
 	}
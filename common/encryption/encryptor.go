@@ -0,0 +1,177 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	envelopeVersion = 1
+	// KeySize is the size in bytes of the keys returned by a KeyProvider, keys are used for AES-256
+	KeySize = 32
+	// MaxKeyIDLength is the max length of a key ID
+	MaxKeyIDLength = 255
+)
+
+var errMalformedEnvelope = errors.New("malformed encrypted payload")
+
+type (
+	encryptorImpl struct {
+		keyProvider KeyProvider
+	}
+)
+
+// NewEncryptor creates a new Encryptor which uses the keys of the given KeyProvider
+func NewEncryptor(keyProvider KeyProvider) Encryptor {
+	return &encryptorImpl{
+		keyProvider: keyProvider,
+	}
+}
+
+// Encrypt encrypts the payload into an envelope:
+// version | key ID length (1 byte) | key ID | encrypted data key length (2 bytes) | encrypted data key | encrypted payload
+// where the data key and the payload are both encrypted with AES-256-GCM and prefixed with their nonce.
+func (e *encryptorImpl) Encrypt(
+	keyID string,
+	payload []byte,
+) ([]byte, error) {
+
+	if keyID == "" || len(payload) == 0 {
+		return payload, nil
+	}
+	if len(keyID) > MaxKeyIDLength {
+		return nil, fmt.Errorf("payload encryption key ID %v is longer than %v bytes", keyID, MaxKeyIDLength)
+	}
+	key, err := e.keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	encryptedDataKey, err := seal(key, dataKey, []byte(keyID))
+	if err != nil {
+		return nil, err
+	}
+	encryptedPayload, err := seal(dataKey, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := make([]byte, 0, 4+len(keyID)+len(encryptedDataKey)+len(encryptedPayload))
+	envelope = append(envelope, envelopeVersion, byte(len(keyID)))
+	envelope = append(envelope, keyID...)
+	envelope = append(envelope, 0, 0)
+	binary.BigEndian.PutUint16(envelope[len(envelope)-2:], uint16(len(encryptedDataKey)))
+	envelope = append(envelope, encryptedDataKey...)
+	envelope = append(envelope, encryptedPayload...)
+	return envelope, nil
+}
+
+func (e *encryptorImpl) Decrypt(
+	payload []byte,
+) ([]byte, error) {
+
+	if len(payload) == 0 {
+		return payload, nil
+	}
+
+	envelope := payload
+	if len(envelope) < 2 {
+		return nil, errMalformedEnvelope
+	}
+	if envelope[0] != envelopeVersion {
+		return nil, fmt.Errorf("unknown encrypted payload version %v", envelope[0])
+	}
+	keyIDLength := int(envelope[1])
+	envelope = envelope[2:]
+	if len(envelope) < keyIDLength+2 {
+		return nil, errMalformedEnvelope
+	}
+	keyID := envelope[:keyIDLength]
+	envelope = envelope[keyIDLength:]
+	encryptedDataKeyLength := int(binary.BigEndian.Uint16(envelope))
+	envelope = envelope[2:]
+	if len(envelope) < encryptedDataKeyLength {
+		return nil, errMalformedEnvelope
+	}
+
+	key, err := e.keyProvider.GetKey(string(keyID))
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := open(key, envelope[:encryptedDataKeyLength], keyID)
+	if err != nil {
+		return nil, err
+	}
+	return open(dataKey, envelope[encryptedDataKeyLength:], nil)
+}
+
+func seal(
+	key []byte,
+	plaintext []byte,
+	additionalData []byte,
+) ([]byte, error) {
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(
+	key []byte,
+	ciphertext []byte,
+	additionalData []byte,
+) ([]byte, error) {
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errMalformedEnvelope
+	}
+	nonce := ciphertext[:aead.NonceSize()]
+	return aead.Open(nil, nonce, ciphertext[aead.NonceSize():], additionalData)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testKeyProvider map[string][]byte
+
+func (p testKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %v not found", keyID)
+	}
+	return key, nil
+}
+
+func newTestKeyProvider(keyIDs ...string) testKeyProvider {
+	provider := make(testKeyProvider)
+	for i, keyID := range keyIDs {
+		provider[keyID] = bytes.Repeat([]byte{byte(i + 1)}, KeySize)
+	}
+	return provider
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider("key-1"))
+	payload := []byte("workflow input")
+
+	encrypted, err := encryptor.Encrypt("key-1", payload)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(encrypted, payload))
+
+	decrypted, err := encryptor.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, payload, decrypted)
+
+	// a new data key and nonce is used for every payload
+	encryptedAgain, err := encryptor.Encrypt("key-1", payload)
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted, encryptedAgain)
+}
+
+func TestEncrypt_Passthrough(t *testing.T) {
+	encryptor := NewEncryptor(NewNoopKeyProvider())
+	payload := []byte("workflow input")
+
+	encrypted, err := encryptor.Encrypt("", payload)
+	require.NoError(t, err)
+	require.Equal(t, payload, encrypted)
+
+	encrypted, err = encryptor.Encrypt("key-1", nil)
+	require.NoError(t, err)
+	require.Nil(t, encrypted)
+
+	decrypted, err := encryptor.Decrypt(nil)
+	require.NoError(t, err)
+	require.Nil(t, decrypted)
+}
+
+func TestEncrypt_KeyRotation(t *testing.T) {
+	keyProvider := newTestKeyProvider("key-1", "key-2")
+	encryptor := NewEncryptor(keyProvider)
+
+	encryptedWithOldKey, err := encryptor.Encrypt("key-1", []byte("old payload"))
+	require.NoError(t, err)
+	encryptedWithNewKey, err := encryptor.Encrypt("key-2", []byte("new payload"))
+	require.NoError(t, err)
+
+	decrypted, err := encryptor.Decrypt(encryptedWithOldKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("old payload"), decrypted)
+	decrypted, err = encryptor.Decrypt(encryptedWithNewKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("new payload"), decrypted)
+
+	// payloads encrypted with a key removed from the keyring are no longer readable
+	delete(keyProvider, "key-1")
+	_, err = encryptor.Decrypt(encryptedWithOldKey)
+	require.Error(t, err)
+}
+
+func TestEncrypt_UnknownKey(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider("key-1"))
+
+	_, err := encryptor.Encrypt("key-2", []byte("workflow input"))
+	require.Error(t, err)
+}
+
+func TestDecrypt_WrongKey(t *testing.T) {
+	encrypted, err := NewEncryptor(newTestKeyProvider("key-1")).Encrypt("key-1", []byte("workflow input"))
+	require.NoError(t, err)
+
+	// same key ID but different key material
+	_, err = NewEncryptor(testKeyProvider{"key-1": bytes.Repeat([]byte{0xff}, KeySize)}).Decrypt(encrypted)
+	require.Error(t, err)
+}
+
+func TestDecrypt_Tampered(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider("key-1"))
+	encrypted, err := encryptor.Encrypt("key-1", []byte("workflow input"))
+	require.NoError(t, err)
+
+	tampered := append([]byte(nil), encrypted...)
+	tampered[len(tampered)-1] ^= 0x01
+	_, err = encryptor.Decrypt(tampered)
+	require.Error(t, err)
+
+	truncated := encrypted[:3]
+	_, err = encryptor.Decrypt(truncated)
+	require.Error(t, err)
+
+	// plaintext is not mistaken for an encrypted payload
+	_, err = encryptor.Decrypt([]byte("workflow input"))
+	require.Error(t, err)
+}
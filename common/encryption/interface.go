@@ -0,0 +1,40 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+type (
+	// KeyProvider provides the keys used to encrypt the data keys of payloads
+	KeyProvider interface {
+		// GetKey returns the key of the given ID
+		GetKey(keyID string) ([]byte, error)
+	}
+
+	// Encryptor encrypts payloads with envelope encryption: every payload is encrypted with a new data key,
+	// and the data key is encrypted with a key from the KeyProvider. The ID of that key is stored with the
+	// encrypted payload, so payloads stay readable after the key used for new payloads is rotated.
+	Encryptor interface {
+		// Encrypt encrypts the payload with the key of the given ID, the payload is returned as is if keyID is empty
+		Encrypt(keyID string, payload []byte) ([]byte, error)
+		// Decrypt decrypts a payload returned by Encrypt with a non-empty keyID. The envelope of an encrypted
+		// payload is not self-identifying, callers must record which payloads are encrypted.
+		Decrypt(payload []byte) ([]byte, error)
+	}
+)
@@ -0,0 +1,95 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	keyringProvider struct {
+		keys map[string][]byte
+	}
+
+	noopKeyProvider struct{}
+
+	// keyringFile is the format of a keyring file, keys are base64 encoded
+	keyringFile struct {
+		Keys map[string]string `yaml:"keys"`
+	}
+)
+
+// NewKeyringProvider creates a KeyProvider which reads keys from a local keyring file, e.g.
+//
+//	keys:
+//	  key-2021-01: "<base64 encoded 32 bytes key>"
+//	  key-2021-06: "<base64 encoded 32 bytes key>"
+//
+// Keys are read once when the provider is created.
+func NewKeyringProvider(path string) (KeyProvider, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyring file: %v", err)
+	}
+	var keyring keyringFile
+	if err := yaml.Unmarshal(content, &keyring); err != nil {
+		return nil, fmt.Errorf("failed to parse keyring file: %v", err)
+	}
+
+	keys := make(map[string][]byte, len(keyring.Keys))
+	for keyID, encodedKey := range keyring.Keys {
+		if keyID == "" || len(keyID) > MaxKeyIDLength {
+			return nil, fmt.Errorf("invalid key ID %q in keyring file, key IDs must be 1 to %v bytes", keyID, MaxKeyIDLength)
+		}
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("key %v in keyring file is not base64 encoded: %v", keyID, err)
+		}
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("key %v in keyring file must be %v bytes, got %v", keyID, KeySize, len(key))
+		}
+		keys[keyID] = key
+	}
+	return &keyringProvider{
+		keys: keys,
+	}, nil
+}
+
+// NewNoopKeyProvider creates a KeyProvider which has no keys, it is used when payload encryption is not configured
+func NewNoopKeyProvider() KeyProvider {
+	return &noopKeyProvider{}
+}
+
+func (p *keyringProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("payload encryption key %v not found in keyring", keyID)
+	}
+	return key, nil
+}
+
+func (p *noopKeyProvider) GetKey(keyID string) ([]byte, error) {
+	return nil, fmt.Errorf("payload encryption key %v not found, payload encryption is not configured", keyID)
+}
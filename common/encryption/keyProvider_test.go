@@ -0,0 +1,93 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+)
+
+func writeKeyring(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "keyring.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestKeyringProvider(t *testing.T) {
+	key1 := bytes.Repeat([]byte{1}, KeySize)
+	key2 := bytes.Repeat([]byte{2}, KeySize)
+	path := writeKeyring(t, "keys:\n"+
+		"  key-1: \""+base64.StdEncoding.EncodeToString(key1)+"\"\n"+
+		"  key-2: \""+base64.StdEncoding.EncodeToString(key2)+"\"\n")
+
+	provider, err := NewKeyringProvider(path)
+	require.NoError(t, err)
+	key, err := provider.GetKey("key-1")
+	require.NoError(t, err)
+	require.Equal(t, key1, key)
+	key, err = provider.GetKey("key-2")
+	require.NoError(t, err)
+	require.Equal(t, key2, key)
+	_, err = provider.GetKey("key-3")
+	require.Error(t, err)
+}
+
+func TestKeyringProvider_InvalidKeyring(t *testing.T) {
+	testCases := map[string]string{
+		"not yaml":       "keys: [",
+		"not base64":     "keys:\n  key-1: \"not base64!\"\n",
+		"wrong key size": "keys:\n  key-1: \"" + base64.StdEncoding.EncodeToString([]byte("short")) + "\"\n",
+		"empty key ID":   "keys:\n  \"\": \"" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, KeySize)) + "\"\n",
+	}
+	for name, content := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewKeyringProvider(writeKeyring(t, content))
+			require.Error(t, err)
+		})
+	}
+
+	_, err := NewKeyringProvider(filepath.Join(os.TempDir(), "keyring-does-not-exist.yaml"))
+	require.Error(t, err)
+}
+
+func TestNewKeyProvider(t *testing.T) {
+	provider, err := NewKeyProvider(config.PayloadEncryption{})
+	require.NoError(t, err)
+	_, err = provider.GetKey("key-1")
+	require.Error(t, err)
+
+	path := writeKeyring(t, "keys:\n  key-1: \""+base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, KeySize))+"\"\n")
+	provider, err = NewKeyProvider(config.PayloadEncryption{Keyring: &config.Keyring{Path: path}})
+	require.NoError(t, err)
+	_, err = provider.GetKey("key-1")
+	require.NoError(t, err)
+}
@@ -49,6 +49,16 @@ func Timestamp(timestamp time.Time) Tag {
 	return newTimeTag("timestamp", timestamp)
 }
 
+// Reason returns tag for the reason given for an operation
+func Reason(reason string) Tag {
+	return newStringTag("reason", reason)
+}
+
+// Identity returns tag for the identity of the caller of an operation
+func Identity(identity string) Tag {
+	return newStringTag("identity", identity)
+}
+
 ///////////////////  Workflow tags defined here: ( wf is short for workflow) ///////////////////
 
 // WorkflowAction returns tag for WorkflowAction
@@ -664,6 +674,11 @@ func PrevActiveCluster(prevActiveCluster string) Tag {
 	return newStringTag("xdc-prev-active-cluster", prevActiveCluster)
 }
 
+// DLQDeadReason returns tag for the reason a replication DLQ task is given up on
+func DLQDeadReason(reason string) Tag {
+	return newStringTag("xdc-dlq-dead-reason", reason)
+}
+
 // FailoverMsg returns tag for FailoverMsg
 func FailoverMsg(failoverMsg string) Tag {
 	return newStringTag("xdc-failover-msg", failoverMsg)
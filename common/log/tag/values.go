@@ -30,6 +30,8 @@ var (
 	WorkflowActionWorkflowTimeout       = workflowAction("add-workflow-timeout-event")
 	WorkflowActionWorkflowTerminated    = workflowAction("add-workflow-terminated-event")
 	WorkflowActionWorkflowContinueAsNew = workflowAction("add-workflow-continue-as-new-event")
+	WorkflowActionWorkflowSuspended     = workflowAction("add-workflow-suspended-event")
+	WorkflowActionWorkflowResumed       = workflowAction("add-workflow-resumed-event")
 
 	// workflow cancellation / sign
 	WorkflowActionWorkflowCancelRequested        = workflowAction("add-workflow-cancel-requested-event")
@@ -285,6 +287,7 @@ var (
 	AdminClientOperationGetWorkflowExecutionRawHistoryV2  = clientOperation("admin-get-wf-execution-raw-history-v2")
 	AdminClientOperationDescribeCluster                   = clientOperation("admin-describe-cluster")
 	AdminClientOperationGetReplicationMessages            = clientOperation("admin-get-replication-messsages")
+	AdminClientOperationStreamReplicationMessages         = clientOperation("admin-stream-replication-messages")
 	AdminClientOperationGetDomainReplicationMessages      = clientOperation("admin-get-domain-replication-messsages")
 	AdminClientOperationGetDLQReplicationMessages         = clientOperation("admin-get-dlq-replication-messsages")
 	AdminClientOperationReapplyEvents                     = clientOperation("admin-reapply-events")
@@ -292,6 +295,13 @@ var (
 	AdminClientOperationPurgeDLQMessages                  = clientOperation("admin-purge-dlq-messsages")
 	AdminClientOperationMergeDLQMessages                  = clientOperation("admin-merge-dlq-messsages")
 	AdminClientOperationRefreshWorkflowTasks              = clientOperation("admin-refresh-wf-tasks")
+	AdminClientOperationFailoverWorkflowExecution         = clientOperation("admin-failover-wf")
+	AdminClientOperationSuspendWorkflowExecution          = clientOperation("admin-suspend-wf")
+	AdminClientOperationResumeWorkflowExecution           = clientOperation("admin-resume-wf")
+	AdminClientOperationListWorkflowTasks                 = clientOperation("admin-list-wf-tasks")
+	AdminClientOperationDeleteWorkflowTask                = clientOperation("admin-delete-wf-task")
+	AdminClientOperationReenqueueWorkflowTask             = clientOperation("admin-reenqueue-wf-task")
+	AdminClientOperationDescribeDomainUsage               = clientOperation("admin-describe-domain-usage")
 	AdminClientOperationResendReplicationTasks            = clientOperation("admin-resend-replication-tasks")
 	AdminClientOperationGetCrossClusterTasks              = clientOperation("admin-get-cross-cluster-tasks")
 	AdminClientOperationRespondCrossClusterTasksCompleted = clientOperation("admin-respond-cross-cluster-tasks-completed")
@@ -299,6 +309,9 @@ var (
 	AdminClientOperationUpdateDynamicConfig               = clientOperation("admin-update-dynamic-config")
 	AdminClientOperationRestoreDynamicConfig              = clientOperation("admin-restore-dynamic-config")
 	AdminClientOperationListDynamicConfig                 = clientOperation("admin-list-dynamic-config")
+	AdminClientOperationPromoteDomain                     = clientOperation("admin-promote-domain")
+	AdminClientOperationRemoveSearchAttribute             = clientOperation("admin-remove-search-attribute")
+	AdminClientOperationRenameSearchAttribute             = clientOperation("admin-rename-search-attribute")
 
 	FrontendClientOperationDeprecateDomain                  = clientOperation("frontend-deprecate-domain")
 	FrontendClientOperationDescribeDomain                   = clientOperation("frontend-describe-domain")
@@ -378,6 +391,14 @@ var (
 	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
 	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
 	HistoryClientOperationRefreshWorkflowTasks              = clientOperation("history-refresh-wf-tasks")
+	HistoryClientOperationFailoverWorkflowExecution         = clientOperation("history-failover-wf")
+	HistoryClientOperationSuspendWorkflowExecution          = clientOperation("history-suspend-wf")
+	HistoryClientOperationResumeWorkflowExecution           = clientOperation("history-resume-wf")
+	HistoryClientOperationReindexWorkflowExecution          = clientOperation("history-reindex-wf")
+	HistoryClientOperationRebuildMutableState               = clientOperation("history-rebuild-mutable-state")
+	HistoryClientOperationListWorkflowTasks                 = clientOperation("history-list-wf-tasks")
+	HistoryClientOperationDeleteWorkflowTask                = clientOperation("history-delete-wf-task")
+	HistoryClientOperationReenqueueWorkflowTask             = clientOperation("history-reenqueue-wf-task")
 	HistoryClientOperationNotifyFailoverMarkers             = clientOperation("history-notify-failover-markers")
 	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
 	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
@@ -60,6 +60,13 @@ type (
 		Publish(ctx context.Context, message interface{}) error
 	}
 
+	// EncodedMessage is a message that is already serialized by the caller.
+	// Key is used by the producer to pick the partition, so messages with the same key are kept in order.
+	EncodedMessage struct {
+		Key   []byte
+		Value []byte
+	}
+
 	// CloseableProducer is a Producer that can be closed
 	CloseableProducer interface {
 		Producer
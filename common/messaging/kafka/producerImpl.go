@@ -104,6 +104,13 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
+	case *messaging.EncodedMessage:
+		msg := &sarama.ProducerMessage{
+			Topic: p.topic,
+			Key:   sarama.ByteEncoder(message.Key),
+			Value: sarama.ByteEncoder(message.Value),
+		}
+		return msg, nil
 	case *sarama.ConsumerMessage:
 		msg := &sarama.ProducerMessage{
 			Topic: p.topic,
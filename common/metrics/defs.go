@@ -368,6 +368,22 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientFailoverWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientFailoverWorkflowExecutionScope
+	// HistoryClientSuspendWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientSuspendWorkflowExecutionScope
+	// HistoryClientResumeWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResumeWorkflowExecutionScope
+	// HistoryClientReindexWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientReindexWorkflowExecutionScope
+	// HistoryClientRebuildMutableStateScope tracks RPC calls to history service
+	HistoryClientRebuildMutableStateScope
+	// HistoryClientListWorkflowTasksScope tracks RPC calls to history service
+	HistoryClientListWorkflowTasksScope
+	// HistoryClientDeleteWorkflowTaskScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowTaskScope
+	// HistoryClientReenqueueWorkflowTaskScope tracks RPC calls to history service
+	HistoryClientReenqueueWorkflowTaskScope
 	// HistoryClientNotifyFailoverMarkersScope tracks RPC calls to history service
 	HistoryClientNotifyFailoverMarkersScope
 	// HistoryClientGetCrossClusterTasksScope tracks RPC calls to history service
@@ -474,6 +490,8 @@ const (
 	FrontendClientGetSearchAttributesScope
 	// FrontendClientGetReplicationTasksScope tracks RPC calls to frontend service
 	FrontendClientGetReplicationTasksScope
+	// FrontendClientStreamReplicationTasksScope tracks RPC calls to frontend service
+	FrontendClientStreamReplicationTasksScope
 	// FrontendClientGetDomainReplicationTasksScope tracks RPC calls to frontend service
 	FrontendClientGetDomainReplicationTasksScope
 	// FrontendClientGetDLQReplicationTasksScope tracks RPC calls to frontend service
@@ -514,6 +532,20 @@ const (
 	AdminClientMergeDLQMessagesScope
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope
+	// AdminClientFailoverWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientFailoverWorkflowExecutionScope
+	// AdminClientSuspendWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientSuspendWorkflowExecutionScope
+	// AdminClientResumeWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientResumeWorkflowExecutionScope
+	// AdminClientListWorkflowTasksScope tracks RPC calls to admin service
+	AdminClientListWorkflowTasksScope
+	// AdminClientDeleteWorkflowTaskScope tracks RPC calls to admin service
+	AdminClientDeleteWorkflowTaskScope
+	// AdminClientReenqueueWorkflowTaskScope tracks RPC calls to admin service
+	AdminClientReenqueueWorkflowTaskScope
+	// AdminClientDescribeDomainUsageScope tracks RPC calls to admin service
+	AdminClientDescribeDomainUsageScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
 	// AdminClientGetCrossClusterTasksScope tracks RPC calls to Admin service
@@ -528,6 +560,12 @@ const (
 	AdminClientRestoreDynamicConfigScope
 	// AdminClientListDynamicConfigScope tracks RPC calls to admin service
 	AdminClientListDynamicConfigScope
+	// AdminClientPromoteDomainScope tracks RPC calls to admin service
+	AdminClientPromoteDomainScope
+	// AdminClientRemoveSearchAttributeScope tracks RPC calls to admin service
+	AdminClientRemoveSearchAttributeScope
+	// AdminClientRenameSearchAttributeScope tracks RPC calls to admin service
+	AdminClientRenameSearchAttributeScope
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
 	// DCRedirectionDescribeDomainScope tracks RPC calls for dc redirection
@@ -728,6 +766,8 @@ const (
 	AdminGetWorkflowExecutionRawHistoryV2Scope
 	// AdminGetReplicationMessagesScope is the metric scope for admin.GetReplicationMessages
 	AdminGetReplicationMessagesScope
+	// AdminStreamReplicationMessagesScope is the metric scope for admin.StreamReplicationMessages
+	AdminStreamReplicationMessagesScope
 	// AdminGetDomainReplicationMessagesScope is the metric scope for admin.GetDomainReplicationMessages
 	AdminGetDomainReplicationMessagesScope
 	// AdminGetDLQReplicationMessagesScope is the metric scope for admin.GetDLQReplicationMessages
@@ -736,6 +776,20 @@ const (
 	AdminReapplyEventsScope
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope
+	// AdminFailoverWorkflowExecutionScope is the metric scope for admin.FailoverWorkflowExecution
+	AdminFailoverWorkflowExecutionScope
+	// AdminSuspendWorkflowExecutionScope is the metric scope for admin.SuspendWorkflowExecution
+	AdminSuspendWorkflowExecutionScope
+	// AdminResumeWorkflowExecutionScope is the metric scope for admin.ResumeWorkflowExecution
+	AdminResumeWorkflowExecutionScope
+	// AdminListWorkflowTasksScope is the metric scope for admin.ListWorkflowTasks
+	AdminListWorkflowTasksScope
+	// AdminDeleteWorkflowTaskScope is the metric scope for admin.DeleteWorkflowTask
+	AdminDeleteWorkflowTaskScope
+	// AdminReenqueueWorkflowTaskScope is the metric scope for admin.ReenqueueWorkflowTask
+	AdminReenqueueWorkflowTaskScope
+	// AdminDescribeDomainUsageScope is the metric scope for admin.DescribeDomainUsage
+	AdminDescribeDomainUsageScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
@@ -766,6 +820,12 @@ const (
 	AdminRestoreDynamicConfigScope
 	// AdminListDynamicConfigScope is the metric scope for admin.ListDynamicConfig
 	AdminListDynamicConfigScope
+	// AdminPromoteDomainScope is the metric scope for admin.PromoteDomain
+	AdminPromoteDomainScope
+	// AdminRemoveSearchAttributeScope is the metric scope for admin.RemoveSearchAttribute
+	AdminRemoveSearchAttributeScope
+	// AdminRenameSearchAttributeScope is the metric scope for admin.RenameSearchAttribute
+	AdminRenameSearchAttributeScope
 
 	NumAdminScopes
 )
@@ -932,6 +992,22 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope tracks RefreshWorkflowTasks API calls received by service
 	HistoryRefreshWorkflowTasksScope
+	// HistoryFailoverWorkflowExecutionScope tracks FailoverWorkflowExecution API calls received by service
+	HistoryFailoverWorkflowExecutionScope
+	// HistorySuspendWorkflowExecutionScope tracks SuspendWorkflowExecution API calls received by service
+	HistorySuspendWorkflowExecutionScope
+	// HistoryResumeWorkflowExecutionScope tracks ResumeWorkflowExecution API calls received by service
+	HistoryResumeWorkflowExecutionScope
+	// HistoryReindexWorkflowExecutionScope tracks ReindexWorkflowExecution API calls received by service
+	HistoryReindexWorkflowExecutionScope
+	// HistoryRebuildMutableStateScope tracks RebuildMutableState API calls received by service
+	HistoryRebuildMutableStateScope
+	// HistoryListWorkflowTasksScope tracks ListWorkflowTasks API calls received by service
+	HistoryListWorkflowTasksScope
+	// HistoryDeleteWorkflowTaskScope tracks DeleteWorkflowTask API calls received by service
+	HistoryDeleteWorkflowTaskScope
+	// HistoryReenqueueWorkflowTaskScope tracks ReenqueueWorkflowTask API calls received by service
+	HistoryReenqueueWorkflowTaskScope
 	// HistoryNotifyFailoverMarkersScope is the scope used by notify failover marker API
 	HistoryNotifyFailoverMarkersScope
 	// HistoryGetCrossClusterTasksScope tracks GetCrossClusterTasks API calls received by service
@@ -972,6 +1048,8 @@ const (
 	TransferActiveTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferActiveTaskApplyParentClosePolicyScope
+	// TransferActiveTaskWorkflowLifecycleEventsScope is the scope used for workflow lifecycle events task processing by transfer queue processor
+	TransferActiveTaskWorkflowLifecycleEventsScope
 	// TransferStandbyTaskResetWorkflowScope is the scope used for record workflow started task processing by transfer queue processor
 	TransferStandbyTaskResetWorkflowScope
 	// TransferStandbyTaskActivityScope is the scope used for activity task processing by transfer queue processor
@@ -996,6 +1074,8 @@ const (
 	TransferStandbyTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferStandbyTaskApplyParentClosePolicyScope
+	// TransferStandbyTaskWorkflowLifecycleEventsScope is the scope used for workflow lifecycle events task processing by transfer queue processor
+	TransferStandbyTaskWorkflowLifecycleEventsScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerActiveQueueProcessorScope is the scope used by all metric emitted by timer queue processor
@@ -1108,6 +1188,8 @@ const (
 	ReplicationTaskCleanupScope
 	// ReplicationDLQStatsScope is scope used by all metrics emitted related to replication DLQ
 	ReplicationDLQStatsScope
+	// ReplicationDLQRedriveScope is scope used by all metrics emitted by the replication DLQ redrive processor
+	ReplicationDLQRedriveScope
 	// FailoverMarkerScope is scope used by all metrics emitted related to failover marker
 	FailoverMarkerScope
 	// HistoryReplicationV2TaskScope is the scope used by history task replication processing
@@ -1186,6 +1268,8 @@ const (
 	CheckDataCorruptionWorkflowScope
 	// ESAnalyzerScope is scope used by ElasticSearch Analyzer (esanalyzer) workflow
 	ESAnalyzerScope
+	// DomainUsageAggregatorScope is scope used by the domain usage aggregator workflow
+	DomainUsageAggregatorScope
 
 	NumWorkerScopes
 )
@@ -1322,6 +1406,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientFailoverWorkflowExecutionScope:           {operation: "HistoryClientFailoverWorkflowExecutionScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSuspendWorkflowExecutionScope:            {operation: "HistoryClientSuspendWorkflowExecutionScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResumeWorkflowExecutionScope:             {operation: "HistoryClientResumeWorkflowExecutionScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReindexWorkflowExecutionScope:            {operation: "HistoryClientReindexWorkflowExecutionScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRebuildMutableStateScope:                 {operation: "HistoryClientRebuildMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientListWorkflowTasksScope:                   {operation: "HistoryClientListWorkflowTasksScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDeleteWorkflowTaskScope:                  {operation: "HistoryClientDeleteWorkflowTaskScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReenqueueWorkflowTaskScope:               {operation: "HistoryClientReenqueueWorkflowTaskScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientNotifyFailoverMarkersScope:               {operation: "HistoryClientNotifyFailoverMarkersScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetCrossClusterTasksScope:                {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondCrossClusterTasksCompletedScope:   {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1375,6 +1467,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientCountWorkflowExecutionsScope:            {operation: "FrontendClientCountWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientGetSearchAttributesScope:                {operation: "FrontendClientGetSearchAttributes", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientGetReplicationTasksScope:                {operation: "FrontendClientGetReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientStreamReplicationTasksScope:             {operation: "FrontendClientStreamReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientGetDomainReplicationTasksScope:          {operation: "FrontendClientGetDomainReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientGetDLQReplicationTasksScope:             {operation: "FrontendClientGetDLQReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientReapplyEventsScope:                      {operation: "FrontendClientReapplyEventsScope", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1388,6 +1481,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:      {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientFailoverWorkflowExecutionScope:             {operation: "AdminClientFailoverWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientSuspendWorkflowExecutionScope:              {operation: "AdminClientSuspendWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientResumeWorkflowExecutionScope:               {operation: "AdminClientResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientListWorkflowTasksScope:                     {operation: "AdminClientListWorkflowTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDeleteWorkflowTaskScope:                    {operation: "AdminClientDeleteWorkflowTask", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientReenqueueWorkflowTaskScope:                 {operation: "AdminClientReenqueueWorkflowTask", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDescribeDomainUsageScope:                   {operation: "AdminClientDescribeDomainUsage", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRemoveTaskScope:                            {operation: "AdminClientRemoveTask", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1402,6 +1502,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientUpdateDynamicConfigScope:                   {operation: "AdminClientUpdateDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRestoreDynamicConfigScope:                  {operation: "AdminClientRestoreDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientListDynamicConfigScope:                     {operation: "AdminClientListDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientPromoteDomainScope:                         {operation: "AdminClientPromoteDomain", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRemoveSearchAttributeScope:                 {operation: "AdminClientRemoveSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRenameSearchAttributeScope:                 {operation: "AdminClientRenameSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                     {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeDomainScope:                      {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeTaskListScope:                    {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1503,10 +1606,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminGetWorkflowExecutionRawHistoryScope:    {operation: "GetWorkflowExecutionRawHistory"},
 		AdminGetWorkflowExecutionRawHistoryV2Scope:  {operation: "GetWorkflowExecutionRawHistoryV2"},
 		AdminGetReplicationMessagesScope:            {operation: "GetReplicationMessages"},
+		AdminStreamReplicationMessagesScope:         {operation: "StreamReplicationMessages"},
 		AdminGetDomainReplicationMessagesScope:      {operation: "GetDomainReplicationMessages"},
 		AdminGetDLQReplicationMessagesScope:         {operation: "AdminGetDLQReplicationMessages"},
 		AdminReapplyEventsScope:                     {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:              {operation: "RefreshWorkflowTasks"},
+		AdminFailoverWorkflowExecutionScope:         {operation: "FailoverWorkflowExecution"},
+		AdminSuspendWorkflowExecutionScope:          {operation: "SuspendWorkflowExecution"},
+		AdminResumeWorkflowExecutionScope:           {operation: "ResumeWorkflowExecution"},
+		AdminListWorkflowTasksScope:                 {operation: "ListWorkflowTasks"},
+		AdminDeleteWorkflowTaskScope:                {operation: "DeleteWorkflowTask"},
+		AdminReenqueueWorkflowTaskScope:             {operation: "ReenqueueWorkflowTask"},
+		AdminDescribeDomainUsageScope:               {operation: "DescribeDomainUsage"},
 		AdminResendReplicationTasksScope:            {operation: "ResendReplicationTasks"},
 		AdminGetCrossClusterTasksScope:              {operation: "AdminGetCrossClusterTasks"},
 		AdminRespondCrossClusterTasksCompletedScope: {operation: "AdminRespondCrossClusterTasksCompleted"},
@@ -1514,6 +1625,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminUpdateDynamicConfigScope:               {operation: "AdminUpdateDynamicConfig"},
 		AdminRestoreDynamicConfigScope:              {operation: "AdminRestoreDynamicConfig"},
 		AdminListDynamicConfigScope:                 {operation: "AdminListDynamicConfig"},
+		AdminPromoteDomainScope:                     {operation: "AdminPromoteDomain"},
+		AdminRemoveSearchAttributeScope:             {operation: "RemoveSearchAttribute"},
+		AdminRenameSearchAttributeScope:             {operation: "RenameSearchAttribute"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:                {operation: "PollForDecisionTask"},
@@ -1598,6 +1712,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                                     {operation: "ShardController"},
 		HistoryReapplyEventsScope:                                       {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                                {operation: "RefreshWorkflowTasks"},
+		HistoryFailoverWorkflowExecutionScope:                           {operation: "FailoverWorkflowExecution"},
+		HistorySuspendWorkflowExecutionScope:                            {operation: "SuspendWorkflowExecution"},
+		HistoryResumeWorkflowExecutionScope:                             {operation: "ResumeWorkflowExecution"},
+		HistoryReindexWorkflowExecutionScope:                            {operation: "ReindexWorkflowExecution"},
+		HistoryRebuildMutableStateScope:                                 {operation: "RebuildMutableState"},
+		HistoryListWorkflowTasksScope:                                   {operation: "ListWorkflowTasks"},
+		HistoryDeleteWorkflowTaskScope:                                  {operation: "DeleteWorkflowTask"},
+		HistoryReenqueueWorkflowTaskScope:                               {operation: "ReenqueueWorkflowTask"},
 		HistoryNotifyFailoverMarkersScope:                               {operation: "NotifyFailoverMarkers"},
 		HistoryGetCrossClusterTasksScope:                                {operation: "GetCrossClusterTasks"},
 		HistoryRespondCrossClusterTasksCompletedScope:                   {operation: "RespondCrossClusterTasksCompleted"},
@@ -1618,6 +1740,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferActiveTaskRecordWorkflowClosedScope:                     {operation: "TransferActiveTaskRecordWorkflowClosed"},
 		TransferActiveTaskRecordChildExecutionCompletedScope:            {operation: "TransferActiveTaskRecordChildExecutionCompleted"},
 		TransferActiveTaskApplyParentClosePolicyScope:                   {operation: "TransferActiveTaskApplyParentClosePolicy"},
+		TransferActiveTaskWorkflowLifecycleEventsScope:                  {operation: "TransferActiveTaskWorkflowLifecycleEvents"},
 		TransferStandbyTaskActivityScope:                                {operation: "TransferStandbyTaskActivity"},
 		TransferStandbyTaskDecisionScope:                                {operation: "TransferStandbyTaskDecision"},
 		TransferStandbyTaskCloseExecutionScope:                          {operation: "TransferStandbyTaskCloseExecution"},
@@ -1630,6 +1753,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferStandbyTaskRecordWorkflowClosedScope:                    {operation: "TransferStandbyTaskRecordWorkflowClosed"},
 		TransferStandbyTaskRecordChildExecutionCompletedScope:           {operation: "TransferStandbyTaskRecordChildExecutionCompleted"},
 		TransferStandbyTaskApplyParentClosePolicyScope:                  {operation: "TransferStandbyTaskApplyParentClosePolicy"},
+		TransferStandbyTaskWorkflowLifecycleEventsScope:                 {operation: "TransferStandbyTaskWorkflowLifecycleEvents"},
 		TimerQueueProcessorScope:                                        {operation: "TimerQueueProcessor"},
 		TimerActiveQueueProcessorScope:                                  {operation: "TimerActiveQueueProcessor"},
 		TimerStandbyQueueProcessorScope:                                 {operation: "TimerStandbyQueueProcessor"},
@@ -1683,6 +1807,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ReplicationTaskFetcherScope:                                     {operation: "ReplicationTaskFetcher"},
 		ReplicationTaskCleanupScope:                                     {operation: "ReplicationTaskCleanup"},
 		ReplicationDLQStatsScope:                                        {operation: "ReplicationDLQStats"},
+		ReplicationDLQRedriveScope:                                      {operation: "ReplicationDLQRedrive"},
 		FailoverMarkerScope:                                             {operation: "FailoverMarker"},
 		HistoryReplicationV2TaskScope:                                   {operation: "HistoryReplicationV2Task"},
 		SyncActivityTaskScope:                                           {operation: "SyncActivityTask"},
@@ -1722,6 +1847,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 		ESAnalyzerScope:                        {operation: "ESAnalyzer"},
+		DomainUsageAggregatorScope:             {operation: "DomainUsageAggregator"},
 	},
 }
 
@@ -1912,6 +2038,7 @@ const (
 	TaskAttemptTimerPerDomain
 	TaskStandbyRetryCounterPerDomain
 	TaskPendingActiveCounterPerDomain
+	TaskWorkflowSuspendedCounterPerDomain
 	TaskNotActiveCounterPerDomain
 	TaskTargetNotActiveCounterPerDomain
 	TaskLimitExceededCounterPerDomain
@@ -2087,6 +2214,7 @@ const (
 	ReplicationTasksFetched
 	ReplicationTasksReturned
 	ReplicationTasksReturnedDiff
+	ReplicationTokenShardOutOfRange
 	ReplicationTasksAppliedLatency
 	ReplicationDLQFailed
 	ReplicationDLQMaxLevelGauge
@@ -2094,6 +2222,12 @@ const (
 	ReplicationDLQProbeFailed
 	ReplicationDLQSize
 	ReplicationDLQValidationFailed
+	ReplicationDLQDomainSize
+	ReplicationDLQAge
+	ReplicationDLQDeadSize
+	ReplicationDLQRedriveSuccess
+	ReplicationDLQRedriveFailed
+	ReplicationDLQRedriveDead
 	GetReplicationMessagesForShardLatency
 	GetDLQReplicationMessagesLatency
 	EventReapplySkippedCount
@@ -2124,6 +2258,8 @@ const (
 	FailoverMarkerUpdateShardFailure
 	FailoverMarkerCallbackCount
 	HistoryFailoverCallbackCount
+	WorkflowTaskDeletedCount
+	WorkflowTaskReenqueuedCount
 
 	NumHistoryMetrics
 )
@@ -2240,6 +2376,10 @@ const (
 	ESAnalyzerNumStuckWorkflowsDiscovered
 	ESAnalyzerNumStuckWorkflowsRefreshed
 	ESAnalyzerNumStuckWorkflowsFailedToRefresh
+	DomainUsageHistorySize
+	DomainUsageHistoryEventCount
+	DomainUsageOpenWorkflowCount
+	DomainUsageClosedWorkflowCount
 
 	NumWorkerMetrics
 )
@@ -2450,6 +2590,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskUnsupportedPerDomain:                 {metricName: "task_errors_unsupported_per_domain", metricRollupName: "task_errors_discarded", metricType: Counter},
 		TaskStandbyRetryCounterPerDomain:         {metricName: "task_errors_standby_retry_counter_per_domain", metricRollupName: "task_errors_standby_retry_counter", metricType: Counter},
 		TaskPendingActiveCounterPerDomain:        {metricName: "task_errors_pending_active_counter_per_domain", metricRollupName: "task_errors_pending_active_counter", metricType: Counter},
+		TaskWorkflowSuspendedCounterPerDomain:    {metricName: "task_errors_workflow_suspended_counter_per_domain", metricRollupName: "task_errors_workflow_suspended_counter", metricType: Counter},
 		TaskNotActiveCounterPerDomain:            {metricName: "task_errors_not_active_counter_per_domain", metricRollupName: "task_errors_not_active_counter", metricType: Counter},
 		TaskTargetNotActiveCounterPerDomain:      {metricName: "task_errors_target_not_active_counter_per_domain", metricRollupName: "task_errors_target_not_active_counter", metricType: Counter},
 		TaskLimitExceededCounterPerDomain:        {metricName: "task_errors_limit_exceeded_counter_per_domain", metricRollupName: "task_errors_limit_exceeded_counter", metricType: Counter},
@@ -2617,6 +2758,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTasksFetched:                           {metricName: "replication_tasks_fetched", metricType: Timer},
 		ReplicationTasksReturned:                          {metricName: "replication_tasks_returned", metricType: Timer},
 		ReplicationTasksReturnedDiff:                      {metricName: "replication_tasks_returned_diff", metricType: Timer},
+		ReplicationTokenShardOutOfRange:                   {metricName: "replication_token_shard_out_of_range", metricType: Counter},
 		ReplicationTasksAppliedLatency:                    {metricName: "replication_tasks_applied_latency", metricType: Timer},
 		ReplicationDLQFailed:                              {metricName: "replication_dlq_enqueue_failed", metricType: Counter},
 		ReplicationDLQMaxLevelGauge:                       {metricName: "replication_dlq_max_level", metricType: Gauge},
@@ -2624,6 +2766,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationDLQProbeFailed:                         {metricName: "replication_dlq_probe_failed", metricType: Counter},
 		ReplicationDLQSize:                                {metricName: "replication_dlq_size", metricType: Gauge},
 		ReplicationDLQValidationFailed:                    {metricName: "replication_dlq_validation_failed", metricType: Counter},
+		ReplicationDLQDomainSize:                          {metricName: "replication_dlq_domain_size", metricType: Gauge},
+		ReplicationDLQAge:                                 {metricName: "replication_dlq_age", metricType: Gauge},
+		ReplicationDLQDeadSize:                            {metricName: "replication_dlq_dead_size", metricType: Gauge},
+		ReplicationDLQRedriveSuccess:                      {metricName: "replication_dlq_redrive_success", metricType: Counter},
+		ReplicationDLQRedriveFailed:                       {metricName: "replication_dlq_redrive_failed", metricType: Counter},
+		ReplicationDLQRedriveDead:                         {metricName: "replication_dlq_redrive_dead", metricType: Counter},
 		GetReplicationMessagesForShardLatency:             {metricName: "get_replication_messages_for_shard", metricType: Timer},
 		GetDLQReplicationMessagesLatency:                  {metricName: "get_dlq_replication_messages", metricType: Timer},
 		EventReapplySkippedCount:                          {metricName: "event_reapply_skipped_count", metricType: Counter},
@@ -2654,6 +2802,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		FailoverMarkerUpdateShardFailure:                  {metricName: "failover_marker_update_shard_failures", metricType: Counter},
 		FailoverMarkerCallbackCount:                       {metricName: "failover_marker_callback_count", metricType: Counter},
 		HistoryFailoverCallbackCount:                      {metricName: "failover_callback_handler_count", metricType: Counter},
+		WorkflowTaskDeletedCount:                          {metricName: "workflow_task_deleted", metricType: Counter},
+		WorkflowTaskReenqueuedCount:                       {metricName: "workflow_task_reenqueued", metricType: Counter},
 		TransferTasksCount:                                {metricName: "transfer_tasks_count", metricType: Timer},
 		TimerTasksCount:                                   {metricName: "timer_tasks_count", metricType: Timer},
 		CrossClusterTasksCount:                            {metricName: "cross_cluster_tasks_count", metricType: Timer},
@@ -2766,6 +2916,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ESAnalyzerNumStuckWorkflowsDiscovered:         {metricName: "es_analyzer_num_stuck_workflows_discovered", metricType: Counter},
 		ESAnalyzerNumStuckWorkflowsRefreshed:          {metricName: "es_analyzer_num_stuck_workflows_refreshed", metricType: Counter},
 		ESAnalyzerNumStuckWorkflowsFailedToRefresh:    {metricName: "es_analyzer_num_stuck_workflows_failed_to_refresh", metricType: Counter},
+		DomainUsageHistorySize:                        {metricName: "domain_usage_history_size", metricType: Gauge},
+		DomainUsageHistoryEventCount:                  {metricName: "domain_usage_history_event_count", metricType: Gauge},
+		DomainUsageOpenWorkflowCount:                  {metricName: "domain_usage_open_workflow_count", metricType: Gauge},
+		DomainUsageClosedWorkflowCount:                {metricName: "domain_usage_closed_workflow_count", metricType: Gauge},
 	},
 }
 
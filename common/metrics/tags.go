@@ -47,6 +47,8 @@ const (
 	transport              = "transport"
 	caller                 = "caller"
 	signalName             = "signalName"
+	replicationMode        = "replication_mode"
+	deadReason             = "dead_reason"
 
 	allValue     = "all"
 	unknownValue = "_unknown_"
@@ -174,3 +176,13 @@ func SignalNameTag(value string) Tag {
 func SignalNameAllTag() Tag {
 	return metricWithUnknown(signalName, allValue)
 }
+
+// ReplicationModeTag returns a new replication mode tag, either polling or streaming.
+func ReplicationModeTag(value string) Tag {
+	return simpleMetric{key: replicationMode, value: value}
+}
+
+// DeadReasonTag returns a new tag with the reason a replication DLQ task is given up on.
+func DeadReasonTag(value string) Tag {
+	return metricWithUnknown(deadReason, value)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/dynamicconfig"
 	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/encryption"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -100,6 +101,7 @@ type (
 		logger        log.Logger
 		datastores    map[storeType]Datastore
 		clusterName   string
+		encryptor     encryption.Encryptor
 	}
 
 	storeType int
@@ -147,6 +149,11 @@ func NewFactory(
 		logger:        logger,
 		clusterName:   clusterName,
 	}
+	keyProvider, err := encryption.NewKeyProvider(cfg.PayloadEncryption)
+	if err != nil {
+		logger.Fatal("Creating payload encryption key provider failed", tag.Error(err))
+	}
+	factory.encryptor = encryption.NewEncryptor(keyProvider)
 	limiters := buildRatelimiters(cfg, persistenceMaxQPS)
 	factory.init(clusterName, limiters)
 	return factory
@@ -199,7 +206,7 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.encryptor, f.config.TransactionSizeLimit)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = p.NewHistoryPersistenceErrorInjectionClient(result, errorRate, f.logger)
 	}
@@ -241,7 +248,7 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManagerImpl(store, f.logger)
+	result := p.NewExecutionManagerImpl(store, f.logger, f.encryptor)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = p.NewWorkflowExecutionPersistenceErrorInjectionClient(result, errorRate, f.logger)
 	}
@@ -278,7 +285,7 @@ func (f *factoryImpl) NewVisibilityManager(
 			f.logger.Fatal("Creating visibility producer failed", tag.Error(err))
 		}
 		visibilityFromES = newESVisibilityManager(
-			visibilityIndexName, params.ESClient, resourceConfig, visibilityProducer, params.MetricsClient, f.logger,
+			visibilityIndexName, params.ESClient, resourceConfig, visibilityProducer, params.MetricsClient, f.encryptor, f.logger,
 		)
 	}
 	return p.NewVisibilityDualManager(
@@ -299,11 +306,12 @@ func newESVisibilityManager(
 	visibilityConfig *service.Config,
 	producer messaging.Producer,
 	metricsClient metrics.Client,
+	encryptor encryption.Encryptor,
 	log log.Logger,
 ) p.VisibilityManager {
 
 	visibilityFromESStore := elasticsearch.NewElasticSearchVisibilityStore(esClient, indexName, producer, visibilityConfig, log)
-	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, log)
+	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, log, encryptor)
 
 	// wrap with rate limiter
 	if visibilityConfig.PersistenceMaxQPS != nil && visibilityConfig.PersistenceMaxQPS() != 0 {
@@ -337,7 +345,7 @@ func (f *factoryImpl) newDBVisibilityManager(
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewVisibilityManagerImpl(store, f.logger)
+	result := p.NewVisibilityManagerImpl(store, f.logger, f.encryptor)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = p.NewVisibilityPersistenceErrorInjectionClient(result, errorRate, f.logger)
 	}
@@ -116,6 +116,7 @@ const (
 	WorkflowStateZombie
 	WorkflowStateVoid
 	WorkflowStateCorrupted
+	WorkflowStateSuspended
 )
 
 // Workflow execution close status
@@ -155,6 +156,7 @@ const (
 	TransferTaskTypeRecordWorkflowClosed
 	TransferTaskTypeRecordChildExecutionCompleted
 	TransferTaskTypeApplyParentClosePolicy
+	TransferTaskTypeWorkflowLifecycleEvents
 )
 
 // Types of cross-cluster tasks
@@ -285,6 +287,7 @@ type (
 		ClusterReplicationLevel           map[string]int64                  `json:"cluster_replication_level"`
 		DomainNotificationVersion         int64                             `json:"domain_notification_version"`
 		PendingFailoverMarkers            []*types.FailoverMarkerAttributes `json:"pending_failover_markers"`
+		DomainUsages                      *types.DomainUsages               `json:"domain_usages"`
 	}
 
 	// WorkflowExecutionInfo describes a workflow execution
@@ -346,6 +349,11 @@ type (
 		CronSchedule      string
 		IsCron            bool
 		ExpirationSeconds int32 // TODO: is this field useful?
+		// RetentionDays overrides the domain retention for this run when positive
+		RetentionDays int32
+		// PayloadEncryptionKeyID is set if the raw payloads of the execution, e.g. activity details, signal
+		// inputs and memo, are encrypted. It is the ID of the key they were first encrypted with.
+		PayloadEncryptionKeyID string
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -412,6 +420,9 @@ type (
 		BranchToken       []byte
 		NewRunBranchToken []byte
 		CreationTime      int64
+		// DeadReason and RedriveAttempts are only set for tasks in the dead replication DLQ
+		DeadReason      string
+		RedriveAttempts int
 	}
 
 	// TimerTaskInfo describes a timer task.
@@ -606,6 +617,15 @@ type (
 		Version             int64
 	}
 
+	// WorkflowLifecycleEventsTask identifies a transfer task for publishing the workflow lifecycle events
+	// in the history event batch starting at FirstEventID
+	WorkflowLifecycleEventsTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		FirstEventID        int64
+		Version             int64
+	}
+
 	// CrossClusterStartChildExecutionTask is the cross-cluster version of StartChildExecutionTask
 	CrossClusterStartChildExecutionTask struct {
 		StartChildExecutionTask
@@ -860,6 +880,8 @@ type (
 		PreviousLastWriteVersion int64
 
 		NewWorkflowSnapshot WorkflowSnapshot
+
+		EncryptionKeyID string // optional ID of the key used to encrypt payloads
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -946,7 +968,8 @@ type (
 
 		NewWorkflowSnapshot *WorkflowSnapshot
 
-		Encoding common.EncodingType // optional binary encoding type
+		Encoding        common.EncodingType // optional binary encoding type
+		EncryptionKeyID string              // optional ID of the key used to encrypt payloads
 	}
 
 	// ConflictResolveWorkflowExecutionRequest is used to reset workflow execution state for a single run
@@ -964,7 +987,8 @@ type (
 		// current workflow
 		CurrentWorkflowMutation *WorkflowMutation
 
-		Encoding common.EncodingType // optional binary encoding type
+		Encoding        common.EncodingType // optional binary encoding type
+		EncryptionKeyID string              // optional ID of the key used to encrypt payloads
 	}
 
 	// WorkflowEvents is used as generic workflow history events transaction container
@@ -1395,6 +1419,7 @@ type (
 		Info                        *DomainInfo
 		Config                      *DomainConfig
 		ReplicationConfig           *DomainReplicationConfig
+		IsGlobalDomain              bool
 		ConfigVersion               int64
 		FailoverVersion             int64
 		FailoverNotificationVersion int64
@@ -1509,6 +1534,8 @@ type (
 		TransactionID int64
 		// optional binary encoding type
 		Encoding common.EncodingType
+		// optional ID of the key used to encrypt the events
+		EncryptionKeyID string
 		// The shard to get history node data
 		ShardID *int
 	}
@@ -2428,6 +2455,41 @@ func (u *ApplyParentClosePolicyTask) SetVisibilityTimestamp(timestamp time.Time)
 	u.VisibilityTimestamp = timestamp
 }
 
+// GetType returns the type of the workflow lifecycle events transfer task
+func (u *WorkflowLifecycleEventsTask) GetType() int {
+	return TransferTaskTypeWorkflowLifecycleEvents
+}
+
+// GetVersion returns the version of the workflow lifecycle events transfer task
+func (u *WorkflowLifecycleEventsTask) GetVersion() int64 {
+	return u.Version
+}
+
+// SetVersion sets the version of the workflow lifecycle events transfer task
+func (u *WorkflowLifecycleEventsTask) SetVersion(version int64) {
+	u.Version = version
+}
+
+// GetTaskID returns the sequence ID of the workflow lifecycle events transfer task
+func (u *WorkflowLifecycleEventsTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the workflow lifecycle events transfer task
+func (u *WorkflowLifecycleEventsTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (u *WorkflowLifecycleEventsTask) GetVisibilityTimestamp() time.Time {
+	return u.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (u *WorkflowLifecycleEventsTask) SetVisibilityTimestamp(timestamp time.Time) {
+	u.VisibilityTimestamp = timestamp
+}
+
 // GetType returns the type of the upsert search attributes transfer task
 func (u *UpsertWorkflowSearchAttributesTask) GetType() int {
 	return TransferTaskTypeUpsertWorkflowSearchAttributes
@@ -2805,6 +2867,17 @@ func (s *ShardInfo) Copy() *ShardInfo {
 	for k, v := range s.ReplicationDLQAckLevel {
 		replicationDLQAckLevel[k] = v
 	}
+	var domainUsages *types.DomainUsages
+	if s.DomainUsages != nil {
+		// domain usages are updated in place by the shard owner, so they are always deep copied
+		domainUsages = &types.DomainUsages{
+			UsagesByDomainID: make(map[string]*types.DomainUsage, len(s.DomainUsages.UsagesByDomainID)),
+		}
+		for k, v := range s.DomainUsages.UsagesByDomainID {
+			usage := *v
+			domainUsages.UsagesByDomainID[k] = &usage
+		}
+	}
 	return &ShardInfo{
 		ShardID:                           s.ShardID,
 		Owner:                             s.Owner,
@@ -2822,6 +2895,7 @@ func (s *ShardInfo) Copy() *ShardInfo {
 		ClusterReplicationLevel:           clusterReplicationLevel,
 		ReplicationDLQAckLevel:            replicationDLQAckLevel,
 		PendingFailoverMarkers:            s.PendingFailoverMarkers,
+		DomainUsages:                      domainUsages,
 		UpdatedAt:                         s.UpdatedAt,
 	}
 }
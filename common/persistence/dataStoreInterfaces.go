@@ -265,6 +265,8 @@ type (
 		BranchToken       []byte
 		NewRunBranchToken []byte
 		CreationTime      time.Time
+		DeadReason        string
+		RedriveAttempts   int
 	}
 
 	// InternalWorkflowExecutionInfo describes a workflow execution for Persistence Interface
@@ -324,6 +326,9 @@ type (
 		ExpirationSeconds  time.Duration
 		Memo               map[string][]byte
 		SearchAttributes   map[string][]byte
+		Retention          time.Duration
+		// PayloadEncryptionKeyID is set if the raw payloads of the execution are encrypted
+		PayloadEncryptionKeyID string
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -797,6 +802,7 @@ type (
 		Info                        *DomainInfo
 		Config                      *InternalDomainConfig
 		ReplicationConfig           *DomainReplicationConfig
+		IsGlobalDomain              bool
 		ConfigVersion               int64
 		FailoverVersion             int64
 		FailoverNotificationVersion int64
@@ -831,6 +837,7 @@ type (
 		ClusterReplicationLevel           map[string]int64     `json:"cluster_replication_level"`
 		DomainNotificationVersion         int64                `json:"domain_notification_version"`
 		PendingFailoverMarkers            *DataBlob            `json:"pending_failover_markers"`
+		DomainUsages                      *DataBlob            `json:"domain_usages"`
 	}
 
 	// InternalCreateShardRequest is request to CreateShard
@@ -930,6 +937,10 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 		return common.EncodingTypeJSON
 	case common.EncodingTypeThriftRW:
 		return common.EncodingTypeThriftRW
+	case common.EncodingTypeJSONEncrypted:
+		return common.EncodingTypeJSONEncrypted
+	case common.EncodingTypeThriftRWEncrypted:
+		return common.EncodingTypeThriftRWEncrypted
 	case common.EncodingTypeEmpty:
 		return common.EncodingTypeEmpty
 	default:
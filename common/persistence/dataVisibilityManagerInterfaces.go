@@ -50,6 +50,7 @@ type (
 		IsCron             bool
 		NumClusters        int16
 		SearchAttributes   map[string][]byte
+		EncryptionKeyID    string // not persisted, optional ID of the key used to encrypt the memo
 	}
 
 	// RecordWorkflowExecutionClosedRequest is used to add a record of a newly
@@ -71,6 +72,7 @@ type (
 		IsCron             bool
 		NumClusters        int16
 		SearchAttributes   map[string][]byte
+		EncryptionKeyID    string // not persisted, optional ID of the key used to encrypt the memo
 	}
 
 	// UpsertWorkflowExecutionRequest is used to upsert workflow execution
@@ -88,6 +90,7 @@ type (
 		IsCron             bool
 		NumClusters        int16
 		SearchAttributes   map[string][]byte
+		EncryptionKeyID    string // not persisted, optional ID of the key used to encrypt the memo
 	}
 
 	// ListWorkflowExecutionsRequest is used to list executions in a domain
@@ -134,6 +137,8 @@ type (
 	// CountWorkflowExecutionsResponse is response to CountWorkflowExecutions
 	CountWorkflowExecutionsResponse struct {
 		Count int64
+		// Groups holds the bucketed counts when the query has a GROUP BY clause
+		Groups []*types.CountWorkflowExecutionsGroup
 	}
 
 	// ListWorkflowExecutionsByTypeRequest is used to list executions of
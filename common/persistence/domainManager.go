@@ -117,6 +117,7 @@ func (m *domainManagerImpl) UpdateDomain(
 		Info:                        request.Info,
 		Config:                      &dc,
 		ReplicationConfig:           request.ReplicationConfig,
+		IsGlobalDomain:              request.IsGlobalDomain,
 		ConfigVersion:               request.ConfigVersion,
 		FailoverVersion:             request.FailoverVersion,
 		FailoverNotificationVersion: request.FailoverNotificationVersion,
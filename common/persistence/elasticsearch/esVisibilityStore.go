@@ -21,6 +21,7 @@
 package elasticsearch
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -33,12 +34,14 @@ import (
 
 	"github.com/cch123/elasticsql"
 	"github.com/valyala/fastjson"
+	"github.com/xwb1989/sqlparser"
 
 	"github.com/uber/cadence/.gen/go/indexer"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/definition"
 	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/elasticsearch/esql"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -60,6 +63,15 @@ type (
 		logger   log.Logger
 		config   *service.Config
 	}
+
+	// compositeAggregationResult is the subset of an ElasticSearch composite aggregation result used for GROUP BY
+	compositeAggregationResult struct {
+		AfterKey json.RawMessage `json:"after_key"`
+		Buckets  []struct {
+			Key      map[string]interface{} `json:"key"`
+			DocCount int64                  `json:"doc_count"`
+		} `json:"buckets"`
+	}
 )
 
 var _ p.VisibilityStore = (*esVisibilityStore)(nil)
@@ -442,11 +454,15 @@ func (v *esVisibilityStore) CountWorkflowExecutions(
 ) (
 	*p.CountWorkflowExecutionsResponse, error) {
 
-	queryDSL, err := getESQueryDSLForCount(request)
+	queryDSL, groupByFields, err := getESQueryDSLForCount(request)
 	if err != nil {
 		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
 	}
 
+	if len(groupByFields) != 0 {
+		return v.countWorkflowExecutionsByGroup(ctx, queryDSL, groupByFields)
+	}
+
 	count, err := v.esClient.CountByQuery(ctx, v.index, queryDSL)
 	if err != nil {
 		return nil, &types.InternalServiceError{
@@ -458,6 +474,76 @@ func (v *esVisibilityStore) CountWorkflowExecutions(
 	return response, nil
 }
 
+// countWorkflowExecutionsByGroup pages through the composite aggregation of a GROUP BY count query,
+// returning one group per distinct combination of values of the group by fields.
+// The query is rejected once it yields more groups than ESCountMaxGroups.
+func (v *esVisibilityStore) countWorkflowExecutionsByGroup(
+	ctx context.Context,
+	queryDSL string,
+	groupByFields []string,
+) (*p.CountWorkflowExecutionsResponse, error) {
+
+	dsl, err := fastjson.Parse(queryDSL)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+
+	response := &p.CountWorkflowExecutionsResponse{}
+	for {
+		searchResult, err := v.esClient.SearchRaw(ctx, v.index, dsl.String())
+		if err != nil {
+			return nil, &types.InternalServiceError{
+				Message: fmt.Sprintf("CountWorkflowExecutions failed. Error: %v", err),
+			}
+		}
+
+		aggregation, ok := searchResult.Aggregations[dslAggsGroupBy]
+		if !ok {
+			break
+		}
+		var groupByResult compositeAggregationResult
+		decoder := json.NewDecoder(bytes.NewReader(aggregation))
+		decoder.UseNumber()
+		if err := decoder.Decode(&groupByResult); err != nil {
+			return nil, &types.InternalServiceError{
+				Message: fmt.Sprintf("CountWorkflowExecutions failed to decode aggregation. Error: %v", err),
+			}
+		}
+
+		for _, bucket := range groupByResult.Buckets {
+			groupValues := make([]string, len(groupByFields))
+			for i, field := range groupByFields {
+				// missing values are grouped into a nil key, which is returned as an empty string
+				if value := bucket.Key[dslGroupByKeyPrefix+field]; value != nil {
+					groupValues[i] = fmt.Sprintf("%v", value)
+				}
+			}
+			response.Groups = append(response.Groups, &types.CountWorkflowExecutionsGroup{
+				GroupValues: groupValues,
+				Count:       bucket.DocCount,
+			})
+			response.Count += bucket.DocCount
+		}
+		if maxGroups := v.config.ESCountMaxGroups(); len(response.Groups) > maxGroups {
+			return nil, &types.BadRequestError{
+				Message: fmt.Sprintf("CountWorkflowExecutions returns more than %v groups, narrow down the query or group by fewer fields", maxGroups),
+			}
+		}
+
+		if len(groupByResult.Buckets) == 0 || len(groupByResult.AfterKey) == 0 {
+			break
+		}
+		afterKey, err := fastjson.ParseBytes(groupByResult.AfterKey)
+		if err != nil {
+			return nil, &types.InternalServiceError{
+				Message: fmt.Sprintf("CountWorkflowExecutions failed to decode aggregation. Error: %v", err),
+			}
+		}
+		dsl.Get(dslFieldAggs, dslAggsGroupBy, dslFieldComposite).Set(dslFieldAfter, afterKey)
+	}
+	return response, nil
+}
+
 const (
 	jsonMissingCloseTime     = `{"missing":{"field":"CloseTime"}}`
 	jsonRangeOnExecutionTime = `{"range":{"ExecutionTime":`
@@ -468,6 +554,14 @@ const (
 	dslFieldSearchAfter = "search_after"
 	dslFieldFrom        = "from"
 	dslFieldSize        = "size"
+	dslFieldAggs        = "aggs"
+	dslFieldComposite   = "composite"
+	dslFieldAfter       = "after"
+
+	// dslAggsGroupBy and dslGroupByKeyPrefix are the aggregation name and bucket key prefix
+	// used by esql when translating a GROUP BY clause into a composite aggregation
+	dslAggsGroupBy      = "groupby"
+	dslGroupByKeyPrefix = "group_"
 
 	defaultDateTimeFormat = time.RFC3339 // used for converting UnixNano to string like 2018-02-15T16:16:36-08:00
 )
@@ -499,11 +593,15 @@ func getESQueryDSLForScan(request *p.ListWorkflowExecutionsByQueryRequest) (stri
 	return dsl.String(), nil
 }
 
-func getESQueryDSLForCount(request *p.CountWorkflowExecutionsRequest) (string, error) {
+func getESQueryDSLForCount(request *p.CountWorkflowExecutionsRequest) (string, []string, error) {
 	sql := getSQLFromCountRequest(request)
+	sql, groupBy, err := splitGroupByFromSQL(sql)
+	if err != nil {
+		return "", nil, err
+	}
 	dsl, err := getCustomizedDSLFromSQL(sql, request.DomainUUID)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	// remove not needed fields
@@ -511,7 +609,48 @@ func getESQueryDSLForCount(request *p.CountWorkflowExecutionsRequest) (string, e
 	dsl.Del(dslFieldSize)
 	dsl.Del(dslFieldSort)
 
-	return dsl.String(), nil
+	if len(groupBy) == 0 {
+		return dsl.String(), nil, nil
+	}
+
+	// elasticsql has no composite aggregation support, so translate GROUP BY with esql instead
+	aggsDSL, _, err := esql.NewESql().Convert("select * from dummy" + sqlparser.String(groupBy))
+	if err != nil {
+		return "", nil, err
+	}
+	aggs, err := fastjson.Parse(aggsDSL)
+	if err != nil {
+		return "", nil, err
+	}
+	dsl.Set(dslFieldAggs, aggs.Get(dslFieldAggs))
+	dsl.Set(dslFieldSize, fastjson.MustParse("0"))
+
+	groupByFields := make([]string, 0, len(groupBy))
+	for _, expr := range groupBy {
+		groupByFields = append(groupByFields, strings.Replace(sqlparser.String(expr), "`", "", -1))
+	}
+	return dsl.String(), groupByFields, nil
+}
+
+// splitGroupByFromSQL removes the GROUP BY clause from sql and returns it separately
+func splitGroupByFromSQL(sql string) (string, sqlparser.GroupBy, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return "", nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.GroupBy) == 0 {
+		return sql, nil, nil
+	}
+	for _, expr := range sel.GroupBy {
+		if _, ok := expr.(*sqlparser.ColName); !ok {
+			return "", nil, fmt.Errorf("GROUP BY %v is not supported", sqlparser.String(expr))
+		}
+	}
+
+	groupBy := sel.GroupBy
+	sel.GroupBy = nil
+	return sqlparser.String(sel), groupBy, nil
 }
 
 func (v *esVisibilityStore) getESQueryDSL(request *p.ListWorkflowExecutionsByQueryRequest, token *es.ElasticVisibilityPageToken) (string, error) {
@@ -556,8 +695,11 @@ func getSQLFromListRequest(request *p.ListWorkflowExecutionsByQueryRequest) stri
 
 func getSQLFromCountRequest(request *p.CountWorkflowExecutionsRequest) string {
 	var sql string
-	if strings.TrimSpace(request.Query) == "" {
+	query := strings.TrimSpace(request.Query)
+	if query == "" {
 		sql = "select * from dummy"
+	} else if common.IsJustOrderByClause(query) || common.IsJustGroupByClause(query) {
+		sql = fmt.Sprintf("select * from dummy %s", request.Query)
 	} else {
 		sql = fmt.Sprintf("select * from dummy where %s", request.Query)
 	}
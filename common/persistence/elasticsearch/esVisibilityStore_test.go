@@ -91,6 +91,7 @@ var (
 	filterByStatus = fmt.Sprintf("map[match:map[CloseStatus:map[query:%v]]]", testCloseStatus)
 
 	esIndexMaxResultWindow = 3
+	esCountMaxGroups       = 10
 )
 
 func TestESVisibilitySuite(t *testing.T) {
@@ -104,6 +105,7 @@ func (s *ESVisibilitySuite) SetupTest() {
 	s.mockESClient = &esMocks.GenericClient{}
 	config := &service.Config{
 		ESIndexMaxResultWindow: dynamicconfig.GetIntPropertyFn(esIndexMaxResultWindow),
+		ESCountMaxGroups:       dynamicconfig.GetIntPropertyFn(esCountMaxGroups),
 		ValidSearchAttributes:  dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
 	}
 
@@ -693,24 +695,44 @@ func (s *ESVisibilitySuite) TestGetESQueryDSLForCount() {
 	}
 
 	// empty query
-	dsl, err := getESQueryDSLForCount(request)
+	dsl, groupBy, err := getESQueryDSLForCount(request)
 	s.Nil(err)
+	s.Nil(groupBy)
 	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_all":{}}]}}]}}}`, dsl)
 
 	request.Query = `WorkflowID = 'wid' order by StartTime desc`
-	dsl, err = getESQueryDSLForCount(request)
+	dsl, groupBy, err = getESQueryDSLForCount(request)
 	s.Nil(err)
+	s.Nil(groupBy)
 	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_phrase":{"WorkflowID":{"query":"wid"}}}]}}]}}}`, dsl)
 
 	request.Query = `CloseTime < "2018-06-07T15:04:05+07:00" and StartTime > "2018-05-04T16:00:00+07:00" and ExecutionTime >= "2018-05-05T16:00:00+07:00"`
-	dsl, err = getESQueryDSLForCount(request)
+	dsl, groupBy, err = getESQueryDSLForCount(request)
 	s.Nil(err)
+	s.Nil(groupBy)
 	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"range":{"ExecutionTime":{"gt":"0"}}},{"bool":{"must":[{"range":{"CloseTime":{"lt":"1528358645000000000"}}},{"range":{"StartTime":{"gt":"1525424400000000000"}}},{"range":{"ExecutionTime":{"from":"1525510800000000000"}}}]}}]}}]}}}`, dsl)
 
 	request.Query = `ExecutionTime < 1000`
-	dsl, err = getESQueryDSLForCount(request)
+	dsl, groupBy, err = getESQueryDSLForCount(request)
 	s.Nil(err)
+	s.Nil(groupBy)
 	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"range":{"ExecutionTime":{"gt":"0"}}},{"bool":{"must":[{"range":{"ExecutionTime":{"lt":"1000"}}}]}}]}}]}}}`, dsl)
+
+	request.Query = `CloseStatus = 0 group by WorkflowType, ` + "`Attr.CustomKeywordField`"
+	dsl, groupBy, err = getESQueryDSLForCount(request)
+	s.Nil(err)
+	s.Equal([]string{"WorkflowType", "Attr.CustomKeywordField"}, groupBy)
+	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_phrase":{"CloseStatus":{"query":"0"}}}]}}]}},"aggs":{"groupby":{"composite":{"size":1000,"sources":[{"group_WorkflowType":{"terms":{"field":"WorkflowType","missing_bucket":true}}},{"group_Attr.CustomKeywordField":{"terms":{"field":"Attr.CustomKeywordField","missing_bucket":true}}}]}}},"size":0}`, dsl)
+
+	request.Query = ` group by WorkflowType`
+	dsl, groupBy, err = getESQueryDSLForCount(request)
+	s.Nil(err)
+	s.Equal([]string{"WorkflowType"}, groupBy)
+	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_all":{}}]}}]}},"aggs":{"groupby":{"composite":{"size":1000,"sources":[{"group_WorkflowType":{"terms":{"field":"WorkflowType","missing_bucket":true}}}]}}},"size":0}`, dsl)
+
+	request.Query = `WorkflowID = 'wid' group by count(*)`
+	_, _, err = getESQueryDSLForCount(request)
+	s.Error(err)
 }
 
 func (s *ESVisibilitySuite) TestAddDomainToQuery() {
@@ -834,6 +856,78 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.True(strings.Contains(err.Error(), "Error when parse query"))
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
+	firstPage := `{"after_key":{"group_WorkflowType":"wf2","group_CloseStatus":1},"buckets":[` +
+		`{"key":{"group_WorkflowType":"wf1","group_CloseStatus":0},"doc_count":5},` +
+		`{"key":{"group_WorkflowType":"wf2","group_CloseStatus":1},"doc_count":3}]}`
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		return !strings.Contains(input, `"after"`)
+	})).Return(&es.RawResponse{
+		Aggregations: map[string]json.RawMessage{"groupby": json.RawMessage(firstPage)},
+	}, nil).Once()
+	lastPage := `{"after_key":{"group_WorkflowType":null,"group_CloseStatus":null},"buckets":[` +
+		`{"key":{"group_WorkflowType":null,"group_CloseStatus":null},"doc_count":2}]}`
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		return strings.Contains(input, `"after":{"group_WorkflowType":"wf2","group_CloseStatus":1}`)
+	})).Return(&es.RawResponse{
+		Aggregations: map[string]json.RawMessage{"groupby": json.RawMessage(lastPage)},
+	}, nil).Once()
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		return strings.Contains(input, `"after":{"group_WorkflowType":null,"group_CloseStatus":null}`)
+	})).Return(&es.RawResponse{
+		Aggregations: map[string]json.RawMessage{"groupby": json.RawMessage(`{"buckets":[]}`)},
+	}, nil).Once()
+
+	request := &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Domain:     testDomain,
+		Query:      `group by WorkflowType, CloseStatus`,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	resp, err := s.visibilityStore.CountWorkflowExecutions(ctx, request)
+	s.NoError(err)
+	s.Equal(int64(10), resp.Count)
+	s.Equal([]*types.CountWorkflowExecutionsGroup{
+		{GroupValues: []string{"wf1", "0"}, Count: 5},
+		{GroupValues: []string{"wf2", "1"}, Count: 3},
+		{GroupValues: []string{"", ""}, Count: 2},
+	}, resp.Groups)
+
+	// test internal error
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.Anything).Return(nil, errTestESSearch).Once()
+
+	_, err = s.visibilityStore.CountWorkflowExecutions(ctx, request)
+	s.Error(err)
+	_, ok := err.(*types.InternalServiceError)
+	s.True(ok)
+	s.True(strings.Contains(err.Error(), "CountWorkflowExecutions failed"))
+}
+
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy_TooManyGroups() {
+	s.visibilityStore.config.ESCountMaxGroups = dynamicconfig.GetIntPropertyFn(1)
+	page := `{"after_key":{"group_WorkflowType":"wf2"},"buckets":[` +
+		`{"key":{"group_WorkflowType":"wf1"},"doc_count":5},` +
+		`{"key":{"group_WorkflowType":"wf2"},"doc_count":3}]}`
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.Anything).Return(&es.RawResponse{
+		Aggregations: map[string]json.RawMessage{"groupby": json.RawMessage(page)},
+	}, nil).Once()
+
+	request := &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Domain:     testDomain,
+		Query:      `group by WorkflowType`,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	_, err := s.visibilityStore.CountWorkflowExecutions(ctx, request)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func (s *ESVisibilitySuite) TestTimeProcessFunc() {
 	cases := []struct {
 		key   string
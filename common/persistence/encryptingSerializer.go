@@ -0,0 +1,149 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
+	"github.com/uber/cadence/common/types"
+)
+
+var (
+	encryptedEncodings = map[common.EncodingType]common.EncodingType{
+		common.EncodingTypeJSON:     common.EncodingTypeJSONEncrypted,
+		common.EncodingTypeThriftRW: common.EncodingTypeThriftRWEncrypted,
+	}
+	decryptedEncodings = map[common.EncodingType]common.EncodingType{
+		common.EncodingTypeJSONEncrypted:     common.EncodingTypeJSON,
+		common.EncodingTypeThriftRWEncrypted: common.EncodingTypeThriftRW,
+	}
+)
+
+type (
+	encryptingSerializer struct {
+		PayloadSerializer
+
+		encryptor encryption.Encryptor
+		keyID     string
+	}
+)
+
+// NewEncryptingPayloadSerializer returns a PayloadSerializer which encrypts serialized history events and
+// visibility memos with the key of the given ID. Payloads are written in plaintext if keyID is empty.
+// Encrypted payloads are marked by an encrypted encoding type, e.g. common.EncodingTypeThriftRWEncrypted,
+// and are decrypted on deserialization with the key they were encrypted with, so data written before a key
+// rotation stays readable.
+func NewEncryptingPayloadSerializer(
+	serializer PayloadSerializer,
+	encryptor encryption.Encryptor,
+	keyID string,
+) PayloadSerializer {
+	return &encryptingSerializer{
+		PayloadSerializer: serializer,
+		encryptor:         encryptor,
+		keyID:             keyID,
+	}
+}
+
+func (t *encryptingSerializer) SerializeBatchEvents(events []*types.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error) {
+	data, err := t.PayloadSerializer.SerializeBatchEvents(events, encodingType)
+	if err != nil {
+		return nil, err
+	}
+	return t.encrypt(data)
+}
+
+func (t *encryptingSerializer) DeserializeBatchEvents(data *DataBlob) ([]*types.HistoryEvent, error) {
+	data, err := t.decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+	return t.PayloadSerializer.DeserializeBatchEvents(data)
+}
+
+func (t *encryptingSerializer) SerializeEvent(event *types.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error) {
+	data, err := t.PayloadSerializer.SerializeEvent(event, encodingType)
+	if err != nil {
+		return nil, err
+	}
+	return t.encrypt(data)
+}
+
+func (t *encryptingSerializer) DeserializeEvent(data *DataBlob) (*types.HistoryEvent, error) {
+	data, err := t.decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+	return t.PayloadSerializer.DeserializeEvent(data)
+}
+
+func (t *encryptingSerializer) SerializeVisibilityMemo(memo *types.Memo, encodingType common.EncodingType) (*DataBlob, error) {
+	data, err := t.PayloadSerializer.SerializeVisibilityMemo(memo, encodingType)
+	if err != nil {
+		return nil, err
+	}
+	return t.encrypt(data)
+}
+
+func (t *encryptingSerializer) DeserializeVisibilityMemo(data *DataBlob) (*types.Memo, error) {
+	data, err := t.decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+	return t.PayloadSerializer.DeserializeVisibilityMemo(data)
+}
+
+func (t *encryptingSerializer) encrypt(data *DataBlob) (*DataBlob, error) {
+	if data == nil || t.keyID == "" {
+		return data, nil
+	}
+	encoding, ok := encryptedEncodings[data.Encoding]
+	if !ok {
+		return nil, NewCadenceSerializationError(fmt.Sprintf("encryption is not supported for encoding type: %v", data.Encoding))
+	}
+	encrypted, err := t.encryptor.Encrypt(t.keyID, data.Data)
+	if err != nil {
+		return nil, NewCadenceSerializationError(err.Error())
+	}
+	return &DataBlob{Data: encrypted, Encoding: encoding}, nil
+}
+
+func (t *encryptingSerializer) decrypt(data *DataBlob) (*DataBlob, error) {
+	return decryptDataBlob(t.encryptor, data)
+}
+
+// decryptDataBlob decrypts the blob if its encoding says it is encrypted, other blobs are returned as is
+func decryptDataBlob(encryptor encryption.Encryptor, data *DataBlob) (*DataBlob, error) {
+	if data == nil {
+		return data, nil
+	}
+	encoding, ok := decryptedEncodings[data.Encoding]
+	if !ok {
+		return data, nil
+	}
+	decrypted, err := encryptor.Decrypt(data.Data)
+	if err != nil {
+		return nil, NewCadenceDeserializationError(err.Error())
+	}
+	return &DataBlob{Data: decrypted, Encoding: encoding}, nil
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
+	"github.com/uber/cadence/common/types"
+)
+
+func newTestEncryptor(t *testing.T, keyIDs ...string) encryption.Encryptor {
+	content := "keys:\n"
+	for i, keyID := range keyIDs {
+		content += "  " + keyID + ": \"" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{byte(i + 1)}, encryption.KeySize)) + "\"\n"
+	}
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "keyring.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+	keyProvider, err := encryption.NewKeyringProvider(path)
+	require.NoError(t, err)
+	return encryption.NewEncryptor(keyProvider)
+}
+
+func TestEncryptingSerializer_Events(t *testing.T) {
+	encryptor := newTestEncryptor(t, "key-1")
+	serializer := NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryptor, "key-1")
+	event := &types.HistoryEvent{
+		EventID:   1,
+		EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+			Input: []byte("workflow input"),
+		},
+	}
+
+	for encoding, encryptedEncoding := range map[common.EncodingType]common.EncodingType{
+		common.EncodingTypeThriftRW: common.EncodingTypeThriftRWEncrypted,
+		common.EncodingTypeJSON:     common.EncodingTypeJSONEncrypted,
+	} {
+		blob, err := serializer.SerializeBatchEvents([]*types.HistoryEvent{event}, encoding)
+		require.NoError(t, err)
+		require.Equal(t, encryptedEncoding, blob.Encoding)
+		require.Equal(t, encryptedEncoding, blob.GetEncoding())
+		require.False(t, bytes.Contains(blob.Data, []byte("workflow input")))
+		events, err := serializer.DeserializeBatchEvents(blob)
+		require.NoError(t, err)
+		require.Equal(t, []*types.HistoryEvent{event}, events)
+
+		blob, err = serializer.SerializeEvent(event, encoding)
+		require.NoError(t, err)
+		require.Equal(t, encryptedEncoding, blob.Encoding)
+		deserialized, err := serializer.DeserializeEvent(blob)
+		require.NoError(t, err)
+		require.Equal(t, event, deserialized)
+	}
+}
+
+func TestEncryptingSerializer_VisibilityMemo(t *testing.T) {
+	encryptor := newTestEncryptor(t, "key-1")
+	serializer := NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryptor, "key-1")
+	memo := &types.Memo{Fields: map[string][]byte{"key": []byte("memo value")}}
+
+	blob, err := serializer.SerializeVisibilityMemo(memo, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	require.Equal(t, common.EncodingTypeThriftRWEncrypted, blob.Encoding)
+	deserialized, err := serializer.DeserializeVisibilityMemo(blob)
+	require.NoError(t, err)
+	require.Equal(t, memo, deserialized)
+}
+
+func TestEncryptingSerializer_PlaintextAndKeyRotation(t *testing.T) {
+	encryptor := newTestEncryptor(t, "key-1", "key-2")
+	plaintextSerializer := NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryptor, "")
+	oldKeySerializer := NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryptor, "key-1")
+	newKeySerializer := NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryptor, "key-2")
+	event := &types.HistoryEvent{
+		EventID:   1,
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			Input: []byte("signal input"),
+		},
+	}
+
+	plaintextBlob, err := plaintextSerializer.SerializeEvent(event, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	require.Equal(t, common.EncodingTypeThriftRW, plaintextBlob.Encoding)
+	oldKeyBlob, err := oldKeySerializer.SerializeEvent(event, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+
+	// data written in plaintext or with an older key stays readable after the domain switches to a new key
+	for _, blob := range []*DataBlob{plaintextBlob, oldKeyBlob} {
+		deserialized, err := newKeySerializer.DeserializeEvent(blob)
+		require.NoError(t, err)
+		require.Equal(t, event, deserialized)
+	}
+}
+
+func TestEncryptingSerializer_Errors(t *testing.T) {
+	encryptor := newTestEncryptor(t, "key-1")
+	event := &types.HistoryEvent{EventID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}
+
+	_, err := NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryptor, "unknown-key").SerializeEvent(event, common.EncodingTypeThriftRW)
+	require.IsType(t, &CadenceSerializationError{}, err)
+
+	blob, err := NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryptor, "key-1").SerializeEvent(event, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	_, err = NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryption.NewEncryptor(encryption.NewNoopKeyProvider()), "").DeserializeEvent(blob)
+	require.IsType(t, &CadenceDeserializationError{}, err)
+}
+
+func TestExecutionManager_RawPayloadEncryption(t *testing.T) {
+	encryptor := newTestEncryptor(t, "key-1", "key-2")
+	manager := NewExecutionManagerImpl(nil, nil, encryptor).(*executionManagerImpl)
+	memo := map[string][]byte{"key": []byte("memo value")}
+	activityInfos := []*ActivityInfo{{ScheduleID: 5, Details: []byte("heartbeat details")}}
+
+	// executions which do not record encryption keep their raw payloads in plaintext, even if the domain has a key
+	info := &WorkflowExecutionInfo{Memo: memo}
+	serializedInfo, err := manager.SerializeExecutionInfo(info, &ExecutionStats{}, common.EncodingTypeThriftRW, "key-1")
+	require.NoError(t, err)
+	require.Equal(t, memo, serializedInfo.Memo)
+	serializedActivityInfos, err := manager.SerializeUpsertActivityInfos(activityInfos, common.EncodingTypeThriftRW, "key-1", payloadEncryptionKeyID(info, "key-1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("heartbeat details"), serializedActivityInfos[0].Details)
+
+	// executions which record encryption are encrypted with the current key, or the recorded one if there is none
+	info = &WorkflowExecutionInfo{Memo: memo, PayloadEncryptionKeyID: "key-1"}
+	require.Equal(t, "key-2", payloadEncryptionKeyID(info, "key-2"))
+	require.Equal(t, "key-1", payloadEncryptionKeyID(info, ""))
+	serializedInfo, err = manager.SerializeExecutionInfo(info, &ExecutionStats{}, common.EncodingTypeThriftRW, "")
+	require.NoError(t, err)
+	require.Equal(t, "key-1", serializedInfo.PayloadEncryptionKeyID)
+	require.False(t, bytes.Contains(serializedInfo.Memo["key"], []byte("memo value")))
+	deserializedInfo, _, err := manager.DeserializeExecutionInfo(serializedInfo)
+	require.NoError(t, err)
+	require.Equal(t, memo, deserializedInfo.Memo)
+	require.Equal(t, "key-1", deserializedInfo.PayloadEncryptionKeyID)
+
+	serializedActivityInfos, err = manager.SerializeUpsertActivityInfos(activityInfos, common.EncodingTypeThriftRW, "key-2", payloadEncryptionKeyID(info, "key-2"))
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(serializedActivityInfos[0].Details, []byte("heartbeat details")))
+	deserializedActivityInfos, err := manager.DeserializeActivityInfos(map[int64]*InternalActivityInfo{5: serializedActivityInfos[0]}, true)
+	require.NoError(t, err)
+	require.Equal(t, []byte("heartbeat details"), deserializedActivityInfos[5].Details)
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/types"
 )
@@ -34,6 +35,7 @@ type (
 	// executionManagerImpl implements ExecutionManager based on ExecutionStore, statsComputer and PayloadSerializer
 	executionManagerImpl struct {
 		serializer    PayloadSerializer
+		encryptor     encryption.Encryptor
 		persistence   ExecutionStore
 		statsComputer statsComputer
 		logger        log.Logger
@@ -46,10 +48,12 @@ var _ ExecutionManager = (*executionManagerImpl)(nil)
 func NewExecutionManagerImpl(
 	persistence ExecutionStore,
 	logger log.Logger,
+	encryptor encryption.Encryptor,
 ) ExecutionManager {
 
 	return &executionManagerImpl{
-		serializer:    NewPayloadSerializer(),
+		serializer:    NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryptor, ""),
+		encryptor:     encryptor,
 		persistence:   persistence,
 		statsComputer: statsComputer{},
 		logger:        logger,
@@ -89,7 +93,13 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 		},
 	}
 
-	newResponse.State.ActivityInfos, err = m.DeserializeActivityInfos(response.State.ActivityInfos)
+	payloadsEncrypted := response.State.ExecutionInfo.PayloadEncryptionKeyID != ""
+	if payloadsEncrypted {
+		if err := m.decryptSignalInfos(response.State.SignalInfos); err != nil {
+			return nil, err
+		}
+	}
+	newResponse.State.ActivityInfos, err = m.DeserializeActivityInfos(response.State.ActivityInfos, payloadsEncrypted)
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +139,13 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		return nil, nil, err
 	}
 
+	memo := info.Memo
+	if info.PayloadEncryptionKeyID != "" {
+		if memo, err = m.decryptPayloads(info.Memo); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	newInfo := &WorkflowExecutionInfo{
 		CompletionEvent: completionEvent,
 
@@ -183,9 +200,11 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		BranchToken:                        info.BranchToken,
 		CronSchedule:                       info.CronSchedule,
 		ExpirationSeconds:                  int32(info.ExpirationSeconds.Seconds()),
+		RetentionDays:                      common.DurationToDays(info.Retention),
 		AutoResetPoints:                    autoResetPoints,
 		SearchAttributes:                   info.SearchAttributes,
-		Memo:                               info.Memo,
+		Memo:                               memo,
+		PayloadEncryptionKeyID:             info.PayloadEncryptionKeyID,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -257,6 +276,7 @@ func (m *executionManagerImpl) DeserializeChildExecutionInfos(
 
 func (m *executionManagerImpl) DeserializeActivityInfos(
 	infos map[int64]*InternalActivityInfo,
+	payloadsEncrypted bool,
 ) (map[int64]*ActivityInfo, error) {
 
 	newInfos := make(map[int64]*ActivityInfo, 0)
@@ -269,6 +289,15 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		details, lastFailureDetails := v.Details, v.LastFailureDetails
+		if payloadsEncrypted {
+			if details, err = m.decryptPayload(v.Details); err != nil {
+				return nil, err
+			}
+			if lastFailureDetails, err = m.decryptPayload(v.LastFailureDetails); err != nil {
+				return nil, err
+			}
+		}
 		a := &ActivityInfo{
 			ScheduledEvent: scheduledEvent,
 			StartedEvent:   startedEvent,
@@ -281,7 +310,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			StartedTime:                             v.StartedTime,
 			ActivityID:                              v.ActivityID,
 			RequestID:                               v.RequestID,
-			Details:                                 v.Details,
+			Details:                                 details,
 			ScheduleToStartTimeout:                  int32(v.ScheduleToStartTimeout.Seconds()),
 			ScheduleToCloseTimeout:                  int32(v.ScheduleToCloseTimeout.Seconds()),
 			StartToCloseTimeout:                     int32(v.StartToCloseTimeout.Seconds()),
@@ -303,7 +332,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			NonRetriableErrors:                      v.NonRetriableErrors,
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
-			LastFailureDetails:                      v.LastFailureDetails,
+			LastFailureDetails:                      lastFailureDetails,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos[k] = a
@@ -316,13 +345,13 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 	request *UpdateWorkflowExecutionRequest,
 ) (*UpdateWorkflowExecutionResponse, error) {
 
-	serializedWorkflowMutation, err := m.SerializeWorkflowMutation(&request.UpdateWorkflowMutation, request.Encoding)
+	serializedWorkflowMutation, err := m.SerializeWorkflowMutation(&request.UpdateWorkflowMutation, request.Encoding, request.EncryptionKeyID)
 	if err != nil {
 		return nil, err
 	}
 	var serializedNewWorkflowSnapshot *InternalWorkflowSnapshot
 	if request.NewWorkflowSnapshot != nil {
-		serializedNewWorkflowSnapshot, err = m.SerializeWorkflowSnapshot(request.NewWorkflowSnapshot, request.Encoding)
+		serializedNewWorkflowSnapshot, err = m.SerializeWorkflowSnapshot(request.NewWorkflowSnapshot, request.Encoding, request.EncryptionKeyID)
 		if err != nil {
 			return nil, err
 		}
@@ -347,15 +376,17 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 func (m *executionManagerImpl) SerializeUpsertChildExecutionInfos(
 	infos []*ChildExecutionInfo,
 	encoding common.EncodingType,
+	encryptionKeyID string,
 ) ([]*InternalChildExecutionInfo, error) {
 
+	serializer := NewEncryptingPayloadSerializer(m.serializer, m.encryptor, encryptionKeyID)
 	newInfos := make([]*InternalChildExecutionInfo, 0)
 	for _, v := range infos {
-		initiatedEvent, err := m.serializer.SerializeEvent(v.InitiatedEvent, encoding)
+		initiatedEvent, err := serializer.SerializeEvent(v.InitiatedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
-		startedEvent, err := m.serializer.SerializeEvent(v.StartedEvent, encoding)
+		startedEvent, err := serializer.SerializeEvent(v.StartedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -383,15 +414,26 @@ func (m *executionManagerImpl) SerializeUpsertChildExecutionInfos(
 func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 	infos []*ActivityInfo,
 	encoding common.EncodingType,
+	encryptionKeyID string,
+	payloadEncryptionKeyID string,
 ) ([]*InternalActivityInfo, error) {
 
+	serializer := NewEncryptingPayloadSerializer(m.serializer, m.encryptor, encryptionKeyID)
 	newInfos := make([]*InternalActivityInfo, 0)
 	for _, v := range infos {
-		scheduledEvent, err := m.serializer.SerializeEvent(v.ScheduledEvent, encoding)
+		scheduledEvent, err := serializer.SerializeEvent(v.ScheduledEvent, encoding)
+		if err != nil {
+			return nil, err
+		}
+		startedEvent, err := serializer.SerializeEvent(v.StartedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
-		startedEvent, err := m.serializer.SerializeEvent(v.StartedEvent, encoding)
+		details, err := m.encryptPayload(payloadEncryptionKeyID, v.Details)
+		if err != nil {
+			return nil, err
+		}
+		lastFailureDetails, err := m.encryptPayload(payloadEncryptionKeyID, v.LastFailureDetails)
 		if err != nil {
 			return nil, err
 		}
@@ -406,7 +448,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			StartedTime:                             v.StartedTime,
 			ActivityID:                              v.ActivityID,
 			RequestID:                               v.RequestID,
-			Details:                                 v.Details,
+			Details:                                 details,
 			ScheduleToStartTimeout:                  common.SecondsToDuration(int64(v.ScheduleToStartTimeout)),
 			ScheduleToCloseTimeout:                  common.SecondsToDuration(int64(v.ScheduleToCloseTimeout)),
 			StartToCloseTimeout:                     common.SecondsToDuration(int64(v.StartToCloseTimeout)),
@@ -428,7 +470,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			NonRetriableErrors:                      v.NonRetriableErrors,
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
-			LastFailureDetails:                      v.LastFailureDetails,
+			LastFailureDetails:                      lastFailureDetails,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos = append(newInfos, i)
@@ -440,12 +482,19 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 	info *WorkflowExecutionInfo,
 	stats *ExecutionStats,
 	encoding common.EncodingType,
+	encryptionKeyID string,
 ) (*InternalWorkflowExecutionInfo, error) {
 
 	if info == nil {
 		return &InternalWorkflowExecutionInfo{}, nil
 	}
-	completionEvent, err := m.serializer.SerializeEvent(info.CompletionEvent, encoding)
+	completionEvent, err := NewEncryptingPayloadSerializer(m.serializer, m.encryptor, encryptionKeyID).
+		SerializeEvent(info.CompletionEvent, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	memo, err := m.encryptPayloads(payloadEncryptionKeyID(info, encryptionKeyID), info.Memo)
 	if err != nil {
 		return nil, err
 	}
@@ -508,8 +557,10 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		BranchToken:                        info.BranchToken,
 		CronSchedule:                       info.CronSchedule,
 		ExpirationSeconds:                  common.SecondsToDuration(int64(info.ExpirationSeconds)),
-		Memo:                               info.Memo,
+		Retention:                          common.DaysToDuration(info.RetentionDays),
+		Memo:                               memo,
 		SearchAttributes:                   info.SearchAttributes,
+		PayloadEncryptionKeyID:             info.PayloadEncryptionKeyID,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
@@ -521,20 +572,20 @@ func (m *executionManagerImpl) ConflictResolveWorkflowExecution(
 	request *ConflictResolveWorkflowExecutionRequest,
 ) (*ConflictResolveWorkflowExecutionResponse, error) {
 
-	serializedResetWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.ResetWorkflowSnapshot, request.Encoding)
+	serializedResetWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.ResetWorkflowSnapshot, request.Encoding, request.EncryptionKeyID)
 	if err != nil {
 		return nil, err
 	}
 	var serializedCurrentWorkflowMutation *InternalWorkflowMutation
 	if request.CurrentWorkflowMutation != nil {
-		serializedCurrentWorkflowMutation, err = m.SerializeWorkflowMutation(request.CurrentWorkflowMutation, request.Encoding)
+		serializedCurrentWorkflowMutation, err = m.SerializeWorkflowMutation(request.CurrentWorkflowMutation, request.Encoding, request.EncryptionKeyID)
 		if err != nil {
 			return nil, err
 		}
 	}
 	var serializedNewWorkflowMutation *InternalWorkflowSnapshot
 	if request.NewWorkflowSnapshot != nil {
-		serializedNewWorkflowMutation, err = m.SerializeWorkflowSnapshot(request.NewWorkflowSnapshot, request.Encoding)
+		serializedNewWorkflowMutation, err = m.SerializeWorkflowSnapshot(request.NewWorkflowSnapshot, request.Encoding, request.EncryptionKeyID)
 		if err != nil {
 			return nil, err
 		}
@@ -566,7 +617,7 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 
 	encoding := common.EncodingTypeThriftRW
 
-	serializedNewWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.NewWorkflowSnapshot, encoding)
+	serializedNewWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.NewWorkflowSnapshot, encoding, request.EncryptionKeyID)
 	if err != nil {
 		return nil, err
 	}
@@ -593,12 +644,14 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 func (m *executionManagerImpl) SerializeWorkflowMutation(
 	input *WorkflowMutation,
 	encoding common.EncodingType,
+	encryptionKeyID string,
 ) (*InternalWorkflowMutation, error) {
 
 	serializedExecutionInfo, err := m.SerializeExecutionInfo(
 		input.ExecutionInfo,
 		input.ExecutionStats,
 		encoding,
+		encryptionKeyID,
 	)
 	if err != nil {
 		return nil, err
@@ -607,21 +660,27 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 	if err != nil {
 		return nil, err
 	}
-	serializedUpsertActivityInfos, err := m.SerializeUpsertActivityInfos(input.UpsertActivityInfos, encoding)
+	payloadKeyID := payloadEncryptionKeyID(input.ExecutionInfo, encryptionKeyID)
+	serializedUpsertActivityInfos, err := m.SerializeUpsertActivityInfos(input.UpsertActivityInfos, encoding, encryptionKeyID, payloadKeyID)
 	if err != nil {
 		return nil, err
 	}
-	serializedUpsertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.UpsertChildExecutionInfos, encoding)
+	serializedUpsertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.UpsertChildExecutionInfos, encoding, encryptionKeyID)
 	if err != nil {
 		return nil, err
 	}
 	var serializedNewBufferedEvents *DataBlob
 	if input.NewBufferedEvents != nil {
-		serializedNewBufferedEvents, err = m.serializer.SerializeBatchEvents(input.NewBufferedEvents, encoding)
+		serializedNewBufferedEvents, err = NewEncryptingPayloadSerializer(m.serializer, m.encryptor, encryptionKeyID).
+			SerializeBatchEvents(input.NewBufferedEvents, encoding)
 		if err != nil {
 			return nil, err
 		}
 	}
+	upsertSignalInfos, err := m.encryptSignalInfos(payloadKeyID, input.UpsertSignalInfos)
+	if err != nil {
+		return nil, err
+	}
 
 	startVersion, err := getStartVersion(input.VersionHistories)
 	if err != nil {
@@ -646,7 +705,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 		DeleteChildExecutionInfos: input.DeleteChildExecutionInfos,
 		UpsertRequestCancelInfos:  input.UpsertRequestCancelInfos,
 		DeleteRequestCancelInfos:  input.DeleteRequestCancelInfos,
-		UpsertSignalInfos:         input.UpsertSignalInfos,
+		UpsertSignalInfos:         upsertSignalInfos,
 		DeleteSignalInfos:         input.DeleteSignalInfos,
 		UpsertSignalRequestedIDs:  input.UpsertSignalRequestedIDs,
 		DeleteSignalRequestedIDs:  input.DeleteSignalRequestedIDs,
@@ -666,12 +725,14 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 	input *WorkflowSnapshot,
 	encoding common.EncodingType,
+	encryptionKeyID string,
 ) (*InternalWorkflowSnapshot, error) {
 
 	serializedExecutionInfo, err := m.SerializeExecutionInfo(
 		input.ExecutionInfo,
 		input.ExecutionStats,
 		encoding,
+		encryptionKeyID,
 	)
 	if err != nil {
 		return nil, err
@@ -680,11 +741,16 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 	if err != nil {
 		return nil, err
 	}
-	serializedActivityInfos, err := m.SerializeUpsertActivityInfos(input.ActivityInfos, encoding)
+	payloadKeyID := payloadEncryptionKeyID(input.ExecutionInfo, encryptionKeyID)
+	serializedActivityInfos, err := m.SerializeUpsertActivityInfos(input.ActivityInfos, encoding, encryptionKeyID, payloadKeyID)
+	if err != nil {
+		return nil, err
+	}
+	serializedChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.ChildExecutionInfos, encoding, encryptionKeyID)
 	if err != nil {
 		return nil, err
 	}
-	serializedChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.ChildExecutionInfos, encoding)
+	signalInfos, err := m.encryptSignalInfos(payloadKeyID, input.SignalInfos)
 	if err != nil {
 		return nil, err
 	}
@@ -708,7 +774,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 		TimerInfos:          input.TimerInfos,
 		ChildExecutionInfos: serializedChildExecutionInfos,
 		RequestCancelInfos:  input.RequestCancelInfos,
-		SignalInfos:         input.SignalInfos,
+		SignalInfos:         signalInfos,
 		SignalRequestedIDs:  input.SignalRequestedIDs,
 
 		TransferTasks:     input.TransferTasks,
@@ -746,6 +812,122 @@ func (m *executionManagerImpl) DeserializeVersionHistories(
 	return NewVersionHistoriesFromInternalType(versionHistories), nil
 }
 
+// payloadEncryptionKeyID returns the ID of the key to encrypt the raw payloads of the execution with, e.g.
+// activity details, signal inputs and memo. Unlike events, raw payloads carry no encoding, so they are only
+// encrypted if the execution records that they are. They are encrypted with the current key of the domain,
+// or with the recorded key if the domain no longer has one.
+func payloadEncryptionKeyID(
+	info *WorkflowExecutionInfo,
+	encryptionKeyID string,
+) string {
+
+	if info == nil || info.PayloadEncryptionKeyID == "" {
+		return ""
+	}
+	if encryptionKeyID != "" {
+		return encryptionKeyID
+	}
+	return info.PayloadEncryptionKeyID
+}
+
+func (m *executionManagerImpl) encryptSignalInfos(
+	encryptionKeyID string,
+	infos []*SignalInfo,
+) ([]*SignalInfo, error) {
+
+	if encryptionKeyID == "" || len(infos) == 0 {
+		return infos, nil
+	}
+	newInfos := make([]*SignalInfo, 0, len(infos))
+	for _, v := range infos {
+		// signal infos are shared with mutable state, so they are copied instead of updated
+		info := *v
+		var err error
+		if info.Input, err = m.encryptPayload(encryptionKeyID, v.Input); err != nil {
+			return nil, err
+		}
+		if info.Control, err = m.encryptPayload(encryptionKeyID, v.Control); err != nil {
+			return nil, err
+		}
+		newInfos = append(newInfos, &info)
+	}
+	return newInfos, nil
+}
+
+func (m *executionManagerImpl) decryptSignalInfos(
+	infos map[int64]*SignalInfo,
+) error {
+
+	for _, v := range infos {
+		var err error
+		if v.Input, err = m.decryptPayload(v.Input); err != nil {
+			return err
+		}
+		if v.Control, err = m.decryptPayload(v.Control); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *executionManagerImpl) encryptPayloads(
+	encryptionKeyID string,
+	payloads map[string][]byte,
+) (map[string][]byte, error) {
+
+	if encryptionKeyID == "" || len(payloads) == 0 {
+		return payloads, nil
+	}
+	encrypted := make(map[string][]byte, len(payloads))
+	for key, payload := range payloads {
+		var err error
+		if encrypted[key], err = m.encryptPayload(encryptionKeyID, payload); err != nil {
+			return nil, err
+		}
+	}
+	return encrypted, nil
+}
+
+func (m *executionManagerImpl) decryptPayloads(
+	payloads map[string][]byte,
+) (map[string][]byte, error) {
+
+	if len(payloads) == 0 {
+		return payloads, nil
+	}
+	decrypted := make(map[string][]byte, len(payloads))
+	for key, payload := range payloads {
+		var err error
+		if decrypted[key], err = m.decryptPayload(payload); err != nil {
+			return nil, err
+		}
+	}
+	return decrypted, nil
+}
+
+func (m *executionManagerImpl) encryptPayload(
+	encryptionKeyID string,
+	payload []byte,
+) ([]byte, error) {
+
+	encrypted, err := m.encryptor.Encrypt(encryptionKeyID, payload)
+	if err != nil {
+		return nil, NewCadenceSerializationError(err.Error())
+	}
+	return encrypted, nil
+}
+
+func (m *executionManagerImpl) decryptPayload(
+	payload []byte,
+) ([]byte, error) {
+
+	decrypted, err := m.encryptor.Decrypt(payload)
+	if err != nil {
+		return nil, NewCadenceDeserializationError(err.Error())
+	}
+	return decrypted, nil
+}
+
 func (m *executionManagerImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *DeleteWorkflowExecutionRequest,
@@ -991,6 +1173,8 @@ func (m *executionManagerImpl) fromInternalReplicationTaskInfo(internalInfo *Int
 		BranchToken:       internalInfo.BranchToken,
 		NewRunBranchToken: internalInfo.NewRunBranchToken,
 		CreationTime:      internalInfo.CreationTime.UnixNano(),
+		DeadReason:        internalInfo.DeadReason,
+		RedriveAttempts:   internalInfo.RedriveAttempts,
 	}
 }
 
@@ -1022,6 +1206,8 @@ func (m *executionManagerImpl) toInternalReplicationTaskInfo(info *ReplicationTa
 		BranchToken:       info.BranchToken,
 		NewRunBranchToken: info.NewRunBranchToken,
 		CreationTime:      time.Unix(0, info.CreationTime),
+		DeadReason:        info.DeadReason,
+		RedriveAttempts:   info.RedriveAttempts,
 	}
 }
 
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/encryption"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/types"
@@ -40,6 +41,7 @@ type (
 	// historyManagerImpl implements HistoryManager based on HistoryStore and PayloadSerializer
 	historyV2ManagerImpl struct {
 		historySerializer     PayloadSerializer
+		encryptor             encryption.Encryptor
 		persistence           HistoryStore
 		logger                log.Logger
 		thriftEncoder         codec.BinaryEncoder
@@ -59,11 +61,13 @@ var _ HistoryManager = (*historyV2ManagerImpl)(nil)
 func NewHistoryV2ManagerImpl(
 	persistence HistoryStore,
 	logger log.Logger,
+	encryptor encryption.Encryptor,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
 ) HistoryManager {
 
 	return &historyV2ManagerImpl{
-		historySerializer:     NewPayloadSerializer(),
+		historySerializer:     NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryptor, ""),
+		encryptor:             encryptor,
 		persistence:           persistence,
 		logger:                logger,
 		thriftEncoder:         codec.NewThriftRWEncoder(),
@@ -221,7 +225,8 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 	}
 
 	// nodeID will be the first eventID
-	blob, err := m.historySerializer.SerializeBatchEvents(request.Events, request.Encoding)
+	serializer := NewEncryptingPayloadSerializer(m.historySerializer, m.encryptor, request.EncryptionKeyID)
+	blob, err := serializer.SerializeBatchEvents(request.Events, request.Encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -422,10 +427,16 @@ func (m *historyV2ManagerImpl) readRawHistoryBranch(
 		return nil, nil, 0, nil, &types.EntityNotExistsError{Message: "Workflow execution history not found."}
 	}
 
-	dataBlobs := resp.History
+	dataBlobs := make([]*DataBlob, 0, len(resp.History))
 	dataSize := 0
 	for _, dataBlob := range resp.History {
 		dataSize += len(dataBlob.Data)
+		// raw history is returned decrypted, e.g. for replication to clusters which use different keys
+		dataBlob, err := decryptDataBlob(m.encryptor, dataBlob)
+		if err != nil {
+			return nil, nil, 0, nil, err
+		}
+		dataBlobs = append(dataBlobs, dataBlob)
 	}
 
 	token.StoreToken = resp.NextPageToken
@@ -500,6 +500,9 @@ func (d *nosqlExecutionStore) prepareTransferTasksForWorkflowTxn(
 		case p.TransferTaskTypeApplyParentClosePolicy:
 			targetDomainIDs = task.(*p.ApplyParentClosePolicyTask).TargetDomainIDs
 
+		case p.TransferTaskTypeWorkflowLifecycleEvents:
+			scheduleID = task.(*p.WorkflowLifecycleEventsTask).FirstEventID
+
 		case p.TransferTaskTypeCloseExecution,
 			p.TransferTaskTypeRecordWorkflowStarted,
 			p.TransferTaskTypeResetWorkflow,
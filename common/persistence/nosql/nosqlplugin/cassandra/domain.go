@@ -105,6 +105,7 @@ const (
 		`SET domain = ` + templateDomainInfoType + `, ` +
 		`config = ` + templateDomainConfigType + `, ` +
 		`replication_config = ` + templateDomainReplicationConfigType + `, ` +
+		`is_global_domain = ? ,` +
 		`config_version = ? ,` +
 		`failover_version = ? ,` +
 		`failover_notification_version = ? , ` +
@@ -294,6 +295,7 @@ func (db *cdb) UpdateDomain(
 		string(row.Config.BadBinaries.Encoding),
 		row.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
+		row.IsGlobalDomain,
 		row.ConfigVersion,
 		row.FailoverVersion,
 		row.FailoverNotificationVersion,
@@ -53,7 +53,9 @@ const (
 		`cluster_replication_level: ?, ` +
 		`replication_dlq_ack_level: ?, ` +
 		`pending_failover_markers: ?, ` +
-		`pending_failover_markers_encoding: ? ` +
+		`pending_failover_markers_encoding: ?, ` +
+		`domain_usages: ?, ` +
+		`domain_usages_encoding: ? ` +
 		`}`
 
 	templateCreateShardQuery = `INSERT INTO executions (` +
@@ -101,6 +103,7 @@ func (db *cdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error
 	transferPQS, transferPQSEncoding := persistence.FromDataBlob(row.TransferProcessingQueueStates)
 	crossClusterPQS, crossClusterPQSEncoding := persistence.FromDataBlob(row.CrossClusterProcessingQueueStates)
 	timerPQS, timerPQSEncoding := persistence.FromDataBlob(row.TimerProcessingQueueStates)
+	domainUsages, domainUsagesEncoding := persistence.FromDataBlob(row.DomainUsages)
 	query := db.session.Query(templateCreateShardQuery,
 		row.ShardID,
 		rowTypeShard,
@@ -130,6 +133,8 @@ func (db *cdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error
 		row.ReplicationDLQAckLevel,
 		markerData,
 		markerEncoding,
+		domainUsages,
+		domainUsagesEncoding,
 		row.RangeID,
 	).WithContext(ctx)
 
@@ -195,6 +200,8 @@ func convertToShardInfo(
 	var crossClusterProcessingQueueStatesEncoding string
 	var timerProcessingQueueStatesRawData []byte
 	var timerProcessingQueueStatesEncoding string
+	var domainUsagesRawData []byte
+	var domainUsagesEncoding string
 	info := &persistence.InternalShardInfo{}
 	info.RangeID = rangeID
 	for k, v := range shard {
@@ -239,6 +246,10 @@ func convertToShardInfo(
 			pendingFailoverMarkersRawData = v.([]byte)
 		case "pending_failover_markers_encoding":
 			pendingFailoverMarkersEncoding = v.(string)
+		case "domain_usages":
+			domainUsagesRawData = v.([]byte)
+		case "domain_usages_encoding":
+			domainUsagesEncoding = v.(string)
 		}
 	}
 
@@ -274,6 +285,10 @@ func convertToShardInfo(
 		timerProcessingQueueStatesRawData,
 		common.EncodingType(timerProcessingQueueStatesEncoding),
 	)
+	info.DomainUsages = persistence.NewDataBlob(
+		domainUsagesRawData,
+		common.EncodingType(domainUsagesEncoding),
+	)
 
 	return info
 }
@@ -314,6 +329,7 @@ func (db *cdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previ
 	transferPQS, transferPQSEncoding := persistence.FromDataBlob(row.TransferProcessingQueueStates)
 	crossClusterPQS, crossClusterPQSEncoding := persistence.FromDataBlob(row.CrossClusterProcessingQueueStates)
 	timerPQS, timerPQSEncoding := persistence.FromDataBlob(row.TimerProcessingQueueStates)
+	domainUsages, domainUsagesEncoding := persistence.FromDataBlob(row.DomainUsages)
 
 	query := db.session.Query(templateUpdateShardQuery,
 		row.ShardID,
@@ -337,6 +353,8 @@ func (db *cdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previ
 		row.ReplicationDLQAckLevel,
 		markerData,
 		markerEncoding,
+		domainUsages,
+		domainUsagesEncoding,
 		row.RangeID,
 		row.ShardID,
 		rowTypeShard,
@@ -671,7 +671,9 @@ func (db *cdb) InsertReplicationDLQTask(ctx context.Context, shardID int, source
 		task.BranchToken,
 		p.EventStoreVersion,
 		task.NewRunBranchToken,
-		defaultVisibilityTimestamp,
+		task.CreationTime.UnixNano(),
+		task.DeadReason,
+		task.RedriveAttempts,
 		defaultVisibilityTimestamp,
 		task.TaskID,
 	).WithContext(ctx)
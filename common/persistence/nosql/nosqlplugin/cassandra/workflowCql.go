@@ -80,7 +80,9 @@ const (
 		`cron_schedule: ?, ` +
 		`expiration_seconds: ?, ` +
 		`search_attributes: ?, ` +
-		`memo: ? ` +
+		`memo: ?, ` +
+		`retention_days: ?, ` +
+		`payload_encryption_key_id: ? ` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -117,7 +119,9 @@ const (
 		`branch_token: ?, ` +
 		`new_run_event_store_version: ?, ` +
 		`new_run_branch_token: ?, ` +
-		`created_time: ? ` +
+		`created_time: ?, ` +
+		`dead_reason: ?, ` +
+		`redrive_attempts: ? ` +
 		`}`
 
 	templateTimerTaskType = `{` +
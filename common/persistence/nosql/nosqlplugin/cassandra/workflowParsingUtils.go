@@ -164,6 +164,10 @@ func parseWorkflowExecutionInfo(
 			info.SearchAttributes = v.(map[string][]byte)
 		case "memo":
 			info.Memo = v.(map[string][]byte)
+		case "retention_days":
+			info.Retention = common.DaysToDuration(int32(v.(int)))
+		case "payload_encryption_key_id":
+			info.PayloadEncryptionKeyID = v.(string)
 		}
 	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
@@ -573,6 +577,10 @@ func parseReplicationTaskInfo(
 			info.NewRunBranchToken = v.([]byte)
 		case "created_time":
 			info.CreationTime = time.Unix(0, v.(int64))
+		case "dead_reason":
+			info.DeadReason = v.(string)
+		case "redrive_attempts":
+			info.RedriveAttempts = v.(int)
 		}
 	}
 
@@ -362,6 +362,8 @@ func (db *cdb) createReplicationTasks(
 			persistence.EventStoreVersion,
 			task.NewRunBranchToken,
 			task.CreationTime.UnixNano(),
+			task.DeadReason,
+			task.RedriveAttempts,
 			// NOTE: use a constant here instead of task.VisibilityTimestamp so that we can query tasks with the same visibilityTimestamp
 			defaultVisibilityTimestamp,
 			task.TaskID)
@@ -1258,6 +1260,8 @@ func (db *cdb) updateWorkflowExecution(
 		int32(execution.ExpirationSeconds.Seconds()),
 		execution.SearchAttributes,
 		execution.Memo,
+		common.DurationToDays(execution.Retention),
+		execution.PayloadEncryptionKeyID,
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1352,6 +1356,8 @@ func (db *cdb) createWorkflowExecution(
 		int32(execution.ExpirationSeconds.Seconds()),
 		execution.SearchAttributes,
 		execution.Memo,
+		common.DurationToDays(execution.Retention),
+		execution.PayloadEncryptionKeyID,
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
@@ -58,6 +58,7 @@ func ValidateCreateWorkflowModeState(
 	case CreateWorkflowModeZombie:
 		if workflowState == WorkflowStateCreated ||
 			workflowState == WorkflowStateRunning ||
+			workflowState == WorkflowStateSuspended ||
 			workflowState == WorkflowStateCompleted {
 			return newInvalidCreateWorkflowMode(
 				mode,
@@ -98,7 +99,7 @@ func ValidateUpdateWorkflowModeState(
 		// 1. current workflow only ->
 		//  current workflow cannot be zombie
 		// 2. current workflow & new workflow ->
-		//  current workflow cannot be created / running,
+		//  current workflow cannot be created / running / suspended,
 		//  new workflow cannot be zombie
 
 		// case 1
@@ -112,6 +113,7 @@ func ValidateUpdateWorkflowModeState(
 		// case 2
 		if currentWorkflowState == WorkflowStateCreated ||
 			currentWorkflowState == WorkflowStateRunning ||
+			currentWorkflowState == WorkflowStateSuspended ||
 			*newWorkflowState == WorkflowStateZombie ||
 			*newWorkflowState == WorkflowStateCompleted {
 			return newInvalidUpdateWorkflowWithNewMode(mode, currentWorkflowState, *newWorkflowState)
@@ -121,15 +123,16 @@ func ValidateUpdateWorkflowModeState(
 	case UpdateWorkflowModeBypassCurrent:
 		// bypass current record
 		// 1. current workflow only ->
-		//  current workflow cannot be created / running
+		//  current workflow cannot be created / running / suspended
 		// 2. current workflow & new workflow ->
-		//  current workflow cannot be created / running,
-		//  new workflow cannot be created / running / completed
+		//  current workflow cannot be created / running / suspended,
+		//  new workflow cannot be created / running / suspended / completed
 
 		// case 1
 		if newWorkflowState == nil {
 			if currentWorkflowState == WorkflowStateCreated ||
-				currentWorkflowState == WorkflowStateRunning {
+				currentWorkflowState == WorkflowStateRunning ||
+				currentWorkflowState == WorkflowStateSuspended {
 				return newInvalidUpdateWorkflowMode(mode, currentWorkflowState)
 			}
 			return nil
@@ -138,8 +141,10 @@ func ValidateUpdateWorkflowModeState(
 		// case 2
 		if currentWorkflowState == WorkflowStateCreated ||
 			currentWorkflowState == WorkflowStateRunning ||
+			currentWorkflowState == WorkflowStateSuspended ||
 			*newWorkflowState == WorkflowStateCreated ||
 			*newWorkflowState == WorkflowStateRunning ||
+			*newWorkflowState == WorkflowStateSuspended ||
 			*newWorkflowState == WorkflowStateCompleted {
 			return newInvalidUpdateWorkflowWithNewMode(
 				mode,
@@ -189,14 +194,14 @@ func ValidateConflictResolveWorkflowModeState(
 		// 1. reset workflow only ->
 		//  reset workflow cannot be zombie
 		// 2. reset workflow & new workflow ->
-		//  reset workflow cannot be created / running / zombie,
+		//  reset workflow cannot be created / running / suspended / zombie,
 		//  new workflow cannot be zombie / completed
 		// 3. current workflow & reset workflow ->
-		//  current workflow cannot be created / running,
+		//  current workflow cannot be created / running / suspended,
 		//  reset workflow cannot be zombie
 		// 4. current workflow & reset workflow & new workflow ->
-		//  current workflow cannot be created / running,
-		//  reset workflow cannot be created / running / zombie,
+		//  current workflow cannot be created / running / suspended,
+		//  reset workflow cannot be created / running / suspended / zombie,
 		//  new workflow cannot be zombie / completed
 
 		// TODO remove case 1 & 2 support once 2DC is deprecated
@@ -220,6 +225,7 @@ func ValidateConflictResolveWorkflowModeState(
 			// case 2
 			if resetWorkflowState == WorkflowStateCreated ||
 				resetWorkflowState == WorkflowStateRunning ||
+				resetWorkflowState == WorkflowStateSuspended ||
 				resetWorkflowState == WorkflowStateZombie ||
 				*newWorkflowState == WorkflowStateZombie ||
 				*newWorkflowState == WorkflowStateCompleted {
@@ -237,6 +243,7 @@ func ValidateConflictResolveWorkflowModeState(
 		if newWorkflowState == nil {
 			if *currentWorkflowState == WorkflowStateCreated ||
 				*currentWorkflowState == WorkflowStateRunning ||
+				*currentWorkflowState == WorkflowStateSuspended ||
 				resetWorkflowState == WorkflowStateZombie {
 				return newInvalidConflictResolveWorkflowWithCurrentMode(
 					mode,
@@ -250,8 +257,10 @@ func ValidateConflictResolveWorkflowModeState(
 		// case 4
 		if *currentWorkflowState == WorkflowStateCreated ||
 			*currentWorkflowState == WorkflowStateRunning ||
+			*currentWorkflowState == WorkflowStateSuspended ||
 			resetWorkflowState == WorkflowStateCreated ||
 			resetWorkflowState == WorkflowStateRunning ||
+			resetWorkflowState == WorkflowStateSuspended ||
 			resetWorkflowState == WorkflowStateZombie ||
 			*newWorkflowState == WorkflowStateZombie ||
 			*newWorkflowState == WorkflowStateCompleted {
@@ -268,10 +277,10 @@ func ValidateConflictResolveWorkflowModeState(
 		// bypass current record
 		// * current workflow cannot be set
 		// 1. reset workflow only ->
-		//  reset workflow cannot be created / running
+		//  reset workflow cannot be created / running / suspended
 		// 2. reset workflow & new workflow ->
-		//  reset workflow cannot be created / running / zombie,
-		//  new workflow cannot be created / running / completed
+		//  reset workflow cannot be created / running / suspended / zombie,
+		//  new workflow cannot be created / running / suspended / completed
 
 		// precondition
 		if currentWorkflowMutation != nil {
@@ -286,7 +295,8 @@ func ValidateConflictResolveWorkflowModeState(
 		// case 1
 		if newWorkflowState == nil {
 			if resetWorkflowState == WorkflowStateCreated ||
-				resetWorkflowState == WorkflowStateRunning {
+				resetWorkflowState == WorkflowStateRunning ||
+				resetWorkflowState == WorkflowStateSuspended {
 				return newInvalidConflictResolveWorkflowMode(
 					mode,
 					resetWorkflowState,
@@ -298,9 +308,11 @@ func ValidateConflictResolveWorkflowModeState(
 		// case 2
 		if resetWorkflowState == WorkflowStateCreated ||
 			resetWorkflowState == WorkflowStateRunning ||
+			resetWorkflowState == WorkflowStateSuspended ||
 			resetWorkflowState == WorkflowStateZombie ||
 			*newWorkflowState == WorkflowStateCreated ||
 			*newWorkflowState == WorkflowStateRunning ||
+			*newWorkflowState == WorkflowStateSuspended ||
 			*newWorkflowState == WorkflowStateCompleted {
 			return newInvalidConflictResolveWorkflowWithNewMode(
 				mode,
@@ -321,6 +333,7 @@ func checkWorkflowState(state int) error {
 	switch state {
 	case WorkflowStateCreated,
 		WorkflowStateRunning,
+		WorkflowStateSuspended,
 		WorkflowStateZombie,
 		WorkflowStateCompleted,
 		WorkflowStateCorrupted:
@@ -5153,17 +5153,23 @@ func (s *ExecutionManagerSuite) TestReplicationDLQ() {
 
 	sourceCluster := "test"
 	taskInfo := &p.ReplicationTaskInfo{
-		DomainID:   uuid.New(),
-		WorkflowID: uuid.New(),
-		RunID:      uuid.New(),
-		TaskID:     0,
-		TaskType:   0,
+		DomainID:        uuid.New(),
+		WorkflowID:      uuid.New(),
+		RunID:           uuid.New(),
+		TaskID:          0,
+		TaskType:        0,
+		CreationTime:    time.Now().UnixNano(),
+		DeadReason:      "max_attempts_exceeded",
+		RedriveAttempts: 3,
 	}
 	err := s.PutReplicationTaskToDLQ(ctx, sourceCluster, taskInfo)
 	s.NoError(err)
 	resp, err := s.GetReplicationTasksFromDLQ(ctx, sourceCluster, -1, 0, 1, nil)
 	s.NoError(err)
 	s.Len(resp.Tasks, 1)
+	s.Equal(taskInfo.CreationTime, resp.Tasks[0].CreationTime)
+	s.Equal(taskInfo.DeadReason, resp.Tasks[0].DeadReason)
+	s.Equal(taskInfo.RedriveAttempts, resp.Tasks[0].RedriveAttempts)
 	err = s.DeleteReplicationTaskFromDLQ(ctx, sourceCluster, 0)
 	s.NoError(err)
 	resp, err = s.GetReplicationTasksFromDLQ(ctx, sourceCluster, -1, 0, 1, nil)
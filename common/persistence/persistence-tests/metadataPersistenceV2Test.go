@@ -616,6 +616,7 @@ func (m *MetadataPersistenceSuiteV2) TestConcurrentUpdateDomain() {
 					ActiveClusterName: resp2.ReplicationConfig.ActiveClusterName,
 					Clusters:          resp2.ReplicationConfig.Clusters,
 				},
+				resp2.IsGlobalDomain,
 				resp2.ConfigVersion,
 				resp2.FailoverVersion,
 				resp2.FailoverNotificationVersion,
@@ -807,6 +808,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			ActiveClusterName: updateClusterActive,
 			Clusters:          updateClusters,
 		},
+		isGlobalDomain,
 		updateConfigVersion,
 		updateFailoverVersion,
 		updateFailoverNotificationVersion,
@@ -901,6 +903,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			ActiveClusterName: updateClusterActive,
 			Clusters:          updateClusters,
 		},
+		isGlobalDomain,
 		updateConfigVersion,
 		updateFailoverVersion,
 		updateFailoverNotificationVersion,
@@ -1244,6 +1247,7 @@ func (m *MetadataPersistenceSuiteV2) UpdateDomain(
 	info *p.DomainInfo,
 	config *p.DomainConfig,
 	replicationConfig *p.DomainReplicationConfig,
+	isGlobalDomain bool,
 	configVersion int64,
 	failoverVersion int64,
 	failoverNotificationVersion int64,
@@ -1257,6 +1261,7 @@ func (m *MetadataPersistenceSuiteV2) UpdateDomain(
 		Info:                        info,
 		Config:                      config,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              isGlobalDomain,
 		FailoverEndTime:             failoverEndTime,
 		ConfigVersion:               configVersion,
 		FailoverVersion:             failoverVersion,
@@ -1021,7 +1021,8 @@ func (s *TestBase) UpdateWorkflowExecutionWithReplication(
 			*p.SignalExecutionTask,
 			*p.RecordWorkflowStartedTask,
 			*p.ResetWorkflowTask,
-			*p.UpsertWorkflowSearchAttributesTask:
+			*p.UpsertWorkflowSearchAttributesTask,
+			*p.WorkflowLifecycleEventsTask:
 			transferTasks = append(transferTasks, t)
 		case *p.CrossClusterStartChildExecutionTask,
 			*p.CrossClusterCancelExecutionTask,
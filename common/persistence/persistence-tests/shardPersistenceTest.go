@@ -161,6 +161,11 @@ func (s *ShardPersistenceSuite) TestUpdateShard() {
 	}
 	updatedInfo.ReplicationAckLevel = updatedReplicationAckLevel
 	updatedInfo.StolenSinceRenew = updatedStolenSinceRenew
+	updatedInfo.DomainUsages = &types.DomainUsages{
+		UsagesByDomainID: map[string]*types.DomainUsage{
+			"domain-usage-test-domain-id": {HistorySize: 1024, HistoryEventCount: 10, OpenWorkflowCount: 1, ClosedWorkflowCount: 2},
+		},
+	}
 
 	err2 := s.UpdateShard(ctx, updatedInfo, shardInfo.RangeID)
 	s.Nil(err2)
@@ -181,6 +186,7 @@ func (s *ShardPersistenceSuite) TestUpdateShard() {
 	s.Equal(updatedReplicationAckLevel, info1.ReplicationAckLevel)
 	s.Equal(updatedInfo.ReplicationDLQAckLevel, info1.ReplicationDLQAckLevel)
 	s.Equal(updatedStolenSinceRenew, info1.StolenSinceRenew)
+	s.Equal(updatedInfo.DomainUsages, info1.DomainUsages)
 
 	failedUpdateInfo := shardInfo.Copy()
 	failedUpdateInfo.Owner = "failed_owner"
@@ -207,6 +213,7 @@ func (s *ShardPersistenceSuite) TestUpdateShard() {
 	s.Equal(updatedReplicationAckLevel, info2.ReplicationAckLevel)
 	s.Equal(updatedInfo.ReplicationDLQAckLevel, info2.ReplicationDLQAckLevel)
 	s.Equal(updatedStolenSinceRenew, info2.StolenSinceRenew)
+	s.Equal(updatedInfo.DomainUsages, info2.DomainUsages)
 }
 
 func (s *ShardPersistenceSuite) TestCreateGetShardBackfill() {
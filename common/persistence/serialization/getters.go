@@ -180,6 +180,22 @@ func (s *ShardInfo) GetTimerProcessingQueueStatesEncoding() (o string) {
 	return
 }
 
+// GetDomainUsages internal sql blob getter
+func (s *ShardInfo) GetDomainUsages() (o []byte) {
+	if s != nil {
+		return s.DomainUsages
+	}
+	return
+}
+
+// GetDomainUsagesEncoding internal sql blob getter
+func (s *ShardInfo) GetDomainUsagesEncoding() (o string) {
+	if s != nil {
+		return s.DomainUsagesEncoding
+	}
+	return
+}
+
 // GetName internal sql blob getter
 func (d *DomainInfo) GetName() (o string) {
 	if d != nil {
@@ -844,6 +860,22 @@ func (w *WorkflowExecutionInfo) GetSearchAttributes() (o map[string][]byte) {
 	return
 }
 
+// GetRetention internal sql blob getter
+func (w *WorkflowExecutionInfo) GetRetention() (o time.Duration) {
+	if w != nil {
+		return w.Retention
+	}
+	return
+}
+
+// GetPayloadEncryptionKeyID internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPayloadEncryptionKeyID() (o string) {
+	if w != nil {
+		return w.PayloadEncryptionKeyID
+	}
+	return
+}
+
 // GetRetryNonRetryableErrors internal sql blob getter
 func (w *WorkflowExecutionInfo) GetRetryNonRetryableErrors() (o []string) {
 	if w != nil {
@@ -1679,3 +1711,19 @@ func (t *ReplicationTaskInfo) GetCreationTimestamp() time.Time {
 	}
 	return time.Unix(0, 0)
 }
+
+// GetDeadReason internal sql blob getter
+func (t *ReplicationTaskInfo) GetDeadReason() (o string) {
+	if t != nil {
+		return t.DeadReason
+	}
+	return
+}
+
+// GetRedriveAttempts internal sql blob getter
+func (t *ReplicationTaskInfo) GetRedriveAttempts() (o int32) {
+	if t != nil {
+		return t.RedriveAttempts
+	}
+	return
+}
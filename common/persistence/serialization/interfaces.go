@@ -55,6 +55,8 @@ type (
 		CrossClusterProcessingQueueStatesEncoding string
 		TimerProcessingQueueStates                []byte
 		TimerProcessingQueueStatesEncoding        string
+		DomainUsages                              []byte
+		DomainUsagesEncoding                      string
 	}
 
 	// DomainInfo blob in a serialization agnostic format
@@ -160,6 +162,8 @@ type (
 		Memo                               map[string][]byte
 		VersionHistories                   []byte
 		VersionHistoriesEncoding           string
+		Retention                          time.Duration
+		PayloadEncryptionKeyID             string
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
@@ -308,6 +312,8 @@ type (
 		BranchToken             []byte
 		NewRunBranchToken       []byte
 		CreationTimestamp       time.Time
+		DeadReason              string
+		RedriveAttempts         int32
 	}
 )
 
@@ -52,6 +52,8 @@ func shardInfoToThrift(info *ShardInfo) *sqlblobs.ShardInfo {
 		CrossClusterProcessingQueueStatesEncoding: &info.CrossClusterProcessingQueueStatesEncoding,
 		TimerProcessingQueueStates:                info.TimerProcessingQueueStates,
 		TimerProcessingQueueStatesEncoding:        &info.TimerProcessingQueueStatesEncoding,
+		DomainUsages:                              info.DomainUsages,
+		DomainUsagesEncoding:                      &info.DomainUsagesEncoding,
 		UpdatedAtNanos:                            timeToUnixNanoPtr(info.UpdatedAt),
 		TimerAckLevelNanos:                        timeToUnixNanoPtr(info.TimerAckLevel),
 	}
@@ -86,6 +88,8 @@ func shardInfoFromThrift(info *sqlblobs.ShardInfo) *ShardInfo {
 		CrossClusterProcessingQueueStatesEncoding: info.GetCrossClusterProcessingQueueStatesEncoding(),
 		TimerProcessingQueueStates:                info.TimerProcessingQueueStates,
 		TimerProcessingQueueStatesEncoding:        info.GetTimerProcessingQueueStatesEncoding(),
+		DomainUsages:                              info.DomainUsages,
+		DomainUsagesEncoding:                      info.GetDomainUsagesEncoding(),
 		UpdatedAt:                                 timeFromUnixNano(info.GetUpdatedAtNanos()),
 		TimerAckLevel:                             timeFromUnixNano(info.GetTimerAckLevelNanos()),
 	}
@@ -266,6 +270,8 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		SearchAttributes:                        info.SearchAttributes,
 		Memo:                                    info.Memo,
 		VersionHistories:                        info.VersionHistories,
+		RetentionDays:                           common.Int32Ptr(common.DurationToDays(info.Retention)),
+		PayloadEncryptionKeyID:                  &info.PayloadEncryptionKeyID,
 		VersionHistoriesEncoding:                &info.VersionHistoriesEncoding,
 	}
 }
@@ -333,6 +339,8 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		Memo:                               info.Memo,
 		VersionHistories:                   info.VersionHistories,
 		VersionHistoriesEncoding:           info.GetVersionHistoriesEncoding(),
+		Retention:                          common.DaysToDuration(info.GetRetentionDays()),
+		PayloadEncryptionKeyID:             info.GetPayloadEncryptionKeyID(),
 	}
 }
 
@@ -696,6 +704,8 @@ func replicationTaskInfoToThrift(info *ReplicationTaskInfo) *sqlblobs.Replicatio
 		BranchToken:             info.BranchToken,
 		NewRunBranchToken:       info.NewRunBranchToken,
 		CreationTime:            timeToUnixNanoPtr(info.CreationTimestamp),
+		DeadReason:              &info.DeadReason,
+		RedriveAttempts:         &info.RedriveAttempts,
 	}
 }
 
@@ -717,6 +727,8 @@ func replicationTaskInfoFromThrift(info *sqlblobs.ReplicationTaskInfo) *Replicat
 		BranchToken:             info.BranchToken,
 		NewRunBranchToken:       info.NewRunBranchToken,
 		CreationTimestamp:       timeFromUnixNano(info.GetCreationTime()),
+		DeadReason:              info.GetDeadReason(),
+		RedriveAttempts:         info.GetRedriveAttempts(),
 	}
 }
 
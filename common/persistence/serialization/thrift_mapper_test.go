@@ -53,6 +53,8 @@ func TestShardInfo(t *testing.T) {
 		TransferProcessingQueueStatesEncoding: "TransferProcessingQueueStatesEncoding",
 		TimerProcessingQueueStates:            []byte("TimerProcessingQueueStates"),
 		TimerProcessingQueueStatesEncoding:    "TimerProcessingQueueStatesEncoding",
+		DomainUsages:                          []byte("DomainUsages"),
+		DomainUsagesEncoding:                  "DomainUsagesEncoding",
 	}
 	actual := shardInfoFromThrift(shardInfoToThrift(expected))
 	assert.Equal(t, expected.StolenSinceRenew, actual.StolenSinceRenew)
@@ -71,6 +73,8 @@ func TestShardInfo(t *testing.T) {
 	assert.Equal(t, expected.TransferProcessingQueueStatesEncoding, actual.TransferProcessingQueueStatesEncoding)
 	assert.Equal(t, expected.TimerProcessingQueueStates, actual.TimerProcessingQueueStates)
 	assert.Equal(t, expected.TimerProcessingQueueStatesEncoding, actual.TimerProcessingQueueStatesEncoding)
+	assert.Equal(t, expected.DomainUsages, actual.DomainUsages)
+	assert.Equal(t, expected.DomainUsagesEncoding, actual.DomainUsagesEncoding)
 	assert.Len(t, actual.ClusterTimerAckLevel, 2)
 	assert.Contains(t, actual.ClusterTimerAckLevel, "key_1")
 	assert.Contains(t, actual.ClusterTimerAckLevel, "key_2")
@@ -214,6 +218,8 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		Memo:                               map[string][]byte{"key_1": []byte("Memo")},
 		VersionHistories:                   []byte("VersionHistories"),
 		VersionHistoriesEncoding:           "VersionHistoriesEncoding",
+		Retention:                          common.DaysToDuration(int32(rand.Intn(30))),
+		PayloadEncryptionKeyID:             "PayloadEncryptionKeyID",
 	}
 	actual := workflowExecutionInfoFromThrift(workflowExecutionInfoToThrift(expected))
 	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
@@ -268,6 +274,8 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.Memo, actual.Memo)
 	assert.Equal(t, expected.VersionHistories, actual.VersionHistories)
 	assert.Equal(t, expected.VersionHistoriesEncoding, actual.VersionHistoriesEncoding)
+	assert.Equal(t, expected.Retention, actual.Retention)
+	assert.Equal(t, expected.PayloadEncryptionKeyID, actual.PayloadEncryptionKeyID)
 	assert.Equal(t, expected.RetryExpirationTimestamp.Sub(actual.RetryExpirationTimestamp), time.Duration(0))
 	assert.True(t, (expected.StickyScheduleToStartTimeout-actual.StickyScheduleToStartTimeout) < time.Second)
 	assert.True(t, (expected.RetryInitialInterval-actual.RetryInitialInterval) < time.Second)
@@ -479,6 +487,8 @@ func TestReplicationTaskInfo(t *testing.T) {
 		NewRunEventStoreVersion: int32(rand.Intn(1000)),
 		BranchToken:             []byte("BranchToken"),
 		NewRunBranchToken:       []byte("NewRunBranchToken"),
+		DeadReason:              "DeadReason",
+		RedriveAttempts:         int32(rand.Intn(1000)),
 	}
 	actual := replicationTaskInfoFromThrift(replicationTaskInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
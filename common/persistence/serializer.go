@@ -70,6 +70,10 @@ type (
 		SerializeProcessingQueueStates(states *types.ProcessingQueueStates, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeProcessingQueueStates(data *DataBlob) (*types.ProcessingQueueStates, error)
 
+		// serialize/deserialize domain usages
+		SerializeDomainUsages(usages *types.DomainUsages, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeDomainUsages(data *DataBlob) (*types.DomainUsages, error)
+
 		// serialize/deserialize DynamicConfigBlob
 		SerializeDynamicConfigBlob(blob *types.DynamicConfigBlob, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeDynamicConfigBlob(data *DataBlob) (*types.DynamicConfigBlob, error)
@@ -239,6 +243,31 @@ func (t *serializerImpl) DeserializeProcessingQueueStates(
 	return &states, err
 }
 
+func (t *serializerImpl) SerializeDomainUsages(
+	usages *types.DomainUsages,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+	if usages == nil {
+		return nil, nil
+	}
+	return t.serialize(usages, encodingType)
+}
+
+func (t *serializerImpl) DeserializeDomainUsages(
+	data *DataBlob,
+) (*types.DomainUsages, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var usages types.DomainUsages
+	if len(data.Data) == 0 {
+		return &usages, nil
+	}
+	err := t.deserialize(data, &usages)
+	return &usages, err
+}
+
 func (t *serializerImpl) SerializeDynamicConfigBlob(blob *types.DynamicConfigBlob, encodingType common.EncodingType) (*DataBlob, error) {
 	if blob == nil {
 		return nil, nil
@@ -302,6 +331,8 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(&replicator.FailoverMarkers{FailoverMarkers: thrift.FromFailoverMarkerAttributesArray(input)})
 	case *types.ProcessingQueueStates:
 		return t.thriftrwEncoder.Encode(thrift.FromProcessingQueueStates(input))
+	case *types.DomainUsages:
+		return t.thriftrwEncoder.Encode(thrift.FromDomainUsages(input))
 	case *types.DynamicConfigBlob:
 		return t.thriftrwEncoder.Encode(thrift.FromDynamicConfigBlob(input))
 	default:
@@ -391,6 +422,13 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		}
 		*target = *thrift.ToProcessingQueueStates(&thriftTarget)
 		return nil
+	case *types.DomainUsages:
+		thriftTarget := history.DomainUsages{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = *thrift.ToDomainUsages(&thriftTarget)
+		return nil
 	case *types.DynamicConfigBlob:
 		thriftTarget := config.DynamicConfigBlob{}
 		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
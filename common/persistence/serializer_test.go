@@ -167,6 +167,12 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	}
 	processingQueueStates := &types.ProcessingQueueStates{StatesByCluster: processingQueueStateMap}
 
+	domainUsages := &types.DomainUsages{
+		UsagesByDomainID: map[string]*types.DomainUsage{
+			"domain1": {HistorySize: 1024, HistoryEventCount: 10, OpenWorkflowCount: 1, ClosedWorkflowCount: 2},
+		},
+	}
+
 	boolFalseEnc, _ := json.Marshal(false)
 	dcBlob := &types.DynamicConfigBlob{
 		SchemaVersion: 1,
@@ -493,6 +499,34 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.Equal(dProcessingQueueStatesEmpty, processingQueueStates)
 
+			// serialize domain usages
+
+			nilDomainUsages, err := serializer.SerializeDomainUsages(nil, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.Nil(nilDomainUsages)
+
+			domainUsagesJSON, err := serializer.SerializeDomainUsages(domainUsages, common.EncodingTypeJSON)
+			s.Nil(err)
+			s.NotNil(domainUsagesJSON)
+
+			domainUsagesThrift, err := serializer.SerializeDomainUsages(domainUsages, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.NotNil(domainUsagesThrift)
+
+			// deserialize domain usages
+
+			dNilDomainUsages, err := serializer.DeserializeDomainUsages(nil)
+			s.Nil(err)
+			s.Nil(dNilDomainUsages)
+
+			dDomainUsagesJSON, err := serializer.DeserializeDomainUsages(domainUsagesJSON)
+			s.Nil(err)
+			s.Equal(domainUsages, dDomainUsagesJSON)
+
+			dDomainUsagesThrift, err := serializer.DeserializeDomainUsages(domainUsagesThrift)
+			s.Nil(err)
+			s.Equal(domainUsages, dDomainUsagesThrift)
+
 			// serialize dynamic config blob
 
 			nilDynamicConfigBlob, err := serializer.SerializeDynamicConfigBlob(nil, common.EncodingTypeJSON)
@@ -116,6 +116,10 @@ func (m *shardManager) toInternalShardInfo(shardInfo *ShardInfo) (*InternalShard
 	if err != nil {
 		return nil, err
 	}
+	serializedDomainUsages, err := m.serializer.SerializeDomainUsages(shardInfo.DomainUsages, common.EncodingTypeThriftRW)
+	if err != nil {
+		return nil, err
+	}
 
 	return &InternalShardInfo{
 		ShardID:                           shardInfo.ShardID,
@@ -135,6 +139,7 @@ func (m *shardManager) toInternalShardInfo(shardInfo *ShardInfo) (*InternalShard
 		ClusterReplicationLevel:           shardInfo.ClusterReplicationLevel,
 		DomainNotificationVersion:         shardInfo.DomainNotificationVersion,
 		PendingFailoverMarkers:            pendingFailoverMarker,
+		DomainUsages:                      serializedDomainUsages,
 	}, nil
 }
 
@@ -158,6 +163,10 @@ func (m *shardManager) fromInternalShardInfo(internalShardInfo *InternalShardInf
 	if err != nil {
 		return nil, err
 	}
+	domainUsages, err := m.serializer.DeserializeDomainUsages(internalShardInfo.DomainUsages)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ShardInfo{
 		ShardID:                           internalShardInfo.ShardID,
@@ -177,5 +186,6 @@ func (m *shardManager) fromInternalShardInfo(internalShardInfo *InternalShardInf
 		ClusterReplicationLevel:           internalShardInfo.ClusterReplicationLevel,
 		DomainNotificationVersion:         internalShardInfo.DomainNotificationVersion,
 		PendingFailoverMarkers:            pendingFailoverMarker,
+		DomainUsages:                      domainUsages,
 	}, nil
 }
@@ -315,6 +315,7 @@ func (m *sqlDomainStore) UpdateDomain(
 			ID:           serialization.MustParseUUID(request.Info.ID),
 			Data:         blob.Data,
 			DataEncoding: string(blob.Encoding),
+			IsGlobal:     request.IsGlobalDomain,
 		})
 		if err != nil {
 			return err
@@ -989,6 +989,8 @@ func (m *sqlExecutionStore) populateGetReplicationTasksResponse(
 			BranchToken:       info.GetBranchToken(),
 			NewRunBranchToken: info.GetNewRunBranchToken(),
 			CreationTime:      info.GetCreationTimestamp(),
+			DeadReason:        info.GetDeadReason(),
+			RedriveAttempts:   int(info.GetRedriveAttempts()),
 		}
 	}
 	var nextPageToken []byte
@@ -1293,6 +1295,8 @@ func (m *sqlExecutionStore) PutReplicationTaskToDLQ(
 		BranchToken:             replicationTask.BranchToken,
 		NewRunBranchToken:       replicationTask.NewRunBranchToken,
 		CreationTimestamp:       replicationTask.CreationTime,
+		DeadReason:              replicationTask.DeadReason,
+		RedriveAttempts:         int32(replicationTask.RedriveAttempts),
 	})
 	if err != nil {
 		return err
@@ -1374,6 +1378,8 @@ func (m *sqlExecutionStore) populateWorkflowMutableState(
 		NonRetriableErrors:                 info.GetRetryNonRetryableErrors(),
 		SearchAttributes:                   info.GetSearchAttributes(),
 		Memo:                               info.GetMemo(),
+		Retention:                          info.GetRetention(),
+		PayloadEncryptionKeyID:             info.GetPayloadEncryptionKeyID(),
 	}
 
 	// TODO: remove this after all 2DC workflows complete
@@ -939,6 +939,9 @@ func createTransferTasks(
 				info.TargetDomainIDs = append(info.TargetDomainIDs, serialization.MustParseUUID(targetDomainID))
 			}
 
+		case p.TransferTaskTypeWorkflowLifecycleEvents:
+			info.ScheduleID = task.(*p.WorkflowLifecycleEventsTask).FirstEventID
+
 		case p.TransferTaskTypeCloseExecution,
 			p.TransferTaskTypeRecordWorkflowStarted,
 			p.TransferTaskTypeResetWorkflow,
@@ -1404,6 +1407,8 @@ func buildExecutionRow(
 		AutoResetPointsEncoding:            string(executionInfo.AutoResetPoints.GetEncoding()),
 		SearchAttributes:                   executionInfo.SearchAttributes,
 		Memo:                               executionInfo.Memo,
+		Retention:                          executionInfo.Retention,
+		PayloadEncryptionKeyID:             executionInfo.PayloadEncryptionKeyID,
 		CompletionEventEncoding:            string(common.EncodingTypeEmpty),
 		VersionHistoriesEncoding:           string(common.EncodingTypeEmpty),
 		InitiatedID:                        common.EmptyEventID,
@@ -145,6 +145,14 @@ func (m *sqlShardStore) GetShard(
 		}
 	}
 
+	var domainUsages *persistence.DataBlob
+	if shardInfo.GetDomainUsages() != nil {
+		domainUsages = &persistence.DataBlob{
+			Encoding: common.EncodingType(shardInfo.GetDomainUsagesEncoding()),
+			Data:     shardInfo.GetDomainUsages(),
+		}
+	}
+
 	resp := &persistence.InternalGetShardResponse{ShardInfo: &persistence.InternalShardInfo{
 		ShardID:                           int(row.ShardID),
 		RangeID:                           row.RangeID,
@@ -162,6 +170,7 @@ func (m *sqlShardStore) GetShard(
 		DomainNotificationVersion:         shardInfo.GetDomainNotificationVersion(),
 		ClusterReplicationLevel:           shardInfo.ClusterReplicationLevel,
 		ReplicationDLQAckLevel:            shardInfo.ReplicationDlqAckLevel,
+		DomainUsages:                      domainUsages,
 	}}
 
 	return resp, nil
@@ -269,6 +278,13 @@ func shardInfoToShardsRow(s persistence.InternalShardInfo, parser serialization.
 		timerPQSEncoding = string(s.TimerProcessingQueueStates.Encoding)
 	}
 
+	var domainUsagesData []byte
+	domainUsagesEncoding := string(common.EncodingTypeEmpty)
+	if s.DomainUsages != nil {
+		domainUsagesData = s.DomainUsages.Data
+		domainUsagesEncoding = string(s.DomainUsages.Encoding)
+	}
+
 	shardInfo := &serialization.ShardInfo{
 		StolenSinceRenew:                          int32(s.StolenSinceRenew),
 		UpdatedAt:                                 s.UpdatedAt,
@@ -289,6 +305,8 @@ func shardInfoToShardsRow(s persistence.InternalShardInfo, parser serialization.
 		ReplicationDlqAckLevel:                    s.ReplicationDLQAckLevel,
 		PendingFailoverMarkers:                    markerData,
 		PendingFailoverMarkersEncoding:            markerEncoding,
+		DomainUsages:                              domainUsagesData,
+		DomainUsagesEncoding:                      domainUsagesEncoding,
 	}
 
 	blob, err := parser.ShardInfoToBlob(shardInfo)
@@ -43,6 +43,23 @@ type (
 		CreateAdminDB(cfg *config.SQL) (AdminDB, error)
 	}
 
+	// SchemaColumnRow represents a column of a table, as described by the database catalog
+	SchemaColumnRow struct {
+		TableName  string `db:"table_name"`
+		ColumnName string `db:"column_name"`
+		DataType   string `db:"data_type"`
+		IsNullable bool   `db:"is_nullable"`
+	}
+
+	// SchemaIndexRow represents a column of an index, as described by the database catalog
+	SchemaIndexRow struct {
+		TableName  string `db:"table_name"`
+		IndexName  string `db:"index_name"`
+		ColumnName string `db:"column_name"`
+		IsUnique   bool   `db:"is_unique"`
+		Position   int    `db:"position"`
+	}
+
 	// DomainRow represents a row in domain table
 	DomainRow struct {
 		ID           serialization.UUID
@@ -810,6 +827,10 @@ type (
 		UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
 		ListTables(database string) ([]string, error)
+		// ListColumns returns the columns of all tables, ordered by table and column position
+		ListColumns(database string) ([]SchemaColumnRow, error)
+		// ListIndexes returns the columns of all indexes, ordered by table, index and column position
+		ListIndexes(database string) ([]SchemaIndexRow, error)
 		DropTable(table string) error
 		DropAllTables(database string) error
 		CreateDatabase(database string) error
@@ -57,6 +57,14 @@ const (
 
 	listTablesQuery = "SHOW TABLES FROM %v"
 
+	listColumnsQuery = `SELECT table_name AS table_name, column_name AS column_name, column_type AS data_type, ` +
+		`is_nullable = 'YES' AS is_nullable FROM information_schema.columns ` +
+		`WHERE table_schema = ? ORDER BY table_name, ordinal_position`
+
+	listIndexesQuery = `SELECT table_name AS table_name, index_name AS index_name, column_name AS column_name, ` +
+		`non_unique = 0 AS is_unique, seq_in_index AS position FROM information_schema.statistics ` +
+		`WHERE table_schema = ? ORDER BY table_name, index_name, seq_in_index`
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -100,6 +108,20 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, err
 }
 
+// ListColumns returns the columns of all tables in this database
+func (mdb *db) ListColumns(database string) ([]sqlplugin.SchemaColumnRow, error) {
+	var columns []sqlplugin.SchemaColumnRow
+	err := mdb.driver.SelectForSchemaQuery(sqlplugin.DbShardUndefined, &columns, listColumnsQuery, database)
+	return columns, err
+}
+
+// ListIndexes returns the columns of all indexes in this database
+func (mdb *db) ListIndexes(database string) ([]sqlplugin.SchemaIndexRow, error) {
+	var indexes []sqlplugin.SchemaIndexRow
+	err := mdb.driver.SelectForSchemaQuery(sqlplugin.DbShardUndefined, &indexes, listIndexesQuery, database)
+	return indexes, err
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.ExecSchemaOperationQuery(context.Background(), fmt.Sprintf(dropTableQuery, name))
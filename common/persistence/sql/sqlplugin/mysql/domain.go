@@ -34,7 +34,7 @@ const (
  VALUES(?, ?, ?, ?, ?)`
 
 	updateDomainQuery = `UPDATE domains 
- SET name = ?, data = ?, data_encoding = ?
+ SET name = ?, data = ?, data_encoding = ?, is_global = ?
  WHERE shard_id=54321 AND id = ?`
 
 	getDomainPart = `SELECT id, name, is_global, data, data_encoding FROM domains`
@@ -66,7 +66,7 @@ func (mdb *db) InsertIntoDomain(ctx context.Context, row *sqlplugin.DomainRow) (
 
 // UpdateDomain updates a single row in domains table
 func (mdb *db) UpdateDomain(ctx context.Context, row *sqlplugin.DomainRow) (sql.Result, error) {
-	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, updateDomainQuery, row.Name, row.Data, row.DataEncoding, row.ID)
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, updateDomainQuery, row.Name, row.Data, row.DataEncoding, row.IsGlobal, row.ID)
 }
 
 // SelectFromDomain reads one or more rows from domains table
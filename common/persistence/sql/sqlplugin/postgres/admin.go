@@ -63,6 +63,20 @@ const (
 
 	listTablesQuery = "select table_name from information_schema.tables where table_schema='public'"
 
+	listColumnsQuery = `SELECT c.relname AS table_name, a.attname AS column_name, ` +
+		`format_type(a.atttypid, a.atttypmod) AS data_type, NOT a.attnotnull AS is_nullable ` +
+		`FROM pg_attribute a JOIN pg_class c ON c.oid = a.attrelid JOIN pg_namespace n ON n.oid = c.relnamespace ` +
+		`WHERE n.nspname = 'public' AND c.relkind = 'r' AND a.attnum > 0 AND NOT a.attisdropped ` +
+		`ORDER BY c.relname, a.attnum`
+
+	listIndexesQuery = `SELECT t.relname AS table_name, i.relname AS index_name, a.attname AS column_name, ` +
+		`ix.indisunique AS is_unique, k.position AS position ` +
+		`FROM pg_index ix JOIN pg_class t ON t.oid = ix.indrelid JOIN pg_class i ON i.oid = ix.indexrelid ` +
+		`JOIN pg_namespace n ON n.oid = t.relnamespace ` +
+		`CROSS JOIN LATERAL unnest(ix.indkey) WITH ORDINALITY AS k(attnum, position) ` +
+		`JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum ` +
+		`WHERE n.nspname = 'public' ORDER BY t.relname, i.relname, k.position`
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -106,6 +120,20 @@ func (pdb *db) ListTables(database string) ([]string, error) {
 	return tables, err
 }
 
+// ListColumns returns the columns of all tables in this database
+func (pdb *db) ListColumns(database string) ([]sqlplugin.SchemaColumnRow, error) {
+	var columns []sqlplugin.SchemaColumnRow
+	err := pdb.driver.SelectForSchemaQuery(sqlplugin.DbShardUndefined, &columns, listColumnsQuery)
+	return columns, err
+}
+
+// ListIndexes returns the columns of all indexes in this database
+func (pdb *db) ListIndexes(database string) ([]sqlplugin.SchemaIndexRow, error) {
+	var indexes []sqlplugin.SchemaIndexRow
+	err := pdb.driver.SelectForSchemaQuery(sqlplugin.DbShardUndefined, &indexes, listIndexesQuery)
+	return indexes, err
+}
+
 // DropTable drops a given table from the database
 func (pdb *db) DropTable(name string) error {
 	return pdb.ExecSchemaOperationQuery(context.Background(), fmt.Sprintf(dropTableQuery, name))
@@ -34,8 +34,8 @@ const (
  VALUES($1, $2, $3, $4, $5)`
 
 	updateDomainQuery = `UPDATE domains 
- SET name = $1, data = $2, data_encoding = $3
- WHERE shard_id=54321 AND id = $4`
+ SET name = $1, data = $2, data_encoding = $3, is_global = $4
+ WHERE shard_id=54321 AND id = $5`
 
 	getDomainPart = `SELECT id, name, is_global, data, data_encoding FROM domains`
 
@@ -66,7 +66,7 @@ func (pdb *db) InsertIntoDomain(ctx context.Context, row *sqlplugin.DomainRow) (
 
 // UpdateDomain updates a single row in domains table
 func (pdb *db) UpdateDomain(ctx context.Context, row *sqlplugin.DomainRow) (sql.Result, error) {
-	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, updateDomainQuery, row.Name, row.Data, row.DataEncoding, row.ID)
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, updateDomainQuery, row.Name, row.Data, row.DataEncoding, row.IsGlobal, row.ID)
 }
 
 // SelectFromDomain reads one or more rows from domains table
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/types"
@@ -34,6 +35,7 @@ import (
 type (
 	visibilityManagerImpl struct {
 		serializer  PayloadSerializer
+		encryptor   encryption.Encryptor
 		persistence VisibilityStore
 		logger      log.Logger
 	}
@@ -45,9 +47,10 @@ const VisibilityEncoding = common.EncodingTypeThriftRW
 var _ VisibilityManager = (*visibilityManagerImpl)(nil)
 
 // NewVisibilityManagerImpl returns new VisibilityManager via a VisibilityStore
-func NewVisibilityManagerImpl(persistence VisibilityStore, logger log.Logger) VisibilityManager {
+func NewVisibilityManagerImpl(persistence VisibilityStore, logger log.Logger, encryptor encryption.Encryptor) VisibilityManager {
 	return &visibilityManagerImpl{
-		serializer:  NewPayloadSerializer(),
+		serializer:  NewEncryptingPayloadSerializer(NewPayloadSerializer(), encryptor, ""),
+		encryptor:   encryptor,
 		persistence: persistence,
 		logger:      logger,
 	}
@@ -77,7 +80,7 @@ func (v *visibilityManagerImpl) RecordWorkflowExecutionStarted(
 		TaskList:           request.TaskList,
 		IsCron:             request.IsCron,
 		NumClusters:        request.NumClusters,
-		Memo:               v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID()),
+		Memo:               v.serializeMemo(request.Memo, request.EncryptionKeyID, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID()),
 		SearchAttributes:   request.SearchAttributes,
 	}
 	return v.persistence.RecordWorkflowExecutionStarted(ctx, req)
@@ -95,7 +98,7 @@ func (v *visibilityManagerImpl) RecordWorkflowExecutionClosed(
 		StartTimestamp:     time.Unix(0, request.StartTimestamp),
 		ExecutionTimestamp: time.Unix(0, request.ExecutionTimestamp),
 		TaskID:             request.TaskID,
-		Memo:               v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID()),
+		Memo:               v.serializeMemo(request.Memo, request.EncryptionKeyID, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID()),
 		TaskList:           request.TaskList,
 		SearchAttributes:   request.SearchAttributes,
 		CloseTimestamp:     time.Unix(0, request.CloseTimestamp),
@@ -120,7 +123,7 @@ func (v *visibilityManagerImpl) UpsertWorkflowExecution(
 		StartTimestamp:     time.Unix(0, request.StartTimestamp),
 		ExecutionTimestamp: time.Unix(0, request.ExecutionTimestamp),
 		TaskID:             request.TaskID,
-		Memo:               v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID()),
+		Memo:               v.serializeMemo(request.Memo, request.EncryptionKeyID, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID()),
 		TaskList:           request.TaskList,
 		IsCron:             request.IsCron,
 		NumClusters:        request.NumClusters,
@@ -413,8 +416,9 @@ func (v *visibilityManagerImpl) toInternalListWorkflowExecutionsRequest(req *Lis
 	}
 }
 
-func (v *visibilityManagerImpl) serializeMemo(visibilityMemo *types.Memo, domainID, wID, rID string) *DataBlob {
-	memo, err := v.serializer.SerializeVisibilityMemo(visibilityMemo, VisibilityEncoding)
+func (v *visibilityManagerImpl) serializeMemo(visibilityMemo *types.Memo, encryptionKeyID, domainID, wID, rID string) *DataBlob {
+	memo, err := NewEncryptingPayloadSerializer(v.serializer, v.encryptor, encryptionKeyID).
+		SerializeVisibilityMemo(visibilityMemo, VisibilityEncoding)
 	if err != nil {
 		v.logger.WithTags(
 			tag.WorkflowDomainID(domainID),
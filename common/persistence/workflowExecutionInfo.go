@@ -74,6 +74,11 @@ func (e *WorkflowExecutionInfo) UpdateWorkflowStateCloseStatus(
 				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
 			}
 
+		case WorkflowStateSuspended:
+			if closeStatus != WorkflowCloseStatusNone {
+				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
+			}
+
 		default:
 			return &types.InternalServiceError{
 				Message: fmt.Sprintf("unknown workflow state: %v", state),
@@ -99,6 +104,11 @@ func (e *WorkflowExecutionInfo) UpdateWorkflowStateCloseStatus(
 				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
 			}
 
+		case WorkflowStateSuspended:
+			if closeStatus != WorkflowCloseStatusNone {
+				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
+			}
+
 		default:
 			return &types.InternalServiceError{
 				Message: fmt.Sprintf("unknown workflow state: %v", state),
@@ -120,6 +130,9 @@ func (e *WorkflowExecutionInfo) UpdateWorkflowStateCloseStatus(
 		case WorkflowStateZombie:
 			return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
 
+		case WorkflowStateSuspended:
+			return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
+
 		default:
 			return &types.InternalServiceError{
 				Message: fmt.Sprintf("unknown workflow state: %v", state),
@@ -147,6 +160,41 @@ func (e *WorkflowExecutionInfo) UpdateWorkflowStateCloseStatus(
 				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
 			}
 
+		case WorkflowStateSuspended:
+			return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
+
+		default:
+			return &types.InternalServiceError{
+				Message: fmt.Sprintf("unknown workflow state: %v", state),
+			}
+		}
+	case WorkflowStateSuspended:
+		switch state {
+		case WorkflowStateCreated:
+			if closeStatus != WorkflowCloseStatusNone {
+				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
+			}
+
+		case WorkflowStateRunning:
+			if closeStatus != WorkflowCloseStatusNone {
+				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
+			}
+
+		case WorkflowStateCompleted:
+			if closeStatus == WorkflowCloseStatusNone {
+				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
+			}
+
+		case WorkflowStateZombie:
+			if closeStatus != WorkflowCloseStatusNone {
+				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
+			}
+
+		case WorkflowStateSuspended:
+			if closeStatus != WorkflowCloseStatusNone {
+				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
+			}
+
 		default:
 			return &types.InternalServiceError{
 				Message: fmt.Sprintf("unknown workflow state: %v", state),
@@ -33,6 +33,7 @@ var (
 		WorkflowStateCompleted: {},
 		WorkflowStateZombie:    {},
 		WorkflowStateCorrupted: {},
+		WorkflowStateSuspended: {},
 	}
 
 	validWorkflowCloseStatuses = map[int]struct{}{
@@ -191,6 +191,23 @@ func (s *workflowStateCloseStatusSuite) TestUpdateWorkflowStateCloseStatus_Workf
 	}
 }
 
+func (s *workflowStateCloseStatusSuite) TestUpdateWorkflowStateCloseStatus_WorkflowStateSuspended() {
+	closeStatuses := []int{
+		WorkflowCloseStatusCompleted,
+		WorkflowCloseStatusFailed,
+		WorkflowCloseStatusCanceled,
+		WorkflowCloseStatusTerminated,
+		WorkflowCloseStatusContinuedAsNew,
+		WorkflowCloseStatusTimedOut,
+	}
+
+	s.Nil(ValidateUpdateWorkflowStateCloseStatus(WorkflowStateSuspended, WorkflowCloseStatusNone))
+
+	for _, closeStatus := range closeStatuses {
+		s.NotNil(ValidateUpdateWorkflowStateCloseStatus(WorkflowStateSuspended, closeStatus))
+	}
+}
+
 func (s *workflowStateCloseStatusSuite) TestInternalMapping() {
 	s.Nil(ToInternalWorkflowExecutionCloseStatus(WorkflowCloseStatusNone))
 	s.Equal(types.WorkflowExecutionCloseStatusCompleted.Ptr(), ToInternalWorkflowExecutionCloseStatus(WorkflowCloseStatusCompleted))
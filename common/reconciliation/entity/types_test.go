@@ -154,7 +154,7 @@ func (t *TypeSuite) TestValidateExecution() {
 					DomainID:   domainID,
 					WorkflowID: workflowID,
 					RunID:      runID,
-					State:      persistence.WorkflowStateCorrupted + 1,
+					State:      persistence.WorkflowStateSuspended + 1,
 				},
 				BranchToken: []byte{1, 2, 3},
 				TreeID:      treeID,
@@ -29,11 +29,11 @@ import (
 	"strings"
 )
 
-const _CollectionName = "CollectionMutableStateCollectionHistory"
+const _CollectionName = "CollectionMutableStateCollectionHistoryCollectionVisibilityCollectionReplay"
 
-var _CollectionIndex = [...]uint8{0, 22, 39}
+var _CollectionIndex = [...]uint8{0, 22, 39, 59, 75}
 
-const _CollectionLowerName = "collectionmutablestatecollectionhistory"
+const _CollectionLowerName = "collectionmutablestatecollectionhistorycollectionvisibilitycollectionreplay"
 
 func (i Collection) String() string {
 	if i < 0 || i >= Collection(len(_CollectionIndex)-1) {
@@ -48,20 +48,28 @@ func _CollectionNoOp() {
 	var x [1]struct{}
 	_ = x[CollectionMutableState-(0)]
 	_ = x[CollectionHistory-(1)]
+	_ = x[CollectionVisibility-(2)]
+	_ = x[CollectionReplay-(3)]
 }
 
-var _CollectionValues = []Collection{CollectionMutableState, CollectionHistory}
+var _CollectionValues = []Collection{CollectionMutableState, CollectionHistory, CollectionVisibility, CollectionReplay}
 
 var _CollectionNameToValueMap = map[string]Collection{
 	_CollectionName[0:22]:       CollectionMutableState,
 	_CollectionLowerName[0:22]:  CollectionMutableState,
 	_CollectionName[22:39]:      CollectionHistory,
 	_CollectionLowerName[22:39]: CollectionHistory,
+	_CollectionName[39:59]:      CollectionVisibility,
+	_CollectionLowerName[39:59]: CollectionVisibility,
+	_CollectionName[59:75]:      CollectionReplay,
+	_CollectionLowerName[59:75]: CollectionReplay,
 }
 
 var _CollectionNames = []string{
 	_CollectionName[0:22],
 	_CollectionName[22:39],
+	_CollectionName[39:59],
+	_CollectionName[59:75],
 }
 
 // CollectionString retrieves an enum value from the enum constants string name.
@@ -0,0 +1,148 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"strings"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	mutableStateReplay struct {
+		historyClient history.Client
+	}
+)
+
+// NewMutableStateReplay returns a new invariant for checking that the mutable state of a concrete
+// execution matches the mutable state rebuilt by replaying its history
+func NewMutableStateReplay(
+	historyClient history.Client,
+) Invariant {
+	return &mutableStateReplay{
+		historyClient: historyClient,
+	}
+}
+
+func (m *mutableStateReplay) Check(
+	ctx context.Context,
+	execution interface{},
+) CheckResult {
+	if checkResult := validateCheckContext(ctx, m.Name()); checkResult != nil {
+		return *checkResult
+	}
+
+	concreteExecution, ok := execution.(*entity.ConcreteExecution)
+	if !ok {
+		return CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   m.Name(),
+			Info:            "failed to check: expected concrete execution",
+		}
+	}
+
+	mismatches, err := m.rebuild(ctx, concreteExecution, true)
+	if err != nil {
+		switch err.(type) {
+		case *types.EntityNotExistsError:
+			// execution is deleted after the scan
+			return CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   m.Name(),
+			}
+		default:
+			return CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   m.Name(),
+				Info:            "failed to rebuild mutable state",
+				InfoDetails:     err.Error(),
+			}
+		}
+	}
+	if len(mismatches) > 0 {
+		return CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   m.Name(),
+			Info:            "mutable state does not match the mutable state rebuilt from history",
+			InfoDetails:     strings.Join(mismatches, "; "),
+		}
+	}
+	return CheckResult{
+		CheckResultType: CheckResultTypeHealthy,
+		InvariantName:   m.Name(),
+	}
+}
+
+// Fix replaces the mutable state of the execution with the one rebuilt from history
+func (m *mutableStateReplay) Fix(
+	ctx context.Context,
+	execution interface{},
+) FixResult {
+	if fixResult := validateFixContext(ctx, m.Name()); fixResult != nil {
+		return *fixResult
+	}
+
+	fixResult, checkResult := checkBeforeFix(ctx, m, execution)
+	if fixResult != nil {
+		return *fixResult
+	}
+
+	if _, err := m.rebuild(ctx, execution.(*entity.ConcreteExecution), false); err != nil {
+		return FixResult{
+			FixResultType: FixResultTypeFailed,
+			InvariantName: m.Name(),
+			CheckResult:   *checkResult,
+			Info:          "failed to rebuild mutable state",
+			InfoDetails:   err.Error(),
+		}
+	}
+	return FixResult{
+		FixResultType: FixResultTypeFixed,
+		InvariantName: m.Name(),
+		CheckResult:   *checkResult,
+	}
+}
+
+func (m *mutableStateReplay) Name() Name {
+	return MutableStateReplay
+}
+
+func (m *mutableStateReplay) rebuild(
+	ctx context.Context,
+	concreteExecution *entity.ConcreteExecution,
+	dryRun bool,
+) ([]string, error) {
+	resp, err := m.historyClient.RebuildMutableState(ctx, &types.HistoryRebuildMutableStateRequest{
+		DomainUUID: concreteExecution.DomainID,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: concreteExecution.WorkflowID,
+			RunID:      concreteExecution.RunID,
+		},
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetMismatches(), nil
+}
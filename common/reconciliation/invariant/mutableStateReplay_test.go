@@ -0,0 +1,148 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common/types"
+)
+
+type MutableStateReplaySuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestMutableStateReplaySuite(t *testing.T) {
+	suite.Run(t, new(MutableStateReplaySuite))
+}
+
+func (s *MutableStateReplaySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *MutableStateReplaySuite) TestCheck() {
+	testCases := []struct {
+		rebuildResp    *types.RebuildMutableStateResponse
+		rebuildErr     error
+		expectedResult CheckResult
+	}{
+		{
+			rebuildErr: &types.EntityNotExistsError{},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   MutableStateReplay,
+			},
+		},
+		{
+			rebuildErr: &types.ServiceBusyError{Message: "busy"},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   MutableStateReplay,
+				Info:            "failed to rebuild mutable state",
+				InfoDetails:     "ServiceBusyError{Message: busy}",
+			},
+		},
+		{
+			rebuildResp: &types.RebuildMutableStateResponse{},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   MutableStateReplay,
+			},
+		},
+		{
+			rebuildResp: &types.RebuildMutableStateResponse{
+				Mismatches: []string{"NextEventID: stored 10, rebuilt 12", "PendingActivityInfos[5]: stored true, rebuilt false"},
+			},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   MutableStateReplay,
+				Info:            "mutable state does not match the mutable state rebuilt from history",
+				InfoDetails:     "NextEventID: stored 10, rebuilt 12; PendingActivityInfos[5]: stored true, rebuilt false",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		ctrl := gomock.NewController(s.T())
+		historyClient := history.NewMockClient(ctrl)
+		historyClient.EXPECT().RebuildMutableState(gomock.Any(), &types.HistoryRebuildMutableStateRequest{
+			DomainUUID: domainID,
+			Execution:  &types.WorkflowExecution{WorkflowID: workflowID, RunID: runID},
+			DryRun:     true,
+		}).Return(tc.rebuildResp, tc.rebuildErr)
+		m := NewMutableStateReplay(historyClient)
+		s.Equal(tc.expectedResult, m.Check(context.Background(), getOpenConcreteExecution()))
+		ctrl.Finish()
+	}
+}
+
+func (s *MutableStateReplaySuite) TestFix() {
+	mismatched := &types.RebuildMutableStateResponse{Mismatches: []string{"NextEventID: stored 10, rebuilt 12"}}
+	testCases := []struct {
+		checkResp      *types.RebuildMutableStateResponse
+		expectRebuild  bool
+		rebuildErr     error
+		expectedResult FixResultType
+	}{
+		{
+			checkResp:      &types.RebuildMutableStateResponse{},
+			expectedResult: FixResultTypeSkipped,
+		},
+		{
+			checkResp:      mismatched,
+			expectRebuild:  true,
+			expectedResult: FixResultTypeFixed,
+		},
+		{
+			checkResp:      mismatched,
+			expectRebuild:  true,
+			rebuildErr:     errors.New("error rebuilding"),
+			expectedResult: FixResultTypeFailed,
+		},
+	}
+	for _, tc := range testCases {
+		ctrl := gomock.NewController(s.T())
+		historyClient := history.NewMockClient(ctrl)
+		request := &types.HistoryRebuildMutableStateRequest{
+			DomainUUID: domainID,
+			Execution:  &types.WorkflowExecution{WorkflowID: workflowID, RunID: runID},
+			DryRun:     true,
+		}
+		historyClient.EXPECT().RebuildMutableState(gomock.Any(), request).Return(tc.checkResp, nil)
+		if tc.expectRebuild {
+			fixRequest := *request
+			fixRequest.DryRun = false
+			historyClient.EXPECT().RebuildMutableState(gomock.Any(), &fixRequest).Return(tc.checkResp, tc.rebuildErr)
+		}
+		m := NewMutableStateReplay(historyClient)
+		result := m.Fix(context.Background(), getOpenConcreteExecution())
+		s.Equal(tc.expectedResult, result.FixResultType)
+		s.Equal(MutableStateReplay, result.InvariantName)
+		ctrl.Finish()
+	}
+}
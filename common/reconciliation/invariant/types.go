@@ -48,11 +48,19 @@ const (
 	OpenCurrentExecution Name = "open_current_execution"
 	// ConcreteExecutionExists asserts that an open current execution must have a valid concrete execution
 	ConcreteExecutionExists Name = "concrete_execution_exists"
+	// VisibilityConsistent asserts that the visibility record of a concrete execution matches its mutable state
+	VisibilityConsistent Name = "visibility_consistent"
+	// MutableStateReplay asserts that the mutable state of a concrete execution matches the one rebuilt from its history
+	MutableStateReplay Name = "mutable_state_replay"
 
 	// CollectionMutableState is the collection of invariants relating to mutable state
 	CollectionMutableState Collection = 0
 	// CollectionHistory is the collection  of invariants relating to history
 	CollectionHistory Collection = 1
+	// CollectionVisibility is the collection of invariants relating to visibility records
+	CollectionVisibility Collection = 2
+	// CollectionReplay is the collection of invariants relating to replaying history
+	CollectionReplay Collection = 3
 )
 
 type (
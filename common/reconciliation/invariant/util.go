@@ -56,7 +56,9 @@ func checkBeforeFix(
 
 // Open returns true if workflow state is open false if workflow is closed
 func Open(state int) bool {
-	return state == persistence.WorkflowStateCreated || state == persistence.WorkflowStateRunning
+	return state == persistence.WorkflowStateCreated ||
+		state == persistence.WorkflowStateRunning ||
+		state == persistence.WorkflowStateSuspended
 }
 
 // ExecutionOpen returns true if execution state is open false if workflow is closed
@@ -0,0 +1,272 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// visibility records are written asynchronously by transfer tasks (and Kafka for advanced visibility),
+	// so executions which changed recently are not checked
+	visibilityGracePeriod = time.Hour
+	// visibilityTimeSkew widens the time range of the list requests to tolerate clock differences
+	// between the mutable state and the visibility record
+	visibilityTimeSkew     = time.Minute
+	visibilityListPageSize = 100
+)
+
+type (
+	visibilityConsistent struct {
+		pr                persistence.Retryer
+		visibilityManager persistence.VisibilityManager
+		historyClient     history.Client
+		domainCache       cache.DomainCache
+	}
+
+	listVisibilityFn func(context.Context, *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.ListWorkflowExecutionsResponse, error)
+)
+
+// NewVisibilityConsistent returns a new invariant for checking that the visibility record
+// of a concrete execution matches its mutable state
+func NewVisibilityConsistent(
+	pr persistence.Retryer,
+	visibilityManager persistence.VisibilityManager,
+	historyClient history.Client,
+	domainCache cache.DomainCache,
+) Invariant {
+	return &visibilityConsistent{
+		pr:                pr,
+		visibilityManager: visibilityManager,
+		historyClient:     historyClient,
+		domainCache:       domainCache,
+	}
+}
+
+func (v *visibilityConsistent) Check(
+	ctx context.Context,
+	execution interface{},
+) CheckResult {
+	if checkResult := validateCheckContext(ctx, v.Name()); checkResult != nil {
+		return *checkResult
+	}
+
+	concreteExecution, ok := execution.(*entity.ConcreteExecution)
+	if !ok {
+		return CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   v.Name(),
+			Info:            "failed to check: expected concrete execution",
+		}
+	}
+
+	resp, err := v.pr.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID: concreteExecution.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: concreteExecution.WorkflowID,
+			RunID:      concreteExecution.RunID,
+		},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *types.EntityNotExistsError:
+			// execution is deleted after the scan, its visibility record is deleted by retention
+			return CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   v.Name(),
+			}
+		default:
+			return CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   v.Name(),
+				Info:            "failed to get concrete execution",
+				InfoDetails:     err.Error(),
+			}
+		}
+	}
+
+	executionInfo := resp.State.ExecutionInfo
+	open := Open(executionInfo.State)
+	lastChange := executionInfo.LastUpdatedTimestamp
+	if open {
+		// the started record is written once, later updates of an open execution do not change it
+		lastChange = executionInfo.StartTimestamp
+	}
+	if time.Since(lastChange) < visibilityGracePeriod {
+		return CheckResult{
+			CheckResultType: CheckResultTypeHealthy,
+			InvariantName:   v.Name(),
+		}
+	}
+
+	domainName, err := v.domainCache.GetDomainName(concreteExecution.DomainID)
+	if err != nil {
+		return CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   v.Name(),
+			Info:            "failed to get domain name",
+			InfoDetails:     err.Error(),
+		}
+	}
+	request := &persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:   concreteExecution.DomainID,
+			Domain:       domainName,
+			EarliestTime: executionInfo.StartTimestamp.Add(-visibilityTimeSkew).UnixNano(),
+			LatestTime:   time.Now().UnixNano(),
+			PageSize:     visibilityListPageSize,
+		},
+		WorkflowID: concreteExecution.WorkflowID,
+	}
+
+	openRecord, err := v.findRecord(ctx, v.visibilityManager.ListOpenWorkflowExecutionsByWorkflowID, request, concreteExecution.RunID)
+	if err != nil {
+		return CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   v.Name(),
+			Info:            "failed to list open visibility records",
+			InfoDetails:     err.Error(),
+		}
+	}
+	if open {
+		if openRecord == nil {
+			return CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   v.Name(),
+				Info:            "execution is open but its open visibility record is missing",
+			}
+		}
+		return CheckResult{
+			CheckResultType: CheckResultTypeHealthy,
+			InvariantName:   v.Name(),
+		}
+	}
+	if openRecord != nil {
+		return CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   v.Name(),
+			Info:            "execution is closed but its visibility record is still open",
+		}
+	}
+
+	closedRecord, err := v.findRecord(ctx, v.visibilityManager.ListClosedWorkflowExecutionsByWorkflowID, request, concreteExecution.RunID)
+	if err != nil {
+		return CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   v.Name(),
+			Info:            "failed to list closed visibility records",
+			InfoDetails:     err.Error(),
+		}
+	}
+	if closedRecord == nil {
+		return CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   v.Name(),
+			Info:            "execution is closed but its closed visibility record is missing",
+		}
+	}
+	closeStatus := persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
+	if closeStatus != nil && closedRecord.CloseStatus != nil && *closeStatus != *closedRecord.CloseStatus {
+		return CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   v.Name(),
+			Info:            "close status of visibility record does not match the execution",
+			InfoDetails:     fmt.Sprintf("execution close status %v, visibility close status %v", *closeStatus, *closedRecord.CloseStatus),
+		}
+	}
+	return CheckResult{
+		CheckResultType: CheckResultTypeHealthy,
+		InvariantName:   v.Name(),
+	}
+}
+
+// Fix re-emits the visibility record from the mutable state of the execution
+func (v *visibilityConsistent) Fix(
+	ctx context.Context,
+	execution interface{},
+) FixResult {
+	if fixResult := validateFixContext(ctx, v.Name()); fixResult != nil {
+		return *fixResult
+	}
+
+	fixResult, checkResult := checkBeforeFix(ctx, v, execution)
+	if fixResult != nil {
+		return *fixResult
+	}
+
+	concreteExecution := execution.(*entity.ConcreteExecution)
+	if err := v.historyClient.ReindexWorkflowExecution(ctx, &types.HistoryReindexWorkflowExecutionRequest{
+		DomainUUID: concreteExecution.DomainID,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: concreteExecution.WorkflowID,
+			RunID:      concreteExecution.RunID,
+		},
+	}); err != nil {
+		return FixResult{
+			FixResultType: FixResultTypeFailed,
+			InvariantName: v.Name(),
+			CheckResult:   *checkResult,
+			Info:          "failed to reindex workflow execution",
+			InfoDetails:   err.Error(),
+		}
+	}
+	return FixResult{
+		FixResultType: FixResultTypeFixed,
+		InvariantName: v.Name(),
+		CheckResult:   *checkResult,
+	}
+}
+
+func (v *visibilityConsistent) Name() Name {
+	return VisibilityConsistent
+}
+
+func (v *visibilityConsistent) findRecord(
+	ctx context.Context,
+	list listVisibilityFn,
+	request *persistence.ListWorkflowExecutionsByWorkflowIDRequest,
+	runID string,
+) (*types.WorkflowExecutionInfo, error) {
+	req := *request
+	for {
+		resp, err := list(ctx, &req)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range resp.Executions {
+			if record.GetExecution().GetRunID() == runID {
+				return record, nil
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
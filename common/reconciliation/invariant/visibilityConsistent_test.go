@@ -0,0 +1,268 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/client/history"
+	c2 "github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+)
+
+type VisibilityConsistentSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestVisibilityConsistentSuite(t *testing.T) {
+	suite.Run(t, new(VisibilityConsistentSuite))
+}
+
+func (s *VisibilityConsistentSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *VisibilityConsistentSuite) TestCheck() {
+	oldTime := time.Now().Add(-2 * visibilityGracePeriod)
+	record := &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{WorkflowID: workflowID, RunID: runID},
+	}
+	completedRecord := &types.WorkflowExecutionInfo{
+		Execution:   &types.WorkflowExecution{WorkflowID: workflowID, RunID: runID},
+		CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+	}
+	otherRecord := &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{WorkflowID: workflowID, RunID: currentRunID},
+	}
+
+	testCases := []struct {
+		execution       *entity.ConcreteExecution
+		getConcreteResp *persistence.GetWorkflowExecutionResponse
+		getConcreteErr  error
+		openRecords     []*types.WorkflowExecutionInfo
+		openErr         error
+		closedRecords   []*types.WorkflowExecutionInfo
+		expectedResult  CheckResult
+	}{
+		{
+			execution:      getOpenConcreteExecution(),
+			getConcreteErr: &types.EntityNotExistsError{},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   VisibilityConsistent,
+			},
+		},
+		{
+			execution:      getOpenConcreteExecution(),
+			getConcreteErr: errors.New("error getting concrete execution"),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   VisibilityConsistent,
+				Info:            "failed to get concrete execution",
+				InfoDetails:     "error getting concrete execution",
+			},
+		},
+		{
+			execution:       getOpenConcreteExecution(),
+			getConcreteResp: getMutableStateResponse(openState, persistence.WorkflowCloseStatusNone, time.Now()),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   VisibilityConsistent,
+			},
+		},
+		{
+			execution:       getOpenConcreteExecution(),
+			getConcreteResp: getMutableStateResponse(openState, persistence.WorkflowCloseStatusNone, oldTime),
+			openErr:         errors.New("error listing open executions"),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   VisibilityConsistent,
+				Info:            "failed to list open visibility records",
+				InfoDetails:     "error listing open executions",
+			},
+		},
+		{
+			execution:       getOpenConcreteExecution(),
+			getConcreteResp: getMutableStateResponse(openState, persistence.WorkflowCloseStatusNone, oldTime),
+			openRecords:     []*types.WorkflowExecutionInfo{otherRecord},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   VisibilityConsistent,
+				Info:            "execution is open but its open visibility record is missing",
+			},
+		},
+		{
+			execution:       getOpenConcreteExecution(),
+			getConcreteResp: getMutableStateResponse(openState, persistence.WorkflowCloseStatusNone, oldTime),
+			openRecords:     []*types.WorkflowExecutionInfo{otherRecord, record},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   VisibilityConsistent,
+			},
+		},
+		{
+			execution:       getClosedConcreteExecution(),
+			getConcreteResp: getMutableStateResponse(closedState, persistence.WorkflowCloseStatusCompleted, oldTime),
+			openRecords:     []*types.WorkflowExecutionInfo{record},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   VisibilityConsistent,
+				Info:            "execution is closed but its visibility record is still open",
+			},
+		},
+		{
+			execution:       getClosedConcreteExecution(),
+			getConcreteResp: getMutableStateResponse(closedState, persistence.WorkflowCloseStatusCompleted, oldTime),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   VisibilityConsistent,
+				Info:            "execution is closed but its closed visibility record is missing",
+			},
+		},
+		{
+			execution:       getClosedConcreteExecution(),
+			getConcreteResp: getMutableStateResponse(closedState, persistence.WorkflowCloseStatusFailed, oldTime),
+			closedRecords:   []*types.WorkflowExecutionInfo{completedRecord},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   VisibilityConsistent,
+				Info:            "close status of visibility record does not match the execution",
+				InfoDetails:     "execution close status FAILED, visibility close status COMPLETED",
+			},
+		},
+		{
+			execution:       getClosedConcreteExecution(),
+			getConcreteResp: getMutableStateResponse(closedState, persistence.WorkflowCloseStatusCompleted, oldTime),
+			closedRecords:   []*types.WorkflowExecutionInfo{completedRecord},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   VisibilityConsistent,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		ctrl := gomock.NewController(s.T())
+		execManager := &mocks.ExecutionManager{}
+		execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(tc.getConcreteResp, tc.getConcreteErr)
+		visibilityManager := &mocks.VisibilityManager{}
+		var openResp *persistence.ListWorkflowExecutionsResponse
+		if tc.openErr == nil {
+			openResp = &persistence.ListWorkflowExecutionsResponse{Executions: tc.openRecords}
+		}
+		visibilityManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.Anything).Return(openResp, tc.openErr)
+		visibilityManager.On("ListClosedWorkflowExecutionsByWorkflowID", mock.Anything, mock.Anything).
+			Return(&persistence.ListWorkflowExecutionsResponse{Executions: tc.closedRecords}, nil)
+		domainCache := cache.NewMockDomainCache(ctrl)
+		domainCache.EXPECT().GetDomainName(domainID).Return("test-domain", nil).AnyTimes()
+
+		v := NewVisibilityConsistent(
+			persistence.NewPersistenceRetryer(execManager, nil, c2.CreatePersistenceRetryPolicy()),
+			visibilityManager,
+			history.NewMockClient(ctrl),
+			domainCache,
+		)
+		s.Equal(tc.expectedResult, v.Check(context.Background(), tc.execution))
+		ctrl.Finish()
+	}
+}
+
+func (s *VisibilityConsistentSuite) TestFix() {
+	oldTime := time.Now().Add(-2 * visibilityGracePeriod)
+	testCases := []struct {
+		openRecords    []*types.WorkflowExecutionInfo
+		reindexErr     error
+		expectReindex  bool
+		expectedResult FixResultType
+	}{
+		{
+			openRecords: []*types.WorkflowExecutionInfo{
+				{Execution: &types.WorkflowExecution{WorkflowID: workflowID, RunID: runID}},
+			},
+			expectedResult: FixResultTypeSkipped,
+		},
+		{
+			expectReindex:  true,
+			expectedResult: FixResultTypeFixed,
+		},
+		{
+			reindexErr:     errors.New("error reindexing"),
+			expectReindex:  true,
+			expectedResult: FixResultTypeFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctrl := gomock.NewController(s.T())
+		execManager := &mocks.ExecutionManager{}
+		execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+			Return(getMutableStateResponse(openState, persistence.WorkflowCloseStatusNone, oldTime), nil)
+		visibilityManager := &mocks.VisibilityManager{}
+		visibilityManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.Anything).
+			Return(&persistence.ListWorkflowExecutionsResponse{Executions: tc.openRecords}, nil)
+		domainCache := cache.NewMockDomainCache(ctrl)
+		domainCache.EXPECT().GetDomainName(domainID).Return("test-domain", nil).AnyTimes()
+		historyClient := history.NewMockClient(ctrl)
+		if tc.expectReindex {
+			historyClient.EXPECT().ReindexWorkflowExecution(gomock.Any(), &types.HistoryReindexWorkflowExecutionRequest{
+				DomainUUID: domainID,
+				Execution:  &types.WorkflowExecution{WorkflowID: workflowID, RunID: runID},
+			}).Return(tc.reindexErr)
+		}
+
+		v := NewVisibilityConsistent(
+			persistence.NewPersistenceRetryer(execManager, nil, c2.CreatePersistenceRetryPolicy()),
+			visibilityManager,
+			historyClient,
+			domainCache,
+		)
+		result := v.Fix(context.Background(), getOpenConcreteExecution())
+		s.Equal(tc.expectedResult, result.FixResultType)
+		s.Equal(VisibilityConsistent, result.InvariantName)
+		ctrl.Finish()
+	}
+}
+
+func getMutableStateResponse(state int, closeStatus int, lastChange time.Time) *persistence.GetWorkflowExecutionResponse {
+	return &persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				State:                state,
+				CloseStatus:          closeStatus,
+				StartTimestamp:       lastChange,
+				LastUpdatedTimestamp: lastChange,
+			},
+		},
+	}
+}
@@ -21,9 +21,11 @@
 package rpc
 
 import (
+	"context"
 	"crypto/tls"
 	"net"
 
+	tcg "github.com/uber/tchannel-go"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
 	"go.uber.org/yarpc/peer"
@@ -58,13 +60,23 @@ func NewFactory(logger log.Logger, p Params) *Factory {
 	// Create TChannel transport
 	// This is here only because ringpop expects tchannel.ChannelTransport,
 	// everywhere else we use regular tchannel.Transport.
-	ch, err := tchannel.NewChannelTransport(
-		tchannel.ServiceName(p.ServiceName),
-		tchannel.ListenAddr(p.TChannelAddress))
+	var channelOptions []tchannel.TransportOption
+	var transportOptions []tchannel.TransportOption
+	if p.TChannelTLS != nil {
+		tlsChannel, dialer, err := newTLSChannel(p.ServiceName, p.TChannelAddress, p.TChannelTLS)
+		if err != nil {
+			logger.Fatal("Failed to create TLS channel", tag.Error(err))
+		}
+		channelOptions = append(channelOptions, tchannel.WithChannel(tlsChannel))
+		transportOptions = append(transportOptions, tchannel.Dialer(dialer))
+	} else {
+		channelOptions = append(channelOptions, tchannel.ListenAddr(p.TChannelAddress))
+	}
+	ch, err := tchannel.NewChannelTransport(append(channelOptions, tchannel.ServiceName(p.ServiceName))...)
 	if err != nil {
 		logger.Fatal("Failed to create transport channel", tag.Error(err))
 	}
-	tchannel, err := tchannel.NewTransport(tchannel.ServiceName(p.ServiceName))
+	tchannel, err := tchannel.NewTransport(append(transportOptions, tchannel.ServiceName(p.ServiceName))...)
 	if err != nil {
 		logger.Fatal("Failed to create tchannel transport", tag.Error(err))
 	}
@@ -191,8 +203,27 @@ func (d *Factory) createOutboundDispatcher(
 	return dispatcher, nil
 }
 
-func createDialer(transport *grpc.Transport, tlsConfig *tls.Config) *grpc.Dialer {
-	var dialOptions []grpc.DialOption
+// newTLSChannel creates a TChannel channel serving TLS on the given address,
+// along with a dialer for TLS connections to other hosts
+func newTLSChannel(serviceName string, address string, tlsConfig *tls.Config) (*tcg.Channel, func(context.Context, string, string) (net.Conn, error), error) {
+	dialer := (&tls.Dialer{Config: tlsConfig}).DialContext
+	ch, err := tcg.NewChannel(serviceName, &tcg.ChannelOptions{Dialer: dialer})
+	if err != nil {
+		return nil, nil, err
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		ch.Close()
+		return nil, nil, err
+	}
+	if err := ch.Serve(tls.NewListener(listener, tlsConfig)); err != nil {
+		ch.Close()
+		return nil, nil, err
+	}
+	return ch, dialer, nil
+}
+
+func createDialer(transport *grpc.Transport, tlsConfig *tls.Config, dialOptions ...grpc.DialOption) *grpc.Dialer {
 	if tlsConfig != nil {
 		dialOptions = append(dialOptions, grpc.DialerCredentials(credentials.NewTLS(tlsConfig)))
 	}
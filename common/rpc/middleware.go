@@ -49,6 +49,21 @@ func (m *authOutboundMiddleware) Call(ctx context.Context, request *transport.Re
 	return out.Call(ctx, request)
 }
 
+func (m *authOutboundMiddleware) CallStream(ctx context.Context, request *transport.StreamRequest, out transport.StreamOutbound) (*transport.ClientStream, error) {
+	if m.authProvider == nil {
+		return out.CallStream(ctx, request)
+	}
+
+	token, err := m.authProvider.GetAuthToken()
+	if err != nil {
+		return nil, err
+	}
+	request.Meta.Headers = request.Meta.Headers.
+		With(common.AuthorizationTokenHeaderName, string(token))
+
+	return out.CallStream(ctx, request)
+}
+
 const _responseInfoContextKey = "response-info"
 
 // ContextWithResponseInfo will create a child context that has ResponseInfo set as value.
@@ -96,3 +111,8 @@ func (m *overrideCallerMiddleware) Call(ctx context.Context, request *transport.
 	request.Caller = m.caller
 	return out.Call(ctx, request)
 }
+
+func (m *overrideCallerMiddleware) CallStream(ctx context.Context, request *transport.StreamRequest, out transport.StreamOutbound) (*transport.ClientStream, error) {
+	request.Meta.Caller = m.caller
+	return out.CallStream(ctx, request)
+}
@@ -50,6 +50,24 @@ func TestAuthOubboundMiddleware(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAuthOubboundMiddleware_Stream(t *testing.T) {
+	m := authOutboundMiddleware{}
+	_, err := m.CallStream(context.Background(), &transport.StreamRequest{Meta: &transport.RequestMeta{}}, &fakeOutbound{verifyStream: func(request *transport.StreamRequest) {
+		assert.Empty(t, request.Meta.Headers)
+	}})
+	assert.NoError(t, err)
+
+	m = authOutboundMiddleware{fakeAuthProvider{err: assert.AnError}}
+	_, err = m.CallStream(context.Background(), &transport.StreamRequest{Meta: &transport.RequestMeta{}}, &fakeOutbound{})
+	assert.Error(t, err)
+
+	m = authOutboundMiddleware{fakeAuthProvider{token: []byte("token")}}
+	_, err = m.CallStream(context.Background(), &transport.StreamRequest{Meta: &transport.RequestMeta{}}, &fakeOutbound{verifyStream: func(request *transport.StreamRequest) {
+		assert.Equal(t, "token", request.Meta.Headers.Items()[common.AuthorizationTokenHeaderName])
+	}})
+	assert.NoError(t, err)
+}
+
 func TestResponseInfoMiddleware(t *testing.T) {
 	m := responseInfoMiddleware{}
 	ctx, responseInfo := ContextWithResponseInfo(context.Background())
@@ -83,6 +101,11 @@ func TestOverrideCallerMiddleware(t *testing.T) {
 		assert.Equal(t, "x-caller", r.Caller)
 	}})
 	assert.NoError(t, err)
+
+	_, err = m.CallStream(context.Background(), &transport.StreamRequest{Meta: &transport.RequestMeta{Caller: "service"}}, &fakeOutbound{verifyStream: func(r *transport.StreamRequest) {
+		assert.Equal(t, "x-caller", r.Meta.Caller)
+	}})
+	assert.NoError(t, err)
 }
 
 type fakeHandler struct {
@@ -95,9 +118,10 @@ func (h *fakeHandler) Handle(ctx context.Context, req *transport.Request, resw t
 }
 
 type fakeOutbound struct {
-	verify   func(*transport.Request)
-	response *transport.Response
-	err      error
+	verify       func(*transport.Request)
+	verifyStream func(*transport.StreamRequest)
+	response     *transport.Response
+	err          error
 }
 
 func (o fakeOutbound) Call(ctx context.Context, request *transport.Request) (*transport.Response, error) {
@@ -106,6 +130,12 @@ func (o fakeOutbound) Call(ctx context.Context, request *transport.Request) (*tr
 	}
 	return o.response, o.err
 }
+func (o fakeOutbound) CallStream(ctx context.Context, request *transport.StreamRequest) (*transport.ClientStream, error) {
+	if o.verifyStream != nil {
+		o.verifyStream(request)
+	}
+	return nil, o.err
+}
 func (o fakeOutbound) Start() error                      { return nil }
 func (o fakeOutbound) Stop() error                       { return nil }
 func (o fakeOutbound) IsRunning() bool                   { return true }
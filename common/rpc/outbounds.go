@@ -32,9 +32,11 @@ import (
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/middleware"
 	"go.uber.org/yarpc/api/transport"
+	yarpcgzip "go.uber.org/yarpc/compressor/gzip"
 	"go.uber.org/yarpc/peer/direct"
 	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
+	"google.golang.org/grpc/encoding/gzip"
 )
 
 const (
@@ -129,6 +131,7 @@ func (b crossDCOutbounds) Build(grpcTransport *grpc.Transport, tchannelTransport
 		}
 
 		var outbound transport.UnaryOutbound
+		var streamOutbound transport.StreamOutbound
 		switch clusterInfo.RPCTransport {
 		case tchannel.TransportName:
 			peerChooser, err := b.pcf.CreatePeerChooser(tchannelTransport, clusterInfo.RPCAddress)
@@ -141,31 +144,47 @@ func (b crossDCOutbounds) Build(grpcTransport *grpc.Transport, tchannelTransport
 			if err != nil {
 				return nil, err
 			}
-			peerChooser, err := b.pcf.CreatePeerChooser(createDialer(grpcTransport, tlsConfig), clusterInfo.RPCAddress)
+			var dialOptions []grpc.DialOption
+			if clusterInfo.RPCCompression == gzip.Name {
+				dialOptions = append(dialOptions, grpc.Compressor(yarpcgzip.New()))
+			}
+			peerChooser, err := b.pcf.CreatePeerChooser(createDialer(grpcTransport, tlsConfig, dialOptions...), clusterInfo.RPCAddress)
 			if err != nil {
 				return nil, err
 			}
-			outbound = grpcTransport.NewOutbound(peerChooser)
+			grpcOutbound := grpcTransport.NewOutbound(peerChooser)
+			outbound = grpcOutbound
+			streamOutbound = grpcOutbound
 		default:
 			return nil, fmt.Errorf("unknown cross DC transport type: %s", clusterInfo.RPCTransport)
 		}
 
 		var authMiddleware middleware.UnaryOutbound
+		var streamAuthMiddleware middleware.StreamOutbound
 		if clusterInfo.AuthorizationProvider.Enable {
 			authProvider, err := authorization.GetAuthProviderClient(clusterInfo.AuthorizationProvider.PrivateKey)
 			if err != nil {
 				return nil, fmt.Errorf("create AuthProvider: %v", err)
 			}
 			authMiddleware = &authOutboundMiddleware{authProvider}
+			streamAuthMiddleware = &authOutboundMiddleware{authProvider}
 		}
 
-		outbounds[clusterName] = transport.Outbounds{
+		clusterOutbounds := transport.Outbounds{
 			ServiceName: clusterInfo.RPCName,
 			Unary: middleware.ApplyUnaryOutbound(outbound, yarpc.UnaryOutboundMiddleware(
 				authMiddleware,
 				&overrideCallerMiddleware{crossDCCaller},
 			)),
 		}
+		// only gRPC supports streaming, which is used to push replication tasks
+		if streamOutbound != nil {
+			clusterOutbounds.Stream = middleware.ApplyStreamOutbound(streamOutbound, yarpc.StreamOutboundMiddleware(
+				streamAuthMiddleware,
+				&overrideCallerMiddleware{crossDCCaller},
+			))
+		}
+		outbounds[clusterName] = clusterOutbounds
 	}
 	return outbounds, nil
 }
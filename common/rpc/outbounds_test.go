@@ -138,7 +138,7 @@ func TestCrossDCOutbounds(t *testing.T) {
 	assert.EqualError(t, err, "create AuthProvider: invalid private key path invalid path")
 
 	clusterGroup = map[string]config.ClusterInformation{
-		"cluster-A": {Enabled: true, RPCName: "cadence-frontend", RPCAddress: "address-A", RPCTransport: "grpc", AuthorizationProvider: config.AuthorizationProvider{Enable: true, PrivateKey: tempFile(t, "key")}},
+		"cluster-A": {Enabled: true, RPCName: "cadence-frontend", RPCAddress: "address-A", RPCTransport: "grpc", RPCCompression: "gzip", AuthorizationProvider: config.AuthorizationProvider{Enable: true, PrivateKey: tempFile(t, "key")}},
 		"cluster-B": {Enabled: true, RPCName: "cadence-frontend", RPCAddress: "address-B", RPCTransport: "tchannel"},
 		"cluster-C": {Enabled: false},
 	}
@@ -149,6 +149,8 @@ func TestCrossDCOutbounds(t *testing.T) {
 	assert.Equal(t, "cadence-frontend", outbounds["cluster-B"].ServiceName)
 	assert.NotNil(t, outbounds["cluster-A"].Unary)
 	assert.NotNil(t, outbounds["cluster-B"].Unary)
+	assert.NotNil(t, outbounds["cluster-A"].Stream)
+	assert.Nil(t, outbounds["cluster-B"].Stream)
 }
 
 func TestDirectOutbound(t *testing.T) {
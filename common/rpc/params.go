@@ -42,6 +42,7 @@ type Params struct {
 
 	InboundTLS  *tls.Config
 	OutboundTLS map[string]*tls.Config
+	TChannelTLS *tls.Config
 
 	InboundMiddleware  yarpc.InboundMiddleware
 	OutboundMiddleware yarpc.OutboundMiddleware
@@ -65,6 +66,13 @@ func NewParams(serviceName string, config *config.Config, dc *dynamicconfig.Coll
 	if err != nil {
 		return Params{}, fmt.Errorf("inbound TLS config: %v", err)
 	}
+	var tchannelTLS *tls.Config
+	if serviceConfig.RPC.EnableTChannelTLS {
+		if inboundTLS == nil {
+			return Params{}, fmt.Errorf("TChannel TLS requires TLS to be enabled")
+		}
+		tchannelTLS = inboundTLS
+	}
 	outboundTLS := map[string]*tls.Config{}
 	for _, outboundServiceName := range service.List {
 		outboundServiceConfig, err := config.GetServiceConfig(outboundServiceName)
@@ -96,6 +104,7 @@ func NewParams(serviceName string, config *config.Config, dc *dynamicconfig.Coll
 		),
 		InboundTLS:  inboundTLS,
 		OutboundTLS: outboundTLS,
+		TChannelTLS: tchannelTLS,
 		InboundMiddleware: yarpc.InboundMiddleware{
 			Unary: &inboundMetricsMiddleware{},
 		},
@@ -61,12 +61,16 @@ func TestNewParams(t *testing.T) {
 	}}, dc)
 	assert.EqualError(t, err, "outbound cadence-history TLS config: open invalid: no such file or directory")
 
+	_, err = NewParams(serviceName, makeConfig(config.Service{RPC: config.RPC{BindOnLocalHost: true, EnableTChannelTLS: true}}), dc)
+	assert.EqualError(t, err, "TChannel TLS requires TLS to be enabled")
+
 	params, err := NewParams(serviceName, makeConfig(config.Service{RPC: config.RPC{BindOnLocalHost: true, Port: 1111, GRPCPort: 2222, GRPCMaxMsgSize: 3333}}), dc)
 	assert.NoError(t, err)
 	assert.Equal(t, "127.0.0.1:1111", params.TChannelAddress)
 	assert.Equal(t, "127.0.0.1:2222", params.GRPCAddress)
 	assert.Equal(t, 3333, params.GRPCMaxMsgSize)
 	assert.Nil(t, params.InboundTLS)
+	assert.Nil(t, params.TChannelTLS)
 	assert.IsType(t, GRPCPorts{}, params.HostAddressMapper)
 
 	params, err = NewParams(serviceName, makeConfig(config.Service{RPC: config.RPC{BindOnIP: "1.2.3.4", GRPCPort: 2222}}), dc)
@@ -80,4 +84,9 @@ func TestNewParams(t *testing.T) {
 	assert.Equal(t, "2222", port)
 	assert.NotNil(t, net.ParseIP(ip))
 	assert.NotNil(t, params.InboundTLS)
+	assert.Nil(t, params.TChannelTLS)
+
+	params, err = NewParams(serviceName, makeConfig(config.Service{RPC: config.RPC{BindOnLocalHost: true, TLS: config.TLS{Enabled: true}, EnableTChannelTLS: true}}), dc)
+	assert.NoError(t, err)
+	assert.Same(t, params.InboundTLS, params.TChannelTLS)
 }
@@ -43,6 +43,7 @@ type (
 
 		// configs for es visibility
 		ESIndexMaxResultWindow dynamicconfig.IntPropertyFn                 `yaml:"-" json:"-"`
+		ESCountMaxGroups       dynamicconfig.IntPropertyFn                 `yaml:"-" json:"-"`
 		ValidSearchAttributes  dynamicconfig.MapPropertyFn                 `yaml:"-" json:"-"`
 		ESVisibilityListMaxQPS dynamicconfig.IntPropertyFnWithDomainFilter `yaml:"-" json:"-"`
 	}
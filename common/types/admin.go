@@ -298,6 +298,81 @@ type PersistenceInfo struct {
 	Features []*PersistenceFeature `json:"features,omitempty"`
 }
 
+// PromoteDomainRequest is an internal type (TBD...)
+type PromoteDomainRequest struct {
+	Domain   string                             `json:"domain,omitempty"`
+	Clusters []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PromoteDomainRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetClusters is an internal getter (TBD...)
+func (v *PromoteDomainRequest) GetClusters() (o []*ClusterReplicationConfiguration) {
+	if v != nil && v.Clusters != nil {
+		return v.Clusters
+	}
+	return
+}
+
+// RemoveSearchAttributeRequest is an internal type (TBD...)
+type RemoveSearchAttributeRequest struct {
+	SearchAttribute []string `json:"searchAttribute,omitempty"`
+	SecurityToken   string   `json:"securityToken,omitempty"`
+}
+
+// GetSearchAttribute is an internal getter (TBD...)
+func (v *RemoveSearchAttributeRequest) GetSearchAttribute() (o []string) {
+	if v != nil && v.SearchAttribute != nil {
+		return v.SearchAttribute
+	}
+	return
+}
+
+// GetSecurityToken is an internal getter (TBD...)
+func (v *RemoveSearchAttributeRequest) GetSecurityToken() (o string) {
+	if v != nil {
+		return v.SecurityToken
+	}
+	return
+}
+
+// RenameSearchAttributeRequest is an internal type (TBD...)
+type RenameSearchAttributeRequest struct {
+	OldName       string `json:"oldName,omitempty"`
+	NewName       string `json:"newName,omitempty"`
+	SecurityToken string `json:"securityToken,omitempty"`
+}
+
+// GetOldName is an internal getter (TBD...)
+func (v *RenameSearchAttributeRequest) GetOldName() (o string) {
+	if v != nil {
+		return v.OldName
+	}
+	return
+}
+
+// GetNewName is an internal getter (TBD...)
+func (v *RenameSearchAttributeRequest) GetNewName() (o string) {
+	if v != nil {
+		return v.NewName
+	}
+	return
+}
+
+// GetSecurityToken is an internal getter (TBD...)
+func (v *RenameSearchAttributeRequest) GetSecurityToken() (o string) {
+	if v != nil {
+		return v.SecurityToken
+	}
+	return
+}
+
 // ResendReplicationTasksRequest is an internal type (TBD...)
 type ResendReplicationTasksRequest struct {
 	DomainID      string `json:"domainID,omitempty"`
@@ -65,6 +65,8 @@ func EventTypeValues() []EventType {
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
 		EventTypeUpsertWorkflowSearchAttributes,
+		EventTypeWorkflowExecutionSuspended,
+		EventTypeWorkflowExecutionResumed,
 	}
 }
 
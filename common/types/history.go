@@ -143,6 +143,28 @@ func (v *FailoverMarkerToken) GetFailoverMarker() (o *FailoverMarkerAttributes)
 	return
 }
 
+// HistoryFailoverWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryFailoverWorkflowExecutionRequest struct {
+	DomainUUID string                            `json:"domainUUID,omitempty"`
+	Request    *FailoverWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryFailoverWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryFailoverWorkflowExecutionRequest) GetRequest() (o *FailoverWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
 // GetMutableStateRequest is an internal type (TBD...)
 type GetMutableStateRequest struct {
 	DomainUUID          string             `json:"domainUUID,omitempty"`
@@ -634,6 +656,19 @@ func (v *ProcessingQueueStates) GetStatesByCluster() (o map[string][]*Processing
 	return
 }
 
+// DomainUsages is an internal type (TBD...)
+type DomainUsages struct {
+	UsagesByDomainID map[string]*DomainUsage `json:"usagesByDomainID,omitempty"`
+}
+
+// GetUsagesByDomainID is an internal getter (TBD...)
+func (v *DomainUsages) GetUsagesByDomainID() (o map[string]*DomainUsage) {
+	if v != nil && v.UsagesByDomainID != nil {
+		return v.UsagesByDomainID
+	}
+	return
+}
+
 // HistoryQueryWorkflowRequest is an internal type (TBD...)
 type HistoryQueryWorkflowRequest struct {
 	DomainUUID string                `json:"domainUUID,omitempty"`
@@ -713,6 +748,19 @@ func (v *HistoryRecordActivityTaskHeartbeatRequest) GetHeartbeatRequest() (o *Re
 	return
 }
 
+// RebuildMutableStateResponse is an internal type (TBD...)
+type RebuildMutableStateResponse struct {
+	Mismatches []string `json:"mismatches,omitempty"`
+}
+
+// GetMismatches is an internal getter (TBD...)
+func (v *RebuildMutableStateResponse) GetMismatches() (o []string) {
+	if v != nil && v.Mismatches != nil {
+		return v.Mismatches
+	}
+	return
+}
+
 // RecordActivityTaskStartedRequest is an internal type (TBD...)
 type RecordActivityTaskStartedRequest struct {
 	DomainUUID        string                      `json:"domainUUID,omitempty"`
@@ -1177,6 +1225,125 @@ func (v *ReplicateEventsV2Request) GetNewRunEvents() (o *DataBlob) {
 	return
 }
 
+// HistoryRebuildMutableStateRequest is an internal type (TBD...)
+type HistoryRebuildMutableStateRequest struct {
+	DomainUUID string             `json:"domainUUID,omitempty"`
+	Execution  *WorkflowExecution `json:"execution,omitempty"`
+	DryRun     bool               `json:"dryRun,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryRebuildMutableStateRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *HistoryRebuildMutableStateRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetDryRun is an internal getter (TBD...)
+func (v *HistoryRebuildMutableStateRequest) GetDryRun() (o bool) {
+	if v != nil {
+		return v.DryRun
+	}
+	return
+}
+
+// HistoryReindexWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryReindexWorkflowExecutionRequest struct {
+	DomainUUID string             `json:"domainUUID,omitempty"`
+	Execution  *WorkflowExecution `json:"execution,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryReindexWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *HistoryReindexWorkflowExecutionRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// HistoryListWorkflowTasksRequest is an internal type (TBD...)
+type HistoryListWorkflowTasksRequest struct {
+	DomainUUID string                    `json:"domainUUID,omitempty"`
+	Request    *ListWorkflowTasksRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryListWorkflowTasksRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryListWorkflowTasksRequest) GetRequest() (o *ListWorkflowTasksRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// HistoryDeleteWorkflowTaskRequest is an internal type (TBD...)
+type HistoryDeleteWorkflowTaskRequest struct {
+	DomainUUID string                     `json:"domainUUID,omitempty"`
+	Request    *DeleteWorkflowTaskRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryDeleteWorkflowTaskRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryDeleteWorkflowTaskRequest) GetRequest() (o *DeleteWorkflowTaskRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// HistoryReenqueueWorkflowTaskRequest is an internal type (TBD...)
+type HistoryReenqueueWorkflowTaskRequest struct {
+	DomainUUID string                        `json:"domainUUID,omitempty"`
+	Request    *ReenqueueWorkflowTaskRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryReenqueueWorkflowTaskRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryReenqueueWorkflowTaskRequest) GetRequest() (o *ReenqueueWorkflowTaskRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
 // HistoryRequestCancelWorkflowExecutionRequest is an internal type (TBD...)
 type HistoryRequestCancelWorkflowExecutionRequest struct {
 	DomainUUID                string                                 `json:"domainUUID,omitempty"`
@@ -1274,6 +1441,28 @@ func (v *HistoryResetWorkflowExecutionRequest) GetResetRequest() (o *ResetWorkfl
 	return
 }
 
+// HistoryResumeWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryResumeWorkflowExecutionRequest struct {
+	DomainUUID    string                          `json:"domainUUID,omitempty"`
+	ResumeRequest *ResumeWorkflowExecutionRequest `json:"resumeRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryResumeWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetResumeRequest is an internal getter (TBD...)
+func (v *HistoryResumeWorkflowExecutionRequest) GetResumeRequest() (o *ResumeWorkflowExecutionRequest) {
+	if v != nil && v.ResumeRequest != nil {
+		return v.ResumeRequest
+	}
+	return
+}
+
 // HistoryRespondActivityTaskCanceledRequest is an internal type (TBD...)
 type HistoryRespondActivityTaskCanceledRequest struct {
 	DomainUUID    string                              `json:"domainUUID,omitempty"`
@@ -1785,6 +1974,28 @@ func (v *SyncShardStatusRequest) GetTimestamp() (o int64) {
 	return
 }
 
+// HistorySuspendWorkflowExecutionRequest is an internal type (TBD...)
+type HistorySuspendWorkflowExecutionRequest struct {
+	DomainUUID     string                           `json:"domainUUID,omitempty"`
+	SuspendRequest *SuspendWorkflowExecutionRequest `json:"suspendRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistorySuspendWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetSuspendRequest is an internal getter (TBD...)
+func (v *HistorySuspendWorkflowExecutionRequest) GetSuspendRequest() (o *SuspendWorkflowExecutionRequest) {
+	if v != nil && v.SuspendRequest != nil {
+		return v.SuspendRequest
+	}
+	return
+}
+
 // HistoryTerminateWorkflowExecutionRequest is an internal type (TBD...)
 type HistoryTerminateWorkflowExecutionRequest struct {
 	DomainUUID       string                             `json:"domainUUID,omitempty"`
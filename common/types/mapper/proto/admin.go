@@ -22,6 +22,7 @@ package proto
 
 import (
 	adminv1 "github.com/uber/cadence/.gen/proto/admin/v1"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -45,6 +46,48 @@ func ToAdminAddSearchAttributeRequest(t *adminv1.AddSearchAttributeRequest) *typ
 	}
 }
 
+func FromAdminRemoveSearchAttributeRequest(t *types.RemoveSearchAttributeRequest) *adminv1.RemoveSearchAttributeRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RemoveSearchAttributeRequest{
+		SearchAttribute: t.SearchAttribute,
+		SecurityToken:   t.SecurityToken,
+	}
+}
+
+func ToAdminRemoveSearchAttributeRequest(t *adminv1.RemoveSearchAttributeRequest) *types.RemoveSearchAttributeRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RemoveSearchAttributeRequest{
+		SearchAttribute: t.SearchAttribute,
+		SecurityToken:   t.SecurityToken,
+	}
+}
+
+func FromAdminRenameSearchAttributeRequest(t *types.RenameSearchAttributeRequest) *adminv1.RenameSearchAttributeRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RenameSearchAttributeRequest{
+		OldName:       t.OldName,
+		NewName:       t.NewName,
+		SecurityToken: t.SecurityToken,
+	}
+}
+
+func ToAdminRenameSearchAttributeRequest(t *adminv1.RenameSearchAttributeRequest) *types.RenameSearchAttributeRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RenameSearchAttributeRequest{
+		OldName:       t.OldName,
+		NewName:       t.NewName,
+		SecurityToken: t.SecurityToken,
+	}
+}
+
 func FromAdminCloseShardRequest(t *types.CloseShardRequest) *adminv1.CloseShardRequest {
 	if t == nil {
 		return nil
@@ -162,6 +205,7 @@ func FromAdminDescribeHistoryHostResponse(t *types.DescribeHistoryHostResponse)
 		DomainCache:           FromDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: t.ShardControllerStatus,
 		Address:               t.Address,
+		ShardLoads:            FromHistoryShardLoadArray(t.ShardLoads),
 	}
 }
 
@@ -185,6 +229,7 @@ func ToAdminDescribeHistoryHostResponse(t *adminv1.DescribeHistoryHostResponse)
 		DomainCache:           ToDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: t.ShardControllerStatus,
 		Address:               t.Address,
+		ShardLoads:            ToHistoryShardLoadArray(t.ShardLoads),
 	}
 }
 
@@ -386,6 +431,44 @@ func ToAdminGetReplicationMessagesResponse(t *adminv1.GetReplicationMessagesResp
 	}
 }
 
+func FromAdminStreamReplicationMessagesRequest(t *types.StreamReplicationMessagesRequest) *adminv1.StreamReplicationMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.StreamReplicationMessagesRequest{
+		Tokens:      FromReplicationTokenArray(t.Tokens),
+		ClusterName: t.ClusterName,
+	}
+}
+
+func ToAdminStreamReplicationMessagesRequest(t *adminv1.StreamReplicationMessagesRequest) *types.StreamReplicationMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.StreamReplicationMessagesRequest{
+		Tokens:      ToReplicationTokenArray(t.Tokens),
+		ClusterName: t.ClusterName,
+	}
+}
+
+func FromAdminStreamReplicationMessagesResponse(t *types.StreamReplicationMessagesResponse) *adminv1.StreamReplicationMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.StreamReplicationMessagesResponse{
+		ShardMessages: FromReplicationMessagesMap(t.MessagesByShard),
+	}
+}
+
+func ToAdminStreamReplicationMessagesResponse(t *adminv1.StreamReplicationMessagesResponse) *types.StreamReplicationMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.StreamReplicationMessagesResponse{
+		MessagesByShard: ToReplicationMessagesMap(t.ShardMessages),
+	}
+}
+
 func FromAdminGetWorkflowExecutionRawHistoryV2Request(t *types.GetWorkflowExecutionRawHistoryV2Request) *adminv1.GetWorkflowExecutionRawHistoryV2Request {
 	if t == nil {
 		return nil
@@ -602,6 +685,28 @@ func ToAdminRefreshWorkflowTasksRequest(t *adminv1.RefreshWorkflowTasksRequest)
 	}
 }
 
+func FromAdminFailoverWorkflowExecutionRequest(t *types.FailoverWorkflowExecutionRequest) *adminv1.FailoverWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.FailoverWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+		TargetCluster:     t.TargetCluster,
+	}
+}
+
+func ToAdminFailoverWorkflowExecutionRequest(t *adminv1.FailoverWorkflowExecutionRequest) *types.FailoverWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.FailoverWorkflowExecutionRequest{
+		Domain:        t.Domain,
+		Execution:     ToWorkflowExecution(t.WorkflowExecution),
+		TargetCluster: t.TargetCluster,
+	}
+}
+
 func FromAdminRemoveTaskRequest(t *types.RemoveTaskRequest) *adminv1.RemoveTaskRequest {
 	if t == nil {
 		return nil
@@ -628,6 +733,44 @@ func ToAdminRemoveTaskRequest(t *adminv1.RemoveTaskRequest) *types.RemoveTaskReq
 	}
 }
 
+func FromAdminPromoteDomainRequest(t *types.PromoteDomainRequest) *adminv1.PromoteDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.PromoteDomainRequest{
+		Domain:   t.Domain,
+		Clusters: FromClusterReplicationConfigurationArray(t.Clusters),
+	}
+}
+
+func ToAdminPromoteDomainRequest(t *adminv1.PromoteDomainRequest) *types.PromoteDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PromoteDomainRequest{
+		Domain:   t.Domain,
+		Clusters: ToClusterReplicationConfigurationArray(t.Clusters),
+	}
+}
+
+func FromAdminPromoteDomainResponse(t *types.UpdateDomainResponse) *adminv1.PromoteDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.PromoteDomainResponse{
+		Domain: FromUpdateDomainResponse(t).Domain,
+	}
+}
+
+func ToAdminPromoteDomainResponse(t *adminv1.PromoteDomainResponse) *types.UpdateDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return ToUpdateDomainResponse(&apiv1.UpdateDomainResponse{
+		Domain: t.Domain,
+	})
+}
+
 func FromAdminResendReplicationTasksRequest(t *types.ResendReplicationTasksRequest) *adminv1.ResendReplicationTasksRequest {
 	if t == nil {
 		return nil
@@ -680,6 +823,222 @@ func ToAdminResetQueueRequest(t *adminv1.ResetQueueRequest) *types.ResetQueueReq
 }
 
 // FromAdminGetCrossClusterTasksRequest converts internal GetCrossClusterTasksRequest type to proto
+func FromAdminResumeWorkflowExecutionRequest(t *types.ResumeWorkflowExecutionRequest) *adminv1.ResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ResumeWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func ToAdminResumeWorkflowExecutionRequest(t *adminv1.ResumeWorkflowExecutionRequest) *types.ResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func FromAdminListWorkflowTasksRequest(t *types.ListWorkflowTasksRequest) *adminv1.ListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListWorkflowTasksRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func ToAdminListWorkflowTasksRequest(t *adminv1.ListWorkflowTasksRequest) *types.ListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkflowTasksRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func FromAdminListWorkflowTasksResponse(t *types.ListWorkflowTasksResponse) *adminv1.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListWorkflowTasksResponse{
+		ShardId:   t.ShardID,
+		Tasks:     FromWorkflowTaskInfoArray(t.Tasks),
+		Truncated: t.Truncated,
+	}
+}
+
+func ToAdminListWorkflowTasksResponse(t *adminv1.ListWorkflowTasksResponse) *types.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkflowTasksResponse{
+		ShardID:   t.ShardId,
+		Tasks:     ToWorkflowTaskInfoArray(t.Tasks),
+		Truncated: t.Truncated,
+	}
+}
+
+func FromAdminDeleteWorkflowTaskRequest(t *types.DeleteWorkflowTaskRequest) *adminv1.DeleteWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DeleteWorkflowTaskRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		TaskType:          FromTaskType(t.Type),
+		TaskId:            t.TaskID,
+		VisibilityTime:    unixNanoToTime(t.VisibilityTimestamp),
+		ClusterName:       t.ClusterName,
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func ToAdminDeleteWorkflowTaskRequest(t *adminv1.DeleteWorkflowTaskRequest) *types.DeleteWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteWorkflowTaskRequest{
+		Domain:              t.Domain,
+		WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+		Type:                ToTaskType(t.TaskType),
+		TaskID:              t.TaskId,
+		VisibilityTimestamp: timeToUnixNano(t.VisibilityTime),
+		ClusterName:         t.ClusterName,
+		Reason:              t.Reason,
+		Identity:            t.Identity,
+	}
+}
+
+func FromAdminReenqueueWorkflowTaskRequest(t *types.ReenqueueWorkflowTaskRequest) *adminv1.ReenqueueWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ReenqueueWorkflowTaskRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		TaskType:          FromTaskType(t.Type),
+		TaskId:            t.TaskID,
+		VisibilityTime:    unixNanoToTime(t.VisibilityTimestamp),
+		ClusterName:       t.ClusterName,
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func ToAdminReenqueueWorkflowTaskRequest(t *adminv1.ReenqueueWorkflowTaskRequest) *types.ReenqueueWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReenqueueWorkflowTaskRequest{
+		Domain:              t.Domain,
+		WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+		Type:                ToTaskType(t.TaskType),
+		TaskID:              t.TaskId,
+		VisibilityTimestamp: timeToUnixNano(t.VisibilityTime),
+		ClusterName:         t.ClusterName,
+		Reason:              t.Reason,
+		Identity:            t.Identity,
+	}
+}
+
+func FromAdminDescribeDomainUsageRequest(t *types.DescribeDomainUsageRequest) *adminv1.DescribeDomainUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DescribeDomainUsageRequest{
+		Domain: t.Domain,
+	}
+}
+
+func ToAdminDescribeDomainUsageRequest(t *adminv1.DescribeDomainUsageRequest) *types.DescribeDomainUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeDomainUsageRequest{
+		Domain: t.Domain,
+	}
+}
+
+func FromAdminDescribeDomainUsageResponse(t *types.DescribeDomainUsageResponse) *adminv1.DescribeDomainUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DescribeDomainUsageResponse{
+		Usage:          FromDomainUsage(t.Usage),
+		LastUpdateTime: unixNanoToTime(t.LastUpdateTime),
+	}
+}
+
+func ToAdminDescribeDomainUsageResponse(t *adminv1.DescribeDomainUsageResponse) *types.DescribeDomainUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeDomainUsageResponse{
+		Usage:          ToDomainUsage(t.Usage),
+		LastUpdateTime: timeToUnixNano(t.LastUpdateTime),
+	}
+}
+
+func FromDomainUsage(t *types.DomainUsage) *adminv1.DomainUsage {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DomainUsage{
+		HistorySize:         t.HistorySize,
+		HistoryEventCount:   t.HistoryEventCount,
+		OpenWorkflowCount:   t.OpenWorkflowCount,
+		ClosedWorkflowCount: t.ClosedWorkflowCount,
+	}
+}
+
+func ToDomainUsage(t *adminv1.DomainUsage) *types.DomainUsage {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainUsage{
+		HistorySize:         t.HistorySize,
+		HistoryEventCount:   t.HistoryEventCount,
+		OpenWorkflowCount:   t.OpenWorkflowCount,
+		ClosedWorkflowCount: t.ClosedWorkflowCount,
+	}
+}
+
+func FromAdminSuspendWorkflowExecutionRequest(t *types.SuspendWorkflowExecutionRequest) *adminv1.SuspendWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.SuspendWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func ToAdminSuspendWorkflowExecutionRequest(t *adminv1.SuspendWorkflowExecutionRequest) *types.SuspendWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.SuspendWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
 func FromAdminGetCrossClusterTasksRequest(t *types.GetCrossClusterTasksRequest) *adminv1.GetCrossClusterTasksRequest {
 	if t == nil {
 		return nil
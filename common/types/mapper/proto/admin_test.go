@@ -35,6 +35,16 @@ func TestAdminAddSearchAttributeRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminAddSearchAttributeRequest(FromAdminAddSearchAttributeRequest(item)))
 	}
 }
+func TestAdminRemoveSearchAttributeRequest(t *testing.T) {
+	for _, item := range []*types.RemoveSearchAttributeRequest{nil, {}, &testdata.AdminRemoveSearchAttributeRequest} {
+		assert.Equal(t, item, ToAdminRemoveSearchAttributeRequest(FromAdminRemoveSearchAttributeRequest(item)))
+	}
+}
+func TestAdminRenameSearchAttributeRequest(t *testing.T) {
+	for _, item := range []*types.RenameSearchAttributeRequest{nil, {}, &testdata.AdminRenameSearchAttributeRequest} {
+		assert.Equal(t, item, ToAdminRenameSearchAttributeRequest(FromAdminRenameSearchAttributeRequest(item)))
+	}
+}
 func TestAdminCloseShardRequest(t *testing.T) {
 	for _, item := range []*types.CloseShardRequest{nil, {}, &testdata.AdminCloseShardRequest} {
 		assert.Equal(t, item, ToAdminCloseShardRequest(FromAdminCloseShardRequest(item)))
@@ -112,6 +122,16 @@ func TestAdminGetReplicationMessagesResponse(t *testing.T) {
 		assert.Equal(t, item, ToAdminGetReplicationMessagesResponse(FromAdminGetReplicationMessagesResponse(item)))
 	}
 }
+func TestAdminStreamReplicationMessagesRequest(t *testing.T) {
+	for _, item := range []*types.StreamReplicationMessagesRequest{nil, {}, &testdata.AdminStreamReplicationMessagesRequest} {
+		assert.Equal(t, item, ToAdminStreamReplicationMessagesRequest(FromAdminStreamReplicationMessagesRequest(item)))
+	}
+}
+func TestAdminStreamReplicationMessagesResponse(t *testing.T) {
+	for _, item := range []*types.StreamReplicationMessagesResponse{nil, {}, &testdata.AdminStreamReplicationMessagesResponse} {
+		assert.Equal(t, item, ToAdminStreamReplicationMessagesResponse(FromAdminStreamReplicationMessagesResponse(item)))
+	}
+}
 func TestAdminGetWorkflowExecutionRawHistoryV2Request(t *testing.T) {
 	for _, item := range []*types.GetWorkflowExecutionRawHistoryV2Request{nil, {}, &testdata.AdminGetWorkflowExecutionRawHistoryV2Request} {
 		assert.Equal(t, item, ToAdminGetWorkflowExecutionRawHistoryV2Request(FromAdminGetWorkflowExecutionRawHistoryV2Request(item)))
@@ -152,6 +172,51 @@ func TestAdminReapplyEventsRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminReapplyEventsRequest(FromAdminReapplyEventsRequest(item)))
 	}
 }
+func TestAdminSuspendWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.SuspendWorkflowExecutionRequest{nil, {}, &testdata.AdminSuspendWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToAdminSuspendWorkflowExecutionRequest(FromAdminSuspendWorkflowExecutionRequest(item)))
+	}
+}
+func TestAdminResumeWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.ResumeWorkflowExecutionRequest{nil, {}, &testdata.AdminResumeWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToAdminResumeWorkflowExecutionRequest(FromAdminResumeWorkflowExecutionRequest(item)))
+	}
+}
+func TestAdminListWorkflowTasksRequest(t *testing.T) {
+	for _, item := range []*types.ListWorkflowTasksRequest{nil, {}, &testdata.AdminListWorkflowTasksRequest} {
+		assert.Equal(t, item, ToAdminListWorkflowTasksRequest(FromAdminListWorkflowTasksRequest(item)))
+	}
+}
+func TestAdminListWorkflowTasksResponse(t *testing.T) {
+	for _, item := range []*types.ListWorkflowTasksResponse{nil, {}, &testdata.AdminListWorkflowTasksResponse} {
+		assert.Equal(t, item, ToAdminListWorkflowTasksResponse(FromAdminListWorkflowTasksResponse(item)))
+	}
+}
+func TestAdminDeleteWorkflowTaskRequest(t *testing.T) {
+	for _, item := range []*types.DeleteWorkflowTaskRequest{nil, {}, &testdata.AdminDeleteWorkflowTaskRequest} {
+		assert.Equal(t, item, ToAdminDeleteWorkflowTaskRequest(FromAdminDeleteWorkflowTaskRequest(item)))
+	}
+}
+func TestAdminReenqueueWorkflowTaskRequest(t *testing.T) {
+	for _, item := range []*types.ReenqueueWorkflowTaskRequest{nil, {}, &testdata.AdminReenqueueWorkflowTaskRequest} {
+		assert.Equal(t, item, ToAdminReenqueueWorkflowTaskRequest(FromAdminReenqueueWorkflowTaskRequest(item)))
+	}
+}
+func TestAdminDescribeDomainUsageRequest(t *testing.T) {
+	for _, item := range []*types.DescribeDomainUsageRequest{nil, {}, &testdata.AdminDescribeDomainUsageRequest} {
+		assert.Equal(t, item, ToAdminDescribeDomainUsageRequest(FromAdminDescribeDomainUsageRequest(item)))
+	}
+}
+func TestAdminDescribeDomainUsageResponse(t *testing.T) {
+	for _, item := range []*types.DescribeDomainUsageResponse{nil, {}, &testdata.AdminDescribeDomainUsageResponse} {
+		assert.Equal(t, item, ToAdminDescribeDomainUsageResponse(FromAdminDescribeDomainUsageResponse(item)))
+	}
+}
+func TestAdminFailoverWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.FailoverWorkflowExecutionRequest{nil, {}, &testdata.AdminFailoverWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToAdminFailoverWorkflowExecutionRequest(FromAdminFailoverWorkflowExecutionRequest(item)))
+	}
+}
 func TestAdminRefreshWorkflowTasksRequest(t *testing.T) {
 	for _, item := range []*types.RefreshWorkflowTasksRequest{nil, {}, &testdata.AdminRefreshWorkflowTasksRequest} {
 		assert.Equal(t, item, ToAdminRefreshWorkflowTasksRequest(FromAdminRefreshWorkflowTasksRequest(item)))
@@ -162,6 +227,16 @@ func TestAdminRemoveTaskRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminRemoveTaskRequest(FromAdminRemoveTaskRequest(item)))
 	}
 }
+func TestAdminPromoteDomainRequest(t *testing.T) {
+	for _, item := range []*types.PromoteDomainRequest{nil, {}, &testdata.AdminPromoteDomainRequest} {
+		assert.Equal(t, item, ToAdminPromoteDomainRequest(FromAdminPromoteDomainRequest(item)))
+	}
+}
+func TestAdminPromoteDomainResponse(t *testing.T) {
+	for _, item := range []*types.UpdateDomainResponse{nil, &testdata.AdminPromoteDomainResponse} {
+		assert.Equal(t, item, ToAdminPromoteDomainResponse(FromAdminPromoteDomainResponse(item)))
+	}
+}
 func TestAdminResendReplicationTasksRequest(t *testing.T) {
 	for _, item := range []*types.ResendReplicationTasksRequest{nil, {}, &testdata.AdminResendReplicationTasksRequest} {
 		assert.Equal(t, item, ToAdminResendReplicationTasksRequest(FromAdminResendReplicationTasksRequest(item)))
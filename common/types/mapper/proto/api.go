@@ -675,6 +675,7 @@ func FromContinueAsNewWorkflowExecutionDecisionAttributes(t *types.ContinueAsNew
 		Header:                       FromHeader(t.Header),
 		Memo:                         FromMemo(t.Memo),
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
+		RetentionPeriod:              daysToDuration(t.RetentionPeriodInDays),
 	}
 }
 
@@ -698,6 +699,7 @@ func ToContinueAsNewWorkflowExecutionDecisionAttributes(t *apiv1.ContinueAsNewWo
 		Header:                              ToHeader(t.Header),
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+		RetentionPeriodInDays:               durationToDays(t.RetentionPeriod),
 	}
 }
 
@@ -726,7 +728,8 @@ func FromCountWorkflowExecutionsResponse(t *types.CountWorkflowExecutionsRespons
 		return nil
 	}
 	return &apiv1.CountWorkflowExecutionsResponse{
-		Count: t.Count,
+		Count:  t.Count,
+		Groups: FromCountWorkflowExecutionsGroupArray(t.Groups),
 	}
 }
 
@@ -735,10 +738,53 @@ func ToCountWorkflowExecutionsResponse(t *apiv1.CountWorkflowExecutionsResponse)
 		return nil
 	}
 	return &types.CountWorkflowExecutionsResponse{
-		Count: t.Count,
+		Count:  t.Count,
+		Groups: ToCountWorkflowExecutionsGroupArray(t.Groups),
 	}
 }
 
+func FromCountWorkflowExecutionsGroup(t *types.CountWorkflowExecutionsGroup) *apiv1.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CountWorkflowExecutionsGroup{
+		GroupValues: t.GroupValues,
+		Count:       t.Count,
+	}
+}
+
+func ToCountWorkflowExecutionsGroup(t *apiv1.CountWorkflowExecutionsGroup) *types.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	return &types.CountWorkflowExecutionsGroup{
+		GroupValues: t.GroupValues,
+		Count:       t.Count,
+	}
+}
+
+func FromCountWorkflowExecutionsGroupArray(t []*types.CountWorkflowExecutionsGroup) []*apiv1.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.CountWorkflowExecutionsGroup, len(t))
+	for i := range t {
+		v[i] = FromCountWorkflowExecutionsGroup(t[i])
+	}
+	return v
+}
+
+func ToCountWorkflowExecutionsGroupArray(t []*apiv1.CountWorkflowExecutionsGroup) []*types.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CountWorkflowExecutionsGroup, len(t))
+	for i := range t {
+		v[i] = ToCountWorkflowExecutionsGroup(t[i])
+	}
+	return v
+}
+
 func FromDataBlob(t *types.DataBlob) *apiv1.DataBlob {
 	if t == nil {
 		return nil
@@ -1962,6 +2008,28 @@ func ToPendingActivityInfo(t *apiv1.PendingActivityInfo) *types.PendingActivityI
 	}
 }
 
+func FromPendingTimerInfo(t *types.PendingTimerInfo) *apiv1.PendingTimerInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PendingTimerInfo{
+		TimerId:        t.TimerID,
+		StartedEventId: t.StartedEventID,
+		ExpiryTime:     unixNanoToTime(t.ExpiryTimestamp),
+	}
+}
+
+func ToPendingTimerInfo(t *apiv1.PendingTimerInfo) *types.PendingTimerInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.PendingTimerInfo{
+		TimerID:         t.TimerId,
+		StartedEventID:  t.StartedEventId,
+		ExpiryTimestamp: timeToUnixNano(t.ExpiryTime),
+	}
+}
+
 func FromPendingActivityState(t *types.PendingActivityState) apiv1.PendingActivityState {
 	if t == nil {
 		return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_INVALID
@@ -2740,6 +2808,7 @@ func FromResetWorkflowExecutionRequest(t *types.ResetWorkflowExecutionRequest) *
 		DecisionFinishEventId: t.DecisionFinishEventID,
 		RequestId:             t.RequestID,
 		SkipSignalReapply:     t.SkipSignalReapply,
+		DryRun:                t.DryRun,
 	}
 }
 
@@ -2754,6 +2823,7 @@ func ToResetWorkflowExecutionRequest(t *apiv1.ResetWorkflowExecutionRequest) *ty
 		DecisionFinishEventID: t.DecisionFinishEventId,
 		RequestID:             t.RequestId,
 		SkipSignalReapply:     t.SkipSignalReapply,
+		DryRun:                t.DryRun,
 	}
 }
 
@@ -2762,7 +2832,8 @@ func FromResetWorkflowExecutionResponse(t *types.ResetWorkflowExecutionResponse)
 		return nil
 	}
 	return &apiv1.ResetWorkflowExecutionResponse{
-		RunId: t.RunID,
+		RunId:   t.RunID,
+		Preview: FromResetWorkflowExecutionPreview(t.Preview),
 	}
 }
 
@@ -2771,7 +2842,36 @@ func ToResetWorkflowExecutionResponse(t *apiv1.ResetWorkflowExecutionResponse) *
 		return nil
 	}
 	return &types.ResetWorkflowExecutionResponse{
-		RunID: t.RunId,
+		RunID:   t.RunId,
+		Preview: ToResetWorkflowExecutionPreview(t.Preview),
+	}
+}
+
+func FromResetWorkflowExecutionPreview(t *types.ResetWorkflowExecutionPreview) *apiv1.ResetWorkflowExecutionPreview {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetWorkflowExecutionPreview{
+		ResetPointEvent:        FromHistoryEvent(t.ResetPointEvent),
+		DiscardedEvents:        FromHistoryEventArray(t.DiscardedEvents),
+		ReappliedSignals:       FromHistoryEventArray(t.ReappliedSignals),
+		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
+		PendingTimers:          FromPendingTimerInfoArray(t.PendingTimers),
+		OrphanedChildWorkflows: FromWorkflowExecutionArray(t.OrphanedChildWorkflows),
+	}
+}
+
+func ToResetWorkflowExecutionPreview(t *apiv1.ResetWorkflowExecutionPreview) *types.ResetWorkflowExecutionPreview {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetWorkflowExecutionPreview{
+		ResetPointEvent:        ToHistoryEvent(t.ResetPointEvent),
+		DiscardedEvents:        ToHistoryEventArray(t.DiscardedEvents),
+		ReappliedSignals:       ToHistoryEventArray(t.ReappliedSignals),
+		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
+		PendingTimers:          ToPendingTimerInfoArray(t.PendingTimers),
+		OrphanedChildWorkflows: ToWorkflowExecutionArray(t.OrphanedChildWorkflows),
 	}
 }
 
@@ -3316,6 +3416,7 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 			SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 			Header:                       FromHeader(t.Header),
 			DelayStart:                   secondsToDuration(t.DelayStartSeconds),
+			RetentionPeriod:              daysToDuration(t.RetentionPeriodInDays),
 		},
 		SignalName:  t.SignalName,
 		SignalInput: FromPayload(t.SignalInput),
@@ -3347,6 +3448,7 @@ func ToSignalWithStartWorkflowExecutionRequest(t *apiv1.SignalWithStartWorkflowE
 		SearchAttributes:                    ToSearchAttributes(t.StartRequest.SearchAttributes),
 		Header:                              ToHeader(t.StartRequest.Header),
 		DelayStartSeconds:                   durationToSeconds(t.StartRequest.DelayStart),
+		RetentionPeriodInDays:               durationToDays(t.StartRequest.RetentionPeriod),
 	}
 }
 
@@ -3585,6 +3687,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 		Header:                       FromHeader(t.Header),
 		DelayStart:                   secondsToDuration(t.DelayStartSeconds),
+		RetentionPeriod:              daysToDuration(t.RetentionPeriodInDays),
 	}
 }
 
@@ -3609,6 +3712,7 @@ func ToStartWorkflowExecutionRequest(t *apiv1.StartWorkflowExecutionRequest) *ty
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   durationToSeconds(t.DelayStart),
+		RetentionPeriodInDays:               durationToDays(t.RetentionPeriod),
 	}
 }
 
@@ -3673,6 +3777,7 @@ func FromSupportedClientVersions(t *types.SupportedClientVersions) *apiv1.Suppor
 	return &apiv1.SupportedClientVersions{
 		GoSdk:   t.GoSdk,
 		JavaSdk: t.JavaSdk,
+		Cli:     t.Cli,
 	}
 }
 
@@ -3683,6 +3788,7 @@ func ToSupportedClientVersions(t *apiv1.SupportedClientVersions) *types.Supporte
 	return &types.SupportedClientVersions{
 		GoSdk:   t.GoSdk,
 		JavaSdk: t.JavaSdk,
+		Cli:     t.Cli,
 	}
 }
 
@@ -4430,6 +4536,7 @@ func FromWorkflowExecutionConfiguration(t *types.WorkflowExecutionConfiguration)
 		TaskList:                     FromTaskList(t.TaskList),
 		ExecutionStartToCloseTimeout: secondsToDuration(t.ExecutionStartToCloseTimeoutSeconds),
 		TaskStartToCloseTimeout:      secondsToDuration(t.TaskStartToCloseTimeoutSeconds),
+		RetentionPeriod:              daysToDuration(t.RetentionPeriodInDays),
 	}
 }
 
@@ -4441,6 +4548,7 @@ func ToWorkflowExecutionConfiguration(t *apiv1.WorkflowExecutionConfiguration) *
 		TaskList:                            ToTaskList(t.TaskList),
 		ExecutionStartToCloseTimeoutSeconds: durationToSeconds(t.ExecutionStartToCloseTimeout),
 		TaskStartToCloseTimeoutSeconds:      durationToSeconds(t.TaskStartToCloseTimeout),
+		RetentionPeriodInDays:               durationToDays(t.RetentionPeriod),
 	}
 }
 
@@ -4643,6 +4751,26 @@ func ToWorkflowExecutionInfo(t *apiv1.WorkflowExecutionInfo) *types.WorkflowExec
 	}
 }
 
+func FromWorkflowExecutionResumedEventAttributes(t *types.WorkflowExecutionResumedEventAttributes) *apiv1.WorkflowExecutionResumedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionResumedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
+func ToWorkflowExecutionResumedEventAttributes(t *apiv1.WorkflowExecutionResumedEventAttributes) *types.WorkflowExecutionResumedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionResumedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
 func FromWorkflowExecutionSignaledEventAttributes(t *types.WorkflowExecutionSignaledEventAttributes) *apiv1.WorkflowExecutionSignaledEventAttributes {
 	if t == nil {
 		return nil
@@ -4692,6 +4820,7 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:          FromResetPoints(t.PrevAutoResetPoints),
 		Header:                       FromHeader(t.Header),
+		RetentionPeriod:              daysToDuration(t.RetentionPeriodInDays),
 	}
 }
 
@@ -4726,6 +4855,27 @@ func ToWorkflowExecutionStartedEventAttributes(t *apiv1.WorkflowExecutionStarted
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		RetentionPeriodInDays:               durationToDays(t.RetentionPeriod),
+	}
+}
+
+func FromWorkflowExecutionSuspendedEventAttributes(t *types.WorkflowExecutionSuspendedEventAttributes) *apiv1.WorkflowExecutionSuspendedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionSuspendedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
+func ToWorkflowExecutionSuspendedEventAttributes(t *apiv1.WorkflowExecutionSuspendedEventAttributes) *types.WorkflowExecutionSuspendedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionSuspendedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
 	}
 }
 
@@ -4902,6 +5052,50 @@ func ToDataBlobArray(t []*apiv1.DataBlob) []*types.DataBlob {
 	return v
 }
 
+func FromPendingTimerInfoArray(t []*types.PendingTimerInfo) []*apiv1.PendingTimerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.PendingTimerInfo, len(t))
+	for i := range t {
+		v[i] = FromPendingTimerInfo(t[i])
+	}
+	return v
+}
+
+func ToPendingTimerInfoArray(t []*apiv1.PendingTimerInfo) []*types.PendingTimerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.PendingTimerInfo, len(t))
+	for i := range t {
+		v[i] = ToPendingTimerInfo(t[i])
+	}
+	return v
+}
+
+func FromWorkflowExecutionArray(t []*types.WorkflowExecution) []*apiv1.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecution(t[i])
+	}
+	return v
+}
+
+func ToWorkflowExecutionArray(t []*apiv1.WorkflowExecution) []*types.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecution(t[i])
+	}
+	return v
+}
+
 func FromHistoryEventArray(t []*types.HistoryEvent) []*apiv1.HistoryEvent {
 	if t == nil {
 		return nil
@@ -5480,6 +5674,14 @@ func FromHistoryEvent(e *types.HistoryEvent) *apiv1.HistoryEvent {
 		event.Attributes = &apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
 			UpsertWorkflowSearchAttributesEventAttributes: FromUpsertWorkflowSearchAttributesEventAttributes(e.UpsertWorkflowSearchAttributesEventAttributes),
 		}
+	case types.EventTypeWorkflowExecutionSuspended:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionSuspendedEventAttributes{
+			WorkflowExecutionSuspendedEventAttributes: FromWorkflowExecutionSuspendedEventAttributes(e.WorkflowExecutionSuspendedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionResumed:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionResumedEventAttributes{
+			WorkflowExecutionResumedEventAttributes: FromWorkflowExecutionResumedEventAttributes(e.WorkflowExecutionResumedEventAttributes),
+		}
 	}
 	return &event
 }
@@ -5621,6 +5823,12 @@ func ToHistoryEvent(e *apiv1.HistoryEvent) *types.HistoryEvent {
 	case *apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes:
 		event.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		event.UpsertWorkflowSearchAttributesEventAttributes = ToUpsertWorkflowSearchAttributesEventAttributes(attr.UpsertWorkflowSearchAttributesEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionSuspendedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionSuspended.Ptr()
+		event.WorkflowExecutionSuspendedEventAttributes = ToWorkflowExecutionSuspendedEventAttributes(attr.WorkflowExecutionSuspendedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionResumedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionResumed.Ptr()
+		event.WorkflowExecutionResumedEventAttributes = ToWorkflowExecutionResumedEventAttributes(attr.WorkflowExecutionResumedEventAttributes)
 	}
 	return &event
 }
@@ -345,6 +345,11 @@ func TestMemo(t *testing.T) {
 		assert.Equal(t, item, ToMemo(FromMemo(item)))
 	}
 }
+func TestPendingTimerInfo(t *testing.T) {
+	for _, item := range []*types.PendingTimerInfo{nil, {}, &testdata.PendingTimerInfo} {
+		assert.Equal(t, item, ToPendingTimerInfo(FromPendingTimerInfo(item)))
+	}
+}
 func TestPendingActivityInfo(t *testing.T) {
 	for _, item := range []*types.PendingActivityInfo{nil, {}, &testdata.PendingActivityInfo} {
 		assert.Equal(t, item, ToPendingActivityInfo(FromPendingActivityInfo(item)))
@@ -480,6 +485,11 @@ func TestResetWorkflowExecutionRequest(t *testing.T) {
 		assert.Equal(t, item, ToResetWorkflowExecutionRequest(FromResetWorkflowExecutionRequest(item)))
 	}
 }
+func TestResetWorkflowExecutionPreview(t *testing.T) {
+	for _, item := range []*types.ResetWorkflowExecutionPreview{nil, {}, &testdata.ResetWorkflowExecutionPreview} {
+		assert.Equal(t, item, ToResetWorkflowExecutionPreview(FromResetWorkflowExecutionPreview(item)))
+	}
+}
 func TestResetWorkflowExecutionResponse(t *testing.T) {
 	for _, item := range []*types.ResetWorkflowExecutionResponse{nil, {}, &testdata.ResetWorkflowExecutionResponse} {
 		assert.Equal(t, item, ToResetWorkflowExecutionResponse(FromResetWorkflowExecutionResponse(item)))
@@ -799,6 +809,16 @@ func TestWorkflowExecutionStartedEventAttributes(t *testing.T) {
 		assert.Equal(t, item, ToWorkflowExecutionStartedEventAttributes(FromWorkflowExecutionStartedEventAttributes(item)))
 	}
 }
+func TestWorkflowExecutionSuspendedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionSuspendedEventAttributes{nil, {}, &testdata.WorkflowExecutionSuspendedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionSuspendedEventAttributes(FromWorkflowExecutionSuspendedEventAttributes(item)))
+	}
+}
+func TestWorkflowExecutionResumedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionResumedEventAttributes{nil, {}, &testdata.WorkflowExecutionResumedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionResumedEventAttributes(FromWorkflowExecutionResumedEventAttributes(item)))
+	}
+}
 func TestWorkflowExecutionTerminatedEventAttributes(t *testing.T) {
 	for _, item := range []*types.WorkflowExecutionTerminatedEventAttributes{nil, {}, &testdata.WorkflowExecutionTerminatedEventAttributes} {
 		assert.Equal(t, item, ToWorkflowExecutionTerminatedEventAttributes(FromWorkflowExecutionTerminatedEventAttributes(item)))
@@ -115,6 +115,24 @@ func durationToSeconds(d *gogo.Duration) *int32 {
 	return common.Int32Ptr(int32(common.DurationToSeconds(duration)))
 }
 
+func millisToDuration(d *int64) *gogo.Duration {
+	if d == nil {
+		return nil
+	}
+	return gogo.DurationProto(time.Duration(*d) * time.Millisecond)
+}
+
+func durationToMillis(d *gogo.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+	duration, err := gogo.DurationFromProto(d)
+	if err != nil {
+		panic(err)
+	}
+	return common.Int64Ptr(duration.Milliseconds())
+}
+
 func int32To64(v *int32) *int64 {
 	if v == nil {
 		return nil
@@ -71,6 +71,7 @@ func FromHistoryDescribeHistoryHostResponse(t *types.DescribeHistoryHostResponse
 		DomainCache:           FromDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: t.ShardControllerStatus,
 		Address:               t.Address,
+		ShardLoads:            FromHistoryShardLoadArray(t.ShardLoads),
 	}
 }
 
@@ -84,6 +85,7 @@ func ToHistoryDescribeHistoryHostResponse(t *historyv1.DescribeHistoryHostRespon
 		DomainCache:           ToDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: t.ShardControllerStatus,
 		Address:               t.Address,
+		ShardLoads:            ToHistoryShardLoadArray(t.ShardLoads),
 	}
 }
 
@@ -371,8 +373,9 @@ func FromHistoryGetReplicationMessagesRequest(t *types.GetReplicationMessagesReq
 		return nil
 	}
 	return &historyv1.GetReplicationMessagesRequest{
-		Tokens:      FromReplicationTokenArray(t.Tokens),
-		ClusterName: t.ClusterName,
+		Tokens:          FromReplicationTokenArray(t.Tokens),
+		ClusterName:     t.ClusterName,
+		WaitForNewTasks: t.WaitForNewTasks,
 	}
 }
 
@@ -381,8 +384,9 @@ func ToHistoryGetReplicationMessagesRequest(t *historyv1.GetReplicationMessagesR
 		return nil
 	}
 	return &types.GetReplicationMessagesRequest{
-		Tokens:      ToReplicationTokenArray(t.Tokens),
-		ClusterName: t.ClusterName,
+		Tokens:          ToReplicationTokenArray(t.Tokens),
+		ClusterName:     t.ClusterName,
+		WaitForNewTasks: t.WaitForNewTasks,
 	}
 }
 
@@ -906,6 +910,32 @@ func ToHistoryRefreshWorkflowTasksRequest(t *historyv1.RefreshWorkflowTasksReque
 	}
 }
 
+func FromHistoryFailoverWorkflowExecutionRequest(t *types.HistoryFailoverWorkflowExecutionRequest) *historyv1.FailoverWorkflowExecutionRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.FailoverWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.Execution),
+		TargetCluster:     t.Request.TargetCluster,
+	}
+}
+
+func ToHistoryFailoverWorkflowExecutionRequest(t *historyv1.FailoverWorkflowExecutionRequest) *types.HistoryFailoverWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryFailoverWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.FailoverWorkflowExecutionRequest{
+			Domain:        t.Domain,
+			Execution:     ToWorkflowExecution(t.WorkflowExecution),
+			TargetCluster: t.TargetCluster,
+		},
+	}
+}
+
 func FromHistoryRemoveSignalMutableStateRequest(t *types.RemoveSignalMutableStateRequest) *historyv1.RemoveSignalMutableStateRequest {
 	if t == nil {
 		return nil
@@ -1075,7 +1105,8 @@ func FromHistoryResetWorkflowExecutionResponse(t *types.ResetWorkflowExecutionRe
 		return nil
 	}
 	return &historyv1.ResetWorkflowExecutionResponse{
-		RunId: t.RunID,
+		RunId:   t.RunID,
+		Preview: FromResetWorkflowExecutionPreview(t.Preview),
 	}
 }
 
@@ -1084,7 +1115,8 @@ func ToHistoryResetWorkflowExecutionResponse(t *historyv1.ResetWorkflowExecution
 		return nil
 	}
 	return &types.ResetWorkflowExecutionResponse{
-		RunID: t.RunId,
+		RunID:   t.RunId,
+		Preview: ToResetWorkflowExecutionPreview(t.Preview),
 	}
 }
 
@@ -1208,6 +1240,34 @@ func ToHistoryRespondDecisionTaskFailedRequest(t *historyv1.RespondDecisionTaskF
 	}
 }
 
+func FromHistoryResumeWorkflowExecutionRequest(t *types.HistoryResumeWorkflowExecutionRequest) *historyv1.ResumeWorkflowExecutionRequest {
+	if t == nil || t.ResumeRequest == nil {
+		return nil
+	}
+	return &historyv1.ResumeWorkflowExecutionRequest{
+		Domain:            t.ResumeRequest.Domain,
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.ResumeRequest.WorkflowExecution),
+		Reason:            t.ResumeRequest.Reason,
+		Identity:          t.ResumeRequest.Identity,
+	}
+}
+
+func ToHistoryResumeWorkflowExecutionRequest(t *historyv1.ResumeWorkflowExecutionRequest) *types.HistoryResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResumeWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		ResumeRequest: &types.ResumeWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+		},
+	}
+}
+
 func FromHistoryScheduleDecisionTaskRequest(t *types.ScheduleDecisionTaskRequest) *historyv1.ScheduleDecisionTaskRequest {
 	if t == nil {
 		return nil
@@ -1411,6 +1471,212 @@ func ToHistorySyncShardStatusRequest(t *historyv1.SyncShardStatusRequest) *types
 	}
 }
 
+func FromHistoryReindexWorkflowExecutionRequest(t *types.HistoryReindexWorkflowExecutionRequest) *historyv1.ReindexWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReindexWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+func ToHistoryReindexWorkflowExecutionRequest(t *historyv1.ReindexWorkflowExecutionRequest) *types.HistoryReindexWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryReindexWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		Execution:  ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func FromHistoryRebuildMutableStateRequest(t *types.HistoryRebuildMutableStateRequest) *historyv1.RebuildMutableStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.RebuildMutableStateRequest{
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+		DryRun:            t.DryRun,
+	}
+}
+
+func ToHistoryRebuildMutableStateRequest(t *historyv1.RebuildMutableStateRequest) *types.HistoryRebuildMutableStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRebuildMutableStateRequest{
+		DomainUUID: t.DomainId,
+		Execution:  ToWorkflowExecution(t.WorkflowExecution),
+		DryRun:     t.DryRun,
+	}
+}
+
+func FromHistoryRebuildMutableStateResponse(t *types.RebuildMutableStateResponse) *historyv1.RebuildMutableStateResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.RebuildMutableStateResponse{
+		Mismatches: t.Mismatches,
+	}
+}
+
+func ToHistoryRebuildMutableStateResponse(t *historyv1.RebuildMutableStateResponse) *types.RebuildMutableStateResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RebuildMutableStateResponse{
+		Mismatches: t.Mismatches,
+	}
+}
+
+func FromHistoryListWorkflowTasksRequest(t *types.HistoryListWorkflowTasksRequest) *historyv1.ListWorkflowTasksRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.ListWorkflowTasksRequest{
+		Domain:            t.Request.Domain,
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+	}
+}
+
+func ToHistoryListWorkflowTasksRequest(t *historyv1.ListWorkflowTasksRequest) *types.HistoryListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryListWorkflowTasksRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.ListWorkflowTasksRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		},
+	}
+}
+
+func FromHistoryListWorkflowTasksResponse(t *types.ListWorkflowTasksResponse) *historyv1.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ListWorkflowTasksResponse{
+		ShardId:   t.ShardID,
+		Tasks:     FromWorkflowTaskInfoArray(t.Tasks),
+		Truncated: t.Truncated,
+	}
+}
+
+func ToHistoryListWorkflowTasksResponse(t *historyv1.ListWorkflowTasksResponse) *types.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkflowTasksResponse{
+		ShardID:   t.ShardId,
+		Tasks:     ToWorkflowTaskInfoArray(t.Tasks),
+		Truncated: t.Truncated,
+	}
+}
+
+func FromHistoryDeleteWorkflowTaskRequest(t *types.HistoryDeleteWorkflowTaskRequest) *historyv1.DeleteWorkflowTaskRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.DeleteWorkflowTaskRequest{
+		Domain:            t.Request.Domain,
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		TaskType:          FromTaskType(t.Request.Type),
+		TaskId:            t.Request.TaskID,
+		VisibilityTime:    unixNanoToTime(t.Request.VisibilityTimestamp),
+		ClusterName:       t.Request.ClusterName,
+		Reason:            t.Request.Reason,
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryDeleteWorkflowTaskRequest(t *historyv1.DeleteWorkflowTaskRequest) *types.HistoryDeleteWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryDeleteWorkflowTaskRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.DeleteWorkflowTaskRequest{
+			Domain:              t.Domain,
+			WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+			Type:                ToTaskType(t.TaskType),
+			TaskID:              t.TaskId,
+			VisibilityTimestamp: timeToUnixNano(t.VisibilityTime),
+			ClusterName:         t.ClusterName,
+			Reason:              t.Reason,
+			Identity:            t.Identity,
+		},
+	}
+}
+
+func FromHistoryReenqueueWorkflowTaskRequest(t *types.HistoryReenqueueWorkflowTaskRequest) *historyv1.ReenqueueWorkflowTaskRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.ReenqueueWorkflowTaskRequest{
+		Domain:            t.Request.Domain,
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		TaskType:          FromTaskType(t.Request.Type),
+		TaskId:            t.Request.TaskID,
+		VisibilityTime:    unixNanoToTime(t.Request.VisibilityTimestamp),
+		ClusterName:       t.Request.ClusterName,
+		Reason:            t.Request.Reason,
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryReenqueueWorkflowTaskRequest(t *historyv1.ReenqueueWorkflowTaskRequest) *types.HistoryReenqueueWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryReenqueueWorkflowTaskRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.ReenqueueWorkflowTaskRequest{
+			Domain:              t.Domain,
+			WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+			Type:                ToTaskType(t.TaskType),
+			TaskID:              t.TaskId,
+			VisibilityTimestamp: timeToUnixNano(t.VisibilityTime),
+			ClusterName:         t.ClusterName,
+			Reason:              t.Reason,
+			Identity:            t.Identity,
+		},
+	}
+}
+
+func FromHistorySuspendWorkflowExecutionRequest(t *types.HistorySuspendWorkflowExecutionRequest) *historyv1.SuspendWorkflowExecutionRequest {
+	if t == nil || t.SuspendRequest == nil {
+		return nil
+	}
+	return &historyv1.SuspendWorkflowExecutionRequest{
+		Domain:            t.SuspendRequest.Domain,
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.SuspendRequest.WorkflowExecution),
+		Reason:            t.SuspendRequest.Reason,
+		Identity:          t.SuspendRequest.Identity,
+	}
+}
+
+func ToHistorySuspendWorkflowExecutionRequest(t *historyv1.SuspendWorkflowExecutionRequest) *types.HistorySuspendWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistorySuspendWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		SuspendRequest: &types.SuspendWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+		},
+	}
+}
+
 func FromHistoryTerminateWorkflowExecutionRequest(t *types.HistoryTerminateWorkflowExecutionRequest) *historyv1.TerminateWorkflowExecutionRequest {
 	if t == nil {
 		return nil
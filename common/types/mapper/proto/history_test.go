@@ -196,6 +196,65 @@ func TestHistoryRecordDecisionTaskStartedResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryRecordDecisionTaskStartedResponse(FromHistoryRecordDecisionTaskStartedResponse(item)))
 	}
 }
+func TestHistorySuspendWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistorySuspendWorkflowExecutionRequest{nil, &testdata.HistorySuspendWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistorySuspendWorkflowExecutionRequest(FromHistorySuspendWorkflowExecutionRequest(item)))
+	}
+	assert.Nil(t, FromHistorySuspendWorkflowExecutionRequest(&types.HistorySuspendWorkflowExecutionRequest{}))
+}
+func TestHistoryResumeWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryResumeWorkflowExecutionRequest{nil, &testdata.HistoryResumeWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryResumeWorkflowExecutionRequest(FromHistoryResumeWorkflowExecutionRequest(item)))
+	}
+	assert.Nil(t, FromHistoryResumeWorkflowExecutionRequest(&types.HistoryResumeWorkflowExecutionRequest{}))
+}
+func TestHistoryListWorkflowTasksRequest(t *testing.T) {
+	for _, item := range []*types.HistoryListWorkflowTasksRequest{nil, &testdata.HistoryListWorkflowTasksRequest} {
+		assert.Equal(t, item, ToHistoryListWorkflowTasksRequest(FromHistoryListWorkflowTasksRequest(item)))
+	}
+	assert.Nil(t, FromHistoryListWorkflowTasksRequest(&types.HistoryListWorkflowTasksRequest{}))
+}
+func TestHistoryDeleteWorkflowTaskRequest(t *testing.T) {
+	for _, item := range []*types.HistoryDeleteWorkflowTaskRequest{nil, &testdata.HistoryDeleteWorkflowTaskRequest} {
+		assert.Equal(t, item, ToHistoryDeleteWorkflowTaskRequest(FromHistoryDeleteWorkflowTaskRequest(item)))
+	}
+	assert.Nil(t, FromHistoryDeleteWorkflowTaskRequest(&types.HistoryDeleteWorkflowTaskRequest{}))
+}
+func TestHistoryReenqueueWorkflowTaskRequest(t *testing.T) {
+	for _, item := range []*types.HistoryReenqueueWorkflowTaskRequest{nil, &testdata.HistoryReenqueueWorkflowTaskRequest} {
+		assert.Equal(t, item, ToHistoryReenqueueWorkflowTaskRequest(FromHistoryReenqueueWorkflowTaskRequest(item)))
+	}
+	assert.Nil(t, FromHistoryReenqueueWorkflowTaskRequest(&types.HistoryReenqueueWorkflowTaskRequest{}))
+}
+func TestHistoryListWorkflowTasksResponse(t *testing.T) {
+	for _, item := range []*types.ListWorkflowTasksResponse{nil, {}, &testdata.AdminListWorkflowTasksResponse} {
+		assert.Equal(t, item, ToHistoryListWorkflowTasksResponse(FromHistoryListWorkflowTasksResponse(item)))
+	}
+}
+func TestHistoryRebuildMutableStateRequest(t *testing.T) {
+	for _, item := range []*types.HistoryRebuildMutableStateRequest{nil, {}, &testdata.HistoryRebuildMutableStateRequest} {
+		assert.Equal(t, item, ToHistoryRebuildMutableStateRequest(FromHistoryRebuildMutableStateRequest(item)))
+	}
+}
+
+func TestHistoryRebuildMutableStateResponse(t *testing.T) {
+	for _, item := range []*types.RebuildMutableStateResponse{nil, {}, &testdata.HistoryRebuildMutableStateResponse} {
+		assert.Equal(t, item, ToHistoryRebuildMutableStateResponse(FromHistoryRebuildMutableStateResponse(item)))
+	}
+}
+
+func TestHistoryReindexWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryReindexWorkflowExecutionRequest{nil, {}, &testdata.HistoryReindexWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryReindexWorkflowExecutionRequest(FromHistoryReindexWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryFailoverWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryFailoverWorkflowExecutionRequest{nil, &testdata.HistoryFailoverWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryFailoverWorkflowExecutionRequest(FromHistoryFailoverWorkflowExecutionRequest(item)))
+	}
+	assert.Nil(t, FromHistoryFailoverWorkflowExecutionRequest(&types.HistoryFailoverWorkflowExecutionRequest{}))
+}
+
 func TestHistoryRefreshWorkflowTasksRequest(t *testing.T) {
 	for _, item := range []*types.HistoryRefreshWorkflowTasksRequest{nil, &testdata.HistoryRefreshWorkflowTasksRequest} {
 		assert.Equal(t, item, ToHistoryRefreshWorkflowTasksRequest(FromHistoryRefreshWorkflowTasksRequest(item)))
@@ -219,6 +219,62 @@ func ToDomainCacheInfo(t *sharedv1.DomainCacheInfo) *types.DomainCacheInfo {
 	}
 }
 
+func FromHistoryShardLoad(t *types.HistoryShardLoad) *sharedv1.HistoryShardLoad {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.HistoryShardLoad{
+		ShardId:            t.ShardID,
+		RequestsPerSecond:  t.RequestsPerSecond,
+		LockWaitsPerSecond: t.LockWaitsPerSecond,
+		AverageLockWait:    millisToDuration(&t.AverageLockWaitInMillis),
+		TransferTaskLag:    t.TransferTaskLag,
+		TimerTaskLag:       millisToDuration(&t.TimerTaskLagInMillis),
+		TopDomains:         FromHistoryLoadEntryArray(t.TopDomains),
+		TopWorkflows:       FromHistoryLoadEntryArray(t.TopWorkflows),
+	}
+}
+
+func ToHistoryShardLoad(t *sharedv1.HistoryShardLoad) *types.HistoryShardLoad {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryShardLoad{
+		ShardID:                 t.ShardId,
+		RequestsPerSecond:       t.RequestsPerSecond,
+		LockWaitsPerSecond:      t.LockWaitsPerSecond,
+		AverageLockWaitInMillis: common.Int64Default(durationToMillis(t.AverageLockWait)),
+		TransferTaskLag:         t.TransferTaskLag,
+		TimerTaskLagInMillis:    common.Int64Default(durationToMillis(t.TimerTaskLag)),
+		TopDomains:              ToHistoryLoadEntryArray(t.TopDomains),
+		TopWorkflows:            ToHistoryLoadEntryArray(t.TopWorkflows),
+	}
+}
+
+func FromHistoryLoadEntry(t *types.HistoryLoadEntry) *sharedv1.HistoryLoadEntry {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.HistoryLoadEntry{
+		DomainId:           t.DomainID,
+		WorkflowId:         t.WorkflowID,
+		RequestsPerSecond:  t.RequestsPerSecond,
+		LockWaitsPerSecond: t.LockWaitsPerSecond,
+	}
+}
+
+func ToHistoryLoadEntry(t *sharedv1.HistoryLoadEntry) *types.HistoryLoadEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryLoadEntry{
+		DomainID:           t.DomainId,
+		WorkflowID:         t.WorkflowId,
+		RequestsPerSecond:  t.RequestsPerSecond,
+		LockWaitsPerSecond: t.LockWaitsPerSecond,
+	}
+}
+
 func FromRingInfo(t *types.RingInfo) *sharedv1.RingInfo {
 	if t == nil {
 		return nil
@@ -363,6 +419,8 @@ func FromWorkflowState(t *int32) sharedv1.WorkflowState {
 		return sharedv1.WorkflowState_WORKFLOW_STATE_VOID
 	case persistence.WorkflowStateCorrupted:
 		return sharedv1.WorkflowState_WORKFLOW_STATE_CORRUPTED
+	case persistence.WorkflowStateSuspended:
+		return sharedv1.WorkflowState_WORKFLOW_STATE_SUSPENDED
 	}
 	panic("unexpected enum value")
 }
@@ -389,6 +447,9 @@ func ToWorkflowState(t sharedv1.WorkflowState) *int32 {
 	case sharedv1.WorkflowState_WORKFLOW_STATE_CORRUPTED:
 		v := int32(persistence.WorkflowStateCorrupted)
 		return &v
+	case sharedv1.WorkflowState_WORKFLOW_STATE_SUSPENDED:
+		v := int32(persistence.WorkflowStateSuspended)
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -415,6 +476,50 @@ func ToHostInfoArray(t []*sharedv1.HostInfo) []*types.HostInfo {
 	return v
 }
 
+func FromHistoryShardLoadArray(t []*types.HistoryShardLoad) []*sharedv1.HistoryShardLoad {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.HistoryShardLoad, len(t))
+	for i := range t {
+		v[i] = FromHistoryShardLoad(t[i])
+	}
+	return v
+}
+
+func ToHistoryShardLoadArray(t []*sharedv1.HistoryShardLoad) []*types.HistoryShardLoad {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.HistoryShardLoad, len(t))
+	for i := range t {
+		v[i] = ToHistoryShardLoad(t[i])
+	}
+	return v
+}
+
+func FromHistoryLoadEntryArray(t []*types.HistoryLoadEntry) []*sharedv1.HistoryLoadEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.HistoryLoadEntry, len(t))
+	for i := range t {
+		v[i] = FromHistoryLoadEntry(t[i])
+	}
+	return v
+}
+
+func ToHistoryLoadEntryArray(t []*sharedv1.HistoryLoadEntry) []*types.HistoryLoadEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.HistoryLoadEntry, len(t))
+	for i := range t {
+		v[i] = ToHistoryLoadEntry(t[i])
+	}
+	return v
+}
+
 func FromVersionHistoryArray(t []*types.VersionHistory) []*sharedv1.VersionHistory {
 	if t == nil {
 		return nil
@@ -652,6 +757,8 @@ func FromReplicationTaskInfo(t *types.ReplicationTaskInfo) *sharedv1.Replication
 		FirstEventId:      t.FirstEventID,
 		NextEventId:       t.NextEventID,
 		ScheduledId:       t.ScheduledID,
+		DeadReason:        t.DeadReason,
+		RedriveAttempts:   t.RedriveAttempts,
 	}
 }
 
@@ -660,15 +767,17 @@ func ToReplicationTaskInfo(t *sharedv1.ReplicationTaskInfo) *types.ReplicationTa
 		return nil
 	}
 	return &types.ReplicationTaskInfo{
-		DomainID:     t.DomainId,
-		WorkflowID:   ToWorkflowID(t.WorkflowExecution),
-		RunID:        ToRunID(t.WorkflowExecution),
-		TaskType:     int16(t.TaskType),
-		TaskID:       t.TaskId,
-		Version:      t.Version,
-		FirstEventID: t.FirstEventId,
-		NextEventID:  t.NextEventId,
-		ScheduledID:  t.ScheduledId,
+		DomainID:        t.DomainId,
+		WorkflowID:      ToWorkflowID(t.WorkflowExecution),
+		RunID:           ToRunID(t.WorkflowExecution),
+		TaskType:        int16(t.TaskType),
+		TaskID:          t.TaskId,
+		Version:         t.Version,
+		FirstEventID:    t.FirstEventId,
+		NextEventID:     t.NextEventId,
+		ScheduledID:     t.ScheduledId,
+		DeadReason:      t.DeadReason,
+		RedriveAttempts: t.RedriveAttempts,
 	}
 }
 
@@ -1007,6 +1116,58 @@ func ToTaskType(t sharedv1.TaskType) *int32 {
 	panic("unexpected enum value")
 }
 
+func FromWorkflowTaskInfo(t *types.WorkflowTaskInfo) *sharedv1.WorkflowTaskInfo {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.WorkflowTaskInfo{
+		TaskType:       FromTaskType(t.Type),
+		SubType:        t.SubType,
+		TaskId:         t.TaskID,
+		VisibilityTime: unixNanoToTime(t.VisibilityTimestamp),
+		Version:        t.Version,
+		Attempt:        t.Attempt,
+		ClusterName:    t.ClusterName,
+	}
+}
+
+func ToWorkflowTaskInfo(t *sharedv1.WorkflowTaskInfo) *types.WorkflowTaskInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowTaskInfo{
+		Type:                ToTaskType(t.TaskType),
+		SubType:             t.SubType,
+		TaskID:              t.TaskId,
+		VisibilityTimestamp: timeToUnixNano(t.VisibilityTime),
+		Version:             t.Version,
+		Attempt:             t.Attempt,
+		ClusterName:         t.ClusterName,
+	}
+}
+
+func FromWorkflowTaskInfoArray(t []*types.WorkflowTaskInfo) []*sharedv1.WorkflowTaskInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.WorkflowTaskInfo, len(t))
+	for i := range t {
+		v[i] = FromWorkflowTaskInfo(t[i])
+	}
+	return v
+}
+
+func ToWorkflowTaskInfoArray(t []*sharedv1.WorkflowTaskInfo) []*types.WorkflowTaskInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowTaskInfo, len(t))
+	for i := range t {
+		v[i] = ToWorkflowTaskInfo(t[i])
+	}
+	return v
+}
+
 func FromFailoverMarkerTokenArray(t []*types.FailoverMarkerToken) []*sharedv1.FailoverMarkerToken {
 	if t == nil {
 		return nil
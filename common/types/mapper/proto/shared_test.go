@@ -45,6 +45,16 @@ func TestDomainCacheInfo(t *testing.T) {
 		assert.Equal(t, item, ToDomainCacheInfo(FromDomainCacheInfo(item)))
 	}
 }
+func TestHistoryShardLoad(t *testing.T) {
+	for _, item := range []*types.HistoryShardLoad{nil, {}, &testdata.HistoryShardLoad} {
+		assert.Equal(t, item, ToHistoryShardLoad(FromHistoryShardLoad(item)))
+	}
+}
+func TestHistoryLoadEntry(t *testing.T) {
+	for _, item := range []*types.HistoryLoadEntry{nil, {}, &testdata.HistoryLoadEntry} {
+		assert.Equal(t, item, ToHistoryLoadEntry(FromHistoryLoadEntry(item)))
+	}
+}
 func TestRingInfo(t *testing.T) {
 	for _, item := range []*types.RingInfo{nil, {}, &testdata.RingInfo} {
 		assert.Equal(t, item, ToRingInfo(FromRingInfo(item)))
@@ -48,6 +48,52 @@ func ToAddSearchAttributeRequest(t *admin.AddSearchAttributeRequest) *types.AddS
 	}
 }
 
+// FromRemoveSearchAttributeRequest converts internal RemoveSearchAttributeRequest type to thrift
+func FromRemoveSearchAttributeRequest(t *types.RemoveSearchAttributeRequest) *admin.RemoveSearchAttributeRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RemoveSearchAttributeRequest{
+		SearchAttribute: t.SearchAttribute,
+		SecurityToken:   &t.SecurityToken,
+	}
+}
+
+// ToRemoveSearchAttributeRequest converts thrift RemoveSearchAttributeRequest type to internal
+func ToRemoveSearchAttributeRequest(t *admin.RemoveSearchAttributeRequest) *types.RemoveSearchAttributeRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RemoveSearchAttributeRequest{
+		SearchAttribute: t.SearchAttribute,
+		SecurityToken:   t.GetSecurityToken(),
+	}
+}
+
+// FromRenameSearchAttributeRequest converts internal RenameSearchAttributeRequest type to thrift
+func FromRenameSearchAttributeRequest(t *types.RenameSearchAttributeRequest) *admin.RenameSearchAttributeRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RenameSearchAttributeRequest{
+		OldName:       &t.OldName,
+		NewName:       &t.NewName,
+		SecurityToken: &t.SecurityToken,
+	}
+}
+
+// ToRenameSearchAttributeRequest converts thrift RenameSearchAttributeRequest type to internal
+func ToRenameSearchAttributeRequest(t *admin.RenameSearchAttributeRequest) *types.RenameSearchAttributeRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RenameSearchAttributeRequest{
+		OldName:       t.GetOldName(),
+		NewName:       t.GetNewName(),
+		SecurityToken: t.GetSecurityToken(),
+	}
+}
+
 // FromDescribeClusterResponse converts internal DescribeClusterResponse type to thrift
 func FromDescribeClusterResponse(t *types.DescribeClusterResponse) *admin.DescribeClusterResponse {
 	if t == nil {
@@ -362,6 +408,28 @@ func ToPersistenceFeature(t *admin.PersistenceFeature) *types.PersistenceFeature
 	}
 }
 
+// FromPromoteDomainRequest converts internal PromoteDomainRequest type to thrift
+func FromPromoteDomainRequest(t *types.PromoteDomainRequest) *admin.PromoteDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.PromoteDomainRequest{
+		Domain:   &t.Domain,
+		Clusters: FromClusterReplicationConfigurationArray(t.Clusters),
+	}
+}
+
+// ToPromoteDomainRequest converts thrift PromoteDomainRequest type to internal
+func ToPromoteDomainRequest(t *admin.PromoteDomainRequest) *types.PromoteDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PromoteDomainRequest{
+		Domain:   t.GetDomain(),
+		Clusters: ToClusterReplicationConfigurationArray(t.Clusters),
+	}
+}
+
 // FromResendReplicationTasksRequest converts internal ResendReplicationTasksRequest type to thrift
 func FromResendReplicationTasksRequest(t *types.ResendReplicationTasksRequest) *admin.ResendReplicationTasksRequest {
 	if t == nil {
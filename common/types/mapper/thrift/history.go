@@ -402,6 +402,26 @@ func ToProcessingQueueStates(t *history.ProcessingQueueStates) *types.Processing
 	}
 }
 
+// FromDomainUsages converts internal DomainUsages type to thrift
+func FromDomainUsages(t *types.DomainUsages) *history.DomainUsages {
+	if t == nil {
+		return nil
+	}
+	return &history.DomainUsages{
+		UsagesByDomainID: FromDomainUsageMap(t.UsagesByDomainID),
+	}
+}
+
+// ToDomainUsages converts thrift DomainUsages type to internal
+func ToDomainUsages(t *history.DomainUsages) *types.DomainUsages {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainUsages{
+		UsagesByDomainID: ToDomainUsageMap(t.UsagesByDomainID),
+	}
+}
+
 // FromHistoryQueryWorkflowRequest converts internal QueryWorkflowRequest type to thrift
 func FromHistoryQueryWorkflowRequest(t *types.HistoryQueryWorkflowRequest) *history.QueryWorkflowRequest {
 	if t == nil {
@@ -676,6 +696,28 @@ func ToHistoryRefreshWorkflowTasksRequest(t *history.RefreshWorkflowTasksRequest
 	}
 }
 
+// FromHistoryFailoverWorkflowExecutionRequest converts internal FailoverWorkflowExecutionRequest type to thrift
+func FromHistoryFailoverWorkflowExecutionRequest(t *types.HistoryFailoverWorkflowExecutionRequest) *history.FailoverWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.FailoverWorkflowExecutionRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromFailoverWorkflowExecutionRequest(t.Request),
+	}
+}
+
+// ToHistoryFailoverWorkflowExecutionRequest converts thrift FailoverWorkflowExecutionRequest type to internal
+func ToHistoryFailoverWorkflowExecutionRequest(t *history.FailoverWorkflowExecutionRequest) *types.HistoryFailoverWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryFailoverWorkflowExecutionRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToFailoverWorkflowExecutionRequest(t.Request),
+	}
+}
+
 // FromRemoveSignalMutableStateRequest converts internal RemoveSignalMutableStateRequest type to thrift
 func FromRemoveSignalMutableStateRequest(t *types.RemoveSignalMutableStateRequest) *history.RemoveSignalMutableStateRequest {
 	if t == nil {
@@ -816,6 +858,28 @@ func ToHistoryResetWorkflowExecutionRequest(t *history.ResetWorkflowExecutionReq
 	}
 }
 
+// FromHistoryResumeWorkflowExecutionRequest converts internal ResumeWorkflowExecutionRequest type to thrift
+func FromHistoryResumeWorkflowExecutionRequest(t *types.HistoryResumeWorkflowExecutionRequest) *history.ResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ResumeWorkflowExecutionRequest{
+		DomainUUID:    &t.DomainUUID,
+		ResumeRequest: FromResumeWorkflowExecutionRequest(t.ResumeRequest),
+	}
+}
+
+// ToHistoryResumeWorkflowExecutionRequest converts thrift ResumeWorkflowExecutionRequest type to internal
+func ToHistoryResumeWorkflowExecutionRequest(t *history.ResumeWorkflowExecutionRequest) *types.HistoryResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResumeWorkflowExecutionRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		ResumeRequest: ToResumeWorkflowExecutionRequest(t.ResumeRequest),
+	}
+}
+
 // FromHistoryRespondActivityTaskCanceledRequest converts internal RespondActivityTaskCanceledRequest type to thrift
 func FromHistoryRespondActivityTaskCanceledRequest(t *types.HistoryRespondActivityTaskCanceledRequest) *history.RespondActivityTaskCanceledRequest {
 	if t == nil {
@@ -1152,6 +1216,160 @@ func ToSyncShardStatusRequest(t *history.SyncShardStatusRequest) *types.SyncShar
 	}
 }
 
+// FromHistoryReindexWorkflowExecutionRequest converts internal ReindexWorkflowExecutionRequest type to thrift
+func FromHistoryReindexWorkflowExecutionRequest(t *types.HistoryReindexWorkflowExecutionRequest) *history.ReindexWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ReindexWorkflowExecutionRequest{
+		DomainUUID: &t.DomainUUID,
+		Execution:  FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToHistoryReindexWorkflowExecutionRequest converts thrift ReindexWorkflowExecutionRequest type to internal
+func ToHistoryReindexWorkflowExecutionRequest(t *history.ReindexWorkflowExecutionRequest) *types.HistoryReindexWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryReindexWorkflowExecutionRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Execution:  ToWorkflowExecution(t.Execution),
+	}
+}
+
+// FromHistoryRebuildMutableStateRequest converts internal RebuildMutableStateRequest type to thrift
+func FromHistoryRebuildMutableStateRequest(t *types.HistoryRebuildMutableStateRequest) *history.RebuildMutableStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.RebuildMutableStateRequest{
+		DomainUUID: &t.DomainUUID,
+		Execution:  FromWorkflowExecution(t.Execution),
+		DryRun:     &t.DryRun,
+	}
+}
+
+// ToHistoryRebuildMutableStateRequest converts thrift RebuildMutableStateRequest type to internal
+func ToHistoryRebuildMutableStateRequest(t *history.RebuildMutableStateRequest) *types.HistoryRebuildMutableStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRebuildMutableStateRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Execution:  ToWorkflowExecution(t.Execution),
+		DryRun:     t.GetDryRun(),
+	}
+}
+
+// FromRebuildMutableStateResponse converts internal RebuildMutableStateResponse type to thrift
+func FromRebuildMutableStateResponse(t *types.RebuildMutableStateResponse) *history.RebuildMutableStateResponse {
+	if t == nil {
+		return nil
+	}
+	return &history.RebuildMutableStateResponse{
+		Mismatches: t.Mismatches,
+	}
+}
+
+// ToRebuildMutableStateResponse converts thrift RebuildMutableStateResponse type to internal
+func ToRebuildMutableStateResponse(t *history.RebuildMutableStateResponse) *types.RebuildMutableStateResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RebuildMutableStateResponse{
+		Mismatches: t.Mismatches,
+	}
+}
+
+// FromHistoryListWorkflowTasksRequest converts internal ListWorkflowTasksRequest type to thrift
+func FromHistoryListWorkflowTasksRequest(t *types.HistoryListWorkflowTasksRequest) *history.ListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ListWorkflowTasksRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromListWorkflowTasksRequest(t.Request),
+	}
+}
+
+// ToHistoryListWorkflowTasksRequest converts thrift ListWorkflowTasksRequest type to internal
+func ToHistoryListWorkflowTasksRequest(t *history.ListWorkflowTasksRequest) *types.HistoryListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryListWorkflowTasksRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToListWorkflowTasksRequest(t.Request),
+	}
+}
+
+// FromHistoryDeleteWorkflowTaskRequest converts internal DeleteWorkflowTaskRequest type to thrift
+func FromHistoryDeleteWorkflowTaskRequest(t *types.HistoryDeleteWorkflowTaskRequest) *history.DeleteWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.DeleteWorkflowTaskRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromDeleteWorkflowTaskRequest(t.Request),
+	}
+}
+
+// ToHistoryDeleteWorkflowTaskRequest converts thrift DeleteWorkflowTaskRequest type to internal
+func ToHistoryDeleteWorkflowTaskRequest(t *history.DeleteWorkflowTaskRequest) *types.HistoryDeleteWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryDeleteWorkflowTaskRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToDeleteWorkflowTaskRequest(t.Request),
+	}
+}
+
+// FromHistoryReenqueueWorkflowTaskRequest converts internal ReenqueueWorkflowTaskRequest type to thrift
+func FromHistoryReenqueueWorkflowTaskRequest(t *types.HistoryReenqueueWorkflowTaskRequest) *history.ReenqueueWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ReenqueueWorkflowTaskRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromReenqueueWorkflowTaskRequest(t.Request),
+	}
+}
+
+// ToHistoryReenqueueWorkflowTaskRequest converts thrift ReenqueueWorkflowTaskRequest type to internal
+func ToHistoryReenqueueWorkflowTaskRequest(t *history.ReenqueueWorkflowTaskRequest) *types.HistoryReenqueueWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryReenqueueWorkflowTaskRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToReenqueueWorkflowTaskRequest(t.Request),
+	}
+}
+
+// FromHistorySuspendWorkflowExecutionRequest converts internal SuspendWorkflowExecutionRequest type to thrift
+func FromHistorySuspendWorkflowExecutionRequest(t *types.HistorySuspendWorkflowExecutionRequest) *history.SuspendWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.SuspendWorkflowExecutionRequest{
+		DomainUUID:     &t.DomainUUID,
+		SuspendRequest: FromSuspendWorkflowExecutionRequest(t.SuspendRequest),
+	}
+}
+
+// ToHistorySuspendWorkflowExecutionRequest converts thrift SuspendWorkflowExecutionRequest type to internal
+func ToHistorySuspendWorkflowExecutionRequest(t *history.SuspendWorkflowExecutionRequest) *types.HistorySuspendWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistorySuspendWorkflowExecutionRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		SuspendRequest: ToSuspendWorkflowExecutionRequest(t.SuspendRequest),
+	}
+}
+
 // FromHistoryTerminateWorkflowExecutionRequest converts internal TerminateWorkflowExecutionRequest type to thrift
 func FromHistoryTerminateWorkflowExecutionRequest(t *types.HistoryTerminateWorkflowExecutionRequest) *history.TerminateWorkflowExecutionRequest {
 	if t == nil {
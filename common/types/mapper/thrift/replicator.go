@@ -524,15 +524,17 @@ func FromReplicationTaskInfo(t *types.ReplicationTaskInfo) *replicator.Replicati
 		return nil
 	}
 	return &replicator.ReplicationTaskInfo{
-		DomainID:     &t.DomainID,
-		WorkflowID:   &t.WorkflowID,
-		RunID:        &t.RunID,
-		TaskType:     &t.TaskType,
-		TaskID:       &t.TaskID,
-		Version:      &t.Version,
-		FirstEventID: &t.FirstEventID,
-		NextEventID:  &t.NextEventID,
-		ScheduledID:  &t.ScheduledID,
+		DomainID:        &t.DomainID,
+		WorkflowID:      &t.WorkflowID,
+		RunID:           &t.RunID,
+		TaskType:        &t.TaskType,
+		TaskID:          &t.TaskID,
+		Version:         &t.Version,
+		FirstEventID:    &t.FirstEventID,
+		NextEventID:     &t.NextEventID,
+		ScheduledID:     &t.ScheduledID,
+		DeadReason:      &t.DeadReason,
+		RedriveAttempts: &t.RedriveAttempts,
 	}
 }
 
@@ -542,15 +544,17 @@ func ToReplicationTaskInfo(t *replicator.ReplicationTaskInfo) *types.Replication
 		return nil
 	}
 	return &types.ReplicationTaskInfo{
-		DomainID:     t.GetDomainID(),
-		WorkflowID:   t.GetWorkflowID(),
-		RunID:        t.GetRunID(),
-		TaskType:     t.GetTaskType(),
-		TaskID:       t.GetTaskID(),
-		Version:      t.GetVersion(),
-		FirstEventID: t.GetFirstEventID(),
-		NextEventID:  t.GetNextEventID(),
-		ScheduledID:  t.GetScheduledID(),
+		DomainID:        t.GetDomainID(),
+		WorkflowID:      t.GetWorkflowID(),
+		RunID:           t.GetRunID(),
+		TaskType:        t.GetTaskType(),
+		TaskID:          t.GetTaskID(),
+		Version:         t.GetVersion(),
+		FirstEventID:    t.GetFirstEventID(),
+		NextEventID:     t.GetNextEventID(),
+		ScheduledID:     t.GetScheduledID(),
+		DeadReason:      t.GetDeadReason(),
+		RedriveAttempts: t.GetRedriveAttempts(),
 	}
 }
 
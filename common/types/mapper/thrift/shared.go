@@ -895,6 +895,7 @@ func FromContinueAsNewWorkflowExecutionDecisionAttributes(t *types.ContinueAsNew
 		Header:                              FromHeader(t.Header),
 		Memo:                                FromMemo(t.Memo),
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
+		RetentionPeriodInDays:               t.RetentionPeriodInDays,
 	}
 }
 
@@ -919,6 +920,7 @@ func ToContinueAsNewWorkflowExecutionDecisionAttributes(t *shared.ContinueAsNewW
 		Header:                              ToHeader(t.Header),
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+		RetentionPeriodInDays:               t.RetentionPeriodInDays,
 	}
 }
 
@@ -950,7 +952,8 @@ func FromCountWorkflowExecutionsResponse(t *types.CountWorkflowExecutionsRespons
 		return nil
 	}
 	return &shared.CountWorkflowExecutionsResponse{
-		Count: &t.Count,
+		Count:  &t.Count,
+		Groups: FromCountWorkflowExecutionsGroupArray(t.Groups),
 	}
 }
 
@@ -960,10 +963,57 @@ func ToCountWorkflowExecutionsResponse(t *shared.CountWorkflowExecutionsResponse
 		return nil
 	}
 	return &types.CountWorkflowExecutionsResponse{
-		Count: t.GetCount(),
+		Count:  t.GetCount(),
+		Groups: ToCountWorkflowExecutionsGroupArray(t.Groups),
 	}
 }
 
+// FromCountWorkflowExecutionsGroup converts internal CountWorkflowExecutionsGroup type to thrift
+func FromCountWorkflowExecutionsGroup(t *types.CountWorkflowExecutionsGroup) *shared.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	return &shared.CountWorkflowExecutionsGroup{
+		GroupValues: t.GroupValues,
+		Count:       &t.Count,
+	}
+}
+
+// ToCountWorkflowExecutionsGroup converts thrift CountWorkflowExecutionsGroup type to internal
+func ToCountWorkflowExecutionsGroup(t *shared.CountWorkflowExecutionsGroup) *types.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	return &types.CountWorkflowExecutionsGroup{
+		GroupValues: t.GroupValues,
+		Count:       t.GetCount(),
+	}
+}
+
+// FromCountWorkflowExecutionsGroupArray converts internal CountWorkflowExecutionsGroup type array to thrift
+func FromCountWorkflowExecutionsGroupArray(t []*types.CountWorkflowExecutionsGroup) []*shared.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.CountWorkflowExecutionsGroup, len(t))
+	for i := range t {
+		v[i] = FromCountWorkflowExecutionsGroup(t[i])
+	}
+	return v
+}
+
+// ToCountWorkflowExecutionsGroupArray converts thrift CountWorkflowExecutionsGroup type array to internal
+func ToCountWorkflowExecutionsGroupArray(t []*shared.CountWorkflowExecutionsGroup) []*types.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CountWorkflowExecutionsGroup, len(t))
+	for i := range t {
+		v[i] = ToCountWorkflowExecutionsGroup(t[i])
+	}
+	return v
+}
+
 // FromCurrentBranchChangedError converts internal CurrentBranchChangedError type to thrift
 func FromCurrentBranchChangedError(t *types.CurrentBranchChangedError) *shared.CurrentBranchChangedError {
 	if t == nil {
@@ -1611,6 +1661,7 @@ func FromDescribeHistoryHostResponse(t *types.DescribeHistoryHostResponse) *shar
 		DomainCache:           FromDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: &t.ShardControllerStatus,
 		Address:               &t.Address,
+		ShardLoads:            FromHistoryShardLoadArray(t.ShardLoads),
 	}
 }
 
@@ -1636,6 +1687,7 @@ func ToDescribeHistoryHostResponse(t *shared.DescribeHistoryHostResponse) *types
 		DomainCache:           ToDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: t.GetShardControllerStatus(),
 		Address:               t.GetAddress(),
+		ShardLoads:            ToHistoryShardLoadArray(t.ShardLoads),
 	}
 }
 
@@ -1834,6 +1886,66 @@ func ToDomainCacheInfo(t *shared.DomainCacheInfo) *types.DomainCacheInfo {
 	}
 }
 
+// FromHistoryShardLoad converts internal HistoryShardLoad type to thrift
+func FromHistoryShardLoad(t *types.HistoryShardLoad) *shared.HistoryShardLoad {
+	if t == nil {
+		return nil
+	}
+	return &shared.HistoryShardLoad{
+		ShardID:                 &t.ShardID,
+		RequestsPerSecond:       &t.RequestsPerSecond,
+		LockWaitsPerSecond:      &t.LockWaitsPerSecond,
+		AverageLockWaitInMillis: &t.AverageLockWaitInMillis,
+		TransferTaskLag:         &t.TransferTaskLag,
+		TimerTaskLagInMillis:    &t.TimerTaskLagInMillis,
+		TopDomains:              FromHistoryLoadEntryArray(t.TopDomains),
+		TopWorkflows:            FromHistoryLoadEntryArray(t.TopWorkflows),
+	}
+}
+
+// ToHistoryShardLoad converts thrift HistoryShardLoad type to internal
+func ToHistoryShardLoad(t *shared.HistoryShardLoad) *types.HistoryShardLoad {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryShardLoad{
+		ShardID:                 t.GetShardID(),
+		RequestsPerSecond:       t.GetRequestsPerSecond(),
+		LockWaitsPerSecond:      t.GetLockWaitsPerSecond(),
+		AverageLockWaitInMillis: t.GetAverageLockWaitInMillis(),
+		TransferTaskLag:         t.GetTransferTaskLag(),
+		TimerTaskLagInMillis:    t.GetTimerTaskLagInMillis(),
+		TopDomains:              ToHistoryLoadEntryArray(t.TopDomains),
+		TopWorkflows:            ToHistoryLoadEntryArray(t.TopWorkflows),
+	}
+}
+
+// FromHistoryLoadEntry converts internal HistoryLoadEntry type to thrift
+func FromHistoryLoadEntry(t *types.HistoryLoadEntry) *shared.HistoryLoadEntry {
+	if t == nil {
+		return nil
+	}
+	return &shared.HistoryLoadEntry{
+		DomainID:           &t.DomainID,
+		WorkflowID:         &t.WorkflowID,
+		RequestsPerSecond:  &t.RequestsPerSecond,
+		LockWaitsPerSecond: &t.LockWaitsPerSecond,
+	}
+}
+
+// ToHistoryLoadEntry converts thrift HistoryLoadEntry type to internal
+func ToHistoryLoadEntry(t *shared.HistoryLoadEntry) *types.HistoryLoadEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryLoadEntry{
+		DomainID:           t.GetDomainID(),
+		WorkflowID:         t.GetWorkflowID(),
+		RequestsPerSecond:  t.GetRequestsPerSecond(),
+		LockWaitsPerSecond: t.GetLockWaitsPerSecond(),
+	}
+}
+
 // FromDomainConfiguration converts internal DomainConfiguration type to thrift
 func FromDomainConfiguration(t *types.DomainConfiguration) *shared.DomainConfiguration {
 	if t == nil {
@@ -2218,6 +2330,12 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeWorkflowExecutionSuspended:
+		v := shared.EventTypeWorkflowExecutionSuspended
+		return &v
+	case types.EventTypeWorkflowExecutionResumed:
+		v := shared.EventTypeWorkflowExecutionResumed
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2354,6 +2472,12 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeWorkflowExecutionSuspended:
+		v := types.EventTypeWorkflowExecutionSuspended
+		return &v
+	case shared.EventTypeWorkflowExecutionResumed:
+		v := types.EventTypeWorkflowExecutionResumed
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2649,6 +2773,8 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionSuspendedEventAttributes:                      FromWorkflowExecutionSuspendedEventAttributes(t.WorkflowExecutionSuspendedEventAttributes),
+		WorkflowExecutionResumedEventAttributes:                        FromWorkflowExecutionResumedEventAttributes(t.WorkflowExecutionResumedEventAttributes),
 	}
 }
 
@@ -2705,6 +2831,8 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionSuspendedEventAttributes:                      ToWorkflowExecutionSuspendedEventAttributes(t.WorkflowExecutionSuspendedEventAttributes),
+		WorkflowExecutionResumedEventAttributes:                        ToWorkflowExecutionResumedEventAttributes(t.WorkflowExecutionResumedEventAttributes),
 	}
 }
 
@@ -3988,6 +4116,30 @@ func ToRefreshWorkflowTasksRequest(t *shared.RefreshWorkflowTasksRequest) *types
 	}
 }
 
+// FromFailoverWorkflowExecutionRequest converts internal FailoverWorkflowExecutionRequest type to thrift
+func FromFailoverWorkflowExecutionRequest(t *types.FailoverWorkflowExecutionRequest) *shared.FailoverWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.FailoverWorkflowExecutionRequest{
+		Domain:        &t.Domain,
+		Execution:     FromWorkflowExecution(t.Execution),
+		TargetCluster: &t.TargetCluster,
+	}
+}
+
+// ToFailoverWorkflowExecutionRequest converts thrift FailoverWorkflowExecutionRequest type to internal
+func ToFailoverWorkflowExecutionRequest(t *shared.FailoverWorkflowExecutionRequest) *types.FailoverWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.FailoverWorkflowExecutionRequest{
+		Domain:        t.GetDomain(),
+		Execution:     ToWorkflowExecution(t.Execution),
+		TargetCluster: t.GetTargetCluster(),
+	}
+}
+
 // FromRegisterDomainRequest converts internal RegisterDomainRequest type to thrift
 func FromRegisterDomainRequest(t *types.RegisterDomainRequest) *shared.RegisterDomainRequest {
 	if t == nil {
@@ -4362,6 +4514,7 @@ func FromResetWorkflowExecutionRequest(t *types.ResetWorkflowExecutionRequest) *
 		DecisionFinishEventId: &t.DecisionFinishEventID,
 		RequestId:             &t.RequestID,
 		SkipSignalReapply:     &t.SkipSignalReapply,
+		DryRun:                &t.DryRun,
 	}
 }
 
@@ -4377,6 +4530,7 @@ func ToResetWorkflowExecutionRequest(t *shared.ResetWorkflowExecutionRequest) *t
 		DecisionFinishEventID: t.GetDecisionFinishEventId(),
 		RequestID:             t.GetRequestId(),
 		SkipSignalReapply:     t.GetSkipSignalReapply(),
+		DryRun:                t.GetDryRun(),
 	}
 }
 
@@ -4386,7 +4540,8 @@ func FromResetWorkflowExecutionResponse(t *types.ResetWorkflowExecutionResponse)
 		return nil
 	}
 	return &shared.ResetWorkflowExecutionResponse{
-		RunId: &t.RunID,
+		RunId:   &t.RunID,
+		Preview: FromResetWorkflowExecutionPreview(t.Preview),
 	}
 }
 
@@ -4396,7 +4551,62 @@ func ToResetWorkflowExecutionResponse(t *shared.ResetWorkflowExecutionResponse)
 		return nil
 	}
 	return &types.ResetWorkflowExecutionResponse{
-		RunID: t.GetRunId(),
+		RunID:   t.GetRunId(),
+		Preview: ToResetWorkflowExecutionPreview(t.Preview),
+	}
+}
+
+// FromResetWorkflowExecutionPreview converts internal ResetWorkflowExecutionPreview type to thrift
+func FromResetWorkflowExecutionPreview(t *types.ResetWorkflowExecutionPreview) *shared.ResetWorkflowExecutionPreview {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetWorkflowExecutionPreview{
+		ResetPointEvent:        FromHistoryEvent(t.ResetPointEvent),
+		DiscardedEvents:        FromHistoryEventArray(t.DiscardedEvents),
+		ReappliedSignals:       FromHistoryEventArray(t.ReappliedSignals),
+		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
+		PendingTimers:          FromPendingTimerInfoArray(t.PendingTimers),
+		OrphanedChildWorkflows: FromWorkflowExecutionArray(t.OrphanedChildWorkflows),
+	}
+}
+
+// ToResetWorkflowExecutionPreview converts thrift ResetWorkflowExecutionPreview type to internal
+func ToResetWorkflowExecutionPreview(t *shared.ResetWorkflowExecutionPreview) *types.ResetWorkflowExecutionPreview {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetWorkflowExecutionPreview{
+		ResetPointEvent:        ToHistoryEvent(t.ResetPointEvent),
+		DiscardedEvents:        ToHistoryEventArray(t.DiscardedEvents),
+		ReappliedSignals:       ToHistoryEventArray(t.ReappliedSignals),
+		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
+		PendingTimers:          ToPendingTimerInfoArray(t.PendingTimers),
+		OrphanedChildWorkflows: ToWorkflowExecutionArray(t.OrphanedChildWorkflows),
+	}
+}
+
+// FromPendingTimerInfo converts internal PendingTimerInfo type to thrift
+func FromPendingTimerInfo(t *types.PendingTimerInfo) *shared.PendingTimerInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.PendingTimerInfo{
+		TimerId:         &t.TimerID,
+		StartedEventId:  &t.StartedEventID,
+		ExpiryTimestamp: t.ExpiryTimestamp,
+	}
+}
+
+// ToPendingTimerInfo converts thrift PendingTimerInfo type to internal
+func ToPendingTimerInfo(t *shared.PendingTimerInfo) *types.PendingTimerInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.PendingTimerInfo{
+		TimerID:         t.GetTimerId(),
+		StartedEventID:  t.GetStartedEventId(),
+		ExpiryTimestamp: t.ExpiryTimestamp,
 	}
 }
 
@@ -4680,6 +4890,294 @@ func ToRespondQueryTaskCompletedRequest(t *shared.RespondQueryTaskCompletedReque
 	}
 }
 
+// FromResumeWorkflowExecutionRequest converts internal ResumeWorkflowExecutionRequest type to thrift
+func FromResumeWorkflowExecutionRequest(t *types.ResumeWorkflowExecutionRequest) *shared.ResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResumeWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToResumeWorkflowExecutionRequest converts thrift ResumeWorkflowExecutionRequest type to internal
+func ToResumeWorkflowExecutionRequest(t *shared.ResumeWorkflowExecutionRequest) *types.ResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromListWorkflowTasksRequest converts internal ListWorkflowTasksRequest type to thrift
+func FromListWorkflowTasksRequest(t *types.ListWorkflowTasksRequest) *shared.ListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListWorkflowTasksRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// ToListWorkflowTasksRequest converts thrift ListWorkflowTasksRequest type to internal
+func ToListWorkflowTasksRequest(t *shared.ListWorkflowTasksRequest) *types.ListWorkflowTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkflowTasksRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// FromListWorkflowTasksResponse converts internal ListWorkflowTasksResponse type to thrift
+func FromListWorkflowTasksResponse(t *types.ListWorkflowTasksResponse) *shared.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListWorkflowTasksResponse{
+		ShardID:   &t.ShardID,
+		Tasks:     FromWorkflowTaskInfoArray(t.Tasks),
+		Truncated: &t.Truncated,
+	}
+}
+
+// ToListWorkflowTasksResponse converts thrift ListWorkflowTasksResponse type to internal
+func ToListWorkflowTasksResponse(t *shared.ListWorkflowTasksResponse) *types.ListWorkflowTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkflowTasksResponse{
+		ShardID:   t.GetShardID(),
+		Tasks:     ToWorkflowTaskInfoArray(t.Tasks),
+		Truncated: t.GetTruncated(),
+	}
+}
+
+// FromWorkflowTaskInfo converts internal WorkflowTaskInfo type to thrift
+func FromWorkflowTaskInfo(t *types.WorkflowTaskInfo) *shared.WorkflowTaskInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowTaskInfo{
+		Type:                t.Type,
+		SubType:             &t.SubType,
+		TaskID:              &t.TaskID,
+		VisibilityTimestamp: t.VisibilityTimestamp,
+		Version:             &t.Version,
+		Attempt:             &t.Attempt,
+		ClusterName:         &t.ClusterName,
+	}
+}
+
+// ToWorkflowTaskInfo converts thrift WorkflowTaskInfo type to internal
+func ToWorkflowTaskInfo(t *shared.WorkflowTaskInfo) *types.WorkflowTaskInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowTaskInfo{
+		Type:                t.Type,
+		SubType:             t.GetSubType(),
+		TaskID:              t.GetTaskID(),
+		VisibilityTimestamp: t.VisibilityTimestamp,
+		Version:             t.GetVersion(),
+		Attempt:             t.GetAttempt(),
+		ClusterName:         t.GetClusterName(),
+	}
+}
+
+// FromWorkflowTaskInfoArray converts internal WorkflowTaskInfo type array to thrift
+func FromWorkflowTaskInfoArray(t []*types.WorkflowTaskInfo) []*shared.WorkflowTaskInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowTaskInfo, len(t))
+	for i := range t {
+		v[i] = FromWorkflowTaskInfo(t[i])
+	}
+	return v
+}
+
+// ToWorkflowTaskInfoArray converts thrift WorkflowTaskInfo type array to internal
+func ToWorkflowTaskInfoArray(t []*shared.WorkflowTaskInfo) []*types.WorkflowTaskInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowTaskInfo, len(t))
+	for i := range t {
+		v[i] = ToWorkflowTaskInfo(t[i])
+	}
+	return v
+}
+
+// FromDeleteWorkflowTaskRequest converts internal DeleteWorkflowTaskRequest type to thrift
+func FromDeleteWorkflowTaskRequest(t *types.DeleteWorkflowTaskRequest) *shared.DeleteWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeleteWorkflowTaskRequest{
+		Domain:              &t.Domain,
+		WorkflowExecution:   FromWorkflowExecution(t.WorkflowExecution),
+		Type:                t.Type,
+		TaskID:              &t.TaskID,
+		VisibilityTimestamp: t.VisibilityTimestamp,
+		ClusterName:         &t.ClusterName,
+		Reason:              &t.Reason,
+		Identity:            &t.Identity,
+	}
+}
+
+// ToDeleteWorkflowTaskRequest converts thrift DeleteWorkflowTaskRequest type to internal
+func ToDeleteWorkflowTaskRequest(t *shared.DeleteWorkflowTaskRequest) *types.DeleteWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteWorkflowTaskRequest{
+		Domain:              t.GetDomain(),
+		WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+		Type:                t.Type,
+		TaskID:              t.GetTaskID(),
+		VisibilityTimestamp: t.VisibilityTimestamp,
+		ClusterName:         t.GetClusterName(),
+		Reason:              t.GetReason(),
+		Identity:            t.GetIdentity(),
+	}
+}
+
+// FromReenqueueWorkflowTaskRequest converts internal ReenqueueWorkflowTaskRequest type to thrift
+func FromReenqueueWorkflowTaskRequest(t *types.ReenqueueWorkflowTaskRequest) *shared.ReenqueueWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ReenqueueWorkflowTaskRequest{
+		Domain:              &t.Domain,
+		WorkflowExecution:   FromWorkflowExecution(t.WorkflowExecution),
+		Type:                t.Type,
+		TaskID:              &t.TaskID,
+		VisibilityTimestamp: t.VisibilityTimestamp,
+		ClusterName:         &t.ClusterName,
+		Reason:              &t.Reason,
+		Identity:            &t.Identity,
+	}
+}
+
+// ToReenqueueWorkflowTaskRequest converts thrift ReenqueueWorkflowTaskRequest type to internal
+func ToReenqueueWorkflowTaskRequest(t *shared.ReenqueueWorkflowTaskRequest) *types.ReenqueueWorkflowTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReenqueueWorkflowTaskRequest{
+		Domain:              t.GetDomain(),
+		WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+		Type:                t.Type,
+		TaskID:              t.GetTaskID(),
+		VisibilityTimestamp: t.VisibilityTimestamp,
+		ClusterName:         t.GetClusterName(),
+		Reason:              t.GetReason(),
+		Identity:            t.GetIdentity(),
+	}
+}
+
+// FromDomainUsage converts internal DomainUsage type to thrift
+func FromDomainUsage(t *types.DomainUsage) *shared.DomainUsage {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainUsage{
+		HistorySize:         &t.HistorySize,
+		HistoryEventCount:   &t.HistoryEventCount,
+		OpenWorkflowCount:   &t.OpenWorkflowCount,
+		ClosedWorkflowCount: &t.ClosedWorkflowCount,
+	}
+}
+
+// ToDomainUsage converts thrift DomainUsage type to internal
+func ToDomainUsage(t *shared.DomainUsage) *types.DomainUsage {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainUsage{
+		HistorySize:         t.GetHistorySize(),
+		HistoryEventCount:   t.GetHistoryEventCount(),
+		OpenWorkflowCount:   t.GetOpenWorkflowCount(),
+		ClosedWorkflowCount: t.GetClosedWorkflowCount(),
+	}
+}
+
+// FromDomainUsageMap converts internal DomainUsage type map to thrift
+func FromDomainUsageMap(t map[string]*types.DomainUsage) map[string]*shared.DomainUsage {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*shared.DomainUsage, len(t))
+	for key := range t {
+		v[key] = FromDomainUsage(t[key])
+	}
+	return v
+}
+
+// ToDomainUsageMap converts thrift DomainUsage type map to internal
+func ToDomainUsageMap(t map[string]*shared.DomainUsage) map[string]*types.DomainUsage {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.DomainUsage, len(t))
+	for key := range t {
+		v[key] = ToDomainUsage(t[key])
+	}
+	return v
+}
+
+// FromDescribeDomainUsageRequest converts internal DescribeDomainUsageRequest type to thrift
+func FromDescribeDomainUsageRequest(t *types.DescribeDomainUsageRequest) *shared.DescribeDomainUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeDomainUsageRequest{
+		Domain: &t.Domain,
+	}
+}
+
+// ToDescribeDomainUsageRequest converts thrift DescribeDomainUsageRequest type to internal
+func ToDescribeDomainUsageRequest(t *shared.DescribeDomainUsageRequest) *types.DescribeDomainUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeDomainUsageRequest{
+		Domain: t.GetDomain(),
+	}
+}
+
+// FromDescribeDomainUsageResponse converts internal DescribeDomainUsageResponse type to thrift
+func FromDescribeDomainUsageResponse(t *types.DescribeDomainUsageResponse) *shared.DescribeDomainUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeDomainUsageResponse{
+		Usage:          FromDomainUsage(t.Usage),
+		LastUpdateTime: t.LastUpdateTime,
+	}
+}
+
+// ToDescribeDomainUsageResponse converts thrift DescribeDomainUsageResponse type to internal
+func ToDescribeDomainUsageResponse(t *shared.DescribeDomainUsageResponse) *types.DescribeDomainUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeDomainUsageResponse{
+		Usage:          ToDomainUsage(t.Usage),
+		LastUpdateTime: t.LastUpdateTime,
+	}
+}
+
 // FromRetryPolicy converts internal RetryPolicy type to thrift
 func FromRetryPolicy(t *types.RetryPolicy) *shared.RetryPolicy {
 	if t == nil {
@@ -4968,6 +5466,7 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 		Memo:                                FromMemo(t.Memo),
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
+		RetentionPeriodInDays:               t.RetentionPeriodInDays,
 	}
 }
 
@@ -4995,6 +5494,7 @@ func ToSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflow
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
+		RetentionPeriodInDays:               t.RetentionPeriodInDays,
 	}
 }
 
@@ -5226,6 +5726,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
+		RetentionPeriodInDays:               t.RetentionPeriodInDays,
 	}
 }
 
@@ -5251,6 +5752,7 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
+		RetentionPeriodInDays:               t.RetentionPeriodInDays,
 	}
 }
 
@@ -5304,6 +5806,7 @@ func FromSupportedClientVersions(t *types.SupportedClientVersions) *shared.Suppo
 	return &shared.SupportedClientVersions{
 		GoSdk:   &t.GoSdk,
 		JavaSdk: &t.JavaSdk,
+		Cli:     &t.Cli,
 	}
 }
 
@@ -5315,6 +5818,33 @@ func ToSupportedClientVersions(t *shared.SupportedClientVersions) *types.Support
 	return &types.SupportedClientVersions{
 		GoSdk:   t.GetGoSdk(),
 		JavaSdk: t.GetJavaSdk(),
+		Cli:     t.GetCli(),
+	}
+}
+
+// FromSuspendWorkflowExecutionRequest converts internal SuspendWorkflowExecutionRequest type to thrift
+func FromSuspendWorkflowExecutionRequest(t *types.SuspendWorkflowExecutionRequest) *shared.SuspendWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.SuspendWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToSuspendWorkflowExecutionRequest converts thrift SuspendWorkflowExecutionRequest type to internal
+func ToSuspendWorkflowExecutionRequest(t *shared.SuspendWorkflowExecutionRequest) *types.SuspendWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.SuspendWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
 	}
 }
 
@@ -6077,6 +6607,7 @@ func FromWorkflowExecutionConfiguration(t *types.WorkflowExecutionConfiguration)
 		TaskList:                            FromTaskList(t.TaskList),
 		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
 		TaskStartToCloseTimeoutSeconds:      t.TaskStartToCloseTimeoutSeconds,
+		RetentionPeriodInDays:               t.RetentionPeriodInDays,
 	}
 }
 
@@ -6089,6 +6620,7 @@ func ToWorkflowExecutionConfiguration(t *shared.WorkflowExecutionConfiguration)
 		TaskList:                            ToTaskList(t.TaskList),
 		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
 		TaskStartToCloseTimeoutSeconds:      t.TaskStartToCloseTimeoutSeconds,
+		RetentionPeriodInDays:               t.RetentionPeriodInDays,
 	}
 }
 
@@ -6232,6 +6764,28 @@ func ToWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *types.WorkflowExe
 	}
 }
 
+// FromWorkflowExecutionResumedEventAttributes converts internal WorkflowExecutionResumedEventAttributes type to thrift
+func FromWorkflowExecutionResumedEventAttributes(t *types.WorkflowExecutionResumedEventAttributes) *shared.WorkflowExecutionResumedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionResumedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionResumedEventAttributes converts thrift WorkflowExecutionResumedEventAttributes type to internal
+func ToWorkflowExecutionResumedEventAttributes(t *shared.WorkflowExecutionResumedEventAttributes) *types.WorkflowExecutionResumedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionResumedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
+	}
+}
+
 // FromWorkflowExecutionSignaledEventAttributes converts internal WorkflowExecutionSignaledEventAttributes type to thrift
 func FromWorkflowExecutionSignaledEventAttributes(t *types.WorkflowExecutionSignaledEventAttributes) *shared.WorkflowExecutionSignaledEventAttributes {
 	if t == nil {
@@ -6287,6 +6841,7 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 FromResetPoints(t.PrevAutoResetPoints),
 		Header:                              FromHeader(t.Header),
+		RetentionPeriodInDays:               t.RetentionPeriodInDays,
 	}
 }
 
@@ -6321,6 +6876,29 @@ func ToWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStarte
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		RetentionPeriodInDays:               t.RetentionPeriodInDays,
+	}
+}
+
+// FromWorkflowExecutionSuspendedEventAttributes converts internal WorkflowExecutionSuspendedEventAttributes type to thrift
+func FromWorkflowExecutionSuspendedEventAttributes(t *types.WorkflowExecutionSuspendedEventAttributes) *shared.WorkflowExecutionSuspendedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionSuspendedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionSuspendedEventAttributes converts thrift WorkflowExecutionSuspendedEventAttributes type to internal
+func ToWorkflowExecutionSuspendedEventAttributes(t *shared.WorkflowExecutionSuspendedEventAttributes) *types.WorkflowExecutionSuspendedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionSuspendedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
 	}
 }
 
@@ -6522,6 +7100,102 @@ func ToPendingActivityInfoArray(t []*shared.PendingActivityInfo) []*types.Pendin
 	return v
 }
 
+// FromHistoryShardLoadArray converts internal HistoryShardLoad type array to thrift
+func FromHistoryShardLoadArray(t []*types.HistoryShardLoad) []*shared.HistoryShardLoad {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.HistoryShardLoad, len(t))
+	for i := range t {
+		v[i] = FromHistoryShardLoad(t[i])
+	}
+	return v
+}
+
+// ToHistoryShardLoadArray converts thrift HistoryShardLoad type array to internal
+func ToHistoryShardLoadArray(t []*shared.HistoryShardLoad) []*types.HistoryShardLoad {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.HistoryShardLoad, len(t))
+	for i := range t {
+		v[i] = ToHistoryShardLoad(t[i])
+	}
+	return v
+}
+
+// FromHistoryLoadEntryArray converts internal HistoryLoadEntry type array to thrift
+func FromHistoryLoadEntryArray(t []*types.HistoryLoadEntry) []*shared.HistoryLoadEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.HistoryLoadEntry, len(t))
+	for i := range t {
+		v[i] = FromHistoryLoadEntry(t[i])
+	}
+	return v
+}
+
+// ToHistoryLoadEntryArray converts thrift HistoryLoadEntry type array to internal
+func ToHistoryLoadEntryArray(t []*shared.HistoryLoadEntry) []*types.HistoryLoadEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.HistoryLoadEntry, len(t))
+	for i := range t {
+		v[i] = ToHistoryLoadEntry(t[i])
+	}
+	return v
+}
+
+// FromPendingTimerInfoArray converts internal PendingTimerInfo type array to thrift
+func FromPendingTimerInfoArray(t []*types.PendingTimerInfo) []*shared.PendingTimerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.PendingTimerInfo, len(t))
+	for i := range t {
+		v[i] = FromPendingTimerInfo(t[i])
+	}
+	return v
+}
+
+// ToPendingTimerInfoArray converts thrift PendingTimerInfo type array to internal
+func ToPendingTimerInfoArray(t []*shared.PendingTimerInfo) []*types.PendingTimerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.PendingTimerInfo, len(t))
+	for i := range t {
+		v[i] = ToPendingTimerInfo(t[i])
+	}
+	return v
+}
+
+// FromWorkflowExecutionArray converts internal WorkflowExecution type array to thrift
+func FromWorkflowExecutionArray(t []*types.WorkflowExecution) []*shared.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecution(t[i])
+	}
+	return v
+}
+
+// ToWorkflowExecutionArray converts thrift WorkflowExecution type array to internal
+func ToWorkflowExecutionArray(t []*shared.WorkflowExecution) []*types.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecution(t[i])
+	}
+	return v
+}
+
 // FromHistoryEventArray converts internal HistoryEvent type array to thrift
 func FromHistoryEventArray(t []*types.HistoryEvent) []*shared.HistoryEvent {
 	if t == nil {
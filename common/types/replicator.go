@@ -320,8 +320,9 @@ func (v *GetDomainReplicationMessagesResponse) GetMessages() (o *ReplicationMess
 
 // GetReplicationMessagesRequest is an internal type (TBD...)
 type GetReplicationMessagesRequest struct {
-	Tokens      []*ReplicationToken `json:"tokens,omitempty"`
-	ClusterName string              `json:"clusterName,omitempty"`
+	Tokens          []*ReplicationToken `json:"tokens,omitempty"`
+	ClusterName     string              `json:"clusterName,omitempty"`
+	WaitForNewTasks bool                `json:"waitForNewTasks,omitempty"`
 }
 
 // GetTokens is an internal getter (TBD...)
@@ -340,6 +341,14 @@ func (v *GetReplicationMessagesRequest) GetClusterName() (o string) {
 	return
 }
 
+// GetWaitForNewTasks is an internal getter (TBD...)
+func (v *GetReplicationMessagesRequest) GetWaitForNewTasks() (o bool) {
+	if v != nil {
+		return v.WaitForNewTasks
+	}
+	return
+}
+
 // GetReplicationMessagesResponse is an internal type (TBD...)
 type GetReplicationMessagesResponse struct {
 	MessagesByShard map[int32]*ReplicationMessages `json:"messagesByShard,omitempty"`
@@ -353,6 +362,41 @@ func (v *GetReplicationMessagesResponse) GetMessagesByShard() (o map[int32]*Repl
 	return
 }
 
+// StreamReplicationMessagesRequest is an internal type (TBD...)
+type StreamReplicationMessagesRequest struct {
+	Tokens      []*ReplicationToken `json:"tokens,omitempty"`
+	ClusterName string              `json:"clusterName,omitempty"`
+}
+
+// GetTokens is an internal getter (TBD...)
+func (v *StreamReplicationMessagesRequest) GetTokens() (o []*ReplicationToken) {
+	if v != nil && v.Tokens != nil {
+		return v.Tokens
+	}
+	return
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *StreamReplicationMessagesRequest) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// StreamReplicationMessagesResponse is an internal type (TBD...)
+type StreamReplicationMessagesResponse struct {
+	MessagesByShard map[int32]*ReplicationMessages `json:"messagesByShard,omitempty"`
+}
+
+// GetMessagesByShard is an internal getter (TBD...)
+func (v *StreamReplicationMessagesResponse) GetMessagesByShard() (o map[int32]*ReplicationMessages) {
+	if v != nil && v.MessagesByShard != nil {
+		return v.MessagesByShard
+	}
+	return
+}
+
 // HistoryTaskV2Attributes is an internal type (TBD...)
 type HistoryTaskV2Attributes struct {
 	TaskID              int64                 `json:"taskId,omitempty"`
@@ -756,6 +800,9 @@ type ReplicationTaskInfo struct {
 	FirstEventID int64  `json:"firstEventID,omitempty"`
 	NextEventID  int64  `json:"nextEventID,omitempty"`
 	ScheduledID  int64  `json:"scheduledID,omitempty"`
+	// DeadReason and RedriveAttempts are only set for tasks in the dead replication DLQ
+	DeadReason      string `json:"deadReason,omitempty"`
+	RedriveAttempts int32  `json:"redriveAttempts,omitempty"`
 }
 
 // GetDomainID is an internal getter (TBD...)
@@ -830,6 +877,22 @@ func (v *ReplicationTaskInfo) GetScheduledID() (o int64) {
 	return
 }
 
+// GetDeadReason is an internal getter (TBD...)
+func (v *ReplicationTaskInfo) GetDeadReason() (o string) {
+	if v != nil {
+		return v.DeadReason
+	}
+	return
+}
+
+// GetRedriveAttempts is an internal getter (TBD...)
+func (v *ReplicationTaskInfo) GetRedriveAttempts() (o int32) {
+	if v != nil {
+		return v.RedriveAttempts
+	}
+	return
+}
+
 // ReplicationTaskType is an internal type (TBD...)
 type ReplicationTaskType int32
 
@@ -1274,6 +1274,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	Header                              *Header                 `json:"header,omitempty"`
 	Memo                                *Memo                   `json:"memo,omitempty"`
 	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
+	RetentionPeriodInDays               *int32                  `json:"retentionPeriodInDays,omitempty"`
 }
 
 // GetWorkflowType is an internal getter (TBD...)
@@ -1396,6 +1397,36 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetSearchAttributes()
 	return
 }
 
+// GetRetentionPeriodInDays is an internal getter (TBD...)
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+	return
+}
+
+// CountWorkflowExecutionsGroup is an internal type (TBD...)
+type CountWorkflowExecutionsGroup struct {
+	GroupValues []string `json:"groupValues,omitempty"`
+	Count       int64    `json:"count,omitempty"`
+}
+
+// GetGroupValues is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsGroup) GetGroupValues() (o []string) {
+	if v != nil && v.GroupValues != nil {
+		return v.GroupValues
+	}
+	return
+}
+
+// GetCount is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsGroup) GetCount() (o int64) {
+	if v != nil {
+		return v.Count
+	}
+	return
+}
+
 // CountWorkflowExecutionsRequest is an internal type (TBD...)
 type CountWorkflowExecutionsRequest struct {
 	Domain string `json:"domain,omitempty"`
@@ -1420,7 +1451,8 @@ func (v *CountWorkflowExecutionsRequest) GetQuery() (o string) {
 
 // CountWorkflowExecutionsResponse is an internal type (TBD...)
 type CountWorkflowExecutionsResponse struct {
-	Count int64 `json:"count,omitempty"`
+	Count  int64                           `json:"count,omitempty"`
+	Groups []*CountWorkflowExecutionsGroup `json:"groups,omitempty"`
 }
 
 // GetCount is an internal getter (TBD...)
@@ -1431,6 +1463,14 @@ func (v *CountWorkflowExecutionsResponse) GetCount() (o int64) {
 	return
 }
 
+// GetGroups is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsResponse) GetGroups() (o []*CountWorkflowExecutionsGroup) {
+	if v != nil && v.Groups != nil {
+		return v.Groups
+	}
+	return
+}
+
 // CurrentBranchChangedError is an internal type (TBD...)
 type CurrentBranchChangedError struct {
 	Message            string `json:"message,required"`
@@ -2263,6 +2303,82 @@ const (
 	DecisionTypeUpsertWorkflowSearchAttributes
 )
 
+// DeleteWorkflowTaskRequest is an internal type (TBD...)
+type DeleteWorkflowTaskRequest struct {
+	Domain              string             `json:"domain,omitempty"`
+	WorkflowExecution   *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Type                *int32             `json:"type,omitempty"`
+	TaskID              int64              `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64             `json:"visibilityTimestamp,omitempty"`
+	ClusterName         string             `json:"clusterName,omitempty"`
+	Reason              string             `json:"reason,omitempty"`
+	Identity            string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DeleteWorkflowTaskRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *DeleteWorkflowTaskRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetType is an internal getter (TBD...)
+func (v *DeleteWorkflowTaskRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+	return
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *DeleteWorkflowTaskRequest) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+// GetVisibilityTimestamp is an internal getter (TBD...)
+func (v *DeleteWorkflowTaskRequest) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+	return
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *DeleteWorkflowTaskRequest) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *DeleteWorkflowTaskRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *DeleteWorkflowTaskRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // DeprecateDomainRequest is an internal type (TBD...)
 type DeprecateDomainRequest struct {
 	Name          string `json:"name,omitempty"`
@@ -2365,6 +2481,41 @@ func (v *DescribeDomainResponse) GetFailoverInfo() (o *FailoverInfo) {
 	return
 }
 
+// DescribeDomainUsageRequest is an internal type (TBD...)
+type DescribeDomainUsageRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeDomainUsageRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// DescribeDomainUsageResponse is an internal type (TBD...)
+type DescribeDomainUsageResponse struct {
+	Usage          *DomainUsage `json:"usage,omitempty"`
+	LastUpdateTime *int64       `json:"lastUpdateTime,omitempty"`
+}
+
+// GetUsage is an internal getter (TBD...)
+func (v *DescribeDomainUsageResponse) GetUsage() (o *DomainUsage) {
+	if v != nil && v.Usage != nil {
+		return v.Usage
+	}
+	return
+}
+
+// GetLastUpdateTime is an internal getter (TBD...)
+func (v *DescribeDomainUsageResponse) GetLastUpdateTime() (o int64) {
+	if v != nil && v.LastUpdateTime != nil {
+		return *v.LastUpdateTime
+	}
+	return
+}
+
 // DescribeHistoryHostRequest is an internal type (TBD...)
 type DescribeHistoryHostRequest struct {
 	HostAddress      *string            `json:"hostAddress,omitempty"`
@@ -2426,11 +2577,12 @@ func (v *DescribeShardDistributionResponse) GetShards() (o map[int32]string) {
 
 // DescribeHistoryHostResponse is an internal type (TBD...)
 type DescribeHistoryHostResponse struct {
-	NumberOfShards        int32            `json:"numberOfShards,omitempty"`
-	ShardIDs              []int32          `json:"shardIDs,omitempty"`
-	DomainCache           *DomainCacheInfo `json:"domainCache,omitempty"`
-	ShardControllerStatus string           `json:"shardControllerStatus,omitempty"`
-	Address               string           `json:"address,omitempty"`
+	NumberOfShards        int32               `json:"numberOfShards,omitempty"`
+	ShardIDs              []int32             `json:"shardIDs,omitempty"`
+	DomainCache           *DomainCacheInfo    `json:"domainCache,omitempty"`
+	ShardControllerStatus string              `json:"shardControllerStatus,omitempty"`
+	Address               string              `json:"address,omitempty"`
+	ShardLoads            []*HistoryShardLoad `json:"shardLoads,omitempty"`
 }
 
 // GetNumberOfShards is an internal getter (TBD...)
@@ -2473,6 +2625,14 @@ func (v *DescribeHistoryHostResponse) GetAddress() (o string) {
 	return
 }
 
+// GetShardLoads is an internal getter (TBD...)
+func (v *DescribeHistoryHostResponse) GetShardLoads() (o []*HistoryShardLoad) {
+	if v != nil && v.ShardLoads != nil {
+		return v.ShardLoads
+	}
+	return
+}
+
 // DescribeQueueRequest is an internal type (TBD...)
 type DescribeQueueRequest struct {
 	ShardID     int32  `json:"shardID,omitempty"`
@@ -2930,6 +3090,46 @@ const (
 	DomainStatusDeleted
 )
 
+// DomainUsage is an internal type (TBD...)
+type DomainUsage struct {
+	HistorySize         int64 `json:"historySize,omitempty"`
+	HistoryEventCount   int64 `json:"historyEventCount,omitempty"`
+	OpenWorkflowCount   int64 `json:"openWorkflowCount,omitempty"`
+	ClosedWorkflowCount int64 `json:"closedWorkflowCount,omitempty"`
+}
+
+// GetHistorySize is an internal getter (TBD...)
+func (v *DomainUsage) GetHistorySize() (o int64) {
+	if v != nil {
+		return v.HistorySize
+	}
+	return
+}
+
+// GetHistoryEventCount is an internal getter (TBD...)
+func (v *DomainUsage) GetHistoryEventCount() (o int64) {
+	if v != nil {
+		return v.HistoryEventCount
+	}
+	return
+}
+
+// GetOpenWorkflowCount is an internal getter (TBD...)
+func (v *DomainUsage) GetOpenWorkflowCount() (o int64) {
+	if v != nil {
+		return v.OpenWorkflowCount
+	}
+	return
+}
+
+// GetClosedWorkflowCount is an internal getter (TBD...)
+func (v *DomainUsage) GetClosedWorkflowCount() (o int64) {
+	if v != nil {
+		return v.ClosedWorkflowCount
+	}
+	return
+}
+
 // EncodingType is an internal type (TBD...)
 type EncodingType int32
 
@@ -3121,6 +3321,10 @@ func (e EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "WorkflowExecutionSuspended"
+	case 43:
+		return "WorkflowExecutionResumed"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -3254,6 +3458,12 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "WORKFLOWEXECUTIONSUSPENDED":
+		*e = EventTypeWorkflowExecutionSuspended
+		return nil
+	case "WORKFLOWEXECUTIONRESUMED":
+		*e = EventTypeWorkflowExecutionResumed
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -3354,6 +3564,10 @@ const (
 	EventTypeExternalWorkflowExecutionSignaled
 	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
 	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeWorkflowExecutionSuspended is an option for EventType
+	EventTypeWorkflowExecutionSuspended
+	// EventTypeWorkflowExecutionResumed is an option for EventType
+	EventTypeWorkflowExecutionResumed
 )
 
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
@@ -3449,6 +3663,37 @@ func (v *FailWorkflowExecutionDecisionAttributes) GetDetails() (o []byte) {
 	return
 }
 
+// FailoverWorkflowExecutionRequest is an internal type (TBD...)
+type FailoverWorkflowExecutionRequest struct {
+	Domain        string             `json:"domain,omitempty"`
+	Execution     *WorkflowExecution `json:"execution,omitempty"`
+	TargetCluster string             `json:"targetCluster,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *FailoverWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *FailoverWorkflowExecutionRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetTargetCluster is an internal getter (TBD...)
+func (v *FailoverWorkflowExecutionRequest) GetTargetCluster() (o string) {
+	if v != nil {
+		return v.TargetCluster
+	}
+	return
+}
+
 // GetSearchAttributesResponse is an internal type (TBD...)
 type GetSearchAttributesResponse struct {
 	Keys map[string]IndexedValueType `json:"keys,omitempty"`
@@ -3755,6 +4000,8 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	WorkflowExecutionSuspendedEventAttributes                      *WorkflowExecutionSuspendedEventAttributes                      `json:"workflowExecutionSuspendedEventAttributes,omitempty"`
+	WorkflowExecutionResumedEventAttributes                        *WorkflowExecutionResumedEventAttributes                        `json:"workflowExecutionResumedEventAttributes,omitempty"`
 }
 
 // GetEventID is an internal getter (TBD...)
@@ -4133,6 +4380,22 @@ func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *Up
 	return
 }
 
+// GetWorkflowExecutionSuspendedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionSuspendedEventAttributes() (o *WorkflowExecutionSuspendedEventAttributes) {
+	if v != nil && v.WorkflowExecutionSuspendedEventAttributes != nil {
+		return v.WorkflowExecutionSuspendedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionResumedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionResumedEventAttributes() (o *WorkflowExecutionResumedEventAttributes) {
+	if v != nil && v.WorkflowExecutionResumedEventAttributes != nil {
+		return v.WorkflowExecutionResumedEventAttributes
+	}
+	return
+}
+
 // HistoryEventFilterType is an internal type (TBD...)
 type HistoryEventFilterType int32
 
@@ -4184,6 +4447,122 @@ const (
 	HistoryEventFilterTypeCloseEvent
 )
 
+// HistoryLoadEntry is an internal type (TBD...)
+type HistoryLoadEntry struct {
+	DomainID           string  `json:"domainID,omitempty"`
+	WorkflowID         string  `json:"workflowID,omitempty"`
+	RequestsPerSecond  float64 `json:"requestsPerSecond,omitempty"`
+	LockWaitsPerSecond float64 `json:"lockWaitsPerSecond,omitempty"`
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *HistoryLoadEntry) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *HistoryLoadEntry) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRequestsPerSecond is an internal getter (TBD...)
+func (v *HistoryLoadEntry) GetRequestsPerSecond() (o float64) {
+	if v != nil {
+		return v.RequestsPerSecond
+	}
+	return
+}
+
+// GetLockWaitsPerSecond is an internal getter (TBD...)
+func (v *HistoryLoadEntry) GetLockWaitsPerSecond() (o float64) {
+	if v != nil {
+		return v.LockWaitsPerSecond
+	}
+	return
+}
+
+// HistoryShardLoad is an internal type (TBD...)
+type HistoryShardLoad struct {
+	ShardID                 int32               `json:"shardID,omitempty"`
+	RequestsPerSecond       float64             `json:"requestsPerSecond,omitempty"`
+	LockWaitsPerSecond      float64             `json:"lockWaitsPerSecond,omitempty"`
+	AverageLockWaitInMillis int64               `json:"averageLockWaitInMillis,omitempty"`
+	TransferTaskLag         int64               `json:"transferTaskLag,omitempty"`
+	TimerTaskLagInMillis    int64               `json:"timerTaskLagInMillis,omitempty"`
+	TopDomains              []*HistoryLoadEntry `json:"topDomains,omitempty"`
+	TopWorkflows            []*HistoryLoadEntry `json:"topWorkflows,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *HistoryShardLoad) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetRequestsPerSecond is an internal getter (TBD...)
+func (v *HistoryShardLoad) GetRequestsPerSecond() (o float64) {
+	if v != nil {
+		return v.RequestsPerSecond
+	}
+	return
+}
+
+// GetLockWaitsPerSecond is an internal getter (TBD...)
+func (v *HistoryShardLoad) GetLockWaitsPerSecond() (o float64) {
+	if v != nil {
+		return v.LockWaitsPerSecond
+	}
+	return
+}
+
+// GetAverageLockWaitInMillis is an internal getter (TBD...)
+func (v *HistoryShardLoad) GetAverageLockWaitInMillis() (o int64) {
+	if v != nil {
+		return v.AverageLockWaitInMillis
+	}
+	return
+}
+
+// GetTransferTaskLag is an internal getter (TBD...)
+func (v *HistoryShardLoad) GetTransferTaskLag() (o int64) {
+	if v != nil {
+		return v.TransferTaskLag
+	}
+	return
+}
+
+// GetTimerTaskLagInMillis is an internal getter (TBD...)
+func (v *HistoryShardLoad) GetTimerTaskLagInMillis() (o int64) {
+	if v != nil {
+		return v.TimerTaskLagInMillis
+	}
+	return
+}
+
+// GetTopDomains is an internal getter (TBD...)
+func (v *HistoryShardLoad) GetTopDomains() (o []*HistoryLoadEntry) {
+	if v != nil && v.TopDomains != nil {
+		return v.TopDomains
+	}
+	return
+}
+
+// GetTopWorkflows is an internal getter (TBD...)
+func (v *HistoryShardLoad) GetTopWorkflows() (o []*HistoryLoadEntry) {
+	if v != nil && v.TopWorkflows != nil {
+		return v.TopWorkflows
+	}
+	return
+}
+
 // IndexedValueType is an internal type (TBD...)
 type IndexedValueType int32
 
@@ -4718,6 +5097,59 @@ func (v *ListWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
 	return
 }
 
+// ListWorkflowTasksRequest is an internal type (TBD...)
+type ListWorkflowTasksRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListWorkflowTasksRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ListWorkflowTasksRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// ListWorkflowTasksResponse is an internal type (TBD...)
+type ListWorkflowTasksResponse struct {
+	ShardID   int32               `json:"shardID,omitempty"`
+	Tasks     []*WorkflowTaskInfo `json:"tasks,omitempty"`
+	Truncated bool                `json:"truncated,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *ListWorkflowTasksResponse) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetTasks is an internal getter (TBD...)
+func (v *ListWorkflowTasksResponse) GetTasks() (o []*WorkflowTaskInfo) {
+	if v != nil && v.Tasks != nil {
+		return v.Tasks
+	}
+	return
+}
+
+// GetTruncated is an internal getter (TBD...)
+func (v *ListWorkflowTasksResponse) GetTruncated() (o bool) {
+	if v != nil {
+		return v.Truncated
+	}
+	return
+}
+
 // MarkerRecordedEventAttributes is an internal type (TBD...)
 type MarkerRecordedEventAttributes struct {
 	MarkerName                   string  `json:"markerName,omitempty"`
@@ -5996,26 +6428,102 @@ type RecordMarkerDecisionAttributes struct {
 	Header     *Header `json:"header,omitempty"`
 }
 
-// GetMarkerName is an internal getter (TBD...)
-func (v *RecordMarkerDecisionAttributes) GetMarkerName() (o string) {
+// GetMarkerName is an internal getter (TBD...)
+func (v *RecordMarkerDecisionAttributes) GetMarkerName() (o string) {
+	if v != nil {
+		return v.MarkerName
+	}
+	return
+}
+
+// GetDetails is an internal getter (TBD...)
+func (v *RecordMarkerDecisionAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+	return
+}
+
+// GetHeader is an internal getter (TBD...)
+func (v *RecordMarkerDecisionAttributes) GetHeader() (o *Header) {
+	if v != nil && v.Header != nil {
+		return v.Header
+	}
+	return
+}
+
+// ReenqueueWorkflowTaskRequest is an internal type (TBD...)
+type ReenqueueWorkflowTaskRequest struct {
+	Domain              string             `json:"domain,omitempty"`
+	WorkflowExecution   *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Type                *int32             `json:"type,omitempty"`
+	TaskID              int64              `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64             `json:"visibilityTimestamp,omitempty"`
+	ClusterName         string             `json:"clusterName,omitempty"`
+	Reason              string             `json:"reason,omitempty"`
+	Identity            string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ReenqueueWorkflowTaskRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ReenqueueWorkflowTaskRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetType is an internal getter (TBD...)
+func (v *ReenqueueWorkflowTaskRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+	return
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *ReenqueueWorkflowTaskRequest) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+// GetVisibilityTimestamp is an internal getter (TBD...)
+func (v *ReenqueueWorkflowTaskRequest) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+	return
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *ReenqueueWorkflowTaskRequest) GetClusterName() (o string) {
 	if v != nil {
-		return v.MarkerName
+		return v.ClusterName
 	}
 	return
 }
 
-// GetDetails is an internal getter (TBD...)
-func (v *RecordMarkerDecisionAttributes) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+// GetReason is an internal getter (TBD...)
+func (v *ReenqueueWorkflowTaskRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
 	}
 	return
 }
 
-// GetHeader is an internal getter (TBD...)
-func (v *RecordMarkerDecisionAttributes) GetHeader() (o *Header) {
-	if v != nil && v.Header != nil {
-		return v.Header
+// GetIdentity is an internal getter (TBD...)
+func (v *ReenqueueWorkflowTaskRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
 	return
 }
@@ -6611,6 +7119,7 @@ type ResetWorkflowExecutionRequest struct {
 	DecisionFinishEventID int64              `json:"decisionFinishEventId,omitempty"`
 	RequestID             string             `json:"requestId,omitempty"`
 	SkipSignalReapply     bool               `json:"skipSignalReapply,omitempty"`
+	DryRun                bool               `json:"dryRun,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -6661,9 +7170,18 @@ func (v *ResetWorkflowExecutionRequest) GetSkipSignalReapply() (o bool) {
 	return
 }
 
+// GetDryRun is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetDryRun() (o bool) {
+	if v != nil {
+		return v.DryRun
+	}
+	return
+}
+
 // ResetWorkflowExecutionResponse is an internal type (TBD...)
 type ResetWorkflowExecutionResponse struct {
-	RunID string `json:"runId,omitempty"`
+	RunID   string                         `json:"runId,omitempty"`
+	Preview *ResetWorkflowExecutionPreview `json:"preview,omitempty"`
 }
 
 // GetRunID is an internal getter (TBD...)
@@ -6674,6 +7192,103 @@ func (v *ResetWorkflowExecutionResponse) GetRunID() (o string) {
 	return
 }
 
+// GetPreview is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionResponse) GetPreview() (o *ResetWorkflowExecutionPreview) {
+	if v != nil && v.Preview != nil {
+		return v.Preview
+	}
+	return
+}
+
+// ResetWorkflowExecutionPreview is an internal type (TBD...)
+type ResetWorkflowExecutionPreview struct {
+	ResetPointEvent        *HistoryEvent          `json:"resetPointEvent,omitempty"`
+	DiscardedEvents        []*HistoryEvent        `json:"discardedEvents,omitempty"`
+	ReappliedSignals       []*HistoryEvent        `json:"reappliedSignals,omitempty"`
+	PendingActivities      []*PendingActivityInfo `json:"pendingActivities,omitempty"`
+	PendingTimers          []*PendingTimerInfo    `json:"pendingTimers,omitempty"`
+	OrphanedChildWorkflows []*WorkflowExecution   `json:"orphanedChildWorkflows,omitempty"`
+}
+
+// GetResetPointEvent is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionPreview) GetResetPointEvent() (o *HistoryEvent) {
+	if v != nil && v.ResetPointEvent != nil {
+		return v.ResetPointEvent
+	}
+	return
+}
+
+// GetDiscardedEvents is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionPreview) GetDiscardedEvents() (o []*HistoryEvent) {
+	if v != nil && v.DiscardedEvents != nil {
+		return v.DiscardedEvents
+	}
+	return
+}
+
+// GetReappliedSignals is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionPreview) GetReappliedSignals() (o []*HistoryEvent) {
+	if v != nil && v.ReappliedSignals != nil {
+		return v.ReappliedSignals
+	}
+	return
+}
+
+// GetPendingActivities is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionPreview) GetPendingActivities() (o []*PendingActivityInfo) {
+	if v != nil && v.PendingActivities != nil {
+		return v.PendingActivities
+	}
+	return
+}
+
+// GetPendingTimers is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionPreview) GetPendingTimers() (o []*PendingTimerInfo) {
+	if v != nil && v.PendingTimers != nil {
+		return v.PendingTimers
+	}
+	return
+}
+
+// GetOrphanedChildWorkflows is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionPreview) GetOrphanedChildWorkflows() (o []*WorkflowExecution) {
+	if v != nil && v.OrphanedChildWorkflows != nil {
+		return v.OrphanedChildWorkflows
+	}
+	return
+}
+
+// PendingTimerInfo is an internal type (TBD...)
+type PendingTimerInfo struct {
+	TimerID         string `json:"timerId,omitempty"`
+	StartedEventID  int64  `json:"startedEventId,omitempty"`
+	ExpiryTimestamp *int64 `json:"expiryTimestamp,omitempty"`
+}
+
+// GetTimerID is an internal getter (TBD...)
+func (v *PendingTimerInfo) GetTimerID() (o string) {
+	if v != nil {
+		return v.TimerID
+	}
+	return
+}
+
+// GetStartedEventID is an internal getter (TBD...)
+func (v *PendingTimerInfo) GetStartedEventID() (o int64) {
+	if v != nil {
+		return v.StartedEventID
+	}
+	return
+}
+
+// GetExpiryTimestamp is an internal getter (TBD...)
+func (v *PendingTimerInfo) GetExpiryTimestamp() (o int64) {
+	if v != nil && v.ExpiryTimestamp != nil {
+		return *v.ExpiryTimestamp
+	}
+	return
+}
+
 // RespondActivityTaskCanceledByIDRequest is an internal type (TBD...)
 type RespondActivityTaskCanceledByIDRequest struct {
 	Domain     string `json:"domain,omitempty"`
@@ -7164,6 +7779,46 @@ func (v *RespondQueryTaskCompletedRequest) GetWorkerVersionInfo() (o *WorkerVers
 	return
 }
 
+// ResumeWorkflowExecutionRequest is an internal type (TBD...)
+type ResumeWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ResumeWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ResumeWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *ResumeWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ResumeWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // RetryPolicy is an internal type (TBD...)
 type RetryPolicy struct {
 	InitialIntervalInSeconds    int32    `json:"initialIntervalInSeconds,omitempty"`
@@ -7684,6 +8339,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 	Header                              *Header                `json:"header,omitempty"`
 	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	RetentionPeriodInDays               *int32                 `json:"retentionPeriodInDays,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7838,6 +8494,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetHeader() (o *Header) {
 	return
 }
 
+// GetRetentionPeriodInDays is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+	return
+}
+
 // SignalWorkflowExecutionRequest is an internal type (TBD...)
 type SignalWorkflowExecutionRequest struct {
 	Domain            string             `json:"domain,omitempty"`
@@ -8330,6 +8994,7 @@ type StartWorkflowExecutionRequest struct {
 	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 	Header                              *Header                `json:"header,omitempty"`
 	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	RetentionPeriodInDays               *int32                 `json:"retentionPeriodInDays,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -8460,6 +9125,14 @@ func (v *StartWorkflowExecutionRequest) GetHeader() (o *Header) {
 	return
 }
 
+// GetRetentionPeriodInDays is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+	return
+}
+
 // StartWorkflowExecutionResponse is an internal type (TBD...)
 type StartWorkflowExecutionResponse struct {
 	RunID string `json:"runId,omitempty"`
@@ -8499,6 +9172,7 @@ func (v *StickyExecutionAttributes) GetScheduleToStartTimeoutSeconds() (o int32)
 type SupportedClientVersions struct {
 	GoSdk   string `json:"goSdk,omitempty"`
 	JavaSdk string `json:"javaSdk,omitempty"`
+	Cli     string `json:"cli,omitempty"`
 }
 
 // GetGoSdk is an internal getter (TBD...)
@@ -8517,6 +9191,54 @@ func (v *SupportedClientVersions) GetJavaSdk() (o string) {
 	return
 }
 
+// GetCli is an internal getter (TBD...)
+func (v *SupportedClientVersions) GetCli() (o string) {
+	if v != nil {
+		return v.Cli
+	}
+	return
+}
+
+// SuspendWorkflowExecutionRequest is an internal type (TBD...)
+type SuspendWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *SuspendWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *SuspendWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *SuspendWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *SuspendWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // TaskIDBlock is an internal type (TBD...)
 type TaskIDBlock struct {
 	StartID int64 `json:"startID,omitempty"`
@@ -9437,6 +10159,7 @@ type WorkflowExecutionConfiguration struct {
 	TaskList                            *TaskList `json:"taskList,omitempty"`
 	ExecutionStartToCloseTimeoutSeconds *int32    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
 	TaskStartToCloseTimeoutSeconds      *int32    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	RetentionPeriodInDays               *int32    `json:"retentionPeriodInDays,omitempty"`
 }
 
 // GetTaskList is an internal getter (TBD...)
@@ -9463,6 +10186,14 @@ func (v *WorkflowExecutionConfiguration) GetTaskStartToCloseTimeoutSeconds() (o
 	return
 }
 
+// GetRetentionPeriodInDays is an internal getter (TBD...)
+func (v *WorkflowExecutionConfiguration) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+	return
+}
+
 // WorkflowExecutionContinuedAsNewEventAttributes is an internal type (TBD...)
 type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	NewExecutionRunID                   string                  `json:"newExecutionRunId,omitempty"`
@@ -9787,6 +10518,28 @@ func (v *WorkflowExecutionInfo) GetIsCron() (o bool) {
 	return
 }
 
+// WorkflowExecutionResumedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionResumedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionResumedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionResumedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName string `json:"signalName,omitempty"`
@@ -9846,6 +10599,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
 	PrevAutoResetPoints                 *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
 	Header                              *Header                 `json:"header,omitempty"`
+	RetentionPeriodInDays               *int32                  `json:"retentionPeriodInDays,omitempty"`
 }
 
 // GetWorkflowType is an internal getter (TBD...)
@@ -10049,6 +10803,36 @@ func (v *WorkflowExecutionStartedEventAttributes) GetHeader() (o *Header) {
 	return
 }
 
+// GetRetentionPeriodInDays is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+	return
+}
+
+// WorkflowExecutionSuspendedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionSuspendedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionSuspendedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionSuspendedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // WorkflowExecutionTerminatedEventAttributes is an internal type (TBD...)
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   string `json:"reason,omitempty"`
@@ -10211,6 +10995,73 @@ func (v *WorkflowQueryResult) GetErrorMessage() (o string) {
 	return
 }
 
+// WorkflowTaskInfo is an internal type (TBD...)
+type WorkflowTaskInfo struct {
+	Type                *int32 `json:"type,omitempty"`
+	SubType             int32  `json:"subType,omitempty"`
+	TaskID              int64  `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64 `json:"visibilityTimestamp,omitempty"`
+	Version             int64  `json:"version,omitempty"`
+	Attempt             int32  `json:"attempt,omitempty"`
+	ClusterName         string `json:"clusterName,omitempty"`
+}
+
+// GetType is an internal getter (TBD...)
+func (v *WorkflowTaskInfo) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+	return
+}
+
+// GetSubType is an internal getter (TBD...)
+func (v *WorkflowTaskInfo) GetSubType() (o int32) {
+	if v != nil {
+		return v.SubType
+	}
+	return
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *WorkflowTaskInfo) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+// GetVisibilityTimestamp is an internal getter (TBD...)
+func (v *WorkflowTaskInfo) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+	return
+}
+
+// GetVersion is an internal getter (TBD...)
+func (v *WorkflowTaskInfo) GetVersion() (o int64) {
+	if v != nil {
+		return v.Version
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *WorkflowTaskInfo) GetAttempt() (o int32) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *WorkflowTaskInfo) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
 // WorkflowType is an internal type (TBD...)
 type WorkflowType struct {
 	Name string `json:"name,omitempty"`
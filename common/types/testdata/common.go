@@ -242,6 +242,7 @@ var (
 	SupportedClientVersions = types.SupportedClientVersions{
 		GoSdk:   "GoSDK",
 		JavaSdk: "JavaSDK",
+		Cli:     "CLI",
 	}
 	IndexedValueTypeMap = map[string]types.IndexedValueType{
 		"IndexedValueType1": IndexedValueType,
@@ -278,6 +279,22 @@ var (
 		NumOfItemsInCacheByID:   int64(2000),
 		NumOfItemsInCacheByName: int64(3000),
 	}
+	HistoryLoadEntry = types.HistoryLoadEntry{
+		DomainID:           DomainID,
+		WorkflowID:         WorkflowID,
+		RequestsPerSecond:  12.5,
+		LockWaitsPerSecond: 2.5,
+	}
+	HistoryShardLoad = types.HistoryShardLoad{
+		ShardID:                 ShardID,
+		RequestsPerSecond:       25,
+		LockWaitsPerSecond:      5,
+		AverageLockWaitInMillis: 15,
+		TransferTaskLag:         100,
+		TimerTaskLagInMillis:    2000,
+		TopDomains:              []*types.HistoryLoadEntry{{DomainID: DomainID, RequestsPerSecond: 25, LockWaitsPerSecond: 5}},
+		TopWorkflows:            []*types.HistoryLoadEntry{&HistoryLoadEntry},
+	}
 	VersionHistoryItem = types.VersionHistoryItem{
 		EventID: EventID1,
 		Version: Version1,
@@ -359,6 +376,7 @@ var (
 		TaskList:                            &TaskList,
 		ExecutionStartToCloseTimeoutSeconds: &Duration1,
 		TaskStartToCloseTimeoutSeconds:      &Duration2,
+		RetentionPeriodInDays:               common.Int32Ptr(DomainRetention),
 	}
 	PendingActivityInfo = types.PendingActivityInfo{
 		ActivityID:             ActivityID,
@@ -378,6 +396,14 @@ var (
 	PendingActivityInfoArray = []*types.PendingActivityInfo{
 		&PendingActivityInfo,
 	}
+	PendingTimerInfo = types.PendingTimerInfo{
+		TimerID:         TimerID,
+		StartedEventID:  EventID1,
+		ExpiryTimestamp: &Timestamp1,
+	}
+	PendingTimerInfoArray = []*types.PendingTimerInfo{
+		&PendingTimerInfo,
+	}
 	PendingChildExecutionInfo = types.PendingChildExecutionInfo{
 		WorkflowID:        WorkflowID,
 		RunID:             RunID,
@@ -120,6 +120,7 @@ var (
 		Header:                              &Header,
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
+		RetentionPeriodInDays:               common.Int32Ptr(DomainRetention),
 	}
 	FailWorkflowExecutionDecisionAttributes = types.FailWorkflowExecutionDecisionAttributes{
 		Reason:  &FailureReason,
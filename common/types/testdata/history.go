@@ -75,6 +75,8 @@ var (
 		&HistoryEvent_SignalExternalWorkflowExecutionFailed,
 		&HistoryEvent_ExternalWorkflowExecutionSignaled,
 		&HistoryEvent_UpsertWorkflowSearchAttributes,
+		&HistoryEvent_WorkflowExecutionSuspended,
+		&HistoryEvent_WorkflowExecutionResumed,
 	}
 
 	HistoryEvent_WorkflowExecutionStarted = generateEvent(func(e *types.HistoryEvent) {
@@ -245,6 +247,14 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	HistoryEvent_WorkflowExecutionSuspended = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionSuspended.Ptr()
+		e.WorkflowExecutionSuspendedEventAttributes = &WorkflowExecutionSuspendedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionResumed = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionResumed.Ptr()
+		e.WorkflowExecutionResumedEventAttributes = &WorkflowExecutionResumedEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -273,6 +283,7 @@ var (
 		SearchAttributes:                    &SearchAttributes,
 		PrevAutoResetPoints:                 &ResetPoints,
 		Header:                              &Header,
+		RetentionPeriodInDays:               common.Int32Ptr(DomainRetention),
 	}
 	WorkflowExecutionCompletedEventAttributes = types.WorkflowExecutionCompletedEventAttributes{
 		Result:                       Payload1,
@@ -421,6 +432,14 @@ var (
 		Details:  Payload1,
 		Identity: Identity,
 	}
+	WorkflowExecutionSuspendedEventAttributes = types.WorkflowExecutionSuspendedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
+	WorkflowExecutionResumedEventAttributes = types.WorkflowExecutionResumedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
 	WorkflowExecutionCancelRequestedEventAttributes = types.WorkflowExecutionCancelRequestedEventAttributes{
 		Cause:                     Cause,
 		ExternalInitiatedEventID:  common.Int64Ptr(EventID1),
@@ -46,15 +46,17 @@ var (
 		&ReplicationToken,
 	}
 	ReplicationTaskInfo = types.ReplicationTaskInfo{
-		DomainID:     DomainID,
-		WorkflowID:   WorkflowID,
-		RunID:        RunID,
-		TaskType:     TaskType,
-		TaskID:       TaskID,
-		Version:      Version1,
-		FirstEventID: EventID1,
-		NextEventID:  EventID2,
-		ScheduledID:  EventID3,
+		DomainID:        DomainID,
+		WorkflowID:      WorkflowID,
+		RunID:           RunID,
+		TaskType:        TaskType,
+		TaskID:          TaskID,
+		Version:         Version1,
+		FirstEventID:    EventID1,
+		NextEventID:     EventID2,
+		ScheduledID:     EventID3,
+		DeadReason:      Reason,
+		RedriveAttempts: Attempt,
 	}
 	ReplicationTaskInfoArray = []*types.ReplicationTaskInfo{
 		&ReplicationTaskInfo,
@@ -34,6 +34,15 @@ var (
 		SearchAttribute: IndexedValueTypeMap,
 		SecurityToken:   SecurityToken,
 	}
+	AdminRemoveSearchAttributeRequest = types.RemoveSearchAttributeRequest{
+		SearchAttribute: []string{"CustomKeywordField"},
+		SecurityToken:   SecurityToken,
+	}
+	AdminRenameSearchAttributeRequest = types.RenameSearchAttributeRequest{
+		OldName:       "CustomKeywordField",
+		NewName:       "CustomKeywordField2",
+		SecurityToken: SecurityToken,
+	}
 	AdminCloseShardRequest = types.CloseShardRequest{
 		ShardID: ShardID,
 	}
@@ -56,6 +65,7 @@ var (
 		DomainCache:           &DomainCacheInfo,
 		ShardControllerStatus: "ShardControllerStatus",
 		Address:               HostName,
+		ShardLoads:            []*types.HistoryShardLoad{&HistoryShardLoad},
 	}
 	AdminDescribeQueueRequest = types.DescribeQueueRequest{
 		ShardID:     ShardID,
@@ -96,6 +106,13 @@ var (
 	AdminGetReplicationMessagesResponse = types.GetReplicationMessagesResponse{
 		MessagesByShard: ReplicationMessagesMap,
 	}
+	AdminStreamReplicationMessagesRequest = types.StreamReplicationMessagesRequest{
+		Tokens:      ReplicationTokenArray,
+		ClusterName: ClusterName1,
+	}
+	AdminStreamReplicationMessagesResponse = types.StreamReplicationMessagesResponse{
+		MessagesByShard: ReplicationMessagesMap,
+	}
 	AdminGetWorkflowExecutionRawHistoryV2Request = types.GetWorkflowExecutionRawHistoryV2Request{
 		Domain:            DomainName,
 		Execution:         &WorkflowExecution,
@@ -151,6 +168,73 @@ var (
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
 	}
+	AdminFailoverWorkflowExecutionRequest = types.FailoverWorkflowExecutionRequest{
+		Domain:        DomainName,
+		Execution:     &WorkflowExecution,
+		TargetCluster: ClusterName1,
+	}
+	AdminSuspendWorkflowExecutionRequest = types.SuspendWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+	}
+	AdminResumeWorkflowExecutionRequest = types.ResumeWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+	}
+	AdminListWorkflowTasksRequest = types.ListWorkflowTasksRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+	}
+	AdminListWorkflowTasksResponse = types.ListWorkflowTasksResponse{
+		ShardID:   ShardID,
+		Tasks:     []*types.WorkflowTaskInfo{&WorkflowTaskInfo},
+		Truncated: true,
+	}
+	AdminDeleteWorkflowTaskRequest = types.DeleteWorkflowTaskRequest{
+		Domain:              DomainName,
+		WorkflowExecution:   &WorkflowExecution,
+		Type:                common.Int32Ptr(QueueType),
+		TaskID:              TaskID,
+		VisibilityTimestamp: &Timestamp1,
+		ClusterName:         ClusterName1,
+		Reason:              Reason,
+		Identity:            Identity,
+	}
+	AdminReenqueueWorkflowTaskRequest = types.ReenqueueWorkflowTaskRequest{
+		Domain:              DomainName,
+		WorkflowExecution:   &WorkflowExecution,
+		Type:                common.Int32Ptr(QueueType),
+		TaskID:              TaskID,
+		VisibilityTimestamp: &Timestamp1,
+		ClusterName:         ClusterName1,
+		Reason:              Reason,
+		Identity:            Identity,
+	}
+	AdminDescribeDomainUsageRequest = types.DescribeDomainUsageRequest{
+		Domain: DomainName,
+	}
+	AdminDescribeDomainUsageResponse = types.DescribeDomainUsageResponse{
+		Usage: &types.DomainUsage{
+			HistorySize:         1024,
+			HistoryEventCount:   EventID3,
+			OpenWorkflowCount:   1,
+			ClosedWorkflowCount: 2,
+		},
+		LastUpdateTime: &Timestamp1,
+	}
+	WorkflowTaskInfo = types.WorkflowTaskInfo{
+		Type:                common.Int32Ptr(QueueType),
+		SubType:             1,
+		TaskID:              TaskID,
+		VisibilityTimestamp: &Timestamp1,
+		Version:             Version1,
+		Attempt:             Attempt,
+		ClusterName:         ClusterName1,
+	}
 	AdminRemoveTaskRequest = types.RemoveTaskRequest{
 		ShardID:             ShardID,
 		Type:                common.Int32Ptr(QueueType),
@@ -158,6 +242,11 @@ var (
 		VisibilityTimestamp: &Timestamp1,
 		ClusterName:         ClusterName1,
 	}
+	AdminPromoteDomainRequest = types.PromoteDomainRequest{
+		Domain:   DomainName,
+		Clusters: ClusterReplicationConfigurationArray,
+	}
+	AdminPromoteDomainResponse         = UpdateDomainResponse
 	AdminResendReplicationTasksRequest = types.ResendReplicationTasksRequest{
 		DomainID:      DomainID,
 		WorkflowID:    WorkflowID,
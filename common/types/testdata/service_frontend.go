@@ -160,6 +160,10 @@ var (
 	}
 	CountWorkflowExecutionsResponse = types.CountWorkflowExecutionsResponse{
 		Count: int64(8),
+		Groups: []*types.CountWorkflowExecutionsGroup{
+			{GroupValues: []string{WorkflowTypeName, "0"}, Count: int64(5)},
+			{GroupValues: []string{WorkflowTypeName, "1"}, Count: int64(3)},
+		},
 	}
 	GetSearchAttributesResponse = types.GetSearchAttributesResponse{
 		Keys: IndexedValueTypeMap,
@@ -319,6 +323,7 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		RetentionPeriodInDays:               common.Int32Ptr(DomainRetention),
 	}
 	StartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -351,6 +356,7 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		RetentionPeriodInDays:               common.Int32Ptr(DomainRetention),
 	}
 	ResetWorkflowExecutionRequest = types.ResetWorkflowExecutionRequest{
 		Domain:                DomainName,
@@ -359,9 +365,19 @@ var (
 		DecisionFinishEventID: EventID1,
 		RequestID:             RequestID,
 		SkipSignalReapply:     true,
+		DryRun:                true,
 	}
 	ResetWorkflowExecutionResponse = types.ResetWorkflowExecutionResponse{
-		RunID: RunID,
+		RunID:   RunID,
+		Preview: &ResetWorkflowExecutionPreview,
+	}
+	ResetWorkflowExecutionPreview = types.ResetWorkflowExecutionPreview{
+		ResetPointEvent:        &HistoryEvent_DecisionTaskCompleted,
+		DiscardedEvents:        []*types.HistoryEvent{&HistoryEvent_DecisionTaskCompleted, &HistoryEvent_WorkflowExecutionSignaled},
+		ReappliedSignals:       []*types.HistoryEvent{&HistoryEvent_WorkflowExecutionSignaled},
+		PendingActivities:      PendingActivityInfoArray,
+		PendingTimers:          PendingTimerInfoArray,
+		OrphanedChildWorkflows: []*types.WorkflowExecution{&WorkflowExecution},
 	}
 	TerminateWorkflowExecutionRequest = types.TerminateWorkflowExecutionRequest{
 		Domain:            DomainName,
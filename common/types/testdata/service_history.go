@@ -73,7 +73,11 @@ var (
 		VersionHistories:                     &VersionHistories,
 		IsStickyTaskListEnabled:              true,
 	}
-	HistoryGetReplicationMessagesRequest  = AdminGetReplicationMessagesRequest
+	HistoryGetReplicationMessagesRequest = types.GetReplicationMessagesRequest{
+		Tokens:          ReplicationTokenArray,
+		ClusterName:     ClusterName1,
+		WaitForNewTasks: true,
+	}
 	HistoryGetReplicationMessagesResponse = AdminGetReplicationMessagesResponse
 	HistoryMergeDLQMessagesRequest        = AdminMergeDLQMessagesRequest
 	HistoryMergeDLQMessagesResponse       = AdminMergeDLQMessagesResponse
@@ -174,6 +178,42 @@ var (
 		DomainUIID: DomainID,
 		Request:    &AdminRefreshWorkflowTasksRequest,
 	}
+	HistoryFailoverWorkflowExecutionRequest = types.HistoryFailoverWorkflowExecutionRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminFailoverWorkflowExecutionRequest,
+	}
+	HistorySuspendWorkflowExecutionRequest = types.HistorySuspendWorkflowExecutionRequest{
+		DomainUUID:     DomainID,
+		SuspendRequest: &AdminSuspendWorkflowExecutionRequest,
+	}
+	HistoryResumeWorkflowExecutionRequest = types.HistoryResumeWorkflowExecutionRequest{
+		DomainUUID:    DomainID,
+		ResumeRequest: &AdminResumeWorkflowExecutionRequest,
+	}
+	HistoryListWorkflowTasksRequest = types.HistoryListWorkflowTasksRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminListWorkflowTasksRequest,
+	}
+	HistoryDeleteWorkflowTaskRequest = types.HistoryDeleteWorkflowTaskRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminDeleteWorkflowTaskRequest,
+	}
+	HistoryReenqueueWorkflowTaskRequest = types.HistoryReenqueueWorkflowTaskRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminReenqueueWorkflowTaskRequest,
+	}
+	HistoryRebuildMutableStateRequest = types.HistoryRebuildMutableStateRequest{
+		DomainUUID: DomainID,
+		Execution:  &WorkflowExecution,
+		DryRun:     true,
+	}
+	HistoryRebuildMutableStateResponse = types.RebuildMutableStateResponse{
+		Mismatches: []string{"NextEventID: stored 10, rebuilt 12"},
+	}
+	HistoryReindexWorkflowExecutionRequest = types.HistoryReindexWorkflowExecutionRequest{
+		DomainUUID: DomainID,
+		Execution:  &WorkflowExecution,
+	}
 	HistoryRemoveSignalMutableStateRequest = types.RemoveSignalMutableStateRequest{
 		DomainUUID:        DomainID,
 		WorkflowExecution: &WorkflowExecution,
@@ -204,7 +244,8 @@ var (
 		ResetRequest: &ResetWorkflowExecutionRequest,
 	}
 	HistoryResetWorkflowExecutionResponse = types.ResetWorkflowExecutionResponse{
-		RunID: RunID,
+		RunID:   RunID,
+		Preview: &ResetWorkflowExecutionPreview,
 	}
 	HistoryRespondActivityTaskCanceledRequest = types.HistoryRespondActivityTaskCanceledRequest{
 		DomainUUID:    DomainID,
@@ -311,6 +311,11 @@ func DomainIDToHistoryShard(domainID string, numberOfShards int) int {
 	return int(hash % uint32(numberOfShards))
 }
 
+// IsActiveActiveDomainData returns whether the given domain data marks the domain as active-active
+func IsActiveActiveDomainData(domainData map[string]string) bool {
+	return strings.ToLower(strings.TrimSpace(domainData[DomainDataKeyForActiveActive])) == "true"
+}
+
 // PrettyPrintHistory prints history in human readable format
 func PrettyPrintHistory(history *types.History, logger log.Logger) {
 	data, err := json.MarshalIndent(history, "", "    ")
@@ -497,6 +502,19 @@ func ValidateRetryPolicy(policy *types.RetryPolicy) error {
 	return nil
 }
 
+// ValidateRetentionOverride validates a per-workflow retention override against the allowed range
+func ValidateRetentionOverride(retentionDays *int32, minDays int32, maxDays int32) error {
+	if retentionDays == nil {
+		// nil override is valid which means the domain retention is used
+		return nil
+	}
+	if *retentionDays < minDays || *retentionDays > maxDays {
+		return &types.BadRequestError{Message: fmt.Sprintf(
+			"RetentionPeriodInDays must be between %v and %v days.", minDays, maxDays)}
+	}
+	return nil
+}
+
 // CreateHistoryStartWorkflowRequest create a start workflow request for history
 func CreateHistoryStartWorkflowRequest(
 	domainID string,
@@ -766,6 +784,13 @@ func IsJustOrderByClause(clause string) bool {
 	return strings.HasPrefix(whereClause, "order by")
 }
 
+// IsJustGroupByClause return true is query start with group by
+func IsJustGroupByClause(clause string) bool {
+	whereClause := strings.TrimSpace(clause)
+	whereClause = strings.ToLower(whereClause)
+	return strings.HasPrefix(whereClause, "group by")
+}
+
 // ConvertIndexedValueTypeToThriftType takes fieldType as interface{} and convert to IndexedValueType.
 // Because different implementation of dynamic config client may lead to different types
 func ConvertIndexedValueTypeToThriftType(fieldType interface{}, logger log.Logger) workflow.IndexedValueType {
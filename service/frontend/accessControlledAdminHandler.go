@@ -70,6 +70,38 @@ func (a *AccessControlledWorkflowAdminHandler) AddSearchAttribute(ctx context.Co
 	return a.AdminHandler.AddSearchAttribute(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) RemoveSearchAttribute(ctx context.Context, request *types.RemoveSearchAttributeRequest) error {
+	attr := &authorization.Attributes{
+		APIName:    "RemoveSearchAttribute",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.RemoveSearchAttribute(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) RenameSearchAttribute(ctx context.Context, request *types.RenameSearchAttributeRequest) error {
+	attr := &authorization.Attributes{
+		APIName:    "RenameSearchAttribute",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.RenameSearchAttribute(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) CloseShard(ctx context.Context, request *types.CloseShardRequest) error {
 	attr := &authorization.Attributes{
 		APIName:    "CloseShard",
@@ -214,6 +246,22 @@ func (a *AccessControlledWorkflowAdminHandler) GetReplicationMessages(ctx contex
 	return a.AdminHandler.GetReplicationMessages(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) StreamReplicationMessages(stream ReplicationMessagesServerStream) error {
+	attr := &authorization.Attributes{
+		APIName:    "StreamReplicationMessages",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(stream.Context(), attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.StreamReplicationMessages(stream)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *types.GetWorkflowExecutionRawHistoryV2Request) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 	attr := &authorization.Attributes{
 		APIName:    "GetWorkflowExecutionRawHistoryV2",
@@ -310,6 +358,118 @@ func (a *AccessControlledWorkflowAdminHandler) RefreshWorkflowTasks(ctx context.
 	return a.AdminHandler.RefreshWorkflowTasks(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) FailoverWorkflowExecution(ctx context.Context, request *types.FailoverWorkflowExecutionRequest) error {
+	attr := &authorization.Attributes{
+		APIName:    "FailoverWorkflowExecution",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.FailoverWorkflowExecution(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) SuspendWorkflowExecution(ctx context.Context, request *types.SuspendWorkflowExecutionRequest) error {
+	attr := &authorization.Attributes{
+		APIName:    "SuspendWorkflowExecution",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.SuspendWorkflowExecution(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) ResumeWorkflowExecution(ctx context.Context, request *types.ResumeWorkflowExecutionRequest) error {
+	attr := &authorization.Attributes{
+		APIName:    "ResumeWorkflowExecution",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.ResumeWorkflowExecution(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) ListWorkflowTasks(ctx context.Context, request *types.ListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "ListWorkflowTasks",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.ListWorkflowTasks(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) DeleteWorkflowTask(ctx context.Context, request *types.DeleteWorkflowTaskRequest) error {
+	attr := &authorization.Attributes{
+		APIName:    "DeleteWorkflowTask",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.DeleteWorkflowTask(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) ReenqueueWorkflowTask(ctx context.Context, request *types.ReenqueueWorkflowTaskRequest) error {
+	attr := &authorization.Attributes{
+		APIName:    "ReenqueueWorkflowTask",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.ReenqueueWorkflowTask(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) DescribeDomainUsage(ctx context.Context, request *types.DescribeDomainUsageRequest) (*types.DescribeDomainUsageResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "DescribeDomainUsage",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.DescribeDomainUsage(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) RemoveTask(ctx context.Context, request *types.RemoveTaskRequest) error {
 	attr := &authorization.Attributes{
 		APIName:    "RemoveTask",
@@ -438,10 +598,30 @@ func (a *AccessControlledWorkflowAdminHandler) ListDynamicConfig(ctx context.Con
 	return a.AdminHandler.ListDynamicConfig(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) PromoteDomain(ctx context.Context, request *types.PromoteDomainRequest) (*types.UpdateDomainResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "PromoteDomain",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.PromoteDomain(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
 ) (bool, error) {
+	if attr.Actor == "" {
+		attr.Actor = authorization.GetPeerIdentity(ctx)
+	}
 	result, err := a.authorizer.Authorize(ctx, attr)
 	if err != nil {
 		return false, err
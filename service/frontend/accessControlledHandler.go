@@ -801,6 +801,9 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	sw := scope.StartTimer(metrics.CadenceAuthorizationLatency)
 	defer sw.Stop()
 
+	if attr.Actor == "" {
+		attr.Actor = authorization.GetPeerIdentity(ctx)
+	}
 	result, err := a.authorizer.Authorize(ctx, attr)
 	if err != nil {
 		scope.IncCounter(metrics.CadenceErrAuthorizeFailedCounter)
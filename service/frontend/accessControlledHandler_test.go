@@ -22,12 +22,18 @@ package frontend
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
+	"net"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/config"
@@ -85,6 +91,25 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized() {
 	s.NoError(err)
 }
 
+func (s *accessControlledHandlerSuite) TestIsAuthorized_PeerIdentity() {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)},
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "worker"}}}},
+		}},
+	})
+	attr := &authorization.Attributes{}
+
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Once()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, &authorization.Attributes{Actor: "worker"}).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+
+	res, err := s.handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+}
+
 func (s *accessControlledHandlerSuite) TestIsAuthorized_Failed() {
 	ctx := context.Background()
 	attr := &authorization.Attributes{}
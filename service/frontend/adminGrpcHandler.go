@@ -26,6 +26,7 @@ import (
 	"go.uber.org/yarpc"
 
 	adminv1 "github.com/uber/cadence/.gen/proto/admin/v1"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
@@ -46,6 +47,16 @@ func (g adminGRPCHandler) AddSearchAttribute(ctx context.Context, request *admin
 	return &adminv1.AddSearchAttributeResponse{}, proto.FromError(err)
 }
 
+func (g adminGRPCHandler) RemoveSearchAttribute(ctx context.Context, request *adminv1.RemoveSearchAttributeRequest) (*adminv1.RemoveSearchAttributeResponse, error) {
+	err := g.h.RemoveSearchAttribute(ctx, proto.ToAdminRemoveSearchAttributeRequest(request))
+	return &adminv1.RemoveSearchAttributeResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) RenameSearchAttribute(ctx context.Context, request *adminv1.RenameSearchAttributeRequest) (*adminv1.RenameSearchAttributeResponse, error) {
+	err := g.h.RenameSearchAttribute(ctx, proto.ToAdminRenameSearchAttributeRequest(request))
+	return &adminv1.RenameSearchAttributeResponse{}, proto.FromError(err)
+}
+
 func (g adminGRPCHandler) CloseShard(ctx context.Context, request *adminv1.CloseShardRequest) (*adminv1.CloseShardResponse, error) {
 	err := g.h.CloseShard(ctx, proto.ToAdminCloseShardRequest(request))
 	return &adminv1.CloseShardResponse{}, proto.FromError(err)
@@ -91,6 +102,11 @@ func (g adminGRPCHandler) GetReplicationMessages(ctx context.Context, request *a
 	return proto.FromAdminGetReplicationMessagesResponse(response), proto.FromError(err)
 }
 
+func (g adminGRPCHandler) StreamReplicationMessages(stream adminv1.AdminAPIServiceStreamReplicationMessagesYARPCServer) error {
+	err := g.h.StreamReplicationMessages(grpcReplicationMessagesServerStream{stream})
+	return proto.FromError(err)
+}
+
 func (g adminGRPCHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *adminv1.GetWorkflowExecutionRawHistoryV2Request) (*adminv1.GetWorkflowExecutionRawHistoryV2Response, error) {
 	response, err := g.h.GetWorkflowExecutionRawHistoryV2(ctx, proto.ToAdminGetWorkflowExecutionRawHistoryV2Request(request))
 	return proto.FromAdminGetWorkflowExecutionRawHistoryV2Response(response), proto.FromError(err)
@@ -121,6 +137,41 @@ func (g adminGRPCHandler) RefreshWorkflowTasks(ctx context.Context, request *adm
 	return &adminv1.RefreshWorkflowTasksResponse{}, proto.FromError(err)
 }
 
+func (g adminGRPCHandler) FailoverWorkflowExecution(ctx context.Context, request *adminv1.FailoverWorkflowExecutionRequest) (*adminv1.FailoverWorkflowExecutionResponse, error) {
+	err := g.h.FailoverWorkflowExecution(ctx, proto.ToAdminFailoverWorkflowExecutionRequest(request))
+	return &adminv1.FailoverWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) SuspendWorkflowExecution(ctx context.Context, request *adminv1.SuspendWorkflowExecutionRequest) (*adminv1.SuspendWorkflowExecutionResponse, error) {
+	err := g.h.SuspendWorkflowExecution(ctx, proto.ToAdminSuspendWorkflowExecutionRequest(request))
+	return &adminv1.SuspendWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ResumeWorkflowExecution(ctx context.Context, request *adminv1.ResumeWorkflowExecutionRequest) (*adminv1.ResumeWorkflowExecutionResponse, error) {
+	err := g.h.ResumeWorkflowExecution(ctx, proto.ToAdminResumeWorkflowExecutionRequest(request))
+	return &adminv1.ResumeWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ListWorkflowTasks(ctx context.Context, request *adminv1.ListWorkflowTasksRequest) (*adminv1.ListWorkflowTasksResponse, error) {
+	response, err := g.h.ListWorkflowTasks(ctx, proto.ToAdminListWorkflowTasksRequest(request))
+	return proto.FromAdminListWorkflowTasksResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) DeleteWorkflowTask(ctx context.Context, request *adminv1.DeleteWorkflowTaskRequest) (*adminv1.DeleteWorkflowTaskResponse, error) {
+	err := g.h.DeleteWorkflowTask(ctx, proto.ToAdminDeleteWorkflowTaskRequest(request))
+	return &adminv1.DeleteWorkflowTaskResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ReenqueueWorkflowTask(ctx context.Context, request *adminv1.ReenqueueWorkflowTaskRequest) (*adminv1.ReenqueueWorkflowTaskResponse, error) {
+	err := g.h.ReenqueueWorkflowTask(ctx, proto.ToAdminReenqueueWorkflowTaskRequest(request))
+	return &adminv1.ReenqueueWorkflowTaskResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) DescribeDomainUsage(ctx context.Context, request *adminv1.DescribeDomainUsageRequest) (*adminv1.DescribeDomainUsageResponse, error) {
+	response, err := g.h.DescribeDomainUsage(ctx, proto.ToAdminDescribeDomainUsageRequest(request))
+	return proto.FromAdminDescribeDomainUsageResponse(response), proto.FromError(err)
+}
+
 func (g adminGRPCHandler) RemoveTask(ctx context.Context, request *adminv1.RemoveTaskRequest) (*adminv1.RemoveTaskResponse, error) {
 	err := g.h.RemoveTask(ctx, proto.ToAdminRemoveTaskRequest(request))
 	return &adminv1.RemoveTaskResponse{}, proto.FromError(err)
@@ -165,3 +216,28 @@ func (g adminGRPCHandler) ListDynamicConfig(ctx context.Context, request *adminv
 	response, err := g.h.ListDynamicConfig(ctx, proto.ToListDynamicConfigRequest(request))
 	return proto.FromListDynamicConfigResponse(response), proto.FromError(err)
 }
+
+func (g adminGRPCHandler) PromoteDomain(ctx context.Context, request *adminv1.PromoteDomainRequest) (*adminv1.PromoteDomainResponse, error) {
+	response, err := g.h.PromoteDomain(ctx, proto.ToAdminPromoteDomainRequest(request))
+	return proto.FromAdminPromoteDomainResponse(response), proto.FromError(err)
+}
+
+type grpcReplicationMessagesServerStream struct {
+	s adminv1.AdminAPIServiceStreamReplicationMessagesYARPCServer
+}
+
+func (g grpcReplicationMessagesServerStream) Context() context.Context {
+	return g.s.Context()
+}
+
+func (g grpcReplicationMessagesServerStream) Recv() (*types.StreamReplicationMessagesRequest, error) {
+	request, err := g.s.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return proto.ToAdminStreamReplicationMessagesRequest(request), nil
+}
+
+func (g grpcReplicationMessagesServerStream) Send(response *types.StreamReplicationMessagesResponse) error {
+	return g.s.Send(proto.FromAdminStreamReplicationMessagesResponse(response))
+}
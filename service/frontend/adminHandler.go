@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/domainusage"
 	"github.com/uber/cadence/common/dynamicconfig"
 	dc "github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/elasticsearch"
@@ -59,6 +60,8 @@ const (
 var (
 	errMaxMessageIDNotSet = &types.BadRequestError{Message: "Max messageID is not set."}
 	errInvalidFilters     = &types.BadRequestError{Message: "Request Filters are invalid, unable to parse."}
+
+	errDomainNotActiveActive = &types.BadRequestError{Message: "Domain is not an active-active domain."}
 )
 
 type (
@@ -67,6 +70,8 @@ type (
 		common.Daemon
 
 		AddSearchAttribute(context.Context, *types.AddSearchAttributeRequest) error
+		RemoveSearchAttribute(context.Context, *types.RemoveSearchAttributeRequest) error
+		RenameSearchAttribute(context.Context, *types.RenameSearchAttributeRequest) error
 		CloseShard(context.Context, *types.CloseShardRequest) error
 		DescribeCluster(context.Context) (*types.DescribeClusterResponse, error)
 		DescribeShardDistribution(context.Context, *types.DescribeShardDistributionRequest) (*types.DescribeShardDistributionResponse, error)
@@ -76,12 +81,20 @@ type (
 		GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
 		GetDomainReplicationMessages(context.Context, *types.GetDomainReplicationMessagesRequest) (*types.GetDomainReplicationMessagesResponse, error)
 		GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest) (*types.GetReplicationMessagesResponse, error)
+		StreamReplicationMessages(ReplicationMessagesServerStream) error
 		GetWorkflowExecutionRawHistoryV2(context.Context, *types.GetWorkflowExecutionRawHistoryV2Request) (*types.GetWorkflowExecutionRawHistoryV2Response, error)
 		MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
 		PurgeDLQMessages(context.Context, *types.PurgeDLQMessagesRequest) error
 		ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
 		ReapplyEvents(context.Context, *types.ReapplyEventsRequest) error
 		RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest) error
+		FailoverWorkflowExecution(context.Context, *types.FailoverWorkflowExecutionRequest) error
+		SuspendWorkflowExecution(context.Context, *types.SuspendWorkflowExecutionRequest) error
+		ResumeWorkflowExecution(context.Context, *types.ResumeWorkflowExecutionRequest) error
+		ListWorkflowTasks(context.Context, *types.ListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error)
+		DeleteWorkflowTask(context.Context, *types.DeleteWorkflowTaskRequest) error
+		ReenqueueWorkflowTask(context.Context, *types.ReenqueueWorkflowTaskRequest) error
+		DescribeDomainUsage(context.Context, *types.DescribeDomainUsageRequest) (*types.DescribeDomainUsageResponse, error)
 		RemoveTask(context.Context, *types.RemoveTaskRequest) error
 		ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest) error
 		ResetQueue(context.Context, *types.ResetQueueRequest) error
@@ -91,6 +104,7 @@ type (
 		UpdateDynamicConfig(context.Context, *types.UpdateDynamicConfigRequest) error
 		RestoreDynamicConfig(context.Context, *types.RestoreDynamicConfigRequest) error
 		ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error)
+		PromoteDomain(context.Context, *types.PromoteDomainRequest) (*types.UpdateDomainResponse, error)
 	}
 
 	// adminHandlerImpl is an implementation for admin service independent of wire protocol
@@ -102,9 +116,11 @@ type (
 		config                *Config
 		domainDLQHandler      domain.DLQMessageHandler
 		domainFailoverWatcher domain.FailoverWatcher
+		domainHandler         domain.Handler
 		eventSerializer       persistence.PayloadSerializer
 		esClient              elasticsearch.GenericClient
 		throttleRetry         *backoff.ThrottleRetry
+		domainUsageClient     domainusage.Client
 	}
 
 	getWorkflowRawHistoryV2Token struct {
@@ -155,12 +171,27 @@ func NewAdminHandler(
 			resource.GetMetricsClient(),
 			resource.GetLogger(),
 		),
+		domainHandler: domain.NewHandler(
+			config.domainConfig,
+			resource.GetLogger(),
+			resource.GetDomainManager(),
+			resource.GetClusterMetadata(),
+			domain.NewDomainReplicator(resource.GetDomainReplicationQueue(), resource.GetLogger()),
+			resource.GetArchivalMetadata(),
+			resource.GetArchiverProvider(),
+			resource.GetTimeSource(),
+		),
 		eventSerializer: persistence.NewPayloadSerializer(),
 		esClient:        params.ESClient,
 		throttleRetry: backoff.NewThrottleRetry(
 			backoff.WithRetryPolicy(adminServiceRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		domainUsageClient: domainusage.NewClient(
+			resource.GetSDKClient(),
+			resource.GetTimeSource(),
+			config.DomainUsageRefreshInterval,
+		),
 	}
 }
 
@@ -250,6 +281,176 @@ func (adh *adminHandlerImpl) AddSearchAttribute(
 	return nil
 }
 
+// RemoveSearchAttribute removes search attributes from whitelist
+func (adh *adminHandlerImpl) RemoveSearchAttribute(
+	ctx context.Context,
+	request *types.RemoveSearchAttributeRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRemoveSearchAttributeScope)
+	defer sw.Stop()
+
+	// validate request
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := checkPermission(adh.config, request.SecurityToken); err != nil {
+		return adh.error(errNoPermission, scope)
+	}
+	if len(request.GetSearchAttribute()) == 0 {
+		return adh.error(&types.BadRequestError{Message: "SearchAttributes are not provided"}, scope)
+	}
+	if err := adh.validateConfigForAdvanceVisibility(); err != nil {
+		return adh.error(&types.BadRequestError{Message: fmt.Sprintf("AdvancedVisibilityStore is not configured for this Cadence Cluster")}, scope)
+	}
+
+	currentValidAttr, aliases, err := adh.getSearchAttributeConfigs()
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	removedAttr, err := adh.params.DynamicConfig.GetMapValue(
+		dynamicconfig.RemovedSearchAttributes, nil, map[string]interface{}{})
+	if err != nil {
+		return adh.error(&types.InternalServiceError{Message: fmt.Sprintf("Failed to get dynamic config, err: %v", err)}, scope)
+	}
+	removedAttr = copyMapValue(removedAttr)
+
+	removed := make(map[string]struct{}, len(request.GetSearchAttribute()))
+	for _, keyName := range request.GetSearchAttribute() {
+		if definition.IsSystemIndexedKey(keyName) {
+			return adh.error(&types.BadRequestError{Message: fmt.Sprintf("Key [%s] is reserved by system", keyName)}, scope)
+		}
+		valueType, exist := currentValidAttr[keyName]
+		if !exist {
+			return adh.error(&types.BadRequestError{Message: fmt.Sprintf("Key [%s] is not whitelisted", keyName)}, scope)
+		}
+		delete(currentValidAttr, keyName)
+		removedAttr[keyName] = valueType
+		removed[keyName] = struct{}{}
+	}
+	// old names of a removed attribute go away with it
+	for alias, target := range aliases {
+		if name, ok := target.(string); ok {
+			if _, ok := removed[name]; ok {
+				delete(aliases, alias)
+			}
+		}
+	}
+
+	// ElasticSearch mapping can not be removed, so the field stays in the index. The indexer drops the
+	// attribute for any document written from now on, and queries on it are rejected.
+	adh.updateSearchAttributeConfigs(currentValidAttr, aliases)
+	if err := adh.params.DynamicConfig.UpdateValue(dynamicconfig.RemovedSearchAttributes, removedAttr); err != nil {
+		adh.GetLogger().Warn("Failed to update dynamicconfig. This is only useful in local dev environment. Please ignore this warn if this is in a real Cluster, because you dynamicconfig MUST be updated separately")
+	}
+	return nil
+}
+
+// RenameSearchAttribute renames a whitelisted search attribute, keeping the old name as an alias
+func (adh *adminHandlerImpl) RenameSearchAttribute(
+	ctx context.Context,
+	request *types.RenameSearchAttributeRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRenameSearchAttributeScope)
+	defer sw.Stop()
+
+	// validate request
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := checkPermission(adh.config, request.SecurityToken); err != nil {
+		return adh.error(errNoPermission, scope)
+	}
+	oldName, newName := request.GetOldName(), request.GetNewName()
+	if len(oldName) == 0 || len(newName) == 0 {
+		return adh.error(&types.BadRequestError{Message: "OldName and NewName are required"}, scope)
+	}
+	if oldName == newName {
+		return adh.error(&types.BadRequestError{Message: "OldName and NewName are the same"}, scope)
+	}
+	if err := adh.validateConfigForAdvanceVisibility(); err != nil {
+		return adh.error(&types.BadRequestError{Message: fmt.Sprintf("AdvancedVisibilityStore is not configured for this Cadence Cluster")}, scope)
+	}
+	for _, keyName := range []string{oldName, newName} {
+		if definition.IsSystemIndexedKey(keyName) {
+			return adh.error(&types.BadRequestError{Message: fmt.Sprintf("Key [%s] is reserved by system", keyName)}, scope)
+		}
+	}
+
+	currentValidAttr, aliases, err := adh.getSearchAttributeConfigs()
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	valueType, exist := currentValidAttr[oldName]
+	if !exist {
+		return adh.error(&types.BadRequestError{Message: fmt.Sprintf("Key [%s] is not whitelisted", oldName)}, scope)
+	}
+	if _, exist := currentValidAttr[newName]; exist {
+		return adh.error(&types.BadRequestError{Message: fmt.Sprintf("Key [%s] is already whitelisted", newName)}, scope)
+	}
+	indexedValueType, ok := convertDynamicConfigValueTypeToIndexedValueType(valueType)
+	esDataType := convertIndexedValueTypeToESDataType(indexedValueType)
+	if !ok || len(esDataType) == 0 {
+		return adh.error(&types.BadRequestError{Message: fmt.Sprintf("Unknown value type, %v", valueType)}, scope)
+	}
+
+	// documents indexed before the rename keep the old field until the domain is reindexed
+	index := adh.params.ESConfig.GetVisibilityIndex()
+	err = adh.params.ESClient.PutMapping(ctx, index, definition.Attr, newName, esDataType)
+	if adh.esClient.IsNotFoundError(err) {
+		err = adh.params.ESClient.CreateIndex(ctx, index)
+		if err != nil {
+			return adh.error(&types.InternalServiceError{Message: fmt.Sprintf("Failed to create ES index, err: %v", err)}, scope)
+		}
+		err = adh.params.ESClient.PutMapping(ctx, index, definition.Attr, newName, esDataType)
+	}
+	if err != nil {
+		return adh.error(&types.InternalServiceError{Message: fmt.Sprintf("Failed to update ES mapping, err: %v", err)}, scope)
+	}
+
+	delete(currentValidAttr, oldName)
+	currentValidAttr[newName] = valueType
+	for alias, target := range aliases {
+		if target == oldName {
+			aliases[alias] = newName
+		}
+	}
+	aliases[oldName] = newName
+	delete(aliases, newName)
+
+	adh.updateSearchAttributeConfigs(currentValidAttr, aliases)
+	return nil
+}
+
+// getSearchAttributeConfigs returns copies of the whitelisted search attributes and their aliases
+func (adh *adminHandlerImpl) getSearchAttributeConfigs() (map[string]interface{}, map[string]interface{}, error) {
+	validAttr, err := adh.params.DynamicConfig.GetMapValue(
+		dynamicconfig.ValidSearchAttributes, nil, definition.GetDefaultIndexedKeys())
+	if err != nil {
+		return nil, nil, &types.InternalServiceError{Message: fmt.Sprintf("Failed to get dynamic config, err: %v", err)}
+	}
+	aliases, err := adh.params.DynamicConfig.GetMapValue(
+		dynamicconfig.SearchAttributeAliases, nil, map[string]interface{}{})
+	if err != nil {
+		return nil, nil, &types.InternalServiceError{Message: fmt.Sprintf("Failed to get dynamic config, err: %v", err)}
+	}
+	return copyMapValue(validAttr), copyMapValue(aliases), nil
+}
+
+// updateSearchAttributeConfigs writes back the whitelisted search attributes and their aliases.
+// Until the DB based dynamic config is implemented, we shouldn't fail the updating.
+func (adh *adminHandlerImpl) updateSearchAttributeConfigs(validAttr, aliases map[string]interface{}) {
+	if err := adh.params.DynamicConfig.UpdateValue(dynamicconfig.ValidSearchAttributes, validAttr); err != nil {
+		adh.GetLogger().Warn("Failed to update dynamicconfig. This is only useful in local dev environment. Please ignore this warn if this is in a real Cluster, because you dynamicconfig MUST be updated separately")
+	}
+	if err := adh.params.DynamicConfig.UpdateValue(dynamicconfig.SearchAttributeAliases, aliases); err != nil {
+		adh.GetLogger().Warn("Failed to update dynamicconfig. This is only useful in local dev environment. Please ignore this warn if this is in a real Cluster, because you dynamicconfig MUST be updated separately")
+	}
+}
+
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (adh *adminHandlerImpl) DescribeWorkflowExecution(
 	ctx context.Context,
@@ -662,6 +863,25 @@ func (adh *adminHandlerImpl) GetReplicationMessages(
 	return resp, nil
 }
 
+// StreamReplicationMessages pushes new replication tasks to a remote cluster over a long-lived stream.
+// The remote cluster sends the replication token of each shard it wants tasks for, and acknowledges
+// every batch it received for a shard with the next token of that shard.
+func (adh *adminHandlerImpl) StreamReplicationMessages(
+	stream ReplicationMessagesServerStream,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	// stream lifetime is not a meaningful latency, so only requests and failures are counted
+	scope := adh.GetMetricsClient().Scope(metrics.AdminStreamReplicationMessagesScope).Tagged(metrics.GetContextTags(stream.Context())...)
+	scope.IncCounter(metrics.CadenceRequests)
+
+	streamer := newReplicationMessagesStreamer(stream, adh.GetHistoryRawClient(), adh.GetLogger(), scope)
+	if err := streamer.run(); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // GetDomainReplicationMessages returns new domain replication tasks since last retrieved task ID.
 func (adh *adminHandlerImpl) GetDomainReplicationMessages(
 	ctx context.Context,
@@ -982,6 +1202,243 @@ func (adh *adminHandlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// FailoverWorkflowExecution makes a workflow of an active-active domain active in the target cluster
+func (adh *adminHandlerImpl) FailoverWorkflowExecution(
+	ctx context.Context,
+	request *types.FailoverWorkflowExecutionRequest,
+) (err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminFailoverWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetTargetCluster() == "" {
+		return adh.error(errClusterNameNotSet, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if !domainEntry.IsActiveActive() {
+		return adh.error(errDomainNotActiveActive, scope)
+	}
+	isReplicationCluster := false
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		if cluster.ClusterName == request.GetTargetCluster() {
+			isReplicationCluster = true
+			break
+		}
+	}
+	if !isReplicationCluster {
+		return adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Cluster %v is not a replication cluster of the domain.", request.GetTargetCluster()),
+		}, scope)
+	}
+
+	if request.GetTargetCluster() != adh.GetClusterMetadata().GetCurrentClusterName() {
+		// the workflow is taken over by the target cluster
+		err = adh.GetRemoteAdminClient(request.GetTargetCluster()).FailoverWorkflowExecution(ctx, request)
+	} else {
+		err = adh.GetHistoryClient().FailoverWorkflowExecution(ctx, &types.HistoryFailoverWorkflowExecutionRequest{
+			DomainUUID: domainEntry.GetInfo().ID,
+			Request:    request,
+		})
+	}
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// SuspendWorkflowExecution suspends a running workflow, its decision, activity and timer tasks are held back until it is resumed
+func (adh *adminHandlerImpl) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *types.SuspendWorkflowExecutionRequest,
+) (err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminSuspendWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().SuspendWorkflowExecution(ctx, &types.HistorySuspendWorkflowExecutionRequest{
+		DomainUUID:     domainEntry.GetInfo().ID,
+		SuspendRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ResumeWorkflowExecution resumes a suspended workflow and re-generates the tasks held back while it was suspended
+func (adh *adminHandlerImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *types.ResumeWorkflowExecutionRequest,
+) (err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminResumeWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().ResumeWorkflowExecution(ctx, &types.HistoryResumeWorkflowExecutionRequest{
+		DomainUUID:    domainEntry.GetInfo().ID,
+		ResumeRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ListWorkflowTasks lists the pending transfer, timer, replication and cross-cluster tasks of a workflow
+func (adh *adminHandlerImpl) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.ListWorkflowTasksRequest,
+) (resp *types.ListWorkflowTasksResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListWorkflowTasksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.WorkflowExecution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err = adh.GetHistoryClient().ListWorkflowTasks(ctx, &types.HistoryListWorkflowTasksRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DeleteWorkflowTask deletes a single pending task of a workflow
+func (adh *adminHandlerImpl) DeleteWorkflowTask(
+	ctx context.Context,
+	request *types.DeleteWorkflowTaskRequest,
+) (err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDeleteWorkflowTaskScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.Type == nil {
+		return adh.error(errEmptyQueueType, scope)
+	}
+	if err := validateExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().DeleteWorkflowTask(ctx, &types.HistoryDeleteWorkflowTaskRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ReenqueueWorkflowTask submits a single pending task of a workflow for immediate processing
+func (adh *adminHandlerImpl) ReenqueueWorkflowTask(
+	ctx context.Context,
+	request *types.ReenqueueWorkflowTaskRequest,
+) (err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminReenqueueWorkflowTaskScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.Type == nil {
+		return adh.error(errEmptyQueueType, scope)
+	}
+	if err := validateExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().ReenqueueWorkflowTask(ctx, &types.HistoryReenqueueWorkflowTaskRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeDomainUsage returns the history storage and workflow counts of a domain,
+// as last aggregated by the domain usage worker
+func (adh *adminHandlerImpl) DescribeDomainUsage(
+	ctx context.Context,
+	request *types.DescribeDomainUsageRequest,
+) (resp *types.DescribeDomainUsageResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDescribeDomainUsageScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err = adh.domainUsageClient.DescribeDomainUsage(ctx, domainID)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *adminHandlerImpl) ResendReplicationTasks(
 	ctx context.Context,
@@ -1266,6 +1723,28 @@ func convertIndexedValueTypeToESDataType(valueType types.IndexedValueType) strin
 	}
 }
 
+// convertDynamicConfigValueTypeToIndexedValueType converts a value type of the ValidSearchAttributes dynamic config
+func convertDynamicConfigValueTypeToIndexedValueType(valueType interface{}) (types.IndexedValueType, bool) {
+	switch t := valueType.(type) {
+	case float64:
+		return types.IndexedValueType(t), true
+	case int:
+		return types.IndexedValueType(t), true
+	case types.IndexedValueType:
+		return t, true
+	default:
+		return 0, false
+	}
+}
+
+func copyMapValue(value map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(value))
+	for k, v := range value {
+		result[k] = v
+	}
+	return result
+}
+
 func serializeRawHistoryToken(token *getWorkflowRawHistoryV2Token) ([]byte, error) {
 	if token == nil {
 		return nil, nil
@@ -1397,6 +1876,29 @@ func (adh *adminHandlerImpl) ListDynamicConfig(ctx context.Context, request *typ
 	}, nil
 }
 
+// PromoteDomain converts a local domain into a global domain
+func (adh *adminHandlerImpl) PromoteDomain(
+	ctx context.Context,
+	request *types.PromoteDomainRequest,
+) (_ *types.UpdateDomainResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminPromoteDomainScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+
+	resp, err := adh.domainHandler.PromoteDomain(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 func checkValidKey(keyName string) (dynamicconfig.Key, error) {
 	keyVal, ok := dynamicconfig.KeyNames[keyName]
 	if !ok || keyVal == dynamicconfig.UnknownKey {
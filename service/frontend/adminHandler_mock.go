@@ -96,6 +96,34 @@ func (mr *MockAdminHandlerMockRecorder) AddSearchAttribute(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttribute", reflect.TypeOf((*MockAdminHandler)(nil).AddSearchAttribute), arg0, arg1)
 }
 
+// RemoveSearchAttribute mocks base method
+func (m *MockAdminHandler) RemoveSearchAttribute(arg0 context.Context, arg1 *types.RemoveSearchAttributeRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSearchAttribute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSearchAttribute indicates an expected call of RemoveSearchAttribute
+func (mr *MockAdminHandlerMockRecorder) RemoveSearchAttribute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttribute", reflect.TypeOf((*MockAdminHandler)(nil).RemoveSearchAttribute), arg0, arg1)
+}
+
+// RenameSearchAttribute mocks base method
+func (m *MockAdminHandler) RenameSearchAttribute(arg0 context.Context, arg1 *types.RenameSearchAttributeRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameSearchAttribute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameSearchAttribute indicates an expected call of RenameSearchAttribute
+func (mr *MockAdminHandlerMockRecorder) RenameSearchAttribute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameSearchAttribute", reflect.TypeOf((*MockAdminHandler)(nil).RenameSearchAttribute), arg0, arg1)
+}
+
 // CloseShard mocks base method
 func (m *MockAdminHandler) CloseShard(arg0 context.Context, arg1 *types.CloseShardRequest) error {
 	m.ctrl.T.Helper()
@@ -230,6 +258,20 @@ func (mr *MockAdminHandlerMockRecorder) GetReplicationMessages(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminHandler)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// StreamReplicationMessages mocks base method
+func (m *MockAdminHandler) StreamReplicationMessages(arg0 ReplicationMessagesServerStream) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamReplicationMessages", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamReplicationMessages indicates an expected call of StreamReplicationMessages
+func (mr *MockAdminHandlerMockRecorder) StreamReplicationMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamReplicationMessages", reflect.TypeOf((*MockAdminHandler)(nil).StreamReplicationMessages), arg0)
+}
+
 // GetWorkflowExecutionRawHistoryV2 mocks base method
 func (m *MockAdminHandler) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *types.GetWorkflowExecutionRawHistoryV2Request) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
@@ -317,6 +359,106 @@ func (mr *MockAdminHandlerMockRecorder) RefreshWorkflowTasks(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminHandler)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// FailoverWorkflowExecution mocks base method
+func (m *MockAdminHandler) FailoverWorkflowExecution(arg0 context.Context, arg1 *types.FailoverWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailoverWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailoverWorkflowExecution indicates an expected call of FailoverWorkflowExecution
+func (mr *MockAdminHandlerMockRecorder) FailoverWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverWorkflowExecution", reflect.TypeOf((*MockAdminHandler)(nil).FailoverWorkflowExecution), arg0, arg1)
+}
+
+// SuspendWorkflowExecution mocks base method
+func (m *MockAdminHandler) SuspendWorkflowExecution(arg0 context.Context, arg1 *types.SuspendWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendWorkflowExecution indicates an expected call of SuspendWorkflowExecution
+func (mr *MockAdminHandlerMockRecorder) SuspendWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendWorkflowExecution", reflect.TypeOf((*MockAdminHandler)(nil).SuspendWorkflowExecution), arg0, arg1)
+}
+
+// ResumeWorkflowExecution mocks base method
+func (m *MockAdminHandler) ResumeWorkflowExecution(arg0 context.Context, arg1 *types.ResumeWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution
+func (mr *MockAdminHandlerMockRecorder) ResumeWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockAdminHandler)(nil).ResumeWorkflowExecution), arg0, arg1)
+}
+
+// ListWorkflowTasks mocks base method
+func (m *MockAdminHandler) ListWorkflowTasks(arg0 context.Context, arg1 *types.ListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListWorkflowTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowTasks indicates an expected call of ListWorkflowTasks
+func (mr *MockAdminHandlerMockRecorder) ListWorkflowTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowTasks", reflect.TypeOf((*MockAdminHandler)(nil).ListWorkflowTasks), arg0, arg1)
+}
+
+// DeleteWorkflowTask mocks base method
+func (m *MockAdminHandler) DeleteWorkflowTask(arg0 context.Context, arg1 *types.DeleteWorkflowTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowTask indicates an expected call of DeleteWorkflowTask
+func (mr *MockAdminHandlerMockRecorder) DeleteWorkflowTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowTask", reflect.TypeOf((*MockAdminHandler)(nil).DeleteWorkflowTask), arg0, arg1)
+}
+
+// ReenqueueWorkflowTask mocks base method
+func (m *MockAdminHandler) ReenqueueWorkflowTask(arg0 context.Context, arg1 *types.ReenqueueWorkflowTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReenqueueWorkflowTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReenqueueWorkflowTask indicates an expected call of ReenqueueWorkflowTask
+func (mr *MockAdminHandlerMockRecorder) ReenqueueWorkflowTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueWorkflowTask", reflect.TypeOf((*MockAdminHandler)(nil).ReenqueueWorkflowTask), arg0, arg1)
+}
+
+// DescribeDomainUsage mocks base method
+func (m *MockAdminHandler) DescribeDomainUsage(arg0 context.Context, arg1 *types.DescribeDomainUsageRequest) (*types.DescribeDomainUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDomainUsage", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeDomainUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDomainUsage indicates an expected call of DescribeDomainUsage
+func (mr *MockAdminHandlerMockRecorder) DescribeDomainUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDomainUsage", reflect.TypeOf((*MockAdminHandler)(nil).DescribeDomainUsage), arg0, arg1)
+}
+
 // RemoveTask mocks base method
 func (m *MockAdminHandler) RemoveTask(arg0 context.Context, arg1 *types.RemoveTaskRequest) error {
 	m.ctrl.T.Helper()
@@ -446,3 +588,18 @@ func (mr *MockAdminHandlerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).ListDynamicConfig), arg0, arg1)
 }
+
+// PromoteDomain mocks base method
+func (m *MockAdminHandler) PromoteDomain(arg0 context.Context, arg1 *types.PromoteDomainRequest) (*types.UpdateDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteDomain", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteDomain indicates an expected call of PromoteDomain
+func (mr *MockAdminHandlerMockRecorder) PromoteDomain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteDomain", reflect.TypeOf((*MockAdminHandler)(nil).PromoteDomain), arg0, arg1)
+}
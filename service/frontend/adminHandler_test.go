@@ -25,7 +25,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -36,8 +38,11 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/domainusage"
 	"github.com/uber/cadence/common/dynamicconfig"
 	esmock "github.com/uber/cadence/common/elasticsearch/mocks"
 	"github.com/uber/cadence/common/metrics"
@@ -91,6 +96,9 @@ func (s *adminHandlerSuite) SetupTest() {
 	config := &Config{
 		EnableAdminProtection:  dynamicconfig.GetBoolPropertyFn(false),
 		EnableGracefulFailover: dynamicconfig.GetBoolPropertyFn(false),
+		domainConfig: domain.Config{
+			MinRetentionDays: dynamicconfig.GetIntPropertyFn(1),
+		},
 	}
 	s.handler = NewAdminHandler(s.mockResource, params, config).(*adminHandlerImpl)
 	s.handler.Start()
@@ -547,6 +555,102 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 	s.Equal(esErrorTest.Expected, handler.AddSearchAttribute(ctx, esErrorTest.Request))
 }
 
+func (s *adminHandlerSuite) Test_RemoveSearchAttribute() {
+	handler := s.handler
+	handler.params = &resource.Params{}
+	ctx := context.Background()
+
+	s.Equal(&types.BadRequestError{Message: "SearchAttributes are not provided"},
+		handler.RemoveSearchAttribute(ctx, &types.RemoveSearchAttributeRequest{}))
+	s.Equal(&types.BadRequestError{Message: "AdvancedVisibilityStore is not configured for this Cadence Cluster"},
+		handler.RemoveSearchAttribute(ctx, &types.RemoveSearchAttributeRequest{SearchAttribute: []string{"testkey"}}))
+
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	handler.params.DynamicConfig = dynamicConfig
+	handler.params.ESConfig = &config.ElasticSearchConfig{}
+	handler.params.ESClient = &esmock.GenericClient{}
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, nil, definition.GetDefaultIndexedKeys()).
+		Return(map[string]interface{}{
+			"testkey":  types.IndexedValueTypeKeyword,
+			"testkey2": types.IndexedValueTypeInt,
+		}, nil).AnyTimes()
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeAliases, nil, map[string]interface{}{}).
+		Return(map[string]interface{}{
+			"oldkey":  "testkey",
+			"oldkey2": "testkey2",
+		}, nil).AnyTimes()
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.RemovedSearchAttributes, nil, map[string]interface{}{}).
+		Return(map[string]interface{}{
+			"removedkey": types.IndexedValueTypeBool,
+		}, nil).AnyTimes()
+
+	s.Equal(&types.BadRequestError{Message: "Key [WorkflowID] is reserved by system"},
+		handler.RemoveSearchAttribute(ctx, &types.RemoveSearchAttributeRequest{SearchAttribute: []string{"WorkflowID"}}))
+	s.Equal(&types.BadRequestError{Message: "Key [unknown] is not whitelisted"},
+		handler.RemoveSearchAttribute(ctx, &types.RemoveSearchAttributeRequest{SearchAttribute: []string{"unknown"}}))
+
+	dynamicConfig.EXPECT().UpdateValue(dynamicconfig.ValidSearchAttributes, map[string]interface{}{
+		"testkey2": types.IndexedValueTypeInt,
+	}).Return(nil)
+	dynamicConfig.EXPECT().UpdateValue(dynamicconfig.SearchAttributeAliases, map[string]interface{}{
+		"oldkey2": "testkey2",
+	}).Return(nil)
+	dynamicConfig.EXPECT().UpdateValue(dynamicconfig.RemovedSearchAttributes, map[string]interface{}{
+		"removedkey": types.IndexedValueTypeBool,
+		"testkey":    types.IndexedValueTypeKeyword,
+	}).Return(nil)
+	s.NoError(handler.RemoveSearchAttribute(ctx, &types.RemoveSearchAttributeRequest{SearchAttribute: []string{"testkey"}}))
+}
+
+func (s *adminHandlerSuite) Test_RenameSearchAttribute() {
+	handler := s.handler
+	handler.params = &resource.Params{}
+	ctx := context.Background()
+
+	s.Equal(&types.BadRequestError{Message: "OldName and NewName are required"},
+		handler.RenameSearchAttribute(ctx, &types.RenameSearchAttributeRequest{OldName: "testkey"}))
+	s.Equal(&types.BadRequestError{Message: "OldName and NewName are the same"},
+		handler.RenameSearchAttribute(ctx, &types.RenameSearchAttributeRequest{OldName: "testkey", NewName: "testkey"}))
+	s.Equal(&types.BadRequestError{Message: "AdvancedVisibilityStore is not configured for this Cadence Cluster"},
+		handler.RenameSearchAttribute(ctx, &types.RenameSearchAttributeRequest{OldName: "testkey", NewName: "newkey"}))
+
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	handler.params.DynamicConfig = dynamicConfig
+	handler.params.ESConfig = &config.ElasticSearchConfig{}
+	esClient := &esmock.GenericClient{}
+	defer func() { esClient.AssertExpectations(s.T()) }()
+	handler.params.ESClient = esClient
+	handler.esClient = esClient
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, nil, definition.GetDefaultIndexedKeys()).
+		Return(map[string]interface{}{
+			"testkey":  types.IndexedValueTypeKeyword,
+			"testkey2": types.IndexedValueTypeInt,
+		}, nil).AnyTimes()
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeAliases, nil, map[string]interface{}{}).
+		Return(map[string]interface{}{
+			"oldkey": "testkey",
+		}, nil).AnyTimes()
+
+	s.Equal(&types.BadRequestError{Message: "Key [WorkflowID] is reserved by system"},
+		handler.RenameSearchAttribute(ctx, &types.RenameSearchAttributeRequest{OldName: "testkey", NewName: "WorkflowID"}))
+	s.Equal(&types.BadRequestError{Message: "Key [unknown] is not whitelisted"},
+		handler.RenameSearchAttribute(ctx, &types.RenameSearchAttributeRequest{OldName: "unknown", NewName: "newkey"}))
+	s.Equal(&types.BadRequestError{Message: "Key [testkey2] is already whitelisted"},
+		handler.RenameSearchAttribute(ctx, &types.RenameSearchAttributeRequest{OldName: "testkey", NewName: "testkey2"}))
+
+	esClient.On("PutMapping", mock.Anything, mock.Anything, definition.Attr, "newkey", "keyword").Return(nil).Once()
+	esClient.On("IsNotFoundError", mock.Anything).Return(false)
+	dynamicConfig.EXPECT().UpdateValue(dynamicconfig.ValidSearchAttributes, map[string]interface{}{
+		"newkey":   types.IndexedValueTypeKeyword,
+		"testkey2": types.IndexedValueTypeInt,
+	}).Return(nil)
+	dynamicConfig.EXPECT().UpdateValue(dynamicconfig.SearchAttributeAliases, map[string]interface{}{
+		"oldkey":  "newkey",
+		"testkey": "newkey",
+	}).Return(nil)
+	s.NoError(handler.RenameSearchAttribute(ctx, &types.RenameSearchAttributeRequest{OldName: "testkey", NewName: "newkey"}))
+}
+
 func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
 	ctx := context.Background()
 	handler := s.handler
@@ -672,3 +776,279 @@ func (s *adminHandlerSuite) Test_GetDynamicConfig_FilterMatch() {
 	s.NoError(err)
 	s.Equal(resp.Value.Data, encTrue)
 }
+
+func (s *adminHandlerSuite) Test_PromoteDomain_Validate() {
+	ctx := context.Background()
+	handler := s.handler
+
+	_, err := handler.PromoteDomain(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = handler.PromoteDomain(ctx, &types.PromoteDomainRequest{})
+	s.Equal(errDomainNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_FailoverWorkflowExecution() {
+	ctx := context.Background()
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   s.domainID,
+			Name: s.domainName,
+			Data: map[string]string{common.DomainDataKeyForActiveActive: "true"},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1234,
+		nil,
+	)
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(domainEntry, nil).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	execution := &types.WorkflowExecution{WorkflowID: "workflowID", RunID: uuid.New()}
+
+	// failover to the current cluster is done by the history service
+	request := &types.FailoverWorkflowExecutionRequest{
+		Domain:        s.domainName,
+		Execution:     execution,
+		TargetCluster: cluster.TestCurrentClusterName,
+	}
+	s.mockHistoryClient.EXPECT().FailoverWorkflowExecution(ctx, &types.HistoryFailoverWorkflowExecutionRequest{
+		DomainUUID: s.domainID,
+		Request:    request,
+	}).Return(nil).Times(1)
+	s.NoError(s.handler.FailoverWorkflowExecution(ctx, request))
+
+	// failover to another cluster is forwarded to the target cluster
+	request = &types.FailoverWorkflowExecutionRequest{
+		Domain:        s.domainName,
+		Execution:     execution,
+		TargetCluster: cluster.TestAlternativeClusterName,
+	}
+	s.mockResource.RemoteAdminClient.EXPECT().FailoverWorkflowExecution(ctx, request).Return(nil).Times(1)
+	s.NoError(s.handler.FailoverWorkflowExecution(ctx, request))
+
+	// target cluster must be a replication cluster of the domain
+	err := s.handler.FailoverWorkflowExecution(ctx, &types.FailoverWorkflowExecutionRequest{
+		Domain:        s.domainName,
+		Execution:     execution,
+		TargetCluster: "unknown-cluster",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) Test_FailoverWorkflowExecution_NotActiveActive() {
+	ctx := context.Background()
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(domainEntry, nil).Times(1)
+
+	err := s.handler.FailoverWorkflowExecution(ctx, &types.FailoverWorkflowExecutionRequest{
+		Domain:        s.domainName,
+		Execution:     &types.WorkflowExecution{WorkflowID: "workflowID", RunID: uuid.New()},
+		TargetCluster: cluster.TestCurrentClusterName,
+	})
+	s.Equal(errDomainNotActiveActive, err)
+
+	err = s.handler.FailoverWorkflowExecution(ctx, &types.FailoverWorkflowExecutionRequest{
+		Domain:    s.domainName,
+		Execution: &types.WorkflowExecution{WorkflowID: "workflowID", RunID: uuid.New()},
+	})
+	s.Equal(errClusterNameNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_ListWorkflowTasks() {
+	ctx := context.Background()
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(domainEntry, nil).Times(1)
+
+	_, err := s.handler.ListWorkflowTasks(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	request := &types.ListWorkflowTasksRequest{
+		Domain:            s.domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflowID", RunID: uuid.New()},
+	}
+	expected := &types.ListWorkflowTasksResponse{
+		ShardID: 1,
+		Tasks:   []*types.WorkflowTaskInfo{{Type: common.Int32Ptr(int32(common.TaskTypeTransfer)), TaskID: 123}},
+	}
+	s.mockHistoryClient.EXPECT().ListWorkflowTasks(ctx, &types.HistoryListWorkflowTasksRequest{
+		DomainUUID: s.domainID,
+		Request:    request,
+	}).Return(expected, nil).Times(1)
+	resp, err := s.handler.ListWorkflowTasks(ctx, request)
+	s.NoError(err)
+	s.Equal(expected, resp)
+}
+
+func (s *adminHandlerSuite) Test_DeleteWorkflowTask() {
+	ctx := context.Background()
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(domainEntry, nil).Times(1)
+	execution := &types.WorkflowExecution{WorkflowID: "workflowID", RunID: uuid.New()}
+
+	err := s.handler.DeleteWorkflowTask(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	err = s.handler.DeleteWorkflowTask(ctx, &types.DeleteWorkflowTaskRequest{
+		Domain:            s.domainName,
+		WorkflowExecution: execution,
+		TaskID:            123,
+	})
+	s.Equal(errEmptyQueueType, err)
+
+	request := &types.DeleteWorkflowTaskRequest{
+		Domain:            s.domainName,
+		WorkflowExecution: execution,
+		Type:              common.Int32Ptr(int32(common.TaskTypeTransfer)),
+		TaskID:            123,
+		Reason:            "poisoned task",
+		Identity:          "operator",
+	}
+	s.mockHistoryClient.EXPECT().DeleteWorkflowTask(ctx, &types.HistoryDeleteWorkflowTaskRequest{
+		DomainUUID: s.domainID,
+		Request:    request,
+	}).Return(nil).Times(1)
+	s.NoError(s.handler.DeleteWorkflowTask(ctx, request))
+}
+
+func (s *adminHandlerSuite) Test_ReenqueueWorkflowTask() {
+	ctx := context.Background()
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(domainEntry, nil).Times(1)
+	execution := &types.WorkflowExecution{WorkflowID: "workflowID", RunID: uuid.New()}
+
+	err := s.handler.ReenqueueWorkflowTask(ctx, &types.ReenqueueWorkflowTaskRequest{
+		Domain:            s.domainName,
+		WorkflowExecution: execution,
+		TaskID:            123,
+	})
+	s.Equal(errEmptyQueueType, err)
+
+	request := &types.ReenqueueWorkflowTaskRequest{
+		Domain:              s.domainName,
+		WorkflowExecution:   execution,
+		Type:                common.Int32Ptr(int32(common.TaskTypeTimer)),
+		TaskID:              123,
+		VisibilityTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+	}
+	s.mockHistoryClient.EXPECT().ReenqueueWorkflowTask(ctx, &types.HistoryReenqueueWorkflowTaskRequest{
+		DomainUUID: s.domainID,
+		Request:    request,
+	}).Return(nil).Times(1)
+	s.NoError(s.handler.ReenqueueWorkflowTask(ctx, request))
+}
+
+func (s *adminHandlerSuite) Test_DescribeDomainUsage() {
+	ctx := context.Background()
+	mockDomainUsageClient := domainusage.NewMockClient(s.controller)
+	s.handler.domainUsageClient = mockDomainUsageClient
+
+	_, err := s.handler.DescribeDomainUsage(ctx, &types.DescribeDomainUsageRequest{})
+	s.Equal(errDomainNotSet, err)
+
+	expected := &types.DescribeDomainUsageResponse{
+		Usage:          &types.DomainUsage{HistorySize: 1024, HistoryEventCount: 10, OpenWorkflowCount: 1},
+		LastUpdateTime: common.Int64Ptr(time.Now().UnixNano()),
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	mockDomainUsageClient.EXPECT().DescribeDomainUsage(ctx, s.domainID).Return(expected, nil).Times(1)
+	resp, err := s.handler.DescribeDomainUsage(ctx, &types.DescribeDomainUsageRequest{Domain: s.domainName})
+	s.NoError(err)
+	s.Equal(expected, resp)
+}
+
+func (s *adminHandlerSuite) Test_StreamReplicationMessages() {
+	stream := newFakeReplicationMessagesServerStream()
+	token := &types.ReplicationToken{
+		ShardID:                0,
+		LastRetrievedMessageID: 10,
+		LastProcessedMessageID: 10,
+	}
+	messages := &types.ReplicationMessages{LastRetrievedMessageID: 11}
+	s.mockHistoryClient.EXPECT().GetReplicationMessages(gomock.Any(), &types.GetReplicationMessagesRequest{
+		Tokens:          []*types.ReplicationToken{token},
+		ClusterName:     "standby",
+		WaitForNewTasks: true,
+	}).Return(&types.GetReplicationMessagesResponse{
+		MessagesByShard: map[int32]*types.ReplicationMessages{0: messages},
+	}, nil).Times(1)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.handler.StreamReplicationMessages(stream)
+	}()
+
+	stream.requests <- &types.StreamReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token},
+		ClusterName: "standby",
+	}
+	response := <-stream.responses
+	s.Equal(map[int32]*types.ReplicationMessages{0: messages}, response.GetMessagesByShard())
+
+	close(stream.requests)
+	s.NoError(<-errCh)
+}
+
+func (s *adminHandlerSuite) Test_StreamReplicationMessages_ClusterNameNotSet() {
+	stream := newFakeReplicationMessagesServerStream()
+	stream.requests <- &types.StreamReplicationMessagesRequest{
+		Tokens: []*types.ReplicationToken{{ShardID: 0}},
+	}
+
+	err := s.handler.StreamReplicationMessages(stream)
+	s.Equal(errClusterNameNotSet, err)
+}
+
+type fakeReplicationMessagesServerStream struct {
+	requests  chan *types.StreamReplicationMessagesRequest
+	responses chan *types.StreamReplicationMessagesResponse
+}
+
+func newFakeReplicationMessagesServerStream() *fakeReplicationMessagesServerStream {
+	return &fakeReplicationMessagesServerStream{
+		requests:  make(chan *types.StreamReplicationMessagesRequest, 1),
+		responses: make(chan *types.StreamReplicationMessagesResponse, 1),
+	}
+}
+
+func (f *fakeReplicationMessagesServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeReplicationMessagesServerStream) Recv() (*types.StreamReplicationMessagesRequest, error) {
+	request, ok := <-f.requests
+	if !ok {
+		return nil, io.EOF
+	}
+	return request, nil
+}
+
+func (f *fakeReplicationMessagesServerStream) Send(response *types.StreamReplicationMessagesResponse) error {
+	f.responses <- response
+	return nil
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// streamReplicationPollTimeout bounds a single long poll against history,
+	// history itself returns earlier when its long poll expiration interval is shorter
+	streamReplicationPollTimeout = 60 * time.Second
+	// streamReplicationErrorRetryWait is the wait before polling a shard again after a failure
+	streamReplicationErrorRetryWait = time.Second
+	// streamReplicationMaxShardsPerResponse caps the number of shards batched into a single response
+	streamReplicationMaxShardsPerResponse = 100
+)
+
+var (
+	errClusterNameChanged = &types.BadRequestError{Message: "Cluster name cannot change within a replication stream."}
+)
+
+type (
+	// ReplicationMessagesServerStream is the server side of a replication messages stream
+	ReplicationMessagesServerStream interface {
+		Context() context.Context
+		Recv() (*types.StreamReplicationMessagesRequest, error)
+		Send(*types.StreamReplicationMessagesResponse) error
+	}
+
+	// replicationMessagesStreamer pushes replication tasks of the requested shards to a remote cluster.
+	// Every shard has at most one outstanding batch: after a batch is sent for a shard,
+	// history is polled for it again only once the remote cluster acknowledges the batch with its next token.
+	replicationMessagesStreamer struct {
+		stream        ReplicationMessagesServerStream
+		historyClient history.Client
+		logger        log.Logger
+		scope         metrics.Scope
+
+		messagesC chan shardReplicationMessages
+	}
+
+	shardReplicationMessages struct {
+		shardID  int32
+		messages *types.ReplicationMessages
+	}
+)
+
+func newReplicationMessagesStreamer(
+	stream ReplicationMessagesServerStream,
+	historyClient history.Client,
+	logger log.Logger,
+	scope metrics.Scope,
+) *replicationMessagesStreamer {
+	return &replicationMessagesStreamer{
+		stream:        stream,
+		historyClient: historyClient,
+		logger:        logger,
+		scope:         scope,
+		messagesC:     make(chan shardReplicationMessages, streamReplicationMaxShardsPerResponse),
+	}
+}
+
+// run serves the stream until the remote cluster closes it or an error occurs
+func (s *replicationMessagesStreamer) run() error {
+	ctx, cancel := context.WithCancel(s.stream.Context())
+	defer cancel()
+
+	recvErrC := make(chan error, 1)
+	go func() {
+		recvErrC <- s.recvLoop(ctx)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-recvErrC:
+			if s.stream.Context().Err() != nil {
+				return nil
+			}
+			return err
+		case m := <-s.messagesC:
+			response := &types.StreamReplicationMessagesResponse{
+				MessagesByShard: map[int32]*types.ReplicationMessages{m.shardID: m.messages},
+			}
+		Batch:
+			for len(response.MessagesByShard) < streamReplicationMaxShardsPerResponse {
+				select {
+				case m := <-s.messagesC:
+					response.MessagesByShard[m.shardID] = m.messages
+				default:
+					break Batch
+				}
+			}
+			if err := s.stream.Send(response); err != nil {
+				if s.stream.Context().Err() != nil {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+}
+
+// recvLoop receives replication tokens from the remote cluster and hands the latest token of each shard to its poller
+func (s *replicationMessagesStreamer) recvLoop(ctx context.Context) error {
+	var clusterName string
+	tokensByShard := make(map[int32]chan *types.ReplicationToken)
+	for {
+		request, err := s.stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if request.GetClusterName() == "" {
+			return errClusterNameNotSet
+		}
+		if clusterName == "" {
+			clusterName = request.GetClusterName()
+		} else if clusterName != request.GetClusterName() {
+			return errClusterNameChanged
+		}
+
+		for _, token := range request.GetTokens() {
+			tokenC, ok := tokensByShard[token.GetShardID()]
+			if !ok {
+				tokenC = make(chan *types.ReplicationToken, 1)
+				tokensByShard[token.GetShardID()] = tokenC
+				go s.pollShard(ctx, clusterName, tokenC)
+			}
+			// only the latest token of a shard matters, drop the stale one if the poller has not picked it up yet
+			select {
+			case <-tokenC:
+			default:
+			}
+			tokenC <- token
+		}
+	}
+}
+
+// pollShard long polls history for the replication tasks of a single shard
+func (s *replicationMessagesStreamer) pollShard(
+	ctx context.Context,
+	clusterName string,
+	tokenC <-chan *types.ReplicationToken,
+) {
+	retryTimer := time.NewTimer(0)
+	defer retryTimer.Stop()
+
+	var token *types.ReplicationToken
+	for {
+		if token == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case token = <-tokenC:
+			}
+		}
+
+		messages, err := s.getMessages(ctx, clusterName, token)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil && messages != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case s.messagesC <- shardReplicationMessages{shardID: token.GetShardID(), messages: messages}:
+			}
+			token = nil
+			continue
+		}
+
+		if err != nil {
+			s.logger.Warn("Failed to get replication tasks for stream.", tag.ShardID(int(token.GetShardID())), tag.Error(err))
+			s.scope.IncCounter(metrics.CadenceFailures)
+		}
+		if !retryTimer.Stop() {
+			select {
+			case <-retryTimer.C:
+			default:
+			}
+		}
+		retryTimer.Reset(streamReplicationErrorRetryWait)
+		select {
+		case <-ctx.Done():
+			return
+		case <-retryTimer.C:
+		case token = <-tokenC:
+		}
+	}
+}
+
+func (s *replicationMessagesStreamer) getMessages(
+	ctx context.Context,
+	clusterName string,
+	token *types.ReplicationToken,
+) (*types.ReplicationMessages, error) {
+	ctx, cancel := context.WithTimeout(ctx, streamReplicationPollTimeout)
+	defer cancel()
+
+	response, err := s.historyClient.GetReplicationMessages(ctx, &types.GetReplicationMessagesRequest{
+		Tokens:          []*types.ReplicationToken{token},
+		ClusterName:     clusterName,
+		WaitForNewTasks: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.GetMessagesByShard()[token.GetShardID()], nil
+}
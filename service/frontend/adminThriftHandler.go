@@ -52,6 +52,18 @@ func (t AdminThriftHandler) AddSearchAttribute(ctx context.Context, request *adm
 	return thrift.FromError(err)
 }
 
+// RemoveSearchAttribute forwards request to the underlying handler
+func (t AdminThriftHandler) RemoveSearchAttribute(ctx context.Context, request *admin.RemoveSearchAttributeRequest) error {
+	err := t.h.RemoveSearchAttribute(ctx, thrift.ToRemoveSearchAttributeRequest(request))
+	return thrift.FromError(err)
+}
+
+// RenameSearchAttribute forwards request to the underlying handler
+func (t AdminThriftHandler) RenameSearchAttribute(ctx context.Context, request *admin.RenameSearchAttributeRequest) error {
+	err := t.h.RenameSearchAttribute(ctx, thrift.ToRenameSearchAttributeRequest(request))
+	return thrift.FromError(err)
+}
+
 // CloseShard forwards request to the underlying handler
 func (t AdminThriftHandler) CloseShard(ctx context.Context, request *shared.CloseShardRequest) error {
 	err := t.h.CloseShard(ctx, thrift.ToCloseShardRequest(request))
@@ -142,6 +154,48 @@ func (t AdminThriftHandler) RefreshWorkflowTasks(ctx context.Context, request *s
 	return thrift.FromError(err)
 }
 
+// FailoverWorkflowExecution forwards request to the underlying handler
+func (t AdminThriftHandler) FailoverWorkflowExecution(ctx context.Context, request *shared.FailoverWorkflowExecutionRequest) error {
+	err := t.h.FailoverWorkflowExecution(ctx, thrift.ToFailoverWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// SuspendWorkflowExecution forwards request to the underlying handler
+func (t AdminThriftHandler) SuspendWorkflowExecution(ctx context.Context, request *shared.SuspendWorkflowExecutionRequest) error {
+	err := t.h.SuspendWorkflowExecution(ctx, thrift.ToSuspendWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// ResumeWorkflowExecution forwards request to the underlying handler
+func (t AdminThriftHandler) ResumeWorkflowExecution(ctx context.Context, request *shared.ResumeWorkflowExecutionRequest) error {
+	err := t.h.ResumeWorkflowExecution(ctx, thrift.ToResumeWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// ListWorkflowTasks forwards request to the underlying handler
+func (t AdminThriftHandler) ListWorkflowTasks(ctx context.Context, request *shared.ListWorkflowTasksRequest) (*shared.ListWorkflowTasksResponse, error) {
+	response, err := t.h.ListWorkflowTasks(ctx, thrift.ToListWorkflowTasksRequest(request))
+	return thrift.FromListWorkflowTasksResponse(response), thrift.FromError(err)
+}
+
+// DeleteWorkflowTask forwards request to the underlying handler
+func (t AdminThriftHandler) DeleteWorkflowTask(ctx context.Context, request *shared.DeleteWorkflowTaskRequest) error {
+	err := t.h.DeleteWorkflowTask(ctx, thrift.ToDeleteWorkflowTaskRequest(request))
+	return thrift.FromError(err)
+}
+
+// ReenqueueWorkflowTask forwards request to the underlying handler
+func (t AdminThriftHandler) ReenqueueWorkflowTask(ctx context.Context, request *shared.ReenqueueWorkflowTaskRequest) error {
+	err := t.h.ReenqueueWorkflowTask(ctx, thrift.ToReenqueueWorkflowTaskRequest(request))
+	return thrift.FromError(err)
+}
+
+// DescribeDomainUsage forwards request to the underlying handler
+func (t AdminThriftHandler) DescribeDomainUsage(ctx context.Context, request *shared.DescribeDomainUsageRequest) (*shared.DescribeDomainUsageResponse, error) {
+	response, err := t.h.DescribeDomainUsage(ctx, thrift.ToDescribeDomainUsageRequest(request))
+	return thrift.FromDescribeDomainUsageResponse(response), thrift.FromError(err)
+}
+
 // RemoveTask forwards request to the underlying handler
 func (t AdminThriftHandler) RemoveTask(ctx context.Context, request *shared.RemoveTaskRequest) error {
 	err := t.h.RemoveTask(ctx, thrift.ToRemoveTaskRequest(request))
@@ -195,3 +249,9 @@ func (t AdminThriftHandler) ListDynamicConfig(ctx context.Context, request *admi
 	response, err := t.h.ListDynamicConfig(ctx, thrift.ToListDynamicConfigRequest(request))
 	return thrift.FromListDynamicConfigResponse(response), thrift.FromError(err)
 }
+
+// PromoteDomain forwards request to the underlying handler
+func (t AdminThriftHandler) PromoteDomain(ctx context.Context, request *admin.PromoteDomainRequest) (*shared.UpdateDomainResponse, error) {
+	response, err := t.h.PromoteDomain(ctx, thrift.ToPromoteDomainRequest(request))
+	return thrift.FromUpdateDomainResponse(response), thrift.FromError(err)
+}
@@ -49,6 +49,16 @@ func TestAdminThriftHandler(t *testing.T) {
 		err := th.AddSearchAttribute(ctx, &admin.AddSearchAttributeRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RemoveSearchAttribute", func(t *testing.T) {
+		h.EXPECT().RemoveSearchAttribute(ctx, &types.RemoveSearchAttributeRequest{}).Return(internalErr).Times(1)
+		err := th.RemoveSearchAttribute(ctx, &admin.RemoveSearchAttributeRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("RenameSearchAttribute", func(t *testing.T) {
+		h.EXPECT().RenameSearchAttribute(ctx, &types.RenameSearchAttributeRequest{}).Return(internalErr).Times(1)
+		err := th.RenameSearchAttribute(ctx, &admin.RenameSearchAttributeRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("CloseShard", func(t *testing.T) {
 		h.EXPECT().CloseShard(ctx, &types.CloseShardRequest{}).Return(internalErr).Times(1)
 		err := th.CloseShard(ctx, &shared.CloseShardRequest{})
@@ -124,11 +134,48 @@ func TestAdminThriftHandler(t *testing.T) {
 		err := th.ReapplyEvents(ctx, &shared.ReapplyEventsRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("FailoverWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().FailoverWorkflowExecution(ctx, &types.FailoverWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.FailoverWorkflowExecution(ctx, &shared.FailoverWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RefreshWorkflowTasks", func(t *testing.T) {
 		h.EXPECT().RefreshWorkflowTasks(ctx, &types.RefreshWorkflowTasksRequest{}).Return(internalErr).Times(1)
 		err := th.RefreshWorkflowTasks(ctx, &shared.RefreshWorkflowTasksRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("SuspendWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().SuspendWorkflowExecution(ctx, &types.SuspendWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.SuspendWorkflowExecution(ctx, &shared.SuspendWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ResumeWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().ResumeWorkflowExecution(ctx, &types.ResumeWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.ResumeWorkflowExecution(ctx, &shared.ResumeWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ListWorkflowTasks", func(t *testing.T) {
+		h.EXPECT().ListWorkflowTasks(ctx, &types.ListWorkflowTasksRequest{}).Return(&types.ListWorkflowTasksResponse{}, internalErr).Times(1)
+		resp, err := th.ListWorkflowTasks(ctx, &shared.ListWorkflowTasksRequest{})
+		assert.Equal(t, shared.ListWorkflowTasksResponse{ShardID: common.Int32Ptr(0), Truncated: common.BoolPtr(false)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DeleteWorkflowTask", func(t *testing.T) {
+		h.EXPECT().DeleteWorkflowTask(ctx, &types.DeleteWorkflowTaskRequest{}).Return(internalErr).Times(1)
+		err := th.DeleteWorkflowTask(ctx, &shared.DeleteWorkflowTaskRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ReenqueueWorkflowTask", func(t *testing.T) {
+		h.EXPECT().ReenqueueWorkflowTask(ctx, &types.ReenqueueWorkflowTaskRequest{}).Return(internalErr).Times(1)
+		err := th.ReenqueueWorkflowTask(ctx, &shared.ReenqueueWorkflowTaskRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DescribeDomainUsage", func(t *testing.T) {
+		h.EXPECT().DescribeDomainUsage(ctx, &types.DescribeDomainUsageRequest{}).Return(&types.DescribeDomainUsageResponse{}, internalErr).Times(1)
+		resp, err := th.DescribeDomainUsage(ctx, &shared.DescribeDomainUsageRequest{})
+		assert.Equal(t, shared.DescribeDomainUsageResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RemoveTask", func(t *testing.T) {
 		h.EXPECT().RemoveTask(ctx, &types.RemoveTaskRequest{}).Return(internalErr).Times(1)
 		err := th.RemoveTask(ctx, &shared.RemoveTaskRequest{})
@@ -150,4 +197,10 @@ func TestAdminThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.GetCrossClusterTasksResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("PromoteDomain", func(t *testing.T) {
+		h.EXPECT().PromoteDomain(ctx, &types.PromoteDomainRequest{}).Return(&types.UpdateDomainResponse{}, internalErr).Times(1)
+		resp, err := th.PromoteDomain(ctx, &admin.PromoteDomainRequest{})
+		assert.Equal(t, shared.UpdateDomainResponse{IsGlobalDomain: common.BoolPtr(false), FailoverVersion: common.Int64Ptr(0)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 }
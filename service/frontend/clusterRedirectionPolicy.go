@@ -186,6 +186,11 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) getTargetClusterAndI
 	}
 
 	currentActiveCluster := domainEntry.GetReplicationConfig().ActiveClusterName
+	if domainEntry.IsActiveActive() {
+		// workflows of an active-active domain are active in different clusters,
+		// try the current cluster first and forward based on the domain not active error
+		currentActiveCluster = policy.currentClusterName
+	}
 	if policy.allDomainAPIs {
 		if policy.targetCluster == "" {
 			return currentActiveCluster, true
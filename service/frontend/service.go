@@ -49,6 +49,7 @@ type Config struct {
 	EnableReadVisibilityFromES      dynamicconfig.BoolPropertyFnWithDomainFilter
 	ESVisibilityListMaxQPS          dynamicconfig.IntPropertyFnWithDomainFilter
 	ESIndexMaxResultWindow          dynamicconfig.IntPropertyFn
+	ESCountMaxGroups                dynamicconfig.IntPropertyFn
 	HistoryMaxPageSize              dynamicconfig.IntPropertyFnWithDomainFilter
 	RPS                             dynamicconfig.IntPropertyFn
 	MaxDomainRPSPerInstance         dynamicconfig.IntPropertyFnWithDomainFilter
@@ -88,7 +89,9 @@ type Config struct {
 	DomainFailoverRefreshTimerJitterCoefficient dynamicconfig.FloatPropertyFn
 
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
-	ValidSearchAttributes             dynamicconfig.MapPropertyFn
+	ValidSearchAttributes dynamicconfig.MapPropertyFn
+	// SearchAttributeAliases maps old names of renamed search attributes to their current names
+	SearchAttributeAliases            dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
@@ -101,6 +104,13 @@ type Config struct {
 	// max number of decisions per RespondDecisionTaskCompleted request (unlimited by default)
 	DecisionResultCountLimit dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// domain usage quota, new workflows are rejected beyond the limits (unlimited by default),
+	// the quota is only checked when the domain usage aggregator is enabled
+	MaxOpenWorkflowsPerDomain   dynamicconfig.IntPropertyFnWithDomainFilter
+	MaxHistorySizePerDomain     dynamicconfig.IntPropertyFnWithDomainFilter
+	DomainUsageRefreshInterval  dynamicconfig.DurationPropertyFn
+	EnableDomainUsageAggregator dynamicconfig.BoolPropertyFn
+
 	//Debugging
 
 	// Emit signal related metrics with signal name tag. Be aware of cardinality.
@@ -120,10 +130,15 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		ESVisibilityListMaxQPS:                      dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendESVisibilityListMaxQPS, 30),
 		EnableReadVisibilityFromES:                  dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableReadVisibilityFromES, enableReadFromES),
 		ESIndexMaxResultWindow:                      dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
+		ESCountMaxGroups:                            dc.GetIntProperty(dynamicconfig.FrontendESCountMaxGroups, 1000),
 		HistoryMaxPageSize:                          dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendHistoryMaxPageSize, common.GetHistoryMaxPageSize),
 		RPS:                                         dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
 		MaxDomainRPSPerInstance:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxDomainRPSPerInstance, 1200),
 		GlobalDomainRPS:                             dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendGlobalDomainRPS, 0),
+		MaxOpenWorkflowsPerDomain:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxOpenWorkflowsPerDomain, 0),
+		MaxHistorySizePerDomain:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxHistorySizePerDomain, 0),
+		DomainUsageRefreshInterval:                  dc.GetDurationProperty(dynamicconfig.FrontendDomainUsageRefreshInterval, time.Minute),
+		EnableDomainUsageAggregator:                 dc.GetBoolProperty(dynamicconfig.EnableDomainUsageAggregator, false),
 		MaxIDLengthWarnLimit:                        dc.GetIntProperty(dynamicconfig.MaxIDLengthWarnLimit, common.DefaultIDLengthWarnLimit),
 		DomainNameMaxLength:                         dc.GetIntPropertyFilteredByDomain(dynamicconfig.DomainNameMaxLength, common.DefaultIDLengthErrorLimit),
 		IdentityMaxLength:                           dc.GetIntPropertyFilteredByDomain(dynamicconfig.IdentityMaxLength, common.DefaultIDLengthErrorLimit),
@@ -146,6 +161,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		DomainFailoverRefreshTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.DomainFailoverRefreshTimerJitterCoefficient, 0.1),
 		EnableClientVersionCheck:                    dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck, false),
 		ValidSearchAttributes:                       dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
+		SearchAttributeAliases:                      dc.GetMapProperty(dynamicconfig.SearchAttributeAliases, map[string]interface{}{}),
 		SearchAttributesNumberOfKeysLimit:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
 		SearchAttributesTotalSizeLimit:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
@@ -224,6 +240,7 @@ func NewService(
 
 			ESVisibilityListMaxQPS: serviceConfig.ESVisibilityListMaxQPS,
 			ESIndexMaxResultWindow: serviceConfig.ESIndexMaxResultWindow,
+			ESCountMaxGroups:       serviceConfig.ESCountMaxGroups,
 			ValidSearchAttributes:  serviceConfig.ValidSearchAttributes,
 		},
 	)
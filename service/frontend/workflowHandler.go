@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/domainusage"
 	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -85,6 +86,7 @@ type (
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
 		throttleRetry             *backoff.ThrottleRetry
+		domainUsageClient         domainusage.Client
 	}
 
 	getHistoryContinuationToken struct {
@@ -146,6 +148,10 @@ var (
 	errRequestIDTooLong    = &types.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &types.BadRequestError{Message: "Identity length exceeds limit."}
 
+	// err for domain usage quota
+	errOpenWorkflowQuotaExceeded = &types.LimitExceededError{Message: "Domain exceeds its open workflow quota."}
+	errHistorySizeQuotaExceeded  = &types.LimitExceededError{Message: "Domain exceeds its history size quota."}
+
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
 
@@ -187,10 +193,11 @@ func NewWorkflowHandler(
 			resource.GetArchiverProvider(),
 			resource.GetTimeSource(),
 		),
-		visibilityQueryValidator: validator.NewQueryValidator(config.ValidSearchAttributes),
+		visibilityQueryValidator: validator.NewQueryValidator(config.ValidSearchAttributes, config.SearchAttributeAliases),
 		searchAttributesValidator: validator.NewSearchAttributesValidator(
 			resource.GetLogger(),
 			config.ValidSearchAttributes,
+			config.SearchAttributeAliases,
 			config.SearchAttributesNumberOfKeysLimit,
 			config.SearchAttributesSizeOfValueLimit,
 			config.SearchAttributesTotalSizeLimit,
@@ -199,6 +206,11 @@ func NewWorkflowHandler(
 			backoff.WithRetryPolicy(frontendServiceRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		domainUsageClient: domainusage.NewClient(
+			resource.GetSDKClient(),
+			resource.GetTimeSource(),
+			config.DomainUsageRefreshInterval,
+		),
 	}
 }
 
@@ -2005,6 +2017,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	if err := wh.checkDomainUsageQuota(ctx, domainName, domainID); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 	actualSize := len(startRequest.Input)
@@ -2538,6 +2554,14 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	if err := wh.checkDomainUsageQuota(ctx, domainName, domainID); err != nil {
+		// a new run is only started when the workflow is not running,
+		// signals to a running workflow are not subject to the quota
+		if !wh.isWorkflowRunning(ctx, domainName, domainID, signalWithStartRequest.GetWorkflowID()) {
+			return nil, wh.error(err, scope, tags...)
+		}
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 	if err := common.CheckEventBlobSizeLimit(
@@ -3258,7 +3282,7 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
-	validatedQuery, err := wh.visibilityQueryValidator.ValidateQuery(countRequest.GetQuery())
+	validatedQuery, err := wh.visibilityQueryValidator.ValidateCountQuery(countRequest.GetQuery())
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -3280,7 +3304,8 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 	}
 
 	resp = &types.CountWorkflowExecutionsResponse{
-		Count: persistenceResp.Count,
+		Count:  persistenceResp.Count,
+		Groups: persistenceResp.Groups,
 	}
 	return resp, nil
 }
@@ -3854,6 +3879,53 @@ func (wh *WorkflowHandler) error(err error, scope metrics.Scope, tagsForErrorLog
 	return frontendInternalServiceError("cadence internal uncategorized error, msg: %v", err.Error())
 }
 
+// checkDomainUsageQuota rejects new workflows of a domain which is beyond its usage quota.
+// The usage is aggregated periodically, so the quota is approximate and the check fails open
+// when no aggregated usage is available, or the aggregator is disabled.
+func (wh *WorkflowHandler) checkDomainUsageQuota(ctx context.Context, domainName string, domainID string) error {
+	if !wh.config.EnableDomainUsageAggregator() {
+		return nil
+	}
+	maxOpenWorkflows := int64(wh.config.MaxOpenWorkflowsPerDomain(domainName))
+	maxHistorySize := int64(wh.config.MaxHistorySizePerDomain(domainName))
+	if maxOpenWorkflows <= 0 && maxHistorySize <= 0 {
+		return nil
+	}
+
+	resp, err := wh.domainUsageClient.DescribeDomainUsage(ctx, domainID)
+	if err != nil {
+		wh.GetLogger().Warn("Failed to get domain usage, skipping quota check.", tag.WorkflowDomainName(domainName), tag.Error(err))
+		return nil
+	}
+	usage := resp.GetUsage()
+	if maxOpenWorkflows > 0 && usage.GetOpenWorkflowCount() >= maxOpenWorkflows {
+		return errOpenWorkflowQuotaExceeded
+	}
+	if maxHistorySize > 0 && usage.GetHistorySize() >= maxHistorySize {
+		return errHistorySizeQuotaExceeded
+	}
+	return nil
+}
+
+// isWorkflowRunning returns whether the current run of a workflow is running.
+// Like the usage quota check, it fails open and returns true when the current run cannot be loaded.
+func (wh *WorkflowHandler) isWorkflowRunning(ctx context.Context, domainName string, domainID string, workflowID string) bool {
+	resp, err := wh.GetHistoryClient().GetMutableState(ctx, &types.GetMutableStateRequest{
+		DomainUUID: domainID,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return false
+		}
+		wh.GetLogger().Warn("Failed to get workflow mutable state, skipping quota check.", tag.WorkflowDomainName(domainName), tag.WorkflowID(workflowID), tag.Error(err))
+		return true
+	}
+	return resp.GetIsWorkflowRunning()
+}
+
 func (wh *WorkflowHandler) validateTaskList(t *types.TaskList, scope metrics.Scope, domain string) error {
 	if t == nil || t.GetName() == "" {
 		return errTaskListNotSet
@@ -4237,6 +4309,7 @@ func (wh *WorkflowHandler) GetClusterInfo(
 		SupportedClientVersions: &types.SupportedClientVersions{
 			GoSdk:   client.SupportedGoSDKVersion,
 			JavaSdk: client.SupportedJavaSDKVersion,
+			Cli:     client.SupportedCLIVersion,
 		},
 	}, nil
 }
@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/domainusage"
 	dc "github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
@@ -267,6 +268,124 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet
 	s.Equal(errRequestIDNotSet, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_DomainUsageQuotaExceeded() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	config.MaxOpenWorkflowsPerDomain = dc.GetIntPropertyFilteredByDomain(2)
+	config.EnableDomainUsageAggregator = dc.GetBoolPropertyFn(true)
+	wh := s.getWorkflowHandler(config)
+	mockDomainUsageClient := domainusage.NewMockClient(s.controller)
+	wh.domainUsageClient = mockDomainUsageClient
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	mockDomainUsageClient.EXPECT().DescribeDomainUsage(gomock.Any(), s.testDomainID).Return(&types.DescribeDomainUsageResponse{
+		Usage:          &types.DomainUsage{OpenWorkflowCount: 2},
+		LastUpdateTime: common.Int64Ptr(time.Now().UnixNano()),
+	}, nil)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Error(err)
+	s.Equal(errOpenWorkflowQuotaExceeded, err)
+}
+
+func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution_DomainUsageQuotaExceeded() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	config.MaxOpenWorkflowsPerDomain = dc.GetIntPropertyFilteredByDomain(2)
+	config.EnableDomainUsageAggregator = dc.GetBoolPropertyFn(true)
+	wh := s.getWorkflowHandler(config)
+	mockDomainUsageClient := domainusage.NewMockClient(s.controller)
+	wh.domainUsageClient = mockDomainUsageClient
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(2)
+	mockDomainUsageClient.EXPECT().DescribeDomainUsage(gomock.Any(), s.testDomainID).Return(&types.DescribeDomainUsageResponse{
+		Usage:          &types.DomainUsage{OpenWorkflowCount: 2},
+		LastUpdateTime: common.Int64Ptr(time.Now().UnixNano()),
+	}, nil).Times(2)
+
+	signalWithStartRequest := &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		SignalName:                          "signal-name",
+		RequestID:                           uuid.New(),
+	}
+	getMutableStateRequest := &types.GetMutableStateRequest{
+		DomainUUID: s.testDomainID,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: "workflow-id",
+		},
+	}
+
+	// the workflow is not running, so a new run would be started beyond the quota
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), getMutableStateRequest).Return(nil, &types.EntityNotExistsError{}).Times(1)
+	_, err := wh.SignalWithStartWorkflowExecution(context.Background(), signalWithStartRequest)
+	s.Equal(errOpenWorkflowQuotaExceeded, err)
+
+	// the workflow is running, so it is only signaled
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), getMutableStateRequest).Return(&types.GetMutableStateResponse{
+		IsWorkflowRunning: true,
+	}, nil).Times(1)
+	s.mockHistoryClient.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), &types.HistorySignalWithStartWorkflowExecutionRequest{
+		DomainUUID:             s.testDomainID,
+		SignalWithStartRequest: signalWithStartRequest,
+	}).Return(&types.StartWorkflowExecutionResponse{RunID: "run-id"}, nil).Times(1)
+	resp, err := wh.SignalWithStartWorkflowExecution(context.Background(), signalWithStartRequest)
+	s.NoError(err)
+	s.Equal("run-id", resp.GetRunID())
+}
+
+func (s *workflowHandlerSuite) TestCheckDomainUsageQuota() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := s.getWorkflowHandler(config)
+	mockDomainUsageClient := domainusage.NewMockClient(s.controller)
+	wh.domainUsageClient = mockDomainUsageClient
+	ctx := context.Background()
+
+	// no quota configured, usage is not fetched
+	config.EnableDomainUsageAggregator = dc.GetBoolPropertyFn(true)
+	s.NoError(wh.checkDomainUsageQuota(ctx, s.testDomain, s.testDomainID))
+
+	// aggregator disabled, usage is not fetched
+	config.EnableDomainUsageAggregator = dc.GetBoolPropertyFn(false)
+	config.MaxHistorySizePerDomain = dc.GetIntPropertyFilteredByDomain(1024)
+	s.NoError(wh.checkDomainUsageQuota(ctx, s.testDomain, s.testDomainID))
+
+	config.EnableDomainUsageAggregator = dc.GetBoolPropertyFn(true)
+	mockDomainUsageClient.EXPECT().DescribeDomainUsage(ctx, s.testDomainID).Return(nil, &types.EntityNotExistsError{}).Times(1)
+	s.NoError(wh.checkDomainUsageQuota(ctx, s.testDomain, s.testDomainID))
+
+	mockDomainUsageClient.EXPECT().DescribeDomainUsage(ctx, s.testDomainID).Return(&types.DescribeDomainUsageResponse{
+		Usage: &types.DomainUsage{HistorySize: 1023},
+	}, nil).Times(1)
+	s.NoError(wh.checkDomainUsageQuota(ctx, s.testDomain, s.testDomainID))
+
+	mockDomainUsageClient.EXPECT().DescribeDomainUsage(ctx, s.testDomainID).Return(&types.DescribeDomainUsageResponse{
+		Usage: &types.DomainUsage{HistorySize: 1024},
+	}, nil).Times(1)
+	s.Equal(errHistorySizeQuotaExceeded, wh.checkDomainUsageQuota(ctx, s.testDomain, s.testDomainID))
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadDelayStartSeconds() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -208,6 +208,10 @@ type Config struct {
 	// ShardSyncMinInterval the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval            dynamicconfig.DurationPropertyFn
 	ShardSyncTimerJitterCoefficient dynamicconfig.FloatPropertyFn
+	// ShardLoadReportWindow is the time window over which per-shard load is measured
+	ShardLoadReportWindow dynamicconfig.DurationPropertyFn
+	// ShardLoadReportTopN is the number of top domains and workflows reported per shard
+	ShardLoadReportTopN dynamicconfig.IntPropertyFn
 
 	// Time to hold a poll request before returning an empty response
 	// right now only used by GetMutableState
@@ -215,6 +219,8 @@ type Config struct {
 
 	// encoding the history events
 	EventEncodingType dynamicconfig.StringPropertyFnWithDomainFilter
+	// ID of the key used to encrypt the payloads of a domain, empty to store payloads in plaintext
+	PayloadEncryptionKeyID dynamicconfig.StringPropertyFnWithDomainFilter
 	// whether or not using ParentClosePolicy
 	EnableParentClosePolicy dynamicconfig.BoolPropertyFnWithDomainFilter
 	// whether or not enable system workers for processing parent close policy task
@@ -241,7 +247,9 @@ type Config struct {
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
-	ValidSearchAttributes             dynamicconfig.MapPropertyFn
+	ValidSearchAttributes dynamicconfig.MapPropertyFn
+	// SearchAttributeAliases maps old names of renamed search attributes to their current names
+	SearchAttributeAliases            dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
@@ -255,6 +263,8 @@ type Config struct {
 	DecisionHeartbeatTimeout dynamicconfig.DurationPropertyFnWithDomainFilter
 	// MaxDecisionStartToCloseSeconds is the StartToCloseSeconds for decision
 	MaxDecisionStartToCloseSeconds           dynamicconfig.IntPropertyFnWithDomainFilter
+	MinWorkflowRetentionDays                 dynamicconfig.IntPropertyFnWithDomainFilter
+	MaxWorkflowRetentionDays                 dynamicconfig.IntPropertyFnWithDomainFilter
 	DecisionRetryCriticalAttempts            dynamicconfig.IntPropertyFn
 	DecisionRetryMaxAttempts                 dynamicconfig.IntPropertyFnWithDomainFilter
 	NormalDecisionScheduleToStartMaxAttempts dynamicconfig.IntPropertyFnWithDomainFilter
@@ -266,6 +276,9 @@ type Config struct {
 	ReplicationTaskFetcherTimerJitterCoefficient       dynamicconfig.FloatPropertyFn
 	ReplicationTaskFetcherErrorRetryWait               dynamicconfig.DurationPropertyFn
 	ReplicationTaskFetcherServiceBusyWait              dynamicconfig.DurationPropertyFn
+	ReplicationTaskFetcherStreamingEnabled             dynamicconfig.BoolPropertyFn
+	ReplicationTaskFetcherStreamRetryInterval          dynamicconfig.DurationPropertyFn
+	ReplicationTaskLongPollExpirationInterval          dynamicconfig.DurationPropertyFn
 	ReplicationTaskProcessorErrorRetryWait             dynamicconfig.DurationPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorErrorRetryMaxAttempts      dynamicconfig.IntPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorErrorSecondRetryWait       dynamicconfig.DurationPropertyFnWithShardIDFilter
@@ -279,6 +292,15 @@ type Config struct {
 	ReplicationTaskProcessorStartWaitJitterCoefficient dynamicconfig.FloatPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorHostQPS                    dynamicconfig.FloatPropertyFn
 	ReplicationTaskProcessorShardQPS                   dynamicconfig.FloatPropertyFn
+	ReplicationDLQRedriveEnabled                       dynamicconfig.BoolPropertyFnWithDomainFilter
+	ReplicationDLQRedriveMaxAttempts                   dynamicconfig.IntPropertyFnWithDomainFilter
+	ReplicationDLQRedriveInitialInterval               dynamicconfig.DurationPropertyFnWithDomainFilter
+	ReplicationDLQRedriveMaxInterval                   dynamicconfig.DurationPropertyFnWithDomainFilter
+	ReplicationDLQRedriveScanInterval                  dynamicconfig.DurationPropertyFn
+	ReplicationDLQRedriveBatchSize                     dynamicconfig.IntPropertyFn
+	ActiveActiveClusterSearchAttribute                 dynamicconfig.StringPropertyFnWithDomainFilter
+	EnableWorkflowLifecycleEvents                      dynamicconfig.BoolPropertyFnWithDomainFilter
+	WorkflowLifecycleEventsIncludeSearchAttributes     dynamicconfig.BoolPropertyFnWithDomainFilter
 	ReplicationTaskGenerationQPS                       dynamicconfig.FloatPropertyFn
 	EnableReplicationTaskGeneration                    dynamicconfig.BoolPropertyFnWithDomainIDAndWorkflowIDFilter
 
@@ -361,6 +383,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		VisibilityClosedMaxQPS:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryVisibilityClosedMaxQPS, 300),
 		MaxAutoResetPoints:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryMaxAutoResetPoints, DefaultHistoryMaxAutoResetPoints),
 		MaxDecisionStartToCloseSeconds:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxDecisionStartToCloseSeconds, 240),
+		MinWorkflowRetentionDays:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.MinWorkflowRetentionDays, 1),
+		MaxWorkflowRetentionDays:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxWorkflowRetentionDays, 30),
 		AdvancedVisibilityWritingMode:        dc.GetStringProperty(dynamicconfig.AdvancedVisibilityWritingMode, common.GetDefaultAdvancedVisibilityWritingMode(isAdvancedVisConfigExist)),
 		EmitShardDiffLog:                     dc.GetBoolProperty(dynamicconfig.EmitShardDiffLog, false),
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
@@ -490,10 +514,13 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		ShardUpdateMinInterval:          dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
 		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
 		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
+		ShardLoadReportWindow:           dc.GetDurationProperty(dynamicconfig.ShardLoadReportWindow, time.Minute),
+		ShardLoadReportTopN:             dc.GetIntProperty(dynamicconfig.ShardLoadReportTopN, 5),
 
 		// history client: client/history/client.go set the client timeout 30s
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
 		EventEncodingType:                   dc.GetStringPropertyFilteredByDomain(dynamicconfig.DefaultEventEncoding, string(common.EncodingTypeThriftRW)),
+		PayloadEncryptionKeyID:              dc.GetStringPropertyFilteredByDomain(dynamicconfig.PayloadEncryptionKeyID, ""),
 		EnableParentClosePolicy:             dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableParentClosePolicy, true),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows, 10),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
@@ -514,6 +541,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		EnableStickyQuery: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableStickyQuery, true),
 
 		ValidSearchAttributes:                    dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
+		SearchAttributeAliases:                   dc.GetMapProperty(dynamicconfig.SearchAttributeAliases, map[string]interface{}{}),
 		SearchAttributesNumberOfKeysLimit:        dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
 		SearchAttributesTotalSizeLimit:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
@@ -529,6 +557,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		ReplicationTaskFetcherTimerJitterCoefficient:       dc.GetFloat64Property(dynamicconfig.ReplicationTaskFetcherTimerJitterCoefficient, 0.15),
 		ReplicationTaskFetcherErrorRetryWait:               dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherErrorRetryWait, time.Second),
 		ReplicationTaskFetcherServiceBusyWait:              dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherServiceBusyWait, 60*time.Second),
+		ReplicationTaskFetcherStreamingEnabled:             dc.GetBoolProperty(dynamicconfig.ReplicationTaskFetcherStreamingEnabled, false),
+		ReplicationTaskFetcherStreamRetryInterval:          dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherStreamRetryInterval, time.Minute),
+		ReplicationTaskLongPollExpirationInterval:          dc.GetDurationProperty(dynamicconfig.ReplicationTaskLongPollExpirationInterval, 10*time.Second),
 		ReplicationTaskProcessorErrorRetryWait:             dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorErrorRetryWait, 50*time.Millisecond),
 		ReplicationTaskProcessorErrorRetryMaxAttempts:      dc.GetIntPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorErrorRetryMaxAttempts, 10),
 		ReplicationTaskProcessorErrorSecondRetryWait:       dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorErrorSecondRetryWait, 5*time.Second),
@@ -542,6 +573,15 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		ReplicationTaskProcessorStartWaitJitterCoefficient: dc.GetFloat64PropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorStartWaitJitterCoefficient, 0.9),
 		ReplicationTaskProcessorHostQPS:                    dc.GetFloat64Property(dynamicconfig.ReplicationTaskProcessorHostQPS, 1500),
 		ReplicationTaskProcessorShardQPS:                   dc.GetFloat64Property(dynamicconfig.ReplicationTaskProcessorShardQPS, 5),
+		ReplicationDLQRedriveEnabled:                       dc.GetBoolPropertyFilteredByDomain(dynamicconfig.ReplicationDLQRedriveEnabled, false),
+		ReplicationDLQRedriveMaxAttempts:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.ReplicationDLQRedriveMaxAttempts, 10),
+		ReplicationDLQRedriveInitialInterval:               dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ReplicationDLQRedriveInitialInterval, time.Minute),
+		ReplicationDLQRedriveMaxInterval:                   dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ReplicationDLQRedriveMaxInterval, time.Hour),
+		ReplicationDLQRedriveScanInterval:                  dc.GetDurationProperty(dynamicconfig.ReplicationDLQRedriveScanInterval, time.Minute),
+		ReplicationDLQRedriveBatchSize:                     dc.GetIntProperty(dynamicconfig.ReplicationDLQRedriveBatchSize, 100),
+		ActiveActiveClusterSearchAttribute:                 dc.GetStringPropertyFilteredByDomain(dynamicconfig.ActiveActiveClusterSearchAttribute, ""),
+		EnableWorkflowLifecycleEvents:                      dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableWorkflowLifecycleEvents, false),
+		WorkflowLifecycleEventsIncludeSearchAttributes:     dc.GetBoolPropertyFilteredByDomain(dynamicconfig.WorkflowLifecycleEventsIncludeSearchAttributes, false),
 		ReplicationTaskGenerationQPS:                       dc.GetFloat64Property(dynamicconfig.ReplicationTaskGenerationQPS, 100),
 		EnableReplicationTaskGeneration:                    dc.GetBoolPropertyFilteredByDomainIDAndWorkflowID(dynamicconfig.EnableReplicationTaskGeneration, true),
 
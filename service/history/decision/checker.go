@@ -78,6 +78,7 @@ func newAttrValidator(
 		searchAttributesValidator: validator.NewSearchAttributesValidator(
 			logger,
 			config.ValidSearchAttributes,
+			config.SearchAttributeAliases,
 			config.SearchAttributesNumberOfKeysLimit,
 			config.SearchAttributesSizeOfValueLimit,
 			config.SearchAttributesTotalSizeLimit,
@@ -646,6 +647,13 @@ func (v *attrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 	if err != nil {
 		return err
 	}
+	if err := common.ValidateRetentionOverride(
+		attributes.RetentionPeriodInDays,
+		int32(v.config.MinWorkflowRetentionDays(domainName)),
+		int32(v.config.MaxWorkflowRetentionDays(domainName)),
+	); err != nil {
+		return err
+	}
 	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName)
 }
 
@@ -98,6 +98,8 @@ func (s *attrValidatorSuite) SetupTest() {
 			time.Duration(s.testActivityMaxScheduleToStartTimeoutForRetryInSeconds) * time.Second,
 		),
 		EnableCrossClusterOperations: dynamicconfig.GetBoolPropertyFnFilteredByDomain(false),
+		MinWorkflowRetentionDays:     dynamicconfig.GetIntPropertyFilteredByDomain(1),
+		MaxWorkflowRetentionDays:     dynamicconfig.GetIntPropertyFilteredByDomain(30),
 	}
 	s.validator = newAttrValidator(
 		s.mockDomainCache,
@@ -176,6 +178,33 @@ func (s *attrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes() {
 	s.Nil(err)
 }
 
+func (s *attrValidatorSuite) TestValidateContinueAsNewWorkflowExecutionAttributes_RetentionOverride() {
+	domainName := "testDomain"
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:                    s.testDomainID,
+		WorkflowTypeName:            "workflow type",
+		TaskList:                    "task list",
+		WorkflowTimeout:             100,
+		DecisionStartToCloseTimeout: 10,
+		RetentionDays:               3,
+	}
+	s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return(domainName, nil).AnyTimes()
+
+	attributes := &types.ContinueAsNewWorkflowExecutionDecisionAttributes{}
+	err := s.validator.validateContinueAsNewWorkflowExecutionAttributes(attributes, executionInfo, metrics.HistoryRespondDecisionTaskCompletedScope, domainName)
+	s.NoError(err)
+	s.Nil(attributes.RetentionPeriodInDays)
+
+	attributes = &types.ContinueAsNewWorkflowExecutionDecisionAttributes{RetentionPeriodInDays: common.Int32Ptr(7)}
+	err = s.validator.validateContinueAsNewWorkflowExecutionAttributes(attributes, executionInfo, metrics.HistoryRespondDecisionTaskCompletedScope, domainName)
+	s.NoError(err)
+	s.Equal(int32(7), attributes.GetRetentionPeriodInDays())
+
+	attributes = &types.ContinueAsNewWorkflowExecutionDecisionAttributes{RetentionPeriodInDays: common.Int32Ptr(31)}
+	err = s.validator.validateContinueAsNewWorkflowExecutionAttributes(attributes, executionInfo, metrics.HistoryRespondDecisionTaskCompletedScope, domainName)
+	s.EqualError(err, "BadRequestError{Message: RetentionPeriodInDays must be between 1 and 30 days.}")
+}
+
 func (s *attrValidatorSuite) TestValidateCrossDomainCall_LocalToLocal() {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: s.testDomainID},
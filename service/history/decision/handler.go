@@ -177,6 +177,10 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, workflow.ErrNotExists
 			}
+			if mutableState.IsWorkflowExecutionSuspended() {
+				// the decision task will be re-generated when the workflow is resumed
+				return nil, workflow.ErrSuspended
+			}
 
 			decision, isRunning := mutableState.GetDecisionInfo(scheduleID)
 
@@ -324,6 +328,9 @@ Update_History_Loop:
 		if !msBuilder.IsWorkflowExecutionRunning() {
 			return nil, workflow.ErrAlreadyCompleted
 		}
+		if err := workflow.ValidateActive(msBuilder); err != nil {
+			return nil, err
+		}
 		executionStats, err := wfContext.LoadExecutionStats(ctx)
 		if err != nil {
 			return nil, err
@@ -728,6 +728,11 @@ func (handler *taskHandlerImpl) handleDecisionContinueAsNewWorkflow(
 
 	executionInfo := handler.mutableState.GetExecutionInfo()
 
+	// Inherit retention override from previous execution if not provided on decision
+	if attr.RetentionPeriodInDays == nil && executionInfo.RetentionDays > 0 {
+		attr.RetentionPeriodInDays = common.Int32Ptr(executionInfo.RetentionDays)
+	}
+
 	if err := handler.validateDecisionAttr(
 		func() error {
 			return handler.attrValidator.validateContinueAsNewWorkflowExecutionAttributes(
@@ -1000,6 +1005,7 @@ func (handler *taskHandlerImpl) retryCronContinueAsNew(
 		Header:                              attr.Header,
 		Memo:                                attr.Memo,
 		SearchAttributes:                    attr.SearchAttributes,
+		RetentionPeriodInDays:               attr.RetentionPeriodInDays,
 	}
 
 	_, newStateBuilder, err := handler.mutableState.AddContinueAsNewEvent(
@@ -55,6 +55,14 @@ type (
 		SignalWithStartWorkflowExecution(ctx context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *types.HistoryTerminateWorkflowExecutionRequest) error
+		SuspendWorkflowExecution(ctx context.Context, request *types.HistorySuspendWorkflowExecutionRequest) error
+		FailoverWorkflowExecution(ctx context.Context, request *types.HistoryFailoverWorkflowExecutionRequest) error
+		ResumeWorkflowExecution(ctx context.Context, request *types.HistoryResumeWorkflowExecutionRequest) error
+		ReindexWorkflowExecution(ctx context.Context, request *types.HistoryReindexWorkflowExecutionRequest) error
+		RebuildMutableState(ctx context.Context, request *types.HistoryRebuildMutableStateRequest) (*types.RebuildMutableStateResponse, error)
+		ListWorkflowTasks(ctx context.Context, request *types.HistoryListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error)
+		DeleteWorkflowTask(ctx context.Context, request *types.HistoryDeleteWorkflowTaskRequest) error
+		ReenqueueWorkflowTask(ctx context.Context, request *types.HistoryReenqueueWorkflowTaskRequest) error
 		ResetWorkflowExecution(ctx context.Context, request *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(ctx context.Context, request *types.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *types.RecordChildExecutionCompletedRequest) error
@@ -62,6 +70,7 @@ type (
 		SyncShardStatus(ctx context.Context, request *types.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *types.SyncActivityRequest) error
 		GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID int64) (*types.ReplicationMessages, error)
+		WatchNewReplicationTasks() <-chan struct{}
 		GetDLQReplicationMessages(ctx context.Context, taskInfos []*types.ReplicationTaskInfo) ([]*types.ReplicationTask, error)
 		GetCrossClusterTasks(ctx context.Context, targetCluster string) ([]*types.CrossClusterTaskRequest, error)
 		RespondCrossClusterTasksCompleted(ctx context.Context, targetCluster string, responses []*types.CrossClusterTaskResponse) error
@@ -82,5 +91,6 @@ type (
 		NotifyNewTransferTasks(executionInfo *persistence.WorkflowExecutionInfo, tasks []persistence.Task)
 		NotifyNewTimerTasks(executionInfo *persistence.WorkflowExecutionInfo, tasks []persistence.Task)
 		NotifyNewCrossClusterTasks(executionInfo *persistence.WorkflowExecutionInfo, tasks []persistence.Task)
+		NotifyNewReplicationTasks(executionInfo *persistence.WorkflowExecutionInfo, tasks []persistence.Task)
 	}
 )
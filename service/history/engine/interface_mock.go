@@ -361,6 +361,120 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
 
+// SuspendWorkflowExecution mocks base method
+func (m *MockEngine) SuspendWorkflowExecution(ctx context.Context, request *types.HistorySuspendWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendWorkflowExecution indicates an expected call of SuspendWorkflowExecution
+func (mr *MockEngineMockRecorder) SuspendWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).SuspendWorkflowExecution), ctx, request)
+}
+
+// FailoverWorkflowExecution mocks base method
+func (m *MockEngine) FailoverWorkflowExecution(ctx context.Context, request *types.HistoryFailoverWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailoverWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailoverWorkflowExecution indicates an expected call of FailoverWorkflowExecution
+func (mr *MockEngineMockRecorder) FailoverWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).FailoverWorkflowExecution), ctx, request)
+}
+
+// ResumeWorkflowExecution mocks base method
+func (m *MockEngine) ResumeWorkflowExecution(ctx context.Context, request *types.HistoryResumeWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution
+func (mr *MockEngineMockRecorder) ResumeWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ResumeWorkflowExecution), ctx, request)
+}
+
+// ReindexWorkflowExecution mocks base method
+func (m *MockEngine) ReindexWorkflowExecution(ctx context.Context, request *types.HistoryReindexWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReindexWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReindexWorkflowExecution indicates an expected call of ReindexWorkflowExecution
+func (mr *MockEngineMockRecorder) ReindexWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ReindexWorkflowExecution), ctx, request)
+}
+
+// RebuildMutableState mocks base method
+func (m *MockEngine) RebuildMutableState(ctx context.Context, request *types.HistoryRebuildMutableStateRequest) (*types.RebuildMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildMutableState", ctx, request)
+	ret0, _ := ret[0].(*types.RebuildMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildMutableState indicates an expected call of RebuildMutableState
+func (mr *MockEngineMockRecorder) RebuildMutableState(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockEngine)(nil).RebuildMutableState), ctx, request)
+}
+
+// ListWorkflowTasks mocks base method
+func (m *MockEngine) ListWorkflowTasks(ctx context.Context, request *types.HistoryListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowTasks", ctx, request)
+	ret0, _ := ret[0].(*types.ListWorkflowTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowTasks indicates an expected call of ListWorkflowTasks
+func (mr *MockEngineMockRecorder) ListWorkflowTasks(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowTasks", reflect.TypeOf((*MockEngine)(nil).ListWorkflowTasks), ctx, request)
+}
+
+// DeleteWorkflowTask mocks base method
+func (m *MockEngine) DeleteWorkflowTask(ctx context.Context, request *types.HistoryDeleteWorkflowTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowTask", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowTask indicates an expected call of DeleteWorkflowTask
+func (mr *MockEngineMockRecorder) DeleteWorkflowTask(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowTask", reflect.TypeOf((*MockEngine)(nil).DeleteWorkflowTask), ctx, request)
+}
+
+// ReenqueueWorkflowTask mocks base method
+func (m *MockEngine) ReenqueueWorkflowTask(ctx context.Context, request *types.HistoryReenqueueWorkflowTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReenqueueWorkflowTask", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReenqueueWorkflowTask indicates an expected call of ReenqueueWorkflowTask
+func (mr *MockEngineMockRecorder) ReenqueueWorkflowTask(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueWorkflowTask", reflect.TypeOf((*MockEngine)(nil).ReenqueueWorkflowTask), ctx, request)
+}
+
 // ResetWorkflowExecution mocks base method
 func (m *MockEngine) ResetWorkflowExecution(ctx context.Context, request *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -461,6 +575,20 @@ func (mr *MockEngineMockRecorder) GetReplicationMessages(ctx, pollingCluster, la
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetReplicationMessages), ctx, pollingCluster, lastReadMessageID)
 }
 
+// WatchNewReplicationTasks mocks base method
+func (m *MockEngine) WatchNewReplicationTasks() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchNewReplicationTasks")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// WatchNewReplicationTasks indicates an expected call of WatchNewReplicationTasks
+func (mr *MockEngineMockRecorder) WatchNewReplicationTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchNewReplicationTasks", reflect.TypeOf((*MockEngine)(nil).WatchNewReplicationTasks))
+}
+
 // GetDLQReplicationMessages mocks base method
 func (m *MockEngine) GetDLQReplicationMessages(ctx context.Context, taskInfos []*types.ReplicationTaskInfo) ([]*types.ReplicationTask, error) {
 	m.ctrl.T.Helper()
@@ -726,3 +854,15 @@ func (mr *MockEngineMockRecorder) NotifyNewCrossClusterTasks(executionInfo, task
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewCrossClusterTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewCrossClusterTasks), executionInfo, tasks)
 }
+
+// NotifyNewReplicationTasks mocks base method
+func (m *MockEngine) NotifyNewReplicationTasks(executionInfo *persistence.WorkflowExecutionInfo, tasks []persistence.Task) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyNewReplicationTasks", executionInfo, tasks)
+}
+
+// NotifyNewReplicationTasks indicates an expected call of NotifyNewReplicationTasks
+func (mr *MockEngineMockRecorder) NotifyNewReplicationTasks(executionInfo, tasks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewReplicationTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewReplicationTasks), executionInfo, tasks)
+}
@@ -138,7 +138,7 @@ func (c *Cache) GetAndCreateWorkflowExecution(
 	releaseFunc := NoopReleaseFn
 	// If cache hit, we need to lock the cache to prevent race condition
 	if cacheHit {
-		if err := contextFromCache.Lock(ctx); err != nil {
+		if err := c.lockWorkflowExecution(ctx, contextFromCache, domainID, execution.GetWorkflowID()); err != nil {
 			// ctx is done before lock can be acquired
 			c.Release(key)
 			c.metricsClient.IncCounter(metrics.HistoryCacheGetAndCreateScope, metrics.CacheFailures)
@@ -235,7 +235,7 @@ func (c *Cache) getOrCreateWorkflowExecutionInternal(
 	//  Consider revisiting this if it causes too much GC activity
 	releaseFunc := c.makeReleaseFunc(key, workflowCtx, forceClearContext)
 
-	if err := workflowCtx.Lock(ctx); err != nil {
+	if err := c.lockWorkflowExecution(ctx, workflowCtx, domainID, execution.GetWorkflowID()); err != nil {
 		// ctx is done before lock can be acquired
 		c.Release(key)
 		c.metricsClient.IncCounter(scope, metrics.CacheFailures)
@@ -245,6 +245,24 @@ func (c *Cache) getOrCreateWorkflowExecutionInternal(
 	return workflowCtx, releaseFunc, nil
 }
 
+// lockWorkflowExecution acquires the workflow lock and reports the request
+// as well as the time spent waiting for the lock to the shard load reporter
+func (c *Cache) lockWorkflowExecution(
+	ctx context.Context,
+	workflowCtx Context,
+	domainID string,
+	workflowID string,
+) error {
+
+	loadReporter := c.shard.GetLoadReporter()
+	loadReporter.RecordRequest(domainID, workflowID)
+	timeSource := c.shard.GetTimeSource()
+	startTime := timeSource.Now()
+	err := workflowCtx.Lock(ctx)
+	loadReporter.RecordLockWait(domainID, workflowID, timeSource.Now().Sub(startTime))
+	return err
+}
+
 func (c *Cache) validateWorkflowExecutionInfo(
 	ctx context.Context,
 	domainID string,
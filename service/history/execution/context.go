@@ -332,6 +332,7 @@ func (c *contextImpl) CreateWorkflowExecution(
 		NewWorkflowSnapshot: *newWorkflow,
 	}
 
+	usage := newWorkflowSnapshotUsage(newWorkflow, historySize)
 	historySize += c.GetHistorySize()
 	c.SetHistorySize(historySize)
 	createRequest.NewWorkflowSnapshot.ExecutionStats = &persistence.ExecutionStats{
@@ -347,6 +348,7 @@ func (c *contextImpl) CreateWorkflowExecution(
 	}
 
 	c.notifyTasksFromWorkflowSnapshot(newWorkflow)
+	c.shard.AddDomainUsage(c.domainID, usage)
 
 	// finally emit session stats
 	domainName := c.GetDomainName()
@@ -385,12 +387,14 @@ func (c *contextImpl) ConflictResolveWorkflowExecution(
 		return err
 	}
 	resetHistorySize := c.GetHistorySize()
+	resetUsage := newWorkflowEventsUsage(resetWorkflowEventsSeq, 0)
 	for _, workflowEvents := range resetWorkflowEventsSeq {
 		eventsSize, err := c.PersistNonStartWorkflowBatchEvents(ctx, workflowEvents)
 		if err != nil {
 			return err
 		}
 		resetHistorySize += eventsSize
+		resetUsage.HistorySize += eventsSize
 	}
 	c.SetHistorySize(resetHistorySize)
 	resetWorkflow.ExecutionStats = &persistence.ExecutionStats{
@@ -399,6 +403,7 @@ func (c *contextImpl) ConflictResolveWorkflowExecution(
 
 	var newWorkflow *persistence.WorkflowSnapshot
 	var newWorkflowEventsSeq []*persistence.WorkflowEvents
+	var newUsage *types.DomainUsage
 	if newContext != nil && newMutableState != nil {
 
 		defer func() {
@@ -420,6 +425,7 @@ func (c *contextImpl) ConflictResolveWorkflowExecution(
 		if err != nil {
 			return err
 		}
+		newUsage = newWorkflowSnapshotUsage(newWorkflow, eventsSize)
 		newWorkflowSizeSize += eventsSize
 		newContext.SetHistorySize(newWorkflowSizeSize)
 		newWorkflow.ExecutionStats = &persistence.ExecutionStats{
@@ -429,6 +435,7 @@ func (c *contextImpl) ConflictResolveWorkflowExecution(
 
 	var currentWorkflow *persistence.WorkflowMutation
 	var currentWorkflowEventsSeq []*persistence.WorkflowEvents
+	var currentUsage *types.DomainUsage
 	if currentContext != nil && currentMutableState != nil && currentTransactionPolicy != nil {
 
 		defer func() {
@@ -445,12 +452,14 @@ func (c *contextImpl) ConflictResolveWorkflowExecution(
 			return err
 		}
 		currentWorkflowSize := currentContext.GetHistorySize()
+		currentUsage = newWorkflowEventsUsage(currentWorkflowEventsSeq, 0)
 		for _, workflowEvents := range currentWorkflowEventsSeq {
 			eventsSize, err := c.PersistNonStartWorkflowBatchEvents(ctx, workflowEvents)
 			if err != nil {
 				return err
 			}
 			currentWorkflowSize += eventsSize
+			currentUsage.HistorySize += eventsSize
 		}
 		currentContext.SetHistorySize(currentWorkflowSize)
 		currentWorkflow.ExecutionStats = &persistence.ExecutionStats{
@@ -506,6 +515,14 @@ func (c *contextImpl) ConflictResolveWorkflowExecution(
 	c.notifyTasksFromWorkflowSnapshot(newWorkflow)
 	c.notifyTasksFromWorkflowMutation(currentWorkflow)
 
+	c.shard.AddDomainUsage(c.domainID, resetUsage)
+	if newUsage != nil {
+		c.shard.AddDomainUsage(newContext.GetDomainID(), newUsage)
+	}
+	if currentUsage != nil {
+		c.shard.AddDomainUsage(currentContext.GetDomainID(), currentUsage)
+	}
+
 	// finally emit session stats
 	domainName := c.GetDomainName()
 	emitWorkflowHistoryStats(
@@ -626,12 +643,14 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 	}
 
 	currentWorkflowSize := c.GetHistorySize()
+	currentUsage := newWorkflowEventsUsage(currentWorkflowEventsSeq, 0)
 	for _, workflowEvents := range currentWorkflowEventsSeq {
 		eventsSize, err := c.PersistNonStartWorkflowBatchEvents(ctx, workflowEvents)
 		if err != nil {
 			return err
 		}
 		currentWorkflowSize += eventsSize
+		currentUsage.HistorySize += eventsSize
 	}
 	c.SetHistorySize(currentWorkflowSize)
 	currentWorkflow.ExecutionStats = &persistence.ExecutionStats{
@@ -640,6 +659,7 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 
 	var newWorkflow *persistence.WorkflowSnapshot
 	var newWorkflowEventsSeq []*persistence.WorkflowEvents
+	var newUsage *types.DomainUsage
 	if newContext != nil && newMutableState != nil && newWorkflowTransactionPolicy != nil {
 
 		defer func() {
@@ -672,6 +692,7 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 			}
 		}
 
+		newUsage = newWorkflowSnapshotUsage(newWorkflow, eventsSize)
 		newWorkflowSizeSize += eventsSize
 		newContext.SetHistorySize(newWorkflowSizeSize)
 		newWorkflow.ExecutionStats = &persistence.ExecutionStats{
@@ -736,6 +757,11 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 	// notify new workflow tasks
 	c.notifyTasksFromWorkflowSnapshot(newWorkflow)
 
+	c.shard.AddDomainUsage(c.domainID, currentUsage)
+	if newUsage != nil {
+		c.shard.AddDomainUsage(newContext.GetDomainID(), newUsage)
+	}
+
 	// finally emit session stats
 	domainName := c.GetDomainName()
 	emitWorkflowHistoryStats(
@@ -775,6 +801,7 @@ func (c *contextImpl) notifyTasksFromWorkflowSnapshot(
 		workflowSnapShot.TransferTasks,
 		workflowSnapShot.TimerTasks,
 		workflowSnapShot.CrossClusterTasks,
+		workflowSnapShot.ReplicationTasks,
 	)
 }
 
@@ -790,6 +817,7 @@ func (c *contextImpl) notifyTasksFromWorkflowMutation(
 		workflowMutation.TransferTasks,
 		workflowMutation.TimerTasks,
 		workflowMutation.CrossClusterTasks,
+		workflowMutation.ReplicationTasks,
 	)
 }
 
@@ -798,10 +826,12 @@ func (c *contextImpl) notifyTasks(
 	transferTasks []persistence.Task,
 	timerTasks []persistence.Task,
 	crossClusterTasks []persistence.Task,
+	replicationTasks []persistence.Task,
 ) {
 	c.shard.GetEngine().NotifyNewTransferTasks(executionInfo, transferTasks)
 	c.shard.GetEngine().NotifyNewTimerTasks(executionInfo, timerTasks)
 	c.shard.GetEngine().NotifyNewCrossClusterTasks(executionInfo, crossClusterTasks)
+	c.shard.GetEngine().NotifyNewReplicationTasks(executionInfo, replicationTasks)
 }
 
 func (c *contextImpl) mergeContinueAsNewReplicationTasks(
@@ -1156,7 +1186,22 @@ func (c *contextImpl) ReapplyEvents(
 	defer cancel()
 
 	activeCluster := domainEntry.GetReplicationConfig().ActiveClusterName
-	if activeCluster == c.shard.GetClusterMetadata().GetCurrentClusterName() {
+	if domainEntry.IsActiveActive() {
+		// the current run of an active-active domain workflow can be active in any cluster,
+		// try the current cluster first and follow the domain not active error otherwise
+		err := c.shard.GetEngine().ReapplyEvents(
+			ctx,
+			domainID,
+			workflowID,
+			runID,
+			reapplyEvents,
+		)
+		notActiveErr, ok := err.(*types.DomainNotActiveError)
+		if !ok {
+			return err
+		}
+		activeCluster = notActiveErr.ActiveCluster
+	} else if activeCluster == c.shard.GetClusterMetadata().GetCurrentClusterName() {
 		return c.shard.GetEngine().ReapplyEvents(
 			ctx,
 			domainID,
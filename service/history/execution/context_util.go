@@ -157,3 +157,56 @@ func emitWorkflowCompletionStats(
 		)
 	}
 }
+
+// newWorkflowSnapshotUsage returns the domain usage delta of a newly persisted workflow run
+func newWorkflowSnapshotUsage(
+	snapshot *persistence.WorkflowSnapshot,
+	historySize int64,
+) *types.DomainUsage {
+	usage := &types.DomainUsage{
+		HistorySize:       historySize,
+		HistoryEventCount: snapshot.ExecutionInfo.NextEventID - 1,
+	}
+	if snapshot.ExecutionInfo.State == persistence.WorkflowStateCompleted {
+		usage.ClosedWorkflowCount = 1
+	} else {
+		usage.OpenWorkflowCount = 1
+	}
+	return usage
+}
+
+// newWorkflowEventsUsage returns the domain usage delta of history events appended to an existing workflow run
+func newWorkflowEventsUsage(
+	eventsSeq []*persistence.WorkflowEvents,
+	historySize int64,
+) *types.DomainUsage {
+	usage := &types.DomainUsage{
+		HistorySize: historySize,
+	}
+	for _, workflowEvents := range eventsSeq {
+		usage.HistoryEventCount += int64(len(workflowEvents.Events))
+		for _, event := range workflowEvents.Events {
+			if isWorkflowCloseEvent(event.GetEventType()) {
+				usage.OpenWorkflowCount--
+				usage.ClosedWorkflowCount++
+			}
+		}
+	}
+	return usage
+}
+
+func isWorkflowCloseEvent(
+	eventType types.EventType,
+) bool {
+	switch eventType {
+	case types.EventTypeWorkflowExecutionCompleted,
+		types.EventTypeWorkflowExecutionFailed,
+		types.EventTypeWorkflowExecutionTimedOut,
+		types.EventTypeWorkflowExecutionTerminated,
+		types.EventTypeWorkflowExecutionContinuedAsNew,
+		types.EventTypeWorkflowExecutionCanceled:
+		return true
+	default:
+		return false
+	}
+}
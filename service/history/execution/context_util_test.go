@@ -0,0 +1,78 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestNewWorkflowSnapshotUsage(t *testing.T) {
+	snapshot := &persistence.WorkflowSnapshot{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			State:       persistence.WorkflowStateRunning,
+			NextEventID: 3,
+		},
+	}
+	assert.Equal(t, &types.DomainUsage{
+		HistorySize:       1024,
+		HistoryEventCount: 2,
+		OpenWorkflowCount: 1,
+	}, newWorkflowSnapshotUsage(snapshot, 1024))
+
+	snapshot.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	assert.Equal(t, &types.DomainUsage{
+		HistorySize:         1024,
+		HistoryEventCount:   2,
+		ClosedWorkflowCount: 1,
+	}, newWorkflowSnapshotUsage(snapshot, 1024))
+}
+
+func TestNewWorkflowEventsUsage(t *testing.T) {
+	eventsSeq := []*persistence.WorkflowEvents{
+		{
+			Events: []*types.HistoryEvent{
+				{EventID: 5, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+				{EventID: 6, EventType: types.EventTypeActivityTaskScheduled.Ptr()},
+			},
+		},
+	}
+	assert.Equal(t, &types.DomainUsage{
+		HistorySize:       512,
+		HistoryEventCount: 2,
+	}, newWorkflowEventsUsage(eventsSeq, 512))
+
+	eventsSeq = append(eventsSeq, &persistence.WorkflowEvents{
+		Events: []*types.HistoryEvent{
+			{EventID: 7, EventType: types.EventTypeWorkflowExecutionContinuedAsNew.Ptr()},
+		},
+	})
+	assert.Equal(t, &types.DomainUsage{
+		HistorySize:         512,
+		HistoryEventCount:   3,
+		OpenWorkflowCount:   -1,
+		ClosedWorkflowCount: 1,
+	}, newWorkflowEventsUsage(eventsSeq, 512))
+}
@@ -240,6 +240,24 @@ func (b *HistoryBuilder) AddWorkflowExecutionTerminatedEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddWorkflowExecutionSuspendedEvent adds WorkflowExecutionSuspended event to history
+func (b *HistoryBuilder) AddWorkflowExecutionSuspendedEvent(
+	reason string,
+	identity string,
+) *types.HistoryEvent {
+	event := b.newWorkflowExecutionSuspendedEvent(reason, identity)
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionResumedEvent adds WorkflowExecutionResumed event to history
+func (b *HistoryBuilder) AddWorkflowExecutionResumedEvent(
+	reason string,
+	identity string,
+) *types.HistoryEvent {
+	event := b.newWorkflowExecutionResumedEvent(reason, identity)
+	return b.addEventToHistory(event)
+}
+
 // AddContinuedAsNewEvent adds WorkflowExecutionContinuedAsNew event to history
 func (b *HistoryBuilder) AddContinuedAsNewEvent(decisionCompletedEventID int64, newRunID string,
 	attributes *types.ContinueAsNewWorkflowExecutionDecisionAttributes) *types.HistoryEvent {
@@ -576,6 +594,7 @@ func (b *HistoryBuilder) newWorkflowExecutionStartedEvent(
 		OriginalExecutionRunID:              originalRunID,
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
+		RetentionPeriodInDays:               request.RetentionPeriodInDays,
 	}
 	if parentInfo := startRequest.ParentExecutionInfo; parentInfo != nil {
 		attributes.ParentWorkflowDomainID = &parentInfo.DomainUUID
@@ -811,6 +830,28 @@ func (b *HistoryBuilder) newWorkflowExecutionTerminatedEvent(
 	return historyEvent
 }
 
+func (b *HistoryBuilder) newWorkflowExecutionSuspendedEvent(
+	reason string, identity string) *types.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionSuspended)
+	attributes := &types.WorkflowExecutionSuspendedEventAttributes{}
+	attributes.Reason = reason
+	attributes.Identity = identity
+	historyEvent.WorkflowExecutionSuspendedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *HistoryBuilder) newWorkflowExecutionResumedEvent(
+	reason string, identity string) *types.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionResumed)
+	attributes := &types.WorkflowExecutionResumedEventAttributes{}
+	attributes.Reason = reason
+	attributes.Identity = identity
+	historyEvent.WorkflowExecutionResumedEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *HistoryBuilder) newMarkerRecordedEventAttributes(DecisionTaskCompletedEventID int64,
 	request *types.RecordMarkerDecisionAttributes) *types.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeMarkerRecorded)
@@ -45,6 +45,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/events"
+	"github.com/uber/cadence/service/history/lifecycle"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/shard"
 )
@@ -776,7 +777,7 @@ func (e *mutableStateBuilder) assignTaskIDToEvents() error {
 func (e *mutableStateBuilder) IsCurrentWorkflowGuaranteed() bool {
 	// stateInDB is used like a bloom filter:
 	//
-	// 1. stateInDB being created / running meaning that this workflow must be the current
+	// 1. stateInDB being created / running / suspended meaning that this workflow must be the current
 	//  workflow (assuming there is no rebuild of mutable state).
 	// 2. stateInDB being completed does not guarantee this workflow being the current workflow
 	// 3. stateInDB being zombie guarantees this workflow not being the current workflow
@@ -789,6 +790,8 @@ func (e *mutableStateBuilder) IsCurrentWorkflowGuaranteed() bool {
 		return true
 	case persistence.WorkflowStateRunning:
 		return true
+	case persistence.WorkflowStateSuspended:
+		return true
 	case persistence.WorkflowStateCompleted:
 		return false
 	case persistence.WorkflowStateZombie:
@@ -1534,6 +1537,8 @@ func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
 		return true
 	case persistence.WorkflowStateRunning:
 		return true
+	case persistence.WorkflowStateSuspended:
+		return true
 	case persistence.WorkflowStateCompleted:
 		return false
 	case persistence.WorkflowStateZombie:
@@ -1545,6 +1550,10 @@ func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
 	}
 }
 
+func (e *mutableStateBuilder) IsWorkflowExecutionSuspended() bool {
+	return e.executionInfo.State == persistence.WorkflowStateSuspended
+}
+
 func (e *mutableStateBuilder) IsWorkflowCompleted() bool {
 	return e.executionInfo.State == persistence.WorkflowStateCompleted
 }
@@ -1632,6 +1641,7 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		CronSchedule:                        attributes.CronSchedule,
 		Memo:                                attributes.Memo,
 		SearchAttributes:                    attributes.SearchAttributes,
+		RetentionPeriodInDays:               attributes.RetentionPeriodInDays,
 	}
 
 	req := &types.HistoryStartWorkflowExecutionRequest{
@@ -1785,6 +1795,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	e.executionInfo.DecisionTimeout = 0
 
 	e.executionInfo.CronSchedule = event.GetCronSchedule()
+	e.executionInfo.RetentionDays = event.GetRetentionPeriodInDays()
 
 	if parentDomainID != nil {
 		e.executionInfo.ParentDomainID = *parentDomainID
@@ -1813,11 +1824,17 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 		e.executionInfo.NonRetriableErrors = event.RetryPolicy.NonRetriableErrorReasons
 	}
 
+	// the retention override is inherited from the previous run on continue as new,
+	// so its reset points expire with the previous run's retention
+	retentionDays := e.domainEntry.GetRetentionDays(e.executionInfo.WorkflowID)
+	if e.executionInfo.RetentionDays > 0 {
+		retentionDays = e.executionInfo.RetentionDays
+	}
 	e.executionInfo.AutoResetPoints = rolloverAutoResetPointsWithExpiringTime(
 		event.GetPrevAutoResetPoints(),
 		event.GetContinuedExecutionRunID(),
 		startEvent.GetTimestamp(),
-		e.domainEntry.GetRetentionDays(e.executionInfo.WorkflowID),
+		retentionDays,
 	)
 
 	if event.Memo != nil {
@@ -3242,6 +3259,75 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionTerminatedEvent(
 	return nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionSuspendedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowSuspended
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionSuspendedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionSuspendedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionSuspendedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	// NOTE: for zombie workflow, should not change the state
+	state, _ := e.GetWorkflowStateCloseStatus()
+	if state == persistence.WorkflowStateZombie {
+		return nil
+	}
+	return e.UpdateWorkflowStateCloseStatus(
+		persistence.WorkflowStateSuspended,
+		persistence.WorkflowCloseStatusNone,
+	)
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionResumedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowResumed
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionResumedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionResumedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionResumedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	state, _ := e.GetWorkflowStateCloseStatus()
+	if state != persistence.WorkflowStateSuspended {
+		return nil
+	}
+
+	// same as reviving a zombie workflow, the state depends on whether the first decision is scheduled
+	state = persistence.WorkflowStateCreated
+	if e.HasProcessedOrPendingDecision() {
+		state = persistence.WorkflowStateRunning
+	}
+	return e.UpdateWorkflowStateCloseStatus(
+		state,
+		persistence.WorkflowCloseStatusNone,
+	)
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 	signalName string,
 	input []byte,
@@ -3315,6 +3401,12 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 		e.logger,
 		e.domainEntry,
 	).(*mutableStateBuilder)
+	if e.domainEntry.IsActiveActive() {
+		// new run of an active-active domain workflow stays in the cluster of the current run
+		if err := newStateBuilder.UpdateCurrentVersion(e.GetCurrentVersion(), true); err != nil {
+			return nil, nil, err
+		}
+	}
 
 	if _, err = newStateBuilder.addWorkflowExecutionStartedEventForContinueAsNew(
 		parentInfo,
@@ -3347,14 +3439,14 @@ func rolloverAutoResetPointsWithExpiringTime(
 	resetPoints *types.ResetPoints,
 	prevRunID string,
 	nowNano int64,
-	domainRetentionDays int32,
+	retentionDays int32,
 ) *types.ResetPoints {
 
 	if resetPoints == nil || resetPoints.Points == nil {
 		return resetPoints
 	}
 	newPoints := make([]*types.ResetPointInfo, 0, len(resetPoints.Points))
-	expiringTimeNano := nowNano + int64(time.Duration(domainRetentionDays)*time.Hour*24)
+	expiringTimeNano := nowNano + int64(time.Duration(retentionDays)*time.Hour*24)
 	for _, rp := range resetPoints.Points {
 		if rp.GetRunID() == prevRunID {
 			rp.ExpiringTimeNano = common.Int64Ptr(expiringTimeNano)
@@ -3947,7 +4039,13 @@ func (e *mutableStateBuilder) StartTransaction(
 ) (bool, error) {
 
 	e.domainEntry = domainEntry
-	if err := e.UpdateCurrentVersion(domainEntry.GetFailoverVersion(), false); err != nil {
+	version := domainEntry.GetFailoverVersion()
+	if domainEntry.IsActiveActive() {
+		// workflow of an active-active domain keeps its own version,
+		// it only changes when the workflow itself is failed over
+		version = common.EmptyVersion
+	}
+	if err := e.UpdateCurrentVersion(version, false); err != nil {
 		return false, err
 	}
 
@@ -4253,6 +4351,10 @@ func (e *mutableStateBuilder) prepareEventsAndReplicationTasks(
 			e.insertReplicationTasks,
 			replicationTasks...,
 		)
+
+		if err := e.closeTransactionHandleWorkflowLifecycleEvents(transactionPolicy, workflowEvents.Events); err != nil {
+			return nil, err
+		}
 	}
 
 	e.insertReplicationTasks = append(
@@ -4610,6 +4712,23 @@ func (e *mutableStateBuilder) closeTransactionHandleWorkflowReset(
 	return nil
 }
 
+func (e *mutableStateBuilder) closeTransactionHandleWorkflowLifecycleEvents(
+	transactionPolicy TransactionPolicy,
+	events []*types.HistoryEvent,
+) error {
+
+	// lifecycle events are only published by the cluster which writes the events
+	if transactionPolicy == TransactionPolicyPassive {
+		return nil
+	}
+	domainName := e.GetDomainEntry().GetInfo().Name
+	if !e.config.EnableWorkflowLifecycleEvents(domainName) ||
+		!lifecycle.HasEvents(events, e.config.WorkflowLifecycleEventsIncludeSearchAttributes(domainName)) {
+		return nil
+	}
+	return e.taskGenerator.GenerateWorkflowLifecycleEventsTasks(events[0].EventID)
+}
+
 func (e *mutableStateBuilder) closeTransactionHandleActivityUserTimerTasks(
 	transactionPolicy TransactionPolicy,
 ) error {
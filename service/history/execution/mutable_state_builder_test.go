@@ -532,6 +532,38 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionStartedEvent_RetentionOverride() {
+	prevRunID := uuid.New()
+	now := time.Now()
+	startEvent := &types.HistoryEvent{
+		EventID:   common.FirstEventID,
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+			WorkflowType:            &types.WorkflowType{Name: "some random workflow type"},
+			TaskList:                &types.TaskList{Name: "some random tasklist"},
+			ContinuedExecutionRunID: prevRunID,
+			RetentionPeriodInDays:   common.Int32Ptr(5),
+			PrevAutoResetPoints: &types.ResetPoints{
+				Points: []*types.ResetPointInfo{{RunID: prevRunID}},
+			},
+		},
+	}
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+
+	err := s.msBuilder.ReplicateWorkflowExecutionStartedEvent(
+		nil,
+		types.WorkflowExecution{WorkflowID: "some random workflow ID", RunID: uuid.New()},
+		uuid.New(),
+		startEvent,
+	)
+	s.NoError(err)
+	s.Equal(int32(5), s.msBuilder.GetExecutionInfo().RetentionDays)
+	resetPoints := s.msBuilder.GetExecutionInfo().AutoResetPoints.GetPoints()
+	s.Len(resetPoints, 1)
+	s.Equal(now.Add(5*24*time.Hour).UnixNano(), resetPoints[0].GetExpiringTimeNano())
+}
+
 func (s *mutableStateSuite) TestTransientDecisionTaskSchedule_CurrentVersionChanged() {
 	version := int64(2000)
 	runID := uuid.New()
@@ -137,9 +137,9 @@ func (m *mutableStateDecisionTaskManagerImpl) ReplicateDecisionTaskScheduledEven
 ) (*DecisionInfo, error) {
 
 	// set workflow state to running, since decision is scheduled
-	// NOTE: for zombie workflow, should not change the state
+	// NOTE: for zombie or suspended workflow, should not change the state
 	state, _ := m.msb.GetWorkflowStateCloseStatus()
-	if state != persistence.WorkflowStateZombie {
+	if state != persistence.WorkflowStateZombie && state != persistence.WorkflowStateSuspended {
 		if err := m.msb.UpdateWorkflowStateCloseStatus(
 			persistence.WorkflowStateRunning,
 			persistence.WorkflowCloseStatusNone,
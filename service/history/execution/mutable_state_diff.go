@@ -0,0 +1,191 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// DiffMutableStates compares the stored mutable state of a workflow with the mutable state rebuilt
+// from its history, and returns the mismatched fields. Only fields which are fully determined by
+// history events are compared.
+func DiffMutableStates(
+	stored MutableState,
+	rebuilt MutableState,
+) []string {
+
+	var mismatches []string
+	diff := func(field string, storedValue, rebuiltValue interface{}) {
+		if storedValue != rebuiltValue {
+			mismatches = append(mismatches, fmt.Sprintf("%v: stored %v, rebuilt %v", field, storedValue, rebuiltValue))
+		}
+	}
+
+	storedInfo := stored.GetExecutionInfo()
+	rebuiltInfo := rebuilt.GetExecutionInfo()
+	diff("NextEventID", storedInfo.NextEventID, rebuiltInfo.NextEventID)
+	diff("LastFirstEventID", storedInfo.LastFirstEventID, rebuiltInfo.LastFirstEventID)
+	diff("LastProcessedEvent", storedInfo.LastProcessedEvent, rebuiltInfo.LastProcessedEvent)
+	diff("State", storedInfo.State, rebuiltInfo.State)
+	diff("CloseStatus", storedInfo.CloseStatus, rebuiltInfo.CloseStatus)
+	diff("WorkflowTypeName", storedInfo.WorkflowTypeName, rebuiltInfo.WorkflowTypeName)
+	diff("TaskList", storedInfo.TaskList, rebuiltInfo.TaskList)
+
+	storedActivities := stored.GetPendingActivityInfos()
+	rebuiltActivities := rebuilt.GetPendingActivityInfos()
+	for _, scheduleID := range unionInt64Keys(activityKeys(storedActivities), activityKeys(rebuiltActivities)) {
+		field := fmt.Sprintf("PendingActivityInfos[%v]", scheduleID)
+		storedActivity, inStored := storedActivities[scheduleID]
+		rebuiltActivity, inRebuilt := rebuiltActivities[scheduleID]
+		if !inStored || !inRebuilt {
+			diff(field, inStored, inRebuilt)
+			continue
+		}
+		diff(field+".ActivityID", storedActivity.ActivityID, rebuiltActivity.ActivityID)
+		// started event of an activity with retry policy is written to history only when the activity completes
+		storedStartedID := storedActivity.StartedID
+		if storedStartedID == common.TransientEventID {
+			storedStartedID = common.EmptyEventID
+		}
+		diff(field+".StartedID", storedStartedID, rebuiltActivity.StartedID)
+	}
+
+	storedTimers := stored.GetPendingTimerInfos()
+	rebuiltTimers := rebuilt.GetPendingTimerInfos()
+	timerIDs := make(map[string]struct{}, len(storedTimers)+len(rebuiltTimers))
+	for timerID := range storedTimers {
+		timerIDs[timerID] = struct{}{}
+	}
+	for timerID := range rebuiltTimers {
+		timerIDs[timerID] = struct{}{}
+	}
+	sortedTimerIDs := make([]string, 0, len(timerIDs))
+	for timerID := range timerIDs {
+		sortedTimerIDs = append(sortedTimerIDs, timerID)
+	}
+	sort.Strings(sortedTimerIDs)
+	for _, timerID := range sortedTimerIDs {
+		field := fmt.Sprintf("PendingTimerInfos[%v]", timerID)
+		storedTimer, inStored := storedTimers[timerID]
+		rebuiltTimer, inRebuilt := rebuiltTimers[timerID]
+		if !inStored || !inRebuilt {
+			diff(field, inStored, inRebuilt)
+			continue
+		}
+		diff(field+".StartedID", storedTimer.StartedID, rebuiltTimer.StartedID)
+	}
+
+	storedChildren := stored.GetPendingChildExecutionInfos()
+	rebuiltChildren := rebuilt.GetPendingChildExecutionInfos()
+	for _, initiatedID := range unionInt64Keys(childKeys(storedChildren), childKeys(rebuiltChildren)) {
+		field := fmt.Sprintf("PendingChildExecutionInfos[%v]", initiatedID)
+		storedChild, inStored := storedChildren[initiatedID]
+		rebuiltChild, inRebuilt := rebuiltChildren[initiatedID]
+		if !inStored || !inRebuilt {
+			diff(field, inStored, inRebuilt)
+			continue
+		}
+		diff(field+".StartedID", storedChild.StartedID, rebuiltChild.StartedID)
+		diff(field+".StartedRunID", storedChild.StartedRunID, rebuiltChild.StartedRunID)
+	}
+
+	storedCancels := stored.GetPendingRequestCancelExternalInfos()
+	rebuiltCancels := rebuilt.GetPendingRequestCancelExternalInfos()
+	for _, initiatedID := range unionInt64Keys(requestCancelKeys(storedCancels), requestCancelKeys(rebuiltCancels)) {
+		_, inStored := storedCancels[initiatedID]
+		_, inRebuilt := rebuiltCancels[initiatedID]
+		diff(fmt.Sprintf("PendingRequestCancelExternalInfos[%v]", initiatedID), inStored, inRebuilt)
+	}
+
+	storedSignals := stored.GetPendingSignalExternalInfos()
+	rebuiltSignals := rebuilt.GetPendingSignalExternalInfos()
+	for _, initiatedID := range unionInt64Keys(signalKeys(storedSignals), signalKeys(rebuiltSignals)) {
+		_, inStored := storedSignals[initiatedID]
+		_, inRebuilt := rebuiltSignals[initiatedID]
+		diff(fmt.Sprintf("PendingSignalExternalInfos[%v]", initiatedID), inStored, inRebuilt)
+	}
+
+	return mismatches
+}
+
+func activityKeys(
+	activities map[int64]*persistence.ActivityInfo,
+) []int64 {
+	keys := make([]int64, 0, len(activities))
+	for key := range activities {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func childKeys(
+	children map[int64]*persistence.ChildExecutionInfo,
+) []int64 {
+	keys := make([]int64, 0, len(children))
+	for key := range children {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func requestCancelKeys(
+	requestCancels map[int64]*persistence.RequestCancelInfo,
+) []int64 {
+	keys := make([]int64, 0, len(requestCancels))
+	for key := range requestCancels {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func signalKeys(
+	signals map[int64]*persistence.SignalInfo,
+) []int64 {
+	keys := make([]int64, 0, len(signals))
+	for key := range signals {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// unionInt64Keys returns the sorted union of the given keys
+func unionInt64Keys(
+	keys1 []int64,
+	keys2 []int64,
+) []int64 {
+	set := make(map[int64]struct{}, len(keys1)+len(keys2))
+	for _, key := range keys1 {
+		set[key] = struct{}{}
+	}
+	for _, key := range keys2 {
+		set[key] = struct{}{}
+	}
+	union := make([]int64, 0, len(set))
+	for key := range set {
+		union = append(union, key)
+	}
+	sort.Slice(union, func(i, j int) bool { return union[i] < union[j] })
+	return union
+}
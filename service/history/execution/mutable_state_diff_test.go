@@ -0,0 +1,138 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type testMutableStateContent struct {
+	executionInfo *persistence.WorkflowExecutionInfo
+	activities    map[int64]*persistence.ActivityInfo
+	timers        map[string]*persistence.TimerInfo
+	children      map[int64]*persistence.ChildExecutionInfo
+	cancels       map[int64]*persistence.RequestCancelInfo
+	signals       map[int64]*persistence.SignalInfo
+}
+
+func TestDiffMutableStates(t *testing.T) {
+	newContent := func() *testMutableStateContent {
+		return &testMutableStateContent{
+			executionInfo: &persistence.WorkflowExecutionInfo{
+				NextEventID:        20,
+				LastFirstEventID:   15,
+				LastProcessedEvent: 14,
+				State:              persistence.WorkflowStateRunning,
+				CloseStatus:        persistence.WorkflowCloseStatusNone,
+				WorkflowTypeName:   "test-workflow-type",
+				TaskList:           "test-tasklist",
+			},
+			activities: map[int64]*persistence.ActivityInfo{
+				5: {ScheduleID: 5, ActivityID: "activity-5", StartedID: common.EmptyEventID},
+				6: {ScheduleID: 6, ActivityID: "activity-6", StartedID: 7},
+			},
+			timers: map[string]*persistence.TimerInfo{
+				"timer-1": {TimerID: "timer-1", StartedID: 8},
+			},
+			children: map[int64]*persistence.ChildExecutionInfo{
+				9: {InitiatedID: 9, StartedID: 10, StartedRunID: "child-run-id"},
+			},
+			cancels: map[int64]*persistence.RequestCancelInfo{11: {InitiatedID: 11}},
+			signals: map[int64]*persistence.SignalInfo{12: {InitiatedID: 12}},
+		}
+	}
+
+	testCases := map[string]struct {
+		mutate             func(stored, rebuilt *testMutableStateContent)
+		expectedMismatches []string
+	}{
+		"identical": {
+			mutate: func(stored, rebuilt *testMutableStateContent) {},
+		},
+		"transient activity start is not a mismatch": {
+			mutate: func(stored, rebuilt *testMutableStateContent) {
+				stored.activities[5].StartedID = common.TransientEventID
+			},
+		},
+		"execution info": {
+			mutate: func(stored, rebuilt *testMutableStateContent) {
+				rebuilt.executionInfo.NextEventID = 22
+				rebuilt.executionInfo.State = persistence.WorkflowStateCompleted
+			},
+			expectedMismatches: []string{
+				"NextEventID: stored 20, rebuilt 22",
+				"State: stored 1, rebuilt 2",
+			},
+		},
+		"pending maps": {
+			mutate: func(stored, rebuilt *testMutableStateContent) {
+				delete(rebuilt.activities, 6)
+				rebuilt.activities[5].StartedID = 13
+				rebuilt.timers["timer-2"] = &persistence.TimerInfo{TimerID: "timer-2", StartedID: 13}
+				rebuilt.children[9].StartedRunID = "other-child-run-id"
+				delete(stored.cancels, 11)
+				delete(rebuilt.signals, 12)
+			},
+			expectedMismatches: []string{
+				"PendingActivityInfos[5].StartedID: stored -23, rebuilt 13",
+				"PendingActivityInfos[6]: stored true, rebuilt false",
+				"PendingTimerInfos[timer-2]: stored false, rebuilt true",
+				"PendingChildExecutionInfos[9].StartedRunID: stored child-run-id, rebuilt other-child-run-id",
+				"PendingRequestCancelExternalInfos[11]: stored false, rebuilt true",
+				"PendingSignalExternalInfos[12]: stored true, rebuilt false",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			stored, rebuilt := newContent(), newContent()
+			tc.mutate(stored, rebuilt)
+			assert.Equal(t, tc.expectedMismatches, DiffMutableStates(
+				newTestMutableStateForDiff(controller, stored),
+				newTestMutableStateForDiff(controller, rebuilt),
+			))
+		})
+	}
+}
+
+func newTestMutableStateForDiff(
+	controller *gomock.Controller,
+	content *testMutableStateContent,
+) MutableState {
+	mutableState := NewMockMutableState(controller)
+	mutableState.EXPECT().GetExecutionInfo().Return(content.executionInfo).AnyTimes()
+	mutableState.EXPECT().GetPendingActivityInfos().Return(content.activities).AnyTimes()
+	mutableState.EXPECT().GetPendingTimerInfos().Return(content.timers).AnyTimes()
+	mutableState.EXPECT().GetPendingChildExecutionInfos().Return(content.children).AnyTimes()
+	mutableState.EXPECT().GetPendingRequestCancelExternalInfos().Return(content.cancels).AnyTimes()
+	mutableState.EXPECT().GetPendingSignalExternalInfos().Return(content.signals).AnyTimes()
+	return mutableState
+}
@@ -758,6 +758,36 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionStartedEvent(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionStartedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionStartedEvent), arg0, arg1)
 }
 
+// AddWorkflowExecutionSuspendedEvent mocks base method
+func (m *MockMutableState) AddWorkflowExecutionSuspendedEvent(reason, identity string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionSuspendedEvent", reason, identity)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionSuspendedEvent indicates an expected call of AddWorkflowExecutionSuspendedEvent
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionSuspendedEvent(reason, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionSuspendedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionSuspendedEvent), reason, identity)
+}
+
+// AddWorkflowExecutionResumedEvent mocks base method
+func (m *MockMutableState) AddWorkflowExecutionResumedEvent(reason, identity string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionResumedEvent", reason, identity)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionResumedEvent indicates an expected call of AddWorkflowExecutionResumedEvent
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionResumedEvent(reason, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionResumedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionResumedEvent), reason, identity)
+}
+
 // AddWorkflowExecutionTerminatedEvent mocks base method
 func (m *MockMutableState) AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details []byte, identity string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -1625,6 +1655,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionRunning() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionRunning", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionRunning))
 }
 
+// IsWorkflowExecutionSuspended mocks base method
+func (m *MockMutableState) IsWorkflowExecutionSuspended() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionSuspended")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowExecutionSuspended indicates an expected call of IsWorkflowExecutionSuspended
+func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionSuspended() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionSuspended", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionSuspended))
+}
+
 // IsWorkflowCompleted mocks base method
 func (m *MockMutableState) IsWorkflowCompleted() bool {
 	m.ctrl.T.Helper()
@@ -2229,6 +2273,34 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionStartedEvent(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionStartedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionStartedEvent), arg0, arg1, arg2, arg3)
 }
 
+// ReplicateWorkflowExecutionSuspendedEvent mocks base method
+func (m *MockMutableState) ReplicateWorkflowExecutionSuspendedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionSuspendedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionSuspendedEvent indicates an expected call of ReplicateWorkflowExecutionSuspendedEvent
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionSuspendedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionSuspendedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionSuspendedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionResumedEvent mocks base method
+func (m *MockMutableState) ReplicateWorkflowExecutionResumedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionResumedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionResumedEvent indicates an expected call of ReplicateWorkflowExecutionResumedEvent
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionResumedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionResumedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionResumedEvent), arg0)
+}
+
 // ReplicateWorkflowExecutionTerminatedEvent mocks base method
 func (m *MockMutableState) ReplicateWorkflowExecutionTerminatedEvent(arg0 int64, arg1 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -79,6 +79,9 @@ type (
 		) error
 		GenerateWorkflowSearchAttrTasks() error
 		GenerateWorkflowResetTasks() error
+		GenerateWorkflowLifecycleEventsTasks(
+			firstEventID int64,
+		) error
 		GenerateFromTransferTask(
 			transferTask *persistence.TransferTaskInfo,
 			targetCluster string,
@@ -176,6 +179,10 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 	if err != nil {
 		return err
 	}
+	if domainEntry := r.mutableState.GetDomainEntry(); isActive && domainEntry.IsActiveActive() {
+		// workflow of an active-active domain is only active in the cluster of its version
+		isActive = domainEntry.IsWorkflowActive(closeEvent.GetVersion())
+	}
 
 	if !isActive {
 		// source domain passive, generate only one close execution task
@@ -246,6 +253,10 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 	default:
 		return err
 	}
+	if executionInfo.RetentionDays > 0 {
+		// workflow level override takes precedence over domain retention
+		retentionInDays = executionInfo.RetentionDays
+	}
 
 	closeTimestamp := time.Unix(0, closeEvent.GetTimestamp())
 	retentionDuration := time.Duration(retentionInDays) * time.Hour * 24
@@ -624,6 +635,21 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowResetTasks() error {
 	return nil
 }
 
+func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowLifecycleEventsTasks(
+	firstEventID int64,
+) error {
+
+	currentVersion := r.mutableState.GetCurrentVersion()
+
+	r.mutableState.AddTransferTasks(&persistence.WorkflowLifecycleEventsTask{
+		// TaskID and VisibilityTimestamp are set by shard context
+		FirstEventID: firstEventID,
+		Version:      currentVersion,
+	})
+
+	return nil
+}
+
 func (r *mutableStateTaskGeneratorImpl) GenerateCrossClusterRecordChildCompletedTask(
 	task *persistence.TransferTaskInfo,
 	targetCluster string,
@@ -818,7 +844,7 @@ func (r *mutableStateTaskGeneratorImpl) GenerateFromCrossClusterTask(
 			return err
 		}
 		targetCluster = targetDomainEntry.GetReplicationConfig().ActiveClusterName
-		if targetCluster == r.clusterMetadata.GetCurrentClusterName() {
+		if targetCluster == r.clusterMetadata.GetCurrentClusterName() || targetDomainEntry.IsActiveActive() {
 			generateTransferTask = true
 		}
 	}
@@ -969,6 +995,11 @@ func (r *mutableStateTaskGeneratorImpl) isCrossClusterTask(
 		return "", false, nil
 	}
 
+	// case 4: target domain is active-active, which is also active in the current cluster
+	if targetDomainEntry.IsActiveActive() {
+		return "", false, nil
+	}
+
 	return targetCluster, true, nil
 }
 
@@ -241,6 +241,20 @@ func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateWorkflowResetTasks(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowResetTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateWorkflowResetTasks))
 }
 
+// GenerateWorkflowLifecycleEventsTasks mocks base method
+func (m *MockMutableStateTaskGenerator) GenerateWorkflowLifecycleEventsTasks(firstEventID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateWorkflowLifecycleEventsTasks", firstEventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateWorkflowLifecycleEventsTasks indicates an expected call of GenerateWorkflowLifecycleEventsTasks
+func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateWorkflowLifecycleEventsTasks(firstEventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowLifecycleEventsTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateWorkflowLifecycleEventsTasks), firstEventID)
+}
+
 // GenerateFromTransferTask mocks base method
 func (m *MockMutableStateTaskGenerator) GenerateFromTransferTask(transferTask *persistence.TransferTaskInfo, targetCluster string) error {
 	m.ctrl.T.Helper()
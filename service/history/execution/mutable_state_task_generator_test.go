@@ -166,6 +166,29 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks() {
 				},
 			},
 		},
+		{
+			// retention overridden by the workflow
+			setupFn: func(mockMutableState *MockMutableState) {
+				mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+					DomainID:      constants.TestDomainID,
+					WorkflowID:    constants.TestWorkflowID,
+					RunID:         constants.TestRunID,
+					RetentionDays: 7,
+				}).AnyTimes()
+				mockMutableState.EXPECT().HasParentExecution().Return(false).AnyTimes()
+				mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(nil).AnyTimes()
+			},
+			generatedTasks: []persistence.Task{
+				&persistence.CloseExecutionTask{
+					VisibilityTimestamp: now,
+					Version:             version,
+				},
+				&persistence.DeleteHistoryEventTask{
+					VisibilityTimestamp: time.Unix(0, closeEvent.GetTimestamp()).Add(7 * 24 * time.Hour),
+					Version:             version,
+				},
+			},
+		},
 		{
 			// parent and children all active in current cluster
 			setupFn: func(mockMutableState *MockMutableState) {
@@ -242,6 +242,37 @@ func ScheduleDecision(
 	return nil
 }
 
+// FailoverWorkflow makes a running workflow of an active-active domain active in the cluster
+// of the given failover version, the pending decision is failed or timed out and a new decision
+// is scheduled so that the workflow's last write version is bumped to the failover version
+func FailoverWorkflow(
+	mutableState MutableState,
+	failoverVersion int64,
+) error {
+
+	if err := mutableState.UpdateCurrentVersion(failoverVersion, true); err != nil {
+		return err
+	}
+
+	if decision, ok := mutableState.GetInFlightDecision(); ok {
+		if err := FailDecision(
+			mutableState,
+			decision,
+			types.DecisionTaskFailedCauseFailoverCloseDecision,
+		); err != nil {
+			return err
+		}
+	} else if decision, ok := mutableState.GetPendingDecision(); ok {
+		if _, err := mutableState.AddDecisionTaskScheduleToStartTimeoutEvent(decision.ScheduleID); err != nil {
+			return err
+		}
+	}
+
+	// sticky task list is bound to a worker polling the previous active cluster
+	mutableState.ClearStickyness()
+	return ScheduleDecision(mutableState)
+}
+
 // FindAutoResetPoint returns the auto reset point
 func FindAutoResetPoint(
 	timeSource clock.TimeSource,
@@ -265,6 +296,24 @@ func FindAutoResetPoint(
 	return "", nil
 }
 
+// BackfillVersionHistories initializes version histories for a workflow created before
+// its domain had version histories, so that its history can be replicated. The existing
+// events are attributed to the given version, which is the failover version of the promoted domain
+func BackfillVersionHistories(mutableState MutableState, version int64) error {
+	if mutableState.GetVersionHistories() != nil {
+		return nil
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	versionHistory := persistence.NewVersionHistory(
+		executionInfo.BranchToken,
+		[]*persistence.VersionHistoryItem{
+			persistence.NewVersionHistoryItem(executionInfo.NextEventID-1, version),
+		},
+	)
+	return mutableState.SetVersionHistories(persistence.NewVersionHistories(versionHistory))
+}
+
 // CreatePersistenceMutableState creates a persistence mutable state based on the its in-memory version
 func CreatePersistenceMutableState(ms MutableState) *persistence.WorkflowMutableState {
 	builder := ms.(*mutableStateBuilder)
@@ -374,6 +423,7 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		NonRetriableErrors:                 sourceInfo.NonRetriableErrors,
 		BranchToken:                        sourceInfo.BranchToken,
 		ExpirationSeconds:                  sourceInfo.ExpirationSeconds,
+		RetentionDays:                      sourceInfo.RetentionDays,
 	}
 }
 
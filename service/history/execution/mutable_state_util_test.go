@@ -24,10 +24,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -148,3 +150,29 @@ func TestFindAutoResetPoint(t *testing.T) {
 	})
 	assert.Equal(t, pt, pt5)
 }
+
+func TestBackfillVersionHistories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	branchToken := []byte("some random branch token")
+	version := int64(1234)
+	mockMutableState := NewMockMutableState(ctrl)
+
+	// case 1: version histories already exist
+	mockMutableState.EXPECT().GetVersionHistories().Return(persistence.NewVersionHistories(&persistence.VersionHistory{})).Times(1)
+	assert.NoError(t, BackfillVersionHistories(mockMutableState, version))
+
+	// case 2: legacy workflow without version histories
+	mockMutableState.EXPECT().GetVersionHistories().Return(nil).Times(1)
+	mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		BranchToken: branchToken,
+		NextEventID: 11,
+	}).Times(1)
+	mockMutableState.EXPECT().SetVersionHistories(persistence.NewVersionHistories(
+		persistence.NewVersionHistory(branchToken, []*persistence.VersionHistoryItem{
+			persistence.NewVersionHistoryItem(10, version),
+		}),
+	)).Return(nil).Times(1)
+	assert.NoError(t, BackfillVersionHistories(mockMutableState, version))
+}
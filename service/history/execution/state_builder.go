@@ -501,6 +501,20 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeWorkflowExecutionSuspended:
+			if err := b.mutableState.ReplicateWorkflowExecutionSuspendedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionResumed:
+			if err := b.mutableState.ReplicateWorkflowExecutionResumedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionCancelRequested:
 			if err := b.mutableState.ReplicateWorkflowExecutionCancelRequestedEvent(
 				event,
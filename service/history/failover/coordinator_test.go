@@ -334,6 +334,7 @@ func (s *coordinatorSuite) TestHandleFailoverMarkers_CleanPendingActiveState_Suc
 		Info:                        info,
 		Config:                      domainConfig,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              true,
 		ConfigVersion:               1,
 		FailoverVersion:             2,
 		FailoverNotificationVersion: 2,
@@ -407,6 +408,7 @@ func (s *coordinatorSuite) TestHandleFailoverMarkers_CleanPendingActiveState_Err
 		Info:                        info,
 		Config:                      domainConfig,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              true,
 		ConfigVersion:               1,
 		FailoverVersion:             2,
 		FailoverNotificationVersion: 2,
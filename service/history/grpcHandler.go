@@ -158,6 +158,46 @@ func (g grpcHandler) RefreshWorkflowTasks(ctx context.Context, request *historyv
 	return &historyv1.RefreshWorkflowTasksResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) FailoverWorkflowExecution(ctx context.Context, request *historyv1.FailoverWorkflowExecutionRequest) (*historyv1.FailoverWorkflowExecutionResponse, error) {
+	err := g.h.FailoverWorkflowExecution(ctx, proto.ToHistoryFailoverWorkflowExecutionRequest(request))
+	return &historyv1.FailoverWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) SuspendWorkflowExecution(ctx context.Context, request *historyv1.SuspendWorkflowExecutionRequest) (*historyv1.SuspendWorkflowExecutionResponse, error) {
+	err := g.h.SuspendWorkflowExecution(ctx, proto.ToHistorySuspendWorkflowExecutionRequest(request))
+	return &historyv1.SuspendWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) ResumeWorkflowExecution(ctx context.Context, request *historyv1.ResumeWorkflowExecutionRequest) (*historyv1.ResumeWorkflowExecutionResponse, error) {
+	err := g.h.ResumeWorkflowExecution(ctx, proto.ToHistoryResumeWorkflowExecutionRequest(request))
+	return &historyv1.ResumeWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) ReindexWorkflowExecution(ctx context.Context, request *historyv1.ReindexWorkflowExecutionRequest) (*historyv1.ReindexWorkflowExecutionResponse, error) {
+	err := g.h.ReindexWorkflowExecution(ctx, proto.ToHistoryReindexWorkflowExecutionRequest(request))
+	return &historyv1.ReindexWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) RebuildMutableState(ctx context.Context, request *historyv1.RebuildMutableStateRequest) (*historyv1.RebuildMutableStateResponse, error) {
+	response, err := g.h.RebuildMutableState(ctx, proto.ToHistoryRebuildMutableStateRequest(request))
+	return proto.FromHistoryRebuildMutableStateResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) ListWorkflowTasks(ctx context.Context, request *historyv1.ListWorkflowTasksRequest) (*historyv1.ListWorkflowTasksResponse, error) {
+	response, err := g.h.ListWorkflowTasks(ctx, proto.ToHistoryListWorkflowTasksRequest(request))
+	return proto.FromHistoryListWorkflowTasksResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) DeleteWorkflowTask(ctx context.Context, request *historyv1.DeleteWorkflowTaskRequest) (*historyv1.DeleteWorkflowTaskResponse, error) {
+	err := g.h.DeleteWorkflowTask(ctx, proto.ToHistoryDeleteWorkflowTaskRequest(request))
+	return &historyv1.DeleteWorkflowTaskResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) ReenqueueWorkflowTask(ctx context.Context, request *historyv1.ReenqueueWorkflowTaskRequest) (*historyv1.ReenqueueWorkflowTaskResponse, error) {
+	err := g.h.ReenqueueWorkflowTask(ctx, proto.ToHistoryReenqueueWorkflowTaskRequest(request))
+	return &historyv1.ReenqueueWorkflowTaskResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) RemoveSignalMutableState(ctx context.Context, request *historyv1.RemoveSignalMutableStateRequest) (*historyv1.RemoveSignalMutableStateResponse, error) {
 	err := g.h.RemoveSignalMutableState(ctx, proto.ToHistoryRemoveSignalMutableStateRequest(request))
 	return &historyv1.RemoveSignalMutableStateResponse{}, proto.FromError(err)
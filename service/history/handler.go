@@ -46,13 +46,18 @@ import (
 	"github.com/uber/cadence/service/history/engine"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/failover"
+	"github.com/uber/cadence/service/history/lifecycle"
 	"github.com/uber/cadence/service/history/replication"
 	"github.com/uber/cadence/service/history/resource"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
 
-const shardOwnershipTransferDelay = 5 * time.Second
+const (
+	shardOwnershipTransferDelay = 5 * time.Second
+	// replicationLongPollTailRoom is the time reserved for returning a long polled replication response before its deadline
+	replicationLongPollTailRoom = time.Second
+)
 
 type (
 	// Handler interface for history service
@@ -82,6 +87,14 @@ type (
 		RecordChildExecutionCompleted(context.Context, *types.RecordChildExecutionCompletedRequest) error
 		RecordDecisionTaskStarted(context.Context, *types.RecordDecisionTaskStartedRequest) (*types.RecordDecisionTaskStartedResponse, error)
 		RefreshWorkflowTasks(context.Context, *types.HistoryRefreshWorkflowTasksRequest) error
+		FailoverWorkflowExecution(context.Context, *types.HistoryFailoverWorkflowExecutionRequest) error
+		SuspendWorkflowExecution(context.Context, *types.HistorySuspendWorkflowExecutionRequest) error
+		ResumeWorkflowExecution(context.Context, *types.HistoryResumeWorkflowExecutionRequest) error
+		ReindexWorkflowExecution(context.Context, *types.HistoryReindexWorkflowExecutionRequest) error
+		RebuildMutableState(context.Context, *types.HistoryRebuildMutableStateRequest) (*types.RebuildMutableStateResponse, error)
+		ListWorkflowTasks(context.Context, *types.HistoryListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error)
+		DeleteWorkflowTask(context.Context, *types.HistoryDeleteWorkflowTaskRequest) error
+		ReenqueueWorkflowTask(context.Context, *types.HistoryReenqueueWorkflowTaskRequest) error
 		RemoveSignalMutableState(context.Context, *types.RemoveSignalMutableStateRequest) error
 		RemoveTask(context.Context, *types.RemoveTaskRequest) error
 		ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request) error
@@ -120,6 +133,7 @@ type (
 		replicationTaskFetchers  replication.TaskFetchers
 		queueTaskProcessor       task.Processor
 		failoverCoordinator      failover.Coordinator
+		lifecyclePublisher       lifecycle.Publisher
 	}
 )
 
@@ -206,6 +220,8 @@ func (h *handlerImpl) Start() {
 	}
 	h.queueTaskProcessor.Start()
 
+	h.lifecyclePublisher = h.newLifecyclePublisher()
+
 	h.controller = shard.NewShardController(
 		h.Resource,
 		h,
@@ -244,6 +260,19 @@ func (h *handlerImpl) Stop() {
 	h.failoverCoordinator.Stop()
 }
 
+func (h *handlerImpl) newLifecyclePublisher() lifecycle.Publisher {
+	messagingClient := h.GetMessagingClient()
+	if messagingClient == nil {
+		return lifecycle.NewNoopPublisher()
+	}
+	producer, err := messagingClient.NewProducer(common.WorkflowLifecycleEventsAppName)
+	if err != nil {
+		h.GetLogger().Info("Workflow lifecycle events topic is not available, lifecycle events will not be published", tag.Error(err))
+		return lifecycle.NewNoopPublisher()
+	}
+	return lifecycle.NewPublisher(producer)
+}
+
 // PrepareToStop starts graceful traffic drain in preparation for shutdown
 func (h *handlerImpl) PrepareToStop(remainingTime time.Duration) time.Duration {
 	h.GetLogger().Info("ShutdownHandler: Initiating shardController shutdown")
@@ -279,6 +308,7 @@ func (h *handlerImpl) CreateEngine(
 		h.GetMatchingRawClient(),
 		h.queueTaskProcessor,
 		h.failoverCoordinator,
+		h.lifecyclePublisher,
 	)
 }
 
@@ -767,6 +797,7 @@ func (h *handlerImpl) DescribeHistoryHost(
 		},
 		ShardControllerStatus: status,
 		Address:               h.GetHostInfo().GetAddress(),
+		ShardLoads:            h.controller.ShardLoads(),
 	}
 	return resp, nil
 }
@@ -1586,13 +1617,52 @@ func (h *handlerImpl) GetReplicationMessages(
 
 	h.GetLogger().Debug("Received GetReplicationMessages call.")
 
-	_, sw := h.startRequestProfile(ctx, metrics.HistoryGetReplicationMessagesScope)
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetReplicationMessagesScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
 		return nil, errShuttingDown
 	}
 
+	tokens := make([]*types.ReplicationToken, 0, len(request.Tokens))
+	for _, token := range request.Tokens {
+		if token.GetShardID() < 0 || int(token.GetShardID()) >= h.config.NumberOfShards {
+			// the requesting cluster has more shards than this one, e.g. during a shard migration,
+			// skip the token so the tasks of the other shards are still served
+			scope.IncCounter(metrics.ReplicationTokenShardOutOfRange)
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	if len(tokens) != len(request.Tokens) {
+		inRangeRequest := *request
+		inRangeRequest.Tokens = tokens
+		request = &inRangeRequest
+	}
+
+	var newTasksChs []<-chan struct{}
+	if request.GetWaitForNewTasks() {
+		// start watching before the first read, so tasks generated in between are not missed
+		newTasksChs = h.watchNewReplicationTasks(request.Tokens)
+	}
+
+	messagesByShard := h.getReplicationMessages(ctx, request)
+	if request.GetWaitForNewTasks() && !hasNewReplicationMessages(request.Tokens, messagesByShard) {
+		if h.waitForNewReplicationTasks(ctx, newTasksChs) {
+			messagesByShard = h.getReplicationMessages(ctx, request)
+		}
+	}
+
+	h.GetLogger().Debug("GetReplicationMessages succeeded.")
+
+	return &types.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
+}
+
+func (h *handlerImpl) getReplicationMessages(
+	ctx context.Context,
+	request *types.GetReplicationMessagesRequest,
+) map[int32]*types.ReplicationMessages {
+
 	var wg sync.WaitGroup
 	wg.Add(len(request.Tokens))
 	result := new(sync.Map)
@@ -1629,10 +1699,81 @@ func (h *handlerImpl) GetReplicationMessages(
 		messagesByShard[shardID] = tasks
 		return true
 	})
+	return messagesByShard
+}
 
-	h.GetLogger().Debug("GetReplicationMessages succeeded.")
+func (h *handlerImpl) watchNewReplicationTasks(
+	tokens []*types.ReplicationToken,
+) []<-chan struct{} {
 
-	return &types.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
+	var newTasksChs []<-chan struct{}
+	for _, token := range tokens {
+		engine, err := h.controller.GetEngineForShard(int(token.GetShardID()))
+		if err != nil {
+			continue
+		}
+		newTasksChs = append(newTasksChs, engine.WatchNewReplicationTasks())
+	}
+	return newTasksChs
+}
+
+// waitForNewReplicationTasks blocks until new replication tasks are generated on any of the watched shards,
+// or the long poll expires. It returns false if the request context is done and shards should not be read again.
+func (h *handlerImpl) waitForNewReplicationTasks(
+	ctx context.Context,
+	newTasksChs []<-chan struct{},
+) bool {
+
+	expiration := h.config.ReplicationTaskLongPollExpirationInterval()
+	if deadline, ok := ctx.Deadline(); ok {
+		// leave room for reading the shards again and returning the response
+		if remaining := time.Until(deadline) - replicationLongPollTailRoom; remaining < expiration {
+			expiration = remaining
+		}
+	}
+	if expiration <= 0 {
+		return false
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, expiration)
+	defer cancel()
+
+	notifyCh := make(chan struct{}, len(newTasksChs))
+	for _, newTasksCh := range newTasksChs {
+		go func(newTasksCh <-chan struct{}) {
+			select {
+			case <-newTasksCh:
+				notifyCh <- struct{}{}
+			case <-waitCtx.Done():
+			}
+		}(newTasksCh)
+	}
+
+	select {
+	case <-notifyCh:
+	case <-waitCtx.Done():
+	}
+	return ctx.Err() == nil
+}
+
+// hasNewReplicationMessages returns true if any requested shard has tasks or an advanced read level to return,
+// or a shard failed to be read so there is no point to wait for it
+func hasNewReplicationMessages(
+	tokens []*types.ReplicationToken,
+	messagesByShard map[int32]*types.ReplicationMessages,
+) bool {
+
+	for _, token := range tokens {
+		messages, ok := messagesByShard[token.GetShardID()]
+		if !ok {
+			return true
+		}
+		if len(messages.GetReplicationTasks()) > 0 ||
+			messages.GetLastRetrievedMessageID() != token.GetLastRetrievedMessageID() {
+			return true
+		}
+	}
+	return false
 }
 
 // GetDLQReplicationMessages is called by remote peers to get replicated messages for DLQ merging
@@ -1862,6 +2003,331 @@ func (h *handlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// FailoverWorkflowExecution makes a workflow of an active-active domain active in the current cluster
+func (h *handlerImpl) FailoverWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryFailoverWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryFailoverWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.FailoverWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// SuspendWorkflowExecution suspends a running workflow execution
+func (h *handlerImpl) SuspendWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistorySuspendWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistorySuspendWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.SuspendRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.SuspendWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// ResumeWorkflowExecution resumes a suspended workflow execution
+func (h *handlerImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryResumeWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryResumeWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.ResumeRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.ResumeWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// ReindexWorkflowExecution rebuilds the visibility record of a workflow execution
+func (h *handlerImpl) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryReindexWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryReindexWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := request.GetExecution()
+	if workflowExecution.GetWorkflowID() == "" || workflowExecution.GetRunID() == "" {
+		return h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+	workflowID := workflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.ReindexWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// RebuildMutableState rebuilds the mutable state of a workflow execution from history and diffs it against the stored one
+func (h *handlerImpl) RebuildMutableState(
+	ctx context.Context,
+	request *types.HistoryRebuildMutableStateRequest,
+) (resp *types.RebuildMutableStateResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRebuildMutableStateScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := request.GetExecution()
+	if workflowExecution.GetWorkflowID() == "" || workflowExecution.GetRunID() == "" {
+		return nil, h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+	workflowID := workflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.RebuildMutableState(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
+// ListWorkflowTasks lists the pending transfer, timer, replication and cross-cluster tasks of a workflow execution
+func (h *handlerImpl) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.HistoryListWorkflowTasksRequest,
+) (resp *types.ListWorkflowTasksResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryListWorkflowTasksScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	if workflowID == "" {
+		return nil, h.error(errWorkflowIDNotSet, scope, domainID, "")
+	}
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.ListWorkflowTasks(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
+// DeleteWorkflowTask deletes a single pending task of a workflow execution
+func (h *handlerImpl) DeleteWorkflowTask(
+	ctx context.Context,
+	request *types.HistoryDeleteWorkflowTaskRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryDeleteWorkflowTaskScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	if workflowID == "" {
+		return h.error(errWorkflowIDNotSet, scope, domainID, "")
+	}
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	if err2 := engine.DeleteWorkflowTask(ctx, request); err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+	return nil
+}
+
+// ReenqueueWorkflowTask submits a pending transfer or timer task of a workflow execution for immediate execution
+func (h *handlerImpl) ReenqueueWorkflowTask(
+	ctx context.Context,
+	request *types.HistoryReenqueueWorkflowTaskRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryReenqueueWorkflowTaskScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	if workflowID == "" {
+		return h.error(errWorkflowIDNotSet, scope, domainID, "")
+	}
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	if err2 := engine.ReenqueueWorkflowTask(ctx, request); err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+	return nil
+}
+
 // NotifyFailoverMarkers sends the failover markers to failover coordinator.
 // The coordinator decides when the failover finishes based on received failover marker.
 func (h *handlerImpl) NotifyFailoverMarkers(
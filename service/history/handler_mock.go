@@ -421,6 +421,120 @@ func (mr *MockHandlerMockRecorder) RefreshWorkflowTasks(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHandler)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// FailoverWorkflowExecution mocks base method
+func (m *MockHandler) FailoverWorkflowExecution(arg0 context.Context, arg1 *types.HistoryFailoverWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailoverWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailoverWorkflowExecution indicates an expected call of FailoverWorkflowExecution
+func (mr *MockHandlerMockRecorder) FailoverWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).FailoverWorkflowExecution), arg0, arg1)
+}
+
+// SuspendWorkflowExecution mocks base method
+func (m *MockHandler) SuspendWorkflowExecution(arg0 context.Context, arg1 *types.HistorySuspendWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendWorkflowExecution indicates an expected call of SuspendWorkflowExecution
+func (mr *MockHandlerMockRecorder) SuspendWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).SuspendWorkflowExecution), arg0, arg1)
+}
+
+// ResumeWorkflowExecution mocks base method
+func (m *MockHandler) ResumeWorkflowExecution(arg0 context.Context, arg1 *types.HistoryResumeWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution
+func (mr *MockHandlerMockRecorder) ResumeWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).ResumeWorkflowExecution), arg0, arg1)
+}
+
+// ReindexWorkflowExecution mocks base method
+func (m *MockHandler) ReindexWorkflowExecution(arg0 context.Context, arg1 *types.HistoryReindexWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReindexWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReindexWorkflowExecution indicates an expected call of ReindexWorkflowExecution
+func (mr *MockHandlerMockRecorder) ReindexWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).ReindexWorkflowExecution), arg0, arg1)
+}
+
+// RebuildMutableState mocks base method
+func (m *MockHandler) RebuildMutableState(arg0 context.Context, arg1 *types.HistoryRebuildMutableStateRequest) (*types.RebuildMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildMutableState", arg0, arg1)
+	ret0, _ := ret[0].(*types.RebuildMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildMutableState indicates an expected call of RebuildMutableState
+func (mr *MockHandlerMockRecorder) RebuildMutableState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockHandler)(nil).RebuildMutableState), arg0, arg1)
+}
+
+// ListWorkflowTasks mocks base method
+func (m *MockHandler) ListWorkflowTasks(arg0 context.Context, arg1 *types.HistoryListWorkflowTasksRequest) (*types.ListWorkflowTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListWorkflowTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowTasks indicates an expected call of ListWorkflowTasks
+func (mr *MockHandlerMockRecorder) ListWorkflowTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowTasks", reflect.TypeOf((*MockHandler)(nil).ListWorkflowTasks), arg0, arg1)
+}
+
+// DeleteWorkflowTask mocks base method
+func (m *MockHandler) DeleteWorkflowTask(arg0 context.Context, arg1 *types.HistoryDeleteWorkflowTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowTask indicates an expected call of DeleteWorkflowTask
+func (mr *MockHandlerMockRecorder) DeleteWorkflowTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowTask", reflect.TypeOf((*MockHandler)(nil).DeleteWorkflowTask), arg0, arg1)
+}
+
+// ReenqueueWorkflowTask mocks base method
+func (m *MockHandler) ReenqueueWorkflowTask(arg0 context.Context, arg1 *types.HistoryReenqueueWorkflowTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReenqueueWorkflowTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReenqueueWorkflowTask indicates an expected call of ReenqueueWorkflowTask
+func (mr *MockHandlerMockRecorder) ReenqueueWorkflowTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueWorkflowTask", reflect.TypeOf((*MockHandler)(nil).ReenqueueWorkflowTask), arg0, arg1)
+}
+
 // RemoveSignalMutableState mocks base method
 func (m *MockHandler) RemoveSignalMutableState(arg0 context.Context, arg1 *types.RemoveSignalMutableStateRequest) error {
 	m.ctrl.T.Helper()
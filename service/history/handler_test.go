@@ -26,12 +26,14 @@ import (
 	"math/rand"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
@@ -148,3 +150,92 @@ func (s *handlerSuite) testRespondCrossClusterTaskCompleted(
 		s.Len(response.Tasks, numTasks)
 	}
 }
+
+func (s *handlerSuite) TestGetReplicationMessages_WaitForNewTasks() {
+	clusterName := cluster.TestAlternativeClusterName
+	token := &types.ReplicationToken{ShardID: 0, LastRetrievedMessageID: 10}
+	request := &types.GetReplicationMessagesRequest{
+		Tokens:          []*types.ReplicationToken{token},
+		ClusterName:     clusterName,
+		WaitForNewTasks: true,
+	}
+	newTasksCh := make(chan struct{})
+	s.mockEngine.EXPECT().WatchNewReplicationTasks().Return(newTasksCh).Times(1)
+	gomock.InOrder(
+		s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), clusterName, int64(10)).DoAndReturn(
+			func(_ context.Context, _ string, _ int64) (*types.ReplicationMessages, error) {
+				close(newTasksCh)
+				return &types.ReplicationMessages{LastRetrievedMessageID: 10}, nil
+			},
+		).Times(1),
+		s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), clusterName, int64(10)).Return(&types.ReplicationMessages{
+			ReplicationTasks:       []*types.ReplicationTask{{SourceTaskID: 11}},
+			LastRetrievedMessageID: 11,
+		}, nil).Times(1),
+	)
+
+	response, err := s.handler.GetReplicationMessages(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(11), response.GetMessagesByShard()[0].GetLastRetrievedMessageID())
+	s.Len(response.GetMessagesByShard()[0].GetReplicationTasks(), 1)
+}
+
+func (s *handlerSuite) TestGetReplicationMessages_WaitForNewTasks_Expired() {
+	s.handler.config.ReplicationTaskLongPollExpirationInterval = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	clusterName := cluster.TestAlternativeClusterName
+	request := &types.GetReplicationMessagesRequest{
+		Tokens:          []*types.ReplicationToken{{ShardID: 0, LastRetrievedMessageID: 10}},
+		ClusterName:     clusterName,
+		WaitForNewTasks: true,
+	}
+	s.mockEngine.EXPECT().WatchNewReplicationTasks().Return(make(chan struct{})).Times(1)
+	s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), clusterName, int64(10)).Return(&types.ReplicationMessages{
+		LastRetrievedMessageID: 10,
+	}, nil).Times(2)
+
+	response, err := s.handler.GetReplicationMessages(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(10), response.GetMessagesByShard()[0].GetLastRetrievedMessageID())
+	s.Empty(response.GetMessagesByShard()[0].GetReplicationTasks())
+}
+
+func (s *handlerSuite) TestGetReplicationMessages_NewTasksAvailable() {
+	clusterName := cluster.TestAlternativeClusterName
+	request := &types.GetReplicationMessagesRequest{
+		Tokens:          []*types.ReplicationToken{{ShardID: 0, LastRetrievedMessageID: 10}},
+		ClusterName:     clusterName,
+		WaitForNewTasks: true,
+	}
+	s.mockEngine.EXPECT().WatchNewReplicationTasks().Return(make(chan struct{})).Times(1)
+	s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), clusterName, int64(10)).Return(&types.ReplicationMessages{
+		LastRetrievedMessageID: 12,
+	}, nil).Times(1)
+
+	response, err := s.handler.GetReplicationMessages(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(12), response.GetMessagesByShard()[0].GetLastRetrievedMessageID())
+}
+
+func (s *handlerSuite) TestGetReplicationMessages_ShardOutOfRange() {
+	clusterName := cluster.TestAlternativeClusterName
+	outOfRangeShardID := int32(s.handler.config.NumberOfShards)
+	request := &types.GetReplicationMessagesRequest{
+		Tokens: []*types.ReplicationToken{
+			{ShardID: 0, LastRetrievedMessageID: 10},
+			{ShardID: outOfRangeShardID, LastRetrievedMessageID: 20},
+		},
+		ClusterName: clusterName,
+	}
+	// only the in-range shard is read
+	s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), clusterName, int64(10)).Return(&types.ReplicationMessages{
+		ReplicationTasks:       []*types.ReplicationTask{{SourceTaskID: 11}},
+		LastRetrievedMessageID: 11,
+	}, nil).Times(1)
+
+	response, err := s.handler.GetReplicationMessages(context.Background(), request)
+	s.NoError(err)
+	s.Len(response.GetMessagesByShard(), 1)
+	s.Equal(int64(11), response.GetMessagesByShard()[0].GetLastRetrievedMessageID())
+	s.NotContains(response.GetMessagesByShard(), outOfRangeShardID)
+	s.Len(request.Tokens, 2)
+}
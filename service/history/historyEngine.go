@@ -26,6 +26,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -55,6 +57,7 @@ import (
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/failover"
+	"github.com/uber/cadence/service/history/lifecycle"
 	"github.com/uber/cadence/service/history/ndc"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/queue"
@@ -72,6 +75,10 @@ const (
 	contextLockTimeout                    = 500 * time.Millisecond
 	longPollCompletionBuffer              = 50 * time.Millisecond
 
+	workflowTasksScanBatchSize = 1000
+	// workflowTasksMaxScanSize is the max number of tasks scanned per queue when looking up tasks of a workflow
+	workflowTasksMaxScanSize = 100000
+
 	// TerminateIfRunningReason reason for terminateIfRunning
 	TerminateIfRunningReason = "TerminateIfRunning Policy"
 	// TerminateIfRunningDetailsTemplate details template for terminateIfRunning
@@ -116,6 +123,7 @@ type (
 		rawMatchingClient          matching.Client
 		clientChecker              client.VersionChecker
 		replicationDLQHandler      replication.DLQHandler
+		replicationDLQRedriver     replication.DLQRedriveProcessor
 		failoverMarkerNotifier     failover.MarkerNotifier
 	}
 )
@@ -146,6 +154,7 @@ func NewEngineWithShardContext(
 	rawMatchingClient matching.Client,
 	queueTaskProcessor task.Processor,
 	failoverCoordinator failover.Coordinator,
+	lifecyclePublisher lifecycle.Publisher,
 ) engine.Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -213,6 +222,7 @@ func NewEngineWithShardContext(
 		executionCache,
 		historyEngImpl.workflowResetter,
 		historyEngImpl.archivalClient,
+		lifecyclePublisher,
 		openExecutionCheck,
 	)
 
@@ -316,6 +326,7 @@ func NewEngineWithShardContext(
 	historyEngImpl.replicationTaskProcessors = replicationTaskProcessors
 	replicationMessageHandler := replication.NewDLQHandler(shard, replicationTaskExecutors)
 	historyEngImpl.replicationDLQHandler = replicationMessageHandler
+	historyEngImpl.replicationDLQRedriver = replication.NewDLQRedriveProcessor(shard, config, replicationTaskExecutors)
 
 	shard.SetEngine(historyEngImpl)
 	return historyEngImpl
@@ -344,6 +355,7 @@ func (e *historyEngineImpl) Start() {
 	for _, replicationTaskProcessor := range e.replicationTaskProcessors {
 		replicationTaskProcessor.Start()
 	}
+	e.replicationDLQRedriver.Start()
 	if e.config.EnableGracefulFailover() {
 		e.failoverMarkerNotifier.Start()
 	}
@@ -363,6 +375,7 @@ func (e *historyEngineImpl) Stop() {
 	for _, replicationTaskProcessor := range e.replicationTaskProcessors {
 		replicationTaskProcessor.Stop()
 	}
+	e.replicationDLQRedriver.Stop()
 
 	if e.queueTaskProcessor != nil {
 		e.queueTaskProcessor.StopShardProcessor(e.shard)
@@ -566,6 +579,80 @@ func (e *historyEngineImpl) newDomainNotActiveError(
 	)
 }
 
+// getActiveActiveStartVersion returns the failover version a new run of an active-active domain workflow starts with,
+// if the run belongs to another cluster, a domain not active error pointing to that cluster is returned
+func (e *historyEngineImpl) getActiveActiveStartVersion(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	startRequest *types.HistoryStartWorkflowExecutionRequest,
+) (int64, error) {
+
+	domainName := domainEntry.GetInfo().Name
+	startCluster, err := e.getActiveActiveStartCluster(domainEntry, startRequest)
+	if err != nil {
+		return 0, err
+	}
+	if startCluster != e.currentClusterName {
+		return 0, ce.NewDomainNotActiveError(domainName, e.currentClusterName, startCluster)
+	}
+
+	// the new run must have a version no smaller than the current run of the workflow
+	startVersion := domainEntry.GetFailoverVersion()
+	resp, err := e.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   domainEntry.GetInfo().ID,
+		WorkflowID: startRequest.StartRequest.GetWorkflowID(),
+	})
+	switch err.(type) {
+	case nil:
+		currentRunCluster := e.clusterMetadata.ClusterNameForFailoverVersion(resp.LastWriteVersion)
+		if resp.State != persistence.WorkflowStateCompleted && currentRunCluster != e.currentClusterName {
+			// the cluster of the running workflow decides whether a new run can be started
+			return 0, ce.NewDomainNotActiveError(domainName, e.currentClusterName, currentRunCluster)
+		}
+		startVersion = common.MaxInt64(startVersion, resp.LastWriteVersion)
+	case *types.EntityNotExistsError:
+	default:
+		return 0, err
+	}
+	return e.clusterMetadata.GetNextFailoverVersion(e.currentClusterName, startVersion), nil
+}
+
+// getActiveActiveStartCluster returns the cluster a new workflow of an active-active domain is active in,
+// which is the cluster named by the configured search attribute or the current cluster
+func (e *historyEngineImpl) getActiveActiveStartCluster(
+	domainEntry *cache.DomainCacheEntry,
+	startRequest *types.HistoryStartWorkflowExecutionRequest,
+) (string, error) {
+
+	if startRequest.ParentExecutionInfo != nil {
+		// child workflow is started by its parent, in the cluster the parent is active in
+		return e.currentClusterName, nil
+	}
+	searchAttribute := e.config.ActiveActiveClusterSearchAttribute(domainEntry.GetInfo().Name)
+	if searchAttribute == "" {
+		return e.currentClusterName, nil
+	}
+	value, ok := startRequest.StartRequest.SearchAttributes.GetIndexedFields()[searchAttribute]
+	if !ok {
+		return e.currentClusterName, nil
+	}
+
+	var clusterName string
+	if err := json.Unmarshal(value, &clusterName); err != nil {
+		return "", &types.BadRequestError{
+			Message: fmt.Sprintf("Search attribute %v must be a cluster name: %v", searchAttribute, err),
+		}
+	}
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		if cluster.ClusterName == clusterName {
+			return clusterName, nil
+		}
+	}
+	return "", &types.BadRequestError{
+		Message: fmt.Sprintf("Search attribute %v names cluster %v which is not a cluster of the domain.", searchAttribute, clusterName),
+	}
+}
+
 func (e *historyEngineImpl) startWorkflowHelper(
 	ctx context.Context,
 	startRequest *types.HistoryStartWorkflowExecutionRequest,
@@ -614,6 +701,15 @@ func (e *historyEngineImpl) startWorkflowHelper(
 	if err != nil {
 		return nil, err
 	}
+	if domainEntry.IsActiveActive() {
+		startVersion, err := e.getActiveActiveStartVersion(ctx, domainEntry, startRequest)
+		if err != nil {
+			return nil, err
+		}
+		if err := curMutableState.UpdateCurrentVersion(startVersion, true); err != nil {
+			return nil, err
+		}
+	}
 
 	// preprocess for signalWithStart
 	var prevMutableState execution.MutableState
@@ -770,7 +866,9 @@ func shouldTerminateAndStart(
 	state int,
 ) bool {
 	return startRequest.StartRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning &&
-		(state == persistence.WorkflowStateRunning || state == persistence.WorkflowStateCreated)
+		(state == persistence.WorkflowStateRunning ||
+			state == persistence.WorkflowStateCreated ||
+			state == persistence.WorkflowStateSuspended)
 }
 
 // terminate running workflow then start a new run in one transaction
@@ -817,6 +915,12 @@ UpdateWorkflowLoop:
 		if err != nil {
 			return nil, err
 		}
+		if domainEntry.IsActiveActive() {
+			// new run continues in the cluster the terminated run is active in
+			if err := newMutableState.UpdateCurrentVersion(runningMutableState.GetCurrentVersion(), true); err != nil {
+				return nil, err
+			}
+		}
 
 		if signalWithStartRequest != nil {
 			startRequest, err = getStartRequest(domainID, signalWithStartRequest.SignalWithStartRequest)
@@ -1525,6 +1629,9 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			SearchAttributes: &types.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
 		},
 	}
+	if executionInfo.RetentionDays > 0 {
+		result.ExecutionConfiguration.RetentionPeriodInDays = common.Int32Ptr(executionInfo.RetentionDays)
+	}
 
 	// TODO: we need to consider adding execution time to mutable state
 	// For now execution time will be calculated based on start time and cron schedule/retry policy
@@ -1661,6 +1768,10 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return workflow.ErrNotExists
 			}
+			if mutableState.IsWorkflowExecutionSuspended() {
+				// the activity task will be re-generated when the workflow is resumed
+				return workflow.ErrSuspended
+			}
 
 			scheduleID := request.GetScheduleID()
 			requestID := request.GetRequestID()
@@ -2295,6 +2406,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				prevMutableState = mutableState
 				break
 			}
+			if err := workflow.ValidateActive(mutableState); err != nil {
+				return nil, err
+			}
 			// workflow is running, if policy is TerminateIfRunning, terminate current run then signalWithStart
 			if sRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning {
 				workflowExecution.RunID = uuid.New()
@@ -2448,6 +2562,855 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		})
 }
 
+// SuspendWorkflowExecution suspends a running workflow execution, its decision, activity and
+// timer tasks are held back by the task executors until the workflow execution is resumed
+func (e *historyEngineImpl) SuspendWorkflowExecution(
+	ctx context.Context,
+	suspendRequest *types.HistorySuspendWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(suspendRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := suspendRequest.SuspendRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			if mutableState.IsWorkflowExecutionSuspended() {
+				return nil, workflow.ErrAlreadySuspended
+			}
+
+			_, err := mutableState.AddWorkflowExecutionSuspendedEvent(request.GetReason(), request.GetIdentity())
+			return workflow.UpdateWithoutDecision, err
+		})
+}
+
+// FailoverWorkflowExecution makes a running workflow of an active-active domain active in the current cluster,
+// the workflow's events are written with a failover version of the current cluster from then on
+func (e *historyEngineImpl) FailoverWorkflowExecution(
+	ctx context.Context,
+	failoverRequest *types.HistoryFailoverWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(failoverRequest.GetDomainUUID())
+	if err != nil {
+		return err
+	}
+	if !domainEntry.IsActiveActive() {
+		return workflow.ErrDomainNotActiveActive
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	currentClusterName := e.clusterMetadata.GetCurrentClusterName()
+	request := failoverRequest.GetRequest()
+	if targetCluster := request.GetTargetCluster(); targetCluster != "" && targetCluster != currentClusterName {
+		return &types.BadRequestError{Message: fmt.Sprintf(
+			"workflow can only be failed over to the current cluster %v, target cluster: %v",
+			currentClusterName,
+			targetCluster,
+		)}
+	}
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.GetExecution().GetWorkflowID(),
+		RunID:      request.GetExecution().GetRunID(),
+	}
+
+	return workflow.FailoverCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			if domainEntry.IsWorkflowActive(mutableState.GetCurrentVersion()) {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+
+			failoverVersion := e.clusterMetadata.GetNextFailoverVersion(
+				currentClusterName,
+				mutableState.GetCurrentVersion(),
+			)
+			if err := execution.FailoverWorkflow(mutableState, failoverVersion); err != nil {
+				return nil, err
+			}
+
+			// tasks generated by the previous active cluster are not dispatched by the current cluster
+			mutableStateTaskRefresher := execution.NewMutableStateTaskRefresher(
+				e.shard.GetConfig(),
+				e.shard.GetClusterMetadata(),
+				e.shard.GetDomainCache(),
+				e.shard.GetEventsCache(),
+				e.shard.GetLogger(),
+				e.shard.GetShardID(),
+			)
+			if err := mutableStateTaskRefresher.RefreshTasks(
+				ctx,
+				mutableState.GetExecutionInfo().StartTimestamp,
+				mutableState,
+			); err != nil {
+				return nil, err
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// ResumeWorkflowExecution resumes a suspended workflow execution and re-generates
+// the tasks held back while the workflow execution was suspended
+func (e *historyEngineImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	resumeRequest *types.HistoryResumeWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(resumeRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := resumeRequest.ResumeRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			if !mutableState.IsWorkflowExecutionSuspended() {
+				return nil, workflow.ErrNotSuspended
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionResumedEvent(request.GetReason(), request.GetIdentity()); err != nil {
+				return nil, err
+			}
+
+			// task executors ack the tasks of a suspended workflow without processing them,
+			// re-generate all tasks so the workflow makes progress after it is resumed
+			mutableStateTaskRefresher := execution.NewMutableStateTaskRefresher(
+				e.shard.GetConfig(),
+				e.shard.GetClusterMetadata(),
+				e.shard.GetDomainCache(),
+				e.shard.GetEventsCache(),
+				e.shard.GetLogger(),
+				e.shard.GetShardID(),
+			)
+			if err := mutableStateTaskRefresher.RefreshTasks(
+				ctx,
+				mutableState.GetExecutionInfo().StartTimestamp,
+				mutableState,
+			); err != nil {
+				return nil, err
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// ReindexWorkflowExecution rebuilds the visibility record of a workflow execution from its mutable state.
+// The record is written with a newly generated task ID so it supersedes the existing document.
+func (e *historyEngineImpl) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryReindexWorkflowExecutionRequest,
+) (retError error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return err
+	}
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, *request.Execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return err
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return err
+	}
+	executionTimestamp := time.Unix(0, 0)
+	if backoffSeconds := startEvent.WorkflowExecutionStartedEventAttributes.GetFirstDecisionTaskBackoffSeconds(); backoffSeconds != 0 {
+		executionTimestamp = time.Unix(0, startEvent.GetTimestamp()).Add(time.Duration(backoffSeconds) * time.Second)
+	}
+	var memo *types.Memo
+	if executionInfo.Memo != nil {
+		memo = &types.Memo{Fields: executionInfo.Memo}
+	}
+	taskID, err := e.shard.GenerateTransferTaskID()
+	if err != nil {
+		return err
+	}
+	execution := types.WorkflowExecution{
+		WorkflowID: executionInfo.WorkflowID,
+		RunID:      executionInfo.RunID,
+	}
+	isCron := len(executionInfo.CronSchedule) > 0
+	numClusters := int16(len(domainEntry.GetReplicationConfig().Clusters))
+
+	if mutableState.IsWorkflowExecutionRunning() {
+		return e.visibilityMgr.RecordWorkflowExecutionStarted(ctx, &persistence.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:         domainID,
+			Domain:             domainEntry.GetInfo().Name,
+			Execution:          execution,
+			WorkflowTypeName:   executionInfo.WorkflowTypeName,
+			StartTimestamp:     startEvent.GetTimestamp(),
+			ExecutionTimestamp: executionTimestamp.UnixNano(),
+			WorkflowTimeout:    int64(executionInfo.WorkflowTimeout),
+			TaskID:             taskID,
+			Memo:               memo,
+			TaskList:           executionInfo.TaskList,
+			IsCron:             isCron,
+			NumClusters:        numClusters,
+			SearchAttributes:   executionInfo.SearchAttributes,
+			EncryptionKeyID:    e.config.PayloadEncryptionKeyID(domainEntry.GetInfo().Name),
+		})
+	}
+
+	completionEvent, err := mutableState.GetCompletionEvent(ctx)
+	if err != nil {
+		return err
+	}
+	retentionDays := domainEntry.GetRetentionDays(executionInfo.WorkflowID)
+	if executionInfo.RetentionDays > 0 {
+		retentionDays = executionInfo.RetentionDays
+	}
+	return e.visibilityMgr.RecordWorkflowExecutionClosed(ctx, &persistence.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:         domainID,
+		Domain:             domainEntry.GetInfo().Name,
+		Execution:          execution,
+		WorkflowTypeName:   executionInfo.WorkflowTypeName,
+		StartTimestamp:     startEvent.GetTimestamp(),
+		ExecutionTimestamp: executionTimestamp.UnixNano(),
+		CloseTimestamp:     completionEvent.GetTimestamp(),
+		Status:             *persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus),
+		HistoryLength:      mutableState.GetNextEventID() - 1,
+		RetentionSeconds:   int64(retentionDays) * int64(time.Hour*24/time.Second),
+		TaskID:             taskID,
+		Memo:               memo,
+		TaskList:           executionInfo.TaskList,
+		SearchAttributes:   executionInfo.SearchAttributes,
+		IsCron:             isCron,
+		NumClusters:        numClusters,
+		EncryptionKeyID:    e.config.PayloadEncryptionKeyID(domainEntry.GetInfo().Name),
+	})
+}
+
+// RebuildMutableState rebuilds the mutable state of a workflow execution by replaying its history and
+// returns the fields where the stored mutable state disagrees with the rebuilt one. Unless DryRun is set,
+// a mismatched stored mutable state is replaced with the rebuilt one, with all its tasks regenerated.
+func (e *historyEngineImpl) RebuildMutableState(
+	ctx context.Context,
+	request *types.HistoryRebuildMutableStateRequest,
+) (response *types.RebuildMutableStateResponse, retError error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return nil, err
+	}
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, *request.Execution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, err
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	// buffered events and transient decisions are only kept in mutable state, so the
+	// rebuilt mutable state can only be compared once they are flushed to history
+	if mutableState.HasBufferedEvents() || executionInfo.DecisionAttempt > 0 {
+		return nil, &types.ServiceBusyError{
+			Message: "Workflow has buffered events or a transient decision, please retry later.",
+		}
+	}
+
+	versionHistories := mutableState.GetVersionHistories()
+	if versionHistories == nil {
+		return nil, execution.ErrMissingVersionHistories
+	}
+	currentVersionHistory, err := versionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := currentVersionHistory.GetLastItem()
+	if err != nil {
+		return nil, err
+	}
+
+	workflowIdentifier := definition.NewWorkflowIdentifier(
+		domainID,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+	)
+	rebuiltMutableState, rebuiltHistorySize, err := execution.NewStateRebuilder(e.shard, e.logger).Rebuild(
+		ctx,
+		executionInfo.StartTimestamp,
+		workflowIdentifier,
+		currentVersionHistory.GetBranchToken(),
+		lastItem.GetEventID(),
+		lastItem.GetVersion(),
+		workflowIdentifier,
+		currentVersionHistory.GetBranchToken(),
+		uuid.New(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// history has no notion of zombie workflows, carry the state over from the stored mutable state
+	conflictResolveMode := persistence.ConflictResolveWorkflowModeUpdateCurrent
+	if executionInfo.State == persistence.WorkflowStateZombie {
+		conflictResolveMode = persistence.ConflictResolveWorkflowModeBypassCurrent
+		if rebuiltMutableState.IsWorkflowExecutionRunning() {
+			if err := rebuiltMutableState.UpdateWorkflowStateCloseStatus(
+				persistence.WorkflowStateZombie,
+				persistence.WorkflowCloseStatusNone,
+			); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	response = &types.RebuildMutableStateResponse{
+		Mismatches: execution.DiffMutableStates(mutableState, rebuiltMutableState),
+	}
+	if request.DryRun || len(response.Mismatches) == 0 {
+		return response, nil
+	}
+
+	if conflictResolveMode == persistence.ConflictResolveWorkflowModeUpdateCurrent {
+		// a completed run which is no longer the current run must not take over the current record
+		resp, err := e.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: executionInfo.WorkflowID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.RunID != executionInfo.RunID {
+			conflictResolveMode = persistence.ConflictResolveWorkflowModeBypassCurrent
+		}
+	}
+
+	if err := rebuiltMutableState.SetVersionHistories(versionHistories); err != nil {
+		return nil, err
+	}
+	rebuiltMutableState.SetUpdateCondition(mutableState.GetUpdateCondition())
+	wfContext.Clear()
+	wfContext.SetHistorySize(rebuiltHistorySize)
+
+	if err := wfContext.ConflictResolveWorkflowExecution(
+		ctx,
+		e.shard.GetTimeSource().Now(),
+		conflictResolveMode,
+		rebuiltMutableState,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ListWorkflowTasks lists the pending transfer, timer, replication and cross-cluster tasks of a workflow execution.
+// Tasks are read from persistence starting at the queue ack levels, the attempt count is only available for
+// tasks currently loaded by the queue processors.
+func (e *historyEngineImpl) ListWorkflowTasks(
+	ctx context.Context,
+	request *types.HistoryListWorkflowTasksRequest,
+) (*types.ListWorkflowTasksResponse, error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return nil, err
+	}
+	workflowExecution := request.GetRequest().GetWorkflowExecution()
+	filter := newWorkflowTaskFilter(request.DomainUUID, workflowExecution)
+
+	response := &types.ListWorkflowTasksResponse{
+		ShardID: int32(e.shard.GetShardID()),
+	}
+	for _, taskType := range []common.TaskType{
+		common.TaskTypeTransfer,
+		common.TaskTypeTimer,
+		common.TaskTypeReplication,
+		common.TaskTypeCrossCluster,
+	} {
+		tasks, truncated, err := e.loadWorkflowTasks(ctx, taskType, request.DomainUUID, workflowExecution, filter)
+		if err != nil {
+			return nil, err
+		}
+		response.Tasks = append(response.Tasks, tasks...)
+		response.Truncated = response.Truncated || truncated
+	}
+	return response, nil
+}
+
+// DeleteWorkflowTask deletes a single pending transfer or timer task of a workflow execution. The in-memory
+// copy of the task is acked so that it won't be executed or retried by the queue processors.
+// Replication and cross-cluster tasks can't be deleted, since their in-memory copies can't be acked.
+func (e *historyEngineImpl) DeleteWorkflowTask(
+	ctx context.Context,
+	request *types.HistoryDeleteWorkflowTaskRequest,
+) error {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return err
+	}
+	deleteRequest := request.GetRequest()
+	queueProcessor := e.getWorkflowTaskQueueProcessor(deleteRequest.Type)
+	if queueProcessor == nil {
+		return &types.BadRequestError{Message: "Only transfer and timer tasks can be deleted."}
+	}
+	taskInfo, err := e.findWorkflowTask(
+		ctx,
+		request.DomainUUID,
+		deleteRequest.GetWorkflowExecution(),
+		deleteRequest.Type,
+		deleteRequest.GetTaskID(),
+		deleteRequest.VisibilityTimestamp,
+		deleteRequest.GetClusterName(),
+	)
+	if err != nil {
+		return err
+	}
+
+	taskType := common.TaskType(taskInfo.GetType())
+	visibilityTimestamp := taskInfo.GetVisibilityTimestamp()
+	switch taskType {
+	case common.TaskTypeTransfer:
+		err = e.executionManager.CompleteTransferTask(ctx, &persistence.CompleteTransferTaskRequest{
+			TaskID: taskInfo.TaskID,
+		})
+	case common.TaskTypeTimer:
+		err = e.executionManager.CompleteTimerTask(ctx, &persistence.CompleteTimerTaskRequest{
+			VisibilityTimestamp: time.Unix(0, visibilityTimestamp),
+			TaskID:              taskInfo.TaskID,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, clusterName := range e.getQueueProcessorClusterNames(true) {
+		if _, err := queueProcessor.HandleAction(ctx, clusterName, queue.NewAckTaskAction(taskInfo.TaskID)); err != nil {
+			// the task is already deleted from persistence and won't be loaded again
+			e.logger.Warn("Failed to ack deleted workflow task in queue processor.",
+				tag.ClusterName(clusterName),
+				tag.TaskID(taskInfo.TaskID),
+				tag.Error(err),
+			)
+		}
+	}
+
+	e.metricsClient.Scope(metrics.HistoryDeleteWorkflowTaskScope, metrics.DomainTag(deleteRequest.GetDomain())).
+		IncCounter(metrics.WorkflowTaskDeletedCount)
+	e.logger.Info("Workflow task deleted.",
+		tag.WorkflowDomainID(request.DomainUUID),
+		tag.WorkflowID(deleteRequest.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(deleteRequest.GetWorkflowExecution().GetRunID()),
+		tag.TaskType(int(taskType)),
+		tag.TaskID(taskInfo.TaskID),
+		tag.TaskVisibilityTimestamp(visibilityTimestamp),
+		tag.Reason(deleteRequest.GetReason()),
+		tag.Identity(deleteRequest.GetIdentity()),
+	)
+	return nil
+}
+
+// ReenqueueWorkflowTask submits a pending transfer or timer task of a workflow execution, which is
+// waiting for retry after failed attempts, to the task processor for immediate execution.
+func (e *historyEngineImpl) ReenqueueWorkflowTask(
+	ctx context.Context,
+	request *types.HistoryReenqueueWorkflowTaskRequest,
+) error {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return err
+	}
+	reenqueueRequest := request.GetRequest()
+	queueProcessor := e.getWorkflowTaskQueueProcessor(reenqueueRequest.Type)
+	if queueProcessor == nil {
+		return &types.BadRequestError{Message: "Only transfer and timer tasks can be re-enqueued."}
+	}
+	taskInfo, err := e.findWorkflowTask(
+		ctx,
+		request.DomainUUID,
+		reenqueueRequest.GetWorkflowExecution(),
+		reenqueueRequest.Type,
+		reenqueueRequest.GetTaskID(),
+		reenqueueRequest.VisibilityTimestamp,
+		reenqueueRequest.GetClusterName(),
+	)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	redispatched := false
+	for _, clusterName := range e.getQueueProcessorClusterNames(true) {
+		result, err := queueProcessor.HandleAction(ctx, clusterName, queue.NewRedispatchTaskAction(taskInfo.TaskID))
+		if err != nil {
+			return err
+		}
+		found = found || result.RedispatchTaskResult.Found
+		redispatched = redispatched || result.RedispatchTaskResult.Redispatched
+	}
+	if !found {
+		return &types.BadRequestError{
+			Message: "Task is not loaded by the queue processor yet, it will be processed once loaded.",
+		}
+	}
+
+	e.metricsClient.Scope(metrics.HistoryReenqueueWorkflowTaskScope, metrics.DomainTag(reenqueueRequest.GetDomain())).
+		IncCounter(metrics.WorkflowTaskReenqueuedCount)
+	e.logger.Info("Workflow task re-enqueued.",
+		tag.WorkflowDomainID(request.DomainUUID),
+		tag.WorkflowID(reenqueueRequest.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(reenqueueRequest.GetWorkflowExecution().GetRunID()),
+		tag.TaskType(int(taskInfo.GetType())),
+		tag.TaskID(taskInfo.TaskID),
+		tag.TaskVisibilityTimestamp(taskInfo.GetVisibilityTimestamp()),
+		tag.Bool(redispatched),
+		tag.Reason(reenqueueRequest.GetReason()),
+		tag.Identity(reenqueueRequest.GetIdentity()),
+	)
+	return nil
+}
+
+func (e *historyEngineImpl) findWorkflowTask(
+	ctx context.Context,
+	domainID string,
+	workflowExecution *types.WorkflowExecution,
+	taskType *int32,
+	taskID int64,
+	visibilityTimestamp *int64,
+	clusterName string,
+) (*types.WorkflowTaskInfo, error) {
+
+	if taskType == nil {
+		return nil, &types.BadRequestError{Message: "Task type is not set."}
+	}
+	switch common.TaskType(*taskType) {
+	case common.TaskTypeTransfer, common.TaskTypeTimer, common.TaskTypeReplication, common.TaskTypeCrossCluster:
+	default:
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Unknown task type: %v.", *taskType)}
+	}
+
+	workflowFilter := newWorkflowTaskFilter(domainID, workflowExecution)
+	filter := func(info task.Info) (bool, error) {
+		if info.GetTaskID() != taskID {
+			return false, nil
+		}
+		if visibilityTimestamp != nil && info.GetVisibilityTimestamp().UnixNano() != *visibilityTimestamp {
+			return false, nil
+		}
+		return workflowFilter(info)
+	}
+	tasks, truncated, err := e.loadWorkflowTasks(ctx, common.TaskType(*taskType), domainID, workflowExecution, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, taskInfo := range tasks {
+		if clusterName == "" || taskInfo.ClusterName == clusterName {
+			return taskInfo, nil
+		}
+	}
+	if truncated {
+		return nil, &types.EntityNotExistsError{
+			Message: fmt.Sprintf("Task %v not found within the first %v tasks of the queue.", taskID, workflowTasksMaxScanSize),
+		}
+	}
+	return nil, &types.EntityNotExistsError{
+		Message: fmt.Sprintf("Task %v not found for workflow execution.", taskID),
+	}
+}
+
+// loadWorkflowTasks scans the pending tasks of the given type in persistence, returns the ones
+// accepted by the filter and whether the scan stopped at workflowTasksMaxScanSize
+func (e *historyEngineImpl) loadWorkflowTasks(
+	ctx context.Context,
+	taskType common.TaskType,
+	domainID string,
+	workflowExecution *types.WorkflowExecution,
+	filter task.Filter,
+) ([]*types.WorkflowTaskInfo, bool, error) {
+
+	var attempts map[int64]int
+	if queueProcessor := e.getWorkflowTaskQueueProcessor(common.Int32Ptr(int32(taskType))); queueProcessor != nil {
+		attempts = e.getOutstandingTaskAttempts(ctx, queueProcessor, e.getQueueProcessorClusterNames(true), domainID, workflowExecution)
+	}
+
+	switch taskType {
+	case common.TaskTypeTransfer:
+		readLevel := e.shard.GetTransferAckLevel()
+		maxReadLevel := e.shard.GetTransferMaxReadLevel()
+		return scanWorkflowTasks(taskType, "", filter, attempts, func(pageToken []byte) ([]task.Info, []byte, error) {
+			response, err := e.executionManager.GetTransferTasks(ctx, &persistence.GetTransferTasksRequest{
+				ReadLevel:     readLevel,
+				MaxReadLevel:  maxReadLevel,
+				BatchSize:     workflowTasksScanBatchSize,
+				NextPageToken: pageToken,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			infos := make([]task.Info, 0, len(response.Tasks))
+			for _, info := range response.Tasks {
+				infos = append(infos, info)
+			}
+			return infos, response.NextPageToken, nil
+		})
+	case common.TaskTypeTimer:
+		minTimestamp := e.shard.GetTimerAckLevel()
+		return scanWorkflowTasks(taskType, "", filter, attempts, func(pageToken []byte) ([]task.Info, []byte, error) {
+			response, err := e.executionManager.GetTimerIndexTasks(ctx, &persistence.GetTimerIndexTasksRequest{
+				MinTimestamp:  minTimestamp,
+				MaxTimestamp:  time.Unix(0, math.MaxInt64),
+				BatchSize:     workflowTasksScanBatchSize,
+				NextPageToken: pageToken,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			infos := make([]task.Info, 0, len(response.Timers))
+			for _, info := range response.Timers {
+				infos = append(infos, info)
+			}
+			return infos, response.NextPageToken, nil
+		})
+	case common.TaskTypeReplication:
+		remoteClusterNames := e.getQueueProcessorClusterNames(false)
+		if len(remoteClusterNames) == 0 {
+			return nil, false, nil
+		}
+		readLevel := int64(math.MaxInt64)
+		for _, clusterName := range remoteClusterNames {
+			readLevel = common.MinInt64(readLevel, e.shard.GetClusterReplicationLevel(clusterName))
+		}
+		maxReadLevel := e.shard.GetTransferMaxReadLevel()
+		return scanWorkflowTasks(taskType, "", filter, nil, func(pageToken []byte) ([]task.Info, []byte, error) {
+			response, err := e.executionManager.GetReplicationTasks(ctx, &persistence.GetReplicationTasksRequest{
+				ReadLevel:     readLevel,
+				MaxReadLevel:  maxReadLevel,
+				BatchSize:     workflowTasksScanBatchSize,
+				NextPageToken: pageToken,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			infos := make([]task.Info, 0, len(response.Tasks))
+			for _, info := range response.Tasks {
+				infos = append(infos, info)
+			}
+			return infos, response.NextPageToken, nil
+		})
+	case common.TaskTypeCrossCluster:
+		var result []*types.WorkflowTaskInfo
+		truncated := false
+		maxReadLevel := e.shard.GetTransferMaxReadLevel()
+		for _, clusterName := range e.getQueueProcessorClusterNames(false) {
+			targetCluster := clusterName
+			readLevel := int64(math.MaxInt64)
+			for _, state := range e.shard.GetCrossClusterProcessingQueueStates(targetCluster) {
+				readLevel = common.MinInt64(readLevel, state.GetAckLevel())
+			}
+			attempts := e.getOutstandingTaskAttempts(ctx, e.crossClusterProcessor, []string{targetCluster}, domainID, workflowExecution)
+			tasks, clusterTruncated, err := scanWorkflowTasks(taskType, targetCluster, filter, attempts, func(pageToken []byte) ([]task.Info, []byte, error) {
+				response, err := e.executionManager.GetCrossClusterTasks(ctx, &persistence.GetCrossClusterTasksRequest{
+					TargetCluster: targetCluster,
+					ReadLevel:     readLevel,
+					MaxReadLevel:  maxReadLevel,
+					BatchSize:     workflowTasksScanBatchSize,
+					NextPageToken: pageToken,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				infos := make([]task.Info, 0, len(response.Tasks))
+				for _, info := range response.Tasks {
+					infos = append(infos, info)
+				}
+				return infos, response.NextPageToken, nil
+			})
+			if err != nil {
+				return nil, false, err
+			}
+			result = append(result, tasks...)
+			truncated = truncated || clusterTruncated
+		}
+		return result, truncated, nil
+	default:
+		return nil, false, &types.BadRequestError{Message: fmt.Sprintf("Unknown task type: %v.", taskType)}
+	}
+}
+
+// getOutstandingTaskAttempts returns the attempt count of the tasks loaded by the queue processors,
+// as the same task is processed by the processor of each cluster, the max attempt count is returned
+func (e *historyEngineImpl) getOutstandingTaskAttempts(
+	ctx context.Context,
+	queueProcessor queue.Processor,
+	clusterNames []string,
+	domainID string,
+	workflowExecution *types.WorkflowExecution,
+) map[int64]int {
+
+	attempts := make(map[int64]int)
+	for _, clusterName := range clusterNames {
+		result, err := queueProcessor.HandleAction(ctx, clusterName, queue.NewGetWorkflowTasksAction(
+			domainID,
+			workflowExecution.GetWorkflowID(),
+			workflowExecution.GetRunID(),
+		))
+		if err != nil {
+			// attempt count is informational only, don't fail the request
+			e.logger.Warn("Failed to get outstanding tasks from queue processor.", tag.ClusterName(clusterName), tag.Error(err))
+			continue
+		}
+		for _, outstandingTask := range result.GetWorkflowTasksResult.Tasks {
+			if attempt := outstandingTask.GetAttempt(); attempt > attempts[outstandingTask.GetTaskID()] {
+				attempts[outstandingTask.GetTaskID()] = attempt
+			}
+		}
+	}
+	return attempts
+}
+
+func (e *historyEngineImpl) getWorkflowTaskQueueProcessor(
+	taskType *int32,
+) queue.Processor {
+	if taskType == nil {
+		return nil
+	}
+	switch common.TaskType(*taskType) {
+	case common.TaskTypeTransfer:
+		return e.txProcessor
+	case common.TaskTypeTimer:
+		return e.timerProcessor
+	default:
+		return nil
+	}
+}
+
+// getQueueProcessorClusterNames returns the clusters with a queue processor in this shard,
+// processors for remote clusters are only started when global domain is enabled
+func (e *historyEngineImpl) getQueueProcessorClusterNames(
+	includeCurrentCluster bool,
+) []string {
+	var clusterNames []string
+	if includeCurrentCluster {
+		clusterNames = append(clusterNames, e.currentClusterName)
+	}
+	if !e.clusterMetadata.IsGlobalDomainEnabled() {
+		return clusterNames
+	}
+	for clusterName, info := range e.clusterMetadata.GetAllClusterInfo() {
+		if info.Enabled && clusterName != e.currentClusterName {
+			clusterNames = append(clusterNames, clusterName)
+		}
+	}
+	sort.Strings(clusterNames)
+	return clusterNames
+}
+
+func newWorkflowTaskFilter(
+	domainID string,
+	workflowExecution *types.WorkflowExecution,
+) task.Filter {
+	return func(info task.Info) (bool, error) {
+		return info.GetDomainID() == domainID &&
+			info.GetWorkflowID() == workflowExecution.GetWorkflowID() &&
+			(workflowExecution.GetRunID() == "" || info.GetRunID() == workflowExecution.GetRunID()), nil
+	}
+}
+
+func scanWorkflowTasks(
+	taskType common.TaskType,
+	clusterName string,
+	filter task.Filter,
+	attempts map[int64]int,
+	getPage func(pageToken []byte) ([]task.Info, []byte, error),
+) ([]*types.WorkflowTaskInfo, bool, error) {
+
+	var result []*types.WorkflowTaskInfo
+	var pageToken []byte
+	scanned := 0
+	for {
+		infos, nextPageToken, err := getPage(pageToken)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, info := range infos {
+			if ok, _ := filter(info); !ok {
+				continue
+			}
+			result = append(result, &types.WorkflowTaskInfo{
+				Type:                common.Int32Ptr(int32(taskType)),
+				SubType:             int32(info.GetTaskType()),
+				TaskID:              info.GetTaskID(),
+				VisibilityTimestamp: common.Int64Ptr(info.GetVisibilityTimestamp().UnixNano()),
+				Version:             info.GetVersion(),
+				Attempt:             int32(attempts[info.GetTaskID()]),
+				ClusterName:         clusterName,
+			})
+		}
+
+		scanned += len(infos)
+		pageToken = nextPageToken
+		if len(pageToken) == 0 {
+			return result, false, nil
+		}
+		if scanned >= workflowTasksMaxScanSize {
+			return result, true, nil
+		}
+	}
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx context.Context,
@@ -2618,7 +3581,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	}
 
 	// dedup by requestID
-	if currentMutableState.GetExecutionInfo().CreateRequestID == request.GetRequestID() {
+	if !request.GetDryRun() && currentMutableState.GetExecutionInfo().CreateRequestID == request.GetRequestID() {
 		e.logger.Info("Duplicated reset request",
 			tag.WorkflowID(workflowID),
 			tag.WorkflowRunID(currentRunID),
@@ -2650,6 +3613,36 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		baseCurrentBranchToken = baseCurrentVersionHistory.GetBranchToken()
 	}
 
+	currentWorkflow := execution.NewWorkflow(
+		ctx,
+		e.shard.GetDomainCache(),
+		e.shard.GetClusterMetadata(),
+		currentContext,
+		currentMutableState,
+		currentReleaseFn,
+	)
+
+	if request.GetDryRun() {
+		preview, err := e.workflowResetter.PreviewResetWorkflow(
+			ctx,
+			domainID,
+			workflowID,
+			baseRunID,
+			baseCurrentBranchToken,
+			baseRebuildLastEventID,
+			baseRebuildLastEventVersion,
+			baseNextEventID,
+			currentWorkflow,
+			request.GetSkipSignalReapply(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &types.ResetWorkflowExecutionResponse{
+			Preview: preview,
+		}, nil
+	}
+
 	if err := e.workflowResetter.ResetWorkflow(
 		ctx,
 		domainID,
@@ -2661,14 +3654,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		baseNextEventID,
 		resetRunID,
 		request.GetRequestID(),
-		execution.NewWorkflow(
-			ctx,
-			e.shard.GetDomainCache(),
-			e.shard.GetClusterMetadata(),
-			currentContext,
-			currentMutableState,
-			currentReleaseFn,
-		),
+		currentWorkflow,
 		request.GetReason(),
 		nil,
 		request.GetSkipSignalReapply(),
@@ -2711,6 +3697,16 @@ func (e *historyEngineImpl) NotifyNewTimerTasks(
 	}
 }
 
+func (e *historyEngineImpl) NotifyNewReplicationTasks(
+	executionInfo *persistence.WorkflowExecutionInfo,
+	tasks []persistence.Task,
+) {
+
+	if len(tasks) > 0 {
+		e.replicationAckManager.NotifyNewTasks()
+	}
+}
+
 func (e *historyEngineImpl) NotifyNewCrossClusterTasks(
 	executionInfo *persistence.WorkflowExecutionInfo,
 	tasks []persistence.Task,
@@ -2867,6 +3863,14 @@ func (e *historyEngineImpl) validateStartWorkflowExecutionRequest(
 		return &types.BadRequestError{Message: "WorkflowType exceeds length limit."}
 	}
 
+	if err := common.ValidateRetentionOverride(
+		request.RetentionPeriodInDays,
+		int32(e.config.MinWorkflowRetentionDays(request.GetDomain())),
+		int32(e.config.MaxWorkflowRetentionDays(request.GetDomain())),
+	); err != nil {
+		return err
+	}
+
 	return common.ValidateRetryPolicy(request.RetryPolicy)
 }
 
@@ -2929,6 +3933,7 @@ func getStartRequest(
 		SearchAttributes:                    request.SearchAttributes,
 		Header:                              request.Header,
 		DelayStartSeconds:                   request.DelayStartSeconds,
+		RetentionPeriodInDays:               request.RetentionPeriodInDays,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now())
@@ -2970,7 +3975,8 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(
 	// or has history check if the workflow is finished
 	switch prevState {
 	case persistence.WorkflowStateCreated,
-		persistence.WorkflowStateRunning:
+		persistence.WorkflowStateRunning,
+		persistence.WorkflowStateSuspended:
 		msg := "Workflow execution is already running. WorkflowId: %v, RunId: %v."
 		return getWorkflowAlreadyStartedError(msg, prevStartRequestID, execution.GetWorkflowID(), prevRunID)
 	case persistence.WorkflowStateCompleted:
@@ -3035,6 +4041,11 @@ func (e *historyEngineImpl) GetReplicationMessages(
 	return replicationMessages, nil
 }
 
+// WatchNewReplicationTasks returns a channel which is closed once new replication tasks are generated for the shard
+func (e *historyEngineImpl) WatchNewReplicationTasks() <-chan struct{} {
+	return e.replicationAckManager.WatchNewTasks()
+}
+
 func (e *historyEngineImpl) GetDLQReplicationMessages(
 	ctx context.Context,
 	taskInfos []*types.ReplicationTaskInfo,
@@ -3280,6 +4291,16 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 		return err
 	}
 
+	if domainEntry.IsGlobalDomain() && mutableState.GetVersionHistories() == nil {
+		// workflows started before the domain was promoted to global may not have version histories
+		if err := execution.BackfillVersionHistories(mutableState, domainEntry.GetFailoverVersion()); err != nil {
+			return err
+		}
+		if err := mutableState.UpdateCurrentVersion(domainEntry.GetFailoverVersion(), true); err != nil {
+			return err
+		}
+	}
+
 	mutableStateTaskRefresher := execution.NewMutableStateTaskRefresher(
 		e.shard.GetConfig(),
 		e.shard.GetClusterMetadata(),
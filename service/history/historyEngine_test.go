@@ -59,8 +59,10 @@ import (
 	"github.com/uber/cadence/service/history/ndc"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/queue"
+	"github.com/uber/cadence/service/history/replication"
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/task"
 	test "github.com/uber/cadence/service/history/testing"
 	"github.com/uber/cadence/service/history/workflow"
 )
@@ -5119,6 +5121,605 @@ func (s *engineSuite) TestRemoveSignalMutableState() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestSuspendWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	ms := s.createSuspendResumeTestMutableState(we)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
+		return input.UpdateWorkflowMutation.ExecutionInfo.State == persistence.WorkflowStateSuspended
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.SuspendWorkflowExecution(context.Background(), &types.HistorySuspendWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SuspendRequest: &types.SuspendWorkflowExecutionRequest{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &we,
+			Reason:            "incident",
+			Identity:          "testIdentity",
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestSuspendWorkflowExecution_AlreadySuspended() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	ms := s.createSuspendResumeTestMutableState(we)
+	ms.ExecutionInfo.State = persistence.WorkflowStateSuspended
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.SuspendWorkflowExecution(context.Background(), &types.HistorySuspendWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SuspendRequest: &types.SuspendWorkflowExecutionRequest{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &we,
+		},
+	})
+	s.Equal(workflow.ErrAlreadySuspended, err)
+}
+
+func (s *engineSuite) TestResumeWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	ms := s.createSuspendResumeTestMutableState(we)
+	ms.ExecutionInfo.State = persistence.WorkflowStateSuspended
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
+		if input.UpdateWorkflowMutation.ExecutionInfo.State != persistence.WorkflowStateRunning {
+			return false
+		}
+		// the decision task held back while suspended is re-generated
+		for _, task := range input.UpdateWorkflowMutation.TransferTasks {
+			if task.GetType() == persistence.TransferTaskTypeDecisionTask {
+				return true
+			}
+		}
+		return false
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.ResumeWorkflowExecution(context.Background(), &types.HistoryResumeWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		ResumeRequest: &types.ResumeWorkflowExecutionRequest{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &we,
+			Reason:            "mitigated",
+			Identity:          "testIdentity",
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestResumeWorkflowExecution_NotSuspended() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	ms := s.createSuspendResumeTestMutableState(we)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.ResumeWorkflowExecution(context.Background(), &types.HistoryResumeWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		ResumeRequest: &types.ResumeWorkflowExecutionRequest{
+			Domain:            constants.TestDomainName,
+			WorkflowExecution: &we,
+		},
+	})
+	s.Equal(workflow.ErrNotSuspended, err)
+}
+
+func (s *engineSuite) TestFailoverWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	alternativeVersion := cluster.TestAlternativeClusterInitialFailoverVersion + cluster.TestFailoverVersionIncrement
+	failoverVersion := cluster.TestCurrentClusterInitialFailoverVersion + 2*cluster.TestFailoverVersionIncrement
+	domainEntry := s.setupActiveActiveDomain(alternativeVersion)
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(alternativeVersion).Return(cluster.TestAlternativeClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(failoverVersion).Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetNextFailoverVersion(cluster.TestCurrentClusterName, alternativeVersion).Return(failoverVersion).Times(1)
+
+	mockReplicationAckManager := replication.NewMockTaskAckManager(s.controller)
+	mockReplicationAckManager.EXPECT().NotifyNewTasks().Times(1)
+	s.mockHistoryEngine.replicationAckManager = mockReplicationAckManager
+
+	ms := s.createActiveActiveTestMutableState(we, domainEntry)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
+		currentVersionHistory, err := input.UpdateWorkflowMutation.VersionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return false
+		}
+		lastItem, err := currentVersionHistory.GetLastItem()
+		return err == nil && lastItem.Version == failoverVersion
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.FailoverWorkflowExecution(context.Background(), &types.HistoryFailoverWorkflowExecutionRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request: &types.FailoverWorkflowExecutionRequest{
+			Domain:        domainEntry.GetInfo().Name,
+			Execution:     &we,
+			TargetCluster: cluster.TestCurrentClusterName,
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestFailoverWorkflowExecution_AlreadyActive() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	currentVersion := cluster.TestCurrentClusterInitialFailoverVersion + cluster.TestFailoverVersionIncrement
+	domainEntry := s.setupActiveActiveDomain(currentVersion)
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(currentVersion).Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	ms := s.createActiveActiveTestMutableState(we, domainEntry)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.FailoverWorkflowExecution(context.Background(), &types.HistoryFailoverWorkflowExecutionRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request: &types.FailoverWorkflowExecutionRequest{
+			Domain:    domainEntry.GetInfo().Name,
+			Execution: &we,
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestFailoverWorkflowExecution_NotActiveActive() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+
+	err := s.mockHistoryEngine.FailoverWorkflowExecution(context.Background(), &types.HistoryFailoverWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.FailoverWorkflowExecutionRequest{
+			Domain:    constants.TestDomainName,
+			Execution: &we,
+		},
+	})
+	s.Equal(workflow.ErrDomainNotActiveActive, err)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_ActiveActiveWorkflowNotActive() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	alternativeVersion := cluster.TestAlternativeClusterInitialFailoverVersion + cluster.TestFailoverVersionIncrement
+	domainEntry := s.setupActiveActiveDomain(alternativeVersion)
+	s.mockDomainCache.EXPECT().GetActiveDomainByID(domainEntry.GetInfo().ID).Return(domainEntry, nil).AnyTimes()
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(alternativeVersion).Return(cluster.TestAlternativeClusterName).AnyTimes()
+
+	ms := s.createActiveActiveTestMutableState(we, domainEntry)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            domainEntry.GetInfo().Name,
+			WorkflowExecution: &we,
+			Identity:          "testIdentity",
+			SignalName:        "my signal name",
+		},
+	})
+	s.IsType(&types.DomainNotActiveError{}, err)
+	s.Equal(cluster.TestAlternativeClusterName, err.(*types.DomainNotActiveError).ActiveCluster)
+}
+
+func (s *engineSuite) TestGetActiveActiveStartCluster() {
+	domainEntry := s.setupActiveActiveDomain(cluster.TestCurrentClusterInitialFailoverVersion)
+	s.mockHistoryEngine.config.ActiveActiveClusterSearchAttribute = func(domain string) string { return "Region" }
+	newStartRequest := func(region string) *types.HistoryStartWorkflowExecutionRequest {
+		return &types.HistoryStartWorkflowExecutionRequest{
+			StartRequest: &types.StartWorkflowExecutionRequest{
+				SearchAttributes: &types.SearchAttributes{
+					IndexedFields: map[string][]byte{"Region": []byte(region)},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		startRequest    *types.HistoryStartWorkflowExecutionRequest
+		expectedCluster string
+		expectedErr     bool
+	}{
+		{
+			name:            "no search attribute",
+			startRequest:    &types.HistoryStartWorkflowExecutionRequest{StartRequest: &types.StartWorkflowExecutionRequest{}},
+			expectedCluster: cluster.TestCurrentClusterName,
+		},
+		{
+			name:            "alternative cluster",
+			startRequest:    newStartRequest(`"` + cluster.TestAlternativeClusterName + `"`),
+			expectedCluster: cluster.TestAlternativeClusterName,
+		},
+		{
+			name: "child workflow",
+			startRequest: &types.HistoryStartWorkflowExecutionRequest{
+				StartRequest:        newStartRequest(`"` + cluster.TestAlternativeClusterName + `"`).StartRequest,
+				ParentExecutionInfo: &types.ParentExecutionInfo{},
+			},
+			expectedCluster: cluster.TestCurrentClusterName,
+		},
+		{
+			name:         "unknown cluster",
+			startRequest: newStartRequest(`"unknown-cluster"`),
+			expectedErr:  true,
+		},
+		{
+			name:         "invalid value",
+			startRequest: newStartRequest(`123`),
+			expectedErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		clusterName, err := s.mockHistoryEngine.getActiveActiveStartCluster(domainEntry, tc.startRequest)
+		if tc.expectedErr {
+			s.IsType(&types.BadRequestError{}, err, tc.name)
+			continue
+		}
+		s.NoError(err, tc.name)
+		s.Equal(tc.expectedCluster, clusterName, tc.name)
+	}
+}
+
+func (s *engineSuite) TestReindexWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	ms := s.createSuspendResumeTestMutableState(we)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	visibilityMgr := s.mockShard.Resource.VisibilityMgr
+	s.mockHistoryEngine.visibilityMgr = visibilityMgr
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	visibilityMgr.On("RecordWorkflowExecutionStarted", mock.Anything, mock.MatchedBy(func(input *persistence.RecordWorkflowExecutionStartedRequest) bool {
+		return input.DomainUUID == constants.TestDomainID &&
+			input.Execution == we &&
+			input.WorkflowTypeName == "wType" &&
+			input.TaskList == "testTaskList" &&
+			input.TaskID > 0
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.ReindexWorkflowExecution(context.Background(), &types.HistoryReindexWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		Execution:  &we,
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestRebuildMutableState_BufferedEvents() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	ms := s.createSuspendResumeTestMutableState(we)
+	ms.BufferedEvents = []*types.HistoryEvent{
+		{
+			EventID:   common.BufferedEventID,
+			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		},
+	}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	_, err := s.mockHistoryEngine.RebuildMutableState(context.Background(), &types.HistoryRebuildMutableStateRequest{
+		DomainUUID: constants.TestDomainID,
+		Execution:  &we,
+		DryRun:     true,
+	})
+	s.IsType(&types.ServiceBusyError{}, err)
+}
+
+func (s *engineSuite) TestListWorkflowTasks() {
+	we := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	visibilityTimestamp := time.Now()
+	s.mockExecutionMgr.On("GetTransferTasks", mock.Anything, mock.Anything).Return(&persistence.GetTransferTasksResponse{
+		Tasks: []*persistence.TransferTaskInfo{
+			{
+				DomainID:            constants.TestDomainID,
+				WorkflowID:          constants.TestWorkflowID,
+				RunID:               constants.TestRunID,
+				TaskID:              101,
+				TaskType:            persistence.TransferTaskTypeDecisionTask,
+				VisibilityTimestamp: visibilityTimestamp,
+				Version:             common.EmptyVersion,
+			},
+			{
+				DomainID:   constants.TestDomainID,
+				WorkflowID: "other-workflow-id",
+				RunID:      constants.TestRunID,
+				TaskID:     102,
+				TaskType:   persistence.TransferTaskTypeDecisionTask,
+			},
+		},
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(&persistence.GetTimerIndexTasksResponse{
+		Timers: []*persistence.TimerTaskInfo{
+			{
+				DomainID:            constants.TestDomainID,
+				WorkflowID:          constants.TestWorkflowID,
+				RunID:               constants.TestRunID,
+				TaskID:              103,
+				TaskType:            persistence.TaskTypeUserTimer,
+				VisibilityTimestamp: visibilityTimestamp,
+			},
+		},
+	}, nil).Once()
+
+	outstandingTask := task.NewMockTask(s.controller)
+	outstandingTask.EXPECT().GetTaskID().Return(int64(101)).AnyTimes()
+	outstandingTask.EXPECT().GetAttempt().Return(3).AnyTimes()
+	s.mockTxProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, queue.NewGetWorkflowTasksAction(
+		constants.TestDomainID,
+		constants.TestWorkflowID,
+		"",
+	)).Return(&queue.ActionResult{
+		ActionType:             queue.ActionTypeGetWorkflowTasks,
+		GetWorkflowTasksResult: &queue.GetWorkflowTasksActionResult{Tasks: []task.Task{outstandingTask}},
+	}, nil).Times(1)
+	s.mockTimerProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, gomock.Any()).Return(&queue.ActionResult{
+		ActionType:             queue.ActionTypeGetWorkflowTasks,
+		GetWorkflowTasksResult: &queue.GetWorkflowTasksActionResult{},
+	}, nil).Times(1)
+
+	resp, err := s.mockHistoryEngine.ListWorkflowTasks(context.Background(), &types.HistoryListWorkflowTasksRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.ListWorkflowTasksRequest{
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: we.WorkflowID},
+		},
+	})
+	s.NoError(err)
+	s.False(resp.Truncated)
+	s.Equal([]*types.WorkflowTaskInfo{
+		{
+			Type:                common.Int32Ptr(int32(common.TaskTypeTransfer)),
+			SubType:             int32(persistence.TransferTaskTypeDecisionTask),
+			TaskID:              101,
+			VisibilityTimestamp: common.Int64Ptr(visibilityTimestamp.UnixNano()),
+			Version:             common.EmptyVersion,
+			Attempt:             3,
+		},
+		{
+			Type:                common.Int32Ptr(int32(common.TaskTypeTimer)),
+			SubType:             int32(persistence.TaskTypeUserTimer),
+			TaskID:              103,
+			VisibilityTimestamp: common.Int64Ptr(visibilityTimestamp.UnixNano()),
+		},
+	}, resp.Tasks)
+}
+
+func (s *engineSuite) TestDeleteWorkflowTask() {
+	we := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(&persistence.GetTimerIndexTasksResponse{
+		Timers: []*persistence.TimerTaskInfo{
+			{
+				DomainID:            constants.TestDomainID,
+				WorkflowID:          constants.TestWorkflowID,
+				RunID:               constants.TestRunID,
+				TaskID:              103,
+				TaskType:            persistence.TaskTypeUserTimer,
+				VisibilityTimestamp: time.Unix(0, 1000),
+			},
+		},
+	}, nil).Once()
+	s.mockExecutionMgr.On("CompleteTimerTask", mock.Anything, &persistence.CompleteTimerTaskRequest{
+		VisibilityTimestamp: time.Unix(0, 1000),
+		TaskID:              103,
+	}).Return(nil).Once()
+	s.mockTimerProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, gomock.Any()).Return(&queue.ActionResult{
+		ActionType:             queue.ActionTypeGetWorkflowTasks,
+		GetWorkflowTasksResult: &queue.GetWorkflowTasksActionResult{},
+	}, nil).Times(1)
+	s.mockTimerProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, queue.NewAckTaskAction(103)).Return(&queue.ActionResult{
+		ActionType:    queue.ActionTypeAckTask,
+		AckTaskResult: &queue.AckTaskActionResult{Found: false},
+	}, nil).Times(1)
+
+	err := s.mockHistoryEngine.DeleteWorkflowTask(context.Background(), &types.HistoryDeleteWorkflowTaskRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.DeleteWorkflowTaskRequest{
+			WorkflowExecution: we,
+			Type:              common.Int32Ptr(int32(common.TaskTypeTimer)),
+			TaskID:            103,
+			Reason:            "test",
+			Identity:          "test-identity",
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowTask_NotFound() {
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(&persistence.GetTimerIndexTasksResponse{}, nil).Once()
+	s.mockTimerProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, gomock.Any()).Return(&queue.ActionResult{
+		ActionType:             queue.ActionTypeGetWorkflowTasks,
+		GetWorkflowTasksResult: &queue.GetWorkflowTasksActionResult{},
+	}, nil).AnyTimes()
+
+	err := s.mockHistoryEngine.DeleteWorkflowTask(context.Background(), &types.HistoryDeleteWorkflowTaskRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.DeleteWorkflowTaskRequest{
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: constants.TestWorkflowID},
+			Type:              common.Int32Ptr(int32(common.TaskTypeTimer)),
+			TaskID:            103,
+		},
+	})
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowTask_UnsupportedTaskType() {
+	// the in-memory copies of replication and cross-cluster tasks can't be acked
+	for _, taskType := range []common.TaskType{common.TaskTypeReplication, common.TaskTypeCrossCluster} {
+		err := s.mockHistoryEngine.DeleteWorkflowTask(context.Background(), &types.HistoryDeleteWorkflowTaskRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.DeleteWorkflowTaskRequest{
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: constants.TestWorkflowID},
+				Type:              common.Int32Ptr(int32(taskType)),
+				TaskID:            103,
+			},
+		})
+		s.IsType(&types.BadRequestError{}, err)
+	}
+}
+
+func (s *engineSuite) TestReenqueueWorkflowTask() {
+	we := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	s.mockExecutionMgr.On("GetTransferTasks", mock.Anything, mock.Anything).Return(&persistence.GetTransferTasksResponse{
+		Tasks: []*persistence.TransferTaskInfo{
+			{
+				DomainID:   constants.TestDomainID,
+				WorkflowID: constants.TestWorkflowID,
+				RunID:      constants.TestRunID,
+				TaskID:     101,
+				TaskType:   persistence.TransferTaskTypeActivityTask,
+			},
+		},
+	}, nil).Times(2)
+	s.mockTxProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, queue.NewGetWorkflowTasksAction(
+		constants.TestDomainID,
+		constants.TestWorkflowID,
+		constants.TestRunID,
+	)).Return(&queue.ActionResult{
+		ActionType:             queue.ActionTypeGetWorkflowTasks,
+		GetWorkflowTasksResult: &queue.GetWorkflowTasksActionResult{},
+	}, nil).Times(2)
+	gomock.InOrder(
+		s.mockTxProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, queue.NewRedispatchTaskAction(101)).Return(&queue.ActionResult{
+			ActionType:           queue.ActionTypeRedispatchTask,
+			RedispatchTaskResult: &queue.RedispatchTaskActionResult{Found: true, Redispatched: true},
+		}, nil).Times(1),
+		s.mockTxProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, queue.NewRedispatchTaskAction(101)).Return(&queue.ActionResult{
+			ActionType:           queue.ActionTypeRedispatchTask,
+			RedispatchTaskResult: &queue.RedispatchTaskActionResult{Found: false},
+		}, nil).Times(1),
+	)
+
+	request := &types.HistoryReenqueueWorkflowTaskRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.ReenqueueWorkflowTaskRequest{
+			WorkflowExecution: we,
+			Type:              common.Int32Ptr(int32(common.TaskTypeTransfer)),
+			TaskID:            101,
+		},
+	}
+	err := s.mockHistoryEngine.ReenqueueWorkflowTask(context.Background(), request)
+	s.NoError(err)
+
+	// task not loaded by the queue processor
+	err = s.mockHistoryEngine.ReenqueueWorkflowTask(context.Background(), request)
+	s.IsType(&types.BadRequestError{}, err)
+
+	// only transfer and timer tasks can be re-enqueued
+	request.Request.Type = common.Int32Ptr(int32(common.TaskTypeReplication))
+	err = s.mockHistoryEngine.ReenqueueWorkflowTask(context.Background(), request)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *engineSuite) createSuspendResumeTestMutableState(
+	we types.WorkflowExecution,
+) *persistence.WorkflowMutableState {
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "testIdentity")
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	return ms
+}
+
+func (s *engineSuite) setupActiveActiveDomain(
+	failoverVersion int64,
+) *cache.DomainCacheEntry {
+	domainID := "active-active-domain-id"
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   domainID,
+			Name: "active-active-domain",
+			Data: map[string]string{common.DomainDataKeyForActiveActive: "true"},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		failoverVersion,
+		s.mockClusterMetadata,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(domainID).Return(domainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainName(domainID).Return(domainEntry.GetInfo().Name, nil).AnyTimes()
+	return domainEntry
+}
+
+func (s *engineSuite) createActiveActiveTestMutableState(
+	we types.WorkflowExecution,
+	domainEntry *cache.DomainCacheEntry,
+) *persistence.WorkflowMutableState {
+	msBuilder := execution.NewMutableStateBuilderWithVersionHistoriesWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		domainEntry.GetFailoverVersion(),
+		we.GetRunID(),
+		domainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "testIdentity")
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = domainEntry.GetInfo().ID
+	// the workflow's active cluster is derived from its last write version
+	currentVersionHistory, err := ms.VersionHistories.GetCurrentVersionHistory()
+	s.NoError(err)
+	s.NoError(currentVersionHistory.AddOrUpdateItem(
+		persistence.NewVersionHistoryItem(ms.ExecutionInfo.NextEventID-1, domainEntry.GetFailoverVersion()),
+	))
+	return ms
+}
+
 func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: "test-reapply",
@@ -0,0 +1,264 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lifecycle
+
+import (
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// SchemaVersion is the version of the workflow lifecycle event schema.
+// It will be bumped whenever a backward incompatible change is made to Event.
+const SchemaVersion = 1
+
+// EventType is the type of a workflow lifecycle event
+type EventType string
+
+const (
+	// EventTypeStarted is published when a workflow run is started
+	EventTypeStarted EventType = "started"
+	// EventTypeClosed is published when a workflow run is closed
+	EventTypeClosed EventType = "closed"
+	// EventTypeSignaled is published when a workflow run receives a signal
+	EventTypeSignaled EventType = "signaled"
+	// EventTypeReset is published for the new run created by a workflow reset
+	EventTypeReset EventType = "reset"
+	// EventTypeSearchAttributesUpdated is published when a workflow upserts its search attributes
+	EventTypeSearchAttributesUpdated EventType = "searchAttributesUpdated"
+)
+
+type (
+	// Event is the JSON encoded value of every message published to the workflow lifecycle events topic.
+	// Messages are keyed by "<domainID>/<workflowID>", so all the events of a workflow land in the same partition.
+	//
+	// Events are published at least once, and a retried transfer task may publish an earlier event after a later one.
+	// (FailoverVersion, TaskID, EventID) increases with the order the history of a workflow is written, across all
+	// of its runs and failovers, so consumers should deduplicate the events of a workflow by it and reorder them
+	// with Before.
+	Event struct {
+		SchemaVersion int       `json:"schemaVersion"`
+		Type          EventType `json:"type"`
+		DomainID      string    `json:"domainId"`
+		DomainName    string    `json:"domainName"`
+		WorkflowID    string    `json:"workflowId"`
+		RunID         string    `json:"runId"`
+		WorkflowType  string    `json:"workflowType"`
+		EventID       int64     `json:"eventId"`
+		// Timestamp is the time of the history event in unix nanoseconds
+		Timestamp int64 `json:"timestamp"`
+		// FailoverVersion is the failover version of the history event, it increases every time the workflow fails over
+		FailoverVersion int64 `json:"failoverVersion"`
+		// TaskID is the ID of the transfer task publishing the event, it increases with every history event batch
+		// written for the workflow while the workflow stays active in the same cluster
+		TaskID int64 `json:"taskId"`
+
+		// Started is set when Type is EventTypeStarted
+		Started *StartedAttributes `json:"started,omitempty"`
+		// Closed is set when Type is EventTypeClosed
+		Closed *ClosedAttributes `json:"closed,omitempty"`
+		// Signaled is set when Type is EventTypeSignaled
+		Signaled *SignaledAttributes `json:"signaled,omitempty"`
+		// Reset is set when Type is EventTypeReset
+		Reset *ResetAttributes `json:"reset,omitempty"`
+		// SearchAttributes is set when Type is EventTypeSearchAttributesUpdated, and for EventTypeStarted
+		// when search attributes are published for the domain. Values are JSON encoded.
+		SearchAttributes map[string]string `json:"searchAttributes,omitempty"`
+	}
+
+	// StartedAttributes are the attributes of a started event
+	StartedAttributes struct {
+		TaskList                string `json:"taskList"`
+		ParentDomainName        string `json:"parentDomainName,omitempty"`
+		ParentWorkflowID        string `json:"parentWorkflowId,omitempty"`
+		ParentRunID             string `json:"parentRunId,omitempty"`
+		ContinuedExecutionRunID string `json:"continuedExecutionRunId,omitempty"`
+		FirstExecutionRunID     string `json:"firstExecutionRunId,omitempty"`
+		CronSchedule            string `json:"cronSchedule,omitempty"`
+		Attempt                 int32  `json:"attempt"`
+		Identity                string `json:"identity,omitempty"`
+	}
+
+	// ClosedAttributes are the attributes of a closed event
+	ClosedAttributes struct {
+		CloseStatus types.WorkflowExecutionCloseStatus `json:"closeStatus"`
+		// NewExecutionRunID is the run ID of the next run when the workflow continued as new
+		NewExecutionRunID string `json:"newExecutionRunId,omitempty"`
+	}
+
+	// SignaledAttributes are the attributes of a signaled event
+	SignaledAttributes struct {
+		SignalName string `json:"signalName"`
+		Identity   string `json:"identity,omitempty"`
+	}
+
+	// ResetAttributes are the attributes of a reset event
+	ResetAttributes struct {
+		BaseRunID string `json:"baseRunId"`
+		Reason    string `json:"reason,omitempty"`
+	}
+
+	// WorkflowInfo identifies the workflow run that lifecycle events are created for
+	WorkflowInfo struct {
+		DomainID     string
+		DomainName   string
+		WorkflowID   string
+		RunID        string
+		WorkflowType string
+	}
+)
+
+var closeStatusByEventType = map[types.EventType]types.WorkflowExecutionCloseStatus{
+	types.EventTypeWorkflowExecutionCompleted:      types.WorkflowExecutionCloseStatusCompleted,
+	types.EventTypeWorkflowExecutionFailed:         types.WorkflowExecutionCloseStatusFailed,
+	types.EventTypeWorkflowExecutionCanceled:       types.WorkflowExecutionCloseStatusCanceled,
+	types.EventTypeWorkflowExecutionTerminated:     types.WorkflowExecutionCloseStatusTerminated,
+	types.EventTypeWorkflowExecutionContinuedAsNew: types.WorkflowExecutionCloseStatusContinuedAsNew,
+	types.EventTypeWorkflowExecutionTimedOut:       types.WorkflowExecutionCloseStatusTimedOut,
+}
+
+// HasEvents returns true if any of the given history events results in a lifecycle event
+func HasEvents(
+	historyEvents []*types.HistoryEvent,
+	includeSearchAttributes bool,
+) bool {
+	for _, event := range historyEvents {
+		if isLifecycleEvent(event, includeSearchAttributes) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewEvents creates the lifecycle events of a workflow run from the history event batch of the given transfer task
+func NewEvents(
+	workflow WorkflowInfo,
+	taskID int64,
+	historyEvents []*types.HistoryEvent,
+	includeSearchAttributes bool,
+) []*Event {
+	var events []*Event
+	for _, historyEvent := range historyEvents {
+		if !isLifecycleEvent(historyEvent, includeSearchAttributes) {
+			continue
+		}
+
+		event := &Event{
+			SchemaVersion:   SchemaVersion,
+			DomainID:        workflow.DomainID,
+			DomainName:      workflow.DomainName,
+			WorkflowID:      workflow.WorkflowID,
+			RunID:           workflow.RunID,
+			WorkflowType:    workflow.WorkflowType,
+			EventID:         historyEvent.EventID,
+			Timestamp:       common.Int64Default(historyEvent.Timestamp),
+			FailoverVersion: historyEvent.Version,
+			TaskID:          taskID,
+		}
+		switch historyEvent.GetEventType() {
+		case types.EventTypeWorkflowExecutionStarted:
+			attributes := historyEvent.WorkflowExecutionStartedEventAttributes
+			event.Type = EventTypeStarted
+			event.Started = &StartedAttributes{
+				TaskList:                attributes.GetTaskList().GetName(),
+				ParentDomainName:        attributes.GetParentWorkflowDomain(),
+				ContinuedExecutionRunID: attributes.ContinuedExecutionRunID,
+				FirstExecutionRunID:     attributes.FirstExecutionRunID,
+				CronSchedule:            attributes.CronSchedule,
+				Attempt:                 attributes.Attempt,
+				Identity:                attributes.Identity,
+			}
+			if parent := attributes.ParentWorkflowExecution; parent != nil {
+				event.Started.ParentWorkflowID = parent.WorkflowID
+				event.Started.ParentRunID = parent.RunID
+			}
+			if includeSearchAttributes {
+				event.SearchAttributes = convertSearchAttributes(attributes.SearchAttributes)
+			}
+		case types.EventTypeWorkflowExecutionSignaled:
+			attributes := historyEvent.WorkflowExecutionSignaledEventAttributes
+			event.Type = EventTypeSignaled
+			event.Signaled = &SignaledAttributes{
+				SignalName: attributes.SignalName,
+				Identity:   attributes.Identity,
+			}
+		case types.EventTypeDecisionTaskFailed:
+			attributes := historyEvent.DecisionTaskFailedEventAttributes
+			event.Type = EventTypeReset
+			event.Reset = &ResetAttributes{
+				BaseRunID: attributes.BaseRunID,
+				Reason:    attributes.GetReason(),
+			}
+		case types.EventTypeUpsertWorkflowSearchAttributes:
+			event.Type = EventTypeSearchAttributesUpdated
+			event.SearchAttributes = convertSearchAttributes(historyEvent.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes)
+		default:
+			event.Type = EventTypeClosed
+			event.Closed = &ClosedAttributes{
+				CloseStatus: closeStatusByEventType[historyEvent.GetEventType()],
+			}
+			if attributes := historyEvent.WorkflowExecutionContinuedAsNewEventAttributes; attributes != nil {
+				event.Closed.NewExecutionRunID = attributes.NewExecutionRunID
+			}
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// Before returns true if the event was written before the other event of the same workflow
+func (e *Event) Before(other *Event) bool {
+	if e.FailoverVersion != other.FailoverVersion {
+		return e.FailoverVersion < other.FailoverVersion
+	}
+	if e.TaskID != other.TaskID {
+		return e.TaskID < other.TaskID
+	}
+	return e.EventID < other.EventID
+}
+
+func isLifecycleEvent(
+	event *types.HistoryEvent,
+	includeSearchAttributes bool,
+) bool {
+	switch event.GetEventType() {
+	case types.EventTypeWorkflowExecutionStarted,
+		types.EventTypeWorkflowExecutionSignaled:
+		return true
+	case types.EventTypeDecisionTaskFailed:
+		// the first decision of the new run created by a reset fails with the reset cause
+		return event.DecisionTaskFailedEventAttributes.GetCause() == types.DecisionTaskFailedCauseResetWorkflow
+	case types.EventTypeUpsertWorkflowSearchAttributes:
+		return includeSearchAttributes
+	}
+	_, ok := closeStatusByEventType[event.GetEventType()]
+	return ok
+}
+
+func convertSearchAttributes(searchAttributes *types.SearchAttributes) map[string]string {
+	if len(searchAttributes.GetIndexedFields()) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(searchAttributes.GetIndexedFields()))
+	for key, value := range searchAttributes.GetIndexedFields() {
+		result[key] = string(value)
+	}
+	return result
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lifecycle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testVersion = int64(1234)
+	testTaskID  = int64(5678)
+)
+
+type (
+	eventSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		workflow WorkflowInfo
+	}
+)
+
+func TestEventSuite(t *testing.T) {
+	s := new(eventSuite)
+	suite.Run(t, s)
+}
+
+func (s *eventSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.workflow = WorkflowInfo{
+		DomainID:     "some random domain ID",
+		DomainName:   "some random domain name",
+		WorkflowID:   "some random workflow ID",
+		RunID:        "some random run ID",
+		WorkflowType: "some random workflow type",
+	}
+}
+
+func (s *eventSuite) TestHasEvents() {
+	s.False(HasEvents(nil, true))
+	s.False(HasEvents([]*types.HistoryEvent{
+		{EventID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+		{EventID: 3, EventType: types.EventTypeDecisionTaskStarted.Ptr()},
+	}, true))
+	s.True(HasEvents([]*types.HistoryEvent{
+		{EventID: 5, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+		{EventID: 6, EventType: types.EventTypeWorkflowExecutionCompleted.Ptr()},
+	}, false))
+
+	upsertEvents := []*types.HistoryEvent{
+		{
+			EventID:   7,
+			EventType: types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+			UpsertWorkflowSearchAttributesEventAttributes: &types.UpsertWorkflowSearchAttributesEventAttributes{},
+		},
+	}
+	s.False(HasEvents(upsertEvents, false))
+	s.True(HasEvents(upsertEvents, true))
+
+	decisionFailedEvents := []*types.HistoryEvent{
+		{
+			EventID:   4,
+			EventType: types.EventTypeDecisionTaskFailed.Ptr(),
+			DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+				Cause: types.DecisionTaskFailedCauseUnhandledDecision.Ptr(),
+			},
+		},
+	}
+	s.False(HasEvents(decisionFailedEvents, true))
+	decisionFailedEvents[0].DecisionTaskFailedEventAttributes.Cause = types.DecisionTaskFailedCauseResetWorkflow.Ptr()
+	s.True(HasEvents(decisionFailedEvents, true))
+}
+
+func (s *eventSuite) TestNewEvents_Started() {
+	historyEvents := []*types.HistoryEvent{
+		{
+			EventID:   common.FirstEventID,
+			Timestamp: common.Int64Ptr(1000),
+			Version:   testVersion,
+			EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+				TaskList:             &types.TaskList{Name: "some random task list"},
+				ParentWorkflowDomain: common.StringPtr("some random parent domain name"),
+				ParentWorkflowExecution: &types.WorkflowExecution{
+					WorkflowID: "some random parent workflow ID",
+					RunID:      "some random parent run ID",
+				},
+				FirstExecutionRunID: s.workflow.RunID,
+				Attempt:             2,
+				SearchAttributes: &types.SearchAttributes{
+					IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)},
+				},
+			},
+		},
+		{
+			EventID:   common.FirstEventID + 1,
+			EventType: types.EventTypeDecisionTaskScheduled.Ptr(),
+		},
+	}
+
+	events := NewEvents(s.workflow, testTaskID, historyEvents, false)
+	s.Len(events, 1)
+	s.Equal(&Event{
+		SchemaVersion:   SchemaVersion,
+		Type:            EventTypeStarted,
+		DomainID:        s.workflow.DomainID,
+		DomainName:      s.workflow.DomainName,
+		WorkflowID:      s.workflow.WorkflowID,
+		RunID:           s.workflow.RunID,
+		WorkflowType:    s.workflow.WorkflowType,
+		EventID:         common.FirstEventID,
+		Timestamp:       1000,
+		FailoverVersion: testVersion,
+		TaskID:          testTaskID,
+		Started: &StartedAttributes{
+			TaskList:            "some random task list",
+			ParentDomainName:    "some random parent domain name",
+			ParentWorkflowID:    "some random parent workflow ID",
+			ParentRunID:         "some random parent run ID",
+			FirstExecutionRunID: s.workflow.RunID,
+			Attempt:             2,
+		},
+	}, events[0])
+
+	events = NewEvents(s.workflow, testTaskID, historyEvents, true)
+	s.Len(events, 1)
+	s.Equal(map[string]string{"CustomKeywordField": `"keyword"`}, events[0].SearchAttributes)
+}
+
+func (s *eventSuite) TestNewEvents_SignaledAndClosed() {
+	historyEvents := []*types.HistoryEvent{
+		{
+			EventID:   10,
+			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "some random signal name",
+				Identity:   "some random identity",
+			},
+		},
+		{
+			EventID:   11,
+			EventType: types.EventTypeWorkflowExecutionContinuedAsNew.Ptr(),
+			WorkflowExecutionContinuedAsNewEventAttributes: &types.WorkflowExecutionContinuedAsNewEventAttributes{
+				NewExecutionRunID: "some random new run ID",
+			},
+		},
+	}
+
+	events := NewEvents(s.workflow, testTaskID, historyEvents, false)
+	s.Len(events, 2)
+	s.Equal(EventTypeSignaled, events[0].Type)
+	s.Equal(int64(10), events[0].EventID)
+	s.Equal(&SignaledAttributes{
+		SignalName: "some random signal name",
+		Identity:   "some random identity",
+	}, events[0].Signaled)
+	s.Equal(EventTypeClosed, events[1].Type)
+	s.Equal(int64(11), events[1].EventID)
+	s.Equal(&ClosedAttributes{
+		CloseStatus:       types.WorkflowExecutionCloseStatusContinuedAsNew,
+		NewExecutionRunID: "some random new run ID",
+	}, events[1].Closed)
+}
+
+func (s *eventSuite) TestNewEvents_Reset() {
+	historyEvents := []*types.HistoryEvent{
+		{
+			EventID:   4,
+			EventType: types.EventTypeDecisionTaskFailed.Ptr(),
+			DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+				Cause:     types.DecisionTaskFailedCauseResetWorkflow.Ptr(),
+				Reason:    common.StringPtr("some random reset reason"),
+				BaseRunID: "some random base run ID",
+			},
+		},
+	}
+
+	events := NewEvents(s.workflow, testTaskID, historyEvents, false)
+	s.Len(events, 1)
+	s.Equal(EventTypeReset, events[0].Type)
+	s.Equal(&ResetAttributes{
+		BaseRunID: "some random base run ID",
+		Reason:    "some random reset reason",
+	}, events[0].Reset)
+}
+
+func (s *eventSuite) TestNewEvents_SearchAttributesUpdated() {
+	historyEvents := []*types.HistoryEvent{
+		{
+			EventID:   7,
+			EventType: types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+			UpsertWorkflowSearchAttributesEventAttributes: &types.UpsertWorkflowSearchAttributesEventAttributes{
+				SearchAttributes: &types.SearchAttributes{
+					IndexedFields: map[string][]byte{"CustomIntField": []byte("1")},
+				},
+			},
+		},
+	}
+
+	s.Empty(NewEvents(s.workflow, testTaskID, historyEvents, false))
+	events := NewEvents(s.workflow, testTaskID, historyEvents, true)
+	s.Len(events, 1)
+	s.Equal(EventTypeSearchAttributesUpdated, events[0].Type)
+	s.Equal(map[string]string{"CustomIntField": "1"}, events[0].SearchAttributes)
+}
+
+func (s *eventSuite) TestBefore() {
+	event := &Event{FailoverVersion: testVersion, TaskID: testTaskID, EventID: 10}
+
+	s.True(event.Before(&Event{FailoverVersion: testVersion + 1, TaskID: testTaskID - 1, EventID: 1}))
+	s.True(event.Before(&Event{FailoverVersion: testVersion, TaskID: testTaskID + 1, EventID: 1}))
+	s.True(event.Before(&Event{FailoverVersion: testVersion, TaskID: testTaskID, EventID: 11}))
+	s.False(event.Before(&Event{FailoverVersion: testVersion, TaskID: testTaskID, EventID: 10}))
+	s.False(event.Before(&Event{FailoverVersion: testVersion, TaskID: testTaskID - 1, EventID: 11}))
+	s.False(event.Before(&Event{FailoverVersion: testVersion - 1, TaskID: testTaskID + 1, EventID: 11}))
+}
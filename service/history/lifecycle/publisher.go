@@ -0,0 +1,73 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/uber/cadence/common/messaging"
+)
+
+type (
+	// Publisher publishes workflow lifecycle events to the workflow lifecycle events topic
+	Publisher interface {
+		Publish(ctx context.Context, events []*Event) error
+	}
+
+	publisherImpl struct {
+		producer messaging.Producer
+	}
+)
+
+// NewPublisher creates a new Publisher which publishes lifecycle events through the given producer
+func NewPublisher(producer messaging.Producer) Publisher {
+	return &publisherImpl{
+		producer: producer,
+	}
+}
+
+// NewNoopPublisher creates a new Publisher which drops all lifecycle events,
+// it is used when the workflow lifecycle events topic is not configured
+func NewNoopPublisher() Publisher {
+	return NewPublisher(messaging.NewNoopProducer())
+}
+
+// Publish publishes the events in order, it stops at the first event that fails to publish
+func (p *publisherImpl) Publish(
+	ctx context.Context,
+	events []*Event,
+) error {
+	for _, event := range events {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if err := p.producer.Publish(ctx, &messaging.EncodedMessage{
+			Key:   []byte(fmt.Sprintf("%s/%s", event.DomainID, event.WorkflowID)),
+			Value: value,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
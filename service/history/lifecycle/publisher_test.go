@@ -0,0 +1,71 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/mocks"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	producer := &mocks.KafkaProducer{}
+	publisher := NewPublisher(producer)
+
+	events := []*Event{
+		{SchemaVersion: SchemaVersion, Type: EventTypeStarted, DomainID: "domain", WorkflowID: "workflow", RunID: "run", EventID: 1},
+		{SchemaVersion: SchemaVersion, Type: EventTypeClosed, DomainID: "domain", WorkflowID: "workflow", RunID: "run", EventID: 5},
+	}
+	var published []*Event
+	producer.On("Publish", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		message := args.Get(1).(*messaging.EncodedMessage)
+		require.Equal(t, "domain/workflow", string(message.Key))
+		var event Event
+		require.NoError(t, json.Unmarshal(message.Value, &event))
+		published = append(published, &event)
+	}).Return(nil).Twice()
+
+	require.NoError(t, publisher.Publish(context.Background(), events))
+	require.Equal(t, events, published)
+	producer.AssertExpectations(t)
+}
+
+func TestPublisher_Publish_Error(t *testing.T) {
+	producer := &mocks.KafkaProducer{}
+	publisher := NewPublisher(producer)
+
+	publishErr := errors.New("some random error")
+	producer.On("Publish", mock.Anything, mock.Anything).Return(publishErr).Once()
+
+	err := publisher.Publish(context.Background(), []*Event{
+		{SchemaVersion: SchemaVersion, Type: EventTypeStarted, EventID: 1},
+		{SchemaVersion: SchemaVersion, Type: EventTypeClosed, EventID: 5},
+	})
+	require.Equal(t, publishErr, err)
+	producer.AssertExpectations(t)
+}
@@ -407,6 +407,17 @@ func (r *historyReplicatorImpl) applyNonStartEventsToCurrentBranch(
 		)
 		return err
 	}
+	if hasWorkflowResumedEvent(task.getEvents()) {
+		// tasks of a suspended workflow are acked without being processed,
+		// re-generate them as the active cluster does when the workflow is resumed
+		if err := r.refreshTasks(ctx, mutableState); err != nil {
+			task.getLogger().Error(
+				"nDCHistoryReplicator unable to refresh tasks when applyNonStartEventsToCurrentBranch",
+				tag.Error(err),
+			)
+			return err
+		}
+	}
 
 	targetWorkflow := execution.NewWorkflow(
 		ctx,
@@ -722,3 +733,35 @@ func newNDCRetryTaskErrorWithHint(
 		EndEventVersion:   endEventVersion,
 	}
 }
+
+func (r *historyReplicatorImpl) refreshTasks(
+	ctx ctx.Context,
+	mutableState execution.MutableState,
+) error {
+
+	mutableStateTaskRefresher := execution.NewMutableStateTaskRefresher(
+		r.shard.GetConfig(),
+		r.clusterMetadata,
+		r.domainCache,
+		r.shard.GetEventsCache(),
+		r.logger,
+		r.shard.GetShardID(),
+	)
+	return mutableStateTaskRefresher.RefreshTasks(
+		ctx,
+		mutableState.GetExecutionInfo().StartTimestamp,
+		mutableState,
+	)
+}
+
+func hasWorkflowResumedEvent(
+	events []*types.HistoryEvent,
+) bool {
+
+	for _, event := range events {
+		if event.GetEventType() == types.EventTypeWorkflowExecutionResumed {
+			return true
+		}
+	}
+	return false
+}
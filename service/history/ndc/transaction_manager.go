@@ -278,9 +278,15 @@ func (r *transactionManagerImpl) backfillWorkflowEventsReapply(
 		return 0, execution.TransactionPolicyActive, err
 	}
 	isWorkflowRunning := targetWorkflow.GetMutableState().IsWorkflowExecutionRunning()
-	targetWorkflowActiveCluster := r.clusterMetadata.ClusterNameForFailoverVersion(
-		targetWorkflow.GetMutableState().GetDomainEntry().GetFailoverVersion(),
-	)
+	targetWorkflowActiveVersion := targetWorkflow.GetMutableState().GetDomainEntry().GetFailoverVersion()
+	if targetWorkflow.GetMutableState().GetDomainEntry().IsActiveActive() {
+		// workflow of an active-active domain is active in the cluster of its own last write version
+		targetWorkflowActiveVersion, err = targetWorkflow.GetMutableState().GetLastWriteVersion()
+		if err != nil {
+			return 0, execution.TransactionPolicyActive, err
+		}
+	}
+	targetWorkflowActiveCluster := r.clusterMetadata.ClusterNameForFailoverVersion(targetWorkflowActiveVersion)
 	currentCluster := r.clusterMetadata.GetCurrentClusterName()
 	isActiveCluster := targetWorkflowActiveCluster == currentCluster
 
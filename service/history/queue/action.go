@@ -22,6 +22,7 @@ package queue
 
 import (
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/task"
 )
 
 type (
@@ -30,21 +31,27 @@ type (
 
 	// Action specifies the Action should be performed
 	Action struct {
-		ActionType               ActionType
-		ResetActionAttributes    *ResetActionAttributes
-		GetStateActionAttributes *GetStateActionAttributes
-		GetTasksAttributes       *GetTasksAttributes
-		UpdateTaskAttributes     *UpdateTasksAttributes
+		ActionType                 ActionType
+		ResetActionAttributes      *ResetActionAttributes
+		GetStateActionAttributes   *GetStateActionAttributes
+		GetTasksAttributes         *GetTasksAttributes
+		UpdateTaskAttributes       *UpdateTasksAttributes
+		GetWorkflowTasksAttributes *GetWorkflowTasksActionAttributes
+		AckTaskAttributes          *AckTaskActionAttributes
+		RedispatchTaskAttributes   *RedispatchTaskActionAttributes
 		// add attributes for other action types here
 	}
 
 	// ActionResult is the result for performing an Action
 	ActionResult struct {
-		ActionType           ActionType
-		ResetActionResult    *ResetActionResult
-		GetStateActionResult *GetStateActionResult
-		GetTasksResult       *GetTasksResult
-		UpdateTaskResult     *UpdateTasksResult
+		ActionType             ActionType
+		ResetActionResult      *ResetActionResult
+		GetStateActionResult   *GetStateActionResult
+		GetTasksResult         *GetTasksResult
+		UpdateTaskResult       *UpdateTasksResult
+		GetWorkflowTasksResult *GetWorkflowTasksActionResult
+		AckTaskResult          *AckTaskActionResult
+		RedispatchTaskResult   *RedispatchTaskActionResult
 	}
 
 	// ResetActionAttributes contains the parameter for performing Reset Action
@@ -72,6 +79,38 @@ type (
 	// UpdateTasksResult is the result for performing UpdateTask Action
 	UpdateTasksResult struct {
 	}
+
+	// GetWorkflowTasksActionAttributes contains the parameter for performing GetWorkflowTasks Action
+	GetWorkflowTasksActionAttributes struct {
+		DomainID   string
+		WorkflowID string
+		// RunID is optional, tasks of all runs are returned if not specified
+		RunID string
+	}
+	// GetWorkflowTasksActionResult is the result for performing GetWorkflowTasks Action
+	GetWorkflowTasksActionResult struct {
+		Tasks []task.Task
+	}
+
+	// AckTaskActionAttributes contains the parameter for performing AckTask Action
+	AckTaskActionAttributes struct {
+		TaskID int64
+	}
+	// AckTaskActionResult is the result for performing AckTask Action
+	AckTaskActionResult struct {
+		Found bool
+	}
+
+	// RedispatchTaskActionAttributes contains the parameter for performing RedispatchTask Action
+	RedispatchTaskActionAttributes struct {
+		TaskID int64
+	}
+	// RedispatchTaskActionResult is the result for performing RedispatchTask Action
+	RedispatchTaskActionResult struct {
+		Found bool
+		// Redispatched is false if the task is already pending execution
+		Redispatched bool
+	}
 )
 
 const (
@@ -83,6 +122,12 @@ const (
 	ActionTypeGetTasks
 	// ActionTypeUpdateTask is the ActionType to update outstanding task
 	ActionTypeUpdateTask
+	// ActionTypeGetWorkflowTasks is the ActionType for reading outstanding tasks of a workflow
+	ActionTypeGetWorkflowTasks
+	// ActionTypeAckTask is the ActionType for acking an outstanding task without executing it
+	ActionTypeAckTask
+	// ActionTypeRedispatchTask is the ActionType for submitting a backing off task for immediate execution
+	ActionTypeRedispatchTask
 	// add more ActionType here
 )
 
@@ -122,3 +167,45 @@ func NewUpdateTasksAction(
 		},
 	}
 }
+
+// NewGetWorkflowTasksAction creates a queue action for reading the outstanding tasks of a workflow
+func NewGetWorkflowTasksAction(
+	domainID string,
+	workflowID string,
+	runID string,
+) *Action {
+	return &Action{
+		ActionType: ActionTypeGetWorkflowTasks,
+		GetWorkflowTasksAttributes: &GetWorkflowTasksActionAttributes{
+			DomainID:   domainID,
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+	}
+}
+
+// NewAckTaskAction creates a queue action for acking an outstanding task,
+// the task will not be executed again by the processor
+func NewAckTaskAction(
+	taskID int64,
+) *Action {
+	return &Action{
+		ActionType: ActionTypeAckTask,
+		AckTaskAttributes: &AckTaskActionAttributes{
+			TaskID: taskID,
+		},
+	}
+}
+
+// NewRedispatchTaskAction creates a queue action for submitting an outstanding task
+// waiting for retry to the task processor immediately
+func NewRedispatchTaskAction(
+	taskID int64,
+) *Action {
+	return &Action{
+		ActionType: ActionTypeRedispatchTask,
+		RedispatchTaskAttributes: &RedispatchTaskActionAttributes{
+			TaskID: taskID,
+		},
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas"
+	t "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -380,6 +381,12 @@ func (p *processorBase) handleActionNotification(
 		result, err = p.resetProcessingQueueStates()
 	case ActionTypeGetState:
 		result = p.getProcessingQueueStates()
+	case ActionTypeGetWorkflowTasks:
+		result = p.getWorkflowTasks(notification.action.GetWorkflowTasksAttributes)
+	case ActionTypeAckTask:
+		result = p.ackTask(notification.action.AckTaskAttributes)
+	case ActionTypeRedispatchTask:
+		result, err = p.redispatchTask(notification.action.RedispatchTaskAttributes)
 	default:
 		err = fmt.Errorf("unknown queue action type: %v", notification.action.ActionType)
 	}
@@ -463,6 +470,84 @@ func (p *processorBase) getProcessingQueueStates() *ActionResult {
 	}
 }
 
+func (p *processorBase) getWorkflowTasks(
+	attributes *GetWorkflowTasksActionAttributes,
+) *ActionResult {
+	var tasks []task.Task
+	for _, queueCollection := range p.processingQueueCollections {
+		for _, queueTask := range queueCollection.GetTasks() {
+			if queueTask.GetDomainID() != attributes.DomainID || queueTask.GetWorkflowID() != attributes.WorkflowID {
+				continue
+			}
+			if attributes.RunID != "" && queueTask.GetRunID() != attributes.RunID {
+				continue
+			}
+			tasks = append(tasks, queueTask)
+		}
+	}
+
+	return &ActionResult{
+		ActionType: ActionTypeGetWorkflowTasks,
+		GetWorkflowTasksResult: &GetWorkflowTasksActionResult{
+			Tasks: tasks,
+		},
+	}
+}
+
+func (p *processorBase) ackTask(
+	attributes *AckTaskActionAttributes,
+) *ActionResult {
+	outstandingTask := p.getOutstandingTask(attributes.TaskID)
+	if outstandingTask != nil && outstandingTask.State() != t.TaskStateAcked {
+		// acked task will be removed from the processing queue upon the next ack level update
+		outstandingTask.Ack()
+	}
+
+	return &ActionResult{
+		ActionType: ActionTypeAckTask,
+		AckTaskResult: &AckTaskActionResult{
+			Found: outstandingTask != nil,
+		},
+	}
+}
+
+func (p *processorBase) redispatchTask(
+	attributes *RedispatchTaskActionAttributes,
+) (*ActionResult, error) {
+	result := &RedispatchTaskActionResult{}
+	outstandingTask := p.getOutstandingTask(attributes.TaskID)
+	if outstandingTask != nil {
+		result.Found = true
+		// only nacked tasks are waiting in the redispatch queue, pending tasks are
+		// already in the task processor and acked tasks have completed
+		if outstandingTask.State() == t.TaskStateNacked {
+			submitted, err := p.submitTask(outstandingTask)
+			if err != nil {
+				return nil, err
+			}
+			result.Redispatched = submitted
+		}
+	}
+
+	return &ActionResult{
+		ActionType:           ActionTypeRedispatchTask,
+		RedispatchTaskResult: result,
+	}, nil
+}
+
+func (p *processorBase) getOutstandingTask(
+	taskID int64,
+) task.Task {
+	for _, queueCollection := range p.processingQueueCollections {
+		for _, queueTask := range queueCollection.GetTasks() {
+			if queueTask.GetTaskID() == taskID {
+				return queueTask
+			}
+		}
+	}
+	return nil
+}
+
 func (p *processorBase) submitTask(
 	task task.Task,
 ) (bool, error) {
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	t "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/shard"
@@ -331,6 +332,52 @@ func (s *processorBaseSuite) TestUpdateAckLevel_Timer_UpdateQueueStates() {
 	s.Equal(now.Add(-5*time.Second), ackLevel.(timerTaskKey).visibilityTimestamp)
 }
 
+func (s *processorBaseSuite) TestWorkflowTaskActions() {
+	processingQueueStates := []ProcessingQueueState{
+		NewProcessingQueueState(
+			0,
+			newTransferTaskKey(0),
+			newTransferTaskKey(100),
+			NewDomainFilter(map[string]struct{}{}, true),
+		),
+	}
+	processorBase := s.newTestProcessorBase(processingQueueStates, nil, nil, nil, nil)
+
+	workflowTask := task.NewMockTask(s.controller)
+	workflowTask.EXPECT().GetDomainID().Return("testDomainID").AnyTimes()
+	workflowTask.EXPECT().GetWorkflowID().Return("testWorkflowID").AnyTimes()
+	workflowTask.EXPECT().GetRunID().Return("testRunID").AnyTimes()
+	workflowTask.EXPECT().GetTaskID().Return(int64(10)).AnyTimes()
+	otherTask := task.NewMockTask(s.controller)
+	otherTask.EXPECT().GetDomainID().Return("testDomainID").AnyTimes()
+	otherTask.EXPECT().GetWorkflowID().Return("otherWorkflowID").AnyTimes()
+	otherTask.EXPECT().GetTaskID().Return(int64(20)).AnyTimes()
+	processorBase.processingQueueCollections[0].AddTasks(map[task.Key]task.Task{
+		newTransferTaskKey(10): workflowTask,
+		newTransferTaskKey(20): otherTask,
+	}, newTransferTaskKey(100))
+
+	result := processorBase.getWorkflowTasks(&GetWorkflowTasksActionAttributes{
+		DomainID:   "testDomainID",
+		WorkflowID: "testWorkflowID",
+	})
+	s.Equal([]task.Task{workflowTask}, result.GetWorkflowTasksResult.Tasks)
+
+	workflowTask.EXPECT().State().Return(t.TaskStateNacked).Times(1)
+	s.mockTaskProcessor.EXPECT().TrySubmit(workflowTask).Return(true, nil).Times(1)
+	result, err := processorBase.redispatchTask(&RedispatchTaskActionAttributes{TaskID: 10})
+	s.NoError(err)
+	s.Equal(&RedispatchTaskActionResult{Found: true, Redispatched: true}, result.RedispatchTaskResult)
+
+	workflowTask.EXPECT().State().Return(t.TaskStatePending).Times(1)
+	workflowTask.EXPECT().Ack().Times(1)
+	result = processorBase.ackTask(&AckTaskActionAttributes{TaskID: 10})
+	s.True(result.AckTaskResult.Found)
+
+	result = processorBase.ackTask(&AckTaskActionAttributes{TaskID: 30})
+	s.False(result.AckTaskResult.Found)
+}
+
 func (s *processorBaseSuite) newTestProcessorBase(
 	processingQueueStates []ProcessingQueueState,
 	updateMaxReadLevel updateMaxReadLevelFn,
@@ -77,6 +77,12 @@ func (t *taskAllocatorImpl) VerifyActiveTask(taskDomainID string, task interface
 		t.logger.Warn("Cannot find domain, default to process task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
 		return true, nil
 	}
+	if domainEntry.IsActiveActive() {
+		// workflows of an active-active domain can be active in any cluster,
+		// the task executor checks the cluster of the workflow
+		t.logger.Debug("Domain is active-active, process task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
+		return true, nil
+	}
 	if domainEntry.IsGlobalDomain() && t.currentClusterName != domainEntry.GetReplicationConfig().ActiveClusterName {
 		// timer task does not belong to cluster name
 		t.logger.Debug("Domain is not active, skip task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
@@ -148,6 +154,17 @@ func (t *taskAllocatorImpl) VerifyStandbyTask(standbyCluster string, taskDomainI
 		// non global domain, timer task does not belong here
 		t.logger.Debug("Domain is not global, skip task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
 		return false, nil
+	} else if domainEntry.IsActiveActive() {
+		// workflows of an active-active domain can be active in any of its clusters,
+		// the task executor checks the cluster of the workflow
+		for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+			if cluster.ClusterName == standbyCluster {
+				t.logger.Debug("Domain is active-active, process task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
+				return true, nil
+			}
+		}
+		t.logger.Debug("Domain is not replicated to standby cluster, skip task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
+		return false, nil
 	} else if domainEntry.IsGlobalDomain() && domainEntry.GetReplicationConfig().ActiveClusterName != standbyCluster {
 		// timer task does not belong here
 		t.logger.Debug("Domain is not standby, skip task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
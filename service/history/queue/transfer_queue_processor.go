@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/lifecycle"
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
@@ -92,6 +93,7 @@ func NewTransferQueueProcessor(
 	executionCache *execution.Cache,
 	workflowResetter reset.WorkflowResetter,
 	archivalClient archiver.Client,
+	lifecyclePublisher lifecycle.Publisher,
 	executionCheck invariant.Invariant,
 ) Processor {
 	logger := shard.GetLogger().WithTags(tag.ComponentTransferQueue)
@@ -104,6 +106,7 @@ func NewTransferQueueProcessor(
 		archivalClient,
 		executionCache,
 		workflowResetter,
+		lifecyclePublisher,
 		logger,
 		config,
 	)
@@ -138,6 +141,7 @@ func NewTransferQueueProcessor(
 			archivalClient,
 			executionCache,
 			historyResender,
+			lifecyclePublisher,
 			logger,
 			clusterName,
 			config,
@@ -366,6 +366,86 @@ func (s *transferQueueProcessorBaseSuite) TestProcessQueueCollections_WithNextPa
 	}
 }
 
+func (s *transferQueueProcessorBaseSuite) TestUpdateAckLevel_WorkflowSuspended() {
+	queueLevel := 0
+	ackLevel := newTransferTaskKey(0)
+	maxLevel := newTransferTaskKey(1000)
+	shardMaxLevel := newTransferTaskKey(500)
+	processingQueueStates := []ProcessingQueueState{
+		NewProcessingQueueState(
+			queueLevel,
+			ackLevel,
+			maxLevel,
+			NewDomainFilter(nil, true),
+		),
+	}
+	updateMaxReadLevel := func() task.Key {
+		return shardMaxLevel
+	}
+	var updatedAckLevel task.Key
+	updateClusterAckLevel := func(ackLevel task.Key) error {
+		updatedAckLevel = ackLevel
+		return nil
+	}
+	taskInfos := []*persistence.TransferTaskInfo{
+		{
+			TaskID:   1,
+			DomainID: constants.TestDomainID,
+		},
+		{
+			TaskID:   10,
+			DomainID: constants.TestDomainID,
+		},
+	}
+	mockExecutionManager := s.mockShard.Resource.ExecutionMgr
+	mockExecutionManager.On("GetTransferTasks", mock.Anything, &persistence.GetTransferTasksRequest{
+		ReadLevel:    ackLevel.(transferTaskKey).taskID,
+		MaxReadLevel: shardMaxLevel.(transferTaskKey).taskID,
+		BatchSize:    s.mockShard.GetConfig().TransferTaskBatchSize(),
+	}).Return(&persistence.GetTransferTasksResponse{
+		Tasks:         taskInfos,
+		NextPageToken: nil,
+	}, nil).Once()
+
+	// all tasks belong to a suspended workflow
+	mockTaskExecutor := task.NewMockExecutor(s.controller)
+	mockTaskExecutor.EXPECT().Execute(gomock.Any(), true).Return(task.ErrTaskWorkflowSuspended).Times(len(taskInfos))
+	s.mockTaskProcessor.EXPECT().TrySubmit(gomock.Any()).DoAndReturn(func(t task.Task) (bool, error) {
+		err := t.Execute()
+		if err != nil {
+			err = t.HandleErr(err)
+		}
+		if err != nil {
+			t.Nack()
+		} else {
+			t.Ack()
+		}
+		return true, nil
+	}).Times(len(taskInfos))
+
+	processorBase := newTransferQueueProcessorBase(
+		s.mockShard,
+		processingQueueStates,
+		s.mockTaskProcessor,
+		newTransferQueueProcessorOptions(s.mockShard.GetConfig(), true, false),
+		updateMaxReadLevel,
+		updateClusterAckLevel,
+		nil,
+		nil,
+		func(task.Info) (bool, error) { return true, nil },
+		mockTaskExecutor,
+		s.logger,
+		s.metricsClient,
+	)
+	processorBase.shouldProcess[queueLevel] = true
+
+	processorBase.processQueueCollections()
+	_, _, err := processorBase.updateAckLevel()
+	s.NoError(err)
+	// tasks of the suspended workflow are acked, so the ack level moves to the read level
+	s.True(taskKeyEquals(shardMaxLevel, updatedAckLevel))
+}
+
 func (s *transferQueueProcessorBaseSuite) TestReadTasks_NoNextPage() {
 	readLevel := newTransferTaskKey(3)
 	maxReadLevel := newTransferTaskKey(100)
@@ -24,7 +24,9 @@ package replication
 
 import (
 	"context"
+	"strings"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -130,7 +132,7 @@ func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 		return nil, nil, nil, err
 	}
 
-	remoteAdminClient := r.shard.GetService().GetClientBean().GetRemoteAdminClient(sourceCluster)
+	remoteAdminClient := r.shard.GetService().GetClientBean().GetRemoteAdminClient(dlqSourceCluster(sourceCluster))
 	if remoteAdminClient == nil {
 		return nil, nil, nil, errInvalidCluster
 	}
@@ -138,15 +140,17 @@ func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 	taskInfo := make([]*types.ReplicationTaskInfo, 0, len(resp.Tasks))
 	for _, task := range resp.Tasks {
 		taskInfo = append(taskInfo, &types.ReplicationTaskInfo{
-			DomainID:     task.GetDomainID(),
-			WorkflowID:   task.GetWorkflowID(),
-			RunID:        task.GetRunID(),
-			TaskType:     int16(task.GetTaskType()),
-			TaskID:       task.GetTaskID(),
-			Version:      task.GetVersion(),
-			FirstEventID: task.FirstEventID,
-			NextEventID:  task.NextEventID,
-			ScheduledID:  task.ScheduledID,
+			DomainID:        task.GetDomainID(),
+			WorkflowID:      task.GetWorkflowID(),
+			RunID:           task.GetRunID(),
+			TaskType:        int16(task.GetTaskType()),
+			TaskID:          task.GetTaskID(),
+			Version:         task.GetVersion(),
+			FirstEventID:    task.FirstEventID,
+			NextEventID:     task.NextEventID,
+			ScheduledID:     task.ScheduledID,
+			DeadReason:      task.DeadReason,
+			RedriveAttempts: int32(task.RedriveAttempts),
 		})
 	}
 	response := &types.GetDLQReplicationMessagesResponse{}
@@ -193,7 +197,8 @@ func (r *dlqHandlerImpl) MergeMessages(
 	pageToken []byte,
 ) ([]byte, error) {
 
-	if _, ok := r.taskExecutors[sourceCluster]; !ok {
+	taskExecutor, ok := r.taskExecutors[dlqSourceCluster(sourceCluster)]
+	if !ok {
 		return nil, errInvalidCluster
 	}
 
@@ -207,7 +212,7 @@ func (r *dlqHandlerImpl) MergeMessages(
 
 	lastMessageID = defaultBeginningMessageID
 	for _, task := range tasks {
-		if _, err := r.taskExecutors[sourceCluster].execute(
+		if _, err := taskExecutor.execute(
 			task,
 			true,
 		); err != nil {
@@ -232,3 +237,13 @@ func (r *dlqHandlerImpl) MergeMessages(
 	}
 	return token, nil
 }
+
+// deadDLQName returns the name of the DLQ holding tasks from the source cluster which are given up on by redrive
+func deadDLQName(sourceCluster string) string {
+	return sourceCluster + common.ReplicationDeadDLQSuffix
+}
+
+// dlqSourceCluster returns the cluster where tasks of the given DLQ are generated
+func dlqSourceCluster(dlqName string) string {
+	return strings.TrimSuffix(dlqName, common.ReplicationDeadDLQSuffix)
+}
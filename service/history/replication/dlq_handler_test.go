@@ -32,6 +32,7 @@ import (
 
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -153,6 +154,47 @@ func (s *dlqHandlerSuite) TestReadMessages_OK() {
 	s.Nil(tasks)
 }
 
+func (s *dlqHandlerSuite) TestReadMessages_DeadDLQ() {
+	ctx := context.Background()
+	deadDLQ := s.sourceCluster + common.ReplicationDeadDLQSuffix
+	lastMessageID := int64(1)
+	pageSize := 1
+	pageToken := []byte{}
+
+	resp := &persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{
+				DomainID:        uuid.New(),
+				WorkflowID:      uuid.New(),
+				RunID:           uuid.New(),
+				TaskType:        0,
+				TaskID:          1,
+				DeadReason:      dlqDeadReasonMaxAttempts,
+				RedriveAttempts: 5,
+			},
+		},
+	}
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: deadDLQ,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:     -1,
+			MaxReadLevel:  lastMessageID,
+			BatchSize:     pageSize,
+			NextPageToken: pageToken,
+		},
+	}).Return(resp, nil).Times(1)
+
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient).AnyTimes()
+	s.adminClient.EXPECT().
+		GetDLQReplicationMessages(ctx, gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{}, nil)
+	_, info, _, err := s.messageHandler.ReadMessages(ctx, deadDLQ, lastMessageID, pageSize, pageToken)
+	s.NoError(err)
+	s.Len(info, 1)
+	s.Equal(dlqDeadReasonMaxAttempts, info[0].GetDeadReason())
+	s.Equal(int32(5), info[0].GetRedriveAttempts())
+}
+
 func (s *dlqHandlerSuite) TestPurgeMessages_OK() {
 	sourceCluster := "test"
 	lastMessageID := int64(1)
@@ -217,3 +259,54 @@ func (s *dlqHandlerSuite) TestMergeMessages_OK() {
 	s.NoError(err)
 	s.Nil(token)
 }
+
+func (s *dlqHandlerSuite) TestMergeMessages_DeadDLQ() {
+	ctx := context.Background()
+	deadDLQ := s.sourceCluster + common.ReplicationDeadDLQSuffix
+	lastMessageID := int64(1)
+	pageSize := 1
+	pageToken := []byte{}
+
+	resp := &persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{
+				DomainID:   uuid.New(),
+				WorkflowID: uuid.New(),
+				RunID:      uuid.New(),
+				TaskType:   0,
+				TaskID:     1,
+			},
+		},
+	}
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: deadDLQ,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:     -1,
+			MaxReadLevel:  lastMessageID,
+			BatchSize:     pageSize,
+			NextPageToken: pageToken,
+		},
+	}).Return(resp, nil).Times(1)
+
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient).AnyTimes()
+	replicationTask := &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeHistory.Ptr(),
+		SourceTaskID: lastMessageID,
+	}
+	s.adminClient.EXPECT().
+		GetDLQReplicationMessages(ctx, gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{
+			ReplicationTasks: []*types.ReplicationTask{replicationTask},
+		}, nil)
+	s.taskExecutor.EXPECT().execute(replicationTask, true).Return(0, nil).Times(1)
+	s.executionManager.On("RangeDeleteReplicationTaskFromDLQ", mock.Anything,
+		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
+			SourceClusterName:    deadDLQ,
+			ExclusiveBeginTaskID: -1,
+			InclusiveEndTaskID:   lastMessageID,
+		}).Return(&persistence.RangeDeleteReplicationTaskFromDLQResponse{TasksCompleted: persistence.UnknownNumRowsAffected}, nil).Times(1)
+
+	token, err := s.messageHandler.MergeMessages(ctx, deadDLQ, lastMessageID, pageSize, pageToken)
+	s.NoError(err)
+	s.Nil(token)
+}
@@ -0,0 +1,545 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination dlq_redrive_processor_mock.go
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+const (
+	dlqRedriveScanJitterCoefficient    = 0.15
+	dlqRedriveBackoffJitterCoefficient = 0.1
+
+	dlqDeadReasonMaxAttempts    = "max_attempts_exceeded"
+	dlqDeadReasonNonRetryable   = "non_retryable_error"
+	dlqDeadReasonDomainNotFound = "domain_not_found"
+	dlqDeadReasonSourceNotFound = "not_found_in_source_cluster"
+)
+
+var (
+	errDLQTaskNotFoundInSource = errors.New("replication task is not found in source cluster")
+)
+
+type (
+	// DLQRedriveProcessor periodically retries the replication tasks in DLQ of a shard,
+	// following the redrive policy of the domain the task belongs to.
+	DLQRedriveProcessor interface {
+		common.Daemon
+	}
+
+	dlqRedriveProcessorImpl struct {
+		status        int32
+		shard         shard.Context
+		config        *config.Config
+		domainCache   cache.DomainCache
+		taskExecutors map[string]TaskExecutor
+		timeSource    clock.TimeSource
+		metricsClient metrics.Client
+		logger        log.Logger
+
+		// redrive states are only accessed by the redrive loop. They are kept in memory, so a task
+		// gets its attempts reset when the shard moves to another host.
+		states map[dlqTaskKey]*dlqRedriveState
+		// domains with DLQ stats emitted in the last scan, by source cluster,
+		// so their gauges could be reset once the DLQ is drained
+		reportedDomains map[string]map[string]struct{}
+
+		done chan struct{}
+	}
+
+	dlqTaskKey struct {
+		sourceCluster string
+		taskID        int64
+	}
+
+	dlqRedriveState struct {
+		attempts        int
+		nextAttemptTime time.Time
+		deadReason      string
+		lastError       error
+	}
+
+	dlqDomainStats struct {
+		size         int
+		oldestTaskAt time.Time
+	}
+)
+
+var _ DLQRedriveProcessor = (*dlqRedriveProcessorImpl)(nil)
+
+// NewDLQRedriveProcessor creates a new replication DLQ redrive processor.
+func NewDLQRedriveProcessor(
+	shard shard.Context,
+	config *config.Config,
+	taskExecutors map[string]TaskExecutor,
+) DLQRedriveProcessor {
+
+	if taskExecutors == nil {
+		panic("Failed to initialize replication DLQ redrive processor due to nil task executors")
+	}
+
+	return &dlqRedriveProcessorImpl{
+		status:          common.DaemonStatusInitialized,
+		shard:           shard,
+		config:          config,
+		domainCache:     shard.GetDomainCache(),
+		taskExecutors:   taskExecutors,
+		timeSource:      shard.GetTimeSource(),
+		metricsClient:   shard.GetMetricsClient(),
+		logger:          shard.GetLogger(),
+		states:          make(map[dlqTaskKey]*dlqRedriveState),
+		reportedDomains: make(map[string]map[string]struct{}),
+		done:            make(chan struct{}),
+	}
+}
+
+// Start starts the processor
+func (p *dlqRedriveProcessorImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go p.redriveLoop()
+	p.logger.Info("Replication DLQ redrive processor started.")
+}
+
+// Stop stops the processor
+func (p *dlqRedriveProcessorImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(p.done)
+	p.logger.Info("Replication DLQ redrive processor stopped.")
+}
+
+func (p *dlqRedriveProcessorImpl) redriveLoop() {
+	timer := time.NewTimer(backoff.JitDuration(
+		p.config.ReplicationDLQRedriveScanInterval(),
+		dlqRedriveScanJitterCoefficient,
+	))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-timer.C:
+			for sourceCluster := range p.taskExecutors {
+				if err := p.redriveDLQ(sourceCluster); err != nil {
+					p.logger.Error("Failed to redrive replication DLQ.", tag.SourceCluster(sourceCluster), tag.Error(err))
+					p.metricsClient.Scope(metrics.ReplicationDLQStatsScope).IncCounter(metrics.ReplicationDLQProbeFailed)
+				}
+			}
+			timer.Reset(backoff.JitDuration(
+				p.config.ReplicationDLQRedriveScanInterval(),
+				dlqRedriveScanJitterCoefficient,
+			))
+		}
+	}
+}
+
+// redriveDLQ scans the DLQ of the source cluster once, emits the DLQ stats
+// and retries the tasks whose next attempt is due.
+func (p *dlqRedriveProcessorImpl) redriveDLQ(sourceCluster string) error {
+	now := p.timeSource.Now()
+	batchSize := p.config.ReplicationDLQRedriveBatchSize()
+	stats := make(map[string]*dlqDomainStats)
+	inDLQ := make(map[int64]struct{})
+	var dueTasks []*persistence.ReplicationTaskInfo
+
+	var pageToken []byte
+	for {
+		resp, err := p.shard.GetExecutionManager().GetReplicationTasksFromDLQ(
+			context.Background(),
+			&persistence.GetReplicationTasksFromDLQRequest{
+				SourceClusterName: sourceCluster,
+				GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+					ReadLevel:     defaultBeginningMessageID,
+					MaxReadLevel:  common.EndMessageID,
+					BatchSize:     batchSize,
+					NextPageToken: pageToken,
+				},
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range resp.Tasks {
+			inDLQ[task.GetTaskID()] = struct{}{}
+			domainName := p.domainName(task.GetDomainID())
+			domainStats, ok := stats[domainName]
+			if !ok {
+				domainStats = &dlqDomainStats{}
+				stats[domainName] = domainStats
+			}
+			domainStats.size++
+			createdAt := time.Unix(0, task.CreationTime)
+			if task.CreationTime > 0 && (domainStats.oldestTaskAt.IsZero() || createdAt.Before(domainStats.oldestTaskAt)) {
+				domainStats.oldestTaskAt = createdAt
+			}
+
+			if len(dueTasks) < batchSize && p.isDue(sourceCluster, task, domainName, now) {
+				dueTasks = append(dueTasks, task)
+			}
+		}
+
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	// drop the states of tasks which are no longer in DLQ, e.g. merged or purged by operators
+	for key := range p.states {
+		if _, ok := inDLQ[key.taskID]; key.sourceCluster == sourceCluster && !ok {
+			delete(p.states, key)
+		}
+	}
+	p.emitDLQStats(sourceCluster, stats, now)
+
+	if len(dueTasks) == 0 {
+		return nil
+	}
+	return p.redriveTasks(sourceCluster, dueTasks)
+}
+
+func (p *dlqRedriveProcessorImpl) isDue(
+	sourceCluster string,
+	task *persistence.ReplicationTaskInfo,
+	domainName string,
+	now time.Time,
+) bool {
+
+	key := dlqTaskKey{sourceCluster: sourceCluster, taskID: task.GetTaskID()}
+	state, ok := p.states[key]
+	if ok && state.deadReason != "" {
+		return true
+	}
+	// an empty domain name falls back to the redrive policy without domain filter
+	if !p.config.ReplicationDLQRedriveEnabled(domainName) {
+		return false
+	}
+
+	if !ok {
+		if domainName == "" {
+			// the task of a deleted domain can never be applied
+			if _, err := p.domainCache.GetDomainByID(task.GetDomainID()); isEntityNotExists(err) {
+				p.states[key] = &dlqRedriveState{deadReason: dlqDeadReasonDomainNotFound}
+				return true
+			}
+			return false
+		}
+
+		// the first attempt is one initial interval after the task is put into DLQ
+		firstAttemptTime := now
+		if task.CreationTime > 0 {
+			firstAttemptTime = time.Unix(0, task.CreationTime)
+		}
+		state = &dlqRedriveState{
+			nextAttemptTime: firstAttemptTime.Add(p.config.ReplicationDLQRedriveInitialInterval(domainName)),
+		}
+		p.states[key] = state
+	}
+	return !now.Before(state.nextAttemptTime)
+}
+
+func (p *dlqRedriveProcessorImpl) redriveTasks(
+	sourceCluster string,
+	tasks []*persistence.ReplicationTaskInfo,
+) error {
+
+	taskInfos := make([]*types.ReplicationTaskInfo, 0, len(tasks))
+	for _, task := range tasks {
+		if p.states[dlqTaskKey{sourceCluster: sourceCluster, taskID: task.GetTaskID()}].deadReason != "" {
+			continue
+		}
+		taskInfos = append(taskInfos, &types.ReplicationTaskInfo{
+			DomainID:     task.GetDomainID(),
+			WorkflowID:   task.GetWorkflowID(),
+			RunID:        task.GetRunID(),
+			TaskType:     int16(task.GetTaskType()),
+			TaskID:       task.GetTaskID(),
+			Version:      task.GetVersion(),
+			FirstEventID: task.FirstEventID,
+			NextEventID:  task.NextEventID,
+			ScheduledID:  task.ScheduledID,
+		})
+	}
+
+	replicationTasks := make(map[int64]*types.ReplicationTask)
+	if len(taskInfos) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+		defer cancel()
+		resp, err := p.shard.GetService().GetClientBean().GetRemoteAdminClient(sourceCluster).GetDLQReplicationMessages(
+			ctx,
+			&types.GetDLQReplicationMessagesRequest{
+				TaskInfos: taskInfos,
+			},
+		)
+		if err != nil {
+			// the source cluster is not reachable, which does not count as an attempt
+			return err
+		}
+		for _, replicationTask := range resp.GetReplicationTasks() {
+			replicationTasks[replicationTask.GetSourceTaskID()] = replicationTask
+		}
+	}
+
+	for _, task := range tasks {
+		select {
+		case <-p.done:
+			return nil
+		default:
+		}
+
+		key := dlqTaskKey{sourceCluster: sourceCluster, taskID: task.GetTaskID()}
+		state := p.states[key]
+		domainName := p.domainName(task.GetDomainID())
+		if state.deadReason != "" {
+			p.moveToDeadDLQ(sourceCluster, task, domainName, state)
+			continue
+		}
+
+		replicationTask, ok := replicationTasks[task.GetTaskID()]
+		if !ok {
+			p.handleRedriveFailure(sourceCluster, task, domainName, state, errDLQTaskNotFoundInSource)
+			continue
+		}
+		if _, err := p.taskExecutors[sourceCluster].execute(replicationTask, true); err != nil {
+			p.handleRedriveFailure(sourceCluster, task, domainName, state, err)
+			continue
+		}
+
+		if err := p.shard.GetExecutionManager().DeleteReplicationTaskFromDLQ(
+			context.Background(),
+			&persistence.DeleteReplicationTaskFromDLQRequest{
+				SourceClusterName: sourceCluster,
+				TaskID:            task.GetTaskID(),
+			},
+		); err != nil {
+			// applying the task again on next attempt is fine as the task is idempotent
+			p.logger.Error("Failed to delete redriven task from replication DLQ.", tag.TaskID(task.GetTaskID()), tag.Error(err))
+			continue
+		}
+		delete(p.states, key)
+		p.scope(sourceCluster, domainName).IncCounter(metrics.ReplicationDLQRedriveSuccess)
+	}
+	return nil
+}
+
+func (p *dlqRedriveProcessorImpl) handleRedriveFailure(
+	sourceCluster string,
+	task *persistence.ReplicationTaskInfo,
+	domainName string,
+	state *dlqRedriveState,
+	err error,
+) {
+
+	state.attempts++
+	state.lastError = err
+	p.scope(sourceCluster, domainName).IncCounter(metrics.ReplicationDLQRedriveFailed)
+
+	switch {
+	case isNonRetryableRedriveError(err):
+		state.deadReason = dlqDeadReasonNonRetryable
+	case state.attempts >= p.config.ReplicationDLQRedriveMaxAttempts(domainName) && err == errDLQTaskNotFoundInSource:
+		state.deadReason = dlqDeadReasonSourceNotFound
+	case state.attempts >= p.config.ReplicationDLQRedriveMaxAttempts(domainName):
+		state.deadReason = dlqDeadReasonMaxAttempts
+	default:
+		state.nextAttemptTime = p.timeSource.Now().Add(p.redriveBackoff(domainName, state.attempts))
+		p.logger.Warn("Failed to redrive replication DLQ task.",
+			tag.WorkflowDomainID(task.GetDomainID()),
+			tag.WorkflowID(task.GetWorkflowID()),
+			tag.WorkflowRunID(task.GetRunID()),
+			tag.TaskID(task.GetTaskID()),
+			tag.Attempt(int32(state.attempts)),
+			tag.Error(err),
+		)
+		return
+	}
+	p.moveToDeadDLQ(sourceCluster, task, domainName, state)
+}
+
+// redriveBackoff returns the exponential backoff before the next attempt, capped at the max interval
+func (p *dlqRedriveProcessorImpl) redriveBackoff(domainName string, attempts int) time.Duration {
+	interval := p.config.ReplicationDLQRedriveInitialInterval(domainName)
+	maxInterval := p.config.ReplicationDLQRedriveMaxInterval(domainName)
+	for i := 1; i < attempts && interval < maxInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+	return backoff.JitDuration(interval, dlqRedriveBackoffJitterCoefficient)
+}
+
+// moveToDeadDLQ moves a task given up on by redrive to the dead DLQ of the source cluster,
+// where the task stays with its dead reason and attempts until operators merge or purge it.
+func (p *dlqRedriveProcessorImpl) moveToDeadDLQ(
+	sourceCluster string,
+	task *persistence.ReplicationTaskInfo,
+	domainName string,
+	state *dlqRedriveState,
+) {
+
+	logger := p.logger.WithTags(
+		tag.WorkflowDomainID(task.GetDomainID()),
+		tag.WorkflowID(task.GetWorkflowID()),
+		tag.WorkflowRunID(task.GetRunID()),
+		tag.TaskID(task.GetTaskID()),
+		tag.Attempt(int32(state.attempts)),
+		tag.DLQDeadReason(state.deadReason),
+		tag.Error(state.lastError),
+	)
+
+	deadTask := *task
+	deadTask.DeadReason = state.deadReason
+	deadTask.RedriveAttempts = state.attempts
+	if err := p.shard.GetExecutionManager().PutReplicationTaskToDLQ(
+		context.Background(),
+		&persistence.PutReplicationTaskToDLQRequest{
+			SourceClusterName: deadDLQName(sourceCluster),
+			TaskInfo:          &deadTask,
+		},
+	); err != nil {
+		// the dead reason is kept, so the move is retried in the next scan
+		logger.Error("Failed to put replication task to dead DLQ.", tag.Error(err))
+		return
+	}
+	if err := p.shard.GetExecutionManager().DeleteReplicationTaskFromDLQ(
+		context.Background(),
+		&persistence.DeleteReplicationTaskFromDLQRequest{
+			SourceClusterName: sourceCluster,
+			TaskID:            task.GetTaskID(),
+		},
+	); err != nil {
+		logger.Error("Failed to delete dead task from replication DLQ.", tag.Error(err))
+		return
+	}
+
+	delete(p.states, dlqTaskKey{sourceCluster: sourceCluster, taskID: task.GetTaskID()})
+	logger.Warn("Replication DLQ task is moved to dead DLQ.")
+	p.scope(sourceCluster, domainName).Tagged(metrics.DeadReasonTag(state.deadReason)).IncCounter(metrics.ReplicationDLQRedriveDead)
+}
+
+func (p *dlqRedriveProcessorImpl) emitDLQStats(
+	sourceCluster string,
+	stats map[string]*dlqDomainStats,
+	now time.Time,
+) {
+
+	shardTag := metrics.InstanceTag(strconv.Itoa(p.shard.GetShardID()))
+	reported := make(map[string]struct{}, len(stats))
+	for domainName, domainStats := range stats {
+		scope := p.metricsClient.Scope(
+			metrics.ReplicationDLQStatsScope,
+			shardTag,
+			metrics.TargetClusterTag(sourceCluster),
+			metrics.DomainTag(domainName),
+		)
+		scope.UpdateGauge(metrics.ReplicationDLQDomainSize, float64(domainStats.size))
+		age := time.Duration(0)
+		if !domainStats.oldestTaskAt.IsZero() {
+			age = now.Sub(domainStats.oldestTaskAt)
+		}
+		scope.UpdateGauge(metrics.ReplicationDLQAge, age.Seconds())
+		reported[domainName] = struct{}{}
+	}
+	for domainName := range p.reportedDomains[sourceCluster] {
+		if _, ok := reported[domainName]; ok {
+			continue
+		}
+		scope := p.metricsClient.Scope(
+			metrics.ReplicationDLQStatsScope,
+			shardTag,
+			metrics.TargetClusterTag(sourceCluster),
+			metrics.DomainTag(domainName),
+		)
+		scope.UpdateGauge(metrics.ReplicationDLQDomainSize, 0)
+		scope.UpdateGauge(metrics.ReplicationDLQAge, 0)
+	}
+	p.reportedDomains[sourceCluster] = reported
+
+	resp, err := p.shard.GetExecutionManager().GetReplicationDLQSize(
+		context.Background(),
+		&persistence.GetReplicationDLQSizeRequest{
+			SourceClusterName: deadDLQName(sourceCluster),
+		},
+	)
+	if err != nil {
+		p.logger.Error("Failed to get replication dead DLQ size.", tag.Error(err))
+		p.metricsClient.Scope(metrics.ReplicationDLQStatsScope).IncCounter(metrics.ReplicationDLQProbeFailed)
+		return
+	}
+	p.metricsClient.Scope(
+		metrics.ReplicationDLQStatsScope,
+		shardTag,
+		metrics.TargetClusterTag(sourceCluster),
+	).UpdateGauge(metrics.ReplicationDLQDeadSize, float64(resp.Size))
+}
+
+// domainName returns the name of the domain, or empty if the domain cannot be found
+func (p *dlqRedriveProcessorImpl) domainName(domainID string) string {
+	name, err := p.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+func (p *dlqRedriveProcessorImpl) scope(sourceCluster string, domainName string) metrics.Scope {
+	return p.metricsClient.Scope(
+		metrics.ReplicationDLQRedriveScope,
+		metrics.TargetClusterTag(sourceCluster),
+		metrics.DomainTag(domainName),
+	)
+}
+
+func isNonRetryableRedriveError(err error) bool {
+	_, ok := err.(*types.BadRequestError)
+	return ok
+}
+
+func isEntityNotExists(err error) bool {
+	_, ok := err.(*types.EntityNotExistsError)
+	return ok
+}
@@ -0,0 +1,294 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type (
+	dlqRedriveProcessorSuite struct {
+		suite.Suite
+		*require.Assertions
+		controller *gomock.Controller
+
+		mockShard        *shard.TestContext
+		config           *config.Config
+		mockClientBean   *client.MockBean
+		adminClient      *admin.MockClient
+		mockDomainCache  *cache.MockDomainCache
+		executionManager *mocks.ExecutionManager
+		taskExecutor     *MockTaskExecutor
+		timeSource       *clock.EventTimeSource
+		sourceCluster    string
+		domainID         string
+		domainName       string
+
+		processor *dlqRedriveProcessorImpl
+	}
+)
+
+func TestDLQRedriveProcessorSuite(t *testing.T) {
+	s := new(dlqRedriveProcessorSuite)
+	suite.Run(t, s)
+}
+
+func (s *dlqRedriveProcessorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.config = config.NewForTest()
+	s.config.ReplicationDLQRedriveEnabled = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	s.config.ReplicationDLQRedriveMaxAttempts = func(domain string) int { return 2 }
+	s.config.ReplicationDLQRedriveInitialInterval = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Minute)
+	s.config.ReplicationDLQRedriveMaxInterval = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Hour)
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID: 0,
+			RangeID: 1,
+		},
+		s.config,
+	)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.mockShard.Resource.TimeSource = s.timeSource
+
+	s.mockClientBean = s.mockShard.Resource.ClientBean
+	s.adminClient = s.mockShard.Resource.RemoteAdminClient
+	s.mockDomainCache = s.mockShard.Resource.DomainCache
+	s.executionManager = s.mockShard.Resource.ExecutionMgr
+	s.mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return("active").AnyTimes()
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(gomock.Any()).Return(s.adminClient).AnyTimes()
+
+	s.sourceCluster = "standby"
+	s.domainID = uuid.New()
+	s.domainName = "test-domain"
+	s.taskExecutor = NewMockTaskExecutor(s.controller)
+
+	s.processor = NewDLQRedriveProcessor(
+		s.mockShard,
+		s.config,
+		map[string]TaskExecutor{s.sourceCluster: s.taskExecutor},
+	).(*dlqRedriveProcessorImpl)
+}
+
+func (s *dlqRedriveProcessorSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+}
+
+func (s *dlqRedriveProcessorSuite) TestRedriveDLQ_NotDue() {
+	task := s.newDLQTask(1, s.timeSource.Now().Add(-time.Second))
+	s.mockDLQTasks(task)
+	s.mockDomainCache.EXPECT().GetDomainName(s.domainID).Return(s.domainName, nil).AnyTimes()
+
+	s.NoError(s.processor.redriveDLQ(s.sourceCluster))
+	state := s.processor.states[dlqTaskKey{sourceCluster: s.sourceCluster, taskID: 1}]
+	s.Equal(0, state.attempts)
+	s.Equal(task.CreationTime+int64(time.Minute), state.nextAttemptTime.UnixNano())
+}
+
+func (s *dlqRedriveProcessorSuite) TestRedriveDLQ_Disabled() {
+	s.config.ReplicationDLQRedriveEnabled = dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+	s.mockDLQTasks(s.newDLQTask(1, s.timeSource.Now().Add(-time.Hour)))
+	s.mockDomainCache.EXPECT().GetDomainName(s.domainID).Return(s.domainName, nil).AnyTimes()
+
+	s.NoError(s.processor.redriveDLQ(s.sourceCluster))
+	s.Empty(s.processor.states)
+}
+
+func (s *dlqRedriveProcessorSuite) TestRedriveDLQ_Success() {
+	s.mockDLQTasks(s.newDLQTask(1, s.timeSource.Now().Add(-time.Hour)))
+	s.mockDomainCache.EXPECT().GetDomainName(s.domainID).Return(s.domainName, nil).AnyTimes()
+	replicationTask := &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+		SourceTaskID: 1,
+	}
+	s.adminClient.EXPECT().GetDLQReplicationMessages(gomock.Any(), gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{ReplicationTasks: []*types.ReplicationTask{replicationTask}}, nil).Times(1)
+	s.taskExecutor.EXPECT().execute(replicationTask, true).Return(0, nil).Times(1)
+	s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		TaskID:            1,
+	}).Return(nil).Once()
+
+	s.NoError(s.processor.redriveDLQ(s.sourceCluster))
+	s.Empty(s.processor.states)
+}
+
+func (s *dlqRedriveProcessorSuite) TestRedriveDLQ_RemoteUnavailable() {
+	s.mockDLQTasks(s.newDLQTask(1, s.timeSource.Now().Add(-time.Hour)))
+	s.mockDomainCache.EXPECT().GetDomainName(s.domainID).Return(s.domainName, nil).AnyTimes()
+	s.adminClient.EXPECT().GetDLQReplicationMessages(gomock.Any(), gomock.Any()).
+		Return(nil, &types.InternalServiceError{}).Times(1)
+
+	s.Error(s.processor.redriveDLQ(s.sourceCluster))
+	s.Equal(0, s.processor.states[dlqTaskKey{sourceCluster: s.sourceCluster, taskID: 1}].attempts)
+}
+
+func (s *dlqRedriveProcessorSuite) TestRedriveDLQ_RetryThenDead() {
+	task := s.newDLQTask(1, s.timeSource.Now().Add(-time.Hour))
+	s.mockDLQTasks(task)
+	s.mockDomainCache.EXPECT().GetDomainName(s.domainID).Return(s.domainName, nil).AnyTimes()
+	replicationTask := &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+		SourceTaskID: 1,
+	}
+	s.adminClient.EXPECT().GetDLQReplicationMessages(gomock.Any(), gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{ReplicationTasks: []*types.ReplicationTask{replicationTask}}, nil).Times(2)
+	s.taskExecutor.EXPECT().execute(replicationTask, true).Return(0, errors.New("some random error")).Times(2)
+
+	s.NoError(s.processor.redriveDLQ(s.sourceCluster))
+	state := s.processor.states[dlqTaskKey{sourceCluster: s.sourceCluster, taskID: 1}]
+	s.Equal(1, state.attempts)
+	s.Empty(state.deadReason)
+	s.True(state.nextAttemptTime.After(s.timeSource.Now()))
+
+	// the task is not retried before its backoff expires
+	s.NoError(s.processor.redriveDLQ(s.sourceCluster))
+	s.Equal(1, state.attempts)
+
+	s.timeSource.Update(state.nextAttemptTime)
+	s.executionManager.On("PutReplicationTaskToDLQ", mock.Anything, &persistence.PutReplicationTaskToDLQRequest{
+		SourceClusterName: "standby:dead",
+		TaskInfo:          s.newDeadDLQTask(task, dlqDeadReasonMaxAttempts, 2),
+	}).Return(nil).Once()
+	s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		TaskID:            1,
+	}).Return(nil).Once()
+
+	s.NoError(s.processor.redriveDLQ(s.sourceCluster))
+	s.Empty(s.processor.states)
+}
+
+func (s *dlqRedriveProcessorSuite) TestRedriveDLQ_NonRetryableError() {
+	task := s.newDLQTask(1, s.timeSource.Now().Add(-time.Hour))
+	s.mockDLQTasks(task)
+	s.mockDomainCache.EXPECT().GetDomainName(s.domainID).Return(s.domainName, nil).AnyTimes()
+	replicationTask := &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+		SourceTaskID: 1,
+	}
+	s.adminClient.EXPECT().GetDLQReplicationMessages(gomock.Any(), gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{ReplicationTasks: []*types.ReplicationTask{replicationTask}}, nil).Times(1)
+	s.taskExecutor.EXPECT().execute(replicationTask, true).Return(0, ErrUnknownReplicationTask).Times(1)
+	s.executionManager.On("PutReplicationTaskToDLQ", mock.Anything, &persistence.PutReplicationTaskToDLQRequest{
+		SourceClusterName: "standby:dead",
+		TaskInfo:          s.newDeadDLQTask(task, dlqDeadReasonNonRetryable, 1),
+	}).Return(nil).Once()
+	s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		TaskID:            1,
+	}).Return(nil).Once()
+
+	s.NoError(s.processor.redriveDLQ(s.sourceCluster))
+	s.Empty(s.processor.states)
+}
+
+func (s *dlqRedriveProcessorSuite) TestRedriveDLQ_DomainNotFound() {
+	task := s.newDLQTask(1, s.timeSource.Now())
+	s.mockDLQTasks(task)
+	s.mockDomainCache.EXPECT().GetDomainName(s.domainID).Return("", &types.EntityNotExistsError{}).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(s.domainID).Return(nil, &types.EntityNotExistsError{}).Times(1)
+	s.executionManager.On("PutReplicationTaskToDLQ", mock.Anything, &persistence.PutReplicationTaskToDLQRequest{
+		SourceClusterName: "standby:dead",
+		TaskInfo:          s.newDeadDLQTask(task, dlqDeadReasonDomainNotFound, 0),
+	}).Return(nil).Once()
+	s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		TaskID:            1,
+	}).Return(nil).Once()
+
+	s.NoError(s.processor.redriveDLQ(s.sourceCluster))
+	s.Empty(s.processor.states)
+}
+
+func (s *dlqRedriveProcessorSuite) TestRedriveDLQ_DropStateOfRemovedTask() {
+	s.processor.states[dlqTaskKey{sourceCluster: s.sourceCluster, taskID: 1}] = &dlqRedriveState{attempts: 1}
+	s.mockDLQTasks()
+
+	s.NoError(s.processor.redriveDLQ(s.sourceCluster))
+	s.Empty(s.processor.states)
+}
+
+func (s *dlqRedriveProcessorSuite) TestRedriveBackoff() {
+	s.InDelta(float64(time.Minute), float64(s.processor.redriveBackoff(s.domainName, 1)), float64(6*time.Second))
+	s.InDelta(float64(4*time.Minute), float64(s.processor.redriveBackoff(s.domainName, 3)), float64(24*time.Second))
+	s.InDelta(float64(time.Hour), float64(s.processor.redriveBackoff(s.domainName, 100)), float64(6*time.Minute))
+}
+
+func (s *dlqRedriveProcessorSuite) newDLQTask(taskID int64, creationTime time.Time) *persistence.ReplicationTaskInfo {
+	return &persistence.ReplicationTaskInfo{
+		DomainID:     s.domainID,
+		WorkflowID:   uuid.New(),
+		RunID:        uuid.New(),
+		TaskID:       taskID,
+		TaskType:     persistence.ReplicationTaskTypeHistory,
+		CreationTime: creationTime.UnixNano(),
+	}
+}
+
+func (s *dlqRedriveProcessorSuite) newDeadDLQTask(
+	task *persistence.ReplicationTaskInfo,
+	deadReason string,
+	attempts int,
+) *persistence.ReplicationTaskInfo {
+	deadTask := *task
+	deadTask.DeadReason = deadReason
+	deadTask.RedriveAttempts = attempts
+	return &deadTask
+}
+
+func (s *dlqRedriveProcessorSuite) mockDLQTasks(tasks ...*persistence.ReplicationTaskInfo) {
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:    -1,
+			MaxReadLevel: common.EndMessageID,
+			BatchSize:    s.config.ReplicationDLQRedriveBatchSize(),
+		},
+	}).Return(&persistence.GetReplicationTasksFromDLQResponse{Tasks: tasks}, nil)
+	s.executionManager.On("GetReplicationDLQSize", mock.Anything, &persistence.GetReplicationDLQSizeRequest{
+		SourceClusterName: "standby:dead",
+	}).Return(&persistence.GetReplicationDLQSizeResponse{}, nil)
+}
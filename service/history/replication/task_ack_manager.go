@@ -29,6 +29,7 @@ import (
 	ctx "context"
 	"errors"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -69,6 +70,9 @@ type (
 			pollingCluster string,
 			lastReadTaskID int64,
 		) (*types.ReplicationMessages, error)
+
+		NotifyNewTasks()
+		WatchNewTasks() <-chan struct{}
 	}
 
 	taskAckManagerImpl struct {
@@ -88,6 +92,11 @@ type (
 
 		// This is the batch size used by pull based RPC replicator.
 		fetchTasksBatchSize dynamicconfig.IntPropertyFnWithShardIDFilter
+
+		// newTasksCh is closed and replaced whenever new replication tasks are generated,
+		// it wakes up the long polls waiting for new tasks.
+		newTasksLock sync.Mutex
+		newTasksCh   chan struct{}
 	}
 )
 
@@ -123,9 +132,27 @@ func NewTaskAckManager(
 		metricsClient:        shard.GetMetricsClient(),
 		logger:               shard.GetLogger().WithTags(tag.ComponentReplicationAckManager),
 		fetchTasksBatchSize:  config.ReplicatorProcessorFetchTasksBatchSize,
+		newTasksCh:           make(chan struct{}),
 	}
 }
 
+// NotifyNewTasks notifies the watchers that new replication tasks are generated
+func (t *taskAckManagerImpl) NotifyNewTasks() {
+	t.newTasksLock.Lock()
+	defer t.newTasksLock.Unlock()
+
+	close(t.newTasksCh)
+	t.newTasksCh = make(chan struct{})
+}
+
+// WatchNewTasks returns a channel which is closed on the next new replication tasks notification
+func (t *taskAckManagerImpl) WatchNewTasks() <-chan struct{} {
+	t.newTasksLock.Lock()
+	defer t.newTasksLock.Unlock()
+
+	return t.newTasksCh
+}
+
 func (t *taskAckManagerImpl) GetTask(
 	ctx ctx.Context,
 	taskInfo *types.ReplicationTaskInfo,
@@ -87,3 +87,29 @@ func (mr *MockTaskAckManagerMockRecorder) GetTasks(ctx, pollingCluster, lastRead
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasks", reflect.TypeOf((*MockTaskAckManager)(nil).GetTasks), ctx, pollingCluster, lastReadTaskID)
 }
+
+// NotifyNewTasks mocks base method
+func (m *MockTaskAckManager) NotifyNewTasks() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyNewTasks")
+}
+
+// NotifyNewTasks indicates an expected call of NotifyNewTasks
+func (mr *MockTaskAckManagerMockRecorder) NotifyNewTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTasks", reflect.TypeOf((*MockTaskAckManager)(nil).NotifyNewTasks))
+}
+
+// WatchNewTasks mocks base method
+func (m *MockTaskAckManager) WatchNewTasks() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchNewTasks")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// WatchNewTasks indicates an expected call of WatchNewTasks
+func (mr *MockTaskAckManagerMockRecorder) WatchNewTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchNewTasks", reflect.TypeOf((*MockTaskAckManager)(nil).WatchNewTasks))
+}
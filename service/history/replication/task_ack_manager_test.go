@@ -118,6 +118,29 @@ func (s *taskAckManagerSuite) TearDownTest() {
 	s.mockShard.Finish(s.T())
 }
 
+func (s *taskAckManagerSuite) TestNotifyNewTasks() {
+	newTasksCh := s.ackManager.WatchNewTasks()
+	select {
+	case <-newTasksCh:
+		s.Fail("watch channel should not be closed before notification")
+	default:
+	}
+
+	s.ackManager.NotifyNewTasks()
+	select {
+	case <-newTasksCh:
+	default:
+		s.Fail("watch channel should be closed after notification")
+	}
+
+	nextNewTasksCh := s.ackManager.WatchNewTasks()
+	select {
+	case <-nextNewTasksCh:
+		s.Fail("watch channel should be renewed after notification")
+	default:
+	}
+}
+
 func (s *taskAckManagerSuite) TestGetPaginationFunc() {
 	firstEventID := int64(0)
 	nextEventID := int64(1)
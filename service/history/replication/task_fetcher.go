@@ -24,6 +24,9 @@ package replication
 
 import (
 	"context"
+	"errors"
+	"io"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -46,6 +49,10 @@ const (
 	requestChanBufferSize   = 1000
 )
 
+var (
+	errStreamClosed = errors.New("replication task stream closed by source cluster")
+)
+
 type (
 	// TaskFetcher is responsible for fetching replication messages from remote DC.
 	TaskFetcher interface {
@@ -54,6 +61,7 @@ type (
 		GetSourceCluster() string
 		GetRequestChan() chan<- *request
 		GetRateLimiter() *quotas.DynamicRateLimiter
+		IsStreaming() bool
 	}
 
 	// TaskFetchers is a group of fetchers, one per source DC.
@@ -74,6 +82,8 @@ type (
 		rateLimiter    *quotas.DynamicRateLimiter
 		requestChan    chan *request
 		done           chan struct{}
+		// streaming is 1 while replication tasks are pushed over an established stream, 0 while polling
+		streaming int32
 	}
 
 	// taskFetchersImpl is a group of fetchers, one per source DC.
@@ -181,6 +191,12 @@ func (f *taskFetcherImpl) Start() {
 		return
 	}
 
+	if f.config.ReplicationTaskFetcherStreamingEnabled() {
+		go f.streamTasks()
+		f.logger.Info("Replication task fetcher started in streaming mode.")
+		return
+	}
+
 	for i := 0; i < f.config.ReplicationTaskFetcherParallelism(); i++ {
 		go f.fetchTasks()
 	}
@@ -199,26 +215,25 @@ func (f *taskFetcherImpl) Stop() {
 
 // fetchTasks collects getReplicationTasks request from shards and send out aggregated request to source frontend.
 func (f *taskFetcherImpl) fetchTasks() {
+	f.pollTasks(make(map[int32]*request), nil)
+}
+
+// pollTasks periodically sends out the aggregated pending requests until the fetcher or stop fires.
+// Requests which are not answered yet are left in requestByShard.
+func (f *taskFetcherImpl) pollTasks(
+	requestByShard map[int32]*request,
+	stop <-chan time.Time,
+) {
 	timer := time.NewTimer(backoff.JitDuration(
 		f.config.ReplicationTaskFetcherAggregationInterval(),
 		f.config.ReplicationTaskFetcherTimerJitterCoefficient(),
 	))
 
-	requestByShard := make(map[int32]*request)
-
 	for {
 		select {
 		case request := <-f.requestChan:
 			// Here we only add the request to map. We will wait until timer fires to send the request to remote.
-			if req, ok := requestByShard[request.token.GetShardID()]; ok && req != request {
-				// since this replication task fetcher is per host
-				// and replication task processor is per shard
-				// during shard movement, duplicated requests can appear
-				// if shard moved from this host, to this host.
-				f.logger.Error("Get replication task request already exist for shard.")
-				close(req.respChan)
-			}
-			requestByShard[request.token.GetShardID()] = request
+			f.addRequest(requestByShard, request)
 
 		case <-timer.C:
 			// When timer fires, we collect all the requests we have so far and attempt to send them to remote.
@@ -242,10 +257,161 @@ func (f *taskFetcherImpl) fetchTasks() {
 		case <-f.done:
 			timer.Stop()
 			return
+		case <-stop:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// streamTasks receives replication tasks pushed by source frontend over a stream.
+// When the stream cannot be established or breaks, it falls back to polling for a while before retrying.
+func (f *taskFetcherImpl) streamTasks() {
+	requestByShard := make(map[int32]*request)
+
+	for {
+		if f.config.ReplicationTaskFetcherStreamingEnabled() {
+			err := f.streamTasksOnce(requestByShard)
+			if f.isStopped() {
+				return
+			}
+			f.logger.Warn("Replication task stream closed, falling back to polling.", tag.Error(err))
+		}
+
+		retryTimer := time.NewTimer(backoff.JitDuration(
+			f.config.ReplicationTaskFetcherStreamRetryInterval(),
+			f.config.ReplicationTaskFetcherTimerJitterCoefficient(),
+		))
+		f.pollTasks(requestByShard, retryTimer.C)
+		retryTimer.Stop()
+		if f.isStopped() {
+			return
+		}
+	}
+}
+
+// streamTasksOnce forwards the pending and incoming requests over a single stream, and distributes
+// the received replication tasks to the requesting shards until the stream breaks or the fetcher stops.
+// A shard sends its next request only after processing the tasks it received, which acknowledges them
+// and keeps at most one batch per shard in flight.
+func (f *taskFetcherImpl) streamTasksOnce(requestByShard map[int32]*request) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := f.remotePeer.StreamReplicationMessages(ctx)
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreInt32(&f.streaming, 1)
+	defer atomic.StoreInt32(&f.streaming, 0)
+
+	// requestByShard is shared with the receiving goroutine until it exits
+	var lock sync.Mutex
+	recvErrC := make(chan error, 1)
+	recvDone := make(chan struct{})
+	go func() {
+		defer close(recvDone)
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				recvErrC <- err
+				return
+			}
+			lock.Lock()
+			f.distributeTasks(requestByShard, response.GetMessagesByShard())
+			lock.Unlock()
+		}
+	}()
+	defer func() {
+		cancel()
+		<-recvDone
+	}()
+
+	lock.Lock()
+	tokens := make([]*types.ReplicationToken, 0, len(requestByShard))
+	for _, request := range requestByShard {
+		tokens = append(tokens, request.token)
+	}
+	lock.Unlock()
+
+	for {
+		if len(tokens) > 0 {
+			if err := stream.Send(&types.StreamReplicationMessagesRequest{
+				Tokens:      tokens,
+				ClusterName: f.currentCluster,
+			}); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case request := <-f.requestChan:
+			lock.Lock()
+			f.addRequest(requestByShard, request)
+			tokens = []*types.ReplicationToken{request.token}
+			// batch the requests that are already queued into the same message
+		Batch:
+			for {
+				select {
+				case request := <-f.requestChan:
+					f.addRequest(requestByShard, request)
+					tokens = append(tokens, request.token)
+				default:
+					break Batch
+				}
+			}
+			lock.Unlock()
+		case err := <-recvErrC:
+			if err == io.EOF {
+				err = errStreamClosed
+			}
+			stream.CloseSend()
+			return err
+		case <-f.done:
+			stream.CloseSend()
+			return nil
 		}
 	}
 }
 
+func (f *taskFetcherImpl) addRequest(requestByShard map[int32]*request, request *request) {
+	if req, ok := requestByShard[request.token.GetShardID()]; ok && req != request {
+		// since this replication task fetcher is per host
+		// and replication task processor is per shard
+		// during shard movement, duplicated requests can appear
+		// if shard moved from this host, to this host.
+		f.logger.Error("Get replication task request already exist for shard.")
+		close(req.respChan)
+	}
+	requestByShard[request.token.GetShardID()] = request
+}
+
+func (f *taskFetcherImpl) distributeTasks(
+	requestByShard map[int32]*request,
+	messagesByShard map[int32]*types.ReplicationMessages,
+) {
+	for shardID, tasks := range messagesByShard {
+		request, ok := requestByShard[shardID]
+		if !ok {
+			// the shard has moved away or its request was superseded
+			continue
+		}
+		request.respChan <- tasks
+		close(request.respChan)
+		delete(requestByShard, shardID)
+	}
+}
+
+func (f *taskFetcherImpl) isStopped() bool {
+	select {
+	case <-f.done:
+		return true
+	default:
+		return false
+	}
+}
+
 func (f *taskFetcherImpl) fetchAndDistributeTasks(requestByShard map[int32]*request) error {
 	if len(requestByShard) == 0 {
 		// We don't receive tasks from previous fetch so processors are all sleeping.
@@ -263,12 +429,7 @@ func (f *taskFetcherImpl) fetchAndDistributeTasks(requestByShard map[int32]*requ
 
 	f.logger.Debug("Successfully fetched replication tasks.", tag.Counter(len(messagesByShard)))
 
-	for shardID, tasks := range messagesByShard {
-		request := requestByShard[shardID]
-		request.respChan <- tasks
-		close(request.respChan)
-		delete(requestByShard, shardID)
-	}
+	f.distributeTasks(requestByShard, messagesByShard)
 
 	return err
 }
@@ -310,3 +471,8 @@ func (f *taskFetcherImpl) GetRequestChan() chan<- *request {
 func (f *taskFetcherImpl) GetRateLimiter() *quotas.DynamicRateLimiter {
 	return f.rateLimiter
 }
+
+// IsStreaming returns true if replication tasks are currently pushed over a stream instead of being polled
+func (f *taskFetcherImpl) IsStreaming() bool {
+	return atomic.LoadInt32(&f.streaming) == 1
+}
@@ -123,6 +123,20 @@ func (mr *MockTaskFetcherMockRecorder) GetRateLimiter() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimiter", reflect.TypeOf((*MockTaskFetcher)(nil).GetRateLimiter))
 }
 
+// IsStreaming mocks base method
+func (m *MockTaskFetcher) IsStreaming() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsStreaming")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsStreaming indicates an expected call of IsStreaming
+func (mr *MockTaskFetcherMockRecorder) IsStreaming() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsStreaming", reflect.TypeOf((*MockTaskFetcher)(nil).IsStreaming))
+}
+
 // MockTaskFetchers is a mock of TaskFetchers interface
 type MockTaskFetchers struct {
 	ctrl     *gomock.Controller
@@ -21,13 +21,16 @@
 package replication
 
 import (
+	"io"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/resource"
@@ -140,3 +143,85 @@ func (s *taskFetcherSuite) TestFetchAndDistributeTasks() {
 	respToken := <-respChan
 	s.Equal(messageByShared[0], respToken)
 }
+
+func (s *taskFetcherSuite) TestStreamTasks() {
+	s.config.ReplicationTaskFetcherStreamingEnabled = dynamicconfig.GetBoolPropertyFn(true)
+	token := &types.ReplicationToken{
+		ShardID:                0,
+		LastProcessedMessageID: 1,
+		LastRetrievedMessageID: 2,
+	}
+	messages := &types.ReplicationMessages{LastRetrievedMessageID: 3}
+
+	stream := admin.NewMockReplicationMessagesStream(s.controller)
+	sentCh := make(chan struct{})
+	closedCh := make(chan struct{})
+	s.frontendClient.EXPECT().StreamReplicationMessages(gomock.Any()).Return(stream, nil).Times(1)
+	stream.EXPECT().Send(&types.StreamReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token},
+		ClusterName: "active",
+	}).DoAndReturn(func(_ *types.StreamReplicationMessagesRequest) error {
+		close(sentCh)
+		return nil
+	}).Times(1)
+	gomock.InOrder(
+		stream.EXPECT().Recv().DoAndReturn(func() (*types.StreamReplicationMessagesResponse, error) {
+			<-sentCh
+			return &types.StreamReplicationMessagesResponse{
+				MessagesByShard: map[int32]*types.ReplicationMessages{0: messages},
+			}, nil
+		}).Times(1),
+		stream.EXPECT().Recv().DoAndReturn(func() (*types.StreamReplicationMessagesResponse, error) {
+			<-closedCh
+			return nil, io.EOF
+		}).Times(1),
+	)
+	stream.EXPECT().CloseSend().DoAndReturn(func() error {
+		close(closedCh)
+		return nil
+	}).Times(1)
+
+	s.taskFetcher.Start()
+
+	respChan := make(chan *types.ReplicationMessages, 1)
+	s.taskFetcher.GetRequestChan() <- &request{
+		token:    token,
+		respChan: respChan,
+	}
+	s.Equal(messages, <-respChan)
+	s.True(s.taskFetcher.IsStreaming())
+
+	s.taskFetcher.Stop()
+	<-closedCh
+}
+
+func (s *taskFetcherSuite) TestStreamTasks_FallbackToPolling() {
+	s.config.ReplicationTaskFetcherStreamingEnabled = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ReplicationTaskFetcherStreamRetryInterval = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	s.config.ReplicationTaskFetcherAggregationInterval = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	token := &types.ReplicationToken{
+		ShardID:                0,
+		LastProcessedMessageID: 1,
+		LastRetrievedMessageID: 2,
+	}
+	messages := &types.ReplicationMessages{LastRetrievedMessageID: 3}
+
+	s.frontendClient.EXPECT().StreamReplicationMessages(gomock.Any()).Return(nil, &types.BadRequestError{}).Times(1)
+	s.frontendClient.EXPECT().GetReplicationMessages(gomock.Any(), &types.GetReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token},
+		ClusterName: "active",
+	}).Return(&types.GetReplicationMessagesResponse{
+		MessagesByShard: map[int32]*types.ReplicationMessages{0: messages},
+	}, nil).Times(1)
+
+	s.taskFetcher.Start()
+	defer s.taskFetcher.Stop()
+
+	respChan := make(chan *types.ReplicationMessages, 1)
+	s.taskFetcher.GetRequestChan() <- &request{
+		token:    token,
+		respChan: respChan,
+	}
+	s.Equal(messages, <-respChan)
+	s.False(s.taskFetcher.IsStreaming())
+}
@@ -57,6 +57,9 @@ const (
 	dlqErrorRetryWait              = time.Second
 	dlqMetricsEmitTimerInterval    = 5 * time.Minute
 	dlqMetricsEmitTimerCoefficient = 0.05
+
+	replicationModePolling   = "polling"
+	replicationModeStreaming = "streaming"
 )
 
 var (
@@ -82,6 +85,7 @@ type (
 		metricsClient     metrics.Client
 		logger            log.Logger
 		taskExecutor      TaskExecutor
+		taskFetcher       TaskFetcher
 		hostRateLimiter   *quotas.DynamicRateLimiter
 		shardRateLimiter  *quotas.DynamicRateLimiter
 
@@ -140,6 +144,7 @@ func NewTaskProcessor(
 		metricsClient:     metricsClient,
 		logger:            shard.GetLogger(),
 		taskExecutor:      taskExecutor,
+		taskFetcher:       taskFetcher,
 		hostRateLimiter:   taskFetcher.GetRateLimiter(),
 		shardRateLimiter: quotas.NewDynamicRateLimiter(func() float64 {
 			return config.ReplicationTaskProcessorShardQPS()
@@ -306,7 +311,11 @@ func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages)
 	default:
 	}
 
-	scope := p.metricsClient.Scope(metrics.ReplicationTaskFetcherScope, metrics.TargetClusterTag(p.sourceCluster))
+	scope := p.metricsClient.Scope(
+		metrics.ReplicationTaskFetcherScope,
+		metrics.TargetClusterTag(p.sourceCluster),
+		p.replicationModeTag(),
+	)
 	batchRequestStartTime := time.Now()
 	ctx := context.Background()
 	for _, replicationTask := range response.ReplicationTasks {
@@ -324,8 +333,11 @@ func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages)
 	// we will receive replication tasks but hasMore is false (meaning that we are always catching up).
 	// So hasMore might not be a good indicator for additional wait.
 	if len(response.ReplicationTasks) == 0 {
-		backoffDuration := p.noTaskRetrier.NextBackOff()
-		time.Sleep(backoffDuration)
+		// a streamed response without tasks is only sent after the source cluster waited for new tasks
+		if !p.taskFetcher.IsStreaming() {
+			backoffDuration := p.noTaskRetrier.NextBackOff()
+			time.Sleep(backoffDuration)
+		}
 	} else {
 		scope.RecordTimer(metrics.ReplicationTasksAppliedLatency, time.Now().Sub(batchRequestStartTime))
 	}
@@ -453,7 +465,7 @@ func (p *taskProcessorImpl) processTaskOnce(replicationTask *types.ReplicationTa
 		p.updateFailureMetric(scope, err)
 	} else {
 		now := ts.Now()
-		mScope := p.metricsClient.Scope(scope, metrics.TargetClusterTag(p.sourceCluster))
+		mScope := p.metricsClient.Scope(scope, metrics.TargetClusterTag(p.sourceCluster), p.replicationModeTag())
 		// emit the number of replication tasks
 		mScope.IncCounter(metrics.ReplicationTasksApplied)
 		// emit single task processing latency
@@ -468,6 +480,14 @@ func (p *taskProcessorImpl) processTaskOnce(replicationTask *types.ReplicationTa
 	return err
 }
 
+// replicationModeTag tags the replication latency metrics, so lag is comparable between polling and streaming
+func (p *taskProcessorImpl) replicationModeTag() metrics.Tag {
+	if p.taskFetcher.IsStreaming() {
+		return metrics.ReplicationModeTag(replicationModeStreaming)
+	}
+	return metrics.ReplicationModeTag(replicationModePolling)
+}
+
 func (p *taskProcessorImpl) putReplicationTaskToDLQ(replicationTask *types.ReplicationTask) error {
 	request, err := p.generateDLQRequest(replicationTask)
 	if err != nil {
@@ -521,6 +541,8 @@ func (p *taskProcessorImpl) generateDLQRequest(
 				TaskID:      replicationTask.GetSourceTaskID(),
 				TaskType:    persistence.ReplicationTaskTypeSyncActivity,
 				ScheduledID: taskAttributes.GetScheduledID(),
+				// the time the task is put into DLQ, which redrive backoff and DLQ age are based on
+				CreationTime: p.shard.GetTimeSource().Now().UnixNano(),
 			},
 		}, nil
 
@@ -548,6 +570,7 @@ func (p *taskProcessorImpl) generateDLQRequest(
 				FirstEventID: events[0].GetEventID(),
 				NextEventID:  events[len(events)-1].GetEventID() + 1,
 				Version:      events[0].GetVersion(),
+				CreationTime: p.shard.GetTimeSource().Now().UnixNano(),
 			},
 		}, nil
 	default:
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
@@ -102,6 +103,7 @@ func (s *taskProcessorSuite) SetupTest() {
 		s.config,
 	)
 
+	s.mockShard.Resource.TimeSource = clock.NewEventTimeSource().Update(time.Now())
 	s.mockDomainCache = s.mockShard.Resource.DomainCache
 	s.mockClientBean = s.mockShard.Resource.ClientBean
 	s.mockFrontendClient = s.mockShard.Resource.RemoteFrontendClient
@@ -123,6 +125,7 @@ func (s *taskProcessorSuite) SetupTest() {
 	s.taskFetcher.EXPECT().GetSourceCluster().Return("standby").AnyTimes()
 	s.taskFetcher.EXPECT().GetRequestChan().Return(s.requestChan).AnyTimes()
 	s.taskFetcher.EXPECT().GetRateLimiter().Return(rateLimiter).AnyTimes()
+	s.taskFetcher.EXPECT().IsStreaming().Return(false).AnyTimes()
 	s.clusterMetadata.EXPECT().GetCurrentClusterName().Return("active").AnyTimes()
 
 	s.taskProcessor = NewTaskProcessor(
@@ -188,10 +191,11 @@ func (s *taskProcessorSuite) TestPutReplicationTaskToDLQ_SyncActivityReplication
 	request := &persistence.PutReplicationTaskToDLQRequest{
 		SourceClusterName: "standby",
 		TaskInfo: &persistence.ReplicationTaskInfo{
-			DomainID:   domainID,
-			WorkflowID: workflowID,
-			RunID:      runID,
-			TaskType:   persistence.ReplicationTaskTypeSyncActivity,
+			DomainID:     domainID,
+			WorkflowID:   workflowID,
+			RunID:        runID,
+			TaskType:     persistence.ReplicationTaskTypeSyncActivity,
+			CreationTime: s.mockShard.GetTimeSource().Now().UnixNano(),
 		},
 	}
 	s.executionManager.On("PutReplicationTaskToDLQ", mock.Anything, request).Return(nil)
@@ -234,6 +238,7 @@ func (s *taskProcessorSuite) TestPutReplicationTaskToDLQ_HistoryV2ReplicationTas
 			FirstEventID: 1,
 			NextEventID:  2,
 			Version:      1,
+			CreationTime: s.mockShard.GetTimeSource().Now().UnixNano(),
 		},
 	}
 	s.executionManager.On("PutReplicationTaskToDLQ", mock.Anything, request).Return(nil)
@@ -25,6 +25,9 @@ package reset
 import (
 	"context"
 	"fmt"
+	"sort"
+
+	"github.com/pborman/uuid"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
@@ -58,6 +61,18 @@ type (
 			additionalReapplyEvents []*types.HistoryEvent,
 			skipSignalReapply bool,
 		) error
+		PreviewResetWorkflow(
+			ctx context.Context,
+			domainID string,
+			workflowID string,
+			baseRunID string,
+			baseBranchToken []byte,
+			baseRebuildLastEventID int64,
+			baseRebuildLastEventVersion int64,
+			baseNextEventID int64,
+			currentWorkflow execution.Workflow,
+			skipSignalReapply bool,
+		) (*types.ResetWorkflowExecutionPreview, error)
 	}
 
 	workflowResetterImpl struct {
@@ -118,6 +133,11 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	resetWorkflowVersion := domainEntry.GetFailoverVersion()
 
 	currentMutableState := currentWorkflow.GetMutableState()
+	if domainEntry.IsActiveActive() {
+		if resetWorkflowVersion, err = r.getActiveActiveResetVersion(domainEntry, currentMutableState); err != nil {
+			return err
+		}
+	}
 	currentWorkflowTerminated := false
 	if currentMutableState.IsWorkflowExecutionRunning() {
 		if err := r.terminateWorkflow(
@@ -159,6 +179,117 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	)
 }
 
+// PreviewResetWorkflow runs the same steps as ResetWorkflow against an in-memory copy of the
+// base workflow and reports what the reset would do, without forking history, terminating
+// the current run or persisting anything.
+func (r *workflowResetterImpl) PreviewResetWorkflow(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	baseRunID string,
+	baseBranchToken []byte,
+	baseRebuildLastEventID int64,
+	baseRebuildLastEventVersion int64,
+	baseNextEventID int64,
+	currentWorkflow execution.Workflow,
+	skipSignalReapply bool,
+) (*types.ResetWorkflowExecutionPreview, error) {
+
+	domainEntry, err := r.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+	resetWorkflowVersion := domainEntry.GetFailoverVersion()
+	if domainEntry.IsActiveActive() {
+		if resetWorkflowVersion, err = r.getActiveActiveResetVersion(domainEntry, currentWorkflow.GetMutableState()); err != nil {
+			return nil, err
+		}
+	}
+	if currentMutableState := currentWorkflow.GetMutableState(); currentMutableState.IsWorkflowExecutionRunning() {
+		resetWorkflowVersion = currentMutableState.GetCurrentVersion()
+	}
+
+	// the preview is rebuilt directly on top of the base branch, the placeholder run ID is
+	// never persisted and only used to identify the in-memory mutable state
+	previewRunID := uuid.New()
+	previewWorkflow, err := r.rebuildResetWorkflow(
+		ctx,
+		domainID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		previewRunID,
+		baseBranchToken,
+		previewRunID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	reappliedSignals, err := r.applyReset(
+		ctx,
+		previewWorkflow,
+		domainID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseNextEventID,
+		previewRunID,
+		resetWorkflowVersion,
+		"",
+		nil,
+		skipSignalReapply,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	discardedEvents, err := r.getEvents(ctx, baseRebuildLastEventID+1, baseNextEventID, baseBranchToken)
+	if err != nil {
+		return nil, err
+	}
+
+	previewMutableState := previewWorkflow.GetMutableState()
+	pendingActivities, err := r.getPendingActivities(ctx, previewMutableState)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &types.ResetWorkflowExecutionPreview{
+		DiscardedEvents:        discardedEvents,
+		ReappliedSignals:       reappliedSignals,
+		PendingActivities:      pendingActivities,
+		PendingTimers:          r.getPendingTimers(previewMutableState),
+		OrphanedChildWorkflows: r.getOrphanedChildWorkflows(discardedEvents),
+	}
+	// nothing is discarded when the reset point is the next event ID of the base run,
+	// the preview then has no reset point event
+	if len(discardedEvents) > 0 {
+		preview.ResetPointEvent = discardedEvents[0]
+	}
+	return preview, nil
+}
+
+// getActiveActiveResetVersion returns the version of the current cluster a reset run of an active-active
+// domain workflow is created with, which is no smaller than the last write version of the current run
+func (r *workflowResetterImpl) getActiveActiveResetVersion(
+	domainEntry *cache.DomainCacheEntry,
+	currentMutableState execution.MutableState,
+) (int64, error) {
+
+	lastWriteVersion, err := currentMutableState.GetLastWriteVersion()
+	if err != nil {
+		return 0, err
+	}
+	return r.clusterMetadata.GetNextFailoverVersion(
+		r.clusterMetadata.GetCurrentClusterName(),
+		common.MaxInt64(domainEntry.GetFailoverVersion(), lastWriteVersion),
+	), nil
+}
+
 func (r *workflowResetterImpl) prepareResetWorkflow(
 	ctx context.Context,
 	domainID string,
@@ -191,6 +322,45 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
+	if _, err := r.applyReset(
+		ctx,
+		resetWorkflow,
+		domainID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseNextEventID,
+		resetRunID,
+		resetWorkflowVersion,
+		resetReason,
+		additionalReapplyEvents,
+		skipSignalReapply,
+	); err != nil {
+		return nil, err
+	}
+
+	return resetWorkflow, nil
+}
+
+// applyReset closes the pending decision and inflight activities of the rebuilt workflow,
+// reapplies signals and schedules a new decision, returning the signals reapplied from history
+func (r *workflowResetterImpl) applyReset(
+	ctx context.Context,
+	resetWorkflow execution.Workflow,
+	domainID string,
+	workflowID string,
+	baseRunID string,
+	baseBranchToken []byte,
+	baseRebuildLastEventID int64,
+	baseNextEventID int64,
+	resetRunID string,
+	resetWorkflowVersion int64,
+	resetReason string,
+	additionalReapplyEvents []*types.HistoryEvent,
+	skipSignalReapply bool,
+) ([]*types.HistoryEvent, error) {
+
 	resetMutableState := resetWorkflow.GetMutableState()
 
 	baseLastEventVersion := resetMutableState.GetCurrentVersion()
@@ -206,7 +376,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
-	resetMutableState, err = r.closePendingDecisionTask(
+	resetMutableState, err := r.closePendingDecisionTask(
 		resetMutableState,
 		baseRunID,
 		resetRunID,
@@ -221,11 +391,12 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
+	var reappliedEvents []*types.HistoryEvent
 	// TODO right now only signals are eligible for reapply, so we can directly skip the whole reapply process
 	// for the sake of performance. In the future, if there are other events that need to be reapplied, remove this check
 	// For example, we may want to re-apply activity/timer results for https://github.com/uber/cadence/issues/2934
 	if !skipSignalReapply {
-		if err := r.reapplyResetAndContinueAsNewWorkflowEvents(
+		reappliedEvents, err = r.reapplyResetAndContinueAsNewWorkflowEvents(
 			ctx,
 			resetMutableState,
 			domainID,
@@ -234,14 +405,15 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 			baseBranchToken,
 			baseRebuildLastEventID+1,
 			baseNextEventID,
-		); err != nil {
+		)
+		if err != nil {
 			return nil, err
 		}
 
 	}
 
 	// NOTE: this is reapplying events that are passing into the API that we shouldn't skip
-	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents); err != nil {
+	if _, err := r.reapplyEvents(resetMutableState, additionalReapplyEvents); err != nil {
 		return nil, err
 	}
 
@@ -249,7 +421,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
-	return resetWorkflow, nil
+	return reappliedEvents, nil
 }
 
 func (r *workflowResetterImpl) persistToDB(
@@ -331,6 +503,33 @@ func (r *workflowResetterImpl) replayResetWorkflow(
 		return nil, err
 	}
 
+	return r.rebuildResetWorkflow(
+		ctx,
+		domainID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		resetRunID,
+		resetBranchToken,
+		resetRequestID,
+	)
+}
+
+func (r *workflowResetterImpl) rebuildResetWorkflow(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	baseRunID string,
+	baseBranchToken []byte,
+	baseRebuildLastEventID int64,
+	baseRebuildLastEventVersion int64,
+	resetRunID string,
+	resetBranchToken []byte,
+	resetRequestID string,
+) (execution.Workflow, error) {
+
 	resetContext := execution.NewContext(
 		domainID,
 		types.WorkflowExecution{
@@ -454,23 +653,21 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 	baseBranchToken []byte,
 	baseRebuildNextEventID int64,
 	baseNextEventID int64,
-) error {
+) ([]*types.HistoryEvent, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
 	//  from visibility for better coverage of events eligible for re-application.
 
-	var nextRunID string
-	var err error
-
 	// first special handling the remaining events for base workflow
-	if nextRunID, err = r.reapplyWorkflowEvents(
+	nextRunID, reappliedEvents, err := r.reapplyWorkflowEvents(
 		ctx,
 		resetMutableState,
 		baseRebuildNextEventID,
 		baseNextEventID,
 		baseBranchToken,
-	); err != nil {
-		return err
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	getNextEventIDBranchToken := func(runID string) (nextEventID int64, branchToken []byte, retError error) {
@@ -505,20 +702,22 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 	for len(nextRunID) != 0 {
 		nextWorkflowNextEventID, nextWorkflowBranchToken, err := getNextEventIDBranchToken(nextRunID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if nextRunID, err = r.reapplyWorkflowEvents(
+		var events []*types.HistoryEvent
+		if nextRunID, events, err = r.reapplyWorkflowEvents(
 			ctx,
 			resetMutableState,
 			common.FirstEventID,
 			nextWorkflowNextEventID,
 			nextWorkflowBranchToken,
 		); err != nil {
-			return err
+			return nil, err
 		}
+		reappliedEvents = append(reappliedEvents, events...)
 	}
-	return nil
+	return reappliedEvents, nil
 }
 
 func (r *workflowResetterImpl) reapplyWorkflowEvents(
@@ -527,7 +726,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 	firstEventID int64,
 	nextEventID int64,
 	branchToken []byte,
-) (string, error) {
+) (string, []*types.HistoryEvent, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
 	//  from visibility for better coverage of events eligible for re-application.
@@ -536,7 +735,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 	if firstEventID == nextEventID {
 		// This means the workflow reset to a pending decision task
 		// and the decision task is the latest event in the workflow.
-		return "", nil
+		return "", nil, nil
 	}
 
 	iter := collection.NewPagingIterator(r.getPaginationFn(
@@ -548,16 +747,19 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 
 	var nextRunID string
 	var lastEvents []*types.HistoryEvent
+	var reappliedEvents []*types.HistoryEvent
 
 	for iter.HasNext() {
 		batch, err := iter.Next()
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		lastEvents = batch.(*types.History).Events
-		if err := r.reapplyEvents(mutableState, lastEvents); err != nil {
-			return "", err
+		events, err := r.reapplyEvents(mutableState, lastEvents)
+		if err != nil {
+			return "", nil, err
 		}
+		reappliedEvents = append(reappliedEvents, events...)
 	}
 
 	if len(lastEvents) > 0 {
@@ -566,14 +768,15 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 			nextRunID = lastEvent.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunID()
 		}
 	}
-	return nextRunID, nil
+	return nextRunID, reappliedEvents, nil
 }
 
 func (r *workflowResetterImpl) reapplyEvents(
 	mutableState execution.MutableState,
 	events []*types.HistoryEvent,
-) error {
+) ([]*types.HistoryEvent, error) {
 
+	var reappliedEvents []*types.HistoryEvent
 	for _, event := range events {
 		switch event.GetEventType() {
 		case types.EventTypeWorkflowExecutionSignaled:
@@ -583,13 +786,131 @@ func (r *workflowResetterImpl) reapplyEvents(
 				attr.GetInput(),
 				attr.GetIdentity(),
 			); err != nil {
-				return err
+				return nil, err
 			}
+			reappliedEvents = append(reappliedEvents, event)
 		default:
 			// events other than signal will be ignored
 		}
 	}
-	return nil
+	return reappliedEvents, nil
+}
+
+func (r *workflowResetterImpl) getEvents(
+	ctx context.Context,
+	firstEventID int64,
+	nextEventID int64,
+	branchToken []byte,
+) ([]*types.HistoryEvent, error) {
+
+	if firstEventID == nextEventID {
+		return nil, nil
+	}
+
+	iter := collection.NewPagingIterator(r.getPaginationFn(
+		ctx,
+		firstEventID,
+		nextEventID,
+		branchToken,
+	))
+
+	var events []*types.HistoryEvent
+	for iter.HasNext() {
+		batch, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, batch.(*types.History).Events...)
+	}
+	return events, nil
+}
+
+func (r *workflowResetterImpl) getPendingActivities(
+	ctx context.Context,
+	mutableState execution.MutableState,
+) ([]*types.PendingActivityInfo, error) {
+
+	activityInfos := make([]*persistence.ActivityInfo, 0, len(mutableState.GetPendingActivityInfos()))
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		activityInfos = append(activityInfos, ai)
+	}
+	sort.Slice(activityInfos, func(i, j int) bool {
+		return activityInfos[i].ScheduleID < activityInfos[j].ScheduleID
+	})
+
+	var pendingActivities []*types.PendingActivityInfo
+	for _, ai := range activityInfos {
+		scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, ai.ScheduleID)
+		if err != nil {
+			return nil, err
+		}
+		// inflight activities are failed by the reset, so whatever is left is only scheduled
+		pendingActivities = append(pendingActivities, &types.PendingActivityInfo{
+			ActivityID:         ai.ActivityID,
+			ActivityType:       scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType,
+			State:              types.PendingActivityStateScheduled.Ptr(),
+			ScheduledTimestamp: common.Int64Ptr(ai.ScheduledTime.UnixNano()),
+			Attempt:            ai.Attempt,
+			MaximumAttempts:    ai.MaximumAttempts,
+		})
+	}
+	return pendingActivities, nil
+}
+
+func (r *workflowResetterImpl) getPendingTimers(
+	mutableState execution.MutableState,
+) []*types.PendingTimerInfo {
+
+	var pendingTimers []*types.PendingTimerInfo
+	for _, ti := range mutableState.GetPendingTimerInfos() {
+		pendingTimers = append(pendingTimers, &types.PendingTimerInfo{
+			TimerID:         ti.TimerID,
+			StartedEventID:  ti.StartedID,
+			ExpiryTimestamp: common.Int64Ptr(ti.ExpiryTime.UnixNano()),
+		})
+	}
+	sort.Slice(pendingTimers, func(i, j int) bool {
+		return pendingTimers[i].StartedEventID < pendingTimers[j].StartedEventID
+	})
+	return pendingTimers
+}
+
+// getOrphanedChildWorkflows returns the child workflows started by the discarded events
+// which are not closed by them either, the reset workflow will have no record of these children
+func (r *workflowResetterImpl) getOrphanedChildWorkflows(
+	discardedEvents []*types.HistoryEvent,
+) []*types.WorkflowExecution {
+
+	var initiatedIDs []int64
+	startedChildren := make(map[int64]*types.WorkflowExecution)
+	for _, event := range discardedEvents {
+		switch event.GetEventType() {
+		case types.EventTypeChildWorkflowExecutionStarted:
+			attr := event.GetChildWorkflowExecutionStartedEventAttributes()
+			initiatedIDs = append(initiatedIDs, attr.GetInitiatedEventID())
+			startedChildren[attr.GetInitiatedEventID()] = attr.WorkflowExecution
+		case types.EventTypeChildWorkflowExecutionCompleted:
+			delete(startedChildren, event.GetChildWorkflowExecutionCompletedEventAttributes().GetInitiatedEventID())
+		case types.EventTypeChildWorkflowExecutionFailed:
+			delete(startedChildren, event.GetChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventID())
+		case types.EventTypeChildWorkflowExecutionCanceled:
+			delete(startedChildren, event.GetChildWorkflowExecutionCanceledEventAttributes().GetInitiatedEventID())
+		case types.EventTypeChildWorkflowExecutionTimedOut:
+			delete(startedChildren, event.GetChildWorkflowExecutionTimedOutEventAttributes().GetInitiatedEventID())
+		case types.EventTypeChildWorkflowExecutionTerminated:
+			delete(startedChildren, event.GetChildWorkflowExecutionTerminatedEventAttributes().GetInitiatedEventID())
+		default:
+			// other events do not change the set of running children
+		}
+	}
+
+	var orphanedChildren []*types.WorkflowExecution
+	for _, initiatedID := range initiatedIDs {
+		if child, ok := startedChildren[initiatedID]; ok {
+			orphanedChildren = append(orphanedChildren, child)
+		}
+	}
+	return orphanedChildren
 }
 
 func (r *workflowResetterImpl) getPaginationFn(
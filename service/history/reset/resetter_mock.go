@@ -72,3 +72,18 @@ func (mr *MockWorkflowResetterMockRecorder) ResetWorkflow(ctx, domainID, workflo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflow", reflect.TypeOf((*MockWorkflowResetter)(nil).ResetWorkflow), ctx, domainID, workflowID, baseRunID, baseBranchToken, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID, resetRunID, resetRequestID, currentWorkflow, resetReason, additionalReapplyEvents, skipSignalReapply)
 }
+
+// PreviewResetWorkflow mocks base method
+func (m *MockWorkflowResetter) PreviewResetWorkflow(ctx context.Context, domainID, workflowID, baseRunID string, baseBranchToken []byte, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID int64, currentWorkflow execution.Workflow, skipSignalReapply bool) (*types.ResetWorkflowExecutionPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewResetWorkflow", ctx, domainID, workflowID, baseRunID, baseBranchToken, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID, currentWorkflow, skipSignalReapply)
+	ret0, _ := ret[0].(*types.ResetWorkflowExecutionPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewResetWorkflow indicates an expected call of PreviewResetWorkflow
+func (mr *MockWorkflowResetterMockRecorder) PreviewResetWorkflow(ctx, domainID, workflowID, baseRunID, baseBranchToken, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID, currentWorkflow, skipSignalReapply interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewResetWorkflow", reflect.TypeOf((*MockWorkflowResetter)(nil).PreviewResetWorkflow), ctx, domainID, workflowID, baseRunID, baseBranchToken, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID, currentWorkflow, skipSignalReapply)
+}
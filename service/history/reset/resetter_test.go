@@ -23,6 +23,7 @@ package reset
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -265,6 +266,173 @@ func (s *workflowResetterSuite) TestReplayResetWorkflow() {
 	s.Equal(resetMutableState, resetWorkflow.GetMutableState())
 }
 
+func (s *workflowResetterSuite) TestPreviewResetWorkflow() {
+	ctx := context.Background()
+	baseBranchToken := []byte("some random base branch token")
+	baseRebuildLastEventID := int64(3)
+	baseRebuildLastEventVersion := int64(12)
+	baseNextEventID := int64(7)
+	resetWorkflowVersion := constants.TestVersion
+	timerExpiry := time.Unix(0, 1234)
+
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainByID(s.domainID).Return(constants.TestGlobalDomainEntry, nil).AnyTimes()
+
+	// the current run is only read for its version, never terminated
+	currentMutableState := execution.NewMockMutableState(s.controller)
+	currentMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).Times(1)
+	currentMutableState.EXPECT().GetCurrentVersion().Return(resetWorkflowVersion).Times(1)
+	currentWorkflow := execution.NewMockWorkflow(s.controller)
+	currentWorkflow.EXPECT().GetMutableState().Return(currentMutableState).AnyTimes()
+
+	// the preview is rebuilt on top of the base branch without forking it
+	resetMutableState := execution.NewMockMutableState(s.controller)
+	s.mockStateRebuilder.EXPECT().Rebuild(
+		ctx,
+		gomock.Any(),
+		definition.NewWorkflowIdentifier(
+			s.domainID,
+			s.workflowID,
+			s.baseRunID,
+		),
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		gomock.Any(),
+		baseBranchToken,
+		gomock.Any(),
+	).Return(resetMutableState, int64(0), nil).Times(1)
+
+	resetMutableState.EXPECT().GetCurrentVersion().Return(baseRebuildLastEventVersion).Times(1)
+	resetMutableState.EXPECT().UpdateCurrentVersion(resetWorkflowVersion, false).Return(nil).Times(1)
+	resetMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{}).Times(1)
+	resetMutableState.EXPECT().GetInFlightDecision().Return(nil, false).Times(1)
+	resetMutableState.EXPECT().GetPendingDecision().Return(nil, false).Times(1)
+	resetMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{}).AnyTimes()
+	resetMutableState.EXPECT().HasPendingDecision().Return(false).Times(1)
+	resetMutableState.EXPECT().AddDecisionTaskScheduledEvent(false).Return(&execution.DecisionInfo{}, nil).Times(1)
+	resetMutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistence.TimerInfo{
+		"some random timer ID": {TimerID: "some random timer ID", StartedID: 2, ExpiryTime: timerExpiry},
+	}).Times(1)
+
+	childExecution := &types.WorkflowExecution{WorkflowID: "some random child workflow ID", RunID: uuid.New()}
+	resetPointEvent := &types.HistoryEvent{
+		EventID:                              4,
+		EventType:                            types.EventTypeDecisionTaskCompleted.Ptr(),
+		DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{},
+	}
+	discardedEvents := []*types.HistoryEvent{
+		resetPointEvent,
+		{
+			EventID:   5,
+			EventType: types.EventTypeStartChildWorkflowExecutionInitiated.Ptr(),
+			StartChildWorkflowExecutionInitiatedEventAttributes: &types.StartChildWorkflowExecutionInitiatedEventAttributes{},
+		},
+		{
+			EventID:   6,
+			EventType: types.EventTypeChildWorkflowExecutionStarted.Ptr(),
+			ChildWorkflowExecutionStartedEventAttributes: &types.ChildWorkflowExecutionStartedEventAttributes{
+				InitiatedEventID:  5,
+				WorkflowExecution: childExecution,
+			},
+		},
+	}
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, &persistence.ReadHistoryBranchRequest{
+		BranchToken:   baseBranchToken,
+		MinEventID:    baseRebuildLastEventID + 1,
+		MaxEventID:    baseNextEventID,
+		PageSize:      execution.NDCDefaultPageSize,
+		NextPageToken: nil,
+		ShardID:       common.IntPtr(s.mockShard.GetShardID()),
+	}).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History:       []*types.History{{Events: discardedEvents}},
+		NextPageToken: nil,
+	}, nil).Once()
+
+	preview, err := s.workflowResetter.PreviewResetWorkflow(
+		ctx,
+		s.domainID,
+		s.workflowID,
+		s.baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		baseNextEventID,
+		currentWorkflow,
+		true,
+	)
+	s.NoError(err)
+	s.Equal(&types.ResetWorkflowExecutionPreview{
+		ResetPointEvent: resetPointEvent,
+		DiscardedEvents: discardedEvents,
+		PendingTimers: []*types.PendingTimerInfo{
+			{TimerID: "some random timer ID", StartedEventID: 2, ExpiryTimestamp: common.Int64Ptr(timerExpiry.UnixNano())},
+		},
+		OrphanedChildWorkflows: []*types.WorkflowExecution{childExecution},
+	}, preview)
+}
+
+func (s *workflowResetterSuite) TestPreviewResetWorkflow_NothingDiscarded() {
+	ctx := context.Background()
+	baseBranchToken := []byte("some random base branch token")
+	baseRebuildLastEventID := int64(6)
+	baseRebuildLastEventVersion := int64(12)
+	baseNextEventID := baseRebuildLastEventID + 1
+	resetWorkflowVersion := constants.TestVersion
+
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainByID(s.domainID).Return(constants.TestGlobalDomainEntry, nil).AnyTimes()
+
+	currentMutableState := execution.NewMockMutableState(s.controller)
+	currentMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).Times(1)
+	currentMutableState.EXPECT().GetCurrentVersion().Return(resetWorkflowVersion).Times(1)
+	currentWorkflow := execution.NewMockWorkflow(s.controller)
+	currentWorkflow.EXPECT().GetMutableState().Return(currentMutableState).AnyTimes()
+
+	resetMutableState := execution.NewMockMutableState(s.controller)
+	s.mockStateRebuilder.EXPECT().Rebuild(
+		ctx,
+		gomock.Any(),
+		definition.NewWorkflowIdentifier(
+			s.domainID,
+			s.workflowID,
+			s.baseRunID,
+		),
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		gomock.Any(),
+		baseBranchToken,
+		gomock.Any(),
+	).Return(resetMutableState, int64(0), nil).Times(1)
+
+	resetMutableState.EXPECT().GetCurrentVersion().Return(baseRebuildLastEventVersion).Times(1)
+	resetMutableState.EXPECT().UpdateCurrentVersion(resetWorkflowVersion, false).Return(nil).Times(1)
+	resetMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{}).Times(1)
+	resetMutableState.EXPECT().GetInFlightDecision().Return(nil, false).Times(1)
+	resetMutableState.EXPECT().GetPendingDecision().Return(nil, false).Times(1)
+	resetMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{}).AnyTimes()
+	resetMutableState.EXPECT().HasPendingDecision().Return(false).Times(1)
+	resetMutableState.EXPECT().AddDecisionTaskScheduledEvent(false).Return(&execution.DecisionInfo{}, nil).Times(1)
+	resetMutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistence.TimerInfo{}).Times(1)
+
+	// no history is read since there is no event after the reset point
+	preview, err := s.workflowResetter.PreviewResetWorkflow(
+		ctx,
+		s.domainID,
+		s.workflowID,
+		s.baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		baseNextEventID,
+		currentWorkflow,
+		true,
+	)
+	s.NoError(err)
+	s.Nil(preview.ResetPointEvent)
+	s.Empty(preview.DiscardedEvents)
+	s.Empty(preview.OrphanedChildWorkflows)
+}
+
 func (s *workflowResetterSuite) TestFailInflightActivity() {
 	terminateReason := "some random termination reason"
 
@@ -456,7 +624,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents() {
 
 	mutableState := execution.NewMockMutableState(s.controller)
 
-	err := s.workflowResetter.reapplyResetAndContinueAsNewWorkflowEvents(
+	reappliedEvents, err := s.workflowResetter.reapplyResetAndContinueAsNewWorkflowEvents(
 		ctx,
 		mutableState,
 		s.domainID,
@@ -467,6 +635,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents() {
 		baseNextEventID,
 	)
 	s.NoError(err)
+	s.Empty(reappliedEvents)
 }
 
 func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
@@ -517,7 +686,7 @@ func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 
 	mutableState := execution.NewMockMutableState(s.controller)
 
-	nextRunID, err := s.workflowResetter.reapplyWorkflowEvents(
+	nextRunID, reappliedEvents, err := s.workflowResetter.reapplyWorkflowEvents(
 		context.Background(),
 		mutableState,
 		firstEventID,
@@ -526,6 +695,7 @@ func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 	)
 	s.NoError(err)
 	s.Equal(newRunID, nextRunID)
+	s.Empty(reappliedEvents)
 }
 
 func (s *workflowResetterSuite) TestClosePendingDecisionTask() {
@@ -626,8 +796,43 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 		}
 	}
 
-	err := s.workflowResetter.reapplyEvents(mutableState, events)
+	reappliedEvents, err := s.workflowResetter.reapplyEvents(mutableState, events)
 	s.NoError(err)
+	s.Equal([]*types.HistoryEvent{event1, event3}, reappliedEvents)
+}
+
+func (s *workflowResetterSuite) TestGetOrphanedChildWorkflows() {
+	completedChild := &types.WorkflowExecution{WorkflowID: "completed child", RunID: uuid.New()}
+	runningChild := &types.WorkflowExecution{WorkflowID: "running child", RunID: uuid.New()}
+	events := []*types.HistoryEvent{
+		{
+			EventID:   101,
+			EventType: types.EventTypeChildWorkflowExecutionStarted.Ptr(),
+			ChildWorkflowExecutionStartedEventAttributes: &types.ChildWorkflowExecutionStartedEventAttributes{
+				InitiatedEventID:  99,
+				WorkflowExecution: completedChild,
+			},
+		},
+		{
+			EventID:   102,
+			EventType: types.EventTypeChildWorkflowExecutionStarted.Ptr(),
+			ChildWorkflowExecutionStartedEventAttributes: &types.ChildWorkflowExecutionStartedEventAttributes{
+				InitiatedEventID:  100,
+				WorkflowExecution: runningChild,
+			},
+		},
+		{
+			EventID:   103,
+			EventType: types.EventTypeChildWorkflowExecutionCompleted.Ptr(),
+			ChildWorkflowExecutionCompletedEventAttributes: &types.ChildWorkflowExecutionCompletedEventAttributes{
+				InitiatedEventID:  99,
+				WorkflowExecution: completedChild,
+			},
+		},
+	}
+
+	s.Equal([]*types.WorkflowExecution{runningChild}, s.workflowResetter.getOrphanedChildWorkflows(events))
+	s.Empty(s.workflowResetter.getOrphanedChildWorkflows(nil))
 }
 
 func (s *workflowResetterSuite) TestPagination() {
@@ -60,6 +60,7 @@ type (
 		GetThrottledLogger() log.Logger
 		GetMetricsClient() metrics.Client
 		GetTimeSource() clock.TimeSource
+		GetLoadReporter() LoadReporter
 		PreviousShardOwnerWasDifferent() bool
 
 		GetEngine() engine.Engine
@@ -107,6 +108,9 @@ type (
 		GetDomainNotificationVersion() int64
 		UpdateDomainNotificationVersion(domainNotificationVersion int64) error
 
+		GetDomainUsage(domainID string) *types.DomainUsage
+		AddDomainUsage(domainID string, delta *types.DomainUsage)
+
 		CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error)
 		UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error)
 		ConflictResolveWorkflowExecution(ctx context.Context, request *persistence.ConflictResolveWorkflowExecutionRequest) (*persistence.ConflictResolveWorkflowExecutionResponse, error)
@@ -149,6 +153,7 @@ type (
 		logger           log.Logger
 		throttledLogger  log.Logger
 		engine           engine.Engine
+		loadReporter     LoadReporter
 
 		sync.RWMutex
 		lastUpdated               time.Time
@@ -563,6 +568,46 @@ func (s *contextImpl) UpdateDomainNotificationVersion(domainNotificationVersion
 	return s.updateShardInfoLocked()
 }
 
+func (s *contextImpl) GetDomainUsage(domainID string) *types.DomainUsage {
+	s.RLock()
+	defer s.RUnlock()
+
+	usage := &types.DomainUsage{}
+	if current, ok := s.shardInfo.DomainUsages.GetUsagesByDomainID()[domainID]; ok {
+		*usage = *current
+	}
+	return usage
+}
+
+// AddDomainUsage applies the delta to the usage counters of the domain. The counters are only updated
+// in memory and persisted along with the next shard info update, so the latest changes may be lost
+// when the shard is moved. Counters never go below zero, as workflows created before the counters
+// existed may still be deleted.
+func (s *contextImpl) AddDomainUsage(domainID string, delta *types.DomainUsage) {
+	if *delta == (types.DomainUsage{}) {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.DomainUsages == nil {
+		s.shardInfo.DomainUsages = &types.DomainUsages{}
+	}
+	if s.shardInfo.DomainUsages.UsagesByDomainID == nil {
+		s.shardInfo.DomainUsages.UsagesByDomainID = make(map[string]*types.DomainUsage)
+	}
+	usage, ok := s.shardInfo.DomainUsages.UsagesByDomainID[domainID]
+	if !ok {
+		usage = &types.DomainUsage{}
+		s.shardInfo.DomainUsages.UsagesByDomainID[domainID] = usage
+	}
+	usage.HistorySize = common.MaxInt64(0, usage.HistorySize+delta.GetHistorySize())
+	usage.HistoryEventCount = common.MaxInt64(0, usage.HistoryEventCount+delta.GetHistoryEventCount())
+	usage.OpenWorkflowCount = common.MaxInt64(0, usage.OpenWorkflowCount+delta.GetOpenWorkflowCount())
+	usage.ClosedWorkflowCount = common.MaxInt64(0, usage.ClosedWorkflowCount+delta.GetClosedWorkflowCount())
+}
+
 func (s *contextImpl) GetTimerMaxReadLevel(cluster string) time.Time {
 	s.RLock()
 	defer s.RUnlock()
@@ -608,6 +653,9 @@ func (s *contextImpl) CreateWorkflowExecution(
 		return nil, err
 	}
 
+	request.EncryptionKeyID = s.getEncryptionKeyID(domainEntry.GetInfo().Name)
+	setPayloadEncryptionKeyID(&request.NewWorkflowSnapshot, request.EncryptionKeyID)
+
 	s.Lock()
 	defer s.Unlock()
 
@@ -693,6 +741,18 @@ func (s *contextImpl) getDefaultEncoding(domainName string) common.EncodingType
 	return common.EncodingType(s.config.EventEncodingType(domainName))
 }
 
+func (s *contextImpl) getEncryptionKeyID(domainName string) string {
+	return s.config.PayloadEncryptionKeyID(domainName)
+}
+
+// setPayloadEncryptionKeyID records in the execution whether its raw payloads are encrypted. A snapshot rewrites
+// all of them, so they are encrypted with the current key of the domain. Mutations keep what the execution recorded.
+func setPayloadEncryptionKeyID(snapshot *persistence.WorkflowSnapshot, encryptionKeyID string) {
+	if snapshot != nil && snapshot.ExecutionInfo != nil {
+		snapshot.ExecutionInfo.PayloadEncryptionKeyID = encryptionKeyID
+	}
+}
+
 func (s *contextImpl) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *persistence.UpdateWorkflowExecutionRequest,
@@ -718,6 +778,8 @@ func (s *contextImpl) UpdateWorkflowExecution(
 		return nil, err
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry.GetInfo().Name)
+	request.EncryptionKeyID = s.getEncryptionKeyID(domainEntry.GetInfo().Name)
+	setPayloadEncryptionKeyID(request.NewWorkflowSnapshot, request.EncryptionKeyID)
 
 	s.Lock()
 	defer s.Unlock()
@@ -835,6 +897,9 @@ func (s *contextImpl) ConflictResolveWorkflowExecution(
 		return nil, err
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry.GetInfo().Name)
+	request.EncryptionKeyID = s.getEncryptionKeyID(domainEntry.GetInfo().Name)
+	setPayloadEncryptionKeyID(&request.ResetWorkflowSnapshot, request.EncryptionKeyID)
+	setPayloadEncryptionKeyID(request.NewWorkflowSnapshot, request.EncryptionKeyID)
 
 	s.Lock()
 	defer s.Unlock()
@@ -978,6 +1043,7 @@ func (s *contextImpl) AppendHistoryV2Events(
 	}
 
 	request.Encoding = s.getDefaultEncoding(domainName)
+	request.EncryptionKeyID = s.getEncryptionKeyID(domainName)
 	request.ShardID = common.IntPtr(s.shardID)
 	request.TransactionID = transactionID
 
@@ -1004,6 +1070,10 @@ func (s *contextImpl) GetConfig() *config.Config {
 	return s.config
 }
 
+func (s *contextImpl) GetLoadReporter() LoadReporter {
+	return s.loadReporter
+}
+
 func (s *contextImpl) PreviousShardOwnerWasDifferent() bool {
 	return s.previousShardOwnerWasDifferent
 }
@@ -1627,6 +1697,7 @@ func acquireShard(
 		timerFailoverLevels:            make(map[string]TimerFailoverLevel),
 		logger:                         shardItem.logger,
 		throttledLogger:                shardItem.throttledLogger,
+		loadReporter:                   NewLoadReporter(shardItem.shardID, shardItem.GetTimeSource(), shardItem.config.ShardLoadReportWindow),
 		previousShardOwnerWasDifferent: ownershipChanged,
 	}
 
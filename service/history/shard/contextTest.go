@@ -26,6 +26,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -67,6 +68,10 @@ func NewTestContext(
 			StatesByCluster: make(map[string][]*types.ProcessingQueueState),
 		}
 	}
+	loadReportWindow := dynamicconfig.GetDurationPropertyFn(time.Minute)
+	if config != nil {
+		loadReportWindow = config.ShardLoadReportWindow
+	}
 	shard := &contextImpl{
 		Resource:                  resource,
 		shardID:                   shardInfo.ShardID,
@@ -82,6 +87,7 @@ func NewTestContext(
 		timerMaxReadLevelMap:      make(map[string]time.Time),
 		remoteClusterCurrentTime:  make(map[string]time.Time),
 		eventsCache:               eventsCache,
+		loadReporter:              NewLoadReporter(shardInfo.ShardID, resource.GetTimeSource(), loadReportWindow),
 	}
 	return &TestContext{
 		contextImpl:     shard,
@@ -234,3 +234,42 @@ func (s *contextTestSuite) TestGetAndUpdateProcessingQueueStates() {
 	s.Equal(updatedTransferQueueStates[0].GetAckLevel(), s.context.GetTransferClusterAckLevel(clusterName))
 	s.Equal(time.Unix(0, updatedTimerQueueStates[0].GetAckLevel()), s.context.GetTimerClusterAckLevel(clusterName))
 }
+
+func (s *contextTestSuite) TestGetAndAddDomainUsage() {
+	domainID := "testDomainID"
+	s.Equal(&types.DomainUsage{}, s.context.GetDomainUsage(domainID))
+
+	s.context.AddDomainUsage(domainID, &types.DomainUsage{
+		HistorySize:       1024,
+		HistoryEventCount: 10,
+		OpenWorkflowCount: 2,
+	})
+	s.context.AddDomainUsage(domainID, &types.DomainUsage{
+		HistorySize:         512,
+		HistoryEventCount:   5,
+		OpenWorkflowCount:   -1,
+		ClosedWorkflowCount: 1,
+	})
+	s.Equal(&types.DomainUsage{
+		HistorySize:         1536,
+		HistoryEventCount:   15,
+		OpenWorkflowCount:   1,
+		ClosedWorkflowCount: 1,
+	}, s.context.GetDomainUsage(domainID))
+
+	// counters never go below zero
+	s.context.AddDomainUsage(domainID, &types.DomainUsage{
+		HistorySize:         -4096,
+		HistoryEventCount:   -15,
+		ClosedWorkflowCount: -2,
+	})
+	s.Equal(&types.DomainUsage{
+		OpenWorkflowCount: 1,
+	}, s.context.GetDomainUsage(domainID))
+
+	// usages are deep copied when the shard info is persisted
+	shardInfo := s.context.shardInfo.Copy()
+	s.context.AddDomainUsage(domainID, &types.DomainUsage{OpenWorkflowCount: 1})
+	s.Equal(int64(1), shardInfo.DomainUsages.UsagesByDomainID[domainID].OpenWorkflowCount)
+	s.Equal(int64(2), s.context.GetDomainUsage(domainID).OpenWorkflowCount)
+}
@@ -24,6 +24,7 @@ package shard
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -71,6 +72,7 @@ type (
 		Status() int32
 		NumShards() int
 		ShardIDs() []int32
+		ShardLoads() []*types.HistoryShardLoad
 	}
 
 	controller struct {
@@ -103,8 +105,9 @@ type (
 		engineFactory   EngineFactory
 
 		sync.RWMutex
-		status historyShardsItemStatus
-		engine engine.Engine
+		status  historyShardsItemStatus
+		engine  engine.Engine
+		context Context
 	}
 )
 
@@ -236,6 +239,27 @@ func (c *controller) ShardIDs() []int32 {
 	return ids
 }
 
+func (c *controller) ShardLoads() []*types.HistoryShardLoad {
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	topN := c.config.ShardLoadReportTopN()
+	loads := make([]*types.HistoryShardLoad, 0, len(items))
+	for _, item := range items {
+		if load := item.describeLoad(topN); load != nil {
+			loads = append(loads, load)
+		}
+	}
+	sort.Slice(loads, func(i, j int) bool {
+		return loads[i].ShardID < loads[j].ShardID
+	})
+	return loads
+}
+
 func (c *controller) removeEngineForShard(shardID int, shardItem *historyShardsItem) {
 	sw := c.metricsScope.StartTimer(metrics.RemoveEngineForShardLatency)
 	defer sw.Stop()
@@ -435,6 +459,22 @@ func (c *controller) isShuttingDown() bool {
 	return atomic.LoadInt32(&c.shuttingDown) != 0
 }
 
+func (i *historyShardsItem) describeLoad(topN int) *types.HistoryShardLoad {
+	i.RLock()
+	defer i.RUnlock()
+
+	if i.status != historyShardsItemStatusStarted || i.context == nil {
+		return nil
+	}
+
+	load := i.context.GetLoadReporter().Report(topN)
+	load.TransferTaskLag = i.context.GetTransferMaxReadLevel() - i.context.GetTransferAckLevel()
+	if timerLag := i.GetTimeSource().Now().Sub(i.context.GetTimerAckLevel()); timerLag > 0 {
+		load.TimerTaskLagInMillis = timerLag.Milliseconds()
+	}
+	return load
+}
+
 func (i *historyShardsItem) getOrCreateEngine(
 	closeCallback func(int, *historyShardsItem),
 ) (engine.Engine, error) {
@@ -462,6 +502,7 @@ func (i *historyShardsItem) getOrCreateEngine(
 			i.GetMetricsClient().RecordTimer(metrics.ShardInfoScope, metrics.ShardItemAcquisitionLatency,
 				context.GetCurrentTime(i.GetClusterMetadata().GetCurrentClusterName()).Sub(context.GetLastUpdatedTime()))
 		}
+		i.context = context
 		i.engine = i.engineFactory.CreateEngine(context)
 		i.engine.Start()
 		i.logger.Info("Shard engine state changed", tag.LifeCycleStarted, tag.ComponentShardEngine)
@@ -31,6 +31,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 
+	types "github.com/uber/cadence/common/types"
 	engine "github.com/uber/cadence/service/history/engine"
 )
 
@@ -213,3 +214,17 @@ func (mr *MockControllerMockRecorder) ShardIDs() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardIDs", reflect.TypeOf((*MockController)(nil).ShardIDs))
 }
+
+// ShardLoads mocks base method
+func (m *MockController) ShardLoads() []*types.HistoryShardLoad {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardLoads")
+	ret0, _ := ret[0].([]*types.HistoryShardLoad)
+	return ret0
+}
+
+// ShardLoads indicates an expected call of ShardLoads
+func (mr *MockControllerMockRecorder) ShardLoads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardLoads", reflect.TypeOf((*MockController)(nil).ShardLoads))
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// lock acquisitions faster than this are not considered as contended
+	lockWaitThreshold = time.Millisecond
+	// upper bound on the number of distinct workflows tracked per window,
+	// requests for workflows beyond the bound are still counted towards shard and domain totals
+	maxTrackedWorkflowsPerWindow = 10000
+)
+
+type (
+	// LoadReporter tracks the request rate and workflow lock contention of a shard,
+	// broken down by domain and workflow, over a sliding time window
+	LoadReporter interface {
+		RecordRequest(domainID, workflowID string)
+		RecordLockWait(domainID, workflowID string, latency time.Duration)
+		Report(topN int) *types.HistoryShardLoad
+	}
+
+	loadReporterImpl struct {
+		shardID    int
+		timeSource clock.TimeSource
+		window     dynamicconfig.DurationPropertyFn
+
+		sync.Mutex
+		current  *loadWindow
+		previous *loadWindow
+	}
+
+	loadWindow struct {
+		start     time.Time
+		end       time.Time
+		total     loadCounter
+		domains   map[string]*loadCounter
+		workflows map[loadWorkflowKey]*loadCounter
+	}
+
+	loadCounter struct {
+		requests     int64
+		lockWaits    int64
+		lockWaitTime time.Duration
+	}
+
+	loadWorkflowKey struct {
+		domainID   string
+		workflowID string
+	}
+)
+
+var _ LoadReporter = (*loadReporterImpl)(nil)
+
+// NewLoadReporter creates a new LoadReporter for the given shard
+func NewLoadReporter(
+	shardID int,
+	timeSource clock.TimeSource,
+	window dynamicconfig.DurationPropertyFn,
+) LoadReporter {
+	return &loadReporterImpl{
+		shardID:    shardID,
+		timeSource: timeSource,
+		window:     window,
+		current:    newLoadWindow(timeSource.Now()),
+	}
+}
+
+func newLoadWindow(start time.Time) *loadWindow {
+	return &loadWindow{
+		start:     start,
+		domains:   make(map[string]*loadCounter),
+		workflows: make(map[loadWorkflowKey]*loadCounter),
+	}
+}
+
+func (r *loadReporterImpl) RecordRequest(domainID, workflowID string) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.rotateLocked()
+	for _, counter := range r.current.countersLocked(domainID, workflowID) {
+		counter.requests++
+	}
+}
+
+func (r *loadReporterImpl) RecordLockWait(domainID, workflowID string, latency time.Duration) {
+	if latency < lockWaitThreshold {
+		return
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	r.rotateLocked()
+	for _, counter := range r.current.countersLocked(domainID, workflowID) {
+		counter.lockWaits++
+		counter.lockWaitTime += latency
+	}
+}
+
+// Report returns the load of the last complete window, or of the current window
+// if no window has completed yet
+func (r *loadReporterImpl) Report(topN int) *types.HistoryShardLoad {
+	r.Lock()
+	defer r.Unlock()
+
+	r.rotateLocked()
+	window := r.previous
+	if window == nil {
+		window = r.current
+		window.end = r.timeSource.Now()
+	}
+	seconds := window.end.Sub(window.start).Seconds()
+	if seconds <= 0 {
+		seconds = 1
+	}
+
+	load := &types.HistoryShardLoad{
+		ShardID:            int32(r.shardID),
+		RequestsPerSecond:  float64(window.total.requests) / seconds,
+		LockWaitsPerSecond: float64(window.total.lockWaits) / seconds,
+	}
+	if window.total.lockWaits > 0 {
+		load.AverageLockWaitInMillis = (window.total.lockWaitTime / time.Duration(window.total.lockWaits)).Milliseconds()
+	}
+
+	for domainID, counter := range window.domains {
+		load.TopDomains = append(load.TopDomains, counter.toEntry(domainID, "", seconds))
+	}
+	for key, counter := range window.workflows {
+		load.TopWorkflows = append(load.TopWorkflows, counter.toEntry(key.domainID, key.workflowID, seconds))
+	}
+	load.TopDomains = topLoadEntries(load.TopDomains, topN)
+	load.TopWorkflows = topLoadEntries(load.TopWorkflows, topN)
+	return load
+}
+
+func (r *loadReporterImpl) rotateLocked() {
+	now := r.timeSource.Now()
+	window := r.window()
+	if now.Sub(r.current.start) < window {
+		return
+	}
+
+	if now.Sub(r.current.start) >= 2*window {
+		// no activity during the last window
+		r.previous = newLoadWindow(now.Add(-window))
+	} else {
+		r.previous = r.current
+	}
+	r.previous.end = r.previous.start.Add(window)
+	r.current = newLoadWindow(r.previous.end)
+}
+
+func (w *loadWindow) countersLocked(domainID, workflowID string) []*loadCounter {
+	counters := []*loadCounter{&w.total}
+
+	domainCounter, ok := w.domains[domainID]
+	if !ok {
+		domainCounter = &loadCounter{}
+		w.domains[domainID] = domainCounter
+	}
+	counters = append(counters, domainCounter)
+
+	key := loadWorkflowKey{domainID: domainID, workflowID: workflowID}
+	workflowCounter, ok := w.workflows[key]
+	if !ok && len(w.workflows) < maxTrackedWorkflowsPerWindow {
+		workflowCounter = &loadCounter{}
+		w.workflows[key] = workflowCounter
+	}
+	if workflowCounter != nil {
+		counters = append(counters, workflowCounter)
+	}
+	return counters
+}
+
+func (c *loadCounter) toEntry(domainID, workflowID string, seconds float64) *types.HistoryLoadEntry {
+	return &types.HistoryLoadEntry{
+		DomainID:           domainID,
+		WorkflowID:         workflowID,
+		RequestsPerSecond:  float64(c.requests) / seconds,
+		LockWaitsPerSecond: float64(c.lockWaits) / seconds,
+	}
+}
+
+func topLoadEntries(entries []*types.HistoryLoadEntry, topN int) []*types.HistoryLoadEntry {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].RequestsPerSecond != entries[j].RequestsPerSecond {
+			return entries[i].RequestsPerSecond > entries[j].RequestsPerSecond
+		}
+		if entries[i].LockWaitsPerSecond != entries[j].LockWaitsPerSecond {
+			return entries[i].LockWaitsPerSecond > entries[j].LockWaitsPerSecond
+		}
+		if entries[i].DomainID != entries[j].DomainID {
+			return entries[i].DomainID < entries[j].DomainID
+		}
+		return entries[i].WorkflowID < entries[j].WorkflowID
+	})
+	if topN >= 0 && len(entries) > topN {
+		entries = entries[:topN]
+	}
+	return entries
+}
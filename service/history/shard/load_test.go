@@ -0,0 +1,108 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	loadReporterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		timeSource   *clock.EventTimeSource
+		loadReporter LoadReporter
+	}
+)
+
+func TestLoadReporterSuite(t *testing.T) {
+	s := new(loadReporterSuite)
+	suite.Run(t, s)
+}
+
+func (s *loadReporterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.timeSource = clock.NewEventTimeSource().Update(time.Unix(0, 0))
+	s.loadReporter = NewLoadReporter(10, s.timeSource, dynamicconfig.GetDurationPropertyFn(10*time.Second))
+}
+
+func (s *loadReporterSuite) TestReport_CurrentWindow() {
+	for i := 0; i != 30; i++ {
+		s.loadReporter.RecordRequest("domain-1", "workflow-1")
+	}
+	for i := 0; i != 10; i++ {
+		s.loadReporter.RecordRequest("domain-2", "workflow-2")
+	}
+	s.loadReporter.RecordLockWait("domain-1", "workflow-1", 20*time.Millisecond)
+	s.loadReporter.RecordLockWait("domain-1", "workflow-1", 40*time.Millisecond)
+	s.loadReporter.RecordLockWait("domain-2", "workflow-2", time.Microsecond)
+
+	s.timeSource.Update(time.Unix(5, 0))
+	load := s.loadReporter.Report(1)
+	s.Equal(&types.HistoryShardLoad{
+		ShardID:                 10,
+		RequestsPerSecond:       8,
+		LockWaitsPerSecond:      0.4,
+		AverageLockWaitInMillis: 30,
+		TopDomains: []*types.HistoryLoadEntry{
+			{DomainID: "domain-1", RequestsPerSecond: 6, LockWaitsPerSecond: 0.4},
+		},
+		TopWorkflows: []*types.HistoryLoadEntry{
+			{DomainID: "domain-1", WorkflowID: "workflow-1", RequestsPerSecond: 6, LockWaitsPerSecond: 0.4},
+		},
+	}, load)
+}
+
+func (s *loadReporterSuite) TestReport_CompletedWindow() {
+	for i := 0; i != 20; i++ {
+		s.loadReporter.RecordRequest("domain-1", "workflow-1")
+	}
+
+	s.timeSource.Update(time.Unix(12, 0))
+	s.loadReporter.RecordRequest("domain-2", "workflow-2")
+
+	load := s.loadReporter.Report(5)
+	s.Equal(float64(2), load.RequestsPerSecond)
+	s.Len(load.TopDomains, 1)
+	s.Equal("domain-1", load.TopDomains[0].DomainID)
+
+	s.timeSource.Update(time.Unix(25, 0))
+	load = s.loadReporter.Report(5)
+	s.Equal(0.1, load.RequestsPerSecond)
+	s.Len(load.TopWorkflows, 1)
+	s.Equal("workflow-2", load.TopWorkflows[0].WorkflowID)
+
+	s.timeSource.Update(time.Unix(60, 0))
+	load = s.loadReporter.Report(5)
+	s.Zero(load.RequestsPerSecond)
+	s.Empty(load.TopDomains)
+	s.Empty(load.TopWorkflows)
+}
@@ -731,8 +731,11 @@ func (t *crossClusterSourceTask) isValidLocked() bool {
 
 	// pending active state is treated as valid
 	sourceInvalid := sourceEntry.GetReplicationConfig().ActiveClusterName !=
-		t.shard.GetClusterMetadata().GetCurrentClusterName()
-	targetInvalid := targetEntry != nil && targetEntry.GetReplicationConfig().ActiveClusterName != t.targetCluster
+		t.shard.GetClusterMetadata().GetCurrentClusterName() && !sourceEntry.IsActiveActive()
+	// workflows of an active-active target domain are active in different clusters,
+	// the target cluster of the task is verified when the task is processed there
+	targetInvalid := targetEntry != nil && targetEntry.GetReplicationConfig().ActiveClusterName != t.targetCluster &&
+		!targetEntry.IsActiveActive()
 
 	if sourceInvalid || targetInvalid {
 		t.processingState = processingStateInvalidated
@@ -294,6 +294,25 @@ func (s *crossClusterTaskSuite) TestSourceTask_IsReadyForPoll() {
 }
 
 func (s *crossClusterTaskSuite) TestSourceTask_IsValid() {
+	activeActiveDomainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&p.DomainInfo{
+			ID:   "active-active-target-domain-id",
+			Name: "active-active-target-domain",
+			Data: map[string]string{common.DomainDataKeyForActiveActive: "true"},
+		},
+		&p.DomainConfig{Retention: 1},
+		&p.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*p.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		constants.TestVersion,
+		s.mockClusterMetadata,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(activeActiveDomainEntry.GetInfo().ID).Return(activeActiveDomainEntry, nil).AnyTimes()
+
 	testCases := []struct {
 		sourceDomainID string
 		targetDomainID string
@@ -324,6 +343,12 @@ func (s *crossClusterTaskSuite) TestSourceTask_IsValid() {
 			targetCluster:  "doesn't not match active cluster of target domain",
 			isValid:        false,
 		},
+		{
+			sourceDomainID: constants.TestDomainID,
+			targetDomainID: activeActiveDomainEntry.GetInfo().ID, // target workflow active in alternative cluster
+			targetCluster:  cluster.TestAlternativeClusterName,
+			isValid:        true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -150,7 +150,8 @@ func (a *priorityAssignerImpl) getDomainInfo(
 		return "", true, nil
 	}
 
-	if domainEntry.IsGlobalDomain() && a.currentClusterName != domainEntry.GetReplicationConfig().ActiveClusterName {
+	if domainEntry.IsGlobalDomain() && !domainEntry.IsActiveActive() &&
+		a.currentClusterName != domainEntry.GetReplicationConfig().ActiveClusterName {
 		return domainEntry.GetInfo().Name, false, nil
 	}
 	return domainEntry.GetInfo().Name, true, nil
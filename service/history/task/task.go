@@ -56,6 +56,9 @@ var (
 	ErrTaskRedispatch = errors.New("passive task should be redispatched due to condition in mutable state is not met")
 	// ErrTaskPendingActive is the error indicating that the task should be re-dispatched
 	ErrTaskPendingActive = errors.New("redispatch the task while the domain is pending-active")
+	// ErrTaskWorkflowSuspended is the error indicating that the task belongs to a suspended workflow, the task is acked
+	// and re-generated when the workflow is resumed
+	ErrTaskWorkflowSuspended = errors.New("task is skipped while the workflow is suspended")
 )
 
 type (
@@ -184,6 +187,12 @@ func (t *taskImpl) Execute() error {
 	// the task should be smart enough to tell if it should be
 	// processed as active or standby and use the corresponding
 	// task executor.
+	if t.State() == ctask.TaskStateAcked {
+		// task has been acked while waiting for execution, e.g. removed by an operator
+		logEvent(t.eventLogger, "Skipping acked task")
+		return nil
+	}
+
 	if t.scope == nil {
 		t.scope = getOrCreateDomainTaggedScope(t.shard, t.scopeIdx, t.GetDomainID(), t.logger)
 	}
@@ -266,6 +275,12 @@ func (t *taskImpl) HandleErr(
 		return err
 	}
 
+	// tasks of a suspended workflow are re-generated when the workflow is resumed
+	if err == ErrTaskWorkflowSuspended {
+		t.scope.IncCounter(metrics.TaskWorkflowSuspendedCounterPerDomain)
+		return nil
+	}
+
 	if err == ErrTaskDiscarded {
 		t.scope.IncCounter(metrics.TaskDiscardedPerDomain)
 		err = nil
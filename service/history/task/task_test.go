@@ -123,6 +123,16 @@ func (s *taskSuite) TestExecute_Success() {
 	s.NoError(err)
 }
 
+func (s *taskSuite) TestExecute_AckedTask() {
+	task := s.newTestTask(func(task Info) (bool, error) {
+		return true, nil
+	}, nil)
+
+	task.Ack()
+	err := task.Execute()
+	s.NoError(err)
+}
+
 func (s *taskSuite) TestHandleErr_ErrEntityNotExists() {
 	taskBase := s.newTestTask(func(task Info) (bool, error) {
 		return true, nil
@@ -141,6 +151,15 @@ func (s *taskSuite) TestHandleErr_ErrTaskRetry() {
 	s.Equal(ErrTaskRedispatch, taskBase.HandleErr(err))
 }
 
+func (s *taskSuite) TestHandleErr_ErrTaskWorkflowSuspended() {
+	taskBase := s.newTestTask(func(task Info) (bool, error) {
+		return true, nil
+	}, nil)
+
+	err := ErrTaskWorkflowSuspended
+	s.NoError(taskBase.HandleErr(err))
+}
+
 func (s *taskSuite) TestHandleErr_ErrTaskDiscarded() {
 	taskBase := s.newTestTask(func(task Info) (bool, error) {
 		return true, nil
@@ -142,6 +142,11 @@ func GetTransferTaskMetricsScope(
 			return metrics.TransferActiveTaskApplyParentClosePolicyScope
 		}
 		return metrics.TransferStandbyTaskApplyParentClosePolicyScope
+	case persistence.TransferTaskTypeWorkflowLifecycleEvents:
+		if isActive {
+			return metrics.TransferActiveTaskWorkflowLifecycleEventsScope
+		}
+		return metrics.TransferStandbyTaskWorkflowLifecycleEventsScope
 	default:
 		if isActive {
 			return metrics.TransferActiveQueueProcessorScope
@@ -320,6 +325,18 @@ func loadMutableStateForTimerTask(
 	return msBuilder, nil
 }
 
+// isActiveActiveWorkflowNotIn returns true if the workflow belongs to an active-active domain and is not
+// active in the given cluster, its tasks are then left to the cluster the workflow is active in
+func isActiveActiveWorkflowNotIn(
+	mutableState execution.MutableState,
+	clusterName string,
+) bool {
+
+	domainEntry := mutableState.GetDomainEntry()
+	return domainEntry.IsActiveActive() &&
+		domainEntry.GetWorkflowActiveCluster(mutableState.GetCurrentVersion()) != clusterName
+}
+
 // load mutable state, if mutable state's next event ID <= task ID, will attempt to refresh
 // if still mutable state's next event ID <= task ID, will return nil, nil
 func loadMutableStateForTransferTask(
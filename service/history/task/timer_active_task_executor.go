@@ -137,6 +137,13 @@ func (t *timerActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+	if mutableState.IsWorkflowExecutionSuspended() {
+		release(nil)
+		return ErrTaskWorkflowSuspended
+	}
 
 	timerSequence := execution.NewTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -239,6 +246,13 @@ func (t *timerActiveTaskExecutor) executeActivityTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+	if mutableState.IsWorkflowExecutionSuspended() {
+		release(nil)
+		return ErrTaskWorkflowSuspended
+	}
 
 	timerSequence := execution.NewTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -401,6 +415,13 @@ func (t *timerActiveTaskExecutor) executeDecisionTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+	if mutableState.IsWorkflowExecutionSuspended() {
+		release(nil)
+		return ErrTaskWorkflowSuspended
+	}
 
 	scheduleID := task.EventID
 	decision, ok := mutableState.GetDecisionInfo(scheduleID)
@@ -497,6 +518,13 @@ func (t *timerActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+	if mutableState.IsWorkflowExecutionSuspended() {
+		release(nil)
+		return ErrTaskWorkflowSuspended
+	}
 
 	if task.TimeoutType == persistence.WorkflowBackoffTimeoutTypeRetry {
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowRetryBackoffTimerCount)
@@ -538,6 +566,13 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+	if mutableState.IsWorkflowExecutionSuspended() {
+		release(nil)
+		return ErrTaskWorkflowSuspended
+	}
 
 	// generate activity task
 	scheduledID := task.EventID
@@ -628,6 +663,13 @@ func (t *timerActiveTaskExecutor) executeWorkflowTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+	if mutableState.IsWorkflowExecutionSuspended() {
+		release(nil)
+		return ErrTaskWorkflowSuspended
+	}
 
 	startVersion, err := mutableState.GetStartVersion()
 	if err != nil {
@@ -682,6 +724,7 @@ func (t *timerActiveTaskExecutor) executeWorkflowTimeoutTask(
 		Header:                              startAttributes.Header,
 		Memo:                                startAttributes.Memo,
 		SearchAttributes:                    startAttributes.SearchAttributes,
+		RetentionPeriodInDays:               startAttributes.RetentionPeriodInDays,
 	}
 	newMutableState, err := retryWorkflow(
 		ctx,
@@ -421,7 +421,7 @@ func (t *timerStandbyTaskExecutor) processTimer(
 		return err
 	}
 	defer func() {
-		if retError == ErrTaskRedispatch {
+		if retError == ErrTaskRedispatch || retError == ErrTaskWorkflowSuspended {
 			release(nil)
 		} else {
 			release(retError)
@@ -440,6 +440,14 @@ func (t *timerStandbyTaskExecutor) processTimer(
 		// workflow already finished, no need to process the timer
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.clusterName) {
+		// workflow is not active in the standby cluster, e.g. it is failed over to the current cluster
+		return nil
+	}
+	if mutableState.IsWorkflowExecutionSuspended() {
+		// timers of a suspended workflow do not fire in the active cluster either
+		return ErrTaskWorkflowSuspended
+	}
 
 	historyResendInfo, err := actionFn(ctx, wfContext, mutableState)
 	if err != nil {
@@ -128,7 +128,7 @@ func (t *timerTaskExecutorBase) executeDeleteHistoryEventTask(
 func (t *timerTaskExecutorBase) deleteWorkflow(
 	ctx context.Context,
 	task *persistence.TimerTaskInfo,
-	context execution.Context,
+	workflowContext execution.Context,
 	msBuilder execution.MutableState,
 ) error {
 
@@ -139,6 +139,11 @@ func (t *timerTaskExecutorBase) deleteWorkflow(
 	if err := t.deleteWorkflowExecution(ctx, task); err != nil {
 		return err
 	}
+	t.shard.AddDomainUsage(task.DomainID, &types.DomainUsage{
+		HistorySize:         -workflowContext.GetHistorySize(),
+		HistoryEventCount:   -(msBuilder.GetNextEventID() - 1),
+		ClosedWorkflowCount: -1,
+	})
 
 	if err := t.deleteWorkflowHistory(ctx, task, msBuilder); err != nil {
 		return err
@@ -149,7 +154,7 @@ func (t *timerTaskExecutorBase) deleteWorkflow(
 	}
 	// calling clear here to force accesses of mutable state to read database
 	// if this is not called then callers will get mutable state even though its been removed from database
-	context.Clear()
+	workflowContext.Clear()
 	return nil
 }
 
@@ -203,6 +208,11 @@ func (t *timerTaskExecutorBase) archiveWorkflow(
 	if err := t.deleteWorkflowExecution(ctx, task); err != nil {
 		return err
 	}
+	t.shard.AddDomainUsage(task.DomainID, &types.DomainUsage{
+		HistorySize:         -workflowContext.GetHistorySize(),
+		HistoryEventCount:   -(msBuilder.GetNextEventID() - 1),
+		ClosedWorkflowCount: -1,
+	})
 	// delete workflow history if history archival is not needed or history as been archived inline
 	if resp.HistoryArchivedInline {
 		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteHistoryInlineCount)
@@ -126,6 +126,13 @@ func (s *timerQueueTaskExecutorBaseSuite) TestDeleteWorkflow_NoErr() {
 		RunID:      task.RunID,
 	}
 	wfContext := execution.NewContext(task.DomainID, executionInfo, s.mockShard, s.mockExecutionManager, log.NewNoop())
+	wfContext.SetHistorySize(1024)
+	s.mockShard.AddDomainUsage(task.DomainID, &types.DomainUsage{
+		HistorySize:         4096,
+		HistoryEventCount:   200,
+		OpenWorkflowCount:   1,
+		ClosedWorkflowCount: 2,
+	})
 
 	s.mockExecutionManager.On("DeleteCurrentWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
 	s.mockExecutionManager.On("DeleteWorkflowExecution", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
@@ -133,20 +140,28 @@ func (s *timerQueueTaskExecutorBaseSuite) TestDeleteWorkflow_NoErr() {
 	s.mockVisibilityManager.On("DeleteWorkflowExecution", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	s.mockMutableState.EXPECT().GetCurrentBranchToken().Return([]byte{1, 2, 3}, nil).Times(1)
 	s.mockMutableState.EXPECT().GetLastWriteVersion().Return(int64(1234), nil).AnyTimes()
+	s.mockMutableState.EXPECT().GetNextEventID().Return(int64(101)).Times(1)
 
 	err := s.timerQueueTaskExecutorBase.deleteWorkflow(context.Background(), task, wfContext, s.mockMutableState)
 	s.NoError(err)
+	s.Equal(&types.DomainUsage{
+		HistorySize:         3072,
+		HistoryEventCount:   100,
+		OpenWorkflowCount:   1,
+		ClosedWorkflowCount: 1,
+	}, s.mockShard.GetDomainUsage(task.DomainID))
 }
 
 func (s *timerQueueTaskExecutorBaseSuite) TestArchiveHistory_NoErr_InlineArchivalFailed() {
 	s.mockWorkflowExecutionContext.EXPECT().LoadExecutionStats(gomock.Any()).Return(&persistence.ExecutionStats{
 		HistorySize: 1024,
 	}, nil).Times(1)
+	s.mockWorkflowExecutionContext.EXPECT().GetHistorySize().Return(int64(1024)).Times(1)
 	s.mockWorkflowExecutionContext.EXPECT().Clear().Times(1)
 
 	s.mockMutableState.EXPECT().GetCurrentBranchToken().Return([]byte{1, 2, 3}, nil).Times(1)
 	s.mockMutableState.EXPECT().GetLastWriteVersion().Return(int64(1234), nil).Times(1)
-	s.mockMutableState.EXPECT().GetNextEventID().Return(int64(101)).Times(1)
+	s.mockMutableState.EXPECT().GetNextEventID().Return(int64(101)).Times(2)
 
 	s.mockExecutionManager.On("DeleteCurrentWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
 	s.mockExecutionManager.On("DeleteWorkflowExecution", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
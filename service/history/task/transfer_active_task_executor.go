@@ -41,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/lifecycle"
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/worker/archiver"
@@ -82,6 +83,7 @@ func NewTransferActiveTaskExecutor(
 	archiverClient archiver.Client,
 	executionCache *execution.Cache,
 	workflowResetter reset.WorkflowResetter,
+	lifecyclePublisher lifecycle.Publisher,
 	logger log.Logger,
 	config *config.Config,
 ) Executor {
@@ -91,6 +93,7 @@ func NewTransferActiveTaskExecutor(
 			shard,
 			archiverClient,
 			executionCache,
+			lifecyclePublisher,
 			logger,
 			config,
 		),
@@ -147,6 +150,8 @@ func (t *transferActiveTaskExecutor) Execute(
 		return t.processResetWorkflow(ctx, transferTask)
 	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
 		return t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case persistence.TransferTaskTypeWorkflowLifecycleEvents:
+		return t.publishWorkflowLifecycleEvents(ctx, transferTask)
 	default:
 		return errUnknownTransferTask
 	}
@@ -177,6 +182,13 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+	if mutableState.IsWorkflowExecutionSuspended() {
+		release(nil)
+		return ErrTaskWorkflowSuspended
+	}
 
 	ai, ok := mutableState.GetActivityInfo(task.ScheduleID)
 	if !ok {
@@ -220,6 +232,13 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+	if mutableState.IsWorkflowExecutionSuspended() {
+		release(nil)
+		return ErrTaskWorkflowSuspended
+	}
 
 	decision, found := mutableState.GetDecisionInfo(task.ScheduleID)
 	if !found {
@@ -327,6 +346,9 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
 
 	lastWriteVersion, err := mutableState.GetLastWriteVersion()
 	if err != nil {
@@ -398,22 +420,24 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 	replyToParentWorkflow = replyToParentWorkflow &&
 		mutableState.HasParentExecution() &&
 		executionInfo.CloseStatus != persistence.WorkflowCloseStatusContinuedAsNew
+	var parentDomainEntry *cache.DomainCacheEntry
+	var parentInfo *types.ParentExecutionInfo
 	if replyToParentWorkflow {
 		// generate cross cluster task for recording child completion
-		targetDomainEntry, err := t.shard.GetDomainCache().GetDomainByID(parentDomainID)
+		parentDomainEntry, err = t.shard.GetDomainCache().GetDomainByID(parentDomainID)
 		if err != nil {
 			return err
 		}
-		if targetCluster, isCrossCluster := t.isCrossClusterTask(task.DomainID, targetDomainEntry); isCrossCluster {
-			parentInfo := &types.ParentExecutionInfo{
-				DomainUUID: parentDomainID,
-				Domain:     targetDomainEntry.GetInfo().Name,
-				Execution: &types.WorkflowExecution{
-					WorkflowID: parentWorkflowID,
-					RunID:      parentRunID,
-				},
-				InitiatedID: initiatedID,
-			}
+		parentInfo = &types.ParentExecutionInfo{
+			DomainUUID: parentDomainID,
+			Domain:     parentDomainEntry.GetInfo().Name,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: parentWorkflowID,
+				RunID:      parentRunID,
+			},
+			InitiatedID: initiatedID,
+		}
+		if targetCluster, isCrossCluster := t.isCrossClusterTask(task.DomainID, parentDomainEntry); isCrossCluster {
 			crossClusterTaskGenerators = append(crossClusterTaskGenerators,
 				func(taskGenerator execution.MutableStateTaskGenerator) error {
 					return taskGenerator.GenerateCrossClusterRecordChildCompletedTask(task, targetCluster, parentInfo)
@@ -459,6 +483,7 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 			isCron,
 			numClusters,
 			searchAttr,
+			executionInfo.RetentionDays,
 		); err != nil {
 			return err
 		}
@@ -487,10 +512,12 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 		switch err.(type) {
 		case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError:
 			err = nil
-		case *types.DomainNotActiveError:
-			err = errTargetDomainNotActive
 		}
 
+		targetCluster, err := t.convertTargetNotActiveError(parentDomainEntry, err)
+		if targetCluster != "" {
+			err = t.generateCrossClusterRecordChildCompletedTask(ctx, task, targetCluster, parentInfo)
+		}
 		if err != nil {
 			return err
 		}
@@ -538,6 +565,9 @@ func (t *transferActiveTaskExecutor) processCancelExecution(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
 
 	initiatedEventID := task.ScheduleID
 	requestCancelInfo, ok := mutableState.GetRequestCancelInfo(initiatedEventID)
@@ -576,13 +606,18 @@ func (t *transferActiveTaskExecutor) processCancelExecution(
 		return err
 	}
 
-	if err = requestCancelExternalExecutionWithRetry(
+	err = requestCancelExternalExecutionWithRetry(
 		ctx,
 		t.historyClient,
 		task,
 		targetDomainName,
 		requestCancelInfo.CancelRequestID,
-	); err != nil {
+	)
+	targetCluster, err := t.convertTargetNotActiveError(targetDomainEntry, err)
+	if targetCluster != "" {
+		return t.generateCrossClusterTaskFromTransferTask(ctx, wfContext, task, targetCluster)
+	}
+	if err != nil {
 		t.logger.Debug(fmt.Sprintf("Failed to cancel external workflow execution. Error: %v", err))
 
 		// Check to see if the error is non-transient, in which case add RequestCancelFailed
@@ -645,6 +680,9 @@ func (t *transferActiveTaskExecutor) processSignalExecution(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
 
 	initiatedEventID := task.ScheduleID
 	signalInfo, ok := mutableState.GetSignalInfo(initiatedEventID)
@@ -686,13 +724,18 @@ func (t *transferActiveTaskExecutor) processSignalExecution(
 		)
 	}
 
-	if err = signalExternalExecutionWithRetry(
+	err = signalExternalExecutionWithRetry(
 		ctx,
 		t.historyClient,
 		task,
 		targetDomainName,
 		signalInfo,
-	); err != nil {
+	)
+	targetCluster, err := t.convertTargetNotActiveError(targetDomainEntry, err)
+	if targetCluster != "" {
+		return t.generateCrossClusterTaskFromTransferTask(ctx, wfContext, task, targetCluster)
+	}
+	if err != nil {
 		t.logger.Debug(fmt.Sprintf("Failed to signal external workflow execution. Error: %v", err))
 
 		// Check to see if the error is non-transient, in which case add SignalFailed
@@ -766,6 +809,9 @@ func (t *transferActiveTaskExecutor) processStartChildExecution(
 	if mutableState == nil {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
 
 	initiatedEventID := task.ScheduleID
 	childInfo, ok := mutableState.GetChildExecutionInfo(initiatedEventID)
@@ -925,6 +971,9 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(mutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
 
 	// verify task version for RecordWorkflowStarted.
 	// upsert doesn't require verifyTask, because it is just a sync of mutableState.
@@ -1023,6 +1072,9 @@ func (t *transferActiveTaskExecutor) processResetWorkflow(
 	if currentMutableState == nil {
 		return nil
 	}
+	if isActiveActiveWorkflowNotIn(currentMutableState, t.shard.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
 
 	logger := t.logger.WithTags(
 		tag.WorkflowDomainID(task.DomainID),
@@ -1413,12 +1465,68 @@ func (t *transferActiveTaskExecutor) isCrossClusterTask(
 	}
 
 	targetCluster := targetDomainEntry.GetReplicationConfig().ActiveClusterName
-	if targetCluster != t.shard.GetClusterMetadata().GetCurrentClusterName() {
+	if targetCluster != t.shard.GetClusterMetadata().GetCurrentClusterName() && !targetDomainEntry.IsActiveActive() {
 		return targetCluster, true
 	}
 	return "", false
 }
 
+// generateCrossClusterRecordChildCompletedTask creates a cross-cluster task for recording the child
+// completion in the cluster the parent workflow is active in. The workflow lock is released before
+// the parent workflow is called, so it has to be acquired again for persisting the task.
+func (t *transferActiveTaskExecutor) generateCrossClusterRecordChildCompletedTask(
+	ctx context.Context,
+	task *persistence.TransferTaskInfo,
+	targetCluster string,
+	parentInfo *types.ParentExecutionInfo,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	return t.generateCrossClusterTasks(
+		ctx,
+		wfContext,
+		task,
+		[]generatorF{
+			func(taskGenerator execution.MutableStateTaskGenerator) error {
+				return taskGenerator.GenerateCrossClusterRecordChildCompletedTask(task, targetCluster, parentInfo)
+			},
+		},
+	)
+}
+
+// convertTargetNotActiveError converts the domain not active error returned by the target workflow.
+// If the target domain is active-active and the target workflow is active in another cluster,
+// e.g. after a workflow failover, that cluster is returned so that the task can be forwarded
+// there as a cross-cluster task. Otherwise errTargetDomainNotActive is returned so that the task is retried.
+func (t *transferActiveTaskExecutor) convertTargetNotActiveError(
+	targetDomainEntry *cache.DomainCacheEntry,
+	err error,
+) (string, error) {
+	notActiveErr, ok := err.(*types.DomainNotActiveError)
+	if !ok {
+		return "", err
+	}
+
+	if targetDomainEntry.IsActiveActive() &&
+		notActiveErr.ActiveCluster != "" &&
+		notActiveErr.ActiveCluster != t.shard.GetClusterMetadata().GetCurrentClusterName() {
+		return notActiveErr.ActiveCluster, nil
+	}
+	return "", errTargetDomainNotActive
+}
+
 func (t *transferActiveTaskExecutor) generateCrossClusterTasks(
 	ctx context.Context,
 	wfContext execution.Context,
@@ -1432,7 +1540,8 @@ func (t *transferActiveTaskExecutor) generateCrossClusterTasks(
 		func(ctx context.Context, mutableState execution.MutableState) error {
 			// TODO: we don't have to do this check here, as we hold the mutable state lock
 			// during the update and we already checked the workflow status after acquiring the lock.
-			if task.TaskType == persistence.TransferTaskTypeCloseExecution {
+			if task.TaskType == persistence.TransferTaskTypeCloseExecution ||
+				task.TaskType == persistence.TransferTaskTypeRecordChildExecutionCompleted {
 				// IsWorkflowCompleted only returns true when the workflow is completed,
 				// !IsWorkflowExecutionRunning below returns true when the wf is zombie or corrupted too
 				if !mutableState.IsWorkflowCompleted() {
@@ -1556,8 +1665,6 @@ func requestCancelExternalExecutionWithRetry(
 		// this could happen if target workflow cancellation is already requested
 		// mark as success
 		err = nil
-	case *types.DomainNotActiveError:
-		err = errTargetDomainNotActive
 	}
 	return err
 }
@@ -1602,11 +1709,7 @@ func signalExternalExecutionWithRetry(
 		backoff.WithRetryPolicy(taskRetryPolicy),
 		backoff.WithRetryableError(common.IsServiceTransientError),
 	)
-	err := throttleRetry.Do(context.Background(), op)
-	if _, ok := err.(*types.DomainNotActiveError); ok {
-		err = errTargetDomainNotActive
-	}
-	return err
+	return throttleRetry.Do(context.Background(), op)
 }
 
 func removeSignalMutableStateWithRetry(
@@ -1917,6 +2020,7 @@ func (t *transferActiveTaskExecutor) processParentClosePolicy(
 		return t.parentClosePolicyClient.SendParentClosePolicyRequest(ctx, request)
 	}
 
+	var remoteExecutions []parentclosepolicy.RequestDetail
 	for initiatedID, childDomainID := range sameClusterChildDomainIDs {
 		domainName, err := t.shard.GetDomainCache().GetDomainName(childDomainID)
 		if err != nil {
@@ -1940,15 +2044,39 @@ func (t *transferActiveTaskExecutor) processParentClosePolicy(
 				// expected error, no-op
 				break
 			default:
+				if err == errTargetDomainNotActive && t.isActiveActiveDomain(childDomainID) {
+					// the child workflow of the active-active domain is active in another cluster,
+					// leave it to the parent close policy workflow, whose requests are auto-forwarded
+					remoteExecutions = append(remoteExecutions, parentclosepolicy.RequestDetail{
+						DomainName: domainName,
+						WorkflowID: childInfo.StartedWorkflowID,
+						RunID:      childInfo.StartedRunID,
+						Policy:     childInfo.ParentClosePolicy,
+					})
+					continue
+				}
 				scope.IncCounter(metrics.ParentClosePolicyProcessorFailures)
 				return err
 			}
 		}
 		scope.IncCounter(metrics.ParentClosePolicyProcessorSuccess)
 	}
+
+	if len(remoteExecutions) != 0 {
+		return t.parentClosePolicyClient.SendParentClosePolicyRequest(ctx, parentclosepolicy.Request{
+			Executions: remoteExecutions,
+		})
+	}
 	return nil
 }
 
+func (t *transferActiveTaskExecutor) isActiveActiveDomain(
+	domainID string,
+) bool {
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+	return err == nil && domainEntry.IsActiveActive()
+}
+
 func applyParentClosePolicy(
 	ctx context.Context,
 	historyClient history.Client,
@@ -22,6 +22,7 @@ package task
 
 import (
 	"context"
+	"encoding/json"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -43,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	dc "github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
@@ -52,6 +54,7 @@ import (
 	"github.com/uber/cadence/service/history/engine"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/lifecycle"
 	"github.com/uber/cadence/service/history/shard"
 	test "github.com/uber/cadence/service/history/testing"
 	warchiver "github.com/uber/cadence/service/worker/archiver"
@@ -78,6 +81,7 @@ type (
 		mockArchivalMetadata        *archiver.MockArchivalMetadata
 		mockArchiverProvider        *provider.MockArchiverProvider
 		mockParentClosePolicyClient *parentclosepolicy.ClientMock
+		mockLifecycleProducer       *mocks.KafkaProducer
 
 		logger                     log.Logger
 		domainID                   string
@@ -157,10 +161,12 @@ func (s *transferActiveTaskExecutorSuite) SetupTest() {
 	s.mockEngine.EXPECT().NotifyNewTransferTasks(gomock.Any(), gomock.Any()).AnyTimes()
 	s.mockEngine.EXPECT().NotifyNewTimerTasks(gomock.Any(), gomock.Any()).AnyTimes()
 	s.mockEngine.EXPECT().NotifyNewCrossClusterTasks(gomock.Any(), gomock.Any()).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewReplicationTasks(gomock.Any(), gomock.Any()).AnyTimes()
 	s.mockShard.SetEngine(s.mockEngine)
 
 	s.mockParentClosePolicyClient = &parentclosepolicy.ClientMock{}
 	s.mockArchivalClient = &warchiver.ClientMock{}
+	s.mockLifecycleProducer = &mocks.KafkaProducer{}
 	s.mockMatchingClient = s.mockShard.Resource.MatchingClient
 	s.mockHistoryClient = s.mockShard.Resource.HistoryClient
 	s.mockExecutionMgr = s.mockShard.Resource.ExecutionMgr
@@ -201,6 +207,7 @@ func (s *transferActiveTaskExecutorSuite) SetupTest() {
 		s.mockArchivalClient,
 		execution.NewCache(s.mockShard),
 		nil,
+		lifecycle.NewPublisher(s.mockLifecycleProducer),
 		s.logger,
 		config,
 	).(*transferActiveTaskExecutor)
@@ -212,6 +219,7 @@ func (s *transferActiveTaskExecutorSuite) TearDownTest() {
 	s.mockShard.Finish(s.T())
 	s.mockArchivalClient.AssertExpectations(s.T())
 	s.mockParentClosePolicyClient.AssertExpectations(s.T())
+	s.mockLifecycleProducer.AssertExpectations(s.T())
 }
 
 func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Success() {
@@ -316,6 +324,35 @@ func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_FirstDecision(
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_Suspended() {
+
+	workflowExecution, mutableState, err := test.StartWorkflow(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	di := test.AddDecisionTaskScheduledEvent(mutableState)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		TaskList:   mutableState.GetExecutionInfo().TaskList,
+		TaskType:   persistence.TransferTaskTypeDecisionTask,
+		ScheduleID: di.ScheduleID,
+	})
+
+	_, err = mutableState.AddWorkflowExecutionSuspendedEvent("some random reason", "some random identity")
+	s.NoError(err)
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, di.ScheduleID, di.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Equal(ErrTaskWorkflowSuspended, err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_NonFirstDecision() {
 
 	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
@@ -483,6 +520,34 @@ func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_HasParentCro
 	)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_HasParent_ActiveInRemoteCluster() {
+	parentDomainEntry := s.setupActiveActiveTargetDomain()
+	s.testProcessCloseExecutionWithParent(
+		parentDomainEntry.GetInfo().ID,
+		func(
+			mutableState execution.MutableState,
+			workflowExecution, targetExecution types.WorkflowExecution,
+		) {
+			s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything, mock.Anything).Return(nil).Once()
+			s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewDisabledArchvialConfig())
+			s.mockHistoryClient.EXPECT().RecordChildExecutionCompleted(gomock.Any(), gomock.Any()).Return(&types.DomainNotActiveError{
+				ActiveCluster: cluster.TestAlternativeClusterName,
+			}).Times(1)
+			s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(int64(common.EmptyVersion)).Return(s.mockClusterMetadata.GetCurrentClusterName()).AnyTimes()
+			s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+				crossClusterTasks := request.UpdateWorkflowMutation.CrossClusterTasks
+				s.Len(crossClusterTasks, 1)
+				crossClusterTask, ok := crossClusterTasks[0].(*persistence.CrossClusterRecordChildExecutionCompletedTask)
+				s.True(ok)
+				s.Equal(cluster.TestAlternativeClusterName, crossClusterTask.TargetCluster)
+				s.Equal(targetExecution.GetWorkflowID(), crossClusterTask.TargetWorkflowID)
+				return true
+			})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+		},
+		false,
+	)
+}
+
 func (s *transferActiveTaskExecutorSuite) testProcessCloseExecutionWithParent(
 	targetDomainID string,
 	setupMockFn func(
@@ -689,6 +754,30 @@ func (s *transferActiveTaskExecutorSuite) TestApplyParentPolicy_CrossClusterChil
 	)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestApplyParentPolicy_ActiveActiveChild_ActiveInRemoteCluster() {
+	childDomainEntry := s.setupActiveActiveTargetDomain()
+	s.testProcessCloseExecutionNoParentHasFewChildren(
+		map[string]string{
+			"child_abandon":   s.domainName,
+			"child_terminate": childDomainEntry.GetInfo().Name,
+			"child_cancel":    s.domainName,
+		},
+		func() {
+			s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DomainNotActiveError{
+				ActiveCluster: cluster.TestAlternativeClusterName,
+			}).Times(1)
+			s.expectCancelRequest(s.domainName)
+			s.mockParentClosePolicyClient.On("SendParentClosePolicyRequest", mock.Anything, mock.MatchedBy(
+				func(request parentclosepolicy.Request) bool {
+					return len(request.Executions) == 1 &&
+						request.Executions[0].DomainName == childDomainEntry.GetInfo().Name &&
+						request.Executions[0].Policy == types.ParentClosePolicyTerminate
+				},
+			)).Return(nil).Times(1)
+		},
+	)
+}
+
 func (s *transferActiveTaskExecutorSuite) expectCancelRequest(childDomainName string) {
 	childDomainID, err := s.mockDomainCache.GetDomainID(childDomainName)
 	s.NoError(err)
@@ -1011,6 +1100,36 @@ func (s *transferActiveTaskExecutorSuite) TestProcessCancelExecution_TargetNotAc
 	)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessCancelExecution_TargetActiveInRemoteCluster() {
+	targetDomainEntry := s.setupActiveActiveTargetDomain()
+	s.testProcessCancelExecution(
+		targetDomainEntry.GetInfo().ID,
+		func(
+			mutableState execution.MutableState,
+			workflowExecution, targetExecution types.WorkflowExecution,
+			event *types.HistoryEvent,
+			transferTask Task,
+			requestCancelInfo *persistence.RequestCancelInfo,
+		) {
+			persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, event.GetEventID(), event.GetVersion())
+			s.NoError(err)
+			s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+			cancelRequest := createTestRequestCancelWorkflowExecutionRequest(targetDomainEntry.GetInfo().Name, transferTask.GetInfo().(*persistence.TransferTaskInfo), requestCancelInfo.CancelRequestID)
+			s.mockHistoryClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), cancelRequest).Return(&types.DomainNotActiveError{
+				ActiveCluster: cluster.TestAlternativeClusterName,
+			}).Times(1)
+			s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+				crossClusterTasks := request.UpdateWorkflowMutation.CrossClusterTasks
+				s.Len(crossClusterTasks, 1)
+				crossClusterTask, ok := crossClusterTasks[0].(*persistence.CrossClusterCancelExecutionTask)
+				s.True(ok)
+				s.Equal(cluster.TestAlternativeClusterName, crossClusterTask.TargetCluster)
+				return true
+			})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+		},
+	)
+}
+
 func (s *transferActiveTaskExecutorSuite) testProcessCancelExecution(
 	targetDomainID string,
 	setupMockFn func(
@@ -1143,6 +1262,36 @@ func (s *transferActiveTaskExecutorSuite) TestProcessSignalExecution_TargetNotAc
 	)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessSignalExecution_TargetActiveInRemoteCluster() {
+	targetDomainEntry := s.setupActiveActiveTargetDomain()
+	s.testProcessSignalExecution(
+		targetDomainEntry.GetInfo().ID,
+		func(
+			mutableState execution.MutableState,
+			workflowExecution, targetExecution types.WorkflowExecution,
+			event *types.HistoryEvent,
+			transferTask Task,
+			signalInfo *persistence.SignalInfo,
+		) {
+			persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, event.GetEventID(), event.GetVersion())
+			s.NoError(err)
+			s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+			signalRequest := createTestSignalWorkflowExecutionRequest(targetDomainEntry.GetInfo().Name, transferTask.GetInfo().(*persistence.TransferTaskInfo), signalInfo)
+			s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), signalRequest).Return(&types.DomainNotActiveError{
+				ActiveCluster: cluster.TestAlternativeClusterName,
+			}).Times(1)
+			s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+				crossClusterTasks := request.UpdateWorkflowMutation.CrossClusterTasks
+				s.Len(crossClusterTasks, 1)
+				crossClusterTask, ok := crossClusterTasks[0].(*persistence.CrossClusterSignalExecutionTask)
+				s.True(ok)
+				s.Equal(cluster.TestAlternativeClusterName, crossClusterTask.TargetCluster)
+				return true
+			})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+		},
+	)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessSignalExecution_Duplication() {
 	s.testProcessSignalExecution(
 		s.targetDomainID,
@@ -1640,6 +1789,70 @@ func (s *transferActiveTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttribu
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessWorkflowLifecycleEvents() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+	startEvent, err := mutableState.GetStartEvent(context.Background())
+	s.NoError(err)
+	s.mockShard.GetConfig().EnableWorkflowLifecycleEvents = dc.GetBoolPropertyFnFilteredByDomain(true)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    startEvent.Version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		ScheduleID: startEvent.EventID,
+		TaskType:   persistence.TransferTaskTypeWorkflowLifecycleEvents,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(req *persistence.ReadHistoryBranchRequest) bool {
+		return req.MinEventID == startEvent.EventID && req.MaxEventID == startEvent.EventID+1
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{startEvent},
+	}, nil).Once()
+	s.mockLifecycleProducer.On("Publish", mock.Anything, mock.MatchedBy(func(message *messaging.EncodedMessage) bool {
+		var event lifecycle.Event
+		s.NoError(json.Unmarshal(message.Value, &event))
+		return string(message.Key) == s.domainID+"/"+workflowExecution.GetWorkflowID() &&
+			event.Type == lifecycle.EventTypeStarted &&
+			event.RunID == workflowExecution.GetRunID() &&
+			event.EventID == startEvent.EventID &&
+			event.FailoverVersion == startEvent.Version &&
+			event.TaskID == transferTask.GetTaskID()
+	})).Return(nil).Once()
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessWorkflowLifecycleEvents_Disabled() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		ScheduleID: common.FirstEventID,
+		TaskType:   persistence.TransferTaskTypeWorkflowLifecycleEvents,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestCopySearchAttributes() {
 	var input map[string][]byte
 	s.Nil(copySearchAttributes(input))
@@ -1661,6 +1874,33 @@ func (s *transferActiveTaskExecutorSuite) newTransferTaskFromInfo(
 	return NewTransferTask(s.mockShard, info, QueueTypeActiveTransfer, s.logger, nil, nil, nil, nil, nil)
 }
 
+func (s *transferActiveTaskExecutorSuite) setupActiveActiveTargetDomain() *cache.DomainCacheEntry {
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   "active-active-target-domain-id",
+			Name: "active-active-target-domain",
+			Data: map[string]string{common.DomainDataKeyForActiveActive: "true"},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		s.version,
+		s.mockClusterMetadata,
+	)
+	domainID := domainEntry.GetInfo().ID
+	domainName := domainEntry.GetInfo().Name
+	s.mockDomainCache.EXPECT().GetDomainByID(domainID).Return(domainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainName(domainID).Return(domainName, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomain(domainName).Return(domainEntry, nil).AnyTimes()
+	return domainEntry
+}
+
 func createAddActivityTaskRequest(
 	transferTask Task,
 	ai *persistence.ActivityInfo,
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/lifecycle"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/worker/archiver"
 )
@@ -52,6 +53,7 @@ func NewTransferStandbyTaskExecutor(
 	archiverClient archiver.Client,
 	executionCache *execution.Cache,
 	historyResender ndc.HistoryResender,
+	lifecyclePublisher lifecycle.Publisher,
 	logger log.Logger,
 	clusterName string,
 	config *config.Config,
@@ -61,6 +63,7 @@ func NewTransferStandbyTaskExecutor(
 			shard,
 			archiverClient,
 			executionCache,
+			lifecyclePublisher,
 			logger,
 			config,
 		),
@@ -116,6 +119,9 @@ func (t *transferStandbyTaskExecutor) Execute(
 		return nil
 	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
 		return t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case persistence.TransferTaskTypeWorkflowLifecycleEvents:
+		// lifecycle events written while this cluster was active are still published by this cluster
+		return t.publishWorkflowLifecycleEvents(ctx, transferTask)
 	default:
 		return errUnknownTransferTask
 	}
@@ -139,6 +145,11 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 			return nil, err
 		}
 
+		if mutableState.IsWorkflowExecutionSuspended() {
+			// the active cluster does not dispatch the activity until the workflow is resumed
+			return nil, ErrTaskWorkflowSuspended
+		}
+
 		if activityInfo.StartedID == common.EmptyEventID {
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
@@ -186,6 +197,11 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 			return nil, err
 		}
 
+		if mutableState.IsWorkflowExecutionSuspended() {
+			// the active cluster does not dispatch the decision until the workflow is resumed
+			return nil, ErrTaskWorkflowSuspended
+		}
+
 		if decisionInfo.StartedID == common.EmptyEventID {
 			return newPushDecisionToMatchingInfo(
 				decisionTimeout,
@@ -280,6 +296,7 @@ func (t *transferStandbyTaskExecutor) processCloseExecution(
 			isCron,
 			numClusters,
 			searchAttr,
+			executionInfo.RetentionDays,
 		)
 	}
 
@@ -538,7 +555,7 @@ func (t *transferStandbyTaskExecutor) processTransfer(
 		return err
 	}
 	defer func() {
-		if retError == ErrTaskRedispatch {
+		if retError == ErrTaskRedispatch || retError == ErrTaskWorkflowSuspended {
 			release(nil)
 		} else {
 			release(retError)
@@ -554,6 +571,10 @@ func (t *transferStandbyTaskExecutor) processTransfer(
 		// workflow already finished, no need to process the timer
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionRunning() && isActiveActiveWorkflowNotIn(mutableState, t.clusterName) {
+		// workflow is not active in the standby cluster, e.g. it is failed over to the current cluster
+		return nil
+	}
 
 	historyResendInfo, err := actionFn(ctx, wfContext, mutableState)
 	if err != nil {
@@ -47,6 +47,7 @@ import (
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/lifecycle"
 	"github.com/uber/cadence/service/history/shard"
 	test "github.com/uber/cadence/service/history/testing"
 	warchiver "github.com/uber/cadence/service/worker/archiver"
@@ -160,6 +161,7 @@ func (s *transferStandbyTaskExecutorSuite) SetupTest() {
 		s.mockArchivalClient,
 		execution.NewCache(s.mockShard),
 		s.mockNDCHistoryResender,
+		lifecycle.NewNoopPublisher(),
 		s.logger,
 		s.clusterName,
 		config,
@@ -313,6 +315,39 @@ func (s *transferStandbyTaskExecutorSuite) TestProcessDecisionTask_Pending() {
 	s.Equal(ErrTaskRedispatch, err)
 }
 
+func (s *transferStandbyTaskExecutorSuite) TestProcessDecisionTask_Suspended() {
+
+	workflowExecution, mutableState, err := test.StartWorkflow(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	di := test.AddDecisionTaskScheduledEvent(mutableState)
+
+	now := time.Now()
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          workflowExecution.GetWorkflowID(),
+		RunID:               workflowExecution.GetRunID(),
+		VisibilityTimestamp: now,
+		TaskID:              int64(59),
+		TaskList:            mutableState.GetExecutionInfo().TaskList,
+		TaskType:            persistence.TransferTaskTypeDecisionTask,
+		ScheduleID:          di.ScheduleID,
+	})
+
+	_, err = mutableState.AddWorkflowExecutionSuspendedEvent("some random reason", "some random identity")
+	s.NoError(err)
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, di.ScheduleID, di.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	// the decision is neither pushed to matching nor discarded while the workflow is suspended
+	s.mockShard.SetCurrentTime(s.clusterName, now.Add(s.discardDuration))
+	err = s.transferStandbyTaskExecutor.Execute(transferTask, true)
+	s.Equal(ErrTaskWorkflowSuspended, err)
+}
+
 func (s *transferStandbyTaskExecutorSuite) TestProcessDecisionTask_Pending_PushToMatching() {
 
 	workflowExecution, mutableState, err := test.StartWorkflow(s.mockShard, s.domainID)
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/lifecycle"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/worker/archiver"
 )
@@ -59,6 +60,8 @@ type (
 		visibilityMgr  persistence.VisibilityManager
 		config         *config.Config
 		throttleRetry  *backoff.ThrottleRetry
+
+		lifecyclePublisher lifecycle.Publisher
 	}
 )
 
@@ -66,6 +69,7 @@ func newTransferTaskExecutorBase(
 	shard shard.Context,
 	archiverClient archiver.Client,
 	executionCache *execution.Cache,
+	lifecyclePublisher lifecycle.Publisher,
 	logger log.Logger,
 	config *config.Config,
 ) *transferTaskExecutorBase {
@@ -82,6 +86,7 @@ func newTransferTaskExecutorBase(
 			backoff.WithRetryPolicy(taskRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		lifecyclePublisher: lifecyclePublisher,
 	}
 }
 
@@ -186,6 +191,7 @@ func (t *transferTaskExecutorBase) recordWorkflowStarted(
 		IsCron:             isCron,
 		NumClusters:        numClusters,
 		SearchAttributes:   searchAttributes,
+		EncryptionKeyID:    t.config.PayloadEncryptionKeyID(domain),
 	}
 
 	return t.visibilityMgr.RecordWorkflowExecutionStarted(ctx, request)
@@ -233,6 +239,7 @@ func (t *transferTaskExecutorBase) upsertWorkflowExecution(
 		IsCron:             isCron,
 		NumClusters:        numClusters,
 		SearchAttributes:   searchAttributes,
+		EncryptionKeyID:    t.config.PayloadEncryptionKeyID(domain),
 	}
 
 	return t.visibilityMgr.UpsertWorkflowExecution(ctx, request)
@@ -255,6 +262,7 @@ func (t *transferTaskExecutorBase) recordWorkflowClosed(
 	isCron bool,
 	numClusters int16,
 	searchAttributes map[string][]byte,
+	retentionDays int32,
 ) error {
 
 	// Record closing in visibility store
@@ -282,6 +290,10 @@ func (t *transferTaskExecutorBase) recordWorkflowClosed(
 		domainConfiguredForVisibilityArchival := domainEntry.GetConfig().VisibilityArchivalStatus == types.ArchivalStatusEnabled
 		archiveVisibility = clusterConfiguredForVisibilityArchival && domainConfiguredForVisibilityArchival
 	}
+	if retentionDays > 0 {
+		// workflow level override takes precedence over domain retention
+		retentionSeconds = int64(retentionDays) * int64(secondsInDay)
+	}
 
 	if recordWorkflowClose {
 		if err := t.visibilityMgr.RecordWorkflowExecutionClosed(ctx, &persistence.RecordWorkflowExecutionClosedRequest{
@@ -304,6 +316,7 @@ func (t *transferTaskExecutorBase) recordWorkflowClosed(
 			SearchAttributes:   searchAttributes,
 			IsCron:             isCron,
 			NumClusters:        numClusters,
+			EncryptionKeyID:    t.config.PayloadEncryptionKeyID(domain),
 		}); err != nil {
 			return err
 		}
@@ -338,6 +351,86 @@ func (t *transferTaskExecutorBase) recordWorkflowClosed(
 	return nil
 }
 
+func (t *transferTaskExecutorBase) publishWorkflowLifecycleEvents(
+	ctx context.Context,
+	task *persistence.TransferTaskInfo,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTransferTask(ctx, wfContext, task, t.metricsClient, t.logger)
+	if err != nil || mutableState == nil {
+		return err
+	}
+
+	domainName := mutableState.GetDomainEntry().GetInfo().Name
+	if !t.config.EnableWorkflowLifecycleEvents(domainName) {
+		return nil
+	}
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return err
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	workflow := lifecycle.WorkflowInfo{
+		DomainID:     task.DomainID,
+		DomainName:   domainName,
+		WorkflowID:   task.WorkflowID,
+		RunID:        task.RunID,
+		WorkflowType: executionInfo.WorkflowTypeName,
+	}
+	// release the context lock since we no longer need mutable state builder and
+	// the rest of logic is reading history and publishing to kafka, which takes time.
+	release(nil)
+
+	// the task is generated for the history event batch starting at ScheduleID
+	response, err := t.shard.GetHistoryManager().ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  task.ScheduleID,
+		MaxEventID:  task.ScheduleID + 1,
+		PageSize:    1,
+		ShardID:     common.IntPtr(t.shard.GetShardID()),
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil
+		}
+		return err
+	}
+	historyEvents := response.HistoryEvents
+	if len(historyEvents) == 0 ||
+		historyEvents[0].EventID != task.ScheduleID ||
+		historyEvents[0].Version != task.Version {
+		// the batch is no longer on the current branch, e.g. the workflow was rebuilt by conflict resolution
+		t.logger.Warn("Skip publishing workflow lifecycle events, history event batch not found on current branch.",
+			tag.WorkflowDomainID(task.DomainID),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID),
+			tag.WorkflowFirstEventID(task.ScheduleID),
+		)
+		return nil
+	}
+
+	events := lifecycle.NewEvents(
+		workflow,
+		task.TaskID,
+		historyEvents,
+		t.config.WorkflowLifecycleEventsIncludeSearchAttributes(domainName),
+	)
+	return t.lifecyclePublisher.Publish(ctx, events)
+}
+
 // Argument startEvent is to save additional call of msBuilder.GetStartEvent
 func getWorkflowExecutionTimestamp(
 	msBuilder execution.MutableState,
@@ -181,6 +181,54 @@ func (t ThriftHandler) RefreshWorkflowTasks(ctx context.Context, request *h.Refr
 	return thrift.FromError(err)
 }
 
+// FailoverWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) FailoverWorkflowExecution(ctx context.Context, request *h.FailoverWorkflowExecutionRequest) error {
+	err := t.h.FailoverWorkflowExecution(ctx, thrift.ToHistoryFailoverWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// SuspendWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) SuspendWorkflowExecution(ctx context.Context, request *h.SuspendWorkflowExecutionRequest) error {
+	err := t.h.SuspendWorkflowExecution(ctx, thrift.ToHistorySuspendWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// ResumeWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) ResumeWorkflowExecution(ctx context.Context, request *h.ResumeWorkflowExecutionRequest) error {
+	err := t.h.ResumeWorkflowExecution(ctx, thrift.ToHistoryResumeWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// ReindexWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) ReindexWorkflowExecution(ctx context.Context, request *h.ReindexWorkflowExecutionRequest) error {
+	err := t.h.ReindexWorkflowExecution(ctx, thrift.ToHistoryReindexWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// RebuildMutableState forwards request to the underlying handler
+func (t ThriftHandler) RebuildMutableState(ctx context.Context, request *h.RebuildMutableStateRequest) (*h.RebuildMutableStateResponse, error) {
+	response, err := t.h.RebuildMutableState(ctx, thrift.ToHistoryRebuildMutableStateRequest(request))
+	return thrift.FromRebuildMutableStateResponse(response), thrift.FromError(err)
+}
+
+// ListWorkflowTasks forwards request to the underlying handler
+func (t ThriftHandler) ListWorkflowTasks(ctx context.Context, request *h.ListWorkflowTasksRequest) (*shared.ListWorkflowTasksResponse, error) {
+	response, err := t.h.ListWorkflowTasks(ctx, thrift.ToHistoryListWorkflowTasksRequest(request))
+	return thrift.FromListWorkflowTasksResponse(response), thrift.FromError(err)
+}
+
+// DeleteWorkflowTask forwards request to the underlying handler
+func (t ThriftHandler) DeleteWorkflowTask(ctx context.Context, request *h.DeleteWorkflowTaskRequest) error {
+	err := t.h.DeleteWorkflowTask(ctx, thrift.ToHistoryDeleteWorkflowTaskRequest(request))
+	return thrift.FromError(err)
+}
+
+// ReenqueueWorkflowTask forwards request to the underlying handler
+func (t ThriftHandler) ReenqueueWorkflowTask(ctx context.Context, request *h.ReenqueueWorkflowTaskRequest) error {
+	err := t.h.ReenqueueWorkflowTask(ctx, thrift.ToHistoryReenqueueWorkflowTaskRequest(request))
+	return thrift.FromError(err)
+}
+
 // RemoveSignalMutableState forwards request to the underlying handler
 func (t ThriftHandler) RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error {
 	err := t.h.RemoveSignalMutableState(ctx, thrift.ToRemoveSignalMutableStateRequest(request))
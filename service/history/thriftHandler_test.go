@@ -188,11 +188,53 @@ func TestThriftHandler(t *testing.T) {
 		}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("FailoverWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().FailoverWorkflowExecution(ctx, &types.HistoryFailoverWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.FailoverWorkflowExecution(ctx, &hist.FailoverWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RefreshWorkflowTasks", func(t *testing.T) {
 		h.EXPECT().RefreshWorkflowTasks(ctx, &types.HistoryRefreshWorkflowTasksRequest{}).Return(internalErr).Times(1)
 		err := th.RefreshWorkflowTasks(ctx, &hist.RefreshWorkflowTasksRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("SuspendWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().SuspendWorkflowExecution(ctx, &types.HistorySuspendWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.SuspendWorkflowExecution(ctx, &hist.SuspendWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ReindexWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().ReindexWorkflowExecution(ctx, &types.HistoryReindexWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.ReindexWorkflowExecution(ctx, &hist.ReindexWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("RebuildMutableState", func(t *testing.T) {
+		h.EXPECT().RebuildMutableState(ctx, &types.HistoryRebuildMutableStateRequest{}).Return(&types.RebuildMutableStateResponse{}, internalErr).Times(1)
+		resp, err := th.RebuildMutableState(ctx, &hist.RebuildMutableStateRequest{})
+		assert.Equal(t, hist.RebuildMutableStateResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ListWorkflowTasks", func(t *testing.T) {
+		h.EXPECT().ListWorkflowTasks(ctx, &types.HistoryListWorkflowTasksRequest{}).Return(&types.ListWorkflowTasksResponse{}, internalErr).Times(1)
+		resp, err := th.ListWorkflowTasks(ctx, &hist.ListWorkflowTasksRequest{})
+		assert.Equal(t, shared.ListWorkflowTasksResponse{ShardID: common.Int32Ptr(0), Truncated: common.BoolPtr(false)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DeleteWorkflowTask", func(t *testing.T) {
+		h.EXPECT().DeleteWorkflowTask(ctx, &types.HistoryDeleteWorkflowTaskRequest{}).Return(internalErr).Times(1)
+		err := th.DeleteWorkflowTask(ctx, &hist.DeleteWorkflowTaskRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ReenqueueWorkflowTask", func(t *testing.T) {
+		h.EXPECT().ReenqueueWorkflowTask(ctx, &types.HistoryReenqueueWorkflowTaskRequest{}).Return(internalErr).Times(1)
+		err := th.ReenqueueWorkflowTask(ctx, &hist.ReenqueueWorkflowTaskRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ResumeWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().ResumeWorkflowExecution(ctx, &types.HistoryResumeWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.ResumeWorkflowExecution(ctx, &hist.ResumeWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RemoveSignalMutableState", func(t *testing.T) {
 		h.EXPECT().RemoveSignalMutableState(ctx, &types.RemoveSignalMutableStateRequest{}).Return(internalErr).Times(1)
 		err := th.RemoveSignalMutableState(ctx, &hist.RemoveSignalMutableStateRequest{})
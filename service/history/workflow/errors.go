@@ -37,6 +37,14 @@ var (
 	ErrNotExists = &types.EntityNotExistsError{Message: "workflow execution already completed"}
 	// ErrAlreadyCompleted is the error to indicate workflow execution already completed
 	ErrAlreadyCompleted = &types.WorkflowExecutionAlreadyCompletedError{Message: "workflow execution already completed"}
+	// ErrSuspended is the error to indicate workflow is suspended and its tasks are held back until it is resumed
+	ErrSuspended = &types.EntityNotExistsError{Message: "workflow execution is suspended"}
+	// ErrAlreadySuspended is the error to indicate workflow is already suspended
+	ErrAlreadySuspended = &types.BadRequestError{Message: "workflow execution is already suspended"}
+	// ErrNotSuspended is the error to indicate workflow is not suspended
+	ErrNotSuspended = &types.BadRequestError{Message: "workflow execution is not suspended"}
+	// ErrDomainNotActiveActive is the error to indicate the domain of the workflow is not an active-active domain
+	ErrDomainNotActiveActive = &types.BadRequestError{Message: "domain is not an active-active domain"}
 	// ErrParentMismatch is the error to parent execution is given and mismatch
 	ErrParentMismatch = &types.EntityNotExistsError{Message: "workflow parent does not match"}
 	// ErrDeserializingToken is the error to indicate task token is invalid
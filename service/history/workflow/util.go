@@ -144,7 +144,7 @@ func UpdateWithActionFunc(
 	}
 	defer func() { workflowContext.GetReleaseFn()(retError) }()
 
-	return updateHelper(ctx, workflowContext, now, action)
+	return updateHelper(ctx, workflowContext, now, true, action)
 }
 
 // UpdateCurrentWithActionFunc updates the given workflow execution or current execution if runID is empty.
@@ -158,6 +158,47 @@ func UpdateCurrentWithActionFunc(
 	execution types.WorkflowExecution,
 	now time.Time,
 	action UpdateActionFunc,
+) error {
+
+	return updateCurrentHelper(ctx, cache, executionManager, domainID, execution, now, true, action)
+}
+
+// FailoverCurrentWithActionFunc updates the given workflow execution or current execution if runID is empty.
+// Unlike UpdateCurrentWithActionFunc, the workflow doesn't need to be active in the current cluster,
+// it is used for failing over a workflow of an active-active domain to the current cluster.
+func FailoverCurrentWithActionFunc(
+	ctx context.Context,
+	cache *execution.Cache,
+	executionManager persistence.ExecutionManager,
+	domainID string,
+	execution types.WorkflowExecution,
+	now time.Time,
+	action UpdateActionFunc,
+) error {
+
+	return updateCurrentHelper(ctx, cache, executionManager, domainID, execution, now, false, action)
+}
+
+// ValidateActive returns a domain not active error if the workflow is not active in the current cluster.
+// The activeness of a domain is checked when the domain is loaded, so this only matters for the workflows
+// of active-active domains, each of which is active in the cluster of its failover version.
+func ValidateActive(mutableState execution.MutableState) error {
+	domainEntry := mutableState.GetDomainEntry()
+	if !domainEntry.IsActiveActive() {
+		return nil
+	}
+	return domainEntry.GetWorkflowNotActiveErr(mutableState.GetCurrentVersion())
+}
+
+func updateCurrentHelper(
+	ctx context.Context,
+	cache *execution.Cache,
+	executionManager persistence.ExecutionManager,
+	domainID string,
+	execution types.WorkflowExecution,
+	now time.Time,
+	requireActive bool,
+	action UpdateActionFunc,
 ) (retError error) {
 
 	workflowContext, err := Load(ctx, cache, executionManager, domainID, execution.GetWorkflowID(), execution.GetRunID())
@@ -166,7 +207,7 @@ func UpdateCurrentWithActionFunc(
 	}
 	defer func() { workflowContext.GetReleaseFn()(retError) }()
 
-	return updateHelper(ctx, workflowContext, now, action)
+	return updateHelper(ctx, workflowContext, now, requireActive, action)
 }
 
 // TODO: deprecate and use UpdateWithActionFunc
@@ -211,6 +252,7 @@ func updateHelper(
 	ctx context.Context,
 	workflowContext Context,
 	now time.Time,
+	requireActive bool,
 	action UpdateActionFunc,
 ) (retError error) {
 
@@ -219,6 +261,12 @@ UpdateHistoryLoop:
 		wfContext := workflowContext.GetContext()
 		mutableState := workflowContext.GetMutableState()
 
+		if requireActive {
+			if err := ValidateActive(mutableState); err != nil {
+				return err
+			}
+		}
+
 		// conduct caller action
 		postActions, err := action(wfContext, mutableState)
 		if err != nil {
@@ -30,7 +30,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
@@ -86,10 +89,11 @@ func TestUpdateHelper(t *testing.T) {
 			mockMutableState := execution.NewMockMutableState(controller)
 			mockContext := execution.NewMockContext(controller)
 			mockContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(mockMutableState, nil).AnyTimes()
+			mockMutableState.EXPECT().GetDomainEntry().Return(constants.TestGlobalDomainEntry).AnyTimes()
 			workflowContext := NewContext(mockContext, nil, mockMutableState)
 
 			tc.mockSetupFn(mockContext, mockMutableState)
-			err := updateHelper(context.Background(), workflowContext, time.Now(), tc.actionFn)
+			err := updateHelper(context.Background(), workflowContext, time.Now(), true, tc.actionFn)
 			require.NoError(t, err)
 
 			controller.Finish()
@@ -98,6 +102,78 @@ func TestUpdateHelper(t *testing.T) {
 
 }
 
+func TestUpdateHelper_ActiveActive(t *testing.T) {
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   constants.TestDomainID,
+			Name: constants.TestDomainName,
+			Data: map[string]string{common.DomainDataKeyForActiveActive: "true"},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		constants.TestVersion,
+		cluster.GetTestClusterMetadata(true, true),
+	)
+
+	testCases := []struct {
+		msg                   string
+		version               int64
+		requireActive         bool
+		expectedActiveCluster string
+	}{
+		{
+			msg:           "workflow active in current cluster",
+			version:       cluster.TestCurrentClusterInitialFailoverVersion,
+			requireActive: true,
+		},
+		{
+			msg:                   "workflow active in alternative cluster",
+			version:               cluster.TestAlternativeClusterInitialFailoverVersion,
+			requireActive:         true,
+			expectedActiveCluster: cluster.TestAlternativeClusterName,
+		},
+		{
+			msg:           "failover workflow active in alternative cluster",
+			version:       cluster.TestAlternativeClusterInitialFailoverVersion,
+			requireActive: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			mockMutableState := execution.NewMockMutableState(controller)
+			mockMutableState.EXPECT().GetDomainEntry().Return(domainEntry).AnyTimes()
+			mockMutableState.EXPECT().GetCurrentVersion().Return(tc.version).AnyTimes()
+			mockContext := execution.NewMockContext(controller)
+			workflowContext := NewContext(mockContext, nil, mockMutableState)
+
+			actionCalled := false
+			err := updateHelper(context.Background(), workflowContext, time.Now(), tc.requireActive,
+				func(context execution.Context, mutableState execution.MutableState) (*UpdateAction, error) {
+					actionCalled = true
+					return &UpdateAction{Noop: true}, nil
+				})
+			if tc.expectedActiveCluster != "" {
+				require.IsType(t, &types.DomainNotActiveError{}, err)
+				require.Equal(t, tc.expectedActiveCluster, err.(*types.DomainNotActiveError).ActiveCluster)
+				require.False(t, actionCalled)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, actionCalled)
+		})
+	}
+}
+
 func TestWorkflowLoad(t *testing.T) {
 	persistenceMS := &persistence.WorkflowMutableState{
 		ExecutionInfo: &persistence.WorkflowExecutionInfo{
@@ -71,10 +71,12 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypeReplicate is batch type for replicating workflows to other clusters
+	BatchTypeReplicate = "replicate"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -99,6 +101,14 @@ type (
 		Input      string
 	}
 
+	// ReplicateParams is the parameters for replicating workflow
+	ReplicateParams struct {
+		// cluster the workflow histories are replicated from
+		SourceCluster string
+		// clusters the workflow histories are replicated to
+		TargetClusters []string
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target domain to execute batch operation
@@ -117,6 +127,8 @@ type (
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
+		// ReplicateParams is params only for BatchTypeReplicate
+		ReplicateParams ReplicateParams
 		// RPS of processing. Default to DefaultRPS
 		// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
 		RPS int
@@ -199,6 +211,14 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
+	case BatchTypeReplicate:
+		if params.ReplicateParams.SourceCluster == "" {
+			return fmt.Errorf("must provide source cluster")
+		}
+		if len(params.ReplicateParams.TargetClusters) == 0 {
+			return fmt.Errorf("must provide target clusters")
+		}
+		return nil
 	case BatchTypeCancel:
 		fallthrough
 	case BatchTypeTerminate:
@@ -250,6 +270,20 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		}
 	}
 
+	var domainID string
+	if batchParams.BatchType == BatchTypeReplicate {
+		if err := validateReplicateClusters(batcher, batchParams.ReplicateParams); err != nil {
+			return HeartBeatDetails{}, err
+		}
+		resp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{
+			Name: common.StringPtr(batchParams.DomainName),
+		})
+		if err != nil {
+			return HeartBeatDetails{}, err
+		}
+		domainID = resp.GetDomainInfo().GetUUID()
+	}
+
 	if startOver {
 		resp, err := client.CountWorkflowExecutions(ctx, &types.CountWorkflowExecutionsRequest{
 			Domain: batchParams.DomainName,
@@ -264,7 +298,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	taskCh := make(chan taskDetail, pageSize)
 	respCh := make(chan error, pageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, client)
+		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, rateLimiter, client)
 	}
 
 	for {
@@ -328,9 +362,20 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	return hbd, nil
 }
 
+func validateReplicateClusters(batcher *Batcher, params ReplicateParams) error {
+	clusters := batcher.cfg.ClusterMetadata.GetAllClusterInfo()
+	for _, clusterName := range append([]string{params.SourceCluster}, params.TargetClusters...) {
+		if _, ok := clusters[clusterName]; !ok {
+			return cadence.NewCustomError(fmt.Sprintf("unknown cluster: %v", clusterName))
+		}
+	}
+	return nil
+}
+
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	domainID string,
 	taskCh chan taskDetail,
 	respCh chan error,
 	limiter *rate.Limiter,
@@ -392,6 +437,11 @@ func startTaskProcessor(
 							Input:      []byte(batchParams.SignalParams.Input),
 						})
 					})
+			case BatchTypeReplicate:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return replicateWorkflow(ctx, batcher, batchParams, domainID, workflowID, runID)
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
@@ -472,6 +522,42 @@ func processTask(
 	return nil
 }
 
+// replicateWorkflow regenerates the tasks of the workflow in the source cluster so that
+// it has version histories, then asks each target cluster to pull its history
+func replicateWorkflow(
+	ctx context.Context,
+	batcher *Batcher,
+	batchParams BatchParams,
+	domainID string,
+	workflowID string,
+	runID string,
+) error {
+	sourceCluster := batchParams.ReplicateParams.SourceCluster
+	err := batcher.clientBean.GetRemoteAdminClient(sourceCluster).RefreshWorkflowTasks(ctx, &types.RefreshWorkflowTasksRequest{
+		Domain: batchParams.DomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, targetCluster := range batchParams.ReplicateParams.TargetClusters {
+		err := batcher.clientBean.GetRemoteAdminClient(targetCluster).ResendReplicationTasks(ctx, &types.ResendReplicationTasksRequest{
+			DomainID:      domainID,
+			WorkflowID:    workflowID,
+			RunID:         runID,
+			RemoteCluster: sourceCluster,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -0,0 +1,88 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domainusage
+
+import (
+	"context"
+
+	"go.uber.org/cadence/activity"
+
+	cdomainusage "github.com/uber/cadence/common/domainusage"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// AggregateActivity reads the usage counters persisted in the shard info of every history shard,
+// sums them up by domain and emits them as per-domain gauges
+func AggregateActivity(ctx context.Context) (*AggregateActivityResult, error) {
+	a := getAggregator(ctx)
+	res := a.resource
+
+	usages := make(map[string]*types.DomainUsage)
+	for shardID := 0; shardID < a.cfg.NumHistoryShards; shardID++ {
+		resp, err := res.GetShardManager().GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				// the shard is not created until it is first owned by a history host
+				continue
+			}
+			return nil, err
+		}
+		for domainID, shardUsage := range resp.ShardInfo.DomainUsages.GetUsagesByDomainID() {
+			usage, ok := usages[domainID]
+			if !ok {
+				usage = &types.DomainUsage{}
+				usages[domainID] = usage
+			}
+			usage.HistorySize += shardUsage.GetHistorySize()
+			usage.HistoryEventCount += shardUsage.GetHistoryEventCount()
+			usage.OpenWorkflowCount += shardUsage.GetOpenWorkflowCount()
+			usage.ClosedWorkflowCount += shardUsage.GetClosedWorkflowCount()
+		}
+		activity.RecordHeartbeat(ctx, shardID)
+	}
+
+	for domainID, usage := range usages {
+		domainName, err := res.GetDomainCache().GetDomainName(domainID)
+		if err != nil {
+			// the domain may be deleted while its workflows are still retained
+			continue
+		}
+		scope := res.GetMetricsClient().Scope(metrics.DomainUsageAggregatorScope, metrics.DomainTag(domainName))
+		scope.UpdateGauge(metrics.DomainUsageHistorySize, float64(usage.HistorySize))
+		scope.UpdateGauge(metrics.DomainUsageHistoryEventCount, float64(usage.HistoryEventCount))
+		scope.UpdateGauge(metrics.DomainUsageOpenWorkflowCount, float64(usage.OpenWorkflowCount))
+		scope.UpdateGauge(metrics.DomainUsageClosedWorkflowCount, float64(usage.ClosedWorkflowCount))
+	}
+
+	return &AggregateActivityResult{
+		Snapshot: &cdomainusage.Snapshot{
+			UsagesByDomainID: usages,
+			LastUpdateTime:   res.GetTimeSource().Now().UnixNano(),
+		},
+		NextInterval: a.cfg.AggregationInterval(),
+	}, nil
+}
+
+func getAggregator(ctx context.Context) *Aggregator {
+	return ctx.Value(aggregatorContextKey).(*Aggregator)
+}
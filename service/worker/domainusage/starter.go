@@ -0,0 +1,128 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domainusage
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	cdomainusage "github.com/uber/cadence/common/domainusage"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+type (
+	// Config defines the configuration for the domain usage aggregator
+	Config struct {
+		// NumHistoryShards is the number of history shards of this cluster
+		NumHistoryShards int
+		// AggregationInterval is the interval between two aggregations
+		AggregationInterval dynamicconfig.DurationPropertyFn
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the domain usage aggregator
+	BootstrapParams struct {
+		// Config contains the configuration for the aggregator
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// Resource is the worker service resource, used to reach persistence
+		Resource resource.Resource
+	}
+
+	// Aggregator of cadence worker service, which periodically sums up the
+	// per-domain usage counters maintained by the history shards
+	Aggregator struct {
+		cfg        Config
+		svcClient  workflowserviceclient.Interface
+		tallyScope tally.Scope
+		resource   resource.Resource
+		worker     worker.Worker
+	}
+)
+
+const startUpDelay = time.Second * 10
+
+var wfOptions = cclient.StartWorkflowOptions{
+	ID:                           cdomainusage.WorkflowID,
+	TaskList:                     TaskListName,
+	ExecutionStartToCloseTimeout: 365 * 24 * time.Hour,
+	WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+}
+
+// New returns a new instance of Aggregator
+func New(params *BootstrapParams) *Aggregator {
+	return &Aggregator{
+		cfg:        params.Config,
+		svcClient:  params.ServiceClient,
+		tallyScope: params.TallyScope,
+		resource:   params.Resource,
+	}
+}
+
+// Start starts the worker and the long running aggregator workflow
+func (a *Aggregator) Start() error {
+	ctx := context.WithValue(context.Background(), aggregatorContextKey, a)
+	workerOpts := worker.Options{
+		MetricsScope:              a.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	aggregatorWorker := worker.New(a.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	aggregatorWorker.RegisterWorkflowWithOptions(AggregatorWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	aggregatorWorker.RegisterActivityWithOptions(AggregateActivity, activity.RegisterOptions{Name: aggregateActivityName})
+	a.worker = aggregatorWorker
+	if err := aggregatorWorker.Start(); err != nil {
+		return err
+	}
+
+	go workercommon.StartWorkflowWithRetry(WorkflowTypeName, startUpDelay, a.resource, func(client cclient.Client) error {
+		_, err := client.StartWorkflow(context.Background(), wfOptions, WorkflowTypeName, &AggregatorParams{})
+		switch err.(type) {
+		case nil, *shared.WorkflowExecutionAlreadyStartedError:
+			return nil
+		default:
+			a.resource.GetLogger().Error("Failed to start domain usage aggregator", tag.Error(err))
+			return err
+		}
+	})
+	return nil
+}
+
+// Stop stops the worker
+func (a *Aggregator) Stop() {
+	a.worker.Stop()
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domainusage
+
+import (
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+
+	cdomainusage "github.com/uber/cadence/common/domainusage"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	aggregatorContextKey contextKey = "domainUsageAggregatorContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-domainUsage-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName      = "cadence-sys-domainUsage-workflow"
+	aggregateActivityName = "cadence-sys-domainUsage-aggregate-activity"
+
+	// number of aggregations done before the workflow continues as new, to bound its history size
+	maxAggregationsPerRun = 100
+	// interval used until the first aggregation succeeds
+	defaultAggregationInterval = 10 * time.Minute
+)
+
+type (
+	// AggregatorParams is the arg for AggregatorWorkflow
+	AggregatorParams struct {
+		// Snapshot is carried over across continue-as-new, so that queries
+		// are answered before the first aggregation of the new run
+		Snapshot *cdomainusage.Snapshot
+	}
+
+	// AggregateActivityResult result for activity
+	AggregateActivityResult struct {
+		Snapshot *cdomainusage.Snapshot
+		// NextInterval is read from dynamic config by the activity, so that the workflow stays deterministic
+		NextInterval time.Duration
+	}
+)
+
+// AggregatorWorkflow periodically sums up the usage counters which history shards keep per domain,
+// and serves the latest result through cdomainusage.QueryType. Failed aggregations keep the previous snapshot.
+func AggregatorWorkflow(ctx workflow.Context, params *AggregatorParams) error {
+	snapshot := &cdomainusage.Snapshot{}
+	if params != nil && params.Snapshot != nil {
+		snapshot = params.Snapshot
+	}
+	err := workflow.SetQueryHandler(ctx, cdomainusage.QueryType, func(input []byte) (*cdomainusage.Snapshot, error) {
+		return snapshot, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	interval := defaultAggregationInterval
+	ao := workflow.WithActivityOptions(ctx, getAggregateActivityOptions())
+	for i := 0; i < maxAggregationsPerRun; i++ {
+		var result AggregateActivityResult
+		if err := workflow.ExecuteActivity(ao, AggregateActivity).Get(ctx, &result); err != nil {
+			workflow.GetLogger(ctx).Warn("domain usage aggregation failed")
+		} else {
+			snapshot = result.Snapshot
+			if result.NextInterval > 0 {
+				interval = result.NextInterval
+			}
+		}
+		if err := workflow.Sleep(ctx, interval); err != nil {
+			return err
+		}
+	}
+	return workflow.NewContinueAsNewError(ctx, WorkflowTypeName, &AggregatorParams{Snapshot: snapshot})
+}
+
+func getAggregateActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    10 * time.Minute,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: 10 * time.Minute,
+		},
+	}
+}
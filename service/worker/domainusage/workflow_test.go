@@ -0,0 +1,157 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domainusage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/clock"
+	cdomainusage "github.com/uber/cadence/common/domainusage"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+type aggregatorWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestAggregatorWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(aggregatorWorkflowTestSuite))
+}
+
+func (s *aggregatorWorkflowTestSuite) SetupTest() {
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(AggregatorWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(AggregateActivity, activity.RegisterOptions{Name: aggregateActivityName})
+	s.activityEnv.RegisterActivityWithOptions(AggregateActivity, activity.RegisterOptions{Name: aggregateActivityName})
+}
+
+func (s *aggregatorWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *aggregatorWorkflowTestSuite) TestWorkflow() {
+	snapshot := &cdomainusage.Snapshot{
+		UsagesByDomainID: map[string]*types.DomainUsage{
+			"domain-id": {HistorySize: 1024, HistoryEventCount: 10, OpenWorkflowCount: 1, ClosedWorkflowCount: 2},
+		},
+		LastUpdateTime: 123,
+	}
+	s.workflowEnv.OnActivity(aggregateActivityName, mock.Anything).Return(nil, errors.New("mockErr")).Once()
+	s.workflowEnv.OnActivity(aggregateActivityName, mock.Anything).Return(&AggregateActivityResult{
+		Snapshot:     snapshot,
+		NextInterval: time.Minute,
+	}, nil).Times(maxAggregationsPerRun - 1)
+
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		value, err := s.workflowEnv.QueryWorkflow(cdomainusage.QueryType)
+		s.NoError(err)
+		var result cdomainusage.Snapshot
+		s.NoError(value.Get(&result))
+		s.Equal(*snapshot, result)
+	}, defaultAggregationInterval+time.Second)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &AggregatorParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	s.True(errors.As(s.workflowEnv.GetWorkflowError(), &continueAsNewErr))
+}
+
+func (s *aggregatorWorkflowTestSuite) TestAggregateActivity() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	mockResource.ShardMgr.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: 0}).
+		Return(&persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{
+			ShardID: 0,
+			DomainUsages: &types.DomainUsages{UsagesByDomainID: map[string]*types.DomainUsage{
+				"domain-id-1": {HistorySize: 100, HistoryEventCount: 10, OpenWorkflowCount: 1},
+				"domain-id-2": {HistorySize: 50, HistoryEventCount: 5, ClosedWorkflowCount: 1},
+			}},
+		}}, nil)
+	mockResource.ShardMgr.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: 1}).
+		Return(nil, &types.EntityNotExistsError{})
+	mockResource.ShardMgr.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: 2}).
+		Return(&persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{
+			ShardID: 2,
+			DomainUsages: &types.DomainUsages{UsagesByDomainID: map[string]*types.DomainUsage{
+				"domain-id-1": {HistorySize: 200, HistoryEventCount: 20, ClosedWorkflowCount: 2},
+			}},
+		}}, nil)
+	mockResource.ShardMgr.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: 3}).
+		Return(&persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: 3}}, nil)
+	mockResource.DomainCache.EXPECT().GetDomainName("domain-id-1").Return("domain1", nil)
+	mockResource.DomainCache.EXPECT().GetDomainName("domain-id-2").Return("", &types.EntityNotExistsError{})
+
+	actResult, err := env.ExecuteActivity(aggregateActivityName)
+	s.NoError(err)
+	var result AggregateActivityResult
+	s.NoError(actResult.Get(&result))
+	s.Equal(AggregateActivityResult{
+		Snapshot: &cdomainusage.Snapshot{
+			UsagesByDomainID: map[string]*types.DomainUsage{
+				"domain-id-1": {HistorySize: 300, HistoryEventCount: 30, OpenWorkflowCount: 1, ClosedWorkflowCount: 2},
+				"domain-id-2": {HistorySize: 50, HistoryEventCount: 5, ClosedWorkflowCount: 1},
+			},
+			LastUpdateTime: time.Unix(0, 100).UnixNano(),
+		},
+		NextInterval: 5 * time.Minute,
+	}, result)
+}
+
+func (s *aggregatorWorkflowTestSuite) prepareTestActivityEnv() (*testsuite.TestActivityEnvironment, *resource.Test, *gomock.Controller) {
+	controller := gomock.NewController(s.T())
+	mockResource := resource.NewTest(controller, metrics.Worker)
+	mockResource.TimeSource = clock.NewEventTimeSource().Update(time.Unix(0, 100))
+
+	ctx := &Aggregator{
+		cfg: Config{
+			NumHistoryShards:    4,
+			AggregationInterval: func(...dynamicconfig.FilterOption) time.Duration { return 5 * time.Minute },
+		},
+		svcClient: mockResource.GetSDKClient(),
+		resource:  mockResource,
+	}
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), aggregatorContextKey, ctx),
+	})
+	return s.activityEnv, mockResource, controller
+}
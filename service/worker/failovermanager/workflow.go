@@ -382,7 +382,9 @@ func shouldFailover(domain *types.DescribeDomainResponse, sourceCluster string)
 	}
 	currentActiveCluster := domain.ReplicationConfiguration.GetActiveClusterName()
 	isDomainTarget := currentActiveCluster == sourceCluster
-	return isDomainTarget && isDomainFailoverManagedByCadence(domain)
+	// active-active domains are not failed over as a whole
+	isActiveActive := common.IsActiveActiveDomainData(domain.DomainInfo.GetData())
+	return isDomainTarget && isDomainFailoverManagedByCadence(domain) && !isActiveActive
 }
 
 func isDomainFailoverManagedByCadence(domain *types.DescribeDomainResponse) bool {
@@ -320,6 +320,23 @@ func (s *failoverWorkflowTestSuite) TestShouldFailover() {
 			sourceCluster: "c2",
 			expected:      true,
 		},
+		{
+			domain: &types.DescribeDomainResponse{
+				IsGlobalDomain: true,
+				ReplicationConfiguration: &types.DomainReplicationConfiguration{
+					ActiveClusterName: "c2",
+					Clusters:          clusters,
+				},
+				DomainInfo: &types.DomainInfo{
+					Data: map[string]string{
+						common.DomainDataKeyForManagedFailover: "true",
+						common.DomainDataKeyForActiveActive:    "true",
+					},
+				},
+			},
+			sourceCluster: "c2",
+			expected:      false,
+		},
 	}
 	for _, t := range tests {
 		s.Equal(t.expected, shouldFailover(t.domain, t.sourceCluster))
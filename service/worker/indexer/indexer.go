@@ -53,6 +53,8 @@ type (
 		ESProcessorBulkSize      dynamicconfig.IntPropertyFn // max total size of bytes in bulk
 		ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
 		ValidSearchAttributes    dynamicconfig.MapPropertyFn
+		SearchAttributeAliases   dynamicconfig.MapPropertyFn
+		RemovedSearchAttributes  dynamicconfig.MapPropertyFn
 	}
 )
 
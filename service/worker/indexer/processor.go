@@ -248,8 +248,18 @@ func (p *indexProcessor) decodeSearchAttrBinary(bytes []byte, key string) interf
 func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field) map[string]interface{} {
 	doc := make(map[string]interface{})
 	attr := make(map[string]interface{})
+	var aliases map[string]interface{}
+	if p.config.SearchAttributeAliases != nil {
+		aliases = p.config.SearchAttributeAliases()
+	}
 	for k, v := range fields {
+		// documents of renamed search attributes are written under the current name
+		k = definition.ResolveIndexedKeyAlias(aliases, k)
 		if !p.isValidFieldToES(k) {
+			if p.isRemovedField(k) {
+				// the attribute was removed from the whitelist on purpose, see adminHandler.RemoveSearchAttribute
+				continue
+			}
 			p.logger.Error("Unregistered field.", tag.ESField(k))
 			p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
 			continue
@@ -287,6 +297,14 @@ func (p *indexProcessor) isValidFieldToES(field string) bool {
 	return false
 }
 
+func (p *indexProcessor) isRemovedField(field string) bool {
+	if p.config.RemovedSearchAttributes == nil {
+		return false
+	}
+	_, ok := p.config.RemovedSearchAttributes()[field]
+	return ok
+}
+
 func fulfillDoc(doc map[string]interface{}, msg *indexer.Message, keyToKafkaMsg string) {
 	doc[definition.DomainID] = msg.GetDomainID()
 	doc[definition.WorkflowID] = msg.GetWorkflowID()
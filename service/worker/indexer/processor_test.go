@@ -0,0 +1,68 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	mmocks "github.com/uber/cadence/common/metrics/mocks"
+)
+
+func TestDumpFieldsToMap_RemovedAndUnregisteredFields(t *testing.T) {
+	metricsClient := &mmocks.Client{}
+	metricsClient.On("IncCounter", metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData).Once()
+	p := &indexProcessor{
+		config: &Config{
+			ValidSearchAttributes: dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+				"CustomKeywordField": 1,
+			}),
+			RemovedSearchAttributes: dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+				"RemovedField": 1,
+			}),
+		},
+		logger:        loggerimpl.NewNopLogger(),
+		metricsClient: metricsClient,
+	}
+
+	stringField := func(value string) *indexer.Field {
+		return &indexer.Field{Type: indexer.FieldTypeString.Ptr(), StringData: common.StringPtr(value)}
+	}
+	doc := p.dumpFieldsToMap(map[string]*indexer.Field{
+		"CustomKeywordField": stringField("kept"),
+		"RemovedField":       stringField("dropped quietly"),
+		"UnknownField":       stringField("reported as corrupted"),
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"CustomKeywordField": "kept",
+		definition.Attr:      map[string]interface{}{},
+	}, doc)
+	// the corrupted data metric is only emitted for the unregistered field
+	metricsClient.AssertExpectations(t)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexer
+
+import (
+	"context"
+
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// shardHeartbeat is recorded after each page so that a retried activity resumes where it left off
+	shardHeartbeat struct {
+		NextPageToken []byte
+		Result        ReindexShardActivityResult
+	}
+)
+
+// GetShardCountActivity returns the number of history shards of the cluster
+func GetShardCountActivity(ctx context.Context) (int, error) {
+	return getReindexer(ctx).cfg.NumHistoryShards, nil
+}
+
+// ReindexShardActivity pages through the executions of a history shard and asks history
+// to rebuild the visibility record of each execution belonging to the domain
+func ReindexShardActivity(ctx context.Context, params *ReindexShardActivityParams) (*ReindexShardActivityResult, error) {
+	res := getReindexer(ctx).resource
+	domainID, err := res.GetDomainCache().GetDomainID(params.Domain)
+	if err != nil {
+		return nil, err
+	}
+	executionManager, err := res.GetExecutionManager(params.ShardID)
+	if err != nil {
+		return nil, err
+	}
+	pr := persistence.NewPersistenceRetryer(executionManager, res.GetHistoryManager(), common.CreatePersistenceRetryPolicy())
+	historyClient := res.GetHistoryClient()
+
+	var progress shardHeartbeat
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		resp, err := pr.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			PageSize:  params.PageSize,
+			PageToken: progress.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entity := range resp.Executions {
+			info := entity.ExecutionInfo
+			if info == nil || info.DomainID != domainID {
+				continue
+			}
+			err := historyClient.ReindexWorkflowExecution(ctx, &types.HistoryReindexWorkflowExecutionRequest{
+				DomainUUID: domainID,
+				Execution: &types.WorkflowExecution{
+					WorkflowID: info.WorkflowID,
+					RunID:      info.RunID,
+				},
+			})
+			switch err.(type) {
+			case nil:
+				progress.Result.Reindexed++
+			case *types.EntityNotExistsError:
+				// the execution is deleted after being listed
+			default:
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				progress.Result.Failed++
+				if len(progress.Result.FailedExecutions) < maxReportedExecutions {
+					progress.Result.FailedExecutions = append(progress.Result.FailedExecutions, ExecutionReport{
+						WorkflowID: info.WorkflowID,
+						RunID:      info.RunID,
+						Info:       err.Error(),
+					})
+				}
+			}
+		}
+
+		progress.NextPageToken = resp.PageToken
+		activity.RecordHeartbeat(ctx, progress)
+		if len(progress.NextPageToken) == 0 {
+			return &progress.Result, nil
+		}
+	}
+}
+
+func getReindexer(ctx context.Context) *Reindexer {
+	return ctx.Value(reindexerContextKey).(*Reindexer)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexer
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/resource"
+)
+
+type (
+	// Config defines the configuration for reindexer
+	Config struct {
+		// NumHistoryShards is the number of history shards of this cluster
+		NumHistoryShards int
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// reindexer
+	BootstrapParams struct {
+		// Config contains the configuration for reindexer
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// Resource is the worker service resource, used to reach persistence and history
+		Resource resource.Resource
+	}
+
+	// Reindexer of cadence worker service
+	Reindexer struct {
+		cfg        Config
+		svcClient  workflowserviceclient.Interface
+		tallyScope tally.Scope
+		resource   resource.Resource
+		worker     worker.Worker
+	}
+)
+
+// New returns a new instance of Reindexer
+func New(params *BootstrapParams) *Reindexer {
+	return &Reindexer{
+		cfg:        params.Config,
+		svcClient:  params.ServiceClient,
+		tallyScope: params.TallyScope,
+		resource:   params.Resource,
+	}
+}
+
+// Start starts the worker
+func (r *Reindexer) Start() error {
+	ctx := context.WithValue(context.Background(), reindexerContextKey, r)
+	workerOpts := worker.Options{
+		MetricsScope:              r.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	reindexWorker := worker.New(r.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	reindexWorker.RegisterWorkflowWithOptions(ReindexWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	reindexWorker.RegisterActivityWithOptions(GetShardCountActivity, activity.RegisterOptions{Name: getShardCountActivityName})
+	reindexWorker.RegisterActivityWithOptions(ReindexShardActivity, activity.RegisterOptions{Name: reindexShardActivityName})
+	r.worker = reindexWorker
+	return reindexWorker.Start()
+}
+
+// Stop stops the worker
+func (r *Reindexer) Stop() {
+	r.worker.Stop()
+}
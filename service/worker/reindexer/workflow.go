@@ -0,0 +1,212 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexer
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	reindexerContextKey contextKey = "reindexerContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-reindexer-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName = "cadence-sys-reindexer-workflow"
+	// WorkflowIDPrefix is followed by the domain name, so that only one reindex runs per domain
+	WorkflowIDPrefix          = "cadence-reindexer-"
+	getShardCountActivityName = "cadence-sys-reindexer-getShardCount-activity"
+	reindexShardActivityName  = "cadence-sys-reindexer-reindexShard-activity"
+	defaultPageSize           = 100
+	defaultConcurrency        = 10
+	// number of shards processed before the workflow continues as new, to bound its history size
+	maxShardsPerRun = 500
+	// upper bound on the number of failed executions reported
+	maxReportedExecutions = 20
+
+	errMsgParamsIsNil   = "params is nil"
+	errMsgDomainIsEmpty = "domain is empty"
+
+	// QueryType for reindex workflow
+	QueryType = "state"
+)
+
+type (
+	// ReindexParams is the arg for ReindexWorkflow
+	ReindexParams struct {
+		// Domain whose visibility records are rebuilt
+		Domain string
+		// PageSize is the number of executions read from persistence per page
+		PageSize int
+		// Concurrency is the number of shards reindexed in parallel
+		Concurrency int
+
+		// NumShards and NextShardID are set by the workflow itself, so a run started by
+		// continue-as-new picks up where the previous run stopped
+		NumShards   int
+		NextShardID int
+		// Progress is carried over across continue-as-new
+		Progress *ReindexResult
+	}
+
+	// ReindexResult is workflow result, and also returned by QueryType
+	ReindexResult struct {
+		ShardsDone       int
+		Reindexed        int
+		Failed           int
+		FailedExecutions []ExecutionReport
+		FailedShards     []int
+	}
+
+	// ExecutionReport describes an execution which failed to be reindexed
+	ExecutionReport struct {
+		WorkflowID string
+		RunID      string
+		Info       string
+	}
+
+	// ReindexShardActivityParams params for activity
+	ReindexShardActivityParams struct {
+		Domain   string
+		ShardID  int
+		PageSize int
+	}
+
+	// ReindexShardActivityResult result for activity
+	ReindexShardActivityResult struct {
+		Reindexed        int
+		Failed           int
+		FailedExecutions []ExecutionReport
+	}
+)
+
+// ReindexWorkflow rebuilds the visibility records of all executions of a domain from their mutable state.
+// It is used to backfill search attributes after they are added or renamed, or to repair a visibility
+// store that went out of sync. Shards are processed in batches of params.Concurrency.
+func ReindexWorkflow(ctx workflow.Context, params *ReindexParams) (*ReindexResult, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+
+	result := params.Progress
+	if result == nil {
+		result = &ReindexResult{}
+	}
+	err := workflow.SetQueryHandler(ctx, QueryType, func(input []byte) (*ReindexResult, error) {
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if params.NumShards == 0 {
+		ao := workflow.WithActivityOptions(ctx, getShardCountActivityOptions())
+		if err := workflow.ExecuteActivity(ao, GetShardCountActivity).Get(ctx, &params.NumShards); err != nil {
+			return nil, err
+		}
+	}
+
+	ao := workflow.WithActivityOptions(ctx, getReindexShardActivityOptions())
+	shardID := params.NextShardID
+	for processed := 0; shardID < params.NumShards && processed < maxShardsPerRun; {
+		batchEnd := shardID + params.Concurrency
+		if batchEnd > params.NumShards {
+			batchEnd = params.NumShards
+		}
+		var futures []workflow.Future
+		for id := shardID; id < batchEnd; id++ {
+			futures = append(futures, workflow.ExecuteActivity(ao, ReindexShardActivity, &ReindexShardActivityParams{
+				Domain:   params.Domain,
+				ShardID:  id,
+				PageSize: params.PageSize,
+			}))
+		}
+		for i, future := range futures {
+			var shardResult ReindexShardActivityResult
+			if err := future.Get(ctx, &shardResult); err != nil {
+				result.FailedShards = append(result.FailedShards, shardID+i)
+				continue
+			}
+			result.Reindexed += shardResult.Reindexed
+			result.Failed += shardResult.Failed
+			for _, report := range shardResult.FailedExecutions {
+				if len(result.FailedExecutions) < maxReportedExecutions {
+					result.FailedExecutions = append(result.FailedExecutions, report)
+				}
+			}
+		}
+		processed += batchEnd - shardID
+		shardID = batchEnd
+		result.ShardsDone = shardID
+	}
+
+	if shardID < params.NumShards {
+		params.NextShardID = shardID
+		params.Progress = result
+		return nil, workflow.NewContinueAsNewError(ctx, WorkflowTypeName, params)
+	}
+	return result, nil
+}
+
+func getShardCountActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    10 * time.Second,
+	}
+}
+
+func getReindexShardActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    24 * time.Hour,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       24 * time.Hour,
+			NonRetriableErrorReasons: []string{errMsgParamsIsNil, errMsgDomainIsEmpty},
+		},
+	}
+}
+
+func validateParams(params *ReindexParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if len(params.Domain) == 0 {
+		return errors.New(errMsgDomainIsEmpty)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.Concurrency <= 0 {
+		params.Concurrency = defaultConcurrency
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+type reindexWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestReindexWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(reindexWorkflowTestSuite))
+}
+
+func (s *reindexWorkflowTestSuite) SetupTest() {
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(ReindexWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	for _, env := range []interface {
+		RegisterActivityWithOptions(interface{}, activity.RegisterOptions)
+	}{s.workflowEnv, s.activityEnv} {
+		env.RegisterActivityWithOptions(GetShardCountActivity, activity.RegisterOptions{Name: getShardCountActivityName})
+		env.RegisterActivityWithOptions(ReindexShardActivity, activity.RegisterOptions{Name: reindexShardActivityName})
+	}
+}
+
+func (s *reindexWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *reindexWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReindexParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *reindexWorkflowTestSuite) TestWorkflow() {
+	s.workflowEnv.OnActivity(getShardCountActivityName, mock.Anything).Return(3, nil)
+	s.workflowEnv.OnActivity(reindexShardActivityName, mock.Anything, &ReindexShardActivityParams{Domain: "d1", ShardID: 0, PageSize: defaultPageSize}).
+		Return(&ReindexShardActivityResult{Reindexed: 5}, nil)
+	s.workflowEnv.OnActivity(reindexShardActivityName, mock.Anything, &ReindexShardActivityParams{Domain: "d1", ShardID: 1, PageSize: defaultPageSize}).
+		Return(&ReindexShardActivityResult{
+			Reindexed:        2,
+			Failed:           1,
+			FailedExecutions: []ExecutionReport{{WorkflowID: "wid", RunID: "rid", Info: "mockErr"}},
+		}, nil)
+	s.workflowEnv.OnActivity(reindexShardActivityName, mock.Anything, &ReindexShardActivityParams{Domain: "d1", ShardID: 2, PageSize: defaultPageSize}).
+		Return(nil, errors.New("mockErr"))
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReindexParams{Domain: "d1", Concurrency: 2})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	var result ReindexResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(ReindexResult{
+		ShardsDone:       3,
+		Reindexed:        7,
+		Failed:           1,
+		FailedExecutions: []ExecutionReport{{WorkflowID: "wid", RunID: "rid", Info: "mockErr"}},
+		FailedShards:     []int{2},
+	}, result)
+}
+
+func (s *reindexWorkflowTestSuite) TestReindexShardActivity() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	mockResource.DomainCache.EXPECT().GetDomainID("d1").Return("domain-id", nil)
+	mockResource.ExecutionMgr.On("ListConcreteExecutions", mock.Anything, &persistence.ListConcreteExecutionsRequest{PageSize: 2}).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{
+				{ExecutionInfo: &persistence.WorkflowExecutionInfo{DomainID: "domain-id", WorkflowID: "wid1", RunID: "rid1"}},
+				{ExecutionInfo: &persistence.WorkflowExecutionInfo{DomainID: "other-domain-id", WorkflowID: "wid2", RunID: "rid2"}},
+			},
+			PageToken: []byte("token"),
+		}, nil).Once()
+	mockResource.ExecutionMgr.On("ListConcreteExecutions", mock.Anything, &persistence.ListConcreteExecutionsRequest{PageSize: 2, PageToken: []byte("token")}).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{
+				{ExecutionInfo: &persistence.WorkflowExecutionInfo{DomainID: "domain-id", WorkflowID: "wid3", RunID: "rid3"}},
+				{ExecutionInfo: &persistence.WorkflowExecutionInfo{DomainID: "domain-id", WorkflowID: "wid4", RunID: "rid4"}},
+			},
+		}, nil).Once()
+	mockResource.HistoryClient.EXPECT().ReindexWorkflowExecution(gomock.Any(), &types.HistoryReindexWorkflowExecutionRequest{
+		DomainUUID: "domain-id",
+		Execution:  &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"},
+	}).Return(nil)
+	mockResource.HistoryClient.EXPECT().ReindexWorkflowExecution(gomock.Any(), &types.HistoryReindexWorkflowExecutionRequest{
+		DomainUUID: "domain-id",
+		Execution:  &types.WorkflowExecution{WorkflowID: "wid3", RunID: "rid3"},
+	}).Return(&types.InternalServiceError{Message: "mockErr"})
+	mockResource.HistoryClient.EXPECT().ReindexWorkflowExecution(gomock.Any(), &types.HistoryReindexWorkflowExecutionRequest{
+		DomainUUID: "domain-id",
+		Execution:  &types.WorkflowExecution{WorkflowID: "wid4", RunID: "rid4"},
+	}).Return(&types.EntityNotExistsError{})
+
+	actResult, err := env.ExecuteActivity(reindexShardActivityName, &ReindexShardActivityParams{
+		Domain:   "d1",
+		ShardID:  1,
+		PageSize: 2,
+	})
+	s.NoError(err)
+	var result ReindexShardActivityResult
+	s.NoError(actResult.Get(&result))
+	s.Equal(1, result.Reindexed)
+	s.Equal(1, result.Failed)
+	s.Equal([]ExecutionReport{{WorkflowID: "wid3", RunID: "rid3", Info: "InternalServiceError{Message: mockErr}"}}, result.FailedExecutions)
+}
+
+func (s *reindexWorkflowTestSuite) prepareTestActivityEnv() (*testsuite.TestActivityEnvironment, *resource.Test, *gomock.Controller) {
+	controller := gomock.NewController(s.T())
+	mockResource := resource.NewTest(controller, metrics.Worker)
+
+	ctx := &Reindexer{
+		cfg:       Config{NumHistoryShards: 4},
+		svcClient: mockResource.GetSDKClient(),
+		resource:  mockResource,
+	}
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), reindexerContextKey, ctx),
+	})
+	return s.activityEnv, mockResource, controller
+}
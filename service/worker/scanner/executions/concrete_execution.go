@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/reconciliation/invariant"
 	"github.com/uber/cadence/common/reconciliation/store"
+	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 )
 
@@ -108,6 +109,20 @@ func ScannerManager(
 	for _, fn := range ConcreteExecutionType.ToInvariants(collections) {
 		ivs = append(ivs, fn(pr))
 	}
+	if hasCollection(collections, invariant.CollectionVisibility) {
+		if scannerCtx, err := shardscanner.GetScannerContext(ctx); err == nil {
+			if iv := visibilityInvariant(pr, scannerCtx.Resource); iv != nil {
+				ivs = append(ivs, iv)
+			}
+		}
+	}
+	if hasCollection(collections, invariant.CollectionReplay) {
+		if scannerCtx, err := shardscanner.GetScannerContext(ctx); err == nil {
+			if iv := replayInvariant(scannerCtx.Resource); iv != nil {
+				ivs = append(ivs, iv)
+			}
+		}
+	}
 
 	return invariant.NewInvariantManager(ivs)
 }
@@ -129,7 +144,7 @@ func FixerIterator(ctx context.Context, client blobstore.Client, keys store.Keys
 }
 
 // FixerManager provides invariant manager for concrete execution fixer.
-func FixerManager(_ context.Context, pr persistence.Retryer, _ shardscanner.FixShardActivityParams) invariant.Manager {
+func FixerManager(ctx context.Context, pr persistence.Retryer, _ shardscanner.FixShardActivityParams) invariant.Manager {
 	var ivs []invariant.Invariant
 	var collections []invariant.Collection
 
@@ -138,9 +153,49 @@ func FixerManager(_ context.Context, pr persistence.Retryer, _ shardscanner.FixS
 	for _, fn := range ConcreteExecutionType.ToInvariants(collections) {
 		ivs = append(ivs, fn(pr))
 	}
+	if fixerCtx, err := shardscanner.GetFixerContext(ctx); err == nil {
+		if fixerCtx.Config.DynamicCollection.GetBoolProperty(dynamicconfig.ConcreteExecutionsScannerInvariantCollectionVisibility, false)() {
+			if iv := visibilityInvariant(pr, fixerCtx.Resource); iv != nil {
+				ivs = append(ivs, iv)
+			}
+		}
+		if fixerCtx.Config.DynamicCollection.GetBoolProperty(dynamicconfig.ConcreteExecutionsScannerInvariantCollectionReplay, false)() {
+			if iv := replayInvariant(fixerCtx.Resource); iv != nil {
+				ivs = append(ivs, iv)
+			}
+		}
+	}
 	return invariant.NewInvariantManager(ivs)
 }
 
+// visibilityInvariant builds the visibility invariant, which needs the visibility manager to read records
+// and the history client to re-emit them, so it cannot be created from persistence.Retryer alone.
+// Returns nil if the worker is not configured with visibility.
+func visibilityInvariant(pr persistence.Retryer, res resource.Resource) invariant.Invariant {
+	if res == nil || res.GetVisibilityManager() == nil {
+		return nil
+	}
+	return invariant.NewVisibilityConsistent(pr, res.GetVisibilityManager(), res.GetHistoryClient(), res.GetDomainCache())
+}
+
+// replayInvariant builds the mutable state replay invariant, which rebuilds mutable state
+// on the history host owning the execution. Returns nil if the worker has no history client.
+func replayInvariant(res resource.Resource) invariant.Invariant {
+	if res == nil || res.GetHistoryClient() == nil {
+		return nil
+	}
+	return invariant.NewMutableStateReplay(res.GetHistoryClient())
+}
+
+func hasCollection(collections []invariant.Collection, collection invariant.Collection) bool {
+	for _, c := range collections {
+		if c == collection {
+			return true
+		}
+	}
+	return false
+}
+
 // ConcreteExecutionConfig resolves dynamic config for concrete executions scanner.
 func ConcreteExecutionConfig(ctx shardscanner.Context) shardscanner.CustomScannerConfig {
 	res := shardscanner.CustomScannerConfig{}
@@ -151,6 +206,12 @@ func ConcreteExecutionConfig(ctx shardscanner.Context) shardscanner.CustomScanne
 	if ctx.Config.DynamicCollection.GetBoolProperty(dynamicconfig.ConcreteExecutionsScannerInvariantCollectionMutableState, true)() {
 		res[invariant.CollectionMutableState.String()] = strconv.FormatBool(true)
 	}
+	if ctx.Config.DynamicCollection.GetBoolProperty(dynamicconfig.ConcreteExecutionsScannerInvariantCollectionVisibility, false)() {
+		res[invariant.CollectionVisibility.String()] = strconv.FormatBool(true)
+	}
+	if ctx.Config.DynamicCollection.GetBoolProperty(dynamicconfig.ConcreteExecutionsScannerInvariantCollectionReplay, false)() {
+		res[invariant.CollectionReplay.String()] = strconv.FormatBool(true)
+	}
 
 	return res
 }
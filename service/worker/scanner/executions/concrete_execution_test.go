@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/reconciliation/invariant"
@@ -235,3 +236,18 @@ func (s *concreteExectionsWorkflowsSuite) TestScannerWorkflow_Success() {
 	}
 	s.Equal(shardscanner.ShardCorruptKeysResult(expectedCorrupted), shardCorruptKeysResult.Result)
 }
+
+func TestParseCollections(t *testing.T) {
+	collections := ParseCollections(shardscanner.CustomScannerConfig{
+		invariant.CollectionHistory.String():      "true",
+		invariant.CollectionMutableState.String(): "false",
+		invariant.CollectionVisibility.String():   "true",
+		invariant.CollectionReplay.String():       "true",
+		"unknown":                                 "true",
+	})
+	require.ElementsMatch(t, []invariant.Collection{invariant.CollectionHistory, invariant.CollectionVisibility, invariant.CollectionReplay}, collections)
+	require.True(t, hasCollection(collections, invariant.CollectionVisibility))
+	require.False(t, hasCollection(collections, invariant.CollectionMutableState))
+	// visibility and replay invariants are not built from persistence.Retryer alone
+	require.Len(t, ConcreteExecutionType.ToInvariants(collections), 1)
+}
@@ -40,10 +40,12 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/domainusage"
 	"github.com/uber/cadence/service/worker/esanalyzer"
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
+	"github.com/uber/cadence/service/worker/reindexer"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
 	"github.com/uber/cadence/service/worker/scanner/executions"
@@ -51,6 +53,7 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
 	"github.com/uber/cadence/service/worker/shadower"
+	"github.com/uber/cadence/service/worker/shardmigration"
 )
 
 type (
@@ -75,15 +78,25 @@ type (
 		BatcherCfg                        *batcher.Config
 		ESAnalyzerCfg                     *esanalyzer.Config
 		failoverManagerCfg                *failovermanager.Config
+		shardMigrationCfg                 *shardmigration.Config
+		reindexerCfg                      *reindexer.Config
+		domainUsageCfg                    *domainusage.Config
 		ThrottledLogRPS                   dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS           dynamicconfig.IntPropertyFn
 		PersistenceMaxQPS                 dynamicconfig.IntPropertyFn
 		EnableBatcher                     dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker     dynamicconfig.BoolPropertyFn
 		EnableFailoverManager             dynamicconfig.BoolPropertyFn
+		EnableShardMigration              dynamicconfig.BoolPropertyFn
+		EnableReindexer                   dynamicconfig.BoolPropertyFn
+		EnableDomainUsageAggregator       dynamicconfig.BoolPropertyFn
 		EnableWorkflowShadower            dynamicconfig.BoolPropertyFn
 		DomainReplicationMaxRetryDuration dynamicconfig.DurationPropertyFn
 		EnableESAnalyzer                  dynamicconfig.BoolPropertyFn
+		EnableReadVisibilityFromES        dynamicconfig.BoolPropertyFnWithDomainFilter
+		EnableReadFromClosedExecutionV2   dynamicconfig.BoolPropertyFn
+		ESIndexMaxResultWindow            dynamicconfig.IntPropertyFn
+		ValidSearchAttributes             dynamicconfig.MapPropertyFn
 	}
 )
 
@@ -101,7 +114,14 @@ func NewService(
 			PersistenceMaxQPS:       serviceConfig.PersistenceMaxQPS,
 			PersistenceGlobalMaxQPS: serviceConfig.PersistenceGlobalMaxQPS,
 			ThrottledLoggerMaxRPS:   serviceConfig.ThrottledLogRPS,
-			// worker service doesn't need visibility config as it never call visibilityManager API
+
+			// worker service only reads visibility records, for the visibility invariant of the executions scanner
+			EnableReadVisibilityFromES:    serviceConfig.EnableReadVisibilityFromES,
+			AdvancedVisibilityWritingMode: nil, // worker service never write
+
+			EnableReadDBVisibilityFromClosedExecutionV2: serviceConfig.EnableReadFromClosedExecutionV2,
+			ESIndexMaxResultWindow:                      serviceConfig.ESIndexMaxResultWindow,
+			ValidSearchAttributes:                       serviceConfig.ValidSearchAttributes,
 		},
 	)
 	if err != nil {
@@ -158,6 +178,17 @@ func NewConfig(params *resource.Params) *Config {
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
 		},
+		shardMigrationCfg: &shardmigration.Config{
+			ClusterMetadata:  params.ClusterMetadata,
+			NumHistoryShards: params.PersistenceConfig.NumHistoryShards,
+		},
+		reindexerCfg: &reindexer.Config{
+			NumHistoryShards: params.PersistenceConfig.NumHistoryShards,
+		},
+		domainUsageCfg: &domainusage.Config{
+			NumHistoryShards:    params.PersistenceConfig.NumHistoryShards,
+			AggregationInterval: dc.GetDurationProperty(dynamicconfig.DomainUsageAggregationInterval, 10*time.Minute),
+		},
 		ESAnalyzerCfg: &esanalyzer.Config{
 			ESAnalyzerPause:                 dc.GetBoolProperty(dynamicconfig.ESAnalyzerPause, common.DefaultESAnalyzerPause),
 			ESAnalyzerTimeWindow:            dc.GetDurationProperty(dynamicconfig.ESAnalyzerTimeWindow, common.DefaultESAnalyzerTimeWindow),
@@ -173,11 +204,18 @@ func NewConfig(params *resource.Params) *Config {
 		EnableParentClosePolicyWorker:     dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		EnableESAnalyzer:                  dc.GetBoolProperty(dynamicconfig.EnableESAnalyzer, false),
 		EnableFailoverManager:             dc.GetBoolProperty(dynamicconfig.EnableFailoverManager, true),
+		EnableShardMigration:              dc.GetBoolProperty(dynamicconfig.EnableShardMigration, true),
+		EnableReindexer:                   dc.GetBoolProperty(dynamicconfig.EnableReindexer, true),
+		EnableDomainUsageAggregator:       dc.GetBoolProperty(dynamicconfig.EnableDomainUsageAggregator, false),
 		EnableWorkflowShadower:            dc.GetBoolProperty(dynamicconfig.EnableWorkflowShadower, true),
 		ThrottledLogRPS:                   dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 		PersistenceGlobalMaxQPS:           dc.GetIntProperty(dynamicconfig.WorkerPersistenceGlobalMaxQPS, 0),
 		PersistenceMaxQPS:                 dc.GetIntProperty(dynamicconfig.WorkerPersistenceMaxQPS, 500),
 		DomainReplicationMaxRetryDuration: dc.GetDurationProperty(dynamicconfig.WorkerReplicationTaskMaxRetryDuration, 10*time.Minute),
+		EnableReadVisibilityFromES:        dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableReadVisibilityFromES, params.PersistenceConfig.IsAdvancedVisibilityConfigExist()),
+		EnableReadFromClosedExecutionV2:   dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
+		ESIndexMaxResultWindow:            dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
+		ValidSearchAttributes:             dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
 	}
 	advancedVisWritingMode := dc.GetStringProperty(
 		dynamicconfig.AdvancedVisibilityWritingMode,
@@ -191,6 +229,8 @@ func NewConfig(params *resource.Params) *Config {
 			ESProcessorBulkSize:      dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkSize, 2<<24), // 16MB
 			ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval, 1*time.Second),
 			ValidSearchAttributes:    dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
+			SearchAttributeAliases:   dc.GetMapProperty(dynamicconfig.SearchAttributeAliases, map[string]interface{}{}),
+			RemovedSearchAttributes:  dc.GetMapProperty(dynamicconfig.RemovedSearchAttributes, map[string]interface{}{}),
 		}
 	}
 	return config
@@ -233,6 +273,15 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	if s.GetClusterMetadata().IsGlobalDomainEnabled() && s.config.EnableShardMigration() {
+		s.startShardMigration()
+	}
+	if s.config.EnableReindexer() {
+		s.startReindexer()
+	}
+	if s.config.EnableDomainUsageAggregator() {
+		s.startDomainUsageAggregator()
+	}
 	if s.config.EnableWorkflowShadower() {
 		s.ensureDomainExists(common.ShadowerLocalDomainName)
 		s.startWorkflowShadower()
@@ -394,6 +443,45 @@ func (s *Service) startFailoverManager() {
 	}
 }
 
+func (s *Service) startShardMigration() {
+	params := &shardmigration.BootstrapParams{
+		Config:        *s.config.shardMigrationCfg,
+		ServiceClient: s.params.PublicClient,
+		TallyScope:    s.params.MetricScope,
+		Resource:      s.Resource,
+	}
+	if err := shardmigration.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting shard migration", tag.Error(err))
+	}
+}
+
+func (s *Service) startReindexer() {
+	params := &reindexer.BootstrapParams{
+		Config:        *s.config.reindexerCfg,
+		ServiceClient: s.params.PublicClient,
+		TallyScope:    s.params.MetricScope,
+		Resource:      s.Resource,
+	}
+	if err := reindexer.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting reindexer", tag.Error(err))
+	}
+}
+
+func (s *Service) startDomainUsageAggregator() {
+	params := &domainusage.BootstrapParams{
+		Config:        *s.config.domainUsageCfg,
+		ServiceClient: s.params.PublicClient,
+		TallyScope:    s.params.MetricScope,
+		Resource:      s.Resource,
+	}
+	if err := domainusage.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting domain usage aggregator", tag.Error(err))
+	}
+}
+
 func (s *Service) startWorkflowShadower() {
 	params := &shadower.BootstrapParams{
 		ServiceClient: s.params.PublicClient,
@@ -0,0 +1,301 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardmigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence/activity"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/fetcher"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// executionsHeartbeat is recorded after each page so that a retried activity resumes where it left off
+	executionsHeartbeat struct {
+		NextPageToken []byte
+		Succeeded     int
+		Failed        int
+		Reports       []ExecutionReport
+	}
+)
+
+// GetDomainsActivity returns the global domains which are active in the source cluster
+// and replicated to the target cluster
+func GetDomainsActivity(ctx context.Context, params *GetDomainsActivityParams) ([]string, error) {
+	if params == nil {
+		return nil, errors.New(errMsgParamsIsNil)
+	}
+	if err := validateTargetAndSourceCluster(params.TargetCluster, params.SourceCluster); err != nil {
+		return nil, err
+	}
+	migration := getShardMigration(ctx)
+	if params.TargetCluster != migration.cfg.ClusterMetadata.GetCurrentClusterName() {
+		return nil, errors.New(errMsgTargetClusterIsNotCurrent)
+	}
+
+	targetDomainsSet := make(map[string]struct{})
+	for _, domain := range params.Domains {
+		targetDomainsSet[domain] = struct{}{}
+	}
+
+	frontendClient := migration.resource.GetFrontendClient()
+	var res []string
+	var token []byte
+	for more := true; more; more = len(token) > 0 {
+		listResp, err := frontendClient.ListDomains(ctx, &types.ListDomainsRequest{
+			PageSize:      200,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		token = listResp.GetNextPageToken()
+
+		for _, domain := range listResp.GetDomains() {
+			domainName := domain.GetDomainInfo().GetName()
+			if len(targetDomainsSet) > 0 {
+				if _, ok := targetDomainsSet[domainName]; !ok {
+					continue
+				}
+			}
+			if shouldMigrate(domain, params.SourceCluster, params.TargetCluster) {
+				res = append(res, domainName)
+			}
+		}
+		activity.RecordHeartbeat(ctx, len(res))
+	}
+	return res, nil
+}
+
+func shouldMigrate(domain *types.DescribeDomainResponse, sourceCluster, targetCluster string) bool {
+	if !domain.GetIsGlobalDomain() {
+		return false
+	}
+	if domain.ReplicationConfiguration.GetActiveClusterName() != sourceCluster {
+		return false
+	}
+	for _, cluster := range domain.ReplicationConfiguration.GetClusters() {
+		if cluster.GetClusterName() == targetCluster {
+			return true
+		}
+	}
+	return false
+}
+
+// CopyExecutionsActivity replicates the full history of all open executions of a domain
+// from the source cluster to the current cluster
+func CopyExecutionsActivity(ctx context.Context, params *ExecutionsActivityParams) (*CopyExecutionsActivityResult, error) {
+	migration := getShardMigration(ctx)
+	adminClient := migration.resource.GetRemoteAdminClient(migration.cfg.ClusterMetadata.GetCurrentClusterName())
+	domainID, err := migration.resource.GetDomainCache().GetDomainID(params.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := forEachOpenExecution(ctx, params, func(execution *types.WorkflowExecution) (string, error) {
+		err := adminClient.ResendReplicationTasks(ctx, &types.ResendReplicationTasksRequest{
+			DomainID:      domainID,
+			WorkflowID:    execution.GetWorkflowID(),
+			RunID:         execution.GetRunID(),
+			RemoteCluster: params.SourceCluster,
+		})
+		switch err.(type) {
+		case nil:
+			return "", nil
+		case *types.EntityNotExistsError:
+			// the execution is already gone from the source cluster
+			return "", nil
+		default:
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+			return err.Error(), nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CopyExecutionsActivityResult{
+		Copied:           progress.Succeeded,
+		Failed:           progress.Failed,
+		FailedExecutions: progress.Reports,
+	}, nil
+}
+
+// VerifyExecutionsActivity checks the copied executions of a domain against the
+// reconciliation invariants in the persistence of the current cluster
+func VerifyExecutionsActivity(ctx context.Context, params *ExecutionsActivityParams) (*VerifyExecutionsActivityResult, error) {
+	migration := getShardMigration(ctx)
+	res := migration.resource
+	domainID, err := res.GetDomainCache().GetDomainID(params.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := forEachOpenExecution(ctx, params, func(execution *types.WorkflowExecution) (string, error) {
+		shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowID(), migration.cfg.NumHistoryShards)
+		executionManager, err := res.GetExecutionManager(shardID)
+		if err != nil {
+			return "", err
+		}
+		pr := persistence.NewPersistenceRetryer(executionManager, res.GetHistoryManager(), common.CreatePersistenceRetryPolicy())
+		concreteExecution, err := fetcher.ConcreteExecution(ctx, pr, fetcher.ExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: execution.GetWorkflowID(),
+			RunID:      execution.GetRunID(),
+		})
+		switch err.(type) {
+		case nil:
+		case *types.EntityNotExistsError:
+			return "execution does not exist in the target cluster", nil
+		default:
+			return "", err
+		}
+
+		result := invariant.NewInvariantManager([]invariant.Invariant{
+			invariant.NewHistoryExists(pr),
+			invariant.NewOpenCurrentExecution(pr),
+		}).RunChecks(ctx, concreteExecution)
+		switch result.CheckResultType {
+		case invariant.CheckResultTypeHealthy:
+			return "", nil
+		case invariant.CheckResultTypeCorrupted:
+			return corruptionInfo(result), nil
+		default:
+			return "", fmt.Errorf("failed to run invariant checks for workflow %v", execution.GetWorkflowID())
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &VerifyExecutionsActivityResult{
+		Verified:            progress.Succeeded,
+		Corrupted:           progress.Failed,
+		CorruptedExecutions: progress.Reports,
+	}, nil
+}
+
+func corruptionInfo(result invariant.ManagerCheckResult) string {
+	for _, checkResult := range result.CheckResults {
+		if checkResult.CheckResultType == invariant.CheckResultTypeCorrupted {
+			return fmt.Sprintf("%v: %v", checkResult.InvariantName, checkResult.Info)
+		}
+	}
+	return string(invariant.CheckResultTypeCorrupted)
+}
+
+// forEachOpenExecution pages through the open executions of a domain in the source cluster.
+// The callback returns a non-empty report if the execution failed to be processed,
+// or an error if the activity should be retried.
+func forEachOpenExecution(
+	ctx context.Context,
+	params *ExecutionsActivityParams,
+	fn func(execution *types.WorkflowExecution) (string, error),
+) (*executionsHeartbeat, error) {
+	migration := getShardMigration(ctx)
+	sourceClient := migration.resource.GetRemoteFrontendClient(params.SourceCluster)
+
+	var progress executionsHeartbeat
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			return nil, err
+		}
+	}
+
+	latestTime := time.Now().UnixNano()
+	for {
+		resp, err := sourceClient.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+			Domain:          params.Domain,
+			MaximumPageSize: int32(params.PageSize),
+			NextPageToken:   progress.NextPageToken,
+			StartTimeFilter: &types.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(latestTime),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range resp.GetExecutions() {
+			report, err := fn(info.GetExecution())
+			if err != nil {
+				return nil, err
+			}
+			if len(report) == 0 {
+				progress.Succeeded++
+				continue
+			}
+			progress.Failed++
+			if len(progress.Reports) < maxReportedExecutions {
+				progress.Reports = append(progress.Reports, ExecutionReport{
+					WorkflowID: info.GetExecution().GetWorkflowID(),
+					RunID:      info.GetExecution().GetRunID(),
+					Info:       report,
+				})
+			}
+		}
+
+		progress.NextPageToken = resp.GetNextPageToken()
+		activity.RecordHeartbeat(ctx, progress)
+		if len(progress.NextPageToken) == 0 {
+			return &progress, nil
+		}
+	}
+}
+
+// CutoverActivity fails over the migrated domains to the target cluster
+func CutoverActivity(ctx context.Context, params *CutoverActivityParams) (*CutoverActivityResult, error) {
+	logger := activity.GetLogger(ctx)
+	frontendClient := getShardMigration(ctx).resource.GetFrontendClient()
+	var successDomains []string
+	var failedDomains []string
+	for _, domain := range params.Domains {
+		updateRequest := &types.UpdateDomainRequest{
+			Name:                     domain,
+			ActiveClusterName:        common.StringPtr(params.TargetCluster),
+			FailoverTimeoutInSeconds: params.GracefulFailoverTimeoutInSeconds,
+		}
+		if _, err := frontendClient.UpdateDomain(ctx, updateRequest); err != nil {
+			logger.Error("Failed to cutover domain", zap.String("domain", domain), zap.Error(err))
+			failedDomains = append(failedDomains, domain)
+		} else {
+			successDomains = append(successDomains, domain)
+		}
+	}
+	return &CutoverActivityResult{
+		SuccessDomains: successDomains,
+		FailedDomains:  failedDomains,
+	}, nil
+}
+
+func getShardMigration(ctx context.Context) *ShardMigration {
+	return ctx.Value(shardMigrationContextKey).(*ShardMigration)
+}
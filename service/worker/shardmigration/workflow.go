@@ -0,0 +1,439 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardmigration
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	shardMigrationContextKey contextKey = "shardMigrationContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-shardMigration-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName = "cadence-sys-shardMigration-workflow"
+	// WorkflowID will be reused to ensure only one workflow running
+	WorkflowID                   = "cadence-shard-migration"
+	getDomainsActivityName       = "cadence-sys-shardMigration-getDomains-activity"
+	copyExecutionsActivityName   = "cadence-sys-shardMigration-copyExecutions-activity"
+	verifyExecutionsActivityName = "cadence-sys-shardMigration-verifyExecutions-activity"
+	cutoverActivityName          = "cadence-sys-shardMigration-cutover-activity"
+
+	defaultPageSize = 100
+	// upper bound on the number of failed or corrupted executions reported per domain
+	maxReportedExecutions = 20
+
+	errMsgParamsIsNil                 = "params is nil"
+	errMsgTargetClusterIsEmpty        = "targetCluster is empty"
+	errMsgSourceClusterIsEmpty        = "sourceCluster is empty"
+	errMsgTargetClusterIsSameAsSource = "targetCluster is same as sourceCluster"
+	errMsgTargetClusterIsNotCurrent   = "shard migration must run in the target cluster"
+
+	// QueryType for shard migration workflow
+	QueryType = "state"
+	// PauseSignal signal name for pause
+	PauseSignal = "pause"
+	// ResumeSignal signal name for resume
+	ResumeSignal = "resume"
+	// CutoverSignal signal name for starting the cutover when AutoCutover is not set
+	CutoverSignal = "cutover"
+
+	// workflow states for query
+
+	// WorkflowInitialized state
+	WorkflowInitialized = "initialized"
+	// WorkflowRunning state
+	WorkflowRunning = "running"
+	// WorkflowPaused state
+	WorkflowPaused = "paused"
+	// WorkflowWaitingForCutover state
+	WorkflowWaitingForCutover = "waiting_for_cutover"
+	// WorkflowCompleted state
+	WorkflowCompleted = "complete"
+	// WorkflowAborted state
+	WorkflowAborted = "aborted"
+
+	// domain stages for query
+
+	// DomainPending stage
+	DomainPending = "pending"
+	// DomainCopying stage
+	DomainCopying = "copying"
+	// DomainVerifying stage
+	DomainVerifying = "verifying"
+	// DomainVerified stage
+	DomainVerified = "verified"
+	// DomainFailed stage, either copy or verification did not succeed
+	DomainFailed = "failed"
+	// DomainCutover stage
+	DomainCutover = "cutover"
+	// DomainCutoverFailed stage
+	DomainCutoverFailed = "cutover_failed"
+
+	unknownOperator = "unknown"
+)
+
+type (
+	// MigrationParams is the arg for ShardMigrationWorkflow
+	MigrationParams struct {
+		// SourceCluster is the cluster the domains are migrated from
+		SourceCluster string
+		// TargetCluster is the cluster the domains are migrated to, the workflow must run in this cluster
+		TargetCluster string
+		// Domains candidates to be migrated, all eligible domains are migrated if empty
+		Domains []string
+		// PageSize is the number of executions listed from the source cluster per page
+		PageSize int
+		// AutoCutover fails over verified domains right after verification,
+		// otherwise the workflow waits for CutoverSignal
+		AutoCutover bool
+		// GracefulFailoverTimeoutInSeconds is used for the cutover failover
+		GracefulFailoverTimeoutInSeconds *int32
+	}
+
+	// MigrationResult is workflow result
+	MigrationResult struct {
+		Domains []*DomainProgress
+	}
+
+	// DomainProgress is the migration progress of a single domain
+	DomainProgress struct {
+		Domain              string
+		Stage               string
+		Copied              int
+		CopyFailed          int
+		Verified            int
+		Corrupted           int
+		FailedExecutions    []ExecutionReport
+		CorruptedExecutions []ExecutionReport
+		Error               string `json:",omitempty"`
+	}
+
+	// ExecutionReport describes an execution which failed to be copied or verified
+	ExecutionReport struct {
+		WorkflowID string
+		RunID      string
+		Info       string
+	}
+
+	// GetDomainsActivityParams params for activity
+	GetDomainsActivityParams struct {
+		SourceCluster string
+		TargetCluster string
+		Domains       []string
+	}
+
+	// ExecutionsActivityParams params for copy and verify activities
+	ExecutionsActivityParams struct {
+		Domain        string
+		SourceCluster string
+		PageSize      int
+	}
+
+	// CopyExecutionsActivityResult result for copy activity
+	CopyExecutionsActivityResult struct {
+		Copied           int
+		Failed           int
+		FailedExecutions []ExecutionReport
+	}
+
+	// VerifyExecutionsActivityResult result for verify activity
+	VerifyExecutionsActivityResult struct {
+		Verified            int
+		Corrupted           int
+		CorruptedExecutions []ExecutionReport
+	}
+
+	// CutoverActivityParams params for activity
+	CutoverActivityParams struct {
+		Domains                          []string
+		TargetCluster                    string
+		GracefulFailoverTimeoutInSeconds *int32
+	}
+
+	// CutoverActivityResult result for cutover activity
+	CutoverActivityResult struct {
+		SuccessDomains []string
+		FailedDomains  []string
+	}
+
+	// QueryResult for shard migration progress
+	QueryResult struct {
+		State         string
+		SourceCluster string
+		TargetCluster string
+		Domains       []*DomainProgress
+		Operator      string
+	}
+)
+
+// ShardMigrationWorkflow migrates global domains from a source cluster to the cluster it runs in.
+// The two clusters may have a different number of history shards, which makes it the supported path
+// to grow the shard count: a new cluster with more shards is added to the domains as a replica,
+// open executions are copied over from the source cluster, verified against the reconciliation
+// invariants, and the domains are finally failed over to the new cluster.
+// Replication from the new cluster back to a cluster with fewer shards is not complete,
+// so the source cluster should be retired once the cutover is done.
+func ShardMigrationWorkflow(ctx workflow.Context, params *MigrationParams) (*MigrationResult, error) {
+	err := validateParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// define query properties
+	var progress []*DomainProgress
+	wfState := WorkflowInitialized
+	operator := getOperator(ctx)
+	err = workflow.SetQueryHandler(ctx, QueryType, func(input []byte) (*QueryResult, error) {
+		return &QueryResult{
+			State:         wfState,
+			SourceCluster: params.SourceCluster,
+			TargetCluster: params.TargetCluster,
+			Domains:       progress,
+			Operator:      operator,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// get target domains
+	ao := workflow.WithActivityOptions(ctx, getGetDomainsActivityOptions())
+	getDomainsParams := &GetDomainsActivityParams{
+		SourceCluster: params.SourceCluster,
+		TargetCluster: params.TargetCluster,
+		Domains:       params.Domains,
+	}
+	var domains []string
+	err = workflow.ExecuteActivity(ao, GetDomainsActivity, getDomainsParams).Get(ctx, &domains)
+	if err != nil {
+		return nil, err
+	}
+	for _, domain := range domains {
+		progress = append(progress, &DomainProgress{
+			Domain: domain,
+			Stage:  DomainPending,
+		})
+	}
+
+	pauseCh := workflow.GetSignalChannel(ctx, PauseSignal)
+	resumeCh := workflow.GetSignalChannel(ctx, ResumeSignal)
+	checkPauseSignal := func() {
+		if pauseCh.ReceiveAsync(nil) {
+			wfState = WorkflowPaused
+			resumeCh.Receive(ctx, nil)
+			// clean up all pending pause signal
+			cleanupChannel(pauseCh)
+		}
+		wfState = WorkflowRunning
+	}
+
+	// copy and verify domain by domain
+	ao = workflow.WithActivityOptions(ctx, getExecutionsActivityOptions())
+	for _, domainProgress := range progress {
+		checkPauseSignal()
+
+		executionsParams := &ExecutionsActivityParams{
+			Domain:        domainProgress.Domain,
+			SourceCluster: params.SourceCluster,
+			PageSize:      params.PageSize,
+		}
+
+		domainProgress.Stage = DomainCopying
+		var copyResult CopyExecutionsActivityResult
+		if err := workflow.ExecuteActivity(ao, CopyExecutionsActivity, executionsParams).Get(ctx, &copyResult); err != nil {
+			domainProgress.Stage = DomainFailed
+			domainProgress.Error = err.Error()
+			continue
+		}
+		domainProgress.Copied = copyResult.Copied
+		domainProgress.CopyFailed = copyResult.Failed
+		domainProgress.FailedExecutions = copyResult.FailedExecutions
+
+		domainProgress.Stage = DomainVerifying
+		var verifyResult VerifyExecutionsActivityResult
+		if err := workflow.ExecuteActivity(ao, VerifyExecutionsActivity, executionsParams).Get(ctx, &verifyResult); err != nil {
+			domainProgress.Stage = DomainFailed
+			domainProgress.Error = err.Error()
+			continue
+		}
+		domainProgress.Verified = verifyResult.Verified
+		domainProgress.Corrupted = verifyResult.Corrupted
+		domainProgress.CorruptedExecutions = verifyResult.CorruptedExecutions
+
+		if domainProgress.CopyFailed > 0 || domainProgress.Corrupted > 0 {
+			domainProgress.Stage = DomainFailed
+		} else {
+			domainProgress.Stage = DomainVerified
+		}
+	}
+
+	var verifiedDomains []string
+	for _, domainProgress := range progress {
+		if domainProgress.Stage == DomainVerified {
+			verifiedDomains = append(verifiedDomains, domainProgress.Domain)
+		}
+	}
+	if len(verifiedDomains) == 0 {
+		wfState = WorkflowCompleted
+		return &MigrationResult{Domains: progress}, nil
+	}
+
+	if !params.AutoCutover {
+		wfState = WorkflowWaitingForCutover
+		workflow.GetSignalChannel(ctx, CutoverSignal).Receive(ctx, nil)
+	}
+	checkPauseSignal()
+
+	ao = workflow.WithActivityOptions(ctx, getCutoverActivityOptions())
+	cutoverParams := &CutoverActivityParams{
+		Domains:                          verifiedDomains,
+		TargetCluster:                    params.TargetCluster,
+		GracefulFailoverTimeoutInSeconds: params.GracefulFailoverTimeoutInSeconds,
+	}
+	var cutoverResult CutoverActivityResult
+	if err := workflow.ExecuteActivity(ao, CutoverActivity, cutoverParams).Get(ctx, &cutoverResult); err != nil {
+		// domains in failed activity can be either failed over or not, but we treat them as failed
+		cutoverResult = CutoverActivityResult{FailedDomains: verifiedDomains}
+	}
+	setStage(progress, cutoverResult.SuccessDomains, DomainCutover)
+	setStage(progress, cutoverResult.FailedDomains, DomainCutoverFailed)
+
+	wfState = WorkflowCompleted
+	return &MigrationResult{Domains: progress}, nil
+}
+
+func setStage(progress []*DomainProgress, domains []string, stage string) {
+	domainSet := make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		domainSet[domain] = struct{}{}
+	}
+	for _, domainProgress := range progress {
+		if _, ok := domainSet[domainProgress.Domain]; ok {
+			domainProgress.Stage = stage
+		}
+	}
+}
+
+func getOperator(ctx workflow.Context) string {
+	memo := workflow.GetInfo(ctx).Memo
+	if memo == nil || len(memo.Fields) == 0 {
+		return unknownOperator
+	}
+	opBytes, ok := memo.Fields[common.MemoKeyForOperator]
+	if !ok {
+		return unknownOperator
+	}
+	var operator string
+	err := json.Unmarshal(opBytes, &operator)
+	if err != nil {
+		return unknownOperator
+	}
+	return operator
+}
+
+func cleanupChannel(channel workflow.Channel) {
+	for {
+		if hasValue := channel.ReceiveAsync(nil); !hasValue {
+			return
+		}
+	}
+}
+
+func getGetDomainsActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    20 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          2 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          1 * time.Minute,
+			ExpirationInterval:       10 * time.Minute,
+			NonRetriableErrorReasons: nonRetriableErrorReasons(),
+		},
+	}
+}
+
+func getExecutionsActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    24 * time.Hour,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       24 * time.Hour,
+			NonRetriableErrorReasons: nonRetriableErrorReasons(),
+		},
+	}
+}
+
+func getCutoverActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    time.Minute,
+	}
+}
+
+func nonRetriableErrorReasons() []string {
+	return []string{
+		errMsgParamsIsNil,
+		errMsgTargetClusterIsEmpty,
+		errMsgSourceClusterIsEmpty,
+		errMsgTargetClusterIsSameAsSource,
+		errMsgTargetClusterIsNotCurrent,
+	}
+}
+
+func validateParams(params *MigrationParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	return validateTargetAndSourceCluster(params.TargetCluster, params.SourceCluster)
+}
+
+func validateTargetAndSourceCluster(targetCluster, sourceCluster string) error {
+	if len(targetCluster) == 0 {
+		return errors.New(errMsgTargetClusterIsEmpty)
+	}
+	if len(sourceCluster) == 0 {
+		return errors.New(errMsgSourceClusterIsEmpty)
+	}
+	if sourceCluster == targetCluster {
+		return errors.New(errMsgTargetClusterIsSameAsSource)
+	}
+	return nil
+}
@@ -0,0 +1,344 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardmigration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+type shardMigrationWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestShardMigrationWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(shardMigrationWorkflowTestSuite))
+}
+
+func (s *shardMigrationWorkflowTestSuite) SetupTest() {
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(ShardMigrationWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	for _, env := range []interface {
+		RegisterActivityWithOptions(interface{}, activity.RegisterOptions)
+	}{s.workflowEnv, s.activityEnv} {
+		env.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+		env.RegisterActivityWithOptions(CopyExecutionsActivity, activity.RegisterOptions{Name: copyExecutionsActivityName})
+		env.RegisterActivityWithOptions(VerifyExecutionsActivity, activity.RegisterOptions{Name: verifyExecutionsActivityName})
+		env.RegisterActivityWithOptions(CutoverActivity, activity.RegisterOptions{Name: cutoverActivityName})
+	}
+}
+
+func (s *shardMigrationWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *shardMigrationWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MigrationParams{SourceCluster: "s", TargetCluster: "s"})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *shardMigrationWorkflowTestSuite) TestWorkflow_AutoCutover() {
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return([]string{"d1", "d2", "d3"}, nil)
+	s.workflowEnv.OnActivity(copyExecutionsActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params *ExecutionsActivityParams) (*CopyExecutionsActivityResult, error) {
+			if params.Domain == "d2" {
+				return nil, errors.New("mockErr")
+			}
+			return &CopyExecutionsActivityResult{Copied: 3}, nil
+		})
+	s.workflowEnv.OnActivity(verifyExecutionsActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params *ExecutionsActivityParams) (*VerifyExecutionsActivityResult, error) {
+			if params.Domain == "d3" {
+				return &VerifyExecutionsActivityResult{
+					Verified:            2,
+					Corrupted:           1,
+					CorruptedExecutions: []ExecutionReport{{WorkflowID: "wid", RunID: "rid", Info: "corrupted"}},
+				}, nil
+			}
+			return &VerifyExecutionsActivityResult{Verified: 3}, nil
+		})
+	s.workflowEnv.OnActivity(cutoverActivityName, mock.Anything, &CutoverActivityParams{
+		Domains:       []string{"d1"},
+		TargetCluster: "t",
+	}).Return(&CutoverActivityResult{SuccessDomains: []string{"d1"}}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MigrationParams{
+		SourceCluster: "s",
+		TargetCluster: "t",
+		AutoCutover:   true,
+	})
+
+	var result MigrationResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Len(result.Domains, 3)
+	s.Equal(DomainCutover, result.Domains[0].Stage)
+	s.Equal(3, result.Domains[0].Copied)
+	s.Equal(3, result.Domains[0].Verified)
+	s.Equal(DomainFailed, result.Domains[1].Stage)
+	s.Contains(result.Domains[1].Error, "mockErr")
+	s.Equal(DomainFailed, result.Domains[2].Stage)
+	s.Equal(1, result.Domains[2].Corrupted)
+	s.Len(result.Domains[2].CorruptedExecutions, 1)
+
+	s.assertQueryState(WorkflowCompleted)
+}
+
+func (s *shardMigrationWorkflowTestSuite) TestWorkflow_WaitForCutoverSignal() {
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return([]string{"d1"}, nil)
+	s.workflowEnv.OnActivity(copyExecutionsActivityName, mock.Anything, mock.Anything).Return(&CopyExecutionsActivityResult{Copied: 1}, nil)
+	s.workflowEnv.OnActivity(verifyExecutionsActivityName, mock.Anything, mock.Anything).Return(&VerifyExecutionsActivityResult{Verified: 1}, nil)
+	s.workflowEnv.OnActivity(cutoverActivityName, mock.Anything, mock.Anything).Return(&CutoverActivityResult{FailedDomains: []string{"d1"}}, nil).Once()
+
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.assertQueryState(WorkflowWaitingForCutover)
+	}, time.Minute)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(CutoverSignal, nil)
+	}, 2*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MigrationParams{
+		SourceCluster: "s",
+		TargetCluster: "t",
+	})
+
+	var result MigrationResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Len(result.Domains, 1)
+	s.Equal(DomainCutoverFailed, result.Domains[0].Stage)
+}
+
+func (s *shardMigrationWorkflowTestSuite) TestShouldMigrate() {
+	clusters := []*types.ClusterReplicationConfiguration{{ClusterName: "s"}, {ClusterName: "t"}}
+	tests := []struct {
+		domain   *types.DescribeDomainResponse
+		expected bool
+	}{
+		{
+			domain: &types.DescribeDomainResponse{
+				IsGlobalDomain: false,
+			},
+			expected: false,
+		},
+		{
+			domain: &types.DescribeDomainResponse{
+				IsGlobalDomain: true,
+				ReplicationConfiguration: &types.DomainReplicationConfiguration{
+					ActiveClusterName: "t",
+					Clusters:          clusters,
+				},
+			},
+			expected: false,
+		},
+		{
+			domain: &types.DescribeDomainResponse{
+				IsGlobalDomain: true,
+				ReplicationConfiguration: &types.DomainReplicationConfiguration{
+					ActiveClusterName: "s",
+					Clusters:          clusters[:1],
+				},
+			},
+			expected: false,
+		},
+		{
+			domain: &types.DescribeDomainResponse{
+				IsGlobalDomain: true,
+				ReplicationConfiguration: &types.DomainReplicationConfiguration{
+					ActiveClusterName: "s",
+					Clusters:          clusters,
+				},
+			},
+			expected: true,
+		},
+	}
+	for _, t := range tests {
+		s.Equal(t.expected, shouldMigrate(t.domain, "s", "t"))
+	}
+}
+
+func (s *shardMigrationWorkflowTestSuite) TestGetDomainsActivity_NotTargetCluster() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	_, err := env.ExecuteActivity(getDomainsActivityName, &GetDomainsActivityParams{
+		SourceCluster: cluster.TestCurrentClusterName,
+		TargetCluster: cluster.TestAlternativeClusterName,
+	})
+	s.Error(err)
+}
+
+func (s *shardMigrationWorkflowTestSuite) TestGetDomainsActivity() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	clusters := []*types.ClusterReplicationConfiguration{
+		{ClusterName: cluster.TestCurrentClusterName},
+		{ClusterName: cluster.TestAlternativeClusterName},
+	}
+	mockResource.FrontendClient.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(&types.ListDomainsResponse{
+		Domains: []*types.DescribeDomainResponse{
+			{
+				DomainInfo: &types.DomainInfo{Name: "d1"},
+				ReplicationConfiguration: &types.DomainReplicationConfiguration{
+					ActiveClusterName: cluster.TestAlternativeClusterName,
+					Clusters:          clusters,
+				},
+				IsGlobalDomain: true,
+			},
+			{
+				DomainInfo: &types.DomainInfo{Name: "d2"},
+				ReplicationConfiguration: &types.DomainReplicationConfiguration{
+					ActiveClusterName: cluster.TestAlternativeClusterName,
+					Clusters:          clusters,
+				},
+				IsGlobalDomain: true,
+			},
+			{
+				DomainInfo: &types.DomainInfo{Name: "d3"},
+				ReplicationConfiguration: &types.DomainReplicationConfiguration{
+					ActiveClusterName: cluster.TestCurrentClusterName,
+					Clusters:          clusters,
+				},
+				IsGlobalDomain: true,
+			},
+		},
+	}, nil)
+
+	actResult, err := env.ExecuteActivity(getDomainsActivityName, &GetDomainsActivityParams{
+		SourceCluster: cluster.TestAlternativeClusterName,
+		TargetCluster: cluster.TestCurrentClusterName,
+		Domains:       []string{"d1", "d3"},
+	})
+	s.NoError(err)
+	var result []string
+	s.NoError(actResult.Get(&result))
+	s.Equal([]string{"d1"}, result)
+}
+
+func (s *shardMigrationWorkflowTestSuite) TestCopyExecutionsActivity() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	mockResource.DomainCache.EXPECT().GetDomainID("d1").Return("domain-id", nil)
+	mockResource.RemoteFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListOpenWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}},
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"}},
+		},
+		NextPageToken: []byte("token"),
+	}, nil)
+	mockResource.RemoteFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListOpenWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid3", RunID: "rid3"}},
+		},
+	}, nil)
+	mockResource.RemoteAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), &types.ResendReplicationTasksRequest{
+		DomainID:      "domain-id",
+		WorkflowID:    "wid1",
+		RunID:         "rid1",
+		RemoteCluster: cluster.TestAlternativeClusterName,
+	}).Return(nil)
+	mockResource.RemoteAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), gomock.Any()).Return(&types.InternalServiceError{Message: "mockErr"})
+	mockResource.RemoteAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{})
+
+	actResult, err := env.ExecuteActivity(copyExecutionsActivityName, &ExecutionsActivityParams{
+		Domain:        "d1",
+		SourceCluster: cluster.TestAlternativeClusterName,
+		PageSize:      2,
+	})
+	s.NoError(err)
+	var result CopyExecutionsActivityResult
+	s.NoError(actResult.Get(&result))
+	s.Equal(2, result.Copied)
+	s.Equal(1, result.Failed)
+	s.Equal([]ExecutionReport{{WorkflowID: "wid2", RunID: "rid2", Info: "InternalServiceError{Message: mockErr}"}}, result.FailedExecutions)
+}
+
+func (s *shardMigrationWorkflowTestSuite) TestCutoverActivity() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(&types.UpdateDomainResponse{}, nil)
+	mockResource.FrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, errors.New("mockErr"))
+
+	actResult, err := env.ExecuteActivity(cutoverActivityName, &CutoverActivityParams{
+		Domains:       []string{"d1", "d2"},
+		TargetCluster: cluster.TestCurrentClusterName,
+	})
+	s.NoError(err)
+	var result CutoverActivityResult
+	s.NoError(actResult.Get(&result))
+	s.Equal([]string{"d1"}, result.SuccessDomains)
+	s.Equal([]string{"d2"}, result.FailedDomains)
+}
+
+func (s *shardMigrationWorkflowTestSuite) assertQueryState(expected string) {
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var res QueryResult
+	s.NoError(queryResult.Get(&res))
+	s.Equal(expected, res.State)
+	s.Equal("s", res.SourceCluster)
+	s.Equal("t", res.TargetCluster)
+	s.Equal(unknownOperator, res.Operator)
+}
+
+func (s *shardMigrationWorkflowTestSuite) prepareTestActivityEnv() (*testsuite.TestActivityEnvironment, *resource.Test, *gomock.Controller) {
+	controller := gomock.NewController(s.T())
+	mockResource := resource.NewTest(controller, metrics.Worker)
+
+	ctx := &ShardMigration{
+		cfg: Config{
+			ClusterMetadata:  cluster.GetTestClusterMetadata(true, true),
+			NumHistoryShards: 4,
+		},
+		svcClient: mockResource.GetSDKClient(),
+		resource:  mockResource,
+	}
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), shardMigrationContextKey, ctx),
+	})
+	return s.activityEnv, mockResource, controller
+}
@@ -65,6 +65,9 @@ const (
 	readSchemaVersionCQL        = `SELECT curr_version from schema_version where keyspace_name=?`
 	listTablesCQL               = `SELECT table_name from system_schema.tables where keyspace_name=?`
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
+	describeColumnsCQL          = `SELECT table_name, column_name, kind, position, type, clustering_order from system_schema.columns where keyspace_name=?`
+	describeIndexesCQL          = `SELECT table_name, index_name, kind, options from system_schema.indexes where keyspace_name=?`
+	describeTypesCQL            = `SELECT type_name, field_names, field_types from system_schema.types where keyspace_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
 
@@ -85,9 +88,13 @@ const (
 
 	createKeyspaceCQL = `CREATE KEYSPACE IF NOT EXISTS %v ` +
 		`WITH replication = { 'class' : 'SimpleStrategy', 'replication_factor' : %v};`
+
+	createNewKeyspaceCQL = `CREATE KEYSPACE %v ` +
+		`WITH replication = { 'class' : 'SimpleStrategy', 'replication_factor' : %v};`
 )
 
 var _ schema.SchemaClient = (*CqlClient)(nil)
+var _ schema.SchemaDescriber = (*CqlClient)(nil)
 
 // NewCQLClient returns a new instance of CQLClient
 func NewCQLClient(cfg *CQLClientConfig) (*CqlClient, error) {
@@ -126,6 +133,11 @@ func (client *CqlClient) CreateKeyspace(name string) error {
 	return client.ExecDDLQuery(fmt.Sprintf(createKeyspaceCQL, name, client.nReplicas))
 }
 
+// CreateNewKeyspace creates a cassandra Keyspace, failing if it already exists
+func (client *CqlClient) CreateNewKeyspace(name string) error {
+	return client.ExecDDLQuery(fmt.Sprintf(createNewKeyspaceCQL, name, client.nReplicas))
+}
+
 // DropKeyspace drops a Keyspace
 func (client *CqlClient) DropKeyspace(name string) error {
 	return client.ExecDDLQuery(fmt.Sprintf("DROP KEYSPACE %v", name))
@@ -199,6 +211,68 @@ func (client *CqlClient) ListTables() ([]string, error) {
 	return names, nil
 }
 
+// DescribeSchema returns the tables, indexes and user defined types of the Keyspace.
+// Columns are described by their type and key kind, indexes by their kind and target.
+func (client *CqlClient) DescribeSchema() (*schema.DatabaseSchema, error) {
+	result := &schema.DatabaseSchema{
+		Tables: make(map[string]*schema.TableSchema),
+		Types:  make(map[string]*schema.TableSchema),
+	}
+	getTable := func(tables map[string]*schema.TableSchema, name string) *schema.TableSchema {
+		table, ok := tables[name]
+		if !ok {
+			table = &schema.TableSchema{Columns: make(map[string]string), Indexes: make(map[string]string)}
+			tables[name] = table
+		}
+		return table
+	}
+
+	iter := client.session.Query(describeColumnsCQL, client.cfg.Keyspace).Iter()
+	var tableName, columnName, kind, columnType, clusteringOrder string
+	var position int
+	for iter.Scan(&tableName, &columnName, &kind, &position, &columnType, &clusteringOrder) {
+		definition := columnType
+		switch kind {
+		case "partition_key":
+			definition = fmt.Sprintf("%v partition_key(%v)", columnType, position)
+		case "clustering":
+			definition = fmt.Sprintf("%v clustering(%v) %v", columnType, position, clusteringOrder)
+		case "static":
+			definition = columnType + " static"
+		}
+		getTable(result.Tables, tableName).Columns[columnName] = definition
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	iter = client.session.Query(describeIndexesCQL, client.cfg.Keyspace).Iter()
+	var indexName string
+	var options map[string]string
+	for iter.Scan(&tableName, &indexName, &kind, &options) {
+		getTable(result.Tables, tableName).Indexes[indexName] = fmt.Sprintf("%v %v", kind, options["target"])
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	iter = client.session.Query(describeTypesCQL, client.cfg.Keyspace).Iter()
+	var typeName string
+	var fieldNames, fieldTypes []string
+	for iter.Scan(&typeName, &fieldNames, &fieldTypes) {
+		udt := getTable(result.Types, typeName)
+		for i := range fieldNames {
+			if i < len(fieldTypes) {
+				udt.Columns[fieldNames[i]] = fieldTypes[i]
+			}
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // listTypes lists the User defined types in a Keyspace
 func (client *CqlClient) listTypes() ([]string, error) {
 	qry := client.session.Query(listTypesCQL, client.cfg.Keyspace)
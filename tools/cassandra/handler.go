@@ -23,7 +23,9 @@ package cassandra
 import (
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/pborman/uuid"
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/common/config"
@@ -142,6 +144,48 @@ func updateSchema(cli *cli.Context) error {
 	return nil
 }
 
+// verifySchema executes the verifySchemaTask against a scratch
+// Keyspace, using the given command line args as input
+func verifySchema(cli *cli.Context) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := NewCQLClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+
+	scratchConfig := *config
+	scratchConfig.Keyspace = cli.String(schema.CLIOptScratchName)
+	if scratchConfig.Keyspace == "" {
+		scratchConfig.Keyspace = config.Keyspace + "_verify_" + strings.Replace(uuid.New(), "-", "", -1)[:8]
+	}
+	if scratchConfig.Keyspace == config.Keyspace {
+		return handleErr(schema.NewConfigError(flag(schema.CLIOptScratchName) + " must differ from " + flag(schema.CLIOptKeyspace)))
+	}
+	// the scratch Keyspace is dropped afterwards, so it must not exist before
+	if err := doCreateNewKeyspace(scratchConfig, scratchConfig.Keyspace); err != nil {
+		return handleErr(fmt.Errorf("error creating scratch Keyspace %v, it must not exist:%v", scratchConfig.Keyspace, err))
+	}
+	defer func() {
+		if err := client.DropKeyspace(scratchConfig.Keyspace); err != nil {
+			logErr(fmt.Errorf("error dropping scratch Keyspace:%v", err))
+		}
+	}()
+	scratchClient, err := NewCQLClient(&scratchConfig)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer scratchClient.Close()
+
+	if err := schema.Verify(cli, client, scratchClient); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
 // createKeyspace creates a cassandra Keyspace
 func createKeyspace(cli *cli.Context) error {
 	config, err := newCQLClientConfig(cli)
@@ -169,6 +213,16 @@ func doCreateKeyspace(cfg CQLClientConfig, name string) error {
 	return client.CreateKeyspace(name)
 }
 
+func doCreateNewKeyspace(cfg CQLClientConfig, name string) error {
+	cfg.Keyspace = SystemKeyspace
+	client, err := NewCQLClient(&cfg)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return client.CreateNewKeyspace(name)
+}
+
 func newCQLClientConfig(cli *cli.Context) (*CQLClientConfig, error) {
 	cqlConfig := new(CQLClientConfig)
 	cqlConfig.Hosts = cli.GlobalString(schema.CLIOptEndpoint)
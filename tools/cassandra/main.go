@@ -185,6 +185,29 @@ func BuildCLIOptions() *cli.App {
 				cliHandler(c, updateSchema)
 			},
 		},
+		{
+			Name:  "verify-schema",
+			Usage: "verify that the cassandra schema matches the versioned schema, exits non-zero on drift",
+			Description: "Applies the versioned schema up to the version of the Keyspace to an empty scratch Keyspace, " +
+				"then compares their tables, columns, indexes and types. The scratch Keyspace is dropped afterwards.",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "expected version of the schema, defaults to the version of the Keyspace",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagScratchName,
+					Usage: "name of the scratch Keyspace the versioned schema is applied to, must not exist, defaults to <Keyspace>_verify_<random suffix>",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, verifySchema)
+			},
+		},
 		{
 			Name:    "create-Keyspace",
 			Aliases: []string{"create"},
@@ -30,6 +30,7 @@ import (
 
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/tools/common/flag"
 )
@@ -99,6 +100,166 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminRefreshWorkflowTasks(c)
 			},
 		},
+		{
+			Name:    "list-tasks",
+			Aliases: []string{"lt"},
+			Usage:   "List pending transfer, timer, replication and cross-cluster tasks of a workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, tasks of all runs are listed if not provided",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListWorkflowTasks(c)
+			},
+		},
+		{
+			Name:    "delete-task",
+			Aliases: []string{"dt"},
+			Usage:   "Delete a pending transfer or timer task of a workflow, the task will not be executed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.IntFlag{
+					Name:  FlagTaskType,
+					Usage: "task type: 2 (transfer task) or 3 (timer task)",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskID,
+					Usage: "taskID",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskVisibilityTimestamp,
+					Usage: "task visibility timestamp in nano (optional)",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to delete the task",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDeleteWorkflowTask(c)
+			},
+		},
+		{
+			Name:    "reenqueue-task",
+			Aliases: []string{"ret"},
+			Usage:   "Re-enqueue a pending transfer or timer task of a workflow that is backing off after failed attempts",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.IntFlag{
+					Name:  FlagTaskType,
+					Usage: "task type: 2 (transfer task) or 3 (timer task)",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskID,
+					Usage: "taskID",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskVisibilityTimestamp,
+					Usage: "task visibility timestamp in nano (optional)",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to re-enqueue the task",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReenqueueWorkflowTask(c)
+			},
+		},
+		{
+			Name:    "failover",
+			Aliases: []string{"fo"},
+			Usage:   "Make a workflow of an active-active domain, or all workflows matching the query, active in the target cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagListQueryWithAlias,
+					Usage: "Visibility query of the workflows to fail over, e.g. \"Region = 'us-east' and CloseTime = missing\"",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetClusterWithAlias,
+					Usage: "Cluster the workflows are made active in",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverWorkflow(c)
+			},
+		},
+		{
+			Name:    "suspend",
+			Aliases: []string{"sus"},
+			Usage:   "Suspend a workflow, its decisions, activities and timers are held back until it is resumed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to suspend the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminSuspendWorkflow(c)
+			},
+		},
+		{
+			Name:    "resume",
+			Aliases: []string{"res"},
+			Usage:   "Resume a suspended workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to resume the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminResumeWorkflow(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -160,6 +321,33 @@ func newAdminShardManagementCommands() []cli.Command {
 				AdminDescribeShardDistribution(c)
 			},
 		},
+		{
+			Name:  "top",
+			Usage: "Show the busiest shards based on the load reported by history hosts",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagHistoryAddressWithAlias,
+					Usage: "Only show shards owned by this history host address",
+				},
+				cli.IntFlag{
+					Name:  FlagTopN,
+					Value: 10,
+					Usage: "Number of shards to show",
+				},
+				cli.StringFlag{
+					Name:  FlagSortBy,
+					Value: shardTopSortByRequests,
+					Usage: "Sort shards by: " + strings.Join(shardTopSortKeys, ", "),
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintFullyDetailWithAlias,
+					Usage: "Also show the top domains and workflows of each shard",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShardTop(c)
+			},
+		},
 		{
 			Name:    "setRangeID",
 			Aliases: []string{"srid"},
@@ -384,6 +572,59 @@ func newAdminDomainCommands() []cli.Command {
 				newDomainCLI(c, true).DescribeDomain(c)
 			},
 		},
+		{
+			Name:    "apply",
+			Aliases: []string{"ap"},
+			Usage:   "Register or update domains to match the YAML domain specs under a path",
+			Flags:   adminApplyDomainFlags,
+			Action: func(c *cli.Context) {
+				newDomainCLI(c, true).ApplyDomains(c)
+			},
+		},
+		{
+			Name:  "promote",
+			Usage: "Promote a local domain to a global domain and replicate its open workflows to the new clusters",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					// use StringFlag instead of buggy StringSliceFlag
+					// TODO when https://github.com/urfave/cli/pull/392 & v2 is released
+					//  consider update urfave/cli
+					Name:  FlagClustersWithAlias,
+					Usage: "Clusters the domain is replicated to",
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipReplication,
+					Usage: "Optional flag to skip starting the batch job which replicates open workflows",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: batcher.DefaultRPS,
+					Usage: "RPS of replicating open workflows",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPromoteDomain(c)
+			},
+		},
+		{
+			Name:  "usage",
+			Usage: "Describe the history storage and workflow counts of a domain, as last aggregated by the domain usage worker",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeDomainUsage(c)
+			},
+		},
+		{
+			Name:        "reindex",
+			Aliases:     []string{"ri"},
+			Usage:       "Rebuild the visibility records of a domain from mutable state and history",
+			Subcommands: newAdminReindexCommands(),
+		},
 		{
 			Name:    "getdomainidorname",
 			Aliases: []string{"getdn"},
@@ -707,6 +948,46 @@ func newAdminClusterCommands() []cli.Command {
 				AdminAddSearchAttribute(c)
 			},
 		},
+		{
+			Name:    "remove-search-attr",
+			Aliases: []string{"rsa"},
+			Usage:   "remove search attribute from the whitelist",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSearchAttributesKey,
+					Usage: "Search Attribute key to be removed",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Optional token for security check",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRemoveSearchAttribute(c)
+			},
+		},
+		{
+			Name:    "rename-search-attr",
+			Aliases: []string{"rnsa"},
+			Usage:   "rename search attribute, the old key is kept as an alias of the new key",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSearchAttributesKey,
+					Usage: "Search Attribute key to be renamed",
+				},
+				cli.StringFlag{
+					Name:  FlagSearchAttributesNewKey,
+					Usage: "New Search Attribute key",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Optional token for security check",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRenameSearchAttribute(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"d"},
@@ -741,6 +1022,12 @@ func newAdminClusterCommands() []cli.Command {
 			Usage:       "Rebalance the domains active cluster",
 			Subcommands: newAdminRebalanceCommands(),
 		},
+		{
+			Name:        "migrate",
+			Aliases:     []string{"mig"},
+			Usage:       "Migrate global domains to this cluster, which may have a different number of history shards",
+			Subcommands: newAdminShardMigrationCommands(),
+		},
 	}
 }
 
@@ -779,6 +1066,10 @@ func newAdminDLQCommands() []cli.Command {
 					Name:  FlagDLQRawTask,
 					Usage: "Show DLQ raw task information",
 				},
+				cli.BoolFlag{
+					Name:  FlagDLQDead,
+					Usage: "Read the history replication tasks given up on by DLQ redrive, with their dead reason and redrive attempts",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminGetDLQMessages(c)
@@ -809,6 +1100,10 @@ func newAdminDLQCommands() []cli.Command {
 					Name:  FlagLastMessageIDWithAlias,
 					Usage: "The upper boundary of the read message",
 				},
+				cli.BoolFlag{
+					Name:  FlagDLQDead,
+					Usage: "Manage the history replication tasks given up on by DLQ redrive",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminPurgeDLQMessages(c)
@@ -839,6 +1134,10 @@ func newAdminDLQCommands() []cli.Command {
 					Name:  FlagLastMessageIDWithAlias,
 					Usage: "The upper boundary of the read message",
 				},
+				cli.BoolFlag{
+					Name:  FlagDLQDead,
+					Usage: "Manage the history replication tasks given up on by DLQ redrive",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminMergeDLQMessages(c)
@@ -1007,6 +1306,10 @@ func getDBFlags() []cli.Flag {
 			},
 			Usage: "sql database decoding types",
 		},
+		cli.StringFlag{
+			Name:  FlagKeyringPath,
+			Usage: "path of the keyring file used to decrypt encrypted payloads",
+		},
 		cli.IntFlag{
 			Name:  FlagProtoVersion,
 			Value: 4,
@@ -1290,6 +1593,170 @@ func newAdminRebalanceCommands() []cli.Command {
 	}
 }
 
+func newAdminReindexCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "start reindex workflow for the domain",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "Optional number of executions read from persistence per page",
+					Value: 100,
+				},
+				cli.IntFlag{
+					Name:  FlagConcurrency,
+					Usage: "Optional number of shards reindexed in parallel",
+					Value: 10,
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Optional reindex workflow timeout in seconds",
+					Value: defaultReindexWorkflowTimeoutInSeconds,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReindexStart(c)
+			},
+		},
+		{
+			Name:    "query",
+			Aliases: []string{"q"},
+			Usage:   "query reindex workflow progress of the domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional reindex workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReindexQuery(c)
+			},
+		},
+	}
+}
+
+func newAdminShardMigrationCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "start shard migration workflow, must be run against the target cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Source cluster name",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetClusterWithAlias,
+					Usage: "Target cluster name, this is the cluster the command runs against",
+				},
+				cli.StringSliceFlag{
+					Name: FlagFailoverDomains,
+					Usage: "Optional domains to migrate, eg d1,d2..,dn. " +
+						"Default is all global domains active in the source cluster and replicated to the target cluster.",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "Optional number of executions listed from the source cluster per page",
+					Value: 100,
+				},
+				cli.BoolFlag{
+					Name:  FlagAutoCutover,
+					Usage: "Optional to fail over verified domains without waiting for the cutover command",
+				},
+				cli.IntFlag{
+					Name:  FlagFailoverTimeoutWithAlias,
+					Usage: "Optional graceful failover timeout in seconds used by the cutover",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Optional shard migration workflow timeout in seconds",
+					Value: defaultShardMigrationWorkflowTimeoutInSeconds,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShardMigrationStart(c)
+			},
+		},
+		{
+			Name:    "query",
+			Aliases: []string{"q"},
+			Usage:   "query shard migration workflow progress",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional shard migration workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShardMigrationQuery(c)
+			},
+		},
+		{
+			Name:    "cutover",
+			Aliases: []string{"c"},
+			Usage:   "fail over the verified domains to the target cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional shard migration workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShardMigrationCutover(c)
+			},
+		},
+		{
+			Name:    "pause",
+			Aliases: []string{"p"},
+			Usage:   "pause shard migration workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional shard migration workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShardMigrationPause(c)
+			},
+		},
+		{
+			Name:    "resume",
+			Aliases: []string{"re"},
+			Usage:   "resume paused shard migration workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional shard migration workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShardMigrationResume(c)
+			},
+		},
+		{
+			Name:    "abort",
+			Aliases: []string{"a"},
+			Usage:   "abort shard migration workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional shard migration workflow runID, default is latest runID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Optional reason why abort",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShardMigrationAbort(c)
+			},
+		},
+	}
+}
+
 func newAdminConfigStoreCommands() []cli.Command {
 	return []cli.Command{
 		{
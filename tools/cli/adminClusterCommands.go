@@ -68,6 +68,60 @@ func AdminAddSearchAttribute(c *cli.Context) {
 	fmt.Println("Success. Note that for a multil-node Cadence cluster, DynamicConfig MUST be updated separately to whitelist the new attributes.")
 }
 
+// AdminRemoveSearchAttribute to remove search attribute from the whitelist
+func AdminRemoveSearchAttribute(c *cli.Context) {
+	key := getRequiredOption(c, FlagSearchAttributesKey)
+
+	// ask user for confirmation
+	promptMsg := fmt.Sprintf("Are you trying to remove key [%s]? Existing documents keep the field until the domain is reindexed. Y/N",
+		color.YellowString(key))
+	promptFn(promptMsg)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &types.RemoveSearchAttributeRequest{
+		SearchAttribute: []string{key},
+		SecurityToken:   c.String(FlagSecurityToken),
+	}
+
+	err := adminClient.RemoveSearchAttribute(ctx, request, cc.GetDefaultCLIYarpcCallOptions()...)
+	if err != nil {
+		ErrorAndExit("Remove search attribute failed.", err)
+	}
+	fmt.Println("Success. Note that for a multil-node Cadence cluster, DynamicConfig MUST be updated separately to remove the attributes.")
+}
+
+// AdminRenameSearchAttribute to rename search attribute, the old key keeps working as an alias
+func AdminRenameSearchAttribute(c *cli.Context) {
+	oldKey := getRequiredOption(c, FlagSearchAttributesKey)
+	newKey := getRequiredOption(c, FlagSearchAttributesNewKey)
+	if oldKey == newKey {
+		ErrorAndExit("New search attribute key is same as the old key.", nil)
+	}
+
+	// ask user for confirmation
+	promptMsg := fmt.Sprintf("Are you trying to rename key [%s] to [%s]? Y/N",
+		color.YellowString(oldKey), color.YellowString(newKey))
+	promptFn(promptMsg)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &types.RenameSearchAttributeRequest{
+		OldName:       oldKey,
+		NewName:       newKey,
+		SecurityToken: c.String(FlagSecurityToken),
+	}
+
+	err := adminClient.RenameSearchAttribute(ctx, request, cc.GetDefaultCLIYarpcCallOptions()...)
+	if err != nil {
+		ErrorAndExit("Rename search attribute failed.", err)
+	}
+	fmt.Println("Success. Note that for a multil-node Cadence cluster, DynamicConfig MUST be updated separately to rename the attributes. " +
+		"Reindex the domains using the attribute to move existing documents to the new key.")
+}
+
 // AdminDescribeCluster is used to dump information about the cluster
 func AdminDescribeCluster(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -79,7 +133,7 @@ func AdminDescribeCluster(c *cli.Context) {
 		ErrorAndExit("Operation DescribeCluster failed.", err)
 	}
 
-	prettyPrintJSONObject(response)
+	renderResult(c, response)
 }
 
 func AdminRebalanceStart(c *cli.Context) {
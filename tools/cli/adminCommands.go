@@ -32,6 +32,7 @@ import (
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+	cclient "go.uber.org/cadence/client"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -47,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/uber/cadence/tools/common/flag"
 )
 
@@ -389,6 +391,87 @@ func AdminGetDomainIDOrName(c *cli.Context) {
 	}
 }
 
+// AdminPromoteDomain converts a local domain into a global domain, then starts
+// a batch job to replicate the open workflows of the domain to the other clusters
+func AdminPromoteDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	var clusters []*types.ClusterReplicationConfiguration
+	if c.IsSet(FlagClusters) {
+		clusters = append(clusters, &types.ClusterReplicationConfiguration{
+			ClusterName: c.String(FlagClusters),
+		})
+		for _, clusterStr := range c.Args() {
+			clusters = append(clusters, &types.ClusterReplicationConfiguration{
+				ClusterName: clusterStr,
+			})
+		}
+	}
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.PromoteDomain(ctx, &types.PromoteDomainRequest{
+		Domain:   domain,
+		Clusters: clusters,
+	})
+	if err != nil {
+		ErrorAndExit("Promote domain failed", err)
+	}
+	fmt.Printf("Domain %v is promoted to a global domain with failover version %v.\n", domain, resp.GetFailoverVersion())
+
+	sourceCluster := resp.GetReplicationConfiguration().GetActiveClusterName()
+	var targetClusters []string
+	for _, cluster := range resp.GetReplicationConfiguration().GetClusters() {
+		if cluster.GetClusterName() != sourceCluster {
+			targetClusters = append(targetClusters, cluster.GetClusterName())
+		}
+	}
+	if c.Bool(FlagSkipReplication) || len(targetClusters) == 0 {
+		return
+	}
+
+	svcClient := cFactory.ClientFrontendClient(c)
+	client := cclient.NewClient(svcClient, common.BatcherLocalDomainName, &DefaultClientOptions)
+	startBatchWorkflow(c, client, batcher.BatchParams{
+		DomainName: domain,
+		Query:      "CloseTime = missing",
+		Reason:     "promote domain " + domain,
+		BatchType:  batcher.BatchTypeReplicate,
+		ReplicateParams: batcher.ReplicateParams{
+			SourceCluster:  sourceCluster,
+			TargetClusters: targetClusters,
+		},
+		RPS: c.Int(FlagRPS),
+	})
+}
+
+// AdminDescribeDomainUsage describes the aggregated usage of a domain
+func AdminDescribeDomainUsage(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeDomainUsage(ctx, &types.DescribeDomainUsageRequest{
+		Domain: domain,
+	})
+	if err != nil {
+		ErrorAndExit("Describe domain usage failed", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(resp)
+		return
+	}
+
+	usage := resp.GetUsage()
+	fmt.Printf("HistorySize: %d\n", usage.GetHistorySize())
+	fmt.Printf("HistoryEventCount: %d\n", usage.GetHistoryEventCount())
+	fmt.Printf("OpenWorkflowCount: %d\n", usage.GetOpenWorkflowCount())
+	fmt.Printf("ClosedWorkflowCount: %d\n", usage.GetClosedWorkflowCount())
+	fmt.Printf("LastUpdateTime: %s\n", convertTime(resp.GetLastUpdateTime(), false))
+}
+
 // AdminGetShardID get shardID
 func AdminGetShardID(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
@@ -449,7 +532,7 @@ func AdminDescribeShard(c *cli.Context) {
 		ErrorAndExit("Failed to describe shard.", err)
 	}
 
-	prettyPrintJSONObject(shard)
+	renderResult(c, shard)
 }
 
 // AdminSetShardRangeID set shard rangeID by shard id
@@ -587,7 +670,7 @@ func AdminDescribeHistoryHost(c *cli.Context) {
 	if !printFully {
 		resp.ShardIDs = nil
 	}
-	prettyPrintJSONObject(resp)
+	renderResult(c, resp)
 }
 
 // AdminRefreshWorkflowTasks refreshes all the tasks of a workflow
@@ -615,6 +698,253 @@ func AdminRefreshWorkflowTasks(c *cli.Context) {
 	}
 }
 
+// AdminListWorkflowTasks lists the pending tasks of a workflow
+func AdminListWorkflowTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListWorkflowTasks(ctx, &types.ListWorkflowTasksRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("List workflow tasks failed", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(resp)
+		return
+	}
+
+	fmt.Printf("ShardID: %d\n", resp.ShardID)
+	if resp.Truncated {
+		fmt.Println("Warning: the shard has too many pending tasks, only tasks found in the first part of each queue are listed.")
+	}
+	if len(resp.Tasks) == 0 {
+		fmt.Println("No pending tasks.")
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	header := []string{"Type", "SubType", "TaskID", "VisibilityTimestamp", "VisibilityTime", "Version", "Attempt", "Cluster"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	table.SetHeaderLine(false)
+	for _, taskInfo := range resp.Tasks {
+		table.Append([]string{
+			workflowTaskTypeName(taskInfo.GetType()),
+			strconv.Itoa(int(taskInfo.SubType)),
+			strconv.FormatInt(taskInfo.TaskID, 10),
+			strconv.FormatInt(taskInfo.GetVisibilityTimestamp(), 10),
+			convertTime(taskInfo.GetVisibilityTimestamp(), false),
+			strconv.FormatInt(taskInfo.Version, 10),
+			strconv.Itoa(int(taskInfo.Attempt)),
+			taskInfo.ClusterName,
+		})
+	}
+	table.Render()
+}
+
+// AdminDeleteWorkflowTask deletes a pending transfer or timer task of a workflow
+func AdminDeleteWorkflowTask(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	typeID := getRequiredIntOption(c, FlagTaskType)
+	taskID := getRequiredInt64Option(c, FlagTaskID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.DeleteWorkflowTask(ctx, &types.DeleteWorkflowTaskRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Type:                common.Int32Ptr(int32(typeID)),
+		TaskID:              taskID,
+		VisibilityTimestamp: getOptionalInt64Option(c, FlagTaskVisibilityTimestamp),
+		Reason:              c.String(FlagReason),
+		Identity:            getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Delete workflow task failed", err)
+	} else {
+		fmt.Println("Delete workflow task succeeded.")
+	}
+}
+
+// AdminReenqueueWorkflowTask re-enqueues a pending task of a workflow for immediate execution
+func AdminReenqueueWorkflowTask(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	typeID := getRequiredIntOption(c, FlagTaskType)
+	taskID := getRequiredInt64Option(c, FlagTaskID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.ReenqueueWorkflowTask(ctx, &types.ReenqueueWorkflowTaskRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Type:                common.Int32Ptr(int32(typeID)),
+		TaskID:              taskID,
+		VisibilityTimestamp: getOptionalInt64Option(c, FlagTaskVisibilityTimestamp),
+		Reason:              c.String(FlagReason),
+		Identity:            getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Re-enqueue workflow task failed", err)
+	} else {
+		fmt.Println("Re-enqueue workflow task succeeded.")
+	}
+}
+
+func workflowTaskTypeName(taskType int32) string {
+	switch common.TaskType(taskType) {
+	case common.TaskTypeTransfer:
+		return "Transfer"
+	case common.TaskTypeTimer:
+		return "Timer"
+	case common.TaskTypeReplication:
+		return "Replication"
+	case common.TaskTypeCrossCluster:
+		return "CrossCluster"
+	default:
+		return strconv.Itoa(int(taskType))
+	}
+}
+
+// AdminFailoverWorkflow makes a workflow of an active-active domain, or all workflows matching the query,
+// active in the target cluster
+func AdminFailoverWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	targetCluster := getRequiredOption(c, FlagTargetCluster)
+
+	failover := func(wid, rid string) error {
+		ctx, cancel := newContext(c)
+		defer cancel()
+
+		return adminClient.FailoverWorkflowExecution(ctx, &types.FailoverWorkflowExecutionRequest{
+			Domain: domain,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			TargetCluster: targetCluster,
+		})
+	}
+
+	if !c.IsSet(FlagListQuery) {
+		wid := getRequiredOption(c, FlagWorkflowID)
+		if err := failover(wid, c.String(FlagRunID)); err != nil {
+			ErrorAndExit("Failover workflow failed", err)
+		}
+		fmt.Println("Failover workflow succeeded.")
+		return
+	}
+
+	wfClient := getWorkflowClient(c)
+	listQuery := c.String(FlagListQuery)
+	succeeded, failed := 0, 0
+	var nextPageToken []byte
+	for {
+		executions, token := listWorkflowExecutions(wfClient, defaultPageSizeForList, nextPageToken, listQuery, c)
+		for _, e := range executions {
+			wid := e.GetExecution().GetWorkflowId()
+			rid := e.GetExecution().GetRunId()
+			if err := failover(wid, rid); err != nil {
+				fmt.Printf("Failover workflow %v, run %v failed: %v\n", wid, rid, err)
+				failed++
+				continue
+			}
+			succeeded++
+		}
+		if len(token) == 0 {
+			break
+		}
+		nextPageToken = token
+	}
+	fmt.Printf("Failover workflows finished, succeeded: %v, failed: %v.\n", succeeded, failed)
+}
+
+// AdminSuspendWorkflow suspends a workflow
+func AdminSuspendWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.SuspendWorkflowExecution(ctx, &types.SuspendWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Reason:   c.String(FlagReason),
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Suspend workflow failed", err)
+	} else {
+		fmt.Println("Suspend workflow succeeded.")
+	}
+}
+
+// AdminResumeWorkflow resumes a workflow
+func AdminResumeWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.ResumeWorkflowExecution(ctx, &types.ResumeWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Reason:   c.String(FlagReason),
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Resume workflow failed", err)
+	} else {
+		fmt.Println("Resume workflow succeeded.")
+	}
+}
+
 // AdminResetQueue resets task processing queue states
 func AdminResetQueue(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -77,7 +77,7 @@ func AdminGetDynamicConfig(c *cli.Context) {
 				}
 				cliEntries = append(cliEntries, cliEntry)
 			}
-			prettyPrintJSONObject(cliEntries)
+			renderResult(c, cliEntries)
 		}
 	} else {
 		parsedFilters, err := parseInputFilterArray(filters)
@@ -104,7 +104,7 @@ func AdminGetDynamicConfig(c *cli.Context) {
 		if umVal == nil {
 			fmt.Printf("No values stored for specified dynamic config.\n")
 		} else {
-			prettyPrintJSONObject(umVal)
+			renderResult(c, umVal)
 		}
 	}
 }
@@ -206,7 +206,7 @@ func AdminListDynamicConfig(c *cli.Context) {
 			}
 			cliEntries = append(cliEntries, cliEntry)
 		}
-		prettyPrintJSONObject(cliEntries)
+		renderResult(c, cliEntries)
 	}
 }
 
@@ -34,7 +34,9 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/collection"
+	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/encryption"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/persistence"
@@ -245,7 +247,7 @@ func initializeExecutionStore(
 		execStore = initializeSQLExecutionStore(c, shardID, logger)
 	}
 
-	executionManager := persistence.NewExecutionManagerImpl(execStore, logger)
+	executionManager := persistence.NewExecutionManagerImpl(execStore, logger, initializeEncryptor(c))
 	if rps == 0 {
 		return executionManager
 	}
@@ -308,11 +310,24 @@ func initializeHistoryManager(c *cli.Context) persistence.HistoryManager {
 	historyStore := persistence.NewHistoryV2ManagerImpl(
 		historyV2Mgr,
 		logger,
+		initializeEncryptor(c),
 		dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
 	)
 	return historyStore
 }
 
+func initializeEncryptor(c *cli.Context) encryption.Encryptor {
+	var cfg config.PayloadEncryption
+	if path := c.String(FlagKeyringPath); path != "" {
+		cfg.Keyring = &config.Keyring{Path: path}
+	}
+	keyProvider, err := encryption.NewKeyProvider(cfg)
+	if err != nil {
+		ErrorAndExit("Failed to load keyring", err)
+	}
+	return encryption.NewEncryptor(keyProvider)
+}
+
 func initializeShardManager(c *cli.Context) persistence.ShardManager {
 	var shardStore persistence.ShardStore
 	dbType := c.String(FlagDBType)
@@ -44,13 +44,14 @@ func AdminGetDLQMessages(c *cli.Context) {
 
 	adminClient := cFactory.ServerAdminClient(c)
 	dlqType := getRequiredOption(c, FlagDLQType)
-	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	sourceCluster := getDLQSourceCluster(c)
 	shardID := getRequiredIntOption(c, FlagShardID)
 	serializer := persistence.NewPayloadSerializer()
 	outputFile := getOutputFile(c.String(FlagOutputFilename))
 	defer outputFile.Close()
 
-	showRawTask := c.Bool(FlagDLQRawTask)
+	// raw tasks in the dead DLQ carry the dead reason and redrive attempts
+	showRawTask := c.Bool(FlagDLQRawTask) || c.Bool(FlagDLQDead)
 	var rawTasksInfo []*types.ReplicationTaskInfo
 	remainingMessageCount := common.EndMessageID
 	if c.IsSet(FlagMaxMessageCount) {
@@ -135,7 +136,7 @@ func AdminGetDLQMessages(c *cli.Context) {
 // AdminPurgeDLQMessages deletes messages from DLQ
 func AdminPurgeDLQMessages(c *cli.Context) {
 	dlqType := getRequiredOption(c, FlagDLQType)
-	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	sourceCluster := getDLQSourceCluster(c)
 	lowerShardBound := c.Int(FlagLowerShardBound)
 	upperShardBound := c.Int(FlagUpperShardBound)
 	var lastMessageID *int64
@@ -165,7 +166,7 @@ func AdminPurgeDLQMessages(c *cli.Context) {
 // AdminMergeDLQMessages merges message from DLQ
 func AdminMergeDLQMessages(c *cli.Context) {
 	dlqType := getRequiredOption(c, FlagDLQType)
-	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	sourceCluster := getDLQSourceCluster(c)
 	lowerShardBound := c.Int(FlagLowerShardBound)
 	upperShardBound := c.Int(FlagUpperShardBound)
 	var lastMessageID *int64
@@ -203,6 +204,15 @@ ShardIDLoop:
 	}
 }
 
+// getDLQSourceCluster returns the DLQ to manage, which is the dead DLQ of the source cluster if requested
+func getDLQSourceCluster(c *cli.Context) string {
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	if c.Bool(FlagDLQDead) {
+		return sourceCluster + common.ReplicationDeadDLQSuffix
+	}
+	return sourceCluster
+}
+
 func toQueueType(dlqType string) *types.DLQType {
 	switch dlqType {
 	case "domain":
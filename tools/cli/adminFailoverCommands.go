@@ -104,7 +104,7 @@ func AdminFailoverQuery(c *cli.Context) {
 	if isWorkflowTerminated(descResp) {
 		result.State = failovermanager.WorkflowAborted
 	}
-	prettyPrintJSONObject(result)
+	renderResult(c, result)
 }
 
 // AdminFailoverAbort abort a failover workflow
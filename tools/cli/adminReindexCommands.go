@@ -0,0 +1,89 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/reindexer"
+)
+
+const (
+	defaultReindexWorkflowTimeoutInSeconds = 7 * 24 * 60 * 60
+)
+
+// AdminReindexStart starts the workflow which rebuilds the visibility records of a domain
+func AdminReindexStart(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	options := cclient.StartWorkflowOptions{
+		ID:                           reindexer.WorkflowIDPrefix + domain,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		TaskList:                     reindexer.TaskListName,
+		ExecutionStartToCloseTimeout: time.Duration(c.Int(FlagExecutionTimeout)) * time.Second,
+		Memo: map[string]interface{}{
+			common.MemoKeyForOperator: getOperator(),
+		},
+	}
+	params := &reindexer.ReindexParams{
+		Domain:      domain,
+		PageSize:    c.Int(FlagPageSize),
+		Concurrency: c.Int(FlagConcurrency),
+	}
+	wf, err := client.StartWorkflow(tcCtx, options, reindexer.WorkflowTypeName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start reindex workflow", err)
+	}
+	fmt.Println("Reindex workflow started")
+	fmt.Println("wid: " + wf.ID)
+	fmt.Println("rid: " + wf.RunID)
+}
+
+// AdminReindexQuery queries the progress of the reindex workflow of a domain
+func AdminReindexQuery(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	queryResp, err := client.QueryWorkflow(tcCtx, reindexer.WorkflowIDPrefix+domain, getRunID(c), reindexer.QueryType)
+	if err != nil {
+		ErrorAndExit("Failed to query reindex workflow", err)
+	}
+	if !queryResp.HasValue() {
+		ErrorAndExit("QueryResult has no value", nil)
+	}
+	var result reindexer.ReindexResult
+	if err := queryResp.Get(&result); err != nil {
+		ErrorAndExit("Failed to decode query result", err)
+	}
+	renderResult(c, result)
+}
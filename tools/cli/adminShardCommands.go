@@ -0,0 +1,215 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	shardTopSortByRequests    = "rps"
+	shardTopSortByLockWaits   = "lock"
+	shardTopSortByTransferLag = "transfer_lag"
+	shardTopSortByTimerLag    = "timer_lag"
+	shardDistributionPageSize = 1000
+)
+
+var shardTopSortKeys = []string{
+	shardTopSortByRequests,
+	shardTopSortByLockWaits,
+	shardTopSortByTransferLag,
+	shardTopSortByTimerLag,
+}
+
+type hostShardLoad struct {
+	host string
+	load *types.HistoryShardLoad
+}
+
+// shardTopRow is the load of a shard as printed by `admin shard top` in structured formats
+type shardTopRow struct {
+	ShardID                 int32                     `json:"shardID" header:"ShardID"`
+	Host                    string                    `json:"host" header:"Host"`
+	RequestsPerSecond       float64                   `json:"requestsPerSecond" header:"RPS"`
+	LockWaitsPerSecond      float64                   `json:"lockWaitsPerSecond" header:"Lock Waits/s"`
+	AverageLockWaitInMillis int64                     `json:"averageLockWaitInMillis" header:"Avg Lock Wait (ms)"`
+	TransferTaskLag         int64                     `json:"transferTaskLag" header:"Transfer Lag"`
+	TimerTaskLagInMillis    int64                     `json:"timerTaskLagInMillis" header:"Timer Lag (ms)"`
+	TopDomains              []*types.HistoryLoadEntry `json:"topDomains,omitempty" header:"-"`
+	TopWorkflows            []*types.HistoryLoadEntry `json:"topWorkflows,omitempty" header:"-"`
+}
+
+// AdminShardTop shows the busiest shards across history hosts
+func AdminShardTop(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	less, ok := shardTopLessFuncs()[c.String(FlagSortBy)]
+	if !ok {
+		ErrorAndExit(fmt.Sprintf("Invalid sort key %q, valid values are %v", c.String(FlagSortBy), shardTopSortKeys), nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	var hosts []string
+	if c.IsSet(FlagHistoryAddress) {
+		hosts = []string{c.String(FlagHistoryAddress)}
+	} else {
+		hostSet := make(map[string]struct{})
+		for pageID := int32(0); ; pageID++ {
+			resp, err := adminClient.DescribeShardDistribution(ctx, &types.DescribeShardDistributionRequest{
+				PageSize: shardDistributionPageSize,
+				PageID:   pageID,
+			})
+			if err != nil {
+				ErrorAndExit("Failed to describe shard distribution", err)
+			}
+			for _, identity := range resp.Shards {
+				if identity != "unknown" {
+					hostSet[identity] = struct{}{}
+				}
+			}
+			if int32(len(resp.Shards)) < shardDistributionPageSize {
+				break
+			}
+		}
+		for host := range hostSet {
+			hosts = append(hosts, host)
+		}
+		sort.Strings(hosts)
+	}
+
+	var loads []hostShardLoad
+	for _, host := range hosts {
+		resp, err := adminClient.DescribeHistoryHost(ctx, &types.DescribeHistoryHostRequest{
+			HostAddress: common.StringPtr(host),
+		})
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to describe history host %v", host), err)
+		}
+		for _, load := range resp.ShardLoads {
+			loads = append(loads, hostShardLoad{host: host, load: load})
+		}
+	}
+
+	sort.SliceStable(loads, func(i, j int) bool {
+		return less(loads[i].load, loads[j].load)
+	})
+	if topN := c.Int(FlagTopN); topN >= 0 && len(loads) > topN {
+		loads = loads[:topN]
+	}
+
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		rows := make([]shardTopRow, 0, len(loads))
+		for _, entry := range loads {
+			rows = append(rows, shardTopRow{
+				ShardID:                 entry.load.ShardID,
+				Host:                    entry.host,
+				RequestsPerSecond:       entry.load.RequestsPerSecond,
+				LockWaitsPerSecond:      entry.load.LockWaitsPerSecond,
+				AverageLockWaitInMillis: entry.load.AverageLockWaitInMillis,
+				TransferTaskLag:         entry.load.TransferTaskLag,
+				TimerTaskLagInMillis:    entry.load.TimerTaskLagInMillis,
+				TopDomains:              entry.load.TopDomains,
+				TopWorkflows:            entry.load.TopWorkflows,
+			})
+		}
+		renderer.renderAllRows(rows)
+		return
+	}
+
+	printFully := c.Bool(FlagPrintFullyDetail)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	header := []string{"ShardID", "Host", "RPS", "Lock Waits/s", "Avg Lock Wait", "Transfer Lag", "Timer Lag"}
+	if printFully {
+		header = append(header, "Top Domains", "Top Workflows")
+	}
+	headerColor := make([]tablewriter.Colors, len(header))
+	for i := range headerColor {
+		headerColor[i] = tableHeaderBlue
+	}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	table.SetHeaderLine(false)
+
+	for _, entry := range loads {
+		load := entry.load
+		row := []string{
+			strconv.Itoa(int(load.ShardID)),
+			entry.host,
+			strconv.FormatFloat(load.RequestsPerSecond, 'f', 1, 64),
+			strconv.FormatFloat(load.LockWaitsPerSecond, 'f', 1, 64),
+			(time.Duration(load.AverageLockWaitInMillis) * time.Millisecond).String(),
+			strconv.FormatInt(load.TransferTaskLag, 10),
+			(time.Duration(load.TimerTaskLagInMillis) * time.Millisecond).String(),
+		}
+		if printFully {
+			row = append(row, formatLoadEntries(load.TopDomains, false), formatLoadEntries(load.TopWorkflows, true))
+		}
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func shardTopLessFuncs() map[string]func(a, b *types.HistoryShardLoad) bool {
+	return map[string]func(a, b *types.HistoryShardLoad) bool{
+		shardTopSortByRequests: func(a, b *types.HistoryShardLoad) bool {
+			return a.RequestsPerSecond > b.RequestsPerSecond
+		},
+		shardTopSortByLockWaits: func(a, b *types.HistoryShardLoad) bool {
+			return a.LockWaitsPerSecond > b.LockWaitsPerSecond
+		},
+		shardTopSortByTransferLag: func(a, b *types.HistoryShardLoad) bool {
+			return a.TransferTaskLag > b.TransferTaskLag
+		},
+		shardTopSortByTimerLag: func(a, b *types.HistoryShardLoad) bool {
+			return a.TimerTaskLagInMillis > b.TimerTaskLagInMillis
+		},
+	}
+}
+
+func formatLoadEntries(entries []*types.HistoryLoadEntry, withWorkflowID bool) string {
+	result := ""
+	for i, entry := range entries {
+		if i > 0 {
+			result += "\n"
+		}
+		name := entry.DomainID
+		if withWorkflowID {
+			name = entry.DomainID + "/" + entry.WorkflowID
+		}
+		result += fmt.Sprintf("%v (%.1f rps)", name, entry.RequestsPerSecond)
+	}
+	return result
+}
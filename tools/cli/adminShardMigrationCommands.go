@@ -0,0 +1,150 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/shardmigration"
+)
+
+const (
+	defaultShardMigrationWorkflowTimeoutInSeconds = 7 * 24 * 60 * 60
+	defaultShardMigrationAbortReason              = "Shard migration aborted through admin CLI"
+)
+
+// AdminShardMigrationStart starts the shard migration workflow in the target cluster
+func AdminShardMigrationStart(c *cli.Context) {
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	targetCluster := getRequiredOption(c, FlagTargetCluster)
+	if sourceCluster == targetCluster {
+		ErrorAndExit("sourceCluster is same as targetCluster", nil)
+	}
+	var gracefulFailoverTimeoutInSeconds *int32
+	if c.IsSet(FlagFailoverTimeout) {
+		gracefulFailoverTimeoutInSeconds = common.Int32Ptr(int32(c.Int(FlagFailoverTimeout)))
+	}
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	options := cclient.StartWorkflowOptions{
+		ID:                           shardmigration.WorkflowID,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		TaskList:                     shardmigration.TaskListName,
+		ExecutionStartToCloseTimeout: time.Duration(c.Int(FlagExecutionTimeout)) * time.Second,
+		Memo: map[string]interface{}{
+			common.MemoKeyForOperator: getOperator(),
+		},
+	}
+	params := &shardmigration.MigrationParams{
+		SourceCluster:                    sourceCluster,
+		TargetCluster:                    targetCluster,
+		Domains:                          c.StringSlice(FlagFailoverDomains),
+		PageSize:                         c.Int(FlagPageSize),
+		AutoCutover:                      c.Bool(FlagAutoCutover),
+		GracefulFailoverTimeoutInSeconds: gracefulFailoverTimeoutInSeconds,
+	}
+	wf, err := client.StartWorkflow(tcCtx, options, shardmigration.WorkflowTypeName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start shard migration workflow", err)
+	}
+	fmt.Println("Shard migration workflow started")
+	fmt.Println("wid: " + wf.ID)
+	fmt.Println("rid: " + wf.RunID)
+}
+
+// AdminShardMigrationQuery queries the progress of the shard migration workflow
+func AdminShardMigrationQuery(c *cli.Context) {
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	runID := getRunID(c)
+	queryResp, err := client.QueryWorkflow(tcCtx, shardmigration.WorkflowID, runID, shardmigration.QueryType)
+	if err != nil {
+		ErrorAndExit("Failed to query shard migration workflow", err)
+	}
+	if !queryResp.HasValue() {
+		ErrorAndExit("QueryResult has no value", nil)
+	}
+	var result shardmigration.QueryResult
+	if err := queryResp.Get(&result); err != nil {
+		ErrorAndExit("Failed to decode query result", err)
+	}
+
+	descResp, err := client.DescribeWorkflowExecution(tcCtx, shardmigration.WorkflowID, runID)
+	if err != nil {
+		ErrorAndExit("Failed to describe workflow", err)
+	}
+	if isWorkflowTerminated(descResp) {
+		result.State = shardmigration.WorkflowAborted
+	}
+	renderResult(c, result)
+}
+
+// AdminShardMigrationCutover fails over the verified domains of the shard migration workflow
+func AdminShardMigrationCutover(c *cli.Context) {
+	signalShardMigration(c, shardmigration.CutoverSignal)
+}
+
+// AdminShardMigrationPause pauses the shard migration workflow
+func AdminShardMigrationPause(c *cli.Context) {
+	signalShardMigration(c, shardmigration.PauseSignal)
+}
+
+// AdminShardMigrationResume resumes a paused shard migration workflow
+func AdminShardMigrationResume(c *cli.Context) {
+	signalShardMigration(c, shardmigration.ResumeSignal)
+}
+
+func signalShardMigration(c *cli.Context, signalName string) {
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	if err := client.SignalWorkflow(tcCtx, shardmigration.WorkflowID, getRunID(c), signalName, nil); err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to send %v signal to shard migration workflow", signalName), err)
+	}
+	fmt.Printf("Sent %v signal to shard migration workflow\n", signalName)
+}
+
+// AdminShardMigrationAbort aborts the shard migration workflow
+func AdminShardMigrationAbort(c *cli.Context) {
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	reason := c.String(FlagReason)
+	if len(reason) == 0 {
+		reason = defaultShardMigrationAbortReason
+	}
+	if err := client.TerminateWorkflow(tcCtx, shardmigration.WorkflowID, getRunID(c), reason, nil); err != nil {
+		ErrorAndExit("Failed to abort shard migration workflow", err)
+	}
+	fmt.Println("Shard migration aborted")
+}
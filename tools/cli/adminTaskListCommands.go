@@ -23,6 +23,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -70,6 +71,13 @@ func AdminDescribeTaskList(c *cli.Context) {
 	printPollerInfo(pollers, taskListType)
 }
 
+// taskListRow is a tasklist of a domain as printed by `admin tasklist list` in structured formats
+type taskListRow struct {
+	Name        string `json:"name" header:"Task List Name"`
+	Type        string `json:"type" header:"Type"`
+	PollerCount int    `json:"pollerCount" header:"Poller Count"`
+}
+
 // AdminListTaskList displays all task lists under a domain.
 func AdminListTaskList(c *cli.Context) {
 	frontendClient := cFactory.ServerFrontendClient(c)
@@ -86,6 +94,25 @@ func AdminListTaskList(c *cli.Context) {
 		ErrorAndExit("Operation GetTaskListByDomain failed.", err)
 	}
 
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		var rows []taskListRow
+		for name, taskList := range response.GetDecisionTaskListMap() {
+			rows = append(rows, taskListRow{Name: name, Type: "Decision", PollerCount: len(taskList.GetPollers())})
+		}
+		for name, taskList := range response.GetActivityTaskListMap() {
+			rows = append(rows, taskListRow{Name: name, Type: "Activity", PollerCount: len(taskList.GetPollers())})
+		}
+		sort.Slice(rows, func(i, j int) bool {
+			if rows[i].Type != rows[j].Type {
+				return rows[i].Type > rows[j].Type
+			}
+			return rows[i].Name < rows[j].Name
+		})
+		renderer.renderAllRows(rows)
+		return
+	}
+
 	fmt.Println("Task Lists for domain " + domain + ":")
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(true)
@@ -22,6 +22,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 
 	"github.com/urfave/cli"
 
@@ -74,6 +75,12 @@ func NewCliApp() *cli.App {
 			Usage:  "optional private key path to create JWT. Either this or --jwt is needed for jwt authorization. --jwt flag has priority over this one if both provided",
 			EnvVar: "CADENCE_CLI_JWT_PRIVATE_KEY",
 		},
+		cli.StringFlag{
+			Name:   FlagFormat,
+			Value:  formatTable,
+			Usage:  "output format of command results: table, json, yaml, csv or template='{{...}}' (a Go template applied to each result)",
+			EnvVar: "CADENCE_CLI_FORMAT",
+		},
 	}
 	app.Commands = []cli.Command{
 		{
@@ -181,6 +188,12 @@ func NewCliApp() *cli.App {
 		},
 	}
 
+	app.Before = func(c *cli.Context) error {
+		// reject an invalid --format before any command sends requests
+		_, err := newRendererWithWriter(ioutil.Discard, c.GlobalString(FlagFormat))
+		return err
+	}
+
 	// set builder if not customized
 	if cFactory == nil {
 		SetFactory(NewClientFactory())
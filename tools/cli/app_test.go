@@ -21,6 +21,9 @@
 package cli
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -37,6 +40,7 @@ import (
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
 	"github.com/uber/cadence/common/types"
 )
@@ -268,6 +272,19 @@ func (s *cliAppSuite) TestDomainDescribe() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDomainDescribe_Format() {
+	for _, format := range []string{"json", "yaml", "csv", "template={{.Name}}"} {
+		s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponseServer, nil)
+		err := s.app.Run([]string{"", "--do", domainName, "--format", format, "domain", "describe"})
+		s.Nil(err)
+	}
+}
+
+func (s *cliAppSuite) TestDomainDescribe_InvalidFormat() {
+	err := s.app.Run([]string{"", "--do", domainName, "--format", "xml", "domain", "describe"})
+	s.Error(err)
+}
+
 func (s *cliAppSuite) TestDomainDescribe_DomainNotExist() {
 	resp := describeDomainResponseServer
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, &shared.EntityNotExistsError{})
@@ -390,6 +407,25 @@ func (s *cliAppSuite) TestRunWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestResetWorkflow_DryRunNotSupported() {
+	s.serverFrontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{
+		SupportedClientVersions: &types.SupportedClientVersions{GoSdk: "1.7.0", JavaSdk: "1.5.0"},
+	}, nil)
+	s.Error(validateResetDryRunSupported(context.Background(), s.serverFrontendClient))
+
+	s.serverFrontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{
+		SupportedClientVersions: &types.SupportedClientVersions{Cli: "1.6.0"},
+	}, nil)
+	s.Error(validateResetDryRunSupported(context.Background(), s.serverFrontendClient))
+}
+
+func (s *cliAppSuite) TestResetWorkflow_DryRunSupported() {
+	s.serverFrontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{
+		SupportedClientVersions: &types.SupportedClientVersions{Cli: cc.CLIResetDryRunVersion},
+	}, nil)
+	s.NoError(validateResetDryRunSupported(context.Background(), s.serverFrontendClient))
+}
+
 func (s *cliAppSuite) TestTerminateWorkflow() {
 	s.clientFrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any(), callOptions...).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "terminate", "-w", "wid"})
@@ -556,16 +592,32 @@ func (s *cliAppSuite) TestListArchivedWorkflow() {
 }
 
 func (s *cliAppSuite) TestCountWorkflow() {
-	resp := &shared.CountWorkflowExecutionsResponse{}
-	s.clientFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	resp := &types.CountWorkflowExecutionsResponse{}
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "count"})
 	s.Nil(err)
 
-	s.clientFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err = s.app.Run([]string{"", "--do", domainName, "workflow", "count", "-q", "'CloseTime = missing'"})
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestCountWorkflow_GroupBy() {
+	resp := &types.CountWorkflowExecutionsResponse{
+		Count: 3,
+		Groups: []*types.CountWorkflowExecutionsGroup{
+			{GroupValues: []string{"wtype1"}, Count: 2},
+			{GroupValues: []string{"wtype2"}, Count: 1},
+		},
+	}
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), &types.CountWorkflowExecutionsRequest{
+		Domain: domainName,
+		Query:  "CloseTime = missing group by WorkflowType",
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "count", "-q", "CloseTime = missing group by WorkflowType"})
+	s.Nil(err)
+}
+
 var describeTaskListResponse = &shared.DescribeTaskListResponse{
 	Pollers: []*shared.PollerInfo{
 		{
@@ -593,6 +645,97 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestAdminListWorkflowTasks() {
+	resp := &types.ListWorkflowTasksResponse{
+		ShardID: 1,
+		Tasks: []*types.WorkflowTaskInfo{
+			{
+				Type:                common.Int32Ptr(int32(common.TaskTypeTimer)),
+				SubType:             2,
+				TaskID:              100,
+				VisibilityTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+				Attempt:             3,
+			},
+		},
+	}
+	s.serverAdminClient.EXPECT().ListWorkflowTasks(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "list-tasks", "-w", "test-wf-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDeleteWorkflowTask() {
+	request := &types.DeleteWorkflowTaskRequest{
+		Domain:            domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-wf-id"},
+		Type:              common.Int32Ptr(int32(common.TaskTypeTimer)),
+		TaskID:            100,
+		Reason:            "stuck timer",
+		Identity:          getCliIdentity(),
+	}
+	s.serverAdminClient.EXPECT().DeleteWorkflowTask(gomock.Any(), request).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "delete-task", "-w", "test-wf-id",
+		"--task_type", "3", "--task_id", "100", "--reason", "stuck timer"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminReenqueueWorkflowTask_Failed() {
+	s.serverAdminClient.EXPECT().ReenqueueWorkflowTask(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "wf", "reenqueue-task", "-w", "test-wf-id",
+		"--task_type", "2", "--task_id", "100"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminGetDLQMessages_Dead() {
+	outputFile, err := ioutil.TempFile("", "dlq-read-*.txt")
+	s.NoError(err)
+	outputFile.Close()
+	defer os.Remove(outputFile.Name())
+
+	resp := &types.ReadDLQMessagesResponse{
+		ReplicationTasksInfo: []*types.ReplicationTaskInfo{
+			{
+				TaskID:          100,
+				DeadReason:      "max_attempts_exceeded",
+				RedriveAttempts: 5,
+			},
+		},
+	}
+	s.serverAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, request *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error) {
+			s.Equal("standby"+common.ReplicationDeadDLQSuffix, request.SourceCluster)
+			return resp, nil
+		})
+	err = s.app.Run([]string{"", "admin", "dlq", "read", "--dlq_type", "history", "--source_cluster", "standby",
+		"--shard_id", "1", "--dead", "--output_filename", outputFile.Name()})
+	s.Nil(err)
+
+	output, err := ioutil.ReadFile(outputFile.Name())
+	s.NoError(err)
+	s.Contains(string(output), `"deadReason":"max_attempts_exceeded"`)
+	s.Contains(string(output), `"redriveAttempts":5`)
+}
+
+func (s *cliAppSuite) TestAdminDescribeDomainUsage() {
+	resp := &types.DescribeDomainUsageResponse{
+		Usage: &types.DomainUsage{
+			HistorySize:         1024,
+			HistoryEventCount:   10,
+			OpenWorkflowCount:   1,
+			ClosedWorkflowCount: 2,
+		},
+		LastUpdateTime: common.Int64Ptr(time.Now().UnixNano()),
+	}
+	s.serverAdminClient.EXPECT().DescribeDomainUsage(gomock.Any(), &types.DescribeDomainUsageRequest{Domain: domainName}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "usage"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeDomainUsage_Failed() {
+	s.serverAdminClient.EXPECT().DescribeDomainUsage(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "domain", "usage"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	var promptMsg string
 	promptFn = func(msg string) {
@@ -610,6 +753,40 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminRemoveSearchAttribute() {
+	promptFn = func(msg string) {}
+	request := &types.RemoveSearchAttributeRequest{
+		SearchAttribute: []string{"testKey"},
+	}
+	s.serverAdminClient.EXPECT().RemoveSearchAttribute(gomock.Any(), request, callOptions...).Return(nil)
+
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "cl", "rsa", "--search_attr_key", "testKey"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRenameSearchAttribute() {
+	var promptMsg string
+	promptFn = func(msg string) {
+		promptMsg = msg
+	}
+	request := &types.RenameSearchAttributeRequest{
+		OldName: "testKey",
+		NewName: "newTestKey",
+	}
+	s.serverAdminClient.EXPECT().RenameSearchAttribute(gomock.Any(), request, callOptions...).Return(nil)
+
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "cl", "rnsa", "--search_attr_key", "testKey", "--search_attr_new_key", "newTestKey"})
+	s.Equal("Are you trying to rename key [testKey] to [newTestKey]? Y/N", promptMsg)
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminReindexStart() {
+	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "reindex", "start"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestAdminFailover() {
 	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
 	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(resp, nil)
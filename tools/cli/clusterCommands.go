@@ -28,6 +28,12 @@ import (
 	"github.com/urfave/cli"
 )
 
+// searchAttributeRow is a valid search attribute as printed by `cluster get-search-attr` in structured formats
+type searchAttributeRow struct {
+	Key       string `json:"key" header:"Key"`
+	ValueType string `json:"valueType" header:"Value type"`
+}
+
 // GetSearchAttributes get valid search attributes
 func GetSearchAttributes(c *cli.Context) {
 	wfClient := getWorkflowClientWithOptionalDomain(c)
@@ -39,6 +45,17 @@ func GetSearchAttributes(c *cli.Context) {
 		ErrorAndExit("Failed to get search attributes.", err)
 	}
 
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		rows := make([]searchAttributeRow, 0, len(resp.Keys))
+		for k, v := range resp.Keys {
+			rows = append(rows, searchAttributeRow{Key: k, ValueType: v.String()})
+		}
+		sort.Slice(rows, func(i, j int) bool { return rows[i].Key < rows[j].Key })
+		renderer.renderAllRows(rows)
+		return
+	}
+
 	table := tablewriter.NewWriter(os.Stdout)
 	header := []string{"Key", "Value type"}
 	table.SetHeader(header)
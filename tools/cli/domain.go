@@ -83,5 +83,14 @@ func newDomainCommands() []cli.Command {
 				newDomainCLI(c, false).DescribeDomain(c)
 			},
 		},
+		{
+			Name:    "apply",
+			Aliases: []string{"ap"},
+			Usage:   "Register or update domains to match the YAML domain specs under a path",
+			Flags:   applyDomainFlags,
+			Action: func(c *cli.Context) {
+				newDomainCLI(c, false).ApplyDomains(c)
+			},
+		},
 	}
 }
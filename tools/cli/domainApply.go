@@ -0,0 +1,488 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// domainSpec is the desired state of a domain, read from YAML by `domain apply`.
+	// Fields left out of a spec are not managed, they are neither compared nor updated.
+	domainSpec struct {
+		Name               string            `yaml:"name"`
+		Description        *string           `yaml:"description"`
+		OwnerEmail         *string           `yaml:"ownerEmail"`
+		RetentionDays      *int32            `yaml:"retentionDays"`
+		EmitMetric         *bool             `yaml:"emitMetric"`
+		IsGlobalDomain     *bool             `yaml:"isGlobalDomain"`
+		ActiveClusterName  string            `yaml:"activeClusterName"`
+		Clusters           []string          `yaml:"clusters"`
+		Data               map[string]string `yaml:"data"`
+		HistoryArchival    *archivalSpec     `yaml:"historyArchival"`
+		VisibilityArchival *archivalSpec     `yaml:"visibilityArchival"`
+		// BadBinaries maps the checksum of each bad binary to the reason it is bad
+		BadBinaries map[string]string `yaml:"badBinaries"`
+	}
+
+	archivalSpec struct {
+		Status string `yaml:"status"`
+		URI    string `yaml:"uri"`
+	}
+
+	// domainPlan holds the requests which bring a domain to its spec
+	domainPlan struct {
+		name     string
+		register *types.RegisterDomainRequest
+		update   *types.UpdateDomainRequest
+		// domain handler deletes one bad binary per update request
+		deleteBadBinaries []string
+		// failover is the new active cluster, domain handler rejects
+		// failovers which are combined with other changes
+		failover *string
+		changes  []string
+	}
+)
+
+// ApplyDomains registers or updates domains to match the domain specs under a path
+func (d *domainCLIImpl) ApplyDomains(c *cli.Context) {
+	path := getRequiredOption(c, FlagDomainSpecPath)
+	specs, err := loadDomainSpecs(path)
+	if err != nil {
+		ErrorAndExit("Failed to load domain specs.", err)
+		return
+	}
+
+	var plans []*domainPlan
+	for _, spec := range specs {
+		plan, err := d.planDomain(c, spec)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to plan domain %s.", spec.Name), err)
+			return
+		}
+		plans = append(plans, plan)
+	}
+	printDomainPlans(plans)
+	if c.Bool(FlagDryRun) {
+		return
+	}
+
+	for _, plan := range plans {
+		if plan.failover != nil && !c.Bool(FlagAllowFailover) {
+			ErrorAndExit(
+				fmt.Sprintf("Plan fails over domain %s to %s.", plan.name, *plan.failover),
+				fmt.Errorf("set --%s to apply failovers", FlagAllowFailover),
+			)
+			return
+		}
+	}
+	securityToken := c.String(FlagSecurityToken)
+	for _, plan := range plans {
+		if err := d.applyDomainPlan(c, plan, securityToken); err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to apply domain %s.", plan.name), err)
+			return
+		}
+	}
+	fmt.Println("Domains successfully applied.")
+}
+
+func (d *domainCLIImpl) planDomain(c *cli.Context, spec *domainSpec) (*domainPlan, error) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := d.describeDomain(ctx, &types.DescribeDomainRequest{
+		Name: common.StringPtr(spec.Name),
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); !ok {
+			return nil, err
+		}
+		resp = nil
+	}
+	return newDomainPlan(spec, resp)
+}
+
+func (d *domainCLIImpl) applyDomainPlan(c *cli.Context, plan *domainPlan, securityToken string) error {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if plan.register != nil {
+		plan.register.SecurityToken = securityToken
+		if err := d.registerDomain(ctx, plan.register); err != nil {
+			return err
+		}
+	}
+	// bad binaries are deleted before new ones are added so that the domain stays under the bad binaries limit
+	for _, checksum := range plan.deleteBadBinaries {
+		if _, err := d.updateDomain(ctx, &types.UpdateDomainRequest{
+			Name:            plan.name,
+			DeleteBadBinary: common.StringPtr(checksum),
+			SecurityToken:   securityToken,
+		}); err != nil {
+			return err
+		}
+	}
+	if plan.update != nil {
+		plan.update.SecurityToken = securityToken
+		if _, err := d.updateDomain(ctx, plan.update); err != nil {
+			return err
+		}
+	}
+	if plan.failover != nil {
+		if _, err := d.updateDomain(ctx, &types.UpdateDomainRequest{
+			Name:              plan.name,
+			ActiveClusterName: plan.failover,
+			SecurityToken:     securityToken,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newDomainPlan diffs a domain spec against the described domain, resp is nil if the domain does not exist
+func newDomainPlan(spec *domainSpec, resp *types.DescribeDomainResponse) (*domainPlan, error) {
+	historyArchivalStatus, err := spec.HistoryArchival.status()
+	if err != nil {
+		return nil, err
+	}
+	visibilityArchivalStatus, err := spec.VisibilityArchival.status()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &domainPlan{name: spec.Name}
+	update := &types.UpdateDomainRequest{Name: spec.Name}
+	addChange := func(field string, from, to interface{}) {
+		plan.changes = append(plan.changes, fmt.Sprintf("%v: %v -> %v", field, from, to))
+	}
+
+	if resp == nil {
+		retentionDays := int32(defaultDomainRetentionDays)
+		if spec.RetentionDays != nil {
+			retentionDays = *spec.RetentionDays
+		}
+		isGlobalDomain := true
+		if spec.IsGlobalDomain != nil {
+			isGlobalDomain = *spec.IsGlobalDomain
+		}
+		plan.register = &types.RegisterDomainRequest{
+			Name:                                   spec.Name,
+			Description:                            common.StringDefault(spec.Description),
+			OwnerEmail:                             common.StringDefault(spec.OwnerEmail),
+			WorkflowExecutionRetentionPeriodInDays: retentionDays,
+			EmitMetric:                             spec.EmitMetric,
+			Clusters:                               toClusterReplicationConfigs(spec.Clusters),
+			ActiveClusterName:                      spec.ActiveClusterName,
+			Data:                                   spec.Data,
+			IsGlobalDomain:                         isGlobalDomain,
+			HistoryArchivalStatus:                  historyArchivalStatus,
+			HistoryArchivalURI:                     spec.HistoryArchival.uri(),
+			VisibilityArchivalStatus:               visibilityArchivalStatus,
+			VisibilityArchivalURI:                  spec.VisibilityArchival.uri(),
+		}
+		// bad binaries cannot be set at registration
+		if len(spec.BadBinaries) > 0 {
+			update.BadBinaries = toBadBinaries(spec.BadBinaries)
+			update.WorkflowExecutionRetentionPeriodInDays = common.Int32Ptr(retentionDays)
+			plan.update = update
+			for _, checksum := range sortedKeys(spec.BadBinaries) {
+				addChange("badBinaries["+checksum+"]", "<none>", spec.BadBinaries[checksum])
+			}
+		}
+		return plan, nil
+	}
+
+	info := resp.GetDomainInfo()
+	config := resp.GetConfiguration()
+	replicationConfig := resp.GetReplicationConfiguration()
+	changed := false
+
+	if spec.IsGlobalDomain != nil && *spec.IsGlobalDomain != resp.GetIsGlobalDomain() {
+		return nil, fmt.Errorf("isGlobalDomain cannot be changed from %v to %v", resp.GetIsGlobalDomain(), *spec.IsGlobalDomain)
+	}
+	if spec.Description != nil && *spec.Description != info.GetDescription() {
+		addChange("description", fmt.Sprintf("%q", info.GetDescription()), fmt.Sprintf("%q", *spec.Description))
+		update.Description = spec.Description
+		changed = true
+	}
+	if spec.OwnerEmail != nil && *spec.OwnerEmail != info.GetOwnerEmail() {
+		addChange("ownerEmail", fmt.Sprintf("%q", info.GetOwnerEmail()), fmt.Sprintf("%q", *spec.OwnerEmail))
+		update.OwnerEmail = spec.OwnerEmail
+		changed = true
+	}
+	// domain data updates are merged into the existing data, keys missing from the spec are kept
+	for _, key := range sortedKeys(spec.Data) {
+		current, ok := info.GetData()[key]
+		if ok && current == spec.Data[key] {
+			continue
+		}
+		if !ok {
+			current = "<none>"
+		}
+		addChange("data["+key+"]", current, spec.Data[key])
+		if update.Data == nil {
+			update.Data = make(map[string]string)
+		}
+		update.Data[key] = spec.Data[key]
+		changed = true
+	}
+	if spec.RetentionDays != nil && *spec.RetentionDays != config.GetWorkflowExecutionRetentionPeriodInDays() {
+		addChange("retentionDays", config.GetWorkflowExecutionRetentionPeriodInDays(), *spec.RetentionDays)
+		update.WorkflowExecutionRetentionPeriodInDays = spec.RetentionDays
+		changed = true
+	}
+	if spec.EmitMetric != nil && *spec.EmitMetric != config.GetEmitMetric() {
+		addChange("emitMetric", config.GetEmitMetric(), *spec.EmitMetric)
+		update.EmitMetric = spec.EmitMetric
+		changed = true
+	}
+	if historyArchivalStatus != nil && *historyArchivalStatus != config.GetHistoryArchivalStatus() {
+		addChange("historyArchival.status", config.GetHistoryArchivalStatus(), *historyArchivalStatus)
+		update.HistoryArchivalStatus = historyArchivalStatus
+		changed = true
+	}
+	if uri := spec.HistoryArchival.uri(); uri != "" && uri != config.GetHistoryArchivalURI() {
+		addChange("historyArchival.uri", config.GetHistoryArchivalURI(), uri)
+		update.HistoryArchivalURI = common.StringPtr(uri)
+		changed = true
+	}
+	if visibilityArchivalStatus != nil && *visibilityArchivalStatus != config.GetVisibilityArchivalStatus() {
+		addChange("visibilityArchival.status", config.GetVisibilityArchivalStatus(), *visibilityArchivalStatus)
+		update.VisibilityArchivalStatus = visibilityArchivalStatus
+		changed = true
+	}
+	if uri := spec.VisibilityArchival.uri(); uri != "" && uri != config.GetVisibilityArchivalURI() {
+		addChange("visibilityArchival.uri", config.GetVisibilityArchivalURI(), uri)
+		update.VisibilityArchivalURI = common.StringPtr(uri)
+		changed = true
+	}
+	if spec.BadBinaries != nil {
+		currentBinaries := config.GetBadBinaries().GetBinaries()
+		added := make(map[string]string)
+		for _, checksum := range sortedKeys(spec.BadBinaries) {
+			current, ok := currentBinaries[checksum]
+			if ok && current.GetReason() == spec.BadBinaries[checksum] {
+				continue
+			}
+			from := "<none>"
+			if ok {
+				from = current.GetReason()
+			}
+			addChange("badBinaries["+checksum+"]", from, spec.BadBinaries[checksum])
+			added[checksum] = spec.BadBinaries[checksum]
+		}
+		if len(added) > 0 {
+			update.BadBinaries = toBadBinaries(added)
+			changed = true
+		}
+		if update.BadBinaries != nil && update.WorkflowExecutionRetentionPeriodInDays == nil {
+			// domain handler only persists bad binaries together with another configuration change
+			update.WorkflowExecutionRetentionPeriodInDays = common.Int32Ptr(config.GetWorkflowExecutionRetentionPeriodInDays())
+		}
+		var deleted []string
+		for checksum := range currentBinaries {
+			if _, ok := spec.BadBinaries[checksum]; !ok {
+				deleted = append(deleted, checksum)
+			}
+		}
+		sort.Strings(deleted)
+		for _, checksum := range deleted {
+			addChange("badBinaries["+checksum+"]", currentBinaries[checksum].GetReason(), "<none>")
+		}
+		plan.deleteBadBinaries = deleted
+	}
+	if len(spec.Clusters) > 0 {
+		currentClusters := make([]string, 0, len(replicationConfig.GetClusters()))
+		for _, cluster := range replicationConfig.GetClusters() {
+			currentClusters = append(currentClusters, cluster.GetClusterName())
+		}
+		specClusters := append([]string(nil), spec.Clusters...)
+		sort.Strings(currentClusters)
+		sort.Strings(specClusters)
+		if strings.Join(currentClusters, ",") != strings.Join(specClusters, ",") {
+			addChange("clusters", currentClusters, specClusters)
+			update.Clusters = toClusterReplicationConfigs(spec.Clusters)
+			changed = true
+		}
+	}
+	if spec.ActiveClusterName != "" && spec.ActiveClusterName != replicationConfig.GetActiveClusterName() {
+		addChange("activeClusterName", replicationConfig.GetActiveClusterName(), spec.ActiveClusterName+" (failover)")
+		plan.failover = common.StringPtr(spec.ActiveClusterName)
+	}
+
+	if changed {
+		plan.update = update
+	}
+	return plan, nil
+}
+
+func printDomainPlans(plans []*domainPlan) {
+	var registers, updates, unchanged int
+	for _, plan := range plans {
+		switch {
+		case plan.register != nil:
+			registers++
+			fmt.Printf("+ register domain %s\n", plan.name)
+		case len(plan.changes) > 0:
+			updates++
+			fmt.Printf("~ update domain %s\n", plan.name)
+		default:
+			unchanged++
+			fmt.Printf("  domain %s is up to date\n", plan.name)
+		}
+		for _, change := range plan.changes {
+			fmt.Printf("    %s\n", change)
+		}
+	}
+	fmt.Printf("Plan: %d to register, %d to update, %d unchanged.\n", registers, updates, unchanged)
+}
+
+// loadDomainSpecs reads the domain specs from a YAML file, or from all YAML files of a directory.
+// A file can hold multiple specs as separate YAML documents.
+func loadDomainSpecs(path string) ([]*domainSpec, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if info.IsDir() {
+		files = nil
+		for _, pattern := range []string{"*.yaml", "*.yml"} {
+			matches, err := filepath.Glob(filepath.Join(path, pattern))
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, matches...)
+		}
+		sort.Strings(files)
+	}
+
+	var specs []*domainSpec
+	names := make(map[string]string)
+	for _, file := range files {
+		fileSpecs, err := loadDomainSpecFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", file, err)
+		}
+		for _, spec := range fileSpecs {
+			if spec.Name == "" {
+				return nil, fmt.Errorf("%v: domain spec without name", file)
+			}
+			if other, ok := names[spec.Name]; ok {
+				return nil, fmt.Errorf("%v: domain %v is already specified in %v", file, spec.Name, other)
+			}
+			names[spec.Name] = file
+			specs = append(specs, spec)
+		}
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no domain specs found in %v", path)
+	}
+	return specs, nil
+}
+
+func loadDomainSpecFile(file string) ([]*domainSpec, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.SetStrict(true)
+	var specs []*domainSpec
+	for {
+		spec := &domainSpec{}
+		if err := decoder.Decode(spec); err != nil {
+			if err == io.EOF {
+				return specs, nil
+			}
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+}
+
+func (a *archivalSpec) status() (*types.ArchivalStatus, error) {
+	if a == nil || a.Status == "" {
+		return nil, nil
+	}
+	switch a.Status {
+	case "disabled":
+		return types.ArchivalStatusDisabled.Ptr(), nil
+	case "enabled":
+		return types.ArchivalStatusEnabled.Ptr(), nil
+	default:
+		return nil, fmt.Errorf("invalid archival status %q, valid values are \"disabled\" and \"enabled\"", a.Status)
+	}
+}
+
+func (a *archivalSpec) uri() string {
+	if a == nil {
+		return ""
+	}
+	return a.URI
+}
+
+func toClusterReplicationConfigs(clusters []string) []*types.ClusterReplicationConfiguration {
+	var configs []*types.ClusterReplicationConfiguration
+	for _, cluster := range clusters {
+		configs = append(configs, &types.ClusterReplicationConfiguration{
+			ClusterName: cluster,
+		})
+	}
+	return configs
+}
+
+func toBadBinaries(binaries map[string]string) *types.BadBinaries {
+	operator := getCurrentUserFromEnv()
+	badBinaries := &types.BadBinaries{
+		Binaries: make(map[string]*types.BadBinaryInfo, len(binaries)),
+	}
+	for checksum, reason := range binaries {
+		badBinaries.Binaries[checksum] = &types.BadBinaryInfo{
+			Reason:   reason,
+			Operator: operator,
+		}
+	}
+	return badBinaries
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestLoadDomainSpecs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "domain-specs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.yaml"), []byte(`
+name: domain-a
+retentionDays: 7
+---
+name: domain-b
+data:
+  owner: team-b
+`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "c.yml"), []byte(`
+name: domain-c
+badBinaries: {}
+`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("not a spec"), 0644))
+
+	specs, err := loadDomainSpecs(dir)
+	require.NoError(t, err)
+	require.Len(t, specs, 3)
+	assert.Equal(t, "domain-a", specs[0].Name)
+	assert.Equal(t, common.Int32Ptr(7), specs[0].RetentionDays)
+	assert.Equal(t, map[string]string{"owner": "team-b"}, specs[1].Data)
+	assert.NotNil(t, specs[2].BadBinaries)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "d.yaml"), []byte("name: domain-a\n"), 0644))
+	_, err = loadDomainSpecs(dir)
+	assert.Error(t, err)
+
+	unknownField := filepath.Join(dir, "unknown.yaml")
+	require.NoError(t, ioutil.WriteFile(unknownField, []byte("name: domain-e\nretention: 7\n"), 0644))
+	_, err = loadDomainSpecs(unknownField)
+	assert.Error(t, err)
+}
+
+func TestNewDomainPlan(t *testing.T) {
+	describeResp := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{
+			Name:        "test-domain",
+			Description: "a test domain",
+			Data:        map[string]string{"owner": "team-a", "tier": "1"},
+		},
+		Configuration: &types.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: 3,
+			EmitMetric:                             true,
+			BadBinaries: &types.BadBinaries{
+				Binaries: map[string]*types.BadBinaryInfo{
+					"checksum-1": {Reason: "bug"},
+				},
+			},
+		},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "active",
+			Clusters: []*types.ClusterReplicationConfiguration{
+				{ClusterName: "active"},
+				{ClusterName: "standby"},
+			},
+		},
+		IsGlobalDomain: true,
+	}
+
+	t.Run("register", func(t *testing.T) {
+		plan, err := newDomainPlan(&domainSpec{
+			Name:        "test-domain",
+			Clusters:    []string{"active", "standby"},
+			BadBinaries: map[string]string{"checksum-1": "bug"},
+		}, nil)
+		require.NoError(t, err)
+		require.NotNil(t, plan.register)
+		assert.Equal(t, int32(defaultDomainRetentionDays), plan.register.WorkflowExecutionRetentionPeriodInDays)
+		assert.True(t, plan.register.IsGlobalDomain)
+		assert.Len(t, plan.register.Clusters, 2)
+		require.NotNil(t, plan.update)
+		assert.Contains(t, plan.update.BadBinaries.Binaries, "checksum-1")
+	})
+
+	t.Run("up to date", func(t *testing.T) {
+		plan, err := newDomainPlan(&domainSpec{
+			Name:              "test-domain",
+			Description:       common.StringPtr("a test domain"),
+			RetentionDays:     common.Int32Ptr(3),
+			ActiveClusterName: "active",
+			Clusters:          []string{"standby", "active"},
+			Data:              map[string]string{"owner": "team-a"},
+			BadBinaries:       map[string]string{"checksum-1": "bug"},
+		}, describeResp)
+		require.NoError(t, err)
+		assert.Nil(t, plan.register)
+		assert.Nil(t, plan.update)
+		assert.Nil(t, plan.failover)
+		assert.Empty(t, plan.deleteBadBinaries)
+		assert.Empty(t, plan.changes)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		plan, err := newDomainPlan(&domainSpec{
+			Name:          "test-domain",
+			RetentionDays: common.Int32Ptr(7),
+			Data:          map[string]string{"owner": "team-b"},
+			BadBinaries:   map[string]string{"checksum-2": "crash"},
+			HistoryArchival: &archivalSpec{
+				Status: "enabled",
+				URI:    "file:///tmp/history",
+			},
+		}, describeResp)
+		require.NoError(t, err)
+		require.NotNil(t, plan.update)
+		assert.Equal(t, common.Int32Ptr(7), plan.update.WorkflowExecutionRetentionPeriodInDays)
+		assert.Equal(t, map[string]string{"owner": "team-b"}, plan.update.Data)
+		assert.Contains(t, plan.update.BadBinaries.Binaries, "checksum-2")
+		assert.Equal(t, types.ArchivalStatusEnabled.Ptr(), plan.update.HistoryArchivalStatus)
+		assert.Equal(t, common.StringPtr("file:///tmp/history"), plan.update.HistoryArchivalURI)
+		assert.Nil(t, plan.update.Description)
+		assert.Nil(t, plan.update.Clusters)
+		assert.Equal(t, []string{"checksum-1"}, plan.deleteBadBinaries)
+		assert.Nil(t, plan.failover)
+		assert.Equal(t, []string{
+			"data[owner]: team-a -> team-b",
+			"retentionDays: 3 -> 7",
+			"historyArchival.status: DISABLED -> ENABLED",
+			"historyArchival.uri:  -> file:///tmp/history",
+			"badBinaries[checksum-2]: <none> -> crash",
+			"badBinaries[checksum-1]: bug -> <none>",
+		}, plan.changes)
+	})
+
+	t.Run("failover", func(t *testing.T) {
+		plan, err := newDomainPlan(&domainSpec{
+			Name:              "test-domain",
+			ActiveClusterName: "standby",
+		}, describeResp)
+		require.NoError(t, err)
+		assert.Nil(t, plan.update)
+		assert.Equal(t, common.StringPtr("standby"), plan.failover)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := newDomainPlan(&domainSpec{
+			Name:           "test-domain",
+			IsGlobalDomain: common.BoolPtr(false),
+		}, describeResp)
+		assert.Error(t, err)
+
+		_, err = newDomainPlan(&domainSpec{
+			Name:               "test-domain",
+			VisibilityArchival: &archivalSpec{Status: "on"},
+		}, describeResp)
+		assert.Error(t, err)
+	})
+}
+
+func (s *cliAppSuite) TestDomainApply() {
+	specFile := s.writeDomainSpec(`
+name: test-domain
+retentionDays: 7
+---
+name: new-domain
+clusters: [active, standby]
+activeClusterName: active
+`)
+	defer os.Remove(specFile)
+
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("test-domain")}).
+		Return(describeDomainResponseServer, nil).Times(2)
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("new-domain")}).
+		Return(nil, &types.EntityNotExistsError{}).Times(2)
+	err := s.app.Run([]string{"", "domain", "apply", "--path", specFile, "--dry_run"})
+	s.Nil(err)
+
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:                                   "test-domain",
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(7),
+	}).Return(&types.UpdateDomainResponse{}, nil)
+	s.serverFrontendClient.EXPECT().RegisterDomain(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, request *types.RegisterDomainRequest) error {
+			s.Equal("new-domain", request.Name)
+			s.Equal("active", request.ActiveClusterName)
+			s.Len(request.Clusters, 2)
+			return nil
+		})
+	err = s.app.Run([]string{"", "domain", "apply", "--path", specFile})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainApply_BadBinariesDeletedBeforeAdded() {
+	specFile := s.writeDomainSpec(`
+name: test-domain
+badBinaries:
+  new-checksum: new reason
+`)
+	defer os.Remove(specFile)
+
+	resp := *describeDomainResponseServer
+	config := *resp.Configuration
+	config.BadBinaries = &types.BadBinaries{
+		Binaries: map[string]*types.BadBinaryInfo{
+			"old-checksum": {Reason: "old reason"},
+		},
+	}
+	resp.Configuration = &config
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&resp, nil)
+
+	gomock.InOrder(
+		s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+			Name:            "test-domain",
+			DeleteBadBinary: common.StringPtr("old-checksum"),
+		}).Return(&types.UpdateDomainResponse{}, nil),
+		s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, request *types.UpdateDomainRequest) (*types.UpdateDomainResponse, error) {
+				s.Nil(request.DeleteBadBinary)
+				s.Contains(request.BadBinaries.Binaries, "new-checksum")
+				return &types.UpdateDomainResponse{}, nil
+			}),
+	)
+	err := s.app.Run([]string{"", "domain", "apply", "--path", specFile})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainApply_FailoverNotAllowed() {
+	specFile := s.writeDomainSpec(`
+name: test-domain
+activeClusterName: standby
+`)
+	defer os.Remove(specFile)
+
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponseServer, nil)
+	errorCode := s.RunErrorExitCode([]string{"", "domain", "apply", "--path", specFile})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) writeDomainSpec(spec string) string {
+	f, err := ioutil.TempFile("", "domain-spec-*.yaml")
+	s.NoError(err)
+	defer f.Close()
+	_, err = f.WriteString(spec)
+	s.NoError(err)
+	return f.Name()
+}
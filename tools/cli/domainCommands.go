@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -46,6 +47,34 @@ var (
 )
 
 type (
+	// domainRow is a domain as printed by `domain describe` and `domain list` in structured formats
+	domainRow struct {
+		Name                     string              `json:"name" header:"Name"`
+		UUID                     string              `json:"uuid" header:"UUID"`
+		Description              string              `json:"description,omitempty" header:"Description"`
+		OwnerEmail               string              `json:"ownerEmail,omitempty" header:"Owner Email"`
+		DomainData               map[string]string   `json:"domainData,omitempty" header:"Domain Data"`
+		Status                   string              `json:"status" header:"Status"`
+		IsGlobalDomain           bool                `json:"isGlobalDomain" header:"Is Global Domain"`
+		ActiveCluster            string              `json:"activeCluster" header:"Active Cluster"`
+		Clusters                 []string            `json:"clusters,omitempty" header:"Clusters"`
+		RetentionDays            int32               `json:"retentionDays" header:"Retention Days"`
+		EmitMetrics              bool                `json:"emitMetrics" header:"Emit Metrics"`
+		HistoryArchivalStatus    string              `json:"historyArchivalStatus" header:"History Archival Status"`
+		HistoryArchivalURI       string              `json:"historyArchivalURI,omitempty" header:"History Archival URI"`
+		VisibilityArchivalStatus string              `json:"visibilityArchivalStatus" header:"Visibility Archival Status"`
+		VisibilityArchivalURI    string              `json:"visibilityArchivalURI,omitempty" header:"Visibility Archival URI"`
+		BadBinaries              []badBinaryRow      `json:"badBinaries,omitempty" header:"-"`
+		FailoverInfo             *types.FailoverInfo `json:"failoverInfo,omitempty" header:"-"`
+	}
+
+	badBinaryRow struct {
+		Checksum  string    `json:"checksum"`
+		Operator  string    `json:"operator"`
+		StartTime time.Time `json:"startTime"`
+		Reason    string    `json:"reason"`
+	}
+
 	domainCLIImpl struct {
 		// used when making RPC call to frontend service
 		frontendClient frontend.Client
@@ -405,6 +434,12 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 		ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domainName), err)
 	}
 
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		renderer.renderAllRows([]domainRow{newDomainRow(resp)})
+		return
+	}
+
 	clusters := "N/A, Not a global domain"
 	if resp.IsGlobalDomain {
 		clusters = clustersToString(resp.ReplicationConfiguration.Clusters)
@@ -512,6 +547,16 @@ func (d *domainCLIImpl) ListDomains(c *cli.Context) {
 		}
 	}
 
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		rows := make([]domainRow, 0, len(filteredDomains))
+		for _, domain := range filteredDomains {
+			rows = append(rows, newDomainRow(domain))
+		}
+		renderer.renderAllRows(rows)
+		return
+	}
+
 	if printJSON {
 		output, err := json.Marshal(filteredDomains)
 		if err != nil {
@@ -655,6 +700,45 @@ func appendDomainToTable(
 	table.Append(row)
 }
 
+func newDomainRow(domain *types.DescribeDomainResponse) domainRow {
+	row := domainRow{
+		Name:                     domain.DomainInfo.GetName(),
+		UUID:                     domain.DomainInfo.GetUUID(),
+		Description:              domain.DomainInfo.GetDescription(),
+		OwnerEmail:               domain.DomainInfo.GetOwnerEmail(),
+		DomainData:               domain.DomainInfo.GetData(),
+		Status:                   domain.DomainInfo.GetStatus().String(),
+		IsGlobalDomain:           domain.GetIsGlobalDomain(),
+		ActiveCluster:            domain.ReplicationConfiguration.GetActiveClusterName(),
+		RetentionDays:            domain.Configuration.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetrics:              domain.Configuration.GetEmitMetric(),
+		HistoryArchivalStatus:    domain.Configuration.GetHistoryArchivalStatus().String(),
+		HistoryArchivalURI:       domain.Configuration.GetHistoryArchivalURI(),
+		VisibilityArchivalStatus: domain.Configuration.GetVisibilityArchivalStatus().String(),
+		VisibilityArchivalURI:    domain.Configuration.GetVisibilityArchivalURI(),
+		FailoverInfo:             domain.GetFailoverInfo(),
+	}
+	for _, cluster := range domain.ReplicationConfiguration.GetClusters() {
+		row.Clusters = append(row.Clusters, cluster.GetClusterName())
+	}
+	binaries := domain.Configuration.GetBadBinaries().GetBinaries()
+	checksums := make([]string, 0, len(binaries))
+	for checksum := range binaries {
+		checksums = append(checksums, checksum)
+	}
+	sort.Strings(checksums)
+	for _, checksum := range checksums {
+		bin := binaries[checksum]
+		row.BadBinaries = append(row.BadBinaries, badBinaryRow{
+			Checksum:  checksum,
+			Operator:  bin.GetOperator(),
+			StartTime: time.Unix(0, bin.GetCreatedTimeNano()),
+			Reason:    bin.GetReason(),
+		})
+	}
+	return row
+}
+
 func archivalStatus(c *cli.Context, statusFlagName string) *types.ArchivalStatus {
 	if c.IsSet(statusFlagName) {
 		switch c.String(statusFlagName) {
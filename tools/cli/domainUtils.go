@@ -190,6 +190,25 @@ var (
 		},
 	}
 
+	applyDomainFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagDomainSpecPath,
+			Usage: "Path of a domain spec YAML file, or a directory of them",
+		},
+		cli.BoolFlag{
+			Name:  FlagDryRun,
+			Usage: "Only print the plan, without applying it",
+		},
+		cli.BoolFlag{
+			Name:  FlagAllowFailover,
+			Usage: "Allow the plan to change the active cluster of existing domains",
+		},
+		cli.StringFlag{
+			Name:  FlagSecurityTokenWithAlias,
+			Usage: "Optional token for security check",
+		},
+	}
+
 	describeDomainFlags = []cli.Flag{
 		cli.StringFlag{
 			Name:  FlagDomainID,
@@ -227,6 +246,11 @@ var (
 		adminDomainCommonFlags...,
 	)
 
+	adminApplyDomainFlags = append(
+		applyDomainFlags,
+		adminDomainCommonFlags...,
+	)
+
 	adminDescribeDomainFlags = append(
 		updateDomainFlags,
 		adminDomainCommonFlags...,
@@ -30,6 +30,7 @@ const (
 	FlagDatabaseName                      = "db_name"
 	FlagEncodingType                      = "encoding_type"
 	FlagDecodingTypes                     = "decoding_types"
+	FlagKeyringPath                       = "keyring_path"
 	FlagAddress                           = "address"
 	FlagAddressWithAlias                  = FlagAddress + ", ad"
 	FlagHistoryAddress                    = "history_address"
@@ -140,6 +141,7 @@ const (
 	FlagPrintSearchAttrWithAlias          = FlagPrintSearchAttr + ", psa"
 	FlagPrintJSON                         = "print_json"
 	FlagPrintJSONWithAlias                = FlagPrintJSON + ", pjson"
+	FlagFormat                            = "format"
 	FlagDescription                       = "description"
 	FlagDescriptionWithAlias              = FlagDescription + ", desc"
 	FlagOwnerEmail                        = "owner_email"
@@ -202,6 +204,7 @@ const (
 	FlagSearchAttributesKey               = "search_attr_key"
 	FlagSearchAttributesVal               = "search_attr_value"
 	FlagSearchAttributesType              = "search_attr_type"
+	FlagSearchAttributesNewKey            = "search_attr_new_key"
 	FlagAddBadBinary                      = "add_bad_binary"
 	FlagRemoveBadBinary                   = "remove_bad_binary"
 	FlagResetType                         = "reset_type"
@@ -238,6 +241,7 @@ const (
 	FlagDLQType                           = "dlq_type"
 	FlagDLQTypeWithAlias                  = FlagDLQType + ", dt"
 	FlagDLQRawTask                        = "dlq_raw_task"
+	FlagDLQDead                           = "dead"
 	FlagMaxMessageCount                   = "max_message_count"
 	FlagMaxMessageCountWithAlias          = FlagMaxMessageCount + ", mmc"
 	FlagLastMessageID                     = "last_message_id"
@@ -247,6 +251,14 @@ const (
 	FlagLowerShardBound                   = "lower_shard_bound"
 	FlagUpperShardBound                   = "upper_shard_bound"
 	FlagInputDirectory                    = "input_directory"
+	FlagDomainSpecPath                    = "path"
+	FlagAllowFailover                     = "allow_failover"
+	FlagOtherWorkflowID                   = "other_workflow_id"
+	FlagOtherWorkflowIDWithAlias          = FlagOtherWorkflowID + ", owid"
+	FlagOtherRunID                        = "other_run_id"
+	FlagOtherRunIDWithAlias               = FlagOtherRunID + ", orid"
+	FlagHistoryFile                       = "history_file"
+	FlagOtherHistoryFile                  = "other_history_file"
 	FlagSkipHistoryChecks                 = "skip_history_checks"
 	FlagFailoverType                      = "failover_type"
 	FlagFailoverTypeWithAlias             = FlagFailoverType + ", ft"
@@ -261,6 +273,7 @@ const (
 	FlagFailoverDrillWaitTimeWithAlias    = FlagFailoverDrillWaitTime + ", fdws"
 	FlagFailoverDrill                     = "failover_drill"
 	FlagFailoverDrillWithAlias            = FlagFailoverDrill + ", fd"
+	FlagAutoCutover                       = "auto_cutover"
 	FlagRetryInterval                     = "retry_interval"
 	FlagRetryAttempts                     = "retry_attempts"
 	FlagRetryExpiration                   = "retry_expiration"
@@ -282,6 +295,9 @@ const (
 	FlagDynamicConfigName                 = "dynamic_config_name"
 	FlagDynamicConfigFilter               = "dynamic_config_filter"
 	FlagDynamicConfigValue                = "dynamic_config_value"
+	FlagTopN                              = "top"
+	FlagSortBy                            = "sort_by"
+	FlagSkipReplication                   = "skip_replication"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -652,7 +668,7 @@ func getFlagsForCount() []cli.Flag {
 	return []cli.Flag{
 		cli.StringFlag{
 			Name:  FlagListQueryWithAlias,
-			Usage: "Optional SQL like query. e.g count all open workflows 'CloseTime = missing'; 'WorkflowType=\"wtype\" and CloseTime > 0'",
+			Usage: "Optional SQL like query. e.g count all open workflows 'CloseTime = missing'; 'WorkflowType=\"wtype\" and CloseTime > 0'; count open workflows by type 'CloseTime = missing group by WorkflowType'",
 		},
 	}
 }
@@ -719,6 +735,39 @@ func getFlagsForDescribeID() []cli.Flag {
 	}
 }
 
+func getFlagsForDiff() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagWorkflowIDWithAlias,
+			Usage: "WorkflowID of the first run",
+		},
+		cli.StringFlag{
+			Name:  FlagRunIDWithAlias,
+			Usage: "RunID of the first run, the current run if not set",
+		},
+		cli.StringFlag{
+			Name:  FlagHistoryFile,
+			Usage: "Read the history of the first run from a file exported by `workflow show --output_filename`",
+		},
+		cli.StringFlag{
+			Name:  FlagOtherWorkflowIDWithAlias,
+			Usage: "WorkflowID of the second run, the workflow of the first run if not set",
+		},
+		cli.StringFlag{
+			Name:  FlagOtherRunIDWithAlias,
+			Usage: "RunID of the second run, the run the first run continued from if not set",
+		},
+		cli.StringFlag{
+			Name:  FlagOtherHistoryFile,
+			Usage: "Read the history of the second run from a file exported by `workflow show --output_filename`",
+		},
+		cli.IntFlag{
+			Name:  FlagMaxFieldLengthWithAlias,
+			Usage: "Optional maximum length for each attribute value",
+		},
+	}
+}
+
 func getFlagsForObserve() []cli.Flag {
 	return append(flagsForExecution, getFlagsForObserveID()...)
 }
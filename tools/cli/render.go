@@ -0,0 +1,368 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	formatTable          = "table"
+	formatJSON           = "json"
+	formatYAML           = "yaml"
+	formatCSV            = "csv"
+	formatTemplatePrefix = "template="
+)
+
+var (
+	errCSVNotTabular = errors.New("csv format is only supported for tabular results")
+
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// resultRenderer writes typed command results in the format selected with the global --format flag.
+// Results are either a single object, or rows which are slices of structs. Struct fields are
+// named with their json tag in JSON and YAML, and with their header tag in tables and CSV.
+//
+// Commands listing many results call renderRows once per page, so JSON rows are written as
+// one object per line (NDJSON) and YAML rows as the items of a single sequence.
+type resultRenderer struct {
+	w        io.Writer
+	format   string
+	template *template.Template
+	table    *tablewriter.Table
+	csv      *csv.Writer
+	// csvHeader is true once the CSV header row is written
+	csvHeader bool
+}
+
+func newRenderer(c *cli.Context) *resultRenderer {
+	r, err := newRendererWithWriter(os.Stdout, c.GlobalString(FlagFormat))
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagFormat), err)
+	}
+	return r
+}
+
+func newRendererWithWriter(w io.Writer, format string) (*resultRenderer, error) {
+	r := &resultRenderer{w: w, format: format}
+	switch {
+	case format == "" || format == formatTable:
+		r.format = formatTable
+	case format == formatJSON, format == formatYAML:
+	case format == formatCSV:
+		r.csv = csv.NewWriter(w)
+	case strings.HasPrefix(format, formatTemplatePrefix):
+		tmpl, err := template.New("format").Funcs(template.FuncMap{
+			"json": func(o interface{}) (string, error) {
+				b, err := json.Marshal(o)
+				return string(b), err
+			},
+		}).Parse(strings.TrimPrefix(format, formatTemplatePrefix))
+		if err != nil {
+			return nil, err
+		}
+		r.template = tmpl
+	default:
+		return nil, fmt.Errorf("unknown format %q, valid formats are table, json, yaml, csv and template='{{...}}'", format)
+	}
+	return r, nil
+}
+
+// isTable returns true if results are printed in the default human readable format
+func (r *resultRenderer) isTable() bool {
+	return r.format == formatTable
+}
+
+// renderRows writes a page of rows, rows must be a slice of structs or of pointers to structs
+func (r *resultRenderer) renderRows(rows interface{}) error {
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("expected a slice of rows, got %T", rows)
+	}
+	switch {
+	case r.template != nil:
+		for i := 0; i < v.Len(); i++ {
+			if err := r.executeTemplate(v.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	case r.format == formatJSON:
+		encoder := json.NewEncoder(r.w)
+		for i := 0; i < v.Len(); i++ {
+			if err := encoder.Encode(v.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	case r.format == formatYAML:
+		if v.Len() == 0 {
+			return nil
+		}
+		items := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			item, err := toYAMLValue(v.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+			items = append(items, item)
+		}
+		return r.writeYAML(items)
+	default:
+		rowType := indirectType(v.Type().Elem())
+		if rowType.Kind() != reflect.Struct {
+			if r.csv != nil {
+				return errCSVNotTabular
+			}
+			return fmt.Errorf("expected rows of structs, got %T", rows)
+		}
+		if r.csv != nil {
+			return r.writeCSV(rowType, v)
+		}
+		if r.table == nil {
+			r.table = newResultTable(r.w, rowHeaders(rowType))
+		}
+		for i := 0; i < v.Len(); i++ {
+			r.table.Append(rowValues(v.Index(i)))
+		}
+	}
+	return nil
+}
+
+// renderObject writes a single result. In table format the object is printed as indented JSON,
+// commands with a human readable output of their own should check isTable first.
+func (r *resultRenderer) renderObject(o interface{}) error {
+	switch {
+	case r.template != nil:
+		return r.executeTemplate(o)
+	case r.format == formatJSON, r.format == formatTable:
+		b, err := json.MarshalIndent(o, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(r.w, string(b))
+		return err
+	case r.format == formatYAML:
+		item, err := toYAMLValue(o)
+		if err != nil {
+			return err
+		}
+		return r.writeYAML(item)
+	default:
+		v := reflect.ValueOf(o)
+		if v.Kind() == reflect.Slice {
+			return r.renderRows(o)
+		}
+		if indirectType(v.Type()).Kind() != reflect.Struct {
+			return errCSVNotTabular
+		}
+		rows := reflect.MakeSlice(reflect.SliceOf(v.Type()), 0, 1)
+		return r.renderRows(reflect.Append(rows, v).Interface())
+	}
+}
+
+// flush writes out the rows buffered by table and CSV formats
+func (r *resultRenderer) flush() error {
+	if r.table != nil {
+		r.table.Render()
+		r.table = nil
+	}
+	if r.csv != nil {
+		r.csv.Flush()
+		return r.csv.Error()
+	}
+	return nil
+}
+
+// renderResult writes a single result in the selected format, exiting on failure
+func renderResult(c *cli.Context, o interface{}) {
+	r := newRenderer(c)
+	if err := r.renderObject(o); err != nil {
+		ErrorAndExit("Failed to render result.", err)
+	}
+	if err := r.flush(); err != nil {
+		ErrorAndExit("Failed to render result.", err)
+	}
+}
+
+// renderAllRows writes all rows of a result and flushes them, exiting on failure
+func (r *resultRenderer) renderAllRows(rows interface{}) {
+	err := r.renderRows(rows)
+	if err == nil {
+		err = r.flush()
+	}
+	if err != nil {
+		ErrorAndExit("Failed to render results.", err)
+	}
+}
+
+func (r *resultRenderer) executeTemplate(o interface{}) error {
+	if err := r.template.Execute(r.w, o); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(r.w)
+	return err
+}
+
+func (r *resultRenderer) writeYAML(o interface{}) error {
+	b, err := yaml.Marshal(o)
+	if err != nil {
+		return err
+	}
+	_, err = r.w.Write(b)
+	return err
+}
+
+func (r *resultRenderer) writeCSV(rowType reflect.Type, rows reflect.Value) error {
+	if !r.csvHeader {
+		if err := r.csv.Write(rowHeaders(rowType)); err != nil {
+			return err
+		}
+		r.csvHeader = true
+	}
+	for i := 0; i < rows.Len(); i++ {
+		if err := r.csv.Write(rowValues(rows.Index(i))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newResultTable(w io.Writer, header []string) *tablewriter.Table {
+	table := tablewriter.NewWriter(w)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	headerColor := make([]tablewriter.Colors, len(header))
+	for i := range headerColor {
+		headerColor[i] = tableHeaderBlue
+	}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	table.SetHeaderLine(false)
+	return table
+}
+
+// toYAMLValue converts a result to a value that YAML marshals with the same field names and
+// field order as JSON, so both formats describe a result the same way
+func toYAMLValue(o interface{}) (interface{}, error) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	var value yaml.MapSlice
+	if err := yaml.Unmarshal(b, &value); err == nil {
+		return value, nil
+	}
+	var generic interface{}
+	if err := yaml.Unmarshal(b, &generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+func rowFields(rowType reflect.Type) []int {
+	var fields []int
+	for i := 0; i < rowType.NumField(); i++ {
+		field := rowType.Field(i)
+		if field.PkgPath != "" || field.Tag.Get("header") == "-" {
+			continue
+		}
+		fields = append(fields, i)
+	}
+	return fields
+}
+
+func rowHeaders(rowType reflect.Type) []string {
+	var headers []string
+	for _, i := range rowFields(rowType) {
+		field := rowType.Field(i)
+		header := field.Tag.Get("header")
+		if header == "" {
+			header = field.Name
+		}
+		headers = append(headers, header)
+	}
+	return headers
+}
+
+func rowValues(row reflect.Value) []string {
+	for row.Kind() == reflect.Ptr || row.Kind() == reflect.Interface {
+		row = row.Elem()
+	}
+	var values []string
+	for _, i := range rowFields(row.Type()) {
+		values = append(values, formatRowValue(row.Field(i)))
+	}
+	return values
+}
+
+func formatRowValue(v reflect.Value) string {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	switch {
+	case v.Type() == timeType:
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	case v.Kind() == reflect.Map:
+		pairs := make([]string, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			pairs = append(pairs, fmt.Sprintf("%v=%v", iter.Key().Interface(), formatRowValue(iter.Value())))
+		}
+		sort.Strings(pairs)
+		return strings.Join(pairs, ", ")
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8:
+		items := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items = append(items, formatRowValue(v.Index(i)))
+		}
+		return strings.Join(items, ", ")
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
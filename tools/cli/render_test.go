@@ -0,0 +1,127 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRow struct {
+	Name    string            `json:"name" header:"Name"`
+	Count   int               `json:"count" header:"Count"`
+	Started time.Time         `json:"started" header:"Started"`
+	Tags    map[string]string `json:"tags,omitempty" header:"Tags"`
+	Hidden  string            `json:"hidden" header:"-"`
+}
+
+func testRows() []testRow {
+	started := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []testRow{
+		{Name: "a", Count: 1, Started: started, Tags: map[string]string{"y": "2", "x": "1"}, Hidden: "h"},
+		{Name: "b", Count: 2, Started: started},
+	}
+}
+
+func TestRenderer_InvalidFormat(t *testing.T) {
+	_, err := newRendererWithWriter(&bytes.Buffer{}, "xml")
+	assert.Error(t, err)
+	_, err = newRendererWithWriter(&bytes.Buffer{}, "template={{.Name")
+	assert.Error(t, err)
+
+	r, err := newRendererWithWriter(&bytes.Buffer{}, "")
+	require.NoError(t, err)
+	assert.True(t, r.isTable())
+}
+
+func TestRenderer_JSONRows(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := newRendererWithWriter(&buf, formatJSON)
+	require.NoError(t, err)
+	require.NoError(t, r.renderRows(testRows()[:1]))
+	require.NoError(t, r.renderRows(testRows()[1:]))
+	require.NoError(t, r.flush())
+	assert.Equal(t,
+		`{"name":"a","count":1,"started":"2020-01-02T03:04:05Z","tags":{"x":"1","y":"2"},"hidden":"h"}`+"\n"+
+			`{"name":"b","count":2,"started":"2020-01-02T03:04:05Z","hidden":""}`+"\n",
+		buf.String())
+}
+
+func TestRenderer_YAMLRows(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := newRendererWithWriter(&buf, formatYAML)
+	require.NoError(t, err)
+	require.NoError(t, r.renderRows(testRows()[1:]))
+	assert.Equal(t, "- name: b\n  count: 2\n  started: \"2020-01-02T03:04:05Z\"\n  hidden: \"\"\n", buf.String())
+}
+
+func TestRenderer_CSVRows(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := newRendererWithWriter(&buf, formatCSV)
+	require.NoError(t, err)
+	require.NoError(t, r.renderRows(testRows()[:1]))
+	require.NoError(t, r.renderRows(testRows()[1:]))
+	require.NoError(t, r.flush())
+	assert.Equal(t,
+		"Name,Count,Started,Tags\n"+
+			"a,1,2020-01-02T03:04:05Z,\"x=1, y=2\"\n"+
+			"b,2,2020-01-02T03:04:05Z,\n",
+		buf.String())
+
+	assert.Equal(t, errCSVNotTabular, r.renderObject(map[string]string{"a": "b"}))
+}
+
+func TestRenderer_Template(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := newRendererWithWriter(&buf, `template={{.Name}}:{{.Count}} {{json .Tags}}`)
+	require.NoError(t, err)
+	require.NoError(t, r.renderRows(testRows()))
+	assert.Equal(t, "a:1 {\"x\":\"1\",\"y\":\"2\"}\nb:2 null\n", buf.String())
+}
+
+func TestRenderer_Object(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := newRendererWithWriter(&buf, formatJSON)
+	require.NoError(t, err)
+	require.NoError(t, r.renderObject(map[string]int{"b": 2, "a": 1}))
+	assert.Equal(t, "{\n  \"a\": 1,\n  \"b\": 2\n}\n", buf.String())
+
+	buf.Reset()
+	r, err = newRendererWithWriter(&buf, formatCSV)
+	require.NoError(t, err)
+	require.NoError(t, r.renderObject(testRows()[1]))
+	require.NoError(t, r.flush())
+	assert.Equal(t, "Name,Count,Started,Tags\nb,2,2020-01-02T03:04:05Z,\n", buf.String())
+}
+
+func TestRenderer_TableRows(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := newRendererWithWriter(&buf, "")
+	require.NoError(t, err)
+	require.NoError(t, r.renderRows(testRows()))
+	require.NoError(t, r.flush())
+	assert.Contains(t, buf.String(), "NAME")
+	assert.Contains(t, buf.String(), "x=1, y=2")
+}
@@ -22,6 +22,7 @@ package cli
 
 import (
 	"os"
+	"time"
 
 	"github.com/uber/cadence/common/types"
 
@@ -30,6 +31,21 @@ import (
 	s "go.uber.org/cadence/.gen/go/shared"
 )
 
+type (
+	// taskListPollerRow is a poller of a tasklist, as printed by `tasklist describe`
+	taskListPollerRow struct {
+		Identity       string    `json:"identity" header:"Poller Identity"`
+		LastAccessTime time.Time `json:"lastAccessTime" header:"Last Access Time"`
+	}
+
+	// taskListPartitionRow is a partition of a tasklist, as printed by `tasklist list-partition`
+	taskListPartitionRow struct {
+		Type string `json:"type" header:"Type"`
+		Key  string `json:"key" header:"Partition"`
+		Host string `json:"host" header:"Host"`
+	}
+)
+
 // DescribeTaskList show pollers info of a given tasklist
 func DescribeTaskList(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -48,6 +64,19 @@ func DescribeTaskList(c *cli.Context) {
 		ErrorAndExit(colorMagenta("No poller for tasklist: "+taskList), nil)
 	}
 
+	rows := make([]taskListPollerRow, 0, len(pollers))
+	for _, poller := range pollers {
+		rows = append(rows, taskListPollerRow{
+			Identity:       poller.GetIdentity(),
+			LastAccessTime: time.Unix(0, poller.GetLastAccessTime()),
+		})
+	}
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		renderer.renderAllRows(rows)
+		return
+	}
+
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
@@ -58,8 +87,8 @@ func DescribeTaskList(c *cli.Context) {
 	}
 	table.SetHeaderLine(false)
 	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
-	for _, poller := range pollers {
-		table.Append([]string{poller.GetIdentity(), convertTime(poller.GetLastAccessTime(), false)})
+	for _, row := range rows {
+		table.Append([]string{row.Identity, convertTime(row.LastAccessTime.UnixNano(), false)})
 	}
 	table.Render()
 }
@@ -81,23 +110,42 @@ func ListTaskListPartitions(c *cli.Context) {
 	if err != nil {
 		ErrorAndExit("Operation ListTaskListPartitions failed.", err)
 	}
-	if len(response.DecisionTaskListPartitions) > 0 {
-		printTaskListPartitions("Decision", response.DecisionTaskListPartitions)
+	decisionRows := toTaskListPartitionRows("Decision", response.DecisionTaskListPartitions)
+	activityRows := toTaskListPartitionRows("Activity", response.ActivityTaskListPartitions)
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		renderer.renderAllRows(append(decisionRows, activityRows...))
+		return
+	}
+	if len(decisionRows) > 0 {
+		printTaskListPartitions("Decision", decisionRows)
+	}
+	if len(activityRows) > 0 {
+		printTaskListPartitions("Activity", activityRows)
 	}
-	if len(response.ActivityTaskListPartitions) > 0 {
-		printTaskListPartitions("Activity", response.ActivityTaskListPartitions)
+}
+
+func toTaskListPartitionRows(taskListType string, partitions []*types.TaskListPartitionMetadata) []taskListPartitionRow {
+	rows := make([]taskListPartitionRow, 0, len(partitions))
+	for _, partition := range partitions {
+		rows = append(rows, taskListPartitionRow{
+			Type: taskListType,
+			Key:  partition.GetKey(),
+			Host: partition.GetOwnerHostName(),
+		})
 	}
+	return rows
 }
 
-func printTaskListPartitions(taskListType string, partitions []*types.TaskListPartitionMetadata) {
+func printTaskListPartitions(taskListType string, rows []taskListPartitionRow) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
 	table.SetHeader([]string{taskListType + "TaskListPartition", "Host"})
 	table.SetHeaderLine(false)
 	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
-	for _, partition := range partitions {
-		table.Append([]string{partition.GetKey(), partition.GetOwnerHostName()})
+	for _, row := range rows {
+		table.Append([]string{row.Key, row.Host})
 	}
 	table.Render()
 }
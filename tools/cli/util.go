@@ -591,6 +591,13 @@ func getRequiredIntOption(c *cli.Context, optionName string) int {
 	return c.Int(optionName)
 }
 
+func getOptionalInt64Option(c *cli.Context, optionName string) *int64 {
+	if !c.IsSet(optionName) {
+		return nil
+	}
+	return common.Int64Ptr(c.Int64(optionName))
+}
+
 func getRequiredGlobalOption(c *cli.Context, optionName string) string {
 	value := c.GlobalString(optionName)
 	if len(value) == 0 {
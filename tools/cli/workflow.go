@@ -53,6 +53,15 @@ func newWorkflowCommands() []cli.Command {
 				ShowHistoryWithWID(c)
 			},
 		},
+		{
+			Name:        "diff",
+			Usage:       "compare the histories of two workflow runs",
+			Description: "Events are aligned by decision and matched by event type and activity, timer, signal, marker or child workflow, then added, removed and changed events are printed",
+			Flags:       getFlagsForDiff(),
+			Action: func(c *cli.Context) {
+				DiffWorkflow(c)
+			},
+		},
 		{
 			Name:  "start",
 			Usage: "start a new workflow execution",
@@ -246,6 +255,10 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagSkipSignalReapply,
 					Usage: "whether or not skipping signals reapply after the reset point",
 				},
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Preview the reset without applying it: print the reset point, discarded events, reapplied signals, pending activities/timers and orphaned child workflows",
+				},
 			},
 			Action: func(c *cli.Context) {
 				ResetWorkflow(c)
@@ -300,7 +313,7 @@ func newWorkflowCommands() []cli.Command {
 				},
 				cli.BoolFlag{
 					Name:  FlagDryRun,
-					Usage: "Not do real action of reset(just logging in STDOUT)",
+					Usage: "Not do real action of reset(just printing the reset preview returned by server in STDOUT)",
 				},
 				cli.StringFlag{
 					Name:  FlagResetType,
@@ -476,6 +489,14 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagInputWithAlias,
 					Usage: "Optional input of signal",
 				},
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Required for batch replicate, cluster to replicate workflows from",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetClusterWithAlias,
+					Usage: "Required for batch replicate, comma separated clusters to replicate workflows to",
+				},
 				cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
@@ -57,7 +57,7 @@ func TerminateBatchJob(c *cli.Context) {
 	output := map[string]interface{}{
 		"msg": "batch job is terminated",
 	}
-	prettyPrintJSONObject(output)
+	renderResult(c, output)
 }
 
 // DescribeBatchJob describe the status of the batch job
@@ -92,7 +92,7 @@ func DescribeBatchJob(c *cli.Context) {
 			output["progress"] = hbd
 		}
 	}
-	prettyPrintJSONObject(output)
+	renderResult(c, output)
 }
 
 // ListBatchJobs list the started batch jobs
@@ -129,7 +129,7 @@ func ListBatchJobs(c *cli.Context) {
 
 		output = append(output, job)
 	}
-	prettyPrintJSONObject(output)
+	renderResult(c, output)
 }
 
 // StartBatchJob starts a batch job
@@ -141,12 +141,17 @@ func StartBatchJob(c *cli.Context) {
 	if !validateBatchType(batchType) {
 		ErrorAndExit("batchType is not valid, supported:"+strings.Join(batcher.AllBatchTypes, ","), nil)
 	}
-	operator := getCurrentUserFromEnv()
 	var sigName, sigVal string
 	if batchType == batcher.BatchTypeSignal {
 		sigName = getRequiredOption(c, FlagSignalName)
 		sigVal = getRequiredOption(c, FlagInput)
 	}
+	var sourceCluster string
+	var targetClusters []string
+	if batchType == batcher.BatchTypeReplicate {
+		sourceCluster = getRequiredOption(c, FlagSourceCluster)
+		targetClusters = strings.Split(getRequiredOption(c, FlagTargetCluster), ",")
+	}
 	rps := c.Int(FlagRPS)
 
 	svcClient := cFactory.ClientFrontendClient(c)
@@ -178,19 +183,6 @@ func StartBatchJob(c *cli.Context) {
 		}
 
 	}
-	tcCtx, cancel = newContext(c)
-	defer cancel()
-	options := cclient.StartWorkflowOptions{
-		TaskList:                     batcher.BatcherTaskListName,
-		ExecutionStartToCloseTimeout: batcher.InfiniteDuration,
-		Memo: map[string]interface{}{
-			"Reason": reason,
-		},
-		SearchAttributes: map[string]interface{}{
-			"CustomDomain": domain,
-			"Operator":     operator,
-		},
-	}
 	params := batcher.BatchParams{
 		DomainName: domain,
 		Query:      query,
@@ -200,8 +192,29 @@ func StartBatchJob(c *cli.Context) {
 			SignalName: sigName,
 			Input:      sigVal,
 		},
+		ReplicateParams: batcher.ReplicateParams{
+			SourceCluster:  sourceCluster,
+			TargetClusters: targetClusters,
+		},
 		RPS: rps,
 	}
+	startBatchWorkflow(c, client, params)
+}
+
+func startBatchWorkflow(c *cli.Context, client cclient.Client, params batcher.BatchParams) {
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	options := cclient.StartWorkflowOptions{
+		TaskList:                     batcher.BatcherTaskListName,
+		ExecutionStartToCloseTimeout: batcher.InfiniteDuration,
+		Memo: map[string]interface{}{
+			"Reason": params.Reason,
+		},
+		SearchAttributes: map[string]interface{}{
+			"CustomDomain": params.DomainName,
+			"Operator":     getCurrentUserFromEnv(),
+		},
+	}
 	wf, err := client.StartWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
 	if err != nil {
 		ErrorAndExit("Failed to start batch job", err)
@@ -210,7 +223,7 @@ func StartBatchJob(c *cli.Context) {
 		"msg":   "batch job is started",
 		"jobID": wf.ID,
 	}
-	prettyPrintJSONObject(output)
+	renderResult(c, output)
 }
 
 func validateBatchType(bt string) bool {
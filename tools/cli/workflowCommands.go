@@ -37,9 +37,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	"github.com/olekukonko/tablewriter"
 	"github.com/pborman/uuid"
 	"github.com/urfave/cli"
+	"github.com/xwb1989/sqlparser"
 	s "go.uber.org/cadence/.gen/go/shared"
 	"go.uber.org/cadence/client"
 
@@ -656,6 +658,15 @@ func ListWorkflow(c *cli.Context) {
 	more := c.Bool(FlagMore)
 	queryOpen := c.Bool(FlagOpen)
 
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		if more {
+			ErrorAndExit(fmt.Sprintf("Option %s is only supported in table format, use listall instead.", FlagMore), nil)
+		}
+		renderWorkflowPages(renderer, listWorkflowPages(c, queryOpen), false)
+		return
+	}
+
 	printJSON := c.Bool(FlagPrintJSON)
 	printDecodedRaw := c.Bool(FlagPrintFullyDetail)
 
@@ -699,6 +710,12 @@ func ListWorkflow(c *cli.Context) {
 func ListAllWorkflow(c *cli.Context) {
 	queryOpen := c.Bool(FlagOpen)
 
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		renderWorkflowPages(renderer, listWorkflowPages(c, queryOpen), true)
+		return
+	}
+
 	printJSON := c.Bool(FlagPrintJSON)
 	printDecodedRaw := c.Bool(FlagPrintFullyDetail)
 
@@ -732,6 +749,12 @@ func ListAllWorkflow(c *cli.Context) {
 // ScanAllWorkflow list all workflow executions using Scan API.
 // It should be faster than ListAllWorkflow, but result are not sorted.
 func ScanAllWorkflow(c *cli.Context) {
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		renderWorkflowPages(renderer, scanWorkflowPages(c), true)
+		return
+	}
+
 	printJSON := c.Bool(FlagPrintJSON)
 	printDecodedRaw := c.Bool(FlagPrintFullyDetail)
 
@@ -770,21 +793,85 @@ func isQueryOpen(query string) bool {
 
 // CountWorkflow count number of workflows
 func CountWorkflow(c *cli.Context) {
-	wfClient := getWorkflowClient(c)
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
 
 	query := c.String(FlagListQuery)
-	request := &s.CountWorkflowExecutionsRequest{
-		Query: common.StringPtr(query),
+	request := &types.CountWorkflowExecutionsRequest{
+		Domain: domain,
+		Query:  query,
 	}
 
 	ctx, cancel := newContextForLongPoll(c)
 	defer cancel()
-	response, err := wfClient.CountWorkflow(ctx, request)
+	response, err := frontendClient.CountWorkflowExecutions(ctx, request)
 	if err != nil {
 		ErrorAndExit("Failed to count workflow.", err)
 	}
 
-	fmt.Println(response.GetCount())
+	renderer := newRenderer(c)
+	if !renderer.isTable() {
+		rows := []workflowCountRow{{Count: response.GetCount()}}
+		if len(response.GetGroups()) > 0 {
+			rows = make([]workflowCountRow, 0, len(response.GetGroups()))
+			for _, group := range response.GetGroups() {
+				rows = append(rows, workflowCountRow{
+					GroupValues: group.GetGroupValues(),
+					Count:       group.GetCount(),
+				})
+			}
+		}
+		renderer.renderAllRows(rows)
+		return
+	}
+
+	if len(response.GetGroups()) == 0 {
+		fmt.Println(response.GetCount())
+		return
+	}
+	printCountGroups(getGroupByFields(query), response)
+}
+
+func printCountGroups(groupByFields []string, response *types.CountWorkflowExecutionsResponse) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	header := append(append([]string{}, groupByFields...), "Count")
+	headerColor := make([]tablewriter.Colors, len(header))
+	for i := range headerColor {
+		headerColor[i] = tableHeaderBlue
+	}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	table.SetHeaderLine(false)
+	for _, group := range response.GetGroups() {
+		row := make([]string, len(groupByFields))
+		copy(row, group.GetGroupValues())
+		table.Append(append(row, strconv.FormatInt(group.GetCount(), 10)))
+	}
+	table.SetFooter(append(make([]string, len(groupByFields)), strconv.FormatInt(response.GetCount(), 10)))
+	table.Render()
+}
+
+// getGroupByFields returns the search attributes in the GROUP BY clause of a visibility query
+func getGroupByFields(query string) []string {
+	placeholderQuery := "select * from dummy where " + query
+	if common.IsJustOrderByClause(query) || common.IsJustGroupByClause(query) {
+		placeholderQuery = "select * from dummy " + query
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		ErrorAndExit("Failed to parse query.", err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		ErrorAndExit("Failed to parse query.", errors.New("not a select query"))
+	}
+	var fields []string
+	for _, expr := range sel.GroupBy {
+		fields = append(fields, strings.Trim(sqlparser.String(expr), "`"))
+	}
+	return fields
 }
 
 // ListArchivedWorkflow lists archived workflow executions based on filters
@@ -947,7 +1034,7 @@ func describeWorkflowHelper(c *cli.Context, wid, rid string) {
 		o = convertDescribeWorkflowExecutionResponse(resp, frontendClient, c)
 	}
 
-	prettyPrintJSONObject(o)
+	renderResult(c, o)
 }
 
 func printAutoResetPoints(resp *types.DescribeWorkflowExecutionResponse) {
@@ -972,6 +1059,28 @@ func printAutoResetPoints(resp *types.DescribeWorkflowExecutionResponse) {
 	table.Render()
 }
 
+// workflowExecutionRow is a workflow execution as printed by list and scan commands in structured formats
+type workflowExecutionRow struct {
+	WorkflowType     string                 `json:"workflowType" header:"Workflow Type"`
+	WorkflowID       string                 `json:"workflowId" header:"Workflow ID"`
+	RunID            string                 `json:"runId" header:"Run ID"`
+	TaskList         string                 `json:"taskList" header:"Task List"`
+	IsCron           bool                   `json:"isCron" header:"Is Cron"`
+	StartTime        time.Time              `json:"startTime" header:"Start Time"`
+	ExecutionTime    time.Time              `json:"executionTime" header:"Execution Time"`
+	CloseTime        *time.Time             `json:"closeTime,omitempty" header:"End Time"`
+	CloseStatus      string                 `json:"closeStatus,omitempty" header:"Close Status"`
+	HistoryLength    int64                  `json:"historyLength,omitempty" header:"History Length"`
+	Memo             map[string]string      `json:"memo,omitempty" header:"Memo"`
+	SearchAttributes map[string]interface{} `json:"searchAttributes,omitempty" header:"Search Attributes"`
+}
+
+// workflowCountRow is the count of workflow executions in a GROUP BY group, or in total if the query has no GROUP BY
+type workflowCountRow struct {
+	GroupValues []string `json:"groupValues,omitempty" header:"Group Values"`
+	Count       int64    `json:"count" header:"Count"`
+}
+
 // describeWorkflowExecutionResponse is used to print datetime instead of print raw time
 type describeWorkflowExecutionResponse struct {
 	ExecutionConfiguration *types.WorkflowExecutionConfiguration
@@ -1149,16 +1258,37 @@ func createTableForListWorkflow(c *cli.Context, listAll bool, queryOpen bool) *t
 }
 
 func listWorkflow(c *cli.Context, table *tablewriter.Table, queryOpen bool) func([]byte) ([]byte, int) {
+	listPage := listWorkflowPages(c, queryOpen)
+	printRawTime := c.Bool(FlagPrintRawTime)
+	printDateTime := c.Bool(FlagPrintDateTime)
+	printMemo := c.Bool(FlagPrintMemo)
+	printSearchAttr := c.Bool(FlagPrintSearchAttr)
+
+	prepareTable := func(next []byte) ([]byte, int) {
+		result, nextPageToken := listPage(next)
+		appendWorkflowExecutionsToTable(
+			table,
+			result,
+			queryOpen,
+			printRawTime,
+			printDateTime,
+			printMemo,
+			printSearchAttr,
+		)
+
+		return nextPageToken, len(result)
+	}
+	return prepareTable
+}
+
+// listWorkflowPages returns a function which lists a page of workflow executions matching the filters of the command
+func listWorkflowPages(c *cli.Context, queryOpen bool) func([]byte) ([]*s.WorkflowExecutionInfo, []byte) {
 	wfClient := getWorkflowClient(c)
 
 	earliestTime := parseTime(c.String(FlagEarliestTime), 0)
 	latestTime := parseTime(c.String(FlagLatestTime), time.Now().UnixNano())
 	workflowID := c.String(FlagWorkflowID)
 	workflowType := c.String(FlagWorkflowType)
-	printRawTime := c.Bool(FlagPrintRawTime)
-	printDateTime := c.Bool(FlagPrintDateTime)
-	printMemo := c.Bool(FlagPrintMemo)
-	printSearchAttr := c.Bool(FlagPrintSearchAttr)
 	pageSize := c.Int(FlagPageSize)
 	if pageSize <= 0 {
 		pageSize = defaultPageSizeForList
@@ -1178,31 +1308,15 @@ func listWorkflow(c *cli.Context, table *tablewriter.Table, queryOpen bool) func
 		ErrorAndExit(optionErr, errors.New("you can filter on workflow_id or workflow_type, but not on both"))
 	}
 
-	prepareTable := func(next []byte) ([]byte, int) {
-		var result []*s.WorkflowExecutionInfo
-		var nextPageToken []byte
+	return func(next []byte) ([]*s.WorkflowExecutionInfo, []byte) {
 		if c.IsSet(FlagListQuery) {
 			listQuery := c.String(FlagListQuery)
-			result, nextPageToken = listWorkflowExecutions(wfClient, pageSize, next, listQuery, c)
+			return listWorkflowExecutions(wfClient, pageSize, next, listQuery, c)
 		} else if queryOpen {
-			result, nextPageToken = listOpenWorkflow(wfClient, pageSize, earliestTime, latestTime, workflowID, workflowType, next, c)
-		} else {
-			result, nextPageToken = listClosedWorkflow(wfClient, pageSize, earliestTime, latestTime, workflowID, workflowType, workflowStatus, next, c)
+			return listOpenWorkflow(wfClient, pageSize, earliestTime, latestTime, workflowID, workflowType, next, c)
 		}
-
-		appendWorkflowExecutionsToTable(
-			table,
-			result,
-			queryOpen,
-			printRawTime,
-			printDateTime,
-			printMemo,
-			printSearchAttr,
-		)
-
-		return nextPageToken, len(result)
+		return listClosedWorkflow(wfClient, pageSize, earliestTime, latestTime, workflowID, workflowType, workflowStatus, next, c)
 	}
-	return prepareTable
 }
 
 func appendWorkflowExecutionsToTable(
@@ -1423,22 +1537,14 @@ func scanWorkflowExecutions(client client.Client, pageSize int, nextPageToken []
 }
 
 func scanWorkflow(c *cli.Context, table *tablewriter.Table, queryOpen bool) func([]byte) ([]byte, int) {
-	wfClient := getWorkflowClient(c)
-
+	scanPage := scanWorkflowPages(c)
 	printRawTime := c.Bool(FlagPrintRawTime)
 	printDateTime := c.Bool(FlagPrintDateTime)
 	printMemo := c.Bool(FlagPrintMemo)
 	printSearchAttr := c.Bool(FlagPrintSearchAttr)
-	pageSize := c.Int(FlagPageSize)
-	if pageSize <= 0 {
-		pageSize = defaultPageSizeForScan
-	}
 
 	prepareTable := func(next []byte) ([]byte, int) {
-		var result []*s.WorkflowExecutionInfo
-		var nextPageToken []byte
-		listQuery := c.String(FlagListQuery)
-		result, nextPageToken = scanWorkflowExecutions(wfClient, pageSize, next, listQuery, c)
+		result, nextPageToken := scanPage(next)
 
 		for _, e := range result {
 			var startTime, executionTime, closeTime string
@@ -1468,6 +1574,79 @@ func scanWorkflow(c *cli.Context, table *tablewriter.Table, queryOpen bool) func
 	}
 	return prepareTable
 }
+
+// scanWorkflowPages returns a function which scans a page of workflow executions matching the query of the command
+func scanWorkflowPages(c *cli.Context) func([]byte) ([]*s.WorkflowExecutionInfo, []byte) {
+	wfClient := getWorkflowClient(c)
+	listQuery := c.String(FlagListQuery)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForScan
+	}
+
+	return func(next []byte) ([]*s.WorkflowExecutionInfo, []byte) {
+		return scanWorkflowExecutions(wfClient, pageSize, next, listQuery, c)
+	}
+}
+
+// renderWorkflowPages renders workflow executions page by page, so structured formats stream the results
+func renderWorkflowPages(renderer *resultRenderer, listPage func([]byte) ([]*s.WorkflowExecutionInfo, []byte), allPages bool) {
+	var nextPageToken []byte
+	for {
+		var executions []*s.WorkflowExecutionInfo
+		executions, nextPageToken = listPage(nextPageToken)
+		rows := make([]workflowExecutionRow, 0, len(executions))
+		for _, execution := range executions {
+			rows = append(rows, newWorkflowExecutionRow(execution))
+		}
+		if err := renderer.renderRows(rows); err != nil {
+			ErrorAndExit("Failed to render workflow executions.", err)
+		}
+		if !allPages || len(nextPageToken) == 0 {
+			break
+		}
+	}
+	if err := renderer.flush(); err != nil {
+		ErrorAndExit("Failed to render workflow executions.", err)
+	}
+}
+
+func newWorkflowExecutionRow(execution *s.WorkflowExecutionInfo) workflowExecutionRow {
+	row := workflowExecutionRow{
+		WorkflowType:  execution.Type.GetName(),
+		WorkflowID:    execution.Execution.GetWorkflowId(),
+		RunID:         execution.Execution.GetRunId(),
+		TaskList:      execution.GetTaskList(),
+		IsCron:        execution.GetIsCron(),
+		StartTime:     time.Unix(0, execution.GetStartTime()),
+		ExecutionTime: time.Unix(0, execution.GetExecutionTime()),
+		HistoryLength: execution.GetHistoryLength(),
+	}
+	if execution.CloseTime != nil {
+		closeTime := time.Unix(0, execution.GetCloseTime())
+		row.CloseTime = &closeTime
+	}
+	if execution.CloseStatus != nil {
+		row.CloseStatus = execution.CloseStatus.String()
+	}
+	if len(execution.Memo.GetFields()) > 0 {
+		row.Memo = make(map[string]string, len(execution.Memo.GetFields()))
+		for key, value := range execution.Memo.GetFields() {
+			row.Memo[key] = string(value)
+		}
+	}
+	if len(execution.SearchAttributes.GetIndexedFields()) > 0 {
+		row.SearchAttributes = make(map[string]interface{}, len(execution.SearchAttributes.GetIndexedFields()))
+		for key, value := range execution.SearchAttributes.GetIndexedFields() {
+			var decodedValue interface{}
+			if err := json.Unmarshal(value, &decodedValue); err != nil {
+				decodedValue = string(value)
+			}
+			row.SearchAttributes[key] = decodedValue
+		}
+	}
+	return row
+}
 func getWorkflowStatus(statusStr string) s.WorkflowExecutionCloseStatus {
 	if status, ok := workflowClosedStatusMap[strings.ToLower(statusStr)]; ok {
 		return status
@@ -1536,6 +1715,11 @@ func ResetWorkflow(c *cli.Context) {
 	defer cancel()
 
 	frontendClient := cFactory.ServerFrontendClient(c)
+	if c.Bool(FlagDryRun) {
+		if err := validateResetDryRunSupported(ctx, frontendClient); err != nil {
+			ErrorAndExit("Dry run is not available", err)
+		}
+	}
 	rid := c.String(FlagRunID)
 	var err error
 	if rid == "" {
@@ -1563,11 +1747,12 @@ func ResetWorkflow(c *cli.Context) {
 		DecisionFinishEventID: decisionFinishID,
 		RequestID:             uuid.New(),
 		SkipSignalReapply:     c.Bool(FlagSkipSignalReapply),
+		DryRun:                c.Bool(FlagDryRun),
 	})
 	if err != nil {
 		ErrorAndExit("reset failed", err)
 	}
-	prettyPrintJSONObject(resp)
+	renderResult(c, resp)
 }
 
 func processResets(c *cli.Context, domain string, wes chan types.WorkflowExecution, done chan bool, wg *sync.WaitGroup, params batchResetParamsType) {
@@ -1642,6 +1827,15 @@ func ResetInBatch(c *cli.Context) {
 		ErrorAndExit("Must provide input file or list query to get target workflows to reset", nil)
 	}
 
+	if batchResetParams.dryRun {
+		ctx, cancel := newContext(c)
+		err := validateResetDryRunSupported(ctx, cFactory.ServerFrontendClient(c))
+		cancel()
+		if err != nil {
+			ErrorAndExit("Dry run is not available", err)
+		}
+	}
+
 	wg := &sync.WaitGroup{}
 
 	wes := make(chan types.WorkflowExecution)
@@ -1818,30 +2012,52 @@ func doReset(c *cli.Context, domain, wid, rid string, params batchResetParamsTyp
 	}
 	fmt.Println("DecisionFinishEventId for reset:", wid, rid, resetBaseRunID, decisionFinishID)
 
+	resp2, err := frontendClient.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      resetBaseRunID,
+		},
+		DecisionFinishEventID: decisionFinishID,
+		RequestID:             uuid.New(),
+		Reason:                fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), params.reason),
+		SkipSignalReapply:     params.skipSignalReapply,
+		DryRun:                params.dryRun,
+	})
+
+	if err != nil {
+		return printErrorAndReturn("ResetWorkflowExecution failed", err)
+	}
 	if params.dryRun {
 		fmt.Printf("dry run to reset wid: %v, rid:%v to baseRunID:%v, eventID:%v \n", wid, rid, resetBaseRunID, decisionFinishID)
+		prettyPrintJSONObject(resp2.GetPreview())
 	} else {
-		resp2, err := frontendClient.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
-			Domain: domain,
-			WorkflowExecution: &types.WorkflowExecution{
-				WorkflowID: wid,
-				RunID:      resetBaseRunID,
-			},
-			DecisionFinishEventID: decisionFinishID,
-			RequestID:             uuid.New(),
-			Reason:                fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), params.reason),
-			SkipSignalReapply:     params.skipSignalReapply,
-		})
-
-		if err != nil {
-			return printErrorAndReturn("ResetWorkflowExecution failed", err)
-		}
 		fmt.Println("new runID for wid/rid is ,", wid, rid, resp2.GetRunID())
 	}
 
 	return nil
 }
 
+// validateResetDryRunSupported returns an error if the server would ignore the dry run flag and perform a real reset
+func validateResetDryRunSupported(ctx context.Context, frontendClient frontend.Client) error {
+	clusterInfo, err := frontendClient.GetClusterInfo(ctx)
+	if err != nil {
+		return err
+	}
+	serverCLIVersion := clusterInfo.GetSupportedClientVersions().GetCli()
+	if serverCLIVersion == "" {
+		return fmt.Errorf("server does not support dry run reset")
+	}
+	supportedVersion, err := version.NewVersion(serverCLIVersion)
+	if err != nil {
+		return err
+	}
+	if supportedVersion.LessThan(version.Must(version.NewVersion(cc.CLIResetDryRunVersion))) {
+		return fmt.Errorf("server does not support dry run reset, server cli version %v is lower than %v", serverCLIVersion, cc.CLIResetDryRunVersion)
+	}
+	return nil
+}
+
 func isLastEventDecisionTaskFailedWithNonDeterminism(ctx context.Context, domain, wid, rid string, frontendClient frontend.Client) (bool, error) {
 	req := &types.GetWorkflowExecutionHistoryRequest{
 		Domain: domain,
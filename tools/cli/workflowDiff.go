@@ -0,0 +1,554 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli"
+	s "go.uber.org/cadence/.gen/go/shared"
+)
+
+// Kinds of change reported for an event by DiffHistories
+const (
+	HistoryEventAdded   = "added"
+	HistoryEventRemoved = "removed"
+	HistoryEventChanged = "changed"
+)
+
+// Categories of history events, used to group the differences between two histories
+const (
+	historyCategoryActivity = "activity"
+	historyCategoryTimer    = "timer"
+	historyCategorySignal   = "signal"
+	historyCategoryChild    = "child"
+	historyCategoryMarker   = "marker"
+	historyCategoryDecision = "decision"
+	historyCategoryWorkflow = "workflow"
+)
+
+type (
+	// HistoryDiff is the difference between the histories of two workflow runs
+	HistoryDiff struct {
+		Left      HistoryDiffSource     `json:"left"`
+		Right     HistoryDiffSource     `json:"right"`
+		Decisions []HistoryDecisionDiff `json:"decisions"`
+		Summary   HistoryDiffSummary    `json:"summary"`
+	}
+
+	// HistoryDiffSource describes one of the compared histories
+	HistoryDiffSource struct {
+		WorkflowID string `json:"workflowId,omitempty"`
+		RunID      string `json:"runId,omitempty"`
+		File       string `json:"file,omitempty"`
+		Events     int    `json:"events"`
+		Decisions  int    `json:"decisions"`
+	}
+
+	// HistoryDecisionDiff holds the differing events between two decision boundaries. Decision N starts
+	// at the Nth DecisionTaskCompleted event of a history, decision 0 holds the events before it.
+	HistoryDecisionDiff struct {
+		Decision int                `json:"decision"`
+		Events   []HistoryEventDiff `json:"events"`
+	}
+
+	// HistoryEventDiff is an event added to, removed from or changed in the right history
+	HistoryEventDiff struct {
+		Change    string `json:"change"`
+		Category  string `json:"category"`
+		EventType string `json:"eventType"`
+		// Subject identifies the activity, timer, signal, marker or child workflow of the event
+		Subject      string             `json:"subject,omitempty"`
+		LeftEventID  int64              `json:"leftEventId,omitempty"`
+		RightEventID int64              `json:"rightEventId,omitempty"`
+		Fields       []HistoryFieldDiff `json:"fields,omitempty"`
+	}
+
+	// HistoryFieldDiff is an event attribute with a different value in each history.
+	// The value is nil on the side where the attribute or the event is missing.
+	HistoryFieldDiff struct {
+		Field string  `json:"field"`
+		Left  *string `json:"left"`
+		Right *string `json:"right"`
+	}
+
+	// HistoryDiffSummary counts the events of both histories by change
+	HistoryDiffSummary struct {
+		Unchanged int `json:"unchanged"`
+		Changed   int `json:"changed"`
+		Added     int `json:"added"`
+		Removed   int `json:"removed"`
+		// Categories counts the added, removed and changed events by category
+		Categories map[string]int `json:"categories,omitempty"`
+	}
+
+	diffEvent struct {
+		event      *s.HistoryEvent
+		key        string
+		category   string
+		subject    string
+		attributes map[string]string
+	}
+)
+
+// DiffWorkflow compares the histories of two workflow runs. Each history is read from a
+// file exported by `workflow show --output_filename`, or from the server. When no other run is
+// given, the run is compared with the run it continued from.
+func DiffWorkflow(c *cli.Context) {
+	wid := c.String(FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	file := c.String(FlagHistoryFile)
+	otherWid := c.String(FlagOtherWorkflowID)
+	otherRid := c.String(FlagOtherRunID)
+	otherFile := c.String(FlagOtherHistoryFile)
+	if wid == "" && file == "" {
+		ErrorAndExit(fmt.Sprintf("Option %s or %s is required.", FlagWorkflowID, FlagHistoryFile), nil)
+		return
+	}
+
+	left, leftSource := loadDiffHistory(c, wid, rid, file)
+	continuedFrom := false
+	if otherFile == "" {
+		if otherWid == "" {
+			otherWid = wid
+		}
+		if otherWid == "" {
+			ErrorAndExit(fmt.Sprintf("Option %s or %s is required.", FlagOtherWorkflowID, FlagOtherHistoryFile), nil)
+			return
+		}
+		if otherRid == "" && otherWid == wid {
+			events := left.GetEvents()
+			if len(events) > 0 {
+				otherRid = events[0].WorkflowExecutionStartedEventAttributes.GetContinuedExecutionRunId()
+			}
+			if otherRid == "" {
+				ErrorAndExit(fmt.Sprintf("Option %s is required, the run did not continue from another run.", FlagOtherRunID), nil)
+				return
+			}
+			continuedFrom = true
+		}
+	}
+	right, rightSource := loadDiffHistory(c, otherWid, otherRid, otherFile)
+	if continuedFrom {
+		// show what changed since the previous run
+		left, right = right, left
+		leftSource, rightSource = rightSource, leftSource
+	}
+
+	diff := DiffHistories(left, right)
+	diff.Left.WorkflowID, diff.Left.RunID, diff.Left.File = leftSource.WorkflowID, leftSource.RunID, leftSource.File
+	diff.Right.WorkflowID, diff.Right.RunID, diff.Right.File = rightSource.WorkflowID, rightSource.RunID, rightSource.File
+
+	if !newRenderer(c).isTable() {
+		renderResult(c, diff)
+		return
+	}
+	var maxFieldLength int
+	if c.IsSet(FlagMaxFieldLength) {
+		maxFieldLength = c.Int(FlagMaxFieldLength)
+	}
+	printHistoryDiff(diff, maxFieldLength)
+}
+
+func loadDiffHistory(c *cli.Context, wid, rid, file string) (*s.History, HistoryDiffSource) {
+	if file != "" {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			ErrorAndExit("Failed to read history file.", err)
+		}
+		history, err := (&JSONHistorySerializer{}).Deserialize(data)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to parse history file %s.", file), err)
+		}
+		return history, HistoryDiffSource{File: file}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	history, err := GetHistory(ctx, getWorkflowClient(c), wid, rid)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
+	}
+	return history, HistoryDiffSource{WorkflowID: wid, RunID: rid}
+}
+
+func printHistoryDiff(diff *HistoryDiff, maxFieldLength int) {
+	fmt.Println(colorRed("--- " + historyDiffSourceToString(diff.Left)))
+	fmt.Println(colorGreen("+++ " + historyDiffSourceToString(diff.Right)))
+	for _, decision := range diff.Decisions {
+		fmt.Println(color.CyanString("@@ decision %d @@", decision.Decision))
+		for _, e := range decision.Events {
+			title := e.EventType
+			if e.Subject != "" {
+				title += fmt.Sprintf(" %s %q", e.Category, e.Subject)
+			}
+			switch e.Change {
+			case HistoryEventRemoved:
+				fmt.Println(colorRed(fmt.Sprintf("- %s (event %d)", title, e.LeftEventID)))
+			case HistoryEventAdded:
+				fmt.Println(colorGreen(fmt.Sprintf("+ %s (event %d)", title, e.RightEventID)))
+			default:
+				fmt.Println(color.YellowString("~ %s (event %d -> %d)", title, e.LeftEventID, e.RightEventID))
+			}
+			for _, field := range e.Fields {
+				switch {
+				case field.Right == nil:
+					fmt.Printf("    %s: %s\n", field.Field, colorRed(formatHistoryDiffValue(field.Left, maxFieldLength)))
+				case field.Left == nil:
+					fmt.Printf("    %s: %s\n", field.Field, colorGreen(formatHistoryDiffValue(field.Right, maxFieldLength)))
+				default:
+					fmt.Printf("    %s: %s -> %s\n", field.Field,
+						colorRed(formatHistoryDiffValue(field.Left, maxFieldLength)),
+						colorGreen(formatHistoryDiffValue(field.Right, maxFieldLength)))
+				}
+			}
+		}
+	}
+	fmt.Printf("%d changed, %d removed, %d added, %d unchanged events\n",
+		diff.Summary.Changed, diff.Summary.Removed, diff.Summary.Added, diff.Summary.Unchanged)
+}
+
+func historyDiffSourceToString(source HistoryDiffSource) string {
+	name := source.File
+	if name == "" {
+		name = "workflow " + source.WorkflowID
+		if source.RunID != "" {
+			name += " run " + source.RunID
+		}
+	}
+	return fmt.Sprintf("%s (%d events, %d decisions)", name, source.Events, source.Decisions)
+}
+
+func formatHistoryDiffValue(value *string, maxFieldLength int) string {
+	v := *value
+	if maxFieldLength > 0 {
+		v = trimText(v, maxFieldLength)
+	}
+	return fmt.Sprintf("%q", v)
+}
+
+// DiffHistories compares the history of two workflow runs. Histories are first aligned by decision
+// boundaries, then the events of each decision are matched by event type and subject, so an event
+// that moved within a decision is reported as removed and added rather than as changed. Attributes
+// which differ between any two runs, like event IDs, run IDs, timestamps and identities, are ignored.
+func DiffHistories(left, right *s.History) *HistoryDiff {
+	leftDecisions := splitDecisions(newDiffEvents(left.GetEvents()))
+	rightDecisions := splitDecisions(newDiffEvents(right.GetEvents()))
+
+	result := &HistoryDiff{
+		Left:      HistoryDiffSource{Events: len(left.GetEvents()), Decisions: len(leftDecisions) - 1},
+		Right:     HistoryDiffSource{Events: len(right.GetEvents()), Decisions: len(rightDecisions) - 1},
+		Decisions: []HistoryDecisionDiff{},
+		Summary:   HistoryDiffSummary{Categories: map[string]int{}},
+	}
+	for i := 0; i < len(leftDecisions) || i < len(rightDecisions); i++ {
+		var leftEvents, rightEvents []*diffEvent
+		if i < len(leftDecisions) {
+			leftEvents = leftDecisions[i]
+		}
+		if i < len(rightDecisions) {
+			rightEvents = rightDecisions[i]
+		}
+		events := diffDecision(leftEvents, rightEvents, &result.Summary)
+		if len(events) > 0 {
+			result.Decisions = append(result.Decisions, HistoryDecisionDiff{Decision: i, Events: events})
+		}
+	}
+	return result
+}
+
+// diffDecision matches the events of a decision with their longest common subsequence of keys
+func diffDecision(left, right []*diffEvent, summary *HistoryDiffSummary) []HistoryEventDiff {
+	// lengths[i][j] is the length of the longest common subsequence of left[i:] and right[j:]
+	lengths := make([][]int, len(left)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(right)+1)
+	}
+	for i := len(left) - 1; i >= 0; i-- {
+		for j := len(right) - 1; j >= 0; j-- {
+			switch {
+			case left[i].key == right[j].key:
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var diffs []HistoryEventDiff
+	add := func(diff HistoryEventDiff) {
+		diffs = append(diffs, diff)
+		summary.Categories[diff.Category]++
+		switch diff.Change {
+		case HistoryEventAdded:
+			summary.Added++
+		case HistoryEventRemoved:
+			summary.Removed++
+		case HistoryEventChanged:
+			summary.Changed++
+		}
+	}
+	i, j := 0, 0
+	for i < len(left) || j < len(right) {
+		switch {
+		case i < len(left) && j < len(right) && left[i].key == right[j].key:
+			fields := diffAttributes(left[i].attributes, right[j].attributes)
+			if len(fields) == 0 {
+				summary.Unchanged++
+			} else {
+				add(newHistoryEventDiff(HistoryEventChanged, left[i], left[i], right[j], fields))
+			}
+			i++
+			j++
+		case j == len(right) || (i < len(left) && lengths[i+1][j] >= lengths[i][j+1]):
+			add(newHistoryEventDiff(HistoryEventRemoved, left[i], left[i], nil, diffAttributes(left[i].attributes, nil)))
+			i++
+		default:
+			add(newHistoryEventDiff(HistoryEventAdded, right[j], nil, right[j], diffAttributes(nil, right[j].attributes)))
+			j++
+		}
+	}
+	return diffs
+}
+
+func newHistoryEventDiff(change string, e, left, right *diffEvent, fields []HistoryFieldDiff) HistoryEventDiff {
+	diff := HistoryEventDiff{
+		Change:    change,
+		Category:  e.category,
+		EventType: e.event.GetEventType().String(),
+		Subject:   e.subject,
+		Fields:    fields,
+	}
+	if left != nil {
+		diff.LeftEventID = left.event.GetEventId()
+	}
+	if right != nil {
+		diff.RightEventID = right.event.GetEventId()
+	}
+	return diff
+}
+
+func diffAttributes(left, right map[string]string) []HistoryFieldDiff {
+	var fields []HistoryFieldDiff
+	for field, leftValue := range left {
+		leftValue := leftValue
+		rightValue, ok := right[field]
+		switch {
+		case !ok:
+			fields = append(fields, HistoryFieldDiff{Field: field, Left: &leftValue})
+		case leftValue != rightValue:
+			fields = append(fields, HistoryFieldDiff{Field: field, Left: &leftValue, Right: &rightValue})
+		}
+	}
+	for field, rightValue := range right {
+		rightValue := rightValue
+		if _, ok := left[field]; !ok {
+			fields = append(fields, HistoryFieldDiff{Field: field, Right: &rightValue})
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Field < fields[j].Field })
+	return fields
+}
+
+// splitDecisions splits events at each DecisionTaskCompleted event
+func splitDecisions(events []*diffEvent) [][]*diffEvent {
+	decisions := [][]*diffEvent{nil}
+	for _, e := range events {
+		if e.event.GetEventType() == s.EventTypeDecisionTaskCompleted {
+			decisions = append(decisions, nil)
+		}
+		decisions[len(decisions)-1] = append(decisions[len(decisions)-1], e)
+	}
+	return decisions
+}
+
+func newDiffEvents(events []*s.HistoryEvent) []*diffEvent {
+	// subjects of the events referred to by later events, by event ID
+	subjects := make(map[int64]string)
+	result := make([]*diffEvent, 0, len(events))
+	for _, e := range events {
+		subject := ""
+		switch e.GetEventType() {
+		case s.EventTypeActivityTaskScheduled:
+			subject = e.ActivityTaskScheduledEventAttributes.GetActivityId()
+		case s.EventTypeActivityTaskStarted:
+			subject = subjects[e.ActivityTaskStartedEventAttributes.GetScheduledEventId()]
+		case s.EventTypeActivityTaskCompleted:
+			subject = subjects[e.ActivityTaskCompletedEventAttributes.GetScheduledEventId()]
+		case s.EventTypeActivityTaskFailed:
+			subject = subjects[e.ActivityTaskFailedEventAttributes.GetScheduledEventId()]
+		case s.EventTypeActivityTaskTimedOut:
+			subject = subjects[e.ActivityTaskTimedOutEventAttributes.GetScheduledEventId()]
+		case s.EventTypeActivityTaskCanceled:
+			subject = subjects[e.ActivityTaskCanceledEventAttributes.GetScheduledEventId()]
+		case s.EventTypeActivityTaskCancelRequested:
+			subject = e.ActivityTaskCancelRequestedEventAttributes.GetActivityId()
+		case s.EventTypeRequestCancelActivityTaskFailed:
+			subject = e.RequestCancelActivityTaskFailedEventAttributes.GetActivityId()
+		case s.EventTypeTimerStarted:
+			subject = e.TimerStartedEventAttributes.GetTimerId()
+		case s.EventTypeTimerFired:
+			subject = e.TimerFiredEventAttributes.GetTimerId()
+		case s.EventTypeTimerCanceled:
+			subject = e.TimerCanceledEventAttributes.GetTimerId()
+		case s.EventTypeCancelTimerFailed:
+			subject = e.CancelTimerFailedEventAttributes.GetTimerId()
+		case s.EventTypeWorkflowExecutionSignaled:
+			subject = e.WorkflowExecutionSignaledEventAttributes.GetSignalName()
+		case s.EventTypeMarkerRecorded:
+			subject = e.MarkerRecordedEventAttributes.GetMarkerName()
+		case s.EventTypeSignalExternalWorkflowExecutionInitiated:
+			subject = e.SignalExternalWorkflowExecutionInitiatedEventAttributes.GetSignalName()
+		case s.EventTypeSignalExternalWorkflowExecutionFailed:
+			subject = subjects[e.SignalExternalWorkflowExecutionFailedEventAttributes.GetInitiatedEventId()]
+		case s.EventTypeExternalWorkflowExecutionSignaled:
+			subject = subjects[e.ExternalWorkflowExecutionSignaledEventAttributes.GetInitiatedEventId()]
+		case s.EventTypeRequestCancelExternalWorkflowExecutionInitiated:
+			subject = e.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.GetWorkflowExecution().GetWorkflowId()
+		case s.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+			subject = subjects[e.RequestCancelExternalWorkflowExecutionFailedEventAttributes.GetInitiatedEventId()]
+		case s.EventTypeExternalWorkflowExecutionCancelRequested:
+			subject = subjects[e.ExternalWorkflowExecutionCancelRequestedEventAttributes.GetInitiatedEventId()]
+		case s.EventTypeStartChildWorkflowExecutionInitiated:
+			subject = e.StartChildWorkflowExecutionInitiatedEventAttributes.GetWorkflowType().GetName()
+		case s.EventTypeStartChildWorkflowExecutionFailed:
+			subject = subjects[e.StartChildWorkflowExecutionFailedEventAttributes.GetInitiatedEventId()]
+		case s.EventTypeChildWorkflowExecutionStarted:
+			subject = subjects[e.ChildWorkflowExecutionStartedEventAttributes.GetInitiatedEventId()]
+		case s.EventTypeChildWorkflowExecutionCompleted:
+			subject = subjects[e.ChildWorkflowExecutionCompletedEventAttributes.GetInitiatedEventId()]
+		case s.EventTypeChildWorkflowExecutionFailed:
+			subject = subjects[e.ChildWorkflowExecutionFailedEventAttributes.GetInitiatedEventId()]
+		case s.EventTypeChildWorkflowExecutionCanceled:
+			subject = subjects[e.ChildWorkflowExecutionCanceledEventAttributes.GetInitiatedEventId()]
+		case s.EventTypeChildWorkflowExecutionTimedOut:
+			subject = subjects[e.ChildWorkflowExecutionTimedOutEventAttributes.GetInitiatedEventId()]
+		case s.EventTypeChildWorkflowExecutionTerminated:
+			subject = subjects[e.ChildWorkflowExecutionTerminatedEventAttributes.GetInitiatedEventId()]
+		}
+		subjects[e.GetEventId()] = subject
+
+		attributes := make(map[string]string)
+		flattenAttributes("", reflect.ValueOf(getEventAttributes(e)), attributes)
+		result = append(result, &diffEvent{
+			event:      e,
+			key:        e.GetEventType().String() + "/" + subject,
+			category:   historyEventCategory(e.GetEventType()),
+			subject:    subject,
+			attributes: attributes,
+		})
+	}
+	return result
+}
+
+func historyEventCategory(eventType s.EventType) string {
+	name := eventType.String()
+	switch {
+	case strings.Contains(name, "ActivityTask"):
+		return historyCategoryActivity
+	case strings.Contains(name, "Timer"):
+		return historyCategoryTimer
+	case strings.Contains(name, "Signal"):
+		return historyCategorySignal
+	case strings.Contains(name, "ChildWorkflow"):
+		return historyCategoryChild
+	case strings.HasPrefix(name, "Marker"):
+		return historyCategoryMarker
+	case strings.HasPrefix(name, "DecisionTask"):
+		return historyCategoryDecision
+	default:
+		return historyCategoryWorkflow
+	}
+}
+
+// flattenAttributes writes the attributes of an event to out, keyed by their JSON path
+func flattenAttributes(path string, v reflect.Value, out map[string]string) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		if stringer, ok := v.Interface().(fmt.Stringer); ok && v.Elem().Kind() != reflect.Struct {
+			// thrift enums
+			out[path] = stringer.String()
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "" || name == "-" {
+				name = field.Name
+			}
+			if isVolatileAttribute(name) {
+				continue
+			}
+			flattenAttributes(joinAttributePath(path, name), v.Field(i), out)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if !v.IsNil() {
+				out[path] = string(v.Bytes())
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			flattenAttributes(fmt.Sprintf("%s[%d]", path, i), v.Index(i), out)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			flattenAttributes(joinAttributePath(path, fmt.Sprintf("%v", iter.Key().Interface())), iter.Value(), out)
+		}
+	default:
+		out[path] = fmt.Sprintf("%v", v.Interface())
+	}
+}
+
+func joinAttributePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// isVolatileAttribute returns true for attributes which differ between any two runs
+func isVolatileAttribute(name string) bool {
+	switch name {
+	case "identity", "requestId", "runId", "prevAutoResetPoints":
+		return true
+	}
+	return strings.HasSuffix(name, "EventId") ||
+		strings.HasSuffix(name, "RunId") ||
+		strings.HasSuffix(name, "Timestamp")
+}
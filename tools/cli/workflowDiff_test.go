@@ -0,0 +1,277 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/cadence/.gen/go/shared"
+
+	"github.com/uber/cadence/common"
+)
+
+type diffHistoryBuilder struct {
+	identity string
+	events   []*shared.HistoryEvent
+}
+
+func (b *diffHistoryBuilder) add(eventType shared.EventType, setAttributes func(e *shared.HistoryEvent)) int64 {
+	e := &shared.HistoryEvent{
+		EventId:   common.Int64Ptr(int64(len(b.events) + 1)),
+		EventType: eventType.Ptr(),
+		Timestamp: common.Int64Ptr(int64(len(b.events))),
+	}
+	setAttributes(e)
+	b.events = append(b.events, e)
+	return e.GetEventId()
+}
+
+func (b *diffHistoryBuilder) decision() {
+	scheduledID := b.add(shared.EventTypeDecisionTaskScheduled, func(e *shared.HistoryEvent) {
+		e.DecisionTaskScheduledEventAttributes = &shared.DecisionTaskScheduledEventAttributes{}
+	})
+	startedID := b.add(shared.EventTypeDecisionTaskStarted, func(e *shared.HistoryEvent) {
+		e.DecisionTaskStartedEventAttributes = &shared.DecisionTaskStartedEventAttributes{
+			ScheduledEventId: common.Int64Ptr(scheduledID),
+			Identity:         common.StringPtr(b.identity),
+		}
+	})
+	b.add(shared.EventTypeDecisionTaskCompleted, func(e *shared.HistoryEvent) {
+		e.DecisionTaskCompletedEventAttributes = &shared.DecisionTaskCompletedEventAttributes{
+			ScheduledEventId: common.Int64Ptr(scheduledID),
+			StartedEventId:   common.Int64Ptr(startedID),
+		}
+	})
+}
+
+func (b *diffHistoryBuilder) activity(activityID, input, result string) {
+	scheduledID := b.add(shared.EventTypeActivityTaskScheduled, func(e *shared.HistoryEvent) {
+		e.ActivityTaskScheduledEventAttributes = &shared.ActivityTaskScheduledEventAttributes{
+			ActivityId:   common.StringPtr(activityID),
+			ActivityType: &shared.ActivityType{Name: common.StringPtr("activity")},
+			Input:        []byte(input),
+		}
+	})
+	b.add(shared.EventTypeActivityTaskCompleted, func(e *shared.HistoryEvent) {
+		e.ActivityTaskCompletedEventAttributes = &shared.ActivityTaskCompletedEventAttributes{
+			ScheduledEventId: common.Int64Ptr(scheduledID),
+			Result:           []byte(result),
+		}
+	})
+}
+
+func (b *diffHistoryBuilder) history() *shared.History {
+	return &shared.History{Events: b.events}
+}
+
+func newDiffHistoryBuilder(runID string) *diffHistoryBuilder {
+	// identities differ between runs and are ignored by the diff
+	b := &diffHistoryBuilder{identity: "worker-" + runID}
+	b.add(shared.EventTypeWorkflowExecutionStarted, func(e *shared.HistoryEvent) {
+		e.WorkflowExecutionStartedEventAttributes = &shared.WorkflowExecutionStartedEventAttributes{
+			WorkflowType:            &shared.WorkflowType{Name: common.StringPtr("workflow")},
+			ContinuedExecutionRunId: common.StringPtr(runID),
+		}
+	})
+	return b
+}
+
+func TestDiffHistories(t *testing.T) {
+	left := newDiffHistoryBuilder("run-a")
+	left.decision()
+	left.activity("1", "input", "result")
+	left.add(shared.EventTypeTimerStarted, func(e *shared.HistoryEvent) {
+		e.TimerStartedEventAttributes = &shared.TimerStartedEventAttributes{
+			TimerId:                   common.StringPtr("timer"),
+			StartToFireTimeoutSeconds: common.Int64Ptr(10),
+		}
+	})
+	left.decision()
+
+	right := newDiffHistoryBuilder("run-b")
+	right.decision()
+	right.activity("1", "input", "other result")
+	right.add(shared.EventTypeWorkflowExecutionSignaled, func(e *shared.HistoryEvent) {
+		e.WorkflowExecutionSignaledEventAttributes = &shared.WorkflowExecutionSignaledEventAttributes{
+			SignalName: common.StringPtr("signal"),
+			Input:      []byte("payload"),
+		}
+	})
+	right.decision()
+	right.activity("2", "input", "result")
+
+	diff := DiffHistories(left.history(), right.history())
+	assert.Equal(t, 2, diff.Left.Decisions)
+	assert.Equal(t, 2, diff.Right.Decisions)
+	assert.Equal(t, HistoryDiffSummary{
+		Unchanged: 8,
+		Changed:   1,
+		Added:     3,
+		Removed:   1,
+		Categories: map[string]int{
+			historyCategoryActivity: 3,
+			historyCategoryTimer:    1,
+			historyCategorySignal:   1,
+		},
+	}, diff.Summary)
+
+	require.Len(t, diff.Decisions, 2)
+	assert.Equal(t, 1, diff.Decisions[0].Decision)
+	events := diff.Decisions[0].Events
+	require.Len(t, events, 3)
+
+	assert.Equal(t, HistoryEventChanged, events[0].Change)
+	assert.Equal(t, "ActivityTaskCompleted", events[0].EventType)
+	assert.Equal(t, "1", events[0].Subject)
+	assert.Equal(t, int64(6), events[0].LeftEventID)
+	assert.Equal(t, int64(6), events[0].RightEventID)
+	require.Len(t, events[0].Fields, 1)
+	assert.Equal(t, "result", events[0].Fields[0].Field)
+	assert.Equal(t, "result", *events[0].Fields[0].Left)
+	assert.Equal(t, "other result", *events[0].Fields[0].Right)
+
+	assert.Equal(t, HistoryEventRemoved, events[1].Change)
+	assert.Equal(t, historyCategoryTimer, events[1].Category)
+	assert.Equal(t, "timer", events[1].Subject)
+	assert.Equal(t, int64(7), events[1].LeftEventID)
+	assert.Zero(t, events[1].RightEventID)
+
+	assert.Equal(t, HistoryEventAdded, events[2].Change)
+	assert.Equal(t, "signal", events[2].Subject)
+	require.Len(t, events[2].Fields, 2)
+	assert.Equal(t, "input", events[2].Fields[0].Field)
+	assert.Nil(t, events[2].Fields[0].Left)
+	assert.Equal(t, "payload", *events[2].Fields[0].Right)
+
+	assert.Equal(t, 2, diff.Decisions[1].Decision)
+	require.Len(t, diff.Decisions[1].Events, 2)
+	assert.Equal(t, HistoryEventAdded, diff.Decisions[1].Events[0].Change)
+	assert.Equal(t, "ActivityTaskScheduled", diff.Decisions[1].Events[0].EventType)
+	assert.Equal(t, "2", diff.Decisions[1].Events[0].Subject)
+}
+
+func TestDiffHistories_Identical(t *testing.T) {
+	left := newDiffHistoryBuilder("run-a")
+	left.decision()
+	left.activity("1", "input", "result")
+	right := newDiffHistoryBuilder("run-b")
+	right.decision()
+	right.activity("1", "input", "result")
+
+	diff := DiffHistories(left.history(), right.history())
+	assert.Empty(t, diff.Decisions)
+	assert.Equal(t, 6, diff.Summary.Unchanged)
+}
+
+func writeDiffHistory(t *testing.T, dir, name string, history *shared.History) string {
+	data, err := (&JSONHistorySerializer{}).Serialize(history)
+	require.NoError(t, err)
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, data, 0644))
+	return path
+}
+
+func (s *cliAppSuite) TestDiffWorkflow_Files() {
+	dir, err := ioutil.TempDir("", "workflow-diff")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	left := newDiffHistoryBuilder("")
+	left.decision()
+	left.activity("1", "input", "result")
+	right := newDiffHistoryBuilder("")
+	right.decision()
+	right.activity("1", "other input", "result")
+	leftPath := writeDiffHistory(s.T(), dir, "left.json", left.history())
+	rightPath := writeDiffHistory(s.T(), dir, "right.json", right.history())
+
+	for _, format := range []string{"table", "json"} {
+		err = s.app.Run([]string{"", "--do", domainName, "--format", format, "workflow", "diff",
+			"--history_file", leftPath, "--other_history_file", rightPath})
+		s.NoError(err)
+	}
+}
+
+func (s *cliAppSuite) TestDiffWorkflow_Server() {
+	s.clientFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any(), callOptions...).Return(getWorkflowExecutionHistoryResponse, nil).Times(2)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "diff", "-w", "wid", "-r", "rid", "--orid", "other-rid"})
+	s.NoError(err)
+}
+
+func (s *cliAppSuite) TestDiffWorkflow_ContinuedFrom() {
+	previous := newDiffHistoryBuilder("")
+	previous.decision()
+	current := newDiffHistoryBuilder("previous-rid")
+	current.decision()
+	current.activity("1", "input", "result")
+	histories := map[string]*shared.History{"rid": current.history(), "previous-rid": previous.history()}
+	s.clientFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any(), callOptions...).DoAndReturn(
+		func(_ interface{}, request *shared.GetWorkflowExecutionHistoryRequest, _ ...interface{}) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+			return &shared.GetWorkflowExecutionHistoryResponse{History: histories[request.GetExecution().GetRunId()]}, nil
+		}).Times(2)
+
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	s.NoError(err)
+	os.Stdout = w
+	err = s.app.Run([]string{"", "--do", domainName, "--format", "json", "workflow", "diff", "-w", "wid", "-r", "rid"})
+	os.Stdout = stdout
+	s.NoError(err)
+	s.NoError(w.Close())
+	output, err := ioutil.ReadAll(r)
+	s.NoError(err)
+
+	var diff HistoryDiff
+	s.NoError(json.Unmarshal(output, &diff))
+	// the previous run is on the left, so the activity of the current run shows as added
+	s.Equal("previous-rid", diff.Left.RunID)
+	s.Equal("rid", diff.Right.RunID)
+	s.Equal(2, diff.Summary.Added)
+	s.Equal(0, diff.Summary.Removed)
+}
+
+func (s *cliAppSuite) TestDiffWorkflow_NoOtherRun() {
+	s.clientFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any(), callOptions...).Return(getWorkflowExecutionHistoryResponse, nil)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "diff", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func TestHistoryDiff_JSON(t *testing.T) {
+	left := newDiffHistoryBuilder("")
+	left.activity("1", "input", "result")
+	right := newDiffHistoryBuilder("")
+
+	data, err := json.Marshal(DiffHistories(left.history(), right.history()).Decisions)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"decision":0,"events":[
+		{"change":"removed","category":"activity","eventType":"ActivityTaskScheduled","subject":"1","leftEventId":2,
+			"fields":[{"field":"activityId","left":"1","right":null},{"field":"activityType.name","left":"activity","right":null},{"field":"input","left":"input","right":null}]},
+		{"change":"removed","category":"activity","eventType":"ActivityTaskCompleted","subject":"1","leftEventId":3,
+			"fields":[{"field":"result","left":"result","right":null}]}
+	]}]`, string(data))
+}
@@ -22,6 +22,7 @@ package schema
 
 import (
 	"fmt"
+	"log"
 
 	"github.com/urfave/cli"
 )
@@ -76,6 +77,28 @@ func Update(cli *cli.Context, db SchemaClient) error {
 	return newUpdateSchemaTask(db, cfg).Run()
 }
 
+// Verify compares the schema of the specified database with the versioned schema definitions
+// and returns an error when they differ. The versioned schema is applied to expected, which
+// must be an empty database.
+func Verify(cli *cli.Context, db SchemaClient, expected SchemaClient) error {
+	cfg, err := newVerifyConfig(cli)
+	if err != nil {
+		return err
+	}
+	drifts, err := newVerifySchemaTask(db, expected, cfg).Run()
+	if err != nil {
+		return err
+	}
+	for _, drift := range drifts {
+		log.Println(drift)
+	}
+	if len(drifts) > 0 {
+		return fmt.Errorf("schema drift detected, found %v differences with the versioned schema", len(drifts))
+	}
+	log.Println("Schema matches the versioned schema")
+	return nil
+}
+
 func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
@@ -88,6 +111,17 @@ func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	return config, nil
 }
 
+func newVerifyConfig(cli *cli.Context) (*VerifyConfig, error) {
+	config := new(VerifyConfig)
+	config.SchemaDir = cli.String(CLIOptSchemaDir)
+	config.TargetVersion = cli.String(CLIOptTargetVersion)
+
+	if err := validateVerifyConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 func newSetupConfig(cli *cli.Context) (*SetupConfig, error) {
 	config := new(SetupConfig)
 	config.SchemaFilePath = cli.String(CLIOptSchemaFile)
@@ -134,6 +168,20 @@ func validateUpdateConfig(config *UpdateConfig) error {
 	return nil
 }
 
+func validateVerifyConfig(config *VerifyConfig) error {
+	if len(config.SchemaDir) == 0 {
+		return NewConfigError("missing " + flag(CLIOptSchemaDir) + " argument ")
+	}
+	if len(config.TargetVersion) > 0 {
+		ver, err := parseValidateVersion(config.TargetVersion)
+		if err != nil {
+			return NewConfigError("invalid " + flag(CLIOptTargetVersion) + " argument:" + err.Error())
+		}
+		config.TargetVersion = ver
+	}
+	return nil
+}
+
 func flag(opt string) string {
 	return "(-" + opt + ")"
 }
@@ -47,6 +47,40 @@ type (
 		Overwrite         bool // overwrite previous data
 		DisableVersioning bool // do not use schema versioning
 	}
+	// VerifyConfig holds the config
+	// params for executing a VerifyTask
+	VerifyConfig struct {
+		SchemaDir string
+		// TargetVersion is the version the schema is expected to be at,
+		// defaults to the version recorded in the keyspace/database
+		TargetVersion string
+	}
+	// DatabaseSchema describes the tables and user defined types of a keyspace/database
+	DatabaseSchema struct {
+		Tables map[string]*TableSchema
+		// Types holds the user defined types of cassandra keyspaces
+		Types map[string]*TableSchema
+	}
+	// TableSchema describes the columns and indexes of a table, or the fields of a type.
+	// Columns and indexes are described by a database specific definition, e.g. the column
+	// type with its nullability or key kind, and the indexed columns of an index.
+	TableSchema struct {
+		Columns map[string]string
+		Indexes map[string]string
+	}
+	// SchemaDrift is a difference between the expected and the actual schema,
+	// Expected or Actual is empty when the object is missing or unexpected
+	SchemaDrift struct {
+		Object   string
+		Expected string
+		Actual   string
+	}
+	// SchemaDescriber is implemented by schema clients
+	// that can describe their keyspace/database
+	SchemaDescriber interface {
+		// DescribeSchema returns the tables, types and indexes of the keyspace/database
+		DescribeSchema() (*DatabaseSchema, error)
+	}
 	// SchemaClient is the database interface that's required to be implemented
 	// for the schema-tool to work
 	SchemaClient interface {
@@ -106,6 +140,8 @@ const (
 	CLIOptQuiet = "quiet"
 	// CLIOptProtoVersion is the cli option for protocol version
 	CLIOptProtoVersion = "protocol-version"
+	// CLIOptScratchName is the cli option for the scratch keyspace/database used by verify
+	CLIOptScratchName = "scratch-name"
 
 	// CLIFlagEndpoint is the cli flag for endpoint
 	CLIFlagEndpoint = CLIOptEndpoint + ", ep"
@@ -145,6 +181,8 @@ const (
 	CLIFlagQuiet = CLIOptQuiet + ", q"
 	// CLIFlagProtoVersion is the cli flag for protocol version
 	CLIFlagProtoVersion = CLIOptProtoVersion + ", pv"
+	// CLIFlagScratchName is the cli flag for the scratch keyspace/database used by verify
+	CLIFlagScratchName = CLIOptScratchName
 
 	// CLIFlagEnableTLS enables cassandra client TLS
 	CLIFlagEnableTLS = "tls"
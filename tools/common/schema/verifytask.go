@@ -0,0 +1,175 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"log"
+	"sort"
+)
+
+// VerifyTask represents a task that compares the
+// schema of a keyspace/database with the schema
+// built from the versioned schema definitions
+type VerifyTask struct {
+	db SchemaClient
+	// expected is an empty keyspace/database the versioned schema is applied to
+	expected SchemaClient
+	config   *VerifyConfig
+}
+
+func newVerifySchemaTask(db SchemaClient, expected SchemaClient, config *VerifyConfig) *VerifyTask {
+	return &VerifyTask{
+		db:       db,
+		expected: expected,
+		config:   config,
+	}
+}
+
+// Run executes the task and returns the differences
+// between the expected and the actual schema
+func (task *VerifyTask) Run() ([]SchemaDrift, error) {
+	config := task.config
+	log.Printf("Starting schema verification, config=%+v\n", config)
+
+	version := config.TargetVersion
+	if len(version) == 0 {
+		var err error
+		if version, err = task.db.ReadSchemaVersion(); err != nil {
+			return nil, fmt.Errorf("error reading current schema version:%v", err.Error())
+		}
+	}
+
+	log.Printf("Building expected schema version %v\n", version)
+	if err := newSetupSchemaTask(task.expected, &SetupConfig{InitialVersion: "0.0"}).Run(); err != nil {
+		return nil, fmt.Errorf("error setting up expected schema:%v", err.Error())
+	}
+	if err := newUpdateSchemaTask(task.expected, &UpdateConfig{
+		SchemaDir:     config.SchemaDir,
+		TargetVersion: version,
+	}).Run(); err != nil {
+		return nil, fmt.Errorf("error building expected schema:%v", err.Error())
+	}
+
+	expected, err := describeSchema(task.expected)
+	if err != nil {
+		return nil, fmt.Errorf("error describing expected schema:%v", err.Error())
+	}
+	actual, err := describeSchema(task.db)
+	if err != nil {
+		return nil, fmt.Errorf("error describing schema:%v", err.Error())
+	}
+	drifts := diffSchemas(expected, actual)
+	log.Printf("Schema verification done, found %v differences\n", len(drifts))
+	return drifts, nil
+}
+
+func describeSchema(db SchemaClient) (*DatabaseSchema, error) {
+	describer, ok := db.(SchemaDescriber)
+	if !ok {
+		return nil, fmt.Errorf("schema client %T cannot describe its schema", db)
+	}
+	return describer.DescribeSchema()
+}
+
+// diffSchemas returns the differences between two schemas, sorted by object
+func diffSchemas(expected *DatabaseSchema, actual *DatabaseSchema) []SchemaDrift {
+	var drifts []SchemaDrift
+	drifts = append(drifts, diffTables("type", expected.Types, actual.Types)...)
+	drifts = append(drifts, diffTables("table", expected.Tables, actual.Tables)...)
+	return drifts
+}
+
+func diffTables(kind string, expected map[string]*TableSchema, actual map[string]*TableSchema) []SchemaDrift {
+	var drifts []SchemaDrift
+	for _, name := range sortedTableNames(expected, actual) {
+		expectedTable, actualTable := expected[name], actual[name]
+		switch {
+		case actualTable == nil:
+			drifts = append(drifts, SchemaDrift{
+				Object:   kind + " " + name,
+				Expected: fmt.Sprintf("%v columns", len(expectedTable.Columns)),
+			})
+		case expectedTable == nil:
+			drifts = append(drifts, SchemaDrift{
+				Object: kind + " " + name,
+				Actual: fmt.Sprintf("%v columns", len(actualTable.Columns)),
+			})
+		default:
+			drifts = append(drifts, diffDefinitions("column "+name+".", expectedTable.Columns, actualTable.Columns)...)
+			drifts = append(drifts, diffDefinitions("index "+name+".", expectedTable.Indexes, actualTable.Indexes)...)
+		}
+	}
+	return drifts
+}
+
+func diffDefinitions(prefix string, expected map[string]string, actual map[string]string) []SchemaDrift {
+	names := make(map[string]struct{}, len(expected)+len(actual))
+	for name := range expected {
+		names[name] = struct{}{}
+	}
+	for name := range actual {
+		names[name] = struct{}{}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	var drifts []SchemaDrift
+	for _, name := range sorted {
+		if expected[name] != actual[name] {
+			drifts = append(drifts, SchemaDrift{
+				Object:   prefix + name,
+				Expected: expected[name],
+				Actual:   actual[name],
+			})
+		}
+	}
+	return drifts
+}
+
+func sortedTableNames(expected map[string]*TableSchema, actual map[string]*TableSchema) []string {
+	names := make([]string, 0, len(expected)+len(actual))
+	for name := range expected {
+		names = append(names, name)
+	}
+	for name := range actual {
+		if _, ok := expected[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// String returns a human readable description of the drift
+func (d SchemaDrift) String() string {
+	switch {
+	case len(d.Actual) == 0:
+		return fmt.Sprintf("missing %v, expected %v", d.Object, d.Expected)
+	case len(d.Expected) == 0:
+		return fmt.Sprintf("unexpected %v: %v", d.Object, d.Actual)
+	default:
+		return fmt.Sprintf("changed %v, expected %v, actual %v", d.Object, d.Expected, d.Actual)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	VerifyTaskTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+
+	// fakeSchemaClient keeps the tables created by CREATE TABLE and ALTER TABLE ADD statements in memory
+	fakeSchemaClient struct {
+		version string
+		schema  *DatabaseSchema
+	}
+)
+
+var (
+	fakeCreateTableRegex = regexp.MustCompile(`(?s)^CREATE TABLE (\w+)\s*\((.*)\);?$`)
+	fakeAlterTableRegex  = regexp.MustCompile(`^ALTER TABLE (\w+) ADD (\w+) (.+?);?$`)
+)
+
+func TestVerifyTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(VerifyTaskTestSuite))
+}
+
+func (s *VerifyTaskTestSuite) SetupSuite() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *VerifyTaskTestSuite) TestDiffSchemas() {
+	expected := &DatabaseSchema{
+		Tables: map[string]*TableSchema{
+			"domains": {
+				Columns: map[string]string{"id": "int NOT NULL", "name": "varchar(255)"},
+				Indexes: map[string]string{"PRIMARY": "UNIQUE (id)"},
+			},
+			"shards": {Columns: map[string]string{"id": "int"}},
+		},
+		Types: map[string]*TableSchema{
+			"domain_config": {Columns: map[string]string{"retention": "int"}},
+		},
+	}
+	actual := &DatabaseSchema{
+		Tables: map[string]*TableSchema{
+			"domains": {
+				Columns: map[string]string{"id": "bigint NOT NULL", "owner": "text"},
+				Indexes: map[string]string{"PRIMARY": "UNIQUE (id)", "by_owner": "(owner)"},
+			},
+			"hotfix": {Columns: map[string]string{"id": "int"}},
+		},
+		Types: map[string]*TableSchema{
+			"domain_config": {Columns: map[string]string{"retention": "int"}},
+		},
+	}
+
+	s.Equal([]SchemaDrift{
+		{Object: "column domains.id", Expected: "int NOT NULL", Actual: "bigint NOT NULL"},
+		{Object: "column domains.name", Expected: "varchar(255)"},
+		{Object: "column domains.owner", Actual: "text"},
+		{Object: "index domains.by_owner", Actual: "(owner)"},
+		{Object: "table hotfix", Actual: "1 columns"},
+		{Object: "table shards", Expected: "1 columns"},
+	}, diffSchemas(expected, actual))
+	s.Empty(diffSchemas(expected, expected))
+
+	s.Equal("missing table shards, expected 1 columns", SchemaDrift{Object: "table shards", Expected: "1 columns"}.String())
+	s.Equal("unexpected table hotfix: 1 columns", SchemaDrift{Object: "table hotfix", Actual: "1 columns"}.String())
+	s.Equal("changed column domains.id, expected int, actual bigint",
+		SchemaDrift{Object: "column domains.id", Expected: "int", Actual: "bigint"}.String())
+}
+
+func (s *VerifyTaskTestSuite) TestRun() {
+	dir, err := ioutil.TempDir("", "verify_schema_test")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	s.writeVersion(dir, "1.0", "CREATE TABLE domains (id int, name text);")
+	s.writeVersion(dir, "2.0", "ALTER TABLE domains ADD owner text;")
+	s.writeVersion(dir, "3.0", "CREATE TABLE shards (id int);")
+
+	db := newFakeSchemaClient()
+	s.NoError(db.ExecDDLQuery("CREATE TABLE domains (id int, name text)"))
+	s.NoError(db.ExecDDLQuery("ALTER TABLE domains ADD owner text"))
+	db.version = "2.0"
+
+	drifts, err := newVerifySchemaTask(db, newFakeSchemaClient(), &VerifyConfig{SchemaDir: dir}).Run()
+	s.NoError(err)
+	s.Empty(drifts)
+
+	// a hand applied hotfix
+	s.NoError(db.ExecDDLQuery("ALTER TABLE domains ADD hotfix text"))
+	drifts, err = newVerifySchemaTask(db, newFakeSchemaClient(), &VerifyConfig{SchemaDir: dir}).Run()
+	s.NoError(err)
+	s.Equal([]SchemaDrift{{Object: "column domains.hotfix", Actual: "text"}}, drifts)
+
+	// a partially applied version
+	drifts, err = newVerifySchemaTask(db, newFakeSchemaClient(), &VerifyConfig{SchemaDir: dir, TargetVersion: "3.0"}).Run()
+	s.NoError(err)
+	s.Equal([]SchemaDrift{
+		{Object: "column domains.hotfix", Actual: "text"},
+		{Object: "table shards", Expected: "1 columns"},
+	}, drifts)
+}
+
+func (s *VerifyTaskTestSuite) writeVersion(dir string, version string, stmt string) {
+	versionDir := filepath.Join(dir, "v"+version)
+	s.NoError(os.Mkdir(versionDir, os.FileMode(0700)))
+	manifest := `{
+		"CurrVersion": "` + version + `",
+		"MinCompatibleVersion": "1.0",
+		"Description": "v` + version + ` of schema",
+		"SchemaUpdateCqlFiles": ["schema.cql"]
+	}`
+	s.NoError(ioutil.WriteFile(filepath.Join(versionDir, "manifest.json"), []byte(manifest), os.FileMode(0600)))
+	s.NoError(ioutil.WriteFile(filepath.Join(versionDir, "schema.cql"), []byte(stmt), os.FileMode(0600)))
+}
+
+func newFakeSchemaClient() *fakeSchemaClient {
+	return &fakeSchemaClient{schema: &DatabaseSchema{Tables: map[string]*TableSchema{}}}
+}
+
+func (c *fakeSchemaClient) ExecDDLQuery(stmt string, args ...interface{}) error {
+	stmt = strings.TrimSpace(stmt)
+	if m := fakeCreateTableRegex.FindStringSubmatch(stmt); m != nil {
+		table := &TableSchema{Columns: map[string]string{}}
+		for _, column := range strings.Split(m[2], ",") {
+			parts := strings.Fields(column)
+			table.Columns[parts[0]] = strings.Join(parts[1:], " ")
+		}
+		c.schema.Tables[m[1]] = table
+		return nil
+	}
+	if m := fakeAlterTableRegex.FindStringSubmatch(stmt); m != nil {
+		c.schema.Tables[m[1]].Columns[m[2]] = m[3]
+	}
+	return nil
+}
+
+func (c *fakeSchemaClient) DescribeSchema() (*DatabaseSchema, error) {
+	return c.schema, nil
+}
+
+func (c *fakeSchemaClient) DropAllTables() error {
+	c.schema.Tables = map[string]*TableSchema{}
+	return nil
+}
+
+func (c *fakeSchemaClient) CreateSchemaVersionTables() error {
+	return nil
+}
+
+func (c *fakeSchemaClient) ReadSchemaVersion() (string, error) {
+	return c.version, nil
+}
+
+func (c *fakeSchemaClient) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	c.version = newVersion
+	return nil
+}
+
+func (c *fakeSchemaClient) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	return nil
+}
+
+func (c *fakeSchemaClient) Close() {}
@@ -22,6 +22,8 @@ package sql
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/persistence/sql"
@@ -38,6 +40,7 @@ type (
 )
 
 var _ schema.SchemaClient = (*Connection)(nil)
+var _ schema.SchemaDescriber = (*Connection)(nil)
 
 // NewConnection creates a new connection to database
 func NewConnection(cfg *config.SQL) (*Connection, error) {
@@ -84,6 +87,58 @@ func (c *Connection) ListTables() ([]string, error) {
 	return c.adminDb.ListTables(c.dbName)
 }
 
+// DescribeSchema returns the tables and indexes of this database. Columns are described
+// by their type and nullability, indexes by their uniqueness and indexed columns.
+func (c *Connection) DescribeSchema() (*schema.DatabaseSchema, error) {
+	columns, err := c.adminDb.ListColumns(c.dbName)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := c.adminDb.ListIndexes(c.dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &schema.DatabaseSchema{Tables: make(map[string]*schema.TableSchema)}
+	getTable := func(name string) *schema.TableSchema {
+		table, ok := result.Tables[name]
+		if !ok {
+			table = &schema.TableSchema{Columns: make(map[string]string), Indexes: make(map[string]string)}
+			result.Tables[name] = table
+		}
+		return table
+	}
+	for _, column := range columns {
+		definition := column.DataType
+		if !column.IsNullable {
+			definition += " NOT NULL"
+		}
+		getTable(column.TableName).Columns[column.ColumnName] = definition
+	}
+
+	// index rows are ordered by position, one row per indexed column
+	indexColumns := make(map[string][]string)
+	var indexKeys []string
+	unique := make(map[string]bool)
+	for _, index := range indexes {
+		key := index.TableName + "." + index.IndexName
+		if _, ok := indexColumns[key]; !ok {
+			indexKeys = append(indexKeys, key)
+		}
+		indexColumns[key] = append(indexColumns[key], index.ColumnName)
+		unique[key] = index.IsUnique
+	}
+	for _, key := range indexKeys {
+		parts := strings.SplitN(key, ".", 2)
+		definition := fmt.Sprintf("(%v)", strings.Join(indexColumns[key], ", "))
+		if unique[key] {
+			definition = "UNIQUE " + definition
+		}
+		getTable(parts[0]).Indexes[parts[1]] = definition
+	}
+	return result, nil
+}
+
 // DropTable drops a given table from the database
 func (c *Connection) DropTable(name string) error {
 	return c.adminDb.DropTable(name)
@@ -24,7 +24,9 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"strings"
 
+	"github.com/pborman/uuid"
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/common/config"
@@ -156,6 +158,49 @@ func updateSchema(cli *cli.Context) error {
 	return nil
 }
 
+// verifySchema executes the verifySchemaTask against a scratch
+// database, using the given command line args as input
+func verifySchema(cli *cli.Context) error {
+	cfg, err := parseConnectConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	conn, err := NewConnection(cfg)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer conn.Close()
+
+	scratchCfg := *cfg
+	scratchCfg.DatabaseName = cli.String(schema.CLIOptScratchName)
+	if scratchCfg.DatabaseName == "" {
+		scratchCfg.DatabaseName = cfg.DatabaseName + "_verify_" + strings.Replace(uuid.New(), "-", "", -1)[:8]
+	}
+	if scratchCfg.DatabaseName == cfg.DatabaseName {
+		return handleErr(schema.NewConfigError(flag(schema.CLIOptScratchName) + " must differ from " + flag(schema.CLIOptDatabase)))
+	}
+	// the scratch database is dropped afterwards, so it must not exist before
+	createCfg := scratchCfg
+	if err := doCreateDatabase(&createCfg, scratchCfg.DatabaseName); err != nil {
+		return handleErr(fmt.Errorf("error creating scratch database %v, it must not exist:%v", scratchCfg.DatabaseName, err))
+	}
+	defer func() {
+		if err := conn.DropDatabase(scratchCfg.DatabaseName); err != nil {
+			handleErr(fmt.Errorf("error dropping scratch database:%v", err))
+		}
+	}()
+	scratchConn, err := NewConnection(&scratchCfg)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer scratchConn.Close()
+
+	if err := schema.Verify(cli, conn, scratchConn); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
 // createDatabase creates a sql database
 func createDatabase(cli *cli.Context) error {
 	cfg, err := parseConnectConfig(cli)
@@ -177,6 +177,29 @@ func BuildCLIOptions() *cli.App {
 				cliHandler(c, updateSchema)
 			},
 		},
+		{
+			Name:  "verify-schema",
+			Usage: "verify that the sql schema matches the versioned schema, exits non-zero on drift",
+			Description: "Applies the versioned schema up to the version of the database to an empty scratch database, " +
+				"then compares their tables, columns and indexes. The scratch database is dropped afterwards.",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "expected version of the schema, defaults to the version of the database",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagScratchName,
+					Usage: "name of the scratch database the versioned schema is applied to, must not exist, defaults to <database>_verify_<random suffix>",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, verifySchema)
+			},
+		},
 		{
 			Name:    "create-database",
 			Aliases: []string{"create"},